@@ -13,6 +13,8 @@ import (
 	"github.com/sirupsen/logrus"
 
 	deployer "github.com/Azure/ARO-RP/pkg/deploy"
+	"github.com/Azure/ARO-RP/pkg/deploy/canary"
+	"github.com/Azure/ARO-RP/pkg/util/regions"
 	"github.com/Azure/ARO-RP/pkg/util/version"
 )
 
@@ -33,6 +35,12 @@ func deploy(ctx context.Context, log *logrus.Entry) error {
 		return fmt.Errorf("location %s must be lower case", location)
 	}
 
+	// a region is onboarded by adding it to the region catalog first
+	_, err := regions.Get(location)
+	if err != nil {
+		return err
+	}
+
 	config, err := deployer.GetConfig(flag.Arg(1), location)
 	if err != nil {
 		return err
@@ -43,6 +51,30 @@ func deploy(ctx context.Context, log *logrus.Entry) error {
 		return err
 	}
 
+	// VERIFY_NSGS only reports drift of the deployed NSG rules from those
+	// generated by pkg/deploy/generator, without deploying anything
+	if os.Getenv("VERIFY_NSGS") != "" {
+		return deployer.VerifyNSGs(ctx)
+	}
+
+	// VERIFY_CANARY creates a canary cluster in the region of the deployed
+	// RP, smoke tests it and deletes it, without deploying anything.  Rollout
+	// to further regions should only proceed if it succeeds
+	if os.Getenv("VERIFY_CANARY") != "" {
+		c, err := canary.New(ctx, log, config, deployVersion)
+		if err != nil {
+			return err
+		}
+
+		return c.Verify(ctx)
+	}
+
+	// ROLLBACK redeploys the last known-good version other than the deploy
+	// version, as recorded in the deployment history of the location
+	if os.Getenv("ROLLBACK") != "" {
+		return deployer.Rollback(ctx)
+	}
+
 	err = deployer.PreDeploy(ctx)
 	if err != nil {
 		return err
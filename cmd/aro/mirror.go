@@ -9,10 +9,17 @@ import (
 	"encoding/base64"
 	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/containers/image/v5/types"
 	"github.com/sirupsen/logrus"
 
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/metrics"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	"github.com/Azure/ARO-RP/pkg/metrics/statsd"
 	pkgmirror "github.com/Azure/ARO-RP/pkg/mirror"
 	"github.com/Azure/ARO-RP/pkg/util/version"
 )
@@ -66,46 +73,100 @@ func mirror(ctx context.Context, log *logrus.Entry) error {
 		return err
 	}
 
-	log.Print("reading release graph")
-	releases, err := pkgmirror.AddFromGraph(version.NewVersion(4, 3))
+	// MIRROR_ARCHITECTURES is optional, e.g. "amd64,arm64".  If unset, the
+	// amd64 releases and the amd64 images of any manifest lists are mirrored.
+	archs, err := pkgmirror.ParseArchitectures(os.Getenv("MIRROR_ARCHITECTURES"))
 	if err != nil {
 		return err
 	}
 
-	var errorOccurred bool
-	for _, release := range releases {
-		log.Printf("mirroring release %s", release.Version)
-		err = pkgmirror.Mirror(ctx, log, dstAcr+acrDomainSuffix, release.Payload, dstAuth, srcAuthQuay)
-		if err != nil {
-			log.Errorf("%s: %s\n", release, err)
-			errorOccurred = true
-		}
+	graphArchs := archs
+	if len(graphArchs) == 0 {
+		graphArchs = []string{"amd64"}
 	}
 
-	for _, ref := range []string{
-		version.MdsdImage("linuxgeneva-microsoft" + acrDomainSuffix),
-		version.MdmImage("linuxgeneva-microsoft" + acrDomainSuffix),
-	} {
-		log.Printf("mirroring %s -> %s", ref, pkgmirror.Dest(dstAcr+acrDomainSuffix, ref))
-		err = pkgmirror.Copy(ctx, pkgmirror.Dest(dstAcr+acrDomainSuffix, ref), ref, dstAuth, srcAuthGeneva)
-		if err != nil {
-			log.Errorf("%s: %s\n", ref, err)
-			errorOccurred = true
+	dstRepo := dstAcr + acrDomainSuffix
+
+	mirrorRelease := func(ctx context.Context, arch string, release pkgmirror.Node) error {
+		return pkgmirror.Mirror(ctx, log, dstRepo, release.Payload, dstAuth, srcAuthQuay, archs)
+	}
+
+	mirrorImages := func(ctx context.Context) error {
+		var errorOccurred bool
+
+		for _, ref := range []string{
+			version.MdsdImage("linuxgeneva-microsoft" + acrDomainSuffix),
+			version.MdmImage("linuxgeneva-microsoft" + acrDomainSuffix),
+		} {
+			log.Printf("mirroring %s -> %s", ref, pkgmirror.Dest(dstRepo, ref))
+			err := pkgmirror.Copy(ctx, pkgmirror.Dest(dstRepo, ref), ref, dstAuth, srcAuthGeneva, archs)
+			if err != nil {
+				log.Errorf("%s: %s\n", ref, err)
+				errorOccurred = true
+			}
+		}
+
+		for _, ref := range []string{
+			"registry.redhat.io/rhel7/support-tools:latest",
+			"registry.redhat.io/rhel8/support-tools:latest",
+		} {
+			log.Printf("mirroring %s -> %s", ref, pkgmirror.Dest(dstRepo, ref))
+			err := pkgmirror.Copy(ctx, pkgmirror.Dest(dstRepo, ref), ref, dstAuth, srcAuthRedhat, archs)
+			if err != nil {
+				log.Errorf("%s: %s\n", ref, err)
+				errorOccurred = true
+			}
+		}
+
+		// public images which need no source credentials
+		for _, ref := range []string{
+			version.NodeProblemDetectorImage("k8s.gcr.io"),
+		} {
+			log.Printf("mirroring %s -> %s", ref, pkgmirror.Dest(dstRepo, ref))
+			err := pkgmirror.Copy(ctx, pkgmirror.Dest(dstRepo, ref), ref, dstAuth, nil, archs)
+			if err != nil {
+				log.Errorf("%s: %s\n", ref, err)
+				errorOccurred = true
+			}
 		}
+
+		if errorOccurred {
+			return fmt.Errorf("an error occurred")
+		}
+
+		return nil
 	}
 
-	for _, ref := range []string{
-		"registry.redhat.io/rhel7/support-tools:latest",
-		"registry.redhat.io/rhel8/support-tools:latest",
-	} {
-		log.Printf("mirroring %s -> %s", ref, pkgmirror.Dest(dstAcr+acrDomainSuffix, ref))
-		err = pkgmirror.Copy(ctx, pkgmirror.Dest(dstAcr+acrDomainSuffix, ref), ref, dstAuth, srcAuthRedhat)
+	// MIRROR_POLL_INTERVAL is optional, e.g. "1h".  If set, the mirror runs
+	// until SIGTERM, mirroring new releases as they are accepted into the
+	// release graphs.
+	if interval := os.Getenv("MIRROR_POLL_INTERVAL"); interval != "" {
+		return mirrorWatch(ctx, log, interval, graphArchs, mirrorRelease, mirrorImages)
+	}
+
+	var errorOccurred bool
+	for _, arch := range graphArchs {
+		log.Printf("reading %s release graph", arch)
+		releases, err := pkgmirror.AddFromGraph(version.NewVersion(4, 3), arch)
 		if err != nil {
-			log.Errorf("%s: %s\n", ref, err)
-			errorOccurred = true
+			return err
+		}
+
+		for _, release := range releases {
+			log.Printf("mirroring %s release %s", arch, release.Version)
+			err = mirrorRelease(ctx, arch, release)
+			if err != nil {
+				log.Errorf("%s: %s\n", release, err)
+				errorOccurred = true
+			}
 		}
 	}
 
+	err = mirrorImages(ctx)
+	if err != nil {
+		errorOccurred = true
+	}
+
 	log.Print("done")
 
 	if errorOccurred {
@@ -114,3 +175,48 @@ func mirror(ctx context.Context, log *logrus.Entry) error {
 
 	return nil
 }
+
+func mirrorWatch(ctx context.Context, log *logrus.Entry, interval string, archs []string, mirrorRelease func(context.Context, string, pkgmirror.Node) error, mirrorImages func(context.Context) error) error {
+	d, err := time.ParseDuration(interval)
+	if err != nil {
+		return err
+	}
+
+	if d <= 0 {
+		return fmt.Errorf("invalid MIRROR_POLL_INTERVAL %q", interval)
+	}
+
+	// metrics are only emitted if the mirror runs alongside an MDM agent
+	var m metrics.Interface = &noop.Noop{}
+	if _, found := os.LookupEnv("MDM_ACCOUNT"); found {
+		_env, err := env.NewCore(ctx, log)
+		if err != nil {
+			return err
+		}
+
+		m, err = statsd.New(ctx, log.WithField("component", "metrics"), _env, os.Getenv("MDM_ACCOUNT"), os.Getenv("MDM_NAMESPACE"))
+		if err != nil {
+			return err
+		}
+	}
+
+	w := pkgmirror.NewWatcher(log.WithField("component", "mirror"), m, d, version.NewVersion(4, 3), archs, mirrorRelease, mirrorImages)
+
+	sigterm := make(chan os.Signal, 1)
+	stop := make(chan struct{})
+	done := make(chan struct{})
+
+	signal.Notify(sigterm, syscall.SIGTERM)
+
+	go func() {
+		w.Run(ctx, stop)
+		close(done)
+	}()
+
+	<-sigterm
+	log.Print("received SIGTERM")
+	close(stop)
+	<-done
+
+	return nil
+}
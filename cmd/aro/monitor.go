@@ -21,9 +21,9 @@ import (
 	"github.com/Azure/ARO-RP/pkg/metrics/statsd/k8s"
 	pkgmonitor "github.com/Azure/ARO-RP/pkg/monitor"
 	"github.com/Azure/ARO-RP/pkg/proxy"
-	"github.com/Azure/ARO-RP/pkg/util/deployment"
 	"github.com/Azure/ARO-RP/pkg/util/encryption"
 	"github.com/Azure/ARO-RP/pkg/util/keyvault"
+	"github.com/Azure/ARO-RP/pkg/util/secretstore"
 )
 
 func monitor(ctx context.Context, log *logrus.Entry) error {
@@ -32,7 +32,7 @@ func monitor(ctx context.Context, log *logrus.Entry) error {
 		return err
 	}
 
-	if _env.DeploymentMode() != deployment.Development {
+	if !_env.IsLocalDevelopment() {
 		for _, key := range []string{
 			"CLUSTER_MDM_ACCOUNT",
 			"CLUSTER_MDM_NAMESPACE",
@@ -72,9 +72,9 @@ func monitor(ctx context.Context, log *logrus.Entry) error {
 		return err
 	}
 
-	serviceKeyvault := keyvault.NewManager(rpKVAuthorizer, serviceKeyvaultURI)
+	serviceSecrets := secretstore.New(secretstore.BackendKeyvault, secretstore.NewKeyvaultBackend(keyvault.NewManager(rpKVAuthorizer, serviceKeyvaultURI)), m, 0)
 
-	key, err := serviceKeyvault.GetBase64Secret(ctx, env.EncryptionSecretName)
+	key, err := serviceSecrets.GetBase64Secret(ctx, env.EncryptionSecretName)
 	if err != nil {
 		return err
 	}
@@ -89,27 +89,32 @@ func monitor(ctx context.Context, log *logrus.Entry) error {
 		return err
 	}
 
-	dbMonitors, err := database.NewMonitors(ctx, _env.DeploymentMode(), dbc)
+	dbAsyncOperations, err := database.NewAsyncOperations(ctx, _env, dbc)
 	if err != nil {
 		return err
 	}
 
-	dbOpenShiftClusters, err := database.NewOpenShiftClusters(ctx, _env.DeploymentMode(), dbc)
+	dbMonitors, err := database.NewMonitors(ctx, _env, dbc)
 	if err != nil {
 		return err
 	}
 
-	dbSubscriptions, err := database.NewSubscriptions(ctx, _env.DeploymentMode(), dbc)
+	dbOpenShiftClusters, err := database.NewOpenShiftClusters(ctx, _env, dbc)
 	if err != nil {
 		return err
 	}
 
-	dialer, err := proxy.NewDialer(_env.DeploymentMode())
+	dbSubscriptions, err := database.NewSubscriptions(ctx, _env, dbc)
 	if err != nil {
 		return err
 	}
 
-	mon := pkgmonitor.NewMonitor(log.WithField("component", "monitor"), dialer, dbMonitors, dbOpenShiftClusters, dbSubscriptions, m, clusterm)
+	dialer, err := proxy.NewDialer(_env)
+	if err != nil {
+		return err
+	}
+
+	mon := pkgmonitor.NewMonitor(log.WithField("component", "monitor"), dialer, dbAsyncOperations, dbMonitors, dbOpenShiftClusters, dbSubscriptions, m, clusterm)
 
 	return mon.Run(ctx)
 }
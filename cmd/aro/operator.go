@@ -9,6 +9,7 @@ import (
 	"fmt"
 
 	configclient "github.com/openshift/client-go/config/clientset/versioned"
+	operatorclient "github.com/openshift/client-go/operator/clientset/versioned"
 	securityclient "github.com/openshift/client-go/security/clientset/versioned"
 	maoclient "github.com/openshift/machine-api-operator/pkg/generated/clientset/versioned"
 	mcoclient "github.com/openshift/machine-config-operator/pkg/generated/clientset/versioned"
@@ -20,11 +21,38 @@ import (
 	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/alertwebhook"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/autoscaler"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/checker"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/cloudproviderconfig"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/clusterversion"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/consolenotification"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/debuglog"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/dns"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/etcddefrag"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/genevalogging"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/imageregistry"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/inventory"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/machineconfig"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/machinewebhook"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/networkpolicy"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/nodemetadata"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/nodeproblemdetector"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/nodereadiness"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/nodesizing"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/podsupervisor"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/priorityclass"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/proxy"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/pullsecret"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/rbac"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/rebootcoordinator"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/remediation"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/routefix"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/servicemonitor"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/statusdashboard"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/supportability"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/trustbundle"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/workaround"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/workerpool"
 	"github.com/Azure/ARO-RP/pkg/util/deployment"
 	utillog "github.com/Azure/ARO-RP/pkg/util/log"
 	// +kubebuilder:scaffold:imports
@@ -40,6 +68,11 @@ func operator(ctx context.Context, log *logrus.Entry) error {
 	deploymentMode := deployment.NewMode()
 	log.Infof("running in %s mode", deploymentMode)
 
+	capabilities, err := deployment.NewCapabilities(deploymentMode)
+	if err != nil {
+		return err
+	}
+
 	ctrl.SetLogger(utillog.LogrWrapper(log))
 
 	restConfig, err := ctrl.GetConfig()
@@ -47,8 +80,15 @@ func operator(ctx context.Context, log *logrus.Entry) error {
 		return err
 	}
 
+	// only the master operator serves metrics, which the cluster monitoring
+	// stack scrapes through the ServiceMonitor of the master service
+	metricsBindAddress := "0" // disabled
+	if role == pkgoperator.RoleMaster {
+		metricsBindAddress = fmt.Sprintf(":%d", servicemonitor.MetricsPort)
+	}
+
 	mgr, err := ctrl.NewManager(restConfig, ctrl.Options{
-		MetricsBindAddress: "0", // disabled
+		MetricsBindAddress: metricsBindAddress,
 		Port:               8443,
 	})
 	if err != nil {
@@ -75,46 +115,190 @@ func operator(ctx context.Context, log *logrus.Entry) error {
 	if err != nil {
 		return err
 	}
+	operatorcli, err := operatorclient.NewForConfig(restConfig)
+	if err != nil {
+		return err
+	}
 	arocli, err := aroclient.NewForConfig(restConfig)
 	if err != nil {
 		return err
 	}
 
+	// every controller logs through loggers, so that the debug log controller
+	// can raise their log level
+	loggers := debuglog.NewLoggers(log)
+
 	if role == pkgoperator.RoleMaster {
 		if err = (genevalogging.NewReconciler(
-			log.WithField("controller", controllers.GenevaLoggingControllerName),
+			loggers.Controller(controllers.GenevaLoggingControllerName),
 			kubernetescli, securitycli, arocli,
 			restConfig)).SetupWithManager(mgr); err != nil {
 			return fmt.Errorf("unable to create controller Genevalogging: %v", err)
 		}
 		if err = (pullsecret.NewReconciler(
-			log.WithField("controller", controllers.PullSecretControllerName),
+			loggers.Controller(controllers.PullSecretControllerName),
 			kubernetescli, arocli)).SetupWithManager(mgr); err != nil {
 			return fmt.Errorf("unable to create controller PullSecret: %v", err)
 		}
 		if err = (alertwebhook.NewReconciler(
-			log.WithField("controller", controllers.AlertwebhookControllerName),
+			loggers.Controller(controllers.AlertwebhookControllerName),
 			kubernetescli)).SetupWithManager(mgr); err != nil {
 			return fmt.Errorf("unable to create controller AlertWebhook: %v", err)
 		}
 		if err = (workaround.NewReconciler(
-			log.WithField("controller", controllers.WorkaroundControllerName),
-			kubernetescli, configcli, mcocli, arocli, restConfig)).SetupWithManager(mgr); err != nil {
+			loggers.Controller(controllers.WorkaroundControllerName),
+			kubernetescli, configcli, arocli, restConfig, mgr.GetEventRecorderFor(controllers.WorkaroundControllerName))).SetupWithManager(mgr); err != nil {
 			return fmt.Errorf("unable to create controller Workaround: %v", err)
 		}
 		if err = (routefix.NewReconciler(
-			log.WithField("controller", controllers.RouteFixControllerName),
+			loggers.Controller(controllers.RouteFixControllerName),
 			kubernetescli, securitycli, arocli, restConfig)).SetupWithManager(mgr); err != nil {
 			return fmt.Errorf("unable to create controller RouteFix: %v", err)
 		}
+		if err = (consolenotification.NewReconciler(
+			loggers.Controller(controllers.ConsoleNotificationControllerName),
+			arocli, restConfig)).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller ConsoleNotification: %v", err)
+		}
+		if err = (supportability.NewReconciler(
+			loggers.Controller(controllers.SupportabilityControllerName),
+			kubernetescli, configcli, operatorcli, arocli)).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller Supportability: %v", err)
+		}
+		if err = (proxy.NewReconciler(
+			loggers.Controller(controllers.ProxyControllerName),
+			configcli, arocli, mgr.GetEventRecorderFor(controllers.ProxyControllerName))).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller Proxy: %v", err)
+		}
+		if err = (networkpolicy.NewReconciler(
+			loggers.Controller(controllers.NetworkPolicyControllerName),
+			kubernetescli, arocli, restConfig)).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller NetworkPolicy: %v", err)
+		}
+		if err = (cloudproviderconfig.NewReconciler(
+			loggers.Controller(controllers.CloudProviderConfigControllerName),
+			kubernetescli, arocli)).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller CloudProviderConfig: %v", err)
+		}
+		if err = (nodeproblemdetector.NewReconciler(
+			loggers.Controller(controllers.NodeProblemDetectorControllerName),
+			kubernetescli, securitycli, arocli, restConfig)).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller NodeProblemDetector: %v", err)
+		}
+		if err = (rbac.NewReconciler(
+			loggers.Controller(controllers.RBACControllerName),
+			kubernetescli, arocli, mgr.GetEventRecorderFor(controllers.RBACControllerName))).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller RBAC: %v", err)
+		}
+		if err = (etcddefrag.NewReconciler(
+			loggers.Controller(controllers.EtcdDefragControllerName),
+			kubernetescli, arocli, mgr.GetEventRecorderFor(controllers.EtcdDefragControllerName))).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller EtcdDefrag: %v", err)
+		}
+		if err = (dns.NewReconciler(
+			loggers.Controller(controllers.DNSControllerName),
+			operatorcli, arocli, mgr.GetEventRecorderFor(controllers.DNSControllerName))).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller DNS: %v", err)
+		}
+		if err = (inventory.NewReconciler(
+			loggers.Controller(controllers.InventoryControllerName),
+			kubernetescli, configcli, maocli, arocli)).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller Inventory: %v", err)
+		}
+		if err = (autoscaler.NewReconciler(
+			loggers.Controller(controllers.AutoscalerControllerName),
+			maocli, arocli, restConfig)).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller Autoscaler: %v", err)
+		}
+		if err = (nodesizing.NewReconciler(
+			loggers.Controller(controllers.NodeSizingControllerName),
+			kubernetescli, mcocli, arocli, restConfig, mgr.GetEventRecorderFor(controllers.NodeSizingControllerName))).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller NodeSizing: %v", err)
+		}
+		if err = (imageregistry.NewReconciler(
+			loggers.Controller(controllers.ImageRegistryControllerName),
+			arocli, restConfig, mgr.GetEventRecorderFor(controllers.ImageRegistryControllerName))).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller ImageRegistry: %v", err)
+		}
+		if err = (trustbundle.NewReconciler(
+			loggers.Controller(controllers.TrustBundleControllerName),
+			kubernetescli, arocli, mgr.GetEventRecorderFor(controllers.TrustBundleControllerName))).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller TrustBundle: %v", err)
+		}
+		if err = (workerpool.NewReconciler(
+			loggers.Controller(controllers.WorkerPoolControllerName),
+			kubernetescli, maocli, arocli)).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller WorkerPool: %v", err)
+		}
+		if err = (podsupervisor.NewReconciler(
+			loggers.Controller(controllers.PodSupervisorControllerName),
+			kubernetescli, arocli, mgr.GetEventRecorderFor(controllers.PodSupervisorControllerName))).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller PodSupervisor: %v", err)
+		}
+		if err = (statusdashboard.NewReconciler(
+			loggers.Controller(controllers.StatusDashboardControllerName),
+			kubernetescli, arocli)).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller StatusDashboard: %v", err)
+		}
+		if err = (nodereadiness.NewReconciler(
+			loggers.Controller(controllers.NodeReadinessControllerName),
+			kubernetescli, arocli)).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller NodeReadiness: %v", err)
+		}
+		if err = (nodemetadata.NewReconciler(
+			loggers.Controller(controllers.NodeMetadataControllerName),
+			kubernetescli, maocli, arocli)).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller NodeMetadata: %v", err)
+		}
+		if err = (priorityclass.NewReconciler(
+			loggers.Controller(controllers.PriorityClassControllerName),
+			kubernetescli, arocli, mgr.GetEventRecorderFor(controllers.PriorityClassControllerName))).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller PriorityClass: %v", err)
+		}
+		if err = (clusterversion.NewReconciler(
+			loggers.Controller(controllers.ClusterVersionControllerName),
+			configcli, arocli, mgr.GetEventRecorderFor(controllers.ClusterVersionControllerName))).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller ClusterVersion: %v", err)
+		}
+		if err = (remediation.NewReconciler(
+			loggers.Controller(controllers.RemediationControllerName),
+			maocli, arocli, mgr.GetEventRecorderFor(controllers.RemediationControllerName))).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller Remediation: %v", err)
+		}
+		if err = (machineconfig.NewReconciler(
+			loggers.Controller(controllers.MachineConfigControllerName),
+			mcocli, arocli)).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller MachineConfig: %v", err)
+		}
+		if err = (rebootcoordinator.NewReconciler(
+			loggers.Controller(controllers.RebootCoordinatorControllerName),
+			kubernetescli, arocli, mgr.GetEventRecorderFor(controllers.RebootCoordinatorControllerName))).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller RebootCoordinator: %v", err)
+		}
+		if err = (machinewebhook.NewReconciler(
+			loggers.Controller(controllers.MachineWebhookControllerName),
+			kubernetescli, arocli, capabilities)).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller MachineWebhook: %v", err)
+		}
+		if err = (servicemonitor.NewReconciler(
+			loggers.Controller(controllers.ServiceMonitorControllerName),
+			arocli, restConfig)).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller ServiceMonitor: %v", err)
+		}
 	}
 
 	if err = (checker.NewReconciler(
-		log.WithField("controller", controllers.CheckerControllerName),
-		maocli, arocli, role, deploymentMode)).SetupWithManager(mgr); err != nil {
+		loggers.Controller(controllers.CheckerControllerName),
+		kubernetescli, configcli, maocli, arocli, restConfig, mgr.GetEventRecorderFor(controllers.CheckerControllerName), role, capabilities)).SetupWithManager(mgr); err != nil {
 		return fmt.Errorf("unable to create controller InternetChecker: %v", err)
 	}
 
+	if err = (debuglog.NewReconciler(
+		log.WithField("controller", controllers.DebugLogControllerName),
+		arocli, loggers)).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("unable to create controller DebugLog: %v", err)
+	}
+
 	// +kubebuilder:scaffold:builder
 
 	log.Info("starting manager")
@@ -27,7 +27,7 @@ import (
 	"github.com/Azure/ARO-RP/pkg/metrics/statsd"
 	"github.com/Azure/ARO-RP/pkg/metrics/statsd/azure"
 	"github.com/Azure/ARO-RP/pkg/metrics/statsd/k8s"
-	"github.com/Azure/ARO-RP/pkg/util/deployment"
+	"github.com/Azure/ARO-RP/pkg/util/cmk"
 	"github.com/Azure/ARO-RP/pkg/util/encryption"
 )
 
@@ -38,7 +38,7 @@ func rp(ctx context.Context, log *logrus.Entry) error {
 	}
 
 	var keys []string
-	if _env.DeploymentMode() == deployment.Development {
+	if _env.IsLocalDevelopment() {
 		keys = []string{
 			"PULL_SECRET",
 		}
@@ -76,7 +76,9 @@ func rp(ctx context.Context, log *logrus.Entry) error {
 		RequestLatency: k8s.NewLatency(m),
 	})
 
-	dbKey, err := _env.ServiceKeyvault().GetBase64Secret(ctx, env.EncryptionSecretName)
+	_env.InitializeSecretStoreMetrics(m)
+
+	dbKey, err := _env.ServiceSecrets().GetBase64Secret(ctx, env.EncryptionSecretName)
 	if err != nil {
 		return err
 	}
@@ -91,29 +93,47 @@ func rp(ctx context.Context, log *logrus.Entry) error {
 		return err
 	}
 
-	dbAsyncOperations, err := database.NewAsyncOperations(ctx, _env.DeploymentMode(), dbc)
+	dbAsyncOperations, err := database.NewAsyncOperations(ctx, _env, dbc)
+	if err != nil {
+		return err
+	}
+
+	dbBackends, err := database.NewBackends(ctx, _env, dbc)
 	if err != nil {
 		return err
 	}
 
-	dbBilling, err := database.NewBilling(ctx, _env.DeploymentMode(), dbc)
+	dbBilling, err := database.NewBilling(ctx, _env, dbc)
 	if err != nil {
 		return err
 	}
 
-	dbOpenShiftClusters, err := database.NewOpenShiftClusters(ctx, _env.DeploymentMode(), dbc)
+	dbOpenShiftClusters, err := database.NewOpenShiftClusters(ctx, _env, dbc)
 	if err != nil {
 		return err
 	}
 
-	dbSubscriptions, err := database.NewSubscriptions(ctx, _env.DeploymentMode(), dbc)
+	sealer := cmk.NewManager(log.WithField("component", "cmk"), _env)
+
+	dbSubscriptions, err := database.NewSubscriptions(ctx, _env, dbc)
+	if err != nil {
+		return err
+	}
+
+	dbOpenShiftVersions, err := database.NewOpenShiftVersions(ctx, _env, dbc)
+	if err != nil {
+		return err
+	}
+
+	dbClusterInventories, err := database.NewClusterInventories(ctx, _env, dbc)
 	if err != nil {
 		return err
 	}
 
 	go database.EmitMetrics(ctx, log, dbOpenShiftClusters, m)
+	go database.EmitClusterInventoryMetrics(ctx, log, dbClusterInventories, m)
 
-	feKey, err := _env.ServiceKeyvault().GetBase64Secret(ctx, env.FrontendEncryptionSecretName)
+	feKey, err := _env.ServiceSecrets().GetBase64Secret(ctx, env.FrontendEncryptionSecretName)
 	if err != nil {
 		return err
 	}
@@ -123,12 +143,17 @@ func rp(ctx context.Context, log *logrus.Entry) error {
 		return err
 	}
 
-	f, err := frontend.NewFrontend(ctx, log.WithField("component", "frontend"), _env, dbAsyncOperations, dbOpenShiftClusters, dbSubscriptions, api.APIs, m, feCipher, adminactions.New)
+	// the frontend reads clusters through the cache; the backend needs the
+	// documents it leases to be current and so does not.  The cache holds
+	// documents as they are stored, so their secrets are unsealed above it.
+	dbOpenShiftClustersCache := database.NewOpenShiftClustersCache(log.WithField("component", "database-cache"), dbOpenShiftClusters)
+
+	f, err := frontend.NewFrontend(ctx, log.WithField("component", "frontend"), _env, dbAsyncOperations, database.NewSealedOpenShiftClusters(dbOpenShiftClustersCache, sealer), dbSubscriptions, dbOpenShiftVersions, dbClusterInventories, api.APIs, m, feCipher, adminactions.New)
 	if err != nil {
 		return err
 	}
 
-	b, err := backend.NewBackend(ctx, log.WithField("component", "backend"), _env, dbAsyncOperations, dbBilling, dbOpenShiftClusters, dbSubscriptions, cipher, m)
+	b, err := backend.NewBackend(ctx, log.WithField("component", "backend"), _env, dbAsyncOperations, dbBackends, dbBilling, database.NewSealedOpenShiftClusters(dbOpenShiftClusters, sealer), dbOpenShiftVersions, dbSubscriptions, cipher, m)
 	if err != nil {
 		return err
 	}
@@ -145,6 +170,7 @@ func rp(ctx context.Context, log *logrus.Entry) error {
 	signal.Notify(sigterm, syscall.SIGTERM)
 
 	log.Print("listening")
+	go dbOpenShiftClustersCache.Run(ctx, stop)
 	go b.Run(ctx, stop, doneB)
 	go f.Run(ctx, stop, doneF)
 
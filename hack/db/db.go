@@ -58,7 +58,7 @@ func run(ctx context.Context, log *logrus.Entry) error {
 		return err
 	}
 
-	openShiftClusters, err := database.NewOpenShiftClusters(ctx, _env.DeploymentMode(), dbc)
+	openShiftClusters, err := database.NewOpenShiftClusters(ctx, _env, dbc)
 	if err != nil {
 		return err
 	}
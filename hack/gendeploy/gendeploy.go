@@ -4,6 +4,8 @@ package main
 // Licensed under the Apache License 2.0.
 
 import (
+	"io/ioutil"
+
 	"github.com/Azure/ARO-RP/pkg/deploy/generator"
 )
 
@@ -15,7 +17,12 @@ func run() error {
 	}
 
 	// prod artifacts
-	return generator.New(true).Artifacts()
+	err = generator.New(true).Artifacts()
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile("../docs/rp-nsg-rules.md", generator.NSGRulesDocumentation(), 0666)
 }
 
 func main() {
@@ -28,24 +28,31 @@ type OpenShiftCluster struct {
 
 // OpenShiftClusterProperties represents an OpenShift cluster's properties.
 type OpenShiftClusterProperties struct {
-	ArchitectureVersion     ArchitectureVersion     `json:"architectureVersion,omitempty"`
-	ProvisioningState       ProvisioningState       `json:"provisioningState,omitempty"`
-	LastProvisioningState   ProvisioningState       `json:"lastProvisioningState,omitempty"`
-	FailedProvisioningState ProvisioningState       `json:"failedProvisioningState,omitempty"`
-	LastAdminUpdateError    string                  `json:"lastAdminUpdateError,omitempty"`
-	CreatedBy               string                  `json:"createdBy,omitempty"`
-	ProvisionedBy           string                  `json:"provisionedBy,omitempty"`
-	ClusterProfile          ClusterProfile          `json:"clusterProfile,omitempty"`
-	ConsoleProfile          ConsoleProfile          `json:"consoleProfile,omitempty"`
-	ServicePrincipalProfile ServicePrincipalProfile `json:"servicePrincipalProfile,omitempty"`
-	NetworkProfile          NetworkProfile          `json:"networkProfile,omitempty"`
-	MasterProfile           MasterProfile           `json:"masterProfile,omitempty"`
-	WorkerProfiles          []WorkerProfile         `json:"workerProfiles,omitempty"`
-	APIServerProfile        APIServerProfile        `json:"apiserverProfile,omitempty"`
-	IngressProfiles         []IngressProfile        `json:"ingressProfiles,omitempty"`
-	Install                 *Install                `json:"install,omitempty"`
-	StorageSuffix           string                  `json:"storageSuffix,omitempty"`
-	RegistryProfiles        []RegistryProfile       `json:"registryProfiles,omitempty"`
+	ArchitectureVersion     ArchitectureVersion      `json:"architectureVersion,omitempty"`
+	ProvisioningState       ProvisioningState        `json:"provisioningState,omitempty"`
+	LastProvisioningState   ProvisioningState        `json:"lastProvisioningState,omitempty"`
+	FailedProvisioningState ProvisioningState        `json:"failedProvisioningState,omitempty"`
+	LastAdminUpdateError    string                   `json:"lastAdminUpdateError,omitempty"`
+	CreatedBy               string                   `json:"createdBy,omitempty"`
+	ProvisionedBy           string                   `json:"provisionedBy,omitempty"`
+	ClusterProfile          ClusterProfile           `json:"clusterProfile,omitempty"`
+	ConsoleProfile          ConsoleProfile           `json:"consoleProfile,omitempty"`
+	ServicePrincipalProfile ServicePrincipalProfile  `json:"servicePrincipalProfile,omitempty"`
+	NetworkProfile          NetworkProfile           `json:"networkProfile,omitempty"`
+	MasterProfile           MasterProfile            `json:"masterProfile,omitempty"`
+	WorkerProfiles          []WorkerProfile          `json:"workerProfiles,omitempty"`
+	APIServerProfile        APIServerProfile         `json:"apiserverProfile,omitempty"`
+	IngressProfiles         []IngressProfile         `json:"ingressProfiles,omitempty"`
+	AutoscalerProfile       *AutoscalerProfile       `json:"autoscalerProfile,omitempty"`
+	MaintenanceProfile      *MaintenanceProfile      `json:"maintenanceProfile,omitempty"`
+	EncryptionAtRestProfile *EncryptionAtRestProfile `json:"encryptionAtRestProfile,omitempty"`
+	Install                 *Install                 `json:"install,omitempty"`
+	Progress                *ProvisioningProgress    `json:"progress,omitempty"`
+	StorageSuffix           string                   `json:"storageSuffix,omitempty"`
+	RegistryProfiles        []RegistryProfile        `json:"registryProfiles,omitempty"`
+	ConsoleNotifications    []ConsoleNotification    `json:"consoleNotifications,omitempty" mutable:"true"`
+	OperatorFlags           map[string]string        `json:"operatorFlags,omitempty"`
+	CredentialsProfile      CredentialsProfile       `json:"credentialsProfile,omitempty"`
 }
 
 // ProvisioningState represents a provisioning state.
@@ -66,6 +73,7 @@ type ClusterProfile struct {
 	Domain          string `json:"domain,omitempty"`
 	Version         string `json:"version,omitempty"`
 	ResourceGroupID string `json:"resourceGroupId,omitempty"`
+	SSHPublicKey    string `json:"sshPublicKey,omitempty"`
 }
 
 // ConsoleProfile represents a console profile.
@@ -83,16 +91,34 @@ type ServicePrincipalProfile struct {
 type NetworkProfile struct {
 	PodCIDR     string `json:"podCidr,omitempty"`
 	ServiceCIDR string `json:"serviceCidr,omitempty"`
+	MachineCIDR string `json:"machineCidr,omitempty"`
+	HostPrefix  int    `json:"hostPrefix,omitempty"`
 
 	PrivateEndpointIP string `json:"privateEndpointIp,omitempty"`
+
+	LoadBalancerProfile *LoadBalancerProfile `json:"loadBalancerProfile,omitempty"`
+}
+
+// LoadBalancerProfile represents the configuration of the public load balancer.
+type LoadBalancerProfile struct {
+	ManagedOutboundIPs *ManagedOutboundIPs `json:"managedOutboundIps,omitempty"`
+}
+
+// ManagedOutboundIPs represents the managed outbound public IPs.
+type ManagedOutboundIPs struct {
+	Count int `json:"count,omitempty"`
 }
 
 // MasterProfile represents a master profile.
 type MasterProfile struct {
-	VMSize   VMSize `json:"vmSize,omitempty"`
-	SubnetID string `json:"subnetId,omitempty"`
+	VMSize       VMSize       `json:"vmSize,omitempty"`
+	SubnetID     string       `json:"subnetId,omitempty"`
+	SecurityType SecurityType `json:"securityType,omitempty"`
 }
 
+// SecurityType represents the security type of the VMs of a profile.
+type SecurityType string
+
 // VMSize represents a VM size.
 type VMSize string
 
@@ -119,22 +145,46 @@ const (
 	VMSizeStandardF8sV2  VMSize = "Standard_F8s_v2"
 	VMSizeStandardF16sV2 VMSize = "Standard_F16s_v2"
 	VMSizeStandardF32sV2 VMSize = "Standard_F32s_v2"
+
+	VMSizeStandardD4psV5  VMSize = "Standard_D4ps_v5"
+	VMSizeStandardD8psV5  VMSize = "Standard_D8ps_v5"
+	VMSizeStandardD16psV5 VMSize = "Standard_D16ps_v5"
+	VMSizeStandardD32psV5 VMSize = "Standard_D32ps_v5"
+
+	VMSizeStandardE4psV5  VMSize = "Standard_E4ps_v5"
+	VMSizeStandardE8psV5  VMSize = "Standard_E8ps_v5"
+	VMSizeStandardE16psV5 VMSize = "Standard_E16ps_v5"
+	VMSizeStandardE32psV5 VMSize = "Standard_E32ps_v5"
 )
 
 // WorkerProfile represents a worker profile.
 type WorkerProfile struct {
-	Name       string `json:"name,omitempty"`
-	VMSize     VMSize `json:"vmSize,omitempty"`
-	DiskSizeGB int    `json:"diskSizeGB,omitempty"`
-	SubnetID   string `json:"subnetId,omitempty"`
-	Count      int    `json:"count,omitempty"`
+	Name         string            `json:"name,omitempty"`
+	VMSize       VMSize            `json:"vmSize,omitempty"`
+	DiskSizeGB   int               `json:"diskSizeGB,omitempty"`
+	SubnetID     string            `json:"subnetId,omitempty"`
+	Count        int               `json:"count,omitempty"`
+	SecurityType SecurityType      `json:"securityType,omitempty"`
+	NodeLabels   map[string]string `json:"nodeLabels,omitempty"`
+	NodeTaints   []NodeTaint       `json:"nodeTaints,omitempty"`
 }
 
+// NodeTaint represents a taint on the nodes of a worker profile.
+type NodeTaint struct {
+	Key    string      `json:"key,omitempty"`
+	Value  string      `json:"value,omitempty"`
+	Effect TaintEffect `json:"effect,omitempty"`
+}
+
+// TaintEffect represents the effect of a node taint.
+type TaintEffect string
+
 // APIServerProfile represents an API server profile.
 type APIServerProfile struct {
-	Visibility Visibility `json:"visibility,omitempty"`
-	URL        string     `json:"url,omitempty"`
-	IP         string     `json:"ip,omitempty"`
+	Visibility        Visibility `json:"visibility,omitempty"`
+	URL               string     `json:"url,omitempty"`
+	IP                string     `json:"ip,omitempty"`
+	PublicIPAddressID string     `json:"publicIpAddressId,omitempty"`
 }
 
 // Visibility represents visibility.
@@ -148,9 +198,10 @@ const (
 
 // IngressProfile represents an ingress profile.
 type IngressProfile struct {
-	Name       string     `json:"name,omitempty"`
-	Visibility Visibility `json:"visibility,omitempty"`
-	IP         string     `json:"ip,omitempty"`
+	Name              string     `json:"name,omitempty"`
+	Visibility        Visibility `json:"visibility,omitempty"`
+	IP                string     `json:"ip,omitempty"`
+	PublicIPAddressID string     `json:"publicIpAddressId,omitempty"`
 }
 
 // Install represents an install process.
@@ -159,6 +210,31 @@ type Install struct {
 	Phase InstallPhase `json:"phase"`
 }
 
+// ProvisioningProgress represents how far the backend has got with an
+// operation.
+type ProvisioningProgress struct {
+	Phase              string    `json:"phase,omitempty"`
+	Step               string    `json:"step,omitempty"`
+	PercentComplete    int       `json:"percentComplete,omitempty"`
+	LastTransitionTime time.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// CredentialsProfile represents when the cluster's credentials were issued
+// and rotated.
+type CredentialsProfile struct {
+	KubeadminPassword    CredentialRecord `json:"kubeadminPassword,omitempty"`
+	AROServiceKubeconfig CredentialRecord `json:"aroServiceKubeconfig,omitempty"`
+	ServicePrincipal     CredentialRecord `json:"servicePrincipal,omitempty"`
+	SSHKey               CredentialRecord `json:"sshKey,omitempty"`
+}
+
+// CredentialRecord represents when a credential was issued and rotated.
+type CredentialRecord struct {
+	IssuedAt  time.Time   `json:"issuedAt,omitempty"`
+	ExpiresAt *time.Time  `json:"expiresAt,omitempty"`
+	RotatedAt []time.Time `json:"rotatedAt,omitempty"`
+}
+
 // InstallPhase represents an install phase.
 type InstallPhase int
 
@@ -174,6 +250,84 @@ type RegistryProfile struct {
 	Username string `json:"username,omitempty"`
 }
 
+// AutoscalerProfile represents the configuration of the cluster autoscaler
+type AutoscalerProfile struct {
+	MaxNodesTotal          int              `json:"maxNodesTotal,omitempty"`
+	ScaleDownDelayAfterAdd string           `json:"scaleDownDelayAfterAdd,omitempty"`
+	ScaleDownUnneededTime  string           `json:"scaleDownUnneededTime,omitempty"`
+	Pools                  []AutoscalerPool `json:"pools,omitempty"`
+}
+
+// AutoscalerPool represents the scaling bounds of a worker profile
+type AutoscalerPool struct {
+	Name        string `json:"name,omitempty"`
+	MinReplicas int    `json:"minReplicas,omitempty"`
+	MaxReplicas int    `json:"maxReplicas,omitempty"`
+}
+
+// MaintenanceProfile represents the customer's preferences for when the
+// cluster may be maintained
+type MaintenanceProfile struct {
+	Windows      []MaintenanceWindow `json:"windows,omitempty"`
+	ExcludedDays []string            `json:"excludedDays,omitempty"`
+}
+
+// MaintenanceWindow represents a weekly period, in UTC, in which the cluster
+// may be maintained
+type MaintenanceWindow struct {
+	Day           string `json:"day,omitempty"`
+	StartHour     int    `json:"startHour,omitempty"`
+	DurationHours int    `json:"durationHours,omitempty"`
+}
+
+// EncryptionAtRestProfile represents the customer-managed key with which the
+// cluster's secrets are encrypted at rest, and whether the RP can use it
+type EncryptionAtRestProfile struct {
+	KeyVaultKeyID   string             `json:"keyVaultKeyId,omitempty"`
+	KeyState        EncryptionKeyState `json:"keyState,omitempty"`
+	KeyStateMessage string             `json:"keyStateMessage,omitempty"`
+}
+
+// EncryptionKeyState represents whether the RP can use a customer-managed key
+type EncryptionKeyState string
+
+// EncryptionKeyState constants
+const (
+	EncryptionKeyStateAccessible   EncryptionKeyState = "Accessible"
+	EncryptionKeyStateInaccessible EncryptionKeyState = "Inaccessible"
+)
+
+// ConsoleNotification represents a banner displayed in the OpenShift console
+type ConsoleNotification struct {
+	Name     string                      `json:"name,omitempty"`
+	Type     ConsoleNotificationType     `json:"type,omitempty"`
+	Location ConsoleNotificationLocation `json:"location,omitempty"`
+	Text     string                      `json:"text,omitempty"`
+	LinkText string                      `json:"linkText,omitempty"`
+	LinkURL  string                      `json:"linkUrl,omitempty"`
+}
+
+// ConsoleNotificationType represents the kind of a console notification
+type ConsoleNotificationType string
+
+// ConsoleNotificationType constants
+const (
+	ConsoleNotificationTypeInformation ConsoleNotificationType = "Information"
+	ConsoleNotificationTypeMaintenance ConsoleNotificationType = "Maintenance"
+	ConsoleNotificationTypeDeprecation ConsoleNotificationType = "Deprecation"
+)
+
+// ConsoleNotificationLocation represents where a console notification is
+// displayed
+type ConsoleNotificationLocation string
+
+// ConsoleNotificationLocation constants
+const (
+	ConsoleNotificationLocationBannerTop       ConsoleNotificationLocation = "BannerTop"
+	ConsoleNotificationLocationBannerBottom    ConsoleNotificationLocation = "BannerBottom"
+	ConsoleNotificationLocationBannerTopBottom ConsoleNotificationLocation = "BannerTopBottom"
+)
+
 // ArchitectureVersion represents an architecture version
 type ArchitectureVersion int
 
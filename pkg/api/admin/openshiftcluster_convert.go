@@ -4,6 +4,8 @@ package admin
 // Licensed under the Apache License 2.0.
 
 import (
+	"time"
+
 	"github.com/Azure/ARO-RP/pkg/api"
 )
 
@@ -31,6 +33,7 @@ func (c *openShiftClusterConverter) ToExternal(oc *api.OpenShiftCluster) interfa
 				Domain:          oc.Properties.ClusterProfile.Domain,
 				Version:         oc.Properties.ClusterProfile.Version,
 				ResourceGroupID: oc.Properties.ClusterProfile.ResourceGroupID,
+				SSHPublicKey:    oc.Properties.ClusterProfile.SSHPublicKey,
 			},
 			ConsoleProfile: ConsoleProfile{
 				URL: oc.Properties.ConsoleProfile.URL,
@@ -42,18 +45,28 @@ func (c *openShiftClusterConverter) ToExternal(oc *api.OpenShiftCluster) interfa
 			NetworkProfile: NetworkProfile{
 				PodCIDR:           oc.Properties.NetworkProfile.PodCIDR,
 				ServiceCIDR:       oc.Properties.NetworkProfile.ServiceCIDR,
+				MachineCIDR:       oc.Properties.NetworkProfile.MachineCIDR,
+				HostPrefix:        oc.Properties.NetworkProfile.HostPrefix,
 				PrivateEndpointIP: oc.Properties.NetworkProfile.PrivateEndpointIP,
 			},
 			MasterProfile: MasterProfile{
-				VMSize:   VMSize(oc.Properties.MasterProfile.VMSize),
-				SubnetID: oc.Properties.MasterProfile.SubnetID,
+				VMSize:       VMSize(oc.Properties.MasterProfile.VMSize),
+				SubnetID:     oc.Properties.MasterProfile.SubnetID,
+				SecurityType: SecurityType(oc.Properties.MasterProfile.SecurityType),
 			},
 			APIServerProfile: APIServerProfile{
-				Visibility: Visibility(oc.Properties.APIServerProfile.Visibility),
-				URL:        oc.Properties.APIServerProfile.URL,
-				IP:         oc.Properties.APIServerProfile.IP,
+				Visibility:        Visibility(oc.Properties.APIServerProfile.Visibility),
+				URL:               oc.Properties.APIServerProfile.URL,
+				IP:                oc.Properties.APIServerProfile.IP,
+				PublicIPAddressID: oc.Properties.APIServerProfile.PublicIPAddressID,
 			},
 			StorageSuffix: oc.Properties.StorageSuffix,
+			CredentialsProfile: CredentialsProfile{
+				KubeadminPassword:    credentialRecordToExternal(&oc.Properties.CredentialsProfile.KubeadminPassword),
+				AROServiceKubeconfig: credentialRecordToExternal(&oc.Properties.CredentialsProfile.AROServiceKubeconfig),
+				ServicePrincipal:     credentialRecordToExternal(&oc.Properties.CredentialsProfile.ServicePrincipal),
+				SSHKey:               credentialRecordToExternal(&oc.Properties.CredentialsProfile.SSHKey),
+			},
 		},
 	}
 
@@ -61,11 +74,14 @@ func (c *openShiftClusterConverter) ToExternal(oc *api.OpenShiftCluster) interfa
 		out.Properties.WorkerProfiles = make([]WorkerProfile, 0, len(oc.Properties.WorkerProfiles))
 		for _, p := range oc.Properties.WorkerProfiles {
 			out.Properties.WorkerProfiles = append(out.Properties.WorkerProfiles, WorkerProfile{
-				Name:       p.Name,
-				VMSize:     VMSize(p.VMSize),
-				DiskSizeGB: p.DiskSizeGB,
-				SubnetID:   p.SubnetID,
-				Count:      p.Count,
+				Name:         p.Name,
+				VMSize:       VMSize(p.VMSize),
+				DiskSizeGB:   p.DiskSizeGB,
+				SubnetID:     p.SubnetID,
+				Count:        p.Count,
+				SecurityType: SecurityType(p.SecurityType),
+				NodeLabels:   nodeLabelsCopy(p.NodeLabels),
+				NodeTaints:   nodeTaintsToExternal(p.NodeTaints),
 			})
 		}
 	}
@@ -74,9 +90,10 @@ func (c *openShiftClusterConverter) ToExternal(oc *api.OpenShiftCluster) interfa
 		out.Properties.IngressProfiles = make([]IngressProfile, 0, len(oc.Properties.IngressProfiles))
 		for _, p := range oc.Properties.IngressProfiles {
 			out.Properties.IngressProfiles = append(out.Properties.IngressProfiles, IngressProfile{
-				Name:       p.Name,
-				Visibility: Visibility(p.Visibility),
-				IP:         p.IP,
+				Name:              p.Name,
+				Visibility:        Visibility(p.Visibility),
+				IP:                p.IP,
+				PublicIPAddressID: p.PublicIPAddressID,
 			})
 		}
 	}
@@ -88,6 +105,15 @@ func (c *openShiftClusterConverter) ToExternal(oc *api.OpenShiftCluster) interfa
 		}
 	}
 
+	if oc.Properties.Progress != nil {
+		out.Properties.Progress = &ProvisioningProgress{
+			Phase:              oc.Properties.Progress.Phase,
+			Step:               oc.Properties.Progress.Step,
+			PercentComplete:    oc.Properties.Progress.PercentComplete,
+			LastTransitionTime: oc.Properties.Progress.LastTransitionTime,
+		}
+	}
+
 	if oc.Tags != nil {
 		out.Tags = make(map[string]string, len(oc.Tags))
 		for k, v := range oc.Tags {
@@ -103,6 +129,83 @@ func (c *openShiftClusterConverter) ToExternal(oc *api.OpenShiftCluster) interfa
 		}
 	}
 
+	if oc.Properties.NetworkProfile.LoadBalancerProfile != nil {
+		out.Properties.NetworkProfile.LoadBalancerProfile = &LoadBalancerProfile{}
+
+		if oc.Properties.NetworkProfile.LoadBalancerProfile.ManagedOutboundIPs != nil {
+			out.Properties.NetworkProfile.LoadBalancerProfile.ManagedOutboundIPs = &ManagedOutboundIPs{
+				Count: oc.Properties.NetworkProfile.LoadBalancerProfile.ManagedOutboundIPs.Count,
+			}
+		}
+	}
+
+	if oc.Properties.AutoscalerProfile != nil {
+		out.Properties.AutoscalerProfile = &AutoscalerProfile{
+			MaxNodesTotal:          oc.Properties.AutoscalerProfile.MaxNodesTotal,
+			ScaleDownDelayAfterAdd: oc.Properties.AutoscalerProfile.ScaleDownDelayAfterAdd,
+			ScaleDownUnneededTime:  oc.Properties.AutoscalerProfile.ScaleDownUnneededTime,
+		}
+
+		if oc.Properties.AutoscalerProfile.Pools != nil {
+			out.Properties.AutoscalerProfile.Pools = make([]AutoscalerPool, 0, len(oc.Properties.AutoscalerProfile.Pools))
+			for _, p := range oc.Properties.AutoscalerProfile.Pools {
+				out.Properties.AutoscalerProfile.Pools = append(out.Properties.AutoscalerProfile.Pools, AutoscalerPool{
+					Name:        p.Name,
+					MinReplicas: p.MinReplicas,
+					MaxReplicas: p.MaxReplicas,
+				})
+			}
+		}
+	}
+
+	if oc.Properties.MaintenanceProfile != nil {
+		out.Properties.MaintenanceProfile = &MaintenanceProfile{}
+
+		if oc.Properties.MaintenanceProfile.Windows != nil {
+			out.Properties.MaintenanceProfile.Windows = make([]MaintenanceWindow, 0, len(oc.Properties.MaintenanceProfile.Windows))
+			for _, w := range oc.Properties.MaintenanceProfile.Windows {
+				out.Properties.MaintenanceProfile.Windows = append(out.Properties.MaintenanceProfile.Windows, MaintenanceWindow{
+					Day:           w.Day,
+					StartHour:     w.StartHour,
+					DurationHours: w.DurationHours,
+				})
+			}
+		}
+
+		if oc.Properties.MaintenanceProfile.ExcludedDays != nil {
+			out.Properties.MaintenanceProfile.ExcludedDays = append([]string{}, oc.Properties.MaintenanceProfile.ExcludedDays...)
+		}
+	}
+
+	if oc.Properties.EncryptionAtRestProfile != nil {
+		out.Properties.EncryptionAtRestProfile = &EncryptionAtRestProfile{
+			KeyVaultKeyID:   oc.Properties.EncryptionAtRestProfile.KeyVaultKeyID,
+			KeyState:        EncryptionKeyState(oc.Properties.EncryptionAtRestProfile.KeyState),
+			KeyStateMessage: oc.Properties.EncryptionAtRestProfile.KeyStateMessage,
+		}
+	}
+
+	if oc.Properties.ConsoleNotifications != nil {
+		out.Properties.ConsoleNotifications = make([]ConsoleNotification, 0, len(oc.Properties.ConsoleNotifications))
+		for _, n := range oc.Properties.ConsoleNotifications {
+			out.Properties.ConsoleNotifications = append(out.Properties.ConsoleNotifications, ConsoleNotification{
+				Name:     n.Name,
+				Type:     ConsoleNotificationType(n.Type),
+				Location: ConsoleNotificationLocation(n.Location),
+				Text:     n.Text,
+				LinkText: n.LinkText,
+				LinkURL:  n.LinkURL,
+			})
+		}
+	}
+
+	if oc.Properties.OperatorFlags != nil {
+		out.Properties.OperatorFlags = make(map[string]string, len(oc.Properties.OperatorFlags))
+		for k, v := range oc.Properties.OperatorFlags {
+			out.Properties.OperatorFlags[k] = v
+		}
+	}
+
 	return out
 }
 
@@ -149,14 +252,27 @@ func (c *openShiftClusterConverter) ToInternal(_oc interface{}, out *api.OpenShi
 	out.Properties.ClusterProfile.Domain = oc.Properties.ClusterProfile.Domain
 	out.Properties.ClusterProfile.Version = oc.Properties.ClusterProfile.Version
 	out.Properties.ClusterProfile.ResourceGroupID = oc.Properties.ClusterProfile.ResourceGroupID
+	out.Properties.ClusterProfile.SSHPublicKey = oc.Properties.ClusterProfile.SSHPublicKey
 	out.Properties.ConsoleProfile.URL = oc.Properties.ConsoleProfile.URL
 	out.Properties.ServicePrincipalProfile.TenantID = oc.Properties.ServicePrincipalProfile.TenantID
 	out.Properties.ServicePrincipalProfile.ClientID = oc.Properties.ServicePrincipalProfile.ClientID
 	out.Properties.NetworkProfile.PodCIDR = oc.Properties.NetworkProfile.PodCIDR
 	out.Properties.NetworkProfile.ServiceCIDR = oc.Properties.NetworkProfile.ServiceCIDR
+	out.Properties.NetworkProfile.MachineCIDR = oc.Properties.NetworkProfile.MachineCIDR
+	out.Properties.NetworkProfile.HostPrefix = oc.Properties.NetworkProfile.HostPrefix
 	out.Properties.NetworkProfile.PrivateEndpointIP = oc.Properties.NetworkProfile.PrivateEndpointIP
+	out.Properties.NetworkProfile.LoadBalancerProfile = nil
+	if oc.Properties.NetworkProfile.LoadBalancerProfile != nil {
+		out.Properties.NetworkProfile.LoadBalancerProfile = &api.LoadBalancerProfile{}
+		if oc.Properties.NetworkProfile.LoadBalancerProfile.ManagedOutboundIPs != nil {
+			out.Properties.NetworkProfile.LoadBalancerProfile.ManagedOutboundIPs = &api.ManagedOutboundIPs{
+				Count: oc.Properties.NetworkProfile.LoadBalancerProfile.ManagedOutboundIPs.Count,
+			}
+		}
+	}
 	out.Properties.MasterProfile.VMSize = api.VMSize(oc.Properties.MasterProfile.VMSize)
 	out.Properties.MasterProfile.SubnetID = oc.Properties.MasterProfile.SubnetID
+	out.Properties.MasterProfile.SecurityType = api.SecurityType(oc.Properties.MasterProfile.SecurityType)
 	out.Properties.StorageSuffix = oc.Properties.StorageSuffix
 	out.Properties.WorkerProfiles = nil
 	if oc.Properties.WorkerProfiles != nil {
@@ -167,11 +283,15 @@ func (c *openShiftClusterConverter) ToInternal(_oc interface{}, out *api.OpenShi
 			out.Properties.WorkerProfiles[i].DiskSizeGB = oc.Properties.WorkerProfiles[i].DiskSizeGB
 			out.Properties.WorkerProfiles[i].SubnetID = oc.Properties.WorkerProfiles[i].SubnetID
 			out.Properties.WorkerProfiles[i].Count = oc.Properties.WorkerProfiles[i].Count
+			out.Properties.WorkerProfiles[i].SecurityType = api.SecurityType(oc.Properties.WorkerProfiles[i].SecurityType)
+			out.Properties.WorkerProfiles[i].NodeLabels = nodeLabelsCopy(oc.Properties.WorkerProfiles[i].NodeLabels)
+			out.Properties.WorkerProfiles[i].NodeTaints = nodeTaintsToInternal(oc.Properties.WorkerProfiles[i].NodeTaints)
 		}
 	}
 	out.Properties.APIServerProfile.Visibility = api.Visibility(oc.Properties.APIServerProfile.Visibility)
 	out.Properties.APIServerProfile.URL = oc.Properties.APIServerProfile.URL
 	out.Properties.APIServerProfile.IP = oc.Properties.APIServerProfile.IP
+	out.Properties.APIServerProfile.PublicIPAddressID = oc.Properties.APIServerProfile.PublicIPAddressID
 	out.Properties.IngressProfiles = nil
 	if oc.Properties.IngressProfiles != nil {
 		out.Properties.IngressProfiles = make([]api.IngressProfile, len(oc.Properties.IngressProfiles))
@@ -179,6 +299,7 @@ func (c *openShiftClusterConverter) ToInternal(_oc interface{}, out *api.OpenShi
 			out.Properties.IngressProfiles[i].Name = oc.Properties.IngressProfiles[i].Name
 			out.Properties.IngressProfiles[i].Visibility = api.Visibility(oc.Properties.IngressProfiles[i].Visibility)
 			out.Properties.IngressProfiles[i].IP = oc.Properties.IngressProfiles[i].IP
+			out.Properties.IngressProfiles[i].PublicIPAddressID = oc.Properties.IngressProfiles[i].PublicIPAddressID
 		}
 	}
 
@@ -189,9 +310,130 @@ func (c *openShiftClusterConverter) ToInternal(_oc interface{}, out *api.OpenShi
 			Phase: api.InstallPhase(oc.Properties.Install.Phase),
 		}
 	}
+	out.Properties.Progress = nil
+	if oc.Properties.Progress != nil {
+		out.Properties.Progress = &api.ProvisioningProgress{
+			Phase:              oc.Properties.Progress.Phase,
+			Step:               oc.Properties.Progress.Step,
+			PercentComplete:    oc.Properties.Progress.PercentComplete,
+			LastTransitionTime: oc.Properties.Progress.LastTransitionTime,
+		}
+	}
+
+	out.Properties.AutoscalerProfile = nil
+	if oc.Properties.AutoscalerProfile != nil {
+		out.Properties.AutoscalerProfile = &api.AutoscalerProfile{
+			MaxNodesTotal:          oc.Properties.AutoscalerProfile.MaxNodesTotal,
+			ScaleDownDelayAfterAdd: oc.Properties.AutoscalerProfile.ScaleDownDelayAfterAdd,
+			ScaleDownUnneededTime:  oc.Properties.AutoscalerProfile.ScaleDownUnneededTime,
+		}
+		if oc.Properties.AutoscalerProfile.Pools != nil {
+			out.Properties.AutoscalerProfile.Pools = make([]api.AutoscalerPool, len(oc.Properties.AutoscalerProfile.Pools))
+			for i := range oc.Properties.AutoscalerProfile.Pools {
+				out.Properties.AutoscalerProfile.Pools[i].Name = oc.Properties.AutoscalerProfile.Pools[i].Name
+				out.Properties.AutoscalerProfile.Pools[i].MinReplicas = oc.Properties.AutoscalerProfile.Pools[i].MinReplicas
+				out.Properties.AutoscalerProfile.Pools[i].MaxReplicas = oc.Properties.AutoscalerProfile.Pools[i].MaxReplicas
+			}
+		}
+	}
+	out.Properties.MaintenanceProfile = nil
+	if oc.Properties.MaintenanceProfile != nil {
+		out.Properties.MaintenanceProfile = &api.MaintenanceProfile{}
+		if oc.Properties.MaintenanceProfile.Windows != nil {
+			out.Properties.MaintenanceProfile.Windows = make([]api.MaintenanceWindow, len(oc.Properties.MaintenanceProfile.Windows))
+			for i := range oc.Properties.MaintenanceProfile.Windows {
+				out.Properties.MaintenanceProfile.Windows[i].Day = oc.Properties.MaintenanceProfile.Windows[i].Day
+				out.Properties.MaintenanceProfile.Windows[i].StartHour = oc.Properties.MaintenanceProfile.Windows[i].StartHour
+				out.Properties.MaintenanceProfile.Windows[i].DurationHours = oc.Properties.MaintenanceProfile.Windows[i].DurationHours
+			}
+		}
+		if oc.Properties.MaintenanceProfile.ExcludedDays != nil {
+			out.Properties.MaintenanceProfile.ExcludedDays = append([]string{}, oc.Properties.MaintenanceProfile.ExcludedDays...)
+		}
+	}
+
+	out.Properties.ConsoleNotifications = nil
+	if oc.Properties.ConsoleNotifications != nil {
+		out.Properties.ConsoleNotifications = make([]api.ConsoleNotification, len(oc.Properties.ConsoleNotifications))
+		for i := range oc.Properties.ConsoleNotifications {
+			out.Properties.ConsoleNotifications[i].Name = oc.Properties.ConsoleNotifications[i].Name
+			out.Properties.ConsoleNotifications[i].Type = api.ConsoleNotificationType(oc.Properties.ConsoleNotifications[i].Type)
+			out.Properties.ConsoleNotifications[i].Location = api.ConsoleNotificationLocation(oc.Properties.ConsoleNotifications[i].Location)
+			out.Properties.ConsoleNotifications[i].Text = oc.Properties.ConsoleNotifications[i].Text
+			out.Properties.ConsoleNotifications[i].LinkText = oc.Properties.ConsoleNotifications[i].LinkText
+			out.Properties.ConsoleNotifications[i].LinkURL = oc.Properties.ConsoleNotifications[i].LinkURL
+		}
+	}
+
+	// out.Properties.CredentialsProfile is not converted: it is maintained by
+	// the RP and is read-only in the admin API.
 
 	// out.Properties.RegistryProfiles is not converted. The field is immutable and does not have to be converted.
 	// Other fields are converted and this breaks the pattern, however this converting this field creates an issue
 	// with filling the out.Properties.RegistryProfiles[i].Password as default is "" which erases the original value.
 	// Workaround would be filling the password when receiving request, but it is array and the logic would be to complex.
 }
+
+// nodeLabelsCopy returns a copy of labels, so that the converted object does
+// not alias the map of the original
+func nodeLabelsCopy(labels map[string]string) map[string]string {
+	if labels == nil {
+		return nil
+	}
+
+	out := make(map[string]string, len(labels))
+	for k, v := range labels {
+		out[k] = v
+	}
+
+	return out
+}
+
+func credentialRecordToExternal(r *api.CredentialRecord) CredentialRecord {
+	out := CredentialRecord{
+		IssuedAt: r.IssuedAt,
+	}
+
+	if r.ExpiresAt != nil {
+		expiresAt := *r.ExpiresAt
+		out.ExpiresAt = &expiresAt
+	}
+
+	if r.RotatedAt != nil {
+		out.RotatedAt = append([]time.Time{}, r.RotatedAt...)
+	}
+
+	return out
+}
+
+func nodeTaintsToExternal(taints []api.NodeTaint) []NodeTaint {
+	if taints == nil {
+		return nil
+	}
+
+	out := make([]NodeTaint, 0, len(taints))
+	for _, t := range taints {
+		out = append(out, NodeTaint{
+			Key:    t.Key,
+			Value:  t.Value,
+			Effect: TaintEffect(t.Effect),
+		})
+	}
+
+	return out
+}
+
+func nodeTaintsToInternal(taints []NodeTaint) []api.NodeTaint {
+	if taints == nil {
+		return nil
+	}
+
+	out := make([]api.NodeTaint, len(taints))
+	for i := range taints {
+		out[i].Key = taints[i].Key
+		out[i].Value = taints[i].Value
+		out[i].Effect = api.TaintEffect(taints[i].Effect)
+	}
+
+	return out
+}
@@ -5,11 +5,15 @@ package admin
 
 import (
 	"net/http"
+	"net/url"
+	"regexp"
 
 	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/util/immutable"
 )
 
+var rxConsoleNotificationName = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?$`)
+
 type openShiftClusterStaticValidator struct{}
 
 // Validate validates an OpenShift cluster
@@ -19,7 +23,12 @@ func (sv *openShiftClusterStaticValidator) Static(_oc interface{}, _current *api
 	}
 
 	oc := _oc.(*OpenShiftCluster)
-	return sv.validateDelta(oc, (&openShiftClusterConverter{}).ToExternal(_current).(*OpenShiftCluster))
+	err := sv.validateDelta(oc, (&openShiftClusterConverter{}).ToExternal(_current).(*OpenShiftCluster))
+	if err != nil {
+		return err
+	}
+
+	return sv.validateConsoleNotifications("properties.consoleNotifications", oc.Properties.ConsoleNotifications)
 }
 
 func (sv *openShiftClusterStaticValidator) validateDelta(oc, current *OpenShiftCluster) error {
@@ -31,3 +40,49 @@ func (sv *openShiftClusterStaticValidator) validateDelta(oc, current *OpenShiftC
 
 	return nil
 }
+
+func (sv *openShiftClusterStaticValidator) validateConsoleNotifications(path string, ns []ConsoleNotification) error {
+	names := map[string]struct{}{}
+
+	for _, n := range ns {
+		path := path + "['" + n.Name + "']"
+
+		if !rxConsoleNotificationName.MatchString(n.Name) {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".name", "The provided console notification name '%s' is invalid.", n.Name)
+		}
+		if _, found := names[n.Name]; found {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".name", "The provided console notification name '%s' is duplicated.", n.Name)
+		}
+		names[n.Name] = struct{}{}
+
+		switch n.Type {
+		case ConsoleNotificationTypeInformation, ConsoleNotificationTypeMaintenance, ConsoleNotificationTypeDeprecation:
+		default:
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".type", "The provided console notification type '%s' is invalid.", n.Type)
+		}
+
+		switch n.Location {
+		case "", ConsoleNotificationLocationBannerTop, ConsoleNotificationLocationBannerBottom, ConsoleNotificationLocationBannerTopBottom:
+		default:
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".location", "The provided console notification location '%s' is invalid.", n.Location)
+		}
+
+		if n.Text == "" {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".text", "The provided console notification text is invalid.")
+		}
+
+		if n.LinkURL != "" {
+			u, err := url.Parse(n.LinkURL)
+			if err != nil || u.Scheme != "https" || u.Host == "" {
+				return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".linkUrl", "The provided console notification link URL '%s' is invalid.", n.LinkURL)
+			}
+			if n.LinkText == "" {
+				return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".linkText", "The provided console notification link text is invalid.")
+			}
+		} else if n.LinkText != "" {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".linkUrl", "The provided console notification link URL '' is invalid.")
+		}
+	}
+
+	return nil
+}
@@ -508,6 +508,110 @@ func TestOpenShiftClusterStaticValidateDelta(t *testing.T) {
 			},
 			wantErr: "400: PropertyChangeNotAllowed: properties.provisionedBy: Changing property 'properties.provisionedBy' is not allowed.",
 		},
+		{
+			name: "consoleNotifications change is allowed",
+			oc: func() *OpenShiftCluster {
+				return &OpenShiftCluster{}
+			},
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.ConsoleNotifications = []ConsoleNotification{
+					{
+						Name:     "maintenance",
+						Type:     ConsoleNotificationTypeMaintenance,
+						Location: ConsoleNotificationLocationBannerTop,
+						Text:     "Planned maintenance",
+						LinkText: "Details",
+						LinkURL:  "https://example.com/",
+					},
+				}
+			},
+		},
+		{
+			name: "consoleNotifications invalid name",
+			oc: func() *OpenShiftCluster {
+				return &OpenShiftCluster{}
+			},
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.ConsoleNotifications = []ConsoleNotification{
+					{
+						Name: "Invalid",
+						Type: ConsoleNotificationTypeMaintenance,
+						Text: "Planned maintenance",
+					},
+				}
+			},
+			wantErr: "400: InvalidParameter: properties.consoleNotifications['Invalid'].name: The provided console notification name 'Invalid' is invalid.",
+		},
+		{
+			name: "consoleNotifications duplicate name",
+			oc: func() *OpenShiftCluster {
+				return &OpenShiftCluster{}
+			},
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.ConsoleNotifications = []ConsoleNotification{
+					{
+						Name: "maintenance",
+						Type: ConsoleNotificationTypeMaintenance,
+						Text: "Planned maintenance",
+					},
+					{
+						Name: "maintenance",
+						Type: ConsoleNotificationTypeMaintenance,
+						Text: "Planned maintenance",
+					},
+				}
+			},
+			wantErr: "400: InvalidParameter: properties.consoleNotifications['maintenance'].name: The provided console notification name 'maintenance' is duplicated.",
+		},
+		{
+			name: "consoleNotifications invalid type",
+			oc: func() *OpenShiftCluster {
+				return &OpenShiftCluster{}
+			},
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.ConsoleNotifications = []ConsoleNotification{
+					{
+						Name: "maintenance",
+						Type: "Invalid",
+						Text: "Planned maintenance",
+					},
+				}
+			},
+			wantErr: "400: InvalidParameter: properties.consoleNotifications['maintenance'].type: The provided console notification type 'Invalid' is invalid.",
+		},
+		{
+			name: "consoleNotifications missing text",
+			oc: func() *OpenShiftCluster {
+				return &OpenShiftCluster{}
+			},
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.ConsoleNotifications = []ConsoleNotification{
+					{
+						Name: "maintenance",
+						Type: ConsoleNotificationTypeMaintenance,
+					},
+				}
+			},
+			wantErr: "400: InvalidParameter: properties.consoleNotifications['maintenance'].text: The provided console notification text is invalid.",
+		},
+		{
+			name: "consoleNotifications invalid link",
+			oc: func() *OpenShiftCluster {
+				return &OpenShiftCluster{}
+			},
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.ConsoleNotifications = []ConsoleNotification{
+					{
+						Name:     "maintenance",
+						Type:     ConsoleNotificationTypeMaintenance,
+						Text:     "Planned maintenance",
+						LinkText: "Details",
+						LinkURL:  "http://example.com/",
+					},
+				}
+			},
+			wantErr: "400: InvalidParameter: properties.consoleNotifications['maintenance'].linkUrl: The provided console notification link URL 'http://example.com/' is invalid.",
+		},
 	}
 
 	for _, tt := range tests {
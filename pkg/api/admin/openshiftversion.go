@@ -0,0 +1,34 @@
+package admin
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+// OpenShiftVersionList represents a list of OpenShift versions.
+type OpenShiftVersionList struct {
+	// The list of OpenShift versions.
+	OpenShiftVersions []*OpenShiftVersion `json:"value"`
+}
+
+// OpenShiftVersion represents an OpenShift version which the RP can install.
+type OpenShiftVersion struct {
+	// Version is the x.y.z version of the release.
+	Version string `json:"version,omitempty"`
+
+	// OpenShiftPullspec is the release payload, referenced by digest.
+	OpenShiftPullspec string `json:"openShiftPullspec,omitempty"`
+
+	// BootImage, if set, overrides the RHCOS image which the nodes boot from.
+	BootImage *BootImage `json:"bootImage,omitempty"`
+
+	// Enabled is false for a deprecated version, which can no longer be
+	// installed.
+	Enabled bool `json:"enabled"`
+}
+
+// BootImage represents an Azure Marketplace image of RHCOS.
+type BootImage struct {
+	Publisher string `json:"publisher,omitempty"`
+	Offer     string `json:"offer,omitempty"`
+	SKU       string `json:"sku,omitempty"`
+	Version   string `json:"version,omitempty"`
+}
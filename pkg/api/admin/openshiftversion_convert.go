@@ -0,0 +1,69 @@
+package admin
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+type openShiftVersionConverter struct{}
+
+// ToExternal returns a new external representation of the internal object,
+// reading from the subset of the internal object's fields that appear in the
+// external representation.  ToExternal does not modify its argument; there is
+// no pointer aliasing between the passed and returned objects
+func (c *openShiftVersionConverter) ToExternal(v *api.OpenShiftVersion) interface{} {
+	out := &OpenShiftVersion{
+		Version:           v.Version,
+		OpenShiftPullspec: v.OpenShiftPullspec,
+		Enabled:           v.Enabled,
+	}
+
+	if v.BootImage != nil {
+		out.BootImage = &BootImage{
+			Publisher: v.BootImage.Publisher,
+			Offer:     v.BootImage.Offer,
+			SKU:       v.BootImage.SKU,
+			Version:   v.BootImage.Version,
+		}
+	}
+
+	return out
+}
+
+// ToExternalList returns a slice of external representations of the internal
+// objects
+func (c *openShiftVersionConverter) ToExternalList(vs []*api.OpenShiftVersion) interface{} {
+	l := &OpenShiftVersionList{
+		OpenShiftVersions: make([]*OpenShiftVersion, 0, len(vs)),
+	}
+
+	for _, v := range vs {
+		l.OpenShiftVersions = append(l.OpenShiftVersions, c.ToExternal(v).(*OpenShiftVersion))
+	}
+
+	return l
+}
+
+// ToInternal overwrites in place a pre-existing internal object, setting (only)
+// all mapped fields from the external representation. ToInternal modifies its
+// argument; there is no pointer aliasing between the passed and returned
+// objects
+func (c *openShiftVersionConverter) ToInternal(_v interface{}, out *api.OpenShiftVersion) {
+	v := _v.(*OpenShiftVersion)
+
+	out.Version = v.Version
+	out.OpenShiftPullspec = v.OpenShiftPullspec
+	out.Enabled = v.Enabled
+
+	out.BootImage = nil
+	if v.BootImage != nil {
+		out.BootImage = &api.BootImage{
+			Publisher: v.BootImage.Publisher,
+			Offer:     v.BootImage.Offer,
+			SKU:       v.BootImage.SKU,
+			Version:   v.BootImage.Version,
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package admin
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"net/http"
+	"regexp"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/version"
+)
+
+var rxDigestPullspec = regexp.MustCompile(`^[^@\s]+@sha256:[0-9a-f]{64}$`)
+
+type openShiftVersionStaticValidator struct{}
+
+// Static validates an OpenShift version.  Versions are keyed by version, so
+// there is no delta to validate against the current object.
+func (sv *openShiftVersionStaticValidator) Static(_v interface{}, _ *api.OpenShiftVersion) error {
+	v := _v.(*OpenShiftVersion)
+
+	vsn, err := version.ParseVersion(v.Version)
+	if err != nil || vsn.Suffix != "" {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "version", "The provided version '%s' is invalid.", v.Version)
+	}
+
+	if !rxDigestPullspec.MatchString(v.OpenShiftPullspec) {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "openShiftPullspec", "The provided pullspec '%s' is invalid: must be referenced by digest.", v.OpenShiftPullspec)
+	}
+
+	if v.BootImage != nil &&
+		(v.BootImage.Publisher == "" || v.BootImage.Offer == "" || v.BootImage.SKU == "" || v.BootImage.Version == "") {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "bootImage", "The provided boot image is invalid: publisher, offer, sku and version must all be set.")
+	}
+
+	return nil
+}
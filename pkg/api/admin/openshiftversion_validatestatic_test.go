@@ -0,0 +1,98 @@
+package admin
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/test/validate"
+)
+
+func TestOpenShiftVersionStaticValidate(t *testing.T) {
+	validVersion := func() *OpenShiftVersion {
+		return &OpenShiftVersion{
+			Version:           "4.5.16",
+			OpenShiftPullspec: "quay.io/openshift-release-dev/ocp-release@sha256:0000000000000000000000000000000000000000000000000000000000000000",
+			BootImage: &BootImage{
+				Publisher: "azureopenshift",
+				Offer:     "aro4",
+				SKU:       "aro_45",
+				Version:   "45.82.20200722",
+			},
+			Enabled: true,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(v *OpenShiftVersion)
+		wantErr string
+	}{
+		{
+			name: "valid",
+		},
+		{
+			name:   "valid without boot image",
+			modify: func(v *OpenShiftVersion) { v.BootImage = nil },
+		},
+		{
+			name:    "version invalid",
+			modify:  func(v *OpenShiftVersion) { v.Version = "4.5" },
+			wantErr: "400: InvalidParameter: version: The provided version '4.5' is invalid.",
+		},
+		{
+			name:    "prerelease version",
+			modify:  func(v *OpenShiftVersion) { v.Version = "4.6.0-fc.8" },
+			wantErr: "400: InvalidParameter: version: The provided version '4.6.0-fc.8' is invalid.",
+		},
+		{
+			name: "pullspec by tag",
+			modify: func(v *OpenShiftVersion) {
+				v.OpenShiftPullspec = "quay.io/openshift-release-dev/ocp-release:4.5.16-x86_64"
+			},
+			wantErr: "400: InvalidParameter: openShiftPullspec: The provided pullspec 'quay.io/openshift-release-dev/ocp-release:4.5.16-x86_64' is invalid: must be referenced by digest.",
+		},
+		{
+			name:    "boot image incomplete",
+			modify:  func(v *OpenShiftVersion) { v.BootImage.Version = "" },
+			wantErr: "400: InvalidParameter: bootImage: The provided boot image is invalid: publisher, offer, sku and version must all be set.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &openShiftVersionStaticValidator{}
+
+			ov := validVersion()
+			if tt.modify != nil {
+				tt.modify(ov)
+			}
+
+			err := v.Static(ov, nil)
+			if err == nil {
+				if tt.wantErr != "" {
+					t.Error(err)
+				}
+
+			} else {
+				if err.Error() != tt.wantErr {
+					t.Error(err)
+				}
+
+				cloudErr := err.(*api.CloudError)
+
+				if cloudErr.StatusCode != http.StatusBadRequest {
+					t.Error(cloudErr.StatusCode)
+				}
+				if cloudErr.Target == "" {
+					t.Error("target is required")
+				}
+
+				validate.CloudError(t, err)
+			}
+		})
+	}
+}
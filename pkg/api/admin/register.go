@@ -16,8 +16,14 @@ func init() {
 		OpenShiftClusterConverter: func() api.OpenShiftClusterConverter {
 			return &openShiftClusterConverter{}
 		},
-		OpenShiftClusterStaticValidator: func(string, string, deployment.Mode, string) api.OpenShiftClusterStaticValidator {
+		OpenShiftClusterStaticValidator: func(string, string, deployment.Capabilities, string) api.OpenShiftClusterStaticValidator {
 			return &openShiftClusterStaticValidator{}
 		},
+		OpenShiftVersionConverter: func() api.OpenShiftVersionConverter {
+			return &openShiftVersionConverter{}
+		},
+		OpenShiftVersionStaticValidator: func() api.OpenShiftVersionStaticValidator {
+			return &openShiftVersionStaticValidator{}
+		},
 	}
 }
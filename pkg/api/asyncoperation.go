@@ -21,4 +21,34 @@ type AsyncOperation struct {
 	EndTime   *time.Time `json:"endTime,omitempty" deep:"-"`
 
 	Error *CloudErrorBody `json:"error,omitempty"`
+
+	// ARMCalls is internal and is not returned to the customer
+	ARMCalls *ARMCallStatistics `json:"armCalls,omitempty"`
+
+	// APIVersion is the API version of the request which started the
+	// asyncOperation, and FailedStep the install or update step which failed,
+	// if any.  They are internal and are not returned to the customer.
+	APIVersion string `json:"apiVersion,omitempty"`
+	FailedStep string `json:"failedStep,omitempty"`
+}
+
+// ARMCallStatistics counts the ARM calls made by the RP on behalf of an
+// asyncOperation, in total and per install or update step.  Calls which are
+// retried count once per attempt.
+type ARMCallStatistics struct {
+	MissingFields
+
+	Calls     int `json:"calls,omitempty"`
+	Throttled int `json:"throttled,omitempty"`
+
+	Steps []ARMCallStepStatistics `json:"steps,omitempty"`
+}
+
+// ARMCallStepStatistics counts the ARM calls made by a single step
+type ARMCallStepStatistics struct {
+	MissingFields
+
+	Step      string `json:"step,omitempty"`
+	Calls     int    `json:"calls,omitempty"`
+	Throttled int    `json:"throttled,omitempty"`
 }
@@ -33,6 +33,14 @@ type AsyncOperationDocument struct {
 
 	OpenShiftClusterKey string            `json:"openShiftClusterKey,omitempty"`
 	OpenShiftCluster    *OpenShiftCluster `json:"openShiftCluster,omitempty"`
+
+	// TTL is set by the backend when the asyncOperation completes, so that
+	// completed documents expire sooner than the collection default.
+	TTL int `json:"ttl,omitempty"`
+
+	// Archived is true once the asyncOperation has been copied to the
+	// asyncOperations archive.
+	Archived bool `json:"archived,omitempty"`
 }
 
 func (c *AsyncOperationDocument) String() string {
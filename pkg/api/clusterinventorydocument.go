@@ -0,0 +1,101 @@
+package api
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"time"
+)
+
+// ClusterInventoryDocuments represents cluster inventory documents.
+// pkg/database/cosmosdb requires its definition.
+type ClusterInventoryDocuments struct {
+	Count                     int                         `json:"_count,omitempty"`
+	ResourceID                string                      `json:"_rid,omitempty"`
+	ClusterInventoryDocuments []*ClusterInventoryDocument `json:"Documents,omitempty"`
+}
+
+func (c *ClusterInventoryDocuments) String() string {
+	return encodeJSON(c)
+}
+
+// ClusterInventoryDocument holds the most recent inventory which the ARO
+// operator of a cluster reported.  Its ID is the ID of the cluster document.
+// pkg/database/cosmosdb requires its definition.
+type ClusterInventoryDocument struct {
+	MissingFields
+
+	ID          string                 `json:"id,omitempty"`
+	ResourceID  string                 `json:"_rid,omitempty"`
+	Timestamp   int                    `json:"_ts,omitempty"`
+	Self        string                 `json:"_self,omitempty"`
+	ETag        string                 `json:"_etag,omitempty" deep:"-"`
+	Attachments string                 `json:"_attachments,omitempty"`
+	LSN         int                    `json:"_lsn,omitempty"`
+	Metadata    map[string]interface{} `json:"_metadata,omitempty"`
+
+	// Key is the key of the cluster document
+	Key string `json:"key,omitempty"`
+
+	ClusterInventory *ClusterInventory `json:"clusterInventory,omitempty"`
+}
+
+func (c *ClusterInventoryDocument) String() string {
+	return encodeJSON(c)
+}
+
+// ClusterInventory is a compact summary of the state of a cluster, which the
+// ARO operator periodically reports to the RP
+type ClusterInventory struct {
+	MissingFields
+
+	// ReportedAt is set by the operator, ReceivedAt by the RP
+	ReportedAt time.Time `json:"reportedAt,omitempty"`
+	ReceivedAt time.Time `json:"receivedAt,omitempty"`
+
+	OperatorVersion string `json:"operatorVersion,omitempty"`
+	ClusterVersion  string `json:"clusterVersion,omitempty"`
+
+	Nodes    []InventoryNodeSummary    `json:"nodes,omitempty"`
+	Machines []InventoryMachineSummary `json:"machines,omitempty"`
+
+	Conditions       []InventoryCondition       `json:"conditions,omitempty"`
+	ClusterOperators []InventoryClusterOperator `json:"clusterOperators,omitempty"`
+	OperatorFlags    map[string]string          `json:"operatorFlags,omitempty"`
+}
+
+// InventoryNodeSummary counts the nodes of a role
+type InventoryNodeSummary struct {
+	MissingFields
+
+	Role  string `json:"role,omitempty"`
+	Count int    `json:"count,omitempty"`
+	Ready int    `json:"ready,omitempty"`
+}
+
+// InventoryMachineSummary counts the machines in a phase
+type InventoryMachineSummary struct {
+	MissingFields
+
+	Phase string `json:"phase,omitempty"`
+	Count int    `json:"count,omitempty"`
+}
+
+// InventoryCondition is a condition of the ARO operator's Cluster resource
+type InventoryCondition struct {
+	MissingFields
+
+	Type   string `json:"type,omitempty"`
+	Status string `json:"status,omitempty"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// InventoryClusterOperator is the status of one of the cluster's operators
+type InventoryClusterOperator struct {
+	MissingFields
+
+	Name      string `json:"name,omitempty"`
+	Version   string `json:"version,omitempty"`
+	Available bool   `json:"available,omitempty"`
+	Degraded  bool   `json:"degraded,omitempty"`
+}
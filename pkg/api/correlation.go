@@ -23,6 +23,13 @@ type CorrelationData struct {
 	// ClientPrincipalName contains value of x-ms-client-principal-name
 	ClientPrincipalName string `json:"clientPrincipalName,omitempty"`
 
+	// ClientAppID contains value of x-ms-client-app-id, the AAD application ID
+	// of the caller, which ARM sets on the requests it proxies
+	ClientAppID string `json:"clientAppId,omitempty"`
+
+	// UserAgent contains value of User-Agent
+	UserAgent string `json:"userAgent,omitempty"`
+
 	// RequestTime is the time that the request was received
 	RequestTime time.Time `json:"requestTime,omitempty"`
 }
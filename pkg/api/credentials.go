@@ -0,0 +1,35 @@
+package api
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"time"
+)
+
+// maxCredentialRotations bounds the rotation history kept in the cluster
+// document
+const maxCredentialRotations = 10
+
+// Issue records that the credential was issued at t, expiring at expiresAt if
+// non-nil.  Issue does not record a rotation, e.g. when a failed install is
+// retried.
+func (r *CredentialRecord) Issue(t time.Time, expiresAt *time.Time) {
+	r.IssuedAt = t.UTC()
+	r.ExpiresAt = nil
+	if expiresAt != nil {
+		e := expiresAt.UTC()
+		r.ExpiresAt = &e
+	}
+}
+
+// Rotate records that the credential was replaced by one issued at t,
+// expiring at expiresAt if non-nil
+func (r *CredentialRecord) Rotate(t time.Time, expiresAt *time.Time) {
+	r.RotatedAt = append(r.RotatedAt, t.UTC())
+	if len(r.RotatedAt) > maxCredentialRotations {
+		r.RotatedAt = r.RotatedAt[len(r.RotatedAt)-maxCredentialRotations:]
+	}
+
+	r.Issue(t, expiresAt)
+}
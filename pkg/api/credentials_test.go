@@ -0,0 +1,40 @@
+package api
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCredentialRecord(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := func(i int) time.Time { return start.AddDate(0, 0, i) }
+
+	var r CredentialRecord
+
+	expiry := day(365)
+	r.Issue(day(0), &expiry)
+	if !r.IssuedAt.Equal(day(0)) || !r.ExpiresAt.Equal(day(365)) || r.RotatedAt != nil {
+		t.Fatal(r)
+	}
+
+	// a credential which doesn't expire clears the old expiry
+	r.Rotate(day(1), nil)
+	if !r.IssuedAt.Equal(day(1)) || r.ExpiresAt != nil || !reflect.DeepEqual(r.RotatedAt, []time.Time{day(1)}) {
+		t.Fatal(r)
+	}
+
+	for i := 2; i < 2+maxCredentialRotations; i++ {
+		r.Rotate(day(i), nil)
+	}
+
+	if len(r.RotatedAt) != maxCredentialRotations {
+		t.Fatal(len(r.RotatedAt))
+	}
+	if !r.RotatedAt[0].Equal(day(2)) || !r.RotatedAt[maxCredentialRotations-1].Equal(day(maxCredentialRotations+1)) {
+		t.Error(r.RotatedAt)
+	}
+}
@@ -0,0 +1,55 @@
+package api
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+// Detector represents a read-only diagnostic check run against an OpenShift
+// cluster.
+type Detector struct {
+	// The resource ID.
+	ID string `json:"id,omitempty"`
+
+	// The resource name.
+	Name string `json:"name,omitempty"`
+
+	// The resource type.
+	Type string `json:"type,omitempty"`
+
+	// The detector properties.
+	Properties DetectorProperties `json:"properties,omitempty"`
+}
+
+// DetectorProperties represents the properties of a detector.
+type DetectorProperties struct {
+	// Friendly name of the detector.
+	Title string `json:"title,omitempty"`
+
+	// Description of what the detector checks.
+	Description string `json:"description,omitempty"`
+
+	// The outcome of running the detector.  Empty if the detector was listed
+	// but not run.
+	Status DetectorStatus `json:"status,omitempty"`
+
+	// Issues found by the detector.
+	Findings []DetectorFinding `json:"findings,omitempty"`
+}
+
+// DetectorStatus represents the outcome of running a detector.
+type DetectorStatus string
+
+// DetectorStatus constants.
+const (
+	DetectorStatusHealthy   DetectorStatus = "Healthy"
+	DetectorStatusUnhealthy DetectorStatus = "Unhealthy"
+	DetectorStatusUnknown   DetectorStatus = "Unknown"
+)
+
+// DetectorFinding represents an issue found by a detector.
+type DetectorFinding struct {
+	// Description of the issue.
+	Message string `json:"message,omitempty"`
+
+	// Steps the user can take to resolve the issue.
+	Remediation string `json:"remediation,omitempty"`
+}
@@ -0,0 +1,49 @@
+package api
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"time"
+)
+
+// DRMetadataFormatVersion is incremented whenever OpenShiftClusterDRMetadata
+// changes incompatibly.  Imports of other versions are refused.
+const DRMetadataFormatVersion = 1
+
+// OpenShiftClusterDRMetadata holds what is needed to recover a cluster's
+// document into another region's RP database if its own region is lost.  It
+// never contains secrets: SecretReferences lists the secrets the cluster had,
+// which must be restored out of band after an import.
+type OpenShiftClusterDRMetadata struct {
+	MissingFields
+
+	FormatVersion  int       `json:"formatVersion,omitempty"`
+	ExportedAt     time.Time `json:"exportedAt,omitempty"`
+	SourceLocation string    `json:"sourceLocation,omitempty"`
+
+	OpenShiftCluster *OpenShiftCluster `json:"openShiftCluster,omitempty"`
+
+	DNS              DRMetadataDNS `json:"dns,omitempty"`
+	SecretReferences []string      `json:"secretReferences,omitempty"`
+}
+
+// DRMetadataDNS holds the DNS records of a cluster
+type DRMetadataDNS struct {
+	MissingFields
+
+	Domain      string `json:"domain,omitempty"`
+	APIServerIP string `json:"apiserverIp,omitempty"`
+	IngressIP   string `json:"ingressIp,omitempty"`
+}
+
+// DRImport records where a cluster document imported from DR metadata came
+// from and who imported it
+type DRImport struct {
+	MissingFields
+
+	SourceLocation string    `json:"sourceLocation,omitempty"`
+	ExportedAt     time.Time `json:"exportedAt,omitempty"`
+	ImportedAt     time.Time `json:"importedAt,omitempty"`
+	ImportedBy     string    `json:"importedBy,omitempty"`
+}
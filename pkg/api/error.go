@@ -41,6 +41,20 @@ type CloudErrorBody struct {
 
 	//A list of additional details about the error.
 	Details []CloudErrorBody `json:"details,omitempty"`
+
+	// Additional information about the error, passed through to the client
+	// unchanged by ARM.
+	AdditionalInfo []CloudErrorAdditionalInfo `json:"additionalInfo,omitempty"`
+}
+
+// CloudErrorAdditionalInfo represents a typed item of additional information
+// about a cloud error.
+type CloudErrorAdditionalInfo struct {
+	// The type of the additional information.
+	Type string `json:"type,omitempty"`
+
+	// The additional information.
+	Info interface{} `json:"info,omitempty"`
 }
 
 func (b *CloudErrorBody) String() string {
@@ -67,6 +81,7 @@ var (
 	CloudErrorCodeInvalidRequestContent              = "InvalidRequestContent"
 	CloudErrorCodeInvalidResource                    = "InvalidResource"
 	CloudErrorCodeDuplicateResourceGroup             = "DuplicateResourceGroup"
+	CloudErrorCodeClusterResourceGroupAlreadyExists  = "ClusterResourceGroupAlreadyExists"
 	CloudErrorCodeInvalidResourceNamespace           = "InvalidResourceNamespace"
 	CloudErrorCodeInvalidResourceType                = "InvalidResourceType"
 	CloudErrorCodeInvalidSubscriptionID              = "InvalidSubscriptionID"
@@ -80,6 +95,7 @@ var (
 	CloudErrorCodeUnsupportedMediaType               = "UnsupportedMediaType"
 	CloudErrorCodeInvalidLinkedVNet                  = "InvalidLinkedVNet"
 	CloudErrorCodeInvalidLinkedRouteTable            = "InvalidLinkedRouteTable"
+	CloudErrorCodeInvalidLinkedPublicIPAddress       = "InvalidLinkedPublicIPAddress"
 	CloudErrorCodeNotFound                           = "NotFound"
 	CloudErrorCodeForbidden                          = "Forbidden"
 	CloudErrorCodeInvalidSubscriptionState           = "InvalidSubscriptionState"
@@ -94,6 +110,10 @@ var (
 	CloudErrorCodeResourceQuotaExceeded              = "ResourceQuotaExceeded"
 	CloudErrorCodeQuotaExceeded                      = "QuotaExceeded"
 	CloudErrorResourceProviderNotRegistered          = "ResourceProviderNotRegistered"
+	CloudErrorCodeTooManyRequests                    = "TooManyRequests"
+	CloudErrorCodeRequestDisallowedByPolicy          = "RequestDisallowedByPolicy"
+	CloudErrorCodeServiceUnavailable                 = "ServiceUnavailable"
+	CloudErrorCodeEncryptionKeyInaccessible          = "EncryptionKeyInaccessible"
 )
 
 // NewCloudError returns a new CloudError
@@ -0,0 +1,63 @@
+package api
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"strings"
+	"time"
+)
+
+// ParseWeekday returns the day of the week named by s, e.g. "Monday", case
+// insensitively
+func ParseWeekday(s string) (time.Weekday, bool) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(s, d.String()) {
+			return d, true
+		}
+	}
+
+	return 0, false
+}
+
+// Allows returns true if the cluster may be maintained at t: t must not fall
+// on an excluded day and, if there are any windows, must fall within one of
+// them.  A nil MaintenanceProfile allows maintenance at any time.
+func (p *MaintenanceProfile) Allows(t time.Time) bool {
+	if p == nil {
+		return true
+	}
+
+	t = t.UTC()
+
+	for _, s := range p.ExcludedDays {
+		if d, ok := ParseWeekday(s); ok && d == t.Weekday() {
+			return false
+		}
+	}
+
+	if len(p.Windows) == 0 {
+		return true
+	}
+
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	for _, w := range p.Windows {
+		d, ok := ParseWeekday(w.Day)
+		if !ok {
+			continue
+		}
+
+		// the most recent start of the window at or before t
+		start := midnight.AddDate(0, 0, -((int(t.Weekday()) - int(d) + 7) % 7)).Add(time.Duration(w.StartHour) * time.Hour)
+		if start.After(t) {
+			start = start.AddDate(0, 0, -7)
+		}
+
+		if t.Before(start.Add(time.Duration(w.DurationHours) * time.Hour)) {
+			return true
+		}
+	}
+
+	return false
+}
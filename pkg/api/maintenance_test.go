@@ -0,0 +1,120 @@
+package api
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMaintenanceProfileAllows(t *testing.T) {
+	// Wednesday
+	now := time.Date(2020, 11, 4, 10, 30, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		name string
+		p    *MaintenanceProfile
+		t    time.Time
+		want bool
+	}{
+		{
+			name: "no profile",
+			t:    now,
+			want: true,
+		},
+		{
+			name: "empty profile",
+			p:    &MaintenanceProfile{},
+			t:    now,
+			want: true,
+		},
+		{
+			name: "excluded day",
+			p: &MaintenanceProfile{
+				ExcludedDays: []string{"Saturday", "wednesday"},
+			},
+			t: now,
+		},
+		{
+			name: "day not excluded",
+			p: &MaintenanceProfile{
+				ExcludedDays: []string{"Saturday"},
+			},
+			t:    now,
+			want: true,
+		},
+		{
+			name: "within window",
+			p: &MaintenanceProfile{
+				Windows: []MaintenanceWindow{{Day: "Wednesday", StartHour: 10, DurationHours: 4}},
+			},
+			t:    now,
+			want: true,
+		},
+		{
+			name: "before window",
+			p: &MaintenanceProfile{
+				Windows: []MaintenanceWindow{{Day: "Wednesday", StartHour: 11, DurationHours: 4}},
+			},
+			t: now,
+		},
+		{
+			name: "after window",
+			p: &MaintenanceProfile{
+				Windows: []MaintenanceWindow{{Day: "Wednesday", StartHour: 6, DurationHours: 4}},
+			},
+			t: now,
+		},
+		{
+			name: "window from previous day",
+			p: &MaintenanceProfile{
+				Windows: []MaintenanceWindow{{Day: "Tuesday", StartHour: 22, DurationHours: 24}},
+			},
+			t:    now,
+			want: true,
+		},
+		{
+			name: "window across the end of the week",
+			p: &MaintenanceProfile{
+				Windows: []MaintenanceWindow{{Day: "Saturday", StartHour: 20, DurationHours: 8}},
+			},
+			t:    time.Date(2020, 11, 8, 3, 0, 0, 0, time.UTC), // Sunday
+			want: true,
+		},
+		{
+			name: "one of several windows",
+			p: &MaintenanceProfile{
+				Windows: []MaintenanceWindow{
+					{Day: "Monday", StartHour: 0, DurationHours: 4},
+					{Day: "Wednesday", StartHour: 8, DurationHours: 4},
+				},
+			},
+			t:    now,
+			want: true,
+		},
+		{
+			name: "time zone is converted to UTC",
+			p: &MaintenanceProfile{
+				Windows: []MaintenanceWindow{{Day: "Wednesday", StartHour: 10, DurationHours: 4}},
+			},
+			t:    now.In(time.FixedZone("", -12*60*60)), // Tuesday locally
+			want: true,
+		},
+		{
+			name: "excluded day overrides window",
+			p: &MaintenanceProfile{
+				Windows:      []MaintenanceWindow{{Day: "Tuesday", StartHour: 22, DurationHours: 24}},
+				ExcludedDays: []string{"Wednesday"},
+			},
+			t: now,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.p.Allows(tt.t)
+			if got != tt.want {
+				t.Error(got)
+			}
+		})
+	}
+}
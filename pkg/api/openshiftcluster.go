@@ -4,6 +4,7 @@ package api
 // Licensed under the Apache License 2.0.
 
 import (
+	"net/http"
 	"time"
 )
 
@@ -90,13 +91,39 @@ type OpenShiftClusterProperties struct {
 
 	WorkerProfiles []WorkerProfile `json:"workerProfiles,omitempty"`
 
+	// AdditionalWorkerProfiles are worker profiles added after cluster
+	// creation.  Unlike WorkerProfiles, which is overwritten with whatever is
+	// found on the cluster, this is the desired state and the RP manages the
+	// corresponding machinesets.
+	AdditionalWorkerProfiles []WorkerProfile `json:"additionalWorkerProfiles,omitempty"`
+
 	APIServerProfile APIServerProfile `json:"apiserverProfile,omitempty"`
 
 	IngressProfiles []IngressProfile `json:"ingressProfiles,omitempty"`
 
+	// AutoscalerProfile is non-nil only if the customer has enabled the
+	// cluster autoscaler
+	AutoscalerProfile *AutoscalerProfile `json:"autoscalerProfile,omitempty"`
+
+	// MaintenanceProfile is non-nil only if the customer has restricted when
+	// the cluster may be maintained
+	MaintenanceProfile *MaintenanceProfile `json:"maintenanceProfile,omitempty"`
+
+	// EncryptionAtRestProfile is non-nil only if the customer has asked for
+	// the cluster's secrets to be encrypted with their own Key Vault key
+	EncryptionAtRestProfile *EncryptionAtRestProfile `json:"encryptionAtRestProfile,omitempty"`
+
 	// Install is non-nil only when an install is in progress
 	Install *Install `json:"install,omitempty"`
 
+	// Progress is non-nil only while an operation is in progress: it records
+	// the last step which the backend started
+	Progress *ProvisioningProgress `json:"progress,omitempty"`
+
+	// LastOperationFailure summarises the last failed install or update for
+	// the customer.  It is cleared when an install or update next succeeds.
+	LastOperationFailure *OperationFailure `json:"lastOperationFailure,omitempty"`
+
 	StorageSuffix string `json:"storageSuffix,omitempty"`
 
 	InfraID              string       `json:"infraId,omitempty"`
@@ -105,7 +132,36 @@ type OpenShiftClusterProperties struct {
 	AROServiceKubeconfig SecureBytes  `json:"aroServiceKubeconfig,omitempty"`
 	KubeadminPassword    SecureString `json:"kubeadminPassword,omitempty"`
 
+	// RefreshAROServiceKubeconfig is set while the RP's credentials to the
+	// cluster are being regenerated
+	RefreshAROServiceKubeconfig bool `json:"refreshAroServiceKubeconfig,omitempty"`
+
+	// NewSSHKey is non-nil only while the RP's SSH key is being rotated: it
+	// replaces SSHKey once every node has it in its authorized keys
+	NewSSHKey SecureBytes `json:"newSshKey,omitempty"`
+
+	// ReimageMachines are the names of the worker machines which never
+	// joined the cluster and which are being replaced
+	ReimageMachines []string `json:"reimageMachines,omitempty"`
+
+	// InventoryClientKey and InventoryClientCertificate are the TLS client
+	// credentials with which the cluster's ARO operator reports its inventory
+	// to the RP
+	InventoryClientKey         SecureBytes `json:"inventoryClientKey,omitempty"`
+	InventoryClientCertificate []byte      `json:"inventoryClientCertificate,omitempty"`
+
+	// CredentialsProfile records when the cluster's credentials were issued
+	// and rotated
+	CredentialsProfile CredentialsProfile `json:"credentialsProfile,omitempty"`
+
 	RegistryProfiles []*RegistryProfile `json:"registryProfiles,omitempty"`
+
+	// ConsoleNotifications are banners which the ARO operator displays in the
+	// OpenShift console, e.g. to announce planned maintenance
+	ConsoleNotifications []ConsoleNotification `json:"consoleNotifications,omitempty"`
+
+	// OperatorFlags override the defaults of the ARO operator's flags
+	OperatorFlags map[string]string `json:"operatorFlags,omitempty"`
 }
 
 // ProvisioningState represents a provisioning state
@@ -138,6 +194,7 @@ type ClusterProfile struct {
 	Domain          string       `json:"domain,omitempty"`
 	Version         string       `json:"version,omitempty"`
 	ResourceGroupID string       `json:"resourceGroupId,omitempty"`
+	SSHPublicKey    string       `json:"sshPublicKey,omitempty"`
 }
 
 // ConsoleProfile represents a console profile.
@@ -162,18 +219,50 @@ type NetworkProfile struct {
 
 	PodCIDR     string `json:"podCidr,omitempty"`
 	ServiceCIDR string `json:"serviceCidr,omitempty"`
+	MachineCIDR string `json:"machineCidr,omitempty"`
+	HostPrefix  int    `json:"hostPrefix,omitempty"`
 
 	PrivateEndpointIP string `json:"privateEndpointIp,omitempty"`
+
+	LoadBalancerProfile *LoadBalancerProfile `json:"loadBalancerProfile,omitempty"`
+}
+
+// LoadBalancerProfile represents the configuration of the public load balancer
+// of the cluster
+type LoadBalancerProfile struct {
+	MissingFields
+
+	ManagedOutboundIPs *ManagedOutboundIPs `json:"managedOutboundIps,omitempty"`
+}
+
+// ManagedOutboundIPs represents the public IPs which the RP manages for the
+// outbound SNAT of the cluster
+type ManagedOutboundIPs struct {
+	MissingFields
+
+	Count int `json:"count,omitempty"`
 }
 
 // MasterProfile represents a master profile
 type MasterProfile struct {
 	MissingFields
 
-	VMSize   VMSize `json:"vmSize,omitempty"`
-	SubnetID string `json:"subnetId,omitempty"`
+	VMSize       VMSize       `json:"vmSize,omitempty"`
+	SubnetID     string       `json:"subnetId,omitempty"`
+	SecurityType SecurityType `json:"securityType,omitempty"`
 }
 
+// SecurityType represents the security type of the VMs of a profile
+type SecurityType string
+
+// SecurityType constants
+const (
+	SecurityTypeStandard SecurityType = "Standard"
+
+	// SecurityTypeTrustedLaunch VMs boot with secure boot and a virtual TPM
+	SecurityTypeTrustedLaunch SecurityType = "TrustedLaunch"
+)
+
 // VMSize represents a VM size
 type VMSize string
 
@@ -201,19 +290,53 @@ const (
 	VMSizeStandardF8sV2  VMSize = "Standard_F8s_v2"
 	VMSizeStandardF16sV2 VMSize = "Standard_F16s_v2"
 	VMSizeStandardF32sV2 VMSize = "Standard_F32s_v2"
+
+	// arm64 sizes, only valid for additional worker profiles
+	VMSizeStandardD4psV5  VMSize = "Standard_D4ps_v5"
+	VMSizeStandardD8psV5  VMSize = "Standard_D8ps_v5"
+	VMSizeStandardD16psV5 VMSize = "Standard_D16ps_v5"
+	VMSizeStandardD32psV5 VMSize = "Standard_D32ps_v5"
+
+	VMSizeStandardE4psV5  VMSize = "Standard_E4ps_v5"
+	VMSizeStandardE8psV5  VMSize = "Standard_E8ps_v5"
+	VMSizeStandardE16psV5 VMSize = "Standard_E16ps_v5"
+	VMSizeStandardE32psV5 VMSize = "Standard_E32ps_v5"
 )
 
 // WorkerProfile represents a worker profile
 type WorkerProfile struct {
 	MissingFields
 
-	Name       string `json:"name,omitempty"`
-	VMSize     VMSize `json:"vmSize,omitempty"`
-	DiskSizeGB int    `json:"diskSizeGB,omitempty"`
-	SubnetID   string `json:"subnetId,omitempty"`
-	Count      int    `json:"count,omitempty"`
+	Name         string       `json:"name,omitempty"`
+	VMSize       VMSize       `json:"vmSize,omitempty"`
+	DiskSizeGB   int          `json:"diskSizeGB,omitempty"`
+	SubnetID     string       `json:"subnetId,omitempty"`
+	Count        int          `json:"count,omitempty"`
+	SecurityType SecurityType `json:"securityType,omitempty"`
+
+	NodeLabels map[string]string `json:"nodeLabels,omitempty"`
+	NodeTaints []NodeTaint       `json:"nodeTaints,omitempty"`
+}
+
+// NodeTaint represents a taint which is set on the nodes of a worker profile
+type NodeTaint struct {
+	MissingFields
+
+	Key    string      `json:"key,omitempty"`
+	Value  string      `json:"value,omitempty"`
+	Effect TaintEffect `json:"effect,omitempty"`
 }
 
+// TaintEffect represents the effect of a node taint
+type TaintEffect string
+
+// TaintEffect constants
+const (
+	TaintEffectNoSchedule       TaintEffect = "NoSchedule"
+	TaintEffectPreferNoSchedule TaintEffect = "PreferNoSchedule"
+	TaintEffectNoExecute        TaintEffect = "NoExecute"
+)
+
 // APIServerProfile represents an API server profile
 type APIServerProfile struct {
 	MissingFields
@@ -221,6 +344,10 @@ type APIServerProfile struct {
 	Visibility Visibility `json:"visibility,omitempty"`
 	URL        string     `json:"url,omitempty"`
 	IP         string     `json:"ip,omitempty"`
+
+	// PublicIPAddressID is the customer's public IP which fronts the API
+	// server, instead of one created by the RP
+	PublicIPAddressID string `json:"publicIpAddressId,omitempty"`
 }
 
 // Visibility represents visibility.
@@ -239,6 +366,95 @@ type IngressProfile struct {
 	Name       string     `json:"name,omitempty"`
 	Visibility Visibility `json:"visibility,omitempty"`
 	IP         string     `json:"ip,omitempty"`
+
+	// PublicIPAddressID is the customer's public IP which fronts the
+	// ingress, instead of one created by the cloud provider
+	PublicIPAddressID string `json:"publicIpAddressId,omitempty"`
+}
+
+// AutoscalerProfile represents the configuration of the cluster autoscaler
+type AutoscalerProfile struct {
+	MissingFields
+
+	MaxNodesTotal          int              `json:"maxNodesTotal,omitempty"`
+	ScaleDownDelayAfterAdd string           `json:"scaleDownDelayAfterAdd,omitempty"`
+	ScaleDownUnneededTime  string           `json:"scaleDownUnneededTime,omitempty"`
+	Pools                  []AutoscalerPool `json:"pools,omitempty"`
+}
+
+// AutoscalerPool represents the scaling bounds of the machinesets of a worker
+// profile
+type AutoscalerPool struct {
+	MissingFields
+
+	Name        string `json:"name,omitempty"`
+	MinReplicas int    `json:"minReplicas,omitempty"`
+	MaxReplicas int    `json:"maxReplicas,omitempty"`
+}
+
+// MaintenanceProfile represents the customer's preferences for when the
+// cluster may be maintained
+type MaintenanceProfile struct {
+	MissingFields
+
+	Windows      []MaintenanceWindow `json:"windows,omitempty"`
+	ExcludedDays []string            `json:"excludedDays,omitempty"`
+}
+
+// MaintenanceWindow represents a weekly period, in UTC, in which the cluster
+// may be maintained
+type MaintenanceWindow struct {
+	MissingFields
+
+	Day           string `json:"day,omitempty"`
+	StartHour     int    `json:"startHour,omitempty"`
+	DurationHours int    `json:"durationHours,omitempty"`
+}
+
+// EncryptionAtRestProfile represents the customer-managed key with which the
+// cluster's secrets are encrypted at rest.  The secrets are sealed into
+// SealedSecrets with a per-cluster data key; the data key is stored in
+// WrappedKey, wrapped by the customer's Key Vault key.  Only the RP's first
+// party identity can unwrap it, and only while the customer allows it.
+type EncryptionAtRestProfile struct {
+	MissingFields
+
+	// KeyVaultKeyID is the versioned ID of the customer's Key Vault key, e.g.
+	// https://vault.vault.azure.net/keys/key/0123456789abcdef0123456789abcdef
+	KeyVaultKeyID string `json:"keyVaultKeyId,omitempty"`
+
+	WrappedKey    []byte      `json:"wrappedKey,omitempty"`
+	SealedSecrets SecureBytes `json:"sealedSecrets,omitempty"`
+
+	// KeyState and KeyStateMessage record whether the RP could unwrap the
+	// data key when the document was last read
+	KeyState        EncryptionKeyState `json:"keyState,omitempty"`
+	KeyStateMessage string             `json:"keyStateMessage,omitempty"`
+
+	// Unsealed is set in memory once the sealed secrets have been restored
+	// to the document.  It is never persisted.
+	Unsealed bool `json:"-"`
+}
+
+// EncryptionKeyState represents whether the RP can use a customer-managed key
+type EncryptionKeyState string
+
+// EncryptionKeyState constants
+const (
+	EncryptionKeyStateAccessible   EncryptionKeyState = "Accessible"
+	EncryptionKeyStateInaccessible EncryptionKeyState = "Inaccessible"
+)
+
+// IsInaccessible returns true if the cluster's secrets are sealed with a
+// customer-managed key which the RP cannot currently use
+func (p *EncryptionAtRestProfile) IsInaccessible() bool {
+	return p != nil && p.KeyState == EncryptionKeyStateInaccessible
+}
+
+// InaccessibleError returns the error with which requests and operations
+// which need the cluster's secrets fail while its key is inaccessible
+func (p *EncryptionAtRestProfile) InaccessibleError() *CloudError {
+	return NewCloudError(http.StatusBadRequest, CloudErrorCodeEncryptionKeyInaccessible, "properties.encryptionAtRestProfile.keyVaultKeyId", "The key vault key '%s' which encrypts the cluster's secrets is inaccessible: %s. Restore the resource provider's wrapKey and unwrapKey permissions on the key and retry.", p.KeyVaultKeyID, p.KeyStateMessage)
 }
 
 // RegistryProfile represents a registry's login
@@ -250,6 +466,65 @@ type RegistryProfile struct {
 	Password SecureString `json:"password,omitempty"`
 }
 
+// ConsoleNotification represents a banner displayed in the OpenShift console
+type ConsoleNotification struct {
+	MissingFields
+
+	Name     string                      `json:"name,omitempty"`
+	Type     ConsoleNotificationType     `json:"type,omitempty"`
+	Location ConsoleNotificationLocation `json:"location,omitempty"`
+	Text     string                      `json:"text,omitempty"`
+	LinkText string                      `json:"linkText,omitempty"`
+	LinkURL  string                      `json:"linkUrl,omitempty"`
+}
+
+// ConsoleNotificationType represents the kind of a console notification
+type ConsoleNotificationType string
+
+// ConsoleNotificationType constants
+const (
+	ConsoleNotificationTypeInformation ConsoleNotificationType = "Information"
+	ConsoleNotificationTypeMaintenance ConsoleNotificationType = "Maintenance"
+	ConsoleNotificationTypeDeprecation ConsoleNotificationType = "Deprecation"
+)
+
+// ConsoleNotificationLocation represents where a console notification is
+// displayed
+type ConsoleNotificationLocation string
+
+// ConsoleNotificationLocation constants
+const (
+	ConsoleNotificationLocationBannerTop       ConsoleNotificationLocation = "BannerTop"
+	ConsoleNotificationLocationBannerBottom    ConsoleNotificationLocation = "BannerBottom"
+	ConsoleNotificationLocationBannerTopBottom ConsoleNotificationLocation = "BannerTopBottom"
+)
+
+// CredentialsProfile records the issuance of each of the cluster's
+// credentials
+type CredentialsProfile struct {
+	MissingFields
+
+	KubeadminPassword    CredentialRecord `json:"kubeadminPassword,omitempty"`
+	AROServiceKubeconfig CredentialRecord `json:"aroServiceKubeconfig,omitempty"`
+	ServicePrincipal     CredentialRecord `json:"servicePrincipal,omitempty"`
+	SSHKey               CredentialRecord `json:"sshKey,omitempty"`
+}
+
+// CredentialRecord records when a credential was issued and rotated.  A zero
+// IssuedAt means that the credential predates tracking.
+type CredentialRecord struct {
+	MissingFields
+
+	IssuedAt time.Time `json:"issuedAt,omitempty"`
+
+	// ExpiresAt is non-nil only if the credential expires and its expiry is
+	// known to the RP
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// RotatedAt holds the times of the most recent rotations, oldest first
+	RotatedAt []time.Time `json:"rotatedAt,omitempty"`
+}
+
 // Install represents an install process
 type Install struct {
 	MissingFields
@@ -258,6 +533,17 @@ type Install struct {
 	Phase InstallPhase `json:"phase"`
 }
 
+// ProvisioningProgress represents how far the backend has got with an
+// operation
+type ProvisioningProgress struct {
+	MissingFields
+
+	Phase              string    `json:"phase,omitempty"`
+	Step               string    `json:"step,omitempty"`
+	PercentComplete    int       `json:"percentComplete,omitempty"`
+	LastTransitionTime time.Time `json:"lastTransitionTime,omitempty"`
+}
+
 // InstallPhase represents an install phase
 type InstallPhase int
 
@@ -3,6 +3,10 @@ package api
 // Copyright (c) Microsoft Corporation.
 // Licensed under the Apache License 2.0.
 
+import (
+	"time"
+)
+
 // OpenShiftClusterDocuments represents OpenShift cluster documents.
 // pkg/database/cosmosdb requires its definition.
 type OpenShiftClusterDocuments struct {
@@ -44,9 +48,139 @@ type OpenShiftClusterDocument struct {
 
 	OpenShiftCluster *OpenShiftCluster `json:"openShiftCluster,omitempty"`
 
+	// Snapshot is taken before the backend runs risky maintenance steps on
+	// the cluster, so that they can be rolled back by an admin action
+	Snapshot *OpenShiftClusterSnapshot `json:"snapshot,omitempty"`
+
+	// FollowUpTasks holds non-critical work which an operation could not
+	// complete and left for the backend to retry after it finished
+	FollowUpTasks []FollowUpTask `json:"followUpTasks,omitempty"`
+
+	// DRImport is non-nil only if the document was imported from another
+	// region's DR metadata
+	DRImport *DRImport `json:"drImport,omitempty"`
+
+	// IdempotencyRecords holds the recent admin actions which were requested
+	// with an Idempotency-Key header
+	IdempotencyRecords []IdempotencyRecord `json:"idempotencyRecords,omitempty"`
+
+	// OperationHistory holds the recent operations which the backend ran on
+	// the cluster, oldest first
+	OperationHistory []OperationRecord `json:"operationHistory,omitempty"`
+
 	CorrelationData *CorrelationData `json:"correlationData,omitempty" deep:"-"`
 }
 
 func (c *OpenShiftClusterDocument) String() string {
 	return encodeJSON(c)
 }
+
+// FollowUpTaskType is the type of a FollowUpTask
+type FollowUpTaskType string
+
+// FollowUpTaskType constants
+const (
+	FollowUpTaskTypeBillingRecord FollowUpTaskType = "BillingRecord"
+)
+
+// FollowUpTask is a task which the backend retries, with backoff, until it
+// succeeds
+type FollowUpTask struct {
+	MissingFields
+
+	Type FollowUpTaskType `json:"type,omitempty"`
+
+	// Attempts counts the failed attempts so far, including the one made by
+	// the operation which created the task
+	Attempts    int    `json:"attempts,omitempty"`
+	NextAttempt int    `json:"nextAttempt,omitempty"`
+	LastError   string `json:"lastError,omitempty"`
+}
+
+// IdempotencyRecord records an admin action which was requested with an
+// Idempotency-Key header and, once the action has completed, its response, so
+// that retried requests are answered without executing the action again
+type IdempotencyRecord struct {
+	MissingFields
+
+	Key       string    `json:"key,omitempty"`
+	Request   string    `json:"request,omitempty"`
+	CreatedAt time.Time `json:"createdAt,omitempty"`
+
+	// StatusCode is zero while the action is in progress
+	StatusCode int                       `json:"statusCode,omitempty"`
+	Headers    []IdempotencyRecordHeader `json:"headers,omitempty"`
+	Body       []byte                    `json:"body,omitempty"`
+}
+
+// IdempotencyRecordHeader is a header of a recorded admin action response
+type IdempotencyRecordHeader struct {
+	MissingFields
+
+	Name  string `json:"name,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// OperationRecord records an operation which the backend ran on the cluster.
+// A cluster install spans several leases, each of which adds its steps to the
+// same record.
+type OperationRecord struct {
+	MissingFields
+
+	Operation        ProvisioningState `json:"operation,omitempty"`
+	AsyncOperationID string            `json:"asyncOperationId,omitempty"`
+	StartTime        time.Time         `json:"startTime,omitempty"`
+
+	// EndTime and Result are unset while the operation is in progress
+	EndTime    *time.Time        `json:"endTime,omitempty"`
+	Result     ProvisioningState `json:"result,omitempty"`
+	FailedStep string            `json:"failedStep,omitempty"`
+	Error      string            `json:"error,omitempty"`
+
+	Steps []OperationRecordStep `json:"steps,omitempty"`
+}
+
+// OperationRecordStep records a step which the backend ran during an
+// operation
+type OperationRecordStep struct {
+	MissingFields
+
+	Step       string            `json:"step,omitempty"`
+	DurationMS int64             `json:"durationMs,omitempty"`
+	Result     ProvisioningState `json:"result,omitempty"`
+}
+
+// OpenShiftClusterSnapshot is a copy of the cluster document sections and the
+// cluster resources which are changed by load balancer reconfiguration and
+// certificate rotation
+type OpenShiftClusterSnapshot struct {
+	MissingFields
+
+	CreatedAt time.Time `json:"createdAt,omitempty"`
+
+	APIServerProfile APIServerProfile `json:"apiserverProfile,omitempty"`
+	IngressProfiles  []IngressProfile `json:"ingressProfiles,omitempty"`
+	NetworkProfile   NetworkProfile   `json:"networkProfile,omitempty"`
+
+	LoadBalancers []SnapshotLoadBalancer `json:"loadBalancers,omitempty"`
+	Certificates  []SnapshotCertificate  `json:"certificates,omitempty"`
+}
+
+// SnapshotLoadBalancer holds the ARM JSON of one of the cluster's load
+// balancers
+type SnapshotLoadBalancer struct {
+	MissingFields
+
+	Name string `json:"name,omitempty"`
+	JSON []byte `json:"json,omitempty"`
+}
+
+// SnapshotCertificate holds the PEM encoded key and certificate chain of one
+// of the cluster's serving certificate secrets
+type SnapshotCertificate struct {
+	MissingFields
+
+	Namespace string      `json:"namespace,omitempty"`
+	Name      string      `json:"name,omitempty"`
+	PEM       SecureBytes `json:"pem,omitempty"`
+}
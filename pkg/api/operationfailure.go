@@ -0,0 +1,88 @@
+package api
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"time"
+)
+
+// FailureCategory is the part of an install or update which failed
+type FailureCategory string
+
+// FailureCategory constants
+const (
+	// FailureCategoryConfiguration covers failures which the customer must
+	// fix: invalid credentials, missing permissions and policy denials
+	FailureCategoryConfiguration    FailureCategory = "Configuration"
+	FailureCategoryQuota            FailureCategory = "Quota"
+	FailureCategoryAzureResources   FailureCategory = "AzureResources"
+	FailureCategoryNetworking       FailureCategory = "Networking"
+	FailureCategoryClusterBootstrap FailureCategory = "ClusterBootstrap"
+	FailureCategoryClusterOperators FailureCategory = "ClusterOperators"
+	FailureCategoryNodes            FailureCategory = "Nodes"
+	FailureCategoryInternal         FailureCategory = "Internal"
+)
+
+// OperationFailure summarises a failed install or update in terms which are
+// safe to return to the customer
+type OperationFailure struct {
+	MissingFields
+
+	// Operation is the provisioning state of the operation which failed,
+	// i.e. Creating or Updating
+	Operation ProvisioningState `json:"operation,omitempty"`
+	Time      time.Time         `json:"time,omitempty"`
+
+	Category FailureCategory `json:"category,omitempty"`
+
+	// Code and Message are those of the error returned to the customer in
+	// the asyncOperation; Message is replaced with remediation guidance for
+	// the category if the error was internal
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+
+	// AzureErrorCode is the code of the Azure error underlying the failure,
+	// if any, e.g. SkuNotAvailable
+	AzureErrorCode string `json:"azureErrorCode,omitempty"`
+
+	// FailedStep is internal and is not returned to the customer
+	FailedStep string `json:"failedStep,omitempty"`
+}
+
+// FailureSummary represents the summary of the last failed install or update
+// of an OpenShift cluster.
+type FailureSummary struct {
+	// The resource ID.
+	ID string `json:"id,omitempty"`
+
+	// The resource name.
+	Name string `json:"name,omitempty"`
+
+	// The resource type.
+	Type string `json:"type,omitempty"`
+
+	// The failure summary properties.
+	Properties FailureSummaryProperties `json:"properties,omitempty"`
+}
+
+// FailureSummaryProperties represents the properties of a failure summary.
+type FailureSummaryProperties struct {
+	// The operation which failed.
+	Operation ProvisioningState `json:"operation,omitempty"`
+
+	// When the operation failed.
+	FailedAt time.Time `json:"failedAt,omitempty"`
+
+	// The part of the operation which failed.
+	Category FailureCategory `json:"category,omitempty"`
+
+	// The error code.
+	Code string `json:"code,omitempty"`
+
+	// A message describing the failure and how to remedy it.
+	Message string `json:"message,omitempty"`
+
+	// The code of the underlying Azure error, if any.
+	AzureErrorCode string `json:"azureErrorCode,omitempty"`
+}
@@ -21,11 +21,55 @@ type OpenShiftClusterCredentialsConverter interface {
 	ToExternal(*OpenShiftCluster) interface{}
 }
 
+type WorkerProfileConverter interface {
+	ToExternal(*WorkerProfile) interface{}
+	ToInternal(interface{}, *WorkerProfile)
+}
+
+type WorkerProfileStaticValidator interface {
+	Static(interface{}, *OpenShiftCluster) error
+}
+
+type DetectorConverter interface {
+	ToExternal(*Detector) interface{}
+	ToExternalList([]*Detector) interface{}
+}
+
+type EgressConverter interface {
+	ToExternal(*Egress) interface{}
+}
+
+type FailureSummaryConverter interface {
+	ToExternal(*FailureSummary) interface{}
+}
+
+type DeletePreflightConverter interface {
+	ToExternal(*DeletePreflight) interface{}
+}
+
+type OpenShiftVersionConverter interface {
+	ToExternal(*OpenShiftVersion) interface{}
+	ToExternalList([]*OpenShiftVersion) interface{}
+	ToInternal(interface{}, *OpenShiftVersion)
+}
+
+type OpenShiftVersionStaticValidator interface {
+	Static(interface{}, *OpenShiftVersion) error
+}
+
 // Version is a set of endpoints implemented by each API version
 type Version struct {
 	OpenShiftClusterConverter            func() OpenShiftClusterConverter
-	OpenShiftClusterStaticValidator      func(string, string, deployment.Mode, string) OpenShiftClusterStaticValidator
+	OpenShiftClusterStaticValidator      func(string, string, deployment.Capabilities, string) OpenShiftClusterStaticValidator
 	OpenShiftClusterCredentialsConverter func() OpenShiftClusterCredentialsConverter
+	WorkerProfileConverter               func() WorkerProfileConverter
+	WorkerProfileStaticValidator         func(deployment.Capabilities) WorkerProfileStaticValidator
+	DetectorConverter                    func() DetectorConverter
+	EgressConverter                      func() EgressConverter
+	FailureSummaryConverter              func() FailureSummaryConverter
+	DeletePreflightConverter             func() DeletePreflightConverter
+	OpenShiftVersionConverter            func() OpenShiftVersionConverter
+	OpenShiftVersionStaticValidator      func() OpenShiftVersionStaticValidator
 }
 
 // APIs is the map of registered API versions
@@ -22,10 +22,10 @@ import (
 )
 
 type openShiftClusterStaticValidator struct {
-	location       string
-	domain         string
-	deploymentMode deployment.Mode
-	resourceID     string
+	location     string
+	domain       string
+	capabilities deployment.Capabilities
+	resourceID   string
 
 	r azure.Resource
 }
@@ -70,6 +70,11 @@ func (sv *openShiftClusterStaticValidator) validate(oc *OpenShiftCluster, isCrea
 	if !strings.EqualFold(oc.Location, sv.location) {
 		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "location", "The provided location '%s' is invalid.", oc.Location)
 	}
+	if isCreate {
+		if err := validate.ClusterName(oc.Name); err != nil {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "name", "The provided resource name '%s' is invalid: %s.", oc.Name, err)
+		}
+	}
 
 	return sv.validateProperties("properties", &oc.Properties, isCreate)
 }
@@ -122,30 +127,35 @@ func (sv *openShiftClusterStaticValidator) validateClusterProfile(path string, c
 	if pullsecret.Validate(cp.PullSecret) != nil {
 		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".pullSecret", "The provided pull secret is invalid.")
 	}
-	if !validate.RxDomainName.MatchString(cp.Domain) {
-		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".domain", "The provided domain '%s' is invalid.", cp.Domain)
+	if err := validate.Domain(cp.Domain, sv.domain); err != nil {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".domain", "The provided domain '%s' is invalid: %s.", cp.Domain, err)
 	}
-	// domain ends .aroapp.io, but doesn't end .<rp-location>.aroapp.io
-	if strings.HasSuffix(cp.Domain, "."+strings.SplitN(sv.domain, ".", 2)[1]) &&
-		!strings.HasSuffix(cp.Domain, "."+sv.domain) {
-		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".domain", "The provided domain '%s' is invalid.", cp.Domain)
-	}
-	// domain is of form multiple.names.<rp-location>.aroapp.io
-	if strings.HasSuffix(cp.Domain, "."+sv.domain) &&
-		strings.ContainsRune(strings.TrimSuffix(cp.Domain, "."+sv.domain), '.') {
-		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".domain", "The provided domain '%s' is invalid.", cp.Domain)
+	if isCreate {
+		if err := validate.DomainLength(cp.Domain, sv.domain); err != nil {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".domain", "The provided domain '%s' is invalid: %s.", cp.Domain, err)
+		}
 	}
 
-	if isCreate && cp.Version != version.InstallStream.Version.String() {
-		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".version", "The provided version '%s' is invalid.", cp.Version)
+	// whether the version is installable is validated against the versions
+	// in the database by the frontend
+	if isCreate {
+		v, err := version.ParseVersion(cp.Version)
+		if err != nil || v.Suffix != "" {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".version", "The provided version '%s' is invalid.", cp.Version)
+		}
 	}
 
-	if !validate.RxResourceGroupID.MatchString(cp.ResourceGroupID) {
-		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".resourceGroupId", "The provided resource group '%s' is invalid.", cp.ResourceGroupID)
+	if err := validate.ResourceGroupID(cp.ResourceGroupID); err != nil {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".resourceGroupId", "The provided resource group '%s' is invalid: %s.", cp.ResourceGroupID, err)
 	}
 	if strings.Split(cp.ResourceGroupID, "/")[2] != sv.r.SubscriptionID {
 		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".resourceGroupId", "The provided resource group '%s' is invalid: must be in same subscription as cluster.", cp.ResourceGroupID)
 	}
+	// the cluster resource group is deleted with the cluster and locked by a
+	// deny assignment, so it must never be the customer's own resource group
+	if isCreate && strings.EqualFold(strings.Split(cp.ResourceGroupID, "/")[4], sv.r.ResourceGroup) {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".resourceGroupId", "The provided resource group '%s' is invalid: must be different from resourceGroup of the OpenShift Cluster object.", cp.ResourceGroupID)
+	}
 
 	return nil
 }
@@ -204,7 +214,7 @@ func (sv *openShiftClusterStaticValidator) validateNetworkProfile(path string, n
 }
 
 func (sv *openShiftClusterStaticValidator) validateMasterProfile(path string, mp *MasterProfile) error {
-	if !validate.VMSizeIsValid(api.VMSize(mp.VMSize), sv.deploymentMode, true) {
+	if !validate.InstallProfileFor(sv.capabilities).MasterVMSizeIsValid(api.VMSize(mp.VMSize)) {
 		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".vmSize", "The provided master VM size '%s' is invalid.", mp.VMSize)
 	}
 	if !validate.RxSubnetID.MatchString(mp.SubnetID) {
@@ -222,13 +232,15 @@ func (sv *openShiftClusterStaticValidator) validateMasterProfile(path string, mp
 }
 
 func (sv *openShiftClusterStaticValidator) validateWorkerProfile(path string, wp *WorkerProfile, mp *MasterProfile) error {
+	profile := validate.InstallProfileFor(sv.capabilities)
+
 	if wp.Name != "worker" {
 		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".name", "The provided worker name '%s' is invalid.", wp.Name)
 	}
-	if !validate.VMSizeIsValid(api.VMSize(wp.VMSize), sv.deploymentMode, false) {
+	if !profile.WorkerVMSizeIsValid(api.VMSize(wp.VMSize)) {
 		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".vmSize", "The provided worker VM size '%s' is invalid.", wp.VMSize)
 	}
-	if !validate.DiskSizeIsValid(wp.DiskSizeGB) {
+	if !profile.DiskSizeIsValid(wp.DiskSizeGB) {
 		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".diskSizeGB", "The provided worker disk size '%d' is invalid.", wp.DiskSizeGB)
 	}
 	if !validate.RxSubnetID.MatchString(wp.SubnetID) {
@@ -248,7 +260,7 @@ func (sv *openShiftClusterStaticValidator) validateWorkerProfile(path string, wp
 	if strings.EqualFold(mp.SubnetID, wp.SubnetID) {
 		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".subnetId", "The provided worker VM subnet '%s' is invalid: must be different to master VM subnet '%s'.", wp.SubnetID, mp.SubnetID)
 	}
-	if wp.Count < 3 || wp.Count > 20 {
+	if !profile.WorkerCountIsValid(wp.Count) {
 		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".count", "The provided worker count '%d' is invalid.", wp.Count)
 	}
 
@@ -129,6 +129,9 @@ func (c *openShiftClusterConverter) ToInternal(_oc interface{}, out *api.OpenShi
 	out.Properties.NetworkProfile.ServiceCIDR = oc.Properties.NetworkProfile.ServiceCIDR
 	out.Properties.MasterProfile.VMSize = api.VMSize(oc.Properties.MasterProfile.VMSize)
 	out.Properties.MasterProfile.SubnetID = oc.Properties.MasterProfile.SubnetID
+	// the security type, node labels and node taints of a worker profile are
+	// not exposed in this API version: keep the existing ones
+	existingWorkerProfiles := out.Properties.WorkerProfiles
 	out.Properties.WorkerProfiles = nil
 	if oc.Properties.WorkerProfiles != nil {
 		out.Properties.WorkerProfiles = make([]api.WorkerProfile, len(oc.Properties.WorkerProfiles))
@@ -138,11 +141,19 @@ func (c *openShiftClusterConverter) ToInternal(_oc interface{}, out *api.OpenShi
 			out.Properties.WorkerProfiles[i].DiskSizeGB = oc.Properties.WorkerProfiles[i].DiskSizeGB
 			out.Properties.WorkerProfiles[i].SubnetID = oc.Properties.WorkerProfiles[i].SubnetID
 			out.Properties.WorkerProfiles[i].Count = oc.Properties.WorkerProfiles[i].Count
+			if i < len(existingWorkerProfiles) {
+				out.Properties.WorkerProfiles[i].SecurityType = existingWorkerProfiles[i].SecurityType
+				out.Properties.WorkerProfiles[i].NodeLabels = existingWorkerProfiles[i].NodeLabels
+				out.Properties.WorkerProfiles[i].NodeTaints = existingWorkerProfiles[i].NodeTaints
+			}
 		}
 	}
 	out.Properties.APIServerProfile.Visibility = api.Visibility(oc.Properties.APIServerProfile.Visibility)
 	out.Properties.APIServerProfile.URL = oc.Properties.APIServerProfile.URL
 	out.Properties.APIServerProfile.IP = oc.Properties.APIServerProfile.IP
+	// the public IP address of an ingress profile is not exposed in this API
+	// version: keep the existing one
+	existingIngressProfiles := out.Properties.IngressProfiles
 	out.Properties.IngressProfiles = nil
 	if oc.Properties.IngressProfiles != nil {
 		out.Properties.IngressProfiles = make([]api.IngressProfile, len(oc.Properties.IngressProfiles))
@@ -150,6 +161,9 @@ func (c *openShiftClusterConverter) ToInternal(_oc interface{}, out *api.OpenShi
 			out.Properties.IngressProfiles[i].Name = oc.Properties.IngressProfiles[i].Name
 			out.Properties.IngressProfiles[i].Visibility = api.Visibility(oc.Properties.IngressProfiles[i].Visibility)
 			out.Properties.IngressProfiles[i].IP = oc.Properties.IngressProfiles[i].IP
+			if i < len(existingIngressProfiles) {
+				out.Properties.IngressProfiles[i].PublicIPAddressID = existingIngressProfiles[i].PublicIPAddressID
+			}
 		}
 	}
 }
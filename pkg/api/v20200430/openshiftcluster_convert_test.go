@@ -0,0 +1,42 @@
+package v20200430
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+// TestOpenShiftClusterConverterRoundTrip checks that a cluster read and
+// written back through this API version keeps the fields which the API
+// version does not expose
+func TestOpenShiftClusterConverterRoundTrip(t *testing.T) {
+	internal := func() *api.OpenShiftCluster {
+		oc := api.ExampleOpenShiftClusterDocument().OpenShiftCluster
+		oc.Properties.WorkerProfiles[0].SecurityType = api.SecurityTypeTrustedLaunch
+		oc.Properties.WorkerProfiles[0].NodeLabels = map[string]string{
+			"key": "value",
+		}
+		oc.Properties.WorkerProfiles[0].NodeTaints = []api.NodeTaint{
+			{
+				Key:    "key",
+				Value:  "value",
+				Effect: api.TaintEffectNoSchedule,
+			},
+		}
+		oc.Properties.IngressProfiles[0].PublicIPAddressID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/clusterResourceGroup/providers/Microsoft.Network/publicIPAddresses/ingress"
+		return oc
+	}
+
+	c := &openShiftClusterConverter{}
+
+	out := internal()
+	c.ToInternal(c.ToExternal(internal()), out)
+
+	if !reflect.DeepEqual(out, internal()) {
+		t.Errorf("%#v", out.Properties.WorkerProfiles)
+	}
+}
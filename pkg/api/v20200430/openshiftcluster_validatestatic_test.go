@@ -100,11 +100,16 @@ func runTests(t *testing.T, mode testMode, tests []*validateTest) {
 	t.Run(string(mode), func(t *testing.T) {
 		for _, tt := range tests {
 			t.Run(tt.name, func(t *testing.T) {
+				capabilities, err := deployment.NewCapabilities(tt.deploymentMode)
+				if err != nil {
+					t.Fatal(err)
+				}
+
 				v := &openShiftClusterStaticValidator{
-					location:       "location",
-					domain:         "location.aroapp.io",
-					deploymentMode: tt.deploymentMode,
-					resourceID:     id,
+					location:     "location",
+					domain:       "location.aroapp.io",
+					capabilities: capabilities,
+					resourceID:   id,
 					r: azure.Resource{
 						SubscriptionID: subscriptionID,
 						ResourceGroup:  "resourceGroup",
@@ -125,7 +130,7 @@ func runTests(t *testing.T, mode testMode, tests []*validateTest) {
 					(&openShiftClusterConverter{}).ToInternal(validOpenShiftCluster(), current)
 				}
 
-				err := v.Static(oc, current)
+				err = v.Static(oc, current)
 				if err == nil {
 					if tt.wantErr != "" {
 						t.Error(err)
@@ -250,42 +255,42 @@ func TestOpenShiftClusterStaticValidateClusterProfile(t *testing.T) {
 			modify: func(oc *OpenShiftCluster) {
 				oc.Properties.ClusterProfile.Domain = ""
 			},
-			wantErr: "400: InvalidParameter: properties.clusterProfile.domain: The provided domain '' is invalid.",
+			wantErr: "400: InvalidParameter: properties.clusterProfile.domain: The provided domain '' is invalid: must not be empty.",
 		},
 		{
 			name: "upper case domain invalid",
 			modify: func(oc *OpenShiftCluster) {
 				oc.Properties.ClusterProfile.Domain = "BAD"
 			},
-			wantErr: "400: InvalidParameter: properties.clusterProfile.domain: The provided domain 'BAD' is invalid.",
+			wantErr: "400: InvalidParameter: properties.clusterProfile.domain: The provided domain 'BAD' is invalid: must be lower case.",
 		},
 		{
 			name: "domain invalid",
 			modify: func(oc *OpenShiftCluster) {
 				oc.Properties.ClusterProfile.Domain = "!"
 			},
-			wantErr: "400: InvalidParameter: properties.clusterProfile.domain: The provided domain '!' is invalid.",
+			wantErr: "400: InvalidParameter: properties.clusterProfile.domain: The provided domain '!' is invalid: must be a DNS name whose first label starts with a letter.",
 		},
 		{
 			name: "wrong location managed domain invalid",
 			modify: func(oc *OpenShiftCluster) {
 				oc.Properties.ClusterProfile.Domain = "cluster.wronglocation.aroapp.io"
 			},
-			wantErr: "400: InvalidParameter: properties.clusterProfile.domain: The provided domain 'cluster.wronglocation.aroapp.io' is invalid.",
+			wantErr: "400: InvalidParameter: properties.clusterProfile.domain: The provided domain 'cluster.wronglocation.aroapp.io' is invalid: a managed domain must end with .location.aroapp.io.",
 		},
 		{
 			name: "double part managed domain invalid",
 			modify: func(oc *OpenShiftCluster) {
 				oc.Properties.ClusterProfile.Domain = "foo.bar.location.aroapp.io"
 			},
-			wantErr: "400: InvalidParameter: properties.clusterProfile.domain: The provided domain 'foo.bar.location.aroapp.io' is invalid.",
+			wantErr: "400: InvalidParameter: properties.clusterProfile.domain: The provided domain 'foo.bar.location.aroapp.io' is invalid: a managed domain must have a single label before .location.aroapp.io.",
 		},
 		{
 			name: "resourceGroupId invalid",
 			modify: func(oc *OpenShiftCluster) {
 				oc.Properties.ClusterProfile.ResourceGroupID = "invalid"
 			},
-			wantErr: "400: InvalidParameter: properties.clusterProfile.resourceGroupId: The provided resource group 'invalid' is invalid.",
+			wantErr: "400: InvalidParameter: properties.clusterProfile.resourceGroupId: The provided resource group 'invalid' is invalid: must be of the form /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}.",
 		},
 		{
 			name: "cluster resource group subscriptionId not matching cluster subscriptionId",
@@ -310,6 +315,27 @@ func TestOpenShiftClusterStaticValidateClusterProfile(t *testing.T) {
 			},
 			wantErr: "400: InvalidParameter: properties.clusterProfile.version: The provided version 'invalid' is invalid.",
 		},
+		{
+			name: "managed domain too long invalid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.ClusterProfile.Domain = strings.Repeat("a", 40) + ".location.aroapp.io"
+			},
+			wantErr: "400: InvalidParameter: properties.clusterProfile.domain: The provided domain '" + strings.Repeat("a", 40) + ".location.aroapp.io' is invalid: a managed domain must be at most 57 characters long.",
+		},
+		{
+			name: "resourceGroupId with unicode name invalid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.ClusterProfile.ResourceGroupID = fmt.Sprintf("/subscriptions/%s/resourceGroups/tést-cluster", subscriptionID)
+			},
+			wantErr: fmt.Sprintf("400: InvalidParameter: properties.clusterProfile.resourceGroupId: The provided resource group '/subscriptions/%s/resourceGroups/tést-cluster' is invalid: the resource group name must contain only ASCII letters, digits, hyphens, underscores, parentheses and periods.", subscriptionID),
+		},
+		{
+			name: "cluster resource group same as cluster object resource group",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.ClusterProfile.ResourceGroupID = fmt.Sprintf("/subscriptions/%s/resourceGroups/resourceGroup", subscriptionID)
+			},
+			wantErr: fmt.Sprintf("400: InvalidParameter: properties.clusterProfile.resourceGroupId: The provided resource group '/subscriptions/%s/resourceGroups/resourceGroup' is invalid: must be different from resourceGroup of the OpenShift Cluster object.", subscriptionID),
+		},
 	}
 
 	runTests(t, testModeCreate, createTests)
@@ -21,12 +21,12 @@ func init() {
 		OpenShiftClusterConverter: func() api.OpenShiftClusterConverter {
 			return &openShiftClusterConverter{}
 		},
-		OpenShiftClusterStaticValidator: func(location, domain string, deploymentMode deployment.Mode, resourceID string) api.OpenShiftClusterStaticValidator {
+		OpenShiftClusterStaticValidator: func(location, domain string, capabilities deployment.Capabilities, resourceID string) api.OpenShiftClusterStaticValidator {
 			return &openShiftClusterStaticValidator{
-				location:       location,
-				domain:         domain,
-				deploymentMode: deploymentMode,
-				resourceID:     resourceID,
+				location:     location,
+				domain:       domain,
+				capabilities: capabilities,
+				resourceID:   resourceID,
 			}
 		},
 		OpenShiftClusterCredentialsConverter: func() api.OpenShiftClusterCredentialsConverter {
@@ -0,0 +1,92 @@
+package v20201031preview
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+// DeletePreflight represents the checks which are run before an OpenShift
+// cluster is deleted: whether anything would prevent the delete, and what
+// the delete would destroy.
+type DeletePreflight struct {
+	// The resource ID.
+	ID string `json:"id,omitempty"`
+
+	// The resource name.
+	Name string `json:"name,omitempty"`
+
+	// The resource type.
+	Type string `json:"type,omitempty"`
+
+	// The delete preflight properties.
+	Properties DeletePreflightProperties `json:"properties,omitempty"`
+}
+
+// DeletePreflightConditionCode represents the kind of a delete preflight
+// condition.
+type DeletePreflightConditionCode string
+
+// DeletePreflightConditionCode constants.
+const (
+	// DeletePreflightConditionCodeOperationInProgress is set while another
+	// operation is running on the cluster.
+	DeletePreflightConditionCodeOperationInProgress DeletePreflightConditionCode = "OperationInProgress"
+
+	// DeletePreflightConditionCodeDeleteProtected is set if a CanNotDelete
+	// lock applies to the cluster.
+	DeletePreflightConditionCodeDeleteProtected DeletePreflightConditionCode = "DeleteProtected"
+
+	// DeletePreflightConditionCodeResourceLocked is set if a ReadOnly lock
+	// applies to the cluster or to a virtual network which the delete must
+	// update.
+	DeletePreflightConditionCodeResourceLocked DeletePreflightConditionCode = "ResourceLocked"
+
+	// DeletePreflightConditionCodeLocksNotChecked is set if the locks which
+	// apply to a resource could not be read.
+	DeletePreflightConditionCodeLocksNotChecked DeletePreflightConditionCode = "LocksNotChecked"
+)
+
+// DeletePreflightProperties represents the properties of a delete preflight.
+type DeletePreflightProperties struct {
+	// Whether the cluster can be deleted now.
+	CanDelete bool `json:"canDelete"`
+
+	// The conditions which prevent the cluster being deleted now.
+	BlockingConditions []DeletePreflightCondition `json:"blockingConditions,omitempty"`
+
+	// The conditions which do not prevent the cluster being deleted, but
+	// which mean that the preflight may be incomplete.
+	Warnings []DeletePreflightCondition `json:"warnings,omitempty"`
+
+	// The resources which are destroyed, with their data, when the cluster is
+	// deleted.
+	DeletedResources []DeletePreflightResource `json:"deletedResources,omitempty"`
+
+	// The customer's resources which are modified, but not destroyed, when
+	// the cluster is deleted.
+	ModifiedResources []DeletePreflightResource `json:"modifiedResources,omitempty"`
+}
+
+// DeletePreflightCondition represents a condition found by a delete
+// preflight.
+type DeletePreflightCondition struct {
+	// The kind of the condition.
+	Code DeletePreflightConditionCode `json:"code,omitempty"`
+
+	// A message describing the condition and how to remedy it.
+	Message string `json:"message,omitempty"`
+
+	// The ID of the resource to which the condition applies, if any.
+	ResourceID string `json:"resourceId,omitempty"`
+}
+
+// DeletePreflightResource represents a resource which deleting a cluster
+// affects.
+type DeletePreflightResource struct {
+	// The resource ID.
+	ID string `json:"id,omitempty"`
+
+	// The resource type.
+	Type string `json:"type,omitempty"`
+
+	// What deleting the cluster does to the resource.
+	Impact string `json:"impact,omitempty"`
+}
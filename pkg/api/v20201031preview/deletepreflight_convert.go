@@ -0,0 +1,71 @@
+package v20201031preview
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+type deletePreflightConverter struct{}
+
+// ToExternal returns a new external representation of the internal object,
+// reading from the subset of the internal object's fields that appear in the
+// external representation.  ToExternal does not modify its argument; there is
+// no pointer aliasing between the passed and returned objects.
+func (*deletePreflightConverter) ToExternal(dp *api.DeletePreflight) interface{} {
+	out := &DeletePreflight{
+		ID:   dp.ID,
+		Name: dp.Name,
+		Type: dp.Type,
+		Properties: DeletePreflightProperties{
+			CanDelete: dp.Properties.CanDelete,
+		},
+	}
+
+	if dp.Properties.BlockingConditions != nil {
+		out.Properties.BlockingConditions = make([]DeletePreflightCondition, 0, len(dp.Properties.BlockingConditions))
+		for _, c := range dp.Properties.BlockingConditions {
+			out.Properties.BlockingConditions = append(out.Properties.BlockingConditions, deletePreflightConditionToExternal(c))
+		}
+	}
+
+	if dp.Properties.Warnings != nil {
+		out.Properties.Warnings = make([]DeletePreflightCondition, 0, len(dp.Properties.Warnings))
+		for _, c := range dp.Properties.Warnings {
+			out.Properties.Warnings = append(out.Properties.Warnings, deletePreflightConditionToExternal(c))
+		}
+	}
+
+	if dp.Properties.DeletedResources != nil {
+		out.Properties.DeletedResources = make([]DeletePreflightResource, 0, len(dp.Properties.DeletedResources))
+		for _, r := range dp.Properties.DeletedResources {
+			out.Properties.DeletedResources = append(out.Properties.DeletedResources, deletePreflightResourceToExternal(r))
+		}
+	}
+
+	if dp.Properties.ModifiedResources != nil {
+		out.Properties.ModifiedResources = make([]DeletePreflightResource, 0, len(dp.Properties.ModifiedResources))
+		for _, r := range dp.Properties.ModifiedResources {
+			out.Properties.ModifiedResources = append(out.Properties.ModifiedResources, deletePreflightResourceToExternal(r))
+		}
+	}
+
+	return out
+}
+
+func deletePreflightConditionToExternal(c api.DeletePreflightCondition) DeletePreflightCondition {
+	return DeletePreflightCondition{
+		Code:       DeletePreflightConditionCode(c.Code),
+		Message:    c.Message,
+		ResourceID: c.ResourceID,
+	}
+}
+
+func deletePreflightResourceToExternal(r api.DeletePreflightResource) DeletePreflightResource {
+	return DeletePreflightResource{
+		ID:     r.ID,
+		Type:   r.Type,
+		Impact: r.Impact,
+	}
+}
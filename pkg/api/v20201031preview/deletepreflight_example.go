@@ -0,0 +1,43 @@
+package v20201031preview
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+// ExampleDeletePreflightResponse returns an example DeletePreflight object
+// that the RP might return to an end-user
+func ExampleDeletePreflightResponse() *DeletePreflight {
+	return &DeletePreflight{
+		ID:   "/subscriptions/subscriptionId/resourceGroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName/deletePreflight",
+		Name: "deletePreflight",
+		Type: "Microsoft.RedHatOpenShift/openShiftClusters/deletePreflight",
+		Properties: DeletePreflightProperties{
+			CanDelete: false,
+			BlockingConditions: []DeletePreflightCondition{
+				{
+					Code:       DeletePreflightConditionCodeDeleteProtected,
+					Message:    "The CanNotDelete lock 'protect' applies to the cluster. Remove the lock to delete the cluster.",
+					ResourceID: "/subscriptions/subscriptionId/resourceGroups/resourceGroup/providers/Microsoft.Authorization/locks/protect",
+				},
+			},
+			DeletedResources: []DeletePreflightResource{
+				{
+					ID:     "/subscriptions/subscriptionId/resourceGroups/clusterResourceGroup",
+					Type:   "Microsoft.Resources/resourceGroups",
+					Impact: "The managed resource group of the cluster is deleted with everything in it.",
+				},
+				{
+					ID:     "/subscriptions/subscriptionId/resourceGroups/clusterResourceGroup/providers/Microsoft.Compute/disks/cluster-dynamic-pvc-00000000-0000-0000-0000-000000000000",
+					Type:   "Microsoft.Compute/disks",
+					Impact: "A persistent volume: the data stored on it is lost.",
+				},
+			},
+			ModifiedResources: []DeletePreflightResource{
+				{
+					ID:     "/subscriptions/subscriptionId/resourceGroups/vnetResourceGroup/providers/Microsoft.Network/virtualNetworks/vnet/subnets/master",
+					Type:   "Microsoft.Network/virtualNetworks/subnets",
+					Impact: "The network security group of the cluster is detached from the subnet. The subnet itself is not deleted.",
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,53 @@
+package v20201031preview
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+type detectorConverter struct{}
+
+// ToExternal returns a new external representation of the internal object,
+// reading from the subset of the internal object's fields that appear in the
+// external representation.  ToExternal does not modify its argument; there is
+// no pointer aliasing between the passed and returned objects.
+func (*detectorConverter) ToExternal(d *api.Detector) interface{} {
+	out := &Detector{
+		ID:   d.ID,
+		Name: d.Name,
+		Type: d.Type,
+		Properties: DetectorProperties{
+			Title:       d.Properties.Title,
+			Description: d.Properties.Description,
+			Status:      DetectorStatus(d.Properties.Status),
+		},
+	}
+
+	if d.Properties.Findings != nil {
+		out.Properties.Findings = make([]DetectorFinding, 0, len(d.Properties.Findings))
+		for _, f := range d.Properties.Findings {
+			out.Properties.Findings = append(out.Properties.Findings, DetectorFinding{
+				Message:     f.Message,
+				Remediation: f.Remediation,
+			})
+		}
+	}
+
+	return out
+}
+
+// ToExternalList returns a slice of external representations of the internal
+// objects
+func (c *detectorConverter) ToExternalList(ds []*api.Detector) interface{} {
+	l := &DetectorList{
+		Detectors: make([]*Detector, 0, len(ds)),
+	}
+
+	for _, d := range ds {
+		l.Detectors = append(l.Detectors, c.ToExternal(d).(*Detector))
+	}
+
+	return l
+}
@@ -0,0 +1,39 @@
+package v20201031preview
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+// ExampleDetectorResponse returns an example Detector object that the RP might
+// return to an end-user
+func ExampleDetectorResponse() *Detector {
+	return &Detector{
+		ID:   "/subscriptions/subscriptionId/resourceGroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName/detectors/nodehealth",
+		Name: "nodehealth",
+		Type: "Microsoft.RedHatOpenShift/openShiftClusters/detectors",
+		Properties: DetectorProperties{
+			Title:       "Node health",
+			Description: "Checks that all cluster nodes are ready and not under resource pressure.",
+			Status:      DetectorStatusUnhealthy,
+			Findings: []DetectorFinding{
+				{
+					Message:     "Node 'worker-1' is not ready.",
+					Remediation: "Check the status of the virtual machine backing the node in the cluster resource group.",
+				},
+			},
+		},
+	}
+}
+
+// ExampleDetectorListResponse returns an example DetectorList object that the
+// RP might return to an end-user
+func ExampleDetectorListResponse() *DetectorList {
+	d := ExampleDetectorResponse()
+	d.Properties.Status = ""
+	d.Properties.Findings = nil
+
+	return &DetectorList{
+		Detectors: []*Detector{
+			d,
+		},
+	}
+}
@@ -0,0 +1,42 @@
+package v20201031preview
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+// Egress represents the egress of an OpenShift cluster which a customer
+// firewall must allow.
+type Egress struct {
+	// The resource ID.
+	ID string `json:"id,omitempty"`
+
+	// The resource name.
+	Name string `json:"name,omitempty"`
+
+	// The resource type.
+	Type string `json:"type,omitempty"`
+
+	// The egress properties.
+	Properties EgressProperties `json:"properties,omitempty"`
+}
+
+// EgressProperties represents the properties of the egress of a cluster.
+type EgressProperties struct {
+	// The public IPs from which the outbound connections of the cluster
+	// originate.
+	OutboundIPs []string `json:"outboundIps,omitempty"`
+
+	// The endpoints which the cluster must be able to reach.
+	RequiredEndpoints []EgressEndpoint `json:"requiredEndpoints,omitempty"`
+}
+
+// EgressEndpoint represents an endpoint which a cluster must be able to reach.
+type EgressEndpoint struct {
+	// The host name of the endpoint.
+	Host string `json:"host,omitempty"`
+
+	// The TCP port of the endpoint.
+	Port int `json:"port,omitempty"`
+
+	// What the cluster uses the endpoint for.
+	Purpose string `json:"purpose,omitempty"`
+}
@@ -0,0 +1,40 @@
+package v20201031preview
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+type egressConverter struct{}
+
+// ToExternal returns a new external representation of the internal object,
+// reading from the subset of the internal object's fields that appear in the
+// external representation.  ToExternal does not modify its argument; there is
+// no pointer aliasing between the passed and returned objects.
+func (*egressConverter) ToExternal(e *api.Egress) interface{} {
+	out := &Egress{
+		ID:   e.ID,
+		Name: e.Name,
+		Type: e.Type,
+	}
+
+	if e.Properties.OutboundIPs != nil {
+		out.Properties.OutboundIPs = make([]string, len(e.Properties.OutboundIPs))
+		copy(out.Properties.OutboundIPs, e.Properties.OutboundIPs)
+	}
+
+	if e.Properties.RequiredEndpoints != nil {
+		out.Properties.RequiredEndpoints = make([]EgressEndpoint, 0, len(e.Properties.RequiredEndpoints))
+		for _, ep := range e.Properties.RequiredEndpoints {
+			out.Properties.RequiredEndpoints = append(out.Properties.RequiredEndpoints, EgressEndpoint{
+				Host:    ep.Host,
+				Port:    ep.Port,
+				Purpose: ep.Purpose,
+			})
+		}
+	}
+
+	return out
+}
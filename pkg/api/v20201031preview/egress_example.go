@@ -0,0 +1,31 @@
+package v20201031preview
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+// ExampleEgressResponse returns an example Egress object that the RP might
+// return to an end-user
+func ExampleEgressResponse() *Egress {
+	return &Egress{
+		ID:   "/subscriptions/subscriptionId/resourceGroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName/egress",
+		Name: "egress",
+		Type: "Microsoft.RedHatOpenShift/openShiftClusters/egress",
+		Properties: EgressProperties{
+			OutboundIPs: []string{
+				"20.0.0.1",
+			},
+			RequiredEndpoints: []EgressEndpoint{
+				{
+					Host:    "arosvc.azurecr.io",
+					Port:    443,
+					Purpose: "Azure Red Hat OpenShift and OpenShift container images",
+				},
+				{
+					Host:    "login.microsoftonline.com",
+					Port:    443,
+					Purpose: "Azure Active Directory authentication",
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,60 @@
+package v20201031preview
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"time"
+)
+
+// FailureSummary represents the summary of the last failed install or update
+// of an OpenShift cluster.
+type FailureSummary struct {
+	// The resource ID.
+	ID string `json:"id,omitempty"`
+
+	// The resource name.
+	Name string `json:"name,omitempty"`
+
+	// The resource type.
+	Type string `json:"type,omitempty"`
+
+	// The failure summary properties.
+	Properties FailureSummaryProperties `json:"properties,omitempty"`
+}
+
+// FailureCategory represents the part of an install or update which failed.
+type FailureCategory string
+
+// FailureCategory constants.
+const (
+	FailureCategoryConfiguration    FailureCategory = "Configuration"
+	FailureCategoryQuota            FailureCategory = "Quota"
+	FailureCategoryAzureResources   FailureCategory = "AzureResources"
+	FailureCategoryNetworking       FailureCategory = "Networking"
+	FailureCategoryClusterBootstrap FailureCategory = "ClusterBootstrap"
+	FailureCategoryClusterOperators FailureCategory = "ClusterOperators"
+	FailureCategoryNodes            FailureCategory = "Nodes"
+	FailureCategoryInternal         FailureCategory = "Internal"
+)
+
+// FailureSummaryProperties represents the properties of a failure summary.
+type FailureSummaryProperties struct {
+	// The operation which failed.
+	Operation ProvisioningState `json:"operation,omitempty"`
+
+	// When the operation failed.
+	FailedAt time.Time `json:"failedAt,omitempty"`
+
+	// The part of the operation which failed.
+	Category FailureCategory `json:"category,omitempty"`
+
+	// The error code.
+	Code string `json:"code,omitempty"`
+
+	// A message describing the failure and how to remedy it.
+	Message string `json:"message,omitempty"`
+
+	// The code of the underlying Azure error, if any.
+	AzureErrorCode string `json:"azureErrorCode,omitempty"`
+}
@@ -0,0 +1,30 @@
+package v20201031preview
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+type failureSummaryConverter struct{}
+
+// ToExternal returns a new external representation of the internal object,
+// reading from the subset of the internal object's fields that appear in the
+// external representation.  ToExternal does not modify its argument; there is
+// no pointer aliasing between the passed and returned objects.
+func (*failureSummaryConverter) ToExternal(fs *api.FailureSummary) interface{} {
+	return &FailureSummary{
+		ID:   fs.ID,
+		Name: fs.Name,
+		Type: fs.Type,
+		Properties: FailureSummaryProperties{
+			Operation:      ProvisioningState(fs.Properties.Operation),
+			FailedAt:       fs.Properties.FailedAt,
+			Category:       FailureCategory(fs.Properties.Category),
+			Code:           fs.Properties.Code,
+			Message:        fs.Properties.Message,
+			AzureErrorCode: fs.Properties.AzureErrorCode,
+		},
+	}
+}
@@ -0,0 +1,26 @@
+package v20201031preview
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"time"
+)
+
+// ExampleFailureSummaryResponse returns an example FailureSummary object that
+// the RP might return to an end-user
+func ExampleFailureSummaryResponse() *FailureSummary {
+	return &FailureSummary{
+		ID:   "/subscriptions/subscriptionId/resourceGroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName/failureSummary",
+		Name: "failureSummary",
+		Type: "Microsoft.RedHatOpenShift/openShiftClusters/failureSummary",
+		Properties: FailureSummaryProperties{
+			Operation:      ProvisioningStateCreating,
+			FailedAt:       time.Date(2020, time.November, 1, 12, 0, 0, 0, time.UTC),
+			Category:       FailureCategoryQuota,
+			Code:           "DeploymentFailed",
+			Message:        "Deployment failed.",
+			AzureErrorCode: "QuotaExceeded",
+		},
+	}
+}
@@ -3,6 +3,10 @@ package v20201031preview
 // Copyright (c) Microsoft Corporation.
 // Licensed under the Apache License 2.0.
 
+import (
+	"time"
+)
+
 // OpenShiftClusterList represents a list of OpenShift clusters.
 type OpenShiftClusterList struct {
 	// The list of OpenShift clusters.
@@ -41,6 +45,10 @@ type OpenShiftClusterProperties struct {
 	// The cluster provisioning state (immutable).
 	ProvisioningState ProvisioningState `json:"provisioningState,omitempty"`
 
+	// The progress of the operation which is in progress, if any
+	// (immutable).
+	Progress *ProvisioningProgress `json:"progress,omitempty"`
+
 	// The cluster profile.
 	ClusterProfile ClusterProfile `json:"clusterProfile,omitempty"`
 
@@ -64,6 +72,18 @@ type OpenShiftClusterProperties struct {
 
 	// The cluster ingress profiles.
 	IngressProfiles []IngressProfile `json:"ingressProfiles,omitempty"`
+
+	// The cluster autoscaler profile.  The cluster autoscaler is enabled if
+	// this is set.
+	AutoscalerProfile *AutoscalerProfile `json:"autoscalerProfile,omitempty" mutable:"true"`
+
+	// The cluster maintenance profile.  If set, the cluster is only maintained
+	// within its windows.
+	MaintenanceProfile *MaintenanceProfile `json:"maintenanceProfile,omitempty" mutable:"true"`
+
+	// The cluster encryption at rest profile.  If set, the cluster's secrets
+	// are encrypted at rest with the given customer-managed key (immutable).
+	EncryptionAtRestProfile *EncryptionAtRestProfile `json:"encryptionAtRestProfile,omitempty"`
 }
 
 // ProvisioningState represents a provisioning state.
@@ -79,6 +99,18 @@ const (
 	ProvisioningStateFailed        ProvisioningState = "Failed"
 )
 
+// ProvisioningProgress represents the progress of a long running operation.
+type ProvisioningProgress struct {
+	// The phase of the operation which is running.
+	Phase string `json:"phase,omitempty"`
+
+	// An estimate of the percentage of the operation which is complete.
+	PercentComplete int `json:"percentComplete,omitempty"`
+
+	// The time at which the operation last started a new step.
+	LastTransitionTime time.Time `json:"lastTransitionTime,omitempty"`
+}
+
 // ClusterProfile represents a cluster profile.
 type ClusterProfile struct {
 	// The pull secret for the cluster (immutable).
@@ -92,6 +124,10 @@ type ClusterProfile struct {
 
 	// The ID of the cluster resource group (immutable).
 	ResourceGroupID string `json:"resourceGroupId,omitempty"`
+
+	// An SSH public key in authorized_keys format to install on the cluster
+	// nodes, in addition to the RP-managed key (immutable).
+	SSHPublicKey string `json:"sshPublicKey,omitempty"`
 }
 
 // ConsoleProfile represents a console profile.
@@ -116,6 +152,28 @@ type NetworkProfile struct {
 
 	// The CIDR used for OpenShift/Kubernetes Services (immutable).
 	ServiceCIDR string `json:"serviceCidr,omitempty"`
+
+	// The CIDR containing the master and worker subnets (immutable).
+	MachineCIDR string `json:"machineCidr,omitempty"`
+
+	// The prefix length of the subnet of the pod CIDR allocated to each node.  Must be between 23 and 26 (immutable).
+	HostPrefix int `json:"hostPrefix,omitempty"`
+
+	// The configuration of the public load balancer of the cluster.
+	LoadBalancerProfile *LoadBalancerProfile `json:"loadBalancerProfile,omitempty" mutable:"true"`
+}
+
+// LoadBalancerProfile represents the configuration of the public load balancer.
+type LoadBalancerProfile struct {
+	// The public IPs which are managed for the outbound connections of the cluster.
+	ManagedOutboundIPs *ManagedOutboundIPs `json:"managedOutboundIps,omitempty"`
+}
+
+// ManagedOutboundIPs represents the managed outbound public IPs.
+type ManagedOutboundIPs struct {
+	// The number of outbound public IPs.  Each IP provides SNAT ports for the
+	// outbound connections of the cluster.  Must be between 1 and 20.
+	Count int `json:"count,omitempty"`
 }
 
 // MasterProfile represents a master profile.
@@ -125,8 +183,21 @@ type MasterProfile struct {
 
 	// The Azure resource ID of the master subnet (immutable).
 	SubnetID string `json:"subnetId,omitempty"`
+
+	// The security type of the master VMs.  TrustedLaunch VMs boot with
+	// secure boot and a virtual TPM, and require a Gen2 VM size (immutable).
+	SecurityType SecurityType `json:"securityType,omitempty"`
 }
 
+// SecurityType represents the security type of the VMs of a profile.
+type SecurityType string
+
+// SecurityType constants.
+const (
+	SecurityTypeStandard      SecurityType = "Standard"
+	SecurityTypeTrustedLaunch SecurityType = "TrustedLaunch"
+)
+
 // VMSize represents a VM size.
 type VMSize string
 
@@ -153,11 +224,23 @@ const (
 	VMSizeStandardF8sV2  VMSize = "Standard_F8s_v2"
 	VMSizeStandardF16sV2 VMSize = "Standard_F16s_v2"
 	VMSizeStandardF32sV2 VMSize = "Standard_F32s_v2"
+
+	// arm64 sizes, only valid for additional worker profiles
+	VMSizeStandardD4psV5  VMSize = "Standard_D4ps_v5"
+	VMSizeStandardD8psV5  VMSize = "Standard_D8ps_v5"
+	VMSizeStandardD16psV5 VMSize = "Standard_D16ps_v5"
+	VMSizeStandardD32psV5 VMSize = "Standard_D32ps_v5"
+
+	VMSizeStandardE4psV5  VMSize = "Standard_E4ps_v5"
+	VMSizeStandardE8psV5  VMSize = "Standard_E8ps_v5"
+	VMSizeStandardE16psV5 VMSize = "Standard_E16ps_v5"
+	VMSizeStandardE32psV5 VMSize = "Standard_E32ps_v5"
 )
 
 // WorkerProfile represents a worker profile.
 type WorkerProfile struct {
-	// The worker profile name.  Must be "worker" (immutable).
+	// The worker profile name.  Must be "worker" for the worker profile
+	// specified at cluster creation (immutable).
 	Name string `json:"name,omitempty"`
 
 	// The size of the worker VMs (immutable).
@@ -171,8 +254,43 @@ type WorkerProfile struct {
 
 	// The number of worker VMs.  Must be between 3 and 20 (immutable).
 	Count int `json:"count,omitempty"`
+
+	// The security type of the worker VMs.  Only Standard is supported:
+	// trusted launch is only supported for master VMs (immutable).
+	SecurityType SecurityType `json:"securityType,omitempty"`
+
+	// The labels which are set on the worker nodes.  Keys in the
+	// kubernetes.io, k8s.io and openshift.io namespaces are reserved.
+	NodeLabels map[string]string `json:"nodeLabels,omitempty" mutable:"true"`
+
+	// The taints which are set on the worker nodes.  The worker profile
+	// specified at cluster creation only accepts PreferNoSchedule taints, as
+	// the cluster components must remain schedulable on it.
+	NodeTaints []NodeTaint `json:"nodeTaints,omitempty" mutable:"true"`
 }
 
+// NodeTaint represents a taint on the nodes of a worker profile.
+type NodeTaint struct {
+	// The taint key.
+	Key string `json:"key,omitempty"`
+
+	// The taint value.
+	Value string `json:"value,omitempty"`
+
+	// The taint effect.
+	Effect TaintEffect `json:"effect,omitempty"`
+}
+
+// TaintEffect represents the effect of a node taint.
+type TaintEffect string
+
+// TaintEffect constants.
+const (
+	TaintEffectNoSchedule       TaintEffect = "NoSchedule"
+	TaintEffectPreferNoSchedule TaintEffect = "PreferNoSchedule"
+	TaintEffectNoExecute        TaintEffect = "NoExecute"
+)
+
 // APIServerProfile represents an API server profile.
 type APIServerProfile struct {
 	// API server visibility (immutable).
@@ -183,6 +301,10 @@ type APIServerProfile struct {
 
 	// The IP of the cluster API server (immutable).
 	IP string `json:"ip,omitempty"`
+
+	// The resource ID of a public IP address to use for the cluster API
+	// server instead of one managed by the resource provider (immutable).
+	PublicIPAddressID string `json:"publicIpAddressId,omitempty"`
 }
 
 // Visibility represents visibility.
@@ -204,4 +326,83 @@ type IngressProfile struct {
 
 	// The IP of the ingress (immutable).
 	IP string `json:"ip,omitempty"`
+
+	// The resource ID of a public IP address to use for the ingress instead
+	// of one managed by the cluster (immutable).
+	PublicIPAddressID string `json:"publicIpAddressId,omitempty"`
+}
+
+// AutoscalerProfile represents the configuration of the cluster autoscaler.
+type AutoscalerProfile struct {
+	// The maximum number of nodes, including masters, in the cluster.  Zero
+	// means no limit.
+	MaxNodesTotal int `json:"maxNodesTotal,omitempty"`
+
+	// How long after a scale up before scale down evaluation resumes, e.g.
+	// "10m".
+	ScaleDownDelayAfterAdd string `json:"scaleDownDelayAfterAdd,omitempty"`
+
+	// How long a node should be unneeded before it is eligible for scale down,
+	// e.g. "5m".
+	ScaleDownUnneededTime string `json:"scaleDownUnneededTime,omitempty"`
+
+	// The worker profiles which the cluster autoscaler scales.
+	Pools []AutoscalerPool `json:"pools,omitempty"`
+}
+
+// AutoscalerPool represents the scaling bounds of a worker profile.
+type AutoscalerPool struct {
+	// The name of the worker profile.
+	Name string `json:"name,omitempty"`
+
+	// The minimum number of worker VMs of the worker profile.
+	MinReplicas int `json:"minReplicas,omitempty"`
+
+	// The maximum number of worker VMs of the worker profile.
+	MaxReplicas int `json:"maxReplicas,omitempty"`
+}
+
+// MaintenanceProfile represents when the cluster may be maintained.
+type MaintenanceProfile struct {
+	// The weekly windows in which the cluster may be maintained.  If empty,
+	// the cluster may be maintained on any day which is not excluded.
+	Windows []MaintenanceWindow `json:"windows,omitempty"`
+
+	// The days of the week, e.g. "Saturday", on which the cluster must not be
+	// maintained.
+	ExcludedDays []string `json:"excludedDays,omitempty"`
+}
+
+// EncryptionAtRestProfile represents the customer-managed key with which the
+// cluster's secrets are encrypted at rest.
+type EncryptionAtRestProfile struct {
+	// The versioned ID of the Key Vault key which wraps the cluster's data
+	// key (immutable).
+	KeyVaultKeyID string `json:"keyVaultKeyId,omitempty"`
+
+	// Whether the resource provider can currently use the key (immutable).
+	KeyState EncryptionKeyState `json:"keyState,omitempty"`
+}
+
+// EncryptionKeyState represents whether the resource provider can use a
+// customer-managed key.
+type EncryptionKeyState string
+
+// EncryptionKeyState constants.
+const (
+	EncryptionKeyStateAccessible   EncryptionKeyState = "Accessible"
+	EncryptionKeyStateInaccessible EncryptionKeyState = "Inaccessible"
+)
+
+// MaintenanceWindow represents a weekly maintenance window.  Times are in
+// UTC.
+type MaintenanceWindow struct {
+	// The day of the week, e.g. "Monday", on which the window starts.
+	Day string `json:"day,omitempty"`
+
+	// The hour of the day, 0-23, at which the window starts.
+	StartHour int `json:"startHour,omitempty"`
+
+	// The length of the window in hours, 4-24.
+	DurationHours int `json:"durationHours,omitempty"`
 }
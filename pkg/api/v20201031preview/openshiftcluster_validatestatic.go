@@ -4,30 +4,38 @@ package v20201031preview
 // Licensed under the Apache License 2.0.
 
 import (
+	"fmt"
 	"net"
 	"net/http"
 	"net/url"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/apparentlymart/go-cidr/cidr"
 	uuid "github.com/satori/go.uuid"
+	"golang.org/x/crypto/ssh"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/api/validate"
 	"github.com/Azure/ARO-RP/pkg/util/deployment"
 	"github.com/Azure/ARO-RP/pkg/util/immutable"
+	"github.com/Azure/ARO-RP/pkg/util/keyvault"
 	"github.com/Azure/ARO-RP/pkg/util/pullsecret"
 	"github.com/Azure/ARO-RP/pkg/util/subnet"
 	"github.com/Azure/ARO-RP/pkg/util/version"
 )
 
 type openShiftClusterStaticValidator struct {
-	location       string
-	domain         string
-	deploymentMode deployment.Mode
-	resourceID     string
+	location     string
+	domain       string
+	capabilities deployment.Capabilities
+	resourceID   string
 
-	r azure.Resource
+	r                  azure.Resource
+	workerProfileNames map[string]struct{}
 }
 
 // Validate validates an OpenShift cluster
@@ -45,6 +53,15 @@ func (sv *openShiftClusterStaticValidator) Static(_oc interface{}, _current *api
 		return err
 	}
 
+	// autoscaler pools can scale the worker profile created with the cluster
+	// and any worker profile added since
+	sv.workerProfileNames = map[string]struct{}{"worker": {}}
+	if _current != nil {
+		for _, wp := range _current.Properties.AdditionalWorkerProfiles {
+			sv.workerProfileNames[wp.Name] = struct{}{}
+		}
+	}
+
 	err = sv.validate(oc, current == nil)
 	if err != nil {
 		return err
@@ -70,6 +87,11 @@ func (sv *openShiftClusterStaticValidator) validate(oc *OpenShiftCluster, isCrea
 	if !strings.EqualFold(oc.Location, sv.location) {
 		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "location", "The provided location '%s' is invalid.", oc.Location)
 	}
+	if isCreate {
+		if err := validate.ClusterName(oc.Name); err != nil {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "name", "The provided resource name '%s' is invalid: %s.", oc.Name, err)
+		}
+	}
 
 	return sv.validateProperties("properties", &oc.Properties, isCreate)
 }
@@ -101,9 +123,20 @@ func (sv *openShiftClusterStaticValidator) validateProperties(path string, p *Op
 		if len(p.WorkerProfiles) != 1 {
 			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".workerProfiles", "There should be exactly one worker profile.")
 		}
-		if err := sv.validateWorkerProfile(path+".workerProfiles['"+p.WorkerProfiles[0].Name+"']", &p.WorkerProfiles[0], &p.MasterProfile); err != nil {
+		if p.WorkerProfiles[0].Name != "worker" {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".workerProfiles['"+p.WorkerProfiles[0].Name+"'].name", "The provided worker name '%s' is invalid.", p.WorkerProfiles[0].Name)
+		}
+		if err := sv.validateWorkerProfile(path+".workerProfiles['"+p.WorkerProfiles[0].Name+"']", &p.WorkerProfiles[0], &p.MasterProfile, false); err != nil {
 			return err
 		}
+	} else {
+		// the node labels and taints are the only worker profile fields which
+		// can change after creation
+		for i := range p.WorkerProfiles {
+			if err := sv.validateWorkerProfileNodeMetadata(path+".workerProfiles['"+p.WorkerProfiles[i].Name+"']", &p.WorkerProfiles[i]); err != nil {
+				return err
+			}
+		}
 	}
 	if err := sv.validateAPIServerProfile(path+".apiserverProfile", &p.APIServerProfile); err != nil {
 		return err
@@ -114,6 +147,25 @@ func (sv *openShiftClusterStaticValidator) validateProperties(path string, p *Op
 	if err := sv.validateIngressProfile(path+".ingressProfiles['"+p.IngressProfiles[0].Name+"']", &p.IngressProfiles[0]); err != nil {
 		return err
 	}
+	if p.APIServerProfile.PublicIPAddressID != "" &&
+		strings.EqualFold(p.APIServerProfile.PublicIPAddressID, p.IngressProfiles[0].PublicIPAddressID) {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".ingressProfiles['"+p.IngressProfiles[0].Name+"'].publicIpAddressId", "The provided public IP address '%s' is invalid: must not be the public IP address of the API server.", p.IngressProfiles[0].PublicIPAddressID)
+	}
+	if p.AutoscalerProfile != nil {
+		if err := sv.validateAutoscalerProfile(path+".autoscalerProfile", p.AutoscalerProfile); err != nil {
+			return err
+		}
+	}
+	if p.MaintenanceProfile != nil {
+		if err := sv.validateMaintenanceProfile(path+".maintenanceProfile", p.MaintenanceProfile); err != nil {
+			return err
+		}
+	}
+	if p.EncryptionAtRestProfile != nil {
+		if err := sv.validateEncryptionAtRestProfile(path+".encryptionAtRestProfile", p.EncryptionAtRestProfile); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -122,30 +174,45 @@ func (sv *openShiftClusterStaticValidator) validateClusterProfile(path string, c
 	if pullsecret.Validate(cp.PullSecret) != nil {
 		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".pullSecret", "The provided pull secret is invalid.")
 	}
-	if !validate.RxDomainName.MatchString(cp.Domain) {
-		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".domain", "The provided domain '%s' is invalid.", cp.Domain)
+	if err := validate.Domain(cp.Domain, sv.domain); err != nil {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".domain", "The provided domain '%s' is invalid: %s.", cp.Domain, err)
 	}
-	// domain ends .aroapp.io, but doesn't end .<rp-location>.aroapp.io
-	if strings.HasSuffix(cp.Domain, "."+strings.SplitN(sv.domain, ".", 2)[1]) &&
-		!strings.HasSuffix(cp.Domain, "."+sv.domain) {
-		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".domain", "The provided domain '%s' is invalid.", cp.Domain)
-	}
-	// domain is of form multiple.names.<rp-location>.aroapp.io
-	if strings.HasSuffix(cp.Domain, "."+sv.domain) &&
-		strings.ContainsRune(strings.TrimSuffix(cp.Domain, "."+sv.domain), '.') {
-		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".domain", "The provided domain '%s' is invalid.", cp.Domain)
+	if isCreate {
+		if err := validate.DomainLength(cp.Domain, sv.domain); err != nil {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".domain", "The provided domain '%s' is invalid: %s.", cp.Domain, err)
+		}
 	}
 
-	if isCreate && cp.Version != version.InstallStream.Version.String() {
-		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".version", "The provided version '%s' is invalid.", cp.Version)
+	// whether the version is installable is validated against the versions
+	// in the database by the frontend
+	if isCreate {
+		v, err := version.ParseVersion(cp.Version)
+		if err != nil || v.Suffix != "" {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".version", "The provided version '%s' is invalid.", cp.Version)
+		}
 	}
 
-	if !validate.RxResourceGroupID.MatchString(cp.ResourceGroupID) {
-		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".resourceGroupId", "The provided resource group '%s' is invalid.", cp.ResourceGroupID)
+	if err := validate.ResourceGroupID(cp.ResourceGroupID); err != nil {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".resourceGroupId", "The provided resource group '%s' is invalid: %s.", cp.ResourceGroupID, err)
 	}
 	if strings.Split(cp.ResourceGroupID, "/")[2] != sv.r.SubscriptionID {
 		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".resourceGroupId", "The provided resource group '%s' is invalid: must be in same subscription as cluster.", cp.ResourceGroupID)
 	}
+	// the cluster resource group is deleted with the cluster and locked by a
+	// deny assignment, so it must never be the customer's own resource group
+	if isCreate && strings.EqualFold(strings.Split(cp.ResourceGroupID, "/")[4], sv.r.ResourceGroup) {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".resourceGroupId", "The provided resource group '%s' is invalid: must be different from resourceGroup of the OpenShift Cluster object.", cp.ResourceGroupID)
+	}
+
+	if cp.SSHPublicKey != "" {
+		// exactly one key in authorized_keys format, without options: the key
+		// is passed through to install config, which renders it verbatim into
+		// the nodes' authorized_keys
+		_, _, options, rest, err := ssh.ParseAuthorizedKey([]byte(cp.SSHPublicKey))
+		if err != nil || len(options) > 0 || len(strings.TrimSpace(string(rest))) > 0 {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".sshPublicKey", "The provided SSH public key is invalid.")
+		}
+	}
 
 	return nil
 }
@@ -199,12 +266,42 @@ func (sv *openShiftClusterStaticValidator) validateNetworkProfile(path string, n
 			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".serviceCidr", "The provided vnet CIDR '%s' is invalid: must be /22 or larger.", np.ServiceCIDR)
 		}
 	}
+	if np.MachineCIDR != "" {
+		_, machine, err := net.ParseCIDR(np.MachineCIDR)
+		if err != nil {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".machineCidr", "The provided machine CIDR '%s' is invalid: '%s'.", np.MachineCIDR, err)
+		}
+		if machine.IP.To4() == nil {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".machineCidr", "The provided machine CIDR '%s' is invalid: must be IPv4.", np.MachineCIDR)
+		}
+		{
+			// the machine CIDR must have room for a master and a worker
+			// subnet, each of which is at least a /27
+			ones, _ := machine.Mask.Size()
+			if ones > 26 {
+				return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".machineCidr", "The provided machine CIDR '%s' is invalid: must be /26 or larger.", np.MachineCIDR)
+			}
+		}
+		err = cidr.VerifyNoOverlap([]*net.IPNet{machine, pod, service}, &net.IPNet{IP: net.IPv4zero, Mask: net.IPMask(net.IPv4zero)})
+		if err != nil {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".machineCidr", "The provided machine CIDR '%s' is invalid: must not overlap the pod or service CIDR.", np.MachineCIDR)
+		}
+	}
+	if np.HostPrefix != 0 && (np.HostPrefix < 23 || np.HostPrefix > 26) {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".hostPrefix", "The provided host prefix '%d' is invalid: must be between 23 and 26.", np.HostPrefix)
+	}
+	if np.LoadBalancerProfile != nil && np.LoadBalancerProfile.ManagedOutboundIPs != nil {
+		count := np.LoadBalancerProfile.ManagedOutboundIPs.Count
+		if count < 1 || count > 20 {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".loadBalancerProfile.managedOutboundIps.count", "The provided managed outbound IP count '%d' is invalid: must be between 1 and 20.", count)
+		}
+	}
 
 	return nil
 }
 
 func (sv *openShiftClusterStaticValidator) validateMasterProfile(path string, mp *MasterProfile) error {
-	if !validate.VMSizeIsValid(api.VMSize(mp.VMSize), sv.deploymentMode, true) {
+	if !validate.InstallProfileFor(sv.capabilities).MasterVMSizeIsValid(api.VMSize(mp.VMSize)) {
 		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".vmSize", "The provided master VM size '%s' is invalid.", mp.VMSize)
 	}
 	if !validate.RxSubnetID.MatchString(mp.SubnetID) {
@@ -218,17 +315,35 @@ func (sv *openShiftClusterStaticValidator) validateMasterProfile(path string, mp
 		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".subnetId", "The provided master VM subnet '%s' is invalid: must be in same subscription as cluster.", mp.SubnetID)
 	}
 
-	return nil
+	return sv.validateSecurityType(path, mp.SecurityType, mp.VMSize)
 }
 
-func (sv *openShiftClusterStaticValidator) validateWorkerProfile(path string, wp *WorkerProfile, mp *MasterProfile) error {
-	if wp.Name != "worker" {
-		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".name", "The provided worker name '%s' is invalid.", wp.Name)
+// validateSecurityType validates the security type of a master or worker
+// profile and that its VM size is compatible with it
+func (sv *openShiftClusterStaticValidator) validateSecurityType(path string, securityType SecurityType, vmSize VMSize) error {
+	switch securityType {
+	case "", SecurityTypeStandard:
+	case SecurityTypeTrustedLaunch:
+		if !validate.VMSizeSupportsTrustedLaunch(api.VMSize(vmSize)) {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".securityType", "The provided security type '%s' is invalid: VM size '%s' does not support trusted launch.", securityType, vmSize)
+		}
+	default:
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".securityType", "The provided security type '%s' is invalid.", securityType)
 	}
-	if !validate.VMSizeIsValid(api.VMSize(wp.VMSize), sv.deploymentMode, false) {
+
+	return nil
+}
+
+// validateWorkerProfile validates wp.  arm64 VM sizes are only accepted if
+// allowArm64 is set, which is the case for additional worker profiles.
+func (sv *openShiftClusterStaticValidator) validateWorkerProfile(path string, wp *WorkerProfile, mp *MasterProfile, allowArm64 bool) error {
+	profile := validate.InstallProfileFor(sv.capabilities)
+
+	if !profile.WorkerVMSizeIsValid(api.VMSize(wp.VMSize)) &&
+		!(allowArm64 && profile.AllowArm64 && validate.VMSizeIsArm64(api.VMSize(wp.VMSize))) {
 		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".vmSize", "The provided worker VM size '%s' is invalid.", wp.VMSize)
 	}
-	if !validate.DiskSizeIsValid(wp.DiskSizeGB) {
+	if !profile.DiskSizeIsValid(wp.DiskSizeGB) {
 		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".diskSizeGB", "The provided worker disk size '%d' is invalid.", wp.DiskSizeGB)
 	}
 	if !validate.RxSubnetID.MatchString(wp.SubnetID) {
@@ -248,13 +363,89 @@ func (sv *openShiftClusterStaticValidator) validateWorkerProfile(path string, wp
 	if strings.EqualFold(mp.SubnetID, wp.SubnetID) {
 		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".subnetId", "The provided worker VM subnet '%s' is invalid: must be different to master VM subnet '%s'.", wp.SubnetID, mp.SubnetID)
 	}
-	if wp.Count < 3 || wp.Count > 20 {
+	if !profile.WorkerCountIsValid(wp.Count) {
 		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".count", "The provided worker count '%d' is invalid.", wp.Count)
 	}
+	// the machine API's Azure actuator ignores the security profile of a
+	// machine, so only the master VMs, which the RP deploys itself, can use
+	// trusted launch
+	if wp.SecurityType == SecurityTypeTrustedLaunch {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".securityType", "The provided security type '%s' is invalid: trusted launch is only supported for master VMs.", wp.SecurityType)
+	}
+	if err := sv.validateSecurityType(path, wp.SecurityType, wp.VMSize); err != nil {
+		return err
+	}
+
+	return sv.validateWorkerProfileNodeMetadata(path, wp)
+}
+
+// validateWorkerProfileNodeMetadata validates the node labels and taints of
+// wp.  The worker profile specified at cluster creation hosts the cluster
+// components, so it only accepts taints which leave its nodes schedulable.
+func (sv *openShiftClusterStaticValidator) validateWorkerProfileNodeMetadata(path string, wp *WorkerProfile) error {
+	keys := make([]string, 0, len(wp.NodeLabels))
+	for k := range wp.NodeLabels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		v := wp.NodeLabels[k]
+		if len(validation.IsQualifiedName(k)) > 0 || isReservedNodeMetadataKey(k) {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".nodeLabels", "The provided node label key '%s' is invalid.", k)
+		}
+		if len(validation.IsValidLabelValue(v)) > 0 {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".nodeLabels['"+k+"']", "The provided node label value '%s' is invalid.", v)
+		}
+	}
+
+	taints := map[string]struct{}{}
+	for i, t := range wp.NodeTaints {
+		taintPath := fmt.Sprintf("%s.nodeTaints[%d]", path, i)
+
+		if len(validation.IsQualifiedName(t.Key)) > 0 || isReservedNodeMetadataKey(t.Key) {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, taintPath+".key", "The provided node taint key '%s' is invalid.", t.Key)
+		}
+		if len(validation.IsValidLabelValue(t.Value)) > 0 {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, taintPath+".value", "The provided node taint value '%s' is invalid.", t.Value)
+		}
+
+		switch t.Effect {
+		case TaintEffectPreferNoSchedule:
+		case TaintEffectNoSchedule, TaintEffectNoExecute:
+			if wp.Name == "worker" {
+				return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, taintPath+".effect", "The provided node taint effect '%s' is invalid: the worker profile only accepts '%s'.", t.Effect, TaintEffectPreferNoSchedule)
+			}
+		default:
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, taintPath+".effect", "The provided node taint effect '%s' is invalid.", t.Effect)
+		}
+
+		if _, found := taints[t.Key+":"+string(t.Effect)]; found {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, taintPath, "The provided node taint '%s:%s' is duplicated.", t.Key, t.Effect)
+		}
+		taints[t.Key+":"+string(t.Effect)] = struct{}{}
+	}
 
 	return nil
 }
 
+// isReservedNodeMetadataKey returns true if key is in a namespace which
+// Kubernetes or OpenShift manage on the nodes
+func isReservedNodeMetadataKey(key string) bool {
+	parts := strings.SplitN(key, "/", 2)
+	if len(parts) < 2 {
+		return false
+	}
+
+	for _, domain := range []string{"kubernetes.io", "k8s.io", "openshift.io"} {
+		if parts[0] == domain || strings.HasSuffix(parts[0], "."+domain) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (sv *openShiftClusterStaticValidator) validateAPIServerProfile(path string, ap *APIServerProfile) error {
 	switch ap.Visibility {
 	case VisibilityPublic, VisibilityPrivate:
@@ -276,6 +467,11 @@ func (sv *openShiftClusterStaticValidator) validateAPIServerProfile(path string,
 			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".ip", "The provided IP '%s' is invalid: must be IPv4.", ap.IP)
 		}
 	}
+	if ap.PublicIPAddressID != "" {
+		if err := sv.validatePublicIPAddressID(path+".publicIpAddressId", ap.PublicIPAddressID, ap.Visibility); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -299,6 +495,132 @@ func (sv *openShiftClusterStaticValidator) validateIngressProfile(path string, p
 			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".ip", "The provided IP '%s' is invalid: must be IPv4.", p.IP)
 		}
 	}
+	if p.PublicIPAddressID != "" {
+		if err := sv.validatePublicIPAddressID(path+".publicIpAddressId", p.PublicIPAddressID, p.Visibility); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validatePublicIPAddressID validates a customer-provided public IP address.
+// It is only used by public endpoints, so it must not be set on private ones.
+func (sv *openShiftClusterStaticValidator) validatePublicIPAddressID(path, publicIPAddressID string, visibility Visibility) error {
+	if visibility != VisibilityPublic {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path, "The provided public IP address '%s' is invalid: visibility must be '%s'.", publicIPAddressID, VisibilityPublic)
+	}
+	if !validate.RxPublicIPAddressID.MatchString(publicIPAddressID) {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path, "The provided public IP address '%s' is invalid.", publicIPAddressID)
+	}
+	r, err := azure.ParseResourceID(publicIPAddressID)
+	if err != nil {
+		return err
+	}
+	if r.SubscriptionID != sv.r.SubscriptionID {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path, "The provided public IP address '%s' is invalid: must be in same subscription as cluster.", publicIPAddressID)
+	}
+
+	return nil
+}
+
+func (sv *openShiftClusterStaticValidator) validateAutoscalerProfile(path string, ap *AutoscalerProfile) error {
+	if ap.MaxNodesTotal < 0 {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".maxNodesTotal", "The provided maximum node count '%d' is invalid.", ap.MaxNodesTotal)
+	}
+	if ap.ScaleDownDelayAfterAdd != "" {
+		if d, err := time.ParseDuration(ap.ScaleDownDelayAfterAdd); err != nil || d <= 0 {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".scaleDownDelayAfterAdd", "The provided duration '%s' is invalid.", ap.ScaleDownDelayAfterAdd)
+		}
+	}
+	if ap.ScaleDownUnneededTime != "" {
+		if d, err := time.ParseDuration(ap.ScaleDownUnneededTime); err != nil || d <= 0 {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".scaleDownUnneededTime", "The provided duration '%s' is invalid.", ap.ScaleDownUnneededTime)
+		}
+	}
+	if len(ap.Pools) == 0 {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".pools", "There should be at least one autoscaler pool.")
+	}
+
+	minNodesTotal := 3 // masters
+	seen := map[string]struct{}{}
+	for _, p := range ap.Pools {
+		poolPath := path + ".pools['" + p.Name + "']"
+
+		if _, found := sv.workerProfileNames[p.Name]; !found {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, poolPath+".name", "The provided worker profile name '%s' is invalid.", p.Name)
+		}
+		if _, found := seen[p.Name]; found {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, poolPath+".name", "The provided worker profile name '%s' is invalid: it is used by more than one pool.", p.Name)
+		}
+		seen[p.Name] = struct{}{}
+
+		// the cluster must never have fewer than 3 workers
+		if p.MinReplicas < 0 || (p.Name == "worker" && p.MinReplicas < 3) {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, poolPath+".minReplicas", "The provided minimum replicas '%d' is invalid.", p.MinReplicas)
+		}
+		if p.MaxReplicas < 1 || p.MaxReplicas < p.MinReplicas {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, poolPath+".maxReplicas", "The provided maximum replicas '%d' is invalid: must be at least 1 and not less than the minimum replicas.", p.MaxReplicas)
+		}
+
+		minNodesTotal += p.MinReplicas
+	}
+
+	if ap.MaxNodesTotal != 0 && ap.MaxNodesTotal < minNodesTotal {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".maxNodesTotal", "The provided maximum node count '%d' is invalid: must be at least %d, the number of masters plus the minimum replicas of the pools.", ap.MaxNodesTotal, minNodesTotal)
+	}
+
+	return nil
+}
+
+func (sv *openShiftClusterStaticValidator) validateMaintenanceProfile(path string, mp *MaintenanceProfile) error {
+	excluded := map[time.Weekday]struct{}{}
+	for i, s := range mp.ExcludedDays {
+		d, ok := api.ParseWeekday(s)
+		if !ok {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, fmt.Sprintf("%s.excludedDays[%d]", path, i), "The provided day '%s' is invalid.", s)
+		}
+		if _, found := excluded[d]; found {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, fmt.Sprintf("%s.excludedDays[%d]", path, i), "The provided day '%s' is invalid: it is excluded more than once.", s)
+		}
+		excluded[d] = struct{}{}
+	}
+	if len(excluded) == 7 {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".excludedDays", "The provided excluded days are invalid: at least one day must allow maintenance.")
+	}
+
+	for i, w := range mp.Windows {
+		windowPath := fmt.Sprintf("%s.windows[%d]", path, i)
+
+		d, ok := api.ParseWeekday(w.Day)
+		if !ok {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, windowPath+".day", "The provided day '%s' is invalid.", w.Day)
+		}
+		if _, found := excluded[d]; found {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, windowPath+".day", "The provided day '%s' is invalid: it is excluded.", w.Day)
+		}
+		if w.StartHour < 0 || w.StartHour > 23 {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, windowPath+".startHour", "The provided start hour '%d' is invalid.", w.StartHour)
+		}
+		// a window must be long enough for an update to complete
+		if w.DurationHours < 4 || w.DurationHours > 24 {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, windowPath+".durationHours", "The provided duration '%d' is invalid: must be between 4 and 24 hours.", w.DurationHours)
+		}
+	}
+
+	return nil
+}
+
+func (sv *openShiftClusterStaticValidator) validateEncryptionAtRestProfile(path string, ep *EncryptionAtRestProfile) error {
+	if _, _, _, err := keyvault.ParseKeyID(ep.KeyVaultKeyID); err != nil {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".keyVaultKeyId", "The provided key vault key ID '%s' is invalid: it must be the versioned ID of a key.", ep.KeyVaultKeyID)
+	}
+
+	switch ep.KeyState {
+	case "", EncryptionKeyStateAccessible, EncryptionKeyStateInaccessible:
+	default:
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".keyState", "The provided key state '%s' is invalid.", ep.KeyState)
+	}
 
 	return nil
 }
@@ -100,11 +100,16 @@ func runTests(t *testing.T, mode testMode, tests []*validateTest) {
 	t.Run(string(mode), func(t *testing.T) {
 		for _, tt := range tests {
 			t.Run(tt.name, func(t *testing.T) {
+				capabilities, err := deployment.NewCapabilities(tt.deploymentMode)
+				if err != nil {
+					t.Fatal(err)
+				}
+
 				v := &openShiftClusterStaticValidator{
-					location:       "location",
-					domain:         "location.aroapp.io",
-					deploymentMode: tt.deploymentMode,
-					resourceID:     id,
+					location:     "location",
+					domain:       "location.aroapp.io",
+					capabilities: capabilities,
+					resourceID:   id,
 					r: azure.Resource{
 						SubscriptionID: subscriptionID,
 						ResourceGroup:  "resourceGroup",
@@ -125,7 +130,7 @@ func runTests(t *testing.T, mode testMode, tests []*validateTest) {
 					(&openShiftClusterConverter{}).ToInternal(validOpenShiftCluster(), current)
 				}
 
-				err := v.Static(oc, current)
+				err = v.Static(oc, current)
 				if err == nil {
 					if tt.wantErr != "" {
 						t.Error(err)
@@ -250,42 +255,42 @@ func TestOpenShiftClusterStaticValidateClusterProfile(t *testing.T) {
 			modify: func(oc *OpenShiftCluster) {
 				oc.Properties.ClusterProfile.Domain = ""
 			},
-			wantErr: "400: InvalidParameter: properties.clusterProfile.domain: The provided domain '' is invalid.",
+			wantErr: "400: InvalidParameter: properties.clusterProfile.domain: The provided domain '' is invalid: must not be empty.",
 		},
 		{
 			name: "upper case domain invalid",
 			modify: func(oc *OpenShiftCluster) {
 				oc.Properties.ClusterProfile.Domain = "BAD"
 			},
-			wantErr: "400: InvalidParameter: properties.clusterProfile.domain: The provided domain 'BAD' is invalid.",
+			wantErr: "400: InvalidParameter: properties.clusterProfile.domain: The provided domain 'BAD' is invalid: must be lower case.",
 		},
 		{
 			name: "domain invalid",
 			modify: func(oc *OpenShiftCluster) {
 				oc.Properties.ClusterProfile.Domain = "!"
 			},
-			wantErr: "400: InvalidParameter: properties.clusterProfile.domain: The provided domain '!' is invalid.",
+			wantErr: "400: InvalidParameter: properties.clusterProfile.domain: The provided domain '!' is invalid: must be a DNS name whose first label starts with a letter.",
 		},
 		{
 			name: "wrong location managed domain invalid",
 			modify: func(oc *OpenShiftCluster) {
 				oc.Properties.ClusterProfile.Domain = "cluster.wronglocation.aroapp.io"
 			},
-			wantErr: "400: InvalidParameter: properties.clusterProfile.domain: The provided domain 'cluster.wronglocation.aroapp.io' is invalid.",
+			wantErr: "400: InvalidParameter: properties.clusterProfile.domain: The provided domain 'cluster.wronglocation.aroapp.io' is invalid: a managed domain must end with .location.aroapp.io.",
 		},
 		{
 			name: "double part managed domain invalid",
 			modify: func(oc *OpenShiftCluster) {
 				oc.Properties.ClusterProfile.Domain = "foo.bar.location.aroapp.io"
 			},
-			wantErr: "400: InvalidParameter: properties.clusterProfile.domain: The provided domain 'foo.bar.location.aroapp.io' is invalid.",
+			wantErr: "400: InvalidParameter: properties.clusterProfile.domain: The provided domain 'foo.bar.location.aroapp.io' is invalid: a managed domain must have a single label before .location.aroapp.io.",
 		},
 		{
 			name: "resourceGroupId invalid",
 			modify: func(oc *OpenShiftCluster) {
 				oc.Properties.ClusterProfile.ResourceGroupID = "invalid"
 			},
-			wantErr: "400: InvalidParameter: properties.clusterProfile.resourceGroupId: The provided resource group 'invalid' is invalid.",
+			wantErr: "400: InvalidParameter: properties.clusterProfile.resourceGroupId: The provided resource group 'invalid' is invalid: must be of the form /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}.",
 		},
 		{
 			name: "cluster resource group subscriptionId not matching cluster subscriptionId",
@@ -310,6 +315,54 @@ func TestOpenShiftClusterStaticValidateClusterProfile(t *testing.T) {
 			},
 			wantErr: "400: InvalidParameter: properties.clusterProfile.version: The provided version 'invalid' is invalid.",
 		},
+		{
+			name: "ssh public key valid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.ClusterProfile.SSHPublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIINY1/iKiYEtjyU95Pld7mUHNMAejwYXAHFq8d3iu2rB user@example.com\n"
+			},
+		},
+		{
+			name: "ssh public key invalid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.ClusterProfile.SSHPublicKey = "ssh-ed25519 invalid"
+			},
+			wantErr: "400: InvalidParameter: properties.clusterProfile.sshPublicKey: The provided SSH public key is invalid.",
+		},
+		{
+			name: "ssh public key with options invalid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.ClusterProfile.SSHPublicKey = `command="/bin/true" ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIINY1/iKiYEtjyU95Pld7mUHNMAejwYXAHFq8d3iu2rB`
+			},
+			wantErr: "400: InvalidParameter: properties.clusterProfile.sshPublicKey: The provided SSH public key is invalid.",
+		},
+		{
+			name: "multiple ssh public keys invalid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.ClusterProfile.SSHPublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIINY1/iKiYEtjyU95Pld7mUHNMAejwYXAHFq8d3iu2rB\nssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIINY1/iKiYEtjyU95Pld7mUHNMAejwYXAHFq8d3iu2rB"
+			},
+			wantErr: "400: InvalidParameter: properties.clusterProfile.sshPublicKey: The provided SSH public key is invalid.",
+		},
+		{
+			name: "managed domain too long invalid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.ClusterProfile.Domain = strings.Repeat("a", 40) + ".location.aroapp.io"
+			},
+			wantErr: "400: InvalidParameter: properties.clusterProfile.domain: The provided domain '" + strings.Repeat("a", 40) + ".location.aroapp.io' is invalid: a managed domain must be at most 57 characters long.",
+		},
+		{
+			name: "resourceGroupId with unicode name invalid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.ClusterProfile.ResourceGroupID = fmt.Sprintf("/subscriptions/%s/resourceGroups/tést-cluster", subscriptionID)
+			},
+			wantErr: fmt.Sprintf("400: InvalidParameter: properties.clusterProfile.resourceGroupId: The provided resource group '/subscriptions/%s/resourceGroups/tést-cluster' is invalid: the resource group name must contain only ASCII letters, digits, hyphens, underscores, parentheses and periods.", subscriptionID),
+		},
+		{
+			name: "cluster resource group same as cluster object resource group",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.ClusterProfile.ResourceGroupID = fmt.Sprintf("/subscriptions/%s/resourceGroups/resourceGroup", subscriptionID)
+			},
+			wantErr: fmt.Sprintf("400: InvalidParameter: properties.clusterProfile.resourceGroupId: The provided resource group '/subscriptions/%s/resourceGroups/resourceGroup' is invalid: must be different from resourceGroup of the OpenShift Cluster object.", subscriptionID),
+		},
 	}
 
 	runTests(t, testModeCreate, createTests)
@@ -417,10 +470,96 @@ func TestOpenShiftClusterStaticValidateNetworkProfile(t *testing.T) {
 			},
 			wantErr: "400: InvalidParameter: properties.networkProfile.serviceCidr: The provided vnet CIDR '10.0.0.0/23' is invalid: must be /22 or larger.",
 		},
+		{
+			name: "machineCidr invalid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.NetworkProfile.MachineCIDR = "invalid"
+			},
+			wantErr: "400: InvalidParameter: properties.networkProfile.machineCidr: The provided machine CIDR 'invalid' is invalid: 'invalid CIDR address: invalid'.",
+		},
+		{
+			name: "ipv6 machineCidr invalid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.NetworkProfile.MachineCIDR = "::0/0"
+			},
+			wantErr: "400: InvalidParameter: properties.networkProfile.machineCidr: The provided machine CIDR '::0/0' is invalid: must be IPv4.",
+		},
+		{
+			name: "machineCidr too small",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.NetworkProfile.MachineCIDR = "10.0.0.0/27"
+			},
+			wantErr: "400: InvalidParameter: properties.networkProfile.machineCidr: The provided machine CIDR '10.0.0.0/27' is invalid: must be /26 or larger.",
+		},
+		{
+			name: "machineCidr overlaps podCidr",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.NetworkProfile.MachineCIDR = "10.128.0.0/22"
+			},
+			wantErr: "400: InvalidParameter: properties.networkProfile.machineCidr: The provided machine CIDR '10.128.0.0/22' is invalid: must not overlap the pod or service CIDR.",
+		},
+		{
+			name: "machineCidr overlaps serviceCidr",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.NetworkProfile.MachineCIDR = "172.16.0.0/12"
+			},
+			wantErr: "400: InvalidParameter: properties.networkProfile.machineCidr: The provided machine CIDR '172.16.0.0/12' is invalid: must not overlap the pod or service CIDR.",
+		},
+		{
+			name: "hostPrefix too small",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.NetworkProfile.HostPrefix = 22
+			},
+			wantErr: "400: InvalidParameter: properties.networkProfile.hostPrefix: The provided host prefix '22' is invalid: must be between 23 and 26.",
+		},
+		{
+			name: "hostPrefix too large",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.NetworkProfile.HostPrefix = 27
+			},
+			wantErr: "400: InvalidParameter: properties.networkProfile.hostPrefix: The provided host prefix '27' is invalid: must be between 23 and 26.",
+		},
+		{
+			name: "managed outbound IP count valid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.NetworkProfile.LoadBalancerProfile = &LoadBalancerProfile{
+					ManagedOutboundIPs: &ManagedOutboundIPs{Count: 20},
+				}
+			},
+		},
+		{
+			name: "managed outbound IP count too small",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.NetworkProfile.LoadBalancerProfile = &LoadBalancerProfile{
+					ManagedOutboundIPs: &ManagedOutboundIPs{},
+				}
+			},
+			wantErr: "400: InvalidParameter: properties.networkProfile.loadBalancerProfile.managedOutboundIps.count: The provided managed outbound IP count '0' is invalid: must be between 1 and 20.",
+		},
+		{
+			name: "managed outbound IP count too large",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.NetworkProfile.LoadBalancerProfile = &LoadBalancerProfile{
+					ManagedOutboundIPs: &ManagedOutboundIPs{Count: 21},
+				}
+			},
+			wantErr: "400: InvalidParameter: properties.networkProfile.loadBalancerProfile.managedOutboundIps.count: The provided managed outbound IP count '21' is invalid: must be between 1 and 20.",
+		},
 	}
 
 	runTests(t, testModeCreate, tests)
 	runTests(t, testModeUpdate, tests)
+
+	// machineCidr and hostPrefix are immutable, so can only be set at create
+	runTests(t, testModeCreate, []*validateTest{
+		{
+			name: "machineCidr and hostPrefix valid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.NetworkProfile.MachineCIDR = "10.0.0.0/22"
+				oc.Properties.NetworkProfile.HostPrefix = 24
+			},
+		},
+	})
 }
 
 func TestOpenShiftClusterStaticValidateMasterProfile(t *testing.T) {
@@ -449,6 +588,13 @@ func TestOpenShiftClusterStaticValidateMasterProfile(t *testing.T) {
 			},
 			wantErr: "400: InvalidParameter: properties.masterProfile.subnetId: The provided master VM subnet '/subscriptions/7a3036d1-60a1-4605-8a41-44955e050804/resourcegroups/test-vnet/providers/Microsoft.Network/virtualNetworks/test-vnet/subnets/master' is invalid: must be in same subscription as cluster.",
 		},
+		{
+			name: "securityType invalid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.MasterProfile.SecurityType = "invalid"
+			},
+			wantErr: "400: InvalidParameter: properties.masterProfile.securityType: The provided security type 'invalid' is invalid.",
+		},
 	}
 
 	runTests(t, testModeCreate, tests)
@@ -489,6 +635,13 @@ func TestOpenShiftClusterStaticValidateWorkerProfile(t *testing.T) {
 			deploymentMode: deployment.Development,
 			wantErr:        "400: InvalidParameter: properties.workerProfiles['worker'].vmSize: The provided worker VM size 'Standard_D4s_v3' is invalid.",
 		},
+		{
+			name: "vmSize arm64",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.WorkerProfiles[0].VMSize = "Standard_D4ps_v5"
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['worker'].vmSize: The provided worker VM size 'Standard_D4ps_v5' is invalid.",
+		},
 		{
 			name: "disk too small",
 			modify: func(oc *OpenShiftCluster) {
@@ -531,12 +684,131 @@ func TestOpenShiftClusterStaticValidateWorkerProfile(t *testing.T) {
 			},
 			wantErr: "400: InvalidParameter: properties.workerProfiles['worker'].count: The provided worker count '21' is invalid.",
 		},
+		{
+			name: "securityType trusted launch",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.WorkerProfiles[0].SecurityType = SecurityTypeTrustedLaunch
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['worker'].securityType: The provided security type 'TrustedLaunch' is invalid: trusted launch is only supported for master VMs.",
+		},
+		{
+			name: "securityType invalid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.WorkerProfiles[0].SecurityType = "invalid"
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['worker'].securityType: The provided security type 'invalid' is invalid.",
+		},
 	}
 
 	// We do not perform this validation on update
 	runTests(t, testModeCreate, tests)
 }
 
+func TestOpenShiftClusterStaticValidateWorkerProfileNodeMetadata(t *testing.T) {
+	tests := []*validateTest{
+		{
+			name: "valid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.WorkerProfiles[0].NodeLabels = map[string]string{
+					"example.com/team": "payments",
+					"tier":             "",
+				}
+				oc.Properties.WorkerProfiles[0].NodeTaints = []NodeTaint{
+					{
+						Key:    "example.com/dedicated",
+						Value:  "payments",
+						Effect: TaintEffectPreferNoSchedule,
+					},
+				}
+			},
+		},
+		{
+			name: "label key invalid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.WorkerProfiles[0].NodeLabels = map[string]string{
+					"-invalid": "value",
+				}
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['worker'].nodeLabels: The provided node label key '-invalid' is invalid.",
+		},
+		{
+			name: "label key reserved",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.WorkerProfiles[0].NodeLabels = map[string]string{
+					"node-role.kubernetes.io/infra": "",
+				}
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['worker'].nodeLabels: The provided node label key 'node-role.kubernetes.io/infra' is invalid.",
+		},
+		{
+			name: "label value invalid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.WorkerProfiles[0].NodeLabels = map[string]string{
+					"tier": "not valid",
+				}
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['worker'].nodeLabels['tier']: The provided node label value 'not valid' is invalid.",
+		},
+		{
+			name: "taint key reserved",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.WorkerProfiles[0].NodeTaints = []NodeTaint{
+					{
+						Key:    "node.openshift.io/unschedulable",
+						Effect: TaintEffectPreferNoSchedule,
+					},
+				}
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['worker'].nodeTaints[0].key: The provided node taint key 'node.openshift.io/unschedulable' is invalid.",
+		},
+		{
+			name: "taint effect invalid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.WorkerProfiles[0].NodeTaints = []NodeTaint{
+					{
+						Key:    "dedicated",
+						Effect: "invalid",
+					},
+				}
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['worker'].nodeTaints[0].effect: The provided node taint effect 'invalid' is invalid.",
+		},
+		{
+			name: "taint makes worker profile unschedulable",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.WorkerProfiles[0].NodeTaints = []NodeTaint{
+					{
+						Key:    "dedicated",
+						Effect: TaintEffectNoSchedule,
+					},
+				}
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['worker'].nodeTaints[0].effect: The provided node taint effect 'NoSchedule' is invalid: the worker profile only accepts 'PreferNoSchedule'.",
+		},
+		{
+			name: "taint duplicated",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.WorkerProfiles[0].NodeTaints = []NodeTaint{
+					{
+						Key:    "dedicated",
+						Value:  "a",
+						Effect: TaintEffectPreferNoSchedule,
+					},
+					{
+						Key:    "dedicated",
+						Value:  "b",
+						Effect: TaintEffectPreferNoSchedule,
+					},
+				}
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['worker'].nodeTaints[1]: The provided node taint 'dedicated:PreferNoSchedule' is duplicated.",
+		},
+	}
+
+	runTests(t, testModeCreate, tests)
+	runTests(t, testModeUpdate, tests)
+}
+
 func TestOpenShiftClusterStaticValidateAPIServerProfile(t *testing.T) {
 	commonTests := []*validateTest{
 		{
@@ -570,6 +842,36 @@ func TestOpenShiftClusterStaticValidateAPIServerProfile(t *testing.T) {
 			},
 			wantErr: "400: InvalidParameter: properties.apiserverProfile.ip: The provided IP '::' is invalid: must be IPv4.",
 		},
+		{
+			name: "public ip address invalid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.APIServerProfile.PublicIPAddressID = "invalid"
+			},
+			wantErr: "400: InvalidParameter: properties.apiserverProfile.publicIpAddressId: The provided public IP address 'invalid' is invalid.",
+		},
+		{
+			name: "public ip address in other subscription invalid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.APIServerProfile.PublicIPAddressID = "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/ips/providers/Microsoft.Network/publicIPAddresses/api"
+			},
+			wantErr: "400: InvalidParameter: properties.apiserverProfile.publicIpAddressId: The provided public IP address '/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/ips/providers/Microsoft.Network/publicIPAddresses/api' is invalid: must be in same subscription as cluster.",
+		},
+		{
+			name: "public ip address of private api server invalid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.APIServerProfile.Visibility = VisibilityPrivate
+				oc.Properties.APIServerProfile.PublicIPAddressID = fmt.Sprintf("/subscriptions/%s/resourceGroups/ips/providers/Microsoft.Network/publicIPAddresses/api", subscriptionID)
+			},
+			wantErr: fmt.Sprintf("400: InvalidParameter: properties.apiserverProfile.publicIpAddressId: The provided public IP address '/subscriptions/%s/resourceGroups/ips/providers/Microsoft.Network/publicIPAddresses/api' is invalid: visibility must be 'Public'.", subscriptionID),
+		},
+		{
+			name: "public ip address shared with ingress invalid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.APIServerProfile.PublicIPAddressID = fmt.Sprintf("/subscriptions/%s/resourceGroups/ips/providers/Microsoft.Network/publicIPAddresses/api", subscriptionID)
+				oc.Properties.IngressProfiles[0].PublicIPAddressID = fmt.Sprintf("/subscriptions/%s/resourceGroups/ips/providers/Microsoft.Network/publicIPAddresses/API", subscriptionID)
+			},
+			wantErr: fmt.Sprintf("400: InvalidParameter: properties.ingressProfiles['default'].publicIpAddressId: The provided public IP address '/subscriptions/%s/resourceGroups/ips/providers/Microsoft.Network/publicIPAddresses/API' is invalid: must not be the public IP address of the API server.", subscriptionID),
+		},
 	}
 
 	createTests := []*validateTest{
@@ -585,6 +887,12 @@ func TestOpenShiftClusterStaticValidateAPIServerProfile(t *testing.T) {
 				oc.Properties.APIServerProfile.IP = ""
 			},
 		},
+		{
+			name: "public ip address valid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.APIServerProfile.PublicIPAddressID = fmt.Sprintf("/subscriptions/%s/resourceGroups/ips/providers/Microsoft.Network/publicIPAddresses/api", subscriptionID)
+			},
+		},
 	}
 
 	runTests(t, testModeCreate, createTests)
@@ -625,6 +933,21 @@ func TestOpenShiftClusterStaticValidateIngressProfile(t *testing.T) {
 			},
 			wantErr: "400: InvalidParameter: properties.ingressProfiles['default'].ip: The provided IP '::' is invalid: must be IPv4.",
 		},
+		{
+			name: "public ip address invalid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.IngressProfiles[0].PublicIPAddressID = fmt.Sprintf("/subscriptions/%s/resourceGroups/ips/providers/Microsoft.Network/loadBalancers/ingress", subscriptionID)
+			},
+			wantErr: fmt.Sprintf("400: InvalidParameter: properties.ingressProfiles['default'].publicIpAddressId: The provided public IP address '/subscriptions/%s/resourceGroups/ips/providers/Microsoft.Network/loadBalancers/ingress' is invalid.", subscriptionID),
+		},
+		{
+			name: "public ip address of private ingress invalid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.IngressProfiles[0].Visibility = VisibilityPrivate
+				oc.Properties.IngressProfiles[0].PublicIPAddressID = fmt.Sprintf("/subscriptions/%s/resourceGroups/ips/providers/Microsoft.Network/publicIPAddresses/ingress", subscriptionID)
+			},
+			wantErr: fmt.Sprintf("400: InvalidParameter: properties.ingressProfiles['default'].publicIpAddressId: The provided public IP address '/subscriptions/%s/resourceGroups/ips/providers/Microsoft.Network/publicIPAddresses/ingress' is invalid: visibility must be 'Public'.", subscriptionID),
+		},
 	}
 
 	createTests := []*validateTest{
@@ -634,6 +957,12 @@ func TestOpenShiftClusterStaticValidateIngressProfile(t *testing.T) {
 				oc.Properties.IngressProfiles[0].IP = ""
 			},
 		},
+		{
+			name: "public ip address valid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.IngressProfiles[0].PublicIPAddressID = fmt.Sprintf("/subscriptions/%s/resourceGroups/ips/providers/Microsoft.Network/publicIPAddresses/ingress", subscriptionID)
+			},
+		},
 	}
 
 	runTests(t, testModeCreate, createTests)
@@ -641,6 +970,270 @@ func TestOpenShiftClusterStaticValidateIngressProfile(t *testing.T) {
 	runTests(t, testModeUpdate, commonTests)
 }
 
+func TestOpenShiftClusterStaticValidateAutoscalerProfile(t *testing.T) {
+	validAutoscalerProfile := func(oc *OpenShiftCluster) {
+		oc.Properties.AutoscalerProfile = &AutoscalerProfile{
+			MaxNodesTotal:          20,
+			ScaleDownDelayAfterAdd: "10m",
+			ScaleDownUnneededTime:  "5m",
+			Pools: []AutoscalerPool{
+				{
+					Name:        "worker",
+					MinReplicas: 3,
+					MaxReplicas: 12,
+				},
+			},
+		}
+	}
+
+	commonTests := []*validateTest{
+		{
+			name: "no autoscaler profile valid",
+		},
+		{
+			name:   "valid",
+			modify: validAutoscalerProfile,
+		},
+		{
+			name: "maxNodesTotal invalid",
+			modify: func(oc *OpenShiftCluster) {
+				validAutoscalerProfile(oc)
+				oc.Properties.AutoscalerProfile.MaxNodesTotal = -1
+			},
+			wantErr: "400: InvalidParameter: properties.autoscalerProfile.maxNodesTotal: The provided maximum node count '-1' is invalid.",
+		},
+		{
+			name: "maxNodesTotal below minimum replicas",
+			modify: func(oc *OpenShiftCluster) {
+				validAutoscalerProfile(oc)
+				oc.Properties.AutoscalerProfile.MaxNodesTotal = 5
+			},
+			wantErr: "400: InvalidParameter: properties.autoscalerProfile.maxNodesTotal: The provided maximum node count '5' is invalid: must be at least 6, the number of masters plus the minimum replicas of the pools.",
+		},
+		{
+			name: "scaleDownDelayAfterAdd invalid",
+			modify: func(oc *OpenShiftCluster) {
+				validAutoscalerProfile(oc)
+				oc.Properties.AutoscalerProfile.ScaleDownDelayAfterAdd = "10"
+			},
+			wantErr: "400: InvalidParameter: properties.autoscalerProfile.scaleDownDelayAfterAdd: The provided duration '10' is invalid.",
+		},
+		{
+			name: "scaleDownUnneededTime negative",
+			modify: func(oc *OpenShiftCluster) {
+				validAutoscalerProfile(oc)
+				oc.Properties.AutoscalerProfile.ScaleDownUnneededTime = "-5m"
+			},
+			wantErr: "400: InvalidParameter: properties.autoscalerProfile.scaleDownUnneededTime: The provided duration '-5m' is invalid.",
+		},
+		{
+			name: "no pools invalid",
+			modify: func(oc *OpenShiftCluster) {
+				validAutoscalerProfile(oc)
+				oc.Properties.AutoscalerProfile.Pools = nil
+			},
+			wantErr: "400: InvalidParameter: properties.autoscalerProfile.pools: There should be at least one autoscaler pool.",
+		},
+		{
+			name: "unknown worker profile",
+			modify: func(oc *OpenShiftCluster) {
+				validAutoscalerProfile(oc)
+				oc.Properties.AutoscalerProfile.Pools[0].Name = "gpu"
+			},
+			wantErr: "400: InvalidParameter: properties.autoscalerProfile.pools['gpu'].name: The provided worker profile name 'gpu' is invalid.",
+		},
+		{
+			name: "duplicate pools",
+			modify: func(oc *OpenShiftCluster) {
+				validAutoscalerProfile(oc)
+				oc.Properties.AutoscalerProfile.Pools = append(oc.Properties.AutoscalerProfile.Pools, oc.Properties.AutoscalerProfile.Pools[0])
+			},
+			wantErr: "400: InvalidParameter: properties.autoscalerProfile.pools['worker'].name: The provided worker profile name 'worker' is invalid: it is used by more than one pool.",
+		},
+		{
+			name: "worker minReplicas too small",
+			modify: func(oc *OpenShiftCluster) {
+				validAutoscalerProfile(oc)
+				oc.Properties.AutoscalerProfile.Pools[0].MinReplicas = 2
+			},
+			wantErr: "400: InvalidParameter: properties.autoscalerProfile.pools['worker'].minReplicas: The provided minimum replicas '2' is invalid.",
+		},
+		{
+			name: "maxReplicas less than minReplicas",
+			modify: func(oc *OpenShiftCluster) {
+				validAutoscalerProfile(oc)
+				oc.Properties.AutoscalerProfile.Pools[0].MaxReplicas = 2
+			},
+			wantErr: "400: InvalidParameter: properties.autoscalerProfile.pools['worker'].maxReplicas: The provided maximum replicas '2' is invalid: must be at least 1 and not less than the minimum replicas.",
+		},
+	}
+
+	runTests(t, testModeCreate, commonTests)
+	runTests(t, testModeUpdate, commonTests)
+}
+
+func TestOpenShiftClusterStaticValidateMaintenanceProfile(t *testing.T) {
+	validMaintenanceProfile := func(oc *OpenShiftCluster) {
+		oc.Properties.MaintenanceProfile = &MaintenanceProfile{
+			Windows: []MaintenanceWindow{
+				{
+					Day:           "Tuesday",
+					StartHour:     22,
+					DurationHours: 6,
+				},
+			},
+			ExcludedDays: []string{"Friday", "saturday"},
+		}
+	}
+
+	commonTests := []*validateTest{
+		{
+			name: "no maintenance profile valid",
+		},
+		{
+			name:   "valid",
+			modify: validMaintenanceProfile,
+		},
+		{
+			name: "excluded days only valid",
+			modify: func(oc *OpenShiftCluster) {
+				validMaintenanceProfile(oc)
+				oc.Properties.MaintenanceProfile.Windows = nil
+			},
+		},
+		{
+			name: "excluded day invalid",
+			modify: func(oc *OpenShiftCluster) {
+				validMaintenanceProfile(oc)
+				oc.Properties.MaintenanceProfile.ExcludedDays[1] = "Sat"
+			},
+			wantErr: "400: InvalidParameter: properties.maintenanceProfile.excludedDays[1]: The provided day 'Sat' is invalid.",
+		},
+		{
+			name: "excluded day repeated",
+			modify: func(oc *OpenShiftCluster) {
+				validMaintenanceProfile(oc)
+				oc.Properties.MaintenanceProfile.ExcludedDays[1] = "FRIDAY"
+			},
+			wantErr: "400: InvalidParameter: properties.maintenanceProfile.excludedDays[1]: The provided day 'FRIDAY' is invalid: it is excluded more than once.",
+		},
+		{
+			name: "every day excluded",
+			modify: func(oc *OpenShiftCluster) {
+				validMaintenanceProfile(oc)
+				oc.Properties.MaintenanceProfile.ExcludedDays = []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+			},
+			wantErr: "400: InvalidParameter: properties.maintenanceProfile.excludedDays: The provided excluded days are invalid: at least one day must allow maintenance.",
+		},
+		{
+			name: "window day invalid",
+			modify: func(oc *OpenShiftCluster) {
+				validMaintenanceProfile(oc)
+				oc.Properties.MaintenanceProfile.Windows[0].Day = ""
+			},
+			wantErr: "400: InvalidParameter: properties.maintenanceProfile.windows[0].day: The provided day '' is invalid.",
+		},
+		{
+			name: "window on excluded day",
+			modify: func(oc *OpenShiftCluster) {
+				validMaintenanceProfile(oc)
+				oc.Properties.MaintenanceProfile.Windows[0].Day = "Friday"
+			},
+			wantErr: "400: InvalidParameter: properties.maintenanceProfile.windows[0].day: The provided day 'Friday' is invalid: it is excluded.",
+		},
+		{
+			name: "start hour invalid",
+			modify: func(oc *OpenShiftCluster) {
+				validMaintenanceProfile(oc)
+				oc.Properties.MaintenanceProfile.Windows[0].StartHour = 24
+			},
+			wantErr: "400: InvalidParameter: properties.maintenanceProfile.windows[0].startHour: The provided start hour '24' is invalid.",
+		},
+		{
+			name: "duration too short",
+			modify: func(oc *OpenShiftCluster) {
+				validMaintenanceProfile(oc)
+				oc.Properties.MaintenanceProfile.Windows[0].DurationHours = 2
+			},
+			wantErr: "400: InvalidParameter: properties.maintenanceProfile.windows[0].durationHours: The provided duration '2' is invalid: must be between 4 and 24 hours.",
+		},
+	}
+
+	runTests(t, testModeCreate, commonTests)
+	runTests(t, testModeUpdate, commonTests)
+}
+
+func TestOpenShiftClusterStaticValidateEncryptionAtRestProfile(t *testing.T) {
+	validEncryptionAtRestProfile := func(oc *OpenShiftCluster) {
+		oc.Properties.EncryptionAtRestProfile = &EncryptionAtRestProfile{
+			KeyVaultKeyID: "https://vault.vault.azure.net/keys/key/0123456789abcdef0123456789abcdef",
+		}
+	}
+
+	tests := []*validateTest{
+		{
+			name:   "valid",
+			modify: validEncryptionAtRestProfile,
+		},
+		{
+			name: "unversioned key ID invalid",
+			modify: func(oc *OpenShiftCluster) {
+				validEncryptionAtRestProfile(oc)
+				oc.Properties.EncryptionAtRestProfile.KeyVaultKeyID = "https://vault.vault.azure.net/keys/key"
+			},
+			wantErr: "400: InvalidParameter: properties.encryptionAtRestProfile.keyVaultKeyId: The provided key vault key ID 'https://vault.vault.azure.net/keys/key' is invalid: it must be the versioned ID of a key.",
+		},
+		{
+			name: "key state invalid",
+			modify: func(oc *OpenShiftCluster) {
+				validEncryptionAtRestProfile(oc)
+				oc.Properties.EncryptionAtRestProfile.KeyState = "Revoked"
+			},
+			wantErr: "400: InvalidParameter: properties.encryptionAtRestProfile.keyState: The provided key state 'Revoked' is invalid.",
+		},
+	}
+
+	runTests(t, testModeCreate, tests)
+}
+
+func TestOpenShiftClusterStaticValidateAdditionalWorkerProfileAutoscalerPool(t *testing.T) {
+	capabilities, err := deployment.NewCapabilities(deployment.Production)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v := &openShiftClusterStaticValidator{
+		location:     "location",
+		domain:       "location.aroapp.io",
+		capabilities: capabilities,
+		resourceID:   id,
+	}
+
+	oc := validOpenShiftCluster()
+	oc.Properties.AutoscalerProfile = &AutoscalerProfile{
+		Pools: []AutoscalerPool{
+			{
+				Name:        "gpu",
+				MinReplicas: 0,
+				MaxReplicas: 6,
+			},
+		},
+	}
+
+	current := &api.OpenShiftCluster{}
+	(&openShiftClusterConverter{}).ToInternal(validOpenShiftCluster(), current)
+	current.Properties.AdditionalWorkerProfiles = []api.WorkerProfile{
+		{
+			Name: "gpu",
+		},
+	}
+
+	err = v.Static(oc, current)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestOpenShiftClusterStaticValidateDelta(t *testing.T) {
 	tests := []*validateTest{
 		{
@@ -667,6 +1260,59 @@ func TestOpenShiftClusterStaticValidateDelta(t *testing.T) {
 			name:   "valid tags change",
 			modify: func(oc *OpenShiftCluster) { oc.Tags = Tags{"new": "value"} },
 		},
+		{
+			name: "valid autoscaler profile change",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.AutoscalerProfile = &AutoscalerProfile{
+					Pools: []AutoscalerPool{
+						{
+							Name:        "worker",
+							MinReplicas: 3,
+							MaxReplicas: 6,
+						},
+					},
+				}
+			},
+		},
+		{
+			name: "valid worker node metadata change",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.WorkerProfiles[0].NodeLabels = map[string]string{
+					"tier": "frontend",
+				}
+				oc.Properties.WorkerProfiles[0].NodeTaints = []NodeTaint{
+					{
+						Key:    "dedicated",
+						Effect: TaintEffectPreferNoSchedule,
+					},
+				}
+			},
+		},
+		{
+			name: "valid managed outbound IP count change",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.NetworkProfile.LoadBalancerProfile = &LoadBalancerProfile{
+					ManagedOutboundIPs: &ManagedOutboundIPs{Count: 4},
+				}
+			},
+		},
+		{
+			name: "valid maintenance profile change",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.MaintenanceProfile = &MaintenanceProfile{
+					ExcludedDays: []string{"Sunday"},
+				}
+			},
+		},
+		{
+			name: "encryption at rest profile change",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.EncryptionAtRestProfile = &EncryptionAtRestProfile{
+					KeyVaultKeyID: "https://vault.vault.azure.net/keys/key/0123456789abcdef0123456789abcdef",
+				}
+			},
+			wantErr: "400: PropertyChangeNotAllowed: properties.encryptionAtRestProfile: Changing property 'properties.encryptionAtRestProfile' is not allowed.",
+		},
 		{
 			name:    "provisioningState change",
 			modify:  func(oc *OpenShiftCluster) { oc.Properties.ProvisioningState = ProvisioningStateFailed },
@@ -699,6 +1345,13 @@ func TestOpenShiftClusterStaticValidateDelta(t *testing.T) {
 			},
 			wantErr: "400: PropertyChangeNotAllowed: properties.clusterProfile.resourceGroupId: Changing property 'properties.clusterProfile.resourceGroupId' is not allowed.",
 		},
+		{
+			name: "ssh public key change",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.ClusterProfile.SSHPublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIINY1/iKiYEtjyU95Pld7mUHNMAejwYXAHFq8d3iu2rB"
+			},
+			wantErr: "400: PropertyChangeNotAllowed: properties.clusterProfile.sshPublicKey: Changing property 'properties.clusterProfile.sshPublicKey' is not allowed.",
+		},
 		{
 			name: "apiServer private change",
 			modify: func(oc *OpenShiftCluster) {
@@ -723,6 +1376,20 @@ func TestOpenShiftClusterStaticValidateDelta(t *testing.T) {
 			},
 			wantErr: "400: PropertyChangeNotAllowed: properties.ingressProfiles['default'].visibility: Changing property 'properties.ingressProfiles['default'].visibility' is not allowed.",
 		},
+		{
+			name: "apiServer public ip address change",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.APIServerProfile.PublicIPAddressID = fmt.Sprintf("/subscriptions/%s/resourceGroups/ips/providers/Microsoft.Network/publicIPAddresses/api", subscriptionID)
+			},
+			wantErr: "400: PropertyChangeNotAllowed: properties.apiserverProfile.publicIpAddressId: Changing property 'properties.apiserverProfile.publicIpAddressId' is not allowed.",
+		},
+		{
+			name: "ingress public ip address change",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.IngressProfiles[0].PublicIPAddressID = fmt.Sprintf("/subscriptions/%s/resourceGroups/ips/providers/Microsoft.Network/publicIPAddresses/ingress", subscriptionID)
+			},
+			wantErr: "400: PropertyChangeNotAllowed: properties.ingressProfiles['default'].publicIpAddressId: Changing property 'properties.ingressProfiles['default'].publicIpAddressId' is not allowed.",
+		},
 		{
 			name:    "ingress ip change",
 			modify:  func(oc *OpenShiftCluster) { oc.Properties.IngressProfiles[0].IP = "2.3.4.5" },
@@ -748,6 +1415,16 @@ func TestOpenShiftClusterStaticValidateDelta(t *testing.T) {
 			modify:  func(oc *OpenShiftCluster) { oc.Properties.NetworkProfile.ServiceCIDR = "0.0.0.0/0" },
 			wantErr: "400: PropertyChangeNotAllowed: properties.networkProfile.serviceCidr: Changing property 'properties.networkProfile.serviceCidr' is not allowed.",
 		},
+		{
+			name:    "machineCidr change",
+			modify:  func(oc *OpenShiftCluster) { oc.Properties.NetworkProfile.MachineCIDR = "10.0.0.0/22" },
+			wantErr: "400: PropertyChangeNotAllowed: properties.networkProfile.machineCidr: Changing property 'properties.networkProfile.machineCidr' is not allowed.",
+		},
+		{
+			name:    "hostPrefix change",
+			modify:  func(oc *OpenShiftCluster) { oc.Properties.NetworkProfile.HostPrefix = 24 },
+			wantErr: "400: PropertyChangeNotAllowed: properties.networkProfile.hostPrefix: Changing property 'properties.networkProfile.hostPrefix' is not allowed.",
+		},
 		{
 			name: "master subnetId change",
 			modify: func(oc *OpenShiftCluster) {
@@ -777,6 +1454,16 @@ func TestOpenShiftClusterStaticValidateDelta(t *testing.T) {
 			},
 			wantErr: "400: PropertyChangeNotAllowed: properties.workerProfiles['worker'].subnetId: Changing property 'properties.workerProfiles['worker'].subnetId' is not allowed.",
 		},
+		{
+			name:    "master securityType change",
+			modify:  func(oc *OpenShiftCluster) { oc.Properties.MasterProfile.SecurityType = SecurityTypeTrustedLaunch },
+			wantErr: "400: PropertyChangeNotAllowed: properties.masterProfile.securityType: Changing property 'properties.masterProfile.securityType' is not allowed.",
+		},
+		{
+			name:    "worker securityType change",
+			modify:  func(oc *OpenShiftCluster) { oc.Properties.WorkerProfiles[0].SecurityType = SecurityTypeTrustedLaunch },
+			wantErr: "400: PropertyChangeNotAllowed: properties.workerProfiles['worker'].securityType: Changing property 'properties.workerProfiles['worker'].securityType' is not allowed.",
+		},
 		{
 			name:    "workerProfiles count change",
 			modify:  func(oc *OpenShiftCluster) { oc.Properties.WorkerProfiles[0].Count++ },
@@ -21,16 +21,36 @@ func init() {
 		OpenShiftClusterConverter: func() api.OpenShiftClusterConverter {
 			return &openShiftClusterConverter{}
 		},
-		OpenShiftClusterStaticValidator: func(location, domain string, deploymentMode deployment.Mode, resourceID string) api.OpenShiftClusterStaticValidator {
+		OpenShiftClusterStaticValidator: func(location, domain string, capabilities deployment.Capabilities, resourceID string) api.OpenShiftClusterStaticValidator {
 			return &openShiftClusterStaticValidator{
-				location:       location,
-				domain:         domain,
-				deploymentMode: deploymentMode,
-				resourceID:     resourceID,
+				location:     location,
+				domain:       domain,
+				capabilities: capabilities,
+				resourceID:   resourceID,
 			}
 		},
 		OpenShiftClusterCredentialsConverter: func() api.OpenShiftClusterCredentialsConverter {
 			return &openShiftClusterCredentialsConverter{}
 		},
+		WorkerProfileConverter: func() api.WorkerProfileConverter {
+			return &workerProfileConverter{}
+		},
+		WorkerProfileStaticValidator: func(capabilities deployment.Capabilities) api.WorkerProfileStaticValidator {
+			return &workerProfileStaticValidator{
+				capabilities: capabilities,
+			}
+		},
+		DetectorConverter: func() api.DetectorConverter {
+			return &detectorConverter{}
+		},
+		EgressConverter: func() api.EgressConverter {
+			return &egressConverter{}
+		},
+		FailureSummaryConverter: func() api.FailureSummaryConverter {
+			return &failureSummaryConverter{}
+		},
+		DeletePreflightConverter: func() api.DeletePreflightConverter {
+			return &deletePreflightConverter{}
+		},
 	}
 }
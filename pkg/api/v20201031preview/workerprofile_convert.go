@@ -0,0 +1,44 @@
+package v20201031preview
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+type workerProfileConverter struct{}
+
+// ToExternal returns a new external representation of the internal object,
+// reading from the subset of the internal object's fields that appear in the
+// external representation.  ToExternal does not modify its argument; there is
+// no pointer aliasing between the passed and returned objects.
+func (*workerProfileConverter) ToExternal(wp *api.WorkerProfile) interface{} {
+	return &WorkerProfile{
+		Name:         wp.Name,
+		VMSize:       VMSize(wp.VMSize),
+		DiskSizeGB:   wp.DiskSizeGB,
+		SubnetID:     wp.SubnetID,
+		Count:        wp.Count,
+		SecurityType: SecurityType(wp.SecurityType),
+		NodeLabels:   nodeLabelsCopy(wp.NodeLabels),
+		NodeTaints:   nodeTaintsToExternal(wp.NodeTaints),
+	}
+}
+
+// ToInternal overwrites in place a pre-existing internal object, setting (only)
+// all mapped fields from the external representation. ToInternal modifies its
+// argument; there is no pointer aliasing between the passed and returned
+// objects
+func (*workerProfileConverter) ToInternal(_wp interface{}, out *api.WorkerProfile) {
+	wp := _wp.(*WorkerProfile)
+
+	out.Name = wp.Name
+	out.VMSize = api.VMSize(wp.VMSize)
+	out.DiskSizeGB = wp.DiskSizeGB
+	out.SubnetID = wp.SubnetID
+	out.Count = wp.Count
+	out.SecurityType = api.SecurityType(wp.SecurityType)
+	out.NodeLabels = nodeLabelsCopy(wp.NodeLabels)
+	out.NodeTaints = nodeTaintsToInternal(wp.NodeTaints)
+}
@@ -0,0 +1,46 @@
+package v20201031preview
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/deployment"
+)
+
+var rxWorkerProfileName = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,18}[a-z0-9])?$`)
+
+type workerProfileStaticValidator struct {
+	capabilities deployment.Capabilities
+}
+
+// Static validates a worker profile which is to be added to the OpenShift
+// cluster oc
+func (sv *workerProfileStaticValidator) Static(_wp interface{}, oc *api.OpenShiftCluster) error {
+	wp := _wp.(*WorkerProfile)
+
+	path := "properties.workerProfiles['" + wp.Name + "']"
+
+	if !rxWorkerProfileName.MatchString(wp.Name) ||
+		wp.Name == "worker" || wp.Name == "master" {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".name", "The provided worker name '%s' is invalid.", wp.Name)
+	}
+
+	for _, p := range oc.Properties.AdditionalWorkerProfiles {
+		if strings.EqualFold(p.Name, wp.Name) {
+			return api.NewCloudError(http.StatusConflict, api.CloudErrorCodeInvalidParameter, path+".name", "The provided worker name '%s' is already in use.", wp.Name)
+		}
+	}
+
+	ocsv := &openShiftClusterStaticValidator{
+		capabilities: sv.capabilities,
+	}
+
+	return ocsv.validateWorkerProfile(path, wp, &MasterProfile{
+		SubnetID: oc.Properties.MasterProfile.SubnetID,
+	}, true)
+}
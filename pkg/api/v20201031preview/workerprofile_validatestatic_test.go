@@ -0,0 +1,144 @@
+package v20201031preview
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"testing"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/deployment"
+)
+
+func TestWorkerProfileStaticValidate(t *testing.T) {
+	subnetPrefix := "/subscriptions/" + subscriptionID + "/resourceGroups/vnet/providers/Microsoft.Network/virtualNetworks/test-vnet/subnets/"
+
+	oc := &api.OpenShiftCluster{
+		Properties: api.OpenShiftClusterProperties{
+			MasterProfile: api.MasterProfile{
+				SubnetID: subnetPrefix + "master",
+			},
+			AdditionalWorkerProfiles: []api.WorkerProfile{
+				{
+					Name: "existing",
+				},
+			},
+		},
+	}
+
+	for _, tt := range []struct {
+		name    string
+		modify  func(*WorkerProfile)
+		wantErr string
+	}{
+		{
+			name: "valid",
+		},
+		{
+			name: "name invalid",
+			modify: func(wp *WorkerProfile) {
+				wp.Name = "Invalid_Name"
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['Invalid_Name'].name: The provided worker name 'Invalid_Name' is invalid.",
+		},
+		{
+			name: "name reserved",
+			modify: func(wp *WorkerProfile) {
+				wp.Name = "worker"
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['worker'].name: The provided worker name 'worker' is invalid.",
+		},
+		{
+			name: "name in use",
+			modify: func(wp *WorkerProfile) {
+				wp.Name = "existing"
+			},
+			wantErr: "409: InvalidParameter: properties.workerProfiles['existing'].name: The provided worker name 'existing' is already in use.",
+		},
+		{
+			name: "subnet same as master",
+			modify: func(wp *WorkerProfile) {
+				wp.SubnetID = subnetPrefix + "master"
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['gpu'].subnetId: The provided worker VM subnet '" + subnetPrefix + "master' is invalid: must be different to master VM subnet '" + subnetPrefix + "master'.",
+		},
+		{
+			name: "count invalid",
+			modify: func(wp *WorkerProfile) {
+				wp.Count = 21
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['gpu'].count: The provided worker count '21' is invalid.",
+		},
+		{
+			name: "arm64 vmSize valid",
+			modify: func(wp *WorkerProfile) {
+				wp.VMSize = VMSizeStandardD8psV5
+			},
+		},
+		{
+			name: "vmSize invalid",
+			modify: func(wp *WorkerProfile) {
+				wp.VMSize = "Standard_D2ps_v5"
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['gpu'].vmSize: The provided worker VM size 'Standard_D2ps_v5' is invalid.",
+		},
+		{
+			name: "trusted launch invalid",
+			modify: func(wp *WorkerProfile) {
+				wp.SecurityType = SecurityTypeTrustedLaunch
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['gpu'].securityType: The provided security type 'TrustedLaunch' is invalid: trusted launch is only supported for master VMs.",
+		},
+		{
+			name: "NoSchedule taint valid",
+			modify: func(wp *WorkerProfile) {
+				wp.NodeTaints = []NodeTaint{
+					{
+						Key:    "nvidia.com/gpu",
+						Effect: TaintEffectNoSchedule,
+					},
+				}
+			},
+		},
+		{
+			name: "node label invalid",
+			modify: func(wp *WorkerProfile) {
+				wp.NodeLabels = map[string]string{
+					"kubernetes.io/arch": "arm64",
+				}
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['gpu'].nodeLabels: The provided node label key 'kubernetes.io/arch' is invalid.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			wp := &WorkerProfile{
+				Name:       "gpu",
+				VMSize:     VMSizeStandardD4sV3,
+				DiskSizeGB: 128,
+				SubnetID:   subnetPrefix + "gpu",
+				Count:      3,
+			}
+			if tt.modify != nil {
+				tt.modify(wp)
+			}
+
+			capabilities, err := deployment.NewCapabilities(deployment.Production)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sv := &workerProfileStaticValidator{
+				capabilities: capabilities,
+			}
+
+			err = sv.Static(wp, oc)
+			if err == nil {
+				if tt.wantErr != "" {
+					t.Error(err)
+				}
+			} else if err.Error() != tt.wantErr {
+				t.Error(err)
+			}
+		})
+	}
+}
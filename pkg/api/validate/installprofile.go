@@ -0,0 +1,173 @@
+package validate
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"net/http"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/deployment"
+)
+
+// InstallProfile bundles the VM sizes, disk sizes and worker counts which a
+// cluster may be installed with
+type InstallProfile struct {
+	Name string
+
+	MasterVMSizes map[api.VMSize]bool
+	WorkerVMSizes map[api.VMSize]bool
+
+	// AllowArm64 allows the arm64 VM sizes for additional worker profiles
+	AllowArm64 bool
+
+	MinWorkerDiskSizeGB int
+
+	// MinWorkerCount and MaxWorkerCount bound the count of each worker
+	// profile, and MaxTotalWorkerCount, if set, the count of all of them
+	MinWorkerCount      int
+	MaxWorkerCount      int
+	MaxTotalWorkerCount int
+}
+
+var masterVMSizes = map[api.VMSize]bool{
+	api.VMSizeStandardD8sV3:  true,
+	api.VMSizeStandardD16sV3: true,
+	api.VMSizeStandardD32sV3: true,
+}
+
+// InstallProfileStandard is the profile of production clusters
+var InstallProfileStandard = &InstallProfile{
+	Name: "standard",
+
+	MasterVMSizes: masterVMSizes,
+	WorkerVMSizes: map[api.VMSize]bool{
+		api.VMSizeStandardD4asV4:  true,
+		api.VMSizeStandardD8asV4:  true,
+		api.VMSizeStandardD16asV4: true,
+		api.VMSizeStandardD32asV4: true,
+		api.VMSizeStandardD4sV3:   true,
+		api.VMSizeStandardD8sV3:   true,
+		api.VMSizeStandardD16sV3:  true,
+		api.VMSizeStandardD32sV3:  true,
+		api.VMSizeStandardE4sV3:   true,
+		api.VMSizeStandardE8sV3:   true,
+		api.VMSizeStandardE16sV3:  true,
+		api.VMSizeStandardE32sV3:  true,
+		api.VMSizeStandardF4sV2:   true,
+		api.VMSizeStandardF8sV2:   true,
+		api.VMSizeStandardF16sV2:  true,
+		api.VMSizeStandardF32sV2:  true,
+	},
+	AllowArm64: true,
+
+	MinWorkerDiskSizeGB: 128,
+
+	MinWorkerCount: 3,
+	MaxWorkerCount: 20,
+}
+
+// InstallProfileSmall is the profile of clusters with at most three workers
+// of at most 4 vCPUs, on which the managed components run with reduced
+// resource requests so that their overhead doesn't dominate the cluster.  A
+// compact cluster, without workers, is small too.
+var InstallProfileSmall = &InstallProfile{
+	Name: "small",
+
+	MasterVMSizes: masterVMSizes,
+	WorkerVMSizes: map[api.VMSize]bool{
+		api.VMSizeStandardD2sV3:  true,
+		api.VMSizeStandardD4asV4: true,
+		api.VMSizeStandardD4sV3:  true,
+		api.VMSizeStandardE4sV3:  true,
+	},
+
+	MinWorkerDiskSizeGB: 128,
+
+	MinWorkerCount:      1,
+	MaxWorkerCount:      3,
+	MaxTotalWorkerCount: 3,
+}
+
+// InstallProfileDev is the profile of development clusters, which are
+// restricted to the smallest worker VM size to keep their cost down
+var InstallProfileDev = &InstallProfile{
+	Name: "dev",
+
+	MasterVMSizes: masterVMSizes,
+	WorkerVMSizes: map[api.VMSize]bool{
+		api.VMSizeStandardD2sV3: true,
+	},
+	AllowArm64: true,
+
+	MinWorkerDiskSizeGB: 128,
+
+	MinWorkerCount: 3,
+	MaxWorkerCount: 20,
+}
+
+// InstallProfileFor returns the profile which clusters are validated against
+// given capabilities
+func InstallProfileFor(capabilities deployment.Capabilities) *InstallProfile {
+	if capabilities.RelaxedVMSizeValidation() {
+		return InstallProfileDev
+	}
+
+	return InstallProfileStandard
+}
+
+func (p *InstallProfile) MasterVMSizeIsValid(vmSize api.VMSize) bool {
+	return p.MasterVMSizes[vmSize]
+}
+
+func (p *InstallProfile) WorkerVMSizeIsValid(vmSize api.VMSize) bool {
+	return p.WorkerVMSizes[vmSize]
+}
+
+func (p *InstallProfile) DiskSizeIsValid(sizeGB int) bool {
+	return sizeGB >= p.MinWorkerDiskSizeGB
+}
+
+func (p *InstallProfile) WorkerCountIsValid(count int) bool {
+	return count >= p.MinWorkerCount && count <= p.MaxWorkerCount
+}
+
+// Validate validates oc as a whole against the profile.  Unlike the static
+// validators, which see one worker profile at a time, it also takes the
+// additional worker profiles into account.  Worker profiles which are scaled
+// to zero are not validated.
+func (p *InstallProfile) Validate(oc *api.OpenShiftCluster) error {
+	if !p.MasterVMSizeIsValid(oc.Properties.MasterProfile.VMSize) {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "properties.masterProfile.vmSize", "The provided master VM size '%s' is invalid for the %s install profile.", oc.Properties.MasterProfile.VMSize, p.Name)
+	}
+
+	var total int
+
+	wps := append(append([]api.WorkerProfile{}, oc.Properties.WorkerProfiles...), oc.Properties.AdditionalWorkerProfiles...)
+	for _, wp := range wps {
+		if wp.Count == 0 {
+			continue
+		}
+
+		path := "properties.workerProfiles['" + wp.Name + "']"
+
+		if !p.WorkerVMSizeIsValid(wp.VMSize) &&
+			!(p.AllowArm64 && wp.Name != "worker" && VMSizeIsArm64(wp.VMSize)) {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".vmSize", "The provided worker VM size '%s' is invalid for the %s install profile.", wp.VMSize, p.Name)
+		}
+		if !p.DiskSizeIsValid(wp.DiskSizeGB) {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".diskSizeGB", "The provided worker disk size '%d' is invalid for the %s install profile.", wp.DiskSizeGB, p.Name)
+		}
+		if !p.WorkerCountIsValid(wp.Count) {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".count", "The provided worker count '%d' is invalid for the %s install profile.", wp.Count, p.Name)
+		}
+
+		total += wp.Count
+	}
+
+	if p.MaxTotalWorkerCount > 0 && total > p.MaxTotalWorkerCount {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "properties.workerProfiles", "The provided total worker count '%d' is invalid for the %s install profile.", total, p.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+package validate
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"testing"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/deployment"
+)
+
+func TestInstallProfileFor(t *testing.T) {
+	for _, tt := range []struct {
+		deploymentMode deployment.Mode
+		want           *InstallProfile
+	}{
+		{
+			deploymentMode: deployment.Development,
+			want:           InstallProfileDev,
+		},
+		{
+			deploymentMode: deployment.Integration,
+			want:           InstallProfileStandard,
+		},
+		{
+			deploymentMode: deployment.Production,
+			want:           InstallProfileStandard,
+		},
+	} {
+		t.Run(tt.deploymentMode.String(), func(t *testing.T) {
+			capabilities, err := deployment.NewCapabilities(tt.deploymentMode)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := InstallProfileFor(capabilities); got != tt.want {
+				t.Error(got.Name)
+			}
+		})
+	}
+}
+
+func TestInstallProfileValidate(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		profile *InstallProfile
+		modify  func(*api.OpenShiftCluster)
+		wantErr string
+	}{
+		{
+			name:    "valid",
+			profile: InstallProfileStandard,
+		},
+		{
+			name:    "master vmSize invalid",
+			profile: InstallProfileStandard,
+			modify: func(oc *api.OpenShiftCluster) {
+				oc.Properties.MasterProfile.VMSize = api.VMSizeStandardD4sV3
+			},
+			wantErr: "400: InvalidParameter: properties.masterProfile.vmSize: The provided master VM size 'Standard_D4s_v3' is invalid for the standard install profile.",
+		},
+		{
+			name:    "worker vmSize invalid",
+			profile: InstallProfileDev,
+			wantErr: "400: InvalidParameter: properties.workerProfiles['worker'].vmSize: The provided worker VM size 'Standard_D4s_v3' is invalid for the dev install profile.",
+		},
+		{
+			name:    "worker disk too small",
+			profile: InstallProfileStandard,
+			modify: func(oc *api.OpenShiftCluster) {
+				oc.Properties.WorkerProfiles[0].DiskSizeGB = 127
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['worker'].diskSizeGB: The provided worker disk size '127' is invalid for the standard install profile.",
+		},
+		{
+			name:    "worker count too big",
+			profile: InstallProfileStandard,
+			modify: func(oc *api.OpenShiftCluster) {
+				oc.Properties.WorkerProfiles[0].Count = 21
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['worker'].count: The provided worker count '21' is invalid for the standard install profile.",
+		},
+		{
+			name:    "arm64 additional worker profile",
+			profile: InstallProfileStandard,
+			modify: func(oc *api.OpenShiftCluster) {
+				oc.Properties.AdditionalWorkerProfiles = []api.WorkerProfile{
+					{
+						Name:       "arm",
+						VMSize:     api.VMSizeStandardD4psV5,
+						DiskSizeGB: 128,
+						Count:      3,
+					},
+				}
+			},
+		},
+		{
+			name:    "arm64 worker profile",
+			profile: InstallProfileStandard,
+			modify: func(oc *api.OpenShiftCluster) {
+				oc.Properties.WorkerProfiles[0].VMSize = api.VMSizeStandardD4psV5
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['worker'].vmSize: The provided worker VM size 'Standard_D4ps_v5' is invalid for the standard install profile.",
+		},
+		{
+			name:    "small",
+			profile: InstallProfileSmall,
+		},
+		{
+			name:    "small compact",
+			profile: InstallProfileSmall,
+			modify: func(oc *api.OpenShiftCluster) {
+				oc.Properties.WorkerProfiles[0].Count = 0
+			},
+		},
+		{
+			name:    "small with arm64 additional worker profile",
+			profile: InstallProfileSmall,
+			modify: func(oc *api.OpenShiftCluster) {
+				oc.Properties.WorkerProfiles[0].Count = 0
+				oc.Properties.AdditionalWorkerProfiles = []api.WorkerProfile{
+					{
+						Name:       "arm",
+						VMSize:     api.VMSizeStandardD4psV5,
+						DiskSizeGB: 128,
+						Count:      1,
+					},
+				}
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['arm'].vmSize: The provided worker VM size 'Standard_D4ps_v5' is invalid for the small install profile.",
+		},
+		{
+			name:    "small with too many workers in total",
+			profile: InstallProfileSmall,
+			modify: func(oc *api.OpenShiftCluster) {
+				oc.Properties.AdditionalWorkerProfiles = []api.WorkerProfile{
+					{
+						Name:       "extra",
+						VMSize:     api.VMSizeStandardD4sV3,
+						DiskSizeGB: 128,
+						Count:      1,
+					},
+				}
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles: The provided total worker count '4' is invalid for the small install profile.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			oc := &api.OpenShiftCluster{
+				Properties: api.OpenShiftClusterProperties{
+					MasterProfile: api.MasterProfile{
+						VMSize: api.VMSizeStandardD8sV3,
+					},
+					WorkerProfiles: []api.WorkerProfile{
+						{
+							Name:       "worker",
+							VMSize:     api.VMSizeStandardD4sV3,
+							DiskSizeGB: 128,
+							Count:      3,
+						},
+					},
+				},
+			}
+
+			if tt.modify != nil {
+				tt.modify(oc)
+			}
+
+			err := tt.profile.Validate(oc)
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Error(err)
+			}
+		})
+	}
+}
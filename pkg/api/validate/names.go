@@ -0,0 +1,152 @@
+package validate
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// The names of a cluster are validated here for the static validators of all
+// API versions, and the patterns are published in the swagger, so that names
+// which only fail once the install is underway are refused up front.
+
+// resourceGroupName matches the resource group names which ARM accepts,
+// restricted to ASCII
+const resourceGroupName = `[-a-zA-Z0-9_().]{0,89}[-a-zA-Z0-9_()]`
+
+const (
+	// ClusterNamePattern restricts cluster names to ASCII letters, digits,
+	// hyphens, underscores and periods, starting and ending with a letter or a
+	// digit.  The install derives the infrastructure ID, which prefixes the
+	// names of the cluster's Azure resources, from the lower cased cluster
+	// name by replacing any other character with a hyphen: a name of other
+	// characters leaves an infrastructure ID which Azure refuses.
+	ClusterNamePattern   = `^[a-zA-Z0-9]([-_.a-zA-Z0-9]*[a-zA-Z0-9])?$`
+	ClusterNameMinLength = 1
+
+	// ResourceGroupNamePattern matches the resource group names accepted for
+	// the cluster resource group
+	ResourceGroupNamePattern   = `^` + resourceGroupName + `$`
+	ResourceGroupNameMinLength = 1
+	ResourceGroupNameMaxLength = 90
+
+	// DomainMaxLength leaves room under the domain for the longest host name
+	// of the cluster's own routes, canary-openshift-ingress-canary.apps.<domain>,
+	// within the 253 characters which DNS allows
+	DomainMaxLength = 253 - len("canary-openshift-ingress-canary.apps.")
+
+	// managedDomainMaxLength keeps the common name of the wildcard ingress
+	// certificate of a managed domain, *.apps.<domain>, within the 64
+	// characters which X.509 allows
+	managedDomainMaxLength = 64 - len("*.apps.")
+)
+
+var (
+	RxClusterName       = regexp.MustCompile(ClusterNamePattern)
+	RxResourceGroupName = regexp.MustCompile(ResourceGroupNamePattern)
+)
+
+// ClusterName returns an error describing why name can't be the name of a new
+// cluster
+func ClusterName(name string) error {
+	if !RxClusterName.MatchString(name) {
+		return fmt.Errorf("must start and end with a letter or a digit and contain only ASCII letters, digits, hyphens, underscores and periods")
+	}
+
+	return nil
+}
+
+// ResourceGroupID returns an error describing why id can't be the ID of the
+// cluster resource group
+func ResourceGroupID(id string) error {
+	if RxResourceGroupID.MatchString(id) {
+		return nil
+	}
+
+	parts := strings.Split(id, "/")
+	if len(parts) == 5 && parts[0] == "" &&
+		strings.EqualFold(parts[1], "subscriptions") &&
+		strings.EqualFold(parts[3], "resourceGroups") {
+		if err := ResourceGroupName(parts[4]); err != nil {
+			return err
+		}
+	}
+
+	return fmt.Errorf("must be of the form /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}")
+}
+
+// ResourceGroupName returns an error describing why name can't be the name of
+// the cluster resource group
+func ResourceGroupName(name string) error {
+	switch {
+	case len(name) < ResourceGroupNameMinLength || len(name) > ResourceGroupNameMaxLength:
+		return fmt.Errorf("the resource group name must be %d to %d characters long", ResourceGroupNameMinLength, ResourceGroupNameMaxLength)
+	case !isASCII(name):
+		return fmt.Errorf("the resource group name must contain only ASCII letters, digits, hyphens, underscores, parentheses and periods")
+	case strings.HasSuffix(name, "."):
+		return fmt.Errorf("the resource group name must not end with a period")
+	case !RxResourceGroupName.MatchString(name):
+		return fmt.Errorf("the resource group name must contain only letters, digits, hyphens, underscores, parentheses and periods")
+	}
+
+	return nil
+}
+
+// Domain returns an error describing why domain can't be the domain of a
+// cluster of an RP whose managed domain is rpDomain (e.g. location.aroapp.io)
+func Domain(domain, rpDomain string) error {
+	switch {
+	case domain == "":
+		return fmt.Errorf("must not be empty")
+	case !isASCII(domain):
+		return fmt.Errorf("must contain only ASCII characters: internationalized domain names must be given in their punycode form")
+	case strings.ToLower(domain) != domain:
+		return fmt.Errorf("must be lower case")
+	case !RxDomainName.MatchString(domain):
+		return fmt.Errorf("must be a DNS name whose first label starts with a letter")
+	}
+
+	// domain ends .aroapp.io, but doesn't end .<rp-location>.aroapp.io
+	if strings.HasSuffix(domain, "."+strings.SplitN(rpDomain, ".", 2)[1]) &&
+		!strings.HasSuffix(domain, "."+rpDomain) {
+		return fmt.Errorf("a managed domain must end with .%s", rpDomain)
+	}
+
+	// domain is of form multiple.names.<rp-location>.aroapp.io
+	if strings.HasSuffix(domain, "."+rpDomain) &&
+		strings.ContainsRune(strings.TrimSuffix(domain, "."+rpDomain), '.') {
+		return fmt.Errorf("a managed domain must have a single label before .%s", rpDomain)
+	}
+
+	return nil
+}
+
+// DomainLength returns an error if domain, which is valid, is too long for the
+// install to succeed.  It is checked on creation only, as the domain can't
+// change afterwards.
+func DomainLength(domain, rpDomain string) error {
+	if len(domain) > DomainMaxLength {
+		return fmt.Errorf("must be at most %d characters long", DomainMaxLength)
+	}
+
+	if strings.HasSuffix(domain, "."+rpDomain) &&
+		len(domain) > managedDomainMaxLength {
+		return fmt.Errorf("a managed domain must be at most %d characters long", managedDomainMaxLength)
+	}
+
+	return nil
+}
+
+func isASCII(s string) bool {
+	for _, r := range s {
+		if r > unicode.MaxASCII {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,173 @@
+package validate
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestClusterName(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		value   string
+		wantErr string
+	}{
+		{
+			name:  "valid",
+			value: "cluster",
+		},
+		{
+			name:  "valid with hyphens, underscores and periods",
+			value: "My_cluster-1.test",
+		},
+		{
+			name:    "empty",
+			wantErr: "must start and end with a letter or a digit and contain only ASCII letters, digits, hyphens, underscores and periods",
+		},
+		{
+			name:    "unicode",
+			value:   "clüster",
+			wantErr: "must start and end with a letter or a digit and contain only ASCII letters, digits, hyphens, underscores and periods",
+		},
+		{
+			name:    "trailing hyphen",
+			value:   "cluster-",
+			wantErr: "must start and end with a letter or a digit and contain only ASCII letters, digits, hyphens, underscores and periods",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ClusterName(tt.value)
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestResourceGroupID(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		value   string
+		wantErr string
+	}{
+		{
+			name:  "valid",
+			value: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/cluster(1)",
+		},
+		{
+			name:    "not an ID",
+			value:   "cluster",
+			wantErr: "must be of the form /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}",
+		},
+		{
+			name:    "unicode name",
+			value:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/clüster",
+			wantErr: "the resource group name must contain only ASCII letters, digits, hyphens, underscores, parentheses and periods",
+		},
+		{
+			name:    "trailing period",
+			value:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/cluster.",
+			wantErr: "the resource group name must not end with a period",
+		},
+		{
+			name:    "too long",
+			value:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/" + strings.Repeat("a", 91),
+			wantErr: "the resource group name must be 1 to 90 characters long",
+		},
+		{
+			name:    "invalid characters",
+			value:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/cluster!",
+			wantErr: "the resource group name must contain only letters, digits, hyphens, underscores, parentheses and periods",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ResourceGroupID(tt.value)
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestDomain(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		value   string
+		wantErr string
+	}{
+		{
+			name:  "valid managed domain",
+			value: "cluster.location.aroapp.io",
+		},
+		{
+			name:  "valid custom domain",
+			value: "cluster.example.com",
+		},
+		{
+			name:  "valid punycode domain",
+			value: "cluster.xn--exmple-cua.com",
+		},
+		{
+			name:    "unicode",
+			value:   "cluster.exämple.com",
+			wantErr: "must contain only ASCII characters: internationalized domain names must be given in their punycode form",
+		},
+		{
+			name:    "upper case",
+			value:   "Cluster.example.com",
+			wantErr: "must be lower case",
+		},
+		{
+			name:    "managed domain in another location",
+			value:   "cluster.otherlocation.aroapp.io",
+			wantErr: "a managed domain must end with .location.aroapp.io",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Domain(tt.value, "location.aroapp.io")
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestDomainLength(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		value   string
+		wantErr string
+	}{
+		{
+			name:  "valid managed domain",
+			value: strings.Repeat("a", 38) + ".location.aroapp.io",
+		},
+		{
+			name:    "managed domain too long",
+			value:   strings.Repeat("a", 39) + ".location.aroapp.io",
+			wantErr: "a managed domain must be at most 57 characters long",
+		},
+		{
+			name:  "valid custom domain",
+			value: strings.Repeat(strings.Repeat("a", 60)+".", 3) + strings.Repeat("a", 29) + ".com",
+		},
+		{
+			name:    "custom domain too long",
+			value:   strings.Repeat(strings.Repeat("a", 60)+".", 3) + strings.Repeat("a", 30) + ".com",
+			wantErr: "must be at most 216 characters long",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := DomainLength(tt.value, "location.aroapp.io")
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Error(err)
+			}
+		})
+	}
+}
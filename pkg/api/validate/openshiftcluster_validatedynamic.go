@@ -27,8 +27,10 @@ import (
 	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/compute"
 	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/features"
 	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/network"
+	"github.com/Azure/ARO-RP/pkg/util/egress"
 	utilpermissions "github.com/Azure/ARO-RP/pkg/util/permissions"
 	"github.com/Azure/ARO-RP/pkg/util/refreshable"
+	"github.com/Azure/ARO-RP/pkg/util/resolver"
 	"github.com/Azure/ARO-RP/pkg/util/subnet"
 )
 
@@ -53,6 +55,9 @@ func NewOpenShiftClusterDynamicValidator(log *logrus.Entry, env env.Interface, o
 		fpAuthorizer: fpAuthorizer,
 
 		fpPermissions: authorization.NewPermissionsClient(subscriptionDoc.ID, fpAuthorizer),
+		fpDeployments: features.NewDeploymentsClient(subscriptionDoc.ID, fpAuthorizer),
+
+		newResolver: resolver.NewForServer,
 	}, nil
 }
 
@@ -72,11 +77,15 @@ type openShiftClusterDynamicValidator struct {
 
 	fpAuthorizer refreshable.Authorizer
 
-	fpPermissions     authorization.PermissionsClient
-	spPermissions     authorization.PermissionsClient
-	spProviders       features.ProvidersClient
-	spUsage           compute.UsageClient
-	spVirtualNetworks network.VirtualNetworksClient
+	fpPermissions       authorization.PermissionsClient
+	fpDeployments       features.DeploymentsClient
+	spPermissions       authorization.PermissionsClient
+	spProviders         features.ProvidersClient
+	spUsage             compute.UsageClient
+	spVirtualNetworks   network.VirtualNetworksClient
+	spPublicIPAddresses network.PublicIPAddressesClient
+
+	newResolver func(server string) resolver.Resolver
 }
 
 // Dynamic validates an OpenShift cluster
@@ -99,6 +108,7 @@ func (dv *openShiftClusterDynamicValidator) Dynamic(ctx context.Context) error {
 	dv.spProviders = features.NewProvidersClient(r.SubscriptionID, spAuthorizer)
 	dv.spUsage = compute.NewUsageClient(r.SubscriptionID, spAuthorizer)
 	dv.spVirtualNetworks = network.NewVirtualNetworksClient(r.SubscriptionID, spAuthorizer)
+	dv.spPublicIPAddresses = network.NewPublicIPAddressesClient(r.SubscriptionID, spAuthorizer)
 
 	vnetID, _, err := subnet.Split(dv.oc.Properties.MasterProfile.SubnetID)
 	if err != nil {
@@ -141,16 +151,36 @@ func (dv *openShiftClusterDynamicValidator) Dynamic(ctx context.Context) error {
 		return err
 	}
 
+	err = dv.validatePublicIPAddresses(ctx, spAuthorizer)
+	if err != nil {
+		return err
+	}
+
 	err = dv.validateProviders(ctx)
 	if err != nil {
 		return err
 	}
 
 	if dv.oc.Properties.ProvisioningState == api.ProvisioningStateCreating {
+		err = InstallProfileFor(dv.env).Validate(dv.oc)
+		if err != nil {
+			return err
+		}
+
 		err = dv.validateQuotas(ctx)
 		if err != nil {
 			return err
 		}
+
+		err = dv.validateTrustedLaunch()
+		if err != nil {
+			return err
+		}
+
+		err = dv.validatePolicies(ctx)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -248,6 +278,101 @@ func (dv *openShiftClusterDynamicValidator) validateRouteTablePermissionsSubnet(
 	return err
 }
 
+// validatePublicIPAddresses validates the customer's public IP addresses, if
+// any, which front the API server and the ingress
+func (dv *openShiftClusterDynamicValidator) validatePublicIPAddresses(ctx context.Context, spAuthorizer refreshable.Authorizer) error {
+	if dv.oc.Properties.APIServerProfile.PublicIPAddressID != "" {
+		err := dv.validatePublicIPAddressID(ctx, spAuthorizer, "properties.apiserverProfile.publicIpAddressId", dv.oc.Properties.APIServerProfile.PublicIPAddressID)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, p := range dv.oc.Properties.IngressProfiles {
+		if p.PublicIPAddressID != "" {
+			err := dv.validatePublicIPAddressID(ctx, spAuthorizer, "properties.ingressProfiles['"+p.Name+"'].publicIpAddressId", p.PublicIPAddressID)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (dv *openShiftClusterDynamicValidator) validatePublicIPAddressID(ctx context.Context, spAuthorizer refreshable.Authorizer, path, publicIPAddressID string) error {
+	r, err := azure.ParseResourceID(publicIPAddressID)
+	if err != nil {
+		return err
+	}
+
+	err = dv.validatePublicIPAddressPermissions(ctx, spAuthorizer, dv.spPermissions, publicIPAddressID, &r, api.CloudErrorCodeInvalidServicePrincipalPermissions, "provided service principal")
+	if err != nil {
+		return err
+	}
+
+	err = dv.validatePublicIPAddressPermissions(ctx, dv.fpAuthorizer, dv.fpPermissions, publicIPAddressID, &r, api.CloudErrorCodeInvalidResourceProviderPermissions, "resource provider")
+	if err != nil {
+		return err
+	}
+
+	// Get after validating permissions
+	ip, err := dv.spPublicIPAddresses.Get(ctx, r.ResourceGroup, r.ResourceName, "")
+	if err != nil {
+		return err
+	}
+
+	return dv.validatePublicIPAddress(&ip, path, publicIPAddressID)
+}
+
+func (dv *openShiftClusterDynamicValidator) validatePublicIPAddressPermissions(ctx context.Context, authorizer refreshable.Authorizer, client authorization.PermissionsClient, publicIPAddressID string, r *azure.Resource, code, typ string) error {
+	dv.log.Printf("validatePublicIPAddressPermissions (%s)", typ)
+
+	err := validateActions(ctx, dv.log, r, []string{
+		"Microsoft.Network/publicIPAddresses/join/action",
+		"Microsoft.Network/publicIPAddresses/read",
+	}, authorizer, client)
+	if err == wait.ErrWaitTimeout {
+		return api.NewCloudError(http.StatusBadRequest, code, "", "The %s does not have Network Contributor permission on public IP address '%s'.", typ, publicIPAddressID)
+	}
+	if detailedErr, ok := err.(autorest.DetailedError); ok &&
+		detailedErr.StatusCode == http.StatusNotFound {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidLinkedPublicIPAddress, "", "The public IP address '%s' could not be found.", publicIPAddressID)
+	}
+	return err
+}
+
+// validatePublicIPAddress checks that ip can front a standard load balancer of
+// the cluster.  A customer is expected to allocate it from their own public IP
+// prefix, if they want a range of addresses which they already trust.
+func (dv *openShiftClusterDynamicValidator) validatePublicIPAddress(ip *mgmtnetwork.PublicIPAddress, path, publicIPAddressID string) error {
+	dv.log.Printf("validatePublicIPAddress (%s)", path)
+
+	if ip.Location == nil || !strings.EqualFold(*ip.Location, dv.oc.Location) {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidLinkedPublicIPAddress, path, "The provided public IP address '%s' is invalid: must be in the same location as the cluster.", publicIPAddressID)
+	}
+
+	if ip.Sku == nil || ip.Sku.Name != mgmtnetwork.PublicIPAddressSkuNameStandard {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidLinkedPublicIPAddress, path, "The provided public IP address '%s' is invalid: must have the Standard SKU.", publicIPAddressID)
+	}
+
+	if ip.PublicIPAddressPropertiesFormat == nil ||
+		ip.PublicIPAllocationMethod != mgmtnetwork.Static {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidLinkedPublicIPAddress, path, "The provided public IP address '%s' is invalid: must be statically allocated.", publicIPAddressID)
+	}
+
+	if ip.PublicIPAddressVersion != mgmtnetwork.IPv4 {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidLinkedPublicIPAddress, path, "The provided public IP address '%s' is invalid: must be IPv4.", publicIPAddressID)
+	}
+
+	if dv.oc.Properties.ProvisioningState == api.ProvisioningStateCreating &&
+		ip.IPConfiguration != nil {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidLinkedPublicIPAddress, path, "The provided public IP address '%s' is invalid: must not be in use.", publicIPAddressID)
+	}
+
+	return nil
+}
+
 func (dv *openShiftClusterDynamicValidator) validateSubnet(ctx context.Context, vnet *mgmtnetwork.VirtualNetwork, path, subnetID string) (*net.IPNet, error) {
 	dv.log.Printf("validateSubnet (%s)", path)
 
@@ -351,10 +476,66 @@ func (dv *openShiftClusterDynamicValidator) validateVnet(ctx context.Context, vn
 		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidLinkedVNet, "", "The provided CIDRs must not overlap: '%s'.", err)
 	}
 
+	if dv.oc.Properties.NetworkProfile.MachineCIDR != "" {
+		_, machine, err := net.ParseCIDR(dv.oc.Properties.NetworkProfile.MachineCIDR)
+		if err != nil {
+			return err
+		}
+
+		err = cidr.VerifyNoOverlap([]*net.IPNet{master, worker}, machine)
+		if err != nil {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidLinkedVNet, "properties.networkProfile.machineCidr", "The provided machine CIDR '%s' is invalid: must contain the master and worker subnets.", dv.oc.Properties.NetworkProfile.MachineCIDR)
+		}
+	}
+
 	if vnet.DhcpOptions != nil &&
 		vnet.DhcpOptions.DNSServers != nil &&
 		len(*vnet.DhcpOptions.DNSServers) > 0 {
-		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidLinkedVNet, "", "The provided vnet '%s' is invalid: custom DNS servers are not supported.", *vnet.ID)
+		err = dv.validateDNSServers(ctx, vnet)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateDNSServers checks that the custom DNS servers of the vnet resolve
+// the names of the endpoints which the cluster depends on.  Only servers with
+// public addresses can be queried from the RP: servers with private addresses
+// are in (or peered with) the customer's network, and are checked from
+// within the cluster by the operator instead.
+func (dv *openShiftClusterDynamicValidator) validateDNSServers(ctx context.Context, vnet *mgmtnetwork.VirtualNetwork) error {
+	dv.log.Print("validateDNSServers")
+
+	endpoints, err := egress.RequiredEndpoints(dv.env, dv.oc)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(endpoints))
+	for _, e := range endpoints {
+		names = append(names, e.Host)
+	}
+
+	for _, server := range *vnet.DhcpOptions.DNSServers {
+		ip := net.ParseIP(server)
+		if ip == nil || !ip.IsGlobalUnicast() || ip.IsPrivate() {
+			continue
+		}
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+		unresolvable, err := resolver.Unresolvable(timeoutCtx, dv.newResolver(server), names)
+		cancel()
+		if err != nil {
+			// the server may only accept queries from the customer's network
+			dv.log.Warnf("DNS server %s is not reachable: %v", server, err)
+			continue
+		}
+
+		if len(unresolvable) > 0 {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidLinkedVNet, "", "The provided vnet '%s' is invalid: custom DNS server '%s' cannot resolve %s.", *vnet.ID, server, strings.Join(unresolvable, ", "))
+		}
 	}
 
 	return nil
@@ -6,6 +6,7 @@ package validate
 import (
 	"context"
 	"errors"
+	"net"
 	"net/http"
 	"testing"
 
@@ -21,7 +22,9 @@ import (
 	"github.com/Azure/ARO-RP/pkg/api"
 	mock_authorization "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/mgmt/authorization"
 	mock_features "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/mgmt/features"
+	mock_env "github.com/Azure/ARO-RP/pkg/util/mocks/env"
 	mockrefreshable "github.com/Azure/ARO-RP/pkg/util/mocks/refreshable"
+	"github.com/Azure/ARO-RP/pkg/util/resolver"
 )
 
 func TestValidateProviders(t *testing.T) {
@@ -339,13 +342,17 @@ func TestValidateVnet(t *testing.T) {
 			wantErr: "400: InvalidLinkedVNet: : The provided CIDRs must not overlap: '10.0.3.0/24 overlaps with 10.0.3.0/24'.",
 		},
 		{
-			name: "custom dns set",
-			modifyVnet: func(vnet *mgmtnetwork.VirtualNetwork) {
-				vnet.DhcpOptions.DNSServers = &[]string{
-					"172.16.1.1",
-				}
+			name: "machine cidr contains subnets",
+			modifyOC: func(oc *api.OpenShiftCluster) {
+				oc.Properties.NetworkProfile.MachineCIDR = "10.0.0.0/23"
+			},
+		},
+		{
+			name: "machine cidr does not contain subnets",
+			modifyOC: func(oc *api.OpenShiftCluster) {
+				oc.Properties.NetworkProfile.MachineCIDR = "10.0.0.0/24"
 			},
-			wantErr: "400: InvalidLinkedVNet: : The provided vnet '/subscriptions/0000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.Network/virtualNetworks/testVnet' is invalid: custom DNS servers are not supported.",
+			wantErr: "400: InvalidLinkedVNet: properties.networkProfile.machineCidr: The provided machine CIDR '10.0.0.0/24' is invalid: must contain the master and worker subnets.",
 		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
@@ -434,6 +441,98 @@ func TestValidateVnet(t *testing.T) {
 	}
 }
 
+type fakeResolver map[string]error
+
+func (f fakeResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	if err := f[host]; err != nil {
+		return nil, err
+	}
+
+	return []string{"1.2.3.4"}, nil
+}
+
+func TestValidateDNSServers(t *testing.T) {
+	ctx := context.Background()
+
+	vnetID := "/subscriptions/0000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.Network/virtualNetworks/testVnet"
+
+	for _, tt := range []struct {
+		name      string
+		servers   []string
+		resolvers map[string]fakeResolver
+		wantErr   string
+	}{
+		{
+			name:    "private servers are not queried",
+			servers: []string{"10.0.0.4", "172.16.1.1"},
+		},
+		{
+			name:    "public server resolves all names",
+			servers: []string{"10.0.0.4", "20.0.0.4"},
+			resolvers: map[string]fakeResolver{
+				"20.0.0.4": {},
+			},
+		},
+		{
+			name:    "public server does not resolve some names",
+			servers: []string{"20.0.0.4"},
+			resolvers: map[string]fakeResolver{
+				"20.0.0.4": {
+					"arosvc.eastus.data.azurecr.io": &net.DNSError{Err: "no such host", IsNotFound: true},
+					"management.azure.com":          &net.DNSError{Err: "server misbehaving", IsTemporary: true},
+				},
+			},
+			wantErr: "400: InvalidLinkedVNet: : The provided vnet '" + vnetID + "' is invalid: custom DNS server '20.0.0.4' cannot resolve arosvc.eastus.data.azurecr.io, management.azure.com.",
+		},
+		{
+			name:    "public server not reachable",
+			servers: []string{"20.0.0.4"},
+			resolvers: map[string]fakeResolver{
+				"20.0.0.4": {
+					"arosvc.azurecr.io": &net.DNSError{Err: "i/o timeout", IsTimeout: true},
+				},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			_env := mock_env.NewMockInterface(controller)
+			_env.EXPECT().Environment().AnyTimes().Return(&azure.PublicCloud)
+			_env.EXPECT().ACRDomain().AnyTimes().Return("arosvc.azurecr.io")
+
+			dv := &openShiftClusterDynamicValidator{
+				log: logrus.NewEntry(logrus.StandardLogger()),
+				env: _env,
+				oc: &api.OpenShiftCluster{
+					Location: "eastus",
+				},
+				newResolver: func(server string) resolver.Resolver {
+					r, found := tt.resolvers[server]
+					if !found {
+						t.Fatalf("unexpected query to %s", server)
+					}
+					return r
+				},
+			}
+
+			err := dv.validateDNSServers(ctx, &mgmtnetwork.VirtualNetwork{
+				ID: &vnetID,
+				VirtualNetworkPropertiesFormat: &mgmtnetwork.VirtualNetworkPropertiesFormat{
+					DhcpOptions: &mgmtnetwork.DhcpOptions{
+						DNSServers: &tt.servers,
+					},
+				},
+			})
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Error(err)
+			}
+		})
+	}
+}
+
 func TestValidateVnetPermissions(t *testing.T) {
 	ctx := context.Background()
 
@@ -656,3 +755,194 @@ func TestValidateRouteTablePermissionsSubnet(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatePublicIPAddressPermissions(t *testing.T) {
+	ctx := context.Background()
+
+	resourceGroupID := "/subscriptions/0000000-0000-0000-0000-000000000000/resourceGroups/testGroup"
+	publicIPAddressID := resourceGroupID + "/providers/Microsoft.Network/publicIPAddresses/testIP"
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dv := &openShiftClusterDynamicValidator{
+		log: logrus.NewEntry(logrus.StandardLogger()),
+	}
+
+	for _, tt := range []struct {
+		name    string
+		mocks   func(*mock_authorization.MockPermissionsClient, func())
+		wantErr string
+	}{
+		{
+			name: "pass",
+			mocks: func(permissionsClient *mock_authorization.MockPermissionsClient, cancel func()) {
+				permissionsClient.EXPECT().
+					ListForResource(gomock.Any(), "testGroup", "Microsoft.Network", "", "publicIPAddresses", "testIP").
+					Return([]mgmtauthorization.Permission{
+						{
+							Actions: &[]string{
+								"Microsoft.Network/publicIPAddresses/join/action",
+								"Microsoft.Network/publicIPAddresses/read",
+							},
+							NotActions: &[]string{},
+						},
+					}, nil)
+			},
+		},
+		{
+			name: "fail: missing permissions",
+			mocks: func(permissionsClient *mock_authorization.MockPermissionsClient, cancel func()) {
+				permissionsClient.EXPECT().
+					ListForResource(gomock.Any(), "testGroup", "Microsoft.Network", "", "publicIPAddresses", "testIP").
+					Do(func(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) {
+						cancel()
+					}).
+					Return(
+						[]mgmtauthorization.Permission{
+							{
+								Actions: &[]string{
+									"Microsoft.Network/publicIPAddresses/read",
+								},
+								NotActions: &[]string{},
+							},
+						},
+						nil,
+					)
+			},
+			wantErr: "400: InvalidResourceProviderPermissions: : The resource provider does not have Network Contributor permission on public IP address '/subscriptions/0000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.Network/publicIPAddresses/testIP'.",
+		},
+		{
+			name: "fail: not found",
+			mocks: func(permissionsClient *mock_authorization.MockPermissionsClient, cancel func()) {
+				permissionsClient.EXPECT().
+					ListForResource(gomock.Any(), "testGroup", "Microsoft.Network", "", "publicIPAddresses", "testIP").
+					Do(func(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) {
+						cancel()
+					}).
+					Return(
+						nil,
+						autorest.DetailedError{
+							StatusCode: http.StatusNotFound,
+						},
+					)
+			},
+			wantErr: "400: InvalidLinkedPublicIPAddress: : The public IP address '/subscriptions/0000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.Network/publicIPAddresses/testIP' could not be found.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			permissionsClient := mock_authorization.NewMockPermissionsClient(controller)
+			tt.mocks(permissionsClient, cancel)
+
+			r, err := azure.ParseResourceID(publicIPAddressID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = dv.validatePublicIPAddressPermissions(ctx, mockrefreshable.NewMockAuthorizer(controller), permissionsClient, publicIPAddressID, &r, api.CloudErrorCodeInvalidResourceProviderPermissions, "resource provider")
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestValidatePublicIPAddress(t *testing.T) {
+	publicIPAddressID := "/subscriptions/0000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.Network/publicIPAddresses/testIP"
+
+	for _, tt := range []struct {
+		name              string
+		provisioningState api.ProvisioningState
+		modify            func(*mgmtnetwork.PublicIPAddress)
+		wantErr           string
+	}{
+		{
+			name:              "pass",
+			provisioningState: api.ProvisioningStateCreating,
+		},
+		{
+			name:              "pass (in use by the cluster)",
+			provisioningState: api.ProvisioningStateUpdating,
+			modify: func(ip *mgmtnetwork.PublicIPAddress) {
+				ip.IPConfiguration = &mgmtnetwork.IPConfiguration{}
+			},
+		},
+		{
+			name:              "fail: in use",
+			provisioningState: api.ProvisioningStateCreating,
+			modify: func(ip *mgmtnetwork.PublicIPAddress) {
+				ip.IPConfiguration = &mgmtnetwork.IPConfiguration{}
+			},
+			wantErr: "400: InvalidLinkedPublicIPAddress: properties.apiserverProfile.publicIpAddressId: The provided public IP address '" + publicIPAddressID + "' is invalid: must not be in use.",
+		},
+		{
+			name:              "fail: location",
+			provisioningState: api.ProvisioningStateCreating,
+			modify: func(ip *mgmtnetwork.PublicIPAddress) {
+				ip.Location = to.StringPtr("westus")
+			},
+			wantErr: "400: InvalidLinkedPublicIPAddress: properties.apiserverProfile.publicIpAddressId: The provided public IP address '" + publicIPAddressID + "' is invalid: must be in the same location as the cluster.",
+		},
+		{
+			name:              "fail: basic sku",
+			provisioningState: api.ProvisioningStateCreating,
+			modify: func(ip *mgmtnetwork.PublicIPAddress) {
+				ip.Sku.Name = mgmtnetwork.PublicIPAddressSkuNameBasic
+			},
+			wantErr: "400: InvalidLinkedPublicIPAddress: properties.apiserverProfile.publicIpAddressId: The provided public IP address '" + publicIPAddressID + "' is invalid: must have the Standard SKU.",
+		},
+		{
+			name:              "fail: dynamic",
+			provisioningState: api.ProvisioningStateCreating,
+			modify: func(ip *mgmtnetwork.PublicIPAddress) {
+				ip.PublicIPAllocationMethod = mgmtnetwork.Dynamic
+			},
+			wantErr: "400: InvalidLinkedPublicIPAddress: properties.apiserverProfile.publicIpAddressId: The provided public IP address '" + publicIPAddressID + "' is invalid: must be statically allocated.",
+		},
+		{
+			name:              "fail: ipv6",
+			provisioningState: api.ProvisioningStateCreating,
+			modify: func(ip *mgmtnetwork.PublicIPAddress) {
+				ip.PublicIPAddressVersion = mgmtnetwork.IPv6
+			},
+			wantErr: "400: InvalidLinkedPublicIPAddress: properties.apiserverProfile.publicIpAddressId: The provided public IP address '" + publicIPAddressID + "' is invalid: must be IPv4.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dv := &openShiftClusterDynamicValidator{
+				log: logrus.NewEntry(logrus.StandardLogger()),
+				oc: &api.OpenShiftCluster{
+					Location: "eastus",
+					Properties: api.OpenShiftClusterProperties{
+						ProvisioningState: tt.provisioningState,
+					},
+				},
+			}
+
+			ip := &mgmtnetwork.PublicIPAddress{
+				Location: to.StringPtr("eastus"),
+				Sku: &mgmtnetwork.PublicIPAddressSku{
+					Name: mgmtnetwork.PublicIPAddressSkuNameStandard,
+				},
+				PublicIPAddressPropertiesFormat: &mgmtnetwork.PublicIPAddressPropertiesFormat{
+					PublicIPAllocationMethod: mgmtnetwork.Static,
+					PublicIPAddressVersion:   mgmtnetwork.IPv4,
+				},
+			}
+
+			if tt.modify != nil {
+				tt.modify(ip)
+			}
+
+			err := dv.validatePublicIPAddress(ip, "properties.apiserverProfile.publicIpAddressId", publicIPAddressID)
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Error(err)
+			}
+		})
+	}
+}
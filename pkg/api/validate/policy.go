@@ -0,0 +1,151 @@
+package validate
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	mgmtcompute "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
+	mgmtfeatures "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-07-01/features"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/to"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/arm"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
+	"github.com/Azure/ARO-RP/pkg/util/azureerrors"
+	"github.com/Azure/ARO-RP/pkg/util/stringutils"
+)
+
+const policyPreflightDeploymentName = "policy-preflight"
+
+// nestedDeployment is a Microsoft.Resources/deployments resource targeting a
+// resource group of a subscription level template
+type nestedDeployment struct {
+	ResourceGroup string                             `json:"resourceGroup,omitempty"`
+	Properties    *mgmtfeatures.DeploymentProperties `json:"properties,omitempty"`
+}
+
+// validatePolicies simulates the creation of the cluster resource group and
+// virtual machines against the Azure Policy assignments of the subscription,
+// so that a cluster which would be denied by a policy (for example one
+// restricting regions or VM SKUs, or requiring tags) fails before install
+// starts.  ARM evaluates deny policies when validating a deployment, which
+// creates nothing.
+//
+// Only policy violations fail validation: the simulated resources are
+// incomplete, so other validation errors are expected and ignored.
+func (dv *openShiftClusterDynamicValidator) validatePolicies(ctx context.Context) error {
+	dv.log.Print("validatePolicies")
+
+	t, err := dv.policyPreflightTemplate()
+	if err != nil {
+		return err
+	}
+
+	result, err := dv.fpDeployments.ValidateAtSubscriptionScope(ctx, policyPreflightDeploymentName, mgmtfeatures.Deployment{
+		Location: &dv.oc.Location,
+		Properties: &mgmtfeatures.DeploymentProperties{
+			Template: t,
+			Mode:     mgmtfeatures.Incremental,
+		},
+	})
+	if err == nil && result.Error != nil {
+		var serviceErr *azure.ServiceError
+		b, _ := json.Marshal(result.Error)
+		if json.Unmarshal(b, &serviceErr) == nil {
+			err = serviceErr
+		}
+	}
+	if err == nil {
+		return nil
+	}
+
+	if policyID, ok := azureerrors.IsRequestDisallowedByPolicyError(err); ok {
+		if policyID == "" {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeRequestDisallowedByPolicy, "", "The cluster would be disallowed by an Azure Policy assignment of the subscription.")
+		}
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeRequestDisallowedByPolicy, "", "The cluster would be disallowed by Azure Policy '%s'.", policyID)
+	}
+
+	dv.log.Infof("ignoring policy preflight error: %s", err)
+	return nil
+}
+
+func (dv *openShiftClusterDynamicValidator) policyPreflightTemplate() (*arm.Template, error) {
+	resourceGroup := stringutils.LastTokenByte(dv.oc.Properties.ClusterProfile.ResourceGroupID, '/')
+
+	vms := []*arm.Resource{
+		policyPreflightVM("master", dv.oc.Location, dv.oc.Properties.MasterProfile.VMSize),
+	}
+	for _, wp := range dv.oc.Properties.WorkerProfiles {
+		vms = append(vms, policyPreflightVM(wp.Name, dv.oc.Location, wp.VMSize))
+	}
+
+	// arm.Resource marshals itself, which it can't do nested in a template
+	// held by an interface{}: pass the nested template on as plain JSON
+	b, err := json.Marshal(&arm.Template{
+		Schema:         "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+		ContentVersion: "1.0.0.0",
+		Resources:      vms,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var nested map[string]interface{}
+	err = json.Unmarshal(b, &nested)
+	if err != nil {
+		return nil, err
+	}
+
+	return &arm.Template{
+		Schema:         "https://schema.management.azure.com/schemas/2018-05-01/subscriptionDeploymentTemplate.json#",
+		ContentVersion: "1.0.0.0",
+		Resources: []*arm.Resource{
+			{
+				Resource: &mgmtfeatures.ResourceGroup{
+					Name:      &resourceGroup,
+					Type:      to.StringPtr("Microsoft.Resources/resourceGroups"),
+					Location:  &dv.oc.Location,
+					ManagedBy: &dv.oc.ID,
+				},
+				APIVersion: azureclient.APIVersion("Microsoft.Resources"),
+			},
+			{
+				Resource: &nestedDeployment{
+					ResourceGroup: resourceGroup,
+					Properties: &mgmtfeatures.DeploymentProperties{
+						Template: nested,
+						Mode:     mgmtfeatures.Incremental,
+					},
+				},
+				Name:       policyPreflightDeploymentName,
+				Type:       "Microsoft.Resources/deployments",
+				APIVersion: azureclient.APIVersion("Microsoft.Resources"),
+				DependsOn: []string{
+					"[resourceId('Microsoft.Resources/resourceGroups', '" + resourceGroup + "')]",
+				},
+			},
+		},
+	}, nil
+}
+
+func policyPreflightVM(name, location string, vmSize api.VMSize) *arm.Resource {
+	return &arm.Resource{
+		Resource: &mgmtcompute.VirtualMachine{
+			VirtualMachineProperties: &mgmtcompute.VirtualMachineProperties{
+				HardwareProfile: &mgmtcompute.HardwareProfile{
+					VMSize: mgmtcompute.VirtualMachineSizeTypes(vmSize),
+				},
+			},
+			Name:     &name,
+			Type:     to.StringPtr("Microsoft.Compute/virtualMachines"),
+			Location: &location,
+		},
+		APIVersion: azureclient.APIVersion("Microsoft.Compute"),
+	}
+}
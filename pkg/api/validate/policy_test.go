@@ -0,0 +1,176 @@
+package validate
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	mgmtfeatures "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-07-01/features"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	mock_features "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/mgmt/features"
+)
+
+func TestValidatePolicies(t *testing.T) {
+	ctx := context.Background()
+
+	policyViolation := []mgmtfeatures.ErrorAdditionalInfo{
+		{
+			Type: to.StringPtr("PolicyViolation"),
+			Info: map[string]interface{}{
+				"policyDefinitionId": "/providers/Microsoft.Authorization/policyDefinitions/cccc23c7-8427-4f53-ad12-b6a63eb452b3",
+				"policyAssignmentId": "/subscriptions/subscriptionId/providers/Microsoft.Authorization/policyAssignments/allowed-skus",
+			},
+		},
+	}
+
+	for _, tt := range []struct {
+		name    string
+		result  mgmtfeatures.DeploymentValidateResult
+		err     error
+		wantErr string
+	}{
+		{
+			name: "valid",
+		},
+		{
+			name: "disallowed by policy",
+			result: mgmtfeatures.DeploymentValidateResult{
+				Error: &mgmtfeatures.ErrorResponse{
+					Code:    to.StringPtr("InvalidTemplateDeployment"),
+					Message: to.StringPtr("The template deployment failed because of policy violation. Please see details for more information."),
+					Details: &[]mgmtfeatures.ErrorResponse{
+						{
+							Code:           to.StringPtr("RequestDisallowedByPolicy"),
+							Message:        to.StringPtr("Resource 'worker' was disallowed by policy."),
+							AdditionalInfo: &policyViolation,
+						},
+					},
+				},
+			},
+			wantErr: "400: RequestDisallowedByPolicy: : The cluster would be disallowed by Azure Policy '/subscriptions/subscriptionId/providers/Microsoft.Authorization/policyAssignments/allowed-skus'.",
+		},
+		{
+			name: "disallowed by policy without policy ID",
+			err: autorest.DetailedError{
+				Original: &azure.ServiceError{
+					Code:    "RequestDisallowedByPolicy",
+					Message: "Resource 'aro-cluster' was disallowed by policy.",
+				},
+				StatusCode: http.StatusForbidden,
+			},
+			wantErr: "400: RequestDisallowedByPolicy: : The cluster would be disallowed by an Azure Policy assignment of the subscription.",
+		},
+		{
+			name: "other validation errors are ignored",
+			result: mgmtfeatures.DeploymentValidateResult{
+				Error: &mgmtfeatures.ErrorResponse{
+					Code:    to.StringPtr("InvalidTemplateDeployment"),
+					Message: to.StringPtr("The template deployment 'policy-preflight' is not valid according to the validation procedure."),
+					Details: &[]mgmtfeatures.ErrorResponse{
+						{
+							Code:    to.StringPtr("InvalidParameter"),
+							Message: to.StringPtr("Required parameter 'osProfile' is missing (null)."),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "request errors are ignored",
+			err:  errors.New("random error"),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			deployments := mock_features.NewMockDeploymentsClient(controller)
+			deployments.EXPECT().
+				ValidateAtSubscriptionScope(ctx, "policy-preflight", gomock.Any()).
+				Return(tt.result, tt.err)
+
+			dv := &openShiftClusterDynamicValidator{
+				log: logrus.NewEntry(logrus.StandardLogger()),
+				oc: &api.OpenShiftCluster{
+					ID:       "/subscriptions/subscriptionId/resourceGroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName",
+					Location: "eastus",
+					Properties: api.OpenShiftClusterProperties{
+						ClusterProfile: api.ClusterProfile{
+							ResourceGroupID: "/subscriptions/subscriptionId/resourceGroups/aro-cluster",
+						},
+						MasterProfile: api.MasterProfile{
+							VMSize: api.VMSizeStandardD8sV3,
+						},
+						WorkerProfiles: []api.WorkerProfile{
+							{
+								Name:   "worker",
+								VMSize: api.VMSizeStandardD4sV3,
+							},
+						},
+					},
+				},
+				fpDeployments: deployments,
+			}
+
+			err := dv.validatePolicies(ctx)
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestPolicyPreflightTemplate(t *testing.T) {
+	dv := &openShiftClusterDynamicValidator{
+		oc: &api.OpenShiftCluster{
+			ID:       "/subscriptions/subscriptionId/resourceGroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName",
+			Location: "eastus",
+			Properties: api.OpenShiftClusterProperties{
+				ClusterProfile: api.ClusterProfile{
+					ResourceGroupID: "/subscriptions/subscriptionId/resourceGroups/aro-cluster",
+				},
+				MasterProfile: api.MasterProfile{
+					VMSize: api.VMSizeStandardD8sV3,
+				},
+				WorkerProfiles: []api.WorkerProfile{
+					{
+						Name:   "worker",
+						VMSize: api.VMSizeStandardD4sV3,
+					},
+				},
+			},
+		},
+	}
+
+	template, err := dv.policyPreflightTemplate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := json.Marshal(template)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"$schema":"https://schema.management.azure.com/schemas/2018-05-01/subscriptionDeploymentTemplate.json#","contentVersion":"1.0.0.0","resources":[` +
+		`{"name":"aro-cluster","type":"Microsoft.Resources/resourceGroups","location":"eastus","managedBy":"/subscriptions/subscriptionId/resourceGroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName","apiVersion":"2019-07-01"},` +
+		`{"resourceGroup":"aro-cluster","properties":{"template":{"$schema":"https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#","contentVersion":"1.0.0.0","resources":[` +
+		`{"apiVersion":"2019-03-01","location":"eastus","name":"master","properties":{"hardwareProfile":{"vmSize":"Standard_D8s_v3"}},"type":"Microsoft.Compute/virtualMachines"},` +
+		`{"apiVersion":"2019-03-01","location":"eastus","name":"worker","properties":{"hardwareProfile":{"vmSize":"Standard_D4s_v3"}},"type":"Microsoft.Compute/virtualMachines"}]},"mode":"Incremental"},` +
+		`"name":"policy-preflight","type":"Microsoft.Resources/deployments","apiVersion":"2019-07-01","dependsOn":["[resourceId('Microsoft.Resources/resourceGroups', 'aro-cluster')]"]}]}`
+	if string(b) != want {
+		t.Error(string(b))
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 
 	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/compute"
 )
 
 func addRequiredResources(requiredResources map[string]int, vmSize api.VMSize, count int) error {
@@ -71,6 +72,32 @@ func addRequiredResources(requiredResources map[string]int, vmSize api.VMSize, c
 		requiredResources["standardFSv2Family"] += (count * 32)
 		requiredResources["cores"] += (count * 32)
 
+	case api.VMSizeStandardD4psV5:
+		requiredResources["standardDPSv5Family"] += (count * 4)
+		requiredResources["cores"] += (count * 4)
+	case api.VMSizeStandardD8psV5:
+		requiredResources["standardDPSv5Family"] += (count * 8)
+		requiredResources["cores"] += (count * 8)
+	case api.VMSizeStandardD16psV5:
+		requiredResources["standardDPSv5Family"] += (count * 16)
+		requiredResources["cores"] += (count * 16)
+	case api.VMSizeStandardD32psV5:
+		requiredResources["standardDPSv5Family"] += (count * 32)
+		requiredResources["cores"] += (count * 32)
+
+	case api.VMSizeStandardE4psV5:
+		requiredResources["standardEPSv5Family"] += (count * 4)
+		requiredResources["cores"] += (count * 4)
+	case api.VMSizeStandardE8psV5:
+		requiredResources["standardEPSv5Family"] += (count * 8)
+		requiredResources["cores"] += (count * 8)
+	case api.VMSizeStandardE16psV5:
+		requiredResources["standardEPSv5Family"] += (count * 16)
+		requiredResources["cores"] += (count * 16)
+	case api.VMSizeStandardE32psV5:
+		requiredResources["standardEPSv5Family"] += (count * 32)
+		requiredResources["cores"] += (count * 32)
+
 	default:
 		//will only happen if pkg/api verification allows new VMSizes
 		return fmt.Errorf("unexpected node VMSize %s", vmSize)
@@ -78,28 +105,55 @@ func addRequiredResources(requiredResources map[string]int, vmSize api.VMSize, c
 	return nil
 }
 
-// validateQuotas checks usage quotas vs. resources required by cluster before cluster creation
-func (dv *openShiftClusterDynamicValidator) validateQuotas(ctx context.Context) error {
-	dv.log.Print("validateQuotas")
-
+// RequiredResources returns the quota required by count VMs of the given size
+func RequiredResources(vmSize api.VMSize, count int) (map[string]int, error) {
 	requiredResources := map[string]int{}
-	err := addRequiredResources(requiredResources, dv.oc.Properties.MasterProfile.VMSize, 3)
+
+	err := addRequiredResources(requiredResources, vmSize, count)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	//worker node resource calculation
-	for _, w := range dv.oc.Properties.WorkerProfiles {
-		err = addRequiredResources(requiredResources, w.VMSize, w.Count)
-		if err != nil {
-			return err
+
+	return requiredResources, nil
+}
+
+// AutoscalerRequiredResources returns the quota required, in addition to that
+// of the current worker VMs, for the cluster autoscaler to scale each pool of
+// oc to its maximum replicas
+func AutoscalerRequiredResources(oc *api.OpenShiftCluster) (map[string]int, error) {
+	requiredResources := map[string]int{}
+
+	if oc.Properties.AutoscalerProfile == nil {
+		return requiredResources, nil
+	}
+
+	workerProfiles := make([]api.WorkerProfile, 0, len(oc.Properties.WorkerProfiles)+len(oc.Properties.AdditionalWorkerProfiles))
+	workerProfiles = append(workerProfiles, oc.Properties.WorkerProfiles...)
+	workerProfiles = append(workerProfiles, oc.Properties.AdditionalWorkerProfiles...)
+
+	for _, p := range oc.Properties.AutoscalerProfile.Pools {
+		for _, wp := range workerProfiles {
+			if wp.Name != p.Name || p.MaxReplicas <= wp.Count {
+				continue
+			}
+
+			err := addRequiredResources(requiredResources, wp.VMSize, p.MaxReplicas-wp.Count)
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
 
-	usages, err := dv.spUsage.List(ctx, dv.oc.Location)
+	return requiredResources, nil
+}
+
+// ValidateQuota returns a CloudError if the usages of usageClient in location
+// leave less than requiredResources available
+func ValidateQuota(ctx context.Context, usageClient compute.UsageClient, location string, requiredResources map[string]int) error {
+	usages, err := usageClient.List(ctx, location)
 	if err != nil {
 		return err
 	}
-	//check requirements vs. usage
 
 	// we're only checking the limits returned by the Usage API and ignoring usage limits missing from the results
 	// rationale:
@@ -113,3 +167,32 @@ func (dv *openShiftClusterDynamicValidator) validateQuotas(ctx context.Context)
 	}
 	return nil
 }
+
+// validateQuotas checks usage quotas vs. resources required by cluster before cluster creation
+func (dv *openShiftClusterDynamicValidator) validateQuotas(ctx context.Context) error {
+	dv.log.Print("validateQuotas")
+
+	requiredResources := map[string]int{}
+	err := addRequiredResources(requiredResources, dv.oc.Properties.MasterProfile.VMSize, 3)
+	if err != nil {
+		return err
+	}
+	//worker node resource calculation
+	for _, w := range dv.oc.Properties.WorkerProfiles {
+		err = addRequiredResources(requiredResources, w.VMSize, w.Count)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the cluster autoscaler must be able to scale up to its bounds
+	autoscalerRequiredResources, err := AutoscalerRequiredResources(dv.oc)
+	if err != nil {
+		return err
+	}
+	for k, v := range autoscalerRequiredResources {
+		requiredResources[k] += v
+	}
+
+	return ValidateQuota(ctx, dv.spUsage, dv.oc.Location, requiredResources)
+}
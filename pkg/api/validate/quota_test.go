@@ -5,6 +5,7 @@ package validate
 
 import (
 	"context"
+	"reflect"
 	"testing"
 
 	mgmtcompute "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
@@ -167,3 +168,84 @@ func TestQuotaCheck(t *testing.T) {
 		})
 	}
 }
+
+func TestAutoscalerRequiredResources(t *testing.T) {
+	for _, tt := range []struct {
+		name              string
+		autoscalerProfile *api.AutoscalerProfile
+		want              map[string]int
+	}{
+		{
+			name: "autoscaler disabled",
+			want: map[string]int{},
+		},
+		{
+			name: "pools scale beyond the current workers",
+			autoscalerProfile: &api.AutoscalerProfile{
+				Pools: []api.AutoscalerPool{
+					{
+						Name:        "worker",
+						MinReplicas: 3,
+						MaxReplicas: 5,
+					},
+					{
+						Name:        "infra",
+						MinReplicas: 0,
+						MaxReplicas: 4,
+					},
+				},
+			},
+			want: map[string]int{
+				"virtualMachines":    4,
+				"PremiumDiskCount":   4,
+				"standardDSv3Family": 16,
+				"standardESv3Family": 8,
+				"cores":              24,
+			},
+		},
+		{
+			name: "pools within the current workers",
+			autoscalerProfile: &api.AutoscalerProfile{
+				Pools: []api.AutoscalerPool{
+					{
+						Name:        "worker",
+						MinReplicas: 3,
+						MaxReplicas: 3,
+					},
+				},
+			},
+			want: map[string]int{},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			oc := &api.OpenShiftCluster{
+				Properties: api.OpenShiftClusterProperties{
+					WorkerProfiles: []api.WorkerProfile{
+						{
+							Name:   "worker",
+							VMSize: api.VMSizeStandardD8sV3,
+							Count:  3,
+						},
+					},
+					AdditionalWorkerProfiles: []api.WorkerProfile{
+						{
+							Name:   "infra",
+							VMSize: api.VMSizeStandardE4sV3,
+							Count:  2,
+						},
+					},
+					AutoscalerProfile: tt.autoscalerProfile,
+				},
+			}
+
+			got, err := AutoscalerRequiredResources(oc)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Error(got)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package validate
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"net/http"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/env"
+)
+
+// TrustedLaunchIsAvailable returns an error if a profile with the
+// TrustedLaunch security type uses a VM size for which trusted launch is not
+// available in the region.  The static validators only check that the VM size
+// is Gen2 capable: availability varies between regions.
+func TrustedLaunchIsAvailable(_env env.Interface, path string, securityType api.SecurityType, vmSize api.VMSize) error {
+	if securityType != api.SecurityTypeTrustedLaunch {
+		return nil
+	}
+
+	available, err := _env.TrustedLaunchAvailable(string(vmSize))
+	if err != nil {
+		return err
+	}
+
+	if !available {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".securityType", "The provided security type '%s' is invalid: trusted launch is not available for VM size '%s' in this region.", securityType, vmSize)
+	}
+
+	return nil
+}
+
+func (dv *openShiftClusterDynamicValidator) validateTrustedLaunch() error {
+	dv.log.Print("validateTrustedLaunch")
+
+	return TrustedLaunchIsAvailable(dv.env, "properties.masterProfile", dv.oc.Properties.MasterProfile.SecurityType, dv.oc.Properties.MasterProfile.VMSize)
+}
@@ -0,0 +1,96 @@
+package validate
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	mock_env "github.com/Azure/ARO-RP/pkg/util/mocks/env"
+)
+
+func TestValidateTrustedLaunch(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		modify  func(*api.OpenShiftCluster)
+		mocks   func(*mock_env.MockInterface)
+		wantErr string
+	}{
+		{
+			name: "standard profiles are not checked",
+		},
+		{
+			name: "trusted launch available",
+			modify: func(oc *api.OpenShiftCluster) {
+				oc.Properties.MasterProfile.SecurityType = api.SecurityTypeTrustedLaunch
+			},
+			mocks: func(_env *mock_env.MockInterface) {
+				_env.EXPECT().TrustedLaunchAvailable("Standard_D8s_v3").Return(true, nil)
+			},
+		},
+		{
+			name: "trusted launch not available for master VM size",
+			modify: func(oc *api.OpenShiftCluster) {
+				oc.Properties.MasterProfile.SecurityType = api.SecurityTypeTrustedLaunch
+			},
+			mocks: func(_env *mock_env.MockInterface) {
+				_env.EXPECT().TrustedLaunchAvailable("Standard_D8s_v3").Return(false, nil)
+			},
+			wantErr: "400: InvalidParameter: properties.masterProfile.securityType: The provided security type 'TrustedLaunch' is invalid: trusted launch is not available for VM size 'Standard_D8s_v3' in this region.",
+		},
+		{
+			name: "unknown VM size",
+			modify: func(oc *api.OpenShiftCluster) {
+				oc.Properties.MasterProfile.SecurityType = api.SecurityTypeTrustedLaunch
+			},
+			mocks: func(_env *mock_env.MockInterface) {
+				_env.EXPECT().TrustedLaunchAvailable("Standard_D8s_v3").Return(false, errors.New(`trusted launch information not found for vm size "Standard_D8s_v3"`))
+			},
+			wantErr: `trusted launch information not found for vm size "Standard_D8s_v3"`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			_env := mock_env.NewMockInterface(controller)
+			if tt.mocks != nil {
+				tt.mocks(_env)
+			}
+
+			oc := &api.OpenShiftCluster{
+				Properties: api.OpenShiftClusterProperties{
+					MasterProfile: api.MasterProfile{
+						VMSize: api.VMSizeStandardD8sV3,
+					},
+					WorkerProfiles: []api.WorkerProfile{
+						{
+							Name:   "worker",
+							VMSize: api.VMSizeStandardD4sV3,
+						},
+					},
+				},
+			}
+			if tt.modify != nil {
+				tt.modify(oc)
+			}
+
+			dv := &openShiftClusterDynamicValidator{
+				log: logrus.NewEntry(logrus.StandardLogger()),
+				env: _env,
+				oc:  oc,
+			}
+
+			err := dv.validateTrustedLaunch()
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -5,48 +5,52 @@ package validate
 
 import (
 	"github.com/Azure/ARO-RP/pkg/api"
-	"github.com/Azure/ARO-RP/pkg/util/deployment"
 )
 
-func DiskSizeIsValid(sizeGB int) bool {
-	return sizeGB >= 128
+// VMSizeIsArm64 returns true if vmSize is one of the supported arm64 worker VM
+// sizes.  These are not part of the worker VM sizes of any install profile:
+// they may only be used for additional worker profiles of profiles which allow
+// them, and only when the subscription is registered for the feature.
+func VMSizeIsArm64(vmSize api.VMSize) bool {
+	switch vmSize {
+	case api.VMSizeStandardD4psV5,
+		api.VMSizeStandardD8psV5,
+		api.VMSizeStandardD16psV5,
+		api.VMSizeStandardD32psV5,
+		api.VMSizeStandardE4psV5,
+		api.VMSizeStandardE8psV5,
+		api.VMSizeStandardE16psV5,
+		api.VMSizeStandardE32psV5:
+		return true
+	}
+
+	return false
 }
 
-func VMSizeIsValid(vmSize api.VMSize, deploymentMode deployment.Mode, isMaster bool) bool {
-	if isMaster {
-		switch vmSize {
-		case api.VMSizeStandardD8sV3,
-			api.VMSizeStandardD16sV3,
-			api.VMSizeStandardD32sV3:
-			return true
-		}
-	} else {
-		if deploymentMode == deployment.Development {
-			switch vmSize {
-			case api.VMSizeStandardD2sV3:
-				return true
-			}
-		} else {
-			switch vmSize {
-			case api.VMSizeStandardD4asV4,
-				api.VMSizeStandardD8asV4,
-				api.VMSizeStandardD16asV4,
-				api.VMSizeStandardD32asV4,
-				api.VMSizeStandardD4sV3,
-				api.VMSizeStandardD8sV3,
-				api.VMSizeStandardD16sV3,
-				api.VMSizeStandardD32sV3,
-				api.VMSizeStandardE4sV3,
-				api.VMSizeStandardE8sV3,
-				api.VMSizeStandardE16sV3,
-				api.VMSizeStandardE32sV3,
-				api.VMSizeStandardF4sV2,
-				api.VMSizeStandardF8sV2,
-				api.VMSizeStandardF16sV2,
-				api.VMSizeStandardF32sV2:
-				return true
-			}
-		}
+// VMSizeSupportsTrustedLaunch returns true if vmSize may be used for VMs with
+// the TrustedLaunch security type.  Trusted launch requires Gen2 VMs, which
+// the arm64 VM sizes do not support.  Whether trusted launch is available for
+// the VM size in a given region is checked by the dynamic validator.
+func VMSizeSupportsTrustedLaunch(vmSize api.VMSize) bool {
+	switch vmSize {
+	case api.VMSizeStandardD2sV3,
+		api.VMSizeStandardD4asV4,
+		api.VMSizeStandardD8asV4,
+		api.VMSizeStandardD16asV4,
+		api.VMSizeStandardD32asV4,
+		api.VMSizeStandardD4sV3,
+		api.VMSizeStandardD8sV3,
+		api.VMSizeStandardD16sV3,
+		api.VMSizeStandardD32sV3,
+		api.VMSizeStandardE4sV3,
+		api.VMSizeStandardE8sV3,
+		api.VMSizeStandardE16sV3,
+		api.VMSizeStandardE32sV3,
+		api.VMSizeStandardF4sV2,
+		api.VMSizeStandardF8sV2,
+		api.VMSizeStandardF16sV2,
+		api.VMSizeStandardF32sV2:
+		return true
 	}
 
 	return false
@@ -0,0 +1,146 @@
+package backend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/util/analytics"
+	"github.com/Azure/ARO-RP/pkg/util/recover"
+)
+
+const analyticsExportInterval = time.Hour
+
+// exportAnalytics periodically exports anonymized records of the clusters and
+// asyncOperations which changed since the last export, for offline analysis
+// of provisioning trends away from the production database.  Only the master
+// backend exports, so that records are not written once per backend.  It is
+// a no-op if export is not configured.
+func (b *backend) exportAnalytics(ctx context.Context, stop <-chan struct{}) {
+	defer recover.Panic(b.baseLog)
+
+	if b.analytics == nil {
+		return
+	}
+
+	t := time.NewTicker(analyticsExportInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-t.C:
+		case <-stop:
+			return
+		}
+
+		if !b.isMasterBackend() {
+			continue
+		}
+
+		err := b.exportAnalyticsOnce(ctx)
+		if err != nil {
+			b.baseLog.Error(err)
+		}
+	}
+}
+
+func (b *backend) exportAnalyticsOnce(ctx context.Context) error {
+	for _, export := range []struct {
+		table string
+		read  func(context.Context, string) ([]interface{}, string, error)
+	}{
+		{
+			table: analytics.TableOpenShiftClusters,
+			read:  b.readOpenShiftClustersChangeFeed,
+		},
+		{
+			table: analytics.TableAsyncOperations,
+			read:  b.readAsyncOperationsChangeFeed,
+		},
+	} {
+		err := b.exportAnalyticsTable(ctx, export.table, export.read)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// exportAnalyticsTable exports the records read from the change feed of a
+// table since its last export.  The change feed only returns the latest
+// version of each document, so intermediate writes between two exports are
+// not seen.
+func (b *backend) exportAnalyticsTable(ctx context.Context, table string, read func(context.Context, string) ([]interface{}, string, error)) error {
+	var count int64
+	defer func() {
+		b.m.EmitGauge("backend.analytics.exported.count", count, map[string]string{
+			"table": table,
+		})
+	}()
+
+	continuation, err := b.analytics.GetContinuation(ctx, table)
+	if err != nil {
+		return err
+	}
+
+	records, newContinuation, err := read(ctx, continuation)
+	if err != nil {
+		return err
+	}
+
+	// keep our place if the change feed did not return a continuation
+	if newContinuation == "" {
+		newContinuation = continuation
+	}
+
+	err = b.analytics.PutRecords(ctx, table, records, newContinuation, time.Now())
+	if err != nil {
+		return err
+	}
+
+	count = int64(len(records))
+	return nil
+}
+
+func (b *backend) readOpenShiftClustersChangeFeed(ctx context.Context, continuation string) ([]interface{}, string, error) {
+	var records []interface{}
+
+	i := b.dbOpenShiftClusters.ChangeFeedFrom(continuation)
+
+	for {
+		docs, err := i.Next(ctx, -1)
+		if err != nil {
+			return nil, "", err
+		}
+		if docs == nil {
+			return records, i.Continuation(), nil
+		}
+
+		for _, doc := range docs.OpenShiftClusterDocuments {
+			records = append(records, analytics.NewClusterRecord(doc))
+		}
+	}
+}
+
+func (b *backend) readAsyncOperationsChangeFeed(ctx context.Context, continuation string) ([]interface{}, string, error) {
+	var records []interface{}
+
+	i := b.dbAsyncOperations.ChangeFeedFrom(continuation)
+
+	for {
+		docs, err := i.Next(ctx, -1)
+		if err != nil {
+			return nil, "", err
+		}
+		if docs == nil {
+			return records, i.Continuation(), nil
+		}
+
+		for _, doc := range docs.AsyncOperationDocuments {
+			records = append(records, analytics.NewAsyncOperationRecord(doc))
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package backend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	"github.com/Azure/ARO-RP/pkg/util/analytics"
+	mock_analytics "github.com/Azure/ARO-RP/pkg/util/mocks/analytics"
+)
+
+// changeFeedOpenShiftClusters and changeFeedAsyncOperations serve a change
+// feed, which the fake databases do not implement
+type changeFeedOpenShiftClusters struct {
+	database.OpenShiftClusters
+	t                *testing.T
+	wantContinuation string
+	docs             []*api.OpenShiftClusterDocument
+	continuation     string
+}
+
+func (c *changeFeedOpenShiftClusters) ChangeFeedFrom(continuation string) cosmosdb.OpenShiftClusterDocumentIterator {
+	if continuation != c.wantContinuation {
+		c.t.Error(continuation)
+	}
+	return &openShiftClusterDocumentChangeFeedIterator{docs: c.docs, continuation: c.continuation}
+}
+
+type changeFeedAsyncOperations struct {
+	database.AsyncOperations
+	t                *testing.T
+	wantContinuation string
+	docs             []*api.AsyncOperationDocument
+	continuation     string
+}
+
+func (c *changeFeedAsyncOperations) ChangeFeedFrom(continuation string) cosmosdb.AsyncOperationDocumentIterator {
+	if continuation != c.wantContinuation {
+		c.t.Error(continuation)
+	}
+	return &asyncOperationDocumentChangeFeedIterator{docs: c.docs, continuation: c.continuation}
+}
+
+// the change feed iterators return a single page of documents, and then the
+// continuation
+type openShiftClusterDocumentChangeFeedIterator struct {
+	docs         []*api.OpenShiftClusterDocument
+	continuation string
+	done         bool
+}
+
+func (i *openShiftClusterDocumentChangeFeedIterator) Next(ctx context.Context, maxItemCount int) (*api.OpenShiftClusterDocuments, error) {
+	if i.done {
+		return nil, nil
+	}
+	i.done = true
+	return &api.OpenShiftClusterDocuments{OpenShiftClusterDocuments: i.docs}, nil
+}
+
+func (i *openShiftClusterDocumentChangeFeedIterator) Continuation() string {
+	return i.continuation
+}
+
+type asyncOperationDocumentChangeFeedIterator struct {
+	docs         []*api.AsyncOperationDocument
+	continuation string
+	done         bool
+}
+
+func (i *asyncOperationDocumentChangeFeedIterator) Next(ctx context.Context, maxItemCount int) (*api.AsyncOperationDocuments, error) {
+	if i.done {
+		return nil, nil
+	}
+	i.done = true
+	return &api.AsyncOperationDocuments{AsyncOperationDocuments: i.docs}, nil
+}
+
+func (i *asyncOperationDocumentChangeFeedIterator) Continuation() string {
+	return i.continuation
+}
+
+func TestExportAnalyticsOnce(t *testing.T) {
+	ctx := context.Background()
+
+	clusterKey := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourcegroup/providers/microsoft.redhatopenshift/openshiftclusters/resourcename"
+
+	for _, tt := range []struct {
+		name                       string
+		clusterDocs                []*api.OpenShiftClusterDocument
+		asyncOperationDocs         []*api.AsyncOperationDocument
+		clusterContinuation        string
+		asyncOperationContinuation string
+		wantClusterRecords         int
+		wantAsyncOperationRecords  int
+		wantClusterContinuation    string
+	}{
+		{
+			name: "changed documents are exported",
+			clusterDocs: []*api.OpenShiftClusterDocument{
+				{
+					Key:              clusterKey,
+					OpenShiftCluster: &api.OpenShiftCluster{},
+				},
+			},
+			asyncOperationDocs: []*api.AsyncOperationDocument{
+				{
+					ID:                  "11111111-1111-1111-1111-111111111111",
+					OpenShiftClusterKey: clusterKey,
+					AsyncOperation:      &api.AsyncOperation{},
+				},
+				{
+					ID:                  "22222222-2222-2222-2222-222222222222",
+					OpenShiftClusterKey: clusterKey,
+					AsyncOperation:      &api.AsyncOperation{},
+				},
+			},
+			clusterContinuation:        "\"2\"",
+			asyncOperationContinuation: "\"3\"",
+			wantClusterRecords:         1,
+			wantAsyncOperationRecords:  2,
+			wantClusterContinuation:    "\"2\"",
+		},
+		{
+			name:                    "continuation is kept if the change feed returns none",
+			wantClusterContinuation: "\"1\"",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			a := mock_analytics.NewMockManager(controller)
+
+			a.EXPECT().GetContinuation(gomock.Any(), analytics.TableOpenShiftClusters).Return("\"1\"", nil)
+			a.EXPECT().PutRecords(gomock.Any(), analytics.TableOpenShiftClusters, gomock.Any(), tt.wantClusterContinuation, gomock.Any()).
+				Do(func(ctx context.Context, table string, records []interface{}, continuation string, _ interface{}) {
+					if len(records) != tt.wantClusterRecords {
+						t.Error(len(records))
+					}
+					for _, record := range records {
+						if _, ok := record.(*analytics.ClusterRecord); !ok {
+							t.Errorf("%T", record)
+						}
+					}
+				})
+
+			a.EXPECT().GetContinuation(gomock.Any(), analytics.TableAsyncOperations).Return("", nil)
+			a.EXPECT().PutRecords(gomock.Any(), analytics.TableAsyncOperations, gomock.Any(), tt.asyncOperationContinuation, gomock.Any()).
+				Do(func(ctx context.Context, table string, records []interface{}, continuation string, _ interface{}) {
+					if len(records) != tt.wantAsyncOperationRecords {
+						t.Error(len(records))
+					}
+					for _, record := range records {
+						if _, ok := record.(*analytics.AsyncOperationRecord); !ok {
+							t.Errorf("%T", record)
+						}
+					}
+				})
+
+			b := &backend{
+				dbOpenShiftClusters: &changeFeedOpenShiftClusters{
+					t:                t,
+					wantContinuation: "\"1\"",
+					docs:             tt.clusterDocs,
+					continuation:     tt.clusterContinuation,
+				},
+				dbAsyncOperations: &changeFeedAsyncOperations{
+					t:            t,
+					docs:         tt.asyncOperationDocs,
+					continuation: tt.asyncOperationContinuation,
+				},
+				analytics: a,
+				m:         &noop.Noop{},
+			}
+
+			err := b.exportAnalyticsOnce(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package backend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/recover"
+)
+
+// archiveAsyncOperations periodically copies completed AsyncOperationDocuments
+// to the archive, ahead of their expiry from the database.  It is a no-op if
+// archival is not configured.
+func (b *backend) archiveAsyncOperations(ctx context.Context, stop <-chan struct{}) {
+	defer recover.Panic(b.baseLog)
+
+	if b.archive == nil {
+		return
+	}
+
+	t := time.NewTicker(time.Hour)
+	defer t.Stop()
+
+	for {
+		err := b.archiveAsyncOperationsOnce(ctx)
+		if err != nil {
+			b.baseLog.Error(err)
+		}
+
+		select {
+		case <-t.C:
+		case <-stop:
+			return
+		}
+	}
+}
+
+func (b *backend) archiveAsyncOperationsOnce(ctx context.Context) error {
+	var count int64
+	defer func() {
+		b.m.EmitGauge("backend.asyncoperations.archived.count", count, nil)
+	}()
+
+	i := b.dbAsyncOperations.ListUnarchived()
+
+	for {
+		docs, err := i.Next(ctx, -1)
+		if err != nil {
+			return err
+		}
+		if docs == nil {
+			return nil
+		}
+
+		for _, doc := range docs.AsyncOperationDocuments {
+			err = b.archive.PutAsyncOperation(ctx, doc)
+			if err != nil {
+				return err
+			}
+
+			_, err = b.dbAsyncOperations.Patch(ctx, doc.ID, func(asyncdoc *api.AsyncOperationDocument) error {
+				asyncdoc.Archived = true
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			count++
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package backend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	mock_archive "github.com/Azure/ARO-RP/pkg/util/mocks/archive"
+	testdb "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestArchiveAsyncOperationsOnce(t *testing.T) {
+	ctx := context.Background()
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	archive := mock_archive.NewMockManager(controller)
+
+	dbAsyncOperations, _ := testdb.NewFakeAsyncOperations()
+
+	f := testdb.NewFixture().WithAsyncOperations(dbAsyncOperations)
+	f.AddAsyncOperationDocuments(
+		&api.AsyncOperationDocument{
+			ID: "succeeded",
+			AsyncOperation: &api.AsyncOperation{
+				ProvisioningState: api.ProvisioningStateSucceeded,
+			},
+		},
+		&api.AsyncOperationDocument{
+			ID: "failed",
+			AsyncOperation: &api.AsyncOperation{
+				ProvisioningState: api.ProvisioningStateFailed,
+			},
+		},
+		&api.AsyncOperationDocument{
+			ID: "creating",
+			AsyncOperation: &api.AsyncOperation{
+				ProvisioningState: api.ProvisioningStateCreating,
+			},
+		},
+		&api.AsyncOperationDocument{
+			ID: "archived",
+			AsyncOperation: &api.AsyncOperation{
+				ProvisioningState: api.ProvisioningStateSucceeded,
+			},
+			Archived: true,
+		},
+	)
+	err := f.Create()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gomock.InOrder(
+		archive.EXPECT().PutAsyncOperation(gomock.Any(), gomock.Any()).
+			Do(func(ctx context.Context, doc *api.AsyncOperationDocument) {
+				if doc.ID != "failed" {
+					t.Error(doc.ID)
+				}
+			}),
+		archive.EXPECT().PutAsyncOperation(gomock.Any(), gomock.Any()).
+			Do(func(ctx context.Context, doc *api.AsyncOperationDocument) {
+				if doc.ID != "succeeded" {
+					t.Error(doc.ID)
+				}
+			}),
+	)
+
+	b := &backend{
+		dbAsyncOperations: dbAsyncOperations,
+		archive:           archive,
+		m:                 &noop.Noop{},
+	}
+
+	err = b.archiveAsyncOperationsOnce(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for id, wantArchived := range map[string]bool{
+		"succeeded": true,
+		"failed":    true,
+		"creating":  false,
+		"archived":  true,
+	} {
+		doc, err := dbAsyncOperations.Get(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if doc.Archived != wantArchived {
+			t.Error(id, doc.Archived)
+		}
+	}
+}
@@ -5,23 +5,36 @@ package backend
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/database"
 	"github.com/Azure/ARO-RP/pkg/env"
 	"github.com/Azure/ARO-RP/pkg/metrics"
+	"github.com/Azure/ARO-RP/pkg/util/analytics"
+	"github.com/Azure/ARO-RP/pkg/util/archive"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
 	"github.com/Azure/ARO-RP/pkg/util/billing"
+	"github.com/Azure/ARO-RP/pkg/util/bucket"
 	"github.com/Azure/ARO-RP/pkg/util/encryption"
 	"github.com/Azure/ARO-RP/pkg/util/recover"
 )
 
 const (
-	maxWorkers      = 100
-	maxDequeueCount = 5
+	defaultMaxWorkers = 100
+	maxDequeueCount   = 5
+
+	// reservedWorkersDivisor sets the share of the workers (a tenth) which
+	// only operations of the priority class may use, so that deletes and
+	// customer updates are not stuck behind creates when the backend is busy
+	reservedWorkersDivisor = 10
 )
 
 type backend struct {
@@ -29,18 +42,42 @@ type backend struct {
 	env     env.Interface
 
 	dbAsyncOperations   database.AsyncOperations
+	dbBackends          database.Monitors
 	dbBilling           database.Billing
 	dbOpenShiftClusters database.OpenShiftClusters
+	dbOpenShiftVersions database.OpenShiftVersions
 	dbSubscriptions     database.Subscriptions
 
 	cipher  encryption.Cipher
 	m       metrics.Interface
 	billing billing.Manager
+	archive archive.Manager
+
+	analytics analytics.Manager
+
+	armCircuitBreaker *azureclient.CircuitBreaker
+
+	// completedAsyncOperationTTL is the time in seconds for which a completed
+	// AsyncOperationDocument is kept in the database
+	completedAsyncOperationTTL int
 
-	mu       sync.Mutex
-	cond     *sync.Cond
-	workers  int32
-	stopping atomic.Value
+	// liveSteps holds the names of the steps wrapped by steps.Shadowed which
+	// run for real rather than in shadow mode
+	liveSteps map[string]bool
+
+	newDriftReconciler func(context.Context, *backend, *logrus.Entry, *api.OpenShiftClusterDocument, *api.SubscriptionDocument) (driftReconciler, error)
+
+	mu                 sync.Mutex
+	cond               *sync.Cond
+	workers            int32
+	maxWorkers         int32
+	standardWorkers    int32
+	maxStandardWorkers int32
+	stopping           atomic.Value
+
+	isMaster    atomic.Value // bool
+	bucketCount int
+	buckets     atomic.Value // []int
 
 	ocb *openShiftClusterBackend
 	sb  *subscriptionBackend
@@ -52,8 +89,8 @@ type Runnable interface {
 }
 
 // NewBackend returns a new runnable backend
-func NewBackend(ctx context.Context, log *logrus.Entry, env env.Interface, dbAsyncOperations database.AsyncOperations, dbBilling database.Billing, dbOpenShiftClusters database.OpenShiftClusters, dbSubscriptions database.Subscriptions, cipher encryption.Cipher, m metrics.Interface) (Runnable, error) {
-	b, err := newBackend(ctx, log, env, dbAsyncOperations, dbBilling, dbOpenShiftClusters, dbSubscriptions, cipher, m)
+func NewBackend(ctx context.Context, log *logrus.Entry, env env.Interface, dbAsyncOperations database.AsyncOperations, dbBackends database.Monitors, dbBilling database.Billing, dbOpenShiftClusters database.OpenShiftClusters, dbOpenShiftVersions database.OpenShiftVersions, dbSubscriptions database.Subscriptions, cipher encryption.Cipher, m metrics.Interface) (Runnable, error) {
+	b, err := newBackend(ctx, log, env, dbAsyncOperations, dbBackends, dbBilling, dbOpenShiftClusters, dbOpenShiftVersions, dbSubscriptions, cipher, m)
 	if err != nil {
 		return nil, err
 	}
@@ -63,24 +100,64 @@ func NewBackend(ctx context.Context, log *logrus.Entry, env env.Interface, dbAsy
 	return b, nil
 }
 
-func newBackend(ctx context.Context, log *logrus.Entry, env env.Interface, dbAsyncOperations database.AsyncOperations, dbBilling database.Billing, dbOpenShiftClusters database.OpenShiftClusters, dbSubscriptions database.Subscriptions, cipher encryption.Cipher, m metrics.Interface) (*backend, error) {
+func newBackend(ctx context.Context, log *logrus.Entry, env env.Interface, dbAsyncOperations database.AsyncOperations, dbBackends database.Monitors, dbBilling database.Billing, dbOpenShiftClusters database.OpenShiftClusters, dbOpenShiftVersions database.OpenShiftVersions, dbSubscriptions database.Subscriptions, cipher encryption.Cipher, m metrics.Interface) (*backend, error) {
 	billing, err := billing.NewManager(env, dbBilling, dbSubscriptions, log)
 	if err != nil {
 		return nil, err
 	}
 
+	archive, err := archive.NewManager(ctx, env)
+	if err != nil {
+		return nil, err
+	}
+
+	analytics, err := analytics.NewManager(ctx, env)
+	if err != nil {
+		return nil, err
+	}
+
+	completedAsyncOperationTTL, err := database.CompletedAsyncOperationTTL()
+	if err != nil {
+		return nil, err
+	}
+
+	maxWorkers := defaultMaxWorkers
+	if s, found := os.LookupEnv("BACKEND_MAX_WORKERS"); found {
+		maxWorkers, err = strconv.Atoi(s)
+		if err != nil || maxWorkers < 1 {
+			return nil, fmt.Errorf("invalid BACKEND_MAX_WORKERS %q", s)
+		}
+	}
+
 	b := &backend{
 		baseLog: log,
 		env:     env,
 
 		dbAsyncOperations:   dbAsyncOperations,
+		dbBackends:          dbBackends,
 		dbBilling:           dbBilling,
 		dbOpenShiftClusters: dbOpenShiftClusters,
+		dbOpenShiftVersions: dbOpenShiftVersions,
 		dbSubscriptions:     dbSubscriptions,
 
 		billing: billing,
+		archive: archive,
 		cipher:  cipher,
 		m:       m,
+
+		analytics: analytics,
+
+		armCircuitBreaker: azureclient.NewCircuitBreaker(),
+
+		completedAsyncOperationTTL: completedAsyncOperationTTL,
+
+		liveSteps: liveSteps(),
+
+		newDriftReconciler: newDriftReconciler,
+
+		maxWorkers:         int32(maxWorkers),
+		maxStandardWorkers: int32(maxWorkers - maxWorkers/reservedWorkersDivisor),
+		bucketCount:        bucket.Buckets,
 	}
 	b.cond = sync.NewCond(&b.mu)
 	b.stopping.Store(false)
@@ -104,9 +181,16 @@ func (b *backend) Run(ctx context.Context, stop <-chan struct{}, done chan<- str
 		}()
 	}
 
+	go b.coordinate(ctx, stop)
+	go b.archiveAsyncOperations(ctx, stop)
+	go b.exportAnalytics(ctx, stop)
+	go b.reconcileDrift(ctx, stop)
+	go b.runFollowUpTasks(ctx, stop)
+	go b.monitorARMCircuitBreaker(stop)
+
 	for {
 		b.mu.Lock()
-		for atomic.LoadInt32(&b.workers) >= maxWorkers && !b.stopping.Load().(bool) {
+		for atomic.LoadInt32(&b.workers) >= b.maxWorkers && !b.stopping.Load().(bool) {
 			b.cond.Wait()
 		}
 		b.mu.Unlock()
@@ -0,0 +1,123 @@
+package backend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/util/bucket"
+	"github.com/Azure/ARO-RP/pkg/util/recover"
+)
+
+// coordinate shares the OpenShiftClusterDocument buckets between the running
+// backends, so that each backend dequeues from its own share of the queue
+// rather than all of them contending for the same documents.  Until the first
+// allocation is read, the backend dequeues from every bucket.
+func (b *backend) coordinate(ctx context.Context, stop <-chan struct{}) {
+	defer recover.Panic(b.baseLog)
+
+	_, err := b.dbBackends.Create(ctx, &api.MonitorDocument{
+		ID: "master",
+	})
+	if err != nil && !cosmosdb.IsErrorStatusCode(err, http.StatusPreconditionFailed) {
+		b.baseLog.Error(err)
+	}
+
+	t := time.NewTicker(10 * time.Second)
+	defer t.Stop()
+
+	for {
+		// register ourself as a backend
+		err := b.dbBackends.MonitorHeartbeat(ctx)
+		if err != nil {
+			b.baseLog.Error(err)
+		}
+
+		// try to become master and share buckets across registered backends
+		err = b.master(ctx)
+		if err != nil {
+			b.baseLog.Error(err)
+		}
+
+		// read our bucket allocation from the master
+		err = b.listBuckets(ctx)
+		if err != nil {
+			b.baseLog.Error(err)
+		}
+
+		select {
+		case <-t.C:
+		case <-stop:
+			// stop heartbeating so that our buckets are reassigned
+			return
+		}
+	}
+}
+
+// master updates the backends document with the list of buckets balanced
+// between registered backends
+func (b *backend) master(ctx context.Context) error {
+	if !b.isMasterBackend() {
+		doc, err := b.dbBackends.TryLease(ctx)
+		if err != nil || doc == nil {
+			return err
+		}
+		b.isMaster.Store(true)
+	}
+
+	_, err := b.dbBackends.PatchWithLease(ctx, "master", func(doc *api.MonitorDocument) error {
+		docs, err := b.dbBackends.ListMonitors(ctx)
+		if err != nil {
+			return err
+		}
+
+		var backends []string
+		if docs != nil {
+			backends = make([]string, 0, len(docs.MonitorDocuments))
+			for _, doc := range docs.MonitorDocuments {
+				backends = append(backends, doc.ID)
+			}
+		}
+
+		if doc.Monitor == nil {
+			doc.Monitor = &api.Monitor{}
+		}
+		doc.Monitor.Buckets = bucket.Balance(backends, doc.Monitor.Buckets, b.bucketCount)
+
+		return nil
+	})
+	if err != nil && err.Error() == "lost lease" {
+		b.isMaster.Store(false)
+	}
+	return err
+}
+
+// isMasterBackend returns true if this backend holds the master lease.  It is
+// safe to call from goroutines other than coordinate.
+func (b *backend) isMasterBackend() bool {
+	isMaster, _ := b.isMaster.Load().(bool)
+	return isMaster
+}
+
+func (b *backend) listBuckets(ctx context.Context) error {
+	buckets, err := b.dbBackends.ListBuckets(ctx)
+	if err != nil {
+		return err
+	}
+
+	// an empty, non-nil allocation means that we own no buckets, as opposed
+	// to not yet knowing what we own
+	if buckets == nil {
+		buckets = []int{}
+	}
+
+	b.buckets.Store(buckets)
+	b.m.EmitGauge("backend.buckets.count", int64(len(buckets)), nil)
+
+	return nil
+}
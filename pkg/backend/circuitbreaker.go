@@ -0,0 +1,56 @@
+package backend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
+	"github.com/Azure/ARO-RP/pkg/util/recover"
+)
+
+// monitorARMCircuitBreaker logs the transitions of the ARM circuit breaker
+// and emits its state every minute.  While it is open, the backend only works
+// deletes and customer updates, and drift reconciliation and follow-up tasks
+// are paused.
+func (b *backend) monitorARMCircuitBreaker(stop <-chan struct{}) {
+	defer recover.Panic(b.baseLog)
+
+	t := time.NewTicker(time.Minute)
+	defer t.Stop()
+
+	previous := azureclient.CircuitClosed
+
+	for {
+		state := b.armCircuitBreaker.State()
+		if state != previous {
+			switch state {
+			case azureclient.CircuitOpen:
+				b.baseLog.Warn("ARM circuit breaker opened: pausing non-urgent work")
+			case azureclient.CircuitHalfOpen:
+				b.baseLog.Info("ARM circuit breaker half-opened: resuming non-urgent work")
+			case azureclient.CircuitClosed:
+				b.baseLog.Info("ARM circuit breaker closed")
+			}
+			previous = state
+		}
+
+		for _, s := range []string{azureclient.CircuitClosed, azureclient.CircuitOpen, azureclient.CircuitHalfOpen} {
+			var value int64
+			if s == state {
+				value = 1
+			}
+
+			b.m.EmitGauge("backend.armcircuitbreaker.state", value, map[string]string{
+				"state": s,
+			})
+		}
+
+		select {
+		case <-t.C:
+		case <-stop:
+			return
+		}
+	}
+}
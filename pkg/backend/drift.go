@@ -0,0 +1,138 @@
+package backend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/cluster"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+	"github.com/Azure/ARO-RP/pkg/util/recover"
+)
+
+// driftReconcileInterval is deliberately long: drift is rare, and each pass
+// makes several Azure API calls per cluster.
+const driftReconcileInterval = 6 * time.Hour
+
+type driftReconciler interface {
+	ReconcileDrift(context.Context) []cluster.DriftResult
+}
+
+func newDriftReconciler(ctx context.Context, b *backend, log *logrus.Entry, doc *api.OpenShiftClusterDocument, subscriptionDoc *api.SubscriptionDocument) (driftReconciler, error) {
+	return cluster.NewManager(ctx, log, b.env, b.dbOpenShiftClusters, b.cipher, b.billing, doc, subscriptionDoc, b.m)
+}
+
+// reconcileDrift periodically checks the critical Azure resources of every
+// running cluster and repairs those which have drifted, rather than waiting
+// for the next customer operation to do so.
+func (b *backend) reconcileDrift(ctx context.Context, stop <-chan struct{}) {
+	defer recover.Panic(b.baseLog)
+
+	t := time.NewTicker(driftReconcileInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-t.C:
+		case <-stop:
+			return
+		}
+
+		err := b.reconcileDriftOnce(ctx)
+		if err != nil {
+			b.baseLog.Error(err)
+		}
+	}
+}
+
+func (b *backend) reconcileDriftOnce(ctx context.Context) error {
+	ctx = azureclient.WithCircuitBreaker(ctx, b.armCircuitBreaker, true)
+
+	type driftCount struct {
+		drifted int64
+		errors  int64
+	}
+
+	counts := map[string]*driftCount{}
+	defer func() {
+		for resource, count := range counts {
+			dims := map[string]string{
+				"resource": resource,
+			}
+			b.m.EmitGauge("backend.drift.detected.count", count.drifted, dims)
+			b.m.EmitGauge("backend.drift.errors.count", count.errors, dims)
+		}
+	}()
+
+	i := b.dbOpenShiftClusters.List("")
+
+	for {
+		docs, err := i.Next(ctx, -1)
+		if err != nil {
+			return err
+		}
+		if docs == nil {
+			return nil
+		}
+
+		for _, doc := range docs.OpenShiftClusterDocuments {
+			if !b.armCircuitBreaker.Allow() {
+				b.baseLog.Warn("ARM circuit breaker is open: pausing drift reconciliation until the next pass")
+				return nil
+			}
+
+			// leave clusters which are mid-operation or failed to the
+			// operation which will next run on them
+			if doc.OpenShiftCluster.Properties.ProvisioningState != api.ProvisioningStateSucceeded ||
+				doc.LeaseExpires > int(time.Now().Unix()) {
+				continue
+			}
+
+			log := utillog.EnrichWithResourceID(b.baseLog, doc.OpenShiftCluster.ID)
+
+			results, err := b.reconcileClusterDrift(ctx, log, doc)
+			if err != nil {
+				log.Error(err)
+				continue
+			}
+
+			for _, result := range results {
+				if counts[result.Resource] == nil {
+					counts[result.Resource] = &driftCount{}
+				}
+				if result.Drifted {
+					counts[result.Resource].drifted++
+				}
+				if result.Err != nil {
+					counts[result.Resource].errors++
+				}
+			}
+		}
+	}
+}
+
+func (b *backend) reconcileClusterDrift(ctx context.Context, log *logrus.Entry, doc *api.OpenShiftClusterDocument) ([]cluster.DriftResult, error) {
+	r, err := azure.ParseResourceID(doc.OpenShiftCluster.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionDoc, err := b.dbSubscriptions.Get(ctx, r.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := b.newDriftReconciler(ctx, b, log, doc, subscriptionDoc)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.ReconcileDrift(ctx), nil
+}
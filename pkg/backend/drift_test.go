@@ -0,0 +1,125 @@
+package backend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/cluster"
+	mock_metrics "github.com/Azure/ARO-RP/pkg/util/mocks/metrics"
+	testdb "github.com/Azure/ARO-RP/test/database"
+)
+
+type fakeDriftReconciler struct {
+	results []cluster.DriftResult
+}
+
+func (f *fakeDriftReconciler) ReconcileDrift(context.Context) []cluster.DriftResult {
+	return f.results
+}
+
+func TestReconcileDriftOnce(t *testing.T) {
+	ctx := context.Background()
+
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dbOpenShiftClusters, _ := testdb.NewFakeOpenShiftClusters()
+	dbSubscriptions, _ := testdb.NewFakeSubscriptions()
+
+	f := testdb.NewFixture().WithOpenShiftClusters(dbOpenShiftClusters).WithSubscriptions(dbSubscriptions)
+	for name, doc := range map[string]*api.OpenShiftClusterDocument{
+		"succeeded": {},
+		"drifted":   {},
+		"updating": {
+			OpenShiftCluster: &api.OpenShiftCluster{
+				Properties: api.OpenShiftClusterProperties{
+					ProvisioningState: api.ProvisioningStateUpdating,
+				},
+			},
+		},
+		"leased": {
+			LeaseExpires: int(time.Now().Add(time.Minute).Unix()),
+		},
+	} {
+		resourceID := fmt.Sprintf("/subscriptions/%s/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/%s", mockSubID, name)
+		doc.Key = strings.ToLower(resourceID)
+		if doc.OpenShiftCluster == nil {
+			doc.OpenShiftCluster = &api.OpenShiftCluster{
+				Properties: api.OpenShiftClusterProperties{
+					ProvisioningState: api.ProvisioningStateSucceeded,
+				},
+			}
+		}
+		doc.OpenShiftCluster.ID = resourceID
+		doc.OpenShiftCluster.Name = name
+		f.AddOpenShiftClusterDocuments(doc)
+	}
+	f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+		ID: mockSubID,
+	})
+	err := f.Create()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := mock_metrics.NewMockInterface(controller)
+	m.EXPECT().EmitGauge("backend.drift.detected.count", int64(0), map[string]string{"resource": "dns"})
+	m.EXPECT().EmitGauge("backend.drift.errors.count", int64(0), map[string]string{"resource": "dns"})
+	m.EXPECT().EmitGauge("backend.drift.detected.count", int64(1), map[string]string{"resource": "loadbalancer"})
+	m.EXPECT().EmitGauge("backend.drift.errors.count", int64(1), map[string]string{"resource": "loadbalancer"})
+
+	var reconciled []string
+
+	b := &backend{
+		baseLog:             logrus.NewEntry(logrus.StandardLogger()),
+		dbOpenShiftClusters: dbOpenShiftClusters,
+		dbSubscriptions:     dbSubscriptions,
+		m:                   m,
+
+		newDriftReconciler: func(ctx context.Context, b *backend, log *logrus.Entry, doc *api.OpenShiftClusterDocument, subscriptionDoc *api.SubscriptionDocument) (driftReconciler, error) {
+			reconciled = append(reconciled, doc.OpenShiftCluster.Name)
+
+			if doc.OpenShiftCluster.Name == "drifted" {
+				return &fakeDriftReconciler{
+					results: []cluster.DriftResult{
+						{Resource: "dns"},
+						{Resource: "loadbalancer", Drifted: true, Err: fmt.Errorf("random error")},
+					},
+				}, nil
+			}
+
+			return &fakeDriftReconciler{
+				results: []cluster.DriftResult{
+					{Resource: "dns"},
+					{Resource: "loadbalancer"},
+				},
+			}, nil
+		},
+	}
+
+	err = b.reconcileDriftOnce(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(reconciled) != 2 {
+		t.Error(reconciled)
+	}
+	for _, name := range reconciled {
+		if name != "succeeded" && name != "drifted" {
+			t.Error(name)
+		}
+	}
+}
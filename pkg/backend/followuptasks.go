@@ -0,0 +1,195 @@
+package backend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+	"github.com/Azure/ARO-RP/pkg/util/recover"
+)
+
+const (
+	followUpTaskInterval   = 5 * time.Minute
+	followUpTaskMaxBackoff = time.Hour
+)
+
+// followUpTaskBackoff returns how long to wait before the next attempt at a
+// task which has failed the given number of times
+func followUpTaskBackoff(attempts int) time.Duration {
+	backoff := time.Minute
+	for i := 1; i < attempts && backoff < followUpTaskMaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	if backoff > followUpTaskMaxBackoff {
+		backoff = followUpTaskMaxBackoff
+	}
+
+	return backoff
+}
+
+// runFollowUpTasks periodically retries the follow-up tasks which operations
+// left on cluster documents, independently of the operations themselves.
+func (b *backend) runFollowUpTasks(ctx context.Context, stop <-chan struct{}) {
+	defer recover.Panic(b.baseLog)
+
+	t := time.NewTicker(followUpTaskInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-t.C:
+		case <-stop:
+			return
+		}
+
+		err := b.runFollowUpTasksOnce(ctx)
+		if err != nil {
+			b.baseLog.Error(err)
+		}
+	}
+}
+
+func (b *backend) runFollowUpTasksOnce(ctx context.Context) error {
+	type taskCount struct {
+		pending int64
+		failed  int64
+	}
+
+	counts := map[api.FollowUpTaskType]*taskCount{}
+	defer func() {
+		for taskType, count := range counts {
+			dims := map[string]string{
+				"type": string(taskType),
+			}
+			b.m.EmitGauge("backend.followuptasks.pending.count", count.pending, dims)
+			b.m.EmitGauge("backend.followuptasks.failed.count", count.failed, dims)
+		}
+	}()
+
+	ctx = azureclient.WithCircuitBreaker(ctx, b.armCircuitBreaker, true)
+
+	i := b.dbOpenShiftClusters.ListWithFollowUpTasks()
+
+	for {
+		docs, err := i.Next(ctx, -1)
+		if err != nil {
+			return err
+		}
+		if docs == nil {
+			return nil
+		}
+
+		for _, doc := range docs.OpenShiftClusterDocuments {
+			if !b.armCircuitBreaker.Allow() {
+				b.baseLog.Warn("ARM circuit breaker is open: pausing follow-up tasks until the next pass")
+				return nil
+			}
+
+			// the tasks of a cluster which is being deleted go with it; those
+			// of a cluster which is mid-operation wait for the operation to
+			// finish, so that the two never race on the document
+			if doc.OpenShiftCluster.Properties.ProvisioningState == api.ProvisioningStateDeleting ||
+				doc.LeaseExpires > int(time.Now().Unix()) {
+				continue
+			}
+
+			log := utillog.EnrichWithResourceID(b.baseLog, doc.OpenShiftCluster.ID)
+
+			for _, task := range doc.FollowUpTasks {
+				if counts[task.Type] == nil {
+					counts[task.Type] = &taskCount{}
+				}
+
+				if task.NextAttempt > int(time.Now().Unix()) {
+					counts[task.Type].pending++
+					continue
+				}
+
+				done, err := b.runFollowUpTask(ctx, log, doc, task)
+				if err != nil {
+					log.Error(err)
+				}
+				if !done {
+					counts[task.Type].pending++
+					counts[task.Type].failed++
+				}
+			}
+		}
+	}
+}
+
+// runFollowUpTask makes an attempt at task and records the outcome on the
+// cluster document.  It returns true if the task is done.
+func (b *backend) runFollowUpTask(ctx context.Context, log *logrus.Entry, doc *api.OpenShiftClusterDocument, task api.FollowUpTask) (bool, error) {
+	var taskErr error
+	switch task.Type {
+	case api.FollowUpTaskTypeBillingRecord:
+		taskErr = b.ensureBillingRecord(ctx, doc)
+	default:
+		taskErr = fmt.Errorf("unknown follow-up task type %q", task.Type)
+	}
+
+	if taskErr == nil {
+		log.Printf("follow-up task %s done after %d failed attempts", task.Type, task.Attempts)
+	} else {
+		log.Warnf("follow-up task %s failed: %v", task.Type, taskErr)
+	}
+
+	_, err := b.dbOpenShiftClusters.Patch(ctx, doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+		for i := range doc.FollowUpTasks {
+			if doc.FollowUpTasks[i].Type != task.Type {
+				continue
+			}
+
+			if taskErr == nil {
+				doc.FollowUpTasks = append(doc.FollowUpTasks[:i], doc.FollowUpTasks[i+1:]...)
+				return nil
+			}
+
+			doc.FollowUpTasks[i].Attempts++
+			doc.FollowUpTasks[i].NextAttempt = int(time.Now().Add(followUpTaskBackoff(doc.FollowUpTasks[i].Attempts)).Unix())
+			doc.FollowUpTasks[i].LastError = taskErr.Error()
+			return nil
+		}
+
+		return nil
+	})
+	if cosmosdb.IsErrorStatusCode(err, http.StatusNotFound) {
+		err = nil
+	}
+
+	return taskErr == nil, err
+}
+
+// ensureBillingRecord creates the billing record which the operation on the
+// cluster could not.  The cluster may have begun deleting in the meantime,
+// and if so its deletion may have missed the record, so it is then marked for
+// deletion here.
+func (b *backend) ensureBillingRecord(ctx context.Context, doc *api.OpenShiftClusterDocument) error {
+	err := b.billing.Ensure(ctx, doc)
+	if err != nil {
+		return err
+	}
+
+	current, err := b.dbOpenShiftClusters.Get(ctx, doc.Key)
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+	case err != nil:
+		return err
+	case current.OpenShiftCluster.Properties.ProvisioningState != api.ProvisioningStateDeleting:
+		return nil
+	}
+
+	return b.billing.Delete(ctx, doc)
+}
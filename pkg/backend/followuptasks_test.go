@@ -0,0 +1,181 @@
+package backend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	mock_billing "github.com/Azure/ARO-RP/pkg/util/mocks/billing"
+	mock_metrics "github.com/Azure/ARO-RP/pkg/util/mocks/metrics"
+	testdb "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestFollowUpTaskBackoff(t *testing.T) {
+	for attempts, want := range map[int]time.Duration{
+		1:  time.Minute,
+		2:  2 * time.Minute,
+		4:  8 * time.Minute,
+		7:  followUpTaskMaxBackoff,
+		50: followUpTaskMaxBackoff,
+	} {
+		if got := followUpTaskBackoff(attempts); got != want {
+			t.Error(attempts, got)
+		}
+	}
+}
+
+func TestRunFollowUpTasksOnce(t *testing.T) {
+	ctx := context.Background()
+
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dbOpenShiftClusters, _ := testdb.NewFakeOpenShiftClusters()
+
+	due := []api.FollowUpTask{
+		{
+			Type:     api.FollowUpTaskTypeBillingRecord,
+			Attempts: 1,
+		},
+	}
+
+	f := testdb.NewFixture().WithOpenShiftClusters(dbOpenShiftClusters)
+	for name, doc := range map[string]*api.OpenShiftClusterDocument{
+		"none":    {},
+		"retried": {FollowUpTasks: due},
+		"failing": {FollowUpTasks: due},
+		"deletedmeanwhile": {
+			FollowUpTasks: due,
+		},
+		"notdue": {
+			FollowUpTasks: []api.FollowUpTask{
+				{
+					Type:        api.FollowUpTaskTypeBillingRecord,
+					Attempts:    1,
+					NextAttempt: int(time.Now().Add(time.Hour).Unix()),
+				},
+			},
+		},
+		"leased": {
+			FollowUpTasks: due,
+			LeaseExpires:  int(time.Now().Add(time.Minute).Unix()),
+		},
+		"deleting": {
+			FollowUpTasks: due,
+			OpenShiftCluster: &api.OpenShiftCluster{
+				Properties: api.OpenShiftClusterProperties{
+					ProvisioningState: api.ProvisioningStateDeleting,
+				},
+			},
+		},
+	} {
+		resourceID := fmt.Sprintf("/subscriptions/%s/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/%s", mockSubID, name)
+		doc.ID = name
+		doc.Key = strings.ToLower(resourceID)
+		if doc.OpenShiftCluster == nil {
+			doc.OpenShiftCluster = &api.OpenShiftCluster{
+				Properties: api.OpenShiftClusterProperties{
+					ProvisioningState: api.ProvisioningStateSucceeded,
+				},
+			}
+		}
+		doc.OpenShiftCluster.ID = resourceID
+		doc.OpenShiftCluster.Name = name
+		f.AddOpenShiftClusterDocuments(doc)
+	}
+	err := f.Create()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ensured []string
+
+	billing := mock_billing.NewMockManager(controller)
+	billing.EXPECT().
+		Ensure(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, doc *api.OpenShiftClusterDocument) error {
+			ensured = append(ensured, doc.ID)
+
+			switch doc.ID {
+			case "failing":
+				return errors.New("random error")
+			case "deletedmeanwhile":
+				_, err := dbOpenShiftClusters.Patch(ctx, doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+					doc.OpenShiftCluster.Properties.ProvisioningState = api.ProvisioningStateDeleting
+					return nil
+				})
+				return err
+			}
+
+			return nil
+		}).
+		Times(3)
+	billing.EXPECT().
+		Delete(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, doc *api.OpenShiftClusterDocument) error {
+			if doc.ID != "deletedmeanwhile" {
+				t.Error(doc.ID)
+			}
+			return nil
+		})
+
+	m := mock_metrics.NewMockInterface(controller)
+	m.EXPECT().EmitGauge("backend.followuptasks.pending.count", int64(2), map[string]string{"type": "BillingRecord"})
+	m.EXPECT().EmitGauge("backend.followuptasks.failed.count", int64(1), map[string]string{"type": "BillingRecord"})
+
+	b := &backend{
+		baseLog:             logrus.NewEntry(logrus.StandardLogger()),
+		dbOpenShiftClusters: dbOpenShiftClusters,
+		billing:             billing,
+		m:                   m,
+	}
+
+	err = b.runFollowUpTasksOnce(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sort.Strings(ensured)
+	if strings.Join(ensured, ",") != "deletedmeanwhile,failing,retried" {
+		t.Error(ensured)
+	}
+
+	for name, wantTasks := range map[string]int{
+		"none":             0,
+		"retried":          0,
+		"deletedmeanwhile": 0,
+		"failing":          1,
+		"notdue":           1,
+		"leased":           1,
+		"deleting":         1,
+	} {
+		doc, err := dbOpenShiftClusters.Get(ctx, strings.ToLower(fmt.Sprintf("/subscriptions/%s/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/%s", mockSubID, name)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(doc.FollowUpTasks) != wantTasks {
+			t.Error(name, doc.FollowUpTasks)
+		}
+
+		if name == "failing" {
+			task := doc.FollowUpTasks[0]
+			if task.Attempts != 2 || task.LastError != "random error" || task.NextAttempt <= int(time.Now().Unix()) {
+				t.Error(task)
+			}
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package backend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/steps"
+)
+
+// The provisioning funnel metrics are the time which operations spend in each
+// provisioningState and in each of their steps.  They are emitted once per
+// operation or step, so their distribution over the fleet can be compared
+// across versions and RP releases.  The location dimension is added by the
+// metrics emitter.
+
+// stepName returns the name of the function which a step runs, or the
+// step's full name if it is not recognised
+func stepName(step string) string {
+	m := rxStepFunc.FindStringSubmatch(step)
+	if m == nil {
+		return step
+	}
+
+	return m[1]
+}
+
+// stepTiming returns a steps.TimingFunc which emits the duration of each step
+// run on behalf of doc and records the step for the operation history
+func (ocb *openShiftClusterBackend) stepTiming(doc *api.OpenShiftClusterDocument) steps.TimingFunc {
+	provisioningState := doc.OpenShiftCluster.Properties.ProvisioningState
+	version := doc.OpenShiftCluster.Properties.ClusterProfile.Version
+
+	return func(ctx context.Context, step steps.Step, duration time.Duration, err error) {
+		result := "Succeeded"
+		if err != nil {
+			result = "Failed"
+		}
+
+		ocb.m.EmitGauge("backend.openshiftcluster.step.duration", duration.Milliseconds(), map[string]string{
+			"provisioningState": string(provisioningState),
+			"step":              stepName(step.String()),
+			"result":            result,
+			"version":           version,
+		})
+
+		stepRecorderFromContext(ctx).record(step, duration, err)
+	}
+}
@@ -0,0 +1,94 @@
+package backend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	mock_metrics "github.com/Azure/ARO-RP/pkg/util/mocks/metrics"
+	"github.com/Azure/ARO-RP/pkg/util/steps"
+	testlog "github.com/Azure/ARO-RP/test/util/log"
+)
+
+func TestStepName(t *testing.T) {
+	for _, tt := range []struct {
+		step string
+		want string
+	}{
+		{
+			step: "[Action github.com/Azure/ARO-RP/pkg/cluster.(*manager).createDNS-fm]",
+			want: "createDNS",
+		},
+		{
+			step: "[Condition github.com/Azure/ARO-RP/pkg/cluster.(*manager).bootstrapConfigMapReady-fm, timeout 30m0s]",
+			want: "bootstrapConfigMapReady",
+		},
+		{
+			step: "unknown",
+			want: "unknown",
+		},
+	} {
+		if got := stepName(tt.step); got != tt.want {
+			t.Errorf("%s: got %q", tt.step, got)
+		}
+	}
+}
+
+func createDNS(context.Context) error { return nil }
+
+func ensureResourceGroup(context.Context) error { return errors.New("oops") }
+
+func TestStepTiming(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	m := mock_metrics.NewMockInterface(controller)
+
+	ocb := &openShiftClusterBackend{
+		backend: &backend{
+			m: m,
+		},
+	}
+
+	doc := &api.OpenShiftClusterDocument{
+		OpenShiftCluster: &api.OpenShiftCluster{
+			Properties: api.OpenShiftClusterProperties{
+				ProvisioningState: api.ProvisioningStateCreating,
+				ClusterProfile: api.ClusterProfile{
+					Version: "4.6.8",
+				},
+			},
+		},
+	}
+
+	m.EXPECT().EmitGauge("backend.openshiftcluster.step.duration", gomock.Any(), map[string]string{
+		"provisioningState": "Creating",
+		"step":              "createDNS",
+		"result":            "Succeeded",
+		"version":           "4.6.8",
+	})
+	m.EXPECT().EmitGauge("backend.openshiftcluster.step.duration", gomock.Any(), map[string]string{
+		"provisioningState": "Creating",
+		"step":              "ensureResourceGroup",
+		"result":            "Failed",
+		"version":           "4.6.8",
+	})
+
+	_, log := testlog.New()
+	ctx := steps.WithTiming(context.Background(), ocb.stepTiming(doc))
+
+	err := steps.Run(ctx, log, time.Millisecond, []steps.Step{
+		steps.Action(createDNS),
+		steps.Action(ensureResourceGroup),
+	})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
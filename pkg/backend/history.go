@@ -0,0 +1,114 @@
+package backend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/steps"
+)
+
+// maxOperationHistory is the number of operations kept in the history of each
+// cluster document.  Older operations are dropped.
+const maxOperationHistory = 20
+
+type stepRecorderContextKey struct{}
+
+// stepRecorder accumulates the steps run during a lease, for the operation
+// history
+type stepRecorder struct {
+	mu    sync.Mutex
+	start time.Time
+	steps []api.OperationRecordStep
+}
+
+// withStepRecorder returns a context in which stepTiming records each step in
+// a new stepRecorder
+func withStepRecorder(ctx context.Context, start time.Time) context.Context {
+	return context.WithValue(ctx, stepRecorderContextKey{}, &stepRecorder{start: start})
+}
+
+func stepRecorderFromContext(ctx context.Context) *stepRecorder {
+	r, _ := ctx.Value(stepRecorderContextKey{}).(*stepRecorder)
+	return r
+}
+
+func (r *stepRecorder) record(step steps.Step, duration time.Duration, err error) {
+	if r == nil {
+		return
+	}
+
+	result := api.ProvisioningStateSucceeded
+	if err != nil {
+		result = api.ProvisioningStateFailed
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.steps = append(r.steps, api.OperationRecordStep{
+		Step:       stepName(step.String()),
+		DurationMS: duration.Milliseconds(),
+		Result:     result,
+	})
+}
+
+// recordOperationHistory adds the steps run during the lease on doc to the
+// cluster's operation history.  The record is completed once the operation
+// reaches a terminal provisioningState; until then, later leases of the same
+// operation add to it.
+func (ocb *openShiftClusterBackend) recordOperationHistory(ctx context.Context, doc *api.OpenShiftClusterDocument, provisioningState api.ProvisioningState, backendErr error) error {
+	now := ocb.now().UTC()
+	start := now
+
+	var recorded []api.OperationRecordStep
+	if r := stepRecorderFromContext(ctx); r != nil {
+		r.mu.Lock()
+		start = r.start.UTC()
+		recorded = append(recorded, r.steps...)
+		r.mu.Unlock()
+	}
+
+	operation := doc.OpenShiftCluster.Properties.ProvisioningState
+
+	_, err := ocb.dbOpenShiftClusters.PatchWithLease(ctx, doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+		var record *api.OperationRecord
+		if len(doc.OperationHistory) > 0 {
+			last := &doc.OperationHistory[len(doc.OperationHistory)-1]
+			if last.EndTime == nil && last.Operation == operation && last.AsyncOperationID == doc.AsyncOperationID {
+				record = last
+			}
+		}
+
+		if record == nil {
+			doc.OperationHistory = append(doc.OperationHistory, api.OperationRecord{
+				Operation:        operation,
+				AsyncOperationID: doc.AsyncOperationID,
+				StartTime:        start,
+			})
+			record = &doc.OperationHistory[len(doc.OperationHistory)-1]
+		}
+
+		record.Steps = append(record.Steps, recorded...)
+
+		if provisioningState.IsTerminal() {
+			record.EndTime = &now
+			record.Result = provisioningState
+			if backendErr != nil {
+				record.FailedStep = stepName(steps.FailedStep(ctx))
+				record.Error = backendErr.Error()
+			}
+		}
+
+		if len(doc.OperationHistory) > maxOperationHistory {
+			doc.OperationHistory = doc.OperationHistory[len(doc.OperationHistory)-maxOperationHistory:]
+		}
+
+		return nil
+	})
+	return err
+}
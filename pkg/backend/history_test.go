@@ -0,0 +1,143 @@
+package backend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	"github.com/Azure/ARO-RP/pkg/util/steps"
+	testdb "github.com/Azure/ARO-RP/test/database"
+	testlog "github.com/Azure/ARO-RP/test/util/log"
+)
+
+func TestRecordOperationHistory(t *testing.T) {
+	ctx := context.Background()
+	_, log := testlog.New()
+
+	resourceID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName"
+	now := time.Date(2020, time.November, 1, 12, 0, 0, 0, time.UTC)
+
+	old := make([]api.OperationRecord, maxOperationHistory-1)
+	for i := range old {
+		old[i] = api.OperationRecord{
+			Operation: api.ProvisioningStateAdminUpdating,
+			StartTime: now.Add(-time.Hour),
+			EndTime:   &now,
+			Result:    api.ProvisioningStateSucceeded,
+		}
+	}
+	old[0].Operation = api.ProvisioningStateCreating
+
+	dbOpenShiftClusters, _ := testdb.NewFakeOpenShiftClusters()
+
+	f := testdb.NewFixture().WithOpenShiftClusters(dbOpenShiftClusters)
+	f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+		Key:              strings.ToLower(resourceID),
+		AsyncOperationID: "install",
+		OpenShiftCluster: &api.OpenShiftCluster{
+			ID: resourceID,
+			Properties: api.OpenShiftClusterProperties{
+				ProvisioningState: api.ProvisioningStateUpdating,
+			},
+		},
+		OperationHistory: old,
+	})
+	err := f.Create()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err := dbOpenShiftClusters.Dequeue(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ocb := &openShiftClusterBackend{
+		backend: &backend{
+			dbOpenShiftClusters: dbOpenShiftClusters,
+			m:                   &noop.Noop{},
+		},
+		now: func() time.Time { return now },
+	}
+
+	// runLease runs steps in a new lease of the operation and records it
+	runLease := func(provisioningState api.ProvisioningState, s ...steps.Step) {
+		ctx := steps.WithFailedStep(ctx)
+		ctx = withStepRecorder(ctx, now.Add(-time.Minute))
+		ctx = steps.WithTiming(ctx, ocb.stepTiming(doc))
+
+		backendErr := steps.Run(ctx, log, time.Millisecond, s)
+
+		err := ocb.recordOperationHistory(ctx, doc, provisioningState, backendErr)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// an update spanning two leases is recorded once
+	runLease(api.ProvisioningStateUpdating, steps.Action(createDNS))
+	runLease(api.ProvisioningStateFailed, steps.Action(createDNS), steps.Action(ensureResourceGroup))
+
+	doc, err = dbOpenShiftClusters.Get(ctx, strings.ToLower(resourceID))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(doc.OperationHistory) != maxOperationHistory {
+		t.Fatal(len(doc.OperationHistory))
+	}
+
+	got := doc.OperationHistory[maxOperationHistory-1]
+	for i := range got.Steps {
+		got.Steps[i].DurationMS = 0
+	}
+
+	want := api.OperationRecord{
+		Operation:        api.ProvisioningStateUpdating,
+		AsyncOperationID: "install",
+		StartTime:        now.Add(-time.Minute),
+		EndTime:          &now,
+		Result:           api.ProvisioningStateFailed,
+		FailedStep:       "ensureResourceGroup",
+		Error:            "oops",
+		Steps: []api.OperationRecordStep{
+			{
+				Step:   "createDNS",
+				Result: api.ProvisioningStateSucceeded,
+			},
+			{
+				Step:   "createDNS",
+				Result: api.ProvisioningStateSucceeded,
+			},
+			{
+				Step:   "ensureResourceGroup",
+				Result: api.ProvisioningStateFailed,
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Error(got)
+	}
+
+	// a further operation pushes the oldest record out
+	runLease(api.ProvisioningStateSucceeded)
+
+	doc, err = dbOpenShiftClusters.Get(ctx, strings.ToLower(resourceID))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(doc.OperationHistory) != maxOperationHistory ||
+		doc.OperationHistory[0].Operation != api.ProvisioningStateAdminUpdating ||
+		doc.OperationHistory[maxOperationHistory-1].Result != api.ProvisioningStateSucceeded {
+		t.Error(doc.OperationHistory)
+	}
+}
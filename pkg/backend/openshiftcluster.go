@@ -18,22 +18,28 @@ import (
 	"github.com/Azure/ARO-RP/pkg/backend/openshiftcluster"
 	"github.com/Azure/ARO-RP/pkg/database"
 	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/metrics"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
 	"github.com/Azure/ARO-RP/pkg/util/billing"
 	"github.com/Azure/ARO-RP/pkg/util/encryption"
 	utillog "github.com/Azure/ARO-RP/pkg/util/log"
 	"github.com/Azure/ARO-RP/pkg/util/recover"
+	"github.com/Azure/ARO-RP/pkg/util/steps"
 )
 
 type openShiftClusterBackend struct {
 	*backend
 
-	newManager func(log *logrus.Entry, _env env.Interface, db database.OpenShiftClusters, cipher encryption.Cipher, billing billing.Manager, doc *api.OpenShiftClusterDocument, subscriptionDoc *api.SubscriptionDocument) (openshiftcluster.Manager, error)
+	newManager func(log *logrus.Entry, _env env.Interface, db database.OpenShiftClusters, dbOpenShiftVersions database.OpenShiftVersions, cipher encryption.Cipher, billing billing.Manager, doc *api.OpenShiftClusterDocument, subscriptionDoc *api.SubscriptionDocument, m metrics.Interface) (openshiftcluster.Manager, error)
+
+	now func() time.Time
 }
 
 func newOpenShiftClusterBackend(b *backend) *openShiftClusterBackend {
 	return &openShiftClusterBackend{
 		backend:    b,
 		newManager: openshiftcluster.NewManager,
+		now:        time.Now,
 	}
 }
 
@@ -42,7 +48,7 @@ func newOpenShiftClusterBackend(b *backend) *openShiftClusterBackend {
 // succeeded in dequeuing anything - if this is false, the caller should sleep
 // before calling again
 func (ocb *openShiftClusterBackend) try(ctx context.Context) (bool, error) {
-	doc, err := ocb.dbOpenShiftClusters.Dequeue(ctx)
+	doc, err := ocb.dequeue(ctx)
 	if err != nil || doc == nil {
 		return false, err
 	}
@@ -56,9 +62,14 @@ func (ocb *openShiftClusterBackend) try(ctx context.Context) (bool, error) {
 		return true, ocb.endLease(ctx, log, nil, doc, api.ProvisioningStateFailed, err)
 	}
 
+	class := operationClass(doc.OpenShiftCluster.Properties.ProvisioningState)
+
 	log.Print("dequeued")
 	atomic.AddInt32(&ocb.workers, 1)
 	ocb.m.EmitGauge("backend.openshiftcluster.workers.count", int64(atomic.LoadInt32(&ocb.workers)), nil)
+	if class == operationClassStandard {
+		atomic.AddInt32(&ocb.standardWorkers, 1)
+	}
 
 	go func() {
 		defer recover.Panic(log)
@@ -66,6 +77,9 @@ func (ocb *openShiftClusterBackend) try(ctx context.Context) (bool, error) {
 		t := time.Now()
 
 		defer func() {
+			if class == operationClassStandard {
+				atomic.AddInt32(&ocb.standardWorkers, -1)
+			}
 			atomic.AddInt32(&ocb.workers, -1)
 			ocb.m.EmitGauge("backend.openshiftcluster.workers.count", int64(atomic.LoadInt32(&ocb.workers)), nil)
 			ocb.cond.Signal()
@@ -82,6 +96,65 @@ func (ocb *openShiftClusterBackend) try(ctx context.Context) (bool, error) {
 	return true, nil
 }
 
+const (
+	operationClassPriority = "priority"
+	operationClassStandard = "standard"
+)
+
+// operationClass returns the scheduling class of the operation of a document
+// in provisioningState.  Deletes and customer updates act on an existing
+// cluster and are comparatively short, so they are of the priority class and
+// are scheduled ahead of creates and admin updates.
+func operationClass(provisioningState api.ProvisioningState) string {
+	switch provisioningState {
+	case api.ProvisioningStateDeleting, api.ProvisioningStateUpdating:
+		return operationClassPriority
+	default:
+		return operationClassStandard
+	}
+}
+
+// dequeue dequeues a document from one of the buckets allocated to this
+// backend, preferring documents of the priority class.  Standard class
+// documents are only dequeued while there are workers for them to spare and
+// the ARM circuit breaker is not open.  If there is none, it steals a document
+// which has gone unclaimed for too long, so that a saturated or departed
+// backend doesn't hold up the documents in its buckets.
+func (ocb *openShiftClusterBackend) dequeue(ctx context.Context) (*api.OpenShiftClusterDocument, error) {
+	buckets, _ := ocb.buckets.Load().([]int)
+
+	source := "owned"
+	doc, err := ocb.dbOpenShiftClusters.DequeuePriority(ctx, buckets)
+	if err == nil && doc == nil && atomic.LoadInt32(&ocb.standardWorkers) < ocb.maxStandardWorkers &&
+		ocb.armCircuitBreaker.Allow() {
+		doc, err = ocb.dbOpenShiftClusters.Dequeue(ctx, buckets)
+		if err == nil && doc == nil && buckets != nil {
+			source = "stolen"
+			doc, err = ocb.dbOpenShiftClusters.DequeueStale(ctx)
+		}
+	}
+	if err != nil || doc == nil {
+		return nil, err
+	}
+
+	class := operationClass(doc.OpenShiftCluster.Properties.ProvisioningState)
+
+	ocb.m.EmitGauge("backend.openshiftcluster.dequeue.count", 1, map[string]string{
+		"class":  class,
+		"source": source,
+	})
+
+	// the wait is only meaningful the first time that an operation is
+	// dequeued: later install phases and retries were not waiting on a worker
+	if doc.Dequeues == 1 && doc.OpenShiftCluster.Properties.Install == nil && doc.CorrelationData != nil {
+		ocb.m.EmitGauge("backend.openshiftcluster.wait.duration", time.Since(doc.CorrelationData.RequestTime).Milliseconds(), map[string]string{
+			"class": class,
+		})
+	}
+
+	return doc, nil
+}
+
 // handle is responsible for handling backend operation and lease
 func (ocb *openShiftClusterBackend) handle(ctx context.Context, log *logrus.Entry, doc *api.OpenShiftClusterDocument) error {
 	ctx, cancel := context.WithCancel(ctx)
@@ -90,6 +163,14 @@ func (ocb *openShiftClusterBackend) handle(ctx context.Context, log *logrus.Entr
 	stop := ocb.heartbeat(ctx, cancel, log, doc)
 	defer stop()
 
+	ctx = azureclient.WithARMCallRecorder(ctx, azureclient.NewARMCallRecorder())
+	ctx = azureclient.WithCircuitBreaker(ctx, ocb.armCircuitBreaker, false)
+	ctx = steps.WithFailedStep(ctx)
+	ctx = withStepRecorder(ctx, ocb.now())
+	ctx = steps.WithTiming(ctx, ocb.stepTiming(doc))
+	ctx = steps.WithLive(ctx, ocb.liveStep)
+	ctx = steps.WithShadowReport(ctx, ocb.shadowReport(doc))
+
 	r, err := azure.ParseResourceID(doc.OpenShiftCluster.ID)
 	if err != nil {
 		return err
@@ -100,7 +181,14 @@ func (ocb *openShiftClusterBackend) handle(ctx context.Context, log *logrus.Entr
 		return err
 	}
 
-	m, err := ocb.newManager(log, ocb.env, ocb.dbOpenShiftClusters, ocb.cipher, ocb.billing, doc, subscriptionDoc)
+	// a cluster whose secrets are sealed with a customer-managed key which
+	// the RP cannot use can only be deleted
+	if doc.OpenShiftCluster.Properties.EncryptionAtRestProfile.IsInaccessible() &&
+		doc.OpenShiftCluster.Properties.ProvisioningState != api.ProvisioningStateDeleting {
+		return ocb.endLease(ctx, log, stop, doc, api.ProvisioningStateFailed, doc.OpenShiftCluster.Properties.EncryptionAtRestProfile.InaccessibleError())
+	}
+
+	m, err := ocb.newManager(log, ocb.env, ocb.dbOpenShiftClusters, ocb.dbOpenShiftVersions, ocb.cipher, ocb.billing, doc, subscriptionDoc, ocb.m)
 	if err != nil {
 		return ocb.endLease(ctx, log, stop, doc, api.ProvisioningStateFailed, err)
 	}
@@ -206,11 +294,15 @@ func (ocb *openShiftClusterBackend) updateAsyncOperation(ctx context.Context, lo
 	if id != "" {
 		_, err := ocb.dbAsyncOperations.Patch(ctx, id, func(asyncdoc *api.AsyncOperationDocument) error {
 			asyncdoc.AsyncOperation.ProvisioningState = provisioningState
+			addARMCalls(ctx, asyncdoc)
 
 			now := time.Now()
 			asyncdoc.AsyncOperation.EndTime = &now
+			asyncdoc.TTL = ocb.completedAsyncOperationTTL
 
 			if provisioningState == api.ProvisioningStateFailed {
+				asyncdoc.AsyncOperation.FailedStep = steps.FailedStep(ctx)
+
 				// if type is CloudError - we want to propagate it to the
 				// asyncOperations errors. Otherwise - return generic error
 				err, ok := backendErr.(*api.CloudError)
@@ -232,6 +324,14 @@ func (ocb *openShiftClusterBackend) updateAsyncOperation(ctx context.Context, lo
 				ocCopy.Properties.LastProvisioningState = ""
 				ocCopy.Properties.FailedProvisioningState = failedProvisioningState
 
+				// the secrets of a cluster encrypted with a customer-managed
+				// key are stored only sealed
+				if ocCopy.Properties.EncryptionAtRestProfile != nil {
+					ocCopy.Properties.AdminKubeconfig = nil
+					ocCopy.Properties.KubeadminPassword = ""
+					ocCopy.Properties.ServicePrincipalProfile.ClientSecret = ""
+				}
+
 				asyncdoc.OpenShiftCluster = &ocCopy
 			}
 
@@ -264,6 +364,24 @@ func (ocb *openShiftClusterBackend) endLease(ctx context.Context, log *logrus.En
 		}
 
 		ocb.emitMetrics(doc, provisioningState)
+	} else if doc.AsyncOperationID != "" {
+		_, err := ocb.dbAsyncOperations.Patch(ctx, doc.AsyncOperationID, func(asyncdoc *api.AsyncOperationDocument) error {
+			addARMCalls(ctx, asyncdoc)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	err := ocb.recordOperationFailure(ctx, doc, provisioningState, backendErr)
+	if err != nil {
+		return err
+	}
+
+	err = ocb.recordOperationHistory(ctx, doc, provisioningState, backendErr)
+	if err != nil {
+		return err
 	}
 
 	if doc.OpenShiftCluster.Properties.ProvisioningState == api.ProvisioningStateAdminUpdating {
@@ -281,10 +399,73 @@ func (ocb *openShiftClusterBackend) endLease(ctx context.Context, log *logrus.En
 		stop()
 	}
 
-	_, err := ocb.dbOpenShiftClusters.EndLease(ctx, doc.Key, provisioningState, failedProvisioningState, adminUpdateError)
+	_, err = ocb.dbOpenShiftClusters.EndLease(ctx, doc.Key, provisioningState, failedProvisioningState, adminUpdateError)
 	return err
 }
 
+// recordOperationFailure records a summary of a failed install or update in
+// the cluster document for the customer to retrieve, and clears it when an
+// install or update succeeds
+func (ocb *openShiftClusterBackend) recordOperationFailure(ctx context.Context, doc *api.OpenShiftClusterDocument, provisioningState api.ProvisioningState, backendErr error) error {
+	operation := doc.OpenShiftCluster.Properties.ProvisioningState
+	if operation != api.ProvisioningStateCreating &&
+		operation != api.ProvisioningStateUpdating {
+		return nil
+	}
+
+	var failure *api.OperationFailure
+	switch provisioningState {
+	case api.ProvisioningStateFailed:
+		failure = newOperationFailure(operation, steps.FailedStep(ctx), backendErr, ocb.now())
+	case api.ProvisioningStateSucceeded:
+		if doc.OpenShiftCluster.Properties.LastOperationFailure == nil {
+			return nil
+		}
+	default:
+		return nil
+	}
+
+	_, err := ocb.dbOpenShiftClusters.PatchWithLease(ctx, doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+		doc.OpenShiftCluster.Properties.LastOperationFailure = failure
+		return nil
+	})
+	return err
+}
+
+// addARMCalls adds the ARM calls recorded with ctx to the statistics of the
+// async operation.  A cluster install spans several leases, so the statistics
+// of each lease accumulate.
+func addARMCalls(ctx context.Context, asyncdoc *api.AsyncOperationDocument) {
+	stats := azureclient.ARMCallRecorderFromContext(ctx).Statistics()
+	if stats == nil {
+		return
+	}
+
+	if asyncdoc.AsyncOperation.ARMCalls == nil {
+		asyncdoc.AsyncOperation.ARMCalls = &api.ARMCallStatistics{}
+	}
+	armCalls := asyncdoc.AsyncOperation.ARMCalls
+
+	armCalls.Calls += stats.Calls
+	armCalls.Throttled += stats.Throttled
+
+	for _, s := range stats.Steps {
+		var found bool
+		for i := range armCalls.Steps {
+			if armCalls.Steps[i].Step == s.Step {
+				armCalls.Steps[i].Calls += s.Calls
+				armCalls.Steps[i].Throttled += s.Throttled
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			armCalls.Steps = append(armCalls.Steps, s)
+		}
+	}
+}
+
 func (ocb *openShiftClusterBackend) emitMetrics(doc *api.OpenShiftClusterDocument, provisioningState api.ProvisioningState) {
 	if doc.CorrelationData == nil {
 		return
@@ -295,10 +476,12 @@ func (ocb *openShiftClusterBackend) emitMetrics(doc *api.OpenShiftClusterDocumen
 	ocb.m.EmitGauge("backend.openshiftcluster.duration", duration, map[string]string{
 		"oldProvisioningState": string(doc.OpenShiftCluster.Properties.ProvisioningState),
 		"newProvisioningState": string(provisioningState),
+		"version":              doc.OpenShiftCluster.Properties.ClusterProfile.Version,
 	})
 
 	ocb.m.EmitGauge("backend.openshiftcluster.count", 1, map[string]string{
 		"oldProvisioningState": string(doc.OpenShiftCluster.Properties.ProvisioningState),
 		"newProvisioningState": string(provisioningState),
+		"version":              doc.OpenShiftCluster.Properties.ClusterProfile.Version,
 	})
 }
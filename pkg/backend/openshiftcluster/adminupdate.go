@@ -13,7 +13,7 @@ func (m *manager) AdminUpdate(ctx context.Context) error {
 	// m.ocDynamicValidator.Dynamic is not called so that it doesn't block an
 	// admin update
 
-	i, err := cluster.NewManager(ctx, m.log, m.env, m.db, m.cipher, m.billing, m.doc, m.subscriptionDoc)
+	i, err := cluster.NewManager(ctx, m.log, m.env, m.db, m.cipher, m.billing, m.doc, m.subscriptionDoc, m.m)
 	if err != nil {
 		return err
 	}
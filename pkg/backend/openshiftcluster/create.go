@@ -34,11 +34,9 @@ import (
 	"github.com/Azure/ARO-RP/pkg/bootstraplogging"
 	"github.com/Azure/ARO-RP/pkg/cluster"
 	"github.com/Azure/ARO-RP/pkg/util/azureerrors"
-	"github.com/Azure/ARO-RP/pkg/util/deployment"
 	"github.com/Azure/ARO-RP/pkg/util/pullsecret"
 	"github.com/Azure/ARO-RP/pkg/util/stringutils"
 	"github.com/Azure/ARO-RP/pkg/util/subnet"
-	"github.com/Azure/ARO-RP/pkg/util/version"
 )
 
 func (m *manager) Create(ctx context.Context) error {
@@ -66,7 +64,7 @@ func (m *manager) Create(ctx context.Context) error {
 
 	resourceGroup := stringutils.LastTokenByte(m.doc.OpenShiftCluster.Properties.ClusterProfile.ResourceGroupID, '/')
 
-	if m.env.DeploymentMode() != deployment.Development {
+	if !m.env.FakeFirstParty() {
 		rp := m.acrtoken.GetRegistryProfile(m.doc.OpenShiftCluster)
 		if rp == nil {
 			// 1. choose a name and establish the intent to create a token with
@@ -110,6 +108,13 @@ func (m *manager) Create(ctx context.Context) error {
 			}
 
 			doc.OpenShiftCluster.Properties.SSHKey = x509.MarshalPKCS1PrivateKey(sshKey)
+			doc.OpenShiftCluster.Properties.CredentialsProfile.SSHKey.Issue(time.Now(), nil)
+		}
+
+		// the customer's service principal secret was issued outside of the
+		// RP: record when the RP received it
+		if doc.OpenShiftCluster.Properties.CredentialsProfile.ServicePrincipal.IssuedAt.IsZero() {
+			doc.OpenShiftCluster.Properties.CredentialsProfile.ServicePrincipal.Issue(time.Now(), nil)
 		}
 
 		if doc.OpenShiftCluster.Properties.StorageSuffix == "" {
@@ -167,11 +172,31 @@ func (m *manager) Create(ctx context.Context) error {
 		return err
 	}
 
+	// the customer's key, if any, is installed alongside ours: the installer
+	// renders both into the 99-*-ssh MachineConfigs, so nodes added later by
+	// the machinesets get them too
+	sshKeys := sshkey.Type() + " " + base64.StdEncoding.EncodeToString(sshkey.Marshal())
+	if m.doc.OpenShiftCluster.Properties.ClusterProfile.SSHPublicKey != "" {
+		sshKeys += "\n" + strings.TrimSpace(m.doc.OpenShiftCluster.Properties.ClusterProfile.SSHPublicKey)
+	}
+
 	domain := m.doc.OpenShiftCluster.Properties.ClusterProfile.Domain
 	if !strings.ContainsRune(domain, '.') {
 		domain += "." + m.env.Domain()
 	}
 
+	// clusters which don't specify a machine network get a dummy one: ARO
+	// doesn't otherwise use it
+	machineCIDR := m.doc.OpenShiftCluster.Properties.NetworkProfile.MachineCIDR
+	if machineCIDR == "" {
+		machineCIDR = "127.0.0.0/8"
+	}
+
+	hostPrefix := int32(m.doc.OpenShiftCluster.Properties.NetworkProfile.HostPrefix)
+	if hostPrefix == 0 {
+		hostPrefix = 23
+	}
+
 	masterZones, err := m.env.Zones(string(m.doc.OpenShiftCluster.Properties.MasterProfile.VMSize))
 	if err != nil {
 		return err
@@ -205,19 +230,19 @@ func (m *manager) Create(ctx context.Context) error {
 			ObjectMeta: metav1.ObjectMeta{
 				Name: domain[:strings.IndexByte(domain, '.')],
 			},
-			SSHKey:     sshkey.Type() + " " + base64.StdEncoding.EncodeToString(sshkey.Marshal()),
+			SSHKey:     sshKeys,
 			BaseDomain: domain[strings.IndexByte(domain, '.')+1:],
 			Networking: &types.Networking{
 				MachineNetwork: []types.MachineNetworkEntry{
 					{
-						CIDR: *ipnet.MustParseCIDR("127.0.0.0/8"), // dummy
+						CIDR: *ipnet.MustParseCIDR(machineCIDR),
 					},
 				},
 				NetworkType: "OpenShiftSDN",
 				ClusterNetwork: []types.ClusterNetworkEntry{
 					{
 						CIDR:       *ipnet.MustParseCIDR(m.doc.OpenShiftCluster.Properties.NetworkProfile.PodCIDR),
-						HostPrefix: 23,
+						HostPrefix: hostPrefix,
 					},
 				},
 				ServiceNetwork: []ipnet.IPNet{
@@ -293,16 +318,32 @@ func (m *manager) Create(ctx context.Context) error {
 		installConfig.Config.Publish = types.InternalPublishingStrategy
 	}
 
-	installConfig.Config.Azure.Image, err = getRHCOSImage(ctx)
+	// the version was validated by the frontend, but it may have been
+	// deprecated since
+	v, err := m.dbVersions.GetInstallable(ctx, m.doc.OpenShiftCluster.Properties.ClusterProfile.Version)
 	if err != nil {
 		return err
 	}
+	if v == nil {
+		return fmt.Errorf("unimplemented version %q", m.doc.OpenShiftCluster.Properties.ClusterProfile.Version)
+	}
 
-	image := &releaseimage.Image{}
-	if m.doc.OpenShiftCluster.Properties.ClusterProfile.Version == version.InstallStream.Version.String() {
-		image.PullSpec = version.InstallStream.PullSpec
+	if v.BootImage != nil {
+		installConfig.Config.Azure.Image = &azuretypes.Image{
+			Publisher: v.BootImage.Publisher,
+			Offer:     v.BootImage.Offer,
+			SKU:       v.BootImage.SKU,
+			Version:   v.BootImage.Version,
+		}
 	} else {
-		return fmt.Errorf("unimplemented version %q", m.doc.OpenShiftCluster.Properties.ClusterProfile.Version)
+		installConfig.Config.Azure.Image, err = getRHCOSImage(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	image := &releaseimage.Image{
+		PullSpec: v.OpenShiftPullspec,
 	}
 
 	err = validation.ValidateInstallConfig(installConfig.Config, icopenstack.NewValidValuesFetcher()).ToAggregate()
@@ -310,7 +351,7 @@ func (m *manager) Create(ctx context.Context) error {
 		return err
 	}
 
-	i, err := cluster.NewManager(ctx, m.log, m.env, m.db, m.cipher, m.billing, m.doc, m.subscriptionDoc)
+	i, err := cluster.NewManager(ctx, m.log, m.env, m.db, m.cipher, m.billing, m.doc, m.subscriptionDoc, m.m)
 	if err != nil {
 		return err
 	}
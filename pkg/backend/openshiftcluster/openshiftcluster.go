@@ -13,9 +13,9 @@ import (
 	"github.com/Azure/ARO-RP/pkg/api/validate"
 	"github.com/Azure/ARO-RP/pkg/database"
 	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/metrics"
 	pkgacrtoken "github.com/Azure/ARO-RP/pkg/util/acrtoken"
 	"github.com/Azure/ARO-RP/pkg/util/billing"
-	"github.com/Azure/ARO-RP/pkg/util/deployment"
 	"github.com/Azure/ARO-RP/pkg/util/dns"
 	"github.com/Azure/ARO-RP/pkg/util/encryption"
 	"github.com/Azure/ARO-RP/pkg/util/privateendpoint"
@@ -35,9 +35,11 @@ type manager struct {
 	log          *logrus.Entry
 	env          env.Interface
 	db           database.OpenShiftClusters
+	dbVersions   database.OpenShiftVersions
 	cipher       encryption.Cipher
 	billing      billing.Manager
 	fpAuthorizer autorest.Authorizer
+	m            metrics.Interface
 
 	ocDynamicValidator validate.OpenShiftClusterDynamicValidator
 
@@ -51,7 +53,7 @@ type manager struct {
 }
 
 // NewManager returns a new openshiftcluster Manager
-func NewManager(log *logrus.Entry, _env env.Interface, db database.OpenShiftClusters, cipher encryption.Cipher, billing billing.Manager, doc *api.OpenShiftClusterDocument, subscriptionDoc *api.SubscriptionDocument) (Manager, error) {
+func NewManager(log *logrus.Entry, _env env.Interface, db database.OpenShiftClusters, dbVersions database.OpenShiftVersions, cipher encryption.Cipher, billing billing.Manager, doc *api.OpenShiftClusterDocument, subscriptionDoc *api.SubscriptionDocument, m metrics.Interface) (Manager, error) {
 	localFPAuthorizer, err := _env.FPAuthorizer(_env.TenantID(), _env.Environment().ResourceManagerEndpoint)
 	if err != nil {
 		return nil, err
@@ -63,7 +65,7 @@ func NewManager(log *logrus.Entry, _env env.Interface, db database.OpenShiftClus
 	}
 
 	var acrtoken pkgacrtoken.Manager
-	if _env.DeploymentMode() != deployment.Development {
+	if !_env.FakeFirstParty() {
 		acrtoken, err = pkgacrtoken.NewManager(_env, localFPAuthorizer)
 		if err != nil {
 			return nil, err
@@ -75,13 +77,15 @@ func NewManager(log *logrus.Entry, _env env.Interface, db database.OpenShiftClus
 		return nil, err
 	}
 
-	m := &manager{
+	return &manager{
 		log:          log,
 		env:          _env,
 		db:           db,
+		dbVersions:   dbVersions,
 		cipher:       cipher,
 		billing:      billing,
 		fpAuthorizer: fpAuthorizer,
+		m:            m,
 
 		ocDynamicValidator: ocDynamicValidator,
 
@@ -92,7 +96,5 @@ func NewManager(log *logrus.Entry, _env env.Interface, db database.OpenShiftClus
 
 		doc:             doc,
 		subscriptionDoc: subscriptionDoc,
-	}
-
-	return m, nil
+	}, nil
 }
@@ -7,9 +7,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/golang/mock/gomock"
 	"github.com/sirupsen/logrus"
 
@@ -17,7 +21,9 @@ import (
 	"github.com/Azure/ARO-RP/pkg/backend/openshiftcluster"
 	"github.com/Azure/ARO-RP/pkg/database"
 	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/metrics"
 	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
 	"github.com/Azure/ARO-RP/pkg/util/billing"
 	"github.com/Azure/ARO-RP/pkg/util/deployment"
 	"github.com/Azure/ARO-RP/pkg/util/encryption"
@@ -36,6 +42,28 @@ type backendTestStruct struct {
 func TestBackendTry(t *testing.T) {
 	mockSubID := "00000000-0000-0000-0000-000000000000"
 	resourceID := fmt.Sprintf("/subscriptions/%s/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName", mockSubID)
+	now := time.Date(2020, time.November, 1, 12, 0, 0, 0, time.UTC)
+
+	inProgress := func(operation api.ProvisioningState) []api.OperationRecord {
+		return []api.OperationRecord{
+			{
+				Operation: operation,
+				StartTime: now,
+			},
+		}
+	}
+
+	completed := func(operation, result api.ProvisioningState, err string) []api.OperationRecord {
+		return []api.OperationRecord{
+			{
+				Operation: operation,
+				StartTime: now,
+				EndTime:   &now,
+				Result:    result,
+				Error:     err,
+			},
+		}
+	}
 
 	for _, tt := range []backendTestStruct{
 		{
@@ -70,14 +98,23 @@ func TestBackendTry(t *testing.T) {
 							Install: &api.Install{
 								Phase: api.InstallPhaseBootstrap,
 							},
+							Progress: &api.ProvisioningProgress{
+								Phase:           "Bootstrap",
+								PercentComplete: 50,
+							},
 						},
 					},
+					OperationHistory: inProgress(api.ProvisioningStateCreating),
 				})
 			},
 			mocks: func(manager *mock_openshiftcluster.MockManager, dbOpenShiftClusters database.OpenShiftClusters) {
 				manager.EXPECT().Create(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
 					_, err := dbOpenShiftClusters.Patch(ctx, strings.ToLower(resourceID), func(inFlightDoc *api.OpenShiftClusterDocument) error {
 						inFlightDoc.OpenShiftCluster.Properties.Install = &api.Install{}
+						inFlightDoc.OpenShiftCluster.Properties.Progress = &api.ProvisioningProgress{
+							Phase:           "Bootstrap",
+							PercentComplete: 50,
+						}
 						return nil
 					})
 					return err
@@ -85,7 +122,7 @@ func TestBackendTry(t *testing.T) {
 			},
 		},
 		{
-			name: "StateCreating success without an InstallPhase marks provisioning as succeeded",
+			name: "StateCreating success without an InstallPhase marks provisioning as succeeded and clears the progress",
 			fixture: func(f *testdb.Fixture) {
 				f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
 					Key: strings.ToLower(resourceID),
@@ -115,12 +152,17 @@ func TestBackendTry(t *testing.T) {
 							ProvisioningState: api.ProvisioningStateSucceeded,
 						},
 					},
+					OperationHistory: completed(api.ProvisioningStateCreating, api.ProvisioningStateSucceeded, ""),
 				})
 			},
 			mocks: func(manager *mock_openshiftcluster.MockManager, dbOpenShiftClusters database.OpenShiftClusters) {
 				manager.EXPECT().Create(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
 					_, err := dbOpenShiftClusters.Patch(ctx, strings.ToLower(resourceID), func(inFlightDoc *api.OpenShiftClusterDocument) error {
 						inFlightDoc.OpenShiftCluster.Properties.Install = nil
+						inFlightDoc.OpenShiftCluster.Properties.Progress = &api.ProvisioningProgress{
+							Phase:           "RemoveBootstrap",
+							PercentComplete: 95,
+						}
 						return nil
 					})
 					return err
@@ -158,8 +200,16 @@ func TestBackendTry(t *testing.T) {
 						Properties: api.OpenShiftClusterProperties{
 							ProvisioningState:       api.ProvisioningStateFailed,
 							FailedProvisioningState: api.ProvisioningStateCreating,
+							LastOperationFailure: &api.OperationFailure{
+								Operation: api.ProvisioningStateCreating,
+								Time:      now,
+								Category:  api.FailureCategoryInternal,
+								Code:      api.CloudErrorCodeInternalServerError,
+								Message:   "Internal server error. Try again; if the problem persists, open a support case.",
+							},
 						},
 					},
+					OperationHistory: completed(api.ProvisioningStateCreating, api.ProvisioningStateFailed, "something bad!"),
 				})
 			},
 			mocks: func(manager *mock_openshiftcluster.MockManager, dbOpenShiftClusters database.OpenShiftClusters) {
@@ -168,6 +218,105 @@ func TestBackendTry(t *testing.T) {
 				})
 			},
 		},
+		{
+			name: "StateUpdating with an inaccessible encryption key fails without running",
+			fixture: func(f *testdb.Fixture) {
+				f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					Key: strings.ToLower(resourceID),
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID:       resourceID,
+						Name:     "resourceName",
+						Type:     "Microsoft.RedHatOpenShift/OpenShiftClusters",
+						Location: "location",
+						Properties: api.OpenShiftClusterProperties{
+							ProvisioningState: api.ProvisioningStateUpdating,
+							EncryptionAtRestProfile: &api.EncryptionAtRestProfile{
+								KeyVaultKeyID:   "https://vault.vault.azure.net/keys/key/0123456789abcdef0123456789abcdef",
+								KeyState:        api.EncryptionKeyStateInaccessible,
+								KeyStateMessage: "access denied",
+							},
+						},
+					},
+				})
+				f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+					ID: mockSubID,
+				})
+			},
+			checker: func(c *testdb.Checker) {
+				c.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					Key:      strings.ToLower(resourceID),
+					Dequeues: 1,
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID:       resourceID,
+						Name:     "resourceName",
+						Type:     "Microsoft.RedHatOpenShift/OpenShiftClusters",
+						Location: "location",
+						Properties: api.OpenShiftClusterProperties{
+							ProvisioningState:       api.ProvisioningStateFailed,
+							FailedProvisioningState: api.ProvisioningStateUpdating,
+							EncryptionAtRestProfile: &api.EncryptionAtRestProfile{
+								KeyVaultKeyID:   "https://vault.vault.azure.net/keys/key/0123456789abcdef0123456789abcdef",
+								KeyState:        api.EncryptionKeyStateInaccessible,
+								KeyStateMessage: "access denied",
+							},
+							LastOperationFailure: &api.OperationFailure{
+								Operation: api.ProvisioningStateUpdating,
+								Time:      now,
+								Category:  api.FailureCategoryConfiguration,
+								Code:      api.CloudErrorCodeEncryptionKeyInaccessible,
+								Message:   "The key vault key 'https://vault.vault.azure.net/keys/key/0123456789abcdef0123456789abcdef' which encrypts the cluster's secrets is inaccessible: access denied. Restore the resource provider's wrapKey and unwrapKey permissions on the key and retry.",
+							},
+						},
+					},
+					OperationHistory: completed(api.ProvisioningStateUpdating, api.ProvisioningStateFailed, "400: EncryptionKeyInaccessible: properties.encryptionAtRestProfile.keyVaultKeyId: The key vault key 'https://vault.vault.azure.net/keys/key/0123456789abcdef0123456789abcdef' which encrypts the cluster's secrets is inaccessible: access denied. Restore the resource provider's wrapKey and unwrapKey permissions on the key and retry."),
+				})
+			},
+			mocks: func(manager *mock_openshiftcluster.MockManager, dbOpenShiftClusters database.OpenShiftClusters) {},
+		},
+		{
+			name: "StateUpdating success clears LastOperationFailure",
+			fixture: func(f *testdb.Fixture) {
+				f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					Key: strings.ToLower(resourceID),
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID:       resourceID,
+						Name:     "resourceName",
+						Type:     "Microsoft.RedHatOpenShift/OpenShiftClusters",
+						Location: "location",
+						Properties: api.OpenShiftClusterProperties{
+							ProvisioningState: api.ProvisioningStateUpdating,
+							LastOperationFailure: &api.OperationFailure{
+								Operation: api.ProvisioningStateUpdating,
+								Time:      now,
+								Category:  api.FailureCategoryNodes,
+								Code:      api.CloudErrorCodeInternalServerError,
+							},
+						},
+					},
+				})
+				f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+					ID: mockSubID,
+				})
+			},
+			checker: func(c *testdb.Checker) {
+				c.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					Key: strings.ToLower(resourceID),
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID:       resourceID,
+						Name:     "resourceName",
+						Type:     "Microsoft.RedHatOpenShift/OpenShiftClusters",
+						Location: "location",
+						Properties: api.OpenShiftClusterProperties{
+							ProvisioningState: api.ProvisioningStateSucceeded,
+						},
+					},
+					OperationHistory: completed(api.ProvisioningStateUpdating, api.ProvisioningStateSucceeded, ""),
+				})
+			},
+			mocks: func(manager *mock_openshiftcluster.MockManager, dbOpenShiftClusters database.OpenShiftClusters) {
+				manager.EXPECT().Update(gomock.Any()).Return(nil)
+			},
+		},
 		{
 			name: "StateAdminUpdating success sets the last ProvisioningState and clears LastAdminUpdateError",
 			fixture: func(f *testdb.Fixture) {
@@ -201,6 +350,7 @@ func TestBackendTry(t *testing.T) {
 							ProvisioningState: api.ProvisioningStateSucceeded,
 						},
 					},
+					OperationHistory: completed(api.ProvisioningStateAdminUpdating, api.ProvisioningStateSucceeded, ""),
 				})
 			},
 			mocks: func(manager *mock_openshiftcluster.MockManager, dbOpenShiftClusters database.OpenShiftClusters) {
@@ -242,6 +392,7 @@ func TestBackendTry(t *testing.T) {
 							LastAdminUpdateError:    "oh no!",
 						},
 					},
+					OperationHistory: completed(api.ProvisioningStateAdminUpdating, api.ProvisioningStateFailed, "oh no!"),
 				})
 			},
 			mocks: func(manager *mock_openshiftcluster.MockManager, dbOpenShiftClusters database.OpenShiftClusters) {
@@ -294,11 +445,11 @@ func TestBackendTry(t *testing.T) {
 				t.Fatal(err)
 			}
 
-			createManager := func(*logrus.Entry, env.Interface, database.OpenShiftClusters, encryption.Cipher, billing.Manager, *api.OpenShiftClusterDocument, *api.SubscriptionDocument) (openshiftcluster.Manager, error) {
+			createManager := func(*logrus.Entry, env.Interface, database.OpenShiftClusters, database.OpenShiftVersions, encryption.Cipher, billing.Manager, *api.OpenShiftClusterDocument, *api.SubscriptionDocument, metrics.Interface) (openshiftcluster.Manager, error) {
 				return manager, nil
 			}
 
-			b, err := newBackend(ctx, log, _env, nil, nil, dbOpenShiftClusters, dbSubscriptions, nil, &noop.Noop{})
+			b, err := newBackend(ctx, log, _env, nil, nil, nil, dbOpenShiftClusters, nil, dbSubscriptions, nil, &noop.Noop{})
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -306,6 +457,7 @@ func TestBackendTry(t *testing.T) {
 			b.ocb = &openShiftClusterBackend{
 				backend:    b,
 				newManager: createManager,
+				now:        func() time.Time { return now },
 			}
 
 			worked, err := b.ocb.try(ctx)
@@ -329,3 +481,204 @@ func TestBackendTry(t *testing.T) {
 		})
 	}
 }
+
+func TestBackendDequeue(t *testing.T) {
+	ctx := context.Background()
+
+	docInState := func(name string, bucket int, timestamp int, provisioningState api.ProvisioningState) *api.OpenShiftClusterDocument {
+		resourceID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/" + name
+
+		return &api.OpenShiftClusterDocument{
+			Key:       strings.ToLower(resourceID),
+			Bucket:    bucket,
+			Timestamp: timestamp,
+			OpenShiftCluster: &api.OpenShiftCluster{
+				ID: resourceID,
+				Properties: api.OpenShiftClusterProperties{
+					ProvisioningState: provisioningState,
+				},
+			},
+		}
+	}
+
+	doc := func(name string, bucket int, timestamp int) *api.OpenShiftClusterDocument {
+		return docInState(name, bucket, timestamp, api.ProvisioningStateCreating)
+	}
+
+	now := int(time.Now().Unix())
+
+	for _, tt := range []struct {
+		name            string
+		buckets         []int
+		standardWorkers int32
+		docs            []*api.OpenShiftClusterDocument
+		wantKey         string
+	}{
+		{
+			name: "buckets not yet allocated: dequeue from any bucket",
+			docs: []*api.OpenShiftClusterDocument{
+				doc("a", 1, now),
+				doc("b", 2, now),
+			},
+			wantKey: doc("a", 1, now).Key,
+		},
+		{
+			name:    "owned bucket preferred",
+			buckets: []int{2, 3},
+			docs: []*api.OpenShiftClusterDocument{
+				doc("a", 1, 0),
+				doc("b", 2, now),
+			},
+			wantKey: doc("b", 2, now).Key,
+		},
+		{
+			name:    "stale document stolen",
+			buckets: []int{3},
+			docs: []*api.OpenShiftClusterDocument{
+				doc("a", 1, now),
+				doc("b", 2, 0),
+			},
+			wantKey: doc("b", 2, 0).Key,
+		},
+		{
+			name:    "fresh document in other bucket left alone",
+			buckets: []int{},
+			docs: []*api.OpenShiftClusterDocument{
+				doc("a", 1, now),
+			},
+		},
+		{
+			name:    "priority document preferred",
+			buckets: []int{1},
+			docs: []*api.OpenShiftClusterDocument{
+				doc("a", 1, now),
+				docInState("b", 1, now, api.ProvisioningStateAdminUpdating),
+				docInState("c", 1, now, api.ProvisioningStateDeleting),
+			},
+			wantKey: doc("c", 1, now).Key,
+		},
+		{
+			name:            "standard workers saturated: priority document dequeued",
+			buckets:         []int{1},
+			standardWorkers: 9,
+			docs: []*api.OpenShiftClusterDocument{
+				doc("a", 1, now),
+				docInState("b", 1, now, api.ProvisioningStateUpdating),
+			},
+			wantKey: doc("b", 1, now).Key,
+		},
+		{
+			name:            "standard workers saturated: standard documents left alone",
+			buckets:         []int{1},
+			standardWorkers: 9,
+			docs: []*api.OpenShiftClusterDocument{
+				doc("a", 1, now),
+				doc("b", 2, 0),
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dbOpenShiftClusters, _ := testdb.NewFakeOpenShiftClusters()
+
+			f := testdb.NewFixture().WithOpenShiftClusters(dbOpenShiftClusters)
+			f.AddOpenShiftClusterDocuments(tt.docs...)
+			err := f.Create()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			b := &backend{
+				dbOpenShiftClusters: dbOpenShiftClusters,
+				m:                   &noop.Noop{},
+				maxWorkers:          10,
+				standardWorkers:     tt.standardWorkers,
+				maxStandardWorkers:  9,
+			}
+			if tt.buckets != nil {
+				b.buckets.Store(tt.buckets)
+			}
+
+			doc, err := newOpenShiftClusterBackend(b).dequeue(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var key string
+			if doc != nil {
+				key = doc.Key
+			}
+			if key != tt.wantKey {
+				t.Error(key)
+			}
+		})
+	}
+}
+
+func TestAddARMCalls(t *testing.T) {
+	r := azureclient.NewARMCallRecorder()
+	ctx := azureclient.WithARMCallRecorder(context.Background(), r)
+
+	s := azureclient.DecorateSender(autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusTooManyRequests}, nil
+	}))
+	for _, step := range []string{"[Action ensureResourceGroup]", "[Action deployStorageTemplate]"} {
+		req, err := http.NewRequestWithContext(azureclient.WithStep(ctx, step), http.MethodGet, "https://management.azure.com/", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = s.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the statistics of this lease are added to those of an earlier one
+	asyncdoc := &api.AsyncOperationDocument{
+		AsyncOperation: &api.AsyncOperation{
+			ARMCalls: &api.ARMCallStatistics{
+				Calls: 2,
+				Steps: []api.ARMCallStepStatistics{
+					{
+						Step:  "[Action ensureResourceGroup]",
+						Calls: 2,
+					},
+				},
+			},
+		},
+	}
+
+	addARMCalls(ctx, asyncdoc)
+
+	want := &api.ARMCallStatistics{
+		Calls:     4,
+		Throttled: 2,
+		Steps: []api.ARMCallStepStatistics{
+			{
+				Step:      "[Action ensureResourceGroup]",
+				Calls:     3,
+				Throttled: 1,
+			},
+			{
+				Step:      "[Action deployStorageTemplate]",
+				Calls:     1,
+				Throttled: 1,
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(asyncdoc.AsyncOperation.ARMCalls, want) {
+		t.Errorf("%#v", asyncdoc.AsyncOperation.ARMCalls)
+	}
+
+	// an operation which made no ARM calls has no statistics
+	asyncdoc = &api.AsyncOperationDocument{
+		AsyncOperation: &api.AsyncOperation{},
+	}
+
+	addARMCalls(context.Background(), asyncdoc)
+
+	if asyncdoc.AsyncOperation.ARMCalls != nil {
+		t.Error(asyncdoc.AsyncOperation.ARMCalls)
+	}
+}
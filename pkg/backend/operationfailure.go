@@ -0,0 +1,172 @@
+package backend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+// rxStepFunc extracts the name of the function which a step runs from the
+// step's name, e.g. "createDNS" from
+// "[Action github.com/Azure/ARO-RP/pkg/cluster.(*manager).createDNS-fm]"
+var rxStepFunc = regexp.MustCompile(`\.(\w+?)(?:-fm)?[\],]`)
+
+// configurationErrorCodes are the codes of errors which the customer must fix
+// before retrying
+var configurationErrorCodes = map[string]bool{
+	api.CloudErrorCodeInvalidServicePrincipalCredentials: true,
+	api.CloudErrorCodeInvalidServicePrincipalClaims:      true,
+	api.CloudErrorCodeInvalidServicePrincipalPermissions: true,
+	api.CloudErrorCodeInvalidResourceProviderPermissions: true,
+	api.CloudErrorCodeInvalidLinkedVNet:                  true,
+	api.CloudErrorCodeInvalidLinkedRouteTable:            true,
+	api.CloudErrorCodeRequestDisallowedByPolicy:          true,
+	api.CloudErrorResourceProviderNotRegistered:          true,
+	api.CloudErrorCodeEncryptionKeyInaccessible:          true,
+}
+
+// quotaErrorCodes are the codes of errors, including Azure errors, caused by
+// insufficient quota or capacity
+var quotaErrorCodes = map[string]bool{
+	api.CloudErrorCodeResourceQuotaExceeded: true,
+	api.CloudErrorCodeQuotaExceeded:         true,
+	"SkuNotAvailable":                       true,
+	"ZonalAllocationFailed":                 true,
+	"AllocationFailed":                      true,
+}
+
+// stepCategories categorises steps by keywords in the names of their
+// functions.  The first match wins.
+var stepCategories = []struct {
+	keywords []string
+	category api.FailureCategory
+}{
+	{
+		keywords: []string{"quota"},
+		category: api.FailureCategoryQuota,
+	},
+	{
+		keywords: []string{"dns", "privateendpoint", "nsg", "apiip", "routerip", "outboundip", "egress", "ingress"},
+		category: api.FailureCategoryNetworking,
+	},
+	{
+		keywords: []string{"bootstrap", "apiservers"},
+		category: api.FailureCategoryClusterBootstrap,
+	},
+	{
+		keywords: []string{"operator", "clusterversion", "console", "arodeployment"},
+		category: api.FailureCategoryClusterOperators,
+	},
+	{
+		keywords: []string{"worker", "sshkey", "vms", "bootimage", "autoscaler"},
+		category: api.FailureCategoryNodes,
+	},
+	{
+		keywords: []string{"template", "resourcegroup", "storage", "certificate", "snapshot"},
+		category: api.FailureCategoryAzureResources,
+	},
+}
+
+// remediations are returned to the customer in place of the message of an
+// internal error
+var remediations = map[api.FailureCategory]string{
+	api.FailureCategoryQuota:            "The subscription does not have enough quota or capacity for the cluster. Request a quota increase or choose a different VM size or region and try again.",
+	api.FailureCategoryNetworking:       "The cluster's network could not be configured. Check that the virtual network, route tables, firewalls and DNS allow the required egress, and try again.",
+	api.FailureCategoryClusterBootstrap: "The cluster did not bootstrap in time. Check that the cluster's subnets can reach the required endpoints, and try again.",
+	api.FailureCategoryClusterOperators: "The cluster's operators did not become available in time. Check the status of the cluster operators, and try again.",
+	api.FailureCategoryNodes:            "The cluster's nodes could not be configured. Check the status of the cluster's machines, and try again.",
+	api.FailureCategoryAzureResources:   "The cluster's Azure resources could not be deployed. Try again; if the problem persists, open a support case.",
+	api.FailureCategoryInternal:         "Internal server error. Try again; if the problem persists, open a support case.",
+}
+
+// newOperationFailure categorises the failure of operation in failedStep with
+// err and returns a summary which is safe to return to the customer.  The
+// code and message of a CloudError are returned to the customer in the
+// asyncOperation already and are kept; those of any other error are not.
+func newOperationFailure(operation api.ProvisioningState, failedStep string, err error, now time.Time) *api.OperationFailure {
+	f := &api.OperationFailure{
+		Operation:  operation,
+		Time:       now.UTC(),
+		FailedStep: failedStep,
+		Category:   stepCategory(failedStep),
+	}
+
+	cloudErr, ok := err.(*api.CloudError)
+	if !ok || cloudErr.CloudErrorBody == nil {
+		f.Code = api.CloudErrorCodeInternalServerError
+		f.Message = remediations[f.Category]
+		return f
+	}
+
+	f.Code = cloudErr.Code
+	f.Message = cloudErr.Message
+	f.AzureErrorCode = azureErrorCode(cloudErr.CloudErrorBody)
+
+	switch {
+	case configurationErrorCodes[f.Code]:
+		f.Category = api.FailureCategoryConfiguration
+	case quotaErrorCodes[f.Code], quotaErrorCodes[f.AzureErrorCode]:
+		f.Category = api.FailureCategoryQuota
+	}
+
+	return f
+}
+
+// stepCategory returns the category of the step with the given name
+func stepCategory(step string) api.FailureCategory {
+	m := rxStepFunc.FindStringSubmatch(step)
+	if m == nil {
+		return api.FailureCategoryInternal
+	}
+
+	name := strings.ToLower(m[1])
+	for _, c := range stepCategories {
+		for _, keyword := range c.keywords {
+			if strings.Contains(name, keyword) {
+				return c.category
+			}
+		}
+	}
+
+	return api.FailureCategoryInternal
+}
+
+// azureErrorCode returns the innermost code of the Azure error which a failed
+// deployment records in the details of body, if any
+func azureErrorCode(body *api.CloudErrorBody) string {
+	if body.Code != api.CloudErrorCodeDeploymentFailed &&
+		body.Code != api.CloudErrorCodeRequestDisallowedByPolicy {
+		return ""
+	}
+
+	for _, detail := range body.Details {
+		var serviceErr struct {
+			Code    string `json:"code"`
+			Details []struct {
+				Code string `json:"code"`
+			} `json:"details"`
+		}
+
+		if json.Unmarshal([]byte(detail.Message), &serviceErr) != nil {
+			continue
+		}
+
+		for _, d := range serviceErr.Details {
+			if d.Code != "" {
+				return d.Code
+			}
+		}
+
+		if serviceErr.Code != "" {
+			return serviceErr.Code
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,141 @@
+package backend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+func TestNewOperationFailure(t *testing.T) {
+	now := time.Date(2020, time.November, 1, 12, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		name       string
+		failedStep string
+		err        error
+		want       *api.OperationFailure
+	}{
+		{
+			name:       "internal error is replaced by the remediation of the step",
+			failedStep: "[Condition github.com/Azure/ARO-RP/pkg/cluster.(*manager).bootstrapConfigMapReady-fm, timeout 30m0s]",
+			err:        errors.New("timed out waiting for the condition"),
+			want: &api.OperationFailure{
+				Category: api.FailureCategoryClusterBootstrap,
+				Code:     api.CloudErrorCodeInternalServerError,
+				Message:  "The cluster did not bootstrap in time. Check that the cluster's subnets can reach the required endpoints, and try again.",
+			},
+		},
+		{
+			name:       "unknown step",
+			failedStep: "[Action github.com/Azure/ARO-RP/pkg/cluster.(*manager).bootstrap.func1]",
+			err:        errors.New("oops"),
+			want: &api.OperationFailure{
+				Category: api.FailureCategoryInternal,
+				Code:     api.CloudErrorCodeInternalServerError,
+				Message:  "Internal server error. Try again; if the problem persists, open a support case.",
+			},
+		},
+		{
+			name:       "cloud error is kept",
+			failedStep: "[AuthorizationRefreshingAction [Action github.com/Azure/ARO-RP/pkg/cluster.(*manager).attachNSGsAndPatch-fm]]",
+			err:        api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidLinkedVNet, "", "The provided subnet is invalid."),
+			want: &api.OperationFailure{
+				Category: api.FailureCategoryConfiguration,
+				Code:     api.CloudErrorCodeInvalidLinkedVNet,
+				Message:  "The provided subnet is invalid.",
+			},
+		},
+		{
+			name:       "azure error code of a failed deployment",
+			failedStep: "[AuthorizationRefreshingAction [Action github.com/Azure/ARO-RP/pkg/cluster.(*manager).deployResourceTemplate-fm]]",
+			err: &api.CloudError{
+				StatusCode: http.StatusBadRequest,
+				CloudErrorBody: &api.CloudErrorBody{
+					Code:    api.CloudErrorCodeDeploymentFailed,
+					Message: "Deployment failed.",
+					Details: []api.CloudErrorBody{
+						{
+							Message: `{"code":"DeploymentFailed","message":"At least one resource deployment operation failed.","details":[{"code":"SkuNotAvailable","message":"The requested size is not available."}]}`,
+						},
+					},
+				},
+			},
+			want: &api.OperationFailure{
+				Category:       api.FailureCategoryQuota,
+				Code:           api.CloudErrorCodeDeploymentFailed,
+				Message:        "Deployment failed.",
+				AzureErrorCode: "SkuNotAvailable",
+			},
+		},
+		{
+			name:       "failed deployment without details",
+			failedStep: "[AuthorizationRefreshingAction [Action github.com/Azure/ARO-RP/pkg/cluster.(*manager).deployResourceTemplate-fm]]",
+			err:        api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeDeploymentFailed, "", "Deployment failed."),
+			want: &api.OperationFailure{
+				Category: api.FailureCategoryAzureResources,
+				Code:     api.CloudErrorCodeDeploymentFailed,
+				Message:  "Deployment failed.",
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.want.Operation = api.ProvisioningStateCreating
+			tt.want.Time = now
+			tt.want.FailedStep = tt.failedStep
+
+			got := newOperationFailure(api.ProvisioningStateCreating, tt.failedStep, tt.err, now)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("%#v", got)
+			}
+		})
+	}
+}
+
+func TestStepCategory(t *testing.T) {
+	for _, tt := range []struct {
+		step string
+		want api.FailureCategory
+	}{
+		{
+			step: "[Action github.com/Azure/ARO-RP/pkg/cluster.(*manager).createDNS-fm]",
+			want: api.FailureCategoryNetworking,
+		},
+		{
+			step: "[Condition github.com/Azure/ARO-RP/pkg/cluster.(*manager).apiServersReady-fm, timeout 30m0s]",
+			want: api.FailureCategoryClusterBootstrap,
+		},
+		{
+			step: "[Condition github.com/Azure/ARO-RP/pkg/cluster.(*manager).clusterVersionReady-fm, timeout 30m0s]",
+			want: api.FailureCategoryClusterOperators,
+		},
+		{
+			step: "[Condition github.com/Azure/ARO-RP/pkg/cluster.(*manager).sshKeysRolledOut-fm, timeout 3h0m0s]",
+			want: api.FailureCategoryNodes,
+		},
+		{
+			step: "[AuthorizationRefreshingAction [Action github.com/Azure/ARO-RP/pkg/cluster.(*manager).validateAutoscalerQuota-fm]]",
+			want: api.FailureCategoryQuota,
+		},
+		{
+			step: "[Action github.com/Azure/ARO-RP/pkg/cluster.(*manager).finishInstallation-fm]",
+			want: api.FailureCategoryInternal,
+		},
+		{
+			step: "",
+			want: api.FailureCategoryInternal,
+		},
+	} {
+		t.Run(tt.step, func(t *testing.T) {
+			if got := stepCategory(tt.step); got != tt.want {
+				t.Error(got)
+			}
+		})
+	}
+}
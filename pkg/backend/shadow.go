@@ -0,0 +1,58 @@
+package backend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/steps"
+)
+
+// liveSteps returns the names of the steps wrapped by steps.Shadowed which
+// are made live, from the comma-separated function names in
+// BACKEND_LIVE_STEPS.  These are the names in the step dimension of the step
+// metrics.
+func liveSteps() map[string]bool {
+	live := map[string]bool{}
+
+	for _, name := range strings.Split(os.Getenv("BACKEND_LIVE_STEPS"), ",") {
+		name = strings.TrimSpace(name)
+		if name != "" {
+			live[name] = true
+		}
+	}
+
+	return live
+}
+
+// liveStep is the steps.LiveFunc of the backend
+func (ocb *openShiftClusterBackend) liveStep(step steps.Step) bool {
+	return ocb.liveSteps[stepName(step.String())]
+}
+
+// shadowReport returns a steps.ShadowReportFunc which emits the number of
+// changes which each step run in shadow mode on behalf of doc would have
+// made, so that a step's behaviour can be reviewed across the fleet before it
+// is made live
+func (ocb *openShiftClusterBackend) shadowReport(doc *api.OpenShiftClusterDocument) steps.ShadowReportFunc {
+	provisioningState := doc.OpenShiftCluster.Properties.ProvisioningState
+	version := doc.OpenShiftCluster.Properties.ClusterProfile.Version
+
+	return func(ctx context.Context, step steps.Step, changes []string, err error) {
+		result := "Succeeded"
+		if err != nil {
+			result = "Failed"
+		}
+
+		ocb.m.EmitGauge("backend.openshiftcluster.shadowstep.changes", int64(len(changes)), map[string]string{
+			"provisioningState": string(provisioningState),
+			"step":              stepName(step.String()),
+			"result":            result,
+			"version":           version,
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package backend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	mock_metrics "github.com/Azure/ARO-RP/pkg/util/mocks/metrics"
+	"github.com/Azure/ARO-RP/pkg/util/steps"
+	testlog "github.com/Azure/ARO-RP/test/util/log"
+)
+
+func TestLiveSteps(t *testing.T) {
+	defer os.Unsetenv("BACKEND_LIVE_STEPS")
+
+	os.Setenv("BACKEND_LIVE_STEPS", " createDNS, ,ensureResourceGroup")
+
+	want := map[string]bool{
+		"createDNS":           true,
+		"ensureResourceGroup": true,
+	}
+	if got := liveSteps(); !reflect.DeepEqual(got, want) {
+		t.Error(got)
+	}
+
+	os.Unsetenv("BACKEND_LIVE_STEPS")
+
+	if got := liveSteps(); len(got) != 0 {
+		t.Error(got)
+	}
+}
+
+func TestShadowReport(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	m := mock_metrics.NewMockInterface(controller)
+
+	ocb := &openShiftClusterBackend{
+		backend: &backend{
+			m: m,
+			liveSteps: map[string]bool{
+				"createDNS": true,
+			},
+		},
+	}
+
+	doc := &api.OpenShiftClusterDocument{
+		OpenShiftCluster: &api.OpenShiftCluster{
+			Properties: api.OpenShiftClusterProperties{
+				ProvisioningState: api.ProvisioningStateUpdating,
+				ClusterProfile: api.ClusterProfile{
+					Version: "4.6.8",
+				},
+			},
+		},
+	}
+
+	// createDNS is live and so isn't reported
+	m.EXPECT().EmitGauge("backend.openshiftcluster.shadowstep.changes", int64(0), map[string]string{
+		"provisioningState": "Updating",
+		"step":              "ensureResourceGroup",
+		"result":            "Failed",
+		"version":           "4.6.8",
+	})
+
+	_, log := testlog.New()
+	ctx := steps.WithLive(context.Background(), ocb.liveStep)
+	ctx = steps.WithShadowReport(ctx, ocb.shadowReport(doc))
+
+	err := steps.Run(ctx, log, time.Millisecond, []steps.Step{
+		steps.Shadowed(steps.Action(createDNS)),
+		steps.Shadowed(steps.Action(ensureResourceGroup)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -6,6 +6,7 @@ package cluster
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	mgmtfeatures "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-07-01/features"
@@ -46,6 +47,10 @@ func (m *manager) deployARMTemplate(ctx context.Context, rg string, tName string
 	}
 
 	if serviceErr != nil {
+		if policyID, ok := azureerrors.IsRequestDisallowedByPolicyError(serviceErr); ok {
+			return requestDisallowedByPolicyError(policyID, serviceErr)
+		}
+
 		b, _ := json.Marshal(serviceErr)
 
 		return &api.CloudError{
@@ -64,3 +69,28 @@ func (m *manager) deployARMTemplate(ctx context.Context, rg string, tName string
 
 	return err
 }
+
+// requestDisallowedByPolicyError returns the error reported to the customer
+// when an Azure Policy assignment on their subscription denies part of the
+// install.  Retrying can't help until the assignment is changed.
+func requestDisallowedByPolicyError(policyID string, serviceErr *azure.ServiceError) error {
+	message := "The deployment was disallowed by an Azure Policy assignment."
+	if policyID != "" {
+		message = fmt.Sprintf("The deployment was disallowed by Azure Policy '%s'.", policyID)
+	}
+
+	b, _ := json.Marshal(serviceErr)
+
+	return &api.CloudError{
+		StatusCode: http.StatusBadRequest,
+		CloudErrorBody: &api.CloudErrorBody{
+			Code:    api.CloudErrorCodeRequestDisallowedByPolicy,
+			Message: message + " Review the policy assignments of the subscription and try again.",
+			Details: []api.CloudErrorBody{
+				{
+					Message: string(b),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,53 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/Azure/ARO-RP/pkg/api/validate"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/deploy"
+)
+
+// validateAutoscalerQuota checks that the subscription has the quota for the
+// cluster autoscaler to scale each pool up to its maximum replicas
+func (m *manager) validateAutoscalerQuota(ctx context.Context) error {
+	requiredResources, err := validate.AutoscalerRequiredResources(m.doc.OpenShiftCluster)
+	if err != nil {
+		return err
+	}
+
+	if len(requiredResources) == 0 {
+		return nil
+	}
+
+	return validate.ValidateQuota(ctx, m.usage, m.doc.OpenShiftCluster.Location, requiredResources)
+}
+
+// ensureAutoscaler copies the autoscaler profile of the cluster into the
+// Cluster resource, from which the ARO operator renders the autoscaler
+// resources
+func (m *manager) ensureAutoscaler(ctx context.Context) error {
+	spec := deploy.AutoscalerSpec(m.doc.OpenShiftCluster)
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster, err := m.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if reflect.DeepEqual(cluster.Spec.Autoscaler, spec) {
+			return nil
+		}
+
+		cluster.Spec.Autoscaler = spec
+		_, err = m.arocli.Clusters().Update(ctx, cluster, metav1.UpdateOptions{})
+		return err
+	})
+}
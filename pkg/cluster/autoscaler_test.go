@@ -0,0 +1,190 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	mgmtcompute "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	mock_compute "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/mgmt/compute"
+)
+
+func TestValidateAutoscalerQuota(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name              string
+		autoscalerProfile *api.AutoscalerProfile
+		limit             int64
+		wantErr           string
+	}{
+		{
+			name: "no autoscaler profile",
+		},
+		{
+			name: "enough quota",
+			autoscalerProfile: &api.AutoscalerProfile{
+				Pools: []api.AutoscalerPool{
+					{
+						Name:        "worker",
+						MinReplicas: 3,
+						MaxReplicas: 5,
+					},
+				},
+			},
+			limit: 108,
+		},
+		{
+			name: "not enough quota",
+			autoscalerProfile: &api.AutoscalerProfile{
+				Pools: []api.AutoscalerPool{
+					{
+						Name:        "worker",
+						MinReplicas: 3,
+						MaxReplicas: 6,
+					},
+				},
+			},
+			limit:   108,
+			wantErr: "400: ResourceQuotaExceeded: : Resource quota of standardDSv3Family exceeded. Maximum allowed: 108, Current in use: 100, Additional requested: 12.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			usage := mock_compute.NewMockUsageClient(controller)
+			if tt.autoscalerProfile != nil {
+				usage.EXPECT().
+					List(gomock.Any(), "eastus").
+					Return([]mgmtcompute.Usage{
+						{
+							Name: &mgmtcompute.UsageName{
+								Value: to.StringPtr("standardDSv3Family"),
+							},
+							CurrentValue: to.Int32Ptr(100),
+							Limit:        to.Int64Ptr(tt.limit),
+						},
+					}, nil)
+			}
+
+			m := &manager{
+				doc: &api.OpenShiftClusterDocument{
+					OpenShiftCluster: &api.OpenShiftCluster{
+						Location: "eastus",
+						Properties: api.OpenShiftClusterProperties{
+							WorkerProfiles: []api.WorkerProfile{
+								{
+									Name:   "worker",
+									VMSize: api.VMSizeStandardD4sV3,
+									Count:  3,
+								},
+							},
+							AutoscalerProfile: tt.autoscalerProfile,
+						},
+					},
+				},
+				usage: usage,
+			}
+
+			err := m.validateAutoscalerQuota(ctx)
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestEnsureAutoscaler(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name              string
+		existing          *arov1alpha1.AutoscalerSpec
+		autoscalerProfile *api.AutoscalerProfile
+		want              *arov1alpha1.AutoscalerSpec
+	}{
+		{
+			name: "autoscaler set",
+			autoscalerProfile: &api.AutoscalerProfile{
+				MaxNodesTotal:         10,
+				ScaleDownUnneededTime: "5m",
+				Pools: []api.AutoscalerPool{
+					{
+						Name:        "worker",
+						MinReplicas: 3,
+						MaxReplicas: 6,
+					},
+				},
+			},
+			want: &arov1alpha1.AutoscalerSpec{
+				MaxNodesTotal:         10,
+				ScaleDownUnneededTime: "5m",
+				Pools: []arov1alpha1.AutoscalerPoolSpec{
+					{
+						Name:        "worker",
+						MinReplicas: 3,
+						MaxReplicas: 6,
+					},
+				},
+			},
+		},
+		{
+			name: "autoscaler removed",
+			existing: &arov1alpha1.AutoscalerSpec{
+				Pools: []arov1alpha1.AutoscalerPoolSpec{
+					{
+						Name:        "worker",
+						MinReplicas: 3,
+						MaxReplicas: 6,
+					},
+				},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &manager{
+				doc: &api.OpenShiftClusterDocument{
+					OpenShiftCluster: &api.OpenShiftCluster{
+						Properties: api.OpenShiftClusterProperties{
+							AutoscalerProfile: tt.autoscalerProfile,
+						},
+					},
+				},
+				arocli: arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: arov1alpha1.SingletonClusterName,
+					},
+					Spec: arov1alpha1.ClusterSpec{
+						Autoscaler: tt.existing,
+					},
+				}).AroV1alpha1(),
+			}
+
+			err := m.ensureAutoscaler(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cluster, err := m.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(cluster.Spec.Autoscaler, tt.want) {
+				t.Error(cluster.Spec.Autoscaler)
+			}
+		})
+	}
+}
@@ -5,8 +5,37 @@ package cluster
 
 import (
 	"context"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
 )
 
+// ensureBillingRecord creates the billing record of the cluster.  The billing
+// record is not needed for the cluster to work, so if it can't be created the
+// operation carries on and the backend retries it once the operation is done.
 func (m *manager) ensureBillingRecord(ctx context.Context) error {
-	return m.billing.Ensure(ctx, m.doc)
+	ensureErr := m.billing.Ensure(ctx, m.doc)
+	if ensureErr == nil {
+		return nil
+	}
+
+	m.log.Warnf("deferring billing record: %v", ensureErr)
+
+	var err error
+	m.doc, err = m.db.PatchWithLease(ctx, m.doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+		for _, task := range doc.FollowUpTasks {
+			if task.Type == api.FollowUpTaskTypeBillingRecord {
+				return nil
+			}
+		}
+
+		doc.FollowUpTasks = append(doc.FollowUpTasks, api.FollowUpTask{
+			Type:        api.FollowUpTaskTypeBillingRecord,
+			Attempts:    1,
+			NextAttempt: int(time.Now().Unix()),
+			LastError:   ensureErr.Error(),
+		})
+		return nil
+	})
+	return err
 }
@@ -6,56 +6,117 @@ package cluster
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
 
 	"github.com/Azure/ARO-RP/pkg/api"
 	mock_billing "github.com/Azure/ARO-RP/pkg/util/mocks/billing"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
 )
 
 func TestEnsureBillingEntry(t *testing.T) {
 	ctx := context.Background()
+	key := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName1"
 
 	for _, tt := range []struct {
-		name    string
-		mocks   func(*mock_billing.MockManager)
-		wantErr string
+		name          string
+		followUpTasks []api.FollowUpTask
+		mocks         func(*mock_billing.MockManager)
+		wantTasks     int
 	}{
 		{
-			name: "manager create is called and doesn't return an error when create doesn't return an error",
+			name: "manager create is called and no follow-up task is recorded when create doesn't return an error",
 			mocks: func(billing *mock_billing.MockManager) {
 				billing.EXPECT().
-					Ensure(gomock.Any(), &api.OpenShiftClusterDocument{}).
+					Ensure(gomock.Any(), gomock.Any()).
 					Return(nil)
 			},
 		},
 		{
-			name: "manager create is called and returns an error on create returning an error",
+			name: "manager create is called and a follow-up task is recorded on create returning an error",
 			mocks: func(billing *mock_billing.MockManager) {
 				billing.EXPECT().
-					Ensure(gomock.Any(), &api.OpenShiftClusterDocument{}).
+					Ensure(gomock.Any(), gomock.Any()).
 					Return(errors.New("random error"))
 			},
-			wantErr: "random error",
+			wantTasks: 1,
+		},
+		{
+			name: "an existing follow-up task is not duplicated",
+			followUpTasks: []api.FollowUpTask{
+				{
+					Type:     api.FollowUpTaskTypeBillingRecord,
+					Attempts: 3,
+				},
+			},
+			mocks: func(billing *mock_billing.MockManager) {
+				billing.EXPECT().
+					Ensure(gomock.Any(), gomock.Any()).
+					Return(errors.New("random error"))
+			},
+			wantTasks: 1,
 		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			controller := gomock.NewController(t)
 			defer controller.Finish()
 
+			openShiftClustersDatabase, _ := testdatabase.NewFakeOpenShiftClusters()
+			fixture := testdatabase.NewFixture().WithOpenShiftClusters(openShiftClustersDatabase)
+			fixture.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				Key: strings.ToLower(key),
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID: key,
+					Properties: api.OpenShiftClusterProperties{
+						ProvisioningState: api.ProvisioningStateCreating,
+					},
+				},
+				FollowUpTasks: tt.followUpTasks,
+			})
+			err := fixture.Create()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			doc, err := openShiftClustersDatabase.Dequeue(ctx, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
 			billing := mock_billing.NewMockManager(controller)
 			tt.mocks(billing)
 
 			m := &manager{
-				doc:     &api.OpenShiftClusterDocument{},
+				log:     logrus.NewEntry(logrus.StandardLogger()),
+				doc:     doc,
+				db:      openShiftClustersDatabase,
 				billing: billing,
 			}
 
-			err := m.ensureBillingRecord(ctx)
-			if err != nil && err.Error() != tt.wantErr ||
-				err == nil && tt.wantErr != "" {
-				t.Error(err)
+			err = m.ensureBillingRecord(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			doc, err = openShiftClustersDatabase.Get(ctx, strings.ToLower(key))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(doc.FollowUpTasks) != tt.wantTasks {
+				t.Fatal(doc.FollowUpTasks)
+			}
+			for _, task := range doc.FollowUpTasks {
+				if task.Type != api.FollowUpTaskTypeBillingRecord {
+					t.Error(task.Type)
+				}
+			}
+			if tt.followUpTasks == nil && tt.wantTasks > 0 &&
+				(doc.FollowUpTasks[0].Attempts != 1 || doc.FollowUpTasks[0].LastError != "random error") {
+				t.Error(doc.FollowUpTasks[0])
 			}
 		})
 	}
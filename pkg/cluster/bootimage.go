@@ -0,0 +1,201 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Azure/go-autorest/autorest/to"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/util/retry"
+	azureproviderv1beta1 "sigs.k8s.io/cluster-api-provider-azure/pkg/apis/azureprovider/v1beta1"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/validate"
+	"github.com/Azure/ARO-RP/pkg/util/trustedlaunch"
+	"github.com/Azure/ARO-RP/pkg/util/version"
+)
+
+const (
+	bootImagePublisher = "azureopenshift"
+	bootImageOffer     = "aro4"
+
+	bootImageArm64Suffix = "_arm64"
+)
+
+// updateBootImages points the machinesets of the cluster at the latest ARO
+// boot image published for the cluster's minor version, so that machines
+// created on scale-up don't boot, and then have to be updated from, an
+// outdated RHCOS.  Existing machines are left alone: the machine config
+// operator already keeps their OS current, and they pick up the new image as
+// they are replaced in the normal course of events.
+func (m *manager) updateBootImages(ctx context.Context) error {
+	cv, err := m.configcli.ConfigV1().ClusterVersions().Get(ctx, "version", metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	v, err := version.ParseVersion(cv.Status.Desired.Version)
+	if err != nil {
+		return err
+	}
+
+	sku := fmt.Sprintf("aro_%d%d", v.V[0], v.V[1])
+
+	// arm64 machinesets need the arm64 variant of the boot image, so the
+	// latest version is looked up, once, for each SKU actually in use
+	latestVersions := map[string]string{}
+	latestBootImageVersion := func(sku string) (string, error) {
+		if latest, found := latestVersions[sku]; found {
+			return latest, nil
+		}
+
+		latest, err := m.latestBootImageVersion(ctx, sku)
+		if err != nil {
+			return "", err
+		}
+		if latest == "" {
+			m.log.Printf("no boot images found for %s", sku)
+		}
+
+		latestVersions[sku] = latest
+		return latest, nil
+	}
+
+	machinesets, err := m.maocli.MachineV1beta1().MachineSets(machineSetsNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	// update one machineset at a time, so that a problem stops the rollout
+	// with as few machinesets as possible touched
+	for _, machineset := range machinesets.Items {
+		err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			machineset, err := m.maocli.MachineV1beta1().MachineSets(machineSetsNamespace).Get(ctx, machineset.Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+
+			if machineset.Spec.Template.Spec.ProviderSpec.Value == nil {
+				return nil
+			}
+
+			o, _, err := scheme.Codecs.UniversalDeserializer().Decode(machineset.Spec.Template.Spec.ProviderSpec.Value.Raw, nil, nil)
+			if err != nil {
+				return err
+			}
+
+			providerSpec, ok := o.(*azureproviderv1beta1.AzureMachineProviderSpec)
+			if !ok {
+				return fmt.Errorf("machineset %s: failed to read provider spec: %T", machineset.Name, o)
+			}
+
+			if !isMarketplaceBootImage(&providerSpec.Image) {
+				return nil
+			}
+
+			sku := bootImageSKU(sku, api.VMSize(providerSpec.VMSize), api.SecurityTypeStandard)
+
+			latest, err := latestBootImageVersion(sku)
+			if err != nil {
+				return err
+			}
+
+			if latest == "" || !bootImageOutdated(&providerSpec.Image, sku, latest) {
+				return nil
+			}
+
+			m.log.Printf("updating machineset %s boot image from %s/%s to %s/%s", machineset.Name, providerSpec.Image.SKU, providerSpec.Image.Version, sku, latest)
+			providerSpec.Image.SKU = sku
+			providerSpec.Image.Version = latest
+
+			b, err := json.Marshal(providerSpec)
+			if err != nil {
+				return err
+			}
+
+			machineset.Spec.Template.Spec.ProviderSpec.Value = &runtime.RawExtension{
+				Raw: b,
+			}
+
+			_, err = m.maocli.MachineV1beta1().MachineSets(machineSetsNamespace).Update(ctx, machineset, metav1.UpdateOptions{})
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// latestBootImageVersion returns the newest version of the given boot image
+// SKU available in the cluster's region, or "" if there is none
+func (m *manager) latestBootImageVersion(ctx context.Context, sku string) (string, error) {
+	images, err := m.virtualMachineImages.List(ctx, m.doc.OpenShiftCluster.Location, bootImagePublisher, bootImageOffer, sku, "", nil, "")
+	if err != nil {
+		return "", err
+	}
+
+	var latest string
+	var latestVersion *version.Version
+	if images.Value != nil {
+		for _, image := range *images.Value {
+			v, err := version.ParseVersion(to.String(image.Name))
+			if err != nil {
+				continue
+			}
+
+			if latestVersion == nil || latestVersion.Lt(v) {
+				latest, latestVersion = *image.Name, v
+			}
+		}
+	}
+
+	return latest, nil
+}
+
+// bootImageSKU returns the boot image SKU to use for a machine of the given
+// VM size and security type: arm64 VM sizes need the arm64 variant of sku,
+// and trusted launch machines its Gen2 variant
+func bootImageSKU(sku string, vmSize api.VMSize, securityType api.SecurityType) string {
+	if validate.VMSizeIsArm64(vmSize) {
+		return sku + bootImageArm64Suffix
+	}
+	if securityType == api.SecurityTypeTrustedLaunch {
+		return sku + trustedlaunch.BootImageSKUSuffix
+	}
+	return sku
+}
+
+// isMarketplaceBootImage returns true if image is an ARO marketplace boot
+// image, as opposed to a custom image
+func isMarketplaceBootImage(image *azureproviderv1beta1.Image) bool {
+	return image.Publisher == bootImagePublisher && image.Offer == bootImageOffer && image.ResourceID == ""
+}
+
+// bootImageOutdated returns true if image is an ARO marketplace boot image
+// older than sku/latest.  Images are never downgraded, and custom images are
+// left untouched.
+func bootImageOutdated(image *azureproviderv1beta1.Image, sku, latest string) bool {
+	if !isMarketplaceBootImage(image) {
+		return false
+	}
+
+	current, err := version.ParseVersion(image.Version)
+	if err != nil {
+		return false
+	}
+
+	latestVersion, err := version.ParseVersion(latest)
+	if err != nil {
+		return false
+	}
+
+	return current.Lt(latestVersion)
+}
@@ -0,0 +1,138 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	mgmtcompute "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/golang/mock/gomock"
+	configv1 "github.com/openshift/api/config/v1"
+	configfake "github.com/openshift/client-go/config/clientset/versioned/fake"
+	machinev1beta1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
+	maofake "github.com/openshift/machine-api-operator/pkg/generated/clientset/versioned/fake"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	azureproviderv1beta1 "sigs.k8s.io/cluster-api-provider-azure/pkg/apis/azureprovider/v1beta1"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	mock_compute "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/mgmt/compute"
+)
+
+func TestUpdateBootImages(t *testing.T) {
+	ctx := context.Background()
+
+	machineset := func(name, vmSize, image string) *machinev1beta1.MachineSet {
+		return &machinev1beta1.MachineSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: machineSetsNamespace,
+			},
+			Spec: machinev1beta1.MachineSetSpec{
+				Template: machinev1beta1.MachineTemplateSpec{
+					Spec: machinev1beta1.MachineSpec{
+						ProviderSpec: machinev1beta1.ProviderSpec{
+							Value: &runtime.RawExtension{
+								Raw: []byte(fmt.Sprintf(`{
+"apiVersion": "azureproviderconfig.openshift.io/v1beta1",
+"kind": "AzureMachineProviderSpec",
+"vmSize": %q,
+"image": %s
+}`, vmSize, image)),
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	virtualMachineImages := mock_compute.NewMockVirtualMachineImagesClient(controller)
+	virtualMachineImages.EXPECT().
+		List(gomock.Any(), "eastus", "azureopenshift", "aro4", "aro_45", "", nil, "").
+		Return(mgmtcompute.ListVirtualMachineImageResource{
+			Value: &[]mgmtcompute.VirtualMachineImageResource{
+				{
+					Name: to.StringPtr("45.82.20200918"),
+				},
+				{
+					Name: to.StringPtr("45.82.20201101"),
+				},
+				{
+					Name: to.StringPtr("45.82.20200701"),
+				},
+			},
+		}, nil)
+	virtualMachineImages.EXPECT().
+		List(gomock.Any(), "eastus", "azureopenshift", "aro4", "aro_45_arm64", "", nil, "").
+		Return(mgmtcompute.ListVirtualMachineImageResource{
+			Value: &[]mgmtcompute.VirtualMachineImageResource{
+				{
+					Name: to.StringPtr("45.82.20201015"),
+				},
+			},
+		}, nil)
+
+	m := &manager{
+		log: logrus.NewEntry(logrus.StandardLogger()),
+		doc: &api.OpenShiftClusterDocument{
+			OpenShiftCluster: &api.OpenShiftCluster{
+				Location: "eastus",
+			},
+		},
+		virtualMachineImages: virtualMachineImages,
+		configcli: configfake.NewSimpleClientset(&configv1.ClusterVersion{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "version",
+			},
+			Status: configv1.ClusterVersionStatus{
+				Desired: configv1.Update{
+					Version: "4.5.16",
+				},
+			},
+		}),
+		maocli: maofake.NewSimpleClientset(
+			machineset("outdated", "Standard_D4s_v3", `{"publisher":"azureopenshift","offer":"aro4","sku":"aro_44","version":"44.81.20200501"}`),
+			machineset("newer", "Standard_D4s_v3", `{"publisher":"azureopenshift","offer":"aro4","sku":"aro_45","version":"45.82.20201201"}`),
+			machineset("outdated-arm64", "Standard_D4ps_v5", `{"publisher":"azureopenshift","offer":"aro4","sku":"aro_45_arm64","version":"45.82.20200918"}`),
+			machineset("arm64-second", "Standard_D8ps_v5", `{"publisher":"azureopenshift","offer":"aro4","sku":"aro_45_arm64","version":"45.82.20200918"}`),
+			machineset("custom", "Standard_D4s_v3", `{"resourceID":"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/images/providers/Microsoft.Compute/images/custom"}`),
+		),
+	}
+
+	err := m.updateBootImages(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, want := range map[string]azureproviderv1beta1.Image{
+		"outdated":       {Publisher: "azureopenshift", Offer: "aro4", SKU: "aro_45", Version: "45.82.20201101"},
+		"newer":          {Publisher: "azureopenshift", Offer: "aro4", SKU: "aro_45", Version: "45.82.20201201"},
+		"outdated-arm64": {Publisher: "azureopenshift", Offer: "aro4", SKU: "aro_45_arm64", Version: "45.82.20201015"},
+		"arm64-second":   {Publisher: "azureopenshift", Offer: "aro4", SKU: "aro_45_arm64", Version: "45.82.20201015"},
+		"custom":         {ResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/images/providers/Microsoft.Compute/images/custom"},
+	} {
+		ms, err := m.maocli.MachineV1beta1().MachineSets(machineSetsNamespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		o, _, err := scheme.Codecs.UniversalDeserializer().Decode(ms.Spec.Template.Spec.ProviderSpec.Value.Raw, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if o.(*azureproviderv1beta1.AzureMachineProviderSpec).Image != want {
+			t.Errorf("%s: %#v", name, o.(*azureproviderv1beta1.AzureMachineProviderSpec).Image)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"time"
+)
+
+// recordBootstrapDuration logs and emits the time from the start of the
+// install until the bootstrap node has brought up the control plane, which
+// is the bulk of the cluster creation time
+func (m *manager) recordBootstrapDuration(ctx context.Context) error {
+	install := m.doc.OpenShiftCluster.Properties.Install
+	if install == nil || install.Now.IsZero() {
+		return nil
+	}
+
+	d := time.Since(install.Now)
+	m.log.Infof("bootstrap completed in %s", d.Round(time.Second))
+
+	m.m.EmitGauge("backend.openshiftcluster.install.bootstrap.duration", d.Milliseconds(), map[string]string{
+		"version": m.doc.OpenShiftCluster.Properties.ClusterProfile.Version,
+	})
+
+	return nil
+}
@@ -5,6 +5,7 @@ package cluster
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/Azure/go-autorest/autorest/azure"
 	configclient "github.com/openshift/client-go/config/clientset/versioned"
@@ -14,6 +15,8 @@ import (
 	"github.com/openshift/installer/pkg/asset/bootstraplogging"
 	"github.com/openshift/installer/pkg/asset/installconfig"
 	"github.com/openshift/installer/pkg/asset/releaseimage"
+	maoclient "github.com/openshift/machine-api-operator/pkg/generated/clientset/versioned"
+	mcoclient "github.com/openshift/machine-config-operator/pkg/generated/clientset/versioned"
 	"github.com/sirupsen/logrus"
 	extensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	"k8s.io/client-go/kubernetes"
@@ -21,7 +24,9 @@ import (
 	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/database"
 	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/metrics"
 	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/authorization"
 	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/compute"
 	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/features"
 	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/network"
@@ -39,6 +44,8 @@ type Interface interface {
 	Install(ctx context.Context, installConfig *installconfig.InstallConfig, platformCreds *installconfig.PlatformCreds, image *releaseimage.Image, bootstrapLoggingConfig *bootstraplogging.Config) error
 	Delete(ctx context.Context) error
 	AdminUpgrade(ctx context.Context) error
+	Update(ctx context.Context) error
+	ReconcileDrift(ctx context.Context) []DriftResult
 }
 
 // manager contains information needed to install and maintain an ARO cluster
@@ -52,18 +59,24 @@ type manager struct {
 	cipher            encryption.Cipher
 	fpAuthorizer      refreshable.Authorizer
 	localFpAuthorizer refreshable.Authorizer
-
-	disks               compute.DisksClient
-	virtualMachines     compute.VirtualMachinesClient
-	interfaces          network.InterfacesClient
-	publicIPAddresses   network.PublicIPAddressesClient
-	loadBalancers       network.LoadBalancersClient
-	securityGroups      network.SecurityGroupsClient
-	deployments         features.DeploymentsClient
-	resourceGroups      features.ResourceGroupsClient
-	resources           features.ResourcesClient
-	virtualNetworkLinks privatedns.VirtualNetworkLinksClient
-	storageAccounts     storage.AccountsClient
+	m                 metrics.Interface
+
+	disks                 compute.DisksClient
+	virtualMachines       compute.VirtualMachinesClient
+	virtualMachineImages  compute.VirtualMachineImagesClient
+	interfaces            network.InterfacesClient
+	publicIPAddresses     network.PublicIPAddressesClient
+	loadBalancers         network.LoadBalancersClient
+	privateLinkServices   network.PrivateLinkServicesClient
+	roleAssignments       authorization.RoleAssignmentsClient
+	denyAssignmentsClient authorization.DenyAssignmentsClient
+	securityGroups        network.SecurityGroupsClient
+	deployments           features.DeploymentsClient
+	resourceGroups        features.ResourceGroupsClient
+	resources             features.ResourcesClient
+	virtualNetworkLinks   privatedns.VirtualNetworkLinksClient
+	storageAccounts       storage.AccountsClient
+	usage                 compute.UsageClient
 
 	dns             dns.Manager
 	privateendpoint privateendpoint.Manager
@@ -76,13 +89,17 @@ type manager struct {
 	samplescli    samplesclient.Interface
 	securitycli   securityclient.Interface
 	arocli        aroclient.AroV1alpha1Interface
+	maocli        maoclient.Interface
+	mcocli        mcoclient.Interface
+
+	registryClient *http.Client
 }
 
 const deploymentName = "azuredeploy"
 
 // NewManager returns a cluster manager
 func NewManager(ctx context.Context, log *logrus.Entry, _env env.Interface, db database.OpenShiftClusters, cipher encryption.Cipher,
-	billing billing.Manager, doc *api.OpenShiftClusterDocument, subscriptionDoc *api.SubscriptionDocument) (Interface, error) {
+	billing billing.Manager, doc *api.OpenShiftClusterDocument, subscriptionDoc *api.SubscriptionDocument, m metrics.Interface) (Interface, error) {
 	r, err := azure.ParseResourceID(doc.OpenShiftCluster.ID)
 	if err != nil {
 		return nil, err
@@ -108,21 +125,29 @@ func NewManager(ctx context.Context, log *logrus.Entry, _env env.Interface, db d
 		cipher:            cipher,
 		fpAuthorizer:      fpAuthorizer,
 		localFpAuthorizer: localFPAuthorizer,
-
-		disks:               compute.NewDisksClient(r.SubscriptionID, fpAuthorizer),
-		virtualMachines:     compute.NewVirtualMachinesClient(r.SubscriptionID, fpAuthorizer),
-		interfaces:          network.NewInterfacesClient(r.SubscriptionID, fpAuthorizer),
-		publicIPAddresses:   network.NewPublicIPAddressesClient(r.SubscriptionID, fpAuthorizer),
-		loadBalancers:       network.NewLoadBalancersClient(r.SubscriptionID, fpAuthorizer),
-		securityGroups:      network.NewSecurityGroupsClient(r.SubscriptionID, fpAuthorizer),
-		deployments:         features.NewDeploymentsClient(r.SubscriptionID, fpAuthorizer),
-		resourceGroups:      features.NewResourceGroupsClient(r.SubscriptionID, fpAuthorizer),
-		resources:           features.NewResourcesClient(r.SubscriptionID, fpAuthorizer),
-		virtualNetworkLinks: privatedns.NewVirtualNetworkLinksClient(r.SubscriptionID, fpAuthorizer),
-		storageAccounts:     storage.NewAccountsClient(r.SubscriptionID, fpAuthorizer),
+		m:                 m,
+
+		disks:                 compute.NewDisksClient(r.SubscriptionID, fpAuthorizer),
+		virtualMachines:       compute.NewVirtualMachinesClient(r.SubscriptionID, fpAuthorizer),
+		virtualMachineImages:  compute.NewVirtualMachineImagesClient(r.SubscriptionID, fpAuthorizer),
+		interfaces:            network.NewInterfacesClient(r.SubscriptionID, fpAuthorizer),
+		publicIPAddresses:     network.NewPublicIPAddressesClient(r.SubscriptionID, fpAuthorizer),
+		loadBalancers:         network.NewLoadBalancersClient(r.SubscriptionID, fpAuthorizer),
+		privateLinkServices:   network.NewPrivateLinkServicesClient(r.SubscriptionID, fpAuthorizer),
+		roleAssignments:       authorization.NewRoleAssignmentsClient(r.SubscriptionID, fpAuthorizer),
+		denyAssignmentsClient: authorization.NewDenyAssignmentsClient(r.SubscriptionID, fpAuthorizer),
+		securityGroups:        network.NewSecurityGroupsClient(r.SubscriptionID, fpAuthorizer),
+		deployments:           features.NewDeploymentsClient(r.SubscriptionID, fpAuthorizer),
+		resourceGroups:        features.NewResourceGroupsClient(r.SubscriptionID, fpAuthorizer),
+		resources:             features.NewResourcesClient(r.SubscriptionID, fpAuthorizer),
+		virtualNetworkLinks:   privatedns.NewVirtualNetworkLinksClient(r.SubscriptionID, fpAuthorizer),
+		storageAccounts:       storage.NewAccountsClient(r.SubscriptionID, fpAuthorizer),
+		usage:                 compute.NewUsageClient(r.SubscriptionID, fpAuthorizer),
 
 		dns:             dns.NewManager(_env, localFPAuthorizer),
 		privateendpoint: privateendpoint.NewManager(_env, localFPAuthorizer),
 		subnet:          subnet.NewManager(r.SubscriptionID, fpAuthorizer),
+
+		registryClient: &http.Client{},
 	}, nil
 }
@@ -52,6 +52,23 @@ func (m *manager) clusterVersionReady(ctx context.Context) (bool, error) {
 	return false, nil
 }
 
+// ingressIPReady returns true once the router service is served by the
+// customer's public IP, if any
+func (m *manager) ingressIPReady(ctx context.Context) (bool, error) {
+	if m.doc.OpenShiftCluster.Properties.IngressProfiles[0].PublicIPAddressID == "" {
+		return true, nil
+	}
+
+	svc, err := m.kubernetescli.CoreV1().Services("openshift-ingress").Get(ctx, "router-default", metav1.GetOptions{})
+	if err != nil {
+		return false, nil
+	}
+
+	return svc.Spec.LoadBalancerIP != "" &&
+		len(svc.Status.LoadBalancer.Ingress) > 0 &&
+		svc.Status.LoadBalancer.Ingress[0].IP == svc.Spec.LoadBalancerIP, nil
+}
+
 func (m *manager) ingressControllerReady(ctx context.Context) (bool, error) {
 	ingressOperator, err := m.configcli.ConfigV1().ClusterOperators().Get(ctx, "ingress", metav1.GetOptions{})
 	if err != nil {
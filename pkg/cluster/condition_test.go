@@ -15,6 +15,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/Azure/ARO-RP/pkg/api"
 )
 
 const errMustBeNilMsg = "err must be nil; condition is retried until timeout"
@@ -206,3 +208,77 @@ func TestClusterVersionReady(t *testing.T) {
 		}
 	}
 }
+
+func TestIngressIPReady(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name              string
+		publicIPAddressID string
+		loadBalancerIP    string
+		ingressIP         string
+		want              bool
+	}{
+		{
+			name: "No customer public IP",
+			want: true,
+		},
+		{
+			name:              "Router service not configured",
+			publicIPAddressID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/ips/providers/Microsoft.Network/publicIPAddresses/ingress",
+			ingressIP:         "1.2.3.4",
+		},
+		{
+			name:              "Router service not moved yet",
+			publicIPAddressID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/ips/providers/Microsoft.Network/publicIPAddresses/ingress",
+			loadBalancerIP:    "5.6.7.8",
+			ingressIP:         "1.2.3.4",
+		},
+		{
+			name:              "Router service moved",
+			publicIPAddressID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/ips/providers/Microsoft.Network/publicIPAddresses/ingress",
+			loadBalancerIP:    "5.6.7.8",
+			ingressIP:         "5.6.7.8",
+			want:              true,
+		},
+	} {
+		m := &manager{
+			doc: &api.OpenShiftClusterDocument{
+				OpenShiftCluster: &api.OpenShiftCluster{
+					Properties: api.OpenShiftClusterProperties{
+						IngressProfiles: []api.IngressProfile{
+							{
+								PublicIPAddressID: tt.publicIPAddressID,
+							},
+						},
+					},
+				},
+			},
+			kubernetescli: k8sfake.NewSimpleClientset(&corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "router-default",
+					Namespace: "openshift-ingress",
+				},
+				Spec: corev1.ServiceSpec{
+					LoadBalancerIP: tt.loadBalancerIP,
+				},
+				Status: corev1.ServiceStatus{
+					LoadBalancer: corev1.LoadBalancerStatus{
+						Ingress: []corev1.LoadBalancerIngress{
+							{
+								IP: tt.ingressIP,
+							},
+						},
+					},
+				},
+			}),
+		}
+		ready, err := m.ingressIPReady(ctx)
+		if err != nil {
+			t.Error(errMustBeNilMsg)
+		}
+		if ready != tt.want {
+			t.Error(tt.name, ready)
+		}
+	}
+}
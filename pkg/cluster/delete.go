@@ -18,7 +18,6 @@ import (
 	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/util/acrtoken"
 	"github.com/Azure/ARO-RP/pkg/util/azureclient"
-	"github.com/Azure/ARO-RP/pkg/util/deployment"
 	"github.com/Azure/ARO-RP/pkg/util/dns"
 	"github.com/Azure/ARO-RP/pkg/util/stringutils"
 )
@@ -236,7 +235,7 @@ func (m *manager) Delete(ctx context.Context) error {
 		return err
 	}
 
-	if m.env.DeploymentMode() != deployment.Development {
+	if !m.env.DisableSignedCertificates() {
 		managedDomain, err := dns.ManagedDomain(m.env, m.doc.OpenShiftCluster.Properties.ClusterProfile.Domain)
 		if err != nil {
 			return err
@@ -255,7 +254,9 @@ func (m *manager) Delete(ctx context.Context) error {
 				return err
 			}
 		}
+	}
 
+	if !m.env.FakeFirstParty() {
 		acrManager, err := acrtoken.NewManager(m.env, m.localFpAuthorizer)
 		if err != nil {
 			return err
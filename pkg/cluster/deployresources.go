@@ -54,7 +54,6 @@ func (m *manager) deployResourceTemplate(ctx context.Context) error {
 			dnsPrivateRecordAPI(infraID, installConfig),
 			dnsVirtualNetworkLink(vnetID, installConfig),
 			networkPrivateLinkService(infraID, m.env.SubscriptionID(), m.doc.OpenShiftCluster, installConfig),
-			networkPublicIPAddress(infraID, installConfig),
 			networkInternalLoadBalancer(infraID, m.doc.OpenShiftCluster, installConfig),
 			networkPublicLoadBalancer(infraID, m.doc.OpenShiftCluster, installConfig),
 			networkBootstrapNIC(infraID, m.doc.OpenShiftCluster, installConfig),
@@ -63,6 +62,11 @@ func (m *manager) deployResourceTemplate(ctx context.Context) error {
 			computeMasterVMs(infraID, zones, machineMaster, m.doc.OpenShiftCluster, installConfig),
 		},
 	}
+	if m.doc.OpenShiftCluster.Properties.APIServerProfile.PublicIPAddressID == "" {
+		t.Resources = append(t.Resources, networkPublicIPAddress(infraID, installConfig))
+	}
+	t.Resources = append(t.Resources, networkOutboundPublicIPAddresses(infraID, m.doc.OpenShiftCluster, installConfig)...)
+
 	return m.deployARMTemplate(ctx, resourceGroup, "resources", t, map[string]interface{}{
 		"sas": map[string]interface{}{
 			"value": map[string]interface{}{
@@ -247,7 +247,33 @@ func networkInternalLoadBalancer(infraID string, oc *api.OpenShiftCluster, insta
 	}
 }
 
+// networkOutboundPublicIPAddresses returns the managed outbound public IPs
+// beyond the first, which is the public IP of the public load balancer
+func networkOutboundPublicIPAddresses(infraID string, oc *api.OpenShiftCluster, installConfig *installconfig.InstallConfig) []*arm.Resource {
+	var rs []*arm.Resource
+
+	for i := 1; i < managedOutboundIPCount(oc); i++ {
+		rs = append(rs, &arm.Resource{
+			Resource:   outboundPublicIPAddress(outboundPublicIPName(infraID, i), installConfig.Config.Azure.Region),
+			APIVersion: azureclient.APIVersion("Microsoft.Network"),
+		})
+	}
+
+	return rs
+}
+
 func networkPublicLoadBalancer(infraID string, oc *api.OpenShiftCluster, installConfig *installconfig.InstallConfig) *arm.Resource {
+	// the customer's public IP, if any, fronts the API server instead of
+	// the one which is deployed with the cluster.  It is also the first
+	// managed outbound IP.
+	publicIPAddressID := "[resourceId('Microsoft.Network/publicIPAddresses', '" + infraID + "-pip-v4')]"
+	var dependsOn []string
+	if oc.Properties.APIServerProfile.PublicIPAddressID != "" {
+		publicIPAddressID = oc.Properties.APIServerProfile.PublicIPAddressID
+	} else {
+		dependsOn = append(dependsOn, "Microsoft.Network/publicIPAddresses/"+infraID+"-pip-v4")
+	}
+
 	lb := &mgmtnetwork.LoadBalancer{
 		Sku: &mgmtnetwork.LoadBalancerSku{
 			Name: mgmtnetwork.LoadBalancerSkuNameStandard,
@@ -257,7 +283,7 @@ func networkPublicLoadBalancer(infraID string, oc *api.OpenShiftCluster, install
 				{
 					FrontendIPConfigurationPropertiesFormat: &mgmtnetwork.FrontendIPConfigurationPropertiesFormat{
 						PublicIPAddress: &mgmtnetwork.PublicIPAddress{
-							ID: to.StringPtr("[resourceId('Microsoft.Network/publicIPAddresses', '" + infraID + "-pip-v4')]"),
+							ID: to.StringPtr(publicIPAddressID),
 						},
 					},
 					Name: to.StringPtr("public-lb-ip-v4"),
@@ -284,7 +310,7 @@ func networkPublicLoadBalancer(infraID string, oc *api.OpenShiftCluster, install
 						Protocol:             mgmtnetwork.LoadBalancerOutboundRuleProtocolAll,
 						IdleTimeoutInMinutes: to.Int32Ptr(30),
 					},
-					Name: to.StringPtr("outbound-rule-v4"),
+					Name: to.StringPtr(outboundRuleName),
 				},
 			},
 		},
@@ -293,6 +319,24 @@ func networkPublicLoadBalancer(infraID string, oc *api.OpenShiftCluster, install
 		Location: &installConfig.Config.Azure.Region,
 	}
 
+	outboundRule := &(*lb.OutboundRules)[0]
+	for i := 1; i < managedOutboundIPCount(oc); i++ {
+		*lb.FrontendIPConfigurations = append(*lb.FrontendIPConfigurations, mgmtnetwork.FrontendIPConfiguration{
+			FrontendIPConfigurationPropertiesFormat: &mgmtnetwork.FrontendIPConfigurationPropertiesFormat{
+				PublicIPAddress: &mgmtnetwork.PublicIPAddress{
+					ID: to.StringPtr("[resourceId('Microsoft.Network/publicIPAddresses', '" + outboundPublicIPName(infraID, i) + "')]"),
+				},
+			},
+			Name: to.StringPtr(outboundFrontendName(i)),
+		})
+
+		*outboundRule.FrontendIPConfigurations = append(*outboundRule.FrontendIPConfigurations, mgmtnetwork.SubResource{
+			ID: to.StringPtr("[resourceId('Microsoft.Network/loadBalancers/frontendIPConfigurations', '" + infraID + "', '" + outboundFrontendName(i) + "')]"),
+		})
+
+		dependsOn = append(dependsOn, "Microsoft.Network/publicIPAddresses/"+outboundPublicIPName(infraID, i))
+	}
+
 	if oc.Properties.APIServerProfile.Visibility == api.VisibilityPublic {
 		*lb.LoadBalancingRules = append(*lb.LoadBalancingRules, mgmtnetwork.LoadBalancingRule{
 			LoadBalancingRulePropertiesFormat: &mgmtnetwork.LoadBalancingRulePropertiesFormat{
@@ -330,9 +374,7 @@ func networkPublicLoadBalancer(infraID string, oc *api.OpenShiftCluster, install
 	return &arm.Resource{
 		Resource:   lb,
 		APIVersion: azureclient.APIVersion("Microsoft.Network"),
-		DependsOn: []string{
-			"Microsoft.Network/publicIPAddresses/" + infraID + "-pip-v4",
-		},
+		DependsOn:  dependsOn,
 	}
 }
 
@@ -470,7 +512,7 @@ func computeBoostrapVM(infraID string, oc *api.OpenShiftCluster, installConfig *
 }
 
 func computeMasterVMs(infraID string, zones *[]string, machineMaster *machine.Master, oc *api.OpenShiftCluster, installConfig *installconfig.InstallConfig) *arm.Resource {
-	return &arm.Resource{
+	r := &arm.Resource{
 		Resource: &mgmtcompute.VirtualMachine{
 			VirtualMachineProperties: &mgmtcompute.VirtualMachineProperties{
 				HardwareProfile: &mgmtcompute.HardwareProfile{
@@ -531,4 +573,10 @@ func computeMasterVMs(infraID string, zones *[]string, machineMaster *machine.Ma
 			"Microsoft.Network/privateDnsZones/" + installConfig.Config.ObjectMeta.Name + "." + installConfig.Config.BaseDomain + "/virtualNetworkLinks/" + installConfig.Config.ObjectMeta.Name + "-network-link",
 		},
 	}
+
+	if oc.Properties.MasterProfile.SecurityType == api.SecurityTypeTrustedLaunch {
+		trustedLaunchMasterVMs(r)
+	}
+
+	return r
 }
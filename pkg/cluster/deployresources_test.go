@@ -7,10 +7,13 @@ import (
 	"reflect"
 	"testing"
 
+	mgmtnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-07-01/network"
 	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/openshift/installer/pkg/asset/installconfig"
 	"github.com/openshift/installer/pkg/types"
 	aztypes "github.com/openshift/installer/pkg/types/azure"
+
+	"github.com/Azure/ARO-RP/pkg/api"
 )
 
 func TestZones(t *testing.T) {
@@ -65,3 +68,54 @@ func TestZones(t *testing.T) {
 		})
 	}
 }
+
+func TestNetworkPublicLoadBalancerPublicIPAddress(t *testing.T) {
+	installConfig := &installconfig.InstallConfig{
+		Config: &types.InstallConfig{
+			Platform: types.Platform{
+				Azure: &aztypes.Platform{
+					Region: "eastus",
+				},
+			},
+		},
+	}
+
+	for _, tt := range []struct {
+		name              string
+		publicIPAddressID string
+		wantID            string
+		wantDependsOn     []string
+	}{
+		{
+			name:          "public IP deployed with the cluster",
+			wantID:        "[resourceId('Microsoft.Network/publicIPAddresses', 'infra-pip-v4')]",
+			wantDependsOn: []string{"Microsoft.Network/publicIPAddresses/infra-pip-v4"},
+		},
+		{
+			name:              "customer public IP",
+			publicIPAddressID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/ips/providers/Microsoft.Network/publicIPAddresses/api",
+			wantID:            "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/ips/providers/Microsoft.Network/publicIPAddresses/api",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			oc := &api.OpenShiftCluster{
+				Properties: api.OpenShiftClusterProperties{
+					APIServerProfile: api.APIServerProfile{
+						Visibility:        api.VisibilityPublic,
+						PublicIPAddressID: tt.publicIPAddressID,
+					},
+				},
+			}
+
+			r := networkPublicLoadBalancer("infra", oc, installConfig)
+
+			lb := r.Resource.(*mgmtnetwork.LoadBalancer)
+			if *(*lb.FrontendIPConfigurations)[0].PublicIPAddress.ID != tt.wantID {
+				t.Error(*(*lb.FrontendIPConfigurations)[0].PublicIPAddress.ID)
+			}
+			if !reflect.DeepEqual(r.DependsOn, tt.wantDependsOn) {
+				t.Error(r.DependsOn)
+			}
+		})
+	}
+}
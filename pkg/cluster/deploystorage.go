@@ -5,7 +5,6 @@ package cluster
 
 import (
 	"context"
-	"encoding/json"
 	"net/http"
 	"reflect"
 	"strings"
@@ -26,14 +25,17 @@ import (
 	"github.com/openshift/installer/pkg/asset/releaseimage"
 	"github.com/openshift/installer/pkg/asset/targets"
 	"github.com/openshift/installer/pkg/types"
+	"github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 
 	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/env"
 	"github.com/Azure/ARO-RP/pkg/util/aad"
 	"github.com/Azure/ARO-RP/pkg/util/arm"
 	"github.com/Azure/ARO-RP/pkg/util/azureclient"
 	"github.com/Azure/ARO-RP/pkg/util/azureclient/graphrbac"
+	"github.com/Azure/ARO-RP/pkg/util/azureerrors"
 	"github.com/Azure/ARO-RP/pkg/util/deployment"
 	"github.com/Azure/ARO-RP/pkg/util/feature"
 	"github.com/Azure/ARO-RP/pkg/util/rbac"
@@ -46,10 +48,16 @@ func (m *manager) createDNS(ctx context.Context) error {
 }
 
 func (m *manager) clusterSPObjectID(ctx context.Context) (string, error) {
+	return ClusterSPObjectID(ctx, m.log, m.env, m.doc.OpenShiftCluster)
+}
+
+// ClusterSPObjectID returns the object ID of the cluster service principal,
+// waiting for it to become visible in AAD if necessary
+func ClusterSPObjectID(ctx context.Context, log *logrus.Entry, _env env.Interface, oc *api.OpenShiftCluster) (string, error) {
 	var clusterSPObjectID string
-	spp := &m.doc.OpenShiftCluster.Properties.ServicePrincipalProfile
+	spp := &oc.Properties.ServicePrincipalProfile
 
-	token, err := aad.GetToken(ctx, m.log, m.doc.OpenShiftCluster, m.env.Environment().GraphEndpoint)
+	token, err := aad.GetToken(ctx, log, oc, _env.Environment().GraphEndpoint)
 	if err != nil {
 		return "", err
 	}
@@ -67,7 +75,7 @@ func (m *manager) clusterSPObjectID(ctx context.Context) (string, error) {
 		res, err = applications.GetServicePrincipalsIDByAppID(ctx, spp.ClientID)
 		if err != nil {
 			if strings.Contains(err.Error(), "Authorization_IdentityNotFound") {
-				m.log.Info(err)
+				log.Info(err)
 				return false, nil
 			}
 			return false, err
@@ -80,6 +88,44 @@ func (m *manager) clusterSPObjectID(ctx context.Context) (string, error) {
 	return clusterSPObjectID, err
 }
 
+// ensureResourceGroup creates the cluster resource group.  The resource group
+// is named by the customer, so refuse to adopt one which already exists and
+// was not created by us on a previous attempt: it would be locked by the deny
+// assignment and deleted with the cluster.
+func (m *manager) ensureResourceGroup(ctx context.Context, location string) error {
+	resourceGroup := stringutils.LastTokenByte(m.doc.OpenShiftCluster.Properties.ClusterProfile.ResourceGroupID, '/')
+
+	existing, err := m.resourceGroups.Get(ctx, resourceGroup)
+	if detailedErr, ok := err.(autorest.DetailedError); ok &&
+		detailedErr.StatusCode == http.StatusNotFound {
+		err = nil
+	} else if err == nil &&
+		!m.env.FakeFirstParty() &&
+		(existing.ManagedBy == nil || !strings.EqualFold(*existing.ManagedBy, m.doc.OpenShiftCluster.ID)) {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeClusterResourceGroupAlreadyExists, "properties.clusterProfile.resourceGroupId", "The provided resource group '%s' must not already exist.", m.doc.OpenShiftCluster.Properties.ClusterProfile.ResourceGroupID)
+	}
+	if err != nil {
+		return err
+	}
+
+	m.log.Print("creating resource group")
+	group := mgmtfeatures.ResourceGroup{
+		Location:  &location,
+		ManagedBy: to.StringPtr(m.doc.OpenShiftCluster.ID),
+	}
+	if m.env.FakeFirstParty() {
+		group.ManagedBy = nil
+	}
+	_, err = m.resourceGroups.CreateOrUpdate(ctx, resourceGroup, group)
+	if requestErr, ok := err.(*azure.RequestError); ok {
+		if policyID, ok := azureerrors.IsRequestDisallowedByPolicyError(requestErr); ok {
+			// if request was disallowed by policy, inform user so they can take appropriate action
+			return requestDisallowedByPolicyError(policyID, requestErr.ServiceError)
+		}
+	}
+	return err
+}
+
 func (m *manager) deployStorageTemplate(ctx context.Context, installConfig *installconfig.InstallConfig, platformCreds *installconfig.PlatformCreds, image *releaseimage.Image, bootstrapLoggingConfig *bootstraplogging.Config) error {
 	if m.doc.OpenShiftCluster.Properties.InfraID == "" {
 		clusterID := &installconfig.ClusterID{}
@@ -110,32 +156,7 @@ func (m *manager) deployStorageTemplate(ctx context.Context, installConfig *inst
 
 	resourceGroup := stringutils.LastTokenByte(m.doc.OpenShiftCluster.Properties.ClusterProfile.ResourceGroupID, '/')
 
-	m.log.Print("creating resource group")
-	group := mgmtfeatures.ResourceGroup{
-		Location:  &installConfig.Config.Azure.Region,
-		ManagedBy: to.StringPtr(m.doc.OpenShiftCluster.ID),
-	}
-	if m.env.DeploymentMode() == deployment.Development {
-		group.ManagedBy = nil
-	}
-	_, err := m.resourceGroups.CreateOrUpdate(ctx, resourceGroup, group)
-	if requestErr, ok := err.(*azure.RequestError); ok &&
-		requestErr.ServiceError != nil && requestErr.ServiceError.Code == "RequestDisallowedByPolicy" {
-		// if request was disallowed by policy, inform user so they can take appropriate action
-		b, _ := json.Marshal(requestErr.ServiceError)
-		return &api.CloudError{
-			StatusCode: http.StatusBadRequest,
-			CloudErrorBody: &api.CloudErrorBody{
-				Code:    api.CloudErrorCodeDeploymentFailed,
-				Message: "Deployment failed.",
-				Details: []api.CloudErrorBody{
-					{
-						Message: string(b),
-					},
-				},
-			},
-		}
-	}
+	err := m.ensureResourceGroup(ctx, installConfig.Config.Azure.Region)
 	if err != nil {
 		return err
 	}
@@ -240,6 +261,10 @@ var extraDenyAssignmentExclusions = map[string][]string{
 }
 
 func (m *manager) denyAssignments(clusterSPObjectID string) *arm.Resource {
+	return denyAssignments(m.doc.OpenShiftCluster, m.subscriptionDoc, clusterSPObjectID)
+}
+
+func denyAssignments(oc *api.OpenShiftCluster, subscriptionDoc *api.SubscriptionDocument, clusterSPObjectID string) *arm.Resource {
 	notActions := []string{
 		"Microsoft.Network/networkSecurityGroups/join/action",
 		"Microsoft.Compute/disks/beginGetAccess/action",
@@ -251,7 +276,7 @@ func (m *manager) denyAssignments(clusterSPObjectID string) *arm.Resource {
 		"Microsoft.Compute/snapshots/delete",
 	}
 
-	var props = m.subscriptionDoc.Subscription.Properties
+	var props = subscriptionDoc.Subscription.Properties
 
 	for flag, exclusions := range extraDenyAssignmentExclusions {
 		if feature.IsRegisteredForFeature(props, flag) {
@@ -275,7 +300,7 @@ func (m *manager) denyAssignments(clusterSPObjectID string) *arm.Resource {
 						NotActions: &notActions,
 					},
 				},
-				Scope: &m.doc.OpenShiftCluster.Properties.ClusterProfile.ResourceGroupID,
+				Scope: &oc.Properties.ClusterProfile.ResourceGroupID,
 				Principals: &[]mgmtauthorization.Principal{
 					{
 						ID:   to.StringPtr("00000000-0000-0000-0000-000000000000"),
@@ -373,6 +398,11 @@ func (m *manager) attachNSGsAndPatch(ctx context.Context) error {
 		return err
 	}
 
+	expiresAt, err := clientCertificateExpiry(aroServiceInternalClient)
+	if err != nil {
+		return err
+	}
+
 	m.doc, err = m.db.PatchWithLease(ctx, m.doc.Key, func(doc *api.OpenShiftClusterDocument) error {
 		// used for the SAS token with which the bootstrap node retrieves its
 		// ignition payload
@@ -386,6 +416,7 @@ func (m *manager) attachNSGsAndPatch(ctx context.Context) error {
 		}
 		doc.OpenShiftCluster.Properties.AdminKubeconfig = adminInternalClient.File.Data
 		doc.OpenShiftCluster.Properties.AROServiceKubeconfig = aroServiceInternalClient.File.Data
+		doc.OpenShiftCluster.Properties.CredentialsProfile.AROServiceKubeconfig.Issue(time.Now(), expiresAt)
 		return nil
 	})
 	return err
@@ -4,12 +4,23 @@ package cluster
 // Licensed under the Apache License 2.0.
 
 import (
+	"context"
+	"net/http"
 	"reflect"
 	"testing"
 
 	mgmtauthorization "github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2018-09-01-preview/authorization"
+	mgmtfeatures "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-07-01/features"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
 
 	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/deployment"
+	mock_features "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/mgmt/features"
+	mock_env "github.com/Azure/ARO-RP/pkg/util/mocks/env"
 )
 
 var daTestCases = []struct {
@@ -84,3 +95,123 @@ func TestDenyAssignments(t *testing.T) {
 		})
 	}
 }
+
+func TestEnsureResourceGroup(t *testing.T) {
+	ctx := context.Background()
+
+	clusterID := "/subscriptions/subscriptionId/resourceGroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName"
+	resourceGroupID := "/subscriptions/subscriptionId/resourceGroups/aro-cluster"
+
+	for _, tt := range []struct {
+		name           string
+		deploymentMode deployment.Mode
+		existing       *mgmtfeatures.ResourceGroup
+		wantCreate     bool
+		createErr      error
+		wantErr        string
+	}{
+		{
+			name:           "resource group doesn't exist",
+			deploymentMode: deployment.Production,
+			wantCreate:     true,
+		},
+		{
+			name:           "resource group created on a previous attempt",
+			deploymentMode: deployment.Production,
+			existing: &mgmtfeatures.ResourceGroup{
+				ManagedBy: to.StringPtr(clusterID),
+			},
+			wantCreate: true,
+		},
+		{
+			name:           "resource group exists and is not managed",
+			deploymentMode: deployment.Production,
+			existing:       &mgmtfeatures.ResourceGroup{},
+			wantErr:        "400: ClusterResourceGroupAlreadyExists: properties.clusterProfile.resourceGroupId: The provided resource group '" + resourceGroupID + "' must not already exist.",
+		},
+		{
+			name:           "resource group exists and is managed by another resource",
+			deploymentMode: deployment.Production,
+			existing: &mgmtfeatures.ResourceGroup{
+				ManagedBy: to.StringPtr("/subscriptions/subscriptionId/resourceGroups/other/providers/Microsoft.RedHatOpenShift/openShiftClusters/other"),
+			},
+			wantErr: "400: ClusterResourceGroupAlreadyExists: properties.clusterProfile.resourceGroupId: The provided resource group '" + resourceGroupID + "' must not already exist.",
+		},
+		{
+			name:           "development resource groups are never managed",
+			deploymentMode: deployment.Development,
+			existing:       &mgmtfeatures.ResourceGroup{},
+			wantCreate:     true,
+		},
+		{
+			name:           "resource group disallowed by policy",
+			deploymentMode: deployment.Production,
+			wantCreate:     true,
+			createErr: &azure.RequestError{
+				ServiceError: &azure.ServiceError{
+					Code:    "RequestDisallowedByPolicy",
+					Message: "Resource 'aro-cluster' was disallowed by policy.",
+					AdditionalInfo: []map[string]interface{}{
+						{
+							"type": "PolicyViolation",
+							"info": map[string]interface{}{
+								"policyAssignmentId": "/subscriptions/subscriptionId/providers/Microsoft.Authorization/policyAssignments/allowed-locations",
+							},
+						},
+					},
+				},
+			},
+			wantErr: "400: RequestDisallowedByPolicy: : The deployment was disallowed by Azure Policy '/subscriptions/subscriptionId/providers/Microsoft.Authorization/policyAssignments/allowed-locations'. Review the policy assignments of the subscription and try again. Details: : : {\"code\":\"RequestDisallowedByPolicy\",\"message\":\"Resource 'aro-cluster' was disallowed by policy.\",\"target\":null,\"details\":null,\"innererror\":null,\"additionalInfo\":[{\"info\":{\"policyAssignmentId\":\"/subscriptions/subscriptionId/providers/Microsoft.Authorization/policyAssignments/allowed-locations\"},\"type\":\"PolicyViolation\"}]}",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			env := mock_env.NewMockInterface(controller)
+			env.EXPECT().FakeFirstParty().AnyTimes().Return(tt.deploymentMode == deployment.Development)
+
+			resourceGroups := mock_features.NewMockResourceGroupsClient(controller)
+			if tt.existing != nil {
+				resourceGroups.EXPECT().Get(ctx, "aro-cluster").Return(*tt.existing, nil)
+			} else {
+				resourceGroups.EXPECT().Get(ctx, "aro-cluster").Return(mgmtfeatures.ResourceGroup{}, autorest.DetailedError{
+					StatusCode: http.StatusNotFound,
+				})
+			}
+
+			if tt.wantCreate {
+				group := mgmtfeatures.ResourceGroup{
+					Location:  to.StringPtr("eastus"),
+					ManagedBy: to.StringPtr(clusterID),
+				}
+				if tt.deploymentMode == deployment.Development {
+					group.ManagedBy = nil
+				}
+				resourceGroups.EXPECT().CreateOrUpdate(ctx, "aro-cluster", group).Return(group, tt.createErr)
+			}
+
+			m := &manager{
+				log:            logrus.NewEntry(logrus.StandardLogger()),
+				env:            env,
+				resourceGroups: resourceGroups,
+				doc: &api.OpenShiftClusterDocument{
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID: clusterID,
+						Properties: api.OpenShiftClusterProperties{
+							ClusterProfile: api.ClusterProfile{
+								ResourceGroupID: resourceGroupID,
+							},
+						},
+					},
+				},
+			}
+
+			err := m.ensureResourceGroup(ctx, "eastus")
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Error(err)
+			}
+		})
+	}
+}
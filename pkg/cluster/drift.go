@@ -0,0 +1,425 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	mgmtnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-07-01/network"
+	mgmtauthorization "github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2018-09-01-preview/authorization"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/openshift/installer/pkg/asset/installconfig"
+	"github.com/openshift/installer/pkg/types"
+	aztypes "github.com/openshift/installer/pkg/types/azure"
+
+	"github.com/Azure/ARO-RP/pkg/util/arm"
+	"github.com/Azure/ARO-RP/pkg/util/deployment"
+	"github.com/Azure/ARO-RP/pkg/util/dns"
+	"github.com/Azure/ARO-RP/pkg/util/rbac"
+	"github.com/Azure/ARO-RP/pkg/util/stringutils"
+)
+
+// DriftResult is the outcome of checking one class of cluster Azure resources
+// for drift from its expected configuration.  If Drifted is true and Err is
+// nil, the drift was repaired.
+type DriftResult struct {
+	Resource string
+	Drifted  bool
+	Err      error
+}
+
+type driftCheck struct {
+	resource string
+	check    func(context.Context) (bool, error)
+	repair   func(context.Context) error
+}
+
+// ReconcileDrift verifies the critical Azure resources of a running cluster and
+// repairs any which no longer match what we deployed.  Unlike the other entry
+// points it runs outside of a customer operation without holding the lease, so
+// it must only make idempotent changes and must not patch the cluster
+// document.
+func (m *manager) ReconcileDrift(ctx context.Context) []DriftResult {
+	checks := []driftCheck{
+		{
+			resource: "dns",
+			check:    m.dnsDrifted,
+			repair:   m.repairDNS,
+		},
+		{
+			resource: "loadbalancer",
+			check:    m.loadBalancersDrifted,
+			repair:   m.repairLoadBalancers,
+		},
+		{
+			resource: "privateendpoint",
+			check:    m.privateEndpointDrifted,
+			repair:   m.repairPrivateEndpoint,
+		},
+	}
+
+	clusterSPObjectID, spErr := m.clusterSPObjectID(ctx)
+
+	checks = append(checks, driftCheck{
+		resource: "roleassignment",
+		check: func(ctx context.Context) (bool, error) {
+			if spErr != nil {
+				return false, spErr
+			}
+			return m.roleAssignmentDrifted(ctx, clusterSPObjectID)
+		},
+		repair: func(ctx context.Context) error {
+			return m.repairRoleAssignment(ctx, clusterSPObjectID)
+		},
+	})
+
+	// deny assignments are only deployed in production
+	if m.env.DeploymentMode() == deployment.Production {
+		checks = append(checks, driftCheck{
+			resource: "denyassignment",
+			check: func(ctx context.Context) (bool, error) {
+				if spErr != nil {
+					return false, spErr
+				}
+				return m.denyAssignmentDrifted(ctx, clusterSPObjectID)
+			},
+			repair: m.deploySnapshotUpgradeTemplate,
+		})
+	}
+
+	results := make([]DriftResult, 0, len(checks))
+	for _, c := range checks {
+		drifted, err := c.check(ctx)
+		if err == nil && drifted {
+			m.log.Warnf("%s drift detected, repairing", c.resource)
+			err = c.repair(ctx)
+		}
+		if err != nil {
+			m.log.Errorf("%s drift check: %s", c.resource, err)
+		}
+
+		results = append(results, DriftResult{
+			Resource: c.resource,
+			Drifted:  drifted,
+			Err:      err,
+		})
+	}
+
+	return results
+}
+
+func (m *manager) dnsDrifted(ctx context.Context) (bool, error) {
+	managedDomain, err := dns.ManagedDomain(m.env, m.doc.OpenShiftCluster.Properties.ClusterProfile.Domain)
+	if err != nil || managedDomain == "" ||
+		len(m.doc.OpenShiftCluster.Properties.IngressProfiles) == 0 {
+		return false, err
+	}
+
+	apiIP, routerIP, err := m.dns.Get(ctx, m.doc.OpenShiftCluster)
+	if err != nil {
+		return false, err
+	}
+
+	return apiIP != m.doc.OpenShiftCluster.Properties.APIServerProfile.IP ||
+		routerIP != m.doc.OpenShiftCluster.Properties.IngressProfiles[0].IP, nil
+}
+
+func (m *manager) repairDNS(ctx context.Context) error {
+	err := m.dns.Update(ctx, m.doc.OpenShiftCluster, m.doc.OpenShiftCluster.Properties.APIServerProfile.IP)
+	if err != nil {
+		return err
+	}
+
+	return m.dns.CreateOrUpdateRouter(ctx, m.doc.OpenShiftCluster, m.doc.OpenShiftCluster.Properties.IngressProfiles[0].IP)
+}
+
+// expectedLoadBalancers returns the load balancers as deployed at install time.
+// Only their rules and probes are used for drift detection: the cloud provider
+// adds its own rules at runtime, so the load balancers are never redeployed
+// wholesale.
+func (m *manager) expectedLoadBalancers() []*mgmtnetwork.LoadBalancer {
+	infraID := m.doc.OpenShiftCluster.Properties.InfraID
+
+	// the installconfig is used only to set the location
+	installConfig := &installconfig.InstallConfig{
+		Config: &types.InstallConfig{
+			Platform: types.Platform{
+				Azure: &aztypes.Platform{
+					Region: m.doc.OpenShiftCluster.Location,
+				},
+			},
+		},
+	}
+
+	var lbs []*mgmtnetwork.LoadBalancer
+	for _, r := range []*arm.Resource{
+		networkInternalLoadBalancer(infraID, m.doc.OpenShiftCluster, installConfig),
+		networkPublicLoadBalancer(infraID, m.doc.OpenShiftCluster, installConfig),
+	} {
+		lbs = append(lbs, r.Resource.(*mgmtnetwork.LoadBalancer))
+	}
+
+	return lbs
+}
+
+func (m *manager) loadBalancersDrifted(ctx context.Context) (bool, error) {
+	resourceGroup := stringutils.LastTokenByte(m.doc.OpenShiftCluster.Properties.ClusterProfile.ResourceGroupID, '/')
+
+	for _, expected := range m.expectedLoadBalancers() {
+		lb, err := m.loadBalancers.Get(ctx, resourceGroup, *expected.Name, "")
+		if err != nil {
+			return false, err
+		}
+
+		rules, probes := missingLoadBalancerConfig(&lb, expected)
+		if len(rules) > 0 || len(probes) > 0 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (m *manager) repairLoadBalancers(ctx context.Context) error {
+	resourceGroup := stringutils.LastTokenByte(m.doc.OpenShiftCluster.Properties.ClusterProfile.ResourceGroupID, '/')
+
+	for _, expected := range m.expectedLoadBalancers() {
+		lb, err := m.loadBalancers.Get(ctx, resourceGroup, *expected.Name, "")
+		if err != nil {
+			return err
+		}
+
+		rules, probes := missingLoadBalancerConfig(&lb, expected)
+		if len(rules) == 0 && len(probes) == 0 {
+			continue
+		}
+
+		if lb.LoadBalancerPropertiesFormat == nil {
+			lb.LoadBalancerPropertiesFormat = &mgmtnetwork.LoadBalancerPropertiesFormat{}
+		}
+
+		if lb.Probes == nil {
+			lb.Probes = &[]mgmtnetwork.Probe{}
+		}
+		for _, p := range probes {
+			m.log.Printf("restoring probe %s on load balancer %s", *p.Name, *lb.Name)
+			lb.Probes = replaceProbe(*lb.Probes, p)
+		}
+
+		if lb.LoadBalancingRules == nil {
+			lb.LoadBalancingRules = &[]mgmtnetwork.LoadBalancingRule{}
+		}
+		for _, r := range rules {
+			m.log.Printf("restoring rule %s on load balancer %s", *r.Name, *lb.Name)
+			r.FrontendIPConfiguration.ID = to.StringPtr(resolveLoadBalancerSubResourceID(*lb.ID, *r.FrontendIPConfiguration.ID))
+			r.BackendAddressPool.ID = to.StringPtr(resolveLoadBalancerSubResourceID(*lb.ID, *r.BackendAddressPool.ID))
+			r.Probe.ID = to.StringPtr(resolveLoadBalancerSubResourceID(*lb.ID, *r.Probe.ID))
+			lb.LoadBalancingRules = replaceRule(*lb.LoadBalancingRules, r)
+		}
+
+		err = m.loadBalancers.CreateOrUpdateAndWait(ctx, resourceGroup, *lb.Name, lb)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// missingLoadBalancerConfig returns the expected rules and probes which are
+// absent from lb or whose key settings differ.
+func missingLoadBalancerConfig(lb, expected *mgmtnetwork.LoadBalancer) (rules []mgmtnetwork.LoadBalancingRule, probes []mgmtnetwork.Probe) {
+	actualRules := map[string]mgmtnetwork.LoadBalancingRule{}
+	if lb.LoadBalancerPropertiesFormat != nil && lb.LoadBalancingRules != nil {
+		for _, r := range *lb.LoadBalancingRules {
+			actualRules[strings.ToLower(*r.Name)] = r
+		}
+	}
+
+	for _, r := range *expected.LoadBalancingRules {
+		actual, found := actualRules[strings.ToLower(*r.Name)]
+		if !found || actual.LoadBalancingRulePropertiesFormat == nil ||
+			actual.Protocol != r.Protocol ||
+			!reflect.DeepEqual(actual.FrontendPort, r.FrontendPort) ||
+			!reflect.DeepEqual(actual.BackendPort, r.BackendPort) {
+			rules = append(rules, r)
+		}
+	}
+
+	actualProbes := map[string]mgmtnetwork.Probe{}
+	if lb.LoadBalancerPropertiesFormat != nil && lb.Probes != nil {
+		for _, p := range *lb.Probes {
+			actualProbes[strings.ToLower(*p.Name)] = p
+		}
+	}
+
+	for _, p := range *expected.Probes {
+		actual, found := actualProbes[strings.ToLower(*p.Name)]
+		if !found || actual.ProbePropertiesFormat == nil ||
+			actual.Protocol != p.Protocol ||
+			!reflect.DeepEqual(actual.Port, p.Port) ||
+			!reflect.DeepEqual(actual.RequestPath, p.RequestPath) {
+			probes = append(probes, p)
+		}
+	}
+
+	return rules, probes
+}
+
+func replaceRule(rules []mgmtnetwork.LoadBalancingRule, rule mgmtnetwork.LoadBalancingRule) *[]mgmtnetwork.LoadBalancingRule {
+	for i, r := range rules {
+		if strings.EqualFold(*r.Name, *rule.Name) {
+			rules[i] = rule
+			return &rules
+		}
+	}
+
+	rules = append(rules, rule)
+	return &rules
+}
+
+func replaceProbe(probes []mgmtnetwork.Probe, probe mgmtnetwork.Probe) *[]mgmtnetwork.Probe {
+	for i, p := range probes {
+		if strings.EqualFold(*p.Name, *probe.Name) {
+			probes[i] = probe
+			return &probes
+		}
+	}
+
+	probes = append(probes, probe)
+	return &probes
+}
+
+var rxLoadBalancerSubResource = regexp.MustCompile(`^\[resourceId\('Microsoft\.Network/loadBalancers/([a-zA-Z]+)', '[^']+', '([^']+)'\)\]$`)
+
+// resolveLoadBalancerSubResourceID converts the ARM resourceId() expressions
+// used in our templates for load balancer child resources into concrete
+// resource IDs under lbID, so that they can be sent directly to the network
+// API.
+func resolveLoadBalancerSubResourceID(lbID, id string) string {
+	m := rxLoadBalancerSubResource.FindStringSubmatch(id)
+	if m == nil {
+		return id
+	}
+
+	return lbID + "/" + m[1] + "/" + m[2]
+}
+
+func (m *manager) roleAssignmentDrifted(ctx context.Context, clusterSPObjectID string) (bool, error) {
+	resourceGroup := stringutils.LastTokenByte(m.doc.OpenShiftCluster.Properties.ClusterProfile.ResourceGroupID, '/')
+
+	roleAssignments, err := m.roleAssignments.ListForResourceGroup(ctx, resourceGroup, "principalId eq '"+clusterSPObjectID+"'")
+	if err != nil {
+		return false, err
+	}
+
+	for _, ra := range roleAssignments {
+		if ra.RoleAssignmentPropertiesWithScope == nil ||
+			ra.RoleDefinitionID == nil || ra.Scope == nil {
+			continue
+		}
+
+		if strings.EqualFold(*ra.Scope, m.doc.OpenShiftCluster.Properties.ClusterProfile.ResourceGroupID) &&
+			strings.HasSuffix(strings.ToLower(*ra.RoleDefinitionID), "/"+rbac.RoleContributor) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func (m *manager) repairRoleAssignment(ctx context.Context, clusterSPObjectID string) error {
+	resourceGroup := stringutils.LastTokenByte(m.doc.OpenShiftCluster.Properties.ClusterProfile.ResourceGroupID, '/')
+
+	// the name matches the role assignment in the storage template, so that
+	// redeploying it cannot fail with RoleAssignmentExists
+	t := &arm.Template{
+		Schema:         "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+		ContentVersion: "1.0.0.0",
+		Resources:      []*arm.Resource{clusterSPRoleAssignment(clusterSPObjectID)},
+	}
+
+	return m.deployARMTemplate(ctx, resourceGroup, "role assignment", t, nil)
+}
+
+// clusterSPRoleAssignment returns the Contributor role assignment of the
+// cluster service principal over the cluster resource group
+func clusterSPRoleAssignment(clusterSPObjectID string) *arm.Resource {
+	return rbac.ResourceGroupRoleAssignmentWithName(
+		rbac.RoleContributor,
+		"'"+clusterSPObjectID+"'",
+		"guid(resourceGroup().id, 'SP / Contributor')",
+	)
+}
+
+func (m *manager) denyAssignmentDrifted(ctx context.Context, clusterSPObjectID string) (bool, error) {
+	resourceGroup := stringutils.LastTokenByte(m.doc.OpenShiftCluster.Properties.ClusterProfile.ResourceGroupID, '/')
+
+	denyAssignments, err := m.denyAssignmentsClient.ListForResourceGroup(ctx, resourceGroup, "")
+	if err != nil {
+		return false, err
+	}
+
+	expected := m.denyAssignments(clusterSPObjectID).Resource.(*mgmtauthorization.DenyAssignment)
+
+	for _, da := range denyAssignments {
+		if da.DenyAssignmentProperties == nil || da.Scope == nil ||
+			!strings.EqualFold(*da.Scope, m.doc.OpenShiftCluster.Properties.ClusterProfile.ResourceGroupID) ||
+			da.IsSystemProtected == nil || !*da.IsSystemProtected {
+			continue
+		}
+
+		return !denyAssignmentMatches(&da, expected), nil
+	}
+
+	return true, nil
+}
+
+// denyAssignmentMatches returns true if da excludes the same principals and
+// actions as expected.
+func denyAssignmentMatches(da, expected *mgmtauthorization.DenyAssignment) bool {
+	if da.Permissions == nil || len(*da.Permissions) != 1 ||
+		da.ExcludePrincipals == nil {
+		return false
+	}
+
+	var excluded []string
+	for _, p := range *da.ExcludePrincipals {
+		if p.ID != nil {
+			excluded = append(excluded, *p.ID)
+		}
+	}
+
+	var expectedExcluded []string
+	for _, p := range *expected.ExcludePrincipals {
+		expectedExcluded = append(expectedExcluded, *p.ID)
+	}
+
+	return stringSetsEqual(excluded, expectedExcluded) &&
+		(*da.Permissions)[0].Actions != nil &&
+		stringSetsEqual(*(*da.Permissions)[0].Actions, *(*expected.Permissions)[0].Actions) &&
+		(*da.Permissions)[0].NotActions != nil &&
+		stringSetsEqual(*(*da.Permissions)[0].NotActions, *(*expected.Permissions)[0].NotActions)
+}
+
+func stringSetsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	a = append([]string(nil), a...)
+	b = append([]string(nil), b...)
+	for i := range a {
+		a[i] = strings.ToLower(a[i])
+		b[i] = strings.ToLower(b[i])
+	}
+	sort.Strings(a)
+	sort.Strings(b)
+
+	return reflect.DeepEqual(a, b)
+}
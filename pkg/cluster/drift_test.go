@@ -0,0 +1,493 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"testing"
+
+	mgmtnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-07-01/network"
+	mgmtauthorization "github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2018-09-01-preview/authorization"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	mock_authorization "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/mgmt/authorization"
+	mock_network "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/mgmt/network"
+	mock_dns "github.com/Azure/ARO-RP/pkg/util/mocks/dns"
+	mock_env "github.com/Azure/ARO-RP/pkg/util/mocks/env"
+	"github.com/Azure/ARO-RP/pkg/util/rbac"
+)
+
+const (
+	driftTestResourceGroupID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/aro-cluster"
+	driftTestInfraID         = "infra"
+)
+
+func driftTestDoc(visibility api.Visibility) *api.OpenShiftClusterDocument {
+	return &api.OpenShiftClusterDocument{
+		OpenShiftCluster: &api.OpenShiftCluster{
+			ID:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName",
+			Location: "eastus",
+			Properties: api.OpenShiftClusterProperties{
+				InfraID: driftTestInfraID,
+				ClusterProfile: api.ClusterProfile{
+					Domain:          "cluster",
+					ResourceGroupID: driftTestResourceGroupID,
+				},
+				APIServerProfile: api.APIServerProfile{
+					Visibility: visibility,
+					IP:         "1.2.3.4",
+				},
+				IngressProfiles: []api.IngressProfile{
+					{
+						IP: "5.6.7.8",
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestDNSDrifted(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name        string
+		apiIP       string
+		routerIP    string
+		wantDrifted bool
+	}{
+		{
+			name:     "no drift",
+			apiIP:    "1.2.3.4",
+			routerIP: "5.6.7.8",
+		},
+		{
+			name:        "router record drifted",
+			apiIP:       "1.2.3.4",
+			routerIP:    "",
+			wantDrifted: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			doc := driftTestDoc(api.VisibilityPublic)
+
+			env := mock_env.NewMockInterface(controller)
+			env.EXPECT().Domain().AnyTimes().Return("location.aroapp.io")
+
+			dns := mock_dns.NewMockManager(controller)
+			dns.EXPECT().Get(ctx, doc.OpenShiftCluster).Return(tt.apiIP, tt.routerIP, nil)
+
+			m := &manager{
+				env: env,
+				doc: doc,
+				dns: dns,
+			}
+
+			drifted, err := m.dnsDrifted(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if drifted != tt.wantDrifted {
+				t.Error(drifted)
+			}
+		})
+	}
+}
+
+func TestLoadBalancersDrifted(t *testing.T) {
+	ctx := context.Background()
+
+	internalLBID := driftTestResourceGroupID + "/providers/Microsoft.Network/loadBalancers/" + driftTestInfraID + "-internal"
+	publicLBID := driftTestResourceGroupID + "/providers/Microsoft.Network/loadBalancers/" + driftTestInfraID
+
+	for _, tt := range []struct {
+		name        string
+		visibility  api.Visibility
+		mocks       func(*mock_network.MockLoadBalancersClient)
+		wantDrifted bool
+	}{
+		{
+			name:       "private cluster, no drift",
+			visibility: api.VisibilityPrivate,
+			mocks: func(loadBalancers *mock_network.MockLoadBalancersClient) {
+				loadBalancers.EXPECT().
+					Get(ctx, "aro-cluster", driftTestInfraID+"-internal", "").
+					Return(mgmtnetwork.LoadBalancer{
+						ID:   to.StringPtr(internalLBID),
+						Name: to.StringPtr(driftTestInfraID + "-internal"),
+						LoadBalancerPropertiesFormat: &mgmtnetwork.LoadBalancerPropertiesFormat{
+							LoadBalancingRules: &[]mgmtnetwork.LoadBalancingRule{
+								{
+									Name: to.StringPtr("api-internal-v4"),
+									LoadBalancingRulePropertiesFormat: &mgmtnetwork.LoadBalancingRulePropertiesFormat{
+										Protocol:     mgmtnetwork.TransportProtocolTCP,
+										FrontendPort: to.Int32Ptr(6443),
+										BackendPort:  to.Int32Ptr(6443),
+									},
+								},
+								{
+									Name: to.StringPtr("sint-v4"),
+									LoadBalancingRulePropertiesFormat: &mgmtnetwork.LoadBalancingRulePropertiesFormat{
+										Protocol:     mgmtnetwork.TransportProtocolTCP,
+										FrontendPort: to.Int32Ptr(22623),
+										BackendPort:  to.Int32Ptr(22623),
+									},
+								},
+								{
+									// added by the cloud provider; not ours
+									Name: to.StringPtr("a1234-TCP-443"),
+									LoadBalancingRulePropertiesFormat: &mgmtnetwork.LoadBalancingRulePropertiesFormat{
+										Protocol:     mgmtnetwork.TransportProtocolTCP,
+										FrontendPort: to.Int32Ptr(443),
+										BackendPort:  to.Int32Ptr(443),
+									},
+								},
+							},
+							Probes: &[]mgmtnetwork.Probe{
+								{
+									Name: to.StringPtr("api-internal-probe"),
+									ProbePropertiesFormat: &mgmtnetwork.ProbePropertiesFormat{
+										Protocol:    mgmtnetwork.ProbeProtocolHTTPS,
+										Port:        to.Int32Ptr(6443),
+										RequestPath: to.StringPtr("/readyz"),
+									},
+								},
+								{
+									Name: to.StringPtr("sint-probe"),
+									ProbePropertiesFormat: &mgmtnetwork.ProbePropertiesFormat{
+										Protocol:    mgmtnetwork.ProbeProtocolHTTPS,
+										Port:        to.Int32Ptr(22623),
+										RequestPath: to.StringPtr("/healthz"),
+									},
+								},
+							},
+						},
+					}, nil)
+
+				loadBalancers.EXPECT().
+					Get(ctx, "aro-cluster", driftTestInfraID, "").
+					Return(mgmtnetwork.LoadBalancer{
+						ID:                           to.StringPtr(publicLBID),
+						Name:                         to.StringPtr(driftTestInfraID),
+						LoadBalancerPropertiesFormat: &mgmtnetwork.LoadBalancerPropertiesFormat{},
+					}, nil)
+			},
+		},
+		{
+			name:       "public cluster, api rule deleted",
+			visibility: api.VisibilityPublic,
+			mocks: func(loadBalancers *mock_network.MockLoadBalancersClient) {
+				loadBalancers.EXPECT().
+					Get(ctx, "aro-cluster", driftTestInfraID+"-internal", "").
+					Return(mgmtnetwork.LoadBalancer{
+						ID:   to.StringPtr(internalLBID),
+						Name: to.StringPtr(driftTestInfraID + "-internal"),
+						LoadBalancerPropertiesFormat: &mgmtnetwork.LoadBalancerPropertiesFormat{
+							LoadBalancingRules: &[]mgmtnetwork.LoadBalancingRule{
+								{
+									Name: to.StringPtr("api-internal-v4"),
+									LoadBalancingRulePropertiesFormat: &mgmtnetwork.LoadBalancingRulePropertiesFormat{
+										Protocol:     mgmtnetwork.TransportProtocolTCP,
+										FrontendPort: to.Int32Ptr(6443),
+										BackendPort:  to.Int32Ptr(6443),
+									},
+								},
+								{
+									Name: to.StringPtr("sint-v4"),
+									LoadBalancingRulePropertiesFormat: &mgmtnetwork.LoadBalancingRulePropertiesFormat{
+										Protocol:     mgmtnetwork.TransportProtocolTCP,
+										FrontendPort: to.Int32Ptr(22623),
+										BackendPort:  to.Int32Ptr(22623),
+									},
+								},
+							},
+							Probes: &[]mgmtnetwork.Probe{
+								{
+									Name: to.StringPtr("api-internal-probe"),
+									ProbePropertiesFormat: &mgmtnetwork.ProbePropertiesFormat{
+										Protocol:    mgmtnetwork.ProbeProtocolHTTPS,
+										Port:        to.Int32Ptr(6443),
+										RequestPath: to.StringPtr("/readyz"),
+									},
+								},
+								{
+									Name: to.StringPtr("sint-probe"),
+									ProbePropertiesFormat: &mgmtnetwork.ProbePropertiesFormat{
+										Protocol:    mgmtnetwork.ProbeProtocolHTTPS,
+										Port:        to.Int32Ptr(22623),
+										RequestPath: to.StringPtr("/healthz"),
+									},
+								},
+							},
+						},
+					}, nil)
+
+				loadBalancers.EXPECT().
+					Get(ctx, "aro-cluster", driftTestInfraID, "").
+					Return(mgmtnetwork.LoadBalancer{
+						ID:   to.StringPtr(publicLBID),
+						Name: to.StringPtr(driftTestInfraID),
+						LoadBalancerPropertiesFormat: &mgmtnetwork.LoadBalancerPropertiesFormat{
+							Probes: &[]mgmtnetwork.Probe{
+								{
+									Name: to.StringPtr("api-internal-probe"),
+									ProbePropertiesFormat: &mgmtnetwork.ProbePropertiesFormat{
+										Protocol:    mgmtnetwork.ProbeProtocolHTTPS,
+										Port:        to.Int32Ptr(6443),
+										RequestPath: to.StringPtr("/readyz"),
+									},
+								},
+							},
+						},
+					}, nil)
+			},
+			wantDrifted: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			loadBalancers := mock_network.NewMockLoadBalancersClient(controller)
+			tt.mocks(loadBalancers)
+
+			m := &manager{
+				doc:           driftTestDoc(tt.visibility),
+				loadBalancers: loadBalancers,
+			}
+
+			drifted, err := m.loadBalancersDrifted(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if drifted != tt.wantDrifted {
+				t.Error(drifted)
+			}
+		})
+	}
+}
+
+func TestRepairLoadBalancers(t *testing.T) {
+	ctx := context.Background()
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	publicLBID := driftTestResourceGroupID + "/providers/Microsoft.Network/loadBalancers/" + driftTestInfraID
+
+	doc := driftTestDoc(api.VisibilityPublic)
+
+	m := &manager{
+		log: logrus.NewEntry(logrus.StandardLogger()),
+		doc: doc,
+	}
+
+	// the internal load balancer is intact
+	internal := m.expectedLoadBalancers()[0]
+	internal.ID = to.StringPtr(driftTestResourceGroupID + "/providers/Microsoft.Network/loadBalancers/" + driftTestInfraID + "-internal")
+
+	loadBalancers := mock_network.NewMockLoadBalancersClient(controller)
+	loadBalancers.EXPECT().
+		Get(ctx, "aro-cluster", driftTestInfraID+"-internal", "").
+		Return(*internal, nil)
+
+	loadBalancers.EXPECT().
+		Get(ctx, "aro-cluster", driftTestInfraID, "").
+		Return(mgmtnetwork.LoadBalancer{
+			ID:   to.StringPtr(publicLBID),
+			Name: to.StringPtr(driftTestInfraID),
+			LoadBalancerPropertiesFormat: &mgmtnetwork.LoadBalancerPropertiesFormat{
+				LoadBalancingRules: &[]mgmtnetwork.LoadBalancingRule{
+					{
+						Name: to.StringPtr("a1234-TCP-443"),
+						LoadBalancingRulePropertiesFormat: &mgmtnetwork.LoadBalancingRulePropertiesFormat{
+							Protocol:     mgmtnetwork.TransportProtocolTCP,
+							FrontendPort: to.Int32Ptr(443),
+							BackendPort:  to.Int32Ptr(443),
+						},
+					},
+				},
+			},
+		}, nil)
+
+	loadBalancers.EXPECT().
+		CreateOrUpdateAndWait(ctx, "aro-cluster", driftTestInfraID, gomock.Any()).
+		Do(func(ctx context.Context, resourceGroupName, loadBalancerName string, lb mgmtnetwork.LoadBalancer) {
+			if len(*lb.LoadBalancingRules) != 2 {
+				t.Fatal(len(*lb.LoadBalancingRules))
+			}
+
+			if *(*lb.LoadBalancingRules)[0].Name != "a1234-TCP-443" {
+				t.Error(*(*lb.LoadBalancingRules)[0].Name)
+			}
+
+			rule := (*lb.LoadBalancingRules)[1]
+			if *rule.Name != "api-internal-v4" {
+				t.Error(*rule.Name)
+			}
+			if *rule.FrontendIPConfiguration.ID != publicLBID+"/frontendIPConfigurations/public-lb-ip-v4" {
+				t.Error(*rule.FrontendIPConfiguration.ID)
+			}
+			if *rule.BackendAddressPool.ID != publicLBID+"/backendAddressPools/"+driftTestInfraID {
+				t.Error(*rule.BackendAddressPool.ID)
+			}
+			if *rule.Probe.ID != publicLBID+"/probes/api-internal-probe" {
+				t.Error(*rule.Probe.ID)
+			}
+
+			if len(*lb.Probes) != 1 || *(*lb.Probes)[0].Name != "api-internal-probe" {
+				t.Error(*lb.Probes)
+			}
+		})
+
+	m.loadBalancers = loadBalancers
+
+	err := m.repairLoadBalancers(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRoleAssignmentDrifted(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name            string
+		roleAssignments []mgmtauthorization.RoleAssignment
+		wantDrifted     bool
+	}{
+		{
+			name: "contributor role assignment present",
+			roleAssignments: []mgmtauthorization.RoleAssignment{
+				{
+					RoleAssignmentPropertiesWithScope: &mgmtauthorization.RoleAssignmentPropertiesWithScope{
+						Scope:            to.StringPtr(driftTestResourceGroupID),
+						RoleDefinitionID: to.StringPtr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleDefinitions/" + rbac.RoleContributor),
+					},
+				},
+			},
+		},
+		{
+			name: "only inherited role assignment present",
+			roleAssignments: []mgmtauthorization.RoleAssignment{
+				{
+					RoleAssignmentPropertiesWithScope: &mgmtauthorization.RoleAssignmentPropertiesWithScope{
+						Scope:            to.StringPtr("/subscriptions/00000000-0000-0000-0000-000000000000"),
+						RoleDefinitionID: to.StringPtr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleDefinitions/" + rbac.RoleContributor),
+					},
+				},
+			},
+			wantDrifted: true,
+		},
+		{
+			name:        "role assignment deleted",
+			wantDrifted: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			roleAssignments := mock_authorization.NewMockRoleAssignmentsClient(controller)
+			roleAssignments.EXPECT().
+				ListForResourceGroup(ctx, "aro-cluster", "principalId eq 'clusterSPObjectID'").
+				Return(tt.roleAssignments, nil)
+
+			m := &manager{
+				doc:             driftTestDoc(api.VisibilityPublic),
+				roleAssignments: roleAssignments,
+			}
+
+			drifted, err := m.roleAssignmentDrifted(ctx, "clusterSPObjectID")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if drifted != tt.wantDrifted {
+				t.Error(drifted)
+			}
+		})
+	}
+}
+
+func TestDenyAssignmentDrifted(t *testing.T) {
+	ctx := context.Background()
+
+	subscriptionDoc := &api.SubscriptionDocument{
+		Subscription: &api.Subscription{
+			Properties: &api.SubscriptionProperties{},
+		},
+	}
+
+	for _, tt := range []struct {
+		name        string
+		mutate      func(*mgmtauthorization.DenyAssignment)
+		wantDrifted bool
+	}{
+		{
+			name: "deny assignment matches",
+		},
+		{
+			name: "deny assignment missing exclusion",
+			mutate: func(da *mgmtauthorization.DenyAssignment) {
+				notActions := (*(*da.Permissions)[0].NotActions)[1:]
+				(*da.Permissions)[0].NotActions = &notActions
+			},
+			wantDrifted: true,
+		},
+		{
+			name: "deny assignment excludes a different principal",
+			mutate: func(da *mgmtauthorization.DenyAssignment) {
+				(*da.ExcludePrincipals)[0].ID = to.StringPtr("someoneElse")
+			},
+			wantDrifted: true,
+		},
+		{
+			name: "deny assignment deleted",
+			mutate: func(da *mgmtauthorization.DenyAssignment) {
+				da.Scope = to.StringPtr("/subscriptions/00000000-0000-0000-0000-000000000000")
+			},
+			wantDrifted: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			m := &manager{
+				doc:             driftTestDoc(api.VisibilityPublic),
+				subscriptionDoc: subscriptionDoc,
+			}
+
+			da := m.denyAssignments("clusterSPObjectID").Resource.(*mgmtauthorization.DenyAssignment)
+			if tt.mutate != nil {
+				tt.mutate(da)
+			}
+
+			denyAssignments := mock_authorization.NewMockDenyAssignmentsClient(controller)
+			denyAssignments.EXPECT().
+				ListForResourceGroup(ctx, "aro-cluster", "").
+				Return([]mgmtauthorization.DenyAssignment{*da}, nil)
+
+			m.denyAssignmentsClient = denyAssignments
+
+			drifted, err := m.denyAssignmentDrifted(ctx, "clusterSPObjectID")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if drifted != tt.wantDrifted {
+				t.Error(drifted)
+			}
+		})
+	}
+}
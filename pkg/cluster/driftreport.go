@@ -0,0 +1,434 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	mgmtnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-07-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/authorization"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/network"
+	"github.com/Azure/ARO-RP/pkg/util/deployment"
+	"github.com/Azure/ARO-RP/pkg/util/dns"
+	"github.com/Azure/ARO-RP/pkg/util/rbac"
+	"github.com/Azure/ARO-RP/pkg/util/stringutils"
+)
+
+// masterCount is the number of master replicas in the install config
+const masterCount = 3
+
+// DriftReport lists how the live Azure resources of a cluster differ from
+// the RP's expected model of them
+type DriftReport struct {
+	Resources []ResourceDrift `json:"resources"`
+}
+
+// ResourceDrift is the outcome of comparing one class of cluster Azure
+// resources with its expected configuration
+type ResourceDrift struct {
+	Resource    string            `json:"resource"`
+	Drifted     bool              `json:"drifted"`
+	Differences []DriftDifference `json:"differences,omitempty"`
+	Error       string            `json:"error,omitempty"`
+}
+
+// DriftDifference is a single property of a resource whose live value
+// differs from the expected one.  An empty Actual means that the property, or
+// the whole resource if Property is empty, is missing.
+type DriftDifference struct {
+	Name     string `json:"name"`
+	Property string `json:"property,omitempty"`
+	Expected string `json:"expected"`
+	Actual   string `json:"actual,omitempty"`
+}
+
+// DriftReporter reports the drift of the Azure resources of a running
+// cluster.  It is the read-only counterpart of ReconcileDrift: it never
+// changes any resource.
+type DriftReporter interface {
+	DriftReport(ctx context.Context) (*DriftReport, error)
+}
+
+// NewDriftReporter returns a DriftReporter.  Unlike NewManager it needs no
+// database, cipher or billing, none of which the report uses.
+func NewDriftReporter(ctx context.Context, log *logrus.Entry, _env env.Interface, oc *api.OpenShiftCluster, subscriptionDoc *api.SubscriptionDocument) (DriftReporter, error) {
+	r, err := azure.ParseResourceID(oc.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	localFPAuthorizer, err := _env.FPAuthorizer(_env.TenantID(), _env.Environment().ResourceManagerEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	fpAuthorizer, err := _env.FPAuthorizer(oc.Properties.ServicePrincipalProfile.TenantID, _env.Environment().ResourceManagerEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return &manager{
+		log:             log,
+		env:             _env,
+		doc:             &api.OpenShiftClusterDocument{OpenShiftCluster: oc},
+		subscriptionDoc: subscriptionDoc,
+
+		interfaces:            network.NewInterfacesClient(r.SubscriptionID, fpAuthorizer),
+		loadBalancers:         network.NewLoadBalancersClient(r.SubscriptionID, fpAuthorizer),
+		roleAssignments:       authorization.NewRoleAssignmentsClient(r.SubscriptionID, fpAuthorizer),
+		denyAssignmentsClient: authorization.NewDenyAssignmentsClient(r.SubscriptionID, fpAuthorizer),
+
+		dns: dns.NewManager(_env, localFPAuthorizer),
+	}, nil
+}
+
+type driftReportCheck struct {
+	resource string
+	diff     func(context.Context) ([]DriftDifference, error)
+}
+
+// DriftReport renders the expected DNS records, load balancers, master NICs
+// and role and deny assignments of the cluster and returns their differences
+// from the live resources.  A failure to compare one class of resources is
+// recorded in the report rather than returned.
+func (m *manager) DriftReport(ctx context.Context) (*DriftReport, error) {
+	checks := []driftReportCheck{
+		{
+			resource: "dns",
+			diff:     m.dnsDifferences,
+		},
+		{
+			resource: "loadbalancer",
+			diff:     m.loadBalancerDifferences,
+		},
+		{
+			resource: "networkinterface",
+			diff:     m.masterNICDifferences,
+		},
+	}
+
+	clusterSPObjectID, spErr := m.clusterSPObjectID(ctx)
+
+	checks = append(checks, driftReportCheck{
+		resource: "roleassignment",
+		diff: func(ctx context.Context) ([]DriftDifference, error) {
+			if spErr != nil {
+				return nil, spErr
+			}
+			return m.roleAssignmentDifferences(ctx, clusterSPObjectID)
+		},
+	})
+
+	// deny assignments are only deployed in production
+	if m.env.DeploymentMode() == deployment.Production {
+		checks = append(checks, driftReportCheck{
+			resource: "denyassignment",
+			diff: func(ctx context.Context) ([]DriftDifference, error) {
+				if spErr != nil {
+					return nil, spErr
+				}
+				return m.denyAssignmentDifferences(ctx, clusterSPObjectID)
+			},
+		})
+	}
+
+	report := &DriftReport{
+		Resources: make([]ResourceDrift, 0, len(checks)),
+	}
+
+	for _, c := range checks {
+		rd := ResourceDrift{
+			Resource: c.resource,
+		}
+
+		differences, err := c.diff(ctx)
+		if err != nil {
+			m.log.Errorf("%s drift report: %s", c.resource, err)
+			rd.Error = err.Error()
+		}
+
+		rd.Differences = differences
+		rd.Drifted = len(differences) > 0
+
+		report.Resources = append(report.Resources, rd)
+	}
+
+	return report, nil
+}
+
+func (m *manager) dnsDifferences(ctx context.Context) ([]DriftDifference, error) {
+	managedDomain, err := dns.ManagedDomain(m.env, m.doc.OpenShiftCluster.Properties.ClusterProfile.Domain)
+	if err != nil || managedDomain == "" ||
+		len(m.doc.OpenShiftCluster.Properties.IngressProfiles) == 0 {
+		return nil, err
+	}
+
+	apiIP, routerIP, err := m.dns.Get(ctx, m.doc.OpenShiftCluster)
+	if err != nil {
+		return nil, err
+	}
+
+	var differences []DriftDifference
+
+	if apiIP != m.doc.OpenShiftCluster.Properties.APIServerProfile.IP {
+		differences = append(differences, DriftDifference{
+			Name:     "api." + managedDomain,
+			Property: "ipAddress",
+			Expected: m.doc.OpenShiftCluster.Properties.APIServerProfile.IP,
+			Actual:   apiIP,
+		})
+	}
+
+	if routerIP != m.doc.OpenShiftCluster.Properties.IngressProfiles[0].IP {
+		differences = append(differences, DriftDifference{
+			Name:     "*.apps." + managedDomain,
+			Property: "ipAddress",
+			Expected: m.doc.OpenShiftCluster.Properties.IngressProfiles[0].IP,
+			Actual:   routerIP,
+		})
+	}
+
+	return differences, nil
+}
+
+func (m *manager) loadBalancerDifferences(ctx context.Context) ([]DriftDifference, error) {
+	resourceGroup := stringutils.LastTokenByte(m.doc.OpenShiftCluster.Properties.ClusterProfile.ResourceGroupID, '/')
+
+	var differences []DriftDifference
+	for _, expected := range m.expectedLoadBalancers() {
+		lb, err := m.loadBalancers.Get(ctx, resourceGroup, *expected.Name, "")
+		if isNotFound(err) {
+			differences = append(differences, DriftDifference{
+				Name:     *expected.Name,
+				Expected: "present",
+			})
+			continue
+		}
+		if err != nil {
+			return differences, err
+		}
+
+		rules, probes := missingLoadBalancerConfig(&lb, expected)
+
+		for _, r := range rules {
+			d := DriftDifference{
+				Name:     *lb.Name,
+				Property: "loadBalancingRules/" + *r.Name,
+				Expected: describeLoadBalancingRule(&r),
+			}
+			if actual := findLoadBalancingRule(&lb, *r.Name); actual != nil {
+				d.Actual = describeLoadBalancingRule(actual)
+			}
+			differences = append(differences, d)
+		}
+
+		for _, p := range probes {
+			d := DriftDifference{
+				Name:     *lb.Name,
+				Property: "probes/" + *p.Name,
+				Expected: describeProbe(&p),
+			}
+			if actual := findProbe(&lb, *p.Name); actual != nil {
+				d.Actual = describeProbe(actual)
+			}
+			differences = append(differences, d)
+		}
+	}
+
+	return differences, nil
+}
+
+func findLoadBalancingRule(lb *mgmtnetwork.LoadBalancer, name string) *mgmtnetwork.LoadBalancingRule {
+	if lb.LoadBalancerPropertiesFormat == nil || lb.LoadBalancingRules == nil {
+		return nil
+	}
+
+	for _, r := range *lb.LoadBalancingRules {
+		if strings.EqualFold(*r.Name, name) {
+			return &r
+		}
+	}
+
+	return nil
+}
+
+func findProbe(lb *mgmtnetwork.LoadBalancer, name string) *mgmtnetwork.Probe {
+	if lb.LoadBalancerPropertiesFormat == nil || lb.Probes == nil {
+		return nil
+	}
+
+	for _, p := range *lb.Probes {
+		if strings.EqualFold(*p.Name, name) {
+			return &p
+		}
+	}
+
+	return nil
+}
+
+// describeLoadBalancingRule returns the settings of r which
+// missingLoadBalancerConfig compares, e.g. "Tcp 6443:6443"
+func describeLoadBalancingRule(r *mgmtnetwork.LoadBalancingRule) string {
+	if r.LoadBalancingRulePropertiesFormat == nil {
+		return "<no properties>"
+	}
+
+	return fmt.Sprintf("%s %s:%s", r.Protocol, int32PtrString(r.FrontendPort), int32PtrString(r.BackendPort))
+}
+
+// describeProbe returns the settings of p which missingLoadBalancerConfig
+// compares, e.g. "Https 6443 /readyz"
+func describeProbe(p *mgmtnetwork.Probe) string {
+	if p.ProbePropertiesFormat == nil {
+		return "<no properties>"
+	}
+
+	s := fmt.Sprintf("%s %s", p.Protocol, int32PtrString(p.Port))
+	if p.RequestPath != nil {
+		s += " " + *p.RequestPath
+	}
+
+	return s
+}
+
+func int32PtrString(i *int32) string {
+	if i == nil {
+		return "<nil>"
+	}
+	return fmt.Sprint(*i)
+}
+
+// masterNICDifferences checks that each master NIC is still in the master
+// subnet and in the backend pools of both load balancers, without which the
+// API server and machine config server are unreachable
+func (m *manager) masterNICDifferences(ctx context.Context) ([]DriftDifference, error) {
+	infraID := m.doc.OpenShiftCluster.Properties.InfraID
+	resourceGroupID := m.doc.OpenShiftCluster.Properties.ClusterProfile.ResourceGroupID
+	resourceGroup := stringutils.LastTokenByte(resourceGroupID, '/')
+	subnetID := m.doc.OpenShiftCluster.Properties.MasterProfile.SubnetID
+
+	expectedPools := []string{
+		fmt.Sprintf("%s/providers/Microsoft.Network/loadBalancers/%s/backendAddressPools/%[2]s", resourceGroupID, infraID),
+		fmt.Sprintf("%s/providers/Microsoft.Network/loadBalancers/%s-internal/backendAddressPools/%[2]s", resourceGroupID, infraID),
+	}
+
+	var differences []DriftDifference
+	for i := 0; i < masterCount; i++ {
+		name := fmt.Sprintf("%s-master%d-nic", infraID, i)
+
+		nic, err := m.interfaces.Get(ctx, resourceGroup, name, "")
+		if isNotFound(err) {
+			differences = append(differences, DriftDifference{
+				Name:     name,
+				Expected: "present",
+			})
+			continue
+		}
+		if err != nil {
+			return differences, err
+		}
+
+		var ipc *mgmtnetwork.InterfaceIPConfiguration
+		if nic.InterfacePropertiesFormat != nil && nic.IPConfigurations != nil &&
+			len(*nic.IPConfigurations) > 0 &&
+			(*nic.IPConfigurations)[0].InterfaceIPConfigurationPropertiesFormat != nil {
+			ipc = &(*nic.IPConfigurations)[0]
+		}
+
+		if ipc == nil {
+			differences = append(differences, DriftDifference{
+				Name:     name,
+				Property: "ipConfigurations",
+				Expected: "present",
+			})
+			continue
+		}
+
+		if ipc.Subnet == nil || ipc.Subnet.ID == nil || !strings.EqualFold(*ipc.Subnet.ID, subnetID) {
+			d := DriftDifference{
+				Name:     name,
+				Property: "subnet",
+				Expected: subnetID,
+			}
+			if ipc.Subnet != nil && ipc.Subnet.ID != nil {
+				d.Actual = *ipc.Subnet.ID
+			}
+			differences = append(differences, d)
+		}
+
+		var pools []string
+		if ipc.LoadBalancerBackendAddressPools != nil {
+			for _, p := range *ipc.LoadBalancerBackendAddressPools {
+				if p.ID != nil {
+					pools = append(pools, *p.ID)
+				}
+			}
+		}
+
+		for _, expected := range expectedPools {
+			if !containsFold(pools, expected) {
+				differences = append(differences, DriftDifference{
+					Name:     name,
+					Property: "loadBalancerBackendAddressPools",
+					Expected: expected,
+				})
+			}
+		}
+	}
+
+	return differences, nil
+}
+
+func containsFold(haystack []string, needle string) bool {
+	for _, s := range haystack {
+		if strings.EqualFold(s, needle) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *manager) roleAssignmentDifferences(ctx context.Context, clusterSPObjectID string) ([]DriftDifference, error) {
+	drifted, err := m.roleAssignmentDrifted(ctx, clusterSPObjectID)
+	if err != nil || !drifted {
+		return nil, err
+	}
+
+	return []DriftDifference{
+		{
+			Name:     clusterSPObjectID,
+			Property: "roleDefinitionId",
+			Expected: "/providers/Microsoft.Authorization/roleDefinitions/" + rbac.RoleContributor,
+		},
+	}, nil
+}
+
+func (m *manager) denyAssignmentDifferences(ctx context.Context, clusterSPObjectID string) ([]DriftDifference, error) {
+	drifted, err := m.denyAssignmentDrifted(ctx, clusterSPObjectID)
+	if err != nil || !drifted {
+		return nil, err
+	}
+
+	return []DriftDifference{
+		{
+			Name:     m.doc.OpenShiftCluster.Properties.ClusterProfile.ResourceGroupID,
+			Property: "denyAssignments",
+			Expected: "system protected deny assignment excluding the cluster service principal",
+		},
+	}, nil
+}
+
+func isNotFound(err error) bool {
+	detailedErr, ok := err.(autorest.DetailedError)
+	return ok && detailedErr.StatusCode == http.StatusNotFound
+}
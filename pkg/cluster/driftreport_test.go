@@ -0,0 +1,178 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	mgmtnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-07-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/golang/mock/gomock"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	mock_network "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/mgmt/network"
+	mock_dns "github.com/Azure/ARO-RP/pkg/util/mocks/dns"
+	mock_env "github.com/Azure/ARO-RP/pkg/util/mocks/env"
+)
+
+func TestDNSDifferences(t *testing.T) {
+	ctx := context.Background()
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	doc := driftTestDoc(api.VisibilityPublic)
+
+	env := mock_env.NewMockInterface(controller)
+	env.EXPECT().Domain().AnyTimes().Return("location.aroapp.io")
+
+	dns := mock_dns.NewMockManager(controller)
+	dns.EXPECT().Get(ctx, doc.OpenShiftCluster).Return("1.2.3.4", "9.9.9.9", nil)
+
+	m := &manager{
+		env: env,
+		doc: doc,
+		dns: dns,
+	}
+
+	differences, err := m.dnsDifferences(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []DriftDifference{
+		{
+			Name:     "*.apps.cluster.location.aroapp.io",
+			Property: "ipAddress",
+			Expected: "5.6.7.8",
+			Actual:   "9.9.9.9",
+		},
+	}
+	if !reflect.DeepEqual(differences, want) {
+		t.Error(differences)
+	}
+}
+
+func TestLoadBalancerDifferences(t *testing.T) {
+	ctx := context.Background()
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	m := &manager{
+		doc: driftTestDoc(api.VisibilityPrivate),
+	}
+
+	// the internal load balancer has its api probe path changed
+	internal := m.expectedLoadBalancers()[0]
+	(*internal.Probes)[0].RequestPath = to.StringPtr("/healthz")
+
+	loadBalancers := mock_network.NewMockLoadBalancersClient(controller)
+	loadBalancers.EXPECT().
+		Get(ctx, "aro-cluster", driftTestInfraID+"-internal", "").
+		Return(*internal, nil)
+	loadBalancers.EXPECT().
+		Get(ctx, "aro-cluster", driftTestInfraID, "").
+		Return(mgmtnetwork.LoadBalancer{}, autorest.DetailedError{StatusCode: http.StatusNotFound})
+
+	m.loadBalancers = loadBalancers
+
+	differences, err := m.loadBalancerDifferences(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []DriftDifference{
+		{
+			Name:     driftTestInfraID + "-internal",
+			Property: "probes/api-internal-probe",
+			Expected: "Https 6443 /readyz",
+			Actual:   "Https 6443 /healthz",
+		},
+		{
+			Name:     driftTestInfraID,
+			Expected: "present",
+		},
+	}
+	if !reflect.DeepEqual(differences, want) {
+		t.Error(differences)
+	}
+}
+
+func TestMasterNICDifferences(t *testing.T) {
+	ctx := context.Background()
+
+	masterSubnetID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/vnet/providers/Microsoft.Network/virtualNetworks/vnet/subnets/master"
+	publicPoolID := driftTestResourceGroupID + "/providers/Microsoft.Network/loadBalancers/" + driftTestInfraID + "/backendAddressPools/" + driftTestInfraID
+	internalPoolID := driftTestResourceGroupID + "/providers/Microsoft.Network/loadBalancers/" + driftTestInfraID + "-internal/backendAddressPools/" + driftTestInfraID
+
+	nic := func(subnetID string, poolIDs ...string) mgmtnetwork.Interface {
+		var pools []mgmtnetwork.BackendAddressPool
+		for _, id := range poolIDs {
+			pools = append(pools, mgmtnetwork.BackendAddressPool{ID: to.StringPtr(id)})
+		}
+
+		return mgmtnetwork.Interface{
+			InterfacePropertiesFormat: &mgmtnetwork.InterfacePropertiesFormat{
+				IPConfigurations: &[]mgmtnetwork.InterfaceIPConfiguration{
+					{
+						InterfaceIPConfigurationPropertiesFormat: &mgmtnetwork.InterfaceIPConfigurationPropertiesFormat{
+							Subnet: &mgmtnetwork.Subnet{
+								ID: to.StringPtr(subnetID),
+							},
+							LoadBalancerBackendAddressPools: &pools,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	interfaces := mock_network.NewMockInterfacesClient(controller)
+	interfaces.EXPECT().
+		Get(ctx, "aro-cluster", driftTestInfraID+"-master0-nic", "").
+		Return(nic(masterSubnetID, publicPoolID, internalPoolID), nil)
+	interfaces.EXPECT().
+		Get(ctx, "aro-cluster", driftTestInfraID+"-master1-nic", "").
+		Return(nic(masterSubnetID, publicPoolID), nil)
+	interfaces.EXPECT().
+		Get(ctx, "aro-cluster", driftTestInfraID+"-master2-nic", "").
+		Return(mgmtnetwork.Interface{}, autorest.DetailedError{StatusCode: http.StatusNotFound})
+
+	doc := driftTestDoc(api.VisibilityPublic)
+	doc.OpenShiftCluster.Properties.MasterProfile.SubnetID = masterSubnetID
+
+	m := &manager{
+		doc:        doc,
+		interfaces: interfaces,
+	}
+
+	differences, err := m.masterNICDifferences(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []DriftDifference{
+		{
+			Name:     fmt.Sprintf("%s-master1-nic", driftTestInfraID),
+			Property: "loadBalancerBackendAddressPools",
+			Expected: internalPoolID,
+		},
+		{
+			Name:     fmt.Sprintf("%s-master2-nic", driftTestInfraID),
+			Expected: "present",
+		},
+	}
+	if !reflect.DeepEqual(differences, want) {
+		t.Error(differences)
+	}
+}
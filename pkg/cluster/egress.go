@@ -0,0 +1,60 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+// egressReachable waits for the master ARO operator to check the required
+// egress endpoints (the internet checker URLs) from inside the cluster's
+// network, and fails the install with the blocked endpoints if any are
+// blocked.  The RP cannot send traffic through the customer's firewall or
+// proxy itself, so this is the earliest point at which egress can be tested;
+// it saves waiting for the cluster operators to time out instead.
+func (m *manager) egressReachable(ctx context.Context) (bool, error) {
+	cluster, err := m.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return false, nil
+	}
+
+	cond := cluster.Status.Conditions.GetCondition(arov1alpha1.InternetReachableFromMaster)
+	if cond == nil {
+		return false, nil
+	}
+
+	if cond.Status == corev1.ConditionTrue {
+		return true, nil
+	}
+
+	return false, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeDeploymentFailed, "",
+		"The cluster cannot reach the following required endpoints through the egress configuration of its virtual network: %s. Allow egress to these endpoints in the firewall or proxy and try again.",
+		strings.Join(blockedEndpoints(cond.Message), ", "))
+}
+
+// blockedEndpoints returns the URLs from the message of a failed internet
+// checker condition, which has a line "<url>: <error>" for each blocked URL
+func blockedEndpoints(message string) []string {
+	var urls []string
+
+	for _, line := range strings.Split(message, "\n") {
+		url := strings.SplitN(line, ": ", 2)[0]
+		if url != "" {
+			urls = append(urls, url)
+		}
+	}
+
+	sort.Strings(urls)
+
+	return urls
+}
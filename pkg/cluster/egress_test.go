@@ -0,0 +1,74 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+)
+
+func TestEgressReachable(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name       string
+		conditions status.Conditions
+		want       bool
+		wantErr    string
+	}{
+		{
+			name: "not checked yet",
+		},
+		{
+			name: "reachable",
+			conditions: status.Conditions{
+				{
+					Type:   arov1alpha1.InternetReachableFromMaster,
+					Status: corev1.ConditionTrue,
+				},
+			},
+			want: true,
+		},
+		{
+			name: "blocked",
+			conditions: status.Conditions{
+				{
+					Type:    arov1alpha1.InternetReachableFromMaster,
+					Status:  corev1.ConditionFalse,
+					Message: "https://management.azure.com/: Head \"https://management.azure.com/\": context deadline exceeded\nhttps://arosvc.azurecr.io/: Head \"https://arosvc.azurecr.io/\": EOF\n",
+				},
+			},
+			wantErr: "400: DeploymentFailed: : The cluster cannot reach the following required endpoints through the egress configuration of its virtual network: https://arosvc.azurecr.io/, https://management.azure.com/. Allow egress to these endpoints in the firewall or proxy and try again.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &manager{
+				arocli: arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: arov1alpha1.SingletonClusterName,
+					},
+					Status: arov1alpha1.ClusterStatus{
+						Conditions: tt.conditions,
+					},
+				}).AroV1alpha1(),
+			}
+
+			ok, err := m.egressReachable(ctx)
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Error(err)
+			}
+			if ok != tt.want {
+				t.Error(ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/Azure/ARO-RP/pkg/util/stringutils"
+)
+
+// The functions below are invariant checks for steps.Hooked.  They return an
+// error describing the broken invariant, which the step runner reports
+// together with the step it belongs to.
+
+// clusterDocumentSane checks the fields of the cluster document which the
+// steps after the storage template name Azure resources from: without them,
+// the steps would address resources such as "-pip-v4" in a resource group "".
+func (m *manager) clusterDocumentSane(ctx context.Context) error {
+	if m.doc.OpenShiftCluster.Properties.InfraID == "" {
+		return fmt.Errorf("the cluster document has no infraID")
+	}
+
+	if stringutils.LastTokenByte(m.doc.OpenShiftCluster.Properties.ClusterProfile.ResourceGroupID, '/') == "" {
+		return fmt.Errorf("the cluster document has no cluster resource group")
+	}
+
+	return nil
+}
+
+// fpCredentialsValid checks that a token can be obtained for the first party
+// service principal in the customer's tenant, so that an AAD failure is
+// reported as such rather than as an authorization error of the first ARM
+// call.  Transient failures are retried for a short while.
+func (m *manager) fpCredentialsValid(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+
+	err := wait.PollImmediateUntil(10*time.Second, func() (bool, error) {
+		return m.fpAuthorizer.RefreshWithContext(ctx, m.log)
+	}, timeoutCtx.Done())
+	if err != nil {
+		return fmt.Errorf("could not get a token for the first party service principal in tenant %s: %s", m.subscriptionDoc.Subscription.Properties.TenantID, err)
+	}
+
+	return nil
+}
+
+// publicLoadBalancerExists checks that the public load balancer, through
+// which all outbound connections of the cluster go, exists
+func (m *manager) publicLoadBalancerExists(ctx context.Context) error {
+	infraID := m.doc.OpenShiftCluster.Properties.InfraID
+	resourceGroup := stringutils.LastTokenByte(m.doc.OpenShiftCluster.Properties.ClusterProfile.ResourceGroupID, '/')
+
+	_, err := m.loadBalancers.Get(ctx, resourceGroup, infraID, "")
+	if err != nil {
+		return fmt.Errorf("the public load balancer %s in resource group %s was not found: %s", infraID, resourceGroup, err)
+	}
+
+	return nil
+}
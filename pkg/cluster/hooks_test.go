@@ -0,0 +1,109 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	mgmtnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-07-01/network"
+	"github.com/golang/mock/gomock"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	mock_network "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/mgmt/network"
+)
+
+func TestClusterDocumentSane(t *testing.T) {
+	for _, tt := range []struct {
+		name            string
+		infraID         string
+		resourceGroupID string
+		wantErr         string
+	}{
+		{
+			name:            "sane",
+			infraID:         "infra",
+			resourceGroupID: "/subscriptions/subscriptionId/resourceGroups/clusterResourceGroup",
+		},
+		{
+			name:            "no infraID",
+			resourceGroupID: "/subscriptions/subscriptionId/resourceGroups/clusterResourceGroup",
+			wantErr:         "the cluster document has no infraID",
+		},
+		{
+			name:    "no resource group",
+			infraID: "infra",
+			wantErr: "the cluster document has no cluster resource group",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &manager{
+				doc: &api.OpenShiftClusterDocument{
+					OpenShiftCluster: &api.OpenShiftCluster{
+						Properties: api.OpenShiftClusterProperties{
+							InfraID: tt.infraID,
+							ClusterProfile: api.ClusterProfile{
+								ResourceGroupID: tt.resourceGroupID,
+							},
+						},
+					},
+				},
+			}
+
+			err := m.clusterDocumentSane(context.Background())
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestPublicLoadBalancerExists(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		err     error
+		wantErr string
+	}{
+		{
+			name: "exists",
+		},
+		{
+			name:    "not found",
+			err:     fmt.Errorf("random error"),
+			wantErr: "the public load balancer infra in resource group clusterResourceGroup was not found: random error",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			loadBalancers := mock_network.NewMockLoadBalancersClient(controller)
+			loadBalancers.EXPECT().
+				Get(gomock.Any(), "clusterResourceGroup", "infra", "").
+				Return(mgmtnetwork.LoadBalancer{}, tt.err)
+
+			m := &manager{
+				doc: &api.OpenShiftClusterDocument{
+					OpenShiftCluster: &api.OpenShiftCluster{
+						Properties: api.OpenShiftClusterProperties{
+							InfraID: "infra",
+							ClusterProfile: api.ClusterProfile{
+								ResourceGroupID: "/subscriptions/subscriptionId/resourceGroups/clusterResourceGroup",
+							},
+						},
+					},
+				},
+				loadBalancers: loadBalancers,
+			}
+
+			err := m.publicLoadBalancerExists(context.Background())
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Error(err)
+			}
+		})
+	}
+}
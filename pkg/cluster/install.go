@@ -6,6 +6,7 @@ package cluster
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	configclient "github.com/openshift/client-go/config/clientset/versioned"
@@ -15,6 +16,8 @@ import (
 	"github.com/openshift/installer/pkg/asset/bootstraplogging"
 	"github.com/openshift/installer/pkg/asset/installconfig"
 	"github.com/openshift/installer/pkg/asset/releaseimage"
+	maoclient "github.com/openshift/machine-api-operator/pkg/generated/clientset/versioned"
+	mcoclient "github.com/openshift/machine-config-operator/pkg/generated/clientset/versioned"
 	extensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	"k8s.io/client-go/kubernetes"
 
@@ -28,20 +31,57 @@ import (
 // AdminUpgrade performs an admin upgrade of an ARO cluster
 func (m *manager) AdminUpgrade(ctx context.Context) error {
 	steps := []steps.Step{
-		steps.Action(m.initializeKubernetesClients), // must be first
+		steps.Action(m.ensurePrivateEndpointConnection), // must be first: the kubernetes clients dial the private endpoint
+		steps.Action(m.initializeKubernetesClients),
+		steps.Action(m.createSnapshot),
 		steps.Action(m.deploySnapshotUpgradeTemplate),
 		steps.Action(m.startVMs),
 		steps.Condition(m.apiServersReady, 30*time.Minute),
 		steps.Action(m.ensureBillingRecord), // belt and braces
 		steps.Action(m.fixPullSecret),       // TODO(mj): Remove when operator deployed
+		steps.Action(m.ensureInventoryClientCertificate),
 		steps.Action(m.ensureAROOperator),
 		steps.Condition(m.aroDeploymentReady, 20*time.Minute),
 		steps.Action(m.configureAPIServerCertificate),
 		steps.Action(m.configureIngressCertificate),
+		steps.Action(m.updateBootImages),
 		steps.Action(m.updateProvisionedBy), // Run this last so we capture the resource provider only once the upgrade has been fully performed
 	}
 
-	return m.runSteps(ctx, steps)
+	return m.runSteps(ctx, steps, m.progressRecorder("AdminUpdate", 0, len(steps)))
+}
+
+// Update reconciles the worker profiles and the node SSH keys of an ARO
+// cluster, and refreshes the RP's credentials to it and reimages worker
+// machines if requested
+func (m *manager) Update(ctx context.Context) error {
+	steps := []steps.Step{
+		steps.Action(m.ensurePrivateEndpointConnection), // must be first: the kubernetes clients dial the private endpoint
+		steps.Action(m.refreshAROServiceKubeconfig),     // before the kubernetes clients are built from it
+		steps.Action(m.initializeKubernetesClients),
+		steps.Hooked(steps.Action(m.reconcileOutboundIPs),
+			steps.Precondition(m.clusterDocumentSane),
+			steps.Postcondition(m.publicLoadBalancerExists),
+		),
+		steps.Action(m.ensureWorkerProfiles),
+		steps.Action(m.ensureWorkerPools),
+		steps.Action(m.ensureComponentResources),
+		steps.Action(m.ensureWorkerDiskSize),
+		steps.Condition(m.workerDisksResized, 3*time.Hour),
+		steps.Action(m.ensureSSHKeys), // the old and new keys, if rotating
+		steps.Condition(m.sshKeysRolledOut, 3*time.Hour),
+		steps.Action(m.retireSSHKey),
+		steps.Action(m.ensureSSHKeys), // the new key only
+		steps.Condition(m.sshKeysRolledOut, 3*time.Hour),
+		steps.Action(m.ensureWorkerUserData),
+		steps.Action(m.deleteReimageMachines),
+		steps.Condition(m.reimagedMachinesJoined, time.Hour),
+		steps.Action(m.clearReimageMachines),
+		steps.AuthorizationRefreshingAction(m.fpAuthorizer, steps.Action(m.validateAutoscalerQuota)),
+		steps.Action(m.ensureAutoscaler),
+	}
+
+	return m.runSteps(ctx, steps, m.progressRecorder("Update", 0, len(steps)))
 }
 
 // Install installs an ARO cluster
@@ -49,18 +89,30 @@ func (m *manager) Install(ctx context.Context, installConfig *installconfig.Inst
 	steps := map[api.InstallPhase][]steps.Step{
 		api.InstallPhaseBootstrap: {
 			steps.Action(m.createDNS),
-			steps.AuthorizationRefreshingAction(m.fpAuthorizer, steps.Action(func(ctx context.Context) error {
+			steps.Hooked(steps.AuthorizationRefreshingAction(m.fpAuthorizer, steps.Action(func(ctx context.Context) error {
 				return m.deployStorageTemplate(ctx, installConfig, platformCreds, image, bootstrapLoggingConfig)
 			})),
+				steps.Precondition(m.fpCredentialsValid),
+				steps.Postcondition(m.clusterDocumentSane),
+			),
 			steps.AuthorizationRefreshingAction(m.fpAuthorizer, steps.Action(m.attachNSGsAndPatch)),
 			steps.Action(m.ensureBillingRecord),
-			steps.AuthorizationRefreshingAction(m.fpAuthorizer, steps.Action(m.deployResourceTemplate)),
+			steps.Condition(func(ctx context.Context) (bool, error) {
+				return m.releaseImageAvailable(ctx, image)
+			}, 10*time.Minute), // before creating the VMs: bootstrap pulls the payload from the regional ACR
+			steps.Hooked(steps.AuthorizationRefreshingAction(m.fpAuthorizer, steps.Action(m.deployResourceTemplate)),
+				steps.Precondition(m.clusterDocumentSane),
+				steps.Postcondition(m.publicLoadBalancerExists),
+			),
 			steps.Action(m.createPrivateEndpoint),
 			steps.Action(m.updateAPIIP),
 			steps.Action(m.createCertificates),
 			steps.Action(m.initializeKubernetesClients),
 			steps.Condition(m.bootstrapConfigMapReady, 30*time.Minute),
+			steps.Action(m.recordBootstrapDuration),
+			steps.Action(m.ensureInventoryClientCertificate),
 			steps.Action(m.ensureAROOperator),
+			steps.Condition(m.egressReachable, 20*time.Minute),
 			steps.Action(m.incrInstallPhase),
 		},
 		api.InstallPhaseRemoveBootstrap: {
@@ -77,6 +129,8 @@ func (m *manager) Install(ctx context.Context, installConfig *installconfig.Inst
 			steps.Action(m.disableUpdates),
 			steps.Action(m.disableSamples),
 			steps.Action(m.disableOperatorHubSources),
+			steps.Action(m.configureIngressIP),
+			steps.Condition(m.ingressIPReady, 10*time.Minute),
 			steps.Action(m.updateRouterIP),
 			steps.Action(m.configureIngressCertificate),
 			steps.Condition(m.ingressControllerReady, 30*time.Minute),
@@ -92,12 +146,23 @@ func (m *manager) Install(ctx context.Context, installConfig *installconfig.Inst
 	if steps[m.doc.OpenShiftCluster.Properties.Install.Phase] == nil {
 		return fmt.Errorf("unrecognised phase %s", m.doc.OpenShiftCluster.Properties.Install.Phase)
 	}
-	m.log.Printf("starting phase %s", m.doc.OpenShiftCluster.Properties.Install.Phase)
-	return m.runSteps(ctx, steps[m.doc.OpenShiftCluster.Properties.Install.Phase])
+	// the install phases run in separate leases; estimate the progress of
+	// the install as a whole
+	phase := m.doc.OpenShiftCluster.Properties.Install.Phase
+	var offset, total int
+	for p, s := range steps {
+		if p < phase {
+			offset += len(s)
+		}
+		total += len(s)
+	}
+
+	m.log.Printf("starting phase %s", phase)
+	return m.runSteps(ctx, steps[phase], m.progressRecorder(strings.TrimPrefix(phase.String(), "InstallPhase"), offset, total))
 }
 
-func (m *manager) runSteps(ctx context.Context, s []steps.Step) error {
-	err := steps.Run(ctx, m.log, 10*time.Second, s)
+func (m *manager) runSteps(ctx context.Context, s []steps.Step, progress steps.ProgressFunc) error {
+	err := steps.Run(steps.WithProgress(ctx, progress), m.log, 10*time.Second, s)
 	if err != nil {
 		m.gatherFailureLogs(ctx)
 	}
@@ -171,6 +236,16 @@ func (m *manager) initializeKubernetesClients(ctx context.Context) error {
 		return err
 	}
 
+	m.maocli, err = maoclient.NewForConfig(restConfig)
+	if err != nil {
+		return err
+	}
+
+	m.mcocli, err = mcoclient.NewForConfig(restConfig)
+	if err != nil {
+		return err
+	}
+
 	m.configcli, err = configclient.NewForConfig(restConfig)
 	return err
 }
@@ -155,7 +155,7 @@ func TestStepRunnerWithInstaller(t *testing.T) {
 				operatorcli:   tt.operatorcli,
 			}
 
-			err := m.runSteps(ctx, tt.steps)
+			err := m.runSteps(ctx, tt.steps, nil)
 			if err != nil && err.Error() != tt.wantErr ||
 				err == nil && tt.wantErr != "" {
 				t.Error(err)
@@ -292,7 +292,7 @@ func TestUpdateProvisionedBy(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	clusterdoc, err := openShiftClustersDatabase.Dequeue(ctx)
+	clusterdoc, err := openShiftClustersDatabase.Dequeue(ctx, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -0,0 +1,33 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/x509"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	utiltls "github.com/Azure/ARO-RP/pkg/util/tls"
+)
+
+// ensureInventoryClientCertificate generates the TLS client certificate with
+// which the ARO operator authenticates its inventory reports, unless the
+// cluster already has one.  ensureAROOperator passes it on to the operator.
+func (m *manager) ensureInventoryClientCertificate(ctx context.Context) error {
+	if m.doc.OpenShiftCluster.Properties.InventoryClientCertificate != nil {
+		return nil
+	}
+
+	key, certs, err := utiltls.GenerateKeyAndCertificate("aro-operator-inventory", nil, nil, false, true)
+	if err != nil {
+		return err
+	}
+
+	m.doc, err = m.db.PatchWithLease(ctx, m.doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+		doc.OpenShiftCluster.Properties.InventoryClientKey = x509.MarshalPKCS1PrivateKey(key)
+		doc.OpenShiftCluster.Properties.InventoryClientCertificate = certs[0].Raw
+		return nil
+	})
+	return err
+}
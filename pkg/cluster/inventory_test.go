@@ -0,0 +1,97 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestEnsureInventoryClientCertificate(t *testing.T) {
+	ctx := context.Background()
+	key := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName1"
+
+	for _, tt := range []struct {
+		name string
+		cert []byte
+	}{
+		{
+			name: "certificate is generated",
+		},
+		{
+			name: "existing certificate is kept",
+			cert: []byte("cert"),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			openShiftClustersDatabase, _ := testdatabase.NewFakeOpenShiftClusters()
+			fixture := testdatabase.NewFixture().WithOpenShiftClusters(openShiftClustersDatabase)
+			fixture.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				Key: strings.ToLower(key),
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID: key,
+					Properties: api.OpenShiftClusterProperties{
+						ProvisioningState:          api.ProvisioningStateCreating,
+						InventoryClientCertificate: tt.cert,
+					},
+				},
+			})
+			err := fixture.Create()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			doc, err := openShiftClustersDatabase.Dequeue(ctx, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			m := &manager{
+				log: logrus.NewEntry(logrus.StandardLogger()),
+				doc: doc,
+				db:  openShiftClustersDatabase,
+			}
+
+			err = m.ensureInventoryClientCertificate(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			doc, err = openShiftClustersDatabase.Get(ctx, strings.ToLower(key))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			props := doc.OpenShiftCluster.Properties
+			if tt.cert != nil {
+				if !bytes.Equal(props.InventoryClientCertificate, tt.cert) {
+					t.Error(string(props.InventoryClientCertificate))
+				}
+				return
+			}
+
+			cert, err := x509.ParseCertificate(props.InventoryClientCertificate)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(cert.ExtKeyUsage) != 1 || cert.ExtKeyUsage[0] != x509.ExtKeyUsageClientAuth {
+				t.Error(cert.ExtKeyUsage)
+			}
+
+			_, err = x509.ParsePKCS1PrivateKey(props.InventoryClientKey)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -7,15 +7,23 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
+	mgmtnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-07-01/network"
+	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/openshift/installer/pkg/asset/installconfig"
 	"github.com/openshift/installer/pkg/asset/password"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
 
 	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/util/stringutils"
 )
 
+// azureLoadBalancerResourceGroupAnnotation tells the cloud provider the
+// resource group of the public IP of a service, if it is not in the cluster's
+const azureLoadBalancerResourceGroupAnnotation = "service.beta.kubernetes.io/azure-load-balancer-resource-group"
+
 func (m *manager) updateRouterIP(ctx context.Context) error {
 	g, err := m.loadGraph(ctx)
 	if err != nil {
@@ -45,18 +53,80 @@ func (m *manager) updateRouterIP(ctx context.Context) error {
 		doc.OpenShiftCluster.Properties.APIServerProfile.URL = "https://api." + installConfig.Config.ObjectMeta.Name + "." + installConfig.Config.BaseDomain + ":6443/"
 		doc.OpenShiftCluster.Properties.IngressProfiles[0].IP = routerIP
 		doc.OpenShiftCluster.Properties.ConsoleProfile.URL = "https://console-openshift-console.apps." + installConfig.Config.ObjectMeta.Name + "." + installConfig.Config.BaseDomain + "/"
-		doc.OpenShiftCluster.Properties.KubeadminPassword = api.SecureString(kubeadminPassword.Password)
+		if doc.OpenShiftCluster.Properties.KubeadminPassword != api.SecureString(kubeadminPassword.Password) {
+			doc.OpenShiftCluster.Properties.KubeadminPassword = api.SecureString(kubeadminPassword.Password)
+			doc.OpenShiftCluster.Properties.CredentialsProfile.KubeadminPassword.Issue(time.Now(), nil)
+		}
 		return nil
 	})
 	return err
 }
 
+// configureIngressIP points the router service at the customer's public IP,
+// if any.  The cloud provider then moves the ingress frontend of the public
+// load balancer from the public IP it created to the customer's one.
+func (m *manager) configureIngressIP(ctx context.Context) error {
+	publicIPAddressID := m.doc.OpenShiftCluster.Properties.IngressProfiles[0].PublicIPAddressID
+	if publicIPAddressID == "" {
+		return nil
+	}
+
+	ip, resourceGroup, err := m.customerPublicIPAddress(ctx, publicIPAddressID)
+	if err != nil {
+		return err
+	}
+
+	m.log.Printf("configuring router service with public IP %s", publicIPAddressID)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		svc, err := m.kubernetescli.CoreV1().Services("openshift-ingress").Get(ctx, "router-default", metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if svc.Annotations == nil {
+			svc.Annotations = map[string]string{}
+		}
+		svc.Annotations[azureLoadBalancerResourceGroupAnnotation] = resourceGroup
+		svc.Spec.LoadBalancerIP = *ip.IPAddress
+
+		_, err = m.kubernetescli.CoreV1().Services("openshift-ingress").Update(ctx, svc, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// customerPublicIPAddress returns the customer's public IP address
+// publicIPAddressID and its resource group.  It is in the subscription of the
+// cluster.
+func (m *manager) customerPublicIPAddress(ctx context.Context, publicIPAddressID string) (*mgmtnetwork.PublicIPAddress, string, error) {
+	r, err := azure.ParseResourceID(publicIPAddressID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	ip, err := m.publicIPAddresses.Get(ctx, r.ResourceGroup, r.ResourceName, "")
+	if err != nil {
+		return nil, "", err
+	}
+
+	if ip.PublicIPAddressPropertiesFormat == nil || ip.IPAddress == nil {
+		return nil, "", fmt.Errorf("public IP address %s has no address", publicIPAddressID)
+	}
+
+	return &ip, r.ResourceGroup, nil
+}
+
 func (m *manager) updateAPIIP(ctx context.Context) error {
 	infraID := m.doc.OpenShiftCluster.Properties.InfraID
 
 	resourceGroup := stringutils.LastTokenByte(m.doc.OpenShiftCluster.Properties.ClusterProfile.ResourceGroupID, '/')
 	var ipAddress string
-	if m.doc.OpenShiftCluster.Properties.APIServerProfile.Visibility == api.VisibilityPublic {
+	if m.doc.OpenShiftCluster.Properties.APIServerProfile.PublicIPAddressID != "" {
+		ip, _, err := m.customerPublicIPAddress(ctx, m.doc.OpenShiftCluster.Properties.APIServerProfile.PublicIPAddressID)
+		if err != nil {
+			return err
+		}
+		ipAddress = *ip.IPAddress
+	} else if m.doc.OpenShiftCluster.Properties.APIServerProfile.Visibility == api.VisibilityPublic {
 		ip, err := m.publicIPAddresses.Get(ctx, resourceGroup, infraID+"-pip-v4", "")
 		if err != nil {
 			return err
@@ -4,15 +4,24 @@ package cluster
 // Licensed under the Apache License 2.0.
 
 import (
+	"context"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/ghodss/yaml"
 	"github.com/openshift/installer/pkg/asset"
 	"github.com/openshift/installer/pkg/asset/kubeconfig"
 	"github.com/openshift/installer/pkg/asset/tls"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 	clientcmd "k8s.io/client-go/tools/clientcmd/api/v1"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	utilpem "github.com/Azure/ARO-RP/pkg/util/pem"
+	"github.com/Azure/ARO-RP/pkg/util/restconfig"
 )
 
 // generateAROServiceKubeconfig generates additional admin credentials and kubeconfig
@@ -71,3 +80,86 @@ func (m *manager) generateAROServiceKubeconfig(g graph) (*kubeconfig.AdminIntern
 
 	return &aroServiceInternalClient, nil
 }
+
+// refreshAROServiceKubeconfig replaces the RP's credentials to the cluster,
+// if requested, with a newly signed certificate.  The new kubeconfig is only
+// saved once it is known to work, so a refresh cannot lock the RP out of the
+// cluster.
+func (m *manager) refreshAROServiceKubeconfig(ctx context.Context) error {
+	if !m.doc.OpenShiftCluster.Properties.RefreshAROServiceKubeconfig {
+		return nil
+	}
+
+	m.log.Print("refreshing the ARO service kubeconfig")
+
+	g, err := m.loadGraph(ctx)
+	if err != nil {
+		return err
+	}
+
+	aroServiceInternalClient, err := m.generateAROServiceKubeconfig(g)
+	if err != nil {
+		return err
+	}
+
+	err = m.verifyKubeconfig(ctx, aroServiceInternalClient.File.Data)
+	if err != nil {
+		return err
+	}
+
+	expiresAt, err := clientCertificateExpiry(aroServiceInternalClient)
+	if err != nil {
+		return err
+	}
+
+	m.doc, err = m.db.PatchWithLease(ctx, m.doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+		doc.OpenShiftCluster.Properties.AROServiceKubeconfig = aroServiceInternalClient.File.Data
+		doc.OpenShiftCluster.Properties.RefreshAROServiceKubeconfig = false
+		doc.OpenShiftCluster.Properties.CredentialsProfile.AROServiceKubeconfig.Rotate(time.Now(), expiresAt)
+		return nil
+	})
+	return err
+}
+
+// clientCertificateExpiry returns the expiry of the client certificate in
+// the kubeconfig client
+func clientCertificateExpiry(client *kubeconfig.AdminInternalClient) (*time.Time, error) {
+	if len(client.Config.AuthInfos) == 0 {
+		return nil, fmt.Errorf("kubeconfig has no credentials")
+	}
+
+	_, certs, err := utilpem.Parse(client.Config.AuthInfos[0].AuthInfo.ClientCertificateData)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("kubeconfig has no client certificate")
+	}
+
+	return &certs[0].NotAfter, nil
+}
+
+// verifyKubeconfig checks that the API server accepts the credentials in
+// kubeconfig.  Unlike /version, reading a namespace requires authentication.
+func (m *manager) verifyKubeconfig(ctx context.Context, kubeconfig []byte) error {
+	oc := *m.doc.OpenShiftCluster
+	oc.Properties.AROServiceKubeconfig = kubeconfig
+
+	restConfig, err := restconfig.RestConfig(m.env, &oc)
+	if err != nil {
+		return err
+	}
+
+	cli, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return err
+	}
+
+	_, err = cli.CoreV1().Namespaces().Get(ctx, "kube-system", metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("the refreshed kubeconfig was not accepted: %w", err)
+	}
+
+	return nil
+}
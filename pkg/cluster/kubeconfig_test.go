@@ -111,6 +111,14 @@ func TestGenerateAROServiceKubeconfig(t *testing.T) {
 		t.Error(innercert[0].NotAfter)
 	}
 
+	expiresAt, err := clientCertificateExpiry(aroServiceInternalClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !expiresAt.Equal(innercert[0].NotAfter) {
+		t.Error(expiresAt)
+	}
+
 	keyUsage := innercert[0].KeyUsage
 	expectedKeyUsage := x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature
 	if keyUsage != expectedKeyUsage {
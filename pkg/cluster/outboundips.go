@@ -0,0 +1,216 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	mgmtnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-07-01/network"
+	"github.com/Azure/go-autorest/autorest/to"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/stringutils"
+)
+
+const (
+	outboundRuleName = "outbound-rule-v4"
+
+	// publicFrontendName is the frontend of the public IP which every public
+	// load balancer is installed with.  It is the first managed outbound IP.
+	publicFrontendName = "public-lb-ip-v4"
+
+	outboundFrontendPrefix = "outbound-lb-ip-v4-"
+)
+
+// managedOutboundIPCount returns the number of public IPs which SNAT the
+// outbound connections of the cluster through the public load balancer
+func managedOutboundIPCount(oc *api.OpenShiftCluster) int {
+	lbp := oc.Properties.NetworkProfile.LoadBalancerProfile
+	if lbp == nil || lbp.ManagedOutboundIPs == nil || lbp.ManagedOutboundIPs.Count < 1 {
+		return 1
+	}
+
+	return lbp.ManagedOutboundIPs.Count
+}
+
+// outboundPublicIPName returns the name of the i'th managed outbound public
+// IP, for i >= 1
+func outboundPublicIPName(infraID string, i int) string {
+	return fmt.Sprintf("%s-outbound-pip-v4-%d", infraID, i)
+}
+
+// outboundFrontendName returns the name of the load balancer frontend of the
+// i'th managed outbound public IP, for i >= 1
+func outboundFrontendName(i int) string {
+	return outboundFrontendPrefix + strconv.Itoa(i)
+}
+
+// outboundIndex returns i if name is prefix followed by i >= 1
+func outboundIndex(name, prefix string) (int, bool) {
+	if !strings.HasPrefix(name, prefix) {
+		return 0, false
+	}
+
+	i, err := strconv.Atoi(name[len(prefix):])
+	if err != nil || i < 1 {
+		return 0, false
+	}
+
+	return i, true
+}
+
+func outboundPublicIPAddress(name, location string) *mgmtnetwork.PublicIPAddress {
+	return &mgmtnetwork.PublicIPAddress{
+		Sku: &mgmtnetwork.PublicIPAddressSku{
+			Name: mgmtnetwork.PublicIPAddressSkuNameStandard,
+		},
+		PublicIPAddressPropertiesFormat: &mgmtnetwork.PublicIPAddressPropertiesFormat{
+			PublicIPAllocationMethod: mgmtnetwork.Static,
+		},
+		Name:     to.StringPtr(name),
+		Type:     to.StringPtr("Microsoft.Network/publicIPAddresses"),
+		Location: to.StringPtr(location),
+	}
+}
+
+// reconcileOutboundIPs brings the managed outbound public IPs of the public
+// load balancer in line with the count set on the cluster.  Missing public IPs
+// are created before they are added to the outbound rule, and surplus ones are
+// only deleted once the load balancer no longer uses them.
+func (m *manager) reconcileOutboundIPs(ctx context.Context) error {
+	infraID := m.doc.OpenShiftCluster.Properties.InfraID
+	resourceGroupID := m.doc.OpenShiftCluster.Properties.ClusterProfile.ResourceGroupID
+	resourceGroup := stringutils.LastTokenByte(resourceGroupID, '/')
+	count := managedOutboundIPCount(m.doc.OpenShiftCluster)
+
+	ips, err := m.publicIPAddresses.List(ctx, resourceGroup)
+	if err != nil {
+		return err
+	}
+
+	existing := map[int]bool{}
+	for _, ip := range ips {
+		if i, ok := outboundIndex(*ip.Name, infraID+"-outbound-pip-v4-"); ok {
+			existing[i] = true
+		}
+	}
+
+	for i := 1; i < count; i++ {
+		if existing[i] {
+			continue
+		}
+
+		m.log.Printf("creating outbound public IP %s", outboundPublicIPName(infraID, i))
+		err = m.publicIPAddresses.CreateOrUpdateAndWait(ctx, resourceGroup, outboundPublicIPName(infraID, i), *outboundPublicIPAddress(outboundPublicIPName(infraID, i), m.doc.OpenShiftCluster.Location))
+		if err != nil {
+			return err
+		}
+	}
+
+	lb, err := m.loadBalancers.Get(ctx, resourceGroup, infraID, "")
+	if err != nil {
+		return err
+	}
+
+	if setOutboundFrontends(&lb, resourceGroupID, infraID, count) {
+		m.log.Printf("updating outbound rule of load balancer %s to %d public IPs", infraID, count)
+		err = m.loadBalancers.CreateOrUpdateAndWait(ctx, resourceGroup, infraID, lb)
+		if err != nil {
+			return err
+		}
+	}
+
+	for i := range existing {
+		if i < count {
+			continue
+		}
+
+		m.log.Printf("deleting outbound public IP %s", outboundPublicIPName(infraID, i))
+		err = m.publicIPAddresses.DeleteAndWait(ctx, resourceGroup, outboundPublicIPName(infraID, i))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setOutboundFrontends sets the frontends of the managed outbound public IPs
+// on lb and its outbound rule, leaving any other frontends (e.g. those which
+// the cloud provider adds for LoadBalancer services) alone.  It returns true
+// if lb changed.
+func setOutboundFrontends(lb *mgmtnetwork.LoadBalancer, resourceGroupID, infraID string, count int) bool {
+	if lb.LoadBalancerPropertiesFormat == nil || lb.FrontendIPConfigurations == nil || lb.OutboundRules == nil {
+		return false
+	}
+
+	var changed bool
+
+	frontends := make([]mgmtnetwork.FrontendIPConfiguration, 0, len(*lb.FrontendIPConfigurations))
+	existing := map[int]bool{}
+	for _, f := range *lb.FrontendIPConfigurations {
+		if i, ok := outboundIndex(*f.Name, outboundFrontendPrefix); ok {
+			if i >= count {
+				changed = true
+				continue
+			}
+			existing[i] = true
+		}
+		frontends = append(frontends, f)
+	}
+
+	for i := 1; i < count; i++ {
+		if existing[i] {
+			continue
+		}
+
+		frontends = append(frontends, mgmtnetwork.FrontendIPConfiguration{
+			FrontendIPConfigurationPropertiesFormat: &mgmtnetwork.FrontendIPConfigurationPropertiesFormat{
+				PublicIPAddress: &mgmtnetwork.PublicIPAddress{
+					ID: to.StringPtr(resourceGroupID + "/providers/Microsoft.Network/publicIPAddresses/" + outboundPublicIPName(infraID, i)),
+				},
+			},
+			Name: to.StringPtr(outboundFrontendName(i)),
+		})
+		changed = true
+	}
+
+	lb.FrontendIPConfigurations = &frontends
+
+	wantIDs := []string{*lb.ID + "/frontendIPConfigurations/" + publicFrontendName}
+	for i := 1; i < count; i++ {
+		wantIDs = append(wantIDs, *lb.ID+"/frontendIPConfigurations/"+outboundFrontendName(i))
+	}
+
+	for j, r := range *lb.OutboundRules {
+		if !strings.EqualFold(*r.Name, outboundRuleName) || r.OutboundRulePropertiesFormat == nil {
+			continue
+		}
+
+		var ids []string
+		if r.FrontendIPConfigurations != nil {
+			for _, f := range *r.FrontendIPConfigurations {
+				ids = append(ids, *f.ID)
+			}
+		}
+
+		if stringSetsEqual(ids, wantIDs) {
+			continue
+		}
+
+		subresources := make([]mgmtnetwork.SubResource, 0, len(wantIDs))
+		for _, id := range wantIDs {
+			subresources = append(subresources, mgmtnetwork.SubResource{
+				ID: to.StringPtr(id),
+			})
+		}
+		(*lb.OutboundRules)[j].FrontendIPConfigurations = &subresources
+		changed = true
+	}
+
+	return changed
+}
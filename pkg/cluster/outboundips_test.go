@@ -0,0 +1,220 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	mgmtnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-07-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/network"
+	"github.com/Azure/ARO-RP/test/util/fakearm"
+)
+
+func TestReconcileOutboundIPs(t *testing.T) {
+	subscriptionID := "00000000-0000-0000-0000-000000000000"
+	infraID := "infra"
+	resourceGroupID := fmt.Sprintf("/subscriptions/%s/resourceGroups/test-cluster", subscriptionID)
+	ipsID := resourceGroupID + "/providers/Microsoft.Network/publicIPAddresses"
+	lbID := resourceGroupID + "/providers/Microsoft.Network/loadBalancers/" + infraID
+
+	// the load balancer as installed with outbound IPs, plus a frontend which
+	// the cloud provider added for a LoadBalancer service
+	loadBalancer := func(outboundIPs ...int) map[string]interface{} {
+		frontends := []interface{}{
+			map[string]interface{}{
+				"name": "public-lb-ip-v4",
+				"properties": map[string]interface{}{
+					"publicIPAddress": map[string]interface{}{"id": ipsID + "/" + infraID + "-pip-v4"},
+				},
+			},
+			map[string]interface{}{
+				"name": "a0123456789abcdef",
+				"properties": map[string]interface{}{
+					"publicIPAddress": map[string]interface{}{"id": ipsID + "/kubernetes-a0123456789abcdef"},
+				},
+			},
+		}
+		ruleFrontends := []interface{}{
+			map[string]interface{}{"id": lbID + "/frontendIPConfigurations/public-lb-ip-v4"},
+		}
+
+		for _, i := range outboundIPs {
+			frontends = append(frontends, map[string]interface{}{
+				"name": fmt.Sprintf("outbound-lb-ip-v4-%d", i),
+				"properties": map[string]interface{}{
+					"publicIPAddress": map[string]interface{}{"id": fmt.Sprintf("%s/%s-outbound-pip-v4-%d", ipsID, infraID, i)},
+				},
+			})
+			ruleFrontends = append(ruleFrontends, map[string]interface{}{
+				"id": fmt.Sprintf("%s/frontendIPConfigurations/outbound-lb-ip-v4-%d", lbID, i),
+			})
+		}
+
+		return map[string]interface{}{
+			"properties": map[string]interface{}{
+				"frontendIPConfigurations": frontends,
+				"outboundRules": []interface{}{
+					map[string]interface{}{
+						"name": "outbound-rule-v4",
+						"properties": map[string]interface{}{
+							"frontendIPConfigurations": ruleFrontends,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	for _, tt := range []struct {
+		name            string
+		count           int
+		outboundIPs     []int
+		wantPublicIPs   []string
+		wantFrontends   []string
+		wantRuleIPs     int
+		wantLBUnchanged bool
+	}{
+		{
+			name:            "default count, nothing to do",
+			wantPublicIPs:   []string{"infra-pip-v4", "kubernetes-a0123456789abcdef"},
+			wantFrontends:   []string{"a0123456789abcdef", "public-lb-ip-v4"},
+			wantRuleIPs:     1,
+			wantLBUnchanged: true,
+		},
+		{
+			name:          "scale up",
+			count:         3,
+			outboundIPs:   []int{1},
+			wantPublicIPs: []string{"infra-outbound-pip-v4-1", "infra-outbound-pip-v4-2", "infra-pip-v4", "kubernetes-a0123456789abcdef"},
+			wantFrontends: []string{"a0123456789abcdef", "outbound-lb-ip-v4-1", "outbound-lb-ip-v4-2", "public-lb-ip-v4"},
+			wantRuleIPs:   3,
+		},
+		{
+			name:          "scale down",
+			count:         1,
+			outboundIPs:   []int{1, 2},
+			wantPublicIPs: []string{"infra-pip-v4", "kubernetes-a0123456789abcdef"},
+			wantFrontends: []string{"a0123456789abcdef", "public-lb-ip-v4"},
+			wantRuleIPs:   1,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			arm := fakearm.New()
+			defer arm.Close()
+
+			arm.AsyncPolls = 1
+
+			for _, name := range []string{infraID + "-pip-v4", "kubernetes-a0123456789abcdef"} {
+				err := arm.AddResource(ipsID+"/"+name, map[string]interface{}{})
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			for _, i := range tt.outboundIPs {
+				err := arm.AddResource(fmt.Sprintf("%s/%s-outbound-pip-v4-%d", ipsID, infraID, i), map[string]interface{}{})
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err := arm.AddResource(lbID, loadBalancer(tt.outboundIPs...))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ctx := azureclient.WithSender(context.Background(), arm.Sender())
+
+			oc := &api.OpenShiftCluster{
+				Location: "eastus",
+				Properties: api.OpenShiftClusterProperties{
+					ClusterProfile: api.ClusterProfile{
+						ResourceGroupID: resourceGroupID,
+					},
+					InfraID: infraID,
+				},
+			}
+			if tt.count != 0 {
+				oc.Properties.NetworkProfile.LoadBalancerProfile = &api.LoadBalancerProfile{
+					ManagedOutboundIPs: &api.ManagedOutboundIPs{
+						Count: tt.count,
+					},
+				}
+			}
+
+			m := &manager{
+				log:               logrus.NewEntry(logrus.StandardLogger()),
+				publicIPAddresses: network.NewPublicIPAddressesClient(subscriptionID, &autorest.NullAuthorizer{}),
+				loadBalancers:     network.NewLoadBalancersClient(subscriptionID, &autorest.NullAuthorizer{}),
+				doc: &api.OpenShiftClusterDocument{
+					OpenShiftCluster: oc,
+				},
+			}
+
+			err = m.reconcileOutboundIPs(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ips, err := m.publicIPAddresses.List(ctx, "test-cluster")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var names []string
+			for _, ip := range ips {
+				names = append(names, *ip.Name)
+			}
+			sort.Strings(names)
+
+			if !reflect.DeepEqual(names, tt.wantPublicIPs) {
+				t.Error(names)
+			}
+
+			var lb mgmtnetwork.LoadBalancer
+			_, err = arm.Resource(lbID, &lb)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var frontends []string
+			for _, f := range *lb.FrontendIPConfigurations {
+				frontends = append(frontends, *f.Name)
+			}
+			sort.Strings(frontends)
+
+			if !reflect.DeepEqual(frontends, tt.wantFrontends) {
+				t.Error(frontends)
+			}
+
+			if len(*(*lb.OutboundRules)[0].FrontendIPConfigurations) != tt.wantRuleIPs {
+				t.Error(*(*lb.OutboundRules)[0].FrontendIPConfigurations)
+			}
+
+			lbUnchanged := true
+			for _, r := range arm.Requests() {
+				if r == http.MethodPut+" "+lbID {
+					lbUnchanged = false
+				}
+				if strings.HasPrefix(r, http.MethodDelete) && !strings.Contains(r, "-outbound-pip-v4-") {
+					t.Error(r)
+				}
+			}
+
+			if lbUnchanged != tt.wantLBUnchanged {
+				t.Error(lbUnchanged)
+			}
+		})
+	}
+}
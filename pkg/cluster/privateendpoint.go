@@ -0,0 +1,61 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/privateendpoint"
+)
+
+// ensurePrivateEndpointConnection repairs the connection between the RP
+// private endpoint and the cluster private link service, through which the RP
+// reaches the API server, and records the private endpoint IP if it has
+// changed as a result.
+func (m *manager) ensurePrivateEndpointConnection(ctx context.Context) error {
+	status, err := m.privateendpoint.Status(ctx, m.doc)
+	if err != nil || status == privateendpoint.StatusApproved {
+		return err
+	}
+
+	m.log.Printf("private endpoint connection is %s, repairing", status)
+
+	err = m.privateendpoint.Repair(ctx, m.doc, m.privateLinkServices)
+	if err != nil {
+		return err
+	}
+
+	privateEndpointIP, err := m.privateendpoint.GetIP(ctx, m.doc)
+	if err != nil || privateEndpointIP == m.doc.OpenShiftCluster.Properties.NetworkProfile.PrivateEndpointIP {
+		return err
+	}
+
+	m.doc, err = m.db.PatchWithLease(ctx, m.doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+		doc.OpenShiftCluster.Properties.NetworkProfile.PrivateEndpointIP = privateEndpointIP
+		return nil
+	})
+	return err
+}
+
+func (m *manager) privateEndpointDrifted(ctx context.Context) (bool, error) {
+	status, err := m.privateendpoint.Status(ctx, m.doc)
+	return status != privateendpoint.StatusApproved, err
+}
+
+// repairPrivateEndpoint only approves pending connections: recreating the
+// private endpoint changes its IP, which can only be recorded under the lease.
+func (m *manager) repairPrivateEndpoint(ctx context.Context) error {
+	status, err := m.privateendpoint.Status(ctx, m.doc)
+	if err != nil {
+		return err
+	}
+
+	if status != privateendpoint.StatusPending {
+		return fmt.Errorf("private endpoint connection is %s and must be repaired by an admin update", status)
+	}
+
+	return m.privateendpoint.Repair(ctx, m.doc, m.privateLinkServices)
+}
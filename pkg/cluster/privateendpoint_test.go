@@ -0,0 +1,158 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	mock_network "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/mgmt/network"
+	mock_privateendpoint "github.com/Azure/ARO-RP/pkg/util/mocks/privateendpoint"
+	"github.com/Azure/ARO-RP/pkg/util/privateendpoint"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestEnsurePrivateEndpointConnection(t *testing.T) {
+	ctx := context.Background()
+	key := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName1"
+
+	for _, tt := range []struct {
+		name                  string
+		mocks                 func(*mock_privateendpoint.MockManager, *mock_network.MockPrivateLinkServicesClient)
+		wantPrivateEndpointIP string
+	}{
+		{
+			name: "approved",
+			mocks: func(pe *mock_privateendpoint.MockManager, _ *mock_network.MockPrivateLinkServicesClient) {
+				pe.EXPECT().Status(gomock.Any(), gomock.Any()).Return(privateendpoint.StatusApproved, nil)
+			},
+			wantPrivateEndpointIP: "10.0.0.1",
+		},
+		{
+			name: "pending connection is approved",
+			mocks: func(pe *mock_privateendpoint.MockManager, privateLinkServices *mock_network.MockPrivateLinkServicesClient) {
+				pe.EXPECT().Status(gomock.Any(), gomock.Any()).Return(privateendpoint.StatusPending, nil)
+				pe.EXPECT().Repair(gomock.Any(), gomock.Any(), privateLinkServices).Return(nil)
+				pe.EXPECT().GetIP(gomock.Any(), gomock.Any()).Return("10.0.0.1", nil)
+			},
+			wantPrivateEndpointIP: "10.0.0.1",
+		},
+		{
+			name: "recreated private endpoint IP is recorded",
+			mocks: func(pe *mock_privateendpoint.MockManager, privateLinkServices *mock_network.MockPrivateLinkServicesClient) {
+				pe.EXPECT().Status(gomock.Any(), gomock.Any()).Return(privateendpoint.StatusMissing, nil)
+				pe.EXPECT().Repair(gomock.Any(), gomock.Any(), privateLinkServices).Return(nil)
+				pe.EXPECT().GetIP(gomock.Any(), gomock.Any()).Return("10.0.0.2", nil)
+			},
+			wantPrivateEndpointIP: "10.0.0.2",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			openShiftClustersDatabase, _ := testdatabase.NewFakeOpenShiftClusters()
+			fixture := testdatabase.NewFixture().WithOpenShiftClusters(openShiftClustersDatabase)
+			fixture.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				Key: strings.ToLower(key),
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID: key,
+					Properties: api.OpenShiftClusterProperties{
+						ProvisioningState: api.ProvisioningStateAdminUpdating,
+						NetworkProfile: api.NetworkProfile{
+							PrivateEndpointIP: "10.0.0.1",
+						},
+					},
+				},
+			})
+			err := fixture.Create()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			doc, err := openShiftClustersDatabase.Dequeue(ctx, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			pe := mock_privateendpoint.NewMockManager(controller)
+			privateLinkServices := mock_network.NewMockPrivateLinkServicesClient(controller)
+			tt.mocks(pe, privateLinkServices)
+
+			m := &manager{
+				log:                 logrus.NewEntry(logrus.StandardLogger()),
+				doc:                 doc,
+				db:                  openShiftClustersDatabase,
+				privateendpoint:     pe,
+				privateLinkServices: privateLinkServices,
+			}
+
+			err = m.ensurePrivateEndpointConnection(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			doc, err = openShiftClustersDatabase.Get(ctx, strings.ToLower(key))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if doc.OpenShiftCluster.Properties.NetworkProfile.PrivateEndpointIP != tt.wantPrivateEndpointIP {
+				t.Error(doc.OpenShiftCluster.Properties.NetworkProfile.PrivateEndpointIP)
+			}
+		})
+	}
+}
+
+func TestRepairPrivateEndpoint(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name    string
+		status  string
+		wantErr string
+	}{
+		{
+			name:   "pending connection is approved",
+			status: privateendpoint.StatusPending,
+		},
+		{
+			name:    "rejected connection is left to an admin update",
+			status:  privateendpoint.StatusRejected,
+			wantErr: "private endpoint connection is Rejected and must be repaired by an admin update",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			doc := driftTestDoc(api.VisibilityPrivate)
+
+			privateLinkServices := mock_network.NewMockPrivateLinkServicesClient(controller)
+
+			pe := mock_privateendpoint.NewMockManager(controller)
+			pe.EXPECT().Status(ctx, doc).Return(tt.status, nil)
+			if tt.wantErr == "" {
+				pe.EXPECT().Repair(ctx, doc, privateLinkServices).Return(nil)
+			}
+
+			m := &manager{
+				doc:                 doc,
+				privateendpoint:     pe,
+				privateLinkServices: privateLinkServices,
+			}
+
+			err := m.repairPrivateEndpoint(ctx)
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/steps"
+)
+
+// progressRecorder returns a steps.ProgressFunc which records in the cluster
+// document that the operation is in the given phase and how far it has got.
+// The steps run belong to an operation of total steps, of which offset ran
+// before them.  The progress is informational: failing to record it does not
+// fail the operation.
+func (m *manager) progressRecorder(phase string, offset, total int) steps.ProgressFunc {
+	return func(ctx context.Context, step steps.Step, completed int) {
+		doc, err := m.db.PatchWithLease(ctx, m.doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+			doc.OpenShiftCluster.Properties.Progress = &api.ProvisioningProgress{
+				Phase:              phase,
+				Step:               step.String(),
+				PercentComplete:    100 * (offset + completed) / total,
+				LastTransitionTime: time.Now().UTC(),
+			}
+			return nil
+		})
+		if err != nil {
+			m.log.Warnf("could not record progress: %s", err)
+			return
+		}
+
+		m.doc = doc
+	}
+}
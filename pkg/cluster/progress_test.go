@@ -0,0 +1,80 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/steps"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestProgressRecorder(t *testing.T) {
+	ctx := context.Background()
+	key := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName1"
+
+	openShiftClustersDatabase, _ := testdatabase.NewFakeOpenShiftClusters()
+	fixture := testdatabase.NewFixture().WithOpenShiftClusters(openShiftClustersDatabase)
+	fixture.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+		Key: strings.ToLower(key),
+		OpenShiftCluster: &api.OpenShiftCluster{
+			ID: key,
+			Properties: api.OpenShiftClusterProperties{
+				ProvisioningState: api.ProvisioningStateCreating,
+			},
+		},
+	})
+	err := fixture.Create()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err := openShiftClustersDatabase.Dequeue(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := &manager{
+		log: logrus.NewEntry(logrus.StandardLogger()),
+		doc: doc,
+		db:  openShiftClustersDatabase,
+	}
+
+	// the second phase of an install of 4 steps, 2 in each phase
+	var got []api.ProvisioningProgress
+	record := func(context.Context) error {
+		got = append(got, *m.doc.OpenShiftCluster.Properties.Progress)
+		return nil
+	}
+
+	err = m.runSteps(ctx, []steps.Step{steps.Action(record), steps.Action(record)}, m.progressRecorder("RemoveBootstrap", 2, 4))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(got) != 2 {
+		t.Fatal(got)
+	}
+	for i, wantPercent := range []int{50, 75} {
+		if got[i].Phase != "RemoveBootstrap" ||
+			got[i].Step != "[Action github.com/Azure/ARO-RP/pkg/cluster.TestProgressRecorder.func1]" ||
+			got[i].PercentComplete != wantPercent ||
+			got[i].LastTransitionTime.IsZero() {
+			t.Error(i, got[i])
+		}
+	}
+
+	doc, err = openShiftClustersDatabase.Get(ctx, strings.ToLower(key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc.OpenShiftCluster.Properties.Progress == nil || doc.OpenShiftCluster.Properties.Progress.PercentComplete != 75 {
+		t.Error(doc.OpenShiftCluster.Properties.Progress)
+	}
+}
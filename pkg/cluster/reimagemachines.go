@@ -0,0 +1,176 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/openshift/installer/pkg/asset/ignition/machine"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+// workerUserDataSecretName is the name of the secret, created by the
+// installer, which holds the stub ignition config of new worker machines
+const workerUserDataSecretName = "worker-user-data"
+
+// workerUserData returns the stub ignition config with which new worker
+// machines fetch their configuration from the machine config server
+func workerUserData(g graph) ([]byte, error) {
+	a, err := g.resolve(&machine.Worker{})
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(a.(*machine.Worker).Config)
+}
+
+// ensureWorkerUserData regenerates the stub ignition config of the worker
+// machines from the install graph, if machines are being reimaged: a damaged
+// config is a common reason for machines never joining the cluster
+func (m *manager) ensureWorkerUserData(ctx context.Context) error {
+	if len(m.doc.OpenShiftCluster.Properties.ReimageMachines) == 0 {
+		return nil
+	}
+
+	g, err := m.loadGraph(ctx)
+	if err != nil {
+		return err
+	}
+
+	userData, err := workerUserData(g)
+	if err != nil {
+		return err
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		s, err := m.kubernetescli.CoreV1().Secrets(machineSetsNamespace).Get(ctx, workerUserDataSecretName, metav1.GetOptions{})
+		if kerrors.IsNotFound(err) {
+			m.log.Printf("creating secret %s", workerUserDataSecretName)
+			_, err = m.kubernetescli.CoreV1().Secrets(machineSetsNamespace).Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      workerUserDataSecretName,
+					Namespace: machineSetsNamespace,
+				},
+				Type: corev1.SecretTypeOpaque,
+				Data: map[string][]byte{
+					"disableTemplating": []byte("true\n"),
+					"userData":          userData,
+				},
+			}, metav1.CreateOptions{})
+			return err
+		}
+		if err != nil {
+			return err
+		}
+
+		if bytes.Equal(s.Data["userData"], userData) {
+			return nil
+		}
+
+		m.log.Printf("regenerating secret %s", workerUserDataSecretName)
+		if s.Data == nil {
+			s.Data = map[string][]byte{}
+		}
+		s.Data["userData"] = userData
+
+		_, err = m.kubernetescli.CoreV1().Secrets(machineSetsNamespace).Update(ctx, s, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// deleteReimageMachines deletes the machines which are being reimaged, so
+// that their machinesets create them afresh.  A machine which has joined the
+// cluster since it was requested is left alone.
+func (m *manager) deleteReimageMachines(ctx context.Context) error {
+	for _, name := range m.doc.OpenShiftCluster.Properties.ReimageMachines {
+		machine, err := m.maocli.MachineV1beta1().Machines(machineSetsNamespace).Get(ctx, name, metav1.GetOptions{})
+		if kerrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if machine.Status.NodeRef != nil {
+			m.log.Printf("machine %s has joined the cluster as node %s, not reimaging it", name, machine.Status.NodeRef.Name)
+			continue
+		}
+
+		if machine.DeletionTimestamp != nil {
+			continue
+		}
+
+		m.log.Printf("deleting machine %s to reimage it", name)
+		err = m.maocli.MachineV1beta1().Machines(machineSetsNamespace).Delete(ctx, name, metav1.DeleteOptions{})
+		if err != nil && !kerrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reimagedMachinesJoined returns true once the machines which are being
+// reimaged are gone and every worker machineset has all its replicas ready,
+// i.e. the replacement machines have joined the cluster
+func (m *manager) reimagedMachinesJoined(ctx context.Context) (bool, error) {
+	if len(m.doc.OpenShiftCluster.Properties.ReimageMachines) == 0 {
+		return true, nil
+	}
+
+	for _, name := range m.doc.OpenShiftCluster.Properties.ReimageMachines {
+		machine, err := m.maocli.MachineV1beta1().Machines(machineSetsNamespace).Get(ctx, name, metav1.GetOptions{})
+		if kerrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return false, err
+		}
+
+		if machine.Status.NodeRef == nil {
+			m.log.Printf("waiting for machine %s to be deleted", name)
+			return false, nil
+		}
+	}
+
+	machinesets, err := m.maocli.MachineV1beta1().MachineSets(machineSetsNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	for _, machineset := range machinesets.Items {
+		if m.machineSetWorkerProfile(&machineset) == nil {
+			continue
+		}
+
+		if machineset.Spec.Replicas != nil &&
+			machineset.Status.ReadyReplicas < *machineset.Spec.Replicas {
+			m.log.Printf("waiting for machineset %s: %d of %d replicas ready", machineset.Name, machineset.Status.ReadyReplicas, *machineset.Spec.Replicas)
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// clearReimageMachines records that the reimaged machines have been replaced
+func (m *manager) clearReimageMachines(ctx context.Context) error {
+	if len(m.doc.OpenShiftCluster.Properties.ReimageMachines) == 0 {
+		return nil
+	}
+
+	var err error
+	m.doc, err = m.db.PatchWithLease(ctx, m.doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+		doc.OpenShiftCluster.Properties.ReimageMachines = nil
+		return nil
+	})
+	return err
+}
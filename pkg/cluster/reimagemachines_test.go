@@ -0,0 +1,205 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/openshift/installer/pkg/asset/installconfig"
+	"github.com/openshift/installer/pkg/asset/tls"
+	"github.com/openshift/installer/pkg/types"
+	"github.com/openshift/installer/pkg/types/azure"
+	machinev1beta1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
+	maofake "github.com/openshift/machine-api-operator/pkg/generated/clientset/versioned/fake"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+)
+
+func TestWorkerUserData(t *testing.T) {
+	g := graph{
+		reflect.TypeOf(&installconfig.InstallConfig{}): &installconfig.InstallConfig{
+			Config: &types.InstallConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster",
+				},
+				BaseDomain: "example.com",
+				Platform: types.Platform{
+					Azure: &azure.Platform{},
+				},
+			},
+		},
+		reflect.TypeOf(&tls.RootCA{}): &tls.RootCA{
+			SelfSignedCertKey: tls.SelfSignedCertKey{
+				CertKey: tls.CertKey{
+					CertRaw: []byte("ca"),
+				},
+			},
+		},
+	}
+
+	b, err := workerUserData(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var config struct {
+		Ignition struct {
+			Config struct {
+				Append []struct {
+					Source string `json:"source"`
+				} `json:"append"`
+			} `json:"config"`
+			Security struct {
+				TLS struct {
+					CertificateAuthorities []struct {
+						Source string `json:"source"`
+					} `json:"certificateAuthorities"`
+				} `json:"tls"`
+			} `json:"security"`
+		} `json:"ignition"`
+	}
+
+	err = json.Unmarshal(b, &config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(config.Ignition.Config.Append) != 1 ||
+		config.Ignition.Config.Append[0].Source != "https://api-int.cluster.example.com:22623/config/worker" {
+		t.Error(string(b))
+	}
+	if len(config.Ignition.Security.TLS.CertificateAuthorities) != 1 ||
+		config.Ignition.Security.TLS.CertificateAuthorities[0].Source != "data:text/plain;charset=utf-8;base64,Y2E=" {
+		t.Error(string(b))
+	}
+}
+
+func reimageMachine(name string, nodeName string) *machinev1beta1.Machine {
+	m := &machinev1beta1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: machineSetsNamespace,
+			Labels: map[string]string{
+				"machine.openshift.io/cluster-api-machineset": "infra-worker-eastus1",
+			},
+		},
+	}
+
+	if nodeName != "" {
+		m.Status.NodeRef = &corev1.ObjectReference{
+			Name: nodeName,
+		}
+	}
+
+	return m
+}
+
+func TestDeleteReimageMachines(t *testing.T) {
+	ctx := context.Background()
+
+	maocli := maofake.NewSimpleClientset(
+		reimageMachine("stuck", ""),
+		reimageMachine("joined", "joined"),
+		reimageMachine("other", ""),
+	)
+
+	doc := workerDisksDocument()
+	doc.OpenShiftCluster.Properties.ReimageMachines = []string{"stuck", "joined", "missing"}
+
+	m := &manager{
+		log:    logrus.NewEntry(logrus.StandardLogger()),
+		maocli: maocli,
+		doc:    doc,
+	}
+
+	err := m.deleteReimageMachines(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, wantDeleted := range map[string]bool{
+		"stuck":  true,
+		"joined": false,
+		"other":  false,
+	} {
+		_, err := maocli.MachineV1beta1().Machines(machineSetsNamespace).Get(ctx, name, metav1.GetOptions{})
+		if kerrors.IsNotFound(err) != wantDeleted {
+			t.Error(name, err)
+		}
+	}
+}
+
+func TestReimagedMachinesJoined(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name    string
+		objects []*machinev1beta1.MachineSet
+		machine *machinev1beta1.Machine
+		want    bool
+	}{
+		{
+			name: "replacement joined",
+			objects: []*machinev1beta1.MachineSet{
+				workerDisksMachineSet("infra-worker-eastus1", operator.RoleWorker, "", 128, 3, 3),
+			},
+			want: true,
+		},
+		{
+			name: "machine is still being deleted",
+			objects: []*machinev1beta1.MachineSet{
+				workerDisksMachineSet("infra-worker-eastus1", operator.RoleWorker, "", 128, 3, 3),
+			},
+			machine: reimageMachine("stuck", ""),
+		},
+		{
+			name: "replacement not ready",
+			objects: []*machinev1beta1.MachineSet{
+				workerDisksMachineSet("infra-worker-eastus1", operator.RoleWorker, "", 128, 3, 2),
+			},
+		},
+		{
+			name: "master machineset is ignored",
+			objects: []*machinev1beta1.MachineSet{
+				workerDisksMachineSet("infra-worker-eastus1", operator.RoleWorker, "", 128, 3, 3),
+				workerDisksMachineSet("infra-master", "master", "", 1024, 3, 2),
+			},
+			want: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			maocli := maofake.NewSimpleClientset()
+			for _, ms := range tt.objects {
+				maocli.Tracker().Add(ms)
+			}
+			if tt.machine != nil {
+				maocli.Tracker().Add(tt.machine)
+			}
+
+			doc := workerDisksDocument()
+			doc.OpenShiftCluster.Properties.ReimageMachines = []string{"stuck"}
+
+			m := &manager{
+				log:    logrus.NewEntry(logrus.StandardLogger()),
+				maocli: maocli,
+				doc:    doc,
+			}
+
+			got, err := m.reimagedMachinesJoined(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Error(got)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/openshift/installer/pkg/asset/releaseimage"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+// manifestMediaTypes are the manifest types which a release image may have
+var manifestMediaTypes = []string{
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.oci.image.manifest.v1+json",
+}
+
+// releaseImageAvailable returns true once the manifest of the release image
+// can be fetched from the regional ACR mirror with the cluster's ACR token.
+// The bootstrap node pulls the release payload from the mirror, so waiting
+// for it to be replicated into the region here fails an install early, rather
+// than after the bootstrap timeout.  Clusters without an ACR token (i.e. in
+// development) are not checked.
+func (m *manager) releaseImageAvailable(ctx context.Context, image *releaseimage.Image) (bool, error) {
+	acrDomain := m.env.ACRDomain()
+
+	var rp *api.RegistryProfile
+	for i := range m.doc.OpenShiftCluster.Properties.RegistryProfiles {
+		if m.doc.OpenShiftCluster.Properties.RegistryProfiles[i].Name == acrDomain {
+			rp = m.doc.OpenShiftCluster.Properties.RegistryProfiles[i]
+		}
+	}
+	if rp == nil {
+		return true, nil
+	}
+
+	repository, reference, err := splitPullSpec(image.PullSpec)
+	if err != nil {
+		return false, err
+	}
+
+	token, err := m.registryToken(ctx, acrDomain, repository, rp.Username, string(rp.Password))
+	if err != nil {
+		return false, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, "https://"+acrDomain+"/v2/"+repository+"/manifests/"+reference, nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", strings.Join(manifestMediaTypes, ", "))
+
+	resp, err := m.registryClient.Do(req)
+	if err != nil {
+		m.log.Info(err)
+		return false, nil
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		m.log.Infof("release image %s is not yet available in %s", image.PullSpec, acrDomain)
+		return false, nil
+	default:
+		return false, fmt.Errorf("unexpected status code %d fetching release image manifest from %s", resp.StatusCode, acrDomain)
+	}
+}
+
+// registryToken exchanges the ACR token credentials for a bearer token which
+// can pull from repository, the same way that a docker pull does
+func (m *manager) registryToken(ctx context.Context, registry, repository, username, password string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://"+registry+"/oauth2/token?"+url.Values{
+		"service": []string{registry},
+		"scope":   []string{"repository:" + repository + ":pull"},
+	}.Encode(), nil)
+	if err != nil {
+		return "", err
+	}
+	req.SetBasicAuth(username, password)
+
+	resp, err := m.registryClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status code %d requesting a token from %s", resp.StatusCode, registry)
+	}
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&token)
+	if err != nil {
+		return "", err
+	}
+
+	return token.AccessToken, nil
+}
+
+// splitPullSpec splits a pull spec such as
+// quay.io/openshift-release-dev/ocp-release@sha256:... into the repository
+// (without the registry, which is mirrored) and the digest or tag
+func splitPullSpec(pullSpec string) (repository, reference string, err error) {
+	parts := strings.SplitN(pullSpec, "/", 2)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid pull spec %q", pullSpec)
+	}
+
+	if i := strings.LastIndexByte(parts[1], '@'); i != -1 {
+		return parts[1][:i], parts[1][i+1:], nil
+	}
+
+	if i := strings.LastIndexByte(parts[1], ':'); i != -1 {
+		return parts[1][:i], parts[1][i+1:], nil
+	}
+
+	return parts[1], "latest", nil
+}
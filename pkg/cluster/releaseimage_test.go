@@ -0,0 +1,171 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/openshift/installer/pkg/asset/releaseimage"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+	mock_env "github.com/Azure/ARO-RP/pkg/util/mocks/env"
+)
+
+func TestReleaseImageAvailable(t *testing.T) {
+	ctx := context.Background()
+
+	const digest = "sha256:0000000000000000000000000000000000000000000000000000000000000000"
+
+	for _, tt := range []struct {
+		name           string
+		noRegistry     bool
+		manifestStatus int
+		want           bool
+		wantErr        string
+	}{
+		{
+			name:           "available",
+			manifestStatus: http.StatusOK,
+			want:           true,
+		},
+		{
+			name:           "not yet replicated",
+			manifestStatus: http.StatusNotFound,
+		},
+		{
+			name:           "registry error",
+			manifestStatus: http.StatusInternalServerError,
+			wantErr:        "unexpected status code 500 fetching release image manifest from ",
+		},
+		{
+			name:       "no acr token",
+			noRegistry: true,
+			want:       true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			var requests int
+			s := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+
+				switch r.URL.Path {
+				case "/oauth2/token":
+					username, password, _ := r.BasicAuth()
+					if username != "token" || password != "password" ||
+						r.URL.Query().Get("scope") != "repository:openshift-release-dev/ocp-release:pull" {
+						w.WriteHeader(http.StatusUnauthorized)
+						return
+					}
+					_, _ = w.Write([]byte(`{"access_token":"bearer"}`))
+
+				case "/v2/openshift-release-dev/ocp-release/manifests/" + digest:
+					if r.Method != http.MethodHead || r.Header.Get("Authorization") != "Bearer bearer" {
+						w.WriteHeader(http.StatusUnauthorized)
+						return
+					}
+					w.WriteHeader(tt.manifestStatus)
+
+				default:
+					w.WriteHeader(http.StatusBadRequest)
+				}
+			}))
+			defer s.Close()
+
+			acrDomain := strings.TrimPrefix(s.URL, "https://")
+
+			env := mock_env.NewMockInterface(controller)
+			env.EXPECT().ACRDomain().AnyTimes().Return(acrDomain)
+
+			doc := &api.OpenShiftClusterDocument{
+				OpenShiftCluster: &api.OpenShiftCluster{},
+			}
+			if !tt.noRegistry {
+				doc.OpenShiftCluster.Properties.RegistryProfiles = []*api.RegistryProfile{
+					{
+						Name:     "other.azurecr.io",
+						Username: "other",
+					},
+					{
+						Name:     acrDomain,
+						Username: "token",
+						Password: "password",
+					},
+				}
+			}
+
+			m := &manager{
+				log:            utillog.GetLogger(),
+				env:            env,
+				doc:            doc,
+				registryClient: s.Client(),
+			}
+
+			ok, err := m.releaseImageAvailable(ctx, &releaseimage.Image{
+				PullSpec: "quay.io/openshift-release-dev/ocp-release@" + digest,
+			})
+			if err != nil && err.Error() != tt.wantErr+acrDomain ||
+				err == nil && tt.wantErr != "" {
+				t.Error(err)
+			}
+			if ok != tt.want {
+				t.Error(ok)
+			}
+			if tt.noRegistry && requests != 0 {
+				t.Error(requests)
+			}
+		})
+	}
+}
+
+func TestSplitPullSpec(t *testing.T) {
+	for _, tt := range []struct {
+		pullSpec       string
+		wantRepository string
+		wantReference  string
+		wantErr        string
+	}{
+		{
+			pullSpec:       "quay.io/openshift-release-dev/ocp-release@sha256:0000000000000000000000000000000000000000000000000000000000000000",
+			wantRepository: "openshift-release-dev/ocp-release",
+			wantReference:  "sha256:0000000000000000000000000000000000000000000000000000000000000000",
+		},
+		{
+			pullSpec:       "arosvc.azurecr.io/openshift-release-dev/ocp-release:4.5.16-x86_64",
+			wantRepository: "openshift-release-dev/ocp-release",
+			wantReference:  "4.5.16-x86_64",
+		},
+		{
+			pullSpec:       "registry:5000/ocp-release",
+			wantRepository: "ocp-release",
+			wantReference:  "latest",
+		},
+		{
+			pullSpec: "ocp-release",
+			wantErr:  `invalid pull spec "ocp-release"`,
+		},
+	} {
+		t.Run(tt.pullSpec, func(t *testing.T) {
+			repository, reference, err := splitPullSpec(tt.pullSpec)
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Error(err)
+			}
+			if repository != tt.wantRepository {
+				t.Error(repository)
+			}
+			if reference != tt.wantReference {
+				t.Error(reference)
+			}
+		})
+	}
+}
@@ -11,13 +11,11 @@ import (
 	configscheme "github.com/openshift/client-go/config/clientset/versioned/scheme"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/util/retry"
-
-	"github.com/Azure/ARO-RP/pkg/util/deployment"
 )
 
 // disableSamples disables the samples if there's no appropriate pull secret
 func (m *manager) disableSamples(ctx context.Context) error {
-	if m.env.DeploymentMode() != deployment.Development &&
+	if !m.env.IsLocalDevelopment() &&
 		m.doc.OpenShiftCluster.Properties.ClusterProfile.PullSecret != "" {
 		return nil
 	}
@@ -38,7 +36,7 @@ func (m *manager) disableSamples(ctx context.Context) error {
 // disableOperatorHubSources disables operator hub sources if there's no
 // appropriate pull secret
 func (m *manager) disableOperatorHubSources(ctx context.Context) error {
-	if m.env.DeploymentMode() != deployment.Development &&
+	if !m.env.IsLocalDevelopment() &&
 		m.doc.OpenShiftCluster.Properties.ClusterProfile.PullSecret != "" {
 		return nil
 	}
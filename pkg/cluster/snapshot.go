@@ -0,0 +1,89 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/stringutils"
+)
+
+// createSnapshot records the state which the subsequent steps of an admin
+// upgrade may change, replacing any earlier snapshot.  It is restored by the
+// restoresnapshot admin action if a step turns out to have broken the cluster.
+func (m *manager) createSnapshot(ctx context.Context) error {
+	resourceGroup := stringutils.LastTokenByte(m.doc.OpenShiftCluster.Properties.ClusterProfile.ResourceGroupID, '/')
+	infraID := m.doc.OpenShiftCluster.Properties.InfraID
+
+	snapshot := &api.OpenShiftClusterSnapshot{
+		CreatedAt:        time.Now().UTC(),
+		APIServerProfile: m.doc.OpenShiftCluster.Properties.APIServerProfile,
+		IngressProfiles:  append([]api.IngressProfile(nil), m.doc.OpenShiftCluster.Properties.IngressProfiles...),
+		NetworkProfile:   m.doc.OpenShiftCluster.Properties.NetworkProfile,
+	}
+
+	for _, name := range []string{infraID, infraID + "-internal"} {
+		lb, err := m.loadBalancers.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			return err
+		}
+
+		b, err := json.Marshal(lb)
+		if err != nil {
+			return err
+		}
+
+		snapshot.LoadBalancers = append(snapshot.LoadBalancers, api.SnapshotLoadBalancer{
+			Name: name,
+			JSON: b,
+		})
+	}
+
+	for _, s := range []struct {
+		namespace string
+		name      string
+	}{
+		{
+			namespace: "openshift-config",
+			name:      m.doc.ID + "-apiserver",
+		},
+		{
+			namespace: "openshift-ingress",
+			name:      m.doc.ID + "-ingress",
+		},
+	} {
+		secret, err := m.kubernetescli.CoreV1().Secrets(s.namespace).Get(ctx, s.name, metav1.GetOptions{})
+		if kerrors.IsNotFound(err) {
+			// no managed domain, or development mode
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		var b []byte
+		b = append(b, secret.Data[corev1.TLSPrivateKeyKey]...)
+		b = append(b, secret.Data[corev1.TLSCertKey]...)
+
+		snapshot.Certificates = append(snapshot.Certificates, api.SnapshotCertificate{
+			Namespace: s.namespace,
+			Name:      s.name,
+			PEM:       b,
+		})
+	}
+
+	var err error
+	m.doc, err = m.db.PatchWithLease(ctx, m.doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+		doc.Snapshot = snapshot
+		return nil
+	})
+	return err
+}
@@ -0,0 +1,129 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	mgmtnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-07-01/network"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	mock_network "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/mgmt/network"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestCreateSnapshot(t *testing.T) {
+	ctx := context.Background()
+	key := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName1"
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	openShiftClustersDatabase, _ := testdatabase.NewFakeOpenShiftClusters()
+	fixture := testdatabase.NewFixture().WithOpenShiftClusters(openShiftClustersDatabase)
+	fixture.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+		ID:  "id",
+		Key: strings.ToLower(key),
+		OpenShiftCluster: &api.OpenShiftCluster{
+			ID: key,
+			Properties: api.OpenShiftClusterProperties{
+				ProvisioningState: api.ProvisioningStateAdminUpdating,
+				ClusterProfile: api.ClusterProfile{
+					ResourceGroupID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/aro-cluster",
+				},
+				InfraID: "infra",
+				APIServerProfile: api.APIServerProfile{
+					IP: "1.2.3.4",
+				},
+				IngressProfiles: []api.IngressProfile{
+					{
+						Name: "default",
+						IP:   "5.6.7.8",
+					},
+				},
+			},
+		},
+	})
+	err := fixture.Create()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err := openShiftClustersDatabase.Dequeue(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loadBalancers := mock_network.NewMockLoadBalancersClient(controller)
+	for _, name := range []string{"infra", "infra-internal"} {
+		loadBalancers.EXPECT().
+			Get(ctx, "aro-cluster", name, "").
+			Return(mgmtnetwork.LoadBalancer{
+				Location: to.StringPtr("eastus"),
+			}, nil)
+	}
+
+	m := &manager{
+		doc:           doc,
+		db:            openShiftClustersDatabase,
+		loadBalancers: loadBalancers,
+		// only the ingress certificate exists
+		kubernetescli: fake.NewSimpleClientset(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "openshift-ingress",
+				Name:      "id-ingress",
+			},
+			Data: map[string][]byte{
+				corev1.TLSCertKey:       []byte("cert"),
+				corev1.TLSPrivateKeyKey: []byte("key"),
+			},
+		}),
+	}
+
+	err = m.createSnapshot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err = openShiftClustersDatabase.Get(ctx, strings.ToLower(key))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshot := doc.Snapshot
+	if snapshot == nil {
+		t.Fatal("snapshot not saved")
+	}
+
+	if snapshot.APIServerProfile.IP != "1.2.3.4" || len(snapshot.IngressProfiles) != 1 || snapshot.IngressProfiles[0].IP != "5.6.7.8" {
+		t.Error(snapshot)
+	}
+
+	if len(snapshot.LoadBalancers) != 2 {
+		t.Error(len(snapshot.LoadBalancers))
+	}
+	if snapshot.LoadBalancers[1].Name != "infra-internal" {
+		t.Error(snapshot.LoadBalancers[1].Name)
+	}
+	var lb mgmtnetwork.LoadBalancer
+	err = json.Unmarshal(snapshot.LoadBalancers[1].JSON, &lb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *lb.Location != "eastus" {
+		t.Error(*lb.Location)
+	}
+
+	if len(snapshot.Certificates) != 1 || snapshot.Certificates[0].Name != "id-ingress" || string(snapshot.Certificates[0].PEM) != "keycert" {
+		t.Error(snapshot.Certificates)
+	}
+}
@@ -0,0 +1,211 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/openshift/installer/pkg/asset/machines/machineconfig"
+	"golang.org/x/crypto/ssh"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/operator"
+)
+
+// sshKeyRoles are the roles whose 99-<role>-ssh MachineConfig, created by the
+// installer, holds the authorized keys of the nodes
+var sshKeyRoles = []string{operator.RoleMaster, operator.RoleWorker}
+
+// authorizedKeys returns the keys which the core user on the nodes should
+// accept, sorted: the RP's key, the key replacing it if a rotation is in
+// progress, and the customer's key, if any
+func authorizedKeys(oc *api.OpenShiftCluster) ([]string, error) {
+	var keys []string
+
+	for _, b := range []api.SecureBytes{oc.Properties.SSHKey, oc.Properties.NewSSHKey} {
+		if b == nil {
+			continue
+		}
+
+		privateKey, err := x509.ParsePKCS1PrivateKey(b)
+		if err != nil {
+			return nil, err
+		}
+
+		sshkey, err := ssh.NewPublicKey(&privateKey.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, sshkey.Type()+" "+base64.StdEncoding.EncodeToString(sshkey.Marshal()))
+	}
+
+	if oc.Properties.ClusterProfile.SSHPublicKey != "" {
+		keys = append(keys, strings.TrimSpace(oc.Properties.ClusterProfile.SSHPublicKey))
+	}
+
+	sort.Strings(keys)
+
+	return keys, nil
+}
+
+// coreAuthorizedKeys returns the authorized keys of the core user in the given
+// ignition config, one per line, sorted.  Only the passwd section is read, as
+// it is the same in the ignition 2.x configs of the installer and the 3.x
+// configs which the MCO renders.
+func coreAuthorizedKeys(raw []byte) ([]string, error) {
+	var config struct {
+		Passwd struct {
+			Users []struct {
+				Name              string   `json:"name"`
+				SSHAuthorizedKeys []string `json:"sshAuthorizedKeys"`
+			} `json:"users"`
+		} `json:"passwd"`
+	}
+
+	err := json.Unmarshal(raw, &config)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	for _, user := range config.Passwd.Users {
+		if user.Name != "core" {
+			continue
+		}
+
+		for _, entry := range user.SSHAuthorizedKeys {
+			for _, key := range strings.Split(entry, "\n") {
+				key = strings.TrimSpace(key)
+				if key != "" {
+					keys = append(keys, key)
+				}
+			}
+		}
+	}
+
+	sort.Strings(keys)
+
+	return keys, nil
+}
+
+// ensureSSHKeys sets the authorized keys in the 99-master-ssh and
+// 99-worker-ssh MachineConfigs to those of authorizedKeys.  The MCO then
+// updates the nodes; sshKeysRolledOut waits for it.
+func (m *manager) ensureSSHKeys(ctx context.Context) error {
+	keys, err := authorizedKeys(m.doc.OpenShiftCluster)
+	if err != nil {
+		return err
+	}
+
+	for _, role := range sshKeyRoles {
+		// the keys go in a single entry, as the installer writes them
+		desired, err := machineconfig.ForAuthorizedKeys(strings.Join(keys, "\n"), role)
+		if err != nil {
+			return err
+		}
+
+		err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			mc, err := m.mcocli.MachineconfigurationV1().MachineConfigs().Get(ctx, desired.Name, metav1.GetOptions{})
+			if kerrors.IsNotFound(err) {
+				m.log.Printf("creating machineconfig %s", desired.Name)
+				_, err = m.mcocli.MachineconfigurationV1().MachineConfigs().Create(ctx, desired, metav1.CreateOptions{})
+				return err
+			}
+			if err != nil {
+				return err
+			}
+
+			current, err := coreAuthorizedKeys(mc.Spec.Config.Raw)
+			if err != nil {
+				return err
+			}
+
+			if reflect.DeepEqual(current, keys) {
+				return nil
+			}
+
+			m.log.Printf("updating the authorized keys in machineconfig %s", mc.Name)
+			mc.Spec.Config = desired.Spec.Config
+
+			_, err = m.mcocli.MachineconfigurationV1().MachineConfigs().Update(ctx, mc, metav1.UpdateOptions{})
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sshKeysRolledOut returns true once the rendered config which the master and
+// worker pools report as current gives the core user exactly the keys of
+// authorizedKeys.  The MCO only moves the current config of a pool on once
+// every machine in the pool has it, so from then on every node accepts the
+// keys which the RP holds and no others.
+func (m *manager) sshKeysRolledOut(ctx context.Context) (bool, error) {
+	keys, err := authorizedKeys(m.doc.OpenShiftCluster)
+	if err != nil {
+		return false, err
+	}
+
+	for _, role := range sshKeyRoles {
+		pool, err := m.mcocli.MachineconfigurationV1().MachineConfigPools().Get(ctx, role, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+
+		if pool.Status.Configuration.Name == "" {
+			return false, nil
+		}
+
+		mc, err := m.mcocli.MachineconfigurationV1().MachineConfigs().Get(ctx, pool.Status.Configuration.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+
+		current, err := coreAuthorizedKeys(mc.Spec.Config.Raw)
+		if err != nil {
+			return false, err
+		}
+
+		if !reflect.DeepEqual(current, keys) {
+			m.log.Printf("machineconfigpool %s: %d of %d machines updated", pool.Name, pool.Status.UpdatedMachineCount, pool.Status.MachineCount)
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// retireSSHKey replaces the RP's SSH key with the key being rotated in, now
+// that every node accepts it.  ensureSSHKeys then removes the old key from the
+// nodes.
+func (m *manager) retireSSHKey(ctx context.Context) error {
+	if m.doc.OpenShiftCluster.Properties.NewSSHKey == nil {
+		return nil
+	}
+
+	m.log.Print("retiring the old RP SSH key")
+
+	var err error
+	m.doc, err = m.db.PatchWithLease(ctx, m.doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+		doc.OpenShiftCluster.Properties.SSHKey = doc.OpenShiftCluster.Properties.NewSSHKey
+		doc.OpenShiftCluster.Properties.NewSSHKey = nil
+		doc.OpenShiftCluster.Properties.CredentialsProfile.SSHKey.Rotate(time.Now(), nil)
+		return nil
+	})
+	return err
+}
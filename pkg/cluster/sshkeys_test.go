@@ -0,0 +1,348 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	mcv1 "github.com/openshift/machine-config-operator/pkg/apis/machineconfiguration.openshift.io/v1"
+	mcofake "github.com/openshift/machine-config-operator/pkg/generated/clientset/versioned/fake"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kruntime "k8s.io/apimachinery/pkg/runtime"
+	ktesting "k8s.io/client-go/testing"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func sshKeysPrivateKey(t *testing.T) api.SecureBytes {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return x509.MarshalPKCS1PrivateKey(key)
+}
+
+func sshKeysMachineConfig(name string, keys ...string) *mcv1.MachineConfig {
+	b, _ := json.Marshal(map[string]interface{}{
+		"ignition": map[string]interface{}{
+			"version": "3.1.0",
+		},
+		"passwd": map[string]interface{}{
+			"users": []interface{}{
+				map[string]interface{}{
+					"name":              "core",
+					"sshAuthorizedKeys": keys,
+				},
+			},
+		},
+	})
+
+	return &mcv1.MachineConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: mcv1.MachineConfigSpec{
+			Config: kruntime.RawExtension{
+				Raw: b,
+			},
+		},
+	}
+}
+
+func sshKeysMachineConfigPool(name, renderedConfig string) *mcv1.MachineConfigPool {
+	return &mcv1.MachineConfigPool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Status: mcv1.MachineConfigPoolStatus{
+			Configuration: mcv1.MachineConfigPoolStatusConfiguration{
+				ObjectReference: corev1.ObjectReference{
+					Name: renderedConfig,
+				},
+			},
+		},
+	}
+}
+
+func TestAuthorizedKeys(t *testing.T) {
+	oldKey := sshKeysPrivateKey(t)
+	newKey := sshKeysPrivateKey(t)
+
+	oc := &api.OpenShiftCluster{
+		Properties: api.OpenShiftClusterProperties{
+			SSHKey: oldKey,
+			ClusterProfile: api.ClusterProfile{
+				SSHPublicKey: "ssh-rsa customer\n",
+			},
+		},
+	}
+
+	keys, err := authorizedKeys(oc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 || keys[1] != "ssh-rsa customer" {
+		t.Fatal(keys)
+	}
+	oldPublicKey := keys[0]
+	if !strings.HasPrefix(oldPublicKey, "ssh-rsa ") {
+		t.Error(oldPublicKey)
+	}
+
+	oc.Properties.NewSSHKey = newKey
+
+	keys, err = authorizedKeys(oc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 3 || !sort.StringsAreSorted(keys) {
+		t.Fatal(keys)
+	}
+	for _, key := range []string{oldPublicKey, "ssh-rsa customer"} {
+		i := sort.SearchStrings(keys, key)
+		if i == len(keys) || keys[i] != key {
+			t.Error(key)
+		}
+	}
+}
+
+func TestCoreAuthorizedKeys(t *testing.T) {
+	mc := sshKeysMachineConfig("99-worker-ssh", "ssh-rsa b\nssh-rsa a\n", " ssh-rsa c")
+
+	keys, err := coreAuthorizedKeys(mc.Spec.Config.Raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(keys, []string{"ssh-rsa a", "ssh-rsa b", "ssh-rsa c"}) {
+		t.Error(keys)
+	}
+
+	keys, err = coreAuthorizedKeys([]byte(`{"passwd":{"users":[{"name":"root","sshAuthorizedKeys":["ssh-rsa a"]}]}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if keys != nil {
+		t.Error(keys)
+	}
+}
+
+func TestEnsureSSHKeys(t *testing.T) {
+	ctx := context.Background()
+
+	oc := &api.OpenShiftCluster{
+		Properties: api.OpenShiftClusterProperties{
+			SSHKey:    sshKeysPrivateKey(t),
+			NewSSHKey: sshKeysPrivateKey(t),
+			ClusterProfile: api.ClusterProfile{
+				SSHPublicKey: "ssh-rsa customer",
+			},
+		},
+	}
+
+	want, err := authorizedKeys(oc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mcocli := mcofake.NewSimpleClientset(
+		sshKeysMachineConfig("99-master-ssh", "ssh-rsa customer"),
+		sshKeysMachineConfig("99-worker-ssh", strings.Join(want, "\n")),
+	)
+
+	m := &manager{
+		log: logrus.NewEntry(logrus.StandardLogger()),
+		doc: &api.OpenShiftClusterDocument{
+			OpenShiftCluster: oc,
+		},
+		mcocli: mcocli,
+	}
+
+	err = m.ensureSSHKeys(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"99-master-ssh", "99-worker-ssh"} {
+		mc, err := mcocli.MachineconfigurationV1().MachineConfigs().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		keys, err := coreAuthorizedKeys(mc.Spec.Config.Raw)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(keys, want) {
+			t.Error(name, keys)
+		}
+	}
+
+	// the worker MachineConfig already had the keys and is left unchanged
+	var updates []string
+	for _, action := range mcocli.Actions() {
+		if action, ok := action.(ktesting.UpdateAction); ok {
+			updates = append(updates, action.GetObject().(*mcv1.MachineConfig).Name)
+		}
+	}
+	if !reflect.DeepEqual(updates, []string{"99-master-ssh"}) {
+		t.Error(updates)
+	}
+}
+
+func TestSSHKeysRolledOut(t *testing.T) {
+	ctx := context.Background()
+
+	oc := &api.OpenShiftCluster{
+		Properties: api.OpenShiftClusterProperties{
+			SSHKey: sshKeysPrivateKey(t),
+		},
+	}
+
+	keys, err := authorizedKeys(oc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		name    string
+		objects []kruntime.Object
+		want    bool
+		wantErr string
+	}{
+		{
+			name: "rolled out",
+			objects: []kruntime.Object{
+				sshKeysMachineConfigPool("master", "rendered-master-new"),
+				sshKeysMachineConfigPool("worker", "rendered-worker-new"),
+				sshKeysMachineConfig("rendered-master-new", keys...),
+				sshKeysMachineConfig("rendered-worker-new", keys...),
+			},
+			want: true,
+		},
+		{
+			name: "worker pool still has the old keys",
+			objects: []kruntime.Object{
+				sshKeysMachineConfigPool("master", "rendered-master-new"),
+				sshKeysMachineConfigPool("worker", "rendered-worker-old"),
+				sshKeysMachineConfig("rendered-master-new", keys...),
+				sshKeysMachineConfig("rendered-worker-old", append([]string{"ssh-rsa old"}, keys...)...),
+			},
+		},
+		{
+			name: "pool has no rendered config yet",
+			objects: []kruntime.Object{
+				sshKeysMachineConfigPool("master", ""),
+			},
+		},
+		{
+			name:    "pool not found",
+			wantErr: `machineconfigpools.machineconfiguration.openshift.io "master" not found`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &manager{
+				log: logrus.NewEntry(logrus.StandardLogger()),
+				doc: &api.OpenShiftClusterDocument{
+					OpenShiftCluster: oc,
+				},
+				mcocli: mcofake.NewSimpleClientset(tt.objects...),
+			}
+
+			rolledOut, err := m.sshKeysRolledOut(ctx)
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Fatal(err)
+			}
+
+			if rolledOut != tt.want {
+				t.Error(rolledOut)
+			}
+		})
+	}
+}
+
+func TestRetireSSHKey(t *testing.T) {
+	ctx := context.Background()
+	key := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName1"
+
+	oldKey := sshKeysPrivateKey(t)
+	newKey := sshKeysPrivateKey(t)
+
+	for _, tt := range []struct {
+		name      string
+		newSSHKey api.SecureBytes
+		wantKey   api.SecureBytes
+	}{
+		{
+			name:      "new key replaces the old key",
+			newSSHKey: newKey,
+			wantKey:   newKey,
+		},
+		{
+			name:    "no rotation in progress",
+			wantKey: oldKey,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			openShiftClustersDatabase, _ := testdatabase.NewFakeOpenShiftClusters()
+			fixture := testdatabase.NewFixture().WithOpenShiftClusters(openShiftClustersDatabase)
+			fixture.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				Key: strings.ToLower(key),
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID: key,
+					Properties: api.OpenShiftClusterProperties{
+						ProvisioningState: api.ProvisioningStateUpdating,
+						SSHKey:            oldKey,
+						NewSSHKey:         tt.newSSHKey,
+					},
+				},
+			})
+			err := fixture.Create()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			doc, err := openShiftClustersDatabase.Dequeue(ctx, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			m := &manager{
+				log: logrus.NewEntry(logrus.StandardLogger()),
+				doc: doc,
+				db:  openShiftClustersDatabase,
+			}
+
+			err = m.retireSSHKey(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			doc, err = openShiftClustersDatabase.Get(ctx, strings.ToLower(key))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(doc.OpenShiftCluster.Properties.SSHKey, tt.wantKey) {
+				t.Error("unexpected SSH key")
+			}
+			if doc.OpenShiftCluster.Properties.NewSSHKey != nil {
+				t.Error("new SSH key not cleared")
+			}
+		})
+	}
+}
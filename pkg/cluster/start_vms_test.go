@@ -7,14 +7,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	mgmtcompute "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/golang/mock/gomock"
 
 	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/compute"
 	mock_compute "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/mgmt/compute"
+	"github.com/Azure/ARO-RP/test/util/fakearm"
 )
 
 func TestStartVMs(t *testing.T) {
@@ -228,3 +236,143 @@ func TestStartVMs(t *testing.T) {
 		})
 	}
 }
+
+func TestStartVMsFakeARM(t *testing.T) {
+	subscriptionID := "00000000-0000-0000-0000-000000000000"
+	clusterRGName := "test-cluster"
+	vmsID := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachines", subscriptionID, clusterRGName)
+
+	vm := func(powerState string) map[string]interface{} {
+		return map[string]interface{}{
+			"properties": map[string]interface{}{
+				"instanceView": map[string]interface{}{
+					"statuses": []interface{}{
+						map[string]interface{}{
+							"code": "ProvisioningState/succeeded",
+						},
+						map[string]interface{}{
+							"code": "PowerState/" + powerState,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	for _, tt := range []struct {
+		name         string
+		faults       []*fakearm.Fault
+		timeout      time.Duration
+		wantErr      string
+		wantRequests map[string]int
+	}{
+		{
+			name: "throttled requests are retried",
+			faults: []*fakearm.Fault{
+				{
+					Method:     http.MethodGet,
+					Path:       "/virtualMachines$",
+					Times:      1,
+					StatusCode: http.StatusTooManyRequests,
+					RetryAfter: 1,
+				},
+				{
+					Method:     http.MethodGet,
+					Path:       "/master-1$",
+					Times:      1,
+					StatusCode: http.StatusServiceUnavailable,
+					RetryAfter: 1,
+				},
+			},
+			wantRequests: map[string]int{
+				"GET " + vmsID:                      2,
+				"GET " + vmsID + "/master-0":        1,
+				"GET " + vmsID + "/master-1":        2,
+				"POST " + vmsID + "/master-1/start": 1,
+				"GET /operations/0":                 3,
+			},
+		},
+		{
+			name: "failed start",
+			faults: []*fakearm.Fault{
+				{
+					Method:       http.MethodPost,
+					Path:         "/start$",
+					AsyncFailure: true,
+				},
+			},
+			wantErr: "Code=\"InternalOperationError\" Message=\"Injected fault.\"",
+		},
+		{
+			name: "unresponsive ARM",
+			faults: []*fakearm.Fault{
+				{
+					Method:  http.MethodGet,
+					Path:    "/master-0$",
+					Latency: time.Minute,
+				},
+			},
+			timeout: 100 * time.Millisecond,
+			wantErr: "context deadline exceeded",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			arm := fakearm.New()
+			defer arm.Close()
+
+			arm.AsyncPolls = 2
+			for _, f := range tt.faults {
+				arm.AddFault(f)
+			}
+
+			for name, powerState := range map[string]string{
+				"master-0": "running",
+				"master-1": "deallocated",
+			} {
+				err := arm.AddResource(vmsID+"/"+name, vm(powerState))
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			ctx := azureclient.WithSender(context.Background(), arm.Sender())
+			if tt.timeout != 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.timeout)
+				defer cancel()
+			}
+
+			m := &manager{
+				virtualMachines: compute.NewVirtualMachinesClient(subscriptionID, &autorest.NullAuthorizer{}),
+				doc: &api.OpenShiftClusterDocument{
+					OpenShiftCluster: &api.OpenShiftCluster{
+						Properties: api.OpenShiftClusterProperties{
+							ClusterProfile: api.ClusterProfile{
+								ResourceGroupID: fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", subscriptionID, clusterRGName),
+							},
+						},
+					},
+				},
+			}
+
+			err := m.startVMs(ctx)
+			if err != nil && !strings.Contains(err.Error(), tt.wantErr) ||
+				err == nil && tt.wantErr != "" {
+				t.Error(err)
+			}
+
+			if tt.wantRequests == nil {
+				return
+			}
+
+			requests := map[string]int{}
+			for _, r := range arm.Requests() {
+				requests[r]++
+			}
+
+			if !reflect.DeepEqual(requests, tt.wantRequests) {
+				t.Error(requests)
+			}
+		})
+	}
+}
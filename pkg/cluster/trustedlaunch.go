@@ -0,0 +1,83 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	mgmtcompute "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/arm"
+	"github.com/Azure/ARO-RP/pkg/util/trustedlaunch"
+)
+
+// trustedLaunchComputeAPIVersion is the first compute API version which
+// supports the security profile of trusted launch VMs
+const trustedLaunchComputeAPIVersion = "2020-12-01"
+
+// virtualMachine is a virtual machine with a security profile, which the
+// vendored compute SDK predates.  Only the fields set on master VMs are
+// carried over.
+type virtualMachine struct {
+	Properties *virtualMachineProperties `json:"properties,omitempty"`
+	Zones      *[]string                 `json:"zones,omitempty"`
+	Name       *string                   `json:"name,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+	Location   *string                   `json:"location,omitempty"`
+}
+
+type virtualMachineProperties struct {
+	HardwareProfile    *mgmtcompute.HardwareProfile    `json:"hardwareProfile,omitempty"`
+	StorageProfile     *mgmtcompute.StorageProfile     `json:"storageProfile,omitempty"`
+	OsProfile          *mgmtcompute.OSProfile          `json:"osProfile,omitempty"`
+	NetworkProfile     *mgmtcompute.NetworkProfile     `json:"networkProfile,omitempty"`
+	DiagnosticsProfile *mgmtcompute.DiagnosticsProfile `json:"diagnosticsProfile,omitempty"`
+	SecurityProfile    *securityProfile                `json:"securityProfile,omitempty"`
+}
+
+type securityProfile struct {
+	SecurityType string        `json:"securityType,omitempty"`
+	UEFISettings *uefiSettings `json:"uefiSettings,omitempty"`
+}
+
+type uefiSettings struct {
+	SecureBootEnabled *bool `json:"secureBootEnabled,omitempty"`
+	VTPMEnabled       *bool `json:"vTpmEnabled,omitempty"`
+}
+
+// trustedLaunchMasterVMs turns the master VMs resource r into trusted launch
+// VMs, with secure boot and the virtual TPM enabled.  Trusted launch VMs need
+// the Gen2 variant of the boot image, which is published with the same
+// versions as the image itself.
+func trustedLaunchMasterVMs(r *arm.Resource) {
+	vm := r.Resource.(*mgmtcompute.VirtualMachine)
+
+	imageReference := *vm.StorageProfile.ImageReference
+	imageReference.Sku = to.StringPtr(bootImageSKU(*imageReference.Sku, api.VMSize(vm.HardwareProfile.VMSize), api.SecurityTypeTrustedLaunch))
+
+	storageProfile := *vm.StorageProfile
+	storageProfile.ImageReference = &imageReference
+
+	r.Resource = &virtualMachine{
+		Properties: &virtualMachineProperties{
+			HardwareProfile:    vm.HardwareProfile,
+			StorageProfile:     &storageProfile,
+			OsProfile:          vm.OsProfile,
+			NetworkProfile:     vm.NetworkProfile,
+			DiagnosticsProfile: vm.DiagnosticsProfile,
+			SecurityProfile: &securityProfile{
+				SecurityType: trustedlaunch.SecurityTypeTrustedLaunch,
+				UEFISettings: &uefiSettings{
+					SecureBootEnabled: to.BoolPtr(true),
+					VTPMEnabled:       to.BoolPtr(true),
+				},
+			},
+		},
+		Zones:    vm.Zones,
+		Name:     vm.Name,
+		Type:     vm.Type,
+		Location: vm.Location,
+	}
+	r.APIVersion = trustedLaunchComputeAPIVersion
+}
@@ -0,0 +1,89 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	mgmtcompute "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+
+	"github.com/Azure/ARO-RP/pkg/util/arm"
+)
+
+func TestTrustedLaunchMasterVMs(t *testing.T) {
+	r := &arm.Resource{
+		Resource: &mgmtcompute.VirtualMachine{
+			VirtualMachineProperties: &mgmtcompute.VirtualMachineProperties{
+				HardwareProfile: &mgmtcompute.HardwareProfile{
+					VMSize: mgmtcompute.VirtualMachineSizeTypesStandardD8sV3,
+				},
+				StorageProfile: &mgmtcompute.StorageProfile{
+					ImageReference: &mgmtcompute.ImageReference{
+						Publisher: to.StringPtr("azureopenshift"),
+						Offer:     to.StringPtr("aro4"),
+						Sku:       to.StringPtr("aro_46"),
+						Version:   to.StringPtr("46.82.20201126"),
+					},
+				},
+			},
+			Zones:    &[]string{"[copyIndex(1)]"},
+			Name:     to.StringPtr("[concat('infra-master-', copyIndex())]"),
+			Type:     to.StringPtr("Microsoft.Compute/virtualMachines"),
+			Location: to.StringPtr("eastus"),
+		},
+		APIVersion: "2019-03-01",
+	}
+
+	trustedLaunchMasterVMs(r)
+
+	b, err := json.Marshal(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got map[string]interface{}
+	err = json.Unmarshal(b, &got)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var want map[string]interface{}
+	err = json.Unmarshal([]byte(`{
+	"properties": {
+		"hardwareProfile": {
+			"vmSize": "Standard_D8s_v3"
+		},
+		"storageProfile": {
+			"imageReference": {
+				"publisher": "azureopenshift",
+				"offer": "aro4",
+				"sku": "aro_46_gen2",
+				"version": "46.82.20201126"
+			}
+		},
+		"securityProfile": {
+			"securityType": "TrustedLaunch",
+			"uefiSettings": {
+				"secureBootEnabled": true,
+				"vTpmEnabled": true
+			}
+		}
+	},
+	"zones": ["[copyIndex(1)]"],
+	"name": "[concat('infra-master-', copyIndex())]",
+	"type": "Microsoft.Compute/virtualMachines",
+	"location": "eastus",
+	"apiVersion": "2020-12-01"
+}`), &want)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Error(string(b))
+	}
+}
@@ -0,0 +1,32 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/util/arm"
+	"github.com/Azure/ARO-RP/pkg/util/deployment"
+)
+
+// ManagedResourceGroupTemplate returns the template of the resources which
+// the RP redeploys into the managed resource group of a running cluster: the
+// role assignment of the cluster service principal and, in production, the
+// deny assignment.  Admin upgrade and drift repair each deploy a subset of
+// it, so a what-if deployment of it predicts the changes they will make.
+func ManagedResourceGroupTemplate(_env env.Interface, oc *api.OpenShiftCluster, subscriptionDoc *api.SubscriptionDocument, clusterSPObjectID string) *arm.Template {
+	t := &arm.Template{
+		Schema:         "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+		ContentVersion: "1.0.0.0",
+		Resources: []*arm.Resource{
+			clusterSPRoleAssignment(clusterSPObjectID),
+		},
+	}
+
+	if _env.DeploymentMode() == deployment.Production {
+		t.Resources = append(t.Resources, denyAssignments(oc, subscriptionDoc, clusterSPObjectID))
+	}
+
+	return t
+}
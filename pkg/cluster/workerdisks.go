@@ -0,0 +1,192 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	machinev1beta1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/util/retry"
+	azureproviderv1beta1 "sigs.k8s.io/cluster-api-provider-azure/pkg/apis/azureprovider/v1beta1"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/operator"
+)
+
+// ensureWorkerDiskSize sets the OS disk size of each worker machineset to the
+// disk size of its worker profile.  This only affects machines created
+// afterwards: workerDisksResized replaces the existing machines.
+func (m *manager) ensureWorkerDiskSize(ctx context.Context) error {
+	machinesets, err := m.maocli.MachineV1beta1().MachineSets(machineSetsNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, machineset := range machinesets.Items {
+		wp := m.machineSetWorkerProfile(&machineset)
+		if wp == nil {
+			continue
+		}
+
+		err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			machineset, err := m.maocli.MachineV1beta1().MachineSets(machineSetsNamespace).Get(ctx, machineset.Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+
+			providerSpec, err := decodeProviderSpec(machineset.Name, &machineset.Spec.Template.Spec.ProviderSpec)
+			if err != nil {
+				return err
+			}
+
+			if providerSpec.OSDisk.DiskSizeGB == int32(wp.DiskSizeGB) {
+				return nil
+			}
+
+			m.log.Printf("updating machineset %s OS disk size from %dGB to %dGB", machineset.Name, providerSpec.OSDisk.DiskSizeGB, wp.DiskSizeGB)
+			providerSpec.OSDisk.DiskSizeGB = int32(wp.DiskSizeGB)
+
+			b, err := json.Marshal(providerSpec)
+			if err != nil {
+				return err
+			}
+
+			machineset.Spec.Template.Spec.ProviderSpec.Value = &runtime.RawExtension{
+				Raw: b,
+			}
+
+			_, err = m.maocli.MachineV1beta1().MachineSets(machineSetsNamespace).Update(ctx, machineset, metav1.UpdateOptions{})
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// workerDisksResized replaces, one at a time, the worker machines whose OS
+// disk is smaller than that of their machineset, and returns true once there
+// are none left.  A machine is only deleted when no other replacement is in
+// progress and every worker machineset has all its replicas ready, so that the
+// cluster is never more than one worker node short.
+func (m *manager) workerDisksResized(ctx context.Context) (bool, error) {
+	machinesets, err := m.maocli.MachineV1beta1().MachineSets(machineSetsNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	diskSizes := map[string]int32{}
+	ready := true
+
+	for _, machineset := range machinesets.Items {
+		wp := m.machineSetWorkerProfile(&machineset)
+		if wp == nil {
+			continue
+		}
+
+		diskSizes[machineset.Name] = int32(wp.DiskSizeGB)
+
+		if machineset.Spec.Replicas != nil &&
+			machineset.Status.ReadyReplicas < *machineset.Spec.Replicas {
+			ready = false
+		}
+	}
+
+	machines, err := m.maocli.MachineV1beta1().Machines(machineSetsNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	var outdated []*machinev1beta1.Machine
+	var total int
+
+	for i, machine := range machines.Items {
+		diskSize, found := diskSizes[machine.Labels["machine.openshift.io/cluster-api-machineset"]]
+		if !found {
+			continue
+		}
+
+		total++
+
+		providerSpec, err := decodeProviderSpec(machine.Name, &machine.Spec.ProviderSpec)
+		if err != nil {
+			return false, err
+		}
+
+		if providerSpec.OSDisk.DiskSizeGB >= diskSize {
+			continue
+		}
+
+		if machine.DeletionTimestamp != nil {
+			ready = false
+		}
+
+		outdated = append(outdated, &machines.Items[i])
+	}
+
+	m.log.Printf("%d of %d worker machines have the requested OS disk size", total-len(outdated), total)
+
+	if len(outdated) == 0 {
+		return true, nil
+	}
+
+	if !ready {
+		return false, nil
+	}
+
+	m.log.Printf("deleting machine %s to replace its OS disk", outdated[0].Name)
+	err = m.maocli.MachineV1beta1().Machines(machineSetsNamespace).Delete(ctx, outdated[0].Name, metav1.DeleteOptions{})
+	if err != nil && !kerrors.IsNotFound(err) {
+		return false, err
+	}
+
+	return false, nil
+}
+
+// machineSetWorkerProfile returns the worker profile of the given machineset,
+// or nil if it is not a worker machineset.  The machinesets created by the
+// installer belong to the first worker profile.
+func (m *manager) machineSetWorkerProfile(machineset *machinev1beta1.MachineSet) *api.WorkerProfile {
+	if name, ok := machineset.Labels[operator.WorkerProfileLabel]; ok {
+		for i, wp := range m.doc.OpenShiftCluster.Properties.AdditionalWorkerProfiles {
+			if wp.Name == name {
+				return &m.doc.OpenShiftCluster.Properties.AdditionalWorkerProfiles[i]
+			}
+		}
+		return nil
+	}
+
+	if machineset.Spec.Template.Labels["machine.openshift.io/cluster-api-machine-role"] == operator.RoleWorker &&
+		len(m.doc.OpenShiftCluster.Properties.WorkerProfiles) > 0 {
+		return &m.doc.OpenShiftCluster.Properties.WorkerProfiles[0]
+	}
+
+	return nil
+}
+
+func decodeProviderSpec(name string, spec *machinev1beta1.ProviderSpec) (*azureproviderv1beta1.AzureMachineProviderSpec, error) {
+	if spec.Value == nil {
+		return nil, fmt.Errorf("%s: provider spec missing", name)
+	}
+
+	o, _, err := scheme.Codecs.UniversalDeserializer().Decode(spec.Value.Raw, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	providerSpec, ok := o.(*azureproviderv1beta1.AzureMachineProviderSpec)
+	if !ok {
+		return nil, fmt.Errorf("%s: failed to read provider spec: %T", name, o)
+	}
+
+	return providerSpec, nil
+}
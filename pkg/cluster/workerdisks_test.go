@@ -0,0 +1,236 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	machinev1beta1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
+	maofake "github.com/openshift/machine-api-operator/pkg/generated/clientset/versioned/fake"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kruntime "k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/operator"
+)
+
+func workerDisksProviderSpec(diskSizeGB int) machinev1beta1.ProviderSpec {
+	return machinev1beta1.ProviderSpec{
+		Value: &kruntime.RawExtension{
+			Raw: []byte(fmt.Sprintf(`{
+"apiVersion": "azureproviderconfig.openshift.io/v1beta1",
+"kind": "AzureMachineProviderSpec",
+"osDisk": {
+	"diskSizeGB": %d
+}
+}`, diskSizeGB)),
+		},
+	}
+}
+
+func workerDisksMachineSet(name, role, workerProfile string, diskSizeGB int, replicas, readyReplicas int32) *machinev1beta1.MachineSet {
+	ms := &machinev1beta1.MachineSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: machineSetsNamespace,
+			Labels:    map[string]string{},
+		},
+		Spec: machinev1beta1.MachineSetSpec{
+			Replicas: &replicas,
+			Template: machinev1beta1.MachineTemplateSpec{
+				ObjectMeta: machinev1beta1.ObjectMeta{
+					Labels: map[string]string{
+						"machine.openshift.io/cluster-api-machine-role": role,
+					},
+				},
+				Spec: machinev1beta1.MachineSpec{
+					ProviderSpec: workerDisksProviderSpec(diskSizeGB),
+				},
+			},
+		},
+		Status: machinev1beta1.MachineSetStatus{
+			ReadyReplicas: readyReplicas,
+		},
+	}
+
+	if workerProfile != "" {
+		ms.Labels[operator.WorkerProfileLabel] = workerProfile
+	}
+
+	return ms
+}
+
+func workerDisksMachine(name, machineset string, diskSizeGB int, deleting bool) *machinev1beta1.Machine {
+	m := &machinev1beta1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: machineSetsNamespace,
+			Labels: map[string]string{
+				"machine.openshift.io/cluster-api-machineset": machineset,
+			},
+		},
+		Spec: machinev1beta1.MachineSpec{
+			ProviderSpec: workerDisksProviderSpec(diskSizeGB),
+		},
+	}
+
+	if deleting {
+		now := metav1.Now()
+		m.DeletionTimestamp = &now
+	}
+
+	return m
+}
+
+func workerDisksDocument() *api.OpenShiftClusterDocument {
+	return &api.OpenShiftClusterDocument{
+		OpenShiftCluster: &api.OpenShiftCluster{
+			Properties: api.OpenShiftClusterProperties{
+				WorkerProfiles: []api.WorkerProfile{
+					{
+						Name:       "worker",
+						DiskSizeGB: 512,
+					},
+				},
+				AdditionalWorkerProfiles: []api.WorkerProfile{
+					{
+						Name:       "gpu",
+						DiskSizeGB: 256,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestEnsureWorkerDiskSize(t *testing.T) {
+	ctx := context.Background()
+
+	maocli := maofake.NewSimpleClientset(
+		workerDisksMachineSet("infra-worker-eastus1", operator.RoleWorker, "", 128, 1, 1),
+		workerDisksMachineSet("infra-gpu-eastus1", operator.RoleWorker, "gpu", 256, 1, 1),
+		workerDisksMachineSet("infra-master", "master", "", 1024, 3, 3),
+	)
+
+	m := &manager{
+		log:    logrus.NewEntry(logrus.StandardLogger()),
+		maocli: maocli,
+		doc:    workerDisksDocument(),
+	}
+
+	err := m.ensureWorkerDiskSize(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, want := range map[string]int32{
+		"infra-worker-eastus1": 512,
+		"infra-gpu-eastus1":    256,
+		"infra-master":         1024,
+	} {
+		ms, err := maocli.MachineV1beta1().MachineSets(machineSetsNamespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		providerSpec, err := decodeProviderSpec(ms.Name, &ms.Spec.Template.Spec.ProviderSpec)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if providerSpec.OSDisk.DiskSizeGB != want {
+			t.Error(name, providerSpec.OSDisk.DiskSizeGB)
+		}
+	}
+}
+
+func TestWorkerDisksResized(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name         string
+		objects      []kruntime.Object
+		wantResized  bool
+		wantMachines []string
+	}{
+		{
+			name: "all machines resized",
+			objects: []kruntime.Object{
+				workerDisksMachineSet("infra-worker-eastus1", operator.RoleWorker, "", 512, 2, 2),
+				workerDisksMachine("infra-worker-eastus1-a", "infra-worker-eastus1", 512, false),
+				workerDisksMachine("infra-worker-eastus1-b", "infra-worker-eastus1", 512, false),
+			},
+			wantResized:  true,
+			wantMachines: []string{"infra-worker-eastus1-a", "infra-worker-eastus1-b"},
+		},
+		{
+			name: "one outdated machine is replaced at a time",
+			objects: []kruntime.Object{
+				workerDisksMachineSet("infra-worker-eastus1", operator.RoleWorker, "", 512, 2, 2),
+				workerDisksMachine("infra-worker-eastus1-a", "infra-worker-eastus1", 128, false),
+				workerDisksMachine("infra-worker-eastus1-b", "infra-worker-eastus1", 128, false),
+				workerDisksMachine("infra-master-0", "", 128, false),
+			},
+			wantMachines: []string{"infra-master-0", "infra-worker-eastus1-b"},
+		},
+		{
+			name: "wait for machinesets to be ready",
+			objects: []kruntime.Object{
+				workerDisksMachineSet("infra-worker-eastus1", operator.RoleWorker, "", 512, 2, 2),
+				workerDisksMachineSet("infra-gpu-eastus1", operator.RoleWorker, "gpu", 256, 1, 0),
+				workerDisksMachine("infra-worker-eastus1-a", "infra-worker-eastus1", 128, false),
+				workerDisksMachine("infra-gpu-eastus1-a", "infra-gpu-eastus1", 256, false),
+			},
+			wantMachines: []string{"infra-gpu-eastus1-a", "infra-worker-eastus1-a"},
+		},
+		{
+			name: "wait for replacement in progress",
+			objects: []kruntime.Object{
+				workerDisksMachineSet("infra-worker-eastus1", operator.RoleWorker, "", 512, 2, 2),
+				workerDisksMachine("infra-worker-eastus1-a", "infra-worker-eastus1", 128, true),
+				workerDisksMachine("infra-worker-eastus1-b", "infra-worker-eastus1", 128, false),
+			},
+			wantMachines: []string{"infra-worker-eastus1-a", "infra-worker-eastus1-b"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			maocli := maofake.NewSimpleClientset(tt.objects...)
+
+			m := &manager{
+				log:    logrus.NewEntry(logrus.StandardLogger()),
+				maocli: maocli,
+				doc:    workerDisksDocument(),
+			}
+
+			resized, err := m.workerDisksResized(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if resized != tt.wantResized {
+				t.Error(resized)
+			}
+
+			machines, err := maocli.MachineV1beta1().Machines(machineSetsNamespace).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var names []string
+			for _, machine := range machines.Items {
+				names = append(names, machine.Name)
+			}
+			sort.Strings(names)
+
+			if !reflect.DeepEqual(names, tt.wantMachines) {
+				t.Error(names)
+			}
+		})
+	}
+}
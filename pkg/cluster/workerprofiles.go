@@ -0,0 +1,269 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	machinev1beta1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/util/retry"
+	azureproviderv1beta1 "sigs.k8s.io/cluster-api-provider-azure/pkg/apis/azureprovider/v1beta1"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/deploy"
+	_ "github.com/Azure/ARO-RP/pkg/util/scheme"
+	"github.com/Azure/ARO-RP/pkg/util/subnet"
+)
+
+const machineSetsNamespace = "openshift-machine-api"
+
+// ensureWorkerProfiles creates a set of machinesets for each additional
+// worker profile, modelled on the worker machinesets created by the installer,
+// and removes the machinesets of worker profiles which no longer exist
+func (m *manager) ensureWorkerProfiles(ctx context.Context) error {
+	machinesets, err := m.maocli.MachineV1beta1().MachineSets(machineSetsNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	var templates []machinev1beta1.MachineSet
+	existing := map[string]struct{}{}
+	wanted := map[string]struct{}{}
+
+	for _, wp := range m.doc.OpenShiftCluster.Properties.AdditionalWorkerProfiles {
+		wanted[wp.Name] = struct{}{}
+	}
+
+	for _, machineset := range machinesets.Items {
+		name, ok := machineset.Labels[operator.WorkerProfileLabel]
+		if !ok {
+			if machineset.Spec.Template.Labels["machine.openshift.io/cluster-api-machine-role"] == operator.RoleWorker {
+				templates = append(templates, machineset)
+			}
+			continue
+		}
+
+		if _, found := wanted[name]; found {
+			existing[machineset.Name] = struct{}{}
+			continue
+		}
+
+		m.log.Printf("deleting machineset %s", machineset.Name)
+		err = m.maocli.MachineV1beta1().MachineSets(machineSetsNamespace).Delete(ctx, machineset.Name, metav1.DeleteOptions{})
+		if err != nil && !kerrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	if len(m.doc.OpenShiftCluster.Properties.AdditionalWorkerProfiles) > 0 && len(templates) == 0 {
+		return fmt.Errorf("no worker machinesets found")
+	}
+
+	for _, wp := range m.doc.OpenShiftCluster.Properties.AdditionalWorkerProfiles {
+		for i := range templates {
+			if _, found := existing[m.workerProfileMachineSetName(&wp, &templates[i])]; found {
+				continue
+			}
+
+			machineset, err := m.workerProfileMachineSet(ctx, &wp, &templates[i], workerProfileReplicas(wp.Count, len(templates), i))
+			if err != nil {
+				return err
+			}
+
+			m.log.Printf("creating machineset %s", machineset.Name)
+			_, err = m.maocli.MachineV1beta1().MachineSets(machineSetsNamespace).Create(ctx, machineset, metav1.CreateOptions{})
+			if err != nil && !kerrors.IsAlreadyExists(err) {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// workerProfileMachineSet returns the machineset for the given worker profile
+// which corresponds to the given (installer-created) machineset template.  The
+// templates are always amd64, so arm64 worker profiles have their boot image
+// switched to the arm64 variant of the template's SKU.
+func (m *manager) workerProfileMachineSet(ctx context.Context, wp *api.WorkerProfile, template *machinev1beta1.MachineSet, replicas int32) (*machinev1beta1.MachineSet, error) {
+	name := m.workerProfileMachineSetName(wp, template)
+
+	if template.Spec.Template.Spec.ProviderSpec.Value == nil {
+		return nil, fmt.Errorf("machineset %s: provider spec missing", template.Name)
+	}
+
+	o, _, err := scheme.Codecs.UniversalDeserializer().Decode(template.Spec.Template.Spec.ProviderSpec.Value.Raw, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	providerSpec, ok := o.(*azureproviderv1beta1.AzureMachineProviderSpec)
+	if !ok {
+		return nil, fmt.Errorf("machineset %s: failed to read provider spec: %T", template.Name, o)
+	}
+
+	vnetID, subnetName, err := subnet.Split(wp.SubnetID)
+	if err != nil {
+		return nil, err
+	}
+
+	vnetr, err := azure.ParseResourceID(vnetID)
+	if err != nil {
+		return nil, err
+	}
+
+	providerSpec.VMSize = string(wp.VMSize)
+	providerSpec.OSDisk.DiskSizeGB = int32(wp.DiskSizeGB)
+	providerSpec.NetworkResourceGroup = vnetr.ResourceGroup
+	providerSpec.Vnet = vnetr.ResourceName
+	providerSpec.Subnet = subnetName
+
+	if isMarketplaceBootImage(&providerSpec.Image) {
+		sku := bootImageSKU(providerSpec.Image.SKU, wp.VMSize, api.SecurityTypeStandard)
+		if sku != providerSpec.Image.SKU {
+			latest, err := m.latestBootImageVersion(ctx, sku)
+			if err != nil {
+				return nil, err
+			}
+			if latest == "" {
+				return nil, fmt.Errorf("no boot images found for %s", sku)
+			}
+
+			providerSpec.Image.SKU = sku
+			providerSpec.Image.Version = latest
+		}
+	}
+
+	b, err := json.Marshal(providerSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	machineset := &machinev1beta1.MachineSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: machineSetsNamespace,
+			Labels:    map[string]string{},
+		},
+		Spec: *template.Spec.DeepCopy(),
+	}
+
+	for k, v := range template.Labels {
+		machineset.Labels[k] = v
+	}
+	machineset.Labels["machine.openshift.io/cluster-api-machineset"] = name
+	machineset.Labels[operator.WorkerProfileLabel] = wp.Name
+
+	machineset.Spec.Replicas = &replicas
+	machineset.Spec.Selector.MatchLabels = map[string]string{}
+	for k, v := range template.Spec.Selector.MatchLabels {
+		machineset.Spec.Selector.MatchLabels[k] = v
+	}
+	machineset.Spec.Selector.MatchLabels["machine.openshift.io/cluster-api-machineset"] = name
+
+	if machineset.Spec.Template.Labels == nil {
+		machineset.Spec.Template.Labels = map[string]string{}
+	}
+	machineset.Spec.Template.Labels["machine.openshift.io/cluster-api-machineset"] = name
+	machineset.Spec.Template.Labels[operator.WorkerProfileLabel] = wp.Name
+
+	machineset.Spec.Template.Spec.ProviderSpec.Value = &runtime.RawExtension{
+		Raw: b,
+	}
+
+	// the node labels and taints of the template belong to its own worker
+	// profile
+	machineset.Spec.Template.Spec.ObjectMeta.Labels = nil
+	if len(wp.NodeLabels) > 0 {
+		machineset.Spec.Template.Spec.ObjectMeta.Labels = map[string]string{}
+		for k, v := range wp.NodeLabels {
+			machineset.Spec.Template.Spec.ObjectMeta.Labels[k] = v
+		}
+	}
+
+	machineset.Spec.Template.Spec.Taints = nil
+	for _, t := range wp.NodeTaints {
+		machineset.Spec.Template.Spec.Taints = append(machineset.Spec.Template.Spec.Taints, corev1.Taint{
+			Key:    t.Key,
+			Value:  t.Value,
+			Effect: corev1.TaintEffect(t.Effect),
+		})
+	}
+
+	return machineset, nil
+}
+
+// ensureWorkerPools copies the node labels and taints of the worker profiles
+// into the Cluster resource, from which the ARO operator keeps the worker
+// machinesets, machines and nodes consistent
+func (m *manager) ensureWorkerPools(ctx context.Context) error {
+	pools := deploy.WorkerPoolsSpec(m.doc.OpenShiftCluster)
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster, err := m.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if reflect.DeepEqual(cluster.Spec.WorkerPools, pools) {
+			return nil
+		}
+
+		cluster.Spec.WorkerPools = pools
+		_, err = m.arocli.Clusters().Update(ctx, cluster, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// ensureComponentResources sets the resource overrides of the managed
+// components in the Cluster resource, which depend on the size of the
+// cluster's worker profiles
+func (m *manager) ensureComponentResources(ctx context.Context) error {
+	resources := deploy.ComponentResourcesSpec(m.doc.OpenShiftCluster)
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster, err := m.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if reflect.DeepEqual(cluster.Spec.ComponentResources, resources) {
+			return nil
+		}
+
+		cluster.Spec.ComponentResources = resources
+		_, err = m.arocli.Clusters().Update(ctx, cluster, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// workerProfileMachineSetName returns the name of the machineset for the given
+// worker profile which corresponds to the given machineset template
+func (m *manager) workerProfileMachineSetName(wp *api.WorkerProfile, template *machinev1beta1.MachineSet) string {
+	infraID := m.doc.OpenShiftCluster.Properties.InfraID
+
+	return infraID + "-" + wp.Name + "-" + strings.TrimPrefix(template.Name, infraID+"-worker-")
+}
+
+// workerProfileReplicas spreads count replicas as evenly as possible across n
+// machinesets and returns the number of replicas for the i'th machineset
+func workerProfileReplicas(count, n, i int) int32 {
+	replicas := count / n
+	if i < count%n {
+		replicas++
+	}
+	return int32(replicas)
+}
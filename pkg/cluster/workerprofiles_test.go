@@ -0,0 +1,554 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	mgmtcompute "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/golang/mock/gomock"
+	machinev1beta1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
+	maofake "github.com/openshift/machine-api-operator/pkg/generated/clientset/versioned/fake"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	azureproviderv1beta1 "sigs.k8s.io/cluster-api-provider-azure/pkg/apis/azureprovider/v1beta1"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	mock_compute "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/mgmt/compute"
+)
+
+func TestEnsureWorkerProfiles(t *testing.T) {
+	ctx := context.Background()
+
+	machineset := func(name, role, workerProfile string) *machinev1beta1.MachineSet {
+		replicas := int32(1)
+		ms := &machinev1beta1.MachineSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: machineSetsNamespace,
+				Labels: map[string]string{
+					"machine.openshift.io/cluster-api-cluster":    "infra",
+					"machine.openshift.io/cluster-api-machineset": name,
+				},
+			},
+			Spec: machinev1beta1.MachineSetSpec{
+				Replicas: &replicas,
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						"machine.openshift.io/cluster-api-cluster":    "infra",
+						"machine.openshift.io/cluster-api-machineset": name,
+					},
+				},
+				Template: machinev1beta1.MachineTemplateSpec{
+					ObjectMeta: machinev1beta1.ObjectMeta{
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-cluster":      "infra",
+							"machine.openshift.io/cluster-api-machine-role": role,
+							"machine.openshift.io/cluster-api-machineset":   name,
+						},
+					},
+					Spec: machinev1beta1.MachineSpec{
+						ProviderSpec: machinev1beta1.ProviderSpec{
+							Value: &runtime.RawExtension{
+								Raw: []byte(`{
+"apiVersion": "azureproviderconfig.openshift.io/v1beta1",
+"kind": "AzureMachineProviderSpec",
+"osDisk": {
+	"diskSizeGB": 128
+},
+"image": {
+	"publisher": "azureopenshift",
+	"offer": "aro4",
+	"sku": "aro_45",
+	"version": "45.82.20201101"
+},
+"networkResourceGroup": "vnet",
+"vnet": "vnet",
+"subnet": "worker",
+"vmSize": "Standard_D4s_v3"
+}`),
+							},
+						},
+					},
+				},
+			},
+		}
+		if workerProfile != "" {
+			ms.Labels[operator.WorkerProfileLabel] = workerProfile
+		}
+		return ms
+	}
+
+	for _, tt := range []struct {
+		name              string
+		machinesets       []runtime.Object
+		workerProfiles    []api.WorkerProfile
+		wantMachineSets   []string
+		wantReplicas      map[string]int32
+		mocks             func(*mock_compute.MockVirtualMachineImagesClient)
+		wantErr           string
+		checkProviderSpec bool
+		wantImage         azureproviderv1beta1.Image
+	}{
+		{
+			name: "machinesets created",
+			machinesets: []runtime.Object{
+				machineset("infra-master-eastus1", "master", ""),
+				machineset("infra-worker-eastus1", "worker", ""),
+				machineset("infra-worker-eastus2", "worker", ""),
+			},
+			workerProfiles: []api.WorkerProfile{
+				{
+					Name:       "gpu",
+					VMSize:     api.VMSizeStandardD8sV3,
+					DiskSizeGB: 256,
+					SubnetID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/network/providers/Microsoft.Network/virtualNetworks/vnet2/subnets/gpu",
+					Count:      3,
+					NodeLabels: map[string]string{
+						"example.com/accelerator": "gpu",
+					},
+					NodeTaints: []api.NodeTaint{
+						{
+							Key:    "nvidia.com/gpu",
+							Effect: api.TaintEffectNoSchedule,
+						},
+					},
+				},
+			},
+			wantMachineSets: []string{
+				"infra-gpu-eastus1",
+				"infra-gpu-eastus2",
+				"infra-master-eastus1",
+				"infra-worker-eastus1",
+				"infra-worker-eastus2",
+			},
+			wantReplicas: map[string]int32{
+				"infra-gpu-eastus1": 2,
+				"infra-gpu-eastus2": 1,
+			},
+			checkProviderSpec: true,
+			wantImage:         azureproviderv1beta1.Image{Publisher: "azureopenshift", Offer: "aro4", SKU: "aro_45", Version: "45.82.20201101"},
+		},
+		{
+			name: "arm64 machinesets created",
+			machinesets: []runtime.Object{
+				machineset("infra-worker-eastus1", "worker", ""),
+			},
+			workerProfiles: []api.WorkerProfile{
+				{
+					Name:       "gpu",
+					VMSize:     api.VMSizeStandardD8psV5,
+					DiskSizeGB: 256,
+					SubnetID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/network/providers/Microsoft.Network/virtualNetworks/vnet2/subnets/gpu",
+					Count:      3,
+				},
+			},
+			mocks: func(virtualMachineImages *mock_compute.MockVirtualMachineImagesClient) {
+				virtualMachineImages.EXPECT().
+					List(gomock.Any(), "eastus", "azureopenshift", "aro4", "aro_45_arm64", "", nil, "").
+					Return(mgmtcompute.ListVirtualMachineImageResource{
+						Value: &[]mgmtcompute.VirtualMachineImageResource{
+							{
+								Name: to.StringPtr("45.82.20201201"),
+							},
+						},
+					}, nil)
+			},
+			wantMachineSets: []string{
+				"infra-gpu-eastus1",
+				"infra-worker-eastus1",
+			},
+			checkProviderSpec: true,
+			wantImage:         azureproviderv1beta1.Image{Publisher: "azureopenshift", Offer: "aro4", SKU: "aro_45_arm64", Version: "45.82.20201201"},
+		},
+		{
+			name: "arm64 boot image missing",
+			machinesets: []runtime.Object{
+				machineset("infra-worker-eastus1", "worker", ""),
+			},
+			workerProfiles: []api.WorkerProfile{
+				{
+					Name:     "gpu",
+					VMSize:   api.VMSizeStandardD8psV5,
+					SubnetID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/network/providers/Microsoft.Network/virtualNetworks/vnet2/subnets/gpu",
+					Count:    3,
+				},
+			},
+			mocks: func(virtualMachineImages *mock_compute.MockVirtualMachineImagesClient) {
+				virtualMachineImages.EXPECT().
+					List(gomock.Any(), "eastus", "azureopenshift", "aro4", "aro_45_arm64", "", nil, "").
+					Return(mgmtcompute.ListVirtualMachineImageResource{}, nil)
+			},
+			wantErr: "no boot images found for aro_45_arm64",
+		},
+		{
+			name: "stale machinesets deleted",
+			machinesets: []runtime.Object{
+				machineset("infra-worker-eastus1", "worker", ""),
+				machineset("infra-gpu-eastus1", "worker", "gpu"),
+				machineset("infra-old-eastus1", "worker", "old"),
+			},
+			workerProfiles: []api.WorkerProfile{
+				{
+					Name:     "gpu",
+					SubnetID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/network/providers/Microsoft.Network/virtualNetworks/vnet2/subnets/gpu",
+					Count:    3,
+				},
+			},
+			wantMachineSets: []string{
+				"infra-gpu-eastus1",
+				"infra-worker-eastus1",
+			},
+		},
+		{
+			name: "no worker machinesets",
+			machinesets: []runtime.Object{
+				machineset("infra-master-eastus1", "master", ""),
+			},
+			workerProfiles: []api.WorkerProfile{
+				{
+					Name: "gpu",
+				},
+			},
+			wantErr: "no worker machinesets found",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			virtualMachineImages := mock_compute.NewMockVirtualMachineImagesClient(controller)
+			if tt.mocks != nil {
+				tt.mocks(virtualMachineImages)
+			}
+
+			maocli := maofake.NewSimpleClientset(tt.machinesets...)
+
+			m := &manager{
+				log:                  logrus.NewEntry(logrus.StandardLogger()),
+				maocli:               maocli,
+				virtualMachineImages: virtualMachineImages,
+				doc: &api.OpenShiftClusterDocument{
+					OpenShiftCluster: &api.OpenShiftCluster{
+						Location: "eastus",
+						Properties: api.OpenShiftClusterProperties{
+							InfraID:                  "infra",
+							AdditionalWorkerProfiles: tt.workerProfiles,
+						},
+					},
+				},
+			}
+
+			err := m.ensureWorkerProfiles(ctx)
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Fatal(err)
+			}
+			if tt.wantErr != "" {
+				return
+			}
+
+			machinesets, err := maocli.MachineV1beta1().MachineSets(machineSetsNamespace).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var names []string
+			for _, ms := range machinesets.Items {
+				names = append(names, ms.Name)
+
+				if replicas, ok := tt.wantReplicas[ms.Name]; ok && *ms.Spec.Replicas != replicas {
+					t.Errorf("%s: got %d replicas, wanted %d", ms.Name, *ms.Spec.Replicas, replicas)
+				}
+
+				if !tt.checkProviderSpec || ms.Labels[operator.WorkerProfileLabel] != "gpu" {
+					continue
+				}
+
+				if ms.Spec.Selector.MatchLabels["machine.openshift.io/cluster-api-machineset"] != ms.Name ||
+					ms.Spec.Template.Labels["machine.openshift.io/cluster-api-machineset"] != ms.Name {
+					t.Errorf("%s: invalid labels", ms.Name)
+				}
+
+				if !reflect.DeepEqual(ms.Spec.Template.Spec.ObjectMeta.Labels, tt.workerProfiles[0].NodeLabels) {
+					t.Errorf("%s: invalid node labels %v", ms.Name, ms.Spec.Template.Spec.ObjectMeta.Labels)
+				}
+
+				if len(ms.Spec.Template.Spec.Taints) != len(tt.workerProfiles[0].NodeTaints) {
+					t.Errorf("%s: invalid node taints %v", ms.Name, ms.Spec.Template.Spec.Taints)
+				}
+				for i, taint := range ms.Spec.Template.Spec.Taints {
+					if taint.Key != tt.workerProfiles[0].NodeTaints[i].Key ||
+						string(taint.Effect) != string(tt.workerProfiles[0].NodeTaints[i].Effect) {
+						t.Errorf("%s: invalid node taint %v", ms.Name, taint)
+					}
+				}
+
+				o, _, err := scheme.Codecs.UniversalDeserializer().Decode(ms.Spec.Template.Spec.ProviderSpec.Value.Raw, nil, nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				providerSpec := o.(*azureproviderv1beta1.AzureMachineProviderSpec)
+				if providerSpec.VMSize != string(tt.workerProfiles[0].VMSize) ||
+					providerSpec.OSDisk.DiskSizeGB != 256 ||
+					providerSpec.NetworkResourceGroup != "network" ||
+					providerSpec.Vnet != "vnet2" ||
+					providerSpec.Subnet != "gpu" ||
+					providerSpec.Image != tt.wantImage {
+					t.Errorf("%s: invalid provider spec %#v", ms.Name, providerSpec)
+				}
+			}
+
+			sort.Strings(names)
+			if !reflect.DeepEqual(names, tt.wantMachineSets) {
+				t.Error(names)
+			}
+		})
+	}
+}
+
+func TestEnsureWorkerPools(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name                     string
+		existing                 []arov1alpha1.WorkerPoolSpec
+		workerProfiles           []api.WorkerProfile
+		additionalWorkerProfiles []api.WorkerProfile
+		want                     []arov1alpha1.WorkerPoolSpec
+	}{
+		{
+			name: "worker pools set",
+			workerProfiles: []api.WorkerProfile{
+				{
+					Name: "worker",
+					NodeLabels: map[string]string{
+						"tier": "general",
+					},
+				},
+			},
+			additionalWorkerProfiles: []api.WorkerProfile{
+				{
+					Name: "plain",
+				},
+				{
+					Name: "gpu",
+					NodeTaints: []api.NodeTaint{
+						{
+							Key:    "nvidia.com/gpu",
+							Value:  "present",
+							Effect: api.TaintEffectNoSchedule,
+						},
+					},
+				},
+			},
+			want: []arov1alpha1.WorkerPoolSpec{
+				{
+					Name: "worker",
+					NodeLabels: map[string]string{
+						"tier": "general",
+					},
+					NodeTaints: []arov1alpha1.NodeTaintSpec{},
+				},
+				{
+					Name:       "gpu",
+					NodeLabels: map[string]string{},
+					NodeTaints: []arov1alpha1.NodeTaintSpec{
+						{
+							Key:    "nvidia.com/gpu",
+							Value:  "present",
+							Effect: "NoSchedule",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "worker pools removed",
+			existing: []arov1alpha1.WorkerPoolSpec{
+				{
+					Name: "worker",
+					NodeLabels: map[string]string{
+						"tier": "general",
+					},
+				},
+			},
+			workerProfiles: []api.WorkerProfile{
+				{
+					Name: "worker",
+				},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &manager{
+				doc: &api.OpenShiftClusterDocument{
+					OpenShiftCluster: &api.OpenShiftCluster{
+						Properties: api.OpenShiftClusterProperties{
+							WorkerProfiles:           tt.workerProfiles,
+							AdditionalWorkerProfiles: tt.additionalWorkerProfiles,
+						},
+					},
+				},
+				arocli: arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: arov1alpha1.SingletonClusterName,
+					},
+					Spec: arov1alpha1.ClusterSpec{
+						WorkerPools: tt.existing,
+					},
+				}).AroV1alpha1(),
+			}
+
+			err := m.ensureWorkerPools(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cluster, err := m.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(cluster.Spec.WorkerPools, tt.want) {
+				t.Error(cluster.Spec.WorkerPools)
+			}
+		})
+	}
+}
+
+func TestEnsureComponentResources(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name                     string
+		existing                 map[string]corev1.ResourceRequirements
+		workerProfiles           []api.WorkerProfile
+		additionalWorkerProfiles []api.WorkerProfile
+		wantSmall                bool
+	}{
+		{
+			name: "small workers",
+			workerProfiles: []api.WorkerProfile{
+				{
+					Name:       "worker",
+					VMSize:     api.VMSizeStandardD4asV4,
+					DiskSizeGB: 128,
+					Count:      3,
+				},
+			},
+			wantSmall: true,
+		},
+		{
+			name: "compact cluster",
+			workerProfiles: []api.WorkerProfile{
+				{
+					Name:   "worker",
+					VMSize: api.VMSizeStandardD16asV4,
+				},
+			},
+			wantSmall: true,
+		},
+		{
+			name: "too many workers",
+			workerProfiles: []api.WorkerProfile{
+				{
+					Name:       "worker",
+					VMSize:     api.VMSizeStandardD4asV4,
+					DiskSizeGB: 128,
+					Count:      3,
+				},
+			},
+			additionalWorkerProfiles: []api.WorkerProfile{
+				{
+					Name:       "extra",
+					VMSize:     api.VMSizeStandardD4asV4,
+					DiskSizeGB: 128,
+					Count:      1,
+				},
+			},
+		},
+		{
+			name: "grown cluster loses its overrides",
+			existing: map[string]corev1.ResourceRequirements{
+				arov1alpha1.ComponentGenevaLogging: {
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("5m"),
+					},
+				},
+			},
+			workerProfiles: []api.WorkerProfile{
+				{
+					Name:       "worker",
+					VMSize:     api.VMSizeStandardD8sV3,
+					DiskSizeGB: 128,
+					Count:      3,
+				},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &manager{
+				doc: &api.OpenShiftClusterDocument{
+					OpenShiftCluster: &api.OpenShiftCluster{
+						Properties: api.OpenShiftClusterProperties{
+							MasterProfile: api.MasterProfile{
+								VMSize: api.VMSizeStandardD8sV3,
+							},
+							WorkerProfiles:           tt.workerProfiles,
+							AdditionalWorkerProfiles: tt.additionalWorkerProfiles,
+						},
+					},
+				},
+				arocli: arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: arov1alpha1.SingletonClusterName,
+					},
+					Spec: arov1alpha1.ClusterSpec{
+						ComponentResources: tt.existing,
+					},
+				}).AroV1alpha1(),
+			}
+
+			err := m.ensureComponentResources(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cluster, err := m.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !tt.wantSmall {
+				if cluster.Spec.ComponentResources != nil {
+					t.Error(cluster.Spec.ComponentResources)
+				}
+				return
+			}
+
+			for _, component := range []string{arov1alpha1.ComponentGenevaLogging, arov1alpha1.ComponentNodeProblemDetector} {
+				r, found := cluster.Spec.ComponentResources[component]
+				if !found {
+					t.Fatalf("no overrides for %s", component)
+				}
+				if r.Requests.Cpu().String() != "5m" {
+					t.Error(component, r.Requests.Cpu())
+				}
+			}
+		})
+	}
+}
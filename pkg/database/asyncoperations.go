@@ -7,13 +7,33 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
 	"github.com/Azure/ARO-RP/pkg/util/deployment"
 )
 
+const (
+	AsyncOperationsUnarchivedQuery     = `SELECT * FROM AsyncOperations doc WHERE doc.asyncOperation.status IN ("Succeeded", "Failed") AND NOT (doc.archived ?? false)`
+	AsyncOperationsFailedSinceQuery    = `SELECT * FROM AsyncOperations doc WHERE doc.asyncOperation.status = "Failed" AND doc._ts >= StringToNumber(@since)`
+	AsyncOperationsCompletedSinceQuery = `SELECT * FROM AsyncOperations doc WHERE doc.asyncOperation.status IN ("Succeeded", "Failed") AND doc._ts >= StringToNumber(@since)`
+)
+
+const (
+	// defaultAsyncOperationsRetention is how long a completed
+	// AsyncOperationDocument is kept in the database unless
+	// ASYNCOPERATIONS_RETENTION is set
+	defaultAsyncOperationsRetention = 2 * 24 * time.Hour
+
+	// minAsyncOperationsRetention leaves the hourly archival at least one pass
+	// over every completed AsyncOperationDocument before it expires
+	minAsyncOperationsRetention = 2 * time.Hour
+)
+
 type asyncOperations struct {
 	c cosmosdb.AsyncOperationDocumentClient
 }
@@ -23,11 +43,15 @@ type AsyncOperations interface {
 	Create(context.Context, *api.AsyncOperationDocument) (*api.AsyncOperationDocument, error)
 	Get(context.Context, string) (*api.AsyncOperationDocument, error)
 	Patch(context.Context, string, func(*api.AsyncOperationDocument) error) (*api.AsyncOperationDocument, error)
+	ListUnarchived() cosmosdb.AsyncOperationDocumentIterator
+	ListFailedSince(time.Time) cosmosdb.AsyncOperationDocumentIterator
+	ListCompletedSince(time.Time) cosmosdb.AsyncOperationDocumentIterator
+	ChangeFeedFrom(string) cosmosdb.AsyncOperationDocumentIterator
 }
 
 // NewAsyncOperations returns a new AsyncOperations
-func NewAsyncOperations(ctx context.Context, deploymentMode deployment.Mode, dbc cosmosdb.DatabaseClient) (AsyncOperations, error) {
-	dbid, err := databaseName(deploymentMode)
+func NewAsyncOperations(ctx context.Context, capabilities deployment.Capabilities, dbc cosmosdb.DatabaseClient) (AsyncOperations, error) {
+	dbid, err := databaseName(capabilities)
 	if err != nil {
 		return nil, err
 	}
@@ -43,6 +67,33 @@ func NewAsyncOperationsWithProvidedClient(client cosmosdb.AsyncOperationDocument
 	}
 }
 
+// CompletedAsyncOperationTTL returns the time in seconds for which a completed
+// AsyncOperationDocument is kept in the database.  It is read from
+// ASYNCOPERATIONS_RETENTION as a duration, e.g. "48h", and defaults to two
+// days.  It overrides the (longer) collection default TTL, which continues to
+// apply to documents of operations which never complete.
+func CompletedAsyncOperationTTL() (int, error) {
+	s, found := os.LookupEnv("ASYNCOPERATIONS_RETENTION")
+	if !found {
+		return int(defaultAsyncOperationsRetention / time.Second), nil
+	}
+
+	return parseAsyncOperationsRetention(s)
+}
+
+func parseAsyncOperationsRetention(s string) (int, error) {
+	retention, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("ASYNCOPERATIONS_RETENTION is invalid: %v", err)
+	}
+
+	if retention < minAsyncOperationsRetention {
+		return 0, fmt.Errorf("ASYNCOPERATIONS_RETENTION is invalid: %s is shorter than the minimum of %s", retention, minAsyncOperationsRetention)
+	}
+
+	return int(retention / time.Second), nil
+}
+
 func (c *asyncOperations) Create(ctx context.Context, doc *api.AsyncOperationDocument) (*api.AsyncOperationDocument, error) {
 	if doc.ID != strings.ToLower(doc.ID) {
 		return nil, fmt.Errorf("id %q is not lower case", doc.ID)
@@ -85,3 +136,48 @@ func (c *asyncOperations) Patch(ctx context.Context, id string, f func(*api.Asyn
 
 	return doc, err
 }
+
+// ListUnarchived returns an iterator over completed AsyncOperationDocuments
+// which have not yet been copied to the archive
+func (c *asyncOperations) ListUnarchived() cosmosdb.AsyncOperationDocumentIterator {
+	return c.c.Query("", &cosmosdb.Query{
+		Query: AsyncOperationsUnarchivedQuery,
+	}, nil)
+}
+
+// ListFailedSince returns an iterator over the failed AsyncOperationDocuments
+// of all clusters which were last written at or after since.  Completed
+// documents expire after a couple of days, so older failures are only found
+// in the archive.
+func (c *asyncOperations) ListFailedSince(since time.Time) cosmosdb.AsyncOperationDocumentIterator {
+	return c.c.Query("", &cosmosdb.Query{
+		Query: AsyncOperationsFailedSinceQuery,
+		Parameters: []cosmosdb.Parameter{
+			{
+				Name:  "@since",
+				Value: strconv.FormatInt(since.Unix(), 10),
+			},
+		},
+	}, nil)
+}
+
+// ListCompletedSince returns an iterator over the succeeded and failed
+// AsyncOperationDocuments of all clusters which were last written at or after
+// since
+func (c *asyncOperations) ListCompletedSince(since time.Time) cosmosdb.AsyncOperationDocumentIterator {
+	return c.c.Query("", &cosmosdb.Query{
+		Query: AsyncOperationsCompletedSinceQuery,
+		Parameters: []cosmosdb.Parameter{
+			{
+				Name:  "@since",
+				Value: strconv.FormatInt(since.Unix(), 10),
+			},
+		},
+	}, nil)
+}
+
+// ChangeFeedFrom returns an iterator over the change feed which resumes from
+// the given continuation, or starts from the beginning if it is empty
+func (c *asyncOperations) ChangeFeedFrom(continuation string) cosmosdb.AsyncOperationDocumentIterator {
+	return c.c.ChangeFeed(&cosmosdb.Options{Continuation: continuation})
+}
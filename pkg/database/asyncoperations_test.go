@@ -0,0 +1,50 @@
+package database
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"testing"
+)
+
+func TestParseAsyncOperationsRetention(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		s       string
+		wantTTL int
+		wantErr string
+	}{
+		{
+			name:    "valid",
+			s:       "72h",
+			wantTTL: 3 * 86400,
+		},
+		{
+			name:    "minimum",
+			s:       "2h",
+			wantTTL: 7200,
+		},
+		{
+			name:    "too short",
+			s:       "90m",
+			wantErr: "ASYNCOPERATIONS_RETENTION is invalid: 1h30m0s is shorter than the minimum of 2h0m0s",
+		},
+		{
+			name:    "not a duration",
+			s:       "2 days",
+			wantErr: `ASYNCOPERATIONS_RETENTION is invalid: time: unknown unit " days" in duration "2 days"`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ttl, err := parseAsyncOperationsRetention(tt.s)
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Fatal(err)
+			}
+
+			if ttl != tt.wantTTL {
+				t.Error(ttl)
+			}
+		})
+	}
+}
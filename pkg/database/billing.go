@@ -30,8 +30,8 @@ type Billing interface {
 }
 
 // NewBilling returns a new Billing
-func NewBilling(ctx context.Context, deploymentMode deployment.Mode, dbc cosmosdb.DatabaseClient) (Billing, error) {
-	dbid, err := databaseName(deploymentMode)
+func NewBilling(ctx context.Context, capabilities deployment.Capabilities, dbc cosmosdb.DatabaseClient) (Billing, error) {
+	dbid, err := databaseName(capabilities)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,89 @@
+package database
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/util/deployment"
+)
+
+type clusterInventories struct {
+	c cosmosdb.ClusterInventoryDocumentClient
+}
+
+// ClusterInventories is the database interface for ClusterInventoryDocuments
+type ClusterInventories interface {
+	Get(context.Context, string) (*api.ClusterInventoryDocument, error)
+	Put(context.Context, *api.ClusterInventoryDocument) (*api.ClusterInventoryDocument, error)
+	ListAll(context.Context) (*api.ClusterInventoryDocuments, error)
+}
+
+// NewClusterInventories returns a new ClusterInventories
+func NewClusterInventories(ctx context.Context, capabilities deployment.Capabilities, dbc cosmosdb.DatabaseClient) (ClusterInventories, error) {
+	dbid, err := databaseName(capabilities)
+	if err != nil {
+		return nil, err
+	}
+
+	collc := cosmosdb.NewCollectionClient(dbc, dbid)
+
+	documentClient := cosmosdb.NewClusterInventoryDocumentClient(collc, collClusterInventories)
+	return NewClusterInventoriesWithProvidedClient(documentClient), nil
+}
+
+func NewClusterInventoriesWithProvidedClient(client cosmosdb.ClusterInventoryDocumentClient) ClusterInventories {
+	return &clusterInventories{
+		c: client,
+	}
+}
+
+func (c *clusterInventories) Get(ctx context.Context, id string) (*api.ClusterInventoryDocument, error) {
+	if id != strings.ToLower(id) {
+		return nil, fmt.Errorf("id %q is not lower case", id)
+	}
+
+	return c.c.Get(ctx, id, id, nil)
+}
+
+// Put stores the latest inventory of a cluster, replacing the one it
+// reported before
+func (c *clusterInventories) Put(ctx context.Context, doc *api.ClusterInventoryDocument) (*api.ClusterInventoryDocument, error) {
+	if doc.ID != strings.ToLower(doc.ID) {
+		return nil, fmt.Errorf("id %q is not lower case", doc.ID)
+	}
+
+	var newDoc *api.ClusterInventoryDocument
+
+	err := cosmosdb.RetryOnPreconditionFailed(func() (err error) {
+		existing, err := c.Get(ctx, doc.ID)
+		switch {
+		case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+			newDoc, err = c.c.Create(ctx, doc.ID, doc, nil)
+
+			// another report created the document first; retry replacing it
+			if err, ok := err.(*cosmosdb.Error); ok && err.StatusCode == http.StatusConflict {
+				err.StatusCode = http.StatusPreconditionFailed
+			}
+			return
+		case err != nil:
+			return
+		}
+
+		doc.ETag = existing.ETag
+		newDoc, err = c.c.Replace(ctx, doc.ID, doc, nil)
+		return
+	})
+
+	return newDoc, err
+}
+
+func (c *clusterInventories) ListAll(ctx context.Context) (*api.ClusterInventoryDocuments, error) {
+	return c.c.ListAll(ctx, nil)
+}
@@ -26,15 +26,18 @@ import (
 )
 
 const (
-	collAsyncOperations   = "AsyncOperations"
-	collBilling           = "Billing"
-	collMonitors          = "Monitors"
-	collOpenShiftClusters = "OpenShiftClusters"
-	collSubscriptions     = "Subscriptions"
+	collAsyncOperations    = "AsyncOperations"
+	collBackends           = "Backends"
+	collBilling            = "Billing"
+	collClusterInventories = "ClusterInventories"
+	collMonitors           = "Monitors"
+	collOpenShiftClusters  = "OpenShiftClusters"
+	collOpenShiftVersions  = "OpenShiftVersions"
+	collSubscriptions      = "Subscriptions"
 )
 
 func NewDatabaseClient(ctx context.Context, log *logrus.Entry, env env.Core, m metrics.Interface, cipher encryption.Cipher) (cosmosdb.DatabaseClient, error) {
-	databaseAccount, masterKey, err := find(ctx, env)
+	databaseAccounts, databaseAccount, masterKey, err := find(ctx, env)
 	if err != nil {
 		return nil, err
 	}
@@ -44,13 +47,24 @@ func NewDatabaseClient(ctx context.Context, log *logrus.Entry, env env.Core, m m
 		return nil, err
 	}
 
+	rt := newRegionRoundTripper(log, m, dbmetrics.New(log, &http.Transport{
+		// disable HTTP/2 for now: https://github.com/golang/go/issues/36026
+		TLSNextProto:        map[string]func(string, *tls.Conn) http.RoundTripper{},
+		MaxIdleConnsPerHost: 20,
+	}, m), databaseAccounts, env.ResourceGroup(), databaseAccount, preferredRegions(env.Location()))
+
+	// if the regions can't be read now, requests go to the global endpoint
+	// until a later refresh succeeds
+	err = rt.refresh(ctx)
+	if err != nil {
+		log.Error(err)
+	}
+
+	go rt.run(ctx)
+
 	c := &http.Client{
-		Transport: dbmetrics.New(log, &http.Transport{
-			// disable HTTP/2 for now: https://github.com/golang/go/issues/36026
-			TLSNextProto:        map[string]func(string, *tls.Conn) http.RoundTripper{},
-			MaxIdleConnsPerHost: 20,
-		}, m),
-		Timeout: 30 * time.Second,
+		Transport: rt,
+		Timeout:   30 * time.Second,
 	}
 
 	databaseHostname := databaseAccount + "." + env.Environment().CosmosDBDNSSuffix
@@ -79,8 +93,8 @@ func NewJSONHandle(cipher encryption.Cipher) (*codec.JsonHandle, error) {
 	return h, nil
 }
 
-func databaseName(deploymentMode deployment.Mode) (string, error) {
-	if deploymentMode != deployment.Development {
+func databaseName(capabilities deployment.Capabilities) (string, error) {
+	if !capabilities.IsLocalDevelopment() {
 		return "ARO", nil
 	}
 
@@ -95,18 +109,18 @@ func databaseName(deploymentMode deployment.Mode) (string, error) {
 	return os.Getenv("DATABASE_NAME"), nil
 }
 
-func find(ctx context.Context, env env.Core) (string, string, error) {
+func find(ctx context.Context, env env.Core) (documentdb.DatabaseAccountsClient, string, string, error) {
 	for _, key := range []string{
 		"DATABASE_ACCOUNT_NAME",
 	} {
 		if _, found := os.LookupEnv(key); !found {
-			return "", "", fmt.Errorf("environment variable %q unset", key)
+			return nil, "", "", fmt.Errorf("environment variable %q unset", key)
 		}
 	}
 
 	rpAuthorizer, err := env.NewRPAuthorizer(env.Environment().ResourceManagerEndpoint)
 	if err != nil {
-		return "", "", err
+		return nil, "", "", err
 	}
 
 	databaseaccounts := documentdb.NewDatabaseAccountsClient(env.SubscriptionID(), rpAuthorizer)
@@ -115,8 +129,8 @@ func find(ctx context.Context, env env.Core) (string, string, error) {
 
 	keys, err := databaseaccounts.ListKeys(ctx, env.ResourceGroup(), acctName)
 	if err != nil {
-		return "", "", err
+		return nil, "", "", err
 	}
 
-	return acctName, *keys.PrimaryMasterKey, nil
+	return databaseaccounts, acctName, *keys.PrimaryMasterKey, nil
 }
@@ -13,6 +13,10 @@ import (
 	"github.com/Azure/ARO-RP/pkg/util/recover"
 )
 
+// inventoryStaleAfter is how long after the last report an inventory is
+// counted as stale.  Operators report every 15 minutes.
+const inventoryStaleAfter = time.Hour
+
 func EmitMetrics(ctx context.Context, log *logrus.Entry, dbOpenShiftClusters OpenShiftClusters, m metrics.Interface) {
 	defer recover.Panic(log)
 	t := time.NewTicker(time.Minute)
@@ -22,8 +26,49 @@ func EmitMetrics(ctx context.Context, log *logrus.Entry, dbOpenShiftClusters Ope
 		i, err := dbOpenShiftClusters.QueueLength(ctx, "OpenShiftClusters")
 		if err != nil {
 			log.Error(err)
-		} else {
-			m.EmitGauge("database.openshiftclusters.queue.length", int64(i), nil)
+			continue
+		}
+		m.EmitGauge("database.openshiftclusters.queue.length", int64(i), nil)
+
+		// the backend schedules deletes and customer updates ahead of creates
+		// and admin updates; report the depth of each class
+		p, err := dbOpenShiftClusters.PriorityQueueLength(ctx, "OpenShiftClusters")
+		if err != nil {
+			log.Error(err)
+			continue
+		}
+		m.EmitGauge("database.openshiftclusters.queue.class.length", int64(p), map[string]string{
+			"class": "priority",
+		})
+		m.EmitGauge("database.openshiftclusters.queue.class.length", int64(i-p), map[string]string{
+			"class": "standard",
+		})
+	}
+}
+
+// EmitClusterInventoryMetrics reports how many clusters have reported their
+// inventory, and how many of their inventories are stale, ie. have not been
+// refreshed for inventoryStaleAfter
+func EmitClusterInventoryMetrics(ctx context.Context, log *logrus.Entry, dbClusterInventories ClusterInventories, m metrics.Interface) {
+	defer recover.Panic(log)
+	t := time.NewTicker(5 * time.Minute)
+	defer t.Stop()
+
+	for range t.C {
+		docs, err := dbClusterInventories.ListAll(ctx)
+		if err != nil {
+			log.Error(err)
+			continue
+		}
+
+		var stale int
+		for _, doc := range docs.ClusterInventoryDocuments {
+			if doc.ClusterInventory == nil || time.Since(doc.ClusterInventory.ReceivedAt) > inventoryStaleAfter {
+				stale++
+			}
 		}
+
+		m.EmitGauge("database.clusterinventories.count", int64(len(docs.ClusterInventoryDocuments)), nil)
+		m.EmitGauge("database.clusterinventories.stale.count", int64(stale), nil)
 	}
 }
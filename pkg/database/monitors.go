@@ -32,8 +32,19 @@ type Monitors interface {
 }
 
 // NewMonitors returns a new Monitors
-func NewMonitors(ctx context.Context, deploymentMode deployment.Mode, dbc cosmosdb.DatabaseClient) (Monitors, error) {
-	dbid, err := databaseName(deploymentMode)
+func NewMonitors(ctx context.Context, capabilities deployment.Capabilities, dbc cosmosdb.DatabaseClient) (Monitors, error) {
+	return newMonitors(ctx, capabilities, dbc, collMonitors)
+}
+
+// NewBackends returns a new Monitors which coordinates the sharing of
+// OpenShiftClusterDocument buckets between backends.  Backends register and
+// elect a master in the same way as monitors do, but in their own collection.
+func NewBackends(ctx context.Context, capabilities deployment.Capabilities, dbc cosmosdb.DatabaseClient) (Monitors, error) {
+	return newMonitors(ctx, capabilities, dbc, collBackends)
+}
+
+func newMonitors(ctx context.Context, capabilities deployment.Capabilities, dbc cosmosdb.DatabaseClient, collid string) (Monitors, error) {
+	dbid, err := databaseName(capabilities)
 	if err != nil {
 		return nil, err
 	}
@@ -55,7 +66,7 @@ func NewMonitors(ctx context.Context, deploymentMode deployment.Mode, dbc cosmos
 		},
 	}
 
-	triggerc := cosmosdb.NewTriggerClient(collc, collMonitors)
+	triggerc := cosmosdb.NewTriggerClient(collc, collid)
 	for _, trigger := range triggers {
 		_, err := triggerc.Create(ctx, trigger)
 		if err != nil && !cosmosdb.IsErrorStatusCode(err, http.StatusConflict) {
@@ -64,7 +75,7 @@ func NewMonitors(ctx context.Context, deploymentMode deployment.Mode, dbc cosmos
 	}
 
 	return &monitors{
-		c:    cosmosdb.NewMonitorDocumentClient(collc, collMonitors),
+		c:    cosmosdb.NewMonitorDocumentClient(collc, collid),
 		uuid: uuid.NewV4().String(),
 	}, nil
 }
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/Azure/go-autorest/autorest/azure"
@@ -18,12 +19,23 @@ import (
 )
 
 const (
-	OpenShiftClustersDequeueQuery       = `SELECT * FROM OpenShiftClusters doc WHERE doc.openShiftCluster.properties.provisioningState IN ("Creating", "Deleting", "Updating", "AdminUpdating") AND (doc.leaseExpires ?? 0) < GetCurrentTimestamp() / 1000`
-	OpenShiftClustersQueueLengthQuery   = `SELECT VALUE COUNT(1) FROM OpenShiftClusters doc WHERE doc.openShiftCluster.properties.provisioningState IN ("Creating", "Deleting", "Updating", "AdminUpdating") AND (doc.leaseExpires ?? 0) < GetCurrentTimestamp() / 1000`
-	OpenShiftClustersGetQuery           = `SELECT * FROM OpenShiftClusters doc WHERE doc.key = @key`
-	OpenshiftClustersPrefixQuery        = `SELECT * FROM OpenShiftClusters doc WHERE STARTSWITH(doc.key, @prefix)`
-	OpenshiftClustersClientIdQuery      = `SELECT * FROM OpenShiftClusters doc WHERE doc.clientIdKey = @clientID`
-	OpenshiftClustersResourceGroupQuery = `SELECT * FROM OpenShiftClusters doc WHERE doc.clusterResourceGroupIdKey = @resourceGroupID`
+	OpenShiftClustersDequeueQuery        = `SELECT * FROM OpenShiftClusters doc WHERE doc.openShiftCluster.properties.provisioningState IN ("Creating", "Deleting", "Updating", "AdminUpdating") AND (doc.leaseExpires ?? 0) < GetCurrentTimestamp() / 1000`
+	OpenShiftClustersDequeueBucketsQuery = `SELECT * FROM OpenShiftClusters doc WHERE doc.openShiftCluster.properties.provisioningState IN ("Creating", "Deleting", "Updating", "AdminUpdating") AND (doc.leaseExpires ?? 0) < GetCurrentTimestamp() / 1000 AND CONTAINS(@buckets, CONCAT(",", ToString(doc.bucket ?? 0), ","))`
+	OpenShiftClustersDequeueStaleQuery   = `SELECT * FROM OpenShiftClusters doc WHERE doc.openShiftCluster.properties.provisioningState IN ("Creating", "Deleting", "Updating", "AdminUpdating") AND (doc.leaseExpires ?? 0) < GetCurrentTimestamp() / 1000 - 60 AND doc._ts < GetCurrentTimestamp() / 1000 - 60`
+	OpenShiftClustersQueueLengthQuery    = `SELECT VALUE COUNT(1) FROM OpenShiftClusters doc WHERE doc.openShiftCluster.properties.provisioningState IN ("Creating", "Deleting", "Updating", "AdminUpdating") AND (doc.leaseExpires ?? 0) < GetCurrentTimestamp() / 1000`
+	OpenShiftClustersGetQuery            = `SELECT * FROM OpenShiftClusters doc WHERE doc.key = @key`
+	OpenshiftClustersPrefixQuery         = `SELECT * FROM OpenShiftClusters doc WHERE STARTSWITH(doc.key, @prefix)`
+	OpenshiftClustersClientIdQuery       = `SELECT * FROM OpenShiftClusters doc WHERE doc.clientIdKey = @clientID`
+	OpenshiftClustersResourceGroupQuery  = `SELECT * FROM OpenShiftClusters doc WHERE doc.clusterResourceGroupIdKey = @resourceGroupID`
+	OpenShiftClustersFollowUpTasksQuery  = `SELECT * FROM OpenShiftClusters doc WHERE ARRAY_LENGTH(doc.followUpTasks ?? []) > 0`
+	OpenShiftClustersCountQuery          = `SELECT VALUE COUNT(1) FROM OpenShiftClusters doc`
+	OpenShiftClustersPrefixCountQuery    = `SELECT VALUE COUNT(1) FROM OpenShiftClusters doc WHERE STARTSWITH(doc.key, @prefix)`
+
+	// the priority queries match deletes and customer updates, which the
+	// backend schedules ahead of creates and admin updates
+	OpenShiftClustersDequeuePriorityQuery        = `SELECT * FROM OpenShiftClusters doc WHERE doc.openShiftCluster.properties.provisioningState IN ("Deleting", "Updating") AND (doc.leaseExpires ?? 0) < GetCurrentTimestamp() / 1000`
+	OpenShiftClustersDequeuePriorityBucketsQuery = `SELECT * FROM OpenShiftClusters doc WHERE doc.openShiftCluster.properties.provisioningState IN ("Deleting", "Updating") AND (doc.leaseExpires ?? 0) < GetCurrentTimestamp() / 1000 AND CONTAINS(@buckets, CONCAT(",", ToString(doc.bucket ?? 0), ","))`
+	OpenShiftClustersPriorityQueueLengthQuery    = `SELECT VALUE COUNT(1) FROM OpenShiftClusters doc WHERE doc.openShiftCluster.properties.provisioningState IN ("Deleting", "Updating") AND (doc.leaseExpires ?? 0) < GetCurrentTimestamp() / 1000`
 )
 
 type openShiftClusters struct {
@@ -37,14 +49,21 @@ type OpenShiftClusters interface {
 	Create(context.Context, *api.OpenShiftClusterDocument) (*api.OpenShiftClusterDocument, error)
 	Get(context.Context, string) (*api.OpenShiftClusterDocument, error)
 	QueueLength(context.Context, string) (int, error)
+	PriorityQueueLength(context.Context, string) (int, error)
+	Count(context.Context, string) (int, error)
+	CountBySubscription(context.Context, string) (int, error)
 	Patch(context.Context, string, func(*api.OpenShiftClusterDocument) error) (*api.OpenShiftClusterDocument, error)
 	PatchWithLease(context.Context, string, func(*api.OpenShiftClusterDocument) error) (*api.OpenShiftClusterDocument, error)
 	Update(context.Context, *api.OpenShiftClusterDocument) (*api.OpenShiftClusterDocument, error)
 	Delete(context.Context, *api.OpenShiftClusterDocument) error
 	ChangeFeed() cosmosdb.OpenShiftClusterDocumentIterator
+	ChangeFeedFrom(string) cosmosdb.OpenShiftClusterDocumentIterator
 	List(string) cosmosdb.OpenShiftClusterDocumentIterator
 	ListByPrefix(string, string, string) (cosmosdb.OpenShiftClusterDocumentIterator, error)
-	Dequeue(context.Context) (*api.OpenShiftClusterDocument, error)
+	ListWithFollowUpTasks() cosmosdb.OpenShiftClusterDocumentIterator
+	Dequeue(context.Context, []int) (*api.OpenShiftClusterDocument, error)
+	DequeuePriority(context.Context, []int) (*api.OpenShiftClusterDocument, error)
+	DequeueStale(context.Context) (*api.OpenShiftClusterDocument, error)
 	Lease(context.Context, string) (*api.OpenShiftClusterDocument, error)
 	EndLease(context.Context, string, api.ProvisioningState, api.ProvisioningState, *string) (*api.OpenShiftClusterDocument, error)
 	GetByClientID(ctx context.Context, partitionKey, clientID string) (*api.OpenShiftClusterDocuments, error)
@@ -52,8 +71,8 @@ type OpenShiftClusters interface {
 }
 
 // NewOpenShiftClusters returns a new OpenShiftClusters
-func NewOpenShiftClusters(ctx context.Context, deploymentMode deployment.Mode, dbc cosmosdb.DatabaseClient) (OpenShiftClusters, error) {
-	dbid, err := databaseName(deploymentMode)
+func NewOpenShiftClusters(ctx context.Context, capabilities deployment.Capabilities, dbc cosmosdb.DatabaseClient) (OpenShiftClusters, error) {
+	dbid, err := databaseName(capabilities)
 	if err != nil {
 		return nil, err
 	}
@@ -151,6 +170,22 @@ func (c *openShiftClusters) Get(ctx context.Context, key string) (*api.OpenShift
 // QueueLength returns OpenShiftClusters un-queued document count.
 // If error occurs, 0 is returned with error message
 func (c *openShiftClusters) QueueLength(ctx context.Context, collid string) (int, error) {
+	return c.count(ctx, collid, OpenShiftClustersQueueLengthQuery)
+}
+
+// PriorityQueueLength returns the count of un-queued documents whose
+// operations are of the priority class
+func (c *openShiftClusters) PriorityQueueLength(ctx context.Context, collid string) (int, error) {
+	return c.count(ctx, collid, OpenShiftClustersPriorityQueueLengthQuery)
+}
+
+// Count returns the count of all the OpenShiftClusterDocuments, i.e. of the
+// clusters in the region
+func (c *openShiftClusters) Count(ctx context.Context, collid string) (int, error) {
+	return c.count(ctx, collid, OpenShiftClustersCountQuery)
+}
+
+func (c *openShiftClusters) count(ctx context.Context, collid, query string) (int, error) {
 	partitions, err := c.collc.PartitionKeyRanges(ctx, collid)
 	if err != nil {
 		return 0, err
@@ -159,7 +194,7 @@ func (c *openShiftClusters) QueueLength(ctx context.Context, collid string) (int
 	var countTotal int
 	for _, r := range partitions.PartitionKeyRanges {
 		result := c.c.Query("", &cosmosdb.Query{
-			Query: OpenShiftClustersQueueLengthQuery,
+			Query: query,
 		}, &cosmosdb.Options{
 			PartitionKeyRangeID: r.ID,
 		})
@@ -179,6 +214,37 @@ func (c *openShiftClusters) QueueLength(ctx context.Context, collid string) (int
 	return countTotal, nil
 }
 
+// CountBySubscription returns the count of the OpenShiftClusterDocuments of
+// a subscription
+func (c *openShiftClusters) CountBySubscription(ctx context.Context, subscriptionID string) (int, error) {
+	subscriptionID = strings.ToLower(subscriptionID)
+
+	result := c.c.Query(subscriptionID, &cosmosdb.Query{
+		Query: OpenShiftClustersPrefixCountQuery,
+		Parameters: []cosmosdb.Parameter{
+			{
+				Name:  "@prefix",
+				Value: "/subscriptions/" + subscriptionID + "/",
+			},
+		},
+	}, nil)
+
+	var data struct {
+		api.MissingFields
+		Document []int `json:"Documents,omitempty"`
+	}
+	err := result.NextRaw(ctx, -1, &data)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(data.Document) == 0 {
+		return 0, nil
+	}
+
+	return data.Document[0], nil
+}
+
 func (c *openShiftClusters) Patch(ctx context.Context, key string, f func(*api.OpenShiftClusterDocument) error) (*api.OpenShiftClusterDocument, error) {
 	return c.patch(ctx, key, f, nil)
 }
@@ -242,6 +308,12 @@ func (c *openShiftClusters) ChangeFeed() cosmosdb.OpenShiftClusterDocumentIterat
 	return c.c.ChangeFeed(nil)
 }
 
+// ChangeFeedFrom returns an iterator over the change feed which resumes from
+// the given continuation, or starts from the beginning if it is empty
+func (c *openShiftClusters) ChangeFeedFrom(continuation string) cosmosdb.OpenShiftClusterDocumentIterator {
+	return c.c.ChangeFeed(&cosmosdb.Options{Continuation: continuation})
+}
+
 func (c *openShiftClusters) List(continuation string) cosmosdb.OpenShiftClusterDocumentIterator {
 	return c.c.List(&cosmosdb.Options{Continuation: continuation})
 }
@@ -266,10 +338,65 @@ func (c *openShiftClusters) ListByPrefix(subscriptionID, prefix, continuation st
 	), nil
 }
 
-func (c *openShiftClusters) Dequeue(ctx context.Context) (*api.OpenShiftClusterDocument, error) {
-	i := c.c.Query("", &cosmosdb.Query{
-		Query: OpenShiftClustersDequeueQuery,
+// ListWithFollowUpTasks returns an iterator over OpenShiftClusterDocuments
+// which have outstanding follow-up tasks
+func (c *openShiftClusters) ListWithFollowUpTasks() cosmosdb.OpenShiftClusterDocumentIterator {
+	return c.c.Query("", &cosmosdb.Query{
+		Query: OpenShiftClustersFollowUpTasksQuery,
 	}, nil)
+}
+
+// Dequeue leases a queued document in one of the given buckets.  If buckets
+// is nil, a queued document in any bucket is leased.
+func (c *openShiftClusters) Dequeue(ctx context.Context, buckets []int) (*api.OpenShiftClusterDocument, error) {
+	return c.dequeueBuckets(ctx, buckets, OpenShiftClustersDequeueQuery, OpenShiftClustersDequeueBucketsQuery)
+}
+
+// DequeuePriority is like Dequeue, but only leases documents whose operations
+// are of the priority class
+func (c *openShiftClusters) DequeuePriority(ctx context.Context, buckets []int) (*api.OpenShiftClusterDocument, error) {
+	return c.dequeueBuckets(ctx, buckets, OpenShiftClustersDequeuePriorityQuery, OpenShiftClustersDequeuePriorityBucketsQuery)
+}
+
+func (c *openShiftClusters) dequeueBuckets(ctx context.Context, buckets []int, query, bucketsQuery string) (*api.OpenShiftClusterDocument, error) {
+	if buckets == nil {
+		return c.dequeue(ctx, &cosmosdb.Query{
+			Query: query,
+		})
+	}
+
+	// Cosmos DB query parameters are strings here, so the buckets are passed
+	// as a comma-delimited list, e.g. ",1,5,", and matched by substring
+	sb := &strings.Builder{}
+	sb.WriteByte(',')
+	for _, bucket := range buckets {
+		sb.WriteString(strconv.Itoa(bucket))
+		sb.WriteByte(',')
+	}
+
+	return c.dequeue(ctx, &cosmosdb.Query{
+		Query: bucketsQuery,
+		Parameters: []cosmosdb.Parameter{
+			{
+				Name:  "@buckets",
+				Value: sb.String(),
+			},
+		},
+	})
+}
+
+// DequeueStale leases a queued document in any bucket which has been waiting
+// for a minute without being picked up, either because its owning backend is
+// saturated or because it has gone away and its buckets are yet to be
+// reassigned
+func (c *openShiftClusters) DequeueStale(ctx context.Context) (*api.OpenShiftClusterDocument, error) {
+	return c.dequeue(ctx, &cosmosdb.Query{
+		Query: OpenShiftClustersDequeueStaleQuery,
+	})
+}
+
+func (c *openShiftClusters) dequeue(ctx context.Context, query *cosmosdb.Query) (*api.OpenShiftClusterDocument, error) {
+	i := c.c.Query("", query, nil)
 
 	for {
 		docs, err := i.Next(ctx, -1)
@@ -318,6 +445,7 @@ func (c *openShiftClusters) EndLease(ctx context.Context, key string, provisioni
 
 			doc.CorrelationData = nil
 			doc.OpenShiftCluster.Properties.LastProvisioningState = ""
+			doc.OpenShiftCluster.Properties.Progress = nil
 			doc.AsyncOperationID = ""
 		}
 
@@ -0,0 +1,236 @@
+package database
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/ugorji/go/codec"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/util/recover"
+)
+
+const (
+	openShiftClustersCacheInterval = 5 * time.Second
+	openShiftClustersCacheMaxAge   = time.Minute
+)
+
+// OpenShiftClustersCache is an OpenShiftClusters whose Get is served from
+// memory where it is safe to do so and the caller has opted in with
+// WithCachedReads.  The cache is populated from the OpenShiftClusters change
+// feed, which Run must be running to read.
+type OpenShiftClustersCache interface {
+	OpenShiftClusters
+	Run(context.Context, <-chan struct{})
+}
+
+type openShiftClustersCache struct {
+	OpenShiftClusters
+
+	log *logrus.Entry
+	h   *codec.JsonHandle
+
+	mu             sync.RWMutex
+	docs           map[string][]byte
+	written        map[string]time.Time
+	lastChangefeed time.Time
+}
+
+// WithCachedReads marks ctx as belonging to a read which may be served by an
+// OpenShiftClustersCache.  A cached document does not reflect writes made by
+// other processes until the change feed is next read, so only reads which
+// tolerate that, such as admin reads, should use it.  ARM reads must not:
+// after a PUT or PATCH through another frontend, a client polling the
+// cluster could otherwise see its previous, terminal provisioning state.
+func WithCachedReads(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKeyCachedReads, true)
+}
+
+func cachedReads(ctx context.Context) bool {
+	b, _ := ctx.Value(contextKeyCachedReads).(bool)
+	return b
+}
+
+// NewOpenShiftClustersCache returns a new OpenShiftClustersCache over db.
+//
+// Get returns a cached document only if ctx allows it (see WithCachedReads),
+// the change feed was read completely within openShiftClustersCacheMaxAge,
+// the cluster is in a terminal provisioning state, and the document has not
+// been written through the cache since the change feed was last read.  Documents which are mid-operation
+// change often and are read back by their operation, so they always come from
+// the database, as does any document which was written by this process and
+// may not have reached the cache yet.  Documents written by other processes
+// may be served up to openShiftClustersCacheInterval stale.
+func NewOpenShiftClustersCache(log *logrus.Entry, db OpenShiftClusters) OpenShiftClustersCache {
+	return &openShiftClustersCache{
+		OpenShiftClusters: db,
+
+		log: log,
+		h:   &codec.JsonHandle{},
+
+		docs:    map[string][]byte{},
+		written: map[string]time.Time{},
+	}
+}
+
+// Run reads the change feed every openShiftClustersCacheInterval until stop
+// is closed.  Deleted documents do not appear in the change feed: they are
+// never served from the cache because they are only deleted from the Deleting
+// provisioning state.
+func (c *openShiftClustersCache) Run(ctx context.Context, stop <-chan struct{}) {
+	defer recover.Panic(c.log)
+
+	i := c.OpenShiftClusters.ChangeFeed()
+
+	t := time.NewTicker(openShiftClustersCacheInterval)
+	defer t.Stop()
+
+	for {
+		err := c.readChangefeed(ctx, i)
+		if err != nil {
+			c.log.Error(err)
+		}
+
+		select {
+		case <-t.C:
+		case <-stop:
+			return
+		}
+	}
+}
+
+func (c *openShiftClustersCache) readChangefeed(ctx context.Context, i cosmosdb.OpenShiftClusterDocumentIterator) error {
+	start := time.Now()
+
+	for {
+		docs, err := i.Next(ctx, -1)
+		if err != nil {
+			return err
+		}
+		if docs == nil {
+			break
+		}
+
+		for _, doc := range docs.OpenShiftClusterDocuments {
+			var b []byte
+			err = codec.NewEncoderBytes(&b, c.h).Encode(doc)
+			if err != nil {
+				return err
+			}
+
+			c.mu.Lock()
+			c.docs[doc.Key] = b
+			c.mu.Unlock()
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// writes made before this read began are now reflected in the cache
+	for key, t := range c.written {
+		if t.Before(start) {
+			delete(c.written, key)
+		}
+	}
+
+	c.lastChangefeed = start
+
+	return nil
+}
+
+func (c *openShiftClustersCache) Get(ctx context.Context, key string) (*api.OpenShiftClusterDocument, error) {
+	if !cachedReads(ctx) {
+		return c.OpenShiftClusters.Get(ctx, key)
+	}
+
+	if doc := c.get(key); doc != nil {
+		return doc, nil
+	}
+
+	return c.OpenShiftClusters.Get(ctx, key)
+}
+
+// get returns a copy of the cached document with the given key, or nil if it
+// must be read from the database
+func (c *openShiftClustersCache) get(key string) *api.OpenShiftClusterDocument {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if time.Since(c.lastChangefeed) > openShiftClustersCacheMaxAge {
+		return nil
+	}
+
+	if _, found := c.written[key]; found {
+		return nil
+	}
+
+	b, found := c.docs[key]
+	if !found {
+		return nil
+	}
+
+	var doc *api.OpenShiftClusterDocument
+	err := codec.NewDecoderBytes(b, c.h).Decode(&doc)
+	if err != nil {
+		c.log.Error(err)
+		return nil
+	}
+
+	if !doc.OpenShiftCluster.Properties.ProvisioningState.IsTerminal() {
+		return nil
+	}
+
+	return doc
+}
+
+// invalidate stops the document with the given key being served from the
+// cache until the change feed has been read again.  It is called before every
+// write, including those which fail on a stale ETag.
+func (c *openShiftClustersCache) invalidate(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.written[key] = time.Now()
+}
+
+func (c *openShiftClustersCache) Create(ctx context.Context, doc *api.OpenShiftClusterDocument) (*api.OpenShiftClusterDocument, error) {
+	c.invalidate(doc.Key)
+	return c.OpenShiftClusters.Create(ctx, doc)
+}
+
+func (c *openShiftClustersCache) Patch(ctx context.Context, key string, f func(*api.OpenShiftClusterDocument) error) (*api.OpenShiftClusterDocument, error) {
+	c.invalidate(key)
+	return c.OpenShiftClusters.Patch(ctx, key, f)
+}
+
+func (c *openShiftClustersCache) PatchWithLease(ctx context.Context, key string, f func(*api.OpenShiftClusterDocument) error) (*api.OpenShiftClusterDocument, error) {
+	c.invalidate(key)
+	return c.OpenShiftClusters.PatchWithLease(ctx, key, f)
+}
+
+func (c *openShiftClustersCache) Update(ctx context.Context, doc *api.OpenShiftClusterDocument) (*api.OpenShiftClusterDocument, error) {
+	c.invalidate(doc.Key)
+	return c.OpenShiftClusters.Update(ctx, doc)
+}
+
+func (c *openShiftClustersCache) Delete(ctx context.Context, doc *api.OpenShiftClusterDocument) error {
+	c.invalidate(doc.Key)
+	return c.OpenShiftClusters.Delete(ctx, doc)
+}
+
+func (c *openShiftClustersCache) Lease(ctx context.Context, key string) (*api.OpenShiftClusterDocument, error) {
+	c.invalidate(key)
+	return c.OpenShiftClusters.Lease(ctx, key)
+}
+
+func (c *openShiftClustersCache) EndLease(ctx context.Context, key string, provisioningState, failedProvisioningState api.ProvisioningState, adminUpdateError *string) (*api.OpenShiftClusterDocument, error) {
+	c.invalidate(key)
+	return c.OpenShiftClusters.EndLease(ctx, key, provisioningState, failedProvisioningState, adminUpdateError)
+}
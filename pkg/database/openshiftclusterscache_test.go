@@ -0,0 +1,147 @@
+package database
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+type fakeChangefeed struct {
+	batches []*api.OpenShiftClusterDocuments
+}
+
+func (i *fakeChangefeed) Next(context.Context, int) (*api.OpenShiftClusterDocuments, error) {
+	if len(i.batches) == 0 {
+		return nil, nil
+	}
+
+	docs := i.batches[0]
+	i.batches = i.batches[1:]
+	return docs, nil
+}
+
+func (i *fakeChangefeed) Continuation() string {
+	return ""
+}
+
+type fakeOpenShiftClusters struct {
+	OpenShiftClusters
+
+	gets int
+}
+
+func (db *fakeOpenShiftClusters) Get(ctx context.Context, key string) (*api.OpenShiftClusterDocument, error) {
+	db.gets++
+	return openShiftClustersCacheDoc(key, api.ProvisioningStateSucceeded, "db"), nil
+}
+
+func (db *fakeOpenShiftClusters) Patch(ctx context.Context, key string, f func(*api.OpenShiftClusterDocument) error) (*api.OpenShiftClusterDocument, error) {
+	return nil, nil
+}
+
+func openShiftClustersCacheDoc(key string, provisioningState api.ProvisioningState, version string) *api.OpenShiftClusterDocument {
+	return &api.OpenShiftClusterDocument{
+		Key: key,
+		OpenShiftCluster: &api.OpenShiftCluster{
+			Properties: api.OpenShiftClusterProperties{
+				ProvisioningState: provisioningState,
+				ProvisionedBy:     version,
+				SSHKey:            api.SecureBytes("key"),
+			},
+		},
+	}
+}
+
+func TestOpenShiftClustersCache(t *testing.T) {
+	ctx := WithCachedReads(context.Background())
+
+	db := &fakeOpenShiftClusters{}
+	c := NewOpenShiftClustersCache(logrus.NewEntry(logrus.StandardLogger()), db).(*openShiftClustersCache)
+
+	get := func(key, wantVersion string, wantGets int) {
+		t.Helper()
+
+		doc, err := c.Get(ctx, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if doc.OpenShiftCluster.Properties.ProvisionedBy != wantVersion {
+			t.Error(doc.OpenShiftCluster.Properties.ProvisionedBy)
+		}
+		if string(doc.OpenShiftCluster.Properties.SSHKey) != "key" {
+			t.Error(string(doc.OpenShiftCluster.Properties.SSHKey))
+		}
+		if db.gets != wantGets {
+			t.Error(db.gets)
+		}
+	}
+
+	// nothing is served before the change feed has been read
+	get("succeeded", "db", 1)
+
+	err := c.readChangefeed(ctx, &fakeChangefeed{
+		batches: []*api.OpenShiftClusterDocuments{
+			{
+				OpenShiftClusterDocuments: []*api.OpenShiftClusterDocument{
+					openShiftClustersCacheDoc("succeeded", api.ProvisioningStateSucceeded, "cache"),
+					openShiftClustersCacheDoc("updating", api.ProvisioningStateUpdating, "cache"),
+				},
+			},
+			{
+				OpenShiftClusterDocuments: []*api.OpenShiftClusterDocument{
+					openShiftClustersCacheDoc("failed", api.ProvisioningStateFailed, "cache"),
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	get("succeeded", "cache", 1)
+	get("failed", "cache", 1)
+	get("updating", "db", 2)
+	get("missing", "db", 3)
+
+	// reads which have not opted in always go to the database
+	doc, err := c.Get(context.Background(), "succeeded")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc.OpenShiftCluster.Properties.ProvisionedBy != "db" {
+		t.Error(doc.OpenShiftCluster.Properties.ProvisionedBy)
+	}
+	get("succeeded", "cache", 4)
+
+	// callers may modify what they get
+	doc, err = c.Get(ctx, "succeeded")
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc.OpenShiftCluster.Properties.ProvisionedBy = "modified"
+	get("succeeded", "cache", 4)
+
+	// a write sends reads to the database until the change feed is read again
+	_, err = c.Patch(ctx, "succeeded", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	get("succeeded", "db", 5)
+
+	err = c.readChangefeed(ctx, &fakeChangefeed{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	get("succeeded", "cache", 5)
+
+	// a stale cache is not used
+	c.lastChangefeed = time.Now().Add(-2 * openShiftClustersCacheMaxAge)
+	get("succeeded", "db", 6)
+}
@@ -0,0 +1,155 @@
+package database
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+// Sealer seals and unseals the secrets of OpenShiftClusterDocuments which are
+// encrypted at rest with a customer-managed key
+type Sealer interface {
+	Seal(context.Context, *api.OpenShiftClusterDocument) (*api.OpenShiftClusterDocument, error)
+	Unseal(context.Context, *api.OpenShiftClusterDocument) error
+}
+
+type sealedOpenShiftClusters struct {
+	OpenShiftClusters
+
+	sealer Sealer
+}
+
+// NewSealedOpenShiftClusters returns an OpenShiftClusters over db which seals
+// the secrets of every document it writes and unseals those of every document
+// which is read to be worked on.  Listed documents and the change feed are not
+// unsealed, nor are those returned by Lease and EndLease: their secrets stay
+// sealed and their key state is as last written.
+func NewSealedOpenShiftClusters(db OpenShiftClusters, sealer Sealer) OpenShiftClusters {
+	return &sealedOpenShiftClusters{
+		OpenShiftClusters: db,
+
+		sealer: sealer,
+	}
+}
+
+func (c *sealedOpenShiftClusters) unseal(ctx context.Context, doc *api.OpenShiftClusterDocument) (*api.OpenShiftClusterDocument, error) {
+	if doc == nil {
+		return nil, nil
+	}
+
+	err := c.sealer.Unseal(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// patchFunc wraps f so that it works on the unsealed document and the
+// document is sealed again before it is written
+func (c *sealedOpenShiftClusters) patchFunc(ctx context.Context, f func(*api.OpenShiftClusterDocument) error) func(*api.OpenShiftClusterDocument) error {
+	return func(doc *api.OpenShiftClusterDocument) error {
+		err := c.sealer.Unseal(ctx, doc)
+		if err != nil {
+			return err
+		}
+
+		err = f(doc)
+		if err != nil {
+			return err
+		}
+
+		sealed, err := c.sealer.Seal(ctx, doc)
+		if err != nil {
+			return err
+		}
+
+		*doc = *sealed
+		return nil
+	}
+}
+
+func (c *sealedOpenShiftClusters) Create(ctx context.Context, doc *api.OpenShiftClusterDocument) (*api.OpenShiftClusterDocument, error) {
+	doc, err := c.sealer.Seal(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err = c.OpenShiftClusters.Create(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.unseal(ctx, doc)
+}
+
+func (c *sealedOpenShiftClusters) Get(ctx context.Context, key string) (*api.OpenShiftClusterDocument, error) {
+	doc, err := c.OpenShiftClusters.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.unseal(ctx, doc)
+}
+
+func (c *sealedOpenShiftClusters) Patch(ctx context.Context, key string, f func(*api.OpenShiftClusterDocument) error) (*api.OpenShiftClusterDocument, error) {
+	doc, err := c.OpenShiftClusters.Patch(ctx, key, c.patchFunc(ctx, f))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.unseal(ctx, doc)
+}
+
+func (c *sealedOpenShiftClusters) PatchWithLease(ctx context.Context, key string, f func(*api.OpenShiftClusterDocument) error) (*api.OpenShiftClusterDocument, error) {
+	doc, err := c.OpenShiftClusters.PatchWithLease(ctx, key, c.patchFunc(ctx, f))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.unseal(ctx, doc)
+}
+
+func (c *sealedOpenShiftClusters) Update(ctx context.Context, doc *api.OpenShiftClusterDocument) (*api.OpenShiftClusterDocument, error) {
+	doc, err := c.sealer.Seal(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err = c.OpenShiftClusters.Update(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.unseal(ctx, doc)
+}
+
+func (c *sealedOpenShiftClusters) Dequeue(ctx context.Context, buckets []int) (*api.OpenShiftClusterDocument, error) {
+	doc, err := c.OpenShiftClusters.Dequeue(ctx, buckets)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.unseal(ctx, doc)
+}
+
+func (c *sealedOpenShiftClusters) DequeuePriority(ctx context.Context, buckets []int) (*api.OpenShiftClusterDocument, error) {
+	doc, err := c.OpenShiftClusters.DequeuePriority(ctx, buckets)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.unseal(ctx, doc)
+}
+
+func (c *sealedOpenShiftClusters) DequeueStale(ctx context.Context) (*api.OpenShiftClusterDocument, error) {
+	doc, err := c.OpenShiftClusters.DequeueStale(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.unseal(ctx, doc)
+}
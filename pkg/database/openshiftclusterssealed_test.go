@@ -0,0 +1,125 @@
+package database
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+// fakeSealer seals the kubeadmin password by moving it into the sealed
+// secrets
+type fakeSealer struct{}
+
+func (fakeSealer) Seal(ctx context.Context, doc *api.OpenShiftClusterDocument) (*api.OpenShiftClusterDocument, error) {
+	docCopy := *doc
+	oc := *doc.OpenShiftCluster
+	docCopy.OpenShiftCluster = &oc
+
+	oc.Properties.EncryptionAtRestProfile = &api.EncryptionAtRestProfile{
+		SealedSecrets: api.SecureBytes(oc.Properties.KubeadminPassword),
+	}
+	oc.Properties.KubeadminPassword = ""
+
+	return &docCopy, nil
+}
+
+func (fakeSealer) Unseal(ctx context.Context, doc *api.OpenShiftClusterDocument) error {
+	p := &doc.OpenShiftCluster.Properties
+	p.KubeadminPassword = api.SecureString(p.EncryptionAtRestProfile.SealedSecrets)
+	p.EncryptionAtRestProfile.Unsealed = true
+	return nil
+}
+
+type fakeSealedOpenShiftClusters struct {
+	OpenShiftClusters
+
+	stored *api.OpenShiftClusterDocument
+}
+
+func (db *fakeSealedOpenShiftClusters) copy() *api.OpenShiftClusterDocument {
+	doc := *db.stored
+	oc := *db.stored.OpenShiftCluster
+	p := *oc.Properties.EncryptionAtRestProfile
+	oc.Properties.EncryptionAtRestProfile = &p
+	doc.OpenShiftCluster = &oc
+	return &doc
+}
+
+func (db *fakeSealedOpenShiftClusters) Create(ctx context.Context, doc *api.OpenShiftClusterDocument) (*api.OpenShiftClusterDocument, error) {
+	db.stored = doc
+	return db.copy(), nil
+}
+
+func (db *fakeSealedOpenShiftClusters) Get(ctx context.Context, key string) (*api.OpenShiftClusterDocument, error) {
+	return db.copy(), nil
+}
+
+func (db *fakeSealedOpenShiftClusters) Patch(ctx context.Context, key string, f func(*api.OpenShiftClusterDocument) error) (*api.OpenShiftClusterDocument, error) {
+	doc := db.copy()
+	err := f(doc)
+	if err != nil {
+		return nil, err
+	}
+	db.stored = doc
+	return db.copy(), nil
+}
+
+func TestSealedOpenShiftClusters(t *testing.T) {
+	ctx := context.Background()
+
+	fake := &fakeSealedOpenShiftClusters{}
+	db := NewSealedOpenShiftClusters(fake, fakeSealer{})
+
+	doc := &api.OpenShiftClusterDocument{
+		Key: "key",
+		OpenShiftCluster: &api.OpenShiftCluster{
+			Properties: api.OpenShiftClusterProperties{
+				KubeadminPassword: "password",
+			},
+		},
+	}
+
+	created, err := db.Create(ctx, doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc.OpenShiftCluster.Properties.KubeadminPassword != "password" {
+		t.Error("argument was sealed")
+	}
+	if fake.stored.OpenShiftCluster.Properties.KubeadminPassword != "" {
+		t.Error("stored document was not sealed")
+	}
+	if created.OpenShiftCluster.Properties.KubeadminPassword != "password" {
+		t.Error("created document was not unsealed")
+	}
+
+	got, err := db.Get(ctx, "key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.OpenShiftCluster.Properties.KubeadminPassword != "password" {
+		t.Error("got document was not unsealed")
+	}
+
+	patched, err := db.Patch(ctx, "key", func(doc *api.OpenShiftClusterDocument) error {
+		if !doc.OpenShiftCluster.Properties.EncryptionAtRestProfile.Unsealed {
+			t.Error("patched document was not unsealed")
+		}
+		doc.OpenShiftCluster.Properties.KubeadminPassword = "new password"
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fake.stored.OpenShiftCluster.Properties.KubeadminPassword != "" ||
+		string(fake.stored.OpenShiftCluster.Properties.EncryptionAtRestProfile.SealedSecrets) != "new password" {
+		t.Error("patched document was not sealed")
+	}
+	if patched.OpenShiftCluster.Properties.KubeadminPassword != "new password" {
+		t.Error("patched document was not unsealed")
+	}
+}
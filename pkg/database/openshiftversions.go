@@ -0,0 +1,108 @@
+package database
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/util/deployment"
+	"github.com/Azure/ARO-RP/pkg/util/version"
+)
+
+type openShiftVersions struct {
+	c cosmosdb.OpenShiftVersionDocumentClient
+}
+
+// OpenShiftVersions is the database interface for OpenShiftVersionDocuments
+type OpenShiftVersions interface {
+	Create(context.Context, *api.OpenShiftVersionDocument) (*api.OpenShiftVersionDocument, error)
+	Get(context.Context, string) (*api.OpenShiftVersionDocument, error)
+	Update(context.Context, *api.OpenShiftVersionDocument) (*api.OpenShiftVersionDocument, error)
+	ListAll(context.Context) (*api.OpenShiftVersionDocuments, error)
+	GetInstallable(context.Context, string) (*api.OpenShiftVersion, error)
+}
+
+// NewOpenShiftVersions returns a new OpenShiftVersions
+func NewOpenShiftVersions(ctx context.Context, capabilities deployment.Capabilities, dbc cosmosdb.DatabaseClient) (OpenShiftVersions, error) {
+	dbid, err := databaseName(capabilities)
+	if err != nil {
+		return nil, err
+	}
+
+	collc := cosmosdb.NewCollectionClient(dbc, dbid)
+
+	documentClient := cosmosdb.NewOpenShiftVersionDocumentClient(collc, collOpenShiftVersions)
+	return NewOpenShiftVersionsWithProvidedClient(documentClient), nil
+}
+
+func NewOpenShiftVersionsWithProvidedClient(client cosmosdb.OpenShiftVersionDocumentClient) OpenShiftVersions {
+	return &openShiftVersions{
+		c: client,
+	}
+}
+
+func (c *openShiftVersions) Create(ctx context.Context, doc *api.OpenShiftVersionDocument) (*api.OpenShiftVersionDocument, error) {
+	if doc.ID != strings.ToLower(doc.ID) {
+		return nil, fmt.Errorf("id %q is not lower case", doc.ID)
+	}
+
+	doc, err := c.c.Create(ctx, doc.ID, doc, nil)
+
+	if err, ok := err.(*cosmosdb.Error); ok && err.StatusCode == http.StatusConflict {
+		err.StatusCode = http.StatusPreconditionFailed
+	}
+
+	return doc, err
+}
+
+func (c *openShiftVersions) Get(ctx context.Context, id string) (*api.OpenShiftVersionDocument, error) {
+	if id != strings.ToLower(id) {
+		return nil, fmt.Errorf("id %q is not lower case", id)
+	}
+
+	return c.c.Get(ctx, id, id, nil)
+}
+
+func (c *openShiftVersions) Update(ctx context.Context, doc *api.OpenShiftVersionDocument) (*api.OpenShiftVersionDocument, error) {
+	if doc.ID != strings.ToLower(doc.ID) {
+		return nil, fmt.Errorf("id %q is not lower case", doc.ID)
+	}
+
+	return c.c.Replace(ctx, doc.ID, doc, nil)
+}
+
+func (c *openShiftVersions) ListAll(ctx context.Context) (*api.OpenShiftVersionDocuments, error) {
+	return c.c.ListAll(ctx, nil)
+}
+
+// GetInstallable returns the OpenShift version vsn if this RP can install it,
+// otherwise nil.  The InstallStream is installable unless a document
+// deprecates it.
+func (c *openShiftVersions) GetInstallable(ctx context.Context, vsn string) (*api.OpenShiftVersion, error) {
+	var v *api.OpenShiftVersion
+
+	doc, err := c.Get(ctx, vsn)
+	switch {
+	case err == nil:
+		v = doc.OpenShiftVersion
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		if vsn != version.InstallStream.Version.String() {
+			return nil, nil
+		}
+		v = version.DefaultOpenShiftVersion()
+	default:
+		return nil, err
+	}
+
+	if !version.Installable(v) {
+		return nil, nil
+	}
+
+	return v, nil
+}
@@ -0,0 +1,478 @@
+package database
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	mgmtdocumentdb "github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2019-08-01/documentdb"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/metrics"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/documentdb"
+	"github.com/Azure/ARO-RP/pkg/util/recover"
+)
+
+const (
+	// regionRefreshInterval is how often the account's regions are re-read
+	// to pick up failovers initiated outside the RP
+	regionRefreshInterval = time.Minute
+
+	// regionMinRefreshInterval rate limits the refreshes triggered by
+	// failing requests
+	regionMinRefreshInterval = 10 * time.Second
+
+	// substatusWriteForbidden is returned by a region which is no longer
+	// the write region
+	substatusWriteForbidden = "3"
+
+	// substatusReadSessionNotAvailable is returned by a region which has not
+	// yet replicated the writes of the session token sent with a read
+	substatusReadSessionNotAvailable = "1002"
+)
+
+type contextKey int
+
+const (
+	contextKeyReadReplicas contextKey = iota
+	contextKeyCachedReads
+)
+
+// WithReadReplicas marks ctx as belonging to a read-only bulk workload, such
+// as monitor enumeration or admin queries.  Reads issued with the returned
+// context are served by a read region other than the write region where
+// there is one, so that they don't compete with customer operations for the
+// write region's throughput.  They are made with session consistency: the
+// session tokens of earlier requests are sent along, so that the workload
+// still reads its own writes.
+func WithReadReplicas(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKeyReadReplicas, true)
+}
+
+func readReplicas(ctx context.Context) bool {
+	b, _ := ctx.Value(contextKeyReadReplicas).(bool)
+	return b
+}
+
+type regionEndpoint struct {
+	region string
+	host   string
+}
+
+var _ http.RoundTripper = (*regionRoundTripper)(nil)
+
+// regionRoundTripper routes Cosmos DB requests to the regional endpoints of
+// the database account: writes to the current write region, and reads to the
+// first readable region in the preferred region list.  It notices failovers
+// both by periodically re-reading the account and by watching for requests
+// which fail in a way characteristic of a region change, so that the RP keeps
+// working across a regional failover without a restart.  Reads of read-only
+// workloads (see WithReadReplicas) are routed to a secondary read region.
+type regionRoundTripper struct {
+	log *logrus.Entry
+	m   metrics.Interface
+	tr  http.RoundTripper
+
+	databaseAccounts documentdb.DatabaseAccountsClient
+	resourceGroup    string
+	accountName      string
+	preferredRegions []string
+
+	now func() time.Time
+
+	mu          sync.RWMutex
+	write       *regionEndpoint
+	read        *regionEndpoint
+	replica     *regionEndpoint
+	lastRefresh time.Time
+
+	// sessions holds the latest session token of each collection
+	sessions map[string]string
+}
+
+func newRegionRoundTripper(log *logrus.Entry, m metrics.Interface, tr http.RoundTripper, databaseAccounts documentdb.DatabaseAccountsClient, resourceGroup, accountName string, preferredRegions []string) *regionRoundTripper {
+	return &regionRoundTripper{
+		log: log,
+		m:   m,
+		tr:  tr,
+
+		databaseAccounts: databaseAccounts,
+		resourceGroup:    resourceGroup,
+		accountName:      accountName,
+		preferredRegions: preferredRegions,
+
+		now: time.Now,
+
+		sessions: map[string]string{},
+	}
+}
+
+// preferredRegions returns the regions from which the RP prefers to read, in
+// order.  It defaults to the RP's own region.
+func preferredRegions(location string) []string {
+	if regions, found := os.LookupEnv("DATABASE_PREFERRED_REGIONS"); found {
+		return strings.Split(regions, ",")
+	}
+
+	return []string{location}
+}
+
+// normalizeRegion maps both ARM location names (e.g. "eastus") and display
+// names (e.g. "East US") to the same form
+func normalizeRegion(region string) string {
+	return strings.ToLower(strings.ReplaceAll(region, " ", ""))
+}
+
+func (rt *regionRoundTripper) run(ctx context.Context) {
+	defer recover.Panic(rt.log)
+
+	t := time.NewTicker(regionRefreshInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			return
+		}
+
+		err := rt.refresh(ctx)
+		if err != nil {
+			rt.log.Error(err)
+		}
+	}
+}
+
+func (rt *regionRoundTripper) refresh(ctx context.Context) error {
+	rt.mu.Lock()
+	rt.lastRefresh = rt.now()
+	rt.mu.Unlock()
+
+	acct, err := rt.databaseAccounts.Get(ctx, rt.resourceGroup, rt.accountName)
+	if err != nil {
+		return err
+	}
+
+	write, read, replica := rt.selectEndpoints(acct.DatabaseAccountGetProperties)
+
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	if write != nil && (rt.write == nil || *rt.write != *write) {
+		rt.log.Printf("using write region %s", write.region)
+	}
+	if read != nil && (rt.read == nil || *rt.read != *read) {
+		rt.log.Printf("using read region %s", read.region)
+	}
+	if replica != nil && (rt.replica == nil || *rt.replica != *replica) {
+		rt.log.Printf("using read replica region %s", replica.region)
+	}
+
+	rt.write, rt.read, rt.replica = write, read, replica
+
+	return nil
+}
+
+// selectEndpoints returns the endpoints of the write region, of the first
+// readable preferred region (falling back to the write region), and of the
+// first readable preferred region other than the write region (falling back
+// to the first such region in failover order, or nil if there is none)
+func (rt *regionRoundTripper) selectEndpoints(props *mgmtdocumentdb.DatabaseAccountGetProperties) (write, read, replica *regionEndpoint) {
+	if props == nil {
+		return nil, nil, nil
+	}
+
+	if props.WriteLocations != nil {
+		for _, l := range *props.WriteLocations {
+			if e := endpoint(l); e != nil {
+				write = e
+				break
+			}
+		}
+	}
+
+	var secondaries []*regionEndpoint
+	readable := map[string]*regionEndpoint{}
+	if props.ReadLocations != nil {
+		for _, l := range *props.ReadLocations {
+			if e := endpoint(l); e != nil {
+				readable[e.region] = e
+				if write == nil || e.region != write.region {
+					secondaries = append(secondaries, e)
+				}
+			}
+		}
+	}
+
+	for _, region := range rt.preferredRegions {
+		if e, found := readable[normalizeRegion(region)]; found {
+			if read == nil {
+				read = e
+			}
+			if replica == nil && (write == nil || e.region != write.region) {
+				replica = e
+			}
+		}
+	}
+
+	if read == nil {
+		read = write
+	}
+
+	if replica == nil && len(secondaries) > 0 {
+		replica = secondaries[0]
+	}
+
+	return write, read, replica
+}
+
+func endpoint(l mgmtdocumentdb.Location) *regionEndpoint {
+	if l.LocationName == nil || l.DocumentEndpoint == nil {
+		return nil
+	}
+
+	u, err := url.Parse(*l.DocumentEndpoint)
+	if err != nil || u.Hostname() == "" {
+		return nil
+	}
+
+	return &regionEndpoint{
+		region: normalizeRegion(*l.LocationName),
+		host:   u.Hostname(),
+	}
+}
+
+// isRead returns true if req is served by any readable region
+func isRead(req *http.Request) bool {
+	// queries are POSTs, but are served by any readable region
+	return req.Method == http.MethodGet || req.Method == http.MethodHead ||
+		strings.EqualFold(req.Header.Get("x-ms-documentdb-isquery"), "true")
+}
+
+func (rt *regionRoundTripper) endpointFor(req *http.Request) *regionEndpoint {
+	rt.mu.RLock()
+	defer rt.mu.RUnlock()
+
+	if isRead(req) {
+		return rt.read
+	}
+
+	return rt.write
+}
+
+// replicaFor returns the endpoint of the read replica region if req is a
+// read of a read-only workload, or nil
+func (rt *regionRoundTripper) replicaFor(req *http.Request) *regionEndpoint {
+	if !isRead(req) || !readReplicas(req.Context()) {
+		return nil
+	}
+
+	rt.mu.RLock()
+	defer rt.mu.RUnlock()
+
+	return rt.replica
+}
+
+// collection returns the path of the collection which the request path p
+// refers to, or "" if it doesn't refer to one
+func collection(p string) string {
+	parts := strings.Split(strings.Trim(p, "/"), "/")
+	if len(parts) < 4 || parts[0] != "dbs" || parts[2] != "colls" {
+		return ""
+	}
+
+	return strings.Join(parts[:4], "/")
+}
+
+// session returns the latest session token of the collection of req
+func (rt *regionRoundTripper) session(req *http.Request) string {
+	rt.mu.RLock()
+	defer rt.mu.RUnlock()
+
+	return rt.sessions[collection(req.URL.Path)]
+}
+
+// recordSession records the session token returned in resp for the
+// collection of req
+func (rt *regionRoundTripper) recordSession(req *http.Request, resp *http.Response) {
+	coll := collection(req.URL.Path)
+	token := resp.Header.Get("x-ms-session-token")
+	if coll == "" || token == "" {
+		return
+	}
+
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	rt.sessions[coll] = mergeSessionTokens(rt.sessions[coll], token)
+}
+
+// mergeSessionTokens merges the comma separated, per partition key range
+// session tokens in token into those in tokens, replacing the tokens of
+// partition key ranges which are in both
+func mergeSessionTokens(tokens, token string) string {
+	var ranges []string
+	byRange := map[string]string{}
+
+	for _, t := range strings.Split(tokens+","+token, ",") {
+		if t == "" {
+			continue
+		}
+
+		r := strings.SplitN(t, ":", 2)[0]
+		if _, found := byRange[r]; !found {
+			ranges = append(ranges, r)
+		}
+		byRange[r] = t
+	}
+
+	merged := make([]string, 0, len(ranges))
+	for _, r := range ranges {
+		merged = append(merged, byRange[r])
+	}
+
+	return strings.Join(merged, ",")
+}
+
+// sessionNotAvailable returns true if a replica has not yet caught up with
+// the session token sent with a read
+func sessionNotAvailable(resp *http.Response, err error) bool {
+	return err == nil &&
+		resp.StatusCode == http.StatusNotFound &&
+		resp.Header.Get("x-ms-substatus") == substatusReadSessionNotAvailable
+}
+
+// failedOver returns true if the outcome of a request suggests that the
+// region it was sent to is no longer serving that kind of request
+func failedOver(resp *http.Response, err error) bool {
+	switch {
+	case err != nil:
+		return true
+	case resp.StatusCode == http.StatusServiceUnavailable:
+		return true
+	case resp.StatusCode == http.StatusForbidden && resp.Header.Get("x-ms-substatus") == substatusWriteForbidden:
+		return true
+	}
+
+	return false
+}
+
+func (rt *regionRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	// buffer the body so that the request can be resent to another region
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if replica := rt.replicaFor(req); replica != nil {
+		resp, err := rt.roundTripReplica(req, body, replica)
+		if !failedOver(resp, err) && !sessionNotAvailable(resp, err) {
+			return resp, err
+		}
+
+		// fall back to the regular read region
+		rt.log.Debugf("retrying %s %s outside read replica region %s", req.Method, req.URL.Path, replica.region)
+		if resp != nil {
+			resp.Body.Close()
+		}
+	}
+
+	e := rt.endpointFor(req)
+	resp, err := rt.roundTrip(req, body, e)
+	if !failedOver(resp, err) {
+		return resp, err
+	}
+
+	rt.mu.RLock()
+	refreshDue := rt.now().Sub(rt.lastRefresh) >= regionMinRefreshInterval
+	rt.mu.RUnlock()
+
+	if !refreshDue {
+		return resp, err
+	}
+
+	refreshErr := rt.refresh(req.Context())
+	if refreshErr != nil {
+		rt.log.Error(refreshErr)
+		return resp, err
+	}
+
+	newE := rt.endpointFor(req)
+	if newE == nil || e != nil && *newE == *e {
+		return resp, err
+	}
+
+	rt.log.Warnf("retrying %s %s in region %s", req.Method, req.URL.Path, newE.region)
+	if resp != nil {
+		resp.Body.Close()
+	}
+
+	return rt.roundTrip(req, body, newE)
+}
+
+// roundTripReplica sends the read req to the read replica region e with
+// session consistency, which may be weaker than the account's default
+func (rt *regionRoundTripper) roundTripReplica(req *http.Request, body []byte, e *regionEndpoint) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("x-ms-consistency-level", "Session")
+	if token := rt.session(req); token != "" && req.Header.Get("x-ms-session-token") == "" {
+		req.Header.Set("x-ms-session-token", token)
+	}
+
+	return rt.roundTrip(req, body, e)
+}
+
+func (rt *regionRoundTripper) roundTrip(req *http.Request, body []byte, e *regionEndpoint) (resp *http.Response, err error) {
+	req = req.Clone(req.Context())
+	if body != nil {
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	// before the account's regions are known, fall back to the global
+	// endpoint
+	region := "global"
+	if e != nil {
+		region = e.region
+		req.URL.Host = e.host
+		req.Host = e.host
+	}
+
+	start := rt.now()
+
+	defer func() {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+
+		dims := map[string]string{
+			"code":   strconv.Itoa(statusCode),
+			"region": region,
+		}
+
+		rt.m.EmitGauge("client.cosmosdb.region.count", 1, dims)
+		rt.m.EmitGauge("client.cosmosdb.region.duration", rt.now().Sub(start).Milliseconds(), dims)
+	}()
+
+	resp, err = rt.tr.RoundTrip(req)
+	if err == nil {
+		rt.recordSession(req, resp)
+	}
+
+	return resp, err
+}
@@ -0,0 +1,347 @@
+package database
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	mgmtdocumentdb "github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2019-08-01/documentdb"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+
+	mock_metrics "github.com/Azure/ARO-RP/pkg/util/mocks/metrics"
+)
+
+type fakeDatabaseAccounts struct {
+	write string
+	read  []string
+}
+
+func (f *fakeDatabaseAccounts) Get(ctx context.Context, resourceGroupName string, accountName string) (mgmtdocumentdb.DatabaseAccountGetResults, error) {
+	location := func(region string) mgmtdocumentdb.Location {
+		return mgmtdocumentdb.Location{
+			LocationName:     to.StringPtr(region),
+			DocumentEndpoint: to.StringPtr("https://account-" + normalizeRegion(region) + ".documents.azure.com:443/"),
+		}
+	}
+
+	props := &mgmtdocumentdb.DatabaseAccountGetProperties{
+		WriteLocations: &[]mgmtdocumentdb.Location{location(f.write)},
+		ReadLocations:  &[]mgmtdocumentdb.Location{},
+	}
+	for _, region := range f.read {
+		*props.ReadLocations = append(*props.ReadLocations, location(region))
+	}
+
+	return mgmtdocumentdb.DatabaseAccountGetResults{
+		DatabaseAccountGetProperties: props,
+	}, nil
+}
+
+func (f *fakeDatabaseAccounts) ListKeys(ctx context.Context, resourceGroupName string, accountName string) (mgmtdocumentdb.DatabaseAccountListKeysResult, error) {
+	return mgmtdocumentdb.DatabaseAccountListKeysResult{}, nil
+}
+
+type fakeRoundTripper struct {
+	hosts   []string
+	bodies  []string
+	respond func(*http.Request) *http.Response
+}
+
+func (f *fakeRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	f.hosts = append(f.hosts, req.URL.Host)
+	if req.Body != nil {
+		b, _ := ioutil.ReadAll(req.Body)
+		f.bodies = append(f.bodies, string(b))
+	}
+	return f.respond(req), nil
+}
+
+func response(statusCode int, substatus string) *http.Response {
+	resp := &http.Response{
+		StatusCode: statusCode,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(&bytes.Buffer{}),
+	}
+	if substatus != "" {
+		resp.Header.Set("x-ms-substatus", substatus)
+	}
+	return resp
+}
+
+func TestRegionRoundTripper(t *testing.T) {
+	ctx := context.Background()
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	m := mock_metrics.NewMockInterface(controller)
+	m.EXPECT().EmitGauge(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	accounts := &fakeDatabaseAccounts{
+		write: "East US",
+		read:  []string{"East US", "West Europe"},
+	}
+
+	tr := &fakeRoundTripper{}
+
+	now := time.Now()
+	rt := newRegionRoundTripper(logrus.NewEntry(logrus.StandardLogger()), m, tr, accounts, "rg", "account", []string{"westeurope", "eastus"})
+	rt.now = func() time.Time { return now }
+
+	request := func(method, body string) *http.Request {
+		req, err := http.NewRequestWithContext(ctx, method, "https://account.documents.azure.com/dbs/ARO", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if body != "" {
+			req.Body = ioutil.NopCloser(bytes.NewBufferString(body))
+		}
+		return req
+	}
+
+	// before the regions are known, the global endpoint is used
+	tr.respond = func(*http.Request) *http.Response { return response(http.StatusOK, "") }
+	_, err := rt.RoundTrip(request(http.MethodGet, ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = rt.refresh(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// reads go to the preferred region, writes to the write region
+	_, err = rt.RoundTrip(request(http.MethodGet, ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = rt.RoundTrip(request(http.MethodPost, "doc"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// after a failover, a write forbidden response causes the regions to be
+	// re-read and the write to be resent to the new write region
+	accounts.write = "West Europe"
+	now = now.Add(regionMinRefreshInterval)
+	tr.respond = func(req *http.Request) *http.Response {
+		if req.URL.Host == "account-eastus.documents.azure.com" {
+			return response(http.StatusForbidden, substatusWriteForbidden)
+		}
+		return response(http.StatusCreated, "")
+	}
+
+	resp, err := rt.RoundTrip(request(http.MethodPost, "doc2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusCreated {
+		t.Error(resp.StatusCode)
+	}
+
+	wantHosts := []string{
+		"account.documents.azure.com",
+		"account-westeurope.documents.azure.com",
+		"account-eastus.documents.azure.com",
+		"account-eastus.documents.azure.com",
+		"account-westeurope.documents.azure.com",
+	}
+	if len(tr.hosts) != len(wantHosts) {
+		t.Fatal(tr.hosts)
+	}
+	for i := range wantHosts {
+		if tr.hosts[i] != wantHosts[i] {
+			t.Error(i, tr.hosts[i])
+		}
+	}
+
+	wantBodies := []string{"doc", "doc2", "doc2"}
+	if len(tr.bodies) != len(wantBodies) {
+		t.Fatal(tr.bodies)
+	}
+	for i := range wantBodies {
+		if tr.bodies[i] != wantBodies[i] {
+			t.Error(i, tr.bodies[i])
+		}
+	}
+}
+
+func TestSelectEndpointsFallsBackToWriteRegion(t *testing.T) {
+	rt := &regionRoundTripper{preferredRegions: []string{"australiaeast"}}
+
+	acct, _ := (&fakeDatabaseAccounts{
+		write: "East US",
+		read:  []string{"East US", "West Europe"},
+	}).Get(context.Background(), "", "")
+
+	write, read, replica := rt.selectEndpoints(acct.DatabaseAccountGetProperties)
+	if write.region != "eastus" || read.region != "eastus" || replica.region != "westeurope" {
+		t.Error(write, read, replica)
+	}
+}
+
+func TestSelectEndpointsReplica(t *testing.T) {
+	for _, tt := range []struct {
+		name             string
+		preferredRegions []string
+		read             []string
+		wantReplica      string
+	}{
+		{
+			name:             "preferred secondary region",
+			preferredRegions: []string{"eastus", "northeurope"},
+			read:             []string{"East US", "West Europe", "North Europe"},
+			wantReplica:      "northeurope",
+		},
+		{
+			name:             "secondary region in failover order",
+			preferredRegions: []string{"eastus"},
+			read:             []string{"East US", "West Europe", "North Europe"},
+			wantReplica:      "westeurope",
+		},
+		{
+			name:             "single region",
+			preferredRegions: []string{"eastus"},
+			read:             []string{"East US"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			rt := &regionRoundTripper{preferredRegions: tt.preferredRegions}
+
+			acct, _ := (&fakeDatabaseAccounts{
+				write: "East US",
+				read:  tt.read,
+			}).Get(context.Background(), "", "")
+
+			_, _, replica := rt.selectEndpoints(acct.DatabaseAccountGetProperties)
+			switch {
+			case tt.wantReplica == "" && replica != nil,
+				tt.wantReplica != "" && (replica == nil || replica.region != tt.wantReplica):
+				t.Error(replica)
+			}
+		})
+	}
+}
+
+func TestRegionRoundTripperReadReplicas(t *testing.T) {
+	ctx := context.Background()
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	m := mock_metrics.NewMockInterface(controller)
+	m.EXPECT().EmitGauge(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	accounts := &fakeDatabaseAccounts{
+		write: "East US",
+		read:  []string{"East US", "West Europe"},
+	}
+
+	var sessionTokens, consistencyLevels []string
+	tr := &fakeRoundTripper{}
+
+	rt := newRegionRoundTripper(logrus.NewEntry(logrus.StandardLogger()), m, tr, accounts, "rg", "account", []string{"eastus"})
+
+	err := rt.refresh(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	request := func(ctx context.Context, method string) *http.Request {
+		req, err := http.NewRequestWithContext(ctx, method, "https://account.documents.azure.com/dbs/ARO/colls/OpenShiftClusters/docs", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return req
+	}
+
+	tr.respond = func(req *http.Request) *http.Response {
+		sessionTokens = append(sessionTokens, req.Header.Get("x-ms-session-token"))
+		consistencyLevels = append(consistencyLevels, req.Header.Get("x-ms-consistency-level"))
+
+		if req.URL.Host == "account-westeurope.documents.azure.com" && len(sessionTokens) == 4 {
+			return response(http.StatusNotFound, substatusReadSessionNotAvailable)
+		}
+
+		resp := response(http.StatusOK, "")
+		resp.Header.Set("x-ms-session-token", "0:"+strconv.Itoa(len(sessionTokens)))
+		return resp
+	}
+
+	// a write, then a read of a customer operation: both go to the write
+	// region
+	for _, method := range []string{http.MethodPost, http.MethodGet} {
+		_, err = rt.RoundTrip(request(ctx, method))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// a read of a read-only workload goes to the replica region with the
+	// latest session token; if the replica hasn't caught up with the session,
+	// the read is retried in the regular read region
+	for i := 0; i < 2; i++ {
+		resp, err := rt.RoundTrip(request(WithReadReplicas(ctx), http.MethodGet))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			t.Error(resp.StatusCode)
+		}
+	}
+
+	wantHosts := []string{
+		"account-eastus.documents.azure.com",
+		"account-eastus.documents.azure.com",
+		"account-westeurope.documents.azure.com",
+		"account-westeurope.documents.azure.com",
+		"account-eastus.documents.azure.com",
+	}
+	if !reflect.DeepEqual(tr.hosts, wantHosts) {
+		t.Error(tr.hosts)
+	}
+	if !reflect.DeepEqual(sessionTokens, []string{"", "", "0:2", "0:3", ""}) {
+		t.Error(sessionTokens)
+	}
+	if !reflect.DeepEqual(consistencyLevels, []string{"", "", "Session", "Session", ""}) {
+		t.Error(consistencyLevels)
+	}
+}
+
+func TestMergeSessionTokens(t *testing.T) {
+	for _, tt := range []struct {
+		tokens string
+		token  string
+		want   string
+	}{
+		{
+			token: "0:1#10",
+			want:  "0:1#10",
+		},
+		{
+			tokens: "0:1#10,1:1#20",
+			token:  "1:1#21",
+			want:   "0:1#10,1:1#21",
+		},
+		{
+			tokens: "0:1#10",
+			token:  "2:1#5,0:1#11",
+			want:   "0:1#11,2:1#5",
+		},
+	} {
+		if got := mergeSessionTokens(tt.tokens, tt.token); got != tt.want {
+			t.Error(tt.tokens, tt.token, got)
+		}
+	}
+}
@@ -35,8 +35,8 @@ type Subscriptions interface {
 }
 
 // NewSubscriptions returns a new Subscriptions
-func NewSubscriptions(ctx context.Context, deploymentMode deployment.Mode, dbc cosmosdb.DatabaseClient) (Subscriptions, error) {
-	dbid, err := databaseName(deploymentMode)
+func NewSubscriptions(ctx context.Context, capabilities deployment.Capabilities, dbc cosmosdb.DatabaseClient) (Subscriptions, error) {
+	dbid, err := databaseName(capabilities)
 	if err != nil {
 		return nil, err
 	}
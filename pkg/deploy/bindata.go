@@ -91,7 +91,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _clusterPredeployJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x55\x5d\x6b\xdb\x3c\x14\xbe\xcf\xaf\x30\x7a\x5f\x70\x0a\xfe\x2c\x8c\xd1\xde\x8d\x75\x8c\xc1\xd6\x95\xb5\xf4\xa6\xf4\x42\x95\x4e\x52\xad\xb2\x24\xa4\xe3\x6c\x5d\xc9\x7f\x1f\x8e\xe2\x34\xb6\xe5\x2e\xeb\x1a\x18\x23\x57\xd1\xf9\x7e\xce\xf3\x1c\x3f\x4c\xa2\x28\x8a\xc8\xff\x8e\xdd\x42\x45\xc9\x71\x44\x6e\x11\x8d\x3b\xce\x73\xff\x92\x55\x54\xd1\x39\x54\xa0\x30\xa3\x3f\x6a\x0b\x19\xd3\xd5\xda\xe6\xf2\xc3\xa2\x7c\x95\x16\x65\x5a\x94\x39\x07\x23\xf5\x7d\xe3\x77\x01\x95\x91\x14\x21\xfb\xea\xb4\xfa\x8f\x24\xbe\x02\xd3\x0a\x41\xe1\x25\x58\x27\xb4\x6a\x0a\x95\x59\xd1\xfc\x5a\x07\x43\x2d\xad\x00\xc1\x3a\x72\x1c\xf9\xb6\x7c\xa0\xac\x1d\x82\x3d\xa5\x15\x74\x0c\x2b\x23\xde\x9b\xe6\x95\x38\xb4\x42\xcd\xc9\xc6\xb8\x4c\x06\x09\xce\xc1\x2e\x04\x83\x33\x2b\x14\x13\x86\xca\x0f\xfc\x79\xe9\x66\xe6\xc5\x32\xd5\x52\x9e\xac\x60\x1b\x8f\xbf\xd1\x5a\x92\xa4\x6b\xe3\x30\xa3\xb5\xc4\x4b\x2a\xeb\xc6\x67\x46\xa5\x83\x60\x81\x8a\x36\x83\xbf\xe1\xdc\x82\x73\x67\x16\x66\xe2\xfb\xf3\x3a\xfd\xa6\xed\xdd\xf3\x13\x4d\xb6\xd2\x11\x0b\x4e\xd7\x96\x41\xb3\xe5\xab\x8d\x4f\x2f\x95\xb1\xda\x80\x45\x01\x6e\x50\x66\x65\xa7\xbe\x93\x73\x43\xd9\x90\x14\x7d\x2f\xdf\x6f\xaf\xe0\xc0\xb9\x2c\x3c\x1d\xf3\x23\x12\x74\xba\x1e\xbc\x2e\x3b\x2f\xcb\xde\x92\x94\xe7\x2b\xe1\xb0\x48\x17\x0a\xb0\xbf\xc4\x16\xad\x4f\x82\x59\xed\xf4\x0c\xb3\x53\xc0\x06\xe7\x7c\x21\x2c\xd6\x54\xae\xff\xba\x7e\xa0\xd4\x8c\xe2\x5a\x42\x57\x2d\x9a\xef\xad\xae\xcd\xf4\x20\x6b\x8d\xd7\xfd\x28\xa6\x15\x17\x9b\xb0\x47\xad\x4d\xe3\x47\x16\xc6\x07\x83\x30\x6a\xc4\x96\x62\x0f\x8b\xf2\x28\x2d\x5e\xa7\x45\x19\xa4\xc9\x43\x18\x81\x2b\xa6\x15\xa3\x38\xdd\x2e\xba\x25\xea\xf8\x20\x89\xe2\xd4\x62\xa0\xfa\x38\x46\x56\xd7\x08\x17\xf4\x46\xc2\x0b\xe1\xf3\x27\x83\xee\xc8\xd6\x8d\x6e\xba\xf8\x07\x44\x3a\x84\x62\x95\xe7\x71\xe8\x71\xce\x0b\xde\x99\x5b\xf0\x69\xfc\x24\x7a\x71\x12\xed\xba\x9e\x60\x53\x7e\x4f\x74\xde\x8c\xae\x6a\x29\x87\x2a\x09\x0c\xe2\xfc\x01\x7d\xa7\xb8\xd1\x42\xe1\xb8\x32\xc3\x43\x6e\xe7\xe8\xd2\xe3\xad\x56\x48\x85\x02\xfb\x05\xe6\xc2\xa1\xbd\x0f\x6b\x79\x39\x78\xbd\x0e\x74\x69\xac\x58\x50\x84\x8f\x42\xdd\xad\x2f\xfe\x1a\xbf\x33\x2d\x05\xf3\xdb\x26\x27\xc2\x35\x38\x72\xb2\xd3\x31\x68\xa5\x10\xb7\x57\x21\x8f\x93\xe8\x49\xe0\x3d\x3b\x7e\x4b\x1b\xbd\xfb\x91\xbb\xfa\x46\x01\x0e\x74\x32\xca\xf8\xc1\xd7\xc6\x80\xe2\xee\xb3\x0a\x6e\xe9\x57\x54\xeb\x35\x13\x27\xd1\x66\xf6\x11\x96\xef\x8b\xbb\x93\x27\xf6\xbd\xeb\xd1\xd8\xfb\x31\x08\x7c\x68\x47\x60\xfa\x0b\x35\xb4\x27\x09\x78\x4c\xfe\x19\x09\xb4\xcd\x74\xa4\xb0\x1b\x93\xdb\x63\xf0\xe2\x6c\x9e\xf8\xfd\x2d\x27\x3f\x03\x00\x00\xff\xff\x05\x63\xc4\x18\x0f\x0c\x00\x00")
+var _clusterPredeployJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x55\x5b\x6b\x1b\x39\x14\x7e\x9f\x5f\x21\xb4\x0b\xe3\xc0\x78\x2e\x81\x65\x49\xde\x96\x4d\x29\x85\x36\x0d\x4d\xc8\x4b\xf0\x83\xa2\x39\x76\xd4\x68\x24\x21\x9d\x71\x9b\x86\xfc\xf7\xa2\x8c\xc7\x9e\x8b\xec\xba\xa9\x03\xa5\x68\x5e\x2c\x9d\xeb\x77\xbe\xef\xf8\x31\x22\x84\x10\xfa\xb7\xe3\x77\x50\x31\x7a\x4a\xe8\x1d\xa2\x71\xa7\x59\xd6\xdc\xa4\x15\x53\x6c\x01\x15\x28\x4c\xd9\xb7\xda\x42\xca\x75\xb5\x7a\x73\xd9\x71\x5e\xfc\x33\xcd\x8b\x69\x5e\x64\x25\x18\xa9\x1f\xbc\xdd\x15\x54\x46\x32\x84\xf4\xb3\xd3\xea\x2f\x9a\x34\x19\xb8\x56\x08\x0a\xaf\xc1\x3a\xa1\x95\x4f\x54\xa4\xb9\x3f\xad\x81\x61\x96\x55\x80\x60\x1d\x3d\x25\x4d\x59\xfe\x50\x2e\x6b\x87\x60\xcf\x59\x05\xbd\x07\xff\x51\x7c\x30\xfe\x96\x3a\xb4\x42\x2d\xe8\xfa\xf1\x29\x19\x05\xb8\x04\xbb\x14\x1c\x2e\xac\x50\x5c\x18\x26\xdf\x95\x2f\x0b\x37\x37\x07\x8b\x54\x4b\x79\xf6\x0c\xdb\x76\xff\x5b\xad\x25\x4d\xfa\x6f\x25\xcc\x59\x2d\xf1\x9a\xc9\xda\x37\x3f\x67\xd2\x41\x30\x41\xc5\x7c\xe3\xff\x95\xa5\x05\xe7\x2e\x2c\xcc\xc5\xd7\x97\x55\xfa\x45\xdb\xfb\x97\x07\x8a\x3a\xe1\xa8\x05\xa7\x6b\xcb\xc1\x4f\xf9\x66\x6d\x33\x08\x65\xac\x36\x60\x51\x40\x9f\x0b\xed\xa1\xac\x69\xe9\xd2\x30\x3e\x26\xc5\xd0\xaa\x69\x7c\x90\x70\x78\x68\xf1\x4c\xc6\x34\xcf\x4e\x68\x14\xb2\x98\x8d\x6e\x9f\x7a\x37\x1d\xb8\xfc\x47\x55\xc3\x57\x5a\xc2\x72\xba\x54\x80\x34\x09\xa3\xf5\x41\x70\xab\x9d\x9e\x63\x7a\x0e\xe8\x71\xce\x96\xc2\x62\xcd\xe4\xea\xa7\x1b\x3a\x4a\xcd\x19\xae\x24\x74\xd3\xa2\xf9\xd6\xea\xda\x4c\x8e\xd2\xf6\x71\x36\xf4\xe2\x5a\x95\x62\xed\xb6\xd1\xda\x24\xde\xb0\x30\x3e\x1a\xb9\x31\x23\x3a\x8a\x3d\xce\x8b\x93\x69\xfe\xef\x34\x2f\x3a\xd3\xdd\x78\x3c\x86\x11\xb8\xe1\x5a\x71\x86\x93\x6e\xd2\x8e\xa8\xe3\xa3\x84\xc4\x53\x8b\x81\xec\xdb\x31\xb2\xba\x46\xb8\x62\xb7\x12\x0e\x84\xcf\xaf\x34\xba\x27\x5b\xd7\xba\xe9\xe3\x1f\x10\xe9\x18\x0a\x7f\xe8\xa6\xe9\xed\x9c\x17\x65\xaf\x6f\x51\x4e\xe2\x9d\xe8\xc5\x09\xd9\x77\x3c\xc1\xa2\xfc\x47\x91\x2d\xbc\xba\x54\x2d\xe5\xc8\x60\xa0\x0b\xff\x51\xd7\x2c\xd0\x37\xaa\x34\x5a\x28\xdc\xae\xcc\x70\x93\xdd\x18\x7d\x7a\xfc\xaf\x15\x32\xa1\xc0\x7e\x82\x85\x70\x68\x1f\x68\x14\xf2\xee\x2b\xd7\x9f\x59\xa0\x4a\x63\xc5\x92\x21\xbc\x17\xea\x7e\xb5\xf1\x57\xf8\x5d\x68\x29\x78\xb3\x9b\xe8\x99\x70\x1e\xc7\x92\x46\x3b\x9a\x1e\x49\x21\x6e\xb7\x42\x16\x27\x64\x27\xf0\x0d\x3b\x7e\x4a\x1b\x83\xfd\x91\xb9\xfa\x56\x01\xba\xbd\x19\x3f\xb0\x2b\xc1\x80\x2a\xdd\x47\x15\x9c\xd2\x8f\xa8\x36\x28\x26\x4e\xc8\xba\xf7\x2d\x2c\x7f\x2d\xee\x46\x3b\xe6\xbd\xef\xd2\x88\x02\x03\x3e\xe8\x32\x08\xfc\xd1\x6e\x81\xe9\x37\xd4\xd0\x2b\x49\xa0\xc1\xe4\x8f\x91\x40\x5b\x4c\x4f\x0a\xfb\x31\xb9\x5d\x06\x07\x67\x73\x44\x08\x21\xb3\xe8\x29\xfa\x3e\x00\x05\x63\xc4\x18\x0f\x0c\x00\x00")
 
 func clusterPredeployJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -111,7 +111,7 @@ func clusterPredeployJson() (*asset, error) {
 	return a, nil
 }
 
-var _databasesDevelopmentJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x5d\x4f\xdb\x30\x14\x7d\xef\xaf\x88\xbc\x49\x69\xa5\x36\x71\xd0\x98\x58\xdf\x60\x48\x1b\x42\x8c\x69\xa0\xbd\x54\x7d\x30\xce\x85\x78\x24\xb6\xb1\xaf\x1f\xba\x89\xff\x3e\xb9\x69\xfa\x95\xb4\x80\x04\xdd\x5a\xd5\x3c\x61\x9f\x9c\xfb\x75\x8e\x8d\xf8\xd3\x0a\x82\x20\x20\xef\x2d\xcf\xa0\x60\xa4\x1f\x90\x0c\x51\xdb\x7e\x1c\x97\x3b\x51\xc1\x24\xbb\x83\x02\x24\x46\xec\xb7\x33\x10\x71\x55\x4c\xce\x6c\x7c\x40\x93\xc3\x1e\x4d\x7a\x34\x89\x53\xd0\xb9\x1a\x79\xdc\x35\x14\x3a\x67\x08\xd1\x2f\xab\xe4\x3b\xd2\x2d\x23\x70\x25\x11\x24\xfe\x04\x63\x85\x92\x3e\x50\x12\x51\xff\x53\x01\x34\x33\xac\x00\x04\x63\x49\x3f\x28\xd3\x1a\xef\xa7\x0c\xd9\x0d\xb3\x70\xcc\xb9\x72\x12\xbf\xb1\x02\x16\x00\x63\x10\x8e\xb4\xdf\x25\x16\x8d\x90\x77\x64\x7a\xf8\xd8\xad\x13\xbd\x90\xa1\x35\xc7\x43\x0c\x58\xe5\x0c\x07\x9f\xe3\x60\x8a\x59\xa2\xd2\x46\x69\x30\x28\xc0\xd6\xc2\x2c\x90\x34\x9e\x8e\x11\x22\xf5\xa9\x0c\x66\x2d\x69\x87\xf3\xd9\x87\x9d\x21\xa9\x7d\x38\x57\xea\x94\x47\x69\x14\x4a\x36\xa7\x51\x56\x9d\x19\xe5\xee\x32\xed\xd0\x07\x3c\xa4\xb4\x81\xb7\xb5\x26\x0a\x91\x65\x33\xc9\x80\x2b\xc9\x19\xb6\x9b\x52\x9e\x9b\x5c\xd8\xe9\x06\x61\x1c\x76\x83\xd5\xa5\x75\x86\xa4\xdb\x3c\x9a\x0b\xc1\x8d\xb2\xea\x16\xa3\x53\xc5\x9d\x97\xda\xe9\x49\xbc\x14\xc4\xc6\xf6\x21\x3f\x9d\xec\xd9\x65\xa6\x5c\x71\x86\x13\xf9\x0d\xaa\x31\x7c\x31\xca\xe9\x76\x27\xaa\x0e\x6b\xf1\x99\x16\x73\xb2\x3d\xa0\xc9\xa7\x1e\x3d\xea\xd1\xa4\x51\x66\x6f\xa4\x85\x63\x3b\x92\xfc\x52\x83\x61\xe5\x44\xeb\xc3\x0e\x26\x26\x42\xe1\x11\xe7\x30\x5a\x49\x39\x41\x62\xb6\xa8\xe2\x46\x58\x2c\xd2\xba\x24\xaa\x35\x6c\xce\x62\xfc\xe1\xbd\x90\xe3\xc4\xbf\x32\x9b\x35\x33\x34\x08\x76\xfc\x65\x0a\xb7\xcc\xe5\x78\x8d\x39\xe9\x07\x1f\xe9\x87\x23\x4a\x5f\x2a\xf6\x4d\x6b\xd6\x03\x96\x26\x14\xbe\xa6\x8e\x63\x7f\x7b\x32\x21\xfd\xe5\xf8\xb6\x92\x5e\xc2\xa5\xa0\x41\xa6\xf6\x52\x36\x2a\x65\x16\xf0\x2c\x6d\x87\x2f\x2f\x6b\x45\x4f\x97\x7a\xbf\xf6\xaa\x58\xc8\x69\xb8\x41\x43\x9e\x88\x3c\xf7\x0f\xc5\x2e\x18\x71\x1b\xec\x35\xe9\xf7\xde\x56\xbb\x6d\xab\x0b\x25\x05\xaa\xda\x3c\x66\x71\xb6\xc9\x57\xcf\x79\xe0\x7a\xc9\x36\xb8\xaf\x1a\xcb\xde\x7e\xbb\x6d\xbf\x4b\x0d\xf2\x2a\x13\xb7\xf8\x39\x77\x16\xeb\x83\x99\x05\x7c\x75\x1f\x2e\x30\x6e\xd8\x91\x4e\x8a\x07\x07\xe7\x30\xfa\xae\x72\xc1\x9f\x28\x68\x0a\x7e\xba\xaa\xd5\x2c\x53\xb6\xe7\xb5\x67\x0a\x8f\xef\xd7\x75\xa7\x5a\xc3\xb5\x88\x15\x5d\xa8\xd6\x1b\x24\xcd\x4b\x35\xfd\x98\x37\xf2\x59\xba\x76\xd0\xd5\xfa\xff\x4a\x11\x20\xf1\x75\x92\x5f\xad\xf2\xed\xfd\x3b\xad\x76\x83\xec\x9f\x8c\xdd\x7e\x32\xae\xdc\x8d\xe5\x46\xe8\xdd\xf9\xbf\xc4\x36\xd8\x6c\xa1\xeb\x7b\x8b\xfd\x53\x8b\xb5\xca\xdf\x1f\x5b\x7f\x03\x00\x00\xff\xff\x61\x95\x7d\xc5\x4d\x17\x00\x00")
+var _databasesDevelopmentJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x5d\x6f\xda\x30\x14\x7d\xf7\xaf\xb0\xbc\x49\x80\x14\x48\xa8\xd6\xa9\xe3\xad\x5d\xa5\xad\xaa\xba\x4e\x6b\xb5\x17\xc4\x83\xeb\xdc\x16\xaf\x89\xed\xda\xce\x24\x36\xf1\xdf\x27\x97\x84\x42\x62\x68\x91\xa0\x1f\x59\x62\x9e\xec\xeb\xfb\x71\xee\x39\xb6\xc5\x5f\x84\x31\xc6\xe4\xbd\x61\x63\x48\x29\x19\x60\x32\xb6\x56\x99\x41\x18\xce\x66\x7a\x29\x15\xf4\x06\x52\x10\xb6\x47\xff\x64\x1a\x7a\x4c\xa6\xf9\x9a\x09\xf7\xa2\xfe\x7e\x37\xea\x77\xa3\x7e\x18\x83\x4a\xe4\xc4\xd9\x5d\x42\xaa\x12\x6a\xa1\xf7\xcb\x48\xf1\x8e\x04\xb3\x08\x4c\x0a\x0b\xc2\xfe\x04\x6d\xb8\x14\x2e\x50\xbf\x17\xb9\x51\x18\x28\xaa\x69\x0a\x16\xb4\x21\x03\x3c\x4b\xcb\x0d\x12\x53\x4b\xaf\xa8\x81\x43\xc6\x64\x26\xec\x37\x9a\xc2\x92\x81\xfb\x11\x3b\x51\x6e\x96\x18\xab\xb9\xb8\x21\xf3\xc5\x69\x50\x75\xb4\xa1\x07\xb4\xe0\x87\x68\x30\x32\xd3\x0c\x5c\x8e\xc3\xb9\x4d\xc9\x95\xd2\x52\x81\xb6\x1c\x96\x2b\x29\xc6\xdc\x89\x77\xd5\xfd\x08\x8f\x5d\x2a\xc3\x07\x48\xda\xad\xc5\xec\x5b\x9d\x11\x41\xa5\x3d\x45\x8a\x8b\x1f\x91\xca\x72\x29\xfc\x69\xb8\x41\xec\x58\xcb\xec\x66\xac\x32\xeb\x02\xee\x47\x91\xc7\x2f\x5a\x13\x85\x88\x19\x98\x64\xc8\xa4\x60\xd4\xb6\x7d\x29\x2f\x74\xae\xd5\x09\x70\x2b\x6c\x05\x78\x75\x69\x9d\x11\x29\xc5\x28\x5a\x73\xc6\x99\x96\x46\x5e\xdb\xde\xb1\x64\x99\xa3\xda\xf1\x51\x58\x0a\x62\x42\x73\x97\x1c\xe7\x73\xa6\xec\x29\x91\x8c\xda\x9c\x7e\xc3\xa2\x0d\x5f\xb4\xcc\x54\xbb\xd3\x2b\x16\x2b\xf1\xa9\xe2\x0b\xb4\xdd\x8b\xfa\x9f\xba\xd1\x41\x37\xea\x13\xe4\x41\x65\x19\xe8\xad\x71\xe1\xd0\x4c\x04\x3b\x57\xa0\xef\xf3\x2f\x17\x56\x7c\x44\x51\x6d\xb9\xb3\x38\x85\xc9\x4a\x97\xb9\xa5\x1d\x2f\xb3\xd8\xf7\x91\x90\xc7\x04\xad\x58\xc4\x23\x7f\x16\x6e\x90\x5b\x2e\xee\x49\xfc\x95\x9a\xb1\xdf\xc3\x34\xf0\x4e\x93\x18\xae\x69\x96\xd8\x4b\x9b\x90\x01\xfe\x18\x7d\x38\x88\x22\xf4\x84\xbd\x8b\x64\x9f\xa2\x35\xc6\x3b\xe0\xac\x33\x28\x75\xa8\xb5\x4d\x1e\x87\xee\xf4\xa4\x5c\xb8\xc3\x71\xb7\x94\x2e\xd9\xc5\xa0\x40\xc4\xe6\x5c\x78\x99\xf2\x10\xf0\x24\x6e\xb7\x36\x2f\x6b\x05\xa6\x25\xec\x57\xc3\x5e\x3e\x06\x47\xc8\xd3\xf2\x1d\x09\xf2\x88\xb2\x5b\x07\x0d\x09\xfc\x56\xb5\x53\x62\xb7\x8f\x9e\xb0\xef\xa5\x55\x58\xb4\xa5\x91\x5f\xcd\xe5\xc7\x93\xc4\xbd\xf4\x02\xe4\xb1\x79\x63\xea\xab\xcc\xbe\x46\x5d\xcd\xf0\x6e\x64\x55\x6f\x59\x7d\x4e\x32\x63\x41\x9f\x88\xdf\x20\xac\xd4\xee\x96\x0c\x90\xc7\xbc\x51\xd8\xd6\x15\x56\x85\xbe\x11\x5b\xbd\xc5\x76\x26\x05\xb7\xb2\xd2\x8f\xb7\x29\xb1\xfa\x3c\x21\x8b\xb6\x34\xf2\xab\xb7\xfc\xce\x15\x88\x8b\x31\xbf\xb6\xf9\xc9\xfb\x8c\x3a\x5c\xf2\xf8\xcc\x8a\xcc\x04\xbf\xcb\xe0\x14\x26\xdf\x65\xc2\xd9\x23\x05\xcd\x8d\x1f\xaf\x6a\xb5\x97\x0d\xe1\x29\x06\x09\x6f\xd7\xa1\x53\xa5\xcc\x06\x28\xec\x30\x69\x36\x63\xd3\x8f\x9c\x85\xf7\x7f\xc5\x9c\xc4\x6b\x1b\xfd\x7a\x4b\xe1\x20\xec\x76\x92\x47\x9b\xed\x9b\xa2\x27\x94\xff\xd2\x37\x45\xe5\x04\x69\xae\x8c\xff\xe4\xca\xc8\x71\xac\xc7\xd3\xad\x32\xfb\x9a\xa5\x56\x20\xdf\x48\xad\xde\x52\xbb\xc8\xae\x0c\xd3\x3c\x67\x5d\x80\x3c\x96\x8d\xcc\xb6\x2e\xb3\x25\xd4\x1b\x89\xbd\xa8\xc4\x10\xc6\x18\x8f\xd0\x14\xfd\x1b\x00\xff\x24\x6c\xbc\xa4\x21\x00\x00")
 
 func databasesDevelopmentJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -131,7 +131,7 @@ func databasesDevelopmentJson() (*asset, error) {
 	return a, nil
 }
 
-var _envDevelopmentJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\x7b\x6f\xa3\xc8\x96\xff\x7f\x3e\x45\x94\xbd\x52\xba\xb5\x9d\x04\x70\x9c\x0e\x57\xea\x3f\x30\xb6\x31\x36\x26\x06\xcc\x73\x6e\x6b\x04\x55\x04\x63\x17\x8f\x85\x02\x82\xaf\xe6\xbb\xaf\xc0\x8f\xf8\x6d\xa7\xd3\x23\xed\xde\x69\x5a\xe9\xc4\x70\xce\xa9\xaa\x53\xe7\x59\x3f\xfc\xef\xdf\xae\xae\xae\xae\xae\xff\x91\x82\x89\x1b\xd8\xd7\xff\xbc\xba\x9e\x60\x1c\xa7\xff\xbc\xbf\x5f\xdc\xb9\x0b\xec\xd0\xf6\xdc\xc0\x0d\xf1\x9d\x3d\xcf\x12\xf7\x0e\x44\xc1\xf2\x59\x7a\x4f\x11\x64\xf3\x96\x20\x6f\x09\xf2\x1e\xba\x31\x8a\xca\x8a\x6e\xec\x06\x31\xb2\xb1\x7b\x37\x4d\xa3\xf0\xbf\xae\xbf\x2c\x46\x00\x51\x88\xdd\x10\x6b\x6e\x92\xfa\x51\x58\x0d\x44\xde\x11\xd5\xbf\x15\x41\x6c\x27\x76\xe0\x62\x37\x49\xaf\xff\x79\xb5\x98\xd6\x82\xd1\x67\xe6\xf1\x38\x9a\xb9\xe1\xd6\xfd\xfa\x19\x2e\x63\xb7\x12\x95\xe2\xc4\x0f\xbd\xeb\xf5\xc3\x3f\xbf\x6c\xf2\xb3\x76\x6c\x03\x1f\x97\xc7\xf9\xfd\x10\x1f\x63\x6e\xd7\xeb\x1a\x47\x11\xaa\x46\x38\x2a\xc1\x89\x22\x74\xfd\x65\xfb\x19\x74\x5f\xec\x0c\x61\xcd\x46\x59\x45\xf3\x62\xa3\xd4\x3d\x32\xca\x28\x8a\x90\x68\x07\xee\x8f\x2d\x31\x4e\xa2\xd7\x92\x75\x13\xfc\x11\x76\xe4\xbb\x21\xfe\xa0\x90\x76\x14\xd8\x7e\x58\x2d\x44\xb0\x1d\x17\x7d\x40\x12\x1f\xd8\xde\x47\xb4\x51\xf3\x33\x19\x9e\x9c\x90\xe1\x82\x2c\x71\xcf\x4a\x1a\xb8\x27\x0c\xe7\x02\x19\x99\x83\x7c\xc0\x8f\x18\x08\x13\x37\x4d\x19\x84\x22\x60\x63\x3f\x0a\x87\x2e\x9e\x44\xf0\xec\x12\x4f\xdb\xd4\xb5\x82\x6d\xec\x83\x8b\x86\x56\x66\xd9\x45\x26\x76\x7e\xc4\x10\xda\x09\x3c\x3c\x66\x9a\x4e\x46\xf5\xb0\xa7\xd5\x76\x42\x61\x79\x1c\xb2\x4c\x65\x87\xfe\x8b\x0f\x6c\xfc\x1e\x23\xf8\x6d\x43\xd6\x75\xe2\xa6\x51\x96\x00\xb7\x0a\x27\xbf\xaf\x69\x76\x44\xa5\xb3\x6c\x4f\x7e\xfd\x20\x5c\x68\xea\xfa\xf7\xb7\xb0\xf4\xe9\xe6\xb0\x42\x6f\x3e\x7f\xbf\xde\x12\xf0\xe7\x8e\x06\xe3\x24\x8a\xab\xf5\xb8\xe9\xe1\xb1\xd6\x62\xf7\x6d\xe3\xe4\xf8\xbb\xf4\x67\x27\xb2\x5a\x14\x74\xf3\xdb\x3c\x0e\x6f\x63\x3f\xde\xdd\xed\x95\x6e\x87\x3e\x48\xa2\x34\x7a\xc1\x77\xa2\x8b\x8b\x28\x99\xdd\xef\x0c\xee\xa6\xbb\xac\xab\xc9\xd4\xd3\x5e\x69\x9f\x4b\xa2\x2c\xfe\xf4\xf9\x6e\xf5\xf0\xfb\x2e\x97\x1d\xfb\x1b\x39\x81\x22\x48\xfa\x96\xf8\x7a\x4b\x90\x07\x8d\xe3\xdf\xef\x53\xac\xbd\xdc\xa7\xd8\x06\xfb\x66\xb4\x4b\x35\x4a\xdc\x17\xff\x75\xc7\x5c\xf6\x88\x49\x62\x91\xb5\xee\xe9\xeb\x83\x44\xdf\xf7\xee\xee\x6c\x42\x2d\x25\xcd\x9c\xd0\xc5\xc7\x87\x3a\x3c\xd3\xab\x0b\xd6\x7c\x7c\x65\x75\xca\x5d\xcd\x9e\x7a\x38\x3c\xfd\x23\x13\x5e\x4b\x5c\x59\x10\x67\x63\xb7\xb0\x4b\xa5\x5e\xc7\x61\x51\x47\xc4\xfc\xa5\x4b\x23\x17\x4b\x3b\xbe\x80\xc5\x22\x16\x16\xad\x54\xb1\xdb\xc7\x65\x6d\xa2\x67\x47\xac\x39\x7d\xb8\x65\xdc\x3c\xfc\x74\xb3\xef\x28\x87\xc4\xa7\x37\x5f\xae\x6e\x92\xf8\x36\x4c\xbd\xca\x4b\x4f\x4f\xb0\x1e\x0a\xdb\x5e\xa5\x86\x30\x43\xe8\x24\xf1\x9f\x1f\xda\xc7\x65\x5d\x73\x72\x1f\xf7\xee\x7e\xbf\x3c\xc8\x54\x52\x2f\x8e\x30\xb9\x9f\xe0\xcc\x46\xcb\x8f\xff\x0f\xe2\x8b\x1f\xb3\x51\xf8\xe2\x7b\x59\x52\x8f\xfd\x97\xfb\xf3\x22\x6c\xfc\x44\x53\xdd\xd1\xf8\xfd\x32\x2e\x55\xc6\xba\xda\xbe\xea\xef\x2d\x6f\xdf\xcb\x32\xbb\xd7\x09\xbb\xbb\x3a\x50\x96\xfc\xc4\xe5\xec\xa5\xa8\xf5\x42\x16\xc9\xee\xfc\xd4\x3f\xe4\x4c\xcb\x4a\xe9\x62\x37\x3a\x90\x18\xf2\x38\x1c\x2f\xfd\x43\x8e\x32\xec\xb6\xec\xd4\x85\x07\xc2\xc5\xd1\xc2\x65\x6b\x42\x5a\x1c\x72\x05\x79\x24\xda\x5c\x63\xdf\x4d\x36\xa8\x2e\x4a\x5c\x55\x71\xb6\x68\x14\x36\x0d\xff\xf8\x4c\xd6\xf4\xcb\x2d\xa9\xfa\x9c\x93\x1b\xfe\xae\x6c\x5c\x33\x90\x34\x75\x47\x3e\x3e\xdd\x51\xcd\xe6\x99\xc4\xb6\x9f\x9b\x8f\x2c\x73\x7b\xea\x72\x14\xe1\x8d\x7a\xf4\xf4\x94\xce\x78\xf0\x3b\xbc\xfd\xea\xcd\x57\xaa\xd1\xdb\x36\xb6\xf7\x0a\xc2\xdd\x5a\xf9\xc3\xbe\xb9\x5b\x21\x02\xfb\x44\x9d\x70\xb8\x00\x3a\xa7\xce\x51\x12\xe1\x08\x44\xe8\x4c\xa1\xf5\x1c\xbb\xa1\x36\x12\x2f\xae\xb2\xde\x53\xf8\xfe\x68\x4a\x5a\xc6\xc1\xbf\x3a\x33\xed\x35\x60\xb1\x1b\xc2\xf4\x39\x3c\xa8\xb0\x9f\x11\x13\x0f\x38\xfa\xfb\x12\xc7\x56\xc2\xd8\xb5\xc1\xef\x17\x64\xda\xb3\x7d\xd8\xaa\xeb\xfc\xa3\x4d\xa5\x7f\xe4\x8d\x43\x53\x5e\xc5\xb3\x75\x83\x7a\x80\x06\xbc\x1d\x05\x5d\xff\xee\x87\xf8\xd3\xa6\x37\xbd\x9d\x14\xdd\x7c\xfe\x68\x4f\x97\xc5\x5e\x62\x43\x77\x14\x21\x1f\xec\x37\xc2\x6b\xb2\x20\x82\x0b\xcb\xb3\xc3\xcc\x46\x17\xc6\xe0\x85\xee\x87\x36\x98\xf8\xa1\x3b\x4a\xa2\x17\x1f\x9d\x68\x6f\xa2\xf4\x1c\xc9\x42\x33\x51\x10\x67\xd8\x4d\xaa\x8e\xf6\xad\xaa\x06\xfe\xed\x89\x62\xf5\xda\x86\x81\x1f\xaa\xa9\x9b\xac\xb6\x09\xa0\x28\x83\xb7\x59\xea\x26\xa7\xd8\x90\x1f\x66\xaf\x97\x65\x90\x35\x0f\xf4\x53\xdb\x41\xee\xc8\x4e\xd3\x22\x4a\x20\x93\xe1\x89\x1b\x62\x7f\xed\x78\x38\xc9\xdc\x33\xc1\x2d\x4d\xf7\x4f\x83\x0e\x12\xc6\xab\x13\x8c\xf3\xe9\x67\x75\x9d\x97\xfa\x26\xdd\xae\x4f\xa5\xae\xef\x27\x51\xe0\xde\xbf\x69\xec\xfe\x2e\x4d\x27\xf7\x76\x86\x27\x51\xe2\xcf\x5d\xf8\xc7\xcc\xdd\x0b\x35\x27\x05\xcf\xdc\xf2\x60\x96\xd8\x3c\x93\x39\x9b\x21\x56\xd7\xf1\x52\x68\x75\x1d\x4e\xa7\xe7\xf9\x0f\x3f\x39\x96\x86\x53\x1c\x25\xb6\x77\xd6\xcc\x6b\x5a\x3f\xb0\x3d\x57\x76\x5f\xdc\xc4\x0d\x4f\x74\xfc\x6b\xfa\x7a\x9f\xd3\xc9\x22\x6c\xc8\x2e\xec\xd9\xbb\xdd\xca\x1e\x4b\xf4\xf2\xb2\x24\xef\x75\x84\x73\xc4\x8b\xa8\x76\xfd\xf5\x56\xd0\x86\xe7\x68\xf3\xb7\x54\xf0\xf5\x8e\xbe\xa3\x08\x8a\x20\x09\x82\x24\x1f\x7f\xac\x51\x8f\xd2\xb6\x9f\xce\xce\xab\x00\x24\xae\x8d\xdd\xe7\x78\x95\xbe\xba\x49\x14\x2c\xce\x5e\xcf\xcc\x77\x81\x0b\xc0\x8b\x46\xb9\xda\xd8\x48\x06\x80\x28\x0b\xf1\xaa\xca\x1d\x25\x6e\xe0\x67\xc1\x1f\x82\xac\xfc\x70\x69\xfe\x2e\x7b\x5a\x76\xe7\x17\xd9\xd3\x92\x96\x0f\xb1\x9b\xbc\xd8\xc0\xbd\xb0\xdb\x5b\x5d\x17\x28\x65\x1d\x37\xfd\xdb\x3c\x48\xd3\xdb\xd0\x07\x97\x1c\x0e\xbc\xb3\x8c\x5c\xf2\xf8\x81\x9d\x94\x17\x85\xca\x35\xd3\xc5\x1d\xee\xa1\xeb\xf2\x98\x78\x75\x48\x17\x7e\x0c\xea\xb1\xdf\x11\x02\xaf\x7e\x50\x39\x5b\xfc\xef\xe8\xb2\x0f\xf2\xff\xcc\xce\x7b\xeb\x7c\xe6\xe2\xd8\xbd\x79\x9d\xa9\xf8\x0f\xae\xe0\x47\x4c\x65\x5b\xc0\x76\xd1\xf9\xbe\x54\x7f\x54\xea\xae\x81\xec\x1f\x5f\x5f\x3e\xc1\x8f\xd9\xc8\x5a\x0e\x0c\x53\xc5\xc5\xd8\x0f\xbd\x8f\x09\x5a\x08\xdb\x43\xcf\xae\xed\x24\xba\x05\xfe\xfb\x77\x7d\x75\x9d\xcf\xe2\x3f\x87\xeb\x7d\x1c\x97\x53\x5f\x46\x79\xba\x12\x39\x2f\xe7\xf8\xd3\xf7\x1d\x19\xb8\xaf\xd8\x0d\xab\x2c\x7e\x51\x76\x59\x53\xff\x25\x99\x04\xa4\xe7\x52\xf8\xd5\x0f\x67\x92\xcd\xb2\xe9\x2d\xb4\x31\xf5\xeb\x01\x9d\xb7\x55\x5d\x98\x63\x56\xdd\x37\x9b\xa5\x38\x0a\x14\x90\xf8\xf1\xb9\x32\x6c\x8b\xb7\x67\x87\x10\xb9\xc9\x66\x2f\xbd\x7e\xab\xe0\xac\x00\x3b\xc3\x91\xba\xe8\xd5\x86\x7e\x18\x6d\x48\x79\x47\x96\x4c\x37\x42\xc0\x85\xf1\xb6\x52\x3c\x76\x01\x76\xe1\x0f\xc5\x8f\xeb\x74\xa1\xa6\x2a\xd1\x38\x76\xea\x3e\x3e\x7c\x02\x51\x08\x6c\xfc\x69\xf1\x69\x1c\x29\x35\x38\xfa\xe9\x06\x50\x1a\xc1\xb3\x24\x62\xbd\xe8\xdb\xcd\xe7\x2f\x37\x2c\xcf\x58\xa3\xf1\xf3\xa0\x23\x7e\xbb\xb9\xb9\xf9\xb2\xdd\xff\xae\xde\xb4\xa8\x08\x6f\x6e\xfe\x15\xde\x54\xf4\xa3\xe7\x67\x41\x64\x86\x9d\x03\xf4\xab\xd7\x16\x36\xe8\xab\xff\x76\xe7\xc0\x86\x88\x74\x14\x06\xbb\x4a\x8b\x04\x9c\x3c\x81\x9c\xea\x09\x86\xe7\x69\x44\x77\x68\xeb\x4d\xd2\xed\x74\x43\x4b\x6f\x12\xac\x17\xa7\x30\xd0\x1e\x20\xa7\x65\x16\xcb\x60\x87\x65\x12\x71\xcc\x20\x19\xf5\xbb\xb2\xc2\xe4\x16\xa7\x51\x42\xa3\x9f\x3b\x0d\x99\xb2\x4a\x9a\x32\x8d\x7e\x0a\xbd\xf8\xc1\x0a\xc5\x17\xab\xd1\xcf\x21\x65\xcd\x79\xb6\xba\xcf\x0f\xd8\xe0\x95\xb2\x8c\x09\x61\xe9\xcd\x19\xcf\x92\x29\xcf\xa6\xaf\xc3\xf6\x51\x59\x91\x43\x91\xc8\xe9\x99\x03\x97\xb3\xe6\x06\x05\x4b\xa7\x01\x03\x50\x32\xb9\xcd\xd1\xd8\x92\xa2\x01\x08\x5b\x98\x67\x09\x6c\xeb\x64\xe1\x34\xfa\x04\xcf\x4d\x08\xd8\x6b\xcd\x9f\xfd\xa7\xdc\xe2\x8a\xcc\x0a\xb4\x99\xd3\xe8\x4f\x40\xaf\x9f\xdb\x81\x36\x85\x6c\x33\x07\x01\xc8\x41\x4f\xf3\x05\x4a\x2b\x2c\xbd\xc8\x55\xd4\x12\x05\x15\x4a\x72\x49\x0a\xb2\x36\xc3\xb2\xd6\xea\x8e\x59\xa2\xc1\x86\xfd\xa2\xd2\x8f\xa0\x23\x0c\x38\xba\x84\x6c\x2b\x82\x3d\xb9\x00\xf3\x28\x17\x1a\xad\x89\x49\xe1\x89\x45\x69\x73\x21\x20\x63\xb3\xd1\xcf\x01\x45\x07\x90\x6d\x4e\x1d\x8a\xc8\x6d\x4a\x6b\x82\x92\xc6\xb6\x2e\x96\x4e\x43\xcc\xad\x50\xca\x4c\x43\x9c\xb2\x5e\xdc\x84\x3a\xe1\x09\xc6\xcc\xb3\xf5\xe6\x1c\x72\xdd\xd4\xd9\x94\x4b\xc9\xa9\x10\x58\xc8\xe2\xe8\xd2\x34\x5a\xa5\x43\xc5\xc8\x6c\x48\x99\xd3\xe8\x87\x42\xa3\x45\x9a\x3e\x8d\x00\xa7\xa5\xcb\xb9\x63\x10\x68\xa9\xa5\x77\xe7\x96\x42\xa6\xa6\x21\x23\xd0\x90\xb0\x58\x36\x33\x87\xea\x96\x26\xe5\x65\x95\x7e\x58\x2f\x9e\x9a\x86\xe4\x8d\x7c\x1a\x41\x6e\x98\xbb\x86\x86\x85\xb0\x8f\x00\x47\xcf\x85\x40\xca\x4d\x23\x26\x41\xa0\x66\x20\xd0\x0a\xa7\x64\x9e\x46\x2c\xec\x8e\x09\x33\x64\x51\xd5\xcc\x6b\xa5\xa5\x90\x53\x87\x43\x90\x0d\x9a\x13\x47\x57\xe9\x25\x3d\x36\xa9\xd7\x98\x0d\xfa\x13\x40\x69\x24\x08\x0a\xda\xee\xc9\x04\xe8\x0d\x1f\x85\x92\x2e\x4c\x5d\x4c\x4c\x1d\x22\x50\x36\xb7\x75\x40\xd1\x58\x68\x20\xd2\x31\x96\xe3\x53\xdd\x47\x68\xf4\x91\xa0\x8b\xa9\x2d\xc5\xc8\x09\xba\xbe\xc3\x69\xb3\x91\x81\x10\x28\xe2\x10\x70\x70\x6a\x73\xda\xd4\x9e\x93\x4d\xcb\x18\x0e\x64\x95\xe6\xd6\x3a\x54\xc8\x15\x7d\x69\x19\xad\x7c\x64\xf4\x23\xa8\xcf\x30\x08\x26\xc8\x61\x89\x86\x60\x88\x08\x84\x16\x02\x3e\x59\xda\x3d\x2d\x16\x74\xba\x80\x1c\xca\x9d\xa0\x9b\x0a\x46\xbf\x70\x8c\xe1\xee\x3e\x6c\xac\x77\xe6\x59\x1c\x3d\xb5\x29\xad\xe4\xb9\xf8\x95\xe7\x5e\x63\x33\xe8\xce\x41\x43\x9b\x38\x3e\x39\xb3\x0c\x0b\x39\x6c\x2b\x34\xa9\xa1\x67\x35\x5a\xa1\xa3\xab\xb8\xb2\x5d\x4b\x2f\x3c\x10\x78\xd8\xa2\x10\x31\x9c\xce\xea\xbf\x41\x0f\x11\x36\x47\x67\xc3\xb9\xe9\x41\x8e\x2c\x20\xd5\x25\x4c\xca\x1b\xb0\x33\x38\x32\x48\xab\xab\x22\xb1\x3f\x26\x1e\xe8\xa1\xf2\xf0\x2a\xb2\x0f\x4d\x36\x10\x2b\x7d\x7a\x1b\xfa\x9c\x39\x6c\x33\x74\x28\x3a\x74\x38\x75\xa1\x43\x0a\xe6\xd5\x4f\xbf\x87\x7b\x63\x92\xd6\x65\xad\x3f\x56\x54\xfa\xf9\x45\x69\xa6\xb5\xef\xb2\xe4\xc4\xd1\x25\x4a\x64\x9b\x84\x69\xf0\x99\xd5\x88\xbc\x17\xb6\x55\xfd\xed\x09\xea\xd0\x13\x1a\xda\x1c\xf8\x74\xea\x50\xe2\xc4\x61\x19\xec\xf6\xa2\x81\xc3\x3d\x78\x82\xb1\xfb\x8c\x0e\x9d\x92\xf6\x6d\xfd\x21\x1f\xf8\x4c\x0e\x0d\xb1\x14\xa8\xd7\xdc\xa4\xba\xa9\x40\xf5\x63\xc7\x8b\x06\x1a\x12\x55\x95\xa4\x5b\x32\xa1\x3d\x6b\xdd\xb7\xb9\x8c\xc6\x7c\x36\x1c\x03\x52\x98\xf2\x03\x47\xc7\x33\xbb\x1a\x9b\x9a\xe4\x8e\xae\xe6\x26\xf5\x9a\x43\x5d\xc2\xb0\xda\x23\x9f\xae\xfc\x28\x83\x4c\x5c\x40\x43\x8c\x2c\x76\xe9\xdf\x0a\x3d\x75\x38\x9a\xb4\x58\x92\x04\x94\x56\x0a\xd4\x32\x1e\xed\xeb\x87\x02\x0d\x79\x5e\xd9\x9b\x13\xca\x6b\xdb\x13\x82\x85\x6d\x59\xba\x1c\x5a\x4a\x33\xb3\x0c\x29\x5f\xd0\x48\x95\xde\x74\x95\x94\xc7\x06\xd1\xed\xc9\x6a\x53\x7d\xdb\x87\x26\x2d\x34\xac\x39\xec\x0d\xf1\x92\x16\x3b\x1c\xca\xa0\xe1\x61\xb7\x6d\x12\x82\x22\x7f\x3d\xb6\xde\x17\xe5\x80\xae\x8d\xc9\x23\x1b\x88\x91\xd3\x80\x19\xcf\x92\x0a\xcf\x89\xa9\xd3\xd0\x66\x82\xa1\xcd\x2d\x83\x7f\xdc\xd6\x03\x93\xb1\x5e\x94\xd5\x7a\xf5\xe9\xd8\x09\x45\xc2\xd4\x5f\x53\x8b\xab\x7c\xbd\x39\xb3\xf4\xe6\xd4\xd6\xb5\xb9\x10\x8a\x11\x1b\x8a\xa4\xc5\x3d\x79\x82\xa1\x7a\xbb\x32\x04\x4a\xcc\x9d\xc0\x8a\xad\xb2\x39\xb7\x59\x06\x0b\x86\x96\x99\x86\x4c\x2c\x64\x48\x95\xdf\xec\xd9\x97\x65\x98\xd9\x2a\x0e\x2c\xec\x8b\x9c\x2f\x3f\x57\xf4\x13\x68\xc8\x11\xdf\x6b\x4d\x60\x2d\x4f\xae\xe2\x5b\xc6\xb3\xfc\x4c\x22\x50\x4b\x47\x2d\x75\x4c\xe0\xee\xd8\xe7\x2b\xda\xc2\xa1\xe8\x74\xf9\x4c\x1a\x13\xf4\x70\x3c\xeb\x8a\xb2\x52\x3f\x5b\xec\x33\xcb\xf8\x92\xd6\x1f\x09\x5a\x9f\x97\xd5\x02\xf7\x3b\x93\x91\x4a\xca\xcf\x92\x4a\x76\x79\xbf\x92\x5f\xc5\x86\xd7\x89\x49\xa9\x03\xc1\xa7\xe7\x30\x18\x66\x80\xf2\x36\xfd\x74\xcb\x2e\xd8\xb0\x95\x03\x4e\x1a\xb0\xfe\xd0\x73\x74\xad\xb4\x28\xd5\xb3\xf5\x07\x4f\xa0\xe8\x02\xb2\x74\x69\xd7\x3f\xc4\xff\x08\x94\x96\x99\x7a\x3f\xb5\xa4\x75\x3c\xac\xf3\x8b\x40\x6d\xed\xc9\xda\x46\x84\xb0\x35\x81\x9c\xe7\x8d\xda\x45\x58\xc5\x9d\x7e\x11\xe5\x4e\xa3\x45\x08\x8d\x7e\x54\xff\x18\xad\x26\xe4\x26\xb9\x33\x1d\x2e\x73\x96\xb4\xf4\x8f\x7e\xec\x4c\x77\x68\x75\x18\xc3\x36\xdf\x3c\x44\xb7\xe7\x53\xeb\x7b\x07\x9e\x37\xc4\xca\x17\x1f\x97\x3e\x3a\x37\x03\x94\x3d\x1f\x59\x87\x10\x6c\xcb\x3c\xec\x53\x95\x0f\xc7\x55\xbc\x1f\xb0\x81\x58\xed\xef\x7f\x9f\xf4\x51\xf6\x29\xb3\xf4\x26\xc5\xb7\x8b\xa7\x3e\xa1\x8d\x64\x1f\x0c\xc6\x1d\xf9\x65\xdc\x41\xac\x3a\xeb\x2a\xba\x46\x4b\x92\x26\xf3\x23\x85\xce\x41\x4f\xca\x41\xe0\xe5\x3b\x31\x30\x07\x01\x9d\x43\x96\x26\x41\x83\xcf\x1d\x0e\xf9\xe2\x54\x7a\xdc\xd8\xab\x3a\x17\x5a\x6d\x9e\xd8\xd6\xd5\x6b\x6c\x4e\x4d\x82\x9d\x91\xad\x31\x6a\xb5\xb4\x8e\x47\x6f\xef\x2f\x59\xb8\x86\x1c\x39\xd4\xc3\x5c\xf4\xe9\xd2\xa1\x68\x62\xa5\x23\x9b\xeb\x96\x96\x42\x63\xb3\xd2\xdb\x36\x4f\x68\x1b\x52\xf9\xac\x1c\xa5\x1f\xa8\x1d\x4d\x19\xb7\xb5\xa1\xa2\xf2\xbb\xe3\x9d\xe2\x2d\x2c\xa3\x9f\x8a\x0a\x4d\x55\x3e\xe7\x34\xfa\x2f\x80\xd3\x4a\x87\x89\x25\x85\x80\x2f\x12\x41\x3f\xcb\x33\xd4\x33\xc8\x77\xad\x23\xb5\x75\x9e\x12\x59\xba\xb0\x29\x38\x75\xa8\x66\x60\xeb\x60\xa0\x77\xe4\x9e\x41\xc8\x2d\xad\xd3\x7d\x91\x3b\x48\x51\xe7\xc4\x65\x36\xda\x10\x23\xd3\xe8\xa3\x67\x7f\xb5\x0f\xf4\xd4\xd4\x8b\x1c\x50\x93\x09\x08\xd4\xc7\xad\xf5\x48\x4b\xfb\x08\x45\x54\xd5\x7f\xb6\xc2\x60\x4b\x61\x42\x50\xd2\xee\x98\x6c\xa9\x55\xec\x53\xe7\x44\x68\xb0\x20\x14\x08\x5a\xd2\x3a\x68\x34\x46\x43\xba\x4f\x16\x61\xbf\x24\x7b\x3b\x31\xcf\x13\x4a\xe0\x09\x94\x46\x98\x25\x3d\x77\x0d\x71\xb5\x96\x7c\x95\x6f\x59\x2f\xae\xee\x13\x96\x4e\x4e\x21\x57\x78\x96\xde\x9c\x98\xc1\x2b\xe2\x39\x39\x37\x29\x8c\x80\x17\x0d\x4c\xaa\x4b\xf0\xed\x87\xdc\x32\xe4\xa9\x40\x89\xa5\x43\x3d\x64\x36\x47\x93\x20\x78\x6d\x0a\x0d\x19\x83\x1e\x9c\x40\x4e\x8c\x36\xed\x94\x2f\x3b\x79\xe5\x2f\x02\x55\xd5\x2c\xde\x80\x0d\xb4\x07\x4b\xaf\xf2\x5d\xed\x53\xf9\x46\x6e\xf6\x86\x53\x39\xe2\x59\x9a\x70\x0c\x66\x51\x73\x54\x31\x5c\xa7\x67\x3c\x8b\x1f\x78\x76\x51\x47\x55\x35\x9f\xe3\x37\x23\xa7\xa1\x95\x4e\x6f\x96\x6f\xe5\x76\x7f\x32\x77\x38\x0d\x01\x96\x99\x0f\xdb\xa9\x07\x02\xcd\xaf\xf6\x72\xa0\x30\x01\xeb\x7d\xfb\x76\xf3\x79\x0f\xcb\x3a\x76\x9d\x6f\xb6\x7f\x72\x1b\x7d\x11\xdc\x15\xe5\x6e\x12\x27\x51\xee\x2f\xfb\xb2\xed\xd7\xa8\x0f\x70\xed\xb6\xc2\xe7\xd1\x5e\x76\x81\x7d\xdd\x6f\x23\x6b\x0a\xb0\x91\xab\xb8\xf8\x27\xc1\xbd\x20\x0a\xa1\xbf\x66\xdb\xee\xa3\xb6\x5e\x32\xdf\x47\x64\x0f\x42\xc5\x8d\x9f\x0e\x15\xff\x07\x80\xba\xe4\x2f\xd0\x76\xf1\x96\x43\xfd\xea\xfa\x2f\xec\xf6\x17\x76\x7b\xf8\xfa\x85\xdd\xfe\xc2\x6e\x7f\x61\xb7\x27\xae\x77\x9c\xb8\xbf\x85\xdb\x5f\x10\xee\x61\x95\xfc\x5d\x91\xdc\x24\x5e\x03\xb9\x49\x7c\x9b\xfe\x02\x71\x8f\x9b\xc8\xdf\x00\xcb\xdd\xfe\x2a\xd7\x81\xaf\x4b\xfe\x90\x69\xac\xae\x5f\x48\xef\x25\x72\xfe\x93\x90\xde\x1d\x0f\xfa\x05\xf8\x5e\x24\xe0\x6f\x03\xf8\xba\x4c\x34\xb8\xf9\xfc\xe5\x66\x24\x3f\x1b\x26\x3f\x64\xb8\xce\xb7\x7f\x2c\x89\xaf\x6e\xe1\xd5\xbf\x32\x82\x68\x80\xcd\xff\x6f\x6e\x56\xc8\xed\xa7\xbd\x48\x55\x97\x95\x37\x9f\x6b\x98\xf7\x73\x8d\xf3\xbe\x89\x65\xd4\x71\xef\xa3\xa2\xab\x96\x70\x5f\x3c\xdb\x91\xc7\x7b\xa8\xf3\xfa\xcb\xee\x1b\xa0\xf3\x82\x5a\xe0\x3b\xe2\xf8\x04\xcf\xfa\x1b\xee\xbb\x9c\x83\x8e\x79\x98\xa5\x6e\xba\x4e\x42\xdb\xae\xa1\x61\x9e\x25\x9b\x7c\x4f\x2b\x2c\xae\x4b\x58\x0a\x83\x5d\xb6\x65\x48\xea\x6b\xec\x84\xda\x83\xb4\x38\xe2\x1f\xac\xe9\x38\x94\x81\x86\x3c\x71\xb8\xc2\xdb\x38\x86\x0c\x6c\xa3\x8f\x20\xd5\x4d\x1d\x96\x9c\x3a\x0b\xe8\x64\x62\x71\xd2\x02\x0e\x6e\x13\x84\xd8\x1e\xe6\x90\x13\x0b\x9e\x25\x70\x7d\xc4\xab\x77\xb3\x25\x64\x8e\x6d\x4a\x8e\x81\xcf\x2c\x8f\xbb\x9f\xb2\xb5\xdc\xd5\x31\xfb\xf2\x38\x56\x08\x96\xc7\x9a\x3e\xbd\x3a\x0a\xcd\xec\x50\xcc\x1d\x9f\x79\x1a\x75\xb4\x91\xec\xc5\x5f\x59\x6f\xe6\x9b\x86\x46\xd8\xbd\xa1\xff\xec\xb7\xaa\xcf\x7d\xde\x97\xbf\xaa\xdd\xfe\x48\xef\xa2\xfe\x58\xed\xf6\x64\x45\x45\x42\x19\xd3\xfc\x34\xf2\xdc\x22\xea\xb3\xd2\x92\x87\xe5\x1f\x79\x96\x9f\x6d\xd1\xaa\x5d\x4d\xeb\x78\x7e\x25\xe7\x45\x8a\xfa\x2f\x52\x4c\xb3\xb3\x7a\xac\xad\xa3\xd7\x4a\x27\x20\x94\xd6\x3a\x11\xc2\x1a\x52\x8d\x4d\x4a\x1d\xac\xe1\xd1\x5e\x8b\xac\xf4\xc6\xfb\xb2\xa4\xce\x68\x53\x57\x91\x28\xa9\xb0\xcb\x7b\xd1\x06\xec\x57\x1f\xf7\x16\x20\xa0\x1f\x5c\x29\xf6\x4d\x43\x44\xe2\x54\xf2\x04\x5d\xf2\x46\xed\xe2\xe9\x8d\x57\xec\xaa\x48\xf2\xd7\x47\xbb\x8d\x56\xe9\x34\x26\xcd\xf5\xef\x40\x2c\x21\x73\x8a\x1f\x77\x75\x85\x5f\x43\xdd\xa0\xd7\xcf\xdd\xde\x6c\xf5\x3b\xb3\x29\xad\xb9\x80\xac\x55\x4a\x64\x19\x6c\xb1\xcc\xd3\xa8\x5d\xf8\xfd\x6e\x4b\x19\x93\x13\x4b\x22\x5e\xfb\xb2\xda\x54\x25\x42\x53\x34\xf6\xa8\x9c\x1a\xfa\xae\xf6\x79\x39\x9f\xa9\xcd\xd1\x0d\xc7\x67\xb0\xea\x33\xaf\xc3\x36\x53\x3c\x4f\x3b\xc5\xb0\xcd\xec\xae\x7b\x6a\x73\x64\x6e\xb1\x4c\x21\x4e\x99\x62\x75\x8c\x7c\x70\x8e\x3b\x90\x3d\x68\xa0\xb9\x49\xd1\x99\x15\xa0\x70\xa5\x0b\xbe\x5d\x3c\xd5\x47\xd3\x68\x31\x77\x83\x58\xe8\x7d\xa4\x80\xed\xbd\x56\xc0\x0a\x36\xdf\x93\x09\x39\x0d\x5b\x2c\xbd\xdc\x73\x62\x3d\x07\x40\x69\x25\x0c\xd0\xd4\x52\xb6\x5e\x07\xd0\x9c\x00\x11\x86\x14\xb7\xac\x50\x46\x60\x4a\xce\x1c\x4a\x4c\x2c\x83\x7f\xa3\x97\x62\xc5\x32\xba\x64\xe5\x37\x60\x7e\xe8\x79\x34\xd0\xc9\x95\x0d\x69\x90\x9d\x69\x19\x0c\x50\xe9\x50\x4d\x5c\xe9\x53\x0e\x50\x6a\x8d\x89\xe5\xde\x8b\x4d\xb0\x86\x32\xd7\x3a\xec\xba\x9c\x36\x55\x6b\xbb\x94\x25\x10\xa8\xb4\xa0\x2c\x20\x8c\xc5\xd1\xfe\xca\x97\x5a\xa5\xa3\x30\xd8\xf2\x19\xe4\x78\x9b\x3c\x12\xbd\x84\x36\xea\x23\xff\x37\x1b\xee\x93\xce\x02\x7a\xac\x5f\x27\x71\x82\x2e\xb6\x14\x06\x55\xf7\x00\xcb\x54\x7e\xfe\xf8\xdc\x96\x88\x61\xc9\x60\xe8\x33\x5b\xb6\xf9\xec\xd3\x73\x4b\x17\x4b\xcb\x90\xe7\x3c\xbb\xb4\x43\x8d\x5e\xe8\xfe\x6d\xbe\x39\x68\x13\x6f\xb0\x9e\x4f\xaf\x74\x53\xf9\x58\x0e\xd8\x7a\x9e\x8a\x65\x88\x84\x69\xf4\x89\x91\xde\x4d\x21\xd5\x6d\x82\xe2\xed\x9e\x4a\x69\xd3\xd1\xb8\x33\x58\xae\x7d\x68\xeb\x64\x0c\x3b\x28\x83\x5c\xa5\xdb\x6e\x3a\x1a\x33\x03\x16\xe1\xfe\x0a\x36\x36\xa4\xd8\x30\xf5\x26\x61\xe9\x32\xeb\x8e\x49\x0c\xf5\x57\xc2\x56\x96\xb0\x5f\x28\x4f\x40\x00\x11\x64\xd6\xd0\x4e\x13\x34\x64\xe4\xe8\x22\xe1\xb0\xad\xe5\x6b\x15\xaa\xb7\x67\x0f\x52\x34\x18\xf4\xc4\xd4\xd2\xb5\x82\x6f\x0f\x8b\xe7\xb2\x55\x5a\x7a\x1d\xc7\x62\x9e\x35\x07\x7f\x63\x58\x63\xbb\xee\xfb\x3f\x82\x6e\x1c\x45\x28\x7e\xdb\x56\xcb\xf7\xdf\xfe\xfc\xed\x7f\x03\x00\x00\xff\xff\xfc\x05\xd8\x15\x74\x48\x00\x00")
+var _envDevelopmentJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\x7b\x73\xa2\xca\xb6\xff\xdf\x4f\x91\xe2\x9e\xaa\xcc\xd4\x9d\x24\x80\x71\xf6\xb0\xab\xf6\x1f\x48\x14\x51\x24\x02\xf2\xdc\x67\x6a\x17\x74\x13\x44\x9b\xc7\x95\x06\x83\xa7\xe6\xbb\xdf\x6a\x5f\xf1\x1d\x93\x99\x39\x75\xee\xdd\x03\x24\x51\x58\xbd\x7a\xad\xd5\xeb\xd1\xdd\x3f\xf2\xaf\xda\xd5\xd5\xd5\x15\xf5\x8f\x1c\x8c\x82\xd8\xa3\x7e\xbf\xa2\x46\x18\x67\xf9\xef\x77\x77\xcb\x3b\xb7\xb1\x97\x78\x61\x10\x07\x09\xbe\xf5\xe6\xc5\x34\xb8\x05\x69\xbc\x7a\x96\xdf\xb1\x34\xd3\xb8\xa1\x99\x1b\x9a\xb9\x83\x41\x86\xd2\x8a\xd0\x0d\x83\x38\x43\x1e\x0e\x6e\xc7\x79\x9a\xfc\x17\xf5\x69\xd9\x03\x48\x13\x1c\x24\xd8\x0c\xa6\x79\x94\x26\xa4\x23\xe6\x96\x26\xe7\x9a\x20\xf3\xa6\x5e\x1c\xe0\x60\x9a\x53\xbf\x5f\x2d\xc5\x22\x27\x05\x22\x7e\x9e\x0d\xd3\x49\x90\xec\xdc\x27\x27\x85\xab\x2c\x20\xac\x72\x3c\x8d\x92\x90\xda\x3c\xfc\xf6\x69\xf3\x91\x02\x91\xe0\x65\x1e\x88\x70\x75\xba\x7d\x94\xe0\x53\x8d\x1f\x16\x7a\x0d\xd3\x14\x91\x1e\x4e\x72\xf0\xd3\x14\x51\x9f\x76\x9f\xc1\xe0\xc9\x2b\x10\x36\x3d\x54\x10\x9a\x27\x0f\xe5\xc1\x89\x5e\x06\x69\x8a\x14\x2f\x0e\xde\xa7\x62\x36\x4d\x9f\x2b\x21\x98\xe2\xef\x69\x8e\xa2\x20\xc1\xdf\xc9\xe4\x21\x8d\xbd\x28\x21\x8a\xc8\x9e\x1f\xa0\xef\xe0\x24\xc5\x5e\xf8\x3d\xd6\x58\xb4\xe7\x0b\x3c\x3a\xc3\x23\x00\xc5\x34\x78\x95\x53\x2f\x38\xe3\x38\x17\xf0\x28\x7c\x14\x01\x69\xc0\x43\x38\x0d\xf2\x9c\x47\x28\x05\x1e\x8e\xd2\xa4\x1f\xe0\x51\x0a\xcf\xb0\x5e\x32\x3d\xef\x53\x94\x8e\x3d\x1c\x81\x8b\xba\xd6\x27\xc5\x45\x2e\xf6\x7a\x8f\x09\xf4\xa6\xf0\x78\x9f\x79\x3e\x1a\x2c\x34\x3e\x6f\xb6\x33\x06\x2b\xb3\x44\xe0\x89\x1f\x46\x4f\x11\xf0\xf0\x5b\x9c\xa0\xb6\xc5\x8b\x9a\x06\x79\x5a\x4c\x41\x40\xd2\xc9\x9f\x1b\x9a\x3d\x56\xf9\xa4\x38\xe0\x4f\x2e\x2a\x59\x5a\x8a\xfa\xf3\x25\x2d\x7d\xb8\x3e\x6e\xd0\xeb\x8f\x5f\x5f\x64\xd8\xd3\x66\xed\x46\x19\xd1\x27\xd8\xcd\x6c\x87\xe3\x74\xe8\x1b\x67\xfb\xdf\xa7\x7f\x55\x90\xb5\x52\x30\x28\x6f\xca\x2c\xb9\xc9\xa2\x8c\xfa\x74\xdc\xb6\xfd\x08\x4c\xd3\x3c\x7d\xc2\xb7\x4a\x80\x67\xe9\x74\x72\xb7\xd7\x79\x90\xef\x37\x5d\x0b\x43\x9a\xff\xb9\xb6\xbe\x38\x4d\x8b\xec\xc3\xc7\xdb\xf5\xc3\xaf\xfb\xad\xbc\x2c\xda\xaa\x09\x2c\xcd\x70\x37\xf4\x6f\x37\x34\x43\xd5\x8e\x68\xf1\xaf\xb7\x19\xd6\x5b\x8d\x53\xe6\x81\x43\x37\x5a\x1f\x6b\xaa\xc1\x34\x78\x8a\x9e\xf7\xdc\x65\xff\xa4\x98\x45\xcd\xba\xa5\xef\x38\xaa\x76\xe4\xf9\xd5\xd7\x83\xbb\x7b\x83\x40\x2e\x2a\x2f\xfc\x24\xc0\xa7\xbb\x3a\x2e\xe9\x25\x3a\x9f\xd6\x8c\xfa\x7d\x4b\x7a\xf6\xfe\xb8\xf8\x27\x04\x3e\x08\x0b\xd1\xc3\xc1\xcc\xab\xf4\x85\x1e\x54\xed\x0d\x6c\x7e\xaa\x6a\xcc\x52\xb5\xd3\x0a\x90\x93\x4a\x96\x1e\xad\x93\xdc\x1d\xe1\x6a\xe1\xa2\xaf\xf6\x48\x2e\x2a\x82\x3b\xce\x2d\xc1\x0f\xd7\x87\x81\x72\x8c\x7d\x7e\xfd\xe9\xea\x7a\x9a\xdd\x24\x79\x48\xa2\xf4\xbc\x80\xe4\xa4\xb0\x17\x12\x33\x24\x05\x42\x67\x89\xbf\x7d\xd7\x38\xae\xe6\x35\x67\xc7\xb1\x76\xde\xc7\xf7\xba\xd9\xb0\x5e\x24\x19\xe2\x1d\x17\x67\x98\x32\x9a\xe2\xc2\x43\xab\xaf\xff\x07\xf2\x4b\x94\x09\x69\xf2\x14\x85\xc5\x74\x21\xd8\x4f\x8f\xe7\x65\xda\xf8\x81\xae\xba\x67\xf1\xbb\x55\x5e\x22\xce\xba\x1e\x3e\xf2\x79\x27\xda\x0f\xaa\xcc\xfe\xb9\xe7\x10\xa7\xcb\xdd\x32\x84\x7f\xa0\x3a\x07\x25\x6a\xa3\xc8\xb2\xd8\xbd\x2e\x7a\xed\x1d\x4a\x6d\x79\xfc\x62\xbe\x4f\xd5\x2e\x63\xfd\xf5\x90\x25\x55\x66\xc9\x70\x15\x1f\x5a\x5a\xe0\xa0\xe9\xe5\x01\xdc\x73\xe9\xb3\x13\x97\x1d\x81\xcc\x2c\x11\x67\xcc\x91\xe6\xe4\xa2\x70\x14\x4c\xb7\xa8\x6a\x17\xa8\x4c\xe4\x5b\x2d\x14\xb6\x1d\xff\xb4\x24\x1b\xfa\xd5\x90\x90\x75\xce\x49\xea\x37\x57\x63\x72\x51\x0c\xc7\xde\x32\x9f\xbf\xdc\xb2\x8d\xc6\x2b\x85\xed\x6b\xed\x0d\x23\xfb\x22\xba\x96\xa6\x78\x6b\x3e\x7a\x5e\xa4\xd3\x9a\xbd\x35\xda\xb7\x62\x85\xf4\xfe\xe0\x61\xef\x60\x42\xb8\x3f\x57\xfe\xee\xd8\x7c\x71\xe5\x65\xd0\x00\x8f\xaa\xbd\x2d\x58\xbe\xbe\x66\xce\xc1\x34\xc5\x29\x48\xd1\x79\x3b\x52\x8f\x59\x90\x98\x03\x85\xaa\x5d\x36\x92\xdf\x6a\x67\xd4\xdc\x8a\xd0\x85\x5a\xef\x2d\x49\xab\x3c\xf8\xb3\x2b\xd3\x1e\x1d\x0c\xb2\x20\x81\xf9\x63\x72\xd4\x60\x3f\x22\x27\x7e\x7a\x33\xd7\xbd\xc2\xb1\xe1\x79\xac\x3e\x7c\xad\x1d\x19\x96\x7f\xd5\x2e\x4a\x67\x9b\x91\x5b\xaf\x3a\xff\x7a\x60\xf3\xbf\xca\xfa\x31\x91\xd7\xf9\x6c\xb3\x40\x3d\x42\x03\x5e\xb6\x82\xa8\x3f\xa3\x04\x7f\xd8\x8e\xa6\x97\x9d\xa2\xeb\x8f\xfb\x4a\xec\x7b\xd4\x2b\x81\x4c\x15\x59\x38\xf5\x60\x30\x48\x51\x04\x0e\x17\xc2\xeb\x83\x8a\x53\xb8\x50\xaf\xef\x25\x85\x87\x76\xbb\x3c\xd2\x2d\xb9\xa8\x95\xed\xfb\x1e\x18\x45\x49\x30\x98\xa6\x4f\x11\x3a\xb3\xbc\x49\xf3\xd7\x48\xc8\x49\x81\x34\xce\x0a\x1c\x4c\xc9\x8a\xf6\x65\x56\x0d\xa2\x1b\xea\xd3\xe9\x46\x1e\x8c\xa3\xc4\xc8\x83\xe9\x7a\x98\x00\x4a\x0b\x78\x53\xe4\xc1\xf4\x5c\x33\x14\x25\xc5\xf3\xce\xd4\xe9\xac\x6c\xe4\xa2\x60\x94\x7b\x3e\x0a\x06\x5e\x9e\xcf\xd2\x29\x24\x5b\x3b\x41\x82\xa3\x4d\xe0\xe1\x69\x11\x9c\xee\x72\xbd\x37\xf1\x96\xac\xdb\x0b\xaa\xf3\x39\x6a\xfb\x78\x9d\xeb\xfa\xa0\x32\x6f\xb1\x2b\x45\xdd\x8d\xd2\x38\xb8\x7b\xb1\xd8\xdd\x6d\x9e\x8f\xee\xbc\x02\x8f\xd2\x69\x34\x0f\xe0\x5f\x13\x22\xc0\xa7\xda\x05\x3c\x17\x17\x35\x09\xaa\xa3\x55\x62\x7b\x4f\xe6\xd5\x0a\x71\x3c\xa1\x5e\x96\x84\x2f\x6b\x7f\xfc\xc9\x11\x4f\x27\x17\x95\xe3\x74\xea\x85\xaf\xba\x39\xb9\xa8\x88\x6c\xfa\x69\xc1\x53\x30\x0d\x92\x33\x2b\xfe\xf5\xb9\xac\xae\xf9\x68\x99\x36\xb4\x00\x76\xbc\xfd\xd5\xca\xfe\x41\xa5\x4f\x4f\x2b\xf2\x4e\x4b\x7e\x8d\x78\x99\xd5\xa8\xdf\x6e\x64\xb3\xff\x1a\x6d\xf9\x52\x0a\x7e\xbb\xe5\x6e\x59\x9a\xa5\x19\x9a\x66\x98\xcf\xa7\x87\xeb\x84\xc9\x56\x51\xff\x10\xe5\x93\xd7\x4d\x00\xa6\x81\x87\x83\xc7\x6c\x15\x45\x54\x7b\x9a\xc6\x8b\xbd\xd3\xd7\xe4\x5d\xe2\x02\xf0\xa2\x5e\xb6\x07\x92\x07\x20\x2d\x12\xbc\x9e\xe5\x0e\xa6\x41\x1c\x15\xf1\x5f\xb2\xa6\x53\xff\x16\x7f\x5a\xad\xce\x2f\xf2\xa7\x15\xad\x94\xe0\x60\xfa\xe4\x81\xe0\xc2\xd5\xde\xfa\xbc\xc0\x28\x9b\xbc\x19\xdd\x94\x71\x9e\xdf\x24\x11\x78\xc5\xf0\xef\x99\x46\xae\xda\x44\xb1\x37\xad\x2e\x4a\x95\xeb\xe3\xf2\x15\xee\xfb\xf4\x3f\x5a\xea\xd7\xb6\x88\x32\xb0\xb0\xf7\x05\x06\xf9\x5e\xe3\x1c\xd9\x9c\x7b\x57\xdb\x1f\xbe\xf2\xde\xd9\x9f\xb9\x38\x77\x5f\x10\x07\x3f\xdc\x55\x8e\x14\xd0\xcd\xa4\x73\xc7\x87\xde\x6f\xd8\x7d\x07\x39\xdc\xbe\xfe\x77\xf9\xc8\xfa\xa0\x60\x92\xeb\x01\xc6\x51\x12\x7e\x1f\x23\x72\x51\xf0\x00\x3d\xa3\xbc\x69\x7a\x03\x22\xaa\xf6\x4e\x96\x67\xb2\xe6\x8f\x6d\xf5\xad\xf6\x73\xa8\x2f\xa3\xfc\x5a\xfb\x3e\x3e\xdf\x6a\x6f\xe3\x7c\xaa\xb6\x04\xcf\x38\x48\x48\x15\xbf\xa8\xba\x6c\xa8\x7f\x4a\x25\x01\x79\x70\x41\x70\xbc\x27\x10\x76\xa7\x4d\x2f\xa9\x8d\x5f\xbc\x1e\xd0\x7a\xd1\xea\xf5\xee\x77\x56\xdf\x42\x91\xe3\x34\xd6\xc1\x34\xca\xf0\x5b\xda\x76\xbc\x04\xa2\x60\xba\xbd\x96\xde\xbc\x55\xf0\xda\x49\x79\x05\x4e\x8d\xe5\x5a\xad\x1f\x25\xe9\x16\x97\x37\x54\xc9\x7c\x2b\x05\x5c\x98\x6f\x89\xe1\x71\x00\x70\x00\xdf\x95\x3f\xa8\x7c\x69\x26\x52\x68\x7c\x2f\x0f\x3e\xdf\x7f\x00\x69\x02\x3c\xfc\x61\xf9\x6d\x98\xea\x0b\x70\xf4\xc3\x35\x60\x4d\x5a\x12\x18\x24\x84\xe9\x1f\xd7\x1f\x3f\x5d\x0b\x12\xef\x0e\x86\x8f\xbd\x96\xf2\xc7\xf5\xf5\xf5\xa7\xdd\xf5\xef\xfa\x4d\x0b\x42\x78\x7d\xfd\xcf\xe4\x9a\xd0\x0f\x1e\x1f\x65\x85\xef\xb7\x8e\xd0\xaf\x5f\x5b\xd8\xa2\x27\xbf\xf6\x65\x10\x12\xc4\xf8\x3a\x8f\x03\xbd\xc9\x00\x51\x1b\x41\xd1\x08\x65\x3b\x0c\x4d\xba\xdd\xf7\xac\x06\x13\xb4\xda\x89\x6b\x35\x68\x21\xcc\x72\x18\x9b\xf7\x50\x34\x0b\x57\xe0\xb1\x2f\xf0\x53\x65\xc8\x23\x0d\x75\xdb\x9a\xce\x97\xae\x68\xb2\x72\xbd\x5b\xfa\x75\x8d\x75\x2b\x8e\x75\xec\x6e\x0e\xc3\xec\xde\x4d\x94\x27\xb7\xde\x2d\x21\xeb\xce\x25\x81\xdc\x97\x7a\x42\xfc\xcc\xba\xf6\x88\x76\xad\xc6\x44\x12\x98\x5c\x12\xf2\xe7\xfe\xc3\x49\x5e\xa9\xcf\x32\xc8\xef\x38\xbd\x40\x74\xe7\x36\x0b\x2b\xbf\x0e\x63\x50\xf1\xa5\x27\x72\xd8\x55\xd3\x1e\x48\x9a\x58\x12\x68\xec\x59\xcc\xcc\xaf\x77\x69\x49\x1c\xd1\xb0\xd3\x9c\x3f\x46\x5f\x4a\x57\x9c\x15\x6e\x6c\x4e\xfc\x7a\x77\x04\x3a\xdd\xd2\x8b\xcd\x31\x14\x1a\x25\x88\x41\x09\x3a\x66\x24\xb3\xe6\xcc\xb5\x66\xa5\x81\x9a\x8a\x6c\x40\x55\xab\x18\x59\x33\x27\x58\x33\x9b\xed\xa1\x40\xd7\x85\xa4\x3b\xf3\x75\x1e\xcb\x16\xc2\x40\xe4\x2a\x28\x34\x53\xd8\xd1\x66\x60\x9e\x96\x72\xbd\x39\x72\x58\x3c\x72\x59\x73\x2e\xc7\x4c\xe6\xd4\xbb\x25\x60\xb9\x18\x0a\x8d\xb1\xcf\xd2\xa5\xc7\x9a\x0d\x50\x71\xd8\xb3\x94\xca\xaf\x2b\xa5\x9b\xa8\x85\x63\x2b\x63\x21\xcc\x1a\xd0\xa2\x43\xd9\x9e\x84\x9e\xd5\x98\x43\xb1\x9d\xfb\xdb\x7c\x59\x2d\x97\x63\x17\xb9\x22\x57\x39\x76\xb3\xf2\xd9\x0c\x39\x75\xb5\xf0\xeb\xdd\x44\xae\x37\x19\x27\xe2\x10\x10\xcd\x7c\x25\x3b\x06\xb1\x99\xbb\x56\x7b\xee\xea\x4c\xee\xd8\x1a\x02\x75\x15\x2b\x55\xa3\xf0\xd9\x76\xe5\xb0\x61\x41\xec\x23\x84\xd9\xd8\xb1\xd5\x70\x10\x71\x08\x8a\xfd\x32\xb0\x4d\x2c\x27\x5d\x04\x44\x6e\x2e\xc7\x6a\xe9\xd8\x19\x03\x62\xa3\x00\xb1\x39\xf3\x2b\xfe\xcb\x40\x80\xed\x21\xed\x24\x02\x22\x8b\x79\xb3\x72\x75\x66\xec\x8b\x08\x0a\x71\x63\xe4\x5b\x06\xb7\xa2\xc7\x0e\xfb\x9c\x09\x71\x77\x04\x58\x93\x01\xf1\x8c\xf3\x3a\x1a\x0d\x3a\xfd\xcf\x72\xc5\xcd\x1c\x4b\x99\x3a\x16\x44\xa0\x6a\xec\xda\x80\xe5\xb0\x5c\x47\x8c\x6f\xaf\xfa\x67\xdb\x9f\xa1\xdd\x45\xb2\xa5\xe4\x9e\x9a\x21\x3f\x6e\x47\xbe\x68\x4e\x06\x36\x42\x60\x96\x25\x40\x84\x63\x4f\x34\xc7\xde\x9c\x69\xb8\x76\xbf\xa7\x19\x9c\xb8\xb1\xa1\xce\xac\xe9\x2b\xd7\x6e\x96\x03\xbb\x9b\x42\x6b\x82\x41\x3c\x42\xbe\x40\xd7\x65\x5b\x41\x20\x71\x11\x88\x98\xca\xeb\x98\x99\x6c\x71\x33\x28\xa2\xd2\x8f\xdb\xb9\x6c\x77\x67\xbe\xdd\xdf\x1f\x87\x2d\x7d\x27\xa1\x2b\x72\x63\x8f\x35\x2b\x49\xcc\x9e\x25\xf1\x39\x73\xe2\xf6\x1c\xd4\xcd\x91\x1f\x31\x13\xd7\x76\x91\x2f\x34\x13\x87\xed\x87\x6e\xbd\x99\xf8\x96\x81\x89\xef\xba\xd6\x2c\x04\x71\x88\x5d\x16\xd1\xfd\xf1\x64\xf1\x19\x74\x10\xed\x89\x5c\xd1\x9f\x3b\x21\x14\x99\x19\x64\xdb\xb4\xc3\x86\x3d\x61\x02\x07\x36\xe3\xb6\x0d\xa4\x74\x87\xf4\x3d\xd7\xd7\xef\x9f\x15\xe1\xbe\x21\xc4\x0a\xb1\x67\xb8\x65\xcf\x89\x2f\x34\x12\x9f\xe5\x12\x5f\x34\x96\x36\x64\x61\x29\xb3\xb0\xec\x76\x70\x67\xc8\x70\x96\x66\x76\x87\xba\xc1\x3d\x3e\xe9\x8d\x7c\x11\xbb\x02\x33\xf2\x2d\x95\x55\x84\x06\xed\xd8\x52\xe1\xd6\xd3\xf0\x49\x68\x92\xcf\xa1\x6c\xf4\x43\xb9\x6e\xce\x41\xc4\xe5\x3e\xab\x8c\x7c\x81\xc7\x41\x27\xed\xf9\xe2\x7d\x28\xdb\xfb\xcf\xb8\xc4\xaf\xb8\xc8\xb3\xee\xcb\x5e\xc4\x97\xd0\x56\x2a\x99\x7d\x2e\x1d\xb6\x9d\xcb\x6c\x37\xf3\xc3\xb4\x67\x22\xc5\x30\x18\xae\xa9\xd1\xe6\xa3\xd9\x7e\x91\x65\x30\x94\x8a\xfe\x10\x30\xf2\x58\xea\xf9\x16\x9e\x78\xa4\x6f\x76\x54\xfa\x96\x51\x3a\xec\x73\x09\x2d\x15\x43\x32\x46\x11\x47\xe2\xa8\x80\x7c\x36\x83\xb6\x92\xba\xc2\x2a\xbe\x75\x6e\xec\x8b\x1c\xe3\x0a\x0c\x03\x58\xb3\x92\xd9\x55\x3e\x3a\xb4\x0f\x0b\xea\xda\x9c\xf8\x9b\x9f\x68\x1b\xdf\x93\xe3\xa5\x6f\xb9\x96\x96\xb8\x7a\xa3\x70\x6d\xb5\x5c\xd2\xa8\xc4\x6e\x96\xc1\x68\x43\x9b\x6e\x77\x34\xa3\x61\xbc\x8c\x43\x83\x93\xeb\xee\x1c\x76\xfa\x78\x45\x8b\x7d\x11\x15\xd0\x0e\x71\xf0\xe0\xd0\xb2\xae\xfd\x76\x4a\xdf\x27\xfd\x88\xad\xed\xd1\x67\x21\x56\x52\xbf\x0e\x0b\x49\x60\x74\x49\x54\x72\xbf\x6e\x4e\x64\xdb\x9c\xbb\xb6\xf4\x79\xd7\x0e\x7c\x21\x84\x69\xb1\xb0\x6b\xc4\x65\x7e\xa2\xd0\x8e\xf5\x9c\xbb\x22\x89\xf5\xc6\xc4\xb5\x1a\x63\xcf\x32\xe7\x72\xa2\xa4\x42\xa2\x30\xae\xf8\x25\x94\x6d\x23\xdc\xe7\x21\xb3\x4a\xe9\xc7\x6e\xe6\x56\x8d\xb9\x27\xf0\x58\xb6\xcd\xc2\xb1\x35\x7a\xc9\x43\x25\x71\x73\xe0\x5f\xae\xed\x14\xeb\x3c\xb0\xf4\x2f\x66\xbe\xfa\x4e\xe8\x47\xd0\xd6\x52\xa9\xd3\x1c\xc1\x05\x3f\x8d\xe4\xb7\x42\x12\xa4\x89\x4a\xa3\xa6\x85\x9a\xc6\x90\xc6\xed\x61\x24\x11\xda\x99\xcf\x72\xf9\xea\x99\x3a\xa4\xb9\xfe\x70\xd2\x56\x34\x7d\xf1\x6c\x39\xce\x02\x1f\xa9\x66\x77\x20\x9b\x5d\x49\x33\x66\xb8\xdb\x1a\x0d\x0c\x46\x7b\x54\x0d\xa6\x2d\x45\x84\x3f\xc9\x0d\xcf\x23\x87\x35\x7a\x72\xc4\xcd\x61\xdc\x2f\x00\x1b\x6e\xc7\xe9\x8e\x5f\x08\x49\xb3\x04\xa2\xda\x13\xa2\x7e\xe8\x5b\x66\xe5\xb2\x46\xe8\x59\xf7\xa1\xcc\x72\x33\x28\x70\x95\xb7\xf8\xa1\xff\x47\x66\xcd\xc2\xb1\xba\xb9\xab\x6e\xf2\xe1\xa2\xbe\xc8\xec\xce\x98\x6c\x7c\x44\x4e\x9a\x23\x28\x86\xe1\xe0\x61\x96\x90\xbc\xd3\x9d\xa5\xa5\x5f\x6f\xd2\x72\xbd\x9b\x2e\x7e\xec\x66\x03\x8a\xa3\xd2\x1f\xf7\x57\x35\x4b\x5d\xc5\x47\x37\xf3\xc7\x7b\xb4\x16\xcc\xe0\x83\xd4\x38\x46\x77\x10\x53\xe3\xf4\xf4\xf3\xba\x42\x62\xf1\xf3\x2a\x46\xe7\x4e\x8c\x8a\xc7\x13\x7a\xc8\xf1\x2e\xcf\xe3\x31\x45\x62\x38\x23\xf9\xbe\x27\xc4\x0a\x19\xdf\xff\x3e\x1b\xa3\xc2\x97\xc2\xb5\x1a\xac\xf4\x30\xfb\xd2\xa5\xcd\x81\x16\x81\xde\xb0\xa5\x3d\x0d\x5b\x48\x30\x26\x6d\xdd\x32\x39\x55\x35\x35\x69\xa0\x73\x25\xe8\xa8\x25\x88\xc3\x72\x2f\x07\x96\x20\xe6\x4a\x28\x70\x0c\xa8\x4b\xa5\x2f\xa2\x48\x19\xab\x9f\xb7\xc6\x6a\x51\x0b\xdd\x07\x89\xde\xb5\xd5\x73\xe6\x8c\x1d\x5a\x98\x30\xcd\x21\x6a\x36\xcd\x56\xc8\xed\x8e\x2f\x33\x0b\x6c\x2d\xf5\xd9\xfb\xb9\x12\x71\x95\xcf\x72\xf4\xda\x46\x9e\xd8\xae\x5c\x9d\xc3\x8e\x75\xbf\xdd\x4f\xe5\x09\x4c\xe2\xd9\x6a\xf5\xa8\x9f\xa4\xef\x19\x2d\x53\x1f\x3e\x98\x7d\xdd\x90\xb8\x37\xb4\x9d\xb9\x76\x37\x57\x74\x8e\x25\x31\xe7\xd7\xbb\x4f\x40\x34\x2b\x9f\xcf\x54\x9d\x86\x4f\x2a\xcd\x3d\x6a\x13\xd4\xb1\x99\x37\xe9\x91\x7b\x96\xc4\x2a\x02\x37\xf3\x58\x38\xf6\xd9\x46\xec\x59\xa0\x67\xb5\xb4\x8e\x4d\x6b\x4d\xb3\xd5\x7e\xd2\x5a\x48\x37\xe6\xf4\x65\x3e\x5a\x57\x52\xc7\xee\xa2\xc7\x68\x3d\x0e\xdc\xd8\xb1\x66\x25\x60\x47\x23\x10\x1b\x1b\xff\x5a\xd8\x42\x5d\xf9\x47\xa2\x20\x32\xff\xf3\x74\x1e\xbb\x3a\x9f\x80\x8a\x0b\x86\x4c\xd3\x20\xb9\xcf\x98\xd3\x89\x2d\x80\x44\xa6\x39\xd5\x6c\xa1\xc1\x10\xf5\xb9\x2e\x33\x4b\xba\x15\xd3\xd9\xcb\x79\xa1\x5c\x81\x50\x66\x4d\xda\xa9\xb8\x79\x60\x2b\x6b\x5d\xca\x75\xbd\x15\xc2\x8c\xdc\xa7\x5d\x8b\x19\x43\x71\x16\xba\x56\x63\xe4\xc4\xcf\x48\x12\xb5\xd2\x61\x31\x02\x61\xda\x73\xd8\x36\x2d\x3d\xdc\x97\xae\xad\x8d\x65\x56\xa9\x7c\xf6\xbe\xf0\x44\x8e\x01\xf1\x73\x43\xae\x6b\x18\x74\xe0\x08\x8a\x4a\xba\xed\xa7\x52\xd5\x2a\x9d\x18\x91\xfc\x3a\x02\xa4\xfe\xc6\xe6\xbd\x6b\x91\x7a\xb7\x88\xa9\x72\xab\x36\x87\xfd\xb1\x96\x4a\x02\x47\xfb\x36\xbf\x9c\x73\xc4\x4a\xea\x5b\xdc\x44\x12\xf0\xbd\x24\x2c\xe7\x51\x0e\xb1\x67\xd4\x48\xfd\xba\x59\xf9\x9d\xc9\x76\xfb\x9e\x10\x8d\xe6\xbe\x68\x22\x20\xf0\xf3\xfe\x43\x1e\x82\xd8\x8c\xc8\x58\xf6\x74\x3e\x16\xc2\x3f\xfe\xb8\xfe\x78\x80\x65\xbd\x7d\x19\x7c\x19\xc5\x9b\x97\xd1\xb5\x0b\x16\xd6\x54\x5a\x06\xd3\x6c\x9a\x96\xd1\x6a\x5d\xb6\xfb\x1a\xf5\x91\x56\xfb\x4b\xe1\xbd\xa5\xe0\x66\xbd\xf9\xb2\x72\x15\x96\xd8\xd7\xdd\x2e\xb2\xa6\x03\x0f\x05\x7a\x80\x0f\x18\xbc\x0f\xee\x05\x69\x02\xa3\x4d\xb3\xdd\x75\xd4\xce\x4b\xe6\xd7\x1f\x2f\x82\x8a\xeb\x3f\x1c\x2a\xfe\x7f\x00\xea\x32\x67\x1d\xe3\x6f\x02\xda\x12\x24\x7e\xf1\xea\xfa\x2f\xec\xf6\x17\x76\xfb\x0b\xbb\xfd\x85\xdd\xfe\xc2\x6e\x7f\x26\x76\xfb\x92\x6e\x7f\x41\xb8\x2b\x08\x77\xcf\x24\x7f\x57\x24\x77\x9a\x6d\x80\xdc\x69\x76\x93\xff\x02\x71\xb7\x40\xdc\x3d\x17\xf9\x1b\x60\xb9\x3b\x75\xfd\xd8\xbf\x4b\xbe\xcb\x35\xce\x67\xd2\x1f\xdf\xea\x5b\xed\xe7\x50\x5f\x46\xf9\xb5\xf6\x7d\x7c\xbe\xd5\xde\xc6\xf9\x3f\x19\xe9\xdd\x8b\xa0\x5f\x80\xef\x2f\xc0\x77\x1b\xf0\x0d\xf8\xb4\x47\x70\xd9\x81\xf6\x68\x3b\x52\x9f\x17\x5b\x7f\xfc\x63\x45\x7c\x75\x03\xaf\xfe\x59\xd0\x74\x1d\x6c\xff\x26\xf0\xee\x8a\xf5\x41\xa6\x5a\xbc\x12\x78\xfd\x71\x01\xf3\x7e\x24\x10\xef\x16\x5b\xde\x18\x76\xbe\x97\x35\x79\x9d\xf7\x90\xbd\xd0\xd2\x86\x07\xa8\xf3\xe6\x9f\xdd\xb7\x40\xe7\x85\x30\x82\x2c\xb5\x94\xe1\x99\x36\x9b\xff\x70\xdf\x6f\xd9\x6b\x39\xc7\x9b\x2c\x16\x5d\x67\xa1\x6d\x02\x71\x4a\x02\xd3\x90\x3a\xe6\xcc\x15\xdb\xb4\x4b\x60\x6e\xa1\x69\xab\xc6\x73\xe6\x27\xe6\xbd\xba\xdc\xe2\xef\x6d\xe8\x44\x54\x80\xba\x36\xf2\xc9\x76\xe3\xcb\x36\x64\xec\xd9\x5d\x04\xd9\x76\xee\x0b\xcc\xd8\x5f\x42\x27\x23\x57\x54\x97\x70\xf0\x03\x4d\x2b\x0f\xfd\x12\x8a\xca\x8c\x40\xd0\x8b\x2d\x5e\xab\x5d\xac\x20\x73\xec\xb1\x5a\x06\x22\x7e\xb5\xdd\xfd\xa5\xd8\xf0\x5d\x6f\xb3\xaf\xb6\x63\xe5\x78\xb5\xad\x19\x71\xeb\xad\xd0\xc2\x4b\x94\xd2\x8f\xf8\x2f\x83\x96\x39\xd0\xc2\xec\x37\x21\x9c\x44\x8e\x6d\xd2\x5e\xa7\x1f\x3d\x46\x4d\xf2\xbd\x2b\x45\xda\x6f\x46\xbb\x3b\xb0\xda\xa8\x3b\x34\xda\x1d\x4d\x37\x90\x5c\x65\x9c\x34\x4e\xc3\x60\x96\x76\x05\x75\xd5\x46\x90\x3e\x13\x38\x66\x87\xd6\x68\x9b\x66\x2b\x8c\x08\x9f\x27\x35\xed\x3e\xa9\x19\x27\x4c\x16\x7d\xed\x6c\xbd\x12\x9b\x80\x44\xdd\xd8\x44\x4e\x16\x90\x6a\x46\xa0\x99\xf5\x3d\xa9\xd3\x64\x88\xdd\xa4\x48\x53\x8d\x09\xe7\x58\x06\x52\x54\x03\xb6\xa5\x30\xdd\x82\xfd\x16\xdb\xbd\x33\x10\x73\xf7\x81\x9a\x45\x8e\xad\x20\x65\xac\x86\xb2\xa5\x12\x78\xe5\xcb\x4b\x5b\xa5\x6d\x20\x35\xda\x6c\xed\xd6\x9b\x95\x5f\x1f\x35\x36\x7f\x63\xa5\x82\xfc\xb9\xf6\xb8\x6d\xe9\xd2\x06\xea\x26\xb0\x7f\xd0\x99\xac\xff\x16\x1e\x6b\x36\x96\x90\xb5\xc1\x2a\x02\x8f\x5d\x81\xff\x32\x78\x98\x45\xdd\x76\x53\x1f\x32\x23\x57\xa5\x9f\xbb\x04\x0e\x54\x69\x53\x37\x85\x93\x7c\x16\xd0\x37\x19\xe7\x95\x3c\x63\x4f\xe4\xea\x7e\xc4\x63\x23\xe2\xc9\xeb\x0c\xb3\xc7\x71\x6b\xd6\x7f\xe0\xf7\xf5\x1e\x7b\x22\x53\xba\x02\x3f\x53\xc6\xfc\x4c\x12\x8e\xf2\x5e\xca\xb8\x07\xd9\x83\x3a\x9a\x3b\x2c\x57\xb8\x31\x4a\xd6\xb6\x20\x5b\xdb\x8b\xad\x69\xb4\x94\xdd\xa6\x97\x76\x1f\xe8\x60\x77\xac\x75\xb0\x86\xcd\x0f\x78\x42\xd1\xc4\xae\xc0\xad\xc6\x9c\xde\xc8\x40\xa0\x21\x18\xa3\xb1\xab\xef\xbc\x0e\x60\xfa\x31\xa2\x6d\x35\x6b\xba\x89\x86\xc0\x98\x99\xf8\xac\x32\x75\x6d\xe9\x85\x5e\xcd\x74\xd7\x6e\x33\x24\x6e\xc0\xfc\xd8\xf3\xb4\x67\x31\x6b\x1f\x32\xa1\x30\x31\x0b\x18\xa3\xca\x67\x1b\x98\xd8\x53\x8b\x51\xee\x0e\xe9\xd5\xd8\x2b\x0d\xb0\x81\x32\x37\x36\x6c\x07\xa2\x39\x36\x16\x7e\xa9\xa9\x20\x36\x38\x59\x5f\x42\x18\xcb\xad\xfd\x75\x2c\x35\x2b\xf2\x6a\x8b\x1b\xf1\xc8\x0f\xb7\xdb\xa8\xdc\x0a\xda\x58\x6c\xf9\xbf\xf8\x70\x97\x21\xe3\x27\xdb\xdd\xc5\xeb\x24\x7e\xdc\x26\x10\x07\x22\xf7\x80\xc0\x93\x38\xff\xfc\xf8\xa0\xd2\xfd\x8a\xc7\x30\xe2\x77\x7c\xf3\x31\xe2\xe6\xae\xa5\x54\xae\xad\xcd\x25\x61\xe5\x87\x26\xb7\xb4\xfd\x8b\xbc\x25\x78\xa0\x57\x50\xd6\x02\xde\x5d\xdb\x26\x04\xe4\x99\xb0\x90\x53\x77\x6d\x85\x76\xec\x2e\x3d\xb0\xda\x39\x64\xdb\x0d\x30\x7b\xb9\x67\xb0\xe6\x78\x30\x6c\xf5\x56\xba\xf7\x3d\x8b\xc9\x60\x0b\x15\x50\x24\xb6\x6d\xe7\x83\x21\xdf\x13\x10\xee\xae\x61\x63\x5b\xcd\x6c\xc7\x6a\xd0\xae\xa5\x09\xc1\x90\xc1\xd0\x7a\xa6\x3d\x7d\x05\xfb\x25\xda\x08\xc4\x10\x41\x7e\x03\xed\x34\x40\x5d\x43\xbe\xa5\xd0\xbe\xd0\x5c\xbd\x56\x61\x84\x07\xfe\xa0\xa6\xbd\x5e\x47\xc9\x5d\xcb\x9c\x49\x0f\xfd\xd9\x63\xd5\xac\x5c\x6b\x91\xc7\x32\x49\x70\x7a\x7f\x63\x58\x63\x77\xde\xf7\x1f\x82\x6e\x9c\x44\x28\x36\x64\xdf\x6a\x57\x57\x57\x57\x5f\x6b\xdf\x6a\xff\x3b\x00\xfc\x05\xd8\x15\x74\x48\x00\x00")
 
 func envDevelopmentJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -151,7 +151,7 @@ func envDevelopmentJson() (*asset, error) {
 	return a, nil
 }
 
-var _rbacDevelopmentJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x96\x5b\x6f\xda\x30\x14\xc7\xdf\xf9\x14\x56\x36\x09\x2a\x61\x92\x98\x40\x42\xdf\xd0\xaa\x4e\x7d\xe8\x8a\xda\x6e\x2f\x88\x07\xc7\x3e\x50\x4f\x89\x6d\xd9\x0e\x55\x3b\xf1\xdd\xa7\x84\x4b\xb9\xa4\x97\x55\x55\xb5\x6a\x83\x17\x88\x8f\xff\xfe\xfb\x9c\xdf\x39\xca\xaf\x06\x42\x08\x79\x9f\x2d\xbb\x81\x9c\x7a\xc7\xc8\xbb\x71\x4e\xdb\x63\xdf\x5f\x3e\xe9\xe4\x54\xd2\x19\xe4\x20\x5d\x87\xde\x17\x06\x3a\x4c\xe5\xab\x35\xeb\x93\x20\xec\xe1\x20\xc4\x41\xe8\x73\xd0\x99\xba\x2b\xe3\xae\x21\xd7\x19\x75\xd0\xf9\x69\x95\xfc\xe4\xb5\x97\x27\x30\x25\x1d\x48\xf7\x03\x8c\x15\x4a\x96\x07\x85\x9d\xa0\xfc\xae\x03\x34\x35\x34\x07\x07\xc6\x7a\xc7\x68\x69\xab\x7a\x4e\x4d\x7e\x05\x66\x2e\x18\x8c\x8c\x90\x4c\x68\x9a\x9d\xf1\x9d\x90\x2a\xcc\xdd\x69\x28\x55\xad\x33\x42\xce\xbc\xcd\xe2\xa2\xfd\x20\x35\xd5\x6f\xa5\xc4\x61\x5e\x27\xf5\x52\xa5\xc6\x96\x9e\x67\xc0\xaa\xc2\x30\x28\xef\x3d\xde\xc4\xec\x49\x49\x9a\x57\x52\xf1\x00\x78\x14\x47\x14\xc7\xa4\x1f\xe3\x68\x3a\x4d\x70\x4a\x48\x1f\x0f\xfa\x61\x14\xa4\x10\xf4\x09\x25\x5e\xbb\xde\xc6\xb9\x60\x46\x59\x35\x75\x9d\x61\xe1\x6e\x94\x11\xf7\xd4\x09\x25\x7d\xa3\x32\x38\x81\xa9\x90\xa2\xfc\x6b\xf7\xb7\x6b\xa3\x34\x18\x27\xc0\x1e\xe4\x6a\xe9\x5f\x65\xf0\x6d\x65\x6f\x78\x79\x81\xe6\x11\x3a\x81\x39\x64\x4a\x97\x34\xa0\x53\x61\xac\x43\x23\x6a\xdc\x1d\xba\x2a\x52\xcb\x8c\xd0\xe5\x39\x7b\xc7\x2c\x8f\x02\x93\x0b\x6b\x2b\x17\xdb\xc9\xd8\xfe\x1c\x3a\xd8\x6c\xa7\xcc\x3d\xb9\x75\x13\xf8\x90\x89\xcb\x75\xf2\x7d\xbb\xe5\xcd\xfa\xeb\xa2\x7c\x35\xaa\xd0\xd6\xbf\x35\xc2\x81\xf7\xa8\xe8\xa4\x76\x65\x71\xf0\x74\x52\x73\x67\x6a\xad\x98\x49\x9a\x66\x70\xc5\x94\x86\xc7\xdd\x7b\xe3\x6d\x8b\xad\xa3\x8e\xe0\x93\x43\x4b\xbb\x56\x16\x7b\xb5\xa4\x5a\x6c\x75\x20\x09\xc2\x64\xd9\xbe\x58\x1b\x98\x0b\xb8\xad\xa5\xfd\x11\x14\xc7\xb3\x42\xf0\xd6\x81\xa7\x36\x6a\x9e\x8e\x90\x8f\x56\x28\x9c\x8e\x76\xaa\xde\x3c\x9a\xbc\x06\xcf\x61\x95\xa4\x92\xa7\x03\x3c\x39\x68\x90\xdc\x5e\xc8\xda\xc4\xed\x26\x6d\x5d\xed\x33\xde\x6a\xbe\xb0\x1b\x9a\x6d\xd4\x7c\x49\xd3\x95\x17\x6b\x3c\x51\xeb\x67\xdb\xc8\x96\xd5\xaf\xf2\x5a\x53\xe6\x1a\x6e\x76\x7d\x56\x83\xec\x5d\x2f\x5b\xd7\xbe\x3b\x63\xd5\x1b\x3f\x8c\xf4\x56\xb3\x6e\xf6\x3e\x27\x73\xbd\x22\x63\x7f\xa7\xf7\xc7\x8c\x0f\xde\x9c\xf1\xe1\xe5\x39\xf2\xd1\x77\x0b\x06\x0d\x19\x03\x6b\xd1\x90\xe7\x42\x0a\xeb\x0c\x75\xca\xbc\x3d\xe7\x1f\x85\x9f\x30\xe1\x31\x4f\x12\x8e\x79\xb7\x07\x38\x9a\xa6\x3d\x4c\x7b\xac\x8b\xe3\x38\xee\x32\x12\xd0\x98\xf0\xc1\x2b\xf8\xa9\x7d\x0d\xf8\xc8\x00\x9d\xc0\x1c\xf9\xe8\x8b\x92\xce\x88\xb4\xf8\xa7\x91\x49\x49\x34\x48\x12\xca\x70\x3f\x4c\x02\x1c\x11\x1a\x60\x9a\x26\x09\x26\xc1\x34\xee\x26\x84\x73\x12\xb1\x57\x20\x53\xfb\x92\xf6\xf1\x91\xf9\x3f\x73\xde\x6b\xe6\xfc\x9d\x00\x55\xbf\x26\x8d\x45\xe3\x77\x00\x00\x00\xff\xff\xa7\x46\xc2\xc5\xbc\x0d\x00\x00")
+var _rbacDevelopmentJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x96\xcf\x6f\xea\x38\x10\xc7\xef\xf9\x2b\x2c\xef\x4a\x50\x09\x93\x1f\x04\x12\x7a\x43\x5b\x75\xd5\x43\xb7\xa8\xed\xee\x05\x71\x70\xec\x81\x7a\x45\x6c\xcb\x36\x54\xed\xaa\xff\xfb\xca\x85\xd0\x04\xa2\xb6\x5b\x75\x9f\x5e\xf5\x5e\xec\x03\xc4\x9e\xf1\xd7\x33\x9f\x19\xe5\x9f\x00\x21\x84\xf0\xaf\x96\xdd\x41\x49\xf1\x29\xc2\x77\xce\x69\x7b\x1a\x86\xdb\x37\xfd\x92\x4a\xba\x84\x12\xa4\xeb\xd3\xc7\xb5\x81\x3e\x53\xe5\x6e\xcd\x86\x49\x14\x0f\x49\x14\x93\x28\x0e\x39\xe8\x95\x7a\xf0\xfb\x6e\xa1\xd4\x2b\xea\xa0\xff\xb7\x55\xf2\x17\xdc\xdb\x9e\xc0\x94\x74\x20\xdd\x5f\x60\xac\x50\xd2\x1f\x14\xf7\x23\x3f\xaa\x0d\x9a\x1a\x5a\x82\x03\x63\xf1\x29\xda\xca\xf2\x03\x53\x53\xde\x80\xd9\x08\x06\x53\x23\x24\x13\x9a\xae\x2e\x78\x63\x8b\x9f\xd8\x3d\x68\xf0\x5e\xad\x33\x42\x2e\xf1\x7e\xf1\xa9\xb7\xff\x89\x17\xfa\xb3\x3c\x71\xd8\xb4\xb9\x7a\xaf\xa7\xa0\xe6\x0f\x1b\xb0\x6a\x6d\x18\xf8\x7b\xcf\xf6\x7b\x0e\x5c\x49\x5a\x3e\xbb\xca\xc6\xc0\xd3\x2c\xa5\x24\x4b\x46\x19\x49\x17\x8b\x9c\x14\x49\x32\x22\xe3\x51\x9c\x46\x05\x44\xa3\x84\x26\xb8\xd7\xb4\xad\x64\x5c\x0a\x66\x94\x55\x0b\xd7\x9f\xac\xdd\x9d\x32\xe2\x91\x3a\xa1\x64\x68\xd4\x0a\xce\x60\x21\xa4\xf0\x7f\xed\xa1\xb9\x36\x4a\x83\x71\x02\x9a\x89\xa9\x06\xf6\xe6\x7f\xec\xe4\x4d\xae\xaf\xd0\x26\x45\x67\xb0\x81\x95\xd2\x9e\x06\x74\x2e\x8c\x75\x68\x4a\x8d\x7b\x40\x37\xeb\xc2\x32\x23\xb4\x3f\xe7\xe0\x18\x3f\xb1\x06\x53\x0a\xeb\x01\x69\x06\xa3\xfe\x1c\x2b\xa8\x1e\x4c\x99\x7b\xd5\xb4\x7a\x6a\x91\xb8\xae\x82\x1f\xda\x9a\x36\x1b\x56\x49\xf9\xdd\xa8\xb5\xb6\xe1\xbd\x11\x0e\x70\xd0\xea\x0d\x21\x34\x6f\x5d\x79\x3a\x7a\x3b\x6f\xb9\x33\xb5\x56\x2c\x25\x2d\x56\x70\xc3\x94\x3e\xa0\xa0\x3e\xf0\xac\x2e\xb1\x7b\xd2\x17\x7c\x8e\x83\xd7\xa5\xd4\x98\xf5\x13\x53\x2d\x6a\x15\x98\x44\x71\xbe\x2d\x5f\xa2\x0d\x6c\x04\xdc\xe3\xa0\xc5\xb2\x19\xf1\x3d\x8a\xb3\xe5\x5a\xf0\xee\x91\xa6\x1e\xea\x9c\x4f\x51\x88\x76\x28\x9c\x4f\x1b\x59\xef\x9c\xcc\x3f\x82\xe7\xe4\x39\x48\x9e\xa7\x23\x3c\x39\x68\x90\xdc\x5e\xc9\xd6\xc0\x35\x83\x56\x65\xfb\x82\x77\x3b\xef\xac\x86\x4e\x0f\x75\xde\x53\x74\xfe\x62\xc1\x2b\xb9\x7e\xb3\x8c\x2c\x53\xdb\x38\xb4\xa5\xb9\xd7\x5e\x76\x2f\x3a\x2f\xf8\x91\xe9\xff\x7c\xd9\x16\x49\xba\xd1\x56\xf1\xec\xa5\xa5\x77\x3b\x6d\xbd\xf7\x2d\x37\xb7\x3b\x32\x0e\x2d\xf1\x7f\x66\x7c\xfc\xe9\x8c\x4f\xae\x2f\x51\x88\xfe\xb4\x60\xd0\x84\x31\xb0\x16\x4d\x78\x29\xa4\xb0\xce\x50\xa7\xcc\xe7\x73\xfe\x55\xf8\x89\x73\x9e\xf1\x3c\xe7\x84\x0f\x86\x40\xd2\x45\x31\x24\x74\xc8\x06\x24\xcb\xb2\x01\x4b\x22\x9a\x25\x7c\xfc\x01\x7e\x5a\x3f\x03\xbe\x32\x40\x67\xb0\x41\x21\xfa\x4d\x49\x67\x44\xb1\xfe\xa1\x91\x29\x92\x74\x9c\xe7\x94\x91\x51\x9c\x47\x24\x4d\x68\x44\x68\x91\xe7\x24\x89\x16\xd9\x20\x4f\x38\x4f\x52\xf6\x01\x64\x5a\x3f\xd2\xbe\x3e\x32\x3f\x7b\xce\xb7\xea\x39\xdf\x27\x40\x01\x42\x08\xcd\x83\xa7\xe0\xdf\x01\x00\xa7\x46\xc2\xc5\xbc\x0d\x00\x00")
 
 func rbacDevelopmentJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -171,7 +171,7 @@ func rbacDevelopmentJson() (*asset, error) {
 	return a, nil
 }
 
-var _rpDevelopmentPredeployJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x5b\x4f\xdb\x4a\x10\x7e\xcf\xaf\xb0\x7c\x8e\x94\xe4\x28\x71\xec\x1c\x68\xd5\xbc\x21\x55\xaa\x50\x6f\x11\x20\x5e\x22\x84\x36\xeb\x49\xb2\x60\xef\xae\x66\xc7\x40\x5a\xf1\xdf\xab\xb5\x73\xb7\x9d\x0b\x0d\x15\xd0\x84\x07\x24\xcf\xcc\x37\x3b\xb3\x9f\xbf\x19\xff\xac\x38\x8e\xe3\xb8\xff\x1a\x3e\x82\x98\xb9\x1d\xc7\x1d\x11\x69\xd3\x69\xb5\xb2\x27\x5e\xcc\x24\x1b\x42\x0c\x92\x3c\xf6\x23\x41\xf0\xb8\x8a\x27\x36\xd3\x6a\xfb\xc1\x71\xd3\x0f\x9a\x7e\xd0\x0a\x41\x47\x6a\x6c\xfd\x2e\x20\xd6\x11\x23\xf0\x6e\x8c\x92\xff\xb8\x8d\x2c\x03\x57\x92\x40\xd2\x25\xa0\x11\x4a\xda\x44\x81\xe7\xdb\xbf\xa9\x83\x66\xc8\x62\x20\x40\xe3\x76\x9c\xec\x58\xe9\x73\x16\xc6\x42\x7e\xef\xdf\x00\xa7\xd3\x70\xc9\x94\x9a\x69\xac\xc1\xa2\x19\x42\x21\x87\xee\xcc\xf8\xd8\x98\x43\x0c\xf4\x39\xe0\x9d\xe0\xd0\x45\x21\xb9\xd0\x2c\x7a\x2a\xd2\x2d\x8c\xef\x58\x12\x51\x17\x61\x20\x1e\x36\x62\x34\x96\xad\x31\x7b\xf8\x02\x72\x48\x23\xb7\xe3\xb4\xfd\xc2\x04\xf8\x7b\x47\xad\x2c\xe0\xb9\x08\x46\x25\xc8\xc1\x36\xb4\x37\xf3\x59\x81\xd2\xa8\x34\x20\x09\x30\xb9\x34\xa9\xdd\x00\x4f\x50\xd0\xf8\x2c\x89\x56\x80\x16\x7f\xf9\xc0\x6d\x13\xac\xfa\x92\xe2\x2a\xb2\xb5\x5d\x70\xbd\xd2\xbf\xfc\xd9\xd2\xf2\xba\x0a\xe9\x8c\xc9\x61\xda\x91\xff\x36\xc5\x84\x60\x48\x48\x46\x42\xc9\xa5\xc0\xa3\xa3\xff\xb7\x4b\x77\x12\x86\x08\xc6\xcc\x18\xb0\x53\xca\xdd\x83\x19\xe7\x60\x6c\xeb\xdc\x93\x28\x52\xf7\x9b\xdc\x35\x0a\x65\xaf\xcb\xed\x38\x41\xdb\xdf\x74\x30\x81\xc0\x69\xf2\x3a\x9e\xca\xbe\x4a\x64\xe8\x96\x86\x3c\x96\xa3\xb9\x92\xc5\x69\x17\x51\x5f\x0b\x79\xcd\x30\x2e\x86\x29\x81\x78\xfd\xec\x69\xb7\xdf\x1c\x79\x8e\xff\x38\x79\x8c\x19\x5d\x0b\x59\xc2\x9c\xdc\xd3\xab\xca\x1a\xfc\x05\x42\x36\xa5\xc9\x09\xf1\x54\x3f\xbf\x0a\x8e\xca\xa8\x01\x79\xdf\x80\xee\x15\xde\xb6\x64\xf6\xff\x7c\xa2\x7a\x9f\x50\x25\xda\xac\x86\x47\x8a\xb3\x69\xe5\xbd\xa9\xca\xa6\xae\xb5\xba\x37\x35\x5e\xad\x46\x31\x2d\x16\xa6\x5f\xdb\x0f\x3e\x34\xfd\xf7\x4d\x3f\x28\x9c\x34\x6b\x55\x7a\x4d\xb1\x1a\xde\x60\xbd\xf9\xa9\x44\x20\x99\xcc\xf6\x01\xb7\x67\x92\xbe\xe1\x28\xb4\x3d\x45\xad\xee\x4d\x6d\xab\x07\x4a\x03\xcd\x6d\x52\x2a\x24\xee\x80\xc5\x22\x1a\xa7\xaf\x4b\xc9\xab\x32\xe7\x2a\x31\x19\x32\x2c\x60\x7c\x01\xd3\x27\xef\x61\x57\x45\x82\x8b\xa7\x4d\xd1\xa7\x16\x3c\x03\x50\xf3\x0d\xca\xed\xcd\x77\xad\x5a\xb5\x68\x39\xaa\xd6\xd7\x62\x69\xc0\x58\x18\x7b\xb3\x5b\xa8\xb2\x01\x8e\x40\xe5\x45\x2f\x39\x0f\x81\xca\x35\xc4\xfe\xae\x36\xa8\x12\xb7\xac\x19\x08\xce\x68\x4d\x9f\x97\x23\x10\x18\xc1\x06\x69\x74\x32\x29\x8e\x60\x3b\x4f\x5b\xc6\x16\x6e\x89\x0e\x6d\xea\xf5\x05\x97\x4b\xea\x9e\x46\xec\xf3\x51\xab\x68\x99\x3d\x50\xab\xc8\xf3\x40\xad\x62\x80\x52\x6a\x2d\x7d\x15\xee\x93\x53\xbb\xdf\xf2\x96\x37\x12\x09\xb3\x89\x80\xbb\xde\x47\x7e\x27\x2a\x98\x3d\x20\x59\x3f\x82\x73\x35\xa0\x8f\x19\xc9\x3a\x0e\x61\x02\x5b\x6d\x4f\x3d\xae\x24\x67\x54\x5b\xec\xfc\xf2\x27\x70\xb5\xde\x70\xaa\x4d\x1e\x99\xfc\x1d\x14\x6c\x1d\x9f\x61\x7c\x69\x63\x5b\x29\xc2\x73\xed\x19\xef\x9a\x81\x7f\xd8\x33\x5e\xda\x9e\xf1\xca\x86\xc1\x41\x1b\xf3\xce\x3b\xb5\xd8\xec\x4d\x16\xf7\x3e\x97\xf7\x3e\x6d\x45\xac\x15\xfe\xf5\x53\xc0\xdc\xf1\x17\x3d\x05\x2a\x59\x7b\x1f\x2b\xbf\x02\x00\x00\xff\xff\x58\x42\xc8\xe8\xf5\x16\x00\x00")
+var _rpDevelopmentPredeployJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x5f\x6f\xe2\x38\x10\x7f\xcf\xa7\x88\xe6\x4e\x02\x4e\x10\x12\xae\xbd\xd3\xf1\x56\xe9\xa4\x55\xb5\xff\x50\x5b\xf5\x05\xa1\xca\x38\x03\xb8\x4d\x6c\xcb\x76\xda\xb2\x15\xdf\x7d\x65\x20\x34\x81\x10\x48\x97\xae\x68\x55\x4f\x24\x50\xe6\x8f\x3d\x33\x3f\xcf\x4c\x9e\x1c\xd7\x75\x5d\xf8\x53\xd3\x09\xc6\x04\xba\x2e\x4c\x8c\x91\xba\xdb\x6e\x2f\xde\x78\x31\xe1\x64\x8c\x31\x72\xe3\x91\x1f\x89\x42\x8f\x8a\x78\xc9\xd3\xed\x8e\x1f\x9c\xb6\xfc\xa0\xe5\x07\xed\x10\x65\x24\xa6\x56\xee\x0a\x63\x19\x11\x83\xde\xad\x16\xfc\x0f\x68\x2e\x76\xa0\x82\x1b\xe4\xe6\x1a\x95\x66\x82\xdb\x8d\x02\xcf\xb7\x94\x0a\x48\xa2\x48\x8c\x06\x95\x86\xae\xbb\x38\x96\x25\x20\x61\xcc\xf8\xf7\xe1\x2d\x52\x73\x1e\xe6\x58\xf6\x01\x33\x95\x68\xad\x69\xa3\x18\x1f\xc3\x8a\x39\x6b\xae\xfe\xc2\x48\x5e\xa2\xba\x67\x14\x7b\x8a\x71\xca\x24\x89\x5e\x6a\xe9\x0e\xa7\xf7\x24\x89\x4c\x4f\xe1\x88\x3d\xee\xb4\xf1\xac\x69\x09\x62\xf2\xf8\x05\xf9\xd8\x4c\xa0\xeb\x76\xfc\xc2\x0d\xd4\xaf\x1d\xd5\xc9\xd8\x03\x85\x5a\x24\x8a\xa2\x0d\x68\x7f\x25\xb3\x66\x4a\x2a\x21\x51\x19\x86\xf9\xb0\xa7\x04\x1a\x69\xa2\x98\x99\x5e\x24\xd1\x9a\xa1\x2c\x6d\x2a\xa6\x6b\xd7\x06\x59\xb2\xb2\x46\x50\x11\xd9\x10\x5e\x51\xb9\x84\xc6\x36\x82\x85\x7b\x3d\xa1\xcc\x05\xe1\xe3\x79\x44\xfe\xda\xa5\x13\xa2\x36\x8c\x13\xc3\x04\xcf\x29\x9e\x9c\xfc\xbd\xdf\x76\x67\x61\xa8\x50\xeb\x15\x02\x2a\x6d\x59\x5d\x99\x50\x8a\xda\x06\x1e\xce\xa2\x48\x3c\xec\x12\x97\x8a\x09\x9b\x2e\xe8\xba\x41\xc7\xdf\x21\x1c\x32\x85\xd4\x2c\xaf\xe3\x39\x1f\x8a\x84\x87\xe0\x14\xca\xe6\x61\xba\xbe\x80\x93\x78\x1e\x45\x25\x6f\x18\xbf\x21\x2a\x06\xa7\x82\x89\xb7\x8f\x9e\x4e\xe7\xdd\x81\xe7\xf4\xb7\x83\x47\xeb\xc9\x0d\xe3\x5b\x90\xb3\xf1\x76\xe0\x94\xd8\xcf\x00\xb2\xc5\xf5\x46\x21\x4e\xeb\xe7\x57\x46\x95\xd0\x62\x64\xbc\x6f\x68\x1e\x84\xba\x6b\xf3\xc5\xef\xe5\xb2\xea\x7d\x52\x22\x91\x7a\x5d\x3d\x12\x94\xa4\x9e\xf7\xd3\x2a\x3b\x17\xad\x37\xbc\x94\x39\x58\xd7\x22\x92\x65\xba\x5f\xc7\x0f\xfe\x6b\xf9\xff\xb6\xfc\x00\x9c\x02\x27\x9e\x9c\x92\x6b\x50\xe2\xac\xc4\x77\xe8\x6f\x8e\x67\x1f\x30\xc8\x09\x5f\xcc\x03\xd0\xd7\xc9\x50\x53\xc5\xa4\x3d\x45\xbd\xe1\xa5\xbc\xf5\x03\x59\x02\x7d\x97\x6c\x2d\x24\x30\x22\x31\x8b\xa6\x36\x50\x67\x05\xba\xb9\x50\x6b\x43\x78\x48\x54\x01\xe2\xd7\x92\x93\xb9\x87\x3d\x11\x31\xca\x5e\xd6\x45\x5f\xea\x70\xba\x40\x3c\x4f\x50\xd0\x7f\x9e\xb5\xea\xb5\xa2\xe1\xa8\xd6\x28\xb5\x25\x51\xc5\x4c\xdb\x39\x6e\x8f\xaa\xac\x91\x2a\x34\xdb\x9d\xce\x2e\x18\xa3\x01\xa7\x4c\x64\xb0\xfd\x58\x96\x80\xda\x51\x63\xc4\x28\x31\x25\x71\xce\x12\x50\x85\xc4\x20\x34\x77\x4b\x86\x18\xe1\x7e\x92\xd6\x8d\x3d\xc4\x12\x19\xda\xad\x4b\x05\x07\xce\x36\xd6\xac\x90\x33\x6b\x1e\x0f\xb4\xd4\x07\xb4\x3e\xa0\xf5\x3a\xd0\xca\x7d\x15\x1e\x12\x53\xd5\xb3\xbc\x67\x46\x22\xa6\x77\x01\xb0\x6a\x3e\x9c\x3d\x20\x0c\xc8\xc9\x30\xc2\x4b\x31\x32\xff\x2f\xea\x57\xd7\x35\x2a\x41\xa7\x24\xb3\xab\x2e\xd7\xa7\x82\x53\x62\xea\xd9\xc8\xe7\x3f\x81\x6b\x8d\xa6\x5b\x6b\xd1\x48\x6f\xe6\xa0\x60\xea\xf8\x8c\xd3\x6b\xab\xdb\x9e\x7f\x44\xbf\xd6\x9c\xf1\x4f\x2b\xf0\x3f\xe6\x8c\x63\x9b\x33\xde\x58\x33\xa8\x7a\x17\x9b\xc7\x13\xe9\x57\xab\x8d\x95\x42\xac\x0f\x56\x16\x9b\x87\xae\xd8\x07\xef\xb6\x2c\x96\x42\x1d\xca\xdd\xaa\xc8\x3b\x9a\x2e\xa0\xef\xe9\x51\x77\x01\xc7\x75\x5d\x77\xe0\xcc\x9c\x9f\x03\x00\x58\x42\xc8\xe8\xf5\x16\x00\x00")
 
 func rpDevelopmentPredeployJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -191,7 +191,7 @@ func rpDevelopmentPredeployJson() (*asset, error) {
 	return a, nil
 }
 
-var _rpDevelopmentJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\x4d\x6f\xdb\x38\x13\xbe\xe7\x57\x08\x7a\x5f\xc0\x09\x60\xda\x92\xac\xc4\x72\x6e\xd9\xa6\x2d\x0a\x74\xd3\x6c\x1c\xf4\xb0\x45\x0f\x14\x39\x72\xb9\x95\x49\x82\xa4\x9c\xba\x45\xff\xfb\x42\xb2\x1c\xeb\x83\xfe\x88\xe3\x04\xcd\xee\x22\x27\x8b\xc3\x99\xe1\x33\xcf\x33\x1a\x31\x3f\x8e\x1c\xc7\x71\xdc\xff\x6b\xf2\x05\xa6\xd8\x3d\x77\xdc\x2f\xc6\x48\x7d\xde\xef\x2f\x9e\xf4\xa6\x98\xe3\x09\x4c\x81\x9b\x1e\xfe\x9e\x29\xe8\x11\x31\x2d\xd7\x74\x3f\xf0\xfc\x53\xe4\xf9\xc8\xf3\xfb\x14\x64\x2a\xe6\xb9\xdd\x2d\x4c\x65\x8a\x0d\xf4\xfe\xd2\x82\xff\xcf\xed\x2e\x22\x10\xc1\x0d\x70\xf3\x11\x94\x66\x82\xe7\x81\xfc\x9e\x97\xff\x2d\x0d\x24\x56\x78\x0a\x06\x94\x76\xcf\x9d\x45\x5a\xc5\x73\x8a\x0d\x8e\xb1\x86\x0b\x42\x44\xc6\xcd\x15\x9e\x42\xcd\xa0\x30\x32\x73\x99\x3f\x75\xb5\x51\x8c\x4f\xdc\xfb\xc5\x9f\xdd\x8a\x23\x31\xc5\x8c\xef\xbf\x3f\x91\x63\x50\x33\x46\xe0\x5a\x31\x4e\x98\xc4\xe9\x3b\xba\x9f\x27\xf5\x38\x4f\x47\x15\x7f\xae\x02\x2d\x32\x45\x20\x47\xed\xd3\xbd\x4d\xc3\x95\x54\x42\x82\x32\xac\xb0\xfa\x51\x49\xa5\x58\xe5\x0b\x48\xdc\x4f\xab\x12\x1c\x77\x56\x68\x75\x4e\x3e\xbb\x5d\x7b\x6a\xbf\x33\xa2\x84\x16\x89\xe9\x5d\x81\xb9\x13\xea\x6b\x9f\x72\xfd\xa7\xe0\xa0\x9b\x3b\x52\x41\xb0\x29\x0b\x3f\x49\x45\x8c\xd3\xa6\x05\x96\xac\x42\x8e\xc0\xf3\x23\xe4\xe5\xe4\xb2\x42\xb8\xf1\x7c\xb5\xb5\x85\x6f\x4a\x15\x68\x3d\x96\x98\xb4\x8b\xdf\xb4\xba\x56\x90\xb0\x6f\x0d\x40\x5b\xc6\xbe\xb7\xa0\x6f\x3f\x08\x5d\xab\xd5\xe7\xd6\xd3\x06\xee\x85\x1b\x9d\xc5\x1c\xcc\xfa\x58\xf6\x54\x9d\x1d\x0e\xbd\xfe\x68\x85\xf8\x2a\xe9\xb7\xd3\xaa\xed\xe5\x8b\xd2\x8e\x81\x64\x8a\x99\xf9\x5b\x25\x32\xb9\x35\x62\xb1\x93\xd1\x82\x56\x4b\x8a\xbe\xa3\xc7\x9d\x36\x63\x6c\xee\x75\xa7\xeb\x74\x94\x44\x5c\x4f\xda\xec\xb3\x86\x32\x78\x92\xc3\xc0\xb3\x34\xdd\x68\xfc\x73\xed\xaa\xa5\x3a\x2b\x08\x4a\x85\x28\x89\x16\x05\xb3\x97\xbc\xed\xbc\x4e\x82\x75\xc2\x53\x12\xcd\x72\xa7\x3b\xab\x6c\xc6\x94\xc9\x70\x5a\xfe\xdc\x28\xb6\x7b\xf4\x0b\x60\x8f\x4f\x7a\xcb\xc5\x96\xa8\x5b\x02\x1c\x21\x6f\xf8\x8b\x0b\x30\xcc\x19\x1c\xbc\x58\x01\x96\xe9\xff\xba\x02\x94\xf0\x14\x1a\xdc\x72\x5e\xa9\xd8\x0c\x1b\x78\xcd\xa9\x14\x8c\x9b\x32\xcf\x6b\x91\x32\xb2\xc0\xda\xbd\x64\x1a\xc7\x29\x50\x7b\xe1\xb7\xc4\xa8\xca\x4e\xc2\x53\x09\x5a\x42\xa1\x69\xe4\x79\xfe\x3f\x59\xd7\x69\x2a\xee\x3e\xd6\xb2\xbe\x20\x04\x74\x6e\x6e\x54\x06\x16\xc1\x15\x5b\xde\x08\x75\x87\x15\x05\x7a\xab\x70\x92\x30\xb2\xc5\xfc\x2d\x36\x70\x87\xe7\xb7\x0a\x73\xcd\x8c\x7b\xee\x24\x38\xd5\x36\xeb\x4c\xc3\x0d\x4c\x85\x81\x72\x87\xde\x60\xab\x0a\xc3\x7a\xf2\xeb\x7b\xd3\x4e\x52\x6a\xd4\x6f\x25\xa2\x25\x15\x72\x25\xb5\xdb\xd2\x43\x5e\x12\x7d\x09\x90\xcf\x83\xe8\x40\x1c\x6b\xfc\xbe\x5e\x78\x6f\x51\x6f\x2d\x89\x1a\x76\x14\x24\x70\xaa\x3f\x70\x6b\x43\xdd\x0f\xbf\xfc\x8c\xf6\x16\x74\xf8\x7a\x7c\xde\x4f\x72\xff\x49\xe9\x79\xa4\xb4\xa4\xc2\xfe\x1a\xaa\x14\xbf\x2a\xa5\x47\xcd\x5f\x2f\x40\x43\x0d\xce\x1f\x4a\x4a\xd6\x7a\x3c\x9d\x86\xbe\x32\x5e\x70\xe7\x6d\xf1\x05\x79\x29\x48\x36\x05\x6e\x2e\x7f\x6b\x22\xb8\x4d\x6b\x44\x70\xcd\xb4\x01\x4e\xe6\xc5\x58\x31\x5f\x4f\x56\x0a\x09\xce\x52\xf3\x6a\xb5\xe3\x3d\xcc\x20\xcd\xb3\x18\x1b\x25\xaa\x1f\xe6\x96\xfc\x5b\x18\xec\x35\x68\x2e\x37\x5f\x2d\x3f\xd4\x77\x00\xb1\x96\x50\x7b\x8c\xb1\xa4\xd8\xb8\x68\xf9\x90\x24\xa0\x6e\x4b\x09\x8c\x0d\xe6\x14\xab\xc6\xc0\xb5\xdb\x45\x42\xfb\xfe\x66\xa7\x1b\x85\x55\x75\xfb\x0d\x17\x07\x9a\x8b\xca\x71\xd5\x42\x8f\xb2\xe6\xaf\xbf\x49\x50\x0c\x78\xf1\xc9\xe2\xbe\x12\x0a\x9c\xe3\xf1\x1f\xef\x4f\x36\x83\x60\x53\x79\xb4\xe3\xb8\x75\x0f\xe0\x24\x63\xf4\xb8\x79\x12\x46\xbb\x4e\x15\x59\xdb\x35\x52\xe7\xa4\xeb\x74\x6e\xae\x9d\xbe\x73\x03\x98\x82\xda\x09\xea\x8b\xcc\x7c\x11\x8a\x7d\x2f\x70\xea\x2b\x91\xc2\x85\xd6\x6c\xc2\x73\xf8\x5b\x60\x6f\xd3\x96\x26\x42\xda\x59\xca\xa8\xb5\xf1\xe4\xf1\x2e\x21\x61\x9c\xe5\xe1\xdf\x2d\x5e\x0e\x3a\x8b\x35\x51\x4c\xe6\x8f\x6e\xac\x8d\xa9\x9d\xf4\xca\x49\xd1\x9e\x30\xa1\x74\x18\xe0\x21\x1a\x0c\xa2\x53\x14\x46\x90\xa0\x98\x86\x01\x4a\xce\xbc\xb3\x24\xc6\x91\x8f\x61\xb8\xa6\x17\xca\xda\xbd\x5c\x9d\xcf\x76\xd4\x37\xbb\xb9\x97\x51\x63\xe7\x03\x99\x14\x21\x6f\x84\x3c\x1f\x49\x05\x33\x06\x77\x87\x61\x54\xe7\x4d\xce\x96\xb2\xaf\x3b\xaf\x04\x37\x8a\xc5\x99\x11\xff\x66\xea\x84\x74\x34\x8c\x47\x51\x8c\x7c\x1a\x26\x28\x1c\x46\x43\x84\x83\x91\x8f\xc8\xd9\x30\x1a\x84\x34\xf0\x83\xbd\xa8\x63\xbb\x41\x7e\x01\xd4\x21\x82\x13\x6c\x8e\xb7\x36\xf5\xae\xd3\xe9\xaf\x03\xb9\xd3\x75\x6a\x0c\xac\x17\x64\x43\xaf\xef\xd4\x7b\x9e\x35\xf0\xc9\x43\xfa\xe2\x2a\x96\x53\x3a\xa9\x93\xfe\x91\xef\xa6\xbe\x54\x62\xc6\x28\x28\x7d\x78\x35\x1c\x16\xb4\x67\x55\xd4\x69\x4c\x47\x84\x46\x11\x4a\x20\x3c\x45\x61\xe0\x9f\xa1\xd1\x20\x8a\x51\x32\x1a\x86\x83\x21\xf8\xa7\xe1\xa9\xf7\xb2\x9b\xf1\x41\x86\xf8\xc7\xd7\xb4\x3e\x89\x3f\x56\xe6\x95\x7f\x02\xed\xaf\xee\xe6\xff\x86\x9a\x27\xa9\x04\x39\x59\xbe\x8f\x2e\xaf\xc6\x4e\x6e\xfc\x70\x6d\x36\xa3\x3d\x9f\x20\x1f\x72\xce\x67\x15\x5f\x0c\x09\x24\xd8\xf3\x51\x80\x83\x11\x0a\xfd\xd1\x10\x45\x03\x1c\xa1\x60\x18\x24\xc9\x60\x40\x60\xe0\x87\x2f\xfb\x75\x76\xd0\x2f\xe8\xdd\xea\xb7\x4e\x68\x47\x8b\xdf\x3f\x8f\xfe\x0e\x00\x00\xff\xff\x3d\xd5\x64\x45\x5b\x1f\x00\x00")
+var _rpDevelopmentJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\x4d\x6f\xdb\x38\x13\xbe\xeb\x57\x08\x7c\x5f\xc0\x09\x20\xd9\x92\xac\xc4\x72\x6e\xd9\xa6\x2d\x0a\x74\xdb\x6c\x1c\xf4\xb0\x41\x0e\x14\x39\x72\xb9\x95\x49\x82\xa4\x9c\xa6\x45\xfe\xfb\x82\xf2\xb7\x2c\xf9\xab\x6e\x76\xb3\xbb\x91\x0e\xb1\x38\x9c\x19\x3e\xf3\x3c\x34\x3d\xfa\xee\xb8\xae\xeb\xa2\xff\x6b\xf2\x19\x46\x18\x5d\xb8\xe8\xb3\x31\x52\x5f\x74\x3a\x93\x27\xed\x11\xe6\x78\x08\x23\xe0\xa6\x8d\xbf\x15\x0a\xda\x44\x8c\xa6\x63\xba\x13\x05\xe1\x99\x1f\x84\x7e\x10\x76\x28\xc8\x5c\x3c\x5a\xbb\x5b\x18\xc9\x1c\x1b\x68\xff\xa1\x05\xff\x1f\xf2\x26\x11\x88\xe0\x06\xb8\xf9\x04\x4a\x33\xc1\x6d\xa0\xb0\x1d\xd8\x6b\x66\x20\xb1\xc2\x23\x30\xa0\x34\xba\x70\x27\x69\xd9\x0b\x51\x6c\x70\x8a\x35\x5c\x12\x22\x0a\x6e\x3e\xe0\x11\xac\x18\xd8\x1b\x99\x47\x69\x9f\x22\x6d\x14\xe3\x43\x34\x1f\x7c\xf2\xe6\xff\x22\x2a\x46\x98\xf1\xc3\xe7\x67\x72\x00\x6a\xcc\x08\x5c\x2b\xc6\x09\x93\x38\x7f\x47\x0f\xf3\xa4\x7e\xcc\x93\xb3\xe4\x0f\x29\xd0\xa2\x50\x04\x2c\x6a\x77\x73\x9b\x8a\x2b\xa9\x84\x04\x65\x58\x69\xf5\x7d\x29\x15\x7b\x23\x3e\x81\x04\xdd\x2d\x4a\x70\xd2\x5a\xa0\xd5\x3a\xbd\x47\x95\x19\xb3\xd4\x7e\x65\x44\x09\x2d\x32\xd3\xfe\x00\xe6\x41\xa8\x2f\x1d\xca\xf5\xef\x82\x83\xae\xce\xc8\x05\xc1\x66\x5a\xf8\x61\x2e\x52\x9c\x57\x2d\xb0\x64\x4b\xe4\x88\x82\x30\xf1\x03\x4b\xae\x5a\x08\x37\xae\x6f\x65\xcc\xde\x08\x53\xaa\x40\xeb\x81\xc4\x64\xbd\xf8\x55\xab\x6b\x05\x19\xfb\x5a\x01\xb4\x7a\xa1\xb0\x24\x6f\x3b\xe8\x44\x31\x72\xea\x4c\xee\xd7\x9e\x56\x70\xb7\x37\xd2\x45\xca\xc1\x34\xc7\xaa\x4f\x75\x97\x45\x37\x2f\x0d\x5d\xac\xa6\xef\x39\x0d\xd3\xca\x1b\xf1\x49\x69\x07\x40\x0a\xc5\xcc\xe3\x5b\x25\x0a\xb9\x35\xa2\xbd\x11\xb3\xac\x46\x77\x33\x8a\xbe\xa3\x27\xad\x75\xc6\xd4\xb9\xd7\x2d\xcf\x6d\x29\xe9\x73\x3d\x5c\x67\x5f\xdd\x1f\x32\x78\x68\x61\xe0\x45\x9e\x6f\x34\x7e\x6a\x1c\x7d\xf2\x1a\x87\xe6\x0a\x51\xd2\x9f\x14\x0c\x39\xbb\x39\xbf\x77\x36\x84\x58\x76\x3b\xe6\x60\x76\x57\xd9\x98\x29\x53\xe0\x7c\xfa\x71\xa3\xd8\xe6\xe8\x97\x75\x3b\x39\x6d\xcf\x06\xef\xb7\x0a\xb0\xef\x07\xbd\xbf\xb9\x00\x63\xcb\xe0\xe8\xc5\x0a\x70\x9a\xbe\xe7\x34\x4c\xfb\xcb\x05\x28\xe1\x67\x68\x70\xcb\x7a\xa5\x62\x63\x6c\xe0\x35\xa7\x52\x30\x6e\xa6\x79\x5e\x8b\x9c\x91\x09\xd6\xe8\x8a\x69\x9c\xe6\x40\x91\x73\x40\x8c\x65\xd9\x49\xf8\x59\x82\x96\x50\x6a\xda\x0f\x82\xf0\x9f\xac\xeb\x3c\x17\x0f\x9f\x56\xb2\xbe\x24\x04\xb4\x35\x37\xaa\x00\xaf\x61\xca\x1b\xa1\x1e\xb0\xa2\x40\x6f\x15\xce\x32\x46\xb6\x98\xbf\xc5\x06\x1e\xf0\xe3\xad\xc2\x5c\x33\x83\x2e\xdc\x0c\xe7\xba\xce\xba\xd0\x70\x03\x23\x61\x60\x3a\x43\x6f\xb0\x55\xa5\xe1\x6a\xf2\x8d\xb2\xda\x4d\x4a\x95\xfa\x2d\x44\x34\xa3\x82\x55\x92\xb3\x99\x65\x1b\x38\x65\xbd\x74\x24\x80\x3d\x59\xfa\x47\xe2\x58\xe5\xf3\xf5\xc4\xbb\xde\x99\x44\x15\x3b\x0a\x12\x38\xd5\x1f\x79\xed\x86\x7a\x18\x7e\x76\x8d\xf5\x5b\xd0\xf1\xeb\x71\x7f\x98\xe4\x9c\x9a\xea\xfd\x27\xa5\xe3\x4b\x69\x46\x85\xc3\x35\xb4\x54\xfc\x65\x29\xfd\xd0\xf9\xeb\x05\x68\xa8\xc2\x79\xef\x48\x6e\x6b\xeb\xf1\xf3\x34\xf4\x85\xf1\x92\x3b\x6f\xcb\x5f\x90\x57\x82\x14\xb6\xe1\x70\xf5\x0b\xf2\xf6\xd3\x1a\x11\x5c\x33\x6d\x80\x93\xc7\xf2\x58\xf1\xd8\x4c\x56\x0a\x19\x2e\x72\xf3\x6a\x31\xe3\x3d\x8c\x21\xb7\x59\x0c\x8c\x12\xcb\x3f\xcc\x6b\xf2\x5f\xc3\xe0\xa0\x83\xe6\x6c\xf2\xb4\x77\xb1\x13\x88\xcd\xe2\xa8\x29\x51\x5d\xa3\xe5\x63\x96\x81\xba\x9d\x4a\x60\x60\x30\xa7\x58\x55\x0e\x5c\x4d\x32\x5b\x6d\x24\xac\xf7\x6f\xd6\x19\x58\xa3\xb5\x45\x75\x3b\x15\x17\x6b\xc2\xda\x91\x60\xd5\x90\x93\xe3\xea\x77\xa7\xa9\xe6\xaf\xbf\x4a\x50\x0c\x78\xd9\x33\x40\xaf\x84\x02\xf7\x64\xf0\xdb\xfb\xd3\xcd\x20\xd4\xa9\x3c\xd9\xf1\xb8\x35\x07\x70\x58\x30\x7a\x52\x5d\x09\xa3\x9e\xbb\x8c\x6c\x5d\x1b\xa9\x75\xea\xb9\xad\x9b\x6b\xb7\xe3\xde\x00\xa6\xa0\x76\x82\xfa\xb2\x30\x9f\x85\x62\xdf\x4a\x9c\x3a\x4a\xe4\x70\xa9\x35\x1b\xf2\x11\xd4\x80\xbd\x4d\x5b\x9a\x08\x59\xcf\x52\x46\xab\xb9\xd8\x0b\xd9\x78\x57\x90\x31\xce\x6c\xf8\xb2\xa7\x86\xee\x74\x91\x6a\xa2\x98\xb4\x8f\x6e\x6a\x37\xa6\xf5\xa4\x17\x4e\xca\xed\x09\x13\x4a\x7b\x11\xee\xf9\xdd\x6e\x72\xe6\xc7\x09\x64\x7e\x4a\xe3\xc8\xcf\xce\x83\xf3\x2c\xc5\x49\x88\xa1\xb7\x0e\xcf\x74\x8d\x73\x40\xd7\xf8\x5c\x8f\xfa\x66\x37\x73\x19\x55\x66\xee\xc9\xa4\xc4\x0f\xfa\xb6\xdd\x2a\x15\x8c\x19\x3c\x1c\x87\x51\xad\x37\x96\x2d\xd3\x7d\xdd\x7d\x25\xb8\x51\x2c\x2d\x8c\xf8\x37\x53\x27\xa6\xfd\x5e\xda\x4f\x52\x3f\xa4\x71\xe6\xc7\xbd\xa4\xe7\xe3\xa8\x1f\xfa\xe4\xbc\x97\x74\x63\x1a\x85\xd1\x41\xd4\xa9\xeb\x20\xbf\x00\xea\x10\xc1\x09\x36\x27\x5b\x37\x75\xcf\x6d\x75\x9a\x40\x6e\x79\xee\x0a\x03\x57\x0b\xb2\x61\xaf\x6f\x79\xee\xd6\xc0\xa7\xfb\xec\x8b\x8b\x58\xee\xd4\xc9\x2a\xe9\x77\x61\xfd\x86\x7c\x3b\x52\x89\x31\xa3\xa0\xf4\xf1\xd5\x70\x5c\xd0\x9e\x55\x51\x67\x29\xed\x13\x9a\x24\x7e\x06\xf1\x99\x1f\x47\xe1\xb9\xdf\xef\x26\xa9\x9f\xf5\x7b\x71\xb7\x07\xe1\x59\x7c\x16\xbc\xec\xcd\xf8\x28\x87\xf8\x1f\xaf\xa9\x53\xdf\xad\x3a\x50\xe6\x4b\x2f\x81\x0e\x57\x77\xf5\xdd\x50\x75\x25\x4b\x41\x4e\x67\xdf\x47\x57\x1f\x06\xae\x7d\x91\xb4\xbf\x36\xab\xd1\x9e\x4f\x90\xfb\xac\xf3\x59\xc5\x97\x42\x06\x19\x0e\x42\x3f\xc2\x51\xdf\x8f\xc3\x7e\xcf\x4f\xba\x38\xf1\xa3\x5e\x94\x65\xdd\x2e\x81\x6e\x18\xbf\xec\xaf\xb3\xa3\x88\x6f\xbf\xfa\x35\x09\xcd\x71\x5d\xd7\xbd\x77\x9e\x9c\x3f\x07\x00\x3d\xd5\x64\x45\x5b\x1f\x00\x00")
 
 func rpDevelopmentJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -211,7 +211,7 @@ func rpDevelopmentJson() (*asset, error) {
 	return a, nil
 }
 
-var _rpProductionGlobalAcrReplicationJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x52\x4d\x8b\xdb\x30\x10\xbd\xfb\x57\x08\xb5\x60\x1b\x1c\x7f\x04\x7a\x68\xae\xed\x25\x87\x52\x08\x25\x97\x90\xc3\x44\x1e\x27\x2a\xb2\x64\xa4\x31\x34\x5d\xf2\xdf\x17\x59\xf9\x70\xbc\xde\x65\x31\x18\xa1\xf7\xe6\xbd\x79\xa3\x79\x89\x18\x63\x8c\x7f\x75\xe2\x84\x2d\xf0\x15\xe3\x27\xa2\xce\xad\x8a\x22\xdc\xe4\x2d\x68\x38\x62\x8b\x9a\x72\xf8\xdf\x5b\xcc\x85\x69\xaf\x98\x2b\x96\x65\xf5\x6d\x51\x56\x8b\xb2\x2a\x6a\xec\x94\x39\x7b\xde\x1f\x6c\x3b\x05\x84\xf9\x5f\x67\xf4\x17\x9e\x05\x07\x61\x34\xa1\xa6\x2d\x5a\x27\x8d\xf6\x46\x55\x5e\xfa\xef\x46\xe8\xc0\x42\x8b\x84\xd6\xf1\x15\x0b\x6d\x0d\xf7\x20\xec\x06\x9d\xe9\xad\xc0\x75\xfd\x04\x0d\x30\x9d\x3b\xf4\x6a\x8e\xac\xd4\x47\x7e\x07\x2f\xd9\x43\xa2\xe9\x95\xfa\x39\xf4\xf7\x7e\xfd\xc1\x18\xc5\xb3\x67\xac\xc6\x06\x7a\x45\x5b\x50\xbd\xe7\x34\xa0\x1c\xce\x1a\x28\x23\x80\x42\xac\xcf\xb6\x17\x8d\x34\xb8\xbd\x06\xf4\xd1\x77\x77\xce\x44\x4a\x43\x3b\x48\xed\x84\xd1\x02\x28\x71\xfd\x21\xa8\x26\x8f\xd1\x25\xf1\xd3\xb8\xe2\x34\x63\x50\xd7\x89\x02\x47\x6b\x5d\xe3\xbf\xdf\xcd\xc7\xe4\xb8\xf0\xff\x2a\x0d\xc7\x8c\x8d\xc9\xb7\x90\x71\x9a\xee\xa7\x93\xba\xc5\xfc\x25\x85\x35\xce\x34\x94\xff\x30\x9a\x40\x6a\xb4\x1b\x3c\x4a\x47\xf6\x5c\xd8\x70\x90\xe8\x0a\x8b\x9d\x92\x41\xcd\x4d\xa5\x46\xb3\xe4\xbb\x79\xff\x37\xf6\xc2\xe8\x5a\xce\x16\x3d\x9e\x7e\xa6\x0c\x3a\x39\xda\xc7\x65\x59\x7d\x5f\x94\x7e\x9f\xa7\xcf\xb4\x8f\x2e\xd1\x6b\x00\x00\x00\xff\xff\xe9\x9c\x6b\xd7\x29\x03\x00\x00")
+var _rpProductionGlobalAcrReplicationJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x52\x4d\x6b\xe3\x30\x10\xbd\xfb\x57\x08\xed\x82\x6d\x70\xfc\x11\xd8\xc3\xe6\xda\x5e\x72\x28\x85\x50\x72\x09\x39\x4c\xe4\x71\xa2\x22\x4b\x46\x1a\x43\xd3\x92\xff\x5e\x14\xe5\xc3\x49\xdd\x52\x64\xcc\xa0\x79\xf3\xde\xbc\xd1\x7c\x44\x8c\x31\xc6\xff\x3a\xb1\xc3\x16\xf8\x8c\xf1\x1d\x51\xe7\x66\x45\x11\x6e\xf2\x16\x34\x6c\xb1\x45\x4d\x39\xbc\xf7\x16\x73\x61\xda\x53\xce\x15\xd3\xb2\xfa\x37\x29\xab\x49\x59\x15\x35\x76\xca\xec\x3d\xee\x05\xdb\x4e\x01\x61\xfe\xea\x8c\xfe\xc3\xb3\xa0\x20\x8c\x26\xd4\xb4\x44\xeb\xa4\xd1\x5e\xa8\xca\x4b\x7f\xce\x80\x0e\x2c\xb4\x48\x68\x1d\x9f\xb1\xd0\x96\x3f\x1c\x84\x5d\xa0\x33\xbd\x15\x38\xaf\x6f\x52\xfe\xe3\xb4\xef\xd0\xb3\x39\xb2\x52\x6f\xf9\x25\x79\xc8\x2e\x21\x6f\x7a\xa5\x1e\x8f\xfd\x7d\x5f\xbf\x31\x46\xf1\xec\x36\x57\x63\x03\xbd\xa2\x25\xa8\xde\x6b\x34\xa0\x1c\x8e\x0a\x28\x23\x80\x82\xad\xdf\xb6\x17\x0d\x38\xb8\x3d\x19\xf4\xd6\x57\x17\xcc\x1d\x95\x86\xf6\x48\xb5\x12\x46\x0b\xa0\xc4\xf5\x9b\x60\x3a\xb9\x8e\x2e\x89\x6f\xc6\x15\xa7\x19\x83\xba\x4e\x14\x38\x9a\xeb\x1a\xdf\x9e\x9b\x9f\xc1\x71\xe1\xff\x55\x1a\xc2\x8c\x0d\xc1\x67\x93\x71\x9a\xae\x79\x36\x6e\xf3\x49\x0a\x6b\x9c\x69\x28\x7f\x30\x9a\x40\x6a\xb4\x0b\xdc\x4a\x47\x76\x5f\xd8\x10\x48\x74\x85\xc5\x4e\xc9\xc0\xe6\xee\xa9\x06\xb3\xe4\xab\x71\xfd\x2f\xf2\xc2\xe8\x5a\x8e\x16\x5d\x9f\x7e\xa4\x0c\x3a\x39\xd8\xc7\x69\x59\xfd\x9f\x94\x7e\x9f\xef\x9f\x69\x1d\x1d\xa2\xcf\x01\x00\xe9\x9c\x6b\xd7\x29\x03\x00\x00")
 
 func rpProductionGlobalAcrReplicationJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -231,7 +231,7 @@ func rpProductionGlobalAcrReplicationJson() (*asset, error) {
 	return a, nil
 }
 
-var _rpProductionGlobalSubscriptionJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x54\xcd\x6e\xdb\x4c\x0c\xbc\xe7\x29\x04\x7d\x1f\x90\x04\xa8\xfe\xfc\x57\xdb\xb7\x20\xb9\xa6\x05\x92\x20\x17\xc3\x07\x4a\xa2\xed\x6d\x57\xbb\x0b\x92\x4a\xe0\x14\x7e\xf7\x62\x25\xc7\x76\x64\xa7\x87\xc2\x95\x2e\x2b\x72\x76\x66\x40\x91\xfc\x75\x11\x04\x41\x10\xfe\xcf\xc5\x0a\x2b\x08\xa7\x41\xb8\x12\x71\x3c\x4d\x92\x36\x12\x57\x60\x60\x89\x15\x1a\x89\xe1\xad\x26\x8c\x0b\x5b\x6d\x73\x9c\xf4\xd2\x6c\x18\xa5\x59\x94\x66\x49\x89\x4e\xdb\xb5\xc7\x3d\x61\xe5\x34\x08\xc6\x3f\xd8\x9a\xff\xc2\x2f\xad\x42\x61\x8d\xa0\x91\x67\x24\x56\xd6\x78\xa1\x2c\x4e\xfd\xfb\x0e\x70\x40\x50\xa1\x20\x71\x38\x0d\x5a\x5b\x4d\x7c\x51\x6b\x7d\xd7\x90\x7f\x88\x37\x39\x59\x3b\xf4\x54\xb9\xb5\x7a\xcb\xb3\xcb\x95\xb8\x80\x5a\xcb\x33\xe8\xda\x63\x16\xa0\x19\x77\x88\x4d\x73\xda\x6c\xa5\x09\xd9\xd6\x54\xa0\x57\x9e\xed\x30\x1d\x2d\x03\x55\xa3\x35\x18\x4f\xc6\xfd\x61\x7f\x10\xf5\xcb\x74\x14\x0d\xca\x22\x8f\x60\x38\x1a\x45\xe9\x18\x46\x93\x01\xe6\x59\xef\xeb\xa4\xeb\xe5\xdd\xe7\xbd\x2a\xc8\xb2\x5d\x48\x7c\x53\xcb\xca\x92\x7a\x03\x51\xd6\x24\x64\x35\xde\xe1\x42\x19\xe5\x3f\xb9\x7b\xdd\x91\x75\x48\xa2\x90\x8f\x4a\xd0\xfa\xb7\x1a\xbf\x6d\xed\xdd\x3c\x7c\x0f\x5e\x06\xc1\xad\x35\x02\xca\x20\x3d\xe0\x52\xb1\xd0\x3a\x78\xb2\x3f\xd1\x34\x71\x52\x79\x2d\x96\x3a\x2a\xad\x12\x52\xa5\x98\x1b\x13\x87\xb5\x38\x7c\x8e\x0d\xec\xae\x43\x21\x7f\xbc\xba\x03\xee\x0b\x71\x64\x34\xa1\xf6\xa0\x90\x93\x25\x1a\x24\x10\xbc\x25\x2c\xd1\x88\x02\xcd\x49\xab\x71\xc2\xfc\xdf\x08\x70\x61\x1d\xde\x83\xe3\x84\x10\xca\x33\x91\x8a\xaf\x34\x27\x25\x6a\x14\x3c\x2f\xa7\xef\x83\xa6\x65\x1e\x05\xa4\x66\xfc\x17\xbe\xcf\xcf\xf8\x4a\x4a\x30\xfc\x94\x71\x7e\x32\xb3\x39\x8a\xce\x4f\x34\x2c\x30\xab\xa5\x81\x5c\xe3\xa3\xff\x95\x9f\xb7\x5e\x38\xe3\x3a\xe7\x82\x94\xf3\xe5\xbb\xba\x8e\x55\x39\x3f\xb6\xf4\xd1\xca\xa6\x33\x87\x85\x35\x65\x33\xa1\x7e\xd0\x66\xfb\x85\x75\x75\xb9\x5f\x52\x97\xd7\xf3\xee\xf8\x82\x53\x07\x6b\xaf\x97\x66\xe3\x76\x67\x46\x8e\xf0\x45\xe1\x6b\xd8\x59\x4b\xf3\x8b\xcd\xc5\xef\x00\x00\x00\xff\xff\xec\xb5\x56\x93\x98\x05\x00\x00")
+var _rpProductionGlobalSubscriptionJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x54\xcd\x4e\x23\x3d\x10\xbc\xe7\x29\x2c\x7f\x9f\x04\x48\x99\x3f\x48\xb2\x49\x6e\x08\xae\xec\x4a\x80\xb8\x44\x39\xf4\xcc\x74\x12\xef\x7a\x6c\xab\xdd\x03\x0a\xab\x79\xf7\x95\x33\x09\xf9\x85\xc3\x2a\x6b\xfb\x60\xb7\xdb\x55\xa5\xb2\xdd\xbf\x3b\x42\x08\x21\xff\xf7\xc5\x02\x2b\x90\x63\x21\x17\xcc\xce\x8f\x93\xa4\x8d\xc4\x15\x18\x98\x63\x85\x86\x63\x78\xaf\x09\xe3\xc2\x56\xeb\x3d\x9f\x5c\xa7\x59\x3f\x4a\xb3\x28\xcd\x92\x12\x9d\xb6\xcb\x90\xf7\x8c\x95\xd3\xc0\x18\xff\xf4\xd6\xfc\x27\xbb\x2d\x43\x61\x0d\xa3\xe1\x17\x24\xaf\xac\x09\x44\x59\x9c\x86\xbe\x49\x70\x40\x50\x21\x23\x79\x39\x16\xad\xac\xd0\xe5\xac\xd6\xfa\x7e\x05\xbe\x17\x0f\x43\xf2\xd2\x61\x80\xca\xad\xd5\xb2\xbb\xbf\x57\xe2\x0c\x6a\xcd\x2f\xa0\xeb\x90\x33\x03\xed\xf1\x23\xa3\x59\xcd\x9a\x35\x35\xa1\xb7\x35\x15\x18\x98\x27\x1f\x39\x07\x5c\x06\xaa\x80\x23\x7b\xc3\xd1\xf0\xa6\x7f\xd3\x8b\x6e\xca\x74\x10\xf5\xca\x22\x8f\xa0\x3f\x18\x44\xe9\x10\x06\xa3\x1e\xe6\xd9\xf5\xb7\x91\xec\x9e\xd6\xf9\xa0\x0a\xb2\xde\xce\x38\xbe\xad\x79\x61\x49\xbd\x03\x2b\x6b\x12\xb2\x1a\xef\x71\xa6\x8c\x0a\x4b\x7f\x78\xdc\x91\x75\x48\xac\x70\xdf\x9a\x4d\x97\xe1\xf8\xf7\xb5\xbc\xdb\xc7\x1f\xe2\xb5\x27\xee\xac\x61\x50\x06\xe9\x11\xe7\xca\x33\x2d\xc5\xb3\xfd\x85\x66\x15\x27\x95\xd7\x6c\xe9\x80\x25\x0c\xe9\x90\x2a\xe5\xc3\x0d\xed\x7b\xb1\xdb\x8e\x05\x6c\x9a\x84\x82\xbf\x3c\xba\x69\x3b\x46\x1c\x09\x4d\xa8\x9d\x28\xf4\xc9\x1c\x0d\x12\x30\xde\x11\x96\x68\x58\x81\xf6\x49\xcb\x71\x42\xfc\xdf\x10\xf8\xc2\x3a\x7c\x00\xe7\x13\x42\x28\xcf\x04\xca\xc1\x69\x9f\x94\xa8\x91\xf1\xbc\x98\xe1\x1d\xac\x9e\xcc\x13\x03\xd7\x1e\xff\x85\xee\xf3\x23\xbe\x91\x62\x94\x9f\x22\x4e\x4f\xee\x34\x47\xd1\xe9\xb1\x28\x09\xde\xab\xb9\x81\x5c\xe3\x53\xb8\xca\xcf\x9f\x9e\x9c\xf8\x3a\xf7\x05\x29\x17\xec\xbb\xbc\x8a\x55\x39\x95\x9d\xaf\xa5\x34\xdd\xbd\xa5\x2c\xac\x29\x57\x3f\x34\xfc\xe5\xc9\xb6\x60\x5d\x5e\x6c\x8b\xd4\xc5\xd5\xf4\xc0\x3c\x09\x4e\xed\x94\xbd\xeb\x34\x1b\xb6\x35\x33\x72\x84\xaf\x0a\xdf\xb6\x32\x9a\x8e\x10\x42\x4c\x3b\x4d\xe7\xcf\x00\xec\xb5\x56\x93\x98\x05\x00\x00")
 
 func rpProductionGlobalSubscriptionJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -251,7 +251,7 @@ func rpProductionGlobalSubscriptionJson() (*asset, error) {
 	return a, nil
 }
 
-var _rpProductionGlobalJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x6d\x6b\x23\x37\x10\xfe\x9e\x5f\xb1\x6c\x0b\x6b\x83\xd7\xbb\x1b\xaf\x1d\x3b\xdf\xdc\x1e\x2d\x07\x6d\x13\x9c\x70\x5f\x4c\x28\x5a\x69\xec\xa8\x27\x4b\x62\x24\xf9\x9a\x2b\xf9\xef\x45\xbb\xb6\x93\x7d\xb1\x93\x5e\xef\xa0\x84\x62\x30\x8b\x46\x7a\xe6\xe5\x99\x67\x98\xbf\xce\x82\x20\x08\xc2\xef\x0d\xbd\x87\x0d\x09\x2f\x83\xf0\xde\x5a\x6d\x2e\x93\xa4\x3a\x19\x6e\x88\x24\x6b\xd8\x80\xb4\x43\xf2\xd9\x21\x0c\xa9\xda\xec\x6c\x26\x39\x4f\xb3\x71\x9c\x66\x71\x9a\x25\x0c\xb4\x50\x0f\xfe\xde\x2d\x6c\xb4\x20\x16\x86\x7f\x18\x25\xbf\x0b\x07\x95\x07\xaa\xa4\x05\x69\x3f\x00\x1a\xae\xa4\x77\x94\x0d\x53\xff\xdb\x5f\xd0\x04\xc9\x06\x2c\xa0\x09\x2f\x83\x2a\xac\xf2\x9c\x50\x5c\x80\x51\x0e\x29\xbc\x67\x35\x53\x69\xb6\x0f\x1a\x3c\x9a\xb1\xc8\xe5\x3a\x3c\x18\x1f\x07\x4f\x10\x2b\x7d\x03\xb8\xe5\x14\xae\x91\x4b\xca\x35\x11\x5f\x8c\xe4\x84\x78\x57\x66\x7a\xfc\x7d\xa1\x94\x08\x07\x75\x1b\x83\x15\x71\xc2\x7e\x20\xc2\xf9\x3b\x2b\x22\x0c\x74\x3a\xc0\xaf\x16\x2a\xea\x5d\xad\x6f\xac\x42\xb2\x86\x39\xa5\xca\x49\xfb\x1b\xd9\xc0\x3f\x00\x3c\x7b\x06\x1b\xe2\x8e\x07\xcf\xd0\xf2\x70\xa7\x01\x25\x2b\x07\xe1\x92\x2a\x49\x89\xed\x19\x57\x54\xa8\xbd\x27\x86\x7b\x51\x8d\xd5\xa8\x3f\x08\x08\x63\x3d\x41\x8c\x7d\x2f\x19\xfc\x79\xb5\x3a\x7d\x39\x4a\xfc\x7f\xd6\xaf\x3e\xfd\xdf\xaf\x9c\xa2\x32\x6a\x65\x87\x73\x67\xef\x15\xf2\xcf\xc4\x72\x25\xbd\x71\xed\x38\xeb\xed\x82\x39\x89\xfa\xdc\xd8\xc5\x43\xe9\x79\x71\x1d\x24\xc1\x9c\xe2\xb5\x13\x22\xea\xf7\xfb\x77\x4d\xaa\xf7\xa5\x7c\x8a\xe8\x47\x25\x2d\xe1\x12\x70\x01\x6b\x6e\x2c\x3e\x24\x58\x7d\x70\x30\x89\x46\xb5\xe5\x0c\xd0\x24\xa8\x04\xcc\x8d\xe1\x6b\xe9\x55\x64\x9a\xb8\x1a\x95\x06\xb4\x1c\x4c\x8b\xbe\xd2\x6e\xa8\xaa\x1c\x2f\xf1\x90\x55\x2f\x7a\x5d\x14\xd1\x20\xf8\xa6\x34\xb5\xaa\x54\xb5\x93\x12\xf0\x0e\x56\x5c\x72\x4f\x55\xd9\xe7\xe1\xd2\xc7\x41\x91\x6b\x7f\xb4\xe8\x4c\xa4\x4e\x70\x1d\xc4\x67\x12\x5d\xac\x66\xe3\x8c\x31\x12\xe7\xc0\x46\x71\x3e\x99\xa6\x31\xb9\xa0\x24\xce\x47\x2b\xc8\x2e\xce\xd9\x78\x34\x65\x51\x77\x48\xba\xa6\xba\x70\xf9\x72\x4b\x9c\x86\xb9\xdd\x35\x43\xf3\x65\x58\x7b\xf3\xd8\xa0\x9a\x2a\xc9\xca\x74\x5a\x31\x3c\xcd\x9f\xb6\xe7\x90\x68\xfe\x6c\xbc\x9e\xa7\xd9\x34\x4e\x67\x7e\x3c\x6b\x84\x2d\x87\x4f\x9d\x73\xe2\xcd\xa8\x37\xfa\xa9\x54\xe6\xe2\x2a\xd8\xe6\x41\xab\xd9\x83\x5b\xf5\x11\x64\x79\x8e\xbc\x70\x56\xe1\xff\xe2\xfd\x4f\x8a\x37\x9f\xce\xa6\xa3\xf1\x28\x8f\x47\x2c\x9d\xc4\x39\xa3\x45\x4c\xc6\x93\x49\x9c\x4e\xc9\x64\x96\x43\x91\x9d\x5f\xcc\xbe\x40\xbc\x5d\x2b\xc0\x9b\x13\xaf\xf9\xe8\xba\xdb\x6b\xaf\xea\x1b\x4b\x24\x23\xc8\x7e\xff\x65\x71\x73\x3a\x8b\x97\x1a\x96\x08\xa1\x3e\xfd\x20\x54\x71\xed\x0a\xc1\xe9\x9c\x52\x30\xfe\xaa\x45\x07\x27\x81\x85\xa2\xe4\x50\x9d\x7d\xb3\xff\x8c\xca\xe9\x5e\x7f\xb8\x37\xb6\xaa\x73\x18\x4b\xf5\x81\x7c\x74\xc3\xe9\x28\x70\x5b\xda\xbb\x67\x89\xa9\x3d\x6f\x69\xf7\x6b\x11\x3a\x8b\xd3\x3c\x4e\xb3\xd7\x10\xf9\x52\xf5\x75\xbd\xe8\xe1\x61\x3c\x74\xf5\xf3\x06\x2c\x61\xc4\xfa\xe5\x5e\x3a\x21\x4e\xb2\xd3\x1c\xff\xaf\x2e\xb7\x9f\x17\xbb\x25\x37\x41\xbd\xad\x2e\xfe\x1b\x16\x92\x42\xa8\x62\xa7\x3c\x93\xd0\x7d\x82\xdf\x9c\x9d\xd6\xe2\xae\x41\x32\x73\x25\x6b\x2b\xef\xc1\x7c\x64\x5c\x1f\xc9\x29\x6a\xee\x98\x27\x0a\xda\xbf\xab\x0b\xf4\xae\xb1\x93\xdf\x9d\x3d\x9e\xfd\x1d\x00\x00\xff\xff\x28\x72\x06\xd2\xbd\x0d\x00\x00")
+var _rpProductionGlobalJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x5d\x6f\xdb\x36\x14\x7d\xf7\xaf\x20\xb8\x01\xb2\x01\xc9\x92\x62\xd9\xb1\xf3\xe6\xad\xd8\x10\x60\x6b\x02\x27\xe8\x8b\x11\x0c\x14\x79\xed\x70\xa5\x49\x82\x1f\xee\xd2\xa1\xff\x7d\xa0\x65\x39\x91\xad\x38\x5e\xbb\x0c\x5d\x31\x49\x10\x04\x5d\xf2\x7e\x9c\x73\xcf\x05\xff\xec\x20\x84\x10\xfe\xde\xd2\x7b\x58\x11\x7c\x81\xf0\xbd\x73\xda\x5e\xa4\x69\xf5\xa7\xbf\x22\x92\x2c\x61\x05\xd2\xf5\xc9\x47\x6f\xa0\x4f\xd5\x6a\x6b\xb3\xe9\x59\x96\x0f\x93\x2c\x4f\xb2\x3c\x65\xa0\x85\x7a\x08\xeb\x6e\x61\xa5\x05\x71\xd0\xff\xdd\x2a\xf9\x1d\x8e\xab\x08\x54\x49\x07\xd2\xbd\x03\x63\xb9\x92\x21\x50\xde\xcf\xc2\x5d\x2f\xd0\xc4\x90\x15\x38\x30\x16\x5f\xa0\x2a\xad\x70\x63\x42\xcd\x0c\xac\xf2\x86\xc2\x25\x6b\x98\xc2\x83\xdd\x83\x86\xe0\xcd\x3a\xc3\xe5\x12\xef\x8c\x9f\xe2\xdd\x27\x5e\xe8\x1b\x30\x6b\x4e\xe1\xda\x70\x49\xb9\x26\xe2\xb3\x3d\x79\x21\xde\x6c\x2a\x7d\x7e\x7f\xa9\x94\xc0\x71\xd3\xc6\x60\x41\xbc\x70\xef\x88\xf0\x21\xdb\x05\x11\x16\x5a\x03\x98\x7f\x2c\x55\xa3\xb7\x58\xdf\x38\x65\xc8\x12\xa6\x94\x2a\x2f\xdd\x5b\xb2\x82\xbf\xe1\xb0\xf3\xc4\x2d\x36\x5b\x1e\x02\x43\xf3\xdd\x9a\x3d\x57\xb2\x0a\x80\xe7\x54\x49\x4a\x5c\xd7\xfa\xb2\x42\xb4\xfb\xc8\x70\x37\x6a\xb0\x1a\xf5\x62\x44\x18\xeb\x0a\x62\xdd\xa5\x64\xf0\xc7\xd5\xe2\xf8\xe2\x28\x0d\xef\xbc\x57\x7d\x86\xd7\xaf\x9c\x1a\x65\xd5\xc2\xf5\xa7\xde\xdd\x2b\xc3\x3f\x12\xc7\x95\x4c\xa3\x18\x2d\x3d\x67\xdd\x6d\x32\x47\xbd\x3e\x35\xb6\xf1\x10\x62\x46\xb3\x6b\x94\xa2\x29\x35\xd7\x5e\x88\xa8\xd7\xeb\xdd\xe1\xb8\x1d\xca\xc7\x8c\x7e\x54\xd2\x11\x2e\xc1\xcc\x60\xc9\xad\x33\x0f\xa9\xa9\x3e\x38\xd8\x54\x1b\xb5\xe6\x0c\x8c\x4d\x8d\x12\x30\xb5\x96\x2f\x65\x50\x91\xdd\xf7\xab\x8d\xd2\x60\x1c\x87\xa6\x3e\xea\x0b\x5b\xaa\xaa\xc0\xf3\x9a\xa6\x4b\xd6\x8d\x4e\xcb\x22\x8a\xd1\xab\xd2\x74\x80\x52\x78\x70\x28\xf8\x0d\x2c\xb8\xe4\x81\xaa\x8d\x24\xf1\x3c\xe4\x41\x0d\xd7\xe1\xd7\xac\xb5\x90\x26\xc1\x4d\x27\x36\x8a\x51\x74\xbe\x98\x0c\x73\xc6\x48\x52\x00\x1b\x24\xc5\x68\x9c\x25\xe4\x9c\x92\xa4\x18\x2c\x20\x3f\x3f\x63\xc3\xc1\x98\x45\xed\x29\xe9\x86\xea\xf0\xfc\xe5\x96\x38\xee\xe6\x76\xdb\x0c\xfb\x3b\x1f\x15\xb6\x27\xdb\x7a\x56\xb2\x0d\x26\x07\x39\x3c\xce\x9f\xc3\xc8\x98\x68\xfe\x64\xbc\x9e\x65\xf9\x38\xc9\x26\x61\x3c\x6b\x03\x6b\x0e\x1f\x70\xa7\x25\xe0\x37\xa3\xde\xe8\xa7\x8d\x32\x67\x57\x68\x5d\xa0\x83\x66\x47\xb7\xea\x3d\xc8\xcd\x7f\xc3\x4b\xef\x94\xf9\x5f\xbc\x5f\xa5\x78\x8b\xf1\x64\x3c\x18\x0e\x8a\x64\xc0\xb2\x51\x52\x30\x5a\x26\x64\x38\x1a\x25\xd9\x98\x8c\x26\x05\x94\xf9\xd9\xf9\xe4\x33\xc4\xdb\x76\x04\xf8\xe6\xc4\x6b\xdf\xfb\xf6\xf6\xaa\x55\x7d\xe3\x88\x64\xc4\xb0\xdf\x7e\x99\xdd\x1c\xaf\xe2\xa5\x86\x25\x42\xa8\x0f\x3f\x08\x55\x5e\xfb\x52\x70\x3a\xa5\x14\x6c\xe8\x6d\x67\x3c\x1c\x75\x2c\x14\x25\x3b\x74\xea\x66\xff\xd9\x28\xaf\xbb\xbd\x7e\x6d\x3c\x40\x67\x37\x96\x9e\xe2\x79\xe4\x84\x13\x9d\x22\xed\xed\xb6\xd4\x36\xb6\x5b\xfc\x4a\x84\x4e\x92\xac\x48\xb2\xfc\x14\x22\x5f\x42\x5f\x37\x41\xc7\xbb\xf1\xb0\x97\x44\x78\xf0\x0a\x1c\x61\xc4\x85\xc3\xbd\xf4\x42\x1c\x65\x67\x7f\xfc\x9f\x0c\x77\x98\x17\xdb\x43\x6e\x6a\xf4\xba\x2a\xfc\x4b\x58\x48\x4b\xa1\xca\xad\xf2\x6c\x4a\xeb\x02\x5f\x9d\x9d\xbd\x75\x0c\x34\x48\x66\xaf\x64\xe3\xc8\x5b\x5f\xcf\x8d\xeb\x67\x6a\x8a\x62\x74\x32\xa0\xbd\xbb\xa6\x40\xef\x3a\x2d\x9c\x7d\x61\xdb\xbc\x55\x12\xbe\x92\x8e\xb9\xe7\x01\xab\x87\x7f\xa3\x63\xfe\xcb\xd4\x77\x10\x42\xe8\xae\xf3\xa9\xf3\xd7\x00\x17\xd4\xae\x35\xb8\x0f\x00\x00")
 
 func rpProductionGlobalJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -271,7 +271,7 @@ func rpProductionGlobalJson() (*asset, error) {
 	return a, nil
 }
 
-var _rpProductionManagedIdentityJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x51\xcb\x6a\xc3\x30\x10\xbc\xe7\x2b\x8c\x5b\x70\x02\xf1\x43\x29\x85\x92\x5b\xa1\x50\x7a\xc8\xad\xe4\x12\x72\xd8\xca\xeb\x44\x45\xd2\x0a\x69\x75\x48\x4b\xfe\xbd\xd8\xb1\x9d\xd6\xa1\xf8\x62\x66\x46\x33\xb3\xbb\xdf\xb3\x24\x49\x92\xf4\x3e\xc8\x23\x1a\x48\xd7\x49\x7a\x64\x76\x61\x5d\x96\x17\xa4\x30\x60\xe1\x80\x06\x2d\x17\xf0\x15\x3d\x16\x92\x4c\xcf\x85\x72\x55\x89\xc7\xbc\x12\x79\x25\xca\x1a\x9d\xa6\x53\xab\x7b\x47\xe3\x34\x30\x16\x9f\x81\xec\x5d\xba\xbc\x24\x48\xb2\x8c\x96\xb7\xe8\x83\x22\xdb\x06\x89\xa2\x6a\xbf\x41\xe0\xc0\x83\x41\x46\x1f\xd2\x75\x72\xa9\xd5\xe1\x4d\xd4\xfa\xa5\x33\xff\x83\x77\x1c\x9f\x1c\xb6\x56\x1f\x44\xba\xf7\x19\xb9\x1a\x1b\x88\x9a\xb7\xa0\x63\xab\x69\x40\x07\x1c\x15\xe7\xee\xef\xdc\x47\x7b\x0c\x14\xbd\xc4\x36\x79\x37\x6a\x26\x59\x9a\x24\x70\x5f\x7d\x37\xbc\x78\xf5\x14\xdd\x7c\x51\x0c\xe4\x7e\xda\xc2\x82\xe9\x1a\xee\x24\x59\x09\x3c\xcf\xc0\x53\xee\x5d\x9e\x2d\x93\xff\x3c\x16\x37\x26\xc3\x98\x1b\x25\x3d\x05\x6a\xb8\xd8\x74\x57\xa9\xdf\x6a\xb4\xac\xf8\x54\xc6\x80\xfe\x39\x04\x75\xb0\x23\xa8\x30\x4c\x7d\x24\xd9\x5a\x8d\x33\x5c\x17\x3e\xcf\xae\x4b\xce\x6e\xe3\xc1\xa9\x5f\x67\x5b\x55\xe2\x29\x17\x22\x7f\xa8\xd2\xc9\x3a\xf7\xb3\xf3\xec\x27\x00\x00\xff\xff\x0c\x0a\x64\x21\x50\x02\x00\x00")
+var _rpProductionManagedIdentityJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x91\x4d\x6b\xe3\x30\x10\x86\xef\xfe\x15\x42\xbb\xe0\x04\xe2\xaf\x2c\x0b\x4b\x6e\x0b\x85\xd2\x43\x6e\x25\x97\x90\xc3\x54\x1e\x27\x2e\x92\x46\x48\xa3\x43\x5a\xfc\xdf\x8b\x92\xd8\x69\x12\xca\xf8\x60\x66\x5e\xbd\xcf\x7c\x7c\x66\x42\x08\x21\x7f\x07\x75\x40\x03\x72\x25\xe4\x81\xd9\x85\x55\x55\x9d\x33\xa5\x01\x0b\x7b\x34\x68\xb9\x84\x8f\xe8\xb1\x54\x64\x2e\xb5\x50\x2d\xeb\xe6\x6f\x51\x37\x45\xdd\x54\x2d\x3a\x4d\xc7\xa4\x7b\x45\xe3\x34\x30\x96\xef\x81\xec\x2f\xb9\x38\x13\x14\x59\x46\xcb\x1b\xf4\xa1\x27\x9b\x40\x4d\x59\xa7\x18\x05\x0e\x3c\x18\x64\xf4\x41\xae\xc4\xb9\xad\x14\xb2\x8b\x5a\x3f\x9d\xcc\x6f\xf2\xe9\x93\x7c\x74\x98\xac\xde\x88\xb4\x5c\xdc\xd6\x5a\xec\x20\x6a\xde\x80\x8e\x49\xd3\x81\x0e\x38\x29\x86\xd3\xdf\x70\x41\x7b\x0c\x14\xbd\xc2\x44\xde\x4e\x9a\x3b\x96\x26\x05\x7c\x69\x7d\x3b\xbe\x78\xf6\x14\xdd\x6c\x5e\x8e\xc5\xdd\x7d\x17\x16\x4c\xa2\xcb\xad\x22\xab\x80\x67\x39\x78\x2a\xbc\x2b\xf2\x85\xf8\xc9\x63\xfe\x60\x32\x8e\xb9\xee\x95\xa7\x40\x1d\x97\xeb\xd3\x55\xda\x97\x16\x2d\xf7\x7c\xac\x62\x40\xff\x3f\x84\x7e\x6f\xa7\x64\x8f\xe1\xde\x47\x91\x6d\xfb\x69\x86\xeb\xc2\x67\xf9\x75\xc9\xf9\x23\x1e\x5c\xff\xed\x6c\xcb\xba\xf9\x57\x34\x4d\xf1\xa7\x96\x93\x6c\xc8\x84\x10\x62\x97\x0d\xd9\xd7\x00\x0c\x0a\x64\x21\x50\x02\x00\x00")
 
 func rpProductionManagedIdentityJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -291,7 +291,7 @@ func rpProductionManagedIdentityJson() (*asset, error) {
 	return a, nil
 }
 
-var _rpProductionParametersJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x94\xc1\x6e\xd3\x40\x10\x86\xef\x79\x0a\xcb\x70\x6c\x9d\xa4\x88\x4b\x6e\xad\x03\x28\x42\x20\x8b\x08\xae\xd5\x64\x77\x9c\x2c\xec\xce\xac\x66\x76\xad\xb6\xa8\xef\x8e\x1c\xd3\x02\x12\xa9\xb0\xa9\x2c\xf9\x30\xb3\xdf\xf7\x5b\x9a\x59\x7f\x9f\x15\x45\x51\x94\x2f\xd5\x1c\x30\x40\xb9\x2a\xca\x43\x4a\x51\x57\xf3\xf9\x50\xa9\x02\x10\xec\x31\x20\xa5\x0a\xee\xb2\x60\x65\x38\xfc\xec\xe9\xfc\x62\xb1\x7c\x7d\xbe\x58\x9e\x2f\x96\x73\x8b\xd1\xf3\x6d\x7f\xae\x01\x81\x80\x09\x45\xab\xaf\xca\xf4\xa2\x3c\x1b\x32\x0c\x53\x42\x4a\x5f\x50\xd4\x31\xf5\x51\xcb\x6a\xd1\x3f\x0f\x07\xe2\x23\x58\xae\x8a\xe1\xc3\x8e\x75\x30\xf2\x09\x95\xb3\x18\xdc\xd8\x3f\x5a\xc7\x76\x07\x3e\x63\xaf\x2b\x1f\xeb\xf7\x67\xbf\xd1\x36\x38\xba\x8c\xae\x86\xab\x4c\xd6\xe3\x74\x81\x77\x48\xa9\x46\x49\x35\x87\xc0\xf4\x11\xc2\x78\x99\x85\x04\x3b\x50\xbc\x34\x86\x33\xa5\x69\x0e\x0e\xe0\xa6\xc5\xe3\x4d\x12\xa8\x59\x03\xeb\xfa\x6a\xd3\xe8\x68\x41\x1b\xb7\x28\x9d\x33\xd8\x88\x23\xe3\x22\xf8\x09\x23\x69\xb3\xf7\xeb\xe3\xbe\x9c\x46\x5b\xf0\x8a\x7f\xa5\xbf\xe1\x6d\x07\xd9\xa7\x46\xb0\x75\x37\xa3\xc3\x83\x0d\x6f\xe5\xb8\x8a\xf6\xb3\xf8\x09\xb8\xda\x9a\xa9\x75\xfb\x5f\x8b\x3c\xde\xf0\x86\x3a\x27\x4c\xfd\x75\x19\xcd\x4b\xdc\x04\xd8\x8f\x9f\xbd\xc4\x0f\x6c\xa7\x60\xcf\x30\x71\xd5\x43\x93\x77\xde\x99\xf7\xf8\xc4\xcc\x4f\xc1\x89\x05\xf6\xff\x75\x65\x34\xef\xd4\x88\x8b\xc9\x31\x3d\xfc\x4b\xde\x09\xe7\x38\xc9\xd6\x85\xad\xbb\x7b\x0a\xdb\x26\x20\x0b\x62\xaf\xd7\x17\x7a\xdd\xbd\x3a\x65\x51\xfd\xf7\xf8\xd9\xf0\xbe\x9f\xfd\x08\x00\x00\xff\xff\xbe\x95\xac\x03\xb2\x05\x00\x00")
+var _rpProductionParametersJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x94\x51\x6f\xd3\x30\x10\xc7\xdf\xfb\x29\xac\xc0\xe3\xd6\xa6\x03\x5e\xfa\xb6\xb5\x80\x2a\x34\x14\x51\xe0\x75\xba\xda\x97\xc4\x60\xfb\xac\x3b\x3b\x6a\x87\xfa\xdd\x51\x56\x56\x84\xb4\x22\x92\x21\xe7\x21\xb2\xfd\xfb\xfd\x13\x9d\xcf\x3f\x26\x4a\x29\x55\xbc\x14\xdd\xa2\x87\x62\xa1\x8a\x36\xa5\x28\x8b\xd9\xec\x38\x33\xf5\x10\xa0\x41\x8f\x21\x4d\xe1\x3e\x33\x4e\x35\xf9\x5f\x6b\x32\xbb\x2a\xe7\x6f\x2e\xcb\xf9\x65\x39\x9f\x19\x8c\x8e\xf6\xfd\xbe\x0a\x18\x3c\x26\x64\x99\x7e\x13\x0a\x2f\x8a\x8b\x63\x86\xa6\x90\x30\xa4\xaf\xc8\x62\x29\xf4\x51\xf3\x69\xd9\x8f\xc7\x0d\xf1\x04\x16\x0b\x75\xfc\xb0\x7e\x14\xa0\xf9\x13\x0a\x65\xd6\xb8\x36\x7f\x2c\xf5\x4f\xd1\x81\xcb\xd8\xeb\x8a\xd3\xfc\xe1\xe2\xf4\x5a\x80\xf1\x36\x5c\x47\xbb\x84\x9b\x1c\x8c\xc3\xf1\x02\x67\x31\xa4\x25\x72\x5a\x92\xf7\x14\x3e\x82\x1f\x2e\x33\x90\x60\x0b\x82\xd7\x5a\x53\x0e\xe9\x59\x8e\x5b\xd8\x7d\x6e\x99\x72\xd3\xc6\x9c\xce\x5b\x5e\x97\x65\xf9\xb4\x87\x3c\xd8\x71\xbf\x81\xbb\xc4\xb0\x24\xf1\x24\xab\x9b\x75\x25\x83\x05\x75\xdc\x20\x77\x56\x63\xc5\x36\x68\x1b\xc1\x8d\x28\x6d\x9d\x9d\x5b\x3d\x9c\xbb\xf3\x68\x0d\x4e\xf0\x49\xfa\x3b\xee\x3b\xc8\x2e\x55\x8c\xb5\xdd\x0d\x0e\xf7\xc6\xbf\xe3\x87\x23\x6d\xbe\xb0\x1b\x81\x8b\x59\x52\xa8\x6d\xf3\xbb\x21\x86\x1b\xde\x86\xce\x32\x85\xbe\xed\x06\xf3\x1c\xd7\x1e\x9a\xe1\xb5\xe7\x78\x4b\x66\x0c\xf6\x1f\x2a\x2e\xd2\x56\x79\xeb\xac\xfe\x80\xfb\xe1\x70\x22\x86\xe6\x59\xad\x27\x79\x2b\x9a\x6d\x4c\x96\xc2\xe3\x9d\xf4\x9e\x29\xc7\x51\xb6\xce\x6f\xec\xfd\xdf\xb0\x4d\x82\x60\x80\xcd\xdd\xea\x4a\xee\xba\x57\xe7\x2c\x22\xff\x1e\x3f\x51\x4a\xa9\xc3\xe4\x30\xf9\x39\x00\xcf\xc5\xa1\xa7\xfa\x05\x00\x00")
 
 func rpProductionParametersJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -311,7 +311,7 @@ func rpProductionParametersJson() (*asset, error) {
 	return a, nil
 }
 
-var _rpProductionPredeployParametersJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x90\x4f\x4b\x03\x31\x10\xc5\xef\xfd\x14\x21\x7a\x6c\xf7\x8f\xe0\x65\x6f\x45\x41\x44\x28\x0b\x0b\x5e\xc4\xc3\x90\x9d\x6d\xa3\xd9\x24\xcc\x24\x4b\xab\xf4\xbb\xcb\xfe\xb1\x22\x58\xc1\x45\x02\x39\xbc\x37\xf3\x7b\xc9\x7b\x5f\x08\x21\x84\xbc\x64\xb5\xc3\x16\x64\x21\xe4\x2e\x04\xcf\x45\x9a\x8e\x4a\xd2\x82\x85\x2d\xb6\x68\x43\x02\x6f\x91\x30\x51\xae\x9d\x3c\x4e\xaf\xb2\xfc\x7a\x95\xe5\xab\x2c\x4f\x6b\xf4\xc6\x1d\xfa\xb9\x12\x08\x5a\x0c\x48\x9c\xbc\xb0\xb3\x17\x72\x39\x66\x28\x67\x03\xda\xf0\x88\xc4\xda\xd9\x3e\x2a\x4f\xb2\xfe\x7c\x0e\xf8\xd3\xa2\x2c\xc4\xf8\xb0\x41\x1f\xd1\x9b\xea\xee\xbb\x3e\x78\x1d\x98\x88\xb2\x10\x0d\x18\xc6\x93\x75\x5c\x7e\x6d\xe3\x3e\x10\xdc\x98\xc8\x01\xe9\x01\x0f\x1d\x44\x13\xd6\x4a\x21\x73\xe9\x8c\x56\x1a\x7f\xa1\x3e\x3d\x9f\x47\x56\x48\x9d\x56\xf8\x4f\xc8\xc6\x4f\xbc\x92\xb4\x55\xda\x83\xb9\xaf\xcf\x43\xa4\xfc\x19\x12\x8d\xb9\x1d\xca\x9a\x53\xd4\xeb\xf4\x93\x92\xb0\xd1\xfb\x3f\x87\x93\xdf\xf0\xb6\x72\x91\x14\xae\xeb\x9a\xfa\x32\x06\xd0\x8c\x32\x68\x76\x19\x8b\xf1\x3e\x2e\x3e\x02\x00\x00\xff\xff\xbe\x99\x91\x69\xd8\x02\x00\x00")
+var _rpProductionPredeployParametersJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x90\xcf\x4a\xc4\x30\x10\x87\xef\x7d\x8a\x10\x3d\xee\xf6\x8f\xe0\xa5\xb7\x45\x41\x44\x58\x0a\x05\x2f\xe2\x21\xa4\xd3\xdd\x68\x9a\x84\x99\xa4\xec\x2a\x7d\x77\xc9\xb6\x16\x04\x57\xb0\x2c\xd3\x43\x99\x99\x7c\xbf\xe4\xfb\x4c\x18\x63\x8c\x5f\x93\xdc\x43\x27\x78\xc9\xf8\xde\x7b\x47\x65\x96\x8d\x9d\xb4\x13\x46\xec\xa0\x03\xe3\x53\xf1\x11\x10\x52\x69\xbb\x69\x46\xd9\x4d\x5e\xdc\xae\xf3\x62\x9d\x17\x59\x03\x4e\xdb\x63\xdc\xab\x04\x8a\x0e\x3c\x20\xa5\x6f\x64\xcd\x15\x5f\x8d\x19\xd2\x1a\x0f\xc6\x3f\x03\x92\xb2\x26\x46\x15\x69\x1e\xeb\x7b\xc1\xcd\x07\x79\xc9\xc6\x8b\xc5\xe2\x23\x7a\x5b\x3f\xfc\xec\xc7\x8f\xf7\x42\x07\xe0\x25\x6b\x85\x26\x98\x47\xc3\x6a\xfe\xe5\x70\xf0\x28\xee\x74\x20\x0f\xf8\x04\xc7\x5e\x04\xed\x37\x52\x02\x51\x65\xb5\x92\x0a\xfe\xa0\xbe\xbc\x9e\x47\xd6\x80\xbd\x92\x70\x21\x64\xeb\x26\x5e\x85\xca\x48\xe5\x84\x7e\x6c\xce\x43\x38\xff\x1d\x12\xb4\xbe\x3f\xc9\x5a\x22\xea\x7d\x7a\x49\x85\xd0\xaa\xc3\xbf\xc3\xd1\x6d\x69\x57\xdb\x80\x12\x36\x4d\x83\xd1\xef\x09\xb4\x40\x06\x2e\x96\x91\x30\xc6\xd8\x90\x0c\xc9\xd7\x00\xbe\x99\x91\x69\xd8\x02\x00\x00")
 
 func rpProductionPredeployParametersJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -331,7 +331,7 @@ func rpProductionPredeployParametersJson() (*asset, error) {
 	return a, nil
 }
 
-var _rpProductionPredeployJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x5f\x6f\xdb\x36\x10\x7f\xcf\xa7\x10\xb8\x01\xb6\x87\xd8\x96\xd3\x6e\xc3\xfc\x16\xac\x40\x11\x74\x0d\x82\xb8\xc8\x8b\x61\x14\x34\x75\x76\xd8\x50\x24\x71\xa4\xdc\x78\x45\xbe\xfb\x40\x4a\x76\x24\x8b\x92\xdc\x61\x69\x83\x35\xc9\x83\x6d\xf1\x78\xbf\xfb\xf3\xbb\xe3\x51\x5f\x4e\xa2\x28\x8a\xc8\xcf\x86\xdd\x42\x4a\xc9\x34\x22\xb7\xd6\x6a\x33\x1d\x8f\xf3\x27\xa3\x94\x4a\xba\x86\x14\xa4\x1d\xd1\xbf\x33\x84\x11\x53\x69\xb1\x66\xc6\x67\xf1\xe4\xd7\x61\x3c\x19\xc6\x93\x71\x02\x5a\xa8\xad\x93\xfb\x00\xa9\x16\xd4\xc2\xe8\x93\x51\xf2\x27\x72\x9a\x23\x30\x25\x2d\x48\x7b\x03\x68\xb8\x92\x0e\x68\x32\x8a\xdd\xff\x4e\x60\x43\x91\xd3\xa5\x00\x43\xa6\x51\x6e\x55\xbe\x4f\x64\xc6\x02\xbe\x83\xed\x86\x66\xc2\x9e\x33\x06\xc6\x5c\x29\xc1\x19\xf7\xa2\xf3\xbd\xa8\xfb\xfb\x52\xf9\xe5\x15\x58\x90\x54\xda\x8b\xc4\x41\xce\x4d\xb6\x34\x0c\xb9\xb6\x5c\xc9\xfe\x60\xb4\x5b\x5b\x14\x46\x54\x36\xaa\xe5\x27\x60\xbb\x8d\x9a\x22\x4d\xc1\x02\x9a\x7e\x6f\xa5\x67\x80\x1b\xce\xe0\x0a\xb9\x64\x5c\x53\x71\x91\xf4\x06\x41\x1d\x1a\x30\xe5\xc6\x79\x5c\x75\xab\x22\x64\x80\x21\xd8\xba\x33\x15\xa1\x35\x58\x12\x5c\x5d\xd4\x61\xf3\xc0\x01\x5a\xbe\xe2\x8c\xda\x40\x9c\xaa\x92\x08\xd4\x42\xc0\xfc\xbd\x44\x02\x02\xda\x25\x9c\x79\x2d\xcb\x99\x4e\x1c\x44\xd8\x81\xda\xd3\x87\xca\x93\x87\xd3\x6f\x9f\x62\x7c\x49\x71\xd0\xbc\xa7\x4a\xf1\xfe\x57\xc9\x55\x62\xf2\x14\x3c\xa7\xd2\x7f\x66\xbc\x38\x3e\xac\xfe\x5b\x51\x49\xe4\xd1\xa3\x6a\xaf\xcd\x7b\xf8\xe5\xec\x6d\xdd\x62\x62\xb7\x1a\x5c\x38\x96\x4a\x89\x03\x77\x49\x02\x2b\x97\xa0\x1b\x2a\x32\x27\xb3\xa2\xc2\xc0\x5e\xa2\x54\xbe\x04\xee\x2d\xd2\x3f\x3b\x3a\x7a\x03\x30\x45\xa4\xdb\x0e\xe4\xf9\xa2\x19\x76\xd6\xc1\xa6\xff\x1e\x36\x74\x4e\x34\x03\x19\x8b\x5c\xae\x49\x58\x53\x26\xc4\x1b\x9f\x9c\x27\x4a\xcc\x5d\x11\x95\x2b\x84\x15\xbf\xef\x34\xf2\x00\x26\xa5\xf7\x7f\x81\x5c\xdb\x5b\x32\x8d\xce\xe2\x20\x00\xea\x4b\xb3\x9e\xa9\x0c\x19\x9c\x27\x09\xba\xd0\x7b\xa8\x27\x0a\x7d\xa8\x4e\xbf\x22\xf4\x95\x6a\x41\x30\xde\xee\x6a\x95\x1e\xa8\xd2\xa8\xb4\xeb\xc6\x01\x7f\xa2\xa2\xd0\x33\xe4\x76\x7b\x9d\x89\x96\x5e\x1d\xee\x12\xc7\x00\x1c\xca\x5a\xc5\x94\x70\xbe\x7d\x60\xba\xa5\x6b\xe7\xb6\x79\xf7\xae\x14\xda\x6b\x2a\xd7\x3e\x22\xbf\x74\xed\x49\xc0\x58\x2e\xa9\x6b\xa6\x95\x8d\xaf\x5f\xbf\x3a\x0e\xae\xc2\x02\xb7\xf3\xdc\x4d\x97\xd7\x45\xac\xdf\xfb\xa9\x13\xbf\xc2\x8a\x9a\xbe\x4e\x17\xa8\xef\x00\x1e\x5a\x08\xf5\xb9\x4b\x5c\x23\x57\x2e\x83\x64\x1a\x4d\xce\xe2\x2e\xc3\x38\x02\xb3\xc5\xa0\x7b\x21\x97\x2a\x93\x49\xb8\x87\x47\xf5\x09\xa7\xa2\x49\xd2\xd4\x07\x16\xf5\x47\x2e\x3f\x52\x4c\xc3\x6a\x1a\x54\xfc\xa8\x84\xf2\x4e\x1d\x1e\xdd\x4d\x1d\x28\x7c\x80\x37\x59\xf7\x4d\x89\xf6\xea\x7b\x11\x6d\x0d\x12\x36\xb4\x81\x6b\xb5\xa7\x8b\xb6\x81\xbd\xa4\x79\x28\x4d\xed\xf0\xd8\x35\xe1\xf7\x9c\xa1\x32\x6a\x65\x47\x97\x60\x3f\x2b\xbc\x1b\xcb\xfc\x73\x56\xb4\xce\xb7\xa8\x32\x6d\x0e\xb7\x0b\xc5\xe8\xce\xff\xf9\xae\x55\x7b\xd1\xfe\x60\xb4\x5b\x3c\xcc\xaf\xbb\x8d\x26\x7c\xbf\xad\x4c\x93\xc7\x19\xa8\x4e\x0b\x42\x35\x2f\x5d\x60\xcf\xe2\xc9\x1f\xc3\xf8\xf7\x61\x3c\x09\x9e\xda\xad\x27\x44\x4b\x8c\x34\xbc\x84\xa9\xf9\x20\xfd\xd7\x33\xbd\xb9\xcb\x9a\xe7\xf0\x15\x4d\xb9\xd8\xfa\x12\x6d\x28\xcf\x7d\x86\x8c\xa5\x32\xa1\x18\xa8\xb2\x40\x75\x15\xb5\x5f\x1a\x33\xc9\x9c\x29\xc9\xa8\xed\xef\xdf\x77\xf4\x7b\xad\xef\x38\x7a\x83\xd3\xa8\x1c\xf9\xee\x19\xba\x37\x08\xdf\x48\x40\x3a\xbc\x99\x5a\xd9\x37\xf9\x65\x6f\x1a\x59\xcc\xe0\xa8\xda\xdd\x59\x5d\xb6\xa4\x3a\x34\x3a\x3b\x7b\x43\x26\x42\x84\xa8\x93\xf7\x1d\x6c\x6f\xdc\xde\xb1\xd7\xf0\xe4\x74\x7d\x1c\xa0\x8f\xa1\xeb\x6f\xc3\x49\xfc\x42\xd7\x46\xba\xb6\xde\xcb\x83\x74\x6d\xbd\x7b\x7d\x4f\xba\x9a\x0d\xfb\x3f\xd2\xd5\x7f\x5b\x9c\x3c\x9c\xfc\x13\x00\x00\xff\xff\x8b\x45\x21\x6b\xdf\x15\x00\x00")
+var _rpProductionPredeployJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x6d\x6f\xdb\x36\x10\xfe\xae\x5f\x21\x70\x03\x6c\x0f\xb1\x2d\xa7\xdd\x86\xf9\x5b\xb0\x02\x45\xd0\x35\x08\xe2\x22\x5f\x0c\xa3\xa0\xa9\xb3\xc3\x86\x22\x89\x23\xe5\xc6\x2b\xf2\xdf\x07\x4a\x96\xa3\x17\x4a\x4a\x81\x26\x2d\xd6\x88\x01\x02\x88\x77\x7c\xee\xe5\xb9\xe3\xc9\x5f\x82\x30\x0c\x43\xf2\xab\x61\x37\x90\x50\x32\x0f\xc9\x8d\xb5\xda\xcc\xa7\xd3\xfc\xcd\x24\xa1\x92\x6e\x21\x01\x69\x27\xf4\xdf\x14\x61\xc2\x54\x72\xd8\x33\xd3\xd3\x68\xf6\xfb\x38\x9a\x8d\xa3\xd9\x34\x06\x2d\xd4\xde\xc9\x7d\x80\x44\x0b\x6a\x61\xf2\xc9\x28\xf9\x0b\x39\xc9\x11\x98\x92\x16\xa4\xbd\x06\x34\x5c\x49\x07\x34\x9b\x44\x6e\x15\x02\x3b\x8a\x9c\xae\x05\x18\x32\x0f\x73\xab\xdc\x22\x4c\xa4\xc6\x02\xbe\x83\xfd\x8e\xa6\xc2\x9e\x31\x06\xc6\x5c\x2a\xc1\x19\xcf\x44\x97\x47\x51\xf7\xf7\xa0\x58\x3c\xc4\x82\xa4\xd2\x9e\xc7\x0e\x72\x69\xd2\xb5\x61\xc8\xb5\xe5\x4a\x0e\x47\x93\x62\x6f\x75\x30\xa2\xbc\x88\x5a\x7f\x02\x56\x28\x6a\x8a\x34\x01\x0b\x68\x86\x83\x8d\x5e\x00\xee\x38\x83\x4b\xe4\x92\x71\x4d\xc5\x79\x3c\x18\x79\xcf\xd0\x80\x09\x37\xce\xe3\xaa\x5b\xe5\x87\x18\x60\x08\xb6\xe9\x4c\xf9\x21\x5b\xb0\xc4\xbb\xbb\x6a\xc2\xba\x45\x18\xa0\xe5\x1b\xce\xa8\xf5\xc4\xa9\xbc\x08\x43\xa0\x16\xc8\x49\xbb\x44\x0c\x02\xba\x25\x9c\x79\x1d\xdb\xa9\x8e\x1d\x84\x57\x60\xd5\x78\x7b\x5f\x79\x73\x7f\xf2\xfc\x29\xc6\x97\x14\x3f\x6b\x8a\x03\x8f\xab\xc4\xe4\x29\xf8\x91\x4a\xff\x07\xe3\xc5\xe3\xc3\x1a\x94\x2a\x89\x3c\x78\x54\xb1\x8c\xe4\x3d\xfc\x62\xf1\xb6\x69\x31\xb1\x7b\x0d\x2e\x8e\x6b\xa5\x44\xcd\x5d\x12\xc3\xc6\x25\xe8\x9a\x8a\xd4\xc9\x6c\xa8\x30\x10\x78\xca\x97\xc0\x9d\x45\xfa\x77\x4f\x47\x6f\x01\xa6\x88\x74\xdf\x83\xbc\x5c\xb5\xc3\x2e\x7a\xd8\xf4\xed\x61\x7d\xf7\x44\x3b\x90\xb1\xc8\xe5\x96\xf8\x4f\x4a\x85\x78\x93\x25\xe7\x89\x12\x73\x7b\x88\xca\x25\xc2\x86\xdf\xf5\x1a\x59\x83\x49\xe8\xdd\x3f\x20\xb7\xf6\x86\xcc\xc3\xd3\xc8\x0b\x80\xfa\xc2\x6c\x17\x2a\x45\x06\x67\x71\x8c\x2e\xf4\x19\xd4\x13\x85\xde\x57\xa7\xbd\x5e\x1d\x37\xef\x2b\xd5\x82\x60\x32\xbb\xab\x55\x5a\x3b\x4a\xa3\xd2\xae\x1b\x7b\xfc\x29\x0a\x3d\x45\x6e\xf7\x57\xa9\xe8\xe8\xd5\x4d\xc5\xe2\xe9\x03\x28\x2f\x27\x6b\x15\x53\xc2\xf9\xf6\x81\xe9\x5a\x08\xeb\x8b\xe4\xee\x5d\x2a\xb4\x57\x54\x6e\x5d\x54\xc9\x6f\x7d\x3a\x31\x18\xcb\x25\x75\x97\x6c\x45\xf1\xf5\xeb\x57\x8f\x83\xab\xb0\xc0\x41\x9e\xb9\xe9\xf2\xea\x10\xeb\xf7\xd9\xd4\x89\x5f\x61\x45\xe3\xbc\x5e\x17\x68\xd6\x01\x32\x68\x21\xd4\xe7\x3e\x71\x8d\x5c\xb9\x0c\x92\x79\x38\x3b\x8d\x7a\x84\x63\x8e\xc0\xec\x61\xd0\x3d\x97\x6b\x95\xca\xd8\xdf\xc3\x6b\xcc\xad\x2f\x22\x69\x92\x05\x16\xf5\x47\x2e\x3f\x52\x4c\x48\xf0\x15\x47\xfc\xac\x84\x02\xe3\xb9\xba\xdb\x3a\x90\xff\x02\x6f\xb3\xee\x59\x89\xf6\xea\x7b\x11\x6d\x0b\x12\x76\xb4\x85\x6b\x8d\xb7\xab\xa0\x03\xa5\x74\xf2\x58\x9a\xc6\xe5\x51\x34\xe1\xf7\x9c\xa1\x32\x6a\x63\x27\x17\x60\x3f\x2b\xbc\x9d\xca\xfc\xff\xe2\xd0\x3a\xdf\xa2\x4a\xb5\xa9\xab\x0b\xc5\x68\xe1\xff\xb2\x68\xd5\x99\xe8\x70\x34\x29\x36\xeb\xf9\x25\x4c\xc9\x98\x1f\xd5\xca\x34\x79\x98\x81\x9a\xb4\x20\x54\xf3\xd2\x07\xec\x69\x34\xfb\x6b\x1c\xfd\x39\x8e\x66\x24\xf0\xf8\xfe\x25\xe8\xa8\xb7\x8e\x18\x69\x78\x09\x53\x11\xa6\x6f\x38\xd3\x9b\xdb\xb4\xb5\xd1\x91\x0d\x4d\xb8\x70\x15\x47\xce\x3c\xba\x95\x0c\x19\x4b\x65\x4c\xd1\x53\x65\xb5\x9c\x96\x6a\xbf\x34\x66\x92\x25\x53\x92\x51\x3b\x3c\xfe\xde\x31\x1c\x74\xfe\xc6\x31\x18\x9d\x84\xe5\xc8\xf7\xcf\xd0\x83\x51\x23\x25\xee\x8f\x80\x74\x78\x0b\xb5\xb1\x6f\xf2\x8f\xbd\x79\x68\x31\x85\xa0\xc3\x87\xa3\xd7\x85\xd5\x65\x4b\xaa\x43\xa3\xb3\x73\x30\x66\xc2\x47\x88\x26\x79\xdf\xc1\xfe\xda\xe9\x4e\x33\x8f\x9f\x9c\xae\x0f\x03\xf4\x63\xe8\xfa\xc7\x78\x16\xbd\xd0\xb5\x95\xae\x9d\xdf\xe5\x5e\xba\x2e\xba\x35\xbe\x1f\x5d\xcd\x8e\xfd\x1f\xe9\x1a\x84\x61\x18\xae\x82\xfb\xe0\xbf\x01\x00\x8b\x45\x21\x6b\xdf\x15\x00\x00")
 
 func rpProductionPredeployJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -351,7 +351,7 @@ func rpProductionPredeployJson() (*asset, error) {
 	return a, nil
 }
 
-var _rpProductionSubscriptionJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x51\xcd\x6a\xf3\x30\x10\xbc\xe7\x29\x8c\xbf\x0f\xd2\x42\xfd\x97\x52\x28\x3e\x17\x42\x0f\xed\xa5\x25\x97\x90\xc3\x46\x5e\xff\x14\x59\x2b\xa4\xf5\x21\x2d\x7e\xf7\x22\xd9\x71\x1a\x05\x5f\xcc\xce\x68\x66\x76\xe7\x67\x15\x45\x51\x14\xff\xb7\xa2\xc5\x1e\xe2\x32\x8a\x5b\x66\x6d\xcb\x2c\x9b\x26\x69\x0f\x0a\x1a\xec\x51\x71\x0a\xdf\x83\xc1\x54\x50\x3f\x63\x36\xdb\xe4\xc5\x53\x92\x17\x49\x5e\x64\x15\x6a\x49\x27\xc7\xfb\xc4\x5e\x4b\x60\x4c\xbf\x2c\xa9\x7f\xf1\xc3\xe4\x20\x48\x31\x2a\xde\xa1\xb1\x1d\x29\x67\x54\xa4\xb9\xfb\xce\x04\x0d\x06\x7a\x64\x34\x36\x2e\xa3\x29\x96\x9f\xd7\x83\x94\x2f\x5e\xfc\x6a\xee\x31\x3e\x69\x74\x52\x47\x22\x39\xeb\x2c\x58\x85\x35\x0c\x92\x77\x20\x07\xc7\xa9\x41\x5a\x5c\x18\xa3\xff\x1b\x67\x6b\x83\x96\x06\x23\xd0\x39\xef\x17\x4e\xe0\xa5\x0d\x69\x34\xdc\xa1\xbd\xc9\xe1\xf1\xc6\xd0\xa0\x3f\x5a\x32\xfc\x0e\xbd\x4f\x65\x74\x8b\x20\xb9\x0d\x92\x79\x36\x2a\x38\x4a\xac\xe2\x32\x62\x33\xe0\x15\x3e\x06\x8b\xa8\x45\x2e\x99\xf4\x12\x68\xc2\x65\xcf\x87\x78\xeb\x84\x21\x4b\x35\xa7\xaf\xca\x76\x4d\xcb\x36\x03\xc1\x1d\xa9\xad\x4b\x67\xc3\x67\x92\x04\xf0\x5c\xc7\x56\xd2\x11\x6e\xae\x28\x48\x55\xdd\x99\xb2\xbf\x74\x74\xb7\xbe\xf4\xb2\xbe\x3f\x84\xcf\x40\x77\x7f\x9a\xde\xe4\xc5\x73\x92\x3f\x26\x79\x11\x07\x0d\x1c\x56\xe3\xea\x37\x00\x00\xff\xff\xb4\x44\x57\x5e\x83\x02\x00\x00")
+var _rpProductionSubscriptionJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x51\x4d\x6b\xe3\x30\x10\xbd\xfb\x57\x08\xed\x42\x76\x21\xfe\xca\xb2\x50\x7c\x2e\x84\x1e\xda\x4b\x4b\x2e\x21\x87\x89\x3c\xfe\x28\xb2\x46\x48\xe3\x43\x5a\xfc\xdf\x8b\xe2\xd8\x49\xdc\xf2\x74\x10\xd2\x9b\xf7\x1e\xf3\x3e\x23\x21\x84\x90\xbf\xbd\x6a\xb0\x03\x59\x08\xd9\x30\x5b\x5f\xa4\xe9\xf8\x92\x74\x60\xa0\xc6\x0e\x0d\x27\xf0\xd1\x3b\x4c\x14\x75\x97\x3f\x9f\x6e\xb2\xfc\x7f\x9c\xe5\x71\x96\xa7\x25\x5a\x4d\xa7\xc0\x7b\xc3\xce\x6a\x60\x4c\xde\x3d\x99\x5f\x72\x3d\x3a\x28\x32\x8c\x86\x77\xe8\x7c\x4b\x26\x18\xe5\x49\x16\x30\x11\x2c\x38\xe8\x90\xd1\x79\x59\x88\x31\x56\x80\xac\x7a\xad\x1f\xcf\xe2\x77\xef\xe1\x48\x3e\x59\x0c\x52\x47\x22\x2d\xd7\xf7\x7f\x25\x56\xd0\x6b\xde\x81\xee\x03\xa7\x02\xed\x71\x66\x0c\xe7\xdb\x70\xb1\x76\xe8\xa9\x77\x0a\x83\xf3\x7e\xe6\x2c\xbc\xac\x23\x8b\x8e\x5b\xbc\xcf\x37\x41\xd6\x8e\x7a\xfb\xda\x90\xe3\x17\xe8\x82\xa3\x74\xb6\x41\xd0\xdc\x2c\x92\x85\x23\xd1\xc0\x51\x63\x29\x0b\xc1\xae\xbf\xe6\xba\x49\x35\x41\x9a\x59\x2e\x1e\xf5\x62\xa8\xe5\xfa\xe7\x45\x3c\xb7\xca\x91\xa7\x8a\x93\x27\xe3\xdb\xba\x61\x9f\x82\xe2\x96\xcc\x36\xa4\xf3\xcb\x31\x4d\x0a\xf8\x52\xc7\x56\xd3\x11\xbe\x6d\x51\x91\x29\xdb\x89\xb2\xbf\x76\xf4\x67\x75\xed\x65\xf5\xf7\xb0\x1c\x03\xdb\xde\x34\xbd\xc9\xf2\x87\x38\xfb\x17\x67\xb9\x9c\x69\x43\x24\x84\x10\x87\x68\x88\xbe\x06\x00\xb4\x44\x57\x5e\x83\x02\x00\x00")
 
 func rpProductionSubscriptionJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -371,7 +371,7 @@ func rpProductionSubscriptionJson() (*asset, error) {
 	return a, nil
 }
 
-var _rpProductionJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x69\x73\xe2\xc8\x96\xf6\xf7\xfb\x2b\x1c\x7e\x27\xc2\x5d\xef\x94\x6d\x49\x40\xd9\xba\x11\xf7\x03\x08\x04\x12\x20\xd0\x2e\x74\xe7\x46\x87\x96\x44\xc8\x4a\x2d\xad\x05\x0c\x13\xfd\xdf\x27\x52\x0b\x8b\x59\xbd\x54\x75\x75\xb7\xe9\x88\x6a\x8c\x32\x4f\x66\x9e\x3c\xe7\x39\x4f\xae\xfa\xdf\x7f\x5c\x5d\x5d\x5d\x5d\xff\x57\x62\xcd\x80\x6f\x5c\xff\xf3\xea\x7a\x96\xa6\x51\xf2\xcf\xfb\xfb\xe2\x97\x3b\xdf\x08\x0c\x07\xf8\x20\x48\xef\x8c\x55\x16\x83\x3b\x2b\xf4\xcb\x67\xc9\x3d\x81\xe1\x8d\x5b\x0c\xbf\xc5\xf0\x7b\x1b\x44\x30\x5c\xa2\x74\x12\xf0\x23\x68\xa4\xe0\xee\x29\x09\x83\xff\x77\xfd\xb5\x28\xc1\x0a\x83\x14\x04\xa9\x02\xe2\xc4\x0d\x03\x54\x10\x7e\x87\xa1\xff\xaa\x04\x91\x11\x1b\x3e\x48\x41\x9c\x5c\xff\xf3\xaa\xa8\x56\xfe\xbb\x61\xc5\x02\x48\xc2\x2c\xb6\x00\x63\xef\x3c\xca\x1f\xa7\xcb\x08\x20\x69\x49\x1a\xbb\x81\x73\xbd\x7e\xf8\xfb\xd7\x2d\x11\xb6\xef\x06\xcd\xc8\xa5\x8c\x56\x16\xd8\x10\xbc\x53\x0a\x74\x41\x90\x52\x20\x4e\xa9\xd0\xf7\xc3\x80\x33\xfc\x37\x4a\xb4\x8d\xd4\x30\x8d\x04\x34\x2d\x2b\xcc\x82\xf4\x1d\x82\x42\xdf\x70\xdf\x51\x11\xf0\x9c\xc6\x06\x15\x26\x7e\x98\xb4\x5b\xcc\x38\x39\x2b\xe5\xeb\xee\x53\x1b\x4c\x8d\x0c\xa6\x8a\x01\xb3\x3c\xd5\xe1\x52\xa6\x91\x08\xe2\xb9\x6b\x81\x71\xec\x06\x96\x1b\x19\xf0\xad\x1d\x3a\xcd\x20\x6c\xe7\x06\x77\x3c\xbf\x19\x86\xf0\x4c\x3d\xa7\x06\x4c\xc0\xc1\x02\x3c\xb0\x9c\xa3\x94\xe3\x18\x4c\xdd\xe7\xb7\x55\xd2\xb7\x7d\x3a\xce\xad\xde\x96\x63\xf8\x56\x19\x89\x4d\x85\xc1\xd4\x75\x36\x8e\xf3\x46\x31\x9d\x60\xee\xc6\x61\x80\x3c\xf4\x6d\x42\xe2\x88\xf1\x0d\xe7\x8d\x16\x16\x47\xc3\xd0\x3e\x9f\xf7\x4d\x76\x15\x7f\x98\x5d\x25\xc9\x6c\x9c\x99\xd0\xb5\xfa\xe0\x84\x65\x9d\x94\x90\x86\xb1\xe1\xbc\xdf\xa3\x93\xcc\x4c\xac\xd8\x8d\x52\x37\x0c\x2a\xf8\xeb\xc6\x61\x16\xbd\x5d\xe4\xdc\x17\xdd\xd5\xbb\xbb\x40\x4c\x8d\xc0\x36\x62\xfb\xd7\x36\x91\xfc\x3a\xaf\x1d\x2b\x2a\x49\x5e\x59\xd1\x7f\x6c\xc9\xb8\x8e\xcb\x16\x23\x20\xfa\xf7\x3a\xcd\x0b\x51\x89\x97\xed\xc9\xcf\x1f\x04\x45\xc9\xeb\xaa\x5e\xef\x24\xf9\xfd\x45\x03\xa3\x38\x8c\x40\x9c\xba\x60\x1f\xf5\x8a\xe7\xb9\x41\x30\xe3\x26\x84\xa1\x65\xa0\xfe\x18\x82\x74\x16\xda\x65\x09\xa9\x6b\x9d\x96\x5f\xd5\x26\x8e\x6e\x23\x37\x7a\xa9\xde\x4a\x1f\x43\xd7\x8a\xc3\x24\x9c\xa6\x77\x1c\x48\x17\x61\xec\xdd\xaf\xcb\xb5\xed\x18\x24\x09\x48\x5e\x66\xad\xaa\x83\xb2\xff\x3b\xde\xb6\x91\x5f\xbe\xdc\x55\x0f\xff\xf3\x32\x97\x15\x06\xb6\xbb\xce\xb6\x09\xba\xbf\xdc\x6c\x40\xf5\xe6\xcb\x5e\x36\x23\x72\xb7\x42\x37\x81\xe1\xe4\x2d\xf6\x70\x8b\xe1\x07\xfb\xff\x8f\xe9\xa8\x69\x09\xb6\xcc\xb8\x40\xcc\x2c\xce\x35\xb0\x6b\x43\xdb\x9f\x7d\x19\x97\x96\xb5\x9b\x76\xb7\xa3\xce\x66\xc8\x33\xb9\xf6\x4e\xb7\x31\xf6\x2f\x37\x17\x98\xc0\xcd\xd7\xab\x9b\xc2\x8e\xf6\xbb\xe8\x60\x31\xa9\xe1\xa0\x0a\x05\x19\x84\x27\x13\xff\x7e\xf4\xe9\xef\xc7\x4b\xd9\x36\xed\x4a\xf9\xd7\x07\x53\xef\x8b\xff\xcf\xbe\xd8\x6b\xd3\xb0\x3c\x10\xd8\x65\x6b\xc7\x61\x08\xdf\xd4\x77\x5b\xb5\x2a\x25\xbe\xa7\x52\x30\x34\xec\x96\x01\x8d\xc0\x72\x03\x47\xc8\x20\xf8\xee\xf6\x74\xc4\x8e\x3f\xd0\xae\x36\x6d\x02\x71\x72\x7f\xcc\x6f\x4a\x63\x83\x66\xf9\xa5\x4a\x87\x4c\xef\xb4\x31\x9d\x36\xcc\x03\xfd\xfc\xdd\xda\x76\xc0\xa4\x5e\x36\xab\x4c\xf2\xee\x56\x45\x71\x68\xee\x07\xbc\x8f\x6a\x48\x2e\x7d\xaf\xee\xf9\xaf\x1f\x51\xf3\x34\xb4\xf2\x5e\xb8\x96\xac\x97\x21\x6a\x2f\x39\xaa\x58\xdb\x45\x01\xdc\xcc\xaa\x40\xd2\x2e\x58\xc2\xb9\xac\x95\x09\x8d\xc3\x18\x91\xd0\x7a\xbd\x76\x99\xa9\x6c\xd2\xbf\x17\xa7\xa0\x19\x67\x10\xbc\x07\x10\x8a\x9e\xf8\xee\x41\x65\xab\x4f\x7a\x68\x44\x7e\x4e\xb5\xd1\xa5\x2a\x0d\x32\xdf\x04\xf1\x68\x3a\xae\xda\x41\x9c\xc9\x10\x83\xdf\x32\x90\xa4\x63\x23\x9d\xa1\xda\xdc\xcf\x80\x01\xd3\xd9\xea\x3e\x06\x86\xbd\x3c\x6e\x79\x17\x76\x48\xe1\x36\x97\xf6\xc7\xa5\x4c\x0b\x9a\x97\x13\xad\x1d\x47\xfb\x39\x49\xd6\x1e\x27\x8f\x40\x60\x27\xa3\xe0\xa0\x15\xbe\x93\x55\xfc\xe3\xb0\xc2\xdf\x41\xef\x76\x14\x50\x8c\x42\x0e\xd3\x97\xeb\xd4\x05\xf1\x0e\x21\x3c\x90\xc6\x32\x22\xc3\x72\x53\x34\x3e\xab\xbd\x8b\x2f\x66\x91\x13\x1b\x36\x18\x87\xd0\xb5\xf6\x47\x7b\xeb\x64\x7e\x31\x6e\xbd\x1e\x1a\x41\x66\xc0\x7d\x4b\x3d\x60\xe7\xd7\x73\x37\x4e\x33\x03\x0e\x0d\x6b\xe6\x06\x60\x1c\x87\x53\xf7\xc0\xac\xd3\x3a\x79\x98\x9c\x4b\x52\xb4\x3c\xf4\xa3\x2c\x05\x31\x1a\x59\xad\x27\x26\xae\xff\x6d\x85\x81\x65\xa4\xbf\xa0\x2e\xbc\xf9\x7a\xb5\xab\xeb\x62\x18\x76\xf3\xe5\xeb\xd5\xcd\xed\x69\xca\x58\x4c\x6f\xc9\x09\x88\xab\x6e\xb3\x60\x98\xd9\xb7\x59\x02\xe2\x53\xd9\xa0\x1b\x64\xcf\xaf\x23\x2a\xd7\xb6\x9b\x18\x26\x04\x63\x23\x49\x16\x61\x6c\x37\xb3\x74\x06\x82\xd4\x5d\x7b\x5a\x1a\x67\xe0\x0c\x28\x25\xc9\xec\xb2\x58\x1b\x55\xe3\xf9\xe3\x90\xfd\xf2\x73\x5e\xea\x46\xfa\x1a\x14\x43\x1f\xdc\x6f\x34\x76\x7f\x97\x24\xb3\x7b\x23\x4b\x67\x61\xec\xae\x80\xfd\xab\x87\x2a\x70\x9e\xb0\xaf\x05\x7b\x60\xd9\x36\x52\x63\xcf\x7d\xb6\x67\x28\xce\x06\xfe\xea\x73\x9c\xdd\x57\x9f\xff\xbc\x75\x74\x70\x18\xb8\x0f\x37\xb4\x9a\x1c\xb9\xc8\xd8\x5d\xdf\x70\x80\x00\xa6\x20\x06\x81\x75\x9e\x57\x15\xfd\x9c\xcc\x0a\xfc\x10\x80\xdd\x33\xce\xb2\x91\x70\x3a\x2d\x93\xf7\x3a\x83\x73\x89\x0b\x94\xbb\x7e\xb8\x1d\x28\xc3\x73\x69\xe7\x1b\x10\x7f\xb8\x23\xef\x08\x8c\xc0\x70\x0c\xc3\xf1\x6f\x6f\x8b\x96\x61\xd2\x76\x13\xef\xbc\x0a\xac\x18\x18\x29\x18\x45\x55\xe0\xa1\xe3\xd0\x2f\xa6\xec\xce\xd4\xb7\x98\xe3\xb7\x2f\x2a\xe5\x6a\x7f\x96\x4b\x2a\x43\xeb\x38\x06\xbe\x9b\xf9\xbf\x0e\x04\xf1\x0c\x21\xfd\x20\x7b\x0a\x8a\x88\x76\x91\x3d\x15\xac\x65\x7c\x11\x49\xff\x91\x04\xfd\x24\x4d\x2a\x4a\x62\x82\x14\xc4\x53\xc3\x02\x17\xce\x6b\x54\x9f\x0b\x3a\x72\x8b\x32\xa1\x60\x71\x1b\xb8\xd6\x25\xd3\x0a\xaf\x60\xb2\x5b\x79\x5c\xdf\x88\x97\x17\xc1\xfb\x3a\x93\x1b\xbd\xb2\xcd\xdb\x9f\xcb\x71\xfc\xea\x90\x2e\xdc\xc8\xca\xcb\x7e\x05\x6c\x5f\xbd\x51\x39\x3b\xf9\x93\xcc\x0c\xc0\xfe\x1c\xfd\xc5\xf9\x2f\x32\xde\x92\xa1\x94\x7f\x26\xf7\x45\xa1\x95\xfd\xce\x03\x90\x96\x5f\x8b\x07\x17\x87\x9a\xed\xcf\x99\x71\xe7\xc1\xca\xbf\xc5\x4a\x76\x05\xec\xb2\xdb\xd7\x4f\xa1\x1c\x94\xfa\xd2\x36\x22\x37\xfa\x31\xfa\xd8\x06\x99\xd6\x2b\xe6\xc7\xce\x7d\xde\xa6\x87\xab\x3f\x7e\x1a\xe6\xd8\xe7\x3c\xc7\x79\xf9\x39\xcd\x79\xde\x2e\xff\xb2\x94\xe7\x4b\x3f\x2d\xe7\xf8\xd3\xc3\x92\x8f\xc5\x50\xdb\x35\x9c\x20\x4c\x52\xd7\xba\x6c\x10\x62\x86\x61\xda\xde\xe4\x39\x1f\x4b\x41\x80\xb8\xbe\x7d\x21\xad\x2f\x88\x85\x1c\xbb\x3b\x43\x9b\x6a\x4f\xc2\x8b\xf1\xcd\xfe\x62\x5b\x3e\xd2\xb9\x33\x61\x68\xde\x59\x61\x0c\xee\x16\x6e\x60\x87\x8b\xe4\x2e\x00\xe9\xfd\xe9\x30\xfc\x2a\xa5\x81\xe7\x14\x04\x88\xe2\x5d\xa4\xb2\x75\xea\xef\x12\xb2\xad\xe4\x1c\xbf\xbb\x7a\x73\xc8\xde\xe6\xd4\x1b\x1f\x6f\xe6\xfb\x40\x3a\x9b\x56\x5d\x18\xcc\xab\x29\x18\x2a\x4b\xd2\xd0\x17\xf3\xe5\xcd\xd7\xe4\xed\x19\x81\x0d\x41\xbc\x3d\x45\xb2\xde\x3e\x72\x56\x80\x91\xa5\xa1\x5c\x8c\xf8\x87\x6e\x10\x6e\x49\x79\x05\x1d\x49\x40\x9a\xba\x41\xbe\xa4\xf2\xbf\x17\xa2\x79\x3e\x93\x07\xac\x14\xd8\xe2\x56\xe6\xcb\x07\x82\xc5\x2a\x70\xee\x0d\xa6\x91\x80\x6f\xf5\x5f\x4a\xa7\x28\xfe\x92\x42\x31\x5f\x49\xfd\xe5\xc6\x22\x14\x8c\xa1\x70\x08\x9a\x61\xff\xe6\xcb\xd7\x9b\x61\x7b\x48\x0b\x23\x4e\xea\x70\x6d\x59\x18\xfc\xeb\xbf\xca\x0c\x57\xb7\xf6\xd5\xff\x64\x18\x56\xb3\xb6\xff\xbd\xb9\xb9\xf9\x5a\x8a\xdf\x76\xb0\xdd\x2d\x0c\x37\x5f\xbe\x7c\xbd\xb9\xb9\xf9\xf2\x3f\xc1\x0d\x12\x2f\xb6\xa9\x11\x47\x33\x5d\xa5\x23\x88\xcc\x88\x7b\x6b\x09\x2f\x36\x38\xec\x15\xd2\xe1\x14\x46\x18\x71\xc3\x0e\x27\xbd\xbd\x88\xad\xcd\x0f\x3b\x05\x34\x29\x41\xe8\x88\x23\x59\xa0\x3a\x4c\xfb\x6d\xe2\x77\xf6\x27\xed\x08\x6f\x8f\x86\x4d\x86\xe3\x9a\xc3\xce\xdb\x24\x6f\x76\xf5\xec\x88\x15\xc6\xcc\xb0\xd9\x7d\xa3\xcc\x72\x13\xc7\x0b\x81\xc3\x51\xfb\xcd\xf2\x86\xa1\xbd\x2b\xae\xd9\x1e\x32\x5c\x73\xcc\x50\x03\xa6\xc3\x49\x54\x47\x90\xa8\xd1\x70\x38\x7a\x87\x22\x4e\xed\xbc\xda\xd5\x78\x53\x6a\xb6\x9a\x62\xa7\x49\x51\x23\x99\x93\xde\xa1\xfa\xfd\x9d\x59\x3b\x05\xf5\x3b\x13\xa5\x29\x0f\xa4\xb1\xd0\xa1\x19\xed\x6d\x65\xec\xee\x31\x3a\xac\xc2\x66\x4b\xe6\xda\x83\xce\xbf\x90\x88\x83\x1a\x29\x77\xb4\xdd\xe4\x99\x4b\x9f\x19\x16\xf6\x71\x73\x73\xef\x80\x00\xcc\x0d\xdf\xf6\xff\xe9\x1b\x49\x0a\xe2\x5f\x1b\x78\x99\x6a\x30\xa2\x9a\xd2\xab\xbc\xf6\xd8\xe4\xf7\x56\xb5\x45\xb9\x25\x52\x02\x33\x46\x82\x5f\xe3\x4e\xdb\x9b\x5d\x7e\xf9\x72\xb7\xfd\x27\x63\x6f\x1b\x6a\xe9\xaa\x5d\x61\x24\x8f\x5f\xd7\xb9\x2f\x6b\x8f\xa2\xe8\x96\x64\xf4\xcf\x4b\x40\xa5\x02\x88\x9b\x62\x33\x05\x62\x0b\xb7\xba\xc2\xcc\xee\xca\xce\x40\x73\x1c\x05\xa3\x87\x86\xda\xc0\x41\x87\x0e\x74\xb5\x81\x51\x4e\x94\xd8\xbe\x52\xb7\xbb\x4a\xa6\x53\xcd\xd4\xa4\x9a\x31\x27\x35\xa1\x00\x59\x5a\x10\x9b\x73\xbd\xab\x10\x83\x1a\x3b\x37\x6b\x02\xa1\x2f\xc9\xa5\x49\x90\x98\xd9\x9b\xf4\x41\x57\x5f\x69\x84\xbd\x34\x6b\xb6\x6f\x2d\x9b\xf3\x43\x72\x86\x52\x73\xc1\xca\xba\x28\xc8\xb2\x33\x20\x04\x68\xbb\x45\x7e\xdb\xb7\xe6\xb6\x4f\x2f\x0f\xc9\x41\xbf\x53\x4e\xf8\xc4\x74\x69\xc2\x24\xa0\xc7\x50\x2c\xb4\x02\x76\x6e\x3d\x85\x8e\xde\x65\x70\xa6\xab\x2c\x2d\x9f\x5c\xf6\x29\x6c\x35\x6c\x7b\xc4\x48\xf4\x1c\x3d\x60\xe7\xa6\xd8\xf2\x26\xbe\x92\xd9\x2e\xf6\xdf\x66\xad\x05\xcd\xa7\xd0\xe1\x3d\x81\x1a\xb6\x9b\x8d\xa1\xd8\xea\xf0\x90\x54\x05\x85\x95\x44\x99\x1c\x69\x18\xce\xca\x18\xde\x52\x3a\x1c\x33\x72\x5b\x9d\x89\x26\xcc\x26\x3e\xbd\xd2\xc5\x16\x34\x03\x3d\xb2\x7c\x32\x33\x55\x25\xb3\xa9\x16\xa1\x6b\xec\xca\x50\xc9\x8c\xe9\xe2\x91\x45\xe0\x33\xbb\xcb\x85\x8c\x13\x2d\x91\x6e\x75\xb7\xa8\xef\x80\x78\x8e\x26\x2e\xb9\xb4\xba\xd8\x5c\xc3\x49\x6f\xe2\x86\x7d\x2a\x60\x17\x28\xcd\x40\x85\xa9\xd5\x25\x97\x36\xd5\x0a\xed\x9e\xb0\xb0\x56\xe1\x7c\x40\x08\xc9\xc0\xd7\xa1\xde\x25\x97\x13\xad\xb5\x34\x89\x08\x4e\x6a\x7c\x66\xd6\xd8\x60\x50\x6b\xe1\x13\x97\x84\x56\x57\x49\x06\x38\xcb\x4b\x22\xde\x93\x3b\x56\x2a\x62\x8a\x3e\x90\x15\x5e\x90\x17\x29\xb7\x88\x50\x59\xce\x40\xc4\x23\x53\x6b\xcd\xad\x80\x77\x8c\x9e\x80\x59\xbd\xe1\xb7\xc1\x92\x5c\x4c\x54\x2e\x9e\xa8\x36\xb4\x96\x8d\xd4\x50\xb9\xa5\x59\xe3\xe6\x7a\xc0\x67\x13\x82\x4c\x07\x44\x0a\x81\x36\x9c\x9b\x2a\x7c\xb2\x7c\x72\x65\x12\x3a\x36\xf0\xe9\xd5\xe4\x72\x99\xbe\xd9\x53\xa0\x19\x08\xae\xa1\xf1\x99\xa1\x3e\xce\x75\xff\x19\x47\xb6\x34\xf1\x21\x36\xf0\x53\x08\xf8\xb0\xaf\xfb\xe4\x92\xe9\xd2\x98\xdd\x55\x52\xab\xc7\x3b\x86\x5a\x77\xc0\xaa\x93\x0d\x9e\x14\x72\xb4\x6c\x79\xe6\x22\x74\x98\xde\xda\x46\x23\x33\xe0\xb0\x89\xfa\x9c\x30\xdd\x19\x66\xf7\x5a\xab\x91\xfb\x38\xd7\xbb\x8b\x4c\xf7\x15\xcf\xac\xb1\x33\xab\xc7\xce\x0d\x5f\x79\xb2\xa9\xc6\xdc\xf2\xad\xb9\xd5\x53\xdc\x01\xa1\x2c\x74\x75\x31\xd7\xb5\x16\x34\x29\x7c\xa9\xab\xcf\x70\xa2\x71\x70\xa0\x3e\xcf\xec\xae\xb2\xb2\x29\xac\x36\xf0\x1b\xf3\x89\xc6\x3e\x19\x54\x23\x6f\x1f\xeb\x4e\x9c\x49\xc0\xc2\x89\x9a\xf4\x19\xaa\x15\xe9\x6e\xcb\x54\x97\x4d\x0f\x10\x55\x5d\x05\x92\xa1\xf0\xc4\xa6\x9a\x38\x43\xe3\xf6\x68\xd9\xc2\x8c\xae\x92\x31\x3d\x2e\xd1\x55\x65\xc1\xb4\x3b\x8b\xd1\xb2\x05\xcd\x1e\x07\x99\xae\x52\x37\x34\xde\x19\x4a\x89\xa3\xfb\x5e\x5f\xef\x92\x99\xce\x87\xfd\x09\x41\x63\x4c\xbb\x3e\xd7\x35\xe1\x69\x50\x43\x6d\x6c\x2c\x75\xa4\xd3\x65\xc3\x1b\x10\xf4\x37\x5b\x63\xe1\x20\x60\xa1\xd5\x7d\x74\xc6\xed\x45\x20\xc8\x64\x97\x5d\x44\xe6\x44\x8b\x70\xcb\x97\xd3\x09\xf1\x1c\x69\x7c\x94\x4d\x54\x1c\x8e\xd5\x32\xbd\xca\x25\x06\x1f\xb9\xa8\x7d\xb6\xc6\x26\x63\x75\xa3\x27\xab\x4b\x3f\x19\x04\x1d\xe8\xda\x30\xdb\xed\x57\x6e\x6e\x8a\x64\xc3\x56\xf1\xb2\x7c\x72\x06\x02\x65\xa9\x8b\xf8\x93\xd9\xf5\xfa\xba\xda\x98\x4d\xfc\x67\xa8\xb7\xf1\x86\xae\x0d\xfb\x7a\xad\x15\x4c\x88\x19\x9c\x10\x09\x09\x54\x65\x45\x39\x55\x9d\x94\x27\xb3\xc6\xc2\x97\x75\x9a\x10\xe4\x52\xff\xa8\x3a\xa9\xdc\xdc\xf2\xe5\x93\x75\x32\xfd\xc7\x3e\xd2\x15\xe5\x44\x4f\x13\x8d\x77\xc6\x2e\x09\xed\xee\x70\x0e\x34\x25\x2d\xf4\x49\xae\x06\x3e\x3f\xb7\xbb\x7c\x8a\x6c\xd5\x0c\xf8\x34\xb7\xc9\x03\xba\x7e\x99\x66\xdd\x36\x4d\xf0\x06\x6a\x81\x8d\x03\x95\x8d\xec\xe6\xf9\xf6\xed\xda\x3f\x9c\x0f\x08\x0e\xf9\xc7\xdc\x5a\x3e\xd6\x28\x5f\xc9\x26\x2a\x9b\xe8\x2a\x5f\xe8\xd4\xb7\x17\x3a\xc1\x85\xba\xca\xc5\x63\x0d\x42\x6b\x11\xd1\x12\x36\xe9\x53\xbe\x3e\xb7\xdc\xd6\xcc\xee\x09\xd0\xd4\x5a\x18\xd3\x85\x19\xd3\x4b\x9e\x07\x6e\x1d\x9f\x22\xfb\xea\x3e\xf6\x51\x3b\x19\x0a\x6f\xa0\x67\x56\x4d\x98\x99\xdd\x85\x33\xd1\xa2\x95\xae\x0e\x91\xcd\xcc\x4c\x95\x26\x98\x2e\xfd\xcd\x22\x94\xa7\x81\x8a\xcf\x4d\x1f\x62\x66\x8d\x71\xb6\xed\x6a\x20\x31\xd9\x50\x6a\x66\x43\xb1\x55\xd9\x42\xaa\xf7\x38\x0f\xe5\xcb\xed\x4c\xe3\xe0\xa4\xa6\x2c\x0d\x4d\x68\x30\x5d\x61\x3e\x21\x52\x68\xb9\x2d\x4c\xa7\xf0\x99\x4e\x20\x4c\xc4\x91\xdf\xff\x10\x3f\xb2\x82\x16\x6a\x2f\x64\x28\x3e\x2c\xbf\x3f\x4f\xc4\xd6\x23\xd3\xb5\x97\xba\xd6\x74\x34\x9f\x76\xad\x80\x4f\xfb\xfc\xae\x3d\x58\x35\xb8\x9a\xd4\x10\xc6\xf2\xf3\x61\xbb\x93\xea\x5d\xb8\xca\xfb\x00\xd9\x7d\x8d\x85\xb9\x0d\xfa\x93\x6d\x7b\x88\x75\x8d\xcd\x74\x75\x91\xe5\x36\xad\x90\x8b\x89\x26\x60\xe8\x37\xa6\x8d\x39\x53\x8a\x74\x0d\xb5\x3e\xb7\x7b\x2c\xae\xf3\x45\x7f\x55\x65\x30\x14\x96\xa2\xef\xa8\xcd\x94\x13\xf9\x86\xc6\x42\x9b\xa0\x13\x93\xc2\x9f\x4c\x95\x47\x78\x3a\xd3\xbb\x7c\x11\x03\xda\x18\xc6\xb5\x87\x73\xbb\xcb\x2d\xf2\x7c\x5d\x65\x69\xaa\x74\x56\xc6\xe1\x9d\x36\xec\xd9\x70\xed\x85\x5d\x52\x8d\x27\x93\x68\xf8\x4c\x7b\xf1\xc8\x62\xca\x58\x70\xad\xbe\x8a\xc1\x91\x4c\x2b\xb2\xc6\x87\xac\xe4\xd3\xa9\x2e\xb6\x56\x40\xe3\x30\x5d\xc5\x3d\xca\x81\xf2\x44\xb5\x1c\xc3\x27\x71\xcb\x6f\xcc\xcc\x2e\xdf\xa7\x14\xae\x61\xd5\x04\x68\xaa\xc2\x54\xf0\x61\x62\x77\x95\x25\x43\x93\x6d\x09\xc3\xb9\xb1\x4a\x2f\xcd\x45\xd8\x57\x31\x9d\x95\x68\x81\x96\x21\xd6\xa7\xe4\xc6\xcc\x54\x65\xc7\x54\x49\xcf\x50\xf5\x06\xe5\x40\x6e\xa2\x09\x4f\x06\xd5\xfa\xcd\xac\x29\x4b\xd3\xa7\x13\xbd\x19\xb2\xb2\xaf\xa4\x66\x4d\x87\x5a\xcd\x8e\xcc\xae\xf0\x34\xd1\x58\x8f\xa1\x1f\xfb\x94\xc2\x42\x53\x25\x09\x5d\x6c\xc9\xa2\x8c\xd3\x32\x2e\xb4\x24\xa5\xd9\xa7\x60\x3a\x56\x14\x81\x57\x14\xc1\xa6\x1c\x38\x42\x7e\xc8\x74\xf5\xb9\x15\xd8\x33\xcb\x47\xf5\x2c\xe2\xd1\xf0\xc9\x5b\x0e\x57\xcd\x0a\x03\x66\xc0\x6d\x25\x26\x61\x47\xa6\xdb\x4c\x0d\x3e\xff\x7b\x36\x21\xb8\xb9\xad\x36\x30\xa6\xc7\x41\x9b\x6a\xa6\xd6\xb2\xe9\xb2\x34\xa7\xf0\x90\x6b\xcb\x1e\xe4\x95\x0e\x1c\x4b\x1e\xec\x30\x4e\xd8\xaf\xfa\x2d\xef\xc7\x1e\x87\x4d\x34\x16\xdb\xd8\x7c\x63\xa5\x6b\x2c\x61\xa8\x1c\xa4\x7c\xfa\x1b\xd3\xa5\x9f\xac\xad\xf2\x06\x6a\xa1\x0b\xc6\xe5\x43\x8b\x50\xbc\xc2\x4e\xed\xd5\x94\xaa\xff\x36\xa8\x3d\x3f\xb2\xcb\xe6\xe3\xb8\xbd\x70\xd9\x0e\xdd\x96\x21\x4b\xcb\x18\xa9\xc8\x1e\x47\x8b\x32\xef\xf6\x45\xa6\x4f\x79\x78\x47\x92\x21\xc7\xcb\x36\x3d\x16\x19\x0f\xe0\x2c\x2f\xca\x78\x4b\xc0\x64\xc8\x8a\x8f\xbf\x4d\xc5\x47\x0f\x60\x9b\x34\xcc\x72\xf8\xdb\xa0\x86\xb9\x94\xbf\xf6\xc9\x85\x8d\xe2\x25\xc5\x78\x92\x2c\x70\x65\xde\x83\xcf\x65\xd8\x62\x25\x99\xee\x09\xa8\xdc\x0b\x70\x86\xa1\x5a\x48\x97\xb1\xae\x41\x62\xa2\x29\x89\x4d\xb5\x56\xba\xca\x2d\x75\x8d\x77\xf4\x2e\x59\x33\xfd\xe7\xf9\xa4\xb0\x6d\xdf\x50\x9f\x21\x43\x15\x36\x6b\xaa\x42\x3a\x08\x5a\xb0\xe4\x3d\x88\xbb\x6d\x38\xcf\x52\x18\x08\x0a\x54\x79\x45\x19\x2a\x74\x4b\x14\x64\x9d\x55\x29\x7c\x65\xfb\xc3\xcc\xf6\x69\xdc\xec\xf1\x59\x89\x53\x99\xe9\x2b\xd8\xa0\x86\x70\x88\x85\x76\x6f\x38\xb7\x82\x66\x8a\x64\x33\x6e\xd3\x67\xdd\x96\x6b\xf9\xca\xcc\x40\xfc\xa1\x0b\x7d\x86\x4e\x4d\x86\x12\x1e\x72\x7f\x55\xf1\x85\xdd\xc3\x9c\x81\xfa\x8c\x31\x6d\xd9\xd1\x14\xec\x81\xe9\x09\xa1\xae\xd6\x1d\x8b\x78\x86\x08\x33\x86\x52\xf3\x81\xe9\x2a\x89\x45\xc8\x8e\xae\xcd\x22\x9b\x6a\x3e\x8f\x96\x2d\xdf\xe0\x23\xcf\x24\x1a\x90\xf2\xb9\x10\xd9\x37\xd3\x6e\x12\xc3\x76\xd3\x19\x10\x0a\x36\x59\x92\xa9\xde\xc5\x32\x0b\xf9\x78\xc0\x41\xc4\x6f\x0d\xb1\x99\x9a\xbe\xec\xb0\xab\x4e\x32\xc0\x94\x91\x40\xb5\xda\x82\xc2\xca\xa2\xac\xb3\x3c\x46\xcb\x82\xf8\xe8\x58\x94\xb5\x9f\xbf\xb0\xdb\xb7\xe8\x76\xa5\x53\x8d\x85\xae\x16\x1c\x4d\xa7\x9a\xee\x86\x9f\x3d\x7a\x88\x1f\x2a\x1e\xad\x48\xb4\xc0\xcb\x9e\xd2\x15\x15\x27\xb5\x6a\xfa\xd3\x20\xd0\x67\xb6\xfa\x8c\x38\x5e\x81\xef\x7e\x03\xda\x14\x59\x96\x29\xac\x06\x35\x76\x31\x50\x71\xcf\x22\x78\x37\x6f\x7b\xcd\x46\x18\xdd\xb0\x88\xe7\xb9\xbe\x8a\x10\x7e\x24\x26\xb1\xd5\x8e\x1e\xe7\xe5\x7d\xcc\x47\x4f\x46\x17\x9f\xeb\x54\x73\xc1\x3d\x35\x17\x07\xea\xd9\x2f\xfc\xe6\x79\xae\x13\x24\x6e\x37\xc3\xbe\xa9\xa6\x9e\xa1\x31\x95\xac\x99\xe5\x3f\xa6\x56\xd0\xec\xe7\xdc\x5b\x9a\x60\x0c\x85\x7b\x08\xcf\xc6\x14\xe3\xf1\xb9\x4d\x37\x5a\x72\x07\xb6\x79\x99\x55\x24\x4f\x18\x0a\x22\xb3\xc6\xc7\x89\xc6\xce\x07\x1a\xbb\x18\x10\xb4\x67\xaa\x30\x1b\xa8\xdc\x6c\xa0\xb2\xb8\xe9\x0b\x89\x2e\x16\xe5\x4f\x88\xd9\xdc\x26\xea\xce\x40\x61\x50\xbf\x2f\x86\xed\xf0\x79\xd8\xde\xd4\xb5\x92\x41\x39\x51\x6a\x10\x42\x64\xb9\xcd\x92\xb3\x55\xb8\xc8\xcf\x2b\x8c\x18\x10\xb9\x8e\x32\x8b\x50\x96\xb6\x0f\x9f\x74\xb1\xe1\x51\x3e\x37\xb3\xa9\xe6\x7f\x97\xed\x59\x63\xed\x3a\xbf\x58\xe9\x8b\x83\x56\xa0\x47\x13\x42\xce\x74\x8a\x9c\xdb\x3e\x1a\xc3\x40\x4f\x17\x0f\x60\x38\xae\x64\x86\x26\xd8\x94\x47\xfb\x08\x8f\xc7\x6a\x03\xda\x3d\x7b\x6e\xf9\x49\x6a\x12\x8d\xc4\x50\x1b\x70\x10\x08\x33\xcb\xb7\xa1\xdd\xac\xb8\xc3\x4e\x3d\x3c\x5d\x2d\xfb\xbc\xa8\xb3\x33\x6e\x3f\xe7\xe9\x24\x59\x90\x04\x9a\x14\x25\xec\x99\xd6\xf0\xd2\x07\xdb\x58\x31\x86\xa9\xb1\xb8\xe9\x96\xf5\x25\x04\xa8\x17\x3e\x59\xe5\x19\xcb\xb4\xc0\x4a\x58\x43\x1a\x8b\x4c\xca\xa3\xb1\x0f\xc2\x56\xb7\x65\xb1\x1d\xbc\x23\x63\xc2\x54\xf6\xc8\xa1\xa0\x90\x95\xed\xb9\x94\x13\x41\xd0\x2b\x70\x5b\xc6\xb9\xa1\x86\x71\xb4\x0c\x85\xa9\xe0\xc1\xa1\x20\x61\xa5\x9e\x5b\xb1\x21\xa2\xf1\xe4\x70\x3e\x41\x7a\xcd\xff\xdf\x49\x27\x81\x92\xe9\xdd\x67\x14\xa7\x97\xc8\x6e\x74\x6d\xb6\x30\x6b\x2c\xc6\x74\x70\x84\xdd\xb2\x84\xb3\xac\xe4\xd9\x53\x01\xe3\x24\x0d\x53\x46\x8a\x07\x45\x09\x6b\x70\x82\xdc\x90\xc7\xa2\xe5\xe5\x75\xee\xec\xfe\xce\x2e\x36\xf5\x91\x64\x72\x24\x2a\xc2\x58\xf6\xe0\x48\xc0\xc9\x1e\x8f\x73\x53\x5e\xe6\xda\x12\xae\x8c\x94\x36\xde\x92\x3d\x52\x94\x3b\xcf\x73\xbd\x36\x3c\x5d\x36\xce\xd2\x02\x06\xc7\xd2\x13\x16\xb0\x9d\xe7\x31\xf2\x7b\x34\xbe\x3c\x5b\x16\xa6\x88\x0a\x4d\xe6\x78\xb1\xa3\x8f\xca\xc6\x10\x4e\xf8\x4a\xbd\x1c\x37\x72\x12\xd6\x60\x95\x0e\x29\x8a\x72\xa3\xa7\x61\x76\x5b\xc6\x8b\xbc\x1a\x96\xd2\xaa\xac\xb3\x52\x47\x26\x0f\xf8\xe7\xc9\x3a\x48\x1e\xcd\x09\x0a\xc7\xf3\x32\x47\x8f\x65\x5a\x94\xd0\x58\xb3\x4b\x06\xd6\xf1\xba\xb7\x25\xac\xd1\x15\x65\x7b\xaa\x78\x8a\x28\xaf\xdb\x9d\xf7\x7f\xf5\x6c\x3d\xc6\x3e\xa1\x03\x45\xc6\x94\xa9\x80\x30\x53\xd1\x5b\x1a\xc6\x8d\x25\x4f\x67\x05\x9c\x94\x04\x85\x55\x45\x54\x1f\x4d\x58\xda\xaa\xdc\x3f\xaa\x03\x5c\xa0\x25\x8f\x1e\x29\xed\x8b\xf5\x9e\xdb\xfc\x18\xf9\xfa\x51\x99\xec\x58\xea\x28\x53\x51\x6e\x48\x4a\x87\x1e\xf1\x98\x4c\xb2\x4b\x3e\x34\xba\xe4\xca\xee\xe6\xb1\x3e\x62\x17\x07\xfd\x0d\xf9\x7d\xee\xc7\x86\x6a\xe5\x31\x10\xf9\x73\xce\x51\xf2\x18\xaf\x23\x1b\x94\x05\xb9\xd1\x51\x14\x76\x58\xda\x27\x27\x40\x76\x2c\x41\xd4\x0e\x41\x91\xbd\x45\xb0\xcb\x07\xac\xed\x98\x5e\x3c\x53\xb8\xb1\xa2\xb0\x6d\x41\x3e\x60\xef\x1d\xb2\xcd\x2b\xc8\x3f\xeb\xbb\x69\x15\xd4\x26\x99\x44\x18\x8b\xe4\x55\x1c\x24\xf7\x55\x19\x8e\x72\xfe\xe5\x71\x79\x79\xfd\xee\x6c\x6e\xd5\x84\x7c\x0c\xd4\x17\xad\x8a\x63\xad\x71\xb1\x98\xe7\x50\x30\x7b\xb1\xcf\xb5\x11\x3e\xea\x54\x85\x7f\x58\xc9\x03\xd6\x98\xb7\x33\xee\x52\xd4\x46\x64\xd3\x58\x9f\x57\x75\x4c\xd7\x18\x52\xef\x92\x4f\x06\xa1\x2c\xb7\xd2\xf7\x65\x5f\x79\xb6\x55\xb8\xd4\xb5\xe1\xc1\xe7\x14\x4c\xa5\x8a\x9b\x69\x7c\x44\xef\xcc\xd9\x74\xf4\xc8\xec\xae\xfd\x61\xaf\x6f\x28\x4f\xa9\xeb\x2a\x27\xd9\x5d\x7a\x69\xd3\xad\xa5\x2e\x61\xe9\xa0\xa6\xac\x2c\xb7\xe0\xf8\x55\x79\x4c\x8f\x45\xe3\x0d\x9f\xa1\x94\xd1\x4e\x1e\x84\x97\x1a\xb7\x1c\x10\x6c\x64\xba\xa4\x67\x12\x5c\xac\x6b\x8c\x63\x05\x4a\xc6\xd0\x8b\x3e\x43\x21\xee\xa3\x64\x76\x8f\x6d\x58\x5d\x32\x32\x03\xde\x29\xe5\xaf\x26\x3e\xcc\x06\x18\x0e\xed\x1e\x1b\x4d\x6a\x1c\x0d\x10\x07\x0b\xb8\xc8\x24\xea\x8e\xd6\x0c\x1d\x34\x26\xd8\xb2\x37\x87\x95\x9d\xf5\xef\x66\xce\xe7\x9b\x50\x72\x5b\x16\xe5\x36\x11\x07\x58\x9a\x62\xf9\x5d\xc5\x9c\xa1\x6f\x95\x69\x71\x62\x8f\x8f\x49\xe1\x1a\x5f\xcc\x22\x36\x6e\xa7\x25\x26\x1a\x83\x9e\xd7\x46\xee\xd6\xf7\x20\x2c\xd3\x08\x9c\xd0\xc1\x4b\xfe\x58\x96\x27\xa3\xbe\x10\xba\x87\xb9\x5f\x95\x46\x5f\x9a\x44\x03\x13\xd4\x86\xa7\x68\x6c\x52\xca\xe9\xca\x1e\x39\x52\x3a\xca\x48\xa0\x15\x51\xa2\xaa\xb4\x88\x23\xd8\x88\xb3\xb6\x4d\xa2\xb1\x32\x89\x67\x58\xe9\x12\x61\x92\xd4\x55\x08\x5d\x5d\x38\x0a\x41\x2f\x4d\x1f\x66\xfa\xb2\xcc\xa7\xb4\x96\x86\xa6\xcf\xec\xae\x32\xd0\x35\x78\xba\x3e\x0a\x37\xb7\x35\xf6\x49\x97\x95\xcc\xf6\x21\xaa\x5b\x8a\xc6\x52\x65\xbd\x24\x09\x57\x44\x1e\x53\x68\x09\xea\x2c\xaa\xa3\x84\xfc\x8c\x7a\x91\x57\x61\xe7\x66\x57\x76\x10\xe6\xbd\xf0\xb1\x4a\x9f\x12\x1a\x07\x4d\x08\x45\x44\x6d\x10\xd5\xc6\xca\xee\xd2\x19\xf2\x81\xfd\x3c\x0a\x2b\x41\x4e\xe6\xe5\x46\x5b\xe0\x23\x1a\x74\x95\x27\xb9\x26\xcc\xad\x63\xf6\x85\x9e\x51\x4d\x28\x39\x91\xa8\x6b\xc5\x58\x65\xac\xd2\x89\x4d\xd0\x0d\x6b\xb1\xf9\x4d\x26\x94\xa7\xb1\xd4\xe9\xcb\x35\x61\x66\x05\xc2\xd0\x50\xf1\xc8\xee\xc0\x0c\x71\x09\xdb\xa7\x93\xb1\x94\x8f\xb7\xd8\x6a\xbe\x4d\xe3\x23\x6d\xa2\x36\x30\x5d\x15\x28\x20\xe1\x29\xe2\x0d\x86\x88\xe3\x56\xee\x73\x27\x79\xc6\x8e\x6f\x55\x5c\x6a\x07\xfb\x14\xb2\xc5\x63\xdc\x58\x41\x38\x25\x17\xfc\x53\xf6\x15\x4f\xec\xd2\x98\x54\x6b\x41\x13\x72\xa1\xa1\xea\x98\x0c\x0b\x7c\xd2\xb0\x46\x4b\x92\x15\x49\xee\xd0\x6d\x41\xc2\x45\xb9\x19\xb5\x78\x9c\x9d\xca\x9e\x52\xf5\xcf\x54\x94\x79\x92\x5d\x0a\xe8\x77\x51\x58\x63\x22\xec\xb0\x8b\xa8\x95\xdb\x29\x24\x11\x67\x9c\xf2\xd8\x33\x8b\xf0\xb1\xe2\x1d\x3c\x46\x72\x52\x3e\xf7\x8b\xca\x90\x73\x19\x79\x7a\x8f\xe6\x45\x99\x1b\x8a\xa8\xbf\x3b\x79\xda\x7c\xfc\x2b\x61\x8d\x11\x2f\xe3\x34\xbb\x88\x3a\xbc\x22\xb4\x78\x8f\x96\x84\xad\xf6\x6c\xc9\xd9\x3c\x97\xe9\x36\x8f\x91\x8a\x04\x85\x75\x5e\x09\xc3\x5b\xa2\xdc\x28\xe2\xad\x84\xe2\x95\x30\x96\x64\x9a\x95\xbc\x3c\x7f\x40\x79\x29\xad\x2a\x7a\x4b\x91\x9f\xe5\x2d\x4e\x16\xb0\x9d\xcd\xef\x32\xcd\xd2\x82\x07\x27\xec\x22\x12\xe5\xce\x26\x46\x6c\xc6\x75\x56\x5f\x86\xad\xa9\x24\x93\x9d\xbc\x0c\x9a\xe5\xf3\xef\x1b\x1c\x3f\x8b\xd9\x6b\x3e\xfd\x13\xe2\xf6\x9a\xa7\xff\x10\xec\xde\xc4\x41\x86\x52\x98\xcd\xef\x15\x26\x6f\xb0\xda\xf2\xd7\x38\x0a\x99\xce\xae\xbd\x57\xf9\x74\xb1\x85\xf0\x65\x87\x6f\x6d\x3d\x7b\x8d\xcd\x6e\x95\x25\xb4\x94\x0e\x4d\xf1\x0a\x47\x6b\xd8\xda\xe6\x8a\x32\xd6\x31\x41\x76\x84\x0e\xc9\xf1\x32\x3c\x90\xff\x80\xcd\x51\x9b\x7c\x6b\x5b\xda\x92\xc5\xcb\x9c\x88\xf8\x6e\xc5\x21\x34\x0c\x76\xaa\x76\x98\x62\x73\xb9\xc6\x64\xad\xe9\x70\x6d\x7e\x35\x7a\x72\x30\xae\x3d\xdc\x8b\x49\x55\x5f\x8e\x5e\x8c\xe3\x2a\x59\xb6\xdb\x9c\xa3\xfe\xd8\x1e\x83\xad\xe7\xac\xda\xe1\xf1\x67\x55\xfd\xb5\x89\x33\xa8\xe9\x33\x0b\xc9\xef\x59\xdf\xb6\xbf\x83\x32\x76\x22\xff\x28\xb9\x55\x51\xbf\xde\xb6\x6d\x91\x8b\xb1\x48\xe2\x56\x8d\x99\xe7\xf1\x9a\x58\xcf\xa7\xac\xd0\x33\x86\xc2\x31\xa6\x3d\xcc\xe7\x06\x58\xb9\xde\x57\xba\x30\xd5\x55\x12\xb7\x69\x0e\x33\x6b\x2d\x49\x57\x87\xe4\x70\x35\x59\x0c\x9b\x3f\x31\x4e\x6f\xcd\x0d\x6f\x8d\x0d\xb9\x8d\x2d\xf1\x24\x5f\xcc\xfb\x8b\xba\x2a\x30\x13\x4d\x18\x5b\x5d\x25\x93\x89\x59\xa4\x07\x02\xc2\xe5\x3d\x9b\x1e\xcb\x2c\x25\xf1\x51\x5b\xa2\x15\x49\xe9\x28\xa2\x86\x5d\x8e\xff\x3c\xf1\x8c\xa3\xfe\xb4\x9c\xbd\xfc\x39\x8e\xca\x78\xab\x85\xf8\x38\xef\xb1\x1c\xe5\x9d\xb0\xfd\x02\x5f\xd7\xcf\xd7\x63\xba\xce\x4f\x88\xb5\x9b\x3e\xd8\x9a\x53\x68\x3e\x8e\x29\x1b\xf5\x47\x40\xc1\x54\x31\x8b\x75\x89\x96\x1e\x08\xd0\x7a\xc2\x2b\xbc\xda\xa4\xe7\x91\x3d\xd1\xb8\xa1\xb1\xd0\x5a\x1d\x7a\x1e\xf6\x55\xbc\xc2\x5f\xc5\xa6\xbc\x5d\xee\x23\xf8\x30\xd1\xd7\x63\x71\xae\x61\x11\xdc\xdc\xf4\xf5\x48\x2f\xe7\x66\x4c\x95\xcc\x0c\x4d\x98\x5b\xce\x9a\x9f\x20\x5b\xe5\x2d\x5f\x26\x07\x87\x7d\xa4\x5a\x17\x45\x3c\x65\x2b\x0f\x4f\x1e\xc1\x69\xdc\xdc\xf0\xd9\xd0\xac\x71\x58\xc5\x73\x45\x6a\xfd\x7b\x85\xc5\xa3\x35\x16\x6b\x6c\xba\x85\xa1\x47\x6d\xee\x44\x9a\x6d\xbb\x7a\x35\xae\x6e\xcd\xb5\x4d\x2b\x7b\x79\x89\xf7\x6b\xdb\xdb\xc2\xd5\x3d\x8e\x23\x9e\xc2\xdc\x3d\x4e\xbf\xb4\xd5\xfa\xb6\x3d\xad\xe7\xdc\x47\xee\xf1\x67\xdb\xb8\xba\x1e\xc3\xad\xc2\xad\xef\xd1\xb7\x32\xcd\x96\x8d\x17\x75\xd8\xea\xff\x3f\x12\xcb\xc2\x09\x41\x66\x0c\x85\x8b\xf9\xbc\x24\xc2\x7d\x85\xc4\x47\x3e\xe9\xe6\xeb\xc1\x34\xb9\x1c\x05\xfa\xcc\x82\x64\x62\x12\xf6\xd4\x6e\x47\xab\x21\x55\xb5\x95\x5c\xd8\x14\xb9\xbd\x06\x3e\x37\xbb\x30\xb3\xb5\x59\x6a\x12\x8d\x62\x3d\xab\x59\xae\x55\xf7\x36\xbc\xc7\x50\xeb\xce\x4b\x9c\x5c\xc7\xa9\x2e\x8d\x9b\x04\xbe\xaa\xd6\xd9\x74\xaa\x35\x03\x01\x07\x27\x04\x4a\xcb\x3b\xc5\xfc\x67\x2b\xd5\xbb\x98\x33\x21\x66\xc8\xd7\x1a\x3a\xb5\xb7\x7e\xf6\xc0\x74\x85\x39\xd2\xf1\xce\x1a\x44\xb7\x5c\x2b\x14\x9b\xde\xd6\x3c\xe3\xd6\x3a\x84\x30\x37\x7d\xb9\x9a\xc3\x5f\x4d\x08\x3a\xcb\xe7\xef\xbb\xec\xcc\x22\x94\x7c\x6e\x90\xe9\x72\xc9\x44\xc5\x67\xb6\xdb\xca\xd7\x5b\x6d\x82\x5e\xea\xeb\x79\xfd\x66\xc9\x7f\x9e\xe7\x13\x82\x4e\x4a\x1e\x54\xd6\x9f\x77\x90\x9e\x75\x1f\x06\x0c\x85\xaf\x18\x4a\x28\xe4\x53\xb8\xc7\xd0\xcd\x67\xa1\x59\xce\x8f\x3b\x61\xb8\x9e\x4b\x5f\x35\x1f\x98\x1e\x0b\x27\x3e\x39\xb7\x29\xcf\x61\x9d\xf0\x5f\x37\x5f\xbe\x7c\xdc\xc1\xae\x0f\xde\x44\x7c\xd1\xe9\xc7\x70\x0e\xe2\x28\x0e\xe7\x6e\xb9\xc1\x72\xf7\x9a\xa5\x03\xb9\xae\x5d\x1b\x04\x69\x71\xa6\xf3\xc0\x41\xcd\x6a\xdf\xa8\x9c\x80\xb8\x99\x24\xae\x13\x80\x83\x27\x43\xb3\xad\xe7\x4c\x21\xf1\xd4\x76\xd7\x63\x5b\xd8\x87\xc5\x69\xa8\x52\xc2\xf2\xfe\xb0\xd8\x9b\xaf\x57\xd5\xee\x64\x23\x0e\x6f\x8b\xc3\x97\x47\xf7\x48\x7d\xf9\x4f\xbe\x65\x74\x5f\x7d\x17\x1d\x68\xde\x3e\xe2\x99\xef\xf9\x3d\x7a\xce\x73\xff\x4c\xf1\xfe\xb9\x67\xaa\x38\x46\x7a\xbf\x7b\x48\x55\xb4\x0c\x08\x44\x90\x7e\xd0\x09\xe8\x43\x47\x99\x6b\x1f\x76\x94\xb9\x59\x1e\xac\xcc\xcb\xbe\x8f\x43\x08\x8a\x0e\xf2\x41\x90\x9f\x61\x71\x32\xd7\xde\xdb\xf2\xe5\xda\xbb\x5a\x3b\x74\x6d\x54\xbe\x7f\x5c\x18\x5f\xdd\x5f\x09\xc0\xb0\x41\x7c\x40\xa3\xa7\xea\x75\xe4\x74\xcd\xf6\xa9\x9a\x37\xc9\xdb\x39\x4d\xb1\x39\x39\xf1\x2a\x59\x62\xb1\x59\xfe\x7e\x77\xd3\x7c\x72\xc1\x96\xfa\xef\x72\x28\x7c\x7d\x8f\xd4\xde\x69\xc1\x97\x5e\xf0\x36\x03\x3c\x78\xf8\xfc\x50\xeb\x2e\xf0\x98\x23\xaa\xfb\x8e\xa7\xfe\xeb\x17\x5e\xad\x74\xee\x8c\x7b\x02\xe6\x20\x2e\x80\xf5\xc0\x35\x0f\x67\xcf\x66\x5c\x27\x56\x18\x9d\xb8\xec\xe2\xed\x76\xbb\x27\xee\xd0\x55\x1b\x60\x6e\xc0\x2c\xef\x5e\x3a\xbf\x84\x22\xc8\xcf\xe9\x5f\x8f\xa5\xc6\xa1\x23\xb8\xd7\xc5\x61\x8f\xf2\x92\xb3\xa3\xa9\x52\x23\x76\x40\x5a\x6d\xd9\x96\xde\x74\x2d\xc4\x55\x71\xdc\xd6\x4d\x41\xec\x1a\xc7\x03\x8c\x01\xe1\x68\x7a\xf2\xcc\xc7\x99\xb3\x33\xa8\x73\x8d\x34\xcc\xcf\x5f\x0c\x40\x92\x48\x33\x23\x38\x77\x98\x37\x9d\xc5\x20\x99\x85\x10\xf5\x6a\x0d\x3b\x93\xb8\x69\x17\x16\x6b\xc0\xf1\xb6\x25\x05\x19\x84\xe7\xae\x11\x29\xfd\xab\xb7\x39\x57\x4b\xcd\x80\xe5\x9d\x3d\x6b\x0c\xd2\xd8\xb5\xca\xcb\xe1\xae\xdb\x6e\xd4\x9c\x1b\x2e\x34\x4c\x17\x22\x33\xbd\x38\x73\x12\x19\xf9\xa9\xf0\x6b\x7f\xdd\x71\xc1\x85\x1d\xb7\xab\x2d\xd7\x07\x4d\xc7\x89\x81\xb3\xc6\x98\xe6\x1c\xc4\x17\x1c\x9a\x2e\xbb\x3f\x0c\x2a\x13\x2a\x2e\x83\x93\x2a\xf5\x53\xd5\xf3\xd7\x1e\x3a\x3a\xe0\x09\x57\x6f\xea\xab\xeb\xd0\x36\x52\xe3\x6e\x1f\xcf\x8a\x03\x3c\xc3\x30\x70\xd3\x30\xbe\x13\xdd\xc0\x81\xa0\x72\x88\x61\x06\x53\x37\x82\x60\x98\xab\x9a\xaa\x6c\xfc\x22\xe2\x67\x64\x69\x38\x74\x53\xd7\x31\x52\x70\x1c\x53\x0c\xeb\xcd\xd7\xb2\x15\x59\x73\xec\x67\xf6\x4e\x21\xee\x00\xfd\xa9\xeb\x13\xf3\x20\xbf\xd1\x06\x13\x24\xae\x33\x4b\x93\xfb\x2d\xe9\x15\x58\x15\xa7\xc6\x6f\x0d\xe7\xcc\xe5\x19\x0b\x60\xf6\xc2\xd0\xdb\xeb\x98\x0b\x59\xf4\x65\xf7\xda\x6c\xd3\x40\x63\xcb\x6b\x6e\x0d\x08\xe2\xf4\x24\xff\xbc\x20\xc8\xad\xd5\x50\x38\x59\x13\xc9\x3c\x49\x06\x1d\x18\x9a\xc6\xde\x15\xab\x1f\x15\x04\x1f\x3f\x92\x2f\xbe\x32\x1e\x5d\x42\x70\x5e\x11\x79\x0f\xdc\xc8\xf4\xa7\x8d\xbc\xf8\x65\xa1\xf7\x5b\xef\xef\x1e\x7a\xbf\x3d\x7c\x86\xde\xcf\xd0\xfb\x19\x7a\xff\x7a\xa1\xd7\x06\xf9\x29\x62\xfb\x33\xec\xae\x1f\xff\x94\x61\xf7\x73\xc0\x5b\xa4\xfa\x3b\x45\xdd\xda\xb9\xdb\x2f\xff\xe8\xa8\xab\x7c\x46\xdd\xbd\xcf\x67\xd4\x3d\xa6\xaf\xcf\xa8\xbb\x59\xf7\x08\x40\xfa\x19\x71\xd7\x8f\x7f\x5c\xc4\x7d\xd9\x19\x67\xaf\x49\xf8\x7a\x75\x73\x7f\x6c\xa9\xe6\xe5\xda\xcc\x6e\xed\xdb\xa1\x95\xf9\x20\x48\xdb\xad\xfb\x17\x92\x5f\x2e\x53\x1c\xb9\x9f\xe1\xea\x86\x7c\xc0\x1e\x48\xc2\x6c\xdc\x3e\x3c\x10\xd8\x6d\xdd\x9c\x36\x6e\x8d\x5a\xad\x71\x3b\xc5\x1b\x80\x7c\xb0\x1e\x00\xd1\x30\x6e\xae\xbe\x5e\xdd\xb4\x5c\x08\xdd\xc0\xb9\xba\xbf\xda\x94\x7a\x55\x8a\xbb\xa2\xc2\xa0\xb8\xde\x3a\x3c\xb4\x02\x74\xc0\xa6\x4e\xd4\xfc\x3e\x5f\x0a\xb5\x41\x9c\xbc\x5c\xa8\x7a\x29\xf6\x2c\xb7\x41\xdc\xe4\xc4\xdd\x5b\xef\x55\xdf\xc1\xd5\x24\x54\xe7\x36\x98\xba\x81\x5b\x5c\xc7\x90\x97\x7f\x08\x6d\xce\xad\xd0\x6d\x84\xe4\x06\xd9\x30\x6d\xd2\xb2\x1f\x1f\x6f\xa7\xa0\xde\xb8\xad\x13\xf8\xb7\x5b\xb2\xf6\x68\xde\x4e\xc9\x87\x7a\xed\x01\xe0\x8d\x7a\x03\x3b\xb2\xc0\x15\xed\xbc\xe6\xe5\xfa\xdf\x97\x75\xfa\x69\x51\xeb\x98\xf6\x62\x49\xf0\xf4\xd2\xd4\x07\x62\x00\x79\x8b\xe1\xb7\x51\x0c\xe6\x2e\x58\x7c\x0c\x16\xbc\xdf\x1c\xde\xc9\xce\x8f\xc2\xfa\xb1\x4b\x6e\x2e\x70\xb4\x0a\xe0\xec\x20\xd1\xc3\xe0\xf4\x4b\x51\xbe\x3b\x6e\x37\x3e\x68\x95\xce\x28\xee\xc5\x13\x4b\x8a\x77\x8c\x5c\x97\xb7\xe7\xe5\xd7\xc5\x9c\x18\xa3\xe4\x89\x71\xac\x78\xbb\xda\x3d\x51\x3f\x4c\xc7\xf6\x37\x78\x1c\x22\x38\xe5\xb5\x90\xdf\xfb\xb6\xfb\x9d\xa6\x21\xfd\x6e\x57\xff\x1c\x2f\x2f\x2c\x42\x04\x56\x86\x06\x7e\x39\x23\xf8\xc0\xd7\x30\x1c\x12\x5f\x45\xd4\x20\x39\x43\x93\xd6\x45\x5d\xfc\x1e\x96\x73\xf7\xe3\x15\xf0\xd4\x09\xec\x28\x74\x83\x13\x1d\xb3\xfd\xb9\xf0\xb6\xb7\x52\xf6\xae\xbf\xf5\xc1\x52\xb9\xe0\xcd\x0e\x6b\x21\x95\xff\xbd\xee\x66\xca\xeb\xff\x7f\xd9\x7e\xa7\x0b\x2e\x4d\x3c\x5f\xcf\xf7\xa8\x23\x1f\x48\x54\x2f\xca\xfb\xd3\xe8\xe4\x64\x8a\xe3\xf9\x2f\x7c\x83\x43\x79\x5f\xed\x87\x32\xff\x92\xf0\x5f\x1e\x0f\x5e\xec\xb8\xf9\x39\x5f\xe3\xf0\x13\x87\x8a\x3a\xc2\x5a\xe2\x4f\x1b\x2a\xca\xea\xff\xbc\xa1\x22\x02\x7f\x40\xb4\x88\x62\x77\x6e\xa4\xeb\x68\x51\xd6\x33\x7f\xe1\x45\xa1\xeb\xeb\x76\xf1\x26\x86\x23\x6f\xc8\x3a\x53\xc6\xce\x6b\x5c\xc0\xf7\xc2\x81\x08\x14\x63\x7f\x6c\x7f\x8c\xfc\x09\x07\x06\x84\xe1\x42\xd9\x69\x6c\xd3\xb2\x8a\xb7\xd0\x1d\x9b\x8d\x42\x59\xe8\x30\x5e\x18\xb1\x0d\x6c\x29\x36\xa6\x53\xd7\x3a\x93\xbc\x6b\xa4\x60\x61\x2c\xa5\xd8\x08\x12\x37\xad\xb6\xf4\x1e\x48\x9d\x25\x40\x00\x7e\x98\x82\x32\x47\x72\x22\x6d\x9c\x27\xdc\xad\xfc\x71\x48\x7b\xcb\xad\xe6\x1b\xdf\xab\x2c\xe8\xe0\x4c\xfc\x65\x9b\x70\xcb\x90\x74\x1f\x01\x10\xbb\x81\x73\xfb\x41\xa6\xf9\xe2\xef\x71\x21\xfd\x7b\xee\x2d\xfc\xf8\x37\x0a\x7d\xf4\x76\xd7\xd7\x77\xe3\x7f\xde\xe6\xe0\x9f\x1e\xf8\x63\x3c\x70\x7e\xec\x15\x02\x17\xbb\xde\x56\xe7\x6f\x7b\xe0\xbb\x48\xe2\x5f\xd7\xf5\x5e\xb8\xca\x47\x79\xe0\xc1\x6e\xfc\x7e\xae\xe7\xb9\x41\x6e\x72\xdd\x7c\x1e\x67\x33\x9d\xf5\xda\x79\x53\x2b\x0c\x12\x37\x49\x41\x60\x2d\xcf\xbd\xec\xab\x7c\xdb\x31\xb5\xc9\x31\x00\x73\x00\x8b\xd5\xae\x38\xdc\x7e\x63\xf1\x81\xfa\xef\xe9\xe0\x4d\x6c\xb8\xca\x5c\x2d\x19\x5e\xa2\xc4\x9d\x0a\xed\x73\xad\x03\x55\x7c\x31\xe7\x37\x9a\x4e\x41\xbc\xb5\xb0\x77\xf4\xf5\x6b\x6e\x24\x18\x81\x03\x68\x17\xa6\xc5\xbd\xf0\xeb\x45\x1a\x1c\xab\xdf\xd5\x89\x3b\x9c\x6c\xdc\x91\xc4\xd7\x3a\x76\xf7\xf0\xed\xae\x51\xbf\xc3\x6b\xf8\xd7\x06\x71\x87\x3f\x7c\xbb\xfb\x76\x57\xc3\xf2\xef\xdf\xc8\xbb\x06\x76\x57\x6f\xe4\x7f\x3c\x3e\xdc\xe1\x8f\xf5\x3b\xe2\xdb\xcd\xd7\x2b\x77\xfa\x0b\xf8\x2d\x33\x60\xb2\xb3\xcc\xf0\xf2\xf5\xf4\xf9\x1a\x43\xf1\xcf\xd7\xab\x9b\xaf\xe8\xdb\xe9\xe4\x87\x7d\xc0\x4d\x76\xa1\xaf\x68\x53\xe7\xdc\x16\x81\x5d\xdf\x78\xf3\x2b\x61\x7f\xe8\x6b\x5a\x2e\xb4\x89\x62\x34\xd0\x07\xcb\x96\x91\x00\x7b\x08\x52\x03\x99\x89\x1a\xbb\x29\xd8\x09\x6a\x17\xa1\xf6\xbf\xcf\x4e\x31\xbf\x6f\x61\xe5\x63\x48\x7d\x39\xd6\x3a\x00\x1b\x25\x16\x74\x9e\x23\x10\xbb\xe5\x4b\xc8\xae\xa9\x30\x06\x57\xbf\x88\xfc\xe0\xcb\x8f\x59\x19\x20\x6f\xb1\xc7\x1f\xcc\xd7\x76\xd1\xfd\x03\x28\x52\x55\x99\xb3\xd4\xe2\xa6\x29\x8c\x6e\x0e\xb1\x84\x03\xb6\x1a\x46\x15\xc0\x1e\x91\x99\xce\xe2\x30\x73\x66\x51\x96\xbf\x3c\xa1\x81\x61\x6f\x65\x1f\xaf\x5a\xf8\x44\xba\x44\xad\x78\xef\xa2\x61\xf2\x1b\x6c\x97\xbf\xfd\x7c\xa3\xd7\x8f\xb3\xc8\x3f\x7c\x8d\xea\xed\xd6\xda\x4c\x96\x81\x35\xca\xf7\x2b\x9d\x78\x09\xca\x75\x64\xc4\x69\xae\xf6\x3e\x38\xce\x3b\xca\x94\xe9\xec\xfc\x14\xf1\xf5\xbd\x7b\x62\xb6\xe6\xc8\x56\x9a\xab\x6d\x3a\xd5\x33\x92\xd9\x91\x28\x71\xa4\x0d\x25\x12\x4a\x29\x22\x42\xdf\xb0\xfa\x23\x86\xbd\xd6\x47\xbf\xa3\xab\xa1\xef\x2f\x3a\xe3\x43\xdd\xef\xde\x0a\x83\xd4\x70\x83\x9f\xf1\xed\xc0\x3f\xc4\x13\x77\xb4\x71\x89\x5b\x1e\xc3\xc0\x37\x96\xff\xb3\x23\x41\xb9\x95\xe4\x2f\x81\x00\x3f\x99\x5f\x97\xaa\xfd\xf4\xe7\x4f\x7f\x3e\x60\x63\xdf\xc9\x9f\xcb\x7d\xa2\x7f\x9f\x90\x7e\x8b\xff\x64\x6e\x5f\xf5\xc0\xa7\xdf\x7f\xfa\xfd\x01\x23\xfb\x4e\x7e\x3f\x8a\x40\x20\xce\xdc\x69\x4a\xc1\x2c\x49\x8f\xef\xa5\xff\x0e\x00\xb0\x23\xf1\x07\x43\x41\x16\xb8\xbf\x65\xa0\x0f\xce\x4d\x8e\xee\x26\xfe\x90\x77\x59\x5e\xa6\x9e\x75\xf2\x7b\xef\x94\x76\xaa\xcf\x99\x77\xd9\x9f\x5e\x33\xff\x0e\x95\xb6\x0a\x6b\xda\xd9\x95\xcf\xd8\x27\x3b\xba\xfa\xfc\x7c\x4d\x71\x41\x90\x7e\x4c\xe5\x8f\x5b\xf9\x9f\x82\x99\xee\x81\xc5\x67\xac\xfa\x8c\x55\x07\xac\xed\x3b\xc5\x2a\x71\x6b\x07\xfe\x5f\x83\xa8\xfe\x64\xfe\xbd\xa3\xe0\x4f\xdf\xfe\xf4\xed\x03\x96\x76\xec\xa4\xd4\x07\x5e\x3f\x77\x81\xdd\x9d\xbc\x0c\xef\xfd\x67\x8c\x36\xf5\xff\xa1\xe7\x83\x0c\xcb\xb6\x1f\x08\xe3\xe1\xb6\x56\x7b\x6c\xdc\xd6\x1f\xc1\xf4\xd6\xb4\xeb\xc4\xed\xf4\x1b\xf6\x6d\x6a\x1a\x8f\xb8\x01\x1e\x2e\x3c\x1f\x74\x5e\xeb\x7f\xa6\xb3\x41\x1f\x62\x88\x37\x34\x32\xb2\x72\x01\x70\xf7\xb8\xdb\xdf\xd6\xe2\xea\x36\xf9\x60\x92\x8f\xe6\x2d\x6e\xd7\xa7\xb7\xf5\x87\xc7\x87\x5b\x83\x20\xf1\x5b\xeb\xdb\xc3\x63\xad\x6e\x13\x38\xf1\x26\x8b\x9b\xfe\xad\x2c\xee\xcf\x70\x48\xf4\x72\x14\xfe\x3c\x1a\xfa\x27\x3f\x1a\xfa\xd7\x82\xfe\x3f\xeb\x92\xfb\x49\x74\xd8\x3a\xff\xf9\x76\x50\x78\x79\x2c\xf4\x65\x4b\xb6\x0a\xf9\x52\x45\xbf\x36\x27\x5e\xa1\xc4\xaf\x77\xe9\x97\xa5\xfd\x38\x3f\x7e\x4d\x3b\x7f\xa8\xcf\x9a\x60\x0a\xa6\x06\x86\xdf\x12\x06\x41\xde\xd6\x71\xf2\xe1\xf6\xb1\x66\x3c\xde\x12\x0f\xc4\x74\x5a\xab\x59\xa0\x86\xd7\xff\x96\xc1\xf3\x43\x37\x6e\x5d\xd6\xed\xc7\xfc\xf3\x1f\xc5\xdf\xbf\xff\xe3\xff\x02\x00\x00\xff\xff\xe8\xd5\xcb\xd8\xf5\xa9\x00\x00")
+var _rpProductionJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x69\x93\xa2\xc8\xd6\x38\xfe\xde\x4f\x51\xe1\xff\x89\xa8\xe9\xff\x53\x0b\xa0\x76\x15\x37\xe2\xbe\x50\x14\x05\x15\x65\x47\xee\xd3\x71\x83\x4d\xa4\x4c\x96\x61\xd1\xd2\x89\xfe\xee\xbf\x48\x16\xf7\xad\xec\xea\x9a\x9e\x99\xc2\x8a\x6e\x85\xcc\x93\x27\xcf\x9e\x27\x17\xfe\x28\xdd\xdc\xdc\xdc\x94\xff\x27\x32\x26\x96\xab\x95\xff\x75\x53\x9e\xc4\x71\x10\xfd\xeb\xf1\x31\xbb\xf3\xe0\x6a\x9e\x66\x5b\xae\xe5\xc5\x0f\xda\x32\x09\xad\x07\xc3\x77\xf3\x67\xd1\x23\x86\xa0\xb5\x7b\x04\xbd\x47\xd0\x47\xd3\x0a\x80\xbf\x80\xe5\x04\xcb\x0d\x80\x16\x5b\x0f\x2f\x91\xef\xfd\x7f\xe5\xbb\xac\x05\xc3\xf7\x62\xcb\x8b\x25\x2b\x8c\x1c\xdf\x83\x0d\xa1\x0f\x08\xfc\x14\x05\x02\x2d\xd4\x5c\x2b\xb6\xc2\xa8\xfc\xaf\x9b\x0c\x2d\xf8\x29\x6b\x46\xc8\x59\x91\x9f\x84\x86\x45\x99\x5b\x8f\xe0\x5f\x39\x5e\x04\x16\x84\x16\xc5\xa1\xe3\xd9\xe5\xd5\xc3\xef\x77\xab\xaf\x65\xcd\x74\x1d\xaf\x1e\x38\x84\xd6\x48\x3c\x13\x58\x3f\x08\x05\x38\x96\x17\x13\x56\x18\x13\xbe\xeb\xfa\x1e\xa3\xb9\x57\x42\x34\xb5\x58\xd3\xb5\xc8\xaa\x1b\x86\x9f\x78\xf1\x8f\x03\xea\x6b\xaf\xc2\x24\xf4\x13\x7b\x12\x24\xf1\x71\x50\x8e\x17\x97\xef\xb6\x1f\x99\xd6\x58\x4b\x40\x2c\x69\x20\x81\x68\x57\x11\x04\xd9\x29\xe1\x3a\xde\xe6\xd3\xc3\x78\xf8\xae\xe6\xfc\x00\x41\xac\xd7\x38\xd4\x08\x3f\x72\xfd\xa8\xd9\xa0\x86\xd1\x59\x28\xa7\x7b\x51\x3e\xdc\xca\x38\xe0\xad\x70\xe6\x18\xd6\x30\x74\x3c\xc3\x09\x34\x70\xad\x60\x8d\x13\x00\x9a\xa9\xe0\x1f\xaf\xaf\xfb\x3e\x38\x83\xe7\x58\x03\x91\x75\xb0\x81\xa9\xb5\x98\xc1\x1e\x0d\x43\x6b\xec\xbc\x5e\x87\xa4\x6b\xba\x64\x98\x6a\x9f\x29\x86\xe0\x5a\x18\x91\x49\xf8\xde\xd8\xb1\xd7\x0a\x7c\x25\x98\x96\x37\x73\x42\xdf\x83\x96\xe2\x3a\x20\x61\x40\xb9\x9a\x7d\xa5\x84\x85\x41\xdf\x37\xcf\xd7\x3d\xcd\xaf\x23\x72\x15\xbe\x9b\x5c\x45\xd1\x64\x98\xe8\xc0\x31\xba\xd6\xe2\x4a\x08\xb1\x1f\x6a\xf6\x8f\x5b\x96\x28\xd1\x23\x23\x74\x82\xd8\xf1\xbd\xc2\x0c\xb7\x43\x3f\x09\xae\x07\x39\x73\x79\x67\x79\xbe\xee\x19\x16\xf0\xb1\xe6\x99\x5a\x68\xfe\xb7\x89\x45\xff\x9d\x55\x8e\x35\x15\x45\x6f\x44\xb4\xb4\x01\xa3\x1c\xe6\x3d\x86\x86\xe8\x3f\xab\x32\x3b\xa0\xa2\x69\xb2\x07\x1f\xfe\x95\xbd\xac\xe5\x15\xaa\xeb\x56\x76\xf0\x84\x7f\xe5\x20\xf4\x03\x2b\x8c\x1d\x6b\xdf\xea\xc1\x4f\x39\x48\x05\x82\x1a\xd6\x01\xf0\x0d\x0d\xf2\xa3\x6f\xc5\x13\xdf\xcc\x5b\x88\x1d\xe3\x34\xfc\x02\x9b\x30\xb8\x0f\x9c\xa0\x7c\x77\x98\x1e\x7d\xc7\x08\xfd\xc8\x1f\xc7\x0f\x8c\x15\xcf\xfd\x70\xfa\xb8\x6a\xd7\x34\x43\x2b\x8a\xac\x68\xb7\x6a\x81\x0e\xac\xfe\x9f\x82\x62\xa9\x8c\xfc\xf6\xe5\xa1\x78\xf8\x6d\xb7\x96\xe1\x7b\xa6\xb3\xaa\xb6\x76\xfe\xbf\xdd\xae\x8d\xea\xed\x97\xbd\x6a\x5a\xe0\x6c\x84\x10\x18\x82\xe2\xf7\xc8\xd3\x3d\x82\x96\x4b\x07\xfa\xbd\x4d\xc5\x8f\x62\xd4\x38\x37\xb6\xd4\x30\xb3\x98\x49\x98\x72\x6b\x5b\x86\x36\xaf\x7d\x18\x97\xb6\x75\x58\x40\x32\x46\x9d\xad\x00\xff\xca\x8e\xb9\xc5\x36\xca\xfc\xed\xf6\x02\x11\xb8\xbd\xbb\xb9\xcd\xe4\x68\x9f\x45\x87\xae\x72\xac\xd9\xb0\x07\x5e\x02\xc0\xc9\xc2\xdf\x8f\x3e\xdd\xe1\xc2\x41\xfe\x85\xc1\x7d\x41\xfc\x72\xe9\x40\xc1\x5c\xbb\x37\x3f\xdf\xf6\xc1\x96\x75\xcd\x98\x5a\x9e\x99\xf7\x76\xe8\xfb\xe0\x2a\xde\x6d\x60\x95\x43\xfc\x11\xa4\x80\xaf\x99\x0d\x0d\x68\x9e\xe1\x78\x36\x97\x00\xeb\xa7\xcb\xd3\x11\x39\x7e\x47\xb9\x5a\xf7\xc9\x0a\xa3\xc7\x23\xed\x15\xc2\x06\xf4\xfc\x4b\x51\x0e\x8a\xde\x49\x44\x4e\x88\xcc\x11\x3e\xff\xb4\xbe\xed\x37\xb5\xd7\xad\xbc\xc8\x0f\xf7\x2a\x08\x7d\x7d\xdf\xe1\xbd\x57\x47\x52\xe8\x7b\xb8\xa7\x77\xdf\x03\xf3\xd8\x37\x7c\x18\xa2\x96\x05\x63\xd7\x45\xed\x5e\x65\x88\x58\xd3\x81\x0e\x5c\x4f\x0a\x47\xd2\xcc\xa2\x84\x73\x55\x0b\x11\x1a\xfa\x21\x1c\x26\x55\xab\x95\x33\x15\x72\xe6\xac\xcb\x97\xae\xe8\xe4\xa6\x9d\x02\x7a\x98\x00\xeb\x47\x0c\x42\x4a\xf3\x9f\x6e\x04\x36\x79\xd2\x81\x99\x81\x73\xa4\x0d\x2e\x25\xa9\x97\xb8\xba\x15\x0e\xc6\xc3\xa2\x1f\xd8\x99\x0a\xa1\xf5\x7b\x62\x45\xf1\x50\x8b\x27\x10\x9b\xc7\x89\xa5\x81\x78\xb2\x7c\x0c\x2d\xcd\x5c\x94\x7f\x94\x21\x29\x39\x2f\xe6\x47\xe9\x44\x0b\xdb\x6c\xbe\x3c\xd0\xda\x52\xb4\x5f\x33\xc8\xda\x29\x67\x5a\x81\xe5\x99\xd1\xc0\x3b\x28\x85\x3f\x18\x55\x6c\xc1\xfb\x56\x3a\x40\xec\xb7\x86\x77\x5b\x04\xc8\x46\x21\x87\xc3\x97\x72\xec\x58\xe1\x56\x40\x78\xa0\x8c\xa1\x05\x9a\xe1\xc4\x70\x7c\x56\x39\x29\x0e\x67\xd4\xad\x9c\x04\x76\xa8\x99\xd6\xd0\x07\x8e\xb1\x3f\xda\x2b\xae\xb2\x9b\x8d\x5b\xcb\x7d\xcd\x4b\x34\xb0\x2f\xa9\x3b\xcd\xc2\xbf\xf2\xcc\x09\xe3\x44\x03\x7d\xcd\x98\x38\x9e\x35\x0c\xfd\xb1\x73\x20\xfb\x55\x7c\xca\x7e\x74\xae\x48\x2e\x55\x6e\x90\xc4\x56\x08\x47\x56\xab\xc4\x44\xf9\x3f\x86\xef\x19\x5a\xfc\x1b\x64\xe1\xed\xdd\xcd\x36\xad\xb3\x61\xd8\xed\x97\xbb\x9b\xdb\xfb\xc3\x34\x2f\xae\x2c\xcd\x26\x46\x56\x58\xb0\xcd\x00\x7e\x62\xde\x27\x91\x15\x9e\xaa\x06\x1c\x2f\x79\x7d\x5b\xa0\x52\x36\x9d\x48\xd3\x81\x35\xd4\xa2\x68\xee\x87\x66\x3d\x89\x27\x96\x17\x3b\x2b\x4d\x8b\xc3\xc4\x3a\xde\x64\x31\x52\x3f\xdb\xce\x46\x74\xde\xb5\x16\xc7\x4d\xf6\xee\x75\x1e\x6a\x71\x95\x83\x95\x51\xf4\x5d\xeb\x71\x4d\xb1\xc7\x87\x28\x9a\x3c\x6a\x49\x3c\xf1\x43\x67\x69\x99\xff\x9d\x42\x04\xee\x4a\x17\xc0\x5c\x25\xa0\x9a\x5a\xac\xed\xa9\xcf\x66\x86\xe2\xac\xe3\x3f\x6e\x48\x77\xaf\x6f\xa5\x83\xb7\xcf\xd6\x3f\xfc\xe4\x80\x4a\x6c\x26\x47\x2e\x12\x76\x07\xe6\x9a\x38\x6b\x6c\x85\x96\x67\x58\x17\x8e\xc2\xa2\x49\x66\x3f\x38\xcb\xec\x68\x67\xa3\x11\x7f\x3c\xce\x8b\x77\x5a\xbd\x73\x85\xb3\x41\x6c\xf9\xe9\xbe\x27\xf5\xcf\x95\x9d\xad\x8d\xf8\xd3\x03\xfe\x80\x21\x18\x82\x22\x08\x8a\x7e\x3d\xce\xae\x23\x24\xcb\xcd\x43\xd3\x89\xa6\xe7\x49\x60\x84\x96\x16\x5b\x83\x20\xd7\xa2\x32\x19\xfa\x6e\x96\xb2\x3b\x83\x6f\x36\xd7\x60\x5e\xd4\xca\x81\x2c\x97\x90\xbb\xd6\x61\x68\xb9\x4e\xe2\xfe\xb7\xc7\xf1\xe5\x0f\x91\x27\x2f\x8b\xf9\x2f\x92\xa7\x2c\x6a\x19\x5e\x14\xa4\x7f\x64\x80\x7e\x8a\xf1\x79\xff\x28\x2f\xb6\xc2\xb1\x66\x58\xdb\xe3\xb3\xb3\xf6\xec\x74\x27\x77\x43\x26\xe8\x2c\xee\x3d\xc7\x38\x23\x2c\x97\xb8\xd6\x43\x57\x39\x08\x1d\x57\x0b\x17\x17\x99\xf7\xe2\x2a\x3b\xc1\x1b\xfb\xfc\xb6\xfe\x9f\xa4\x85\x13\x18\x69\xdb\x17\x10\xe4\x47\x89\xb3\x79\x95\xa3\x44\xf7\xac\xfd\x1c\xfd\xa5\xd7\x65\xc2\x9b\x47\x28\xf9\xcf\xe8\x31\x6b\xb4\x90\xdf\x99\x67\xc5\xf9\xd7\xec\xc1\xc5\xae\xe6\x42\xd1\x7e\x57\x29\x39\xe0\xef\x57\xd1\xed\x96\xf8\x5c\x4f\xd3\x5d\xd9\x80\x99\xdc\x0f\xa1\xc7\xa6\x91\x69\xec\x27\x33\xde\xa4\x0f\x9b\x9f\xeb\xe8\x70\xad\x71\xd4\xf7\x31\xdf\xb5\x94\x79\x91\xab\x04\xed\xb4\x4f\xb9\x2e\xe6\xb9\x1e\xfe\xf7\xd2\xfb\xb4\xfe\xbd\x74\xdd\xd3\x6f\xa5\x37\x08\x5f\xd9\x74\x34\xdb\xf3\xa3\xd8\x31\x2e\x1b\x84\xe8\xbe\x1f\x37\xd7\x75\xce\xaa\x54\xd9\xf2\x60\xac\x6f\x5e\xa4\xd1\x45\x60\x21\x86\xce\xd6\xd0\xa6\x58\x1b\xb1\x33\xbe\xd9\x0e\x43\x56\x23\x9d\x07\x1d\xf8\xfa\x83\xe1\x87\xd6\xc3\xdc\xf1\x4c\x7f\x1e\x3d\x78\x56\xfc\x78\x52\xb4\xbe\xbf\x89\x68\xd6\x6b\x6c\x79\x30\xc4\xbb\x88\x64\xab\xd2\xe7\xd5\xf5\x8f\xd2\x9b\x4d\x91\x11\x9d\x8b\xef\xae\xf5\x4a\xdb\x31\xf5\x5a\xc7\xeb\xe9\x7a\x94\xd6\xba\x57\xe7\x9b\xdf\x4a\xc1\x10\x49\x14\xfb\x2e\x9f\x4e\x6f\xbe\xa5\x6e\x47\x83\x8b\x48\xc2\xcd\x14\xc9\x6a\x19\xcb\xb9\x4f\x59\x4b\x62\x5f\xcc\x46\xfc\x7d\xc7\xf3\x37\xa0\x5c\xee\x68\xca\x91\x15\xc7\x8e\x97\x4e\xa9\xfc\x71\x44\x36\x76\x3f\x90\xf0\xb1\x65\xc4\x96\xc9\x6f\x54\xbe\xa8\x2a\xfc\x2b\x67\xb3\xc0\x90\x01\xff\x81\x2b\x4d\xbe\x56\x7f\xcb\x95\x22\xfb\x25\xf8\x7c\x3a\x6d\xfb\xdb\xad\x81\x49\x08\x45\xa0\xc0\xaa\xfb\xdd\xdb\x2f\x77\xb7\xfd\x66\x9f\xe4\x06\x8c\xd0\x62\x9a\x22\xd7\xfb\xf7\xff\xe4\x15\x6e\xee\xcd\x9b\xff\x4b\x10\xa4\x62\x6c\xfe\x7b\x7b\x7b\x7b\x97\x83\xdf\x54\xb0\xed\x25\x0c\xb7\x5f\xbe\xdc\xdd\xde\xde\x7e\xf9\x3f\xef\x16\x82\xe7\x9b\xc4\x80\x21\xa9\xb6\xd4\xe2\x78\x6a\xc0\x5c\xdb\xc2\xce\x02\x87\xbd\x46\x5a\x8c\x44\x71\x03\xa6\xdf\x62\x84\xeb\x9b\xd8\x58\xfc\xb0\xd5\x40\x9d\xe0\xb8\x16\x3f\x10\x39\xa2\x45\x35\xaf\x03\xbf\xb5\x4e\x6a\x0b\x78\x73\xd0\xaf\x53\x0c\x53\xef\xb7\xae\x83\xbc\x5e\xd5\xb3\x05\x96\x1b\x52\xfd\x7a\xfb\x4a\x98\xf9\x22\x8e\x1d\x80\xfd\x41\xf3\x6a\x78\x70\x5d\xc7\x16\xb8\x7a\xb3\x4f\x31\xf5\x21\x45\xf4\xa8\x16\x23\x10\x2d\x4e\x20\x06\xfd\xfe\xe0\x07\x08\x71\x6a\x05\xd8\x56\xd3\xcd\xba\x50\x6f\xd4\xf9\x56\x9d\x20\x06\x22\x23\xfc\x00\xe9\xf7\x57\x88\x6d\x35\xd4\x6d\x8d\xa4\xba\xd8\x13\x86\x5c\x8b\xa4\x94\xeb\xda\xd8\x5e\x63\x74\x98\x84\xf5\x86\xc8\x34\x7b\xad\x7f\x43\xc2\x1c\xa4\x48\xbe\xb2\x0e\x2a\xfc\xed\x6d\xae\x33\xfd\x4c\x3e\x6e\x6f\x1f\x6d\xcb\xb3\x66\x9a\x6b\xba\xff\x72\xb5\x28\xb6\xc2\xff\xd6\xd0\xbc\x54\x6f\x40\xd4\x85\x37\x69\xed\xb1\xe4\xf7\x06\xda\xbc\xd8\xe0\x09\x8e\x1a\x42\xc0\x6f\x51\xa7\xcd\xc5\x2e\xbf\x7d\x79\xd8\xfc\x49\x99\x1b\xf0\x0b\x55\x6d\x73\x03\x71\xf8\x36\xe6\xee\x62\x0f\x03\xfa\x0d\xc8\xf0\x9f\x5d\x83\x4a\x78\x00\xd5\xf9\x7a\x6c\xf1\x0d\xd4\x68\x73\x13\xb3\x2d\xda\x3d\xc5\xb6\x25\x84\xec\x6b\x72\x0d\xb5\x5a\xa4\xa7\xca\x35\x84\xb0\x83\xc8\x74\xa5\xaa\xd9\x96\x12\x95\xa8\xc7\x3a\x51\x0f\x19\xa1\x0e\x38\x40\x93\x1c\x5f\x9f\xa9\x6d\x09\xeb\x55\xe8\x99\x5e\xe1\x30\x75\x81\x2f\x74\x0c\x47\xf4\xce\xa8\x6b\xb5\xd5\xa5\x82\x99\x0b\xbd\x62\xba\xc6\xa2\x3e\x3b\x04\xa7\x2f\xd4\xe7\xb4\xa8\xf2\x9c\x28\xda\x3d\x8c\x03\xa6\x93\xd5\x37\x5d\x63\x66\xba\xe4\xe2\x10\x1c\x78\x9f\xb0\xfd\x17\xaa\x4d\x62\x3a\x06\xa6\x14\x41\x03\xc3\xa3\x67\xc6\x8b\x6f\xab\x6d\x0a\xa5\xda\xd2\xc2\x70\xf1\x45\x97\x40\x96\xfd\xe6\x14\x1b\xf0\x53\x5b\xf5\xe8\x99\xce\x37\xa6\x23\x57\x4a\x4c\x07\xf9\x5f\xbd\xd2\x00\xfa\x8b\x6f\xb3\x53\x8e\xe8\x37\xeb\xb5\x3e\xdf\x68\xb1\x00\x97\x39\x89\x16\x78\x11\x1f\x28\x08\x4a\x8b\x08\xda\x90\x5a\x0c\x35\x70\x1a\xad\x91\xc2\x4d\x46\x2e\xb9\x54\xf9\x06\xd0\x3d\x35\x30\x5c\x3c\xd1\x65\x29\x31\x89\x06\xa6\x2a\xf4\x52\x93\xf1\x84\x6a\xa3\x81\x81\xa1\x13\xb3\xcd\xf8\x94\x1d\x2c\x20\x6d\x55\x27\xc3\xb7\x87\xbd\x06\x23\x07\x5f\x18\x6d\x64\xa6\xa0\xf8\x74\xe4\xf8\x5d\xc2\xa3\xe7\xb0\x4c\x4f\x06\xb1\xd1\xc6\x17\x26\xd1\xf0\xcd\x0e\x37\x37\x96\xfe\xac\x87\x71\x51\xcf\x55\x81\xda\xc6\x17\x23\xa5\xb1\xd0\xb1\x00\x8c\x2a\x6c\xa2\x57\x68\xaf\x57\x69\xa0\x23\x07\x07\x46\x5b\x8a\x7a\x28\xcd\x0a\x3c\xda\x11\x5b\x46\xcc\x23\x92\xda\x13\x25\x96\x13\xe7\x31\x33\x0f\x60\x5b\x76\x8f\x47\x03\x5d\x69\xcc\x0c\x8f\xb5\xb5\x0e\x87\x18\x9d\xfe\xd7\xde\x02\x9f\x8f\x64\x26\x1c\xc9\x26\x30\x16\xb5\x58\x93\x99\x85\x5e\x61\x66\xaa\xc7\x26\x23\x0c\x8f\x7b\x58\x0c\x2c\xa5\x3f\xd3\x65\xf0\x62\xb8\xf8\x52\xc7\x54\xa4\xe7\x92\xcb\xd1\xe5\x30\x5d\xbd\x23\x01\xdd\xe3\x1c\x4d\x61\x13\x4d\x7e\x9e\xa9\xee\x2b\x0a\x65\x69\xe4\x02\xa4\xe7\xc6\xc0\x62\xfd\xae\xea\xe2\x0b\xaa\x4d\x22\x66\x5b\x8a\x8d\x0e\x6b\x6b\x72\xd5\xb6\x96\xad\xa4\xf7\x22\xe1\x83\x45\x63\xaa\xcf\x7d\x9b\xea\xac\x64\x34\xd0\x3d\x06\x19\xc9\xaf\x11\xd5\x9e\x20\x66\xa7\xb1\x1c\x38\xcf\x33\xb5\x3d\x4f\x54\x57\x9a\xea\x15\x7a\x62\x74\xe8\x99\xe6\x4a\x2f\x26\x51\x9b\x19\xae\x31\x33\x3a\x92\xd3\xc3\xa4\xb9\x2a\xcf\x67\xaa\xd2\x00\x3a\x81\x2e\x54\xf9\x15\x8c\x14\x06\xf4\xe4\xd7\x89\xd9\x96\x96\x26\x81\x54\x7a\x6e\x6d\x36\x52\xe8\x17\x8d\xa8\xa5\xfd\xa3\x9d\x91\x3d\xf2\x68\x30\x92\xa3\x2e\x45\x34\x02\xd5\x69\xe8\xf2\xa2\x3e\xb5\xb0\x02\x57\x0e\xa7\x08\x34\x32\x89\x3a\x4a\x91\xa8\x39\x58\x34\x10\xad\x2d\x25\x54\x87\x89\x54\x59\x9a\x53\xcd\xd6\x7c\xb0\x68\x00\xbd\xc3\x00\xaa\x2d\x55\x35\x85\xb5\xfb\x42\x64\xab\xee\xb4\xab\xb6\xf1\x44\x65\xfd\xee\x08\x23\x11\xaa\x59\x9d\xa9\x0a\xf7\xd2\xab\xc0\x3e\xd6\x16\x2a\xa4\xe9\xa2\x36\xed\x61\xe4\x57\x53\xa1\x41\xcf\xa3\x81\xd1\x7e\xb6\x87\xcd\xb9\xc7\x89\x78\x9b\x9e\x07\xfa\x48\x09\x50\xc3\x15\xe3\x11\xf6\x1a\x28\x6c\x90\x8c\x64\x14\x0c\xe5\xbc\xbc\xcc\x44\x1a\x1b\x38\xb0\x7f\xa6\x42\x47\x43\x79\x4d\x27\xa3\x4d\xbe\x68\x18\xe9\xa9\x4a\x3f\xd9\xe6\x2b\x33\xd3\x79\xbc\x66\xca\x68\xde\x3e\x3e\xb1\x3c\x69\xa1\xf2\xe8\x8b\xde\x9e\x76\x55\xb9\x36\x19\xb9\xaf\x40\x6d\xa2\x35\x55\xe9\x77\xd5\x4a\xc3\x1b\x61\x13\x30\xc2\x22\xdc\x92\xa5\x25\x61\x17\x38\x49\x2f\x7a\x85\x06\xbb\x38\x8d\x30\x7c\xa1\xbe\x17\x4e\x32\x33\x33\x5c\xf1\x24\x4e\xba\xfb\xdc\x85\xb4\x22\xec\xe0\x65\xa4\xb0\xf6\xd0\xc1\x81\xd9\xee\xcf\x2c\x45\x8a\x33\x7a\xe2\xcb\x9e\xcb\xce\xcc\x36\x1b\x43\xf9\xd7\x3d\x36\x4e\x65\xf2\x00\xad\x77\xcb\xac\xfa\xa6\x70\xd3\x9e\x9c\xd9\xc6\x9e\x4c\x07\x66\xfd\x7c\xff\xb6\xe5\x1f\xcc\x7a\x18\x03\xf5\x63\x66\x2c\x9e\x2b\x84\x2b\x25\x23\x99\x8e\x54\x99\xcd\x68\xea\x9a\x73\x15\x63\x7c\x55\x66\xc2\xa1\x02\x80\x31\x0f\x48\x01\x19\x75\x09\x57\x9d\x19\x4e\x63\x62\x76\x38\xa0\x2b\x0d\x84\x6a\x83\x84\xea\x44\xaf\x3d\xa7\x8a\x8e\xa1\x7c\xb5\x9f\xbb\xb0\x9f\x14\x81\xd6\xe0\x33\xa3\xc2\x4d\xf4\xf6\xdc\x1e\x29\xc1\x52\x95\xfb\x50\x66\x26\xba\x4c\x62\x54\x9b\xfc\x6a\x60\xd2\x4b\x4f\x46\x67\xba\x0b\x10\xbd\x42\xd9\x9b\x72\xd5\x13\xa8\xa4\x2f\xd4\x93\x3e\xdf\x28\x64\x21\x56\x3b\xcc\x14\xd6\x83\x3c\xed\x29\x0c\x18\x55\xa4\x85\xa6\x70\x35\xaa\xcd\xcd\x46\x58\x0c\x0c\xa7\x81\xa8\x04\x3a\x51\x31\x68\x13\x51\xa8\xf7\x1f\xa2\x47\x86\xd7\x88\xe1\xa0\x80\x22\x58\x3f\xff\xfe\x3a\xe2\x1b\xcf\x54\xdb\x5c\xa8\x4a\xdd\x56\x5c\xd2\x31\x3c\x36\xee\xb2\xdb\xf2\x60\x54\xc0\x72\x54\x81\x36\x96\x9d\xf5\x9b\xad\x58\x6d\x83\x65\xca\x03\x28\xf7\x15\x1a\xa4\x7a\xe1\x8e\x36\xe5\x21\x54\x15\x3a\x51\xe5\x39\xb4\x91\x0b\x55\xc2\xe7\x23\x85\x43\xe0\x3d\xaa\x89\xd8\x63\x02\x77\x34\xb9\x3a\x33\x3b\x34\xaa\xb2\x19\xbf\x8a\x36\x28\x02\x89\xe1\x77\xd8\x67\xc2\x0e\x5c\x4d\xa1\x81\x89\x91\x91\x4e\xa0\x2f\xba\xcc\x42\x7b\x3a\x51\xdb\x6c\xe6\x03\x9a\x08\xc2\x34\xfb\x33\xb3\xcd\xcc\xd3\x7a\x6d\x69\xa1\xcb\x64\x92\xfb\xe1\xad\x3e\xec\xc9\x70\x65\x47\x2e\x89\xda\x8b\x8e\xd5\x5c\xaa\x39\x7f\xa6\x11\x69\xc8\x39\x46\x57\x46\xc0\x40\x24\x25\x51\x61\x7d\x5a\x70\xc9\x58\xe5\x1b\x4b\x4b\x61\x10\x55\x46\xa7\x84\x0d\xc4\x91\x6c\xd8\x9a\x8b\xa3\x86\x5b\x9b\xe8\x6d\xb6\x4b\x48\x4c\xcd\xa8\x70\x40\x97\xb9\x31\xe7\x82\xc8\x6c\x4b\x0b\x8a\xc4\x9b\x02\x82\x32\x43\x99\x5c\xe8\x73\xbf\x2b\x23\x2a\x2d\x90\x1c\x29\x02\xa4\x4b\x88\xb5\x89\x2e\x8b\xb6\x2e\xe3\x53\x4d\x56\x6b\x84\x0d\x98\x91\xc2\xbd\x68\x44\xe3\x77\xbd\x22\x2d\x74\x97\x8c\xd4\xba\x4f\x8b\xae\x14\xeb\x15\x15\x28\x15\x33\xd0\xdb\xdc\xcb\x48\xa1\xa7\x14\xf9\xdc\x25\x24\x1a\xe8\x32\x8e\xa9\x7c\x43\xe4\x45\x94\x14\x51\xae\x21\x48\xf5\x2e\x01\xe2\xa1\x24\x71\xac\x24\x71\x26\x61\x83\xc1\x48\x46\x01\xd5\x56\x67\x86\x67\x4e\x0c\x97\xed\x12\x52\xe6\x8f\xfa\x2f\xd3\x45\x7f\x59\x2f\x6c\xc0\xc4\x72\x1a\x91\x8e\x99\x81\xee\xd4\x63\x8d\x4d\x7f\x4f\x46\x18\x33\x33\xe5\x1a\x42\x75\x18\x60\x12\xf5\xd8\x58\xd4\x1d\x9a\x64\x24\x16\x30\x4d\x71\x0a\x58\xa9\x05\x86\xc2\x14\xb4\x28\xdb\xef\x16\x7c\x4b\xf9\xd8\x61\x90\x91\x42\x23\x6b\x99\xaf\x2d\x55\x85\xc6\x34\x99\x01\x84\x4b\x7e\xa5\xda\xe4\x8b\xb1\xd1\x5e\x4f\xce\x68\x41\x39\xac\x6f\x60\xd2\x34\x93\x53\x73\x39\x26\xaa\xbf\xf7\x2a\xaf\xcf\xf4\xa2\xfe\x3c\x6c\xce\x1d\xba\x45\x36\x45\x40\x93\x22\x82\x4b\xe2\x94\x21\x79\x91\x75\xba\x3c\xd5\x25\xa6\x68\x4b\x10\x01\xc3\x8a\x26\x39\xe4\xa9\xa9\x85\xd2\x2c\x2f\xa2\x0d\x0e\x11\x01\xcd\x3f\xff\x3e\xe6\x9f\xa7\x16\xb2\x2e\x43\x2d\xfa\xbf\xf7\x2a\x88\x43\xb8\x2b\x9d\x9c\x9b\xd0\x5f\x12\xd4\x54\x10\x39\x26\xaf\x7b\xf0\xb9\x08\x1a\xb4\x20\x92\x1d\x8e\xa7\x2e\xb2\x33\x14\xd1\x80\xb4\x0c\x55\x05\x60\x23\x45\x8a\x4c\xa2\xb1\x54\x65\x66\xa1\x2a\xac\xad\xb6\xf1\x8a\xee\xbe\xce\x46\x99\x6c\xbb\x9a\xfc\x0a\x28\x22\xd3\x3b\x5d\xe6\xe2\x9e\xd7\x00\x79\xdc\x03\x63\xb7\x75\xcc\xb3\xe0\x7a\x9c\x04\x64\x56\x92\xfa\x12\xd9\xe0\x39\x51\xa5\x65\x02\x5d\x9a\x6e\x3f\x31\x5d\x12\xd5\x3b\x6c\x92\xdb\xa9\x44\x77\x25\xa4\x57\x81\x76\x88\x06\x66\xa7\x3f\x33\xbc\x7a\x0c\x61\x53\x4e\xdd\xa5\x9d\x86\x63\xb8\xd2\x44\x83\xf1\x43\x1b\xb8\x14\x19\xeb\x14\xc1\x3d\xa5\xfa\x2a\xa3\x73\xb3\x83\xd8\x3d\xf9\x15\xa1\x9a\xa2\xad\x48\xc8\x13\xd5\xe1\x7c\x55\xae\xda\x06\xf6\x0a\xa0\xcd\xe8\x0b\xf5\x27\xaa\x2d\x45\x06\x26\xda\xaa\x32\x09\x4c\xa2\xfe\x3a\x58\x34\x5c\x8d\x0d\xa6\x3a\x56\x03\x84\xcb\xf8\x50\xbe\xa9\x66\x1d\xeb\x37\xeb\x76\x0f\x93\x90\xd1\x02\x8f\xd5\x36\x92\x18\x50\xc7\x3d\x06\xc0\xf8\x56\xe3\xeb\xb1\xee\x8a\x36\xbd\x6c\x45\x3d\x44\x1a\x70\x44\xa3\xc9\x49\xb4\xc8\x8b\x2a\xcd\x22\xa4\xc8\xf1\xcf\xb6\x41\x18\xfb\xf5\xed\xe0\x5a\xda\x2e\x55\xa2\x36\x57\x65\x04\x3e\x0b\x54\xa2\xee\xac\xe3\xb3\xe7\x29\x8c\x0f\xa5\x29\x29\x09\x24\xc7\x8a\x53\xa9\xcd\x4b\x76\x6c\x54\xd4\x97\x9e\xa7\x4e\x4c\xf9\x15\xc6\x78\x59\x1c\xe1\xd6\x80\x49\xe0\x79\x9b\xdc\xb2\x57\xa1\xe7\x3d\x19\x9d\x1a\x18\xeb\xa4\x7d\xaf\x98\x30\xd6\xa9\x19\xd8\xeb\x4c\x5d\x06\xd0\x7e\x44\x3a\xb6\xd1\x8f\x0e\x33\x4d\x79\xcc\x06\x2f\x5a\x1b\x9d\xa9\x44\x7d\xce\xbc\xd4\xe7\x07\xf0\xec\x66\x7a\xf3\x3a\x53\x31\x1c\x35\xeb\x7e\x57\x97\xe3\xa9\xa6\x50\x05\xac\x89\xe1\x3e\xc7\x86\x57\xef\xa6\xb1\xb7\x30\x82\x09\xa0\x29\xb4\x67\x43\x82\x9a\xb2\xa9\x4c\xd7\x1a\x62\x0b\x34\x59\x91\x96\x84\x29\xd7\xe7\x78\x6a\x65\x1f\x47\x0a\x3d\xeb\x29\xf4\xbc\x87\x91\x53\x5d\x06\x49\x4f\x66\x26\x3d\x99\x46\x75\x97\x8b\x54\x3e\x6b\x7f\x84\x4d\x66\x26\x56\xb5\x7b\x12\x65\xc3\xb1\x48\xbf\xe9\xbf\xf6\x9b\x6b\x5c\x0b\x18\x84\x1d\xc4\x1a\xc6\x05\x86\x53\xcf\x63\xb6\xc2\x2e\xb2\xb3\xc2\x46\xf4\xb0\x94\x46\x89\x81\x49\x0b\xd3\x05\x2f\x2a\x5f\x9b\x12\x2e\x33\x31\x89\xfa\xff\xe6\xfd\x59\xd9\xda\x55\x7d\xbe\xa0\x17\x03\x0c\x4f\x0d\x46\x98\x98\xa8\x04\x3e\x33\x5d\x38\x86\x01\x53\x95\x3f\x60\xc3\x51\x29\xd1\x14\xce\x24\xa6\xa4\x0b\xed\xf1\x50\xae\x01\xb3\x63\xce\x0c\x37\x8a\x75\xac\x16\x69\x72\x0d\xf4\x3c\x6e\x62\xb8\x26\x30\xeb\x45\xec\xb0\x85\xc7\x54\x95\x73\x9e\x67\x38\xdb\xc3\xe6\x6b\x5a\x4e\x10\x39\x81\x23\x71\x5e\x40\x5e\x49\x05\xcd\x75\xb0\x89\x64\x63\x98\x0a\x8d\xea\x4e\x8e\x2f\xc6\x01\x35\xd3\xc9\xa2\xce\x50\x24\x39\x5a\x40\x6a\xc2\x90\xa7\x62\x96\xaf\xc7\x50\x07\x0c\xa7\x61\xd0\x2d\xb4\x25\x22\xdc\x58\x9c\xe2\x7d\x4e\xc2\x0b\xd9\x73\x08\x3b\x00\x56\x27\x1b\x9b\x88\x28\xd3\x57\x10\x86\x14\x01\x37\xe6\xa6\xa0\xcf\x09\x48\x4e\xe7\x46\xa8\xf1\x70\x3c\xd9\x9f\x8d\x20\x5d\xd3\xff\x5b\xf1\xc8\x93\x12\xb5\xfd\x0a\xfd\xf4\x02\xca\x8d\xaa\x4c\xe6\x7a\x85\x46\xa8\x16\x0a\x6d\xb7\x28\xa0\x34\x2d\x4c\xcd\x31\x87\x30\x82\x82\x48\x03\x69\x0a\x78\x01\xa9\x31\x9c\x58\x13\x87\xbc\x01\xed\xa1\xc0\xb5\xb6\xef\xd3\xf3\x35\x3e\x82\x88\x0f\x78\x89\x1b\x8a\x53\x30\xe0\x50\xbc\xc3\xa2\xcc\x98\x15\x99\xa6\x80\x4a\x03\xa9\x89\x36\xc4\x29\xce\x8b\xad\xd7\x99\x5a\xe9\x9f\x6e\x1b\xa5\x49\x0e\x01\x43\xe1\x05\xf1\xe8\xd6\xeb\x10\xea\x3d\x1c\x5f\x9e\x6d\x0b\x91\x78\x89\xc4\x53\x7b\xb1\x45\x8f\x42\xc6\xa0\x9d\x70\xa5\x6a\x3e\x6e\x64\x04\xa4\x46\x4b\x2d\x9c\xe7\xc5\x5a\x47\x41\xcc\xa6\x88\x66\x75\x15\x24\x26\x65\x51\xa5\x85\x96\x88\x1f\xd0\xcf\x93\x38\x08\x53\x92\xe1\x24\x86\x65\x45\x86\x1c\x8a\x24\x2f\xc0\xb1\x66\x1b\xf7\x8c\xe3\xb8\x37\x05\xa4\xd6\xe6\x45\x73\x2c\x4d\x25\x5e\x5c\xf5\x3b\xe5\x7f\xf1\x6c\x35\xc6\x3e\x41\x03\x49\x44\xa4\x31\x87\x48\x03\x4e\x52\x1b\x0a\xc2\x0c\x85\xa9\x4a\x73\x28\x2e\x70\x12\x2d\xf3\x10\x1f\x85\x5b\x98\xb2\xd8\x3d\x4a\x03\x94\x23\x85\x29\x39\x90\x9a\x17\xd3\x3d\x95\xf9\xa1\x42\xcf\x8f\xc3\xa4\x87\x42\x4b\x1a\xf3\x62\x4d\x90\x5a\xe4\x80\x45\x44\x9c\x5e\xb0\xbe\xd6\xc6\x97\x66\x3b\xf5\xf5\x01\x3d\x3f\xa8\x6f\x4b\x4b\x61\x52\x3d\xd6\x64\x03\xda\xe9\x18\xea\x73\x1a\xa3\xa4\x3e\x5e\x85\xb2\x29\x72\x62\xad\x25\x49\x74\x3f\x97\x4f\x86\x03\xf4\x50\x00\xb0\x1f\x9c\x24\x4e\xe7\xde\x76\x3c\x60\x6c\xfa\xf4\xec\x99\xc4\x0c\x25\x89\x6e\x72\xe2\x01\x79\x6f\xe1\x4d\x56\x82\xfa\x59\xdd\x2e\x2b\xc1\x3e\x89\x38\xb4\xb1\x10\x5e\x11\x83\xa4\xba\x2a\x82\x41\x1a\x7f\x4d\x19\x12\xc2\xe8\xb6\x27\x33\xa3\xc2\xa5\x63\xce\x2e\x6f\x14\x31\xd6\xca\x2e\xa6\x36\x02\x93\x10\x73\xbe\x1f\x6b\x43\xfb\xa8\x12\x85\xfd\x43\xf2\x38\x60\x65\xf3\xb6\xc6\x5d\x92\x5c\x0b\x4c\x12\xe9\xb2\xb2\x8a\xa8\x0a\x85\xab\x6d\xfc\x45\xc3\xa4\xc5\x46\xf9\xae\xe8\x4a\xaf\xa6\x0c\x16\xaa\xd2\x3f\xf8\x9c\x00\xb1\x50\xc4\x66\x0a\x1b\x90\x5b\x39\x9b\x96\x1a\xe8\x6d\x11\x3f\xc6\x1b\x62\x2a\x55\x55\x99\x11\xcc\x36\xb9\x30\xc9\xc6\x42\x15\x90\xb8\x57\x91\x96\x86\x93\xc5\xf8\x45\x7b\x54\x87\x86\x63\x0f\x97\x22\xa4\xc1\x56\x1d\x68\x2f\x15\x66\xd1\xc3\xe8\x40\x77\xf0\xa9\x8e\x31\xa1\xaa\x50\xb6\xe1\x49\x09\x45\xce\xbb\x14\x01\x63\x1f\x29\x31\x3b\x74\xcd\x68\xe3\x81\xee\xb1\x76\x0e\x7f\x39\x72\x41\xd2\x43\x50\x60\x76\xe8\x60\x54\x61\x48\x0b\xc6\x60\x1e\x13\xe8\x58\xd5\x56\xea\xbe\x0d\xc7\x04\x1b\xf2\x66\xd3\xa2\xbd\xba\xaf\xa7\xf1\x7c\x1d\x08\x4e\xc3\x20\x9c\x3a\x8c\x01\x16\x3a\x9f\x7f\x97\x11\xbb\xef\x1a\x79\x59\x14\xdb\xf0\xc5\x59\x3c\x26\xf8\x2b\xfb\xa2\x67\xbe\x71\xb3\x2c\x36\x52\x28\xf8\xbc\x32\x70\x36\xbe\x7b\x7e\x5e\x86\x63\xb8\x16\x9a\xc7\x8f\x79\x7b\x22\xe4\x15\xd7\x3e\x1c\xfb\x15\x65\xd4\x85\x8e\xd5\x10\x4e\xae\x4d\x25\x85\x8e\x72\x38\x6d\x71\x8a\x0f\xa4\x96\x34\xe0\x48\x89\x17\x88\xa2\x2c\x8c\x11\x4c\x18\xb3\x36\x75\xac\xb6\xd4\xb1\x57\x50\xd0\x52\x68\xe3\x9e\xd0\x96\x30\x55\x9e\xdb\x12\x46\x2e\x74\x17\x24\xea\x22\xaf\x27\x35\x16\x9a\xa2\xc2\x5c\x4f\x4f\x55\xc0\x69\x7c\x24\x66\x66\x2a\xf4\x8b\x2a\x4a\x89\xe9\x02\x88\x5b\x0c\xc7\x52\x39\x5e\x82\x80\x4a\x3c\x8b\x48\xa4\x00\x54\x1a\xe2\x28\x88\xd2\x40\x22\x76\xea\x4a\xf4\x4c\x6f\x8b\x36\xdd\xda\xd3\xb1\x82\x9e\x82\x5e\x91\x16\x23\x4c\xe2\x61\x1f\x78\xb9\xb6\x34\xdb\x64\x32\xc2\x0e\xd5\x91\x68\x01\x30\x22\x2b\xd6\x9a\x1c\x1b\x90\x56\x5b\x7a\x11\x2b\xdc\xcc\x38\x26\x5f\xf0\x19\x51\x07\x82\x1d\xf0\xaa\x92\x8d\x55\x86\x32\x19\x99\x18\x59\x33\xe6\xeb\x7b\x22\x26\xbd\x0c\x85\x56\x57\xac\x70\x13\xc3\xe3\xfa\x9a\x8c\x06\x66\x0b\x24\x66\x1b\xc6\x2d\x64\x34\x14\xd2\xf1\x16\x5d\xe4\xdb\x14\x36\x50\x46\x72\x0d\x51\x65\x8e\xb0\x04\x34\x86\x71\x83\xc6\xa3\x28\x8c\x73\xce\xc4\x19\x5b\xba\x55\xc4\x52\x5b\xb6\x4f\xc2\x1b\x2c\xc2\x0c\x25\xe8\x97\xc5\x2c\xbf\x20\xba\xd2\x94\x6f\x93\x88\x00\x73\xb4\x80\xf1\x35\x59\x45\x44\x50\x87\x71\x06\xa3\x20\xb5\x86\x20\x4a\x82\xd8\x22\x9b\x9c\x80\xf2\x62\x3d\x68\xb0\x28\x3d\x16\xa7\x52\xc1\x9f\x31\x2f\xb2\x38\xbd\xe0\xe0\x7d\x9e\x2b\xe8\x29\x82\x16\x3d\x0f\x1a\xa9\x9c\x02\x1c\xc6\x8c\x63\x16\x79\xa5\xa1\x7d\x2c\xe2\x0e\x16\xc1\x19\xe8\x13\xb2\x36\xa0\x6d\xe7\xb2\xf2\x53\x92\xe5\x45\xa6\xcf\x8b\xd2\x40\x6a\xa5\x65\xd3\xf1\xaf\x80\xd4\x06\xac\x88\x92\xf4\x3c\x68\xb1\x12\xd7\x60\xa7\xa4\xc0\x6d\xf4\x67\x03\xce\xfa\xb9\x48\x36\x59\x04\x97\x04\xc0\xad\xea\x0a\x08\xda\xe0\xc5\x5a\xe6\x6f\x05\xe8\xaf\xb8\xa1\x20\x92\xb4\x30\x4d\xeb\x7b\xc4\x34\x26\x65\x49\x6d\x48\xe2\xab\xb8\x11\x93\x79\x74\x6b\x7d\x5f\x24\x69\x92\x9b\x82\x11\x3d\x0f\x78\xb1\xb5\xf6\x11\xeb\x71\x9d\xd1\x15\x41\x63\x2c\x88\x78\x2b\x6d\x83\xa4\xd9\xf4\xfb\xda\x8e\x9f\xb5\xd9\x05\xff\x7e\x45\xbb\x5d\xe0\xf6\x31\xb6\x7b\xed\x07\x29\x42\xa2\xd6\xf7\x0b\x9b\xbc\xb6\xd5\x86\xbb\xb2\xa3\x80\x6a\x6d\xcb\x7b\x51\x4f\xe5\x1b\xd0\x7e\x6e\xc5\x5b\x1b\xcf\xde\x22\xb3\x1b\x6d\x71\x0d\xa9\x45\x12\xac\xc4\x90\x0a\xb2\x92\xb9\xac\x8d\xc2\xde\xc9\xa2\xcd\xb5\x70\x86\x15\xc1\x81\xfa\x07\x64\xae\xb0\x75\xb2\x68\xaf\x64\x69\x03\x16\x2b\x32\xbc\x82\xae\xf3\x18\x0a\x02\x5a\x45\x3f\x74\xbe\xbe\x58\xd9\x64\xa5\x6e\x33\x4d\x76\x39\x78\xb1\x61\xae\x6b\xcf\x27\x15\xbc\x1c\x38\xdb\xbf\x0b\x58\xa6\x53\x9f\x41\x7e\x6c\x8e\xc1\x56\x39\xab\xa6\x7f\xfc\x59\x81\xbf\x32\xb2\x7b\x15\x75\x62\x40\xf8\x1d\xe3\xeb\xe6\x77\x2b\xf7\x9d\x34\xb9\xca\xb5\x64\xf8\x75\x36\x65\x0b\x9f\x0f\x79\x1c\x35\x2a\xd4\x2c\xf5\xd7\xd8\x2a\x9f\xb2\x84\xcf\x28\x02\x45\xa8\x66\x3f\xcd\x0d\xd0\x62\xb5\x2b\xb5\x41\xac\xca\x38\x6a\x92\x0c\xa2\x57\x1a\x82\x2a\xf7\xf1\xfe\x72\x34\xef\xd7\x7f\x61\x3b\xbd\x91\x1b\xde\x18\x1b\x32\x6b\x59\x62\x71\x36\xcd\xc5\x48\xbc\x2a\x73\xd4\x48\xe1\x86\x46\x5b\x4a\x44\x6c\x12\xa8\x1e\x07\xed\xf2\x9e\x4c\x0f\x45\x9a\x10\xd8\xa0\x29\x90\x92\x20\xb5\x24\x5e\x41\x2e\xb7\xff\x2c\xf6\x8a\x42\x5e\x1b\xf6\x5e\xfd\xd4\x8e\x8a\x68\xa3\x01\xe3\x71\x76\x4a\x33\xc4\xf4\x84\xec\x43\xdb\xd7\x5a\x3f\x5f\x8d\xe9\x5a\xbf\xa0\xad\x5d\xf3\x60\x23\xa7\x50\x7f\x1e\x12\x26\x1c\x63\x78\x04\x88\x25\x98\xbf\x57\xd8\xa0\xa1\x7a\x1c\x30\x5e\xd0\xc2\x5e\xad\xcb\xb3\x50\x9e\x48\x14\xe6\x9c\x8d\xe5\xa1\xe7\x7e\x57\x46\x0b\xfb\x2b\x99\xc4\x74\x3b\xf6\x81\x39\x5f\x75\x35\x16\x67\x6a\x06\x06\xf3\xe2\x6a\xa0\xe6\xb9\x19\x5d\xc6\x61\x2e\x62\x66\xd8\xab\xf8\x04\xc6\x14\xac\xe1\x8a\x78\xef\xb0\x8e\x14\xf3\xa2\x40\xd8\xaa\xc3\xe2\x47\xec\x34\xaa\xaf\xe3\x59\x5f\xaf\x30\x48\x11\xe7\xf2\x85\x3e\xf3\x68\x61\x8b\x07\x85\x8d\xe8\x29\x74\x5c\x7c\x57\xf9\xc6\x51\x99\x3b\x51\x66\x53\xae\x36\xec\xe2\x65\x76\x75\x23\xd7\x36\x2e\xe4\x65\xa3\xad\x54\x37\x56\xb2\x57\xf4\x43\x16\xed\xbd\x18\x87\x3f\x65\x73\xf7\x62\xfa\x85\x29\x57\x37\xe5\x69\x95\x73\x1f\x38\xc7\x9f\x15\x78\x99\x9b\x63\xb8\xa5\xbf\xf1\x3d\xf8\x9a\x97\xd9\x90\xf1\x0c\xaf\x0d\xfe\xff\x99\xb6\xcc\x1f\x61\x78\x42\x11\x28\x9f\xe6\x25\xa1\xdd\x97\x70\x74\xe0\xe2\x4e\x3a\x1f\x4c\xe2\x8b\x81\xa7\x4e\x0c\x80\xc3\xdc\xfc\xd8\x6c\x06\xcb\x3e\x51\xf4\x15\x9f\x9b\x04\xbe\x39\x07\x3e\xd3\xdb\x20\x31\x95\x09\xcc\xa5\x65\xf3\x59\xf5\x7c\xae\xba\xb3\x8a\x53\xd2\xb9\xea\x5d\x3b\xb9\xf2\x53\x6d\x12\xd5\x31\x74\x59\xcc\xb3\xa9\x30\x57\xee\x31\x60\x84\xc1\xb2\xac\x9d\xe5\x3f\x1b\x30\xdf\x6b\x8f\xb0\x09\x1c\x67\xd4\x54\x62\x6f\xfe\xec\x09\xce\x33\x40\x1a\x17\x3c\x4b\xe7\x20\xda\xf9\x5c\x21\x5f\x9f\xae\x74\x98\xdf\x9c\x87\xe0\x66\xba\x2b\x16\x39\xfc\xe5\x08\x23\x13\x38\x17\x48\xb5\xe9\x89\x81\x49\x69\x6e\x90\x6a\x33\xd1\x48\x46\x27\xa6\xd3\x48\xe7\x5b\x4d\x8c\x5c\xa8\xab\xbc\x7e\x3d\x8f\x7f\x5e\x67\x23\x8c\x8c\xf2\x38\x28\xc7\x9f\xb5\x21\x9d\x55\x17\x78\x14\x81\x2e\x29\x82\xcb\xe0\xc3\x9c\x2c\x59\x7f\xe5\xea\x79\x7e\xdc\xf6\xfd\x55\x2e\x7d\x59\x7f\xa2\x3a\x34\x18\xb9\xf8\xcc\x24\xa6\x36\x6d\xfb\xff\xbe\xfd\xf2\xe5\xd2\x45\xd0\xdf\x4b\xe7\x8a\x7c\x2f\x5d\xf7\xf4\x5b\xe9\xb2\xf2\x07\x56\x41\x96\xfd\x99\x15\x06\xa1\x3f\x73\xf2\x05\x96\xdb\xc7\x2c\x1d\xa8\x55\x76\x4c\xb8\xff\x2f\x3e\xbc\x0b\x73\xb5\x6e\x14\x6e\x4e\xac\x47\x91\x63\x7b\xd6\xc1\x9d\xa1\xc9\xc6\x73\x2a\x83\x78\x6a\xb9\xeb\xb1\x25\xec\xfd\x6c\x37\x54\x0e\x61\xf1\x78\x18\xec\xed\xdd\x4d\xb1\x3a\x59\x0b\xfd\xfb\x6c\xf3\xe5\xd1\x35\x52\x5f\xbe\xc1\xae\x1d\x20\xdf\x49\xb2\x14\x4b\x7d\x37\xb7\x78\xa6\x6b\x7e\x8f\xee\xf3\xdc\xdb\xe2\xb9\x22\xde\xba\x7f\x44\xb6\x8d\xf4\x71\x7b\x93\x2a\x6f\x68\xc0\xe2\xad\xf8\x9d\x76\x40\x1f\xda\xca\x5c\x79\xb7\xad\xcc\xf5\x7c\x63\x65\x8a\xd8\x63\xe8\x03\x2b\x63\x10\x3c\xdc\x0a\xee\x61\xb1\x13\xc7\xdc\x5b\xf2\xe5\x98\xdb\x54\x3b\x74\x6c\x54\xba\x7e\x9c\x1b\xde\x3c\xde\x70\x96\x66\x5a\xe1\x01\x8a\x9e\xc2\xab\xd8\xfd\x90\x93\x36\xff\xb9\xb5\xab\xe6\x2a\x78\x5b\xbb\x29\x72\x68\x40\x7f\x1b\x2c\x3e\x5b\x2c\xff\xb8\xbd\x68\x3e\xba\x60\x49\xfd\xae\x35\xfa\x56\x3a\x20\xb0\x7f\x94\x0e\x6c\x97\xfc\xa3\x74\x74\xf9\x7a\xb1\xc5\x7b\x7f\xb7\xe0\xf7\x77\x11\xc0\x95\xee\x9c\xeb\xdd\x05\x1a\x73\x84\x74\xbb\x15\xdf\x71\xd7\x7f\xf5\xc2\xa3\x95\xce\xac\xea\x2f\x47\xd6\xcc\x0a\xb3\xcd\xf2\x07\x8e\x79\x38\xbb\x37\xa3\x1c\x19\x7e\x70\xe2\xc4\x9b\xeb\xe5\xf6\xa2\xa3\x36\xac\x99\x06\x92\x94\xbd\x64\x7a\x08\x85\x67\xc0\x8e\x94\x87\x42\xed\xd0\x16\xdc\x72\xb6\xd9\x23\x3f\xe4\xec\x68\xa9\x58\x0b\x6d\x2b\x2e\xce\x54\x13\xf6\x98\x7d\x10\xf7\x43\x80\x8c\xd0\x89\xad\xd0\xd1\x0e\x52\x1e\x7e\xca\x1a\x00\x83\xf1\x51\xe2\xed\xb3\x73\xf7\x2a\x43\xe6\x6a\xb1\x9f\x6e\x52\xee\x59\x51\x24\x4c\x34\xef\x00\x2a\x9b\x9f\x72\x3c\x09\xad\x68\xe2\x03\xb8\xe3\xa6\xb2\x73\x98\xe4\xee\xa7\x5c\x37\x33\x89\xd5\xc0\x70\x53\x92\xbc\x04\x80\x33\x35\x0b\xfd\xea\xac\xf7\xd5\x12\x13\xcb\x98\x9e\x43\xcf\xb5\xe2\xd0\x31\x98\xbc\x76\xd3\x09\xea\x33\xcd\x01\x9a\xee\x00\x28\xa6\x17\x57\x8e\x02\xcd\x48\xb5\xd4\x5d\x31\xce\xbb\x90\x71\x9b\x57\x39\x76\x5c\xab\x6e\xdb\xa1\x65\xaf\x6c\x4c\x7d\x66\x85\x17\x6c\x9a\xce\xd9\xef\x7b\x85\x08\x65\x87\xc1\x09\x05\xf9\x89\xe2\x79\xb9\x74\x04\xc4\x81\x70\xea\x88\x26\x5c\xc7\xab\xb2\x0f\xcf\x27\x7d\xd8\xb7\x67\xd9\x06\x9e\xbe\xef\x39\xb1\x1f\x3e\xf0\x8e\x67\x03\xab\x50\x88\x7e\x02\x62\x27\x00\x56\x3f\x25\x75\xde\x09\x6d\xbf\x0f\x3b\x56\x7a\xb5\xc7\xa6\xef\xc4\x8e\xad\xc5\xd6\x71\x9b\xa2\x19\xa7\xb7\xf2\x1e\xd7\x89\xbc\x6a\x1a\x7c\x50\x7b\xbb\x10\xb7\x0c\xfd\xc6\x12\xf2\xbd\xe3\x13\x53\x27\xbf\xa6\x06\xe5\x45\x8e\x3d\x89\xa3\xc7\x0d\xe8\x85\xb1\xca\x76\x8d\xdf\x6b\xf6\xbe\xdd\xde\xbc\xca\x73\x4b\xef\xf8\xfe\x74\x8f\x31\xa5\xcb\xd8\xfe\xad\x74\x82\xb4\x07\xc3\x40\x6d\x43\x6b\xee\x35\x60\x85\xf1\xc9\xf8\xf3\x02\x27\xb7\x22\x43\xa6\xa1\x75\x08\xf3\x64\x30\x68\x03\x5f\xd7\xc0\xcf\x72\x82\xcf\xef\x19\x2f\xbe\xd1\x1f\x7d\x7b\x5f\xcf\x5b\xf9\x1b\x79\x5e\xf4\x32\xd7\xfb\xb5\xf3\x4f\x77\xbd\x5f\x9f\x3e\x5d\xef\xa7\xeb\xfd\x74\xbd\x7f\x3f\xd7\x6b\x5a\xe9\x2e\x62\xf3\xd3\xed\xfe\xda\x6e\xf7\x73\xc0\xfb\x0f\x1c\xf0\x56\x7e\x71\xaf\x2b\x7d\x7a\xdd\x4f\xaf\xfb\xe9\x75\xdf\xee\x75\x61\xf2\xfc\xd3\xe3\xfe\x09\x1e\x77\x97\x19\x9b\x3d\x2d\xde\x7f\xb3\x95\x50\xbf\xbb\xb9\x7d\x3c\x36\x55\xb3\x3b\x37\xb3\x8d\x7d\xd3\x37\x12\x38\x85\xd3\x6c\x3c\xee\x40\xde\x9d\xa6\x38\xd8\x30\x4c\xeb\xe0\x4f\xc8\x13\x8e\xe9\xb5\xfb\xa7\x27\x0c\xb9\xaf\xea\xe3\xda\xbd\x56\xa9\xd4\xee\xc7\x68\xcd\xc2\x9f\x8c\x27\x0b\xab\x69\xb7\x37\x77\x37\xb7\x0d\x07\x00\xc7\xb3\x6f\x1e\x6f\xd6\xad\xde\xe4\xe0\x6e\x08\xdf\xcb\x8e\xb7\xf6\x0f\xcd\x00\x1d\x90\xa9\x13\x98\x3f\xa6\x53\xa1\x26\x3c\x06\x6b\x67\xa2\xaa\x7c\xf7\xc6\xd8\x06\xc6\x26\xe5\x7f\x1d\x65\xfe\x8f\x92\x6f\xb7\x9f\xf0\x53\x86\x38\x37\xad\xb1\xe3\xa5\xa6\x38\x37\x42\x87\xac\xcd\xb9\x19\xba\x35\x10\x88\xcd\x6d\x4d\x37\x71\xc3\x7c\x7e\xbe\x1f\x5b\xd5\xda\x7d\x15\x43\xbf\xde\xe3\x95\x67\xfd\x7e\x8c\x3f\x55\x2b\x4f\x16\x5a\xab\xd6\x90\xc3\x36\x08\x9e\x11\x57\xcc\xd7\xa5\xd8\x5c\xc6\xf4\xd3\xa0\x56\x3e\x6d\x67\x4a\xb0\x7c\xd2\x50\xbd\xa3\x0d\xc0\xe1\xfb\xbc\x82\xd0\x9a\x39\xd6\xfc\x7d\x6c\xc1\x8f\x8b\xc3\x9b\x6d\xc5\xb6\x04\x7f\xbf\x3b\x62\x49\x8e\x1d\x72\x73\x81\xa2\x15\x06\xce\xf4\x22\xd5\xf7\xac\x3f\xd7\x6e\xd7\xde\x69\x96\x4e\xcb\x4e\xf4\xe3\xf3\x10\x6f\xbf\xc4\x66\xa9\xec\xb8\x98\x13\x63\x14\xf8\x57\x46\xd3\x77\xbc\x3d\x20\x8f\x58\xb5\x5c\x3a\x50\x60\x83\x9d\x07\xf0\xdf\x39\x8b\xf2\xaa\xd0\xe5\x4c\xa7\x8f\x77\xad\xfc\xaf\x6d\xf4\xef\x4a\x47\xaa\xe5\x52\x95\x49\x04\x6f\x19\x09\xcc\xb7\xa6\x11\xc1\xd9\x16\x2f\x3f\xc8\xf0\x10\xf8\xc2\xa3\x7a\xd1\x99\x30\xe9\xed\xef\x61\x39\xd3\xd9\x28\x33\x4f\x2d\xcf\x0c\x7c\xc7\x3b\xc1\x98\xcd\xeb\x3c\x29\x36\x61\x6f\xeb\x5b\xd7\x5a\x48\x17\xbc\xd9\x61\x4f\xff\x2e\xc3\xac\xb8\xca\xff\x7f\xb9\x74\xb6\xd0\x41\xa1\x7d\x23\x01\x7f\x94\x1c\xe9\xcc\x59\xf1\xa2\xbc\xbf\x0c\x4d\x4a\xd7\xd5\x3f\x41\xcb\x95\x2d\x5f\x9d\x10\x5b\x7e\xd7\xc8\x3f\x0f\xf8\x2f\xf7\x07\x3b\x2b\x6e\x76\x2b\x16\x2c\xd8\x52\xf8\xdd\xc1\xc3\xb7\x9f\xe5\x2a\x8a\xd7\x38\x94\x0e\x74\xfb\x17\x71\x15\x55\x68\x6b\xb1\x72\xe9\x32\x09\xf9\xe5\x5c\x45\x8e\xfe\x5d\xe9\x48\xb5\x3f\xdd\x55\x04\xd6\x9f\xe0\x2d\x82\xd0\x99\x69\xf1\xca\x5b\xe4\x78\xa6\x2f\xbc\xc8\x68\x5d\x6e\x66\x6f\x62\x38\xf2\xda\xae\x37\x18\x81\xc0\xfa\x59\x76\x20\xb0\x52\x53\x70\x8f\x20\xe8\xa7\x39\xd8\x33\x07\x00\xf8\x73\x69\xab\xb3\x75\xc3\xb0\xa2\xe8\x44\x36\x0a\x56\x21\xfd\x70\xae\x85\xa6\x65\x0a\xa1\x36\x1e\x3b\xc6\x99\xe2\x6d\x2d\xb6\xe6\xda\x42\x08\x35\x2f\x72\xe2\x62\x49\xef\x81\xd2\x49\x64\x71\x96\xeb\xc7\x56\x5e\x23\x3a\x51\x36\x4c\x0b\x6e\x23\x7f\x54\x1b\x2f\xd3\xc0\x1d\xb6\xaf\x75\xaf\x90\xa0\x83\x99\xf8\xef\x97\x8a\x22\x84\xf2\x18\x58\x16\x3c\x1f\xf0\xfe\x9d\x44\x73\xe7\xf7\x30\x83\x1e\xfd\x6c\xd9\x7b\x97\x11\xe6\x91\x2e\xfd\xf0\x72\xd7\xb7\xb3\xf1\xdb\x75\x0a\x5e\x3a\xc0\xf4\x4f\x0d\x7c\x7f\x0d\x2c\x44\xe1\x7a\xd5\xdb\x60\xfe\xa6\x06\xfe\x50\x90\xf8\xf7\x55\xbd\x1d\x55\xb9\x7b\x27\xb0\x07\xd9\xf8\xf3\x54\x6f\xea\x78\xa9\xc8\xb5\xd3\xfc\xfb\x3a\x9d\x55\xbe\x7b\x9b\x8a\x1a\xbe\x17\x39\x51\x0c\x67\x53\xcf\xbd\xec\x2b\x7f\xdb\x31\xb1\xae\xd1\xb3\x66\x16\x80\x58\xf0\x71\xe8\x6f\xbe\xb1\xf8\x00\xfe\x7b\x34\xb8\x2a\x1a\x2e\x2a\x17\x53\x86\x97\x10\xf1\xb8\x4e\x1d\x60\xd1\xa1\x77\xe0\x0f\xe0\xbb\x90\x36\x26\xf6\x8e\xbe\x7e\xcd\x09\x38\xcd\xb3\x2d\xd2\x01\xb1\x15\x6e\xce\x0b\xdc\xa2\x48\xf5\xa1\x8a\x3d\xa0\x78\xed\x01\xc7\xee\xaa\xc8\xc3\xd3\xd7\x87\x5a\xf5\x01\xad\xa0\x77\x35\xec\x01\x7d\xfa\xfa\xf0\xf5\xa1\x82\xa4\xdf\xbf\xe2\x0f\x35\xe4\xa1\x5a\x4b\x7f\x3c\x3f\x3d\xa0\xcf\xd5\x07\xec\xeb\xed\xdd\x8d\x33\xfe\xcd\xfa\x3d\xd1\x40\xb4\x35\xcd\xb0\xfb\x7a\xfa\x74\x3e\x2b\xfb\xe7\xee\xe6\xf6\x0e\x7e\x3b\x5d\xfc\xb0\x0e\x38\xd1\xb6\xe9\xcb\xfa\xd4\x3a\xb7\x44\x60\x5b\x37\xae\x7e\x25\xec\x87\xbe\xa6\xe5\x42\x99\xc8\x46\x03\x5d\x6b\xd1\xd0\x22\xcb\xec\x5b\xb1\x06\x53\xc3\x32\x9c\x13\xdd\x72\x6a\xa5\x13\x0a\xb0\xb2\xda\xff\x39\x9b\x62\xfe\x76\xde\x74\x9f\x48\x62\xef\x56\x2e\xd4\xe2\x8c\xce\xec\x36\x99\x8d\xb5\xfe\x28\x1d\xb3\x05\xad\xd7\xc0\x0a\x9d\xfc\x25\x64\x65\xc2\x0f\xad\x9b\xdf\x78\xb6\xf7\xa5\x7c\x92\x08\xef\xe8\x34\x9e\x3f\x38\x5e\x2b\x1d\x1e\xa6\x6d\xf4\xf0\x8f\xb7\xd9\xdf\x02\x99\xb3\xa1\xc5\x6d\x9d\x1b\xdc\x1e\x90\xe0\x1d\xe2\xe6\x2b\x45\x0a\x03\x7b\x04\xa6\x96\xc4\x7e\x94\x6f\x6a\x3b\xff\xde\x85\xb2\xab\xbd\x0a\x93\xd0\x4f\xec\x49\x90\xc4\x47\xc5\xb7\xbf\x59\xea\x0d\xda\xf6\xbd\x74\xa2\x3f\xd7\x4d\xb1\x42\xae\x41\x7a\xfd\xa0\x16\x3d\x46\xbf\x83\x66\x7e\xef\x9d\x54\xea\x9d\x64\x1f\x43\xf2\x7d\x50\xef\x22\xfb\x7f\xfa\x6c\xd8\xf5\x7a\x51\x8f\x16\x9e\x31\x48\xb7\x02\x9d\x78\xdd\x4a\x39\xd0\x42\xb8\x35\xd5\xf7\xba\xd6\xf1\x08\x67\xf5\xd6\xca\xe3\x3e\xab\xb8\xca\x8f\xce\x89\xbc\xd0\xb7\xbb\xa3\x8f\x56\x81\x5b\x47\x8b\x26\x87\x21\x7c\xbf\x3b\x78\xbb\xb0\xb9\x42\x0c\x43\xae\xaf\x48\xf5\x19\x41\x4a\x17\xd4\xdd\xb4\x06\x3f\x51\xd5\xa0\xda\xed\x30\xe3\x5d\xd5\xef\xd1\xf0\xbd\x58\x73\x3c\x2b\xfc\xb5\x34\xf1\x7d\xbd\xd0\xa5\xd4\xb8\x44\x2d\x8f\xd9\xc0\xbf\xa9\x25\xc8\xdf\x00\xf7\xcf\x31\x01\xf7\x68\xe9\x82\x7a\x1f\xa8\xfe\x05\x07\x3e\xf5\xfe\x53\xef\x3f\x50\xef\xb3\xc5\x6a\x7f\x0b\xb5\xdf\xbb\xfb\x27\x2b\x74\x46\xda\x4f\x7d\xfe\xd4\xe7\x8f\xd3\x67\x02\x24\xf0\x75\x5c\x94\x37\xb3\xbc\xd8\x0f\x9d\xbd\x91\xd7\xa7\x6a\xbf\x83\x6a\xef\x53\xf9\x53\xcb\x3f\xb5\xfc\xe3\xb4\x3c\xdf\x6f\xf2\xf7\xd0\xed\xbf\x64\xb4\x5e\x70\xe0\x53\xef\x3f\xf5\xfe\xe3\xf4\x7e\x10\x58\x1e\x3f\x71\xc6\x71\xee\x80\x3e\xd0\x00\x6c\x41\xfc\x60\x53\x90\x78\xce\xef\x89\xd5\xb5\xce\x4d\xb2\x6e\x17\x3e\xdf\xab\xe3\x50\xde\x48\x9e\xe2\x53\x7e\x9c\x9e\xa2\xce\xbe\xc8\xbc\x81\x0a\x3f\x11\x69\x23\x93\xa6\xad\xdd\x7d\x94\x79\x92\xd1\xbf\x6e\x57\xe0\x8b\x7c\xdf\x07\xf9\xd2\xdb\xea\x7d\x2f\x5d\xd0\xfd\x0f\x74\x51\x7b\xc6\xe2\xd3\x57\x7d\xfa\xaa\x3f\xc1\x57\xe5\xec\xf9\x7b\x04\xab\x7b\x77\x7f\x11\x1d\x2f\x88\xfc\xa9\xe3\x9f\x3a\xfe\x71\x3a\xce\x6f\xec\xd6\xfd\xd4\xef\x9f\xa0\xdf\x5b\x04\xfe\xd4\xed\x4f\xdd\xfe\x08\xdd\xfe\xa7\xac\x6f\x82\xdf\xf3\x2c\xd3\xfb\xaa\x56\xbc\xea\xe8\x8a\x82\xef\xa2\x62\x7f\xda\x3a\xa6\x1f\xd0\x95\x37\xcb\xef\x8a\xa5\xef\x78\xd4\xfa\x05\xcc\xdd\x3f\x56\xe2\x5d\xcf\xd3\x58\xe3\xff\xa1\x67\x61\x68\x86\x69\x3e\x61\xda\xd3\x7d\xa5\xf2\x5c\xbb\xaf\x3e\x5b\xe3\x7b\xdd\xac\x62\xf7\xe3\xaf\xc8\xd7\xb1\xae\x3d\xa3\x9a\xf5\xb4\x4f\x9e\xbc\x8f\x2b\x82\xee\x29\xf5\x61\xaa\xff\x95\xce\xc1\x28\x1d\x68\xf0\xcd\x82\x78\x4b\xc2\xf3\xfc\xf3\xc5\xae\xdb\x47\xbb\xfc\x63\x25\xae\x6a\xe2\x4f\x3a\xfe\xac\xdf\xa3\x66\x75\x7c\x5f\x7d\x7a\x7e\xba\xd7\x30\x1c\xbd\x37\xbe\x3e\x3d\x57\xaa\x26\x86\x62\x57\x49\xdc\xf8\x1f\x25\x71\x6f\xf1\x66\x7f\xd6\x81\x48\xe7\xed\x41\x61\x85\x3f\x8f\x41\xfa\x8b\x1f\x83\x74\x9e\xd5\x7f\x25\x45\xfc\xd9\xc1\xd2\x85\xa2\x70\x7d\x60\x74\xc8\x3a\x6c\x9c\x75\x74\xbd\x51\xd8\x3d\x02\x69\xb7\x27\x1b\x8d\x7c\x29\xbc\x5f\x93\xe1\x6f\xe0\x79\x49\x6f\x57\xe9\xdd\xd6\x3e\x4e\x8f\xdf\xd2\xcf\x0f\xd5\x59\xdd\x1a\x5b\x63\x0d\x41\xef\x31\x0d\xc3\xef\xab\x28\xfe\x74\xff\x5c\xd1\x9e\xef\xb1\x27\x6c\x3c\xae\x54\x0c\xab\x82\x56\xaf\xd0\xd9\xbf\xbe\xf3\x7c\x17\x9d\x7d\x1b\xdb\x8f\xe9\x67\xe9\xe6\xe6\xe6\xe6\x5b\xe9\x7b\xe9\xff\x0d\x00\xd6\x30\xb5\x57\x69\xb9\x00\x00")
 
 func rpProductionJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -464,11 +464,13 @@ var _bindata = map[string]func() (*asset, error){
 // directory embedded in the file by go-bindata.
 // For example if you run go-bindata on data/... and data contains the
 // following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
+//
+//	data/
+//	  foo.txt
+//	  img/
+//	    a.png
+//	    b.png
+//
 // then AssetDir("data") would return []string{"foo.txt", "img"}
 // AssetDir("data/img") would return []string{"a.png", "b.png"}
 // AssetDir("foo.txt") and AssetDir("notexist") would return an error
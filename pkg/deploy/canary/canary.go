@@ -0,0 +1,253 @@
+package canary
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/azure/auth"
+	"github.com/sirupsen/logrus"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/client-go/tools/clientcmd/api/latest"
+
+	pkgapi "github.com/Azure/ARO-RP/pkg/api"
+	mgmtredhatopenshift "github.com/Azure/ARO-RP/pkg/client/services/redhatopenshift/mgmt/2020-04-30/redhatopenshift"
+	"github.com/Azure/ARO-RP/pkg/deploy"
+	"github.com/Azure/ARO-RP/pkg/metrics"
+	"github.com/Azure/ARO-RP/pkg/monitor/cluster"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/redhatopenshift"
+	utilcluster "github.com/Azure/ARO-RP/pkg/util/cluster"
+	"github.com/Azure/ARO-RP/pkg/util/deployment"
+	"github.com/Azure/ARO-RP/pkg/util/kubeadminkubeconfig"
+)
+
+// requiredMetrics are emitted by every successful monitor run of a healthy
+// cluster
+var requiredMetrics = []string{
+	"apiserver.healthz.code",
+	"cluster.versions",
+	"clusteroperator.count",
+	"node.count",
+}
+
+type clusterManager interface {
+	Create(context.Context, string) error
+	Delete(context.Context, string) error
+}
+
+// Canary verifies a freshly deployed RP end to end: it creates a small
+// cluster in the RP's region, runs a smoke test suite against it and deletes
+// it again.  Rollout to further regions should only proceed if it succeeds.
+type Canary struct {
+	log         *logrus.Entry
+	im          *instanceMetadata
+	clusterName string
+
+	cluster           clusterManager
+	openshiftclusters redhatopenshift.OpenShiftClustersClient
+
+	// monitor runs the cluster monitor against the canary cluster, emitting
+	// to m
+	monitor func(ctx context.Context, resourceID string, m metrics.Interface) []error
+}
+
+// New returns a Canary for the RP in config.  The canary cluster is created
+// in the configured canary subscription, in a resource group of its own.
+func New(ctx context.Context, log *logrus.Entry, config *deploy.RPConfig, version string) (*Canary, error) {
+	if config.Configuration.CanarySubscriptionID == nil {
+		return nil, fmt.Errorf("canarySubscriptionId is not set for location %s", config.Location)
+	}
+
+	if _, found := os.LookupEnv("AZURE_TENANT_ID"); !found {
+		return nil, fmt.Errorf("environment variable %q unset", "AZURE_TENANT_ID")
+	}
+
+	deploymentMode := deployment.Production
+	if config.Configuration.RPMode != nil && strings.EqualFold(*config.Configuration.RPMode, deployment.Integration.String()) {
+		deploymentMode = deployment.Integration
+	}
+
+	capabilities, err := deployment.NewCapabilities(deploymentMode)
+	if err != nil {
+		return nil, err
+	}
+
+	// the resource group of the canary cluster is deleted with it
+	clusterName := "canary-" + version
+	if len(version) > 7 {
+		clusterName = "canary-" + version[:7]
+	}
+
+	im := &instanceMetadata{
+		tenantID:       os.Getenv("AZURE_TENANT_ID"),
+		subscriptionID: *config.Configuration.CanarySubscriptionID,
+		location:       config.Location,
+		resourceGroup:  clusterName,
+	}
+
+	authorizer, err := auth.NewAuthorizerFromEnvironment()
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := utilcluster.New(log, deploymentMode, im, true)
+	if err != nil {
+		return nil, err
+	}
+
+	canary := &Canary{
+		log:         log,
+		im:          im,
+		clusterName: clusterName,
+
+		cluster:           c,
+		openshiftclusters: redhatopenshift.NewOpenShiftClustersClient(capabilities, im.SubscriptionID(), authorizer),
+	}
+
+	canary.monitor = func(ctx context.Context, resourceID string, m metrics.Interface) []error {
+		return monitorCluster(ctx, log, capabilities, authorizer, resourceID, m)
+	}
+
+	return canary, nil
+}
+
+// Verify creates the canary cluster, smoke tests it and deletes it.  The
+// cluster is deleted even if creating or testing it fails.
+func (c *Canary) Verify(ctx context.Context) (err error) {
+	defer func() {
+		c.log.Infof("deleting canary cluster %s", c.clusterName)
+		deleteErr := c.cluster.Delete(ctx, c.clusterName)
+		if err == nil {
+			err = deleteErr
+		} else if deleteErr != nil {
+			c.log.Error(deleteErr)
+		}
+	}()
+
+	c.log.Infof("creating canary cluster %s in %s", c.clusterName, c.im.Location())
+	err = c.cluster.Create(ctx, c.clusterName)
+	if err != nil {
+		return err
+	}
+
+	err = c.smokeTest(ctx)
+	if err != nil {
+		return err
+	}
+
+	c.log.Print("canary cluster passed the smoke tests")
+	return nil
+}
+
+// smokeTest checks that the cluster can be read and its credentials listed
+// through the RP, and that monitoring it yields its metrics
+func (c *Canary) smokeTest(ctx context.Context) error {
+	c.log.Print("getting canary cluster")
+	oc, err := c.openshiftclusters.Get(ctx, c.im.ResourceGroup(), c.clusterName)
+	if err != nil {
+		return err
+	}
+
+	err = checkCluster(&oc)
+	if err != nil {
+		return err
+	}
+
+	c.log.Print("listing canary cluster credentials")
+	creds, err := c.openshiftclusters.ListCredentials(ctx, c.im.ResourceGroup(), c.clusterName)
+	if err != nil {
+		return err
+	}
+
+	if creds.KubeadminUsername == nil || *creds.KubeadminUsername == "" ||
+		creds.KubeadminPassword == nil || *creds.KubeadminPassword == "" {
+		return fmt.Errorf("canary cluster credentials are empty")
+	}
+
+	c.log.Print("monitoring canary cluster")
+	r := &recorder{}
+	errs := c.monitor(ctx, *oc.ID, r)
+	if len(errs) > 0 {
+		return fmt.Errorf("monitoring canary cluster failed: %v", errs)
+	}
+
+	return r.check()
+}
+
+func checkCluster(oc *mgmtredhatopenshift.OpenShiftCluster) error {
+	if oc.ID == nil || oc.OpenShiftClusterProperties == nil {
+		return fmt.Errorf("canary cluster has no properties")
+	}
+
+	if oc.ProvisioningState != mgmtredhatopenshift.Succeeded {
+		return fmt.Errorf("canary cluster is in provisioning state %s", oc.ProvisioningState)
+	}
+
+	if oc.ApiserverProfile == nil || oc.ApiserverProfile.URL == nil ||
+		oc.ConsoleProfile == nil || oc.ConsoleProfile.URL == nil {
+		return fmt.Errorf("canary cluster has no API server or console URL")
+	}
+
+	return nil
+}
+
+func monitorCluster(ctx context.Context, log *logrus.Entry, capabilities deployment.Capabilities, authorizer autorest.Authorizer, resourceID string, m metrics.Interface) []error {
+	configv1, err := kubeadminkubeconfig.Get(ctx, log, capabilities, authorizer, resourceID)
+	if err != nil {
+		return []error{err}
+	}
+
+	var config api.Config
+	err = latest.Scheme.Convert(configv1, &config, nil)
+	if err != nil {
+		return []error{err}
+	}
+
+	restconfig, err := clientcmd.NewDefaultClientConfig(config, &clientcmd.ConfigOverrides{}).ClientConfig()
+	if err != nil {
+		return []error{err}
+	}
+
+	mon, err := cluster.NewMonitor(ctx, log, restconfig, &pkgapi.OpenShiftCluster{
+		ID: resourceID,
+	}, m, true)
+	if err != nil {
+		return []error{err}
+	}
+
+	return mon.Monitor(ctx)
+}
+
+type instanceMetadata struct {
+	tenantID       string
+	subscriptionID string
+	location       string
+	resourceGroup  string
+}
+
+func (im *instanceMetadata) TenantID() string {
+	return im.tenantID
+}
+
+func (im *instanceMetadata) SubscriptionID() string {
+	return im.subscriptionID
+}
+
+func (im *instanceMetadata) Location() string {
+	return im.location
+}
+
+func (im *instanceMetadata) ResourceGroup() string {
+	return im.resourceGroup
+}
+
+func (im *instanceMetadata) Environment() *azure.Environment {
+	return &azure.PublicCloud
+}
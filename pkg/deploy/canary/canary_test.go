@@ -0,0 +1,160 @@
+package canary
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+
+	mgmtredhatopenshift "github.com/Azure/ARO-RP/pkg/client/services/redhatopenshift/mgmt/2020-04-30/redhatopenshift"
+	"github.com/Azure/ARO-RP/pkg/metrics"
+	mock_redhatopenshift "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/mgmt/redhatopenshift"
+)
+
+type fakeClusterManager struct {
+	createErr error
+	deleted   bool
+}
+
+func (f *fakeClusterManager) Create(context.Context, string) error {
+	return f.createErr
+}
+
+func (f *fakeClusterManager) Delete(context.Context, string) error {
+	f.deleted = true
+	return nil
+}
+
+func TestVerify(t *testing.T) {
+	ctx := context.Background()
+
+	resourceID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/canary-abcdef0/providers/Microsoft.RedHatOpenShift/openShiftClusters/canary-abcdef0"
+
+	succeeded := mgmtredhatopenshift.OpenShiftCluster{
+		ID: to.StringPtr(resourceID),
+		OpenShiftClusterProperties: &mgmtredhatopenshift.OpenShiftClusterProperties{
+			ProvisioningState: mgmtredhatopenshift.Succeeded,
+			ApiserverProfile: &mgmtredhatopenshift.APIServerProfile{
+				URL: to.StringPtr("https://api.canary.example.com:6443/"),
+			},
+			ConsoleProfile: &mgmtredhatopenshift.ConsoleProfile{
+				URL: to.StringPtr("https://console-openshift-console.apps.canary.example.com/"),
+			},
+		},
+	}
+
+	creds := mgmtredhatopenshift.OpenShiftClusterCredentials{
+		KubeadminUsername: to.StringPtr("kubeadmin"),
+		KubeadminPassword: to.StringPtr("password"),
+	}
+
+	emitAll := func(ctx context.Context, resourceID string, m metrics.Interface) []error {
+		for _, name := range requiredMetrics {
+			m.EmitGauge(name, 1, nil)
+		}
+		return nil
+	}
+
+	for _, tt := range []struct {
+		name      string
+		createErr error
+		mocks     func(*mock_redhatopenshift.MockOpenShiftClustersClient)
+		monitor   func(context.Context, string, metrics.Interface) []error
+		wantErr   string
+	}{
+		{
+			name: "smoke tests pass",
+			mocks: func(openshiftclusters *mock_redhatopenshift.MockOpenShiftClustersClient) {
+				openshiftclusters.EXPECT().Get(gomock.Any(), "canary-abcdef0", "canary-abcdef0").Return(succeeded, nil)
+				openshiftclusters.EXPECT().ListCredentials(gomock.Any(), "canary-abcdef0", "canary-abcdef0").Return(creds, nil)
+			},
+			monitor: emitAll,
+		},
+		{
+			name:      "create fails",
+			createErr: errors.New("create failed"),
+			mocks:     func(*mock_redhatopenshift.MockOpenShiftClustersClient) {},
+			wantErr:   "create failed",
+		},
+		{
+			name: "cluster failed",
+			mocks: func(openshiftclusters *mock_redhatopenshift.MockOpenShiftClustersClient) {
+				oc := succeeded
+				oc.OpenShiftClusterProperties = &mgmtredhatopenshift.OpenShiftClusterProperties{
+					ProvisioningState: mgmtredhatopenshift.Failed,
+				}
+				openshiftclusters.EXPECT().Get(gomock.Any(), "canary-abcdef0", "canary-abcdef0").Return(oc, nil)
+			},
+			wantErr: "canary cluster is in provisioning state Failed",
+		},
+		{
+			name: "empty credentials",
+			mocks: func(openshiftclusters *mock_redhatopenshift.MockOpenShiftClustersClient) {
+				openshiftclusters.EXPECT().Get(gomock.Any(), "canary-abcdef0", "canary-abcdef0").Return(succeeded, nil)
+				openshiftclusters.EXPECT().ListCredentials(gomock.Any(), "canary-abcdef0", "canary-abcdef0").Return(mgmtredhatopenshift.OpenShiftClusterCredentials{}, nil)
+			},
+			wantErr: "canary cluster credentials are empty",
+		},
+		{
+			name: "monitor errors",
+			mocks: func(openshiftclusters *mock_redhatopenshift.MockOpenShiftClustersClient) {
+				openshiftclusters.EXPECT().Get(gomock.Any(), "canary-abcdef0", "canary-abcdef0").Return(succeeded, nil)
+				openshiftclusters.EXPECT().ListCredentials(gomock.Any(), "canary-abcdef0", "canary-abcdef0").Return(creds, nil)
+			},
+			monitor: func(ctx context.Context, resourceID string, m metrics.Interface) []error {
+				return []error{errors.New("api server unhealthy")}
+			},
+			wantErr: "monitoring canary cluster failed: [api server unhealthy]",
+		},
+		{
+			name: "metrics missing",
+			mocks: func(openshiftclusters *mock_redhatopenshift.MockOpenShiftClustersClient) {
+				openshiftclusters.EXPECT().Get(gomock.Any(), "canary-abcdef0", "canary-abcdef0").Return(succeeded, nil)
+				openshiftclusters.EXPECT().ListCredentials(gomock.Any(), "canary-abcdef0", "canary-abcdef0").Return(creds, nil)
+			},
+			monitor: func(ctx context.Context, resourceID string, m metrics.Interface) []error {
+				m.EmitGauge("apiserver.healthz.code", 1, nil)
+				m.EmitGauge("cluster.versions", 1, nil)
+				return nil
+			},
+			wantErr: "canary cluster metrics missing: [clusteroperator.count node.count]",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			openshiftclusters := mock_redhatopenshift.NewMockOpenShiftClustersClient(controller)
+			tt.mocks(openshiftclusters)
+
+			cluster := &fakeClusterManager{createErr: tt.createErr}
+
+			c := &Canary{
+				log: logrus.NewEntry(logrus.StandardLogger()),
+				im: &instanceMetadata{
+					resourceGroup: "canary-abcdef0",
+				},
+				clusterName:       "canary-abcdef0",
+				cluster:           cluster,
+				openshiftclusters: openshiftclusters,
+				monitor:           tt.monitor,
+			}
+
+			err := c.Verify(ctx)
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Error(err)
+			}
+
+			if !cluster.deleted {
+				t.Error("canary cluster was not deleted")
+			}
+		})
+	}
+}
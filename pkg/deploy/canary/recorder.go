@@ -0,0 +1,55 @@
+package canary
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// recorder is a metrics.Interface which records the names of the metrics
+// emitted to it
+type recorder struct {
+	mu    sync.Mutex
+	names map[string]struct{}
+}
+
+func (r *recorder) EmitFloat(name string, value float64, dims map[string]string) {
+	r.record(name)
+}
+
+func (r *recorder) EmitGauge(name string, value int64, dims map[string]string) {
+	r.record(name)
+}
+
+func (r *recorder) record(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.names == nil {
+		r.names = map[string]struct{}{}
+	}
+	r.names[name] = struct{}{}
+}
+
+// check returns an error listing any requiredMetrics which were not emitted
+func (r *recorder) check() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var missing []string
+	for _, name := range requiredMetrics {
+		if _, found := r.names[name]; !found {
+			missing = append(missing, name)
+		}
+	}
+	sort.Strings(missing)
+
+	if len(missing) > 0 {
+		return fmt.Errorf("canary cluster metrics missing: %v", missing)
+	}
+
+	return nil
+}
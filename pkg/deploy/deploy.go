@@ -34,6 +34,8 @@ type Deployer interface {
 	PreDeploy(context.Context) error
 	Deploy(context.Context) error
 	Upgrade(context.Context) error
+	Rollback(context.Context) error
+	VerifyNSGs(context.Context) error
 }
 
 type deployer struct {
@@ -47,6 +49,7 @@ type deployer struct {
 	groups                 features.ResourceGroupsClient
 	userassignedidentities msi.UserAssignedIdentitiesClient
 	publicipaddresses      network.PublicIPAddressesClient
+	securitygroups         network.SecurityGroupsClient
 	vmss                   compute.VirtualMachineScaleSetsClient
 	vmssvms                compute.VirtualMachineScaleSetVMsClient
 	zones                  dns.ZonesClient
@@ -85,6 +88,7 @@ func New(ctx context.Context, log *logrus.Entry, config *RPConfig, version strin
 		groups:                 features.NewResourceGroupsClient(config.SubscriptionID, authorizer),
 		userassignedidentities: msi.NewUserAssignedIdentitiesClient(config.SubscriptionID, authorizer),
 		publicipaddresses:      network.NewPublicIPAddressesClient(config.SubscriptionID, authorizer),
+		securitygroups:         network.NewSecurityGroupsClient(config.SubscriptionID, authorizer),
 		vmss:                   compute.NewVirtualMachineScaleSetsClient(config.SubscriptionID, authorizer),
 		vmssvms:                compute.NewVirtualMachineScaleSetVMsClient(config.SubscriptionID, authorizer),
 		zones:                  dns.NewZonesClient(config.SubscriptionID, authorizer),
@@ -0,0 +1,146 @@
+package generator
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"fmt"
+
+	mgmtnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-07-01/network"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+// NSGRule declares a security rule of the RP NSG.  The ARM templates, the
+// rule documentation and the verification of the deployed NSG are all
+// derived from RPNSGRules, so a rule only ever has to be changed there.
+type NSGRule struct {
+	Name        string
+	Description string
+
+	Direction            mgmtnetwork.SecurityRuleDirection
+	Priority             int32
+	Protocol             mgmtnetwork.SecurityRuleProtocol
+	DestinationPortRange string
+
+	// SourceAddressPrefix is an address prefix or service tag.  It is ignored
+	// if SourceAddressPrefixesParameter is set.
+	SourceAddressPrefix string
+
+	// SourceAddressPrefixesParameter, if set, names the template parameter
+	// holding the list of source address prefixes of the rule
+	SourceAddressPrefixesParameter string
+}
+
+// RPNSGRules returns the security rules of the RP NSG
+func RPNSGRules(production bool) []NSGRule {
+	if !production {
+		return []NSGRule{
+			{
+				Name:                 "rp_in_arm",
+				Description:          "RP frontend; open to all sources in development",
+				Direction:            mgmtnetwork.SecurityRuleDirectionInbound,
+				Priority:             120,
+				Protocol:             mgmtnetwork.SecurityRuleProtocolTCP,
+				DestinationPortRange: "443",
+				SourceAddressPrefix:  "*",
+			},
+			{
+				Name:                 "ssh_in",
+				Description:          "SSH access to the RP VMs for debugging",
+				Direction:            mgmtnetwork.SecurityRuleDirectionInbound,
+				Priority:             125,
+				Protocol:             mgmtnetwork.SecurityRuleProtocolTCP,
+				DestinationPortRange: "22",
+				SourceAddressPrefix:  "*",
+			},
+		}
+	}
+
+	return []NSGRule{
+		{
+			Name:                 "rp_in_arm",
+			Description:          "RP frontend, called by ARM",
+			Direction:            mgmtnetwork.SecurityRuleDirectionInbound,
+			Priority:             120,
+			Protocol:             mgmtnetwork.SecurityRuleProtocolTCP,
+			DestinationPortRange: "443",
+			SourceAddressPrefix:  "AzureResourceManager",
+		},
+		{
+			Name:                           "rp_in_geneva",
+			Description:                    "RP frontend admin API, called by Geneva Actions",
+			Direction:                      mgmtnetwork.SecurityRuleDirectionInbound,
+			Priority:                       130,
+			Protocol:                       mgmtnetwork.SecurityRuleProtocolTCP,
+			DestinationPortRange:           "443",
+			SourceAddressPrefixesParameter: "rpNsgSourceAddressPrefixes",
+		},
+	}
+}
+
+// SecurityRule returns the ARM security rule for r.  If r takes its source
+// address prefixes from a template parameter, they are taken from
+// sourceAddressPrefixes.
+func (r *NSGRule) SecurityRule(sourceAddressPrefixes []string) mgmtnetwork.SecurityRule {
+	rule := mgmtnetwork.SecurityRule{
+		SecurityRulePropertiesFormat: &mgmtnetwork.SecurityRulePropertiesFormat{
+			Protocol:                 r.Protocol,
+			SourcePortRange:          to.StringPtr("*"),
+			DestinationPortRange:     to.StringPtr(r.DestinationPortRange),
+			DestinationAddressPrefix: to.StringPtr("*"),
+			Access:                   mgmtnetwork.SecurityRuleAccessAllow,
+			Priority:                 to.Int32Ptr(r.Priority),
+			Direction:                r.Direction,
+		},
+		Name: to.StringPtr(r.Name),
+	}
+
+	if r.SourceAddressPrefixesParameter != "" {
+		rule.SourceAddressPrefixes = to.StringSlicePtr(sourceAddressPrefixes)
+	} else {
+		rule.SourceAddressPrefix = to.StringPtr(r.SourceAddressPrefix)
+	}
+
+	return rule
+}
+
+// NSGRulesDocumentation returns a markdown document tabulating the RP NSG
+// rules of each environment
+func NSGRulesDocumentation() []byte {
+	buf := &bytes.Buffer{}
+
+	fmt.Fprintln(buf, "# RP NSG rules")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "<!-- Generated from pkg/deploy/generator/nsgrules.go by `make generate`; do not edit. -->")
+
+	for _, env := range []struct {
+		title      string
+		production bool
+	}{
+		{
+			title:      "Production",
+			production: true,
+		},
+		{
+			title: "Development",
+		},
+	} {
+		fmt.Fprintln(buf)
+		fmt.Fprintf(buf, "## %s\n", env.title)
+		fmt.Fprintln(buf)
+		fmt.Fprintln(buf, "| Name | Direction | Priority | Protocol | Port | Source | Description |")
+		fmt.Fprintln(buf, "| --- | --- | --- | --- | --- | --- | --- |")
+
+		for _, r := range RPNSGRules(env.production) {
+			source := "`" + r.SourceAddressPrefix + "`"
+			if r.SourceAddressPrefixesParameter != "" {
+				source = "`" + r.SourceAddressPrefixesParameter + "` parameter"
+			}
+
+			fmt.Fprintf(buf, "| %s | %s | %d | %s | %s | %s | %s |\n", r.Name, r.Direction, r.Priority, r.Protocol, r.DestinationPortRange, source, r.Description)
+		}
+	}
+
+	return buf.Bytes()
+}
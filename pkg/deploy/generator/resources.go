@@ -51,62 +51,22 @@ func (g *generator) managedIdentity() *arm.Resource {
 }
 
 func (g *generator) securityGroupRP() *arm.Resource {
+	var rules []mgmtnetwork.SecurityRule
+	for _, r := range RPNSGRules(g.production) {
+		// parameterised source address prefixes are filled in by
+		// templateFixup
+		rules = append(rules, r.SecurityRule([]string{}))
+	}
+
 	nsg := &mgmtnetwork.SecurityGroup{
 		SecurityGroupPropertiesFormat: &mgmtnetwork.SecurityGroupPropertiesFormat{
-			SecurityRules: &[]mgmtnetwork.SecurityRule{
-				{
-					SecurityRulePropertiesFormat: &mgmtnetwork.SecurityRulePropertiesFormat{
-						Protocol:                 mgmtnetwork.SecurityRuleProtocolTCP,
-						SourcePortRange:          to.StringPtr("*"),
-						DestinationPortRange:     to.StringPtr("443"),
-						SourceAddressPrefix:      to.StringPtr("AzureResourceManager"),
-						DestinationAddressPrefix: to.StringPtr("*"),
-						Access:                   mgmtnetwork.SecurityRuleAccessAllow,
-						Priority:                 to.Int32Ptr(120),
-						Direction:                mgmtnetwork.SecurityRuleDirectionInbound,
-					},
-					Name: to.StringPtr("rp_in_arm"),
-				},
-			},
+			SecurityRules: &rules,
 		},
 		Name:     to.StringPtr("rp-nsg"),
 		Type:     to.StringPtr("Microsoft.Network/networkSecurityGroups"),
 		Location: to.StringPtr("[resourceGroup().location]"),
 	}
 
-	if !g.production {
-		// override production ARM flag for more open configuration in development
-		(*nsg.SecurityRules)[0].SecurityRulePropertiesFormat.SourceAddressPrefix = to.StringPtr("*")
-
-		*nsg.SecurityRules = append(*nsg.SecurityRules, mgmtnetwork.SecurityRule{
-			SecurityRulePropertiesFormat: &mgmtnetwork.SecurityRulePropertiesFormat{
-				Protocol:                 mgmtnetwork.SecurityRuleProtocolTCP,
-				SourcePortRange:          to.StringPtr("*"),
-				DestinationPortRange:     to.StringPtr("22"),
-				SourceAddressPrefix:      to.StringPtr("*"),
-				DestinationAddressPrefix: to.StringPtr("*"),
-				Access:                   mgmtnetwork.SecurityRuleAccessAllow,
-				Priority:                 to.Int32Ptr(125),
-				Direction:                mgmtnetwork.SecurityRuleDirectionInbound,
-			},
-			Name: to.StringPtr("ssh_in"),
-		})
-	} else {
-		*nsg.SecurityRules = append(*nsg.SecurityRules, mgmtnetwork.SecurityRule{
-			SecurityRulePropertiesFormat: &mgmtnetwork.SecurityRulePropertiesFormat{
-				Protocol:                 mgmtnetwork.SecurityRuleProtocolTCP,
-				SourcePortRange:          to.StringPtr("*"),
-				DestinationPortRange:     to.StringPtr("443"),
-				SourceAddressPrefixes:    to.StringSlicePtr([]string{}),
-				Access:                   mgmtnetwork.SecurityRuleAccessAllow,
-				DestinationAddressPrefix: to.StringPtr("*"),
-				Priority:                 to.Int32Ptr(130),
-				Direction:                mgmtnetwork.SecurityRuleDirectionInbound,
-			},
-			Name: to.StringPtr("rp_in_geneva"),
-		})
-	}
-
 	return &arm.Resource{
 		Resource:   nsg,
 		Condition:  g.conditionStanza("deployNSGs"),
@@ -1298,6 +1258,7 @@ func (g *generator) cosmosdb() []*arm.Resource {
 
 	if g.production {
 		rs = append(rs, g.database("'ARO'", true)...)
+		rs = append(rs, g.databaseThroughput("'ARO'"))
 	}
 
 	return rs
@@ -1347,6 +1308,31 @@ func (g *generator) database(databaseName string, addDependsOn bool) []*arm.Reso
 				"[resourceId('Microsoft.DocumentDB/databaseAccounts/sqlDatabases', parameters('databaseAccountName'), " + databaseName + ")]",
 			},
 		},
+		{
+			Resource: &mgmtdocumentdb.SQLContainerCreateUpdateParameters{
+				SQLContainerCreateUpdateProperties: &mgmtdocumentdb.SQLContainerCreateUpdateProperties{
+					Resource: &mgmtdocumentdb.SQLContainerResource{
+						ID: to.StringPtr("Backends"),
+						PartitionKey: &mgmtdocumentdb.ContainerPartitionKey{
+							Paths: &[]string{
+								"/id",
+							},
+							Kind: mgmtdocumentdb.PartitionKindHash,
+						},
+						DefaultTTL: to.Int32Ptr(-1),
+					},
+					Options: map[string]*string{},
+				},
+				Name:     to.StringPtr("[concat(parameters('databaseAccountName'), '/', " + databaseName + ", '/Backends')]"),
+				Type:     to.StringPtr("Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers"),
+				Location: to.StringPtr("[resourceGroup().location]"),
+			},
+			Condition:  g.conditionStanza("fullDeploy"),
+			APIVersion: azureclient.APIVersion("Microsoft.DocumentDB"),
+			DependsOn: []string{
+				"[resourceId('Microsoft.DocumentDB/databaseAccounts/sqlDatabases', parameters('databaseAccountName'), " + databaseName + ")]",
+			},
+		},
 		{
 			Resource: &mgmtdocumentdb.SQLContainerCreateUpdateParameters{
 				SQLContainerCreateUpdateProperties: &mgmtdocumentdb.SQLContainerCreateUpdateProperties{
@@ -1371,6 +1357,30 @@ func (g *generator) database(databaseName string, addDependsOn bool) []*arm.Reso
 				"[resourceId('Microsoft.DocumentDB/databaseAccounts/sqlDatabases', parameters('databaseAccountName'), " + databaseName + ")]",
 			},
 		},
+		{
+			Resource: &mgmtdocumentdb.SQLContainerCreateUpdateParameters{
+				SQLContainerCreateUpdateProperties: &mgmtdocumentdb.SQLContainerCreateUpdateProperties{
+					Resource: &mgmtdocumentdb.SQLContainerResource{
+						ID: to.StringPtr("ClusterInventories"),
+						PartitionKey: &mgmtdocumentdb.ContainerPartitionKey{
+							Paths: &[]string{
+								"/id",
+							},
+							Kind: mgmtdocumentdb.PartitionKindHash,
+						},
+					},
+					Options: map[string]*string{},
+				},
+				Name:     to.StringPtr("[concat(parameters('databaseAccountName'), '/', " + databaseName + ", '/ClusterInventories')]"),
+				Type:     to.StringPtr("Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers"),
+				Location: to.StringPtr("[resourceGroup().location]"),
+			},
+			Condition:  g.conditionStanza("fullDeploy"),
+			APIVersion: azureclient.APIVersion("Microsoft.DocumentDB"),
+			DependsOn: []string{
+				"[resourceId('Microsoft.DocumentDB/databaseAccounts/sqlDatabases', parameters('databaseAccountName'), " + databaseName + ")]",
+			},
+		},
 		{
 			Resource: &mgmtdocumentdb.SQLContainerCreateUpdateParameters{
 				SQLContainerCreateUpdateProperties: &mgmtdocumentdb.SQLContainerCreateUpdateProperties{
@@ -1439,6 +1449,30 @@ func (g *generator) database(databaseName string, addDependsOn bool) []*arm.Reso
 				"[resourceId('Microsoft.DocumentDB/databaseAccounts/sqlDatabases', parameters('databaseAccountName'), " + databaseName + ")]",
 			},
 		},
+		{
+			Resource: &mgmtdocumentdb.SQLContainerCreateUpdateParameters{
+				SQLContainerCreateUpdateProperties: &mgmtdocumentdb.SQLContainerCreateUpdateProperties{
+					Resource: &mgmtdocumentdb.SQLContainerResource{
+						ID: to.StringPtr("OpenShiftVersions"),
+						PartitionKey: &mgmtdocumentdb.ContainerPartitionKey{
+							Paths: &[]string{
+								"/id",
+							},
+							Kind: mgmtdocumentdb.PartitionKindHash,
+						},
+					},
+					Options: map[string]*string{},
+				},
+				Name:     to.StringPtr("[concat(parameters('databaseAccountName'), '/', " + databaseName + ", '/OpenShiftVersions')]"),
+				Type:     to.StringPtr("Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers"),
+				Location: to.StringPtr("[resourceGroup().location]"),
+			},
+			Condition:  g.conditionStanza("fullDeploy"),
+			APIVersion: azureclient.APIVersion("Microsoft.DocumentDB"),
+			DependsOn: []string{
+				"[resourceId('Microsoft.DocumentDB/databaseAccounts/sqlDatabases', parameters('databaseAccountName'), " + databaseName + ")]",
+			},
+		},
 		{
 			Resource: &mgmtdocumentdb.SQLContainerCreateUpdateParameters{
 				SQLContainerCreateUpdateProperties: &mgmtdocumentdb.SQLContainerCreateUpdateProperties{
@@ -1465,6 +1499,27 @@ func (g *generator) database(databaseName string, addDependsOn bool) []*arm.Reso
 		},
 	}
 
+	if g.production {
+		// production databases autoscale their shared throughput, see
+		// databaseThroughput
+		rs[0].Resource = &sqlDatabaseAutoscaleCreateUpdateParameters{
+			Properties: &sqlDatabaseAutoscaleCreateUpdateProperties{
+				Resource: &mgmtdocumentdb.SQLDatabaseResource{
+					ID: to.StringPtr("[" + databaseName + "]"),
+				},
+				Options: &autoscaleOptions{
+					AutoscaleSettings: &autoscaleSettings{
+						MaxThroughput: "[parameters('databaseMaxThroughput')]",
+					},
+				},
+			},
+			Name:     to.StringPtr("[concat(parameters('databaseAccountName'), '/', " + databaseName + ")]"),
+			Type:     to.StringPtr("Microsoft.DocumentDB/databaseAccounts/sqlDatabases"),
+			Location: to.StringPtr("[resourceGroup().location]"),
+		}
+		rs[0].APIVersion = cosmosDBAutoscaleAPIVersion
+	}
+
 	if addDependsOn {
 		for i := range rs {
 			rs[i].DependsOn = append(rs[i].DependsOn,
@@ -1476,6 +1531,75 @@ func (g *generator) database(databaseName string, addDependsOn bool) []*arm.Reso
 	return rs
 }
 
+// cosmosDBAutoscaleAPIVersion is the first Microsoft.DocumentDB API version
+// which supports autoscale throughput.  The vendored SDK predates it, hence
+// the local types below.
+const cosmosDBAutoscaleAPIVersion = "2020-04-01"
+
+type autoscaleSettings struct {
+	MaxThroughput string `json:"maxThroughput,omitempty"`
+}
+
+type autoscaleOptions struct {
+	AutoscaleSettings *autoscaleSettings `json:"autoscaleSettings,omitempty"`
+}
+
+type sqlDatabaseAutoscaleCreateUpdateParameters struct {
+	Properties *sqlDatabaseAutoscaleCreateUpdateProperties `json:"properties,omitempty"`
+	Name       *string                                     `json:"name,omitempty"`
+	Type       *string                                     `json:"type,omitempty"`
+	Location   *string                                     `json:"location,omitempty"`
+}
+
+type sqlDatabaseAutoscaleCreateUpdateProperties struct {
+	Resource *mgmtdocumentdb.SQLDatabaseResource `json:"resource,omitempty"`
+	Options  *autoscaleOptions                   `json:"options,omitempty"`
+}
+
+type throughputSettingsAutoscaleUpdateParameters struct {
+	Properties *throughputSettingsAutoscaleUpdateProperties `json:"properties,omitempty"`
+	Name       *string                                      `json:"name,omitempty"`
+	Type       *string                                      `json:"type,omitempty"`
+	Location   *string                                      `json:"location,omitempty"`
+}
+
+type throughputSettingsAutoscaleUpdateProperties struct {
+	Resource *throughputSettingsAutoscaleResource `json:"resource,omitempty"`
+}
+
+type throughputSettingsAutoscaleResource struct {
+	AutoscaleSettings *autoscaleSettings `json:"autoscaleSettings,omitempty"`
+}
+
+// databaseThroughput sets the maximum throughput up to which the shared
+// throughput of the database autoscales.  Unlike the database, it is deployed
+// on every deploy, so that the maximum can be tuned from the RP config.
+//
+// Databases created before autoscale was introduced have manual throughput and
+// must first be migrated once with `az cosmosdb sql database throughput
+// migrate -t autoscale`: ARM refuses to switch between manual and autoscale
+// throughput.
+func (g *generator) databaseThroughput(databaseName string) *arm.Resource {
+	return &arm.Resource{
+		Resource: &throughputSettingsAutoscaleUpdateParameters{
+			Properties: &throughputSettingsAutoscaleUpdateProperties{
+				Resource: &throughputSettingsAutoscaleResource{
+					AutoscaleSettings: &autoscaleSettings{
+						MaxThroughput: "[parameters('databaseMaxThroughput')]",
+					},
+				},
+			},
+			Name:     to.StringPtr("[concat(parameters('databaseAccountName'), '/', " + databaseName + ", '/default')]"),
+			Type:     to.StringPtr("Microsoft.DocumentDB/databaseAccounts/sqlDatabases/throughputSettings"),
+			Location: to.StringPtr("[resourceGroup().location]"),
+		},
+		APIVersion: cosmosDBAutoscaleAPIVersion,
+		DependsOn: []string{
+			"[resourceId('Microsoft.DocumentDB/databaseAccounts/sqlDatabases', parameters('databaseAccountName'), " + databaseName + ")]",
+		},
+	}
+}
+
 func (g *generator) roleDefinitionTokenContributor() *arm.Resource {
 	return &arm.Resource{
 		Resource: &mgmtauthorization.RoleDefinition{
@@ -1619,6 +1743,21 @@ func (g *generator) rpVersionStorageAccount() []*arm.Resource {
 				"[resourceId('Microsoft.Storage/storageAccounts', parameters('rpVersionStorageAccountName'))]",
 			},
 		},
+		{
+			// rphistory holds the deployment history of each location, which
+			// is read to find the version to roll back to
+			Resource: &mgmtstorage.BlobContainer{
+				Name: to.StringPtr("[concat(parameters('rpVersionStorageAccountName'), '/default/rphistory')]"),
+				Type: to.StringPtr("Microsoft.Storage/storageAccounts/blobServices/containers"),
+				ContainerProperties: &mgmtstorage.ContainerProperties{
+					PublicAccess: mgmtstorage.PublicAccessNone,
+				},
+			},
+			APIVersion: azureclient.APIVersion("Microsoft.Storage"),
+			DependsOn: []string{
+				"[resourceId('Microsoft.Storage/storageAccounts', parameters('rpVersionStorageAccountName'))]",
+			},
+		},
 	}
 }
 
@@ -80,6 +80,7 @@ func (g *generator) rpTemplate() *arm.Template {
 			"acrResourceId",
 			"adminApiCaBundle",
 			"adminApiClientCertCommonName",
+			"databaseMaxThroughput",
 			"extraCosmosDBIPs",
 			"fullDeploy",
 			"keyvaultPrefix",
@@ -99,6 +100,10 @@ func (g *generator) rpTemplate() *arm.Template {
 	for _, param := range params {
 		p := &arm.TemplateParameter{Type: "string"}
 		switch param {
+		case "databaseMaxThroughput":
+			p.Type = "int"
+			p.DefaultValue = 4000
+			p.MinValue = 4000
 		case "extraCosmosDBIPs", "rpMode":
 			p.DefaultValue = ""
 		case "fullDeploy":
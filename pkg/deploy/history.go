@@ -0,0 +1,106 @@
+package deploy
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	azstorage "github.com/Azure/azure-sdk-for-go/storage"
+)
+
+const (
+	historyActionDeploy   = "deploy"
+	historyActionRollback = "rollback"
+
+	// maxHistoryEntries is the number of deployments which are kept in the
+	// deployment history of each location
+	maxHistoryEntries = 50
+)
+
+// historyEntry records an RP version which was deployed to a location and
+// verified healthy, either by a deployment or by a rollback
+type historyEntry struct {
+	Version string    `json:"version"`
+	Action  string    `json:"action"`
+	Time    time.Time `json:"time"`
+}
+
+// readHistory returns the deployment history of the location, oldest first
+func (d *deployer) readHistory(ctx context.Context) ([]historyEntry, error) {
+	containerRef, err := d.rpVersionContainer(ctx, "rphistory", "r") // read
+	if err != nil {
+		return nil, err
+	}
+
+	rc, err := containerRef.GetBlobReference(d.config.Location).Get(nil)
+	if serviceErr, ok := err.(azstorage.AzureStorageServiceError); ok &&
+		serviceErr.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	b, err := ioutil.ReadAll(rc)
+	if err != nil {
+		return nil, err
+	}
+
+	var history []historyEntry
+	err = json.Unmarshal(b, &history)
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+// recordHistory appends version to the deployment history of the location
+func (d *deployer) recordHistory(ctx context.Context, action, version string) error {
+	d.log.Printf("recording %s of rpVersion %s in %s", action, version, d.config.Location)
+
+	history, err := d.readHistory(ctx)
+	if err != nil {
+		return err
+	}
+
+	history = append(history, historyEntry{
+		Version: version,
+		Action:  action,
+		Time:    time.Now().UTC(),
+	})
+	if len(history) > maxHistoryEntries {
+		history = history[len(history)-maxHistoryEntries:]
+	}
+
+	b, err := json.MarshalIndent(history, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	containerRef, err := d.rpVersionContainer(ctx, "rphistory", "cw") // create and write
+	if err != nil {
+		return err
+	}
+
+	return containerRef.GetBlobReference(d.config.Location).CreateBlockBlobFromReader(bytes.NewReader(b), nil)
+}
+
+// lastKnownGood returns the most recently deployed version in history which
+// is not version, or "" if there is none
+func lastKnownGood(history []historyEntry, version string) string {
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].Version != version {
+			return history[i].Version
+		}
+	}
+
+	return ""
+}
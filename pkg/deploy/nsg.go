@@ -0,0 +1,120 @@
+package deploy
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	mgmtnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-07-01/network"
+	"github.com/Azure/go-autorest/autorest/to"
+
+	"github.com/Azure/ARO-RP/pkg/deploy/generator"
+)
+
+// VerifyNSGs compares the security rules of the deployed RP NSG against
+// generator.RPNSGRules and returns an error listing any drift
+func (d *deployer) VerifyNSGs(ctx context.Context) error {
+	nsg, err := d.securitygroups.Get(ctx, d.config.ResourceGroupName, "rp-nsg", "")
+	if err != nil {
+		return err
+	}
+
+	var deployed []mgmtnetwork.SecurityRule
+	if nsg.SecurityGroupPropertiesFormat != nil && nsg.SecurityRules != nil {
+		deployed = *nsg.SecurityRules
+	}
+
+	diffs := diffSecurityRules(d.expectedSecurityRules(), deployed)
+	if len(diffs) > 0 {
+		return fmt.Errorf("rp-nsg differs from the generated rules:\n%s", strings.Join(diffs, "\n"))
+	}
+
+	d.log.Print("rp-nsg matches the generated rules")
+	return nil
+}
+
+// expectedSecurityRules returns the production RP NSG rules with their
+// template parameters resolved from the deployment configuration
+func (d *deployer) expectedSecurityRules() []mgmtnetwork.SecurityRule {
+	var rules []mgmtnetwork.SecurityRule
+
+	for _, r := range generator.RPNSGRules(true) {
+		var prefixes []string
+		switch r.SourceAddressPrefixesParameter {
+		case "rpNsgSourceAddressPrefixes":
+			prefixes = d.config.Configuration.RPNSGSourceAddressPrefixes
+		}
+
+		rules = append(rules, r.SecurityRule(prefixes))
+	}
+
+	return rules
+}
+
+// diffSecurityRules returns a human readable description of each difference
+// between the wanted and deployed security rules.  Address prefix lists are
+// compared without regard to order.
+func diffSecurityRules(want, deployed []mgmtnetwork.SecurityRule) (diffs []string) {
+	deployedByName := map[string]mgmtnetwork.SecurityRule{}
+	for _, r := range deployed {
+		deployedByName[strings.ToLower(to.String(r.Name))] = r
+	}
+
+	for _, w := range want {
+		name := to.String(w.Name)
+
+		d, found := deployedByName[strings.ToLower(name)]
+		if !found {
+			diffs = append(diffs, fmt.Sprintf("rule %s: missing", name))
+			continue
+		}
+		delete(deployedByName, strings.ToLower(name))
+
+		for _, f := range []struct {
+			field string
+			want  interface{}
+			got   interface{}
+		}{
+			{"direction", w.Direction, d.Direction},
+			{"priority", to.Int32(w.Priority), to.Int32(d.Priority)},
+			{"protocol", w.Protocol, d.Protocol},
+			{"access", w.Access, d.Access},
+			{"sourcePortRange", to.String(w.SourcePortRange), to.String(d.SourcePortRange)},
+			{"destinationPortRange", to.String(w.DestinationPortRange), to.String(d.DestinationPortRange)},
+			{"sourceAddressPrefix", to.String(w.SourceAddressPrefix), to.String(d.SourceAddressPrefix)},
+			{"sourceAddressPrefixes", sortedStrings(w.SourceAddressPrefixes), sortedStrings(d.SourceAddressPrefixes)},
+			{"destinationAddressPrefix", to.String(w.DestinationAddressPrefix), to.String(d.DestinationAddressPrefix)},
+		} {
+			if !reflect.DeepEqual(f.want, f.got) {
+				diffs = append(diffs, fmt.Sprintf("rule %s: %s is %v, expected %v", name, f.field, f.got, f.want))
+			}
+		}
+	}
+
+	var unexpected []string
+	for _, r := range deployedByName {
+		unexpected = append(unexpected, to.String(r.Name))
+	}
+	sort.Strings(unexpected)
+
+	for _, name := range unexpected {
+		diffs = append(diffs, fmt.Sprintf("rule %s: unexpected", name))
+	}
+
+	return diffs
+}
+
+func sortedStrings(s *[]string) []string {
+	if s == nil || len(*s) == 0 {
+		return nil
+	}
+
+	sorted := append([]string{}, *s...)
+	sort.Strings(sorted)
+	return sorted
+}
@@ -0,0 +1,76 @@
+package deploy
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"reflect"
+	"testing"
+
+	mgmtnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-07-01/network"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+func TestDiffSecurityRules(t *testing.T) {
+	d := &deployer{
+		config: &RPConfig{
+			Configuration: &Configuration{
+				RPNSGSourceAddressPrefixes: []string{"10.0.0.0/8", "192.168.0.0/16"},
+			},
+		},
+	}
+
+	for _, tt := range []struct {
+		name      string
+		modify    func([]mgmtnetwork.SecurityRule) []mgmtnetwork.SecurityRule
+		wantDiffs []string
+	}{
+		{
+			name: "no drift",
+		},
+		{
+			name: "prefix order ignored",
+			modify: func(rules []mgmtnetwork.SecurityRule) []mgmtnetwork.SecurityRule {
+				rules[1].SourceAddressPrefixes = &[]string{"192.168.0.0/16", "10.0.0.0/8"}
+				return rules
+			},
+		},
+		{
+			name: "rule changed",
+			modify: func(rules []mgmtnetwork.SecurityRule) []mgmtnetwork.SecurityRule {
+				rules[0].Priority = to.Int32Ptr(200)
+				rules[0].SourceAddressPrefix = to.StringPtr("*")
+				rules[1].SourceAddressPrefixes = &[]string{"10.0.0.0/8"}
+				return rules
+			},
+			wantDiffs: []string{
+				"rule rp_in_arm: priority is 200, expected 120",
+				"rule rp_in_arm: sourceAddressPrefix is *, expected AzureResourceManager",
+				"rule rp_in_geneva: sourceAddressPrefixes is [10.0.0.0/8], expected [10.0.0.0/8 192.168.0.0/16]",
+			},
+		},
+		{
+			name: "rule missing and unexpected rule",
+			modify: func(rules []mgmtnetwork.SecurityRule) []mgmtnetwork.SecurityRule {
+				rules[1].Name = to.StringPtr("ssh_in")
+				return rules
+			},
+			wantDiffs: []string{
+				"rule rp_in_geneva: missing",
+				"rule ssh_in: unexpected",
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			deployed := d.expectedSecurityRules()
+			if tt.modify != nil {
+				deployed = tt.modify(deployed)
+			}
+
+			diffs := diffSecurityRules(d.expectedSecurityRules(), deployed)
+			if !reflect.DeepEqual(diffs, tt.wantDiffs) {
+				t.Error(diffs)
+			}
+		})
+	}
+}
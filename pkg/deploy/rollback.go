@@ -0,0 +1,117 @@
+package deploy
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	mgmtfeatures "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-07-01/features"
+
+	"github.com/Azure/ARO-RP/pkg/util/arm"
+)
+
+// Rollback redeploys the last known-good RP version other than d.version to
+// the location.  The ARM deployment of that version is replayed with the
+// template and parameters with which it was deployed, so that the RP
+// scaleset model and configuration are restored as well as the RP image.
+// Once the restored scaleset is healthy, all other scalesets are removed and
+// the rollback is recorded in the deployment history.
+func (d *deployer) Rollback(ctx context.Context) error {
+	history, err := d.readHistory(ctx)
+	if err != nil {
+		return err
+	}
+
+	version := lastKnownGood(history, d.version)
+	if version == "" {
+		return fmt.Errorf("no known-good version to roll back to from %s in %s", d.version, d.config.Location)
+	}
+
+	d.log.Printf("rolling back %s from %s to %s", d.config.Location, d.version, version)
+
+	deploymentName := "rp-production-" + version
+
+	deployment, err := d.deployments.Get(ctx, d.config.ResourceGroupName, deploymentName)
+	if err != nil {
+		return err
+	}
+
+	parameters, err := deploymentParameters(deploymentName, deployment)
+	if err != nil {
+		return err
+	}
+
+	// the resources which only a full deployment creates already exist
+	parameters.Parameters["fullDeploy"] = &arm.ParametersParameter{
+		Value: false,
+	}
+
+	template, err := d.deployments.ExportTemplate(ctx, d.config.ResourceGroupName, deploymentName)
+	if err != nil {
+		return err
+	}
+
+	d.log.Printf("deploying %s", deploymentName)
+	err = d.deployments.CreateOrUpdateAndWait(ctx, d.config.ResourceGroupName, deploymentName, mgmtfeatures.Deployment{
+		Properties: &mgmtfeatures.DeploymentProperties{
+			Template:   template.Template,
+			Mode:       mgmtfeatures.Incremental,
+			Parameters: parameters.Parameters,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 40*time.Minute)
+	defer cancel()
+	err = d.waitForRPReadiness(timeoutCtx, vmssPrefix+version)
+	if err != nil {
+		return err
+	}
+
+	err = d.removeOldScalesets(ctx, version)
+	if err != nil {
+		return err
+	}
+
+	err = d.saveRPVersion(ctx, version)
+	if err != nil {
+		return err
+	}
+
+	return d.recordHistory(ctx, historyActionRollback, version)
+}
+
+// deploymentParameters returns the parameter values with which deployment
+// was deployed
+func deploymentParameters(deploymentName string, deployment mgmtfeatures.DeploymentExtended) (*arm.Parameters, error) {
+	if deployment.Properties == nil {
+		return nil, fmt.Errorf("deployment %s has no properties", deploymentName)
+	}
+
+	ps, ok := deployment.Properties.Parameters.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("deployment %s has unexpected parameters %T", deploymentName, deployment.Properties.Parameters)
+	}
+
+	parameters := &arm.Parameters{
+		Parameters: map[string]*arm.ParametersParameter{},
+	}
+
+	for name, p := range ps {
+		p, ok := p.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("deployment %s has unexpected parameter %s", deploymentName, name)
+		}
+
+		parameters.Parameters[name] = &arm.ParametersParameter{
+			Value: p["value"],
+		}
+	}
+
+	return parameters, nil
+}
@@ -0,0 +1,136 @@
+package deploy
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"reflect"
+	"testing"
+
+	mgmtfeatures "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-07-01/features"
+
+	"github.com/Azure/ARO-RP/pkg/util/arm"
+)
+
+func TestLastKnownGood(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		history []historyEntry
+		version string
+		want    string
+	}{
+		{
+			name:    "no history",
+			version: "c",
+		},
+		{
+			name: "failed deployment is rolled back to the latest version",
+			history: []historyEntry{
+				{Version: "a", Action: historyActionDeploy},
+				{Version: "b", Action: historyActionDeploy},
+			},
+			version: "c",
+			want:    "b",
+		},
+		{
+			name: "completed deployment is rolled back to the previous version",
+			history: []historyEntry{
+				{Version: "a", Action: historyActionDeploy},
+				{Version: "b", Action: historyActionDeploy},
+				{Version: "c", Action: historyActionDeploy},
+				{Version: "c", Action: historyActionDeploy},
+			},
+			version: "c",
+			want:    "b",
+		},
+		{
+			name: "rollbacks are known-good",
+			history: []historyEntry{
+				{Version: "a", Action: historyActionDeploy},
+				{Version: "b", Action: historyActionDeploy},
+				{Version: "a", Action: historyActionRollback},
+			},
+			version: "c",
+			want:    "a",
+		},
+		{
+			name: "only the current version",
+			history: []historyEntry{
+				{Version: "c", Action: historyActionDeploy},
+			},
+			version: "c",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := lastKnownGood(tt.history, tt.version)
+			if got != tt.want {
+				t.Error(got)
+			}
+		})
+	}
+}
+
+func TestDeploymentParameters(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		deployment mgmtfeatures.DeploymentExtended
+		want       *arm.Parameters
+		wantErr    string
+	}{
+		{
+			name: "valid",
+			deployment: mgmtfeatures.DeploymentExtended{
+				Properties: &mgmtfeatures.DeploymentPropertiesExtended{
+					Parameters: map[string]interface{}{
+						"rpImage": map[string]interface{}{
+							"type":  "String",
+							"value": "arosvc.azurecr.io/aro:a",
+						},
+						"fullDeploy": map[string]interface{}{
+							"type":  "Bool",
+							"value": true,
+						},
+					},
+				},
+			},
+			want: &arm.Parameters{
+				Parameters: map[string]*arm.ParametersParameter{
+					"rpImage": {
+						Value: "arosvc.azurecr.io/aro:a",
+					},
+					"fullDeploy": {
+						Value: true,
+					},
+				},
+			},
+		},
+		{
+			name:       "no properties",
+			deployment: mgmtfeatures.DeploymentExtended{},
+			wantErr:    "deployment rp-production-a has no properties",
+		},
+		{
+			name: "invalid parameter",
+			deployment: mgmtfeatures.DeploymentExtended{
+				Properties: &mgmtfeatures.DeploymentPropertiesExtended{
+					Parameters: map[string]interface{}{
+						"rpImage": "arosvc.azurecr.io/aro:a",
+					},
+				},
+			},
+			wantErr: "deployment rp-production-a has unexpected parameter rpImage",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := deploymentParameters("rp-production-a", tt.deployment)
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Error(got)
+			}
+		})
+	}
+}
@@ -36,13 +36,18 @@ func (d *deployer) Upgrade(ctx context.Context) error {
 	d.log.Print("sleeping 5 minutes")
 	time.Sleep(5 * time.Minute)
 
-	err = d.removeOldScalesets(ctx)
+	err = d.removeOldScalesets(ctx, d.version)
 	if err != nil {
 		return err
 	}
 
 	// Must be last step so we can be sure there are no RPs at older versions still serving
-	return d.saveRPVersion(ctx)
+	err = d.saveRPVersion(ctx, d.version)
+	if err != nil {
+		return err
+	}
+
+	return d.recordHistory(ctx, historyActionDeploy, d.version)
 }
 
 func (d *deployer) waitForRPReadiness(ctx context.Context, vmssName string) error {
@@ -65,7 +70,8 @@ func (d *deployer) waitForRPReadiness(ctx context.Context, vmssName string) erro
 	}, ctx.Done())
 }
 
-func (d *deployer) removeOldScalesets(ctx context.Context) error {
+// removeOldScalesets removes all scalesets other than the one of version
+func (d *deployer) removeOldScalesets(ctx context.Context, version string) error {
 	d.log.Print("removing old scalesets")
 	scalesets, err := d.vmss.List(ctx, d.config.ResourceGroupName)
 	if err != nil {
@@ -73,7 +79,7 @@ func (d *deployer) removeOldScalesets(ctx context.Context) error {
 	}
 
 	for _, vmss := range scalesets {
-		if *vmss.Name == vmssPrefix+d.version {
+		if *vmss.Name == vmssPrefix+version {
 			continue
 		}
 
@@ -116,33 +122,42 @@ func (d *deployer) removeOldScaleset(ctx context.Context, vmssName string) error
 }
 
 // saveRPVersion for current location in shared storage account for environment
-func (d *deployer) saveRPVersion(ctx context.Context) error {
-	d.log.Printf("saving rpVersion %s deployed in %s to storage account %s", d.version, d.config.Location, *d.config.Configuration.RPVersionStorageAccountName)
+func (d *deployer) saveRPVersion(ctx context.Context, version string) error {
+	d.log.Printf("saving rpVersion %s deployed in %s to storage account %s", version, d.config.Location, *d.config.Configuration.RPVersionStorageAccountName)
+	containerRef, err := d.rpVersionContainer(ctx, "rpversion", "cw") // create and write
+	if err != nil {
+		return err
+	}
+
+	// save rpVersion deployed to current location
+	blobRef := containerRef.GetBlobReference(d.config.Location)
+	return blobRef.CreateBlockBlobFromReader(bytes.NewReader([]byte(version)), nil)
+}
+
+// rpVersionContainer returns a reference to container in the shared storage
+// account for environment, authorized by an account SAS with permissions
+func (d *deployer) rpVersionContainer(ctx context.Context, container string, permissions mgmtstorage.Permissions) (*azstorage.Container, error) {
 	t := time.Now().UTC().Truncate(time.Second)
 	res, err := d.globalaccounts.ListAccountSAS(
 		ctx, *d.config.Configuration.GlobalResourceGroupName, *d.config.Configuration.RPVersionStorageAccountName, mgmtstorage.AccountSasParameters{
 			Services:               mgmtstorage.B,
 			ResourceTypes:          mgmtstorage.SignedResourceTypesO,
-			Permissions:            "cw", // create and write
+			Permissions:            permissions,
 			Protocols:              mgmtstorage.HTTPS,
 			SharedAccessStartTime:  &date.Time{Time: t},
 			SharedAccessExpiryTime: &date.Time{Time: t.Add(24 * time.Hour)},
 		})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	v, err := url.ParseQuery(*res.AccountSasToken)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	blobClient := azstorage.NewAccountSASClient(
 		*d.config.Configuration.RPVersionStorageAccountName, v, azure.PublicCloud).GetBlobService()
 
-	containerRef := blobClient.GetContainerReference("rpversion")
-
-	// save rpVersion deployed to current location
-	blobRef := containerRef.GetBlobReference(d.config.Location)
-	return blobRef.CreateBlockBlobFromReader(bytes.NewReader([]byte(d.version)), nil)
+	return blobClient.GetContainerReference(container), nil
 }
@@ -15,11 +15,13 @@ import (
 
 type Core interface {
 	DeploymentMode() deployment.Mode
+	deployment.Capabilities
 	instancemetadata.InstanceMetadata
 	rpauthorizer.RPAuthorizer
 }
 
 type core struct {
+	deployment.Capabilities
 	instancemetadata.InstanceMetadata
 	rpauthorizer.RPAuthorizer
 
@@ -34,17 +36,23 @@ func NewCore(ctx context.Context, log *logrus.Entry) (Core, error) {
 	deploymentMode := deployment.NewMode()
 	log.Infof("running in %s mode", deploymentMode)
 
-	instancemetadata, err := instancemetadata.New(ctx, deploymentMode)
+	capabilities, err := deployment.NewCapabilities(deploymentMode)
 	if err != nil {
 		return nil, err
 	}
 
-	rpauthorizer, err := rpauthorizer.New(deploymentMode)
+	instancemetadata, err := instancemetadata.New(ctx, capabilities)
+	if err != nil {
+		return nil, err
+	}
+
+	rpauthorizer, err := rpauthorizer.New(capabilities)
 	if err != nil {
 		return nil, err
 	}
 
 	return &core{
+		Capabilities:     capabilities,
 		InstanceMetadata: instancemetadata,
 		RPAuthorizer:     rpauthorizer,
 
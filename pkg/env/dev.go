@@ -18,6 +18,7 @@ import (
 	uuid "github.com/satori/go.uuid"
 	"github.com/sirupsen/logrus"
 
+	"github.com/Azure/ARO-RP/pkg/util/adminpolicy"
 	"github.com/Azure/ARO-RP/pkg/util/azureclient/graphrbac"
 	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/authorization"
 	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/features"
@@ -87,6 +88,19 @@ func newDev(ctx context.Context, log *logrus.Entry) (Interface, error) {
 func (d *dev) InitializeAuthorizers() error {
 	d.armClientAuthorizer = clientauthorizer.NewAll()
 	d.adminClientAuthorizer = clientauthorizer.NewAll()
+
+	// only enforce an admin API policy in development if one is explicitly
+	// configured
+	if _, found := os.LookupEnv("ADMIN_API_POLICY"); found {
+		resolver, err := d.adminAADGroupResolver()
+		if err != nil {
+			return err
+		}
+
+		d.adminPolicyAuthorizer = adminpolicy.New(context.Background(), d.log, d.loadAdminPolicy, resolver)
+	} else {
+		d.adminPolicyAuthorizer = adminpolicy.NewAll()
+	}
 	return nil
 }
 
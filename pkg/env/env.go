@@ -11,11 +11,14 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/Azure/ARO-RP/pkg/metrics"
 	"github.com/Azure/ARO-RP/pkg/proxy"
+	"github.com/Azure/ARO-RP/pkg/util/adminpolicy"
 	"github.com/Azure/ARO-RP/pkg/util/clientauthorizer"
 	"github.com/Azure/ARO-RP/pkg/util/deployment"
 	"github.com/Azure/ARO-RP/pkg/util/keyvault"
 	"github.com/Azure/ARO-RP/pkg/util/refreshable"
+	"github.com/Azure/ARO-RP/pkg/util/secretstore"
 )
 
 const (
@@ -26,6 +29,7 @@ const (
 	FrontendEncryptionSecretName = "fe-encryption-key"
 	RPLoggingSecretName          = "rp-mdsd"
 	RPMonitoringSecretName       = "rp-mdm"
+	AdminAPIPolicySecretName     = "admin-api-policy"
 )
 
 type Interface interface {
@@ -33,8 +37,10 @@ type Interface interface {
 	proxy.Dialer
 
 	InitializeAuthorizers() error
+	InitializeSecretStoreMetrics(metrics.Interface)
 	ArmClientAuthorizer() clientauthorizer.ClientAuthorizer
 	AdminClientAuthorizer() clientauthorizer.ClientAuthorizer
+	AdminPolicyAuthorizer() adminpolicy.Authorizer
 	CreateARMResourceGroupRoleAssignment(context.Context, refreshable.Authorizer, string) error
 	ClustersGenevaLoggingConfigVersion() string
 	ClustersGenevaLoggingEnvironment() string
@@ -43,11 +49,13 @@ type Interface interface {
 	Domain() string
 	FPAuthorizer(string, string) (refreshable.Authorizer, error)
 	Listen() (net.Listener, error)
-	ServiceKeyvault() keyvault.Manager
+	ServiceSecrets() secretstore.Store
 	Zones(vmSize string) ([]string, error)
+	TrustedLaunchAvailable(vmSize string) (bool, error)
 	ACRResourceID() string
 	ACRDomain() string
 	AROOperatorImage() string
+	InventoryURL() string
 }
 
 func NewEnv(ctx context.Context, log *logrus.Entry) (Interface, error) {
@@ -11,37 +11,60 @@ import (
 	"net"
 	"os"
 	"strings"
+	"time"
 
+	mgmtcompute "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/adal"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/sirupsen/logrus"
 
 	"github.com/Azure/ARO-RP/pkg/deploy/generator"
+	"github.com/Azure/ARO-RP/pkg/metrics"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
 	"github.com/Azure/ARO-RP/pkg/proxy"
+	"github.com/Azure/ARO-RP/pkg/util/aadgroups"
+	"github.com/Azure/ARO-RP/pkg/util/adminpolicy"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/graphrbac"
 	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/compute"
 	"github.com/Azure/ARO-RP/pkg/util/clientauthorizer"
 	"github.com/Azure/ARO-RP/pkg/util/keyvault"
 	"github.com/Azure/ARO-RP/pkg/util/refreshable"
+	"github.com/Azure/ARO-RP/pkg/util/regions"
+	"github.com/Azure/ARO-RP/pkg/util/secretstore"
 	"github.com/Azure/ARO-RP/pkg/util/version"
 )
 
+// serviceSecretsCacheTTL is how long the service secrets are cached.  The
+// secrets are rotated out of band, so a process which reads a secret again
+// should not see a stale value for long.
+const serviceSecretsCacheTTL = 10 * time.Minute
+
 type prod struct {
 	Core
 	proxy.Dialer
 
 	armClientAuthorizer   clientauthorizer.ClientAuthorizer
 	adminClientAuthorizer clientauthorizer.ClientAuthorizer
+	adminPolicyAuthorizer adminpolicy.Authorizer
 
 	acrDomain string
+	region    *regions.Region
 	zones     map[string][]string
 
+	// trustedLaunch records whether each VM size supports trusted launch in
+	// the region
+	trustedLaunch map[string]bool
+
 	fpCertificate *x509.Certificate
 	fpPrivateKey  *rsa.PrivateKey
 	fpClientID    string
 
 	clustersKeyvault keyvault.Manager
-	serviceKeyvault  keyvault.Manager
+
+	serviceSecretsBackendName string
+	serviceSecretsBackend     secretstore.Backend
+	serviceSecrets            secretstore.Store
 
 	clustersGenevaLoggingCertificate   *x509.Certificate
 	clustersGenevaLoggingPrivateKey    *rsa.PrivateKey
@@ -65,7 +88,7 @@ func newProd(ctx context.Context, log *logrus.Entry) (*prod, error) {
 		return nil, err
 	}
 
-	dialer, err := proxy.NewDialer(core.DeploymentMode())
+	dialer, err := proxy.NewDialer(core)
 	if err != nil {
 		return nil, err
 	}
@@ -80,6 +103,15 @@ func newProd(ctx context.Context, log *logrus.Entry) (*prod, error) {
 		log: log,
 	}
 
+	p.region, err = regions.Get(p.Location())
+	if err != nil {
+		return nil, err
+	}
+
+	if p.region.Cloud != p.Environment().Name {
+		return nil, fmt.Errorf("region %s is in %s, not %s", p.region.Name, p.region.Cloud, p.Environment().Name)
+	}
+
 	rpAuthorizer, err := p.NewRPAuthorizer(p.Environment().ResourceManagerEndpoint)
 	if err != nil {
 		return nil, err
@@ -95,20 +127,23 @@ func newProd(ctx context.Context, log *logrus.Entry) (*prod, error) {
 		return nil, err
 	}
 
-	serviceKeyvaultURI, err := keyvault.URI(p, generator.ServiceKeyvaultSuffix)
+	p.clustersKeyvault = keyvault.NewManager(rpKVAuthorizer, clustersKeyvaultURI)
+
+	p.serviceSecretsBackendName, p.serviceSecretsBackend, err = p.newServiceSecretsBackend(rpKVAuthorizer)
 	if err != nil {
 		return nil, err
 	}
 
-	p.clustersKeyvault = keyvault.NewManager(rpKVAuthorizer, clustersKeyvaultURI)
-	p.serviceKeyvault = keyvault.NewManager(rpKVAuthorizer, serviceKeyvaultURI)
+	// metrics are not available until the caller calls
+	// InitializeSecretStoreMetrics
+	p.serviceSecrets = secretstore.New(p.serviceSecretsBackendName, p.serviceSecretsBackend, &noop.Noop{}, serviceSecretsCacheTTL)
 
 	err = p.populateZones(ctx, rpAuthorizer)
 	if err != nil {
 		return nil, err
 	}
 
-	fpPrivateKey, fpCertificates, err := p.serviceKeyvault.GetCertificateSecret(ctx, RPFirstPartySecretName)
+	fpPrivateKey, fpCertificates, err := p.serviceSecrets.GetCertificateSecret(ctx, RPFirstPartySecretName)
 	if err != nil {
 		return nil, err
 	}
@@ -117,7 +152,7 @@ func newProd(ctx context.Context, log *logrus.Entry) (*prod, error) {
 	p.fpCertificate = fpCertificates[0]
 	p.fpClientID = "f1dd0a37-89c6-4e07-bcd1-ffd3d43d8875"
 
-	clustersGenevaLoggingPrivateKey, clustersGenevaLoggingCertificates, err := p.serviceKeyvault.GetCertificateSecret(ctx, ClusterLoggingSecretName)
+	clustersGenevaLoggingPrivateKey, clustersGenevaLoggingCertificates, err := p.serviceSecrets.GetCertificateSecret(ctx, ClusterLoggingSecretName)
 	if err != nil {
 		return nil, err
 	}
@@ -151,9 +186,76 @@ func (p *prod) InitializeAuthorizers() error {
 	}
 
 	p.adminClientAuthorizer = adminClientAuthorizer
+
+	resolver, err := p.adminAADGroupResolver()
+	if err != nil {
+		return err
+	}
+
+	p.adminPolicyAuthorizer = adminpolicy.New(context.Background(), p.log, p.loadAdminPolicy, resolver)
 	return nil
 }
 
+// adminAADGroupResolver returns the resolver of the AAD groups of admin API
+// callers which present an access token, or nil if ADMIN_API_AAD_AUDIENCE is
+// unset.  Tokens must be issued by the RP's tenant, whose group memberships
+// the RP reads if they don't fit in the token.
+func (p *prod) adminAADGroupResolver() (aadgroups.Resolver, error) {
+	audience := os.Getenv("ADMIN_API_AAD_AUDIENCE")
+	if audience == "" {
+		return nil, nil
+	}
+
+	graphAuthorizer, err := p.NewRPAuthorizer(p.Environment().GraphEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return aadgroups.NewResolver(p.Environment(), p.TenantID(), audience, graphrbac.NewUsersClient(p.TenantID(), graphAuthorizer)), nil
+}
+
+// loadAdminPolicy returns the admin API access policy.  ADMIN_API_POLICY takes
+// precedence over the service key vault; if neither is set, the policy is
+// empty and places no further restrictions on the admin API.
+func (p *prod) loadAdminPolicy(ctx context.Context) ([]byte, error) {
+	if policy, found := os.LookupEnv("ADMIN_API_POLICY"); found {
+		return []byte(policy), nil
+	}
+
+	policy, err := p.serviceSecrets.GetSecret(ctx, AdminAPIPolicySecretName)
+	if err == secretstore.ErrNotFound {
+		return nil, nil
+	}
+
+	return policy, err
+}
+
+// newServiceSecretsBackend returns the backend which holds the service
+// secrets.  SERVICE_SECRETS_BACKEND selects it; the service key vault is the
+// default.
+func (p *prod) newServiceSecretsBackend(rpKVAuthorizer autorest.Authorizer) (string, secretstore.Backend, error) {
+	name := os.Getenv("SERVICE_SECRETS_BACKEND")
+	if name == "" {
+		name = secretstore.BackendKeyvault
+	}
+
+	switch name {
+	case secretstore.BackendKeyvault:
+		serviceKeyvaultURI, err := keyvault.URI(p, generator.ServiceKeyvaultSuffix)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return name, secretstore.NewKeyvaultBackend(keyvault.NewManager(rpKVAuthorizer, serviceKeyvaultURI)), nil
+	default:
+		return "", nil, fmt.Errorf("unsupported secrets backend %q", name)
+	}
+}
+
+func (p *prod) InitializeSecretStoreMetrics(m metrics.Interface) {
+	p.serviceSecrets = secretstore.New(p.serviceSecretsBackendName, p.serviceSecretsBackend, m, serviceSecretsCacheTTL)
+}
+
 func (p *prod) ArmClientAuthorizer() clientauthorizer.ClientAuthorizer {
 	return p.armClientAuthorizer
 }
@@ -162,6 +264,10 @@ func (p *prod) AdminClientAuthorizer() clientauthorizer.ClientAuthorizer {
 	return p.adminClientAuthorizer
 }
 
+func (p *prod) AdminPolicyAuthorizer() adminpolicy.Authorizer {
+	return p.adminPolicyAuthorizer
+}
+
 func (p *prod) ACRResourceID() string {
 	return os.Getenv("ACR_RESOURCE_ID")
 }
@@ -174,6 +280,12 @@ func (p *prod) AROOperatorImage() string {
 	return fmt.Sprintf("%s/aro:%s", p.acrDomain, version.GitCommit)
 }
 
+// InventoryURL returns the base URL at which cluster operators report their
+// inventory to the RP.  If INVENTORY_URL is unset, operators don't report.
+func (p *prod) InventoryURL() string {
+	return os.Getenv("INVENTORY_URL")
+}
+
 func (p *prod) populateZones(ctx context.Context, rpAuthorizer autorest.Authorizer) error {
 	c := compute.NewResourceSkusClient(p.SubscriptionID(), rpAuthorizer)
 
@@ -183,6 +295,7 @@ func (p *prod) populateZones(ctx context.Context, rpAuthorizer autorest.Authoriz
 	}
 
 	p.zones = map[string][]string{}
+	p.trustedLaunch = map[string]bool{}
 
 	for _, sku := range skus {
 		if !strings.EqualFold((*sku.Locations)[0], p.Location()) ||
@@ -194,12 +307,49 @@ func (p *prod) populateZones(ctx context.Context, rpAuthorizer autorest.Authoriz
 			continue
 		}
 
+		p.trustedLaunch[*sku.Name] = skuSupportsTrustedLaunch(sku)
+
+		if !p.region.Zonal {
+			p.zones[*sku.Name] = []string{}
+			continue
+		}
+
 		p.zones[*sku.Name] = *(*sku.LocationInfo)[0].Zones
 	}
 
 	return nil
 }
 
+// skuSupportsTrustedLaunch returns true if the VM SKU supports Gen2 VMs and
+// trusted launch has not been disabled for it
+func skuSupportsTrustedLaunch(sku mgmtcompute.ResourceSku) bool {
+	if sku.Capabilities == nil {
+		return false
+	}
+
+	var gen2 bool
+	for _, c := range *sku.Capabilities {
+		if c.Name == nil || c.Value == nil {
+			continue
+		}
+
+		switch *c.Name {
+		case "HyperVGenerations":
+			for _, g := range strings.Split(*c.Value, ",") {
+				if strings.EqualFold(g, "V2") {
+					gen2 = true
+				}
+			}
+		case "TrustedLaunchDisabled":
+			if strings.EqualFold(*c.Value, "True") {
+				return false
+			}
+		}
+	}
+
+	return gen2
+}
+
 func (p *prod) ClustersGenevaLoggingConfigVersion() string {
 	return p.clustersGenevaLoggingConfigVersion
 }
@@ -238,8 +388,8 @@ func (p *prod) Listen() (net.Listener, error) {
 	return net.Listen("tcp", ":8443")
 }
 
-func (p *prod) ServiceKeyvault() keyvault.Manager {
-	return p.serviceKeyvault
+func (p *prod) ServiceSecrets() secretstore.Store {
+	return p.serviceSecrets
 }
 
 func (p *prod) Zones(vmSize string) ([]string, error) {
@@ -250,6 +400,14 @@ func (p *prod) Zones(vmSize string) ([]string, error) {
 	return zones, nil
 }
 
+func (p *prod) TrustedLaunchAvailable(vmSize string) (bool, error) {
+	available, found := p.trustedLaunch[vmSize]
+	if !found {
+		return false, fmt.Errorf("trusted launch information not found for vm size %q", vmSize)
+	}
+	return available, nil
+}
+
 func (d *prod) CreateARMResourceGroupRoleAssignment(ctx context.Context, fpAuthorizer refreshable.Authorizer, resourceGroup string) error {
 	// ARM ResourceGroup role assignments are not required in production.
 	return nil
@@ -0,0 +1,124 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+// defaultAsyncOperationFailuresPeriod is how far back failures are
+// aggregated if the request doesn't say
+const defaultAsyncOperationFailuresPeriod = 24 * time.Hour
+
+// asyncOperationFailures aggregates the failed asyncOperations of the region
+type asyncOperationFailures struct {
+	Since       time.Time                     `json:"since"`
+	Total       int                           `json:"total"`
+	ErrorCodes  []asyncOperationFailuresCount `json:"errorCodes"`
+	APIVersions []asyncOperationFailuresCount `json:"apiVersions"`
+	Steps       []asyncOperationFailuresCount `json:"steps"`
+}
+
+type asyncOperationFailuresCount struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+func (f *frontend) getAdminAsyncOperationFailures(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+
+	b, err := f._getAdminAsyncOperationFailures(database.WithReadReplicas(ctx), r)
+
+	adminReply(log, w, nil, b, err)
+}
+
+// _getAdminAsyncOperationFailures counts the asyncOperations which failed in
+// the period given by the "period" query parameter (e.g. "6h") by error code,
+// API version and failed step, so that emerging failure patterns stand out
+func (f *frontend) _getAdminAsyncOperationFailures(ctx context.Context, r *http.Request) ([]byte, error) {
+	period := defaultAsyncOperationFailuresPeriod
+	if p := r.URL.Query().Get("period"); p != "" {
+		var err error
+		period, err = time.ParseDuration(p)
+		if err != nil || period <= 0 {
+			return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "period", "The provided period '%s' is invalid.", p)
+		}
+	}
+
+	since := time.Now().Add(-period).UTC().Truncate(time.Second)
+
+	errorCodes := map[string]int{}
+	apiVersions := map[string]int{}
+	steps := map[string]int{}
+	var total int
+
+	i := f.dbAsyncOperations.ListFailedSince(since)
+	for {
+		docs, err := i.Next(ctx, -1)
+		if err != nil {
+			return nil, err
+		}
+		if docs == nil {
+			break
+		}
+
+		for _, doc := range docs.AsyncOperationDocuments {
+			total++
+
+			var code string
+			if doc.AsyncOperation.Error != nil {
+				code = doc.AsyncOperation.Error.Code
+			}
+
+			errorCodes[orUnknown(code)]++
+			apiVersions[orUnknown(doc.AsyncOperation.APIVersion)]++
+			steps[orUnknown(doc.AsyncOperation.FailedStep)]++
+		}
+	}
+
+	return json.MarshalIndent(&asyncOperationFailures{
+		Since:       since,
+		Total:       total,
+		ErrorCodes:  sortedCounts(errorCodes),
+		APIVersions: sortedCounts(apiVersions),
+		Steps:       sortedCounts(steps),
+	}, "", "    ")
+}
+
+// orUnknown returns s, or "unknown" if s is empty: asyncOperations created
+// before the API version and failed step were recorded have neither
+func orUnknown(s string) string {
+	if s == "" {
+		return "unknown"
+	}
+	return s
+}
+
+// sortedCounts returns counts as a list, most frequent first
+func sortedCounts(counts map[string]int) []asyncOperationFailuresCount {
+	results := make([]asyncOperationFailuresCount, 0, len(counts))
+	for name, count := range counts {
+		results = append(results, asyncOperationFailuresCount{Name: name, Count: count})
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Count != results[j].Count {
+			return results[i].Count > results[j].Count
+		}
+		return results[i].Name < results[j].Name
+	})
+
+	return results
+}
@@ -0,0 +1,166 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestAdminAsyncOperationFailures(t *testing.T) {
+	ctx := context.Background()
+
+	now := int(time.Now().Unix())
+
+	failed := func(id string, timestamp int, code, apiVersion, step string) *api.AsyncOperationDocument {
+		doc := &api.AsyncOperationDocument{
+			ID:        id,
+			Timestamp: timestamp,
+			AsyncOperation: &api.AsyncOperation{
+				ProvisioningState: api.ProvisioningStateFailed,
+				APIVersion:        apiVersion,
+				FailedStep:        step,
+			},
+		}
+		if code != "" {
+			doc.AsyncOperation.Error = &api.CloudErrorBody{Code: code}
+		}
+		return doc
+	}
+
+	for _, tt := range []struct {
+		name           string
+		query          string
+		fixture        func(*testdatabase.Fixture)
+		wantPeriod     time.Duration
+		wantStatusCode int
+		wantResponse   *asyncOperationFailures
+		wantError      string
+	}{
+		{
+			name: "failures are aggregated",
+			fixture: func(f *testdatabase.Fixture) {
+				f.AddAsyncOperationDocuments(
+					failed("00000000-0000-0000-0000-000000000001", now-60, api.CloudErrorCodeDeploymentFailed, "2020-10-31-preview", "[Action ensureAROOperator]"),
+					failed("00000000-0000-0000-0000-000000000002", now-120, api.CloudErrorCodeDeploymentFailed, "2020-04-30", "[Action ensureAROOperator]"),
+					failed("00000000-0000-0000-0000-000000000003", now-180, api.CloudErrorCodeInternalServerError, "2020-10-31-preview", ""),
+					failed("00000000-0000-0000-0000-000000000004", now-2*86400, api.CloudErrorCodeInternalServerError, "2020-10-31-preview", "[Action old]"),
+					&api.AsyncOperationDocument{
+						ID:        "00000000-0000-0000-0000-000000000005",
+						Timestamp: now,
+						AsyncOperation: &api.AsyncOperation{
+							ProvisioningState: api.ProvisioningStateSucceeded,
+						},
+					},
+				)
+			},
+			wantPeriod:     24 * time.Hour,
+			wantStatusCode: http.StatusOK,
+			wantResponse: &asyncOperationFailures{
+				Total: 3,
+				ErrorCodes: []asyncOperationFailuresCount{
+					{Name: api.CloudErrorCodeDeploymentFailed, Count: 2},
+					{Name: api.CloudErrorCodeInternalServerError, Count: 1},
+				},
+				APIVersions: []asyncOperationFailuresCount{
+					{Name: "2020-10-31-preview", Count: 2},
+					{Name: "2020-04-30", Count: 1},
+				},
+				Steps: []asyncOperationFailuresCount{
+					{Name: "[Action ensureAROOperator]", Count: 2},
+					{Name: "unknown", Count: 1},
+				},
+			},
+		},
+		{
+			name:  "period is honoured",
+			query: "?period=90s",
+			fixture: func(f *testdatabase.Fixture) {
+				f.AddAsyncOperationDocuments(
+					failed("00000000-0000-0000-0000-000000000001", now-60, "", "", ""),
+					failed("00000000-0000-0000-0000-000000000002", now-120, api.CloudErrorCodeDeploymentFailed, "2020-04-30", "[Action ensureAROOperator]"),
+				)
+			},
+			wantPeriod:     90 * time.Second,
+			wantStatusCode: http.StatusOK,
+			wantResponse: &asyncOperationFailures{
+				Total: 1,
+				ErrorCodes: []asyncOperationFailuresCount{
+					{Name: "unknown", Count: 1},
+				},
+				APIVersions: []asyncOperationFailuresCount{
+					{Name: "unknown", Count: 1},
+				},
+				Steps: []asyncOperationFailuresCount{
+					{Name: "unknown", Count: 1},
+				},
+			},
+		},
+		{
+			name:           "invalid period",
+			query:          "?period=-1h",
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: period: The provided period '-1h' is invalid.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithAsyncOperations()
+			defer ti.done()
+
+			err := ti.buildFixtures(tt.fixture)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, api.APIs, &noop.Noop{}, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodGet,
+				"https://server/admin/asyncoperationfailures"+tt.query,
+				nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.wantResponse == nil {
+				err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, nil)
+				if err != nil {
+					t.Error(err)
+				}
+				return
+			}
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Fatal(resp.StatusCode, string(b))
+			}
+
+			var got *asyncOperationFailures
+			err = json.Unmarshal(b, &got)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if d := time.Since(got.Since) - tt.wantPeriod; d < 0 || d > time.Minute {
+				t.Error(got.Since)
+			}
+			got.Since = time.Time{}
+
+			if !reflect.DeepEqual(got, tt.wantResponse) {
+				t.Error(string(b))
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+const (
+	idempotencyKeyHeader    = "Idempotency-Key"
+	maxIdempotencyKeyLength = 128
+
+	// idempotencyRecordTTL is how long the response to an admin action is
+	// kept for requests which are retried with the same Idempotency-Key
+	idempotencyRecordTTL = 24 * time.Hour
+)
+
+// idempotencyReplayHeaders are the response headers which are replayed along
+// with the status code and body of a recorded response
+var idempotencyReplayHeaders = []string{
+	"Content-Type",
+	"Location",
+	"Azure-AsyncOperation",
+}
+
+// idempotent wraps the handler of a mutating admin action.  Requests which
+// carry an Idempotency-Key header are executed at most once per cluster and
+// key: a retried request is answered with the recorded response of the first
+// one, or with a conflict if it is still in progress.  Responses with a 5xx
+// status code are not recorded, so that failed actions can be retried.
+func (f *frontend) idempotent(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+
+		key := r.Header.Get(idempotencyKeyHeader)
+		if key == "" {
+			h(w, r)
+			return
+		}
+
+		if len(key) > maxIdempotencyKeyLength {
+			adminReply(log, w, nil, nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, idempotencyKeyHeader, "The provided Idempotency-Key is longer than %d characters.", maxIdempotencyKeyLength))
+			return
+		}
+
+		resourceID := strings.TrimPrefix(filepath.Dir(r.URL.Path), "/admin")
+		request := requestFingerprint(r)
+
+		var existing *api.IdempotencyRecord
+		_, err := f.dbOpenShiftClusters.Patch(ctx, resourceID, func(doc *api.OpenShiftClusterDocument) error {
+			existing = claimIdempotencyKey(doc, key, request, time.Now().UTC())
+			return nil
+		})
+		switch {
+		case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+			// the handler reports the missing cluster
+			h(w, r)
+			return
+		case err != nil:
+			adminReply(log, w, nil, nil, err)
+			return
+		case existing != nil:
+			replayIdempotencyRecord(log, w, existing, key, request)
+			return
+		}
+
+		rw := &idempotencyResponseWriter{
+			ResponseWriter: w,
+			statusCode:     http.StatusOK,
+		}
+
+		h(rw, r)
+
+		_, err = f.dbOpenShiftClusters.Patch(ctx, resourceID, func(doc *api.OpenShiftClusterDocument) error {
+			completeIdempotencyKey(doc, key, rw)
+			return nil
+		})
+		if err != nil && !cosmosdb.IsErrorStatusCode(err, http.StatusNotFound) {
+			log.Error(err)
+		}
+	}
+}
+
+// claimIdempotencyKey drops the expired idempotency records of doc and returns
+// the record of key, if there is one.  Otherwise it records key as in
+// progress for request and returns nil.
+func claimIdempotencyKey(doc *api.OpenShiftClusterDocument, key, request string, now time.Time) *api.IdempotencyRecord {
+	var existing *api.IdempotencyRecord
+	var records []api.IdempotencyRecord
+
+	for _, record := range doc.IdempotencyRecords {
+		if now.Sub(record.CreatedAt) >= idempotencyRecordTTL {
+			continue
+		}
+
+		if record.Key == key {
+			existing = &api.IdempotencyRecord{}
+			*existing = record
+		}
+
+		records = append(records, record)
+	}
+
+	if existing == nil {
+		records = append(records, api.IdempotencyRecord{
+			Key:       key,
+			Request:   request,
+			CreatedAt: now,
+		})
+	}
+
+	doc.IdempotencyRecords = records
+
+	return existing
+}
+
+// completeIdempotencyKey records the response written to rw for key, or
+// forgets key if the response is a server error
+func completeIdempotencyKey(doc *api.OpenShiftClusterDocument, key string, rw *idempotencyResponseWriter) {
+	for i, record := range doc.IdempotencyRecords {
+		if record.Key != key {
+			continue
+		}
+
+		if rw.statusCode >= http.StatusInternalServerError {
+			doc.IdempotencyRecords = append(doc.IdempotencyRecords[:i], doc.IdempotencyRecords[i+1:]...)
+			return
+		}
+
+		var headers []api.IdempotencyRecordHeader
+		for _, name := range idempotencyReplayHeaders {
+			for _, value := range rw.Header()[name] {
+				headers = append(headers, api.IdempotencyRecordHeader{
+					Name:  name,
+					Value: value,
+				})
+			}
+		}
+
+		doc.IdempotencyRecords[i].StatusCode = rw.statusCode
+		doc.IdempotencyRecords[i].Headers = headers
+		doc.IdempotencyRecords[i].Body = rw.body.Bytes()
+		return
+	}
+}
+
+func replayIdempotencyRecord(log *logrus.Entry, w http.ResponseWriter, record *api.IdempotencyRecord, key, request string) {
+	switch {
+	case record.Request != request:
+		adminReply(log, w, nil, nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, idempotencyKeyHeader, "The Idempotency-Key '%s' was already used for a different request.", key))
+		return
+	case record.StatusCode == 0:
+		adminReply(log, w, nil, nil, api.NewCloudError(http.StatusConflict, api.CloudErrorCodeRequestNotAllowed, idempotencyKeyHeader, "A request with Idempotency-Key '%s' is still in progress.", key))
+		return
+	}
+
+	log.Printf("replaying the response to Idempotency-Key %s", key)
+
+	for _, header := range record.Headers {
+		w.Header().Add(header.Name, header.Value)
+	}
+	w.WriteHeader(record.StatusCode)
+	_, _ = w.Write(record.Body)
+}
+
+// requestFingerprint identifies the action, parameters and body of r, so that
+// a key which is reused for a different request can be rejected
+func requestFingerprint(r *http.Request) string {
+	h := sha256.New()
+
+	h.Write([]byte(r.URL.Path + "\n" + r.URL.RawQuery + "\n"))
+	if body, ok := r.Context().Value(middleware.ContextKeyBody).([]byte); ok {
+		h.Write(body)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// idempotencyResponseWriter passes a response through to the client while
+// keeping a copy of it
+type idempotencyResponseWriter struct {
+	http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+}
+
+func (rw *idempotencyResponseWriter) WriteHeader(statusCode int) {
+	rw.statusCode = statusCode
+	rw.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (rw *idempotencyResponseWriter) Write(b []byte) (int, error) {
+	rw.body.Write(b)
+	return rw.ResponseWriter.Write(b)
+}
@@ -0,0 +1,292 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/frontend/adminactions"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	mock_adminactions "github.com/Azure/ARO-RP/pkg/util/mocks/adminactions"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestAdminIdempotencyKey(t *testing.T) {
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	mockTenantID := "00000000-0000-0000-0000-000000000000"
+	resourceID := testdatabase.GetResourcePath(mockSubID, "resourceName")
+
+	ctx := context.Background()
+
+	url := func(vmName string) string {
+		return fmt.Sprintf("https://server/admin%s/redeployvm?vmName=%s", resourceID, vmName)
+	}
+
+	fingerprint := func(vmName string) string {
+		r, err := http.NewRequest(http.MethodPost, url(vmName), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r.URL.Path = strings.ToLower(r.URL.Path)
+		return requestFingerprint(r)
+	}
+
+	type request struct {
+		key            string
+		vmName         string
+		wantCall       bool
+		redeployErr    error
+		wantStatusCode int
+		wantError      string
+	}
+
+	for _, tt := range []struct {
+		name        string
+		records     []api.IdempotencyRecord
+		requests    []request
+		wantRecords int
+	}{
+		{
+			name: "no key",
+			requests: []request{
+				{
+					vmName:         "vm1",
+					wantCall:       true,
+					wantStatusCode: http.StatusOK,
+				},
+				{
+					vmName:         "vm1",
+					wantCall:       true,
+					wantStatusCode: http.StatusOK,
+				},
+			},
+		},
+		{
+			name: "retried request is replayed",
+			requests: []request{
+				{
+					key:            "key1",
+					vmName:         "vm1",
+					wantCall:       true,
+					wantStatusCode: http.StatusOK,
+				},
+				{
+					key:            "key1",
+					vmName:         "vm1",
+					wantStatusCode: http.StatusOK,
+				},
+				{
+					key:            "key2",
+					vmName:         "vm1",
+					wantCall:       true,
+					wantStatusCode: http.StatusOK,
+				},
+			},
+			wantRecords: 2,
+		},
+		{
+			name: "recorded error is replayed",
+			requests: []request{
+				{
+					key:            "key1",
+					vmName:         "vm1",
+					wantCall:       true,
+					redeployErr:    api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "vmName", "The VM was not found."),
+					wantStatusCode: http.StatusBadRequest,
+					wantError:      "400: InvalidParameter: vmName: The VM was not found.",
+				},
+				{
+					key:            "key1",
+					vmName:         "vm1",
+					wantStatusCode: http.StatusBadRequest,
+					wantError:      "400: InvalidParameter: vmName: The VM was not found.",
+				},
+			},
+			wantRecords: 1,
+		},
+		{
+			name: "server error is not recorded",
+			requests: []request{
+				{
+					key:            "key1",
+					vmName:         "vm1",
+					wantCall:       true,
+					redeployErr:    fmt.Errorf("random error"),
+					wantStatusCode: http.StatusInternalServerError,
+					wantError:      "500: InternalServerError: : Internal server error.",
+				},
+				{
+					key:            "key1",
+					vmName:         "vm1",
+					wantCall:       true,
+					wantStatusCode: http.StatusOK,
+				},
+			},
+			wantRecords: 1,
+		},
+		{
+			name: "key reused for a different request",
+			requests: []request{
+				{
+					key:            "key1",
+					vmName:         "vm1",
+					wantCall:       true,
+					wantStatusCode: http.StatusOK,
+				},
+				{
+					key:            "key1",
+					vmName:         "vm2",
+					wantStatusCode: http.StatusBadRequest,
+					wantError:      "400: InvalidParameter: Idempotency-Key: The Idempotency-Key 'key1' was already used for a different request.",
+				},
+			},
+			wantRecords: 1,
+		},
+		{
+			name: "request in progress",
+			records: []api.IdempotencyRecord{
+				{
+					Key:       "key1",
+					Request:   fingerprint("vm1"),
+					CreatedAt: time.Now().Add(-time.Minute),
+				},
+			},
+			requests: []request{
+				{
+					key:            "key1",
+					vmName:         "vm1",
+					wantStatusCode: http.StatusConflict,
+					wantError:      "409: RequestNotAllowed: Idempotency-Key: A request with Idempotency-Key 'key1' is still in progress.",
+				},
+			},
+			wantRecords: 1,
+		},
+		{
+			name: "expired records are dropped",
+			records: []api.IdempotencyRecord{
+				{
+					Key:        "key1",
+					Request:    fingerprint("vm1"),
+					CreatedAt:  time.Now().Add(-idempotencyRecordTTL),
+					StatusCode: http.StatusOK,
+				},
+				{
+					Key:        "key2",
+					Request:    fingerprint("vm1"),
+					CreatedAt:  time.Now().Add(-idempotencyRecordTTL),
+					StatusCode: http.StatusOK,
+				},
+			},
+			requests: []request{
+				{
+					key:            "key1",
+					vmName:         "vm1",
+					wantCall:       true,
+					wantStatusCode: http.StatusOK,
+				},
+			},
+			wantRecords: 1,
+		},
+		{
+			name: "key too long",
+			requests: []request{
+				{
+					key:            strings.Repeat("k", maxIdempotencyKeyLength+1),
+					vmName:         "vm1",
+					wantStatusCode: http.StatusBadRequest,
+					wantError:      "400: InvalidParameter: Idempotency-Key: The provided Idempotency-Key is longer than 128 characters.",
+				},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithOpenShiftClusters().WithSubscriptions()
+			defer ti.done()
+
+			a := mock_adminactions.NewMockInterface(ti.controller)
+
+			var calls []*gomock.Call
+			for _, req := range tt.requests {
+				if req.wantCall {
+					calls = append(calls, a.EXPECT().VMRedeployAndWait(gomock.Any(), req.vmName).Return(req.redeployErr))
+				}
+			}
+			gomock.InOrder(calls...)
+
+			err := ti.buildFixtures(func(f *testdatabase.Fixture) {
+				f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					Key: strings.ToLower(resourceID),
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID: resourceID,
+						Properties: api.OpenShiftClusterProperties{
+							ClusterProfile: api.ClusterProfile{
+								ResourceGroupID: fmt.Sprintf("/subscriptions/%s/resourceGroups/test-cluster", mockSubID),
+							},
+						},
+					},
+					IdempotencyRecords: tt.records,
+				})
+
+				f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+					ID: mockSubID,
+					Subscription: &api.Subscription{
+						State: api.SubscriptionStateRegistered,
+						Properties: &api.SubscriptionProperties{
+							TenantID: mockTenantID,
+						},
+					},
+				})
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, api.APIs, &noop.Noop{}, nil, func(*logrus.Entry, env.Interface, *api.OpenShiftCluster,
+				*api.SubscriptionDocument) (adminactions.Interface, error) {
+				return a, nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			for i, req := range tt.requests {
+				header := http.Header{}
+				if req.key != "" {
+					header.Set(idempotencyKeyHeader, req.key)
+				}
+
+				resp, b, err := ti.request(http.MethodPost, url(req.vmName), header, nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				err = validateResponse(resp, b, req.wantStatusCode, req.wantError, nil)
+				if err != nil {
+					t.Error(i, err)
+				}
+			}
+
+			doc, err := ti.openShiftClustersDatabase.Get(ctx, strings.ToLower(resourceID))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(doc.IdempotencyRecords) != tt.wantRecords {
+				t.Error(doc.IdempotencyRecords)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"github.com/ugorji/go/codec"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+func (f *frontend) postAdminOpenShiftClusterReplayAsyncOperation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	r.URL.Path = filepath.Dir(filepath.Dir(filepath.Dir(r.URL.Path)))
+
+	b, err := f._postAdminOpenShiftClusterReplayAsyncOperation(ctx, r, log)
+
+	adminReply(log, w, nil, b, err)
+}
+
+// _postAdminOpenShiftClusterReplayAsyncOperation restores a completed
+// asyncOperation which has expired from the database from the archive, so
+// that its Azure-AsyncOperation URL serves its final status again for the
+// retention period.  Its operation result is not archived: the restored
+// operation result URL returns 204 No Content.
+func (f *frontend) _postAdminOpenShiftClusterReplayAsyncOperation(ctx context.Context, r *http.Request, log *logrus.Entry) ([]byte, error) {
+	if f.archive == nil {
+		return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeRequestNotAllowed, "", "Async operation archival is not enabled.")
+	}
+
+	vars := mux.Vars(r)
+
+	// archived asyncOperations outlive the cluster document, so there is
+	// deliberately no check here that the cluster still exists
+	resourceID := strings.TrimPrefix(r.URL.Path, "/admin")
+
+	asyncdoc, err := f.dbAsyncOperations.Get(ctx, vars["operationId"])
+	switch {
+	case err == nil:
+		if asyncdoc.OpenShiftClusterKey != resourceID {
+			return nil, api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeNotFound, "", "The async operation '%s' was not found.", vars["operationId"])
+		}
+
+		log.Printf("async operation %s is still in the database", vars["operationId"])
+
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		asyncdoc, err = f.archive.GetAsyncOperation(ctx, resourceID, vars["operationId"])
+		if err != nil {
+			return nil, err
+		}
+		if asyncdoc == nil {
+			return nil, api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeNotFound, "", "The async operation '%s' was not found.", vars["operationId"])
+		}
+
+		log.Printf("restoring async operation %s from the archive", vars["operationId"])
+
+		asyncdoc, err = f.dbAsyncOperations.Create(ctx, &api.AsyncOperationDocument{
+			ID:                  asyncdoc.ID,
+			OpenShiftClusterKey: asyncdoc.OpenShiftClusterKey,
+			AsyncOperation:      asyncdoc.AsyncOperation,
+			TTL:                 f.completedAsyncOperationTTL,
+			Archived:            true,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, err
+	}
+
+	h := &codec.JsonHandle{
+		Indent: 4,
+	}
+
+	var b []byte
+	err = codec.NewEncoderBytes(&b, h).Encode(asyncdoc.AsyncOperation)
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
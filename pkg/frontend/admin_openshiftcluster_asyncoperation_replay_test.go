@@ -0,0 +1,189 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	mock_archive "github.com/Azure/ARO-RP/pkg/util/mocks/archive"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestAdminReplayAsyncOperation(t *testing.T) {
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	mockOpID := "11111111-1111-1111-1111-111111111111"
+	ctx := context.Background()
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := time.Date(2020, 1, 1, 1, 0, 0, 0, time.UTC)
+
+	asyncOperation := func() *api.AsyncOperation {
+		return &api.AsyncOperation{
+			ID:                "fakeoppath",
+			Name:              mockOpID,
+			ProvisioningState: api.ProvisioningStateFailed,
+			StartTime:         startTime,
+			EndTime:           &endTime,
+			Error: &api.CloudErrorBody{
+				Code:    api.CloudErrorCodeInternalServerError,
+				Message: "Some error.",
+			},
+		}
+	}
+
+	type test struct {
+		name           string
+		resourceID     string
+		fixture        func(*testdatabase.Fixture)
+		checker        func(*testdatabase.Checker)
+		mocks          func(*test, *mock_archive.MockManager)
+		archiveEnabled bool
+		wantStatusCode int
+		wantResponse   *api.AsyncOperation
+		wantError      string
+	}
+
+	for _, tt := range []*test{
+		{
+			name:           "archival not enabled",
+			resourceID:     testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			mocks:          func(tt *test, a *mock_archive.MockManager) {},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: RequestNotAllowed: : Async operation archival is not enabled.",
+		},
+		{
+			name:           "operation restored from the archive",
+			resourceID:     testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			archiveEnabled: true,
+			mocks: func(tt *test, a *mock_archive.MockManager) {
+				a.EXPECT().
+					GetAsyncOperation(gomock.Any(), strings.ToLower(tt.resourceID), mockOpID).
+					Return(&api.AsyncOperationDocument{
+						ID:                  mockOpID,
+						OpenShiftClusterKey: strings.ToLower(tt.resourceID),
+						AsyncOperation:      asyncOperation(),
+					}, nil)
+			},
+			checker: func(c *testdatabase.Checker) {
+				c.AddAsyncOperationDocuments(&api.AsyncOperationDocument{
+					ID:                  mockOpID,
+					OpenShiftClusterKey: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "resourceName")),
+					AsyncOperation:      asyncOperation(),
+					TTL:                 2 * 86400,
+					Archived:            true,
+				})
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse:   asyncOperation(),
+		},
+		{
+			name:           "operation still in the database",
+			resourceID:     testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			archiveEnabled: true,
+			fixture: func(f *testdatabase.Fixture) {
+				f.AddAsyncOperationDocuments(&api.AsyncOperationDocument{
+					ID:                  mockOpID,
+					OpenShiftClusterKey: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "resourceName")),
+					AsyncOperation:      asyncOperation(),
+				})
+			},
+			mocks: func(tt *test, a *mock_archive.MockManager) {},
+			checker: func(c *testdatabase.Checker) {
+				c.AddAsyncOperationDocuments(&api.AsyncOperationDocument{
+					ID:                  mockOpID,
+					OpenShiftClusterKey: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "resourceName")),
+					AsyncOperation:      asyncOperation(),
+				})
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse:   asyncOperation(),
+		},
+		{
+			name:           "operation of another cluster",
+			resourceID:     testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			archiveEnabled: true,
+			fixture: func(f *testdatabase.Fixture) {
+				f.AddAsyncOperationDocuments(&api.AsyncOperationDocument{
+					ID:                  mockOpID,
+					OpenShiftClusterKey: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "otherResourceName")),
+					AsyncOperation:      asyncOperation(),
+				})
+			},
+			mocks: func(tt *test, a *mock_archive.MockManager) {},
+			checker: func(c *testdatabase.Checker) {
+				c.AddAsyncOperationDocuments(&api.AsyncOperationDocument{
+					ID:                  mockOpID,
+					OpenShiftClusterKey: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "otherResourceName")),
+					AsyncOperation:      asyncOperation(),
+				})
+			},
+			wantStatusCode: http.StatusNotFound,
+			wantError:      "404: NotFound: : The async operation '" + mockOpID + "' was not found.",
+		},
+		{
+			name:           "operation not archived",
+			resourceID:     testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			archiveEnabled: true,
+			mocks: func(tt *test, a *mock_archive.MockManager) {
+				a.EXPECT().
+					GetAsyncOperation(gomock.Any(), strings.ToLower(tt.resourceID), mockOpID).
+					Return(nil, nil)
+			},
+			wantStatusCode: http.StatusNotFound,
+			wantError:      "404: NotFound: : The async operation '" + mockOpID + "' was not found.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithAsyncOperations()
+			defer ti.done()
+
+			err := ti.buildFixtures(tt.fixture)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.checker != nil {
+				tt.checker(ti.checker)
+			}
+
+			a := mock_archive.NewMockManager(ti.controller)
+			tt.mocks(tt, a)
+
+			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, api.APIs, &noop.Noop{}, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.archiveEnabled {
+				f.(*frontend).archive = a
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodPost,
+				fmt.Sprintf("https://server/admin%s/asyncoperations/%s/replay", tt.resourceID, mockOpID),
+				nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, tt.wantResponse)
+			if err != nil {
+				t.Error(err)
+			}
+
+			for _, err := range ti.checker.CheckAsyncOperations(ti.asyncOperationsClient) {
+				t.Error(err)
+			}
+		})
+	}
+}
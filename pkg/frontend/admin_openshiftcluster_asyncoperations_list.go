@@ -0,0 +1,60 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/ugorji/go/codec"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+func (f *frontend) listAdminOpenShiftClusterAsyncOperations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	r.URL.Path = filepath.Dir(r.URL.Path)
+
+	b, err := f._listAdminOpenShiftClusterAsyncOperations(ctx, r)
+
+	adminReply(log, w, nil, b, err)
+}
+
+func (f *frontend) _listAdminOpenShiftClusterAsyncOperations(ctx context.Context, r *http.Request) ([]byte, error) {
+	if f.archive == nil {
+		return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeRequestNotAllowed, "", "Async operation archival is not enabled.")
+	}
+
+	// archived asyncOperations outlive the cluster document, so there is
+	// deliberately no check here that the cluster still exists
+	resourceID := strings.TrimPrefix(r.URL.Path, "/admin")
+
+	asyncOperations, err := f.archive.ListAsyncOperations(ctx, resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if asyncOperations == nil {
+		asyncOperations = []*api.AsyncOperation{}
+	}
+
+	h := &codec.JsonHandle{
+		Indent: 4,
+	}
+
+	var b []byte
+	err = codec.NewEncoderBytes(&b, h).Encode(map[string]interface{}{
+		"value": asyncOperations,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
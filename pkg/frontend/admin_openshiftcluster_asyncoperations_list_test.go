@@ -0,0 +1,120 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	mock_archive "github.com/Azure/ARO-RP/pkg/util/mocks/archive"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestAdminListAsyncOperations(t *testing.T) {
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	ctx := context.Background()
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type test struct {
+		name           string
+		resourceID     string
+		mocks          func(*test, *mock_archive.MockManager)
+		archiveEnabled bool
+		wantStatusCode int
+		wantResponse   []byte
+		wantError      string
+	}
+
+	for _, tt := range []*test{
+		{
+			name:           "archival not enabled",
+			resourceID:     testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			mocks:          func(tt *test, a *mock_archive.MockManager) {},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: RequestNotAllowed: : Async operation archival is not enabled.",
+		},
+		{
+			name:           "archived async operations",
+			resourceID:     testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			archiveEnabled: true,
+			mocks: func(tt *test, a *mock_archive.MockManager) {
+				a.EXPECT().
+					ListAsyncOperations(gomock.Any(), strings.ToLower(tt.resourceID)).
+					Return([]*api.AsyncOperation{
+						{
+							ID:                "id",
+							Name:              "name",
+							ProvisioningState: api.ProvisioningStateSucceeded,
+							StartTime:         startTime,
+						},
+					}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse: []byte(`{
+    "value": [
+        {
+            "id": "id",
+            "name": "name",
+            "status": "Succeeded",
+            "startTime": "2020-01-01T00:00:00Z"
+        }
+    ]
+}` + "\n"),
+		},
+		{
+			name:           "no archived async operations",
+			resourceID:     testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			archiveEnabled: true,
+			mocks: func(tt *test, a *mock_archive.MockManager) {
+				a.EXPECT().
+					ListAsyncOperations(gomock.Any(), strings.ToLower(tt.resourceID)).
+					Return(nil, nil)
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse: []byte(`{
+    "value": [
+    ]
+}` + "\n"),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t)
+			defer ti.done()
+
+			a := mock_archive.NewMockManager(ti.controller)
+			tt.mocks(tt, a)
+
+			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, api.APIs, &noop.Noop{}, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.archiveEnabled {
+				f.(*frontend).archive = a
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodGet,
+				fmt.Sprintf("https://server/admin%s/asyncoperations", tt.resourceID),
+				nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, tt.wantResponse)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
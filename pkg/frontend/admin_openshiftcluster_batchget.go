@@ -0,0 +1,173 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/admin"
+	"github.com/Azure/ARO-RP/pkg/database"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+// maxBatchGetResourceIDs bounds the number of clusters fetched by one request
+const maxBatchGetResourceIDs = 500
+
+// adminBatchGetRequest lists the clusters to fetch.  Fields are dotted paths
+// into the admin representation of a cluster, e.g.
+// "properties.provisioningState"; all fields are returned if none are given.
+type adminBatchGetRequest struct {
+	ResourceIDs []string `json:"resourceIds"`
+	Fields      []string `json:"fields,omitempty"`
+}
+
+type adminBatchGetResponse struct {
+	Value    []map[string]interface{} `json:"value"`
+	NotFound []string                 `json:"notFound,omitempty"`
+}
+
+func (f *frontend) postAdminOpenShiftClustersBatchGet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+
+	b, err := f._postAdminOpenShiftClustersBatchGet(database.WithReadReplicas(ctx), r, f.apis[admin.APIVersion].OpenShiftClusterConverter())
+
+	adminReply(log, w, nil, b, err)
+}
+
+// _postAdminOpenShiftClustersBatchGet returns the clusters with the requested
+// resource IDs in one call, for fleet tooling which would otherwise GET them
+// one by one.  Unlike a GET, clusters are returned as stored and are not
+// enriched with data fetched from the clusters themselves.
+func (f *frontend) _postAdminOpenShiftClustersBatchGet(ctx context.Context, r *http.Request, converter api.OpenShiftClusterConverter) ([]byte, error) {
+	body := r.Context().Value(middleware.ContextKeyBody).([]byte)
+
+	var req *adminBatchGetRequest
+	err := json.Unmarshal(body, &req)
+	if err != nil || req == nil {
+		return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidRequestContent, "", "The request content was invalid and could not be deserialized: %q.", err)
+	}
+
+	err = validateAdminBatchGet(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &adminBatchGetResponse{
+		Value: []map[string]interface{}{},
+	}
+
+	seen := map[string]bool{}
+	for _, resourceID := range req.ResourceIDs {
+		key := strings.ToLower(resourceID)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		doc, err := f.dbOpenShiftClusters.Get(ctx, key)
+		switch {
+		case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+			resp.NotFound = append(resp.NotFound, resourceID)
+			continue
+		case err != nil:
+			return nil, err
+		}
+
+		doc.OpenShiftCluster.Properties.ClusterProfile.PullSecret = ""
+		doc.OpenShiftCluster.Properties.ServicePrincipalProfile.ClientSecret = ""
+
+		b, err := json.Marshal(converter.ToExternal(doc.OpenShiftCluster))
+		if err != nil {
+			return nil, err
+		}
+
+		var oc map[string]interface{}
+		err = json.Unmarshal(b, &oc)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(req.Fields) > 0 {
+			oc = selectFields(oc, req.Fields)
+		}
+
+		resp.Value = append(resp.Value, oc)
+	}
+
+	return json.MarshalIndent(resp, "", "    ")
+}
+
+func validateAdminBatchGet(req *adminBatchGetRequest) error {
+	if len(req.ResourceIDs) == 0 || len(req.ResourceIDs) > maxBatchGetResourceIDs {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "resourceIds", "Between 1 and %d resourceIds must be provided.", maxBatchGetResourceIDs)
+	}
+
+	for _, resourceID := range req.ResourceIDs {
+		r, err := azure.ParseResourceID(resourceID)
+		if err != nil ||
+			!strings.EqualFold(r.Provider, "Microsoft.RedHatOpenShift") ||
+			!strings.EqualFold(r.ResourceType, "openShiftClusters") {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "resourceIds", "The provided resourceId '%s' is invalid.", resourceID)
+		}
+	}
+
+	for _, field := range req.Fields {
+		if field == "" || strings.HasPrefix(field, ".") || strings.HasSuffix(field, ".") || strings.Contains(field, "..") {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "fields", "The provided field '%s' is invalid.", field)
+		}
+	}
+
+	return nil
+}
+
+// selectFields returns the parts of oc at the given dotted paths, keeping
+// their nesting.  The id is always kept so that the caller can tell the
+// results apart; paths which are not present are left out.
+func selectFields(oc map[string]interface{}, fields []string) map[string]interface{} {
+	selected := map[string]interface{}{
+		"id": oc["id"],
+	}
+
+	for _, field := range fields {
+		copyField(selected, oc, strings.Split(field, "."))
+	}
+
+	return selected
+}
+
+func copyField(dst, src map[string]interface{}, path []string) {
+	v, found := src[path[0]]
+	if !found {
+		return
+	}
+
+	if len(path) == 1 {
+		dst[path[0]] = v
+		return
+	}
+
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	d, ok := dst[path[0]].(map[string]interface{})
+	if !ok {
+		d = map[string]interface{}{}
+	}
+
+	copyField(d, m, path[1:])
+	if len(d) > 0 {
+		dst[path[0]] = d
+	}
+}
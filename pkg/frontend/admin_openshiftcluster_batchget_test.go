@@ -0,0 +1,165 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestAdminBatchGetOpenShiftClusters(t *testing.T) {
+	ctx := context.Background()
+
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	otherMockSubID := "00000000-0000-0000-0000-000000000001"
+
+	resourceID1 := testdatabase.GetResourcePath(mockSubID, "resourceName1")
+	resourceID2 := testdatabase.GetResourcePath(otherMockSubID, "resourceName2")
+	missingResourceID := testdatabase.GetResourcePath(mockSubID, "missing")
+
+	fixture := func(f *testdatabase.Fixture) {
+		for _, resourceID := range []string{resourceID1, resourceID2} {
+			f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				Key: strings.ToLower(resourceID),
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID:   resourceID,
+					Name: resourceID[strings.LastIndexByte(resourceID, '/')+1:],
+					Type: "Microsoft.RedHatOpenShift/openshiftClusters",
+					Properties: api.OpenShiftClusterProperties{
+						ProvisioningState: api.ProvisioningStateSucceeded,
+						ClusterProfile: api.ClusterProfile{
+							Domain:     "example.com",
+							PullSecret: "{}",
+						},
+						ServicePrincipalProfile: api.ServicePrincipalProfile{
+							ClientSecret: "clientSecret",
+						},
+					},
+				},
+			})
+		}
+	}
+
+	type test struct {
+		name           string
+		body           interface{}
+		wantStatusCode int
+		wantResponse   *adminBatchGetResponse
+		wantError      string
+	}
+
+	for _, tt := range []*test{
+		{
+			name: "selected fields",
+			body: &adminBatchGetRequest{
+				ResourceIDs: []string{resourceID1, missingResourceID, resourceID2, strings.ToUpper(resourceID1)},
+				Fields:      []string{"name", "properties.provisioningState", "properties.clusterProfile.domain", "properties.clusterProfile.pullSecret", "properties.unknown.field"},
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse: &adminBatchGetResponse{
+				Value: []map[string]interface{}{
+					{
+						"id":   resourceID1,
+						"name": "resourceName1",
+						"properties": map[string]interface{}{
+							"provisioningState": "Succeeded",
+							"clusterProfile": map[string]interface{}{
+								"domain": "example.com",
+							},
+						},
+					},
+					{
+						"id":   resourceID2,
+						"name": "resourceName2",
+						"properties": map[string]interface{}{
+							"provisioningState": "Succeeded",
+							"clusterProfile": map[string]interface{}{
+								"domain": "example.com",
+							},
+						},
+					},
+				},
+				NotFound: []string{missingResourceID},
+			},
+		},
+		{
+			name: "nothing found",
+			body: &adminBatchGetRequest{
+				ResourceIDs: []string{missingResourceID},
+				Fields:      []string{"name"},
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse: &adminBatchGetResponse{
+				Value:    []map[string]interface{}{},
+				NotFound: []string{missingResourceID},
+			},
+		},
+		{
+			name:           "no resource IDs",
+			body:           &adminBatchGetRequest{},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: resourceIds: Between 1 and 500 resourceIds must be provided.",
+		},
+		{
+			name: "invalid resource ID",
+			body: &adminBatchGetRequest{
+				ResourceIDs: []string{"/subscriptions/" + mockSubID + "/resourceGroups/resourceGroup/providers/Microsoft.Compute/virtualMachines/vm"},
+			},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: resourceIds: The provided resourceId '/subscriptions/" + mockSubID + "/resourceGroups/resourceGroup/providers/Microsoft.Compute/virtualMachines/vm' is invalid.",
+		},
+		{
+			name: "invalid field",
+			body: &adminBatchGetRequest{
+				ResourceIDs: []string{resourceID1},
+				Fields:      []string{"properties..provisioningState"},
+			},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: fields: The provided field 'properties..provisioningState' is invalid.",
+		},
+		{
+			name:           "invalid body",
+			body:           []string{resourceID1},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `400: InvalidRequestContent: : The request content was invalid and could not be deserialized: "json: cannot unmarshal array into Go value of type frontend.adminBatchGetRequest".`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithOpenShiftClusters().WithSubscriptions()
+			defer ti.done()
+
+			err := ti.buildFixtures(fixture)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, api.APIs, &noop.Noop{}, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodPost,
+				"https://server/admin/providers/Microsoft.RedHatOpenShift/openShiftClusters/batchget",
+				http.Header{
+					"Content-Type": []string{"application/json"},
+				}, tt.body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, tt.wantResponse)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/frontend/adminactions"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	mock_adminactions "github.com/Azure/ARO-RP/pkg/util/mocks/adminactions"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestAdminClusterHealth(t *testing.T) {
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	mockTenantID := "00000000-0000-0000-0000-000000000000"
+
+	ctx := context.Background()
+
+	health := &operator.ClusterHealth{
+		OperatorVersion: "version",
+		Conditions: status.Conditions{
+			{
+				Type:    arov1alpha1.MachineValid,
+				Status:  corev1.ConditionFalse,
+				Reason:  "CheckFailed",
+				Message: "machine foo-hx8z7-master-2: invalid VM size 'Standard_A1'\n",
+			},
+		},
+		MachineChecks: []arov1alpha1.MachineCheckStatus{
+			{
+				Kind: "Machine",
+				Name: "foo-hx8z7-master-2",
+				Role: "master",
+				Failures: []arov1alpha1.MachineCheckFailure{
+					{
+						Reason:  arov1alpha1.MachineInvalidVMSize,
+						Message: "invalid VM size 'Standard_A1'",
+					},
+				},
+			},
+		},
+	}
+
+	type test struct {
+		name           string
+		resourceID     string
+		fixture        func(*testdatabase.Fixture)
+		mocks          func(*test, *mock_adminactions.MockInterface)
+		wantStatusCode int
+		wantResponse   interface{}
+		wantError      string
+	}
+
+	for _, tt := range []*test{
+		{
+			name:       "cluster health returned",
+			resourceID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			fixture: func(f *testdatabase.Fixture) {
+				f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					Key: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "resourceName")),
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+					},
+				})
+
+				f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+					ID: mockSubID,
+					Subscription: &api.Subscription{
+						State: api.SubscriptionStateRegistered,
+						Properties: &api.SubscriptionProperties{
+							TenantID: mockTenantID,
+						},
+					},
+				})
+			},
+			mocks: func(tt *test, a *mock_adminactions.MockInterface) {
+				a.EXPECT().ClusterHealth(gomock.Any()).Return(health, nil)
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse:   health,
+		},
+		{
+			name:           "cluster not found",
+			resourceID:     testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			fixture:        func(f *testdatabase.Fixture) {},
+			mocks:          func(tt *test, a *mock_adminactions.MockInterface) {},
+			wantStatusCode: http.StatusNotFound,
+			wantError:      "404: ResourceNotFound: : The Resource 'openshiftclusters/resourcename' under resource group 'resourcegroup' was not found.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithOpenShiftClusters().WithSubscriptions()
+			defer ti.done()
+
+			a := mock_adminactions.NewMockInterface(ti.controller)
+			tt.mocks(tt, a)
+
+			err := ti.buildFixtures(tt.fixture)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, api.APIs, &noop.Noop{}, nil, func(*logrus.Entry, env.Interface, *api.OpenShiftCluster,
+				*api.SubscriptionDocument) (adminactions.Interface, error) {
+				return a, nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodGet,
+				fmt.Sprintf("https://server/admin%s/clusterhealth", tt.resourceID),
+				nil, nil)
+			if err != nil {
+				t.Error(err)
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, tt.wantResponse)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
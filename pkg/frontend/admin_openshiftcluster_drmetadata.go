@@ -0,0 +1,232 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	uuid "github.com/satori/go.uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+func (f *frontend) getAdminOpenShiftClusterDRMetadata(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	r.URL.Path = filepath.Dir(r.URL.Path)
+
+	b, err := f._getAdminOpenShiftClusterDRMetadata(ctx, r, log)
+
+	adminReply(log, w, nil, b, err)
+}
+
+// _getAdminOpenShiftClusterDRMetadata exports the cluster's document, stripped
+// of its secrets, together with its DNS records, so that it can be imported
+// into another region's RP database if this region is lost
+func (f *frontend) _getAdminOpenShiftClusterDRMetadata(ctx context.Context, r *http.Request, log *logrus.Entry) ([]byte, error) {
+	vars := mux.Vars(r)
+	correlationData := r.Context().Value(middleware.ContextKeyCorrelationData).(*api.CorrelationData)
+
+	resourceID := strings.TrimPrefix(r.URL.Path, "/admin")
+
+	doc, err := f.dbOpenShiftClusters.Get(ctx, resourceID)
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		return nil, api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeResourceNotFound, "", "The Resource '%s/%s' under resource group '%s' was not found.", vars["resourceType"], vars["resourceName"], vars["resourceGroupName"])
+	case err != nil:
+		return nil, err
+	}
+
+	log.WithField("client_principal_name", correlationData.ClientPrincipalName).Print("exporting DR metadata")
+
+	return json.MarshalIndent(&api.OpenShiftClusterDRMetadata{
+		FormatVersion:    api.DRMetadataFormatVersion,
+		ExportedAt:       time.Now().UTC(),
+		SourceLocation:   f.env.Location(),
+		DNS:              drMetadataDNS(doc.OpenShiftCluster),
+		SecretReferences: stripDRMetadataSecrets(doc.OpenShiftCluster),
+		OpenShiftCluster: doc.OpenShiftCluster,
+	}, "", "    ")
+}
+
+func (f *frontend) putAdminOpenShiftClusterDRMetadata(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	r.URL.Path = filepath.Dir(r.URL.Path)
+
+	err := f._putAdminOpenShiftClusterDRMetadata(ctx, r, log)
+
+	adminReply(log, w, nil, nil, err)
+}
+
+// _putAdminOpenShiftClusterDRMetadata imports DR metadata exported by another
+// region's RP.  The metadata must describe exactly the cluster in the URL, in
+// a terminal provisioning state, and must not carry secrets; the cluster must
+// not already be known to this region.  Secrets listed in the metadata's
+// SecretReferences have to be restored out of band afterwards.
+func (f *frontend) _putAdminOpenShiftClusterDRMetadata(ctx context.Context, r *http.Request, log *logrus.Entry) error {
+	vars := mux.Vars(r)
+	body := r.Context().Value(middleware.ContextKeyBody).([]byte)
+	correlationData := r.Context().Value(middleware.ContextKeyCorrelationData).(*api.CorrelationData)
+
+	resourceID := strings.TrimPrefix(r.URL.Path, "/admin")
+
+	var metadata *api.OpenShiftClusterDRMetadata
+	err := json.Unmarshal(body, &metadata)
+	if err != nil {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidRequestContent, "", "The request content was invalid and could not be deserialized: %q.", err)
+	}
+
+	err = f.validateDRMetadata(metadata, resourceID)
+	if err != nil {
+		return err
+	}
+
+	_, err = f.validateSubscriptionState(ctx, resourceID, api.SubscriptionStateRegistered, api.SubscriptionStateWarned)
+	if err != nil {
+		return err
+	}
+
+	_, err = f.dbOpenShiftClusters.Get(ctx, resourceID)
+	switch {
+	case err == nil:
+		return api.NewCloudError(http.StatusConflict, api.CloudErrorCodeRequestNotAllowed, "", "The Resource '%s/%s' under resource group '%s' already exists in this region.", vars["resourceType"], vars["resourceName"], vars["resourceGroupName"])
+	case !cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		return err
+	}
+
+	doc := &api.OpenShiftClusterDocument{
+		ID:                        uuid.NewV4().String(),
+		Key:                       strings.ToLower(resourceID),
+		ClusterResourceGroupIDKey: strings.ToLower(metadata.OpenShiftCluster.Properties.ClusterProfile.ResourceGroupID),
+		ClientIDKey:               strings.ToLower(metadata.OpenShiftCluster.Properties.ServicePrincipalProfile.ClientID),
+		OpenShiftCluster:          metadata.OpenShiftCluster,
+		DRImport: &api.DRImport{
+			SourceLocation: metadata.SourceLocation,
+			ExportedAt:     metadata.ExportedAt,
+			ImportedAt:     time.Now().UTC(),
+			ImportedBy:     correlationData.ClientPrincipalName,
+		},
+	}
+
+	doc.Bucket, err = f.bucketAllocator.Allocate()
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(logrus.Fields{
+		"client_principal_name": correlationData.ClientPrincipalName,
+		"source_location":       metadata.SourceLocation,
+		"exported_at":           metadata.ExportedAt,
+		"secret_references":     strings.Join(metadata.SecretReferences, ","),
+	}).Print("importing DR metadata")
+
+	_, err = f.dbOpenShiftClusters.Create(ctx, doc)
+	if cosmosdb.IsErrorStatusCode(err, http.StatusPreconditionFailed) {
+		return api.NewCloudError(http.StatusConflict, api.CloudErrorCodeRequestNotAllowed, "", "The cluster resource group or service principal is already used by another cluster in this region.")
+	}
+
+	return err
+}
+
+func (f *frontend) validateDRMetadata(metadata *api.OpenShiftClusterDRMetadata, resourceID string) error {
+	if metadata == nil || metadata.OpenShiftCluster == nil {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidRequestContent, "", "The request content did not contain a cluster.")
+	}
+
+	if metadata.FormatVersion != api.DRMetadataFormatVersion {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "formatVersion", "The provided formatVersion '%d' is not supported.", metadata.FormatVersion)
+	}
+
+	if !strings.EqualFold(metadata.OpenShiftCluster.ID, resourceID) {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeMismatchingResourceID, "openShiftCluster.id", "The provided resource ID '%s' did not match the name in the Url '%s'.", metadata.OpenShiftCluster.ID, resourceID)
+	}
+
+	if strings.EqualFold(metadata.SourceLocation, f.env.Location()) {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "sourceLocation", "The provided sourceLocation '%s' is invalid: DR metadata must be imported into a different region.", metadata.SourceLocation)
+	}
+
+	if !metadata.OpenShiftCluster.Properties.ProvisioningState.IsTerminal() {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeRequestNotAllowed, "", "DR metadata exported in provisioningState '%s' cannot be imported.", metadata.OpenShiftCluster.Properties.ProvisioningState)
+	}
+
+	if secrets := stripDRMetadataSecrets(metadata.OpenShiftCluster); len(secrets) > 0 {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidRequestContent, "", "The request content must not contain secrets, but contained %s.", strings.Join(secrets, ", "))
+	}
+
+	dns := drMetadataDNS(metadata.OpenShiftCluster)
+	if metadata.DNS.Domain != dns.Domain ||
+		metadata.DNS.APIServerIP != dns.APIServerIP ||
+		metadata.DNS.IngressIP != dns.IngressIP {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "dns", "The provided DNS records do not match the cluster.")
+	}
+
+	return nil
+}
+
+// drMetadataDNS returns the DNS records of the cluster
+func drMetadataDNS(oc *api.OpenShiftCluster) (dns api.DRMetadataDNS) {
+	dns.Domain = oc.Properties.ClusterProfile.Domain
+	dns.APIServerIP = oc.Properties.APIServerProfile.IP
+
+	for _, ip := range oc.Properties.IngressProfiles {
+		if ip.Name == "default" {
+			dns.IngressIP = ip.IP
+		}
+	}
+
+	return dns
+}
+
+// stripDRMetadataSecrets clears the secrets of the cluster and returns the
+// paths of those which were set
+func stripDRMetadataSecrets(oc *api.OpenShiftCluster) (secrets []string) {
+	strip := func(path string, set bool) {
+		if set {
+			secrets = append(secrets, path)
+		}
+	}
+
+	p := &oc.Properties
+
+	strip("properties.clusterProfile.pullSecret", p.ClusterProfile.PullSecret != "")
+	p.ClusterProfile.PullSecret = ""
+
+	strip("properties.servicePrincipalProfile.clientSecret", p.ServicePrincipalProfile.ClientSecret != "")
+	p.ServicePrincipalProfile.ClientSecret = ""
+
+	strip("properties.sshKey", p.SSHKey != nil)
+	p.SSHKey = nil
+
+	strip("properties.newSshKey", p.NewSSHKey != nil)
+	p.NewSSHKey = nil
+
+	strip("properties.adminKubeconfig", p.AdminKubeconfig != nil)
+	p.AdminKubeconfig = nil
+
+	strip("properties.aroServiceKubeconfig", p.AROServiceKubeconfig != nil)
+	p.AROServiceKubeconfig = nil
+
+	strip("properties.kubeadminPassword", p.KubeadminPassword != "")
+	p.KubeadminPassword = ""
+
+	strip("properties.inventoryClientKey", p.InventoryClientKey != nil)
+	p.InventoryClientKey = nil
+
+	for _, rp := range p.RegistryProfiles {
+		strip("properties.registryProfiles["+rp.Name+"].password", rp.Password != "")
+		rp.Password = ""
+	}
+
+	return secrets
+}
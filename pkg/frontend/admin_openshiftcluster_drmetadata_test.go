@@ -0,0 +1,312 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestAdminDRMetadata(t *testing.T) {
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	resourceID := testdatabase.GetResourcePath(mockSubID, "resourceName")
+
+	ctx := context.Background()
+
+	cluster := func() *api.OpenShiftCluster {
+		return &api.OpenShiftCluster{
+			ID: resourceID,
+			Properties: api.OpenShiftClusterProperties{
+				ProvisioningState: api.ProvisioningStateSucceeded,
+				ClusterProfile: api.ClusterProfile{
+					Domain:          "example.com",
+					ResourceGroupID: fmt.Sprintf("/subscriptions/%s/resourcegroups/test-cluster", mockSubID),
+				},
+				ServicePrincipalProfile: api.ServicePrincipalProfile{
+					ClientID: "clientid",
+				},
+				APIServerProfile: api.APIServerProfile{
+					IP: "1.2.3.4",
+				},
+				IngressProfiles: []api.IngressProfile{
+					{
+						Name: "default",
+						IP:   "5.6.7.8",
+					},
+				},
+			},
+		}
+	}
+
+	metadata := func(location string) *api.OpenShiftClusterDRMetadata {
+		return &api.OpenShiftClusterDRMetadata{
+			FormatVersion:    api.DRMetadataFormatVersion,
+			SourceLocation:   location,
+			OpenShiftCluster: cluster(),
+			DNS: api.DRMetadataDNS{
+				Domain:      "example.com",
+				APIServerIP: "1.2.3.4",
+				IngressIP:   "5.6.7.8",
+			},
+			SecretReferences: []string{"properties.adminKubeconfig"},
+		}
+	}
+
+	addSubscription := func(f *testdatabase.Fixture) {
+		f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+			ID: mockSubID,
+			Subscription: &api.Subscription{
+				State: api.SubscriptionStateRegistered,
+				Properties: &api.SubscriptionProperties{
+					TenantID: mockSubID,
+				},
+			},
+		})
+	}
+
+	t.Run("export", func(t *testing.T) {
+		ti := newTestInfra(t).WithOpenShiftClusters().WithSubscriptions()
+		defer ti.done()
+
+		err := ti.buildFixtures(func(f *testdatabase.Fixture) {
+			oc := cluster()
+			oc.Properties.ClusterProfile.PullSecret = "pullsecret"
+			oc.Properties.AdminKubeconfig = api.SecureBytes("kubeconfig")
+			oc.Properties.RegistryProfiles = []*api.RegistryProfile{
+				{
+					Name:     "arosvc.azurecr.io",
+					Username: "user",
+					Password: "password",
+				},
+			}
+
+			f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				Key:              strings.ToLower(resourceID),
+				OpenShiftCluster: oc,
+			})
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, api.APIs, &noop.Noop{}, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		go f.Run(ctx, nil, nil)
+
+		resp, b, err := ti.request(http.MethodGet,
+			fmt.Sprintf("https://server/admin%s/drmetadata", resourceID),
+			nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			t.Fatal(resp.StatusCode, string(b))
+		}
+
+		var got *api.OpenShiftClusterDRMetadata
+		err = json.Unmarshal(b, &got)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got.FormatVersion != api.DRMetadataFormatVersion || got.SourceLocation != "eastus" {
+			t.Error(string(b))
+		}
+
+		if !reflect.DeepEqual(got.SecretReferences, []string{
+			"properties.clusterProfile.pullSecret",
+			"properties.adminKubeconfig",
+			"properties.registryProfiles[arosvc.azurecr.io].password",
+		}) {
+			t.Error(got.SecretReferences)
+		}
+
+		if strings.Contains(string(b), "pullsecret") ||
+			got.OpenShiftCluster.Properties.AdminKubeconfig != nil ||
+			got.OpenShiftCluster.Properties.RegistryProfiles[0].Password != "" {
+			t.Error(string(b))
+		}
+
+		if got.DNS.Domain != "example.com" || got.DNS.APIServerIP != "1.2.3.4" || got.DNS.IngressIP != "5.6.7.8" {
+			t.Error(got.DNS)
+		}
+	})
+
+	for _, tt := range []struct {
+		name           string
+		fixture        func(*testdatabase.Fixture)
+		metadata       func() *api.OpenShiftClusterDRMetadata
+		wantStatusCode int
+		wantError      string
+	}{
+		{
+			name:    "import",
+			fixture: addSubscription,
+			metadata: func() *api.OpenShiftClusterDRMetadata {
+				return metadata("westus")
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:    "import from the same region",
+			fixture: addSubscription,
+			metadata: func() *api.OpenShiftClusterDRMetadata {
+				return metadata("eastus")
+			},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: sourceLocation: The provided sourceLocation 'eastus' is invalid: DR metadata must be imported into a different region.",
+		},
+		{
+			name:    "unsupported format version",
+			fixture: addSubscription,
+			metadata: func() *api.OpenShiftClusterDRMetadata {
+				m := metadata("westus")
+				m.FormatVersion = 2
+				return m
+			},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: formatVersion: The provided formatVersion '2' is not supported.",
+		},
+		{
+			name:    "mismatching resource ID",
+			fixture: addSubscription,
+			metadata: func() *api.OpenShiftClusterDRMetadata {
+				m := metadata("westus")
+				m.OpenShiftCluster.ID = testdatabase.GetResourcePath(mockSubID, "otherName")
+				return m
+			},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      fmt.Sprintf("400: MismatchingResourceID: openShiftCluster.id: The provided resource ID '%s' did not match the name in the Url '%s'.", testdatabase.GetResourcePath(mockSubID, "otherName"), strings.ToLower(resourceID)),
+		},
+		{
+			name:    "cluster busy",
+			fixture: addSubscription,
+			metadata: func() *api.OpenShiftClusterDRMetadata {
+				m := metadata("westus")
+				m.OpenShiftCluster.Properties.ProvisioningState = api.ProvisioningStateUpdating
+				return m
+			},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: RequestNotAllowed: : DR metadata exported in provisioningState 'Updating' cannot be imported.",
+		},
+		{
+			name:    "secrets included",
+			fixture: addSubscription,
+			metadata: func() *api.OpenShiftClusterDRMetadata {
+				m := metadata("westus")
+				m.OpenShiftCluster.Properties.ServicePrincipalProfile.ClientSecret = "secret"
+				return m
+			},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidRequestContent: : The request content must not contain secrets, but contained properties.servicePrincipalProfile.clientSecret.",
+		},
+		{
+			name:    "DNS mismatch",
+			fixture: addSubscription,
+			metadata: func() *api.OpenShiftClusterDRMetadata {
+				m := metadata("westus")
+				m.DNS.IngressIP = "9.9.9.9"
+				return m
+			},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: dns: The provided DNS records do not match the cluster.",
+		},
+		{
+			name: "cluster already known",
+			fixture: func(f *testdatabase.Fixture) {
+				addSubscription(f)
+				f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					Key:              strings.ToLower(resourceID),
+					OpenShiftCluster: cluster(),
+				})
+			},
+			metadata: func() *api.OpenShiftClusterDRMetadata {
+				return metadata("westus")
+			},
+			wantStatusCode: http.StatusConflict,
+			wantError:      "409: RequestNotAllowed: : The Resource 'openshiftclusters/resourcename' under resource group 'resourcegroup' already exists in this region.",
+		},
+		{
+			name: "service principal already used",
+			fixture: func(f *testdatabase.Fixture) {
+				addSubscription(f)
+				f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					Key:         strings.ToLower(testdatabase.GetResourcePath(mockSubID, "otherName")),
+					ClientIDKey: "clientid",
+				})
+			},
+			metadata: func() *api.OpenShiftClusterDRMetadata {
+				return metadata("westus")
+			},
+			wantStatusCode: http.StatusConflict,
+			wantError:      "409: RequestNotAllowed: : The cluster resource group or service principal is already used by another cluster in this region.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithOpenShiftClusters().WithSubscriptions()
+			defer ti.done()
+
+			err := ti.buildFixtures(tt.fixture)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, api.APIs, &noop.Noop{}, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodPut,
+				fmt.Sprintf("https://server/admin%s/drmetadata", resourceID),
+				http.Header{
+					"Content-Type":               []string{"application/json"},
+					"X-Ms-Client-Principal-Name": []string{"sre@example.com"},
+				}, tt.metadata())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, nil)
+			if err != nil {
+				t.Error(err)
+			}
+
+			if tt.wantError != "" {
+				return
+			}
+
+			doc, err := ti.openShiftClustersDatabase.Get(ctx, strings.ToLower(resourceID))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if doc.DRImport == nil ||
+				doc.DRImport.SourceLocation != "westus" ||
+				doc.DRImport.ImportedBy != "sre@example.com" ||
+				doc.DRImport.ImportedAt.IsZero() {
+				t.Error(doc.DRImport)
+			}
+
+			if doc.ClusterResourceGroupIDKey != strings.ToLower(cluster().Properties.ClusterProfile.ResourceGroupID) ||
+				doc.ClientIDKey != "clientid" {
+				t.Error(doc.ClusterResourceGroupIDKey, doc.ClientIDKey)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/admin"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+// openShiftClusterExample has the layout of the examples in the swagger
+// specification of the RP API
+type openShiftClusterExample struct {
+	Parameters struct {
+		APIVersion        string          `json:"api-version"`
+		SubscriptionID    string          `json:"subscriptionId"`
+		ResourceGroupName string          `json:"resourceGroupName"`
+		ResourceName      string          `json:"resourceName"`
+		Parameters        json.RawMessage `json:"parameters"`
+	} `json:"parameters"`
+}
+
+func (f *frontend) getAdminOpenShiftClusterExample(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	r.URL.Path = filepath.Dir(r.URL.Path)
+
+	b, err := f._getAdminOpenShiftClusterExample(ctx, r, log)
+
+	adminReply(log, w, nil, b, err)
+}
+
+// _getAdminOpenShiftClusterExample returns the PUT request which would create
+// the cluster at the API version in the apiVersion parameter, in the layout
+// of the swagger examples.  The request is sanitized so that it can be used
+// to reproduce the cluster's configuration in a test environment: secrets are
+// replaced by placeholders, read-only fields and tags are dropped, and the
+// subscription, resource group and name of the cluster are replaced by the
+// placeholders used in the swagger examples.
+func (f *frontend) _getAdminOpenShiftClusterExample(ctx context.Context, r *http.Request, log *logrus.Entry) ([]byte, error) {
+	vars := mux.Vars(r)
+	correlationData := r.Context().Value(middleware.ContextKeyCorrelationData).(*api.CorrelationData)
+
+	apiVersion := r.URL.Query().Get("apiVersion")
+	if apiVersion == admin.APIVersion || f.apis[apiVersion] == nil {
+		return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "apiVersion", "The provided apiVersion '%s' is not supported.  Supported versions are %s.", apiVersion, strings.Join(f.exampleAPIVersions(), ", "))
+	}
+
+	resourceID := strings.TrimPrefix(r.URL.Path, "/admin")
+
+	doc, err := f.dbOpenShiftClusters.Get(ctx, resourceID)
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		return nil, api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeResourceNotFound, "", "The Resource '%s/%s' under resource group '%s' was not found.", vars["resourceType"], vars["resourceName"], vars["resourceGroupName"])
+	case err != nil:
+		return nil, err
+	}
+
+	res, err := azure.ParseResourceID(doc.OpenShiftCluster.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	log.WithField("client_principal_name", correlationData.ClientPrincipalName).Printf("generating example at api version %s", apiVersion)
+
+	sanitizeOpenShiftClusterExample(doc.OpenShiftCluster)
+
+	b, err := json.Marshal(f.apis[apiVersion].OpenShiftClusterConverter().ToExternal(doc.OpenShiftCluster))
+	if err != nil {
+		return nil, err
+	}
+
+	rxSubscriptionID := regexp.MustCompile(`(?i)` + regexp.QuoteMeta(res.SubscriptionID))
+
+	var example openShiftClusterExample
+	example.Parameters.APIVersion = apiVersion
+	example.Parameters.SubscriptionID = "subscriptionId"
+	example.Parameters.ResourceGroupName = "resourceGroup"
+	example.Parameters.ResourceName = "resourceName"
+	example.Parameters.Parameters = rxSubscriptionID.ReplaceAll(b, []byte("subscriptionId"))
+
+	return json.MarshalIndent(example, "", "    ")
+}
+
+// exampleAPIVersions returns the customer facing API versions
+func (f *frontend) exampleAPIVersions() []string {
+	versions := make([]string, 0, len(f.apis))
+	for v := range f.apis {
+		if v != admin.APIVersion {
+			versions = append(versions, v)
+		}
+	}
+	sort.Strings(versions)
+
+	return versions
+}
+
+// sanitizeOpenShiftClusterExample reduces oc to the fields which a customer
+// sets when creating it, and replaces its secrets and service principal by
+// placeholders
+func sanitizeOpenShiftClusterExample(oc *api.OpenShiftCluster) {
+	stripDRMetadataSecrets(oc)
+
+	oc.ID = ""
+	oc.Name = ""
+	oc.Type = ""
+	oc.Tags = nil
+
+	p := &oc.Properties
+	p.ProvisioningState = ""
+	p.ClusterProfile.Version = ""
+	p.ConsoleProfile.URL = ""
+	p.APIServerProfile.URL = ""
+	p.APIServerProfile.IP = ""
+	for i := range p.IngressProfiles {
+		p.IngressProfiles[i].IP = ""
+	}
+
+	p.ServicePrincipalProfile.ClientID = "clientId"
+	p.ServicePrincipalProfile.ClientSecret = "clientSecret"
+}
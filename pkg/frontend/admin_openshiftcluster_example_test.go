@@ -0,0 +1,200 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	v20200430 "github.com/Azure/ARO-RP/pkg/api/v20200430"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestAdminOpenShiftClusterExample(t *testing.T) {
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	resourceID := testdatabase.GetResourcePath(mockSubID, "resourceName")
+
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name           string
+		apiVersion     string
+		fixture        bool
+		wantStatusCode int
+		wantError      string
+	}{
+		{
+			name:           "example",
+			apiVersion:     v20200430.APIVersion,
+			fixture:        true,
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "admin api version is not supported",
+			apiVersion:     "admin",
+			fixture:        true,
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: apiVersion: The provided apiVersion 'admin' is not supported.",
+		},
+		{
+			name:           "unknown api version is not supported",
+			apiVersion:     "2000-01-01",
+			fixture:        true,
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: apiVersion: The provided apiVersion '2000-01-01' is not supported.",
+		},
+		{
+			name:           "cluster not found",
+			apiVersion:     v20200430.APIVersion,
+			wantStatusCode: http.StatusNotFound,
+			wantError:      "404: ResourceNotFound: : The Resource 'openshiftclusters/resourcename' under resource group 'resourcegroup' was not found.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithOpenShiftClusters().WithSubscriptions()
+			defer ti.done()
+
+			err := ti.buildFixtures(func(f *testdatabase.Fixture) {
+				f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+					ID: mockSubID,
+					Subscription: &api.Subscription{
+						State: api.SubscriptionStateRegistered,
+						Properties: &api.SubscriptionProperties{
+							TenantID: mockSubID,
+						},
+					},
+				})
+
+				if !tt.fixture {
+					return
+				}
+
+				f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					Key: strings.ToLower(resourceID),
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID:       resourceID,
+						Name:     "resourceName",
+						Type:     "Microsoft.RedHatOpenShift/openshiftClusters",
+						Location: "eastus",
+						Tags:     map[string]string{"owner": "customer"},
+						Properties: api.OpenShiftClusterProperties{
+							ProvisioningState: api.ProvisioningStateSucceeded,
+							ClusterProfile: api.ClusterProfile{
+								PullSecret:      "pullsecret",
+								Domain:          "example",
+								Version:         "4.6.0",
+								ResourceGroupID: fmt.Sprintf("/subscriptions/%s/resourcegroups/aro-example", strings.ToUpper(mockSubID)),
+							},
+							ServicePrincipalProfile: api.ServicePrincipalProfile{
+								ClientID:     "customer-client-id",
+								ClientSecret: "customer-client-secret",
+							},
+							MasterProfile: api.MasterProfile{
+								VMSize:   api.VMSizeStandardD8sV3,
+								SubnetID: fmt.Sprintf("/subscriptions/%s/resourceGroups/vnet/providers/Microsoft.Network/virtualNetworks/vnet/subnets/master", mockSubID),
+							},
+							APIServerProfile: api.APIServerProfile{
+								Visibility: api.VisibilityPublic,
+								URL:        "https://api.example.eastus.aroapp.io:6443/",
+								IP:         "1.2.3.4",
+							},
+							IngressProfiles: []api.IngressProfile{
+								{
+									Name:       "default",
+									Visibility: api.VisibilityPublic,
+									IP:         "5.6.7.8",
+								},
+							},
+							KubeadminPassword: "kubeadminpassword",
+						},
+					},
+				})
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, api.APIs, &noop.Noop{}, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodGet,
+				fmt.Sprintf("https://server/admin%s/example?apiVersion=%s", resourceID, tt.apiVersion),
+				nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Fatal(resp.StatusCode, string(b))
+			}
+
+			if tt.wantError != "" {
+				cloudErr := &api.CloudError{StatusCode: resp.StatusCode}
+				err = json.Unmarshal(b, &cloudErr)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if !strings.HasPrefix(cloudErr.Error(), tt.wantError) {
+					t.Error(cloudErr)
+				}
+				return
+			}
+
+			for _, s := range []string{
+				"pullsecret",
+				"customer-client",
+				"kubeadminpassword",
+				"customer\"",
+				"1.2.3.4",
+				"5.6.7.8",
+				"4.6.0",
+				"Succeeded",
+				mockSubID,
+			} {
+				if strings.Contains(string(b), s) {
+					t.Errorf("example contains %q: %s", s, string(b))
+				}
+			}
+
+			var example struct {
+				Parameters struct {
+					APIVersion     string                      `json:"api-version"`
+					SubscriptionID string                      `json:"subscriptionId"`
+					Parameters     *v20200430.OpenShiftCluster `json:"parameters"`
+				} `json:"parameters"`
+			}
+			err = json.Unmarshal(b, &example)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if example.Parameters.APIVersion != tt.apiVersion ||
+				example.Parameters.SubscriptionID != "subscriptionId" {
+				t.Error(string(b))
+			}
+
+			oc := example.Parameters.Parameters
+			if oc == nil ||
+				oc.Location != "eastus" ||
+				oc.Properties.ClusterProfile.Domain != "example" ||
+				oc.Properties.ClusterProfile.ResourceGroupID != "/subscriptions/subscriptionId/resourcegroups/aro-example" ||
+				oc.Properties.ServicePrincipalProfile.ClientID != "clientId" ||
+				oc.Properties.ServicePrincipalProfile.ClientSecret != "clientSecret" ||
+				oc.Properties.MasterProfile.SubnetID != "/subscriptions/subscriptionId/resourceGroups/vnet/providers/Microsoft.Network/virtualNetworks/vnet/subnets/master" {
+				t.Error(string(b))
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/http/httpguts"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/frontend/adminactions"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+func (f *frontend) getAdminOpenShiftClusterExec(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	r.URL.Path = filepath.Dir(r.URL.Path)
+
+	err := f._getAdminOpenShiftClusterExec(ctx, w, r, log)
+
+	adminReply(log, w, nil, nil, err)
+}
+
+func (f *frontend) _getAdminOpenShiftClusterExec(ctx context.Context, w http.ResponseWriter, r *http.Request, log *logrus.Entry) error {
+	query := r.URL.Query()
+
+	namespace, podName, containerName := query.Get("namespace"), query.Get("podname"), query.Get("container")
+	err := validateAdminPodExec(namespace, podName, containerName, query["command"])
+	if err != nil {
+		return err
+	}
+
+	if !isWebSocketUpgrade(r) {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidRequestContent, "", "The request must be a WebSocket upgrade.")
+	}
+
+	opts := &corev1.PodExecOptions{
+		Container: containerName,
+		Command:   query["command"],
+		Stdin:     strings.EqualFold(query.Get("stdin"), "true"),
+		TTY:       strings.EqualFold(query.Get("tty"), "true"),
+	}
+	opts.Stdout = true
+	opts.Stderr = !opts.TTY
+
+	a, err := f.podStreamAdminActions(ctx, r, log)
+	if err != nil {
+		return err
+	}
+
+	// the access log records who made the request; record what they ran.
+	// Each argument is quoted so that the command line reads back exactly.
+	quoted := make([]string, 0, len(opts.Command))
+	for _, arg := range opts.Command {
+		quoted = append(quoted, strconv.Quote(arg))
+	}
+
+	log.WithFields(logrus.Fields{
+		"namespace": namespace,
+		"pod":       podName,
+		"container": containerName,
+		"command":   strings.Join(quoted, " "),
+		"stdin":     opts.Stdin,
+		"tty":       opts.TTY,
+	}).Info("executing command in pod")
+
+	return a.K8sExec(ctx, w, r, namespace, podName, opts)
+}
+
+func (f *frontend) getAdminOpenShiftClusterAttach(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	r.URL.Path = filepath.Dir(r.URL.Path)
+
+	err := f._getAdminOpenShiftClusterAttach(ctx, w, r, log)
+
+	adminReply(log, w, nil, nil, err)
+}
+
+func (f *frontend) _getAdminOpenShiftClusterAttach(ctx context.Context, w http.ResponseWriter, r *http.Request, log *logrus.Entry) error {
+	query := r.URL.Query()
+
+	namespace, podName, containerName := query.Get("namespace"), query.Get("podname"), query.Get("container")
+	err := validateAdminPodLogs(namespace, podName, containerName)
+	if err != nil {
+		return err
+	}
+
+	if !isWebSocketUpgrade(r) {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidRequestContent, "", "The request must be a WebSocket upgrade.")
+	}
+
+	opts := &corev1.PodAttachOptions{
+		Container: containerName,
+		Stdin:     strings.EqualFold(query.Get("stdin"), "true"),
+		TTY:       strings.EqualFold(query.Get("tty"), "true"),
+	}
+	opts.Stdout = true
+	opts.Stderr = !opts.TTY
+
+	a, err := f.podStreamAdminActions(ctx, r, log)
+	if err != nil {
+		return err
+	}
+
+	// the access log records who made the request; record what they reached
+	log.WithFields(logrus.Fields{
+		"namespace": namespace,
+		"pod":       podName,
+		"container": containerName,
+		"stdin":     opts.Stdin,
+		"tty":       opts.TTY,
+	}).Info("attaching to pod")
+
+	return a.K8sAttach(ctx, w, r, namespace, podName, opts)
+}
+
+// podStreamAdminActions returns the adminactions of the cluster which r is
+// addressed to
+func (f *frontend) podStreamAdminActions(ctx context.Context, r *http.Request, log *logrus.Entry) (adminactions.Interface, error) {
+	vars := mux.Vars(r)
+
+	resourceID := strings.TrimPrefix(r.URL.Path, "/admin")
+
+	doc, err := f.dbOpenShiftClusters.Get(ctx, resourceID)
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		return nil, api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeResourceNotFound, "", "The Resource '%s/%s' under resource group '%s' was not found.", vars["resourceType"], vars["resourceName"], vars["resourceGroupName"])
+	case err != nil:
+		return nil, err
+	}
+
+	subscriptionDoc, err := f.getSubscriptionDocument(ctx, doc.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.adminActionsFactory(log, f.env, doc.OpenShiftCluster, subscriptionDoc)
+}
+
+// isWebSocketUpgrade returns true if r asks to switch to the WebSocket
+// protocol
+func isWebSocketUpgrade(r *http.Request) bool {
+	return httpguts.HeaderValuesContainsToken(r.Header["Connection"], "upgrade") &&
+		strings.EqualFold(r.Header.Get("Upgrade"), "websocket")
+}
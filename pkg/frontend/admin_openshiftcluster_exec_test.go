@@ -0,0 +1,160 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/frontend/adminactions"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	mock_adminactions "github.com/Azure/ARO-RP/pkg/util/mocks/adminactions"
+)
+
+func TestAdminExecAndAttach(t *testing.T) {
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	mockTenantID := "00000000-0000-0000-0000-000000000000"
+	ctx := context.Background()
+
+	upgrade := http.Header{
+		"Connection": []string{"Upgrade"},
+		"Upgrade":    []string{"websocket"},
+	}
+
+	type test struct {
+		name           string
+		path           string
+		query          string
+		header         http.Header
+		mocks          func(*test, *mock_adminactions.MockInterface)
+		wantStatusCode int
+		wantError      string
+	}
+
+	for _, tt := range []*test{
+		{
+			name:   "exec",
+			path:   "exec",
+			query:  "namespace=openshift-etcd&podname=etcd-master-0&container=etcdctl&command=etcdctl&command=endpoint&command=status&stdin=true&tty=true",
+			header: upgrade,
+			mocks: func(tt *test, a *mock_adminactions.MockInterface) {
+				a.EXPECT().
+					K8sExec(gomock.Any(), gomock.Any(), gomock.Any(), "openshift-etcd", "etcd-master-0", &corev1.PodExecOptions{
+						Container: "etcdctl",
+						Command:   []string{"etcdctl", "endpoint", "status"},
+						Stdin:     true,
+						Stdout:    true,
+						TTY:       true,
+					}).
+					Return(nil)
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:   "attach",
+			path:   "attach",
+			query:  "namespace=openshift-etcd&podname=etcd-master-0&container=etcd",
+			header: upgrade,
+			mocks: func(tt *test, a *mock_adminactions.MockInterface) {
+				a.EXPECT().
+					K8sAttach(gomock.Any(), gomock.Any(), gomock.Any(), "openshift-etcd", "etcd-master-0", &corev1.PodAttachOptions{
+						Container: "etcd",
+						Stdout:    true,
+						Stderr:    true,
+					}).
+					Return(nil)
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "exec without a command",
+			path:           "exec",
+			query:          "namespace=openshift-etcd&podname=etcd-master-0",
+			header:         upgrade,
+			mocks:          func(tt *test, a *mock_adminactions.MockInterface) {},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: : The provided command is invalid.",
+		},
+		{
+			name:           "customer namespace forbidden",
+			path:           "exec",
+			query:          "namespace=customer&podname=app&command=sh",
+			header:         upgrade,
+			mocks:          func(tt *test, a *mock_adminactions.MockInterface) {},
+			wantStatusCode: http.StatusForbidden,
+			wantError:      "403: Forbidden: : Access to the provided namespace 'customer' is forbidden.",
+		},
+		{
+			name:           "not a websocket upgrade",
+			path:           "attach",
+			query:          "namespace=openshift-etcd&podname=etcd-master-0",
+			mocks:          func(tt *test, a *mock_adminactions.MockInterface) {},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidRequestContent: : The request must be a WebSocket upgrade.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithOpenShiftClusters().WithSubscriptions()
+			defer ti.done()
+
+			a := mock_adminactions.NewMockInterface(ti.controller)
+			tt.mocks(tt, a)
+
+			resourceID := fmt.Sprintf("/subscriptions/%s/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName", mockSubID)
+			ti.fixture.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				Key: strings.ToLower(resourceID),
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID:   resourceID,
+					Name: "resourceName",
+					Type: "Microsoft.RedHatOpenShift/openshiftClusters",
+				},
+			})
+			ti.fixture.AddSubscriptionDocuments(&api.SubscriptionDocument{
+				ID: mockSubID,
+				Subscription: &api.Subscription{
+					State: api.SubscriptionStateRegistered,
+					Properties: &api.SubscriptionProperties{
+						TenantID: mockTenantID,
+					},
+				},
+			})
+
+			err := ti.buildFixtures(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, api.APIs, &noop.Noop{}, nil, func(*logrus.Entry, env.Interface, *api.OpenShiftCluster,
+				*api.SubscriptionDocument) (adminactions.Interface, error) {
+				return a, nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodGet,
+				fmt.Sprintf("https://server/admin%s/%s?%s", resourceID, tt.path, tt.query),
+				tt.header, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, nil)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
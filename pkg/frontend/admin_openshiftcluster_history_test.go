@@ -0,0 +1,132 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestAdminGetOpenShiftClusterHistory(t *testing.T) {
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	ctx := context.Background()
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(time.Hour)
+
+	type test struct {
+		name           string
+		resourceID     string
+		fixture        func(*testdatabase.Fixture)
+		wantStatusCode int
+		wantResponse   []byte
+		wantError      string
+	}
+
+	addDocument := func(f *testdatabase.Fixture, history []api.OperationRecord) {
+		f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+			Key: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "resourceName")),
+			OpenShiftCluster: &api.OpenShiftCluster{
+				ID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			},
+			OperationHistory: history,
+		})
+	}
+
+	for _, tt := range []*test{
+		{
+			name:       "history",
+			resourceID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			fixture: func(f *testdatabase.Fixture) {
+				addDocument(f, []api.OperationRecord{
+					{
+						Operation: api.ProvisioningStateAdminUpdating,
+						StartTime: startTime,
+						EndTime:   &endTime,
+						Result:    api.ProvisioningStateSucceeded,
+						Steps: []api.OperationRecordStep{
+							{
+								Step:       "ensureResourceGroup",
+								DurationMS: 1500,
+								Result:     api.ProvisioningStateSucceeded,
+							},
+						},
+					},
+				})
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse: []byte(`{
+    "value": [
+        {
+            "operation": "AdminUpdating",
+            "startTime": "2020-01-01T00:00:00Z",
+            "endTime": "2020-01-01T01:00:00Z",
+            "result": "Succeeded",
+            "steps": [
+                {
+                    "step": "ensureResourceGroup",
+                    "durationMs": 1500,
+                    "result": "Succeeded"
+                }
+            ]
+        }
+    ]
+}` + "\n"),
+		},
+		{
+			name:       "no history",
+			resourceID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			fixture: func(f *testdatabase.Fixture) {
+				addDocument(f, nil)
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse: []byte(`{
+    "value": []
+}` + "\n"),
+		},
+		{
+			name:           "cluster not found",
+			resourceID:     testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			fixture:        func(f *testdatabase.Fixture) {},
+			wantStatusCode: http.StatusNotFound,
+			wantError:      "404: ResourceNotFound: : The Resource 'openshiftclusters/resourcename' under resource group 'resourcegroup' was not found.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithOpenShiftClusters().WithSubscriptions()
+			defer ti.done()
+
+			err := ti.buildFixtures(tt.fixture)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, api.APIs, &noop.Noop{}, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodGet,
+				fmt.Sprintf("https://server/admin%s/history", tt.resourceID),
+				nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, tt.wantResponse)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
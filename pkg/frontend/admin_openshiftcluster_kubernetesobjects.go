@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/gorilla/mux"
@@ -39,6 +40,16 @@ func (f *frontend) _getAdminKubernetesObjects(ctx context.Context, r *http.Reque
 		return nil, err
 	}
 
+	// lists of kinds with many objects can be paged through by passing the
+	// metadata.continue field of each page back as the continue parameter
+	var limit int64
+	if l := r.URL.Query().Get("limit"); l != "" {
+		limit, err = strconv.ParseInt(l, 10, 64)
+		if err != nil || limit <= 0 {
+			return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "", "The provided limit '%s' is invalid.", l)
+		}
+	}
+
 	resourceID := strings.TrimPrefix(r.URL.Path, "/admin")
 
 	doc, err := f.dbOpenShiftClusters.Get(ctx, resourceID)
@@ -62,7 +73,7 @@ func (f *frontend) _getAdminKubernetesObjects(ctx context.Context, r *http.Reque
 	if name != "" {
 		return a.K8sGet(ctx, groupKind, namespace, name)
 	}
-	return a.K8sList(ctx, groupKind, namespace)
+	return a.K8sList(ctx, groupKind, namespace, limit, r.URL.Query().Get("continue"))
 }
 
 func (f *frontend) deleteAdminKubernetesObjects(w http.ResponseWriter, r *http.Request) {
@@ -32,6 +32,7 @@ func TestAdminKubernetesObjectsGetAndDelete(t *testing.T) {
 		objKind        string
 		objNamespace   string
 		objName        string
+		query          string
 		mocks          func(*test, *mock_adminactions.MockInterface)
 		method         string
 		wantStatusCode int
@@ -64,13 +65,41 @@ func TestAdminKubernetesObjectsGetAndDelete(t *testing.T) {
 			objNamespace: "openshift-project",
 			mocks: func(tt *test, a *mock_adminactions.MockInterface) {
 				a.EXPECT().
-					K8sList(gomock.Any(), tt.objKind, tt.objNamespace).
+					K8sList(gomock.Any(), tt.objKind, tt.objNamespace, int64(0), "").
 					Return([]byte(`{"Kind": "test"}`), nil)
 
 			},
 			wantStatusCode: http.StatusOK,
 			wantResponse:   []byte(`{"Kind": "test"}` + "\n"),
 		},
+		{
+			method:       http.MethodGet,
+			name:         "cluster exist in db - list page",
+			resourceID:   fmt.Sprintf("/subscriptions/%s/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName", mockSubID),
+			objKind:      "ConfigMap",
+			objNamespace: "openshift-project",
+			query:        "&limit=500&continue=token",
+			mocks: func(tt *test, a *mock_adminactions.MockInterface) {
+				a.EXPECT().
+					K8sList(gomock.Any(), tt.objKind, tt.objNamespace, int64(500), "token").
+					Return([]byte(`{"Kind": "test"}`), nil)
+
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse:   []byte(`{"Kind": "test"}` + "\n"),
+		},
+		{
+			method:       http.MethodGet,
+			name:         "invalid limit",
+			resourceID:   fmt.Sprintf("/subscriptions/%s/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName", mockSubID),
+			objKind:      "ConfigMap",
+			objNamespace: "openshift-project",
+			query:        "&limit=-1",
+			mocks: func(tt *test, a *mock_adminactions.MockInterface) {
+			},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: : The provided limit '-1' is invalid.",
+		},
 		{
 			method:       http.MethodGet,
 			name:         "no groupKind provided",
@@ -174,7 +203,7 @@ func TestAdminKubernetesObjectsGetAndDelete(t *testing.T) {
 				t.Fatal(err)
 			}
 
-			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, api.APIs, &noop.Noop{}, nil, func(*logrus.Entry, env.Interface, *api.OpenShiftCluster,
+			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, api.APIs, &noop.Noop{}, nil, func(*logrus.Entry, env.Interface, *api.OpenShiftCluster,
 				*api.SubscriptionDocument) (adminactions.Interface, error) {
 				return a, nil
 			})
@@ -185,7 +214,7 @@ func TestAdminKubernetesObjectsGetAndDelete(t *testing.T) {
 			go f.Run(ctx, nil, nil)
 
 			resp, b, err := ti.request(tt.method,
-				fmt.Sprintf("https://server/admin%s/kubernetesObjects?kind=%s&namespace=%s&name=%s", tt.resourceID, tt.objKind, tt.objNamespace, tt.objName),
+				fmt.Sprintf("https://server/admin%s/kubernetesObjects?kind=%s&namespace=%s&name=%s%s", tt.resourceID, tt.objKind, tt.objNamespace, tt.objName, tt.query),
 				nil, nil)
 			if err != nil {
 				t.Fatal(err)
@@ -369,7 +398,7 @@ func TestAdminPostKubernetesObjects(t *testing.T) {
 				t.Fatal(err)
 			}
 
-			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, api.APIs, &noop.Noop{}, nil, func(*logrus.Entry, env.Interface, *api.OpenShiftCluster,
+			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, api.APIs, &noop.Noop{}, nil, func(*logrus.Entry, env.Interface, *api.OpenShiftCluster,
 				*api.SubscriptionDocument) (adminactions.Interface, error) {
 				return a, nil
 			})
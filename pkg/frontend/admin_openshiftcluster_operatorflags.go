@@ -0,0 +1,190 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+	"github.com/Azure/ARO-RP/pkg/operator"
+)
+
+func (f *frontend) getAdminOpenShiftClusterOperatorFlags(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	r.URL.Path = filepath.Dir(r.URL.Path)
+
+	b, err := f._getAdminOpenShiftClusterOperatorFlags(ctx, r)
+
+	adminReply(log, w, nil, b, err)
+}
+
+func (f *frontend) _getAdminOpenShiftClusterOperatorFlags(ctx context.Context, r *http.Request) ([]byte, error) {
+	vars := mux.Vars(r)
+
+	resourceID := strings.TrimPrefix(r.URL.Path, "/admin")
+
+	doc, err := f.dbOpenShiftClusters.Get(ctx, resourceID)
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		return nil, api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeResourceNotFound, "", "The Resource '%s/%s' under resource group '%s' was not found.", vars["resourceType"], vars["resourceName"], vars["resourceGroupName"])
+	case err != nil:
+		return nil, err
+	}
+
+	return json.MarshalIndent(operator.OperatorFlags(doc.OpenShiftCluster.Properties.OperatorFlags), "", "    ")
+}
+
+func (f *frontend) patchAdminOpenShiftClusterOperatorFlags(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	r.URL.Path = filepath.Dir(r.URL.Path)
+
+	b, err := f._patchAdminOpenShiftClusterOperatorFlags(ctx, r, log)
+
+	adminReply(log, w, nil, b, err)
+}
+
+// _patchAdminOpenShiftClusterOperatorFlags merges the request body into the
+// operator flags of the cluster.  A flag set to null reverts to its default.
+func (f *frontend) _patchAdminOpenShiftClusterOperatorFlags(ctx context.Context, r *http.Request, log *logrus.Entry) ([]byte, error) {
+	body := r.Context().Value(middleware.ContextKeyBody).([]byte)
+	vars := mux.Vars(r)
+
+	var flags map[string]*string
+	err := json.Unmarshal(body, &flags)
+	if err != nil {
+		return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidRequestContent, "", "The request content was invalid and could not be deserialized: %q.", err)
+	}
+
+	err = validateOperatorFlags(flags)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceID := strings.TrimPrefix(r.URL.Path, "/admin")
+
+	doc, err := f.dbOpenShiftClusters.Get(ctx, resourceID)
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		return nil, api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeResourceNotFound, "", "The Resource '%s/%s' under resource group '%s' was not found.", vars["resourceType"], vars["resourceName"], vars["resourceGroupName"])
+	case err != nil:
+		return nil, err
+	}
+
+	subscriptionDoc, err := f.getSubscriptionDocument(ctx, doc.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err = f.dbOpenShiftClusters.Patch(ctx, doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+		if doc.OpenShiftCluster.Properties.OperatorFlags == nil {
+			doc.OpenShiftCluster.Properties.OperatorFlags = map[string]string{}
+		}
+
+		for k, v := range flags {
+			if v == nil {
+				delete(doc.OpenShiftCluster.Properties.OperatorFlags, k)
+			} else {
+				doc.OpenShiftCluster.Properties.OperatorFlags[k] = *v
+			}
+		}
+
+		if len(doc.OpenShiftCluster.Properties.OperatorFlags) == 0 {
+			doc.OpenShiftCluster.Properties.OperatorFlags = nil
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := f.adminActionsFactory(log, f.env, doc.OpenShiftCluster, subscriptionDoc)
+	if err != nil {
+		return nil, err
+	}
+
+	effective := operator.OperatorFlags(doc.OpenShiftCluster.Properties.OperatorFlags)
+
+	log.Printf("setting operator flags %v", effective)
+
+	err = a.OperatorFlagsSet(ctx, effective)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(effective, "", "    ")
+}
+
+// validateOperatorFlags checks the requested flags against the catalog of the
+// flags which the operator supports
+func validateOperatorFlags(flags map[string]*string) error {
+	keys := make([]string, 0, len(flags))
+	for k := range flags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if _, found := operator.DefaultOperatorFlags[k]; !found {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, k, "The provided operator flag '%s' is not supported.", k)
+		}
+
+		v := flags[k]
+		if v == nil {
+			continue
+		}
+
+		var valid bool
+		switch k {
+		case operator.FlagDebugControllers:
+			valid = rxDebugControllers.MatchString(*v)
+		case operator.FlagDebugUntil:
+			valid = validDebugUntil(*v, time.Now())
+		case operator.FlagSyntheticProbeClass:
+			valid = rxSyntheticProbeClass.MatchString(*v)
+		default:
+			valid = *v == "true" || *v == "false"
+		}
+
+		if !valid {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, k, "The provided value '%s' of operator flag '%s' is invalid.", *v, k)
+		}
+	}
+
+	return nil
+}
+
+// rxDebugControllers matches a comma-separated list of controller names, or
+// "*" for all controllers
+var rxDebugControllers = regexp.MustCompile(`^(?:\*|[A-Za-z]+(?:,[A-Za-z]+)*)?$`)
+
+// rxSyntheticProbeClass matches a cluster class name, or "" to disable the
+// synthetic workload probe
+var rxSyntheticProbeClass = regexp.MustCompile(`^(?:[a-z][a-z0-9-]{0,31})?$`)
+
+// validDebugUntil returns true if v is empty or an RFC3339 time at most
+// operator.MaxDebugDuration after now.  Times in the past are accepted: they
+// end a capture.
+func validDebugUntil(v string, now time.Time) bool {
+	if v == "" {
+		return true
+	}
+
+	t, err := time.Parse(time.RFC3339, v)
+	return err == nil && !t.After(now.Add(operator.MaxDebugDuration))
+}
@@ -0,0 +1,306 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/frontend/adminactions"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	"github.com/Azure/ARO-RP/pkg/operator"
+	mock_adminactions "github.com/Azure/ARO-RP/pkg/util/mocks/adminactions"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestAdminOperatorFlags(t *testing.T) {
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	mockTenantID := "00000000-0000-0000-0000-000000000000"
+
+	ctx := context.Background()
+
+	stringPtr := func(s string) *string { return &s }
+
+	type test struct {
+		name           string
+		resourceID     string
+		method         string
+		body           map[string]*string
+		fixture        func(*testdatabase.Fixture)
+		mocks          func(*test, *mock_adminactions.MockInterface)
+		wantStatusCode int
+		wantResponse   *map[string]string
+		wantError      string
+		wantFlags      map[string]string
+	}
+
+	addDocuments := func(f *testdatabase.Fixture, flags map[string]string) {
+		f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+			Key: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "resourceName")),
+			OpenShiftCluster: &api.OpenShiftCluster{
+				ID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+				Properties: api.OpenShiftClusterProperties{
+					ClusterProfile: api.ClusterProfile{
+						ResourceGroupID: fmt.Sprintf("/subscriptions/%s/resourceGroups/test-cluster", mockSubID),
+					},
+					OperatorFlags: flags,
+				},
+			},
+		})
+
+		f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+			ID: mockSubID,
+			Subscription: &api.Subscription{
+				State: api.SubscriptionStateRegistered,
+				Properties: &api.SubscriptionProperties{
+					TenantID: mockTenantID,
+				},
+			},
+		})
+	}
+
+	effective := func(set map[string]string) *map[string]string {
+		flags := operator.OperatorFlags(set)
+		return &flags
+	}
+
+	tooFarAhead := time.Now().Add(operator.MaxDebugDuration + time.Hour).UTC().Format(time.RFC3339)
+
+	for _, tt := range []*test{
+		{
+			name:       "get defaults",
+			resourceID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			method:     http.MethodGet,
+			fixture: func(f *testdatabase.Fixture) {
+				addDocuments(f, nil)
+			},
+			mocks:          func(tt *test, a *mock_adminactions.MockInterface) {},
+			wantStatusCode: http.StatusOK,
+			wantResponse:   effective(nil),
+		},
+		{
+			name:       "get overridden",
+			resourceID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			method:     http.MethodGet,
+			fixture: func(f *testdatabase.Fixture) {
+				addDocuments(f, map[string]string{operator.FlagRouteFixEnabled: "false"})
+			},
+			mocks:          func(tt *test, a *mock_adminactions.MockInterface) {},
+			wantStatusCode: http.StatusOK,
+			wantResponse:   effective(map[string]string{operator.FlagRouteFixEnabled: "false"}),
+		},
+		{
+			name:       "set flag",
+			resourceID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			method:     http.MethodPatch,
+			body: map[string]*string{
+				operator.FlagPullSecretEnabled: stringPtr("false"),
+			},
+			fixture: func(f *testdatabase.Fixture) {
+				addDocuments(f, map[string]string{operator.FlagRouteFixEnabled: "false"})
+			},
+			mocks: func(tt *test, a *mock_adminactions.MockInterface) {
+				a.EXPECT().OperatorFlagsSet(gomock.Any(), *tt.wantResponse).Return(nil)
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse: effective(map[string]string{
+				operator.FlagPullSecretEnabled: "false",
+				operator.FlagRouteFixEnabled:   "false",
+			}),
+			wantFlags: map[string]string{
+				operator.FlagPullSecretEnabled: "false",
+				operator.FlagRouteFixEnabled:   "false",
+			},
+		},
+		{
+			name:       "unset flag",
+			resourceID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			method:     http.MethodPatch,
+			body: map[string]*string{
+				operator.FlagRouteFixEnabled: nil,
+			},
+			fixture: func(f *testdatabase.Fixture) {
+				addDocuments(f, map[string]string{operator.FlagRouteFixEnabled: "false"})
+			},
+			mocks: func(tt *test, a *mock_adminactions.MockInterface) {
+				a.EXPECT().OperatorFlagsSet(gomock.Any(), *tt.wantResponse).Return(nil)
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse:   effective(nil),
+		},
+		{
+			name:       "unsupported flag",
+			resourceID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			method:     http.MethodPatch,
+			body: map[string]*string{
+				"aro.unknown": stringPtr("false"),
+			},
+			fixture: func(f *testdatabase.Fixture) {
+				addDocuments(f, map[string]string{operator.FlagRouteFixEnabled: "false"})
+			},
+			mocks:          func(tt *test, a *mock_adminactions.MockInterface) {},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: aro.unknown: The provided operator flag 'aro.unknown' is not supported.",
+			wantFlags:      map[string]string{operator.FlagRouteFixEnabled: "false"},
+		},
+		{
+			name:       "invalid value",
+			resourceID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			method:     http.MethodPatch,
+			body: map[string]*string{
+				operator.FlagRouteFixEnabled: stringPtr("off"),
+			},
+			fixture: func(f *testdatabase.Fixture) {
+				addDocuments(f, nil)
+			},
+			mocks:          func(tt *test, a *mock_adminactions.MockInterface) {},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: aro.routefix.enabled: The provided value 'off' of operator flag 'aro.routefix.enabled' is invalid.",
+		},
+		{
+			name:       "set debug flags",
+			resourceID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			method:     http.MethodPatch,
+			body: map[string]*string{
+				operator.FlagDebugControllers: stringPtr("Checker,DNS"),
+				operator.FlagDebugUntil:       stringPtr("2020-01-01T00:00:00Z"),
+			},
+			fixture: func(f *testdatabase.Fixture) {
+				addDocuments(f, nil)
+			},
+			mocks: func(tt *test, a *mock_adminactions.MockInterface) {
+				a.EXPECT().OperatorFlagsSet(gomock.Any(), *tt.wantResponse).Return(nil)
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse: effective(map[string]string{
+				operator.FlagDebugControllers: "Checker,DNS",
+				operator.FlagDebugUntil:       "2020-01-01T00:00:00Z",
+			}),
+			wantFlags: map[string]string{
+				operator.FlagDebugControllers: "Checker,DNS",
+				operator.FlagDebugUntil:       "2020-01-01T00:00:00Z",
+			},
+		},
+		{
+			name:       "invalid debug controllers",
+			resourceID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			method:     http.MethodPatch,
+			body: map[string]*string{
+				operator.FlagDebugControllers: stringPtr("Checker, DNS"),
+			},
+			fixture: func(f *testdatabase.Fixture) {
+				addDocuments(f, nil)
+			},
+			mocks:          func(tt *test, a *mock_adminactions.MockInterface) {},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: aro.debug.controllers: The provided value 'Checker, DNS' of operator flag 'aro.debug.controllers' is invalid.",
+		},
+		{
+			name:       "debug until too far ahead",
+			resourceID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			method:     http.MethodPatch,
+			body: map[string]*string{
+				operator.FlagDebugUntil: stringPtr(tooFarAhead),
+			},
+			fixture: func(f *testdatabase.Fixture) {
+				addDocuments(f, nil)
+			},
+			mocks:          func(tt *test, a *mock_adminactions.MockInterface) {},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      fmt.Sprintf("400: InvalidParameter: aro.debug.until: The provided value '%s' of operator flag 'aro.debug.until' is invalid.", tooFarAhead),
+		},
+		{
+			name:       "invalid synthetic probe class",
+			resourceID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			method:     http.MethodPatch,
+			body: map[string]*string{
+				operator.FlagSyntheticProbeClass: stringPtr("Canary"),
+			},
+			fixture: func(f *testdatabase.Fixture) {
+				addDocuments(f, nil)
+			},
+			mocks:          func(tt *test, a *mock_adminactions.MockInterface) {},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: aro.syntheticprobe.class: The provided value 'Canary' of operator flag 'aro.syntheticprobe.class' is invalid.",
+		},
+		{
+			name:       "cluster not found",
+			resourceID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			method:     http.MethodGet,
+			fixture: func(f *testdatabase.Fixture) {
+			},
+			mocks:          func(tt *test, a *mock_adminactions.MockInterface) {},
+			wantStatusCode: http.StatusNotFound,
+			wantError:      "404: ResourceNotFound: : The Resource 'openshiftclusters/resourcename' under resource group 'resourcegroup' was not found.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithOpenShiftClusters().WithSubscriptions()
+			defer ti.done()
+
+			a := mock_adminactions.NewMockInterface(ti.controller)
+			tt.mocks(tt, a)
+
+			err := ti.buildFixtures(tt.fixture)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, api.APIs, &noop.Noop{}, nil, func(*logrus.Entry, env.Interface, *api.OpenShiftCluster,
+				*api.SubscriptionDocument) (adminactions.Interface, error) {
+				return a, nil
+			})
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			var header http.Header
+			var body interface{}
+			if tt.body != nil {
+				header = http.Header{
+					"Content-Type": []string{"application/json"},
+				}
+				body = tt.body
+			}
+
+			resp, b, err := ti.request(tt.method,
+				fmt.Sprintf("https://server/admin%s/operatorflags", tt.resourceID),
+				header, body)
+			if err != nil {
+				t.Error(err)
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, tt.wantResponse)
+			if err != nil {
+				t.Error(err)
+			}
+
+			if tt.method != http.MethodPatch || tt.wantStatusCode == http.StatusNotFound {
+				return
+			}
+
+			doc, err := ti.openShiftClustersDatabase.Get(ctx, strings.ToLower(tt.resourceID))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(doc.OpenShiftCluster.Properties.OperatorFlags, tt.wantFlags) {
+				t.Error(doc.OpenShiftCluster.Properties.OperatorFlags)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+func (f *frontend) getAdminOpenShiftClusterPodLogs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	r.URL.Path = filepath.Dir(r.URL.Path)
+
+	err := f._getAdminOpenShiftClusterPodLogs(ctx, w, r, log)
+
+	adminReply(log, w, nil, nil, err)
+}
+
+func (f *frontend) _getAdminOpenShiftClusterPodLogs(ctx context.Context, w http.ResponseWriter, r *http.Request, log *logrus.Entry) error {
+	vars := mux.Vars(r)
+
+	namespace, podName, containerName := r.URL.Query().Get("namespace"), r.URL.Query().Get("podname"), r.URL.Query().Get("container")
+	err := validateAdminPodLogs(namespace, podName, containerName)
+	if err != nil {
+		return err
+	}
+
+	opts := &corev1.PodLogOptions{
+		Container: containerName,
+		Follow:    strings.EqualFold(r.URL.Query().Get("follow"), "true"),
+	}
+
+	if tailLines := r.URL.Query().Get("tailLines"); tailLines != "" {
+		i, err := strconv.ParseInt(tailLines, 10, 64)
+		if err != nil || i < 0 {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "", "The provided tailLines '%s' is invalid.", tailLines)
+		}
+		opts.TailLines = &i
+	}
+
+	resourceID := strings.TrimPrefix(r.URL.Path, "/admin")
+
+	doc, err := f.dbOpenShiftClusters.Get(ctx, resourceID)
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		return api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeResourceNotFound, "", "The Resource '%s/%s' under resource group '%s' was not found.", vars["resourceType"], vars["resourceName"], vars["resourceGroupName"])
+	case err != nil:
+		return err
+	}
+
+	subscriptionDoc, err := f.getSubscriptionDocument(ctx, doc.Key)
+	if err != nil {
+		return err
+	}
+
+	a, err := f.adminActionsFactory(log, f.env, doc.OpenShiftCluster, subscriptionDoc)
+	if err != nil {
+		return err
+	}
+
+	// the access log records who made the request; record what they read
+	log.WithFields(logrus.Fields{
+		"namespace": namespace,
+		"pod":       podName,
+		"container": containerName,
+		"follow":    opts.Follow,
+	}).Info("streaming pod logs")
+
+	return a.K8sPodLogs(ctx, w, namespace, podName, opts)
+}
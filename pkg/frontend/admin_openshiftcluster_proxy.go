@@ -0,0 +1,91 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+// proxyParameters are the query parameters consumed by the proxy endpoint;
+// any others are passed on to the proxied service
+var proxyParameters = []string{"namespace", "service", "pod", "port", "path"}
+
+func (f *frontend) getAdminOpenShiftClusterProxy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	r.URL.Path = filepath.Dir(r.URL.Path)
+
+	err := f._getAdminOpenShiftClusterProxy(ctx, w, r, log)
+
+	adminReply(log, w, nil, nil, err)
+}
+
+func (f *frontend) _getAdminOpenShiftClusterProxy(ctx context.Context, w http.ResponseWriter, r *http.Request, log *logrus.Entry) error {
+	vars := mux.Vars(r)
+
+	query := r.URL.Query()
+	namespace, port, path := query.Get("namespace"), query.Get("port"), query.Get("path")
+	if path == "" {
+		path = "/"
+	}
+
+	var resource, name string
+	switch {
+	case query.Get("service") != "" && query.Get("pod") == "":
+		resource, name = "services", query.Get("service")
+	case query.Get("pod") != "" && query.Get("service") == "":
+		resource, name = "pods", query.Get("pod")
+	}
+
+	err := validateAdminProxy(namespace, resource, name, port, path)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range proxyParameters {
+		query.Del(p)
+	}
+
+	resourceID := strings.TrimPrefix(r.URL.Path, "/admin")
+
+	doc, err := f.dbOpenShiftClusters.Get(ctx, resourceID)
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		return api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeResourceNotFound, "", "The Resource '%s/%s' under resource group '%s' was not found.", vars["resourceType"], vars["resourceName"], vars["resourceGroupName"])
+	case err != nil:
+		return err
+	}
+
+	subscriptionDoc, err := f.getSubscriptionDocument(ctx, doc.Key)
+	if err != nil {
+		return err
+	}
+
+	a, err := f.adminActionsFactory(log, f.env, doc.OpenShiftCluster, subscriptionDoc)
+	if err != nil {
+		return err
+	}
+
+	// the access log records who made the request; record what they reached
+	log.WithFields(logrus.Fields{
+		"namespace": namespace,
+		"resource":  resource,
+		"name":      name,
+		"port":      port,
+		"path":      path,
+		"query":     query.Encode(),
+	}).Info("proxying request")
+
+	return a.K8sProxy(ctx, w, resource, namespace, name, port, path, query)
+}
@@ -0,0 +1,169 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/frontend/adminactions"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	mock_adminactions "github.com/Azure/ARO-RP/pkg/util/mocks/adminactions"
+)
+
+func TestAdminProxy(t *testing.T) {
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	mockTenantID := "00000000-0000-0000-0000-000000000000"
+	ctx := context.Background()
+
+	type test struct {
+		name           string
+		resourceID     string
+		query          string
+		mocks          func(*test, *mock_adminactions.MockInterface)
+		wantStatusCode int
+		wantResponse   []byte
+		wantError      string
+	}
+
+	for _, tt := range []*test{
+		{
+			name:       "service proxied",
+			resourceID: fmt.Sprintf("/subscriptions/%s/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName", mockSubID),
+			query:      "namespace=openshift-monitoring&service=prometheus-k8s&port=web&path=/api/v1/query&query=up",
+			mocks: func(tt *test, a *mock_adminactions.MockInterface) {
+				a.EXPECT().
+					K8sProxy(gomock.Any(), gomock.Any(), "services", "openshift-monitoring", "prometheus-k8s", "web", "/api/v1/query", url.Values{"query": []string{"up"}}).
+					DoAndReturn(func(ctx context.Context, w http.ResponseWriter, resource, namespace, name, port, path string, query url.Values) error {
+						_, err := w.Write([]byte("result\n"))
+						return err
+					})
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse:   []byte("result\n"),
+		},
+		{
+			name:       "pod proxied at the root path",
+			resourceID: fmt.Sprintf("/subscriptions/%s/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName", mockSubID),
+			query:      "namespace=openshift-etcd&pod=etcd-master-0&port=9979",
+			mocks: func(tt *test, a *mock_adminactions.MockInterface) {
+				a.EXPECT().
+					K8sProxy(gomock.Any(), gomock.Any(), "pods", "openshift-etcd", "etcd-master-0", "9979", "/", url.Values{}).
+					Return(nil)
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "customer namespace forbidden",
+			resourceID:     fmt.Sprintf("/subscriptions/%s/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName", mockSubID),
+			query:          "namespace=customer&service=app&port=80",
+			mocks:          func(tt *test, a *mock_adminactions.MockInterface) {},
+			wantStatusCode: http.StatusForbidden,
+			wantError:      "403: Forbidden: : Access to the provided namespace 'customer' is forbidden.",
+		},
+		{
+			name:           "both service and pod provided",
+			resourceID:     fmt.Sprintf("/subscriptions/%s/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName", mockSubID),
+			query:          "namespace=openshift-monitoring&service=prometheus-k8s&pod=prometheus-k8s-0&port=web",
+			mocks:          func(tt *test, a *mock_adminactions.MockInterface) {},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: : Exactly one of service and pod must be provided.",
+		},
+		{
+			name:           "no port provided",
+			resourceID:     fmt.Sprintf("/subscriptions/%s/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName", mockSubID),
+			query:          "namespace=openshift-monitoring&service=prometheus-k8s",
+			mocks:          func(tt *test, a *mock_adminactions.MockInterface) {},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: : The provided port '' is invalid.",
+		},
+		{
+			name:           "path escapes the proxy subresource",
+			resourceID:     fmt.Sprintf("/subscriptions/%s/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName", mockSubID),
+			query:          "namespace=openshift-monitoring&service=prometheus-k8s&port=web&path=/../../secrets",
+			mocks:          func(tt *test, a *mock_adminactions.MockInterface) {},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: : The provided path '/../../secrets' is invalid.",
+		},
+		{
+			name:           "relative path",
+			resourceID:     fmt.Sprintf("/subscriptions/%s/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName", mockSubID),
+			query:          "namespace=openshift-monitoring&service=prometheus-k8s&port=web&path=graph",
+			mocks:          func(tt *test, a *mock_adminactions.MockInterface) {},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: : The provided path 'graph' is invalid.",
+		},
+		{
+			name:           "cluster not found",
+			resourceID:     fmt.Sprintf("/subscriptions/%s/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/otherName", mockSubID),
+			query:          "namespace=openshift-monitoring&service=prometheus-k8s&port=web",
+			mocks:          func(tt *test, a *mock_adminactions.MockInterface) {},
+			wantStatusCode: http.StatusNotFound,
+			wantError:      "404: ResourceNotFound: : The Resource 'openshiftclusters/othername' under resource group 'resourcegroup' was not found.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithOpenShiftClusters().WithSubscriptions()
+			defer ti.done()
+
+			a := mock_adminactions.NewMockInterface(ti.controller)
+			tt.mocks(tt, a)
+
+			resourceID := fmt.Sprintf("/subscriptions/%s/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName", mockSubID)
+			ti.fixture.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				Key: strings.ToLower(resourceID),
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID:   resourceID,
+					Name: "resourceName",
+					Type: "Microsoft.RedHatOpenShift/openshiftClusters",
+				},
+			})
+			ti.fixture.AddSubscriptionDocuments(&api.SubscriptionDocument{
+				ID: mockSubID,
+				Subscription: &api.Subscription{
+					State: api.SubscriptionStateRegistered,
+					Properties: &api.SubscriptionProperties{
+						TenantID: mockTenantID,
+					},
+				},
+			})
+
+			err := ti.buildFixtures(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, api.APIs, &noop.Noop{}, nil, func(*logrus.Entry, env.Interface, *api.OpenShiftCluster,
+				*api.SubscriptionDocument) (adminactions.Interface, error) {
+				return a, nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodGet,
+				fmt.Sprintf("https://server/admin%s/proxy?%s", tt.resourceID, tt.query),
+				nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, tt.wantResponse)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/frontend/adminactions"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+func (f *frontend) getAdminOpenShiftClusterRebootNodes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	r.URL.Path = filepath.Dir(r.URL.Path)
+
+	b, err := f._getAdminOpenShiftClusterRebootNodes(ctx, r, log)
+
+	adminReply(log, w, nil, b, err)
+}
+
+// _getAdminOpenShiftClusterRebootNodes returns the progress of each node in
+// the most recently requested rolling reboot
+func (f *frontend) _getAdminOpenShiftClusterRebootNodes(ctx context.Context, r *http.Request, log *logrus.Entry) ([]byte, error) {
+	a, err := f.rebootNodesAdminActions(ctx, r, log)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := a.RebootStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if status == nil {
+		return nil, api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeNotFound, "", "No reboot has been started on the cluster.")
+	}
+
+	return json.MarshalIndent(status, "", "    ")
+}
+
+func (f *frontend) postAdminOpenShiftClusterRebootNodes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	r.URL.Path = filepath.Dir(r.URL.Path)
+
+	b, err := f._postAdminOpenShiftClusterRebootNodes(ctx, r, log)
+
+	adminReply(log, w, nil, b, err)
+}
+
+// _postAdminOpenShiftClusterRebootNodes asks the operator to reboot all the
+// nodes of the cluster, one at a time, e.g. to pick up a kernel fix without
+// an upgrade.  It returns the request, whose progress is then returned by
+// the GET method.
+func (f *frontend) _postAdminOpenShiftClusterRebootNodes(ctx context.Context, r *http.Request, log *logrus.Entry) ([]byte, error) {
+	a, err := f.rebootNodesAdminActions(ctx, r, log)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := a.RebootNodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("requested reboot %s", req.ID)
+
+	return json.MarshalIndent(req, "", "    ")
+}
+
+func (f *frontend) rebootNodesAdminActions(ctx context.Context, r *http.Request, log *logrus.Entry) (adminactions.Interface, error) {
+	vars := mux.Vars(r)
+
+	resourceID := strings.TrimPrefix(r.URL.Path, "/admin")
+
+	doc, err := f.dbOpenShiftClusters.Get(ctx, resourceID)
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		return nil, api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeResourceNotFound, "", "The Resource '%s/%s' under resource group '%s' was not found.", vars["resourceType"], vars["resourceName"], vars["resourceGroupName"])
+	case err != nil:
+		return nil, err
+	}
+
+	subscriptionDoc, err := f.getSubscriptionDocument(ctx, doc.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.adminActionsFactory(log, f.env, doc.OpenShiftCluster, subscriptionDoc)
+}
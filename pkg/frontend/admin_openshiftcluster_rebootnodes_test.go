@@ -0,0 +1,192 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/frontend/adminactions"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	"github.com/Azure/ARO-RP/pkg/operator"
+	mock_adminactions "github.com/Azure/ARO-RP/pkg/util/mocks/adminactions"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestAdminRebootNodes(t *testing.T) {
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	mockTenantID := "00000000-0000-0000-0000-000000000000"
+
+	ctx := context.Background()
+
+	req := &operator.RebootRequest{
+		ID: "request",
+	}
+
+	status := &operator.RebootStatus{
+		ID: "request",
+		Nodes: []operator.NodeRebootStatus{
+			{
+				Name:  "worker-a",
+				State: operator.NodeRebootStateDraining,
+			},
+			{
+				Name:  "master-0",
+				State: operator.NodeRebootStatePending,
+			},
+		},
+	}
+
+	type test struct {
+		name           string
+		method         string
+		resourceID     string
+		idempotencyKey string
+		fixture        func(*testdatabase.Fixture)
+		mocks          func(*test, *mock_adminactions.MockInterface)
+		wantStatusCode int
+		wantResponse   interface{}
+		wantError      string
+	}
+
+	addDocuments := func(f *testdatabase.Fixture) {
+		f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+			Key: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "resourceName")),
+			OpenShiftCluster: &api.OpenShiftCluster{
+				ID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			},
+		})
+
+		f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+			ID: mockSubID,
+			Subscription: &api.Subscription{
+				State: api.SubscriptionStateRegistered,
+				Properties: &api.SubscriptionProperties{
+					TenantID: mockTenantID,
+				},
+			},
+		})
+	}
+
+	for _, tt := range []*test{
+		{
+			name:       "reboot requested",
+			method:     http.MethodPost,
+			resourceID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			fixture:    addDocuments,
+			mocks: func(tt *test, a *mock_adminactions.MockInterface) {
+				a.EXPECT().RebootNodes(gomock.Any()).Return(req, nil)
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse:   req,
+		},
+		{
+			name:           "retried reboot request is replayed",
+			method:         http.MethodPost,
+			resourceID:     testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			idempotencyKey: "key",
+			fixture:        addDocuments,
+			mocks: func(tt *test, a *mock_adminactions.MockInterface) {
+				a.EXPECT().RebootNodes(gomock.Any()).Return(req, nil)
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse:   req,
+		},
+		{
+			name:       "reboot already in progress",
+			method:     http.MethodPost,
+			resourceID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			fixture:    addDocuments,
+			mocks: func(tt *test, a *mock_adminactions.MockInterface) {
+				a.EXPECT().RebootNodes(gomock.Any()).Return(nil, api.NewCloudError(http.StatusConflict, api.CloudErrorCodeRequestNotAllowed, "", "Reboot 'previous' is in progress."))
+			},
+			wantStatusCode: http.StatusConflict,
+			wantError:      "409: RequestNotAllowed: : Reboot 'previous' is in progress.",
+		},
+		{
+			name:       "reboot status",
+			method:     http.MethodGet,
+			resourceID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			fixture:    addDocuments,
+			mocks: func(tt *test, a *mock_adminactions.MockInterface) {
+				a.EXPECT().RebootStatus(gomock.Any()).Return(status, nil)
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse:   status,
+		},
+		{
+			name:       "reboot not started",
+			method:     http.MethodGet,
+			resourceID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			fixture:    addDocuments,
+			mocks: func(tt *test, a *mock_adminactions.MockInterface) {
+				a.EXPECT().RebootStatus(gomock.Any()).Return(nil, nil)
+			},
+			wantStatusCode: http.StatusNotFound,
+			wantError:      "404: NotFound: : No reboot has been started on the cluster.",
+		},
+		{
+			name:           "cluster not found",
+			method:         http.MethodPost,
+			resourceID:     testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			fixture:        func(f *testdatabase.Fixture) {},
+			mocks:          func(tt *test, a *mock_adminactions.MockInterface) {},
+			wantStatusCode: http.StatusNotFound,
+			wantError:      "404: ResourceNotFound: : The Resource 'openshiftclusters/resourcename' under resource group 'resourcegroup' was not found.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithOpenShiftClusters().WithSubscriptions()
+			defer ti.done()
+
+			a := mock_adminactions.NewMockInterface(ti.controller)
+			tt.mocks(tt, a)
+
+			err := ti.buildFixtures(tt.fixture)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, api.APIs, &noop.Noop{}, nil, func(*logrus.Entry, env.Interface, *api.OpenShiftCluster,
+				*api.SubscriptionDocument) (adminactions.Interface, error) {
+				return a, nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			header := http.Header{}
+			requests := 1
+			if tt.idempotencyKey != "" {
+				// a retry with the same key must not start a second reboot
+				header.Set(idempotencyKeyHeader, tt.idempotencyKey)
+				requests = 2
+			}
+
+			for i := 0; i < requests; i++ {
+				resp, b, err := ti.request(tt.method,
+					fmt.Sprintf("https://server/admin%s/rebootnodes", tt.resourceID),
+					header, nil)
+				if err != nil {
+					t.Error(err)
+				}
+
+				err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, tt.wantResponse)
+				if err != nil {
+					t.Error(i, err)
+				}
+			}
+		})
+	}
+}
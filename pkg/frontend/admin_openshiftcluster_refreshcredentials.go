@@ -0,0 +1,65 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+func (f *frontend) postAdminOpenShiftClusterRefreshCredentials(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	vars := mux.Vars(r)
+	r.URL.Path = filepath.Dir(r.URL.Path)
+
+	resourceID := strings.TrimPrefix(r.URL.Path, "/admin")
+
+	var header http.Header
+	_, err := f.dbOpenShiftClusters.Patch(ctx, resourceID, func(doc *api.OpenShiftClusterDocument) error {
+		return f._postAdminOpenShiftClusterRefreshCredentials(ctx, r, &header, doc, log)
+	})
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		err = api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeResourceNotFound, "", "The Resource '%s/%s' under resource group '%s' was not found.", vars["resourceType"], vars["resourceName"], vars["resourceGroupName"])
+	case err == nil:
+		err = statusCodeError(http.StatusAccepted)
+	}
+
+	adminReply(log, w, header, nil, err)
+}
+
+// _postAdminOpenShiftClusterRefreshCredentials asks the backend to replace
+// the kubeconfig with which the RP (the frontend admin actions, the backend
+// and the monitor) accesses the cluster, e.g. when access fails with
+// credential errors during an incident.  The backend signs a new client
+// certificate, checks that the API server accepts it and only then saves it;
+// progress can be followed through the returned async operation.
+func (f *frontend) _postAdminOpenShiftClusterRefreshCredentials(ctx context.Context, r *http.Request, header *http.Header, doc *api.OpenShiftClusterDocument, log *logrus.Entry) error {
+	correlationData := r.Context().Value(middleware.ContextKeyCorrelationData).(*api.CorrelationData)
+
+	_, err := f.validateSubscriptionState(ctx, doc.Key, api.SubscriptionStateRegistered, api.SubscriptionStateWarned)
+	if err != nil {
+		return err
+	}
+
+	err = validateWorkerProfileProvisioningState(doc)
+	if err != nil {
+		return err
+	}
+
+	log.Print("refreshing the ARO service kubeconfig")
+	doc.OpenShiftCluster.Properties.RefreshAROServiceKubeconfig = true
+
+	return f.startWorkerProfileUpdate(ctx, r, header, doc, correlationData)
+}
@@ -0,0 +1,137 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestAdminRefreshCredentials(t *testing.T) {
+	ctx := context.Background()
+
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	resourceID := testdatabase.GetResourcePath(mockSubID, "resourceName")
+
+	fixture := func(provisioningState api.ProvisioningState) func(*testdatabase.Fixture) {
+		return func(f *testdatabase.Fixture) {
+			f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				Key: strings.ToLower(resourceID),
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID:   resourceID,
+					Name: "resourceName",
+					Type: "Microsoft.RedHatOpenShift/openShiftClusters",
+					Properties: api.OpenShiftClusterProperties{
+						ProvisioningState:    provisioningState,
+						AROServiceKubeconfig: api.SecureBytes("old"),
+					},
+				},
+			})
+			f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+				ID: mockSubID,
+				Subscription: &api.Subscription{
+					State: api.SubscriptionStateRegistered,
+					Properties: &api.SubscriptionProperties{
+						TenantID: "11111111-1111-1111-1111-111111111111",
+					},
+				},
+			})
+		}
+	}
+
+	type test struct {
+		name           string
+		fixture        func(*testdatabase.Fixture)
+		wantStatusCode int
+		wantError      string
+	}
+
+	for _, tt := range []*test{
+		{
+			name:           "refresh is started",
+			fixture:        fixture(api.ProvisioningStateSucceeded),
+			wantStatusCode: http.StatusAccepted,
+		},
+		{
+			name:           "cluster is updating",
+			fixture:        fixture(api.ProvisioningStateUpdating),
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `400: RequestNotAllowed: : Request is not allowed in provisioningState 'Updating'.`,
+		},
+		{
+			name: "cluster not found in db",
+			fixture: func(f *testdatabase.Fixture) {
+			},
+			wantStatusCode: http.StatusNotFound,
+			wantError:      `404: ResourceNotFound: : The Resource 'openshiftclusters/resourcename' under resource group 'resourcegroup' was not found.`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).
+				WithOpenShiftClusters().
+				WithAsyncOperations().
+				WithSubscriptions()
+			defer ti.done()
+
+			err := ti.buildFixtures(tt.fixture)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, api.APIs, &noop.Noop{}, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodPost,
+				fmt.Sprintf("https://server/admin%s/refreshcredentials", resourceID),
+				nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, nil)
+			if err != nil {
+				t.Error(err)
+			}
+
+			if tt.wantStatusCode != http.StatusAccepted {
+				return
+			}
+
+			location := resp.Header.Get("Location")
+			if !strings.HasPrefix(location, fmt.Sprintf("/subscriptions/%s/providers/microsoft.redhatopenshift/locations/%s/operationresults/", mockSubID, ti.env.Location())) {
+				t.Error(location)
+			}
+
+			doc, err := ti.openShiftClustersDatabase.Get(ctx, strings.ToLower(resourceID))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if doc.OpenShiftCluster.Properties.ProvisioningState != api.ProvisioningStateUpdating {
+				t.Error(doc.OpenShiftCluster.Properties.ProvisioningState)
+			}
+
+			if !doc.OpenShiftCluster.Properties.RefreshAROServiceKubeconfig {
+				t.Error("refresh not requested")
+			}
+
+			// the old kubeconfig stays until the backend has verified the new one
+			if !bytes.Equal(doc.OpenShiftCluster.Properties.AROServiceKubeconfig, []byte("old")) {
+				t.Error(string(doc.OpenShiftCluster.Properties.AROServiceKubeconfig))
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/gorilla/mux"
+	machinev1beta1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
+	"github.com/sirupsen/logrus"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+func (f *frontend) postAdminOpenShiftClusterReimageMachine(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	vars := mux.Vars(r)
+	r.URL.Path = filepath.Dir(r.URL.Path)
+
+	resourceID := strings.TrimPrefix(r.URL.Path, "/admin")
+
+	var header http.Header
+	_, err := f.dbOpenShiftClusters.Patch(ctx, resourceID, func(doc *api.OpenShiftClusterDocument) error {
+		return f._postAdminOpenShiftClusterReimageMachine(ctx, r, &header, doc, log)
+	})
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		err = api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeResourceNotFound, "", "The Resource '%s/%s' under resource group '%s' was not found.", vars["resourceType"], vars["resourceName"], vars["resourceGroupName"])
+	case err == nil:
+		err = statusCodeError(http.StatusAccepted)
+	}
+
+	adminReply(log, w, header, nil, err)
+}
+
+// _postAdminOpenShiftClusterReimageMachine replaces a worker machine which
+// never joined the cluster.  The backend regenerates the stub ignition config
+// of the worker machines, deletes the machine so that its machineset creates
+// it afresh and waits for the replacement to join; progress can be followed
+// through the returned async operation.
+func (f *frontend) _postAdminOpenShiftClusterReimageMachine(ctx context.Context, r *http.Request, header *http.Header, doc *api.OpenShiftClusterDocument, log *logrus.Entry) error {
+	correlationData := r.Context().Value(middleware.ContextKeyCorrelationData).(*api.CorrelationData)
+
+	machineName := r.URL.Query().Get("machineName")
+	err := validateAdminMachineName(machineName)
+	if err != nil {
+		return err
+	}
+
+	subscriptionDoc, err := f.validateSubscriptionState(ctx, doc.Key, api.SubscriptionStateRegistered, api.SubscriptionStateWarned)
+	if err != nil {
+		return err
+	}
+
+	err = validateWorkerProfileProvisioningState(doc)
+	if err != nil {
+		return err
+	}
+
+	a, err := f.adminActionsFactory(log, f.env, doc.OpenShiftCluster, subscriptionDoc)
+	if err != nil {
+		return err
+	}
+
+	b, err := a.K8sGet(ctx, "Machine.machine.openshift.io", "openshift-machine-api", machineName)
+	if kerrors.IsNotFound(err) {
+		return api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeNotFound, "", "The machine '%s' was not found.", machineName)
+	}
+	if err != nil {
+		return err
+	}
+
+	var machine *machinev1beta1.Machine
+	err = json.Unmarshal(b, &machine)
+	if err != nil {
+		return err
+	}
+
+	// masters are not in a machineset: nothing would replace them
+	if machine.Labels["machine.openshift.io/cluster-api-machineset"] == "" {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "", "The machine '%s' does not belong to a machineset.", machineName)
+	}
+
+	if machine.Status.NodeRef != nil {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "", "The machine '%s' has joined the cluster as node '%s'.", machineName, machine.Status.NodeRef.Name)
+	}
+
+	// machines left over from a reimage which failed are retried along with
+	// this one
+	var found bool
+	for _, name := range doc.OpenShiftCluster.Properties.ReimageMachines {
+		if name == machineName {
+			found = true
+		}
+	}
+	if !found {
+		doc.OpenShiftCluster.Properties.ReimageMachines = append(doc.OpenShiftCluster.Properties.ReimageMachines, machineName)
+	}
+
+	log.Printf("reimaging machine %s", machineName)
+
+	return f.startWorkerProfileUpdate(ctx, r, header, doc, correlationData)
+}
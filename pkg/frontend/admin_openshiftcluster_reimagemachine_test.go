@@ -0,0 +1,193 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/frontend/adminactions"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	mock_adminactions "github.com/Azure/ARO-RP/pkg/util/mocks/adminactions"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestAdminReimageMachine(t *testing.T) {
+	ctx := context.Background()
+
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	resourceID := testdatabase.GetResourcePath(mockSubID, "resourceName")
+
+	fixture := func(provisioningState api.ProvisioningState) func(*testdatabase.Fixture) {
+		return func(f *testdatabase.Fixture) {
+			f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				Key: strings.ToLower(resourceID),
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID:   resourceID,
+					Name: "resourceName",
+					Type: "Microsoft.RedHatOpenShift/openShiftClusters",
+					Properties: api.OpenShiftClusterProperties{
+						ProvisioningState: provisioningState,
+					},
+				},
+			})
+			f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+				ID: mockSubID,
+				Subscription: &api.Subscription{
+					State: api.SubscriptionStateRegistered,
+					Properties: &api.SubscriptionProperties{
+						TenantID: "11111111-1111-1111-1111-111111111111",
+					},
+				},
+			})
+		}
+	}
+
+	machine := func(machineset, nodeName string) []byte {
+		status := `{}`
+		if nodeName != "" {
+			status = `{"nodeRef": {"name": "` + nodeName + `"}}`
+		}
+
+		labels := `{}`
+		if machineset != "" {
+			labels = `{"machine.openshift.io/cluster-api-machineset": "` + machineset + `"}`
+		}
+
+		return []byte(`{"apiVersion": "machine.openshift.io/v1beta1", "kind": "Machine", "metadata": {"name": "stuck", "labels": ` + labels + `}, "status": ` + status + `}`)
+	}
+
+	type test struct {
+		name           string
+		machineName    string
+		fixture        func(*testdatabase.Fixture)
+		mocks          func(*mock_adminactions.MockInterface)
+		wantStatusCode int
+		wantError      string
+	}
+
+	for _, tt := range []*test{
+		{
+			name:        "reimage is started",
+			machineName: "stuck",
+			fixture:     fixture(api.ProvisioningStateSucceeded),
+			mocks: func(a *mock_adminactions.MockInterface) {
+				a.EXPECT().K8sGet(gomock.Any(), "Machine.machine.openshift.io", "openshift-machine-api", "stuck").Return(machine("infra-worker-eastus1", ""), nil)
+			},
+			wantStatusCode: http.StatusAccepted,
+		},
+		{
+			name:           "invalid machine name",
+			machineName:    "",
+			fixture:        fixture(api.ProvisioningStateSucceeded),
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `400: InvalidParameter: : The provided machineName '' is invalid.`,
+		},
+		{
+			name:           "cluster is updating",
+			machineName:    "stuck",
+			fixture:        fixture(api.ProvisioningStateUpdating),
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `400: RequestNotAllowed: : Request is not allowed in provisioningState 'Updating'.`,
+		},
+		{
+			name:        "machine not found",
+			machineName: "stuck",
+			fixture:     fixture(api.ProvisioningStateSucceeded),
+			mocks: func(a *mock_adminactions.MockInterface) {
+				a.EXPECT().K8sGet(gomock.Any(), "Machine.machine.openshift.io", "openshift-machine-api", "stuck").Return(nil, kerrors.NewNotFound(schema.GroupResource{Group: "machine.openshift.io", Resource: "machines"}, "stuck"))
+			},
+			wantStatusCode: http.StatusNotFound,
+			wantError:      `404: NotFound: : The machine 'stuck' was not found.`,
+		},
+		{
+			name:        "machine is not in a machineset",
+			machineName: "stuck",
+			fixture:     fixture(api.ProvisioningStateSucceeded),
+			mocks: func(a *mock_adminactions.MockInterface) {
+				a.EXPECT().K8sGet(gomock.Any(), "Machine.machine.openshift.io", "openshift-machine-api", "stuck").Return(machine("", ""), nil)
+			},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `400: InvalidParameter: : The machine 'stuck' does not belong to a machineset.`,
+		},
+		{
+			name:        "machine has joined",
+			machineName: "stuck",
+			fixture:     fixture(api.ProvisioningStateSucceeded),
+			mocks: func(a *mock_adminactions.MockInterface) {
+				a.EXPECT().K8sGet(gomock.Any(), "Machine.machine.openshift.io", "openshift-machine-api", "stuck").Return(machine("infra-worker-eastus1", "node"), nil)
+			},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `400: InvalidParameter: : The machine 'stuck' has joined the cluster as node 'node'.`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).
+				WithOpenShiftClusters().
+				WithAsyncOperations().
+				WithSubscriptions()
+			defer ti.done()
+
+			a := mock_adminactions.NewMockInterface(ti.controller)
+			if tt.mocks != nil {
+				tt.mocks(a)
+			}
+
+			err := ti.buildFixtures(tt.fixture)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, api.APIs, &noop.Noop{}, nil, func(*logrus.Entry, env.Interface, *api.OpenShiftCluster,
+				*api.SubscriptionDocument) (adminactions.Interface, error) {
+				return a, nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodPost,
+				fmt.Sprintf("https://server/admin%s/reimagemachine?machineName=%s", resourceID, tt.machineName),
+				nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, nil)
+			if err != nil {
+				t.Error(err)
+			}
+
+			if tt.wantStatusCode != http.StatusAccepted {
+				return
+			}
+
+			doc, err := ti.openShiftClustersDatabase.Get(ctx, strings.ToLower(resourceID))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if doc.OpenShiftCluster.Properties.ProvisioningState != api.ProvisioningStateUpdating {
+				t.Error(doc.OpenShiftCluster.Properties.ProvisioningState)
+			}
+
+			if !reflect.DeepEqual(doc.OpenShiftCluster.Properties.ReimageMachines, []string{tt.machineName}) {
+				t.Error(doc.OpenShiftCluster.Properties.ReimageMachines)
+			}
+		})
+	}
+}
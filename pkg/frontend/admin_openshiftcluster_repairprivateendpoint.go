@@ -0,0 +1,79 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+func (f *frontend) postAdminOpenShiftClusterRepairPrivateEndpoint(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	r.URL.Path = filepath.Dir(r.URL.Path)
+
+	err := f._postAdminOpenShiftClusterRepairPrivateEndpoint(ctx, r, log)
+
+	adminReply(log, w, nil, nil, err)
+}
+
+// _postAdminOpenShiftClusterRepairPrivateEndpoint repairs the connection
+// through which the RP reaches the cluster API server, approving it if it is
+// pending and recreating the private endpoint if it is missing, rejected or
+// disconnected.
+func (f *frontend) _postAdminOpenShiftClusterRepairPrivateEndpoint(ctx context.Context, r *http.Request, log *logrus.Entry) error {
+	vars := mux.Vars(r)
+
+	resourceID := strings.TrimPrefix(r.URL.Path, "/admin")
+
+	doc, err := f.dbOpenShiftClusters.Get(ctx, resourceID)
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		return api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeResourceNotFound, "", "The Resource '%s/%s' under resource group '%s' was not found.", vars["resourceType"], vars["resourceName"], vars["resourceGroupName"])
+	case err != nil:
+		return err
+	}
+
+	// the backend must not be working on the cluster while its private
+	// endpoint may be recreated
+	if !doc.OpenShiftCluster.Properties.ProvisioningState.IsTerminal() {
+		return api.NewCloudError(http.StatusConflict, api.CloudErrorCodeRequestNotAllowed, "", "Request is not allowed in provisioningState '%s'.", doc.OpenShiftCluster.Properties.ProvisioningState)
+	}
+
+	subscriptionDoc, err := f.getSubscriptionDocument(ctx, doc.Key)
+	if err != nil {
+		return err
+	}
+
+	a, err := f.adminActionsFactory(log, f.env, doc.OpenShiftCluster, subscriptionDoc)
+	if err != nil {
+		return err
+	}
+
+	privateEndpointIP, err := a.PrivateEndpointRepair(ctx, doc)
+	if err != nil {
+		return err
+	}
+
+	if privateEndpointIP == doc.OpenShiftCluster.Properties.NetworkProfile.PrivateEndpointIP {
+		return nil
+	}
+
+	log.Printf("private endpoint IP changed from %s to %s", doc.OpenShiftCluster.Properties.NetworkProfile.PrivateEndpointIP, privateEndpointIP)
+
+	_, err = f.dbOpenShiftClusters.Patch(ctx, doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+		doc.OpenShiftCluster.Properties.NetworkProfile.PrivateEndpointIP = privateEndpointIP
+		return nil
+	})
+	return err
+}
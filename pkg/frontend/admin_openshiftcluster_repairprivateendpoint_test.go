@@ -0,0 +1,177 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/frontend/adminactions"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	mock_adminactions "github.com/Azure/ARO-RP/pkg/util/mocks/adminactions"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestAdminRepairPrivateEndpoint(t *testing.T) {
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	mockTenantID := "00000000-0000-0000-0000-000000000000"
+
+	ctx := context.Background()
+
+	type test struct {
+		name                  string
+		resourceID            string
+		fixture               func(*testdatabase.Fixture)
+		mocks                 func(*test, *mock_adminactions.MockInterface)
+		wantStatusCode        int
+		wantResponse          []byte
+		wantError             string
+		wantPrivateEndpointIP string
+	}
+
+	addDocuments := func(f *testdatabase.Fixture, state api.ProvisioningState) {
+		f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+			Key: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "resourceName")),
+			OpenShiftCluster: &api.OpenShiftCluster{
+				ID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+				Properties: api.OpenShiftClusterProperties{
+					ProvisioningState: state,
+					ClusterProfile: api.ClusterProfile{
+						ResourceGroupID: fmt.Sprintf("/subscriptions/%s/resourceGroups/test-cluster", mockSubID),
+					},
+					NetworkProfile: api.NetworkProfile{
+						PrivateEndpointIP: "10.0.0.1",
+					},
+				},
+			},
+		})
+
+		f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+			ID: mockSubID,
+			Subscription: &api.Subscription{
+				State: api.SubscriptionStateRegistered,
+				Properties: &api.SubscriptionProperties{
+					TenantID: mockTenantID,
+				},
+			},
+		})
+	}
+
+	for _, tt := range []*test{
+		{
+			name:       "connection intact",
+			resourceID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			fixture: func(f *testdatabase.Fixture) {
+				addDocuments(f, api.ProvisioningStateSucceeded)
+			},
+			mocks: func(tt *test, a *mock_adminactions.MockInterface) {
+				a.EXPECT().PrivateEndpointRepair(gomock.Any(), gomock.Any()).Return("10.0.0.1", nil)
+			},
+			wantStatusCode:        http.StatusOK,
+			wantPrivateEndpointIP: "10.0.0.1",
+		},
+		{
+			name:       "private endpoint recreated",
+			resourceID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			fixture: func(f *testdatabase.Fixture) {
+				addDocuments(f, api.ProvisioningStateFailed)
+			},
+			mocks: func(tt *test, a *mock_adminactions.MockInterface) {
+				a.EXPECT().PrivateEndpointRepair(gomock.Any(), gomock.Any()).Return("10.0.0.2", nil)
+			},
+			wantStatusCode:        http.StatusOK,
+			wantPrivateEndpointIP: "10.0.0.2",
+		},
+		{
+			name:       "repair failed",
+			resourceID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			fixture: func(f *testdatabase.Fixture) {
+				addDocuments(f, api.ProvisioningStateSucceeded)
+			},
+			mocks: func(tt *test, a *mock_adminactions.MockInterface) {
+				a.EXPECT().PrivateEndpointRepair(gomock.Any(), gomock.Any()).Return("", fmt.Errorf("random error"))
+			},
+			wantStatusCode:        http.StatusInternalServerError,
+			wantError:             "500: InternalServerError: : Internal server error.",
+			wantPrivateEndpointIP: "10.0.0.1",
+		},
+		{
+			name:       "cluster busy",
+			resourceID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			fixture: func(f *testdatabase.Fixture) {
+				addDocuments(f, api.ProvisioningStateAdminUpdating)
+			},
+			mocks:                 func(tt *test, a *mock_adminactions.MockInterface) {},
+			wantStatusCode:        http.StatusConflict,
+			wantError:             "409: RequestNotAllowed: : Request is not allowed in provisioningState 'AdminUpdating'.",
+			wantPrivateEndpointIP: "10.0.0.1",
+		},
+		{
+			name:       "cluster not found",
+			resourceID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			fixture: func(f *testdatabase.Fixture) {
+			},
+			mocks:          func(tt *test, a *mock_adminactions.MockInterface) {},
+			wantStatusCode: http.StatusNotFound,
+			wantError:      "404: ResourceNotFound: : The Resource 'openshiftclusters/resourcename' under resource group 'resourcegroup' was not found.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithOpenShiftClusters().WithSubscriptions()
+			defer ti.done()
+
+			a := mock_adminactions.NewMockInterface(ti.controller)
+			tt.mocks(tt, a)
+
+			err := ti.buildFixtures(tt.fixture)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, api.APIs, &noop.Noop{}, nil, func(*logrus.Entry, env.Interface, *api.OpenShiftCluster,
+				*api.SubscriptionDocument) (adminactions.Interface, error) {
+				return a, nil
+			})
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodPost,
+				fmt.Sprintf("https://server/admin%s/repairprivateendpoint", tt.resourceID),
+				nil, nil)
+			if err != nil {
+				t.Error(err)
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, tt.wantResponse)
+			if err != nil {
+				t.Error(err)
+			}
+
+			if tt.wantPrivateEndpointIP == "" {
+				return
+			}
+
+			doc, err := ti.openShiftClustersDatabase.Get(ctx, strings.ToLower(tt.resourceID))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if doc.OpenShiftCluster.Properties.NetworkProfile.PrivateEndpointIP != tt.wantPrivateEndpointIP {
+				t.Error(doc.OpenShiftCluster.Properties.NetworkProfile.PrivateEndpointIP)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/validate"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+func (f *frontend) postAdminOpenShiftClusterResizeWorkerDisks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	vars := mux.Vars(r)
+	r.URL.Path = filepath.Dir(r.URL.Path)
+
+	resourceID := strings.TrimPrefix(r.URL.Path, "/admin")
+
+	var header http.Header
+	_, err := f.dbOpenShiftClusters.Patch(ctx, resourceID, func(doc *api.OpenShiftClusterDocument) error {
+		return f._postAdminOpenShiftClusterResizeWorkerDisks(ctx, r, &header, doc, log)
+	})
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		err = api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeResourceNotFound, "", "The Resource '%s/%s' under resource group '%s' was not found.", vars["resourceType"], vars["resourceName"], vars["resourceGroupName"])
+	case err == nil:
+		err = statusCodeError(http.StatusAccepted)
+	}
+
+	adminReply(log, w, header, nil, err)
+}
+
+// _postAdminOpenShiftClusterResizeWorkerDisks changes the OS disk size of a
+// worker profile.  The backend then updates the machinesets of the worker
+// profile and replaces its machines one at a time; progress can be followed
+// through the returned async operation.
+func (f *frontend) _postAdminOpenShiftClusterResizeWorkerDisks(ctx context.Context, r *http.Request, header *http.Header, doc *api.OpenShiftClusterDocument, log *logrus.Entry) error {
+	correlationData := r.Context().Value(middleware.ContextKeyCorrelationData).(*api.CorrelationData)
+
+	workerProfileName := r.URL.Query().Get("workerProfile")
+
+	diskSizeGB, err := strconv.Atoi(r.URL.Query().Get("diskSizeGB"))
+	if err != nil || !validate.InstallProfileFor(f.env).DiskSizeIsValid(diskSizeGB) {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "", "The provided diskSizeGB '%s' is invalid.", r.URL.Query().Get("diskSizeGB"))
+	}
+
+	_, err = f.validateSubscriptionState(ctx, doc.Key, api.SubscriptionStateRegistered, api.SubscriptionStateWarned)
+	if err != nil {
+		return err
+	}
+
+	err = validateWorkerProfileProvisioningState(doc)
+	if err != nil {
+		return err
+	}
+
+	var wp *api.WorkerProfile
+	for i := range doc.OpenShiftCluster.Properties.WorkerProfiles {
+		if strings.EqualFold(doc.OpenShiftCluster.Properties.WorkerProfiles[i].Name, workerProfileName) {
+			wp = &doc.OpenShiftCluster.Properties.WorkerProfiles[i]
+		}
+	}
+	for i := range doc.OpenShiftCluster.Properties.AdditionalWorkerProfiles {
+		if strings.EqualFold(doc.OpenShiftCluster.Properties.AdditionalWorkerProfiles[i].Name, workerProfileName) {
+			wp = &doc.OpenShiftCluster.Properties.AdditionalWorkerProfiles[i]
+		}
+	}
+
+	if wp == nil {
+		return api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeNotFound, "", "The worker profile '%s' was not found.", workerProfileName)
+	}
+
+	// Azure can grow managed disks but not shrink them
+	if diskSizeGB < wp.DiskSizeGB {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "", "The provided diskSizeGB '%d' is invalid: it is smaller than the current disk size of %dGB.", diskSizeGB, wp.DiskSizeGB)
+	}
+
+	log.Printf("resizing worker profile %s OS disks from %dGB to %dGB", wp.Name, wp.DiskSizeGB, diskSizeGB)
+	wp.DiskSizeGB = diskSizeGB
+
+	return f.startWorkerProfileUpdate(ctx, r, header, doc, correlationData)
+}
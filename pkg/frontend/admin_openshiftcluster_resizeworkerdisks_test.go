@@ -0,0 +1,228 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestAdminResizeWorkerDisks(t *testing.T) {
+	ctx := context.Background()
+
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	resourceID := testdatabase.GetResourcePath(mockSubID, "resourceName")
+
+	fixture := func(provisioningState api.ProvisioningState) func(*testdatabase.Fixture) {
+		return func(f *testdatabase.Fixture) {
+			f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				Key: strings.ToLower(resourceID),
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID:   resourceID,
+					Name: "resourceName",
+					Type: "Microsoft.RedHatOpenShift/openShiftClusters",
+					Properties: api.OpenShiftClusterProperties{
+						ProvisioningState: provisioningState,
+						WorkerProfiles: []api.WorkerProfile{
+							{
+								Name:       "worker",
+								DiskSizeGB: 128,
+							},
+						},
+						AdditionalWorkerProfiles: []api.WorkerProfile{
+							{
+								Name:       "gpu",
+								DiskSizeGB: 256,
+							},
+						},
+					},
+				},
+			})
+			f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+				ID: mockSubID,
+				Subscription: &api.Subscription{
+					State: api.SubscriptionStateRegistered,
+					Properties: &api.SubscriptionProperties{
+						TenantID: "11111111-1111-1111-1111-111111111111",
+					},
+				},
+			})
+		}
+	}
+
+	wantDocuments := func(workerDiskSizeGB, gpuDiskSizeGB int) func(*testdatabase.Checker) {
+		return func(c *testdatabase.Checker) {
+			c.AddAsyncOperationDocuments(&api.AsyncOperationDocument{
+				OpenShiftClusterKey: strings.ToLower(resourceID),
+				AsyncOperation: &api.AsyncOperation{
+					InitialProvisioningState: api.ProvisioningStateUpdating,
+					ProvisioningState:        api.ProvisioningStateUpdating,
+				},
+			})
+			c.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				Key: strings.ToLower(resourceID),
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID:   resourceID,
+					Name: "resourceName",
+					Type: "Microsoft.RedHatOpenShift/openShiftClusters",
+					Properties: api.OpenShiftClusterProperties{
+						ProvisioningState:     api.ProvisioningStateUpdating,
+						LastProvisioningState: api.ProvisioningStateSucceeded,
+						WorkerProfiles: []api.WorkerProfile{
+							{
+								Name:       "worker",
+								DiskSizeGB: workerDiskSizeGB,
+							},
+						},
+						AdditionalWorkerProfiles: []api.WorkerProfile{
+							{
+								Name:       "gpu",
+								DiskSizeGB: gpuDiskSizeGB,
+							},
+						},
+					},
+				},
+			})
+		}
+	}
+
+	type test struct {
+		name           string
+		workerProfile  string
+		diskSizeGB     string
+		fixture        func(*testdatabase.Fixture)
+		wantDocuments  func(*testdatabase.Checker)
+		wantStatusCode int
+		wantError      string
+	}
+
+	for _, tt := range []*test{
+		{
+			name:           "worker disks are resized",
+			workerProfile:  "worker",
+			diskSizeGB:     "512",
+			fixture:        fixture(api.ProvisioningStateSucceeded),
+			wantDocuments:  wantDocuments(512, 256),
+			wantStatusCode: http.StatusAccepted,
+		},
+		{
+			name:           "additional worker profile disks are resized",
+			workerProfile:  "gpu",
+			diskSizeGB:     "1024",
+			fixture:        fixture(api.ProvisioningStateSucceeded),
+			wantDocuments:  wantDocuments(128, 1024),
+			wantStatusCode: http.StatusAccepted,
+		},
+		{
+			name:           "disk size too small",
+			workerProfile:  "worker",
+			diskSizeGB:     "64",
+			fixture:        fixture(api.ProvisioningStateSucceeded),
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `400: InvalidParameter: : The provided diskSizeGB '64' is invalid.`,
+		},
+		{
+			name:           "disk size invalid",
+			workerProfile:  "worker",
+			diskSizeGB:     "big",
+			fixture:        fixture(api.ProvisioningStateSucceeded),
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `400: InvalidParameter: : The provided diskSizeGB 'big' is invalid.`,
+		},
+		{
+			name:           "disks cannot shrink",
+			workerProfile:  "gpu",
+			diskSizeGB:     "128",
+			fixture:        fixture(api.ProvisioningStateSucceeded),
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `400: InvalidParameter: : The provided diskSizeGB '128' is invalid: it is smaller than the current disk size of 256GB.`,
+		},
+		{
+			name:           "worker profile not found",
+			workerProfile:  "infra",
+			diskSizeGB:     "512",
+			fixture:        fixture(api.ProvisioningStateSucceeded),
+			wantStatusCode: http.StatusNotFound,
+			wantError:      `404: NotFound: : The worker profile 'infra' was not found.`,
+		},
+		{
+			name:           "cluster is updating",
+			workerProfile:  "worker",
+			diskSizeGB:     "512",
+			fixture:        fixture(api.ProvisioningStateUpdating),
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `400: RequestNotAllowed: : Request is not allowed in provisioningState 'Updating'.`,
+		},
+		{
+			name:          "cluster not found in db",
+			workerProfile: "worker",
+			diskSizeGB:    "512",
+			fixture: func(f *testdatabase.Fixture) {
+			},
+			wantStatusCode: http.StatusNotFound,
+			wantError:      `404: ResourceNotFound: : The Resource 'openshiftclusters/resourcename' under resource group 'resourcegroup' was not found.`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).
+				WithOpenShiftClusters().
+				WithAsyncOperations().
+				WithSubscriptions()
+			defer ti.done()
+
+			err := ti.buildFixtures(tt.fixture)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, api.APIs, &noop.Noop{}, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodPost,
+				fmt.Sprintf("https://server/admin%s/resizeworkerdisks?workerProfile=%s&diskSizeGB=%s", resourceID, tt.workerProfile, tt.diskSizeGB),
+				nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			location := resp.Header.Get("Location")
+			if tt.wantStatusCode == http.StatusAccepted {
+				if !strings.HasPrefix(location, fmt.Sprintf("/subscriptions/%s/providers/microsoft.redhatopenshift/locations/%s/operationresults/", mockSubID, ti.env.Location())) {
+					t.Error(location)
+				}
+			} else if location != "" {
+				t.Error(location)
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, nil)
+			if err != nil {
+				t.Error(err)
+			}
+
+			if tt.wantDocuments != nil {
+				tt.wantDocuments(ti.checker)
+
+				errs := ti.checker.CheckOpenShiftClusters(ti.openShiftClustersClient)
+				for _, i := range errs {
+					t.Error(i)
+				}
+				errs = ti.checker.CheckAsyncOperations(ti.asyncOperationsClient)
+				for _, i := range errs {
+					t.Error(i)
+				}
+			}
+		})
+	}
+}
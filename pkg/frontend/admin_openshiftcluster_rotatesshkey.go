@@ -0,0 +1,73 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+func (f *frontend) postAdminOpenShiftClusterRotateSSHKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	vars := mux.Vars(r)
+	r.URL.Path = filepath.Dir(r.URL.Path)
+
+	resourceID := strings.TrimPrefix(r.URL.Path, "/admin")
+
+	var header http.Header
+	_, err := f.dbOpenShiftClusters.Patch(ctx, resourceID, func(doc *api.OpenShiftClusterDocument) error {
+		return f._postAdminOpenShiftClusterRotateSSHKey(ctx, r, &header, doc, log)
+	})
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		err = api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeResourceNotFound, "", "The Resource '%s/%s' under resource group '%s' was not found.", vars["resourceType"], vars["resourceName"], vars["resourceGroupName"])
+	case err == nil:
+		err = statusCodeError(http.StatusAccepted)
+	}
+
+	adminReply(log, w, header, nil, err)
+}
+
+// _postAdminOpenShiftClusterRotateSSHKey generates a new SSH key for the RP.
+// The backend then adds it to the authorized keys of the nodes, waits for
+// every node to have it and only then retires the old key; progress can be
+// followed through the returned async operation.
+func (f *frontend) _postAdminOpenShiftClusterRotateSSHKey(ctx context.Context, r *http.Request, header *http.Header, doc *api.OpenShiftClusterDocument, log *logrus.Entry) error {
+	correlationData := r.Context().Value(middleware.ContextKeyCorrelationData).(*api.CorrelationData)
+
+	_, err := f.validateSubscriptionState(ctx, doc.Key, api.SubscriptionStateRegistered, api.SubscriptionStateWarned)
+	if err != nil {
+		return err
+	}
+
+	err = validateWorkerProfileProvisioningState(doc)
+	if err != nil {
+		return err
+	}
+
+	// a key left over from a rotation which failed is replaced: the backend
+	// removes it from the nodes along with the old key
+	sshKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return err
+	}
+
+	log.Print("rotating the RP SSH key")
+	doc.OpenShiftCluster.Properties.NewSSHKey = x509.MarshalPKCS1PrivateKey(sshKey)
+
+	return f.startWorkerProfileUpdate(ctx, r, header, doc, correlationData)
+}
@@ -0,0 +1,155 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/frontend/adminactions"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	"github.com/Azure/ARO-RP/pkg/operator"
+	mock_adminactions "github.com/Azure/ARO-RP/pkg/util/mocks/adminactions"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestAdminRunChecks(t *testing.T) {
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	mockTenantID := "00000000-0000-0000-0000-000000000000"
+
+	ctx := context.Background()
+
+	result := &operator.CheckResult{
+		ID: "request",
+		Checks: []operator.CheckOutcome{
+			{
+				Name: "EtcdChecker",
+			},
+		},
+	}
+
+	type test struct {
+		name           string
+		resourceID     string
+		query          string
+		fixture        func(*testdatabase.Fixture)
+		mocks          func(*test, *mock_adminactions.MockInterface)
+		wantStatusCode int
+		wantResponse   interface{}
+		wantError      string
+	}
+
+	addDocuments := func(f *testdatabase.Fixture) {
+		f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+			Key: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "resourceName")),
+			OpenShiftCluster: &api.OpenShiftCluster{
+				ID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			},
+		})
+
+		f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+			ID: mockSubID,
+			Subscription: &api.Subscription{
+				State: api.SubscriptionStateRegistered,
+				Properties: &api.SubscriptionProperties{
+					TenantID: mockTenantID,
+				},
+			},
+		})
+	}
+
+	for _, tt := range []*test{
+		{
+			name:       "named checks",
+			resourceID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			query:      "?check=EtcdChecker&check=ACRTokenChecker",
+			fixture:    addDocuments,
+			mocks: func(tt *test, a *mock_adminactions.MockInterface) {
+				a.EXPECT().RunChecks(gomock.Any(), []string{"EtcdChecker", "ACRTokenChecker"}).Return(result, nil)
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse:   result,
+		},
+		{
+			name:       "all checks",
+			resourceID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			fixture:    addDocuments,
+			mocks: func(tt *test, a *mock_adminactions.MockInterface) {
+				a.EXPECT().RunChecks(gomock.Any(), nil).Return(result, nil)
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse:   result,
+		},
+		{
+			name:           "empty check name",
+			resourceID:     testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			query:          "?check=",
+			fixture:        addDocuments,
+			mocks:          func(tt *test, a *mock_adminactions.MockInterface) {},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: check: The provided check '' is invalid.",
+		},
+		{
+			name:       "operator does not respond",
+			resourceID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			fixture:    addDocuments,
+			mocks: func(tt *test, a *mock_adminactions.MockInterface) {
+				a.EXPECT().RunChecks(gomock.Any(), nil).Return(nil, api.NewCloudError(http.StatusGatewayTimeout, api.CloudErrorCodeInternalServerError, "", "The operator did not run the requested checks within 2m0s."))
+			},
+			wantStatusCode: http.StatusGatewayTimeout,
+			wantError:      "504: InternalServerError: : The operator did not run the requested checks within 2m0s.",
+		},
+		{
+			name:           "cluster not found",
+			resourceID:     testdatabase.GetResourcePath(mockSubID, "resourceName"),
+			fixture:        func(f *testdatabase.Fixture) {},
+			mocks:          func(tt *test, a *mock_adminactions.MockInterface) {},
+			wantStatusCode: http.StatusNotFound,
+			wantError:      "404: ResourceNotFound: : The Resource 'openshiftclusters/resourcename' under resource group 'resourcegroup' was not found.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithOpenShiftClusters().WithSubscriptions()
+			defer ti.done()
+
+			a := mock_adminactions.NewMockInterface(ti.controller)
+			tt.mocks(tt, a)
+
+			err := ti.buildFixtures(tt.fixture)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, api.APIs, &noop.Noop{}, nil, func(*logrus.Entry, env.Interface, *api.OpenShiftCluster,
+				*api.SubscriptionDocument) (adminactions.Interface, error) {
+				return a, nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodPost,
+				fmt.Sprintf("https://server/admin%s/runchecks%s", tt.resourceID, tt.query),
+				nil, nil)
+			if err != nil {
+				t.Error(err)
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, tt.wantResponse)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
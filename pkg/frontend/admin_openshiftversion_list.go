@@ -0,0 +1,53 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/admin"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+	"github.com/Azure/ARO-RP/pkg/util/version"
+)
+
+func (f *frontend) getAdminOpenShiftVersions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+
+	b, err := f._getAdminOpenShiftVersions(ctx)
+
+	adminReply(log, w, nil, b, err)
+}
+
+func (f *frontend) _getAdminOpenShiftVersions(ctx context.Context) ([]byte, error) {
+	docs, err := f.dbOpenShiftVersions.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vs := make([]*api.OpenShiftVersion, 0, len(docs.OpenShiftVersionDocuments))
+	for _, doc := range docs.OpenShiftVersionDocuments {
+		vs = append(vs, doc.OpenShiftVersion)
+	}
+
+	// versions are listed newest first
+	sort.Slice(vs, func(i, j int) bool {
+		vi, erri := version.ParseVersion(vs[i].Version)
+		vj, errj := version.ParseVersion(vs[j].Version)
+		if erri != nil || errj != nil {
+			return vs[i].Version > vs[j].Version
+		}
+		return vj.Lt(vi)
+	})
+
+	converter := f.apis[admin.APIVersion].OpenShiftVersionConverter()
+
+	return json.MarshalIndent(converter.ToExternalList(vs), "", "    ")
+}
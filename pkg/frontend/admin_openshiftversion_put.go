@@ -0,0 +1,74 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/admin"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+func (f *frontend) putAdminOpenShiftVersion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+
+	body := r.Context().Value(middleware.ContextKeyBody).([]byte)
+	if len(body) == 0 || !json.Valid(body) {
+		api.WriteError(w, http.StatusBadRequest, api.CloudErrorCodeInvalidRequestContent, "", "The request content was invalid and could not be deserialized.")
+		return
+	}
+
+	b, err := f._putAdminOpenShiftVersion(ctx, r, log)
+
+	adminReply(log, w, nil, b, err)
+}
+
+// _putAdminOpenShiftVersion adds or replaces the OpenShift version in the
+// request body.  A version is deprecated by replacing it with enabled false.
+func (f *frontend) _putAdminOpenShiftVersion(ctx context.Context, r *http.Request, log *logrus.Entry) ([]byte, error) {
+	body := r.Context().Value(middleware.ContextKeyBody).([]byte)
+
+	converter := f.apis[admin.APIVersion].OpenShiftVersionConverter()
+	staticValidator := f.apis[admin.APIVersion].OpenShiftVersionStaticValidator()
+
+	ext := converter.ToExternal(&api.OpenShiftVersion{})
+	err := json.Unmarshal(body, &ext)
+	if err != nil {
+		return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidRequestContent, "", "The request content was invalid and could not be deserialized: %q.", err)
+	}
+
+	err = staticValidator.Static(ext, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &api.OpenShiftVersion{}
+	converter.ToInternal(ext, v)
+
+	doc, err := f.dbOpenShiftVersions.Get(ctx, v.Version)
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		log.Printf("adding version %s", v.Version)
+		doc, err = f.dbOpenShiftVersions.Create(ctx, &api.OpenShiftVersionDocument{
+			ID:               v.Version,
+			OpenShiftVersion: v,
+		})
+	case err == nil:
+		log.Printf("replacing version %s", v.Version)
+		doc.OpenShiftVersion = v
+		doc, err = f.dbOpenShiftVersions.Update(ctx, doc)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(converter.ToExternal(doc.OpenShiftVersion), "", "    ")
+}
@@ -0,0 +1,214 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/admin"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+const testPullspec = "quay.io/openshift-release-dev/ocp-release@sha256:0000000000000000000000000000000000000000000000000000000000000000"
+
+func TestAdminListOpenShiftVersions(t *testing.T) {
+	ctx := context.Background()
+
+	ti := newTestInfra(t).WithOpenShiftVersions()
+	defer ti.done()
+
+	err := ti.buildFixtures(func(f *testdatabase.Fixture) {
+		for _, v := range []string{"4.5.3", "4.5.16", "4.4.21"} {
+			f.AddOpenShiftVersionDocuments(&api.OpenShiftVersionDocument{
+				ID: v,
+				OpenShiftVersion: &api.OpenShiftVersion{
+					Version:           v,
+					OpenShiftPullspec: testPullspec,
+					Enabled:           v != "4.4.21",
+				},
+			})
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, api.APIs, &noop.Noop{}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	go f.Run(ctx, nil, nil)
+
+	resp, b, err := ti.request(http.MethodGet, "https://server/admin/versions", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = validateResponse(resp, b, http.StatusOK, "", &admin.OpenShiftVersionList{
+		OpenShiftVersions: []*admin.OpenShiftVersion{
+			{
+				Version:           "4.5.16",
+				OpenShiftPullspec: testPullspec,
+				Enabled:           true,
+			},
+			{
+				Version:           "4.5.3",
+				OpenShiftPullspec: testPullspec,
+				Enabled:           true,
+			},
+			{
+				Version:           "4.4.21",
+				OpenShiftPullspec: testPullspec,
+			},
+		},
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAdminPutOpenShiftVersion(t *testing.T) {
+	ctx := context.Background()
+
+	type test struct {
+		name           string
+		fixture        func(*testdatabase.Fixture)
+		body           interface{}
+		wantStatusCode int
+		wantResponse   *admin.OpenShiftVersion
+		wantError      string
+		wantDocument   *api.OpenShiftVersion
+	}
+
+	for _, tt := range []*test{
+		{
+			name: "add a version",
+			body: &admin.OpenShiftVersion{
+				Version:           "4.5.16",
+				OpenShiftPullspec: testPullspec,
+				BootImage: &admin.BootImage{
+					Publisher: "azureopenshift",
+					Offer:     "aro4",
+					SKU:       "aro_45",
+					Version:   "45.82.20200722",
+				},
+				Enabled: true,
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse: &admin.OpenShiftVersion{
+				Version:           "4.5.16",
+				OpenShiftPullspec: testPullspec,
+				BootImage: &admin.BootImage{
+					Publisher: "azureopenshift",
+					Offer:     "aro4",
+					SKU:       "aro_45",
+					Version:   "45.82.20200722",
+				},
+				Enabled: true,
+			},
+			wantDocument: &api.OpenShiftVersion{
+				Version:           "4.5.16",
+				OpenShiftPullspec: testPullspec,
+				BootImage: &api.BootImage{
+					Publisher: "azureopenshift",
+					Offer:     "aro4",
+					SKU:       "aro_45",
+					Version:   "45.82.20200722",
+				},
+				Enabled: true,
+			},
+		},
+		{
+			name: "deprecate a version",
+			fixture: func(f *testdatabase.Fixture) {
+				f.AddOpenShiftVersionDocuments(&api.OpenShiftVersionDocument{
+					ID: "4.5.16",
+					OpenShiftVersion: &api.OpenShiftVersion{
+						Version:           "4.5.16",
+						OpenShiftPullspec: testPullspec,
+						Enabled:           true,
+					},
+				})
+			},
+			body: &admin.OpenShiftVersion{
+				Version:           "4.5.16",
+				OpenShiftPullspec: testPullspec,
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse: &admin.OpenShiftVersion{
+				Version:           "4.5.16",
+				OpenShiftPullspec: testPullspec,
+			},
+			wantDocument: &api.OpenShiftVersion{
+				Version:           "4.5.16",
+				OpenShiftPullspec: testPullspec,
+			},
+		},
+		{
+			name: "invalid version",
+			body: &admin.OpenShiftVersion{
+				Version:           "4.5.16-rc.0",
+				OpenShiftPullspec: testPullspec,
+				Enabled:           true,
+			},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: version: The provided version '4.5.16-rc.0' is invalid.",
+		},
+		{
+			name:           "invalid body",
+			body:           []string{},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `400: InvalidRequestContent: : The request content was invalid and could not be deserialized: "json: cannot unmarshal array into Go value of type admin.OpenShiftVersion".`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithOpenShiftVersions()
+			defer ti.done()
+
+			err := ti.buildFixtures(tt.fixture)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, api.APIs, &noop.Noop{}, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodPut, "https://server/admin/versions",
+				http.Header{
+					"Content-Type": []string{"application/json"},
+				}, tt.body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, tt.wantResponse)
+			if err != nil {
+				t.Error(err)
+			}
+
+			if tt.wantDocument == nil {
+				return
+			}
+
+			doc, err := ti.openShiftVersionsDatabase.Get(ctx, tt.wantDocument.Version)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(doc.OpenShiftVersion, tt.wantDocument) {
+				t.Error(doc.OpenShiftVersion)
+			}
+		})
+	}
+}
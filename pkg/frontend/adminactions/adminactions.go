@@ -6,50 +6,77 @@ package adminactions
 import (
 	"context"
 	"net/http"
+	"net/url"
 
 	configclient "github.com/openshift/client-go/config/clientset/versioned"
 	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/cluster"
 	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/operator"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
 	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/compute"
 	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/features"
 	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/network"
 	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/storage"
 	"github.com/Azure/ARO-RP/pkg/util/dynamichelper"
+	"github.com/Azure/ARO-RP/pkg/util/privateendpoint"
 	"github.com/Azure/ARO-RP/pkg/util/restconfig"
 	"github.com/Azure/ARO-RP/pkg/util/stringutils"
 )
 
 // Interface for adminactions
 type Interface interface {
+	ClusterHealth(ctx context.Context) (*operator.ClusterHealth, error)
+	DriftReport(ctx context.Context) (*cluster.DriftReport, error)
 	K8sGet(ctx context.Context, groupKind, namespace, name string) ([]byte, error)
-	K8sList(ctx context.Context, groupKind, namespace string) ([]byte, error)
+	K8sList(ctx context.Context, groupKind, namespace string, limit int64, continueToken string) ([]byte, error)
 	K8sCreateOrUpdate(ctx context.Context, obj *unstructured.Unstructured) error
 	K8sDelete(ctx context.Context, groupKind, namespace, name string) error
+	K8sPodLogs(ctx context.Context, w http.ResponseWriter, namespace, podName string, opts *corev1.PodLogOptions) error
+	K8sEvents(ctx context.Context, w http.ResponseWriter, namespace string, follow bool) error
+	K8sProxy(ctx context.Context, w http.ResponseWriter, resource, namespace, name, port, path string, query url.Values) error
+	K8sExec(ctx context.Context, w http.ResponseWriter, r *http.Request, namespace, podName string, opts *corev1.PodExecOptions) error
+	K8sAttach(ctx context.Context, w http.ResponseWriter, r *http.Request, namespace, podName string, opts *corev1.PodAttachOptions) error
+	OperatorFlagsSet(ctx context.Context, flags map[string]string) error
+	PrivateEndpointRepair(ctx context.Context, doc *api.OpenShiftClusterDocument) (string, error)
+	RebootNodes(ctx context.Context) (*operator.RebootRequest, error)
+	RebootStatus(ctx context.Context) (*operator.RebootStatus, error)
 	ResourcesList(ctx context.Context) ([]byte, error)
+	RestoreSnapshot(ctx context.Context, snapshot *api.OpenShiftClusterSnapshot) error
+	RunChecks(ctx context.Context, checks []string) (*operator.CheckResult, error)
 	Upgrade(ctx context.Context, upgradeY bool) error
 	VMRedeployAndWait(ctx context.Context, vmName string) error
 	VMSerialConsole(ctx context.Context, w http.ResponseWriter,
 		log *logrus.Entry, vmName string) error
+	WhatIf(ctx context.Context) ([]byte, error)
 }
 
 type adminactions struct {
-	log *logrus.Entry
-	env env.Interface
-	oc  *api.OpenShiftCluster
-	dh  dynamichelper.Interface
+	log             *logrus.Entry
+	env             env.Interface
+	oc              *api.OpenShiftCluster
+	subscriptionDoc *api.SubscriptionDocument
+	dh              dynamichelper.Interface
 
 	kubernetescli kubernetes.Interface
 	configcli     configclient.Interface
-
-	resources       features.ResourcesClient
-	virtualMachines compute.VirtualMachinesClient
-	virtualNetworks network.VirtualNetworksClient
-	routeTables     network.RouteTablesClient
-	storageAccounts storage.AccountsClient
+	arocli        aroclient.AroV1alpha1Interface
+
+	deployments         features.DeploymentsClient
+	resources           features.ResourcesClient
+	virtualMachines     compute.VirtualMachinesClient
+	virtualNetworks     network.VirtualNetworksClient
+	loadBalancers       network.LoadBalancersClient
+	privateLinkServices network.PrivateLinkServicesClient
+	routeTables         network.RouteTablesClient
+	storageAccounts     storage.AccountsClient
+
+	privateendpoint privateendpoint.Manager
 }
 
 // New returns an adminactions Interface
@@ -76,26 +103,43 @@ func New(log *logrus.Entry, env env.Interface, oc *api.OpenShiftCluster,
 		return nil, err
 	}
 
+	arocli, err := aroclient.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	fpAuth, err := env.FPAuthorizer(subscriptionDoc.Subscription.Properties.TenantID,
 		env.Environment().ResourceManagerEndpoint)
 	if err != nil {
 		return nil, err
 	}
 
+	localFPAuth, err := env.FPAuthorizer(env.TenantID(), env.Environment().ResourceManagerEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
 	return &adminactions{
-		log: log,
-		env: env,
-		oc:  oc,
-		dh:  dh,
+		log:             log,
+		env:             env,
+		oc:              oc,
+		subscriptionDoc: subscriptionDoc,
+		dh:              dh,
 
 		kubernetescli: kubernetescli,
 		configcli:     configcli,
-
-		resources:       features.NewResourcesClient(subscriptionDoc.ID, fpAuth),
-		virtualMachines: compute.NewVirtualMachinesClient(subscriptionDoc.ID, fpAuth),
-		virtualNetworks: network.NewVirtualNetworksClient(subscriptionDoc.ID, fpAuth),
-		routeTables:     network.NewRouteTablesClient(subscriptionDoc.ID, fpAuth),
-		storageAccounts: storage.NewAccountsClient(subscriptionDoc.ID, fpAuth),
+		arocli:        arocli,
+
+		deployments:         features.NewDeploymentsClient(subscriptionDoc.ID, fpAuth),
+		resources:           features.NewResourcesClient(subscriptionDoc.ID, fpAuth),
+		virtualMachines:     compute.NewVirtualMachinesClient(subscriptionDoc.ID, fpAuth),
+		virtualNetworks:     network.NewVirtualNetworksClient(subscriptionDoc.ID, fpAuth),
+		loadBalancers:       network.NewLoadBalancersClient(subscriptionDoc.ID, fpAuth),
+		privateLinkServices: network.NewPrivateLinkServicesClient(subscriptionDoc.ID, fpAuth),
+		routeTables:         network.NewRouteTablesClient(subscriptionDoc.ID, fpAuth),
+		storageAccounts:     storage.NewAccountsClient(subscriptionDoc.ID, fpAuth),
+
+		privateendpoint: privateendpoint.NewManager(env, localFPAuth),
 	}, nil
 }
 
@@ -107,8 +151,8 @@ func (a *adminactions) K8sGet(ctx context.Context, groupKind, namespace, name st
 	return un.MarshalJSON()
 }
 
-func (a *adminactions) K8sList(ctx context.Context, groupKind, namespace string) ([]byte, error) {
-	ul, err := a.dh.List(ctx, groupKind, namespace)
+func (a *adminactions) K8sList(ctx context.Context, groupKind, namespace string, limit int64, continueToken string) ([]byte, error) {
+	ul, err := a.dh.ListPage(ctx, groupKind, namespace, limit, continueToken)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,85 @@
+package adminactions
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+// CheckTimeout is how long to wait for the operator to run requested checks
+const CheckTimeout = 2 * time.Minute
+
+var checkPollInterval = 2 * time.Second
+
+// RunChecks asks the operator to run the named checks (or all checks, if
+// none are named) now and waits for their result, so that the caller gets
+// fresh results rather than those of the last periodic run
+func (a *adminactions) RunChecks(ctx context.Context, checks []string) (*operator.CheckResult, error) {
+	req := &operator.CheckRequest{
+		ID:     uuid.NewV4().String(),
+		Checks: checks,
+	}
+
+	b, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster, err := a.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if cluster.Annotations == nil {
+			cluster.Annotations = map[string]string{}
+		}
+		cluster.Annotations[operator.CheckRequestAnnotation] = string(b)
+
+		_, err = a.arocli.Clusters().Update(ctx, cluster, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, CheckTimeout)
+	defer cancel()
+
+	var result *operator.CheckResult
+	err = wait.PollImmediateUntil(checkPollInterval, func() (bool, error) {
+		cluster, err := a.arocli.Clusters().Get(timeoutCtx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+
+		if cluster.Annotations[operator.CheckResultAnnotation] == "" {
+			return false, nil
+		}
+
+		err = json.Unmarshal([]byte(cluster.Annotations[operator.CheckResultAnnotation]), &result)
+		if err != nil {
+			return false, err
+		}
+
+		return result.ID == req.ID, nil
+	}, timeoutCtx.Done())
+	if err == wait.ErrWaitTimeout {
+		return nil, api.NewCloudError(http.StatusGatewayTimeout, api.CloudErrorCodeInternalServerError, "", "The operator did not run the requested checks within %s.", CheckTimeout)
+	}
+
+	return result, err
+}
@@ -0,0 +1,94 @@
+package adminactions
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ktesting "k8s.io/client-go/testing"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+)
+
+func TestRunChecks(t *testing.T) {
+	checkPollInterval = time.Millisecond
+
+	for _, tt := range []struct {
+		name        string
+		operatorRan bool
+		wantErr     string
+	}{
+		{
+			name:        "checks run",
+			operatorRan: true,
+		},
+		{
+			name:    "operator does not respond",
+			wantErr: "504: InternalServerError: : The operator did not run the requested checks within 2m0s.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+
+			arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+					Annotations: map[string]string{
+						operator.CheckResultAnnotation: `{"id":"previous"}`,
+					},
+				},
+			})
+
+			var req *operator.CheckRequest
+			arocli.PrependReactor("update", "clusters", func(action ktesting.Action) (bool, runtime.Object, error) {
+				cluster := action.(ktesting.UpdateAction).GetObject().(*arov1alpha1.Cluster)
+
+				err := json.Unmarshal([]byte(cluster.Annotations[operator.CheckRequestAnnotation]), &req)
+				if err != nil {
+					return true, nil, err
+				}
+
+				// act as the operator, which records the result
+				if tt.operatorRan {
+					b, err := json.Marshal(&operator.CheckResult{
+						ID:     req.ID,
+						Checks: []operator.CheckOutcome{{Name: "EtcdChecker"}},
+					})
+					if err != nil {
+						return true, nil, err
+					}
+					cluster.Annotations[operator.CheckResultAnnotation] = string(b)
+				}
+
+				return false, nil, nil
+			})
+
+			a := &adminactions{
+				arocli: arocli.AroV1alpha1(),
+			}
+
+			result, err := a.RunChecks(ctx, []string{"EtcdChecker"})
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Fatal(err)
+			}
+
+			if req == nil || len(req.Checks) != 1 || req.Checks[0] != "EtcdChecker" {
+				t.Error(req)
+			}
+
+			if tt.operatorRan && (result == nil || result.ID != req.ID || len(result.Checks) != 1) {
+				t.Error(result)
+			}
+		})
+	}
+}
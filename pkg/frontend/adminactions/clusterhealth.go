@@ -0,0 +1,31 @@
+package adminactions
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+// ClusterHealth returns the conditions and check results which the operator
+// last recorded on the Cluster resource
+func (a *adminactions) ClusterHealth(ctx context.Context) (*operator.ClusterHealth, error) {
+	cluster, err := a.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &operator.ClusterHealth{
+		OperatorVersion:   cluster.Status.OperatorVersion,
+		LastHeartbeatTime: cluster.Status.LastHeartbeatTime,
+		Conditions:        cluster.Status.Conditions,
+		MachineChecks:     cluster.Status.MachineChecks,
+		Supportability:    cluster.Status.Supportability,
+		CheckPolicies:     cluster.Spec.Checks,
+	}, nil
+}
@@ -0,0 +1,69 @@
+package adminactions
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+)
+
+func TestClusterHealth(t *testing.T) {
+	ctx := context.Background()
+
+	heartbeat := metav1.NewTime(time.Unix(1000, 0))
+	enabled := false
+
+	conditions := status.Conditions{
+		{
+			Type:   arov1alpha1.MachineValid,
+			Status: corev1.ConditionTrue,
+		},
+	}
+	policies := map[string]arov1alpha1.CheckPolicy{
+		"EtcdChecker": {
+			Enabled: &enabled,
+		},
+	}
+
+	a := &adminactions{
+		arocli: arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: arov1alpha1.SingletonClusterName,
+			},
+			Spec: arov1alpha1.ClusterSpec{
+				Checks: policies,
+			},
+			Status: arov1alpha1.ClusterStatus{
+				OperatorVersion:   "version",
+				LastHeartbeatTime: heartbeat,
+				Conditions:        conditions,
+			},
+		}).AroV1alpha1(),
+	}
+
+	health, err := a.ClusterHealth(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &operator.ClusterHealth{
+		OperatorVersion:   "version",
+		LastHeartbeatTime: heartbeat,
+		Conditions:        conditions,
+		CheckPolicies:     policies,
+	}
+	if !reflect.DeepEqual(health, want) {
+		t.Errorf("%#v", health)
+	}
+}
@@ -0,0 +1,21 @@
+package adminactions
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+
+	"github.com/Azure/ARO-RP/pkg/cluster"
+)
+
+// DriftReport compares the live Azure resources of the cluster with the RP's
+// expected model of them, without repairing them
+func (a *adminactions) DriftReport(ctx context.Context) (*cluster.DriftReport, error) {
+	r, err := cluster.NewDriftReporter(ctx, a.log, a.env, a.oc, a.subscriptionDoc)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.DriftReport(ctx)
+}
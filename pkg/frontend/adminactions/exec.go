@@ -0,0 +1,100 @@
+package adminactions
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+)
+
+// upgradeHeaders are the request headers which are passed on to the API
+// server when a WebSocket connection is proxied.  Everything else the admin
+// client sent is dropped.
+var upgradeHeaders = []string{
+	"Connection",
+	"Upgrade",
+	"Sec-Websocket-Extensions",
+	"Sec-Websocket-Key",
+	"Sec-Websocket-Protocol",
+	"Sec-Websocket-Version",
+}
+
+// K8sExec proxies the WebSocket connection of r to the exec subresource of a
+// pod, running opts.Command in one of its containers
+func (a *adminactions) K8sExec(ctx context.Context, w http.ResponseWriter, r *http.Request, namespace, podName string, opts *corev1.PodExecOptions) error {
+	return a.k8sPodUpgrade(ctx, w, r, namespace, podName, "exec", opts)
+}
+
+// K8sAttach proxies the WebSocket connection of r to the attach subresource
+// of a pod, attaching to the running process of one of its containers
+func (a *adminactions) K8sAttach(ctx context.Context, w http.ResponseWriter, r *http.Request, namespace, podName string, opts *corev1.PodAttachOptions) error {
+	return a.k8sPodUpgrade(ctx, w, r, namespace, podName, "attach", opts)
+}
+
+// k8sPodUpgrade proxies the WebSocket connection of r to the given
+// subresource of a pod.  Once the API server has switched protocols, the
+// connection is relayed until either side closes it or ctx is done.
+func (a *adminactions) k8sPodUpgrade(ctx context.Context, w http.ResponseWriter, r *http.Request, namespace, podName, subresource string, opts runtime.Object) error {
+	restcli, ok := a.kubernetescli.CoreV1().RESTClient().(*rest.RESTClient)
+	if !ok {
+		return fmt.Errorf("unexpected rest client type %T", a.kubernetescli.CoreV1().RESTClient())
+	}
+
+	transport := restcli.Client.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	u := restcli.Get().
+		Namespace(namespace).
+		Resource("pods").
+		Name(podName).
+		SubResource(subresource).
+		VersionedParams(opts, scheme.ParameterCodec).
+		URL()
+
+	// the server's read deadline would otherwise end the session once the
+	// connection is hijacked
+	err := http.NewResponseController(w).SetReadDeadline(time.Time{})
+	if err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+
+	var proxyErr error
+	rp := &httputil.ReverseProxy{
+		Rewrite: func(pr *httputil.ProxyRequest) {
+			header := http.Header{}
+			for _, h := range upgradeHeaders {
+				if v := pr.Out.Header.Values(h); len(v) > 0 {
+					header[h] = v
+				}
+			}
+
+			pr.Out.URL = u
+			pr.Out.Host = ""
+			pr.Out.Header = header
+		},
+		Transport: transport,
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+			proxyErr = err
+		},
+	}
+
+	rp.ServeHTTP(w, r.WithContext(ctx))
+
+	if ctx.Err() != nil {
+		// the client went away or the session timed out
+		return nil
+	}
+	return proxyErr
+}
@@ -0,0 +1,116 @@
+package adminactions
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestK8sExec(t *testing.T) {
+	var gotURL *url.URL
+	var gotHeader http.Header
+
+	// apiserver switches to the requested protocol and echoes what it reads
+	apiserver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotURL, gotHeader = r.URL, r.Header
+
+		conn, brw, err := http.NewResponseController(w).Hijack()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer conn.Close()
+
+		_, _ = brw.WriteString("HTTP/1.1 101 Switching Protocols\r\nConnection: Upgrade\r\nUpgrade: websocket\r\nSec-Websocket-Protocol: v4.channel.k8s.io\r\n\r\n")
+		_ = brw.Flush()
+
+		_, _ = io.Copy(conn, brw)
+	}))
+	defer apiserver.Close()
+
+	kubernetescli, err := kubernetes.NewForConfig(&rest.Config{Host: apiserver.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := &adminactions{
+		kubernetescli: kubernetescli,
+	}
+
+	frontend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		err := a.K8sExec(r.Context(), w, r, "openshift-etcd", "etcd-master-0", &corev1.PodExecOptions{
+			Container: "etcdctl",
+			Command:   []string{"etcdctl", "endpoint", "status"},
+			Stdout:    true,
+			Stderr:    true,
+		})
+		if err != nil {
+			t.Error(err)
+		}
+	}))
+	defer frontend.Close()
+
+	conn, err := net.Dial("tcp", frontend.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	_, err = io.WriteString(conn, "GET /exec HTTP/1.1\r\nHost: frontend\r\nConnection: Upgrade\r\nUpgrade: websocket\r\nSec-Websocket-Key: a2V5\r\nSec-Websocket-Protocol: v4.channel.k8s.io\r\nSec-Websocket-Version: 13\r\nX-Ms-Client-Principal-Name: sre@example.com\r\n\r\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	br := bufio.NewReader(conn)
+
+	resp, err := http.ReadResponse(br, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		t.Fatal(resp.StatusCode)
+	}
+	if resp.Header.Get("Sec-Websocket-Protocol") != "v4.channel.k8s.io" {
+		t.Error(resp.Header.Get("Sec-Websocket-Protocol"))
+	}
+
+	_, err = io.WriteString(conn, "frame")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := make([]byte, len("frame"))
+	_, err = io.ReadFull(br, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "frame" {
+		t.Error(string(b))
+	}
+
+	if gotURL.Path != "/api/v1/namespaces/openshift-etcd/pods/etcd-master-0/exec" {
+		t.Error(gotURL.Path)
+	}
+	if gotURL.RawQuery != "command=etcdctl&command=endpoint&command=status&container=etcdctl&stderr=true&stdout=true" {
+		t.Error(gotURL.RawQuery)
+	}
+	for _, h := range []string{"Sec-Websocket-Key", "Sec-Websocket-Protocol", "Sec-Websocket-Version"} {
+		if gotHeader.Get(h) == "" {
+			t.Errorf("missing %s", h)
+		}
+	}
+	if gotHeader.Get("X-Ms-Client-Principal-Name") != "" {
+		t.Error(gotHeader.Get("X-Ms-Client-Principal-Name"))
+	}
+}
@@ -0,0 +1,29 @@
+package adminactions
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+// OperatorFlagsSet writes flags into the Cluster resource, so that they take
+// effect without waiting for the RP to next deploy the operator
+func (a *adminactions) OperatorFlagsSet(ctx context.Context, flags map[string]string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster, err := a.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		cluster.Spec.OperatorFlags = flags
+
+		_, err = a.arocli.Clusters().Update(ctx, cluster, metav1.UpdateOptions{})
+		return err
+	})
+}
@@ -0,0 +1,58 @@
+package adminactions
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+)
+
+func TestOperatorFlagsSet(t *testing.T) {
+	ctx := context.Background()
+
+	arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: arov1alpha1.SingletonClusterName,
+		},
+		Spec: arov1alpha1.ClusterSpec{
+			ResourceID: "resourceid",
+			OperatorFlags: map[string]string{
+				"aro.routefix.enabled": "true",
+			},
+		},
+	})
+
+	a := &adminactions{
+		arocli: arocli.AroV1alpha1(),
+	}
+
+	flags := map[string]string{
+		"aro.routefix.enabled": "false",
+	}
+
+	err := a.OperatorFlagsSet(ctx, flags)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cluster, err := arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(cluster.Spec.OperatorFlags, flags) {
+		t.Error(cluster.Spec.OperatorFlags)
+	}
+
+	// the rest of the spec must be preserved
+	if cluster.Spec.ResourceID != "resourceid" {
+		t.Error(cluster.Spec.ResourceID)
+	}
+}
@@ -0,0 +1,33 @@
+package adminactions
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/privateendpoint"
+)
+
+// PrivateEndpointRepair repairs the connection between the RP private
+// endpoint of doc and the cluster private link service, and returns the
+// private endpoint IP, which changes if the private endpoint had to be
+// recreated.  Recording the IP in the cluster document is left to the caller.
+func (a *adminactions) PrivateEndpointRepair(ctx context.Context, doc *api.OpenShiftClusterDocument) (string, error) {
+	status, err := a.privateendpoint.Status(ctx, doc)
+	if err != nil {
+		return "", err
+	}
+
+	if status != privateendpoint.StatusApproved {
+		a.log.Printf("private endpoint connection is %s, repairing", status)
+
+		err = a.privateendpoint.Repair(ctx, doc, a.privateLinkServices)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return a.privateendpoint.GetIP(ctx, doc)
+}
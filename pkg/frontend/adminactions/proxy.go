@@ -0,0 +1,62 @@
+package adminactions
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"k8s.io/client-go/rest"
+)
+
+// proxyHeaders are the response headers which are copied from the proxied
+// service, so that dashboards render in the browser
+var proxyHeaders = []string{"Content-Type", "Content-Disposition", "Cache-Control"}
+
+// K8sProxy makes a GET request to path on the named port of a service or pod
+// through the proxy subresource of the API server, and streams the response
+// to w.  resource is "services" or "pods".
+func (a *adminactions) K8sProxy(ctx context.Context, w http.ResponseWriter, resource, namespace, name, port, path string, query url.Values) error {
+	restcli, ok := a.kubernetescli.CoreV1().RESTClient().(*rest.RESTClient)
+	if !ok {
+		return fmt.Errorf("unexpected rest client type %T", a.kubernetescli.CoreV1().RESTClient())
+	}
+
+	u := restcli.Get().
+		Namespace(namespace).
+		Resource(resource).
+		Name(name + ":" + port).
+		SubResource("proxy").
+		Suffix(path).
+		URL()
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := restcli.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	for _, h := range proxyHeaders {
+		if v := resp.Header.Get(h); v != "" {
+			w.Header().Set(h, v)
+		}
+	}
+	w.WriteHeader(resp.StatusCode)
+
+	_, err = io.Copy(&flushWriter{w: w}, resp.Body)
+	if ctx.Err() != nil {
+		// the client went away or the request timed out while streaming
+		return nil
+	}
+	return err
+}
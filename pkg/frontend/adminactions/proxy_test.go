@@ -0,0 +1,65 @@
+package adminactions
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestK8sProxy(t *testing.T) {
+	ctx := context.Background()
+
+	var gotURL *url.URL
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotURL = r.URL
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=secret")
+		w.WriteHeader(http.StatusTeapot)
+		_, _ = w.Write([]byte(`{"status":"success"}`))
+	}))
+	defer server.Close()
+
+	kubernetescli, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := &adminactions{
+		kubernetescli: kubernetescli,
+	}
+
+	w := httptest.NewRecorder()
+
+	err = a.K8sProxy(ctx, w, "services", "openshift-monitoring", "prometheus-k8s", "web", "/api/v1/query", url.Values{"query": []string{"up"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if gotURL.Path != "/api/v1/namespaces/openshift-monitoring/services/prometheus-k8s:web/proxy/api/v1/query" {
+		t.Error(gotURL.Path)
+	}
+	if gotURL.RawQuery != "query=up" {
+		t.Error(gotURL.RawQuery)
+	}
+
+	if w.Code != http.StatusTeapot {
+		t.Error(w.Code)
+	}
+	if w.Header().Get("Content-Type") != "application/json" {
+		t.Error(w.Header().Get("Content-Type"))
+	}
+	if w.Header().Get("Set-Cookie") != "" {
+		t.Error(w.Header().Get("Set-Cookie"))
+	}
+	if w.Body.String() != `{"status":"success"}` {
+		t.Error(w.Body.String())
+	}
+}
@@ -0,0 +1,110 @@
+package adminactions
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	uuid "github.com/satori/go.uuid"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+// RebootNodes asks the operator to reboot all the nodes of the cluster, one
+// at a time.  It doesn't wait for the reboots, which take several minutes per
+// node; their progress is returned by RebootStatus.  A new request is refused
+// while the previous one is in progress.
+func (a *adminactions) RebootNodes(ctx context.Context) (*operator.RebootRequest, error) {
+	req := &operator.RebootRequest{
+		ID: uuid.NewV4().String(),
+	}
+
+	b, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster, err := a.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		inProgress, err := rebootInProgress(cluster)
+		if err != nil {
+			return err
+		}
+		if inProgress != "" {
+			return api.NewCloudError(http.StatusConflict, api.CloudErrorCodeRequestNotAllowed, "", "Reboot '%s' is in progress.", inProgress)
+		}
+
+		if cluster.Annotations == nil {
+			cluster.Annotations = map[string]string{}
+		}
+		cluster.Annotations[operator.RebootRequestAnnotation] = string(b)
+
+		_, err = a.arocli.Clusters().Update(ctx, cluster, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// RebootStatus returns the progress of the most recent reboot request, or nil
+// if the operator hasn't started it yet
+func (a *adminactions) RebootStatus(ctx context.Context) (*operator.RebootStatus, error) {
+	cluster, err := a.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	req, status, err := rebootAnnotations(cluster)
+	if err != nil || req == nil || status == nil || status.ID != req.ID {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+// rebootInProgress returns the ID of the reboot request which the operator
+// has yet to start or complete, if any
+func rebootInProgress(cluster *arov1alpha1.Cluster) (string, error) {
+	req, status, err := rebootAnnotations(cluster)
+	if err != nil || req == nil {
+		return "", err
+	}
+
+	if status == nil || status.ID != req.ID || status.CompletionTime == nil {
+		return req.ID, nil
+	}
+
+	return "", nil
+}
+
+func rebootAnnotations(cluster *arov1alpha1.Cluster) (req *operator.RebootRequest, status *operator.RebootStatus, err error) {
+	if cluster.Annotations[operator.RebootRequestAnnotation] != "" {
+		err = json.Unmarshal([]byte(cluster.Annotations[operator.RebootRequestAnnotation]), &req)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if cluster.Annotations[operator.RebootStatusAnnotation] != "" {
+		err = json.Unmarshal([]byte(cluster.Annotations[operator.RebootStatusAnnotation]), &status)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return req, status, nil
+}
@@ -0,0 +1,141 @@
+package adminactions
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+)
+
+func TestRebootNodes(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name        string
+		annotations map[string]string
+		wantErr     string
+	}{
+		{
+			name: "first reboot",
+		},
+		{
+			name: "previous reboot completed",
+			annotations: map[string]string{
+				operator.RebootRequestAnnotation: `{"id":"previous"}`,
+				operator.RebootStatusAnnotation:  `{"id":"previous","completionTime":"2021-01-01T00:00:00Z"}`,
+			},
+		},
+		{
+			name: "previous reboot not started",
+			annotations: map[string]string{
+				operator.RebootRequestAnnotation: `{"id":"previous"}`,
+			},
+			wantErr: "409: RequestNotAllowed: : Reboot 'previous' is in progress.",
+		},
+		{
+			name: "previous reboot in progress",
+			annotations: map[string]string{
+				operator.RebootRequestAnnotation: `{"id":"previous"}`,
+				operator.RebootStatusAnnotation:  `{"id":"previous"}`,
+			},
+			wantErr: "409: RequestNotAllowed: : Reboot 'previous' is in progress.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        arov1alpha1.SingletonClusterName,
+					Annotations: tt.annotations,
+				},
+			})
+
+			a := &adminactions{
+				arocli: arocli.AroV1alpha1(),
+			}
+
+			req, err := a.RebootNodes(ctx)
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Fatal(err)
+			}
+
+			cluster, err := arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got *operator.RebootRequest
+			err = json.Unmarshal([]byte(cluster.Annotations[operator.RebootRequestAnnotation]), &got)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.wantErr == "" && (req == nil || got.ID != req.ID) {
+				t.Error(got, req)
+			}
+			if tt.wantErr != "" && got.ID != "previous" {
+				t.Error(got)
+			}
+		})
+	}
+}
+
+func TestRebootStatus(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name        string
+		annotations map[string]string
+		wantID      string
+	}{
+		{
+			name: "no reboot",
+		},
+		{
+			name: "reboot not started",
+			annotations: map[string]string{
+				operator.RebootRequestAnnotation: `{"id":"request"}`,
+				operator.RebootStatusAnnotation:  `{"id":"previous"}`,
+			},
+		},
+		{
+			name: "reboot started",
+			annotations: map[string]string{
+				operator.RebootRequestAnnotation: `{"id":"request"}`,
+				operator.RebootStatusAnnotation:  `{"id":"request","nodes":[{"name":"worker-a","state":"Draining"}]}`,
+			},
+			wantID: "request",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        arov1alpha1.SingletonClusterName,
+					Annotations: tt.annotations,
+				},
+			})
+
+			a := &adminactions{
+				arocli: arocli.AroV1alpha1(),
+			}
+
+			status, err := a.RebootStatus(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.wantID == "" && status != nil ||
+				tt.wantID != "" && (status == nil || status.ID != tt.wantID || len(status.Nodes) != 1) {
+				t.Error(status)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package adminactions
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+
+	mgmtnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-07-01/network"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/network"
+	"github.com/Azure/ARO-RP/pkg/util/stringutils"
+)
+
+// RestoreSnapshot puts the cluster's load balancers and serving certificates
+// back to the state recorded in snapshot.  Restoring the cluster document
+// sections is left to the caller.
+func (a *adminactions) RestoreSnapshot(ctx context.Context, snapshot *api.OpenShiftClusterSnapshot) error {
+	resourceGroup := stringutils.LastTokenByte(a.oc.Properties.ClusterProfile.ResourceGroupID, '/')
+
+	err := restoreLoadBalancers(ctx, a.log, a.loadBalancers, resourceGroup, snapshot.LoadBalancers)
+	if err != nil {
+		return err
+	}
+
+	return restoreCertificates(ctx, a.log, a.kubernetescli, snapshot.Certificates)
+}
+
+func restoreLoadBalancers(ctx context.Context, log *logrus.Entry, loadBalancers network.LoadBalancersClient, resourceGroup string, snapshot []api.SnapshotLoadBalancer) error {
+	for _, s := range snapshot {
+		var lb mgmtnetwork.LoadBalancer
+		err := json.Unmarshal(s.JSON, &lb)
+		if err != nil {
+			return err
+		}
+
+		// the load balancer has changed since the snapshot: overwrite it
+		// unconditionally
+		lb.Etag = nil
+
+		log.Printf("restoring load balancer %s", s.Name)
+		err = loadBalancers.CreateOrUpdateAndWait(ctx, resourceGroup, s.Name, lb)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func restoreCertificates(ctx context.Context, log *logrus.Entry, kubernetescli kubernetes.Interface, snapshot []api.SnapshotCertificate) error {
+	for _, s := range snapshot {
+		var key, cert []byte
+		rest := []byte(s.PEM)
+		for {
+			var b *pem.Block
+			b, rest = pem.Decode(rest)
+			if b == nil {
+				break
+			}
+
+			if b.Type == "CERTIFICATE" {
+				cert = append(cert, pem.EncodeToMemory(b)...)
+			} else {
+				key = append(key, pem.EncodeToMemory(b)...)
+			}
+		}
+
+		if key == nil || cert == nil {
+			return fmt.Errorf("certificate secret %s/%s has no key or certificate", s.Namespace, s.Name)
+		}
+
+		log.Printf("restoring secret %s/%s", s.Namespace, s.Name)
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			secret, err := kubernetescli.CoreV1().Secrets(s.Namespace).Get(ctx, s.Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+
+			secret.Data = map[string][]byte{
+				corev1.TLSCertKey:       cert,
+				corev1.TLSPrivateKeyKey: key,
+			}
+			secret.Type = corev1.SecretTypeTLS
+
+			_, err = kubernetescli.CoreV1().Secrets(s.Namespace).Update(ctx, secret, metav1.UpdateOptions{})
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
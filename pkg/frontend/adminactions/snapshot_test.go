@@ -0,0 +1,115 @@
+package adminactions
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/pem"
+	"testing"
+
+	mgmtnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-07-01/network"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	mock_network "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/mgmt/network"
+)
+
+func TestRestoreLoadBalancers(t *testing.T) {
+	ctx := context.Background()
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	loadBalancers := mock_network.NewMockLoadBalancersClient(controller)
+	loadBalancers.EXPECT().
+		CreateOrUpdateAndWait(ctx, "test-cluster", "infra-internal", mgmtnetwork.LoadBalancer{
+			Location: to.StringPtr("eastus"),
+		}).
+		Return(nil)
+
+	err := restoreLoadBalancers(ctx, logrus.NewEntry(logrus.StandardLogger()), loadBalancers, "test-cluster", []api.SnapshotLoadBalancer{
+		{
+			Name: "infra-internal",
+			JSON: []byte(`{"etag":"W/\"1\"","location":"eastus"}`),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRestoreCertificates(t *testing.T) {
+	ctx := context.Background()
+
+	key := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("key")})
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("cert")})
+
+	for _, tt := range []struct {
+		name     string
+		snapshot []api.SnapshotCertificate
+		wantErr  string
+	}{
+		{
+			name: "restored",
+			snapshot: []api.SnapshotCertificate{
+				{
+					Namespace: "openshift-ingress",
+					Name:      "cluster-ingress",
+					PEM:       append(append([]byte{}, key...), cert...),
+				},
+			},
+		},
+		{
+			name: "no key",
+			snapshot: []api.SnapshotCertificate{
+				{
+					Namespace: "openshift-ingress",
+					Name:      "cluster-ingress",
+					PEM:       cert,
+				},
+			},
+			wantErr: "certificate secret openshift-ingress/cluster-ingress has no key or certificate",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			kubernetescli := fake.NewSimpleClientset(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "openshift-ingress",
+					Name:      "cluster-ingress",
+				},
+				Data: map[string][]byte{
+					corev1.TLSCertKey:       []byte("rotated cert"),
+					corev1.TLSPrivateKeyKey: []byte("rotated key"),
+				},
+			})
+
+			err := restoreCertificates(ctx, logrus.NewEntry(logrus.StandardLogger()), kubernetescli, tt.snapshot)
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Fatal(err)
+			}
+
+			if tt.wantErr != "" {
+				return
+			}
+
+			s, err := kubernetescli.CoreV1().Secrets("openshift-ingress").Get(ctx, "cluster-ingress", metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(s.Data[corev1.TLSCertKey]) != string(cert) {
+				t.Error(string(s.Data[corev1.TLSCertKey]))
+			}
+			if string(s.Data[corev1.TLSPrivateKeyKey]) != string(key) {
+				t.Error(string(s.Data[corev1.TLSPrivateKeyKey]))
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package adminactions
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// flushWriter flushes after every write, so that streamed output reaches the
+// client as it is produced rather than when the response buffer fills
+type flushWriter struct {
+	w io.Writer
+}
+
+func (fw *flushWriter) Write(b []byte) (int, error) {
+	n, err := fw.w.Write(b)
+	if f, ok := fw.w.(http.Flusher); ok {
+		f.Flush()
+	}
+	return n, err
+}
+
+func (a *adminactions) K8sPodLogs(ctx context.Context, w http.ResponseWriter, namespace, podName string, opts *corev1.PodLogOptions) error {
+	rc, err := a.kubernetescli.CoreV1().Pods(namespace).GetLogs(podName, opts).Stream(ctx)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	w.Header().Add("Content-Type", "text/plain")
+
+	_, err = io.Copy(&flushWriter{w: w}, rc)
+	if ctx.Err() != nil {
+		// the client went away or the request timed out while following
+		return nil
+	}
+	return err
+}
+
+// event is the watch.Event wire format used by the Kubernetes API
+type event struct {
+	Type   watch.EventType `json:"type"`
+	Object *corev1.Event   `json:"object"`
+}
+
+func (a *adminactions) K8sEvents(ctx context.Context, w http.ResponseWriter, namespace string, follow bool) error {
+	events, err := a.kubernetescli.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	sort.SliceStable(events.Items, func(i, j int) bool {
+		return events.Items[i].LastTimestamp.Before(&events.Items[j].LastTimestamp)
+	})
+
+	w.Header().Add("Content-Type", "application/json")
+
+	e := json.NewEncoder(&flushWriter{w: w})
+
+	for i := range events.Items {
+		err = e.Encode(&event{Type: watch.Added, Object: &events.Items[i]})
+		if err != nil {
+			return err
+		}
+	}
+
+	if !follow {
+		return nil
+	}
+
+	wi, err := a.kubernetescli.CoreV1().Events(namespace).Watch(ctx, metav1.ListOptions{
+		ResourceVersion: events.ResourceVersion,
+	})
+	if err != nil {
+		return err
+	}
+	defer wi.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case ev, ok := <-wi.ResultChan():
+			if !ok {
+				return nil
+			}
+
+			switch o := ev.Object.(type) {
+			case *corev1.Event:
+				err = e.Encode(&event{Type: ev.Type, Object: o})
+				if err != nil {
+					return err
+				}
+
+			case *metav1.Status:
+				if ev.Type == watch.Error {
+					return &kerrors.StatusError{ErrStatus: *o}
+				}
+			}
+		}
+	}
+}
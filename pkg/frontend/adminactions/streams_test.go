@@ -0,0 +1,103 @@
+package adminactions
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/fake"
+	ktesting "k8s.io/client-go/testing"
+)
+
+func TestK8sEvents(t *testing.T) {
+	ctx := context.Background()
+
+	newEvent := func(name string, lastTimestamp time.Time) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "openshift-etcd",
+			},
+			LastTimestamp: metav1.NewTime(lastTimestamp),
+		}
+	}
+
+	now := time.Now().Truncate(time.Second)
+
+	for _, tt := range []struct {
+		name   string
+		follow bool
+		want   []string
+	}{
+		{
+			name: "existing events, oldest first",
+			want: []string{"ADDED old", "ADDED new"},
+		},
+		{
+			name:   "existing and watched events",
+			follow: true,
+			want:   []string{"ADDED old", "ADDED new", "ADDED watched", "MODIFIED watched"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			kubernetescli := fake.NewSimpleClientset(
+				newEvent("new", now),
+				newEvent("old", now.Add(-time.Hour)),
+				// events in other namespaces must not be returned
+				&corev1.Event{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "customer",
+						Namespace: "customer",
+					},
+				},
+			)
+
+			if tt.follow {
+				fw := watch.NewFake()
+				kubernetescli.PrependWatchReactor("events", ktesting.DefaultWatchReactor(fw, nil))
+
+				go func() {
+					fw.Add(newEvent("watched", now))
+					fw.Modify(newEvent("watched", now))
+					fw.Stop()
+				}()
+			}
+
+			a := &adminactions{
+				kubernetescli: kubernetescli,
+			}
+
+			w := httptest.NewRecorder()
+
+			err := a.K8sEvents(ctx, w, "openshift-etcd", tt.follow)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			d := json.NewDecoder(strings.NewReader(w.Body.String()))
+			for d.More() {
+				var e event
+				err = d.Decode(&e)
+				if err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, string(e.Type)+" "+e.Object.Name)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Error(got)
+			}
+		})
+	}
+}
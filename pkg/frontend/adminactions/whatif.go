@@ -0,0 +1,64 @@
+package adminactions
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	mgmtfeatures "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-07-01/features"
+	"github.com/Azure/go-autorest/autorest/to"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/cluster"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/features"
+	"github.com/Azure/ARO-RP/pkg/util/stringutils"
+)
+
+// whatIfDeploymentName is distinct from the name of the deployments made by
+// the backend, so that a what-if can never be confused with the real thing
+const whatIfDeploymentName = "admin-whatif"
+
+// WhatIf runs a what-if deployment of the RP's current managed resource group
+// template against the cluster and returns the predicted changes
+func (a *adminactions) WhatIf(ctx context.Context) ([]byte, error) {
+	clusterSPObjectID, err := cluster.ClusterSPObjectID(ctx, a.log, a.env, a.oc)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := whatIf(ctx, a.env, a.deployments, a.oc, a.subscriptionDoc, clusterSPObjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(changes)
+}
+
+func whatIf(ctx context.Context, _env env.Interface, deployments features.DeploymentsClient, oc *api.OpenShiftCluster, subscriptionDoc *api.SubscriptionDocument, clusterSPObjectID string) ([]mgmtfeatures.WhatIfChange, error) {
+	clusterRGName := stringutils.LastTokenByte(oc.Properties.ClusterProfile.ResourceGroupID, '/')
+
+	result, err := deployments.WhatIfAndWait(ctx, clusterRGName, whatIfDeploymentName, mgmtfeatures.DeploymentWhatIf{
+		Properties: &mgmtfeatures.DeploymentWhatIfProperties{
+			Template: cluster.ManagedResourceGroupTemplate(_env, oc, subscriptionDoc, clusterSPObjectID),
+			Mode:     mgmtfeatures.Incremental,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Error != nil {
+		return nil, fmt.Errorf("what-if failed: %s: %s", to.String(result.Error.Code), to.String(result.Error.Message))
+	}
+
+	changes := []mgmtfeatures.WhatIfChange{}
+	if result.WhatIfOperationProperties != nil && result.Changes != nil {
+		changes = *result.Changes
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,116 @@
+package adminactions
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	mgmtfeatures "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-07-01/features"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/golang/mock/gomock"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/arm"
+	"github.com/Azure/ARO-RP/pkg/util/deployment"
+	mock_features "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/mgmt/features"
+	mock_env "github.com/Azure/ARO-RP/pkg/util/mocks/env"
+)
+
+func TestWhatIf(t *testing.T) {
+	ctx := context.Background()
+
+	oc := &api.OpenShiftCluster{
+		Properties: api.OpenShiftClusterProperties{
+			ClusterProfile: api.ClusterProfile{
+				ResourceGroupID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/test-cluster",
+			},
+		},
+	}
+
+	subscriptionDoc := &api.SubscriptionDocument{
+		Subscription: &api.Subscription{
+			Properties: &api.SubscriptionProperties{},
+		},
+	}
+
+	change := mgmtfeatures.WhatIfChange{
+		ResourceID: to.StringPtr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/test-cluster/providers/Microsoft.Authorization/denyAssignments/deny"),
+		ChangeType: mgmtfeatures.Modify,
+	}
+
+	for _, tt := range []struct {
+		name          string
+		mode          deployment.Mode
+		result        mgmtfeatures.WhatIfOperationResult
+		wantResources int
+		wantChanges   []mgmtfeatures.WhatIfChange
+		wantErr       string
+	}{
+		{
+			name: "production includes deny assignment",
+			mode: deployment.Production,
+			result: mgmtfeatures.WhatIfOperationResult{
+				WhatIfOperationProperties: &mgmtfeatures.WhatIfOperationProperties{
+					Changes: &[]mgmtfeatures.WhatIfChange{change},
+				},
+			},
+			wantResources: 2,
+			wantChanges:   []mgmtfeatures.WhatIfChange{change},
+		},
+		{
+			name:          "development, no changes",
+			mode:          deployment.Development,
+			wantResources: 1,
+			wantChanges:   []mgmtfeatures.WhatIfChange{},
+		},
+		{
+			name: "what-if error",
+			mode: deployment.Development,
+			result: mgmtfeatures.WhatIfOperationResult{
+				Error: &mgmtfeatures.ErrorResponse{
+					Code:    to.StringPtr("InvalidTemplate"),
+					Message: to.StringPtr("bad template"),
+				},
+			},
+			wantResources: 1,
+			wantErr:       "what-if failed: InvalidTemplate: bad template",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			_env := mock_env.NewMockInterface(controller)
+			_env.EXPECT().DeploymentMode().AnyTimes().Return(tt.mode)
+
+			deployments := mock_features.NewMockDeploymentsClient(controller)
+			deployments.EXPECT().
+				WhatIfAndWait(gomock.Any(), "test-cluster", whatIfDeploymentName, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, resourceGroupName, deploymentName string, parameters mgmtfeatures.DeploymentWhatIf) (mgmtfeatures.WhatIfOperationResult, error) {
+					if parameters.Properties.Mode != mgmtfeatures.Incremental {
+						t.Error(parameters.Properties.Mode)
+					}
+
+					template := parameters.Properties.Template.(*arm.Template)
+					if len(template.Resources) != tt.wantResources {
+						t.Error(len(template.Resources))
+					}
+
+					return tt.result, nil
+				})
+
+			changes, err := whatIf(ctx, _env, deployments, oc, subscriptionDoc, "clusterSPObjectID")
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(changes, tt.wantChanges) {
+				t.Error(changes)
+			}
+		})
+	}
+}
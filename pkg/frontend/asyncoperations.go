@@ -26,6 +26,7 @@ func (f *frontend) newAsyncOperation(ctx context.Context, r *http.Request, doc *
 			InitialProvisioningState: doc.OpenShiftCluster.Properties.ProvisioningState,
 			ProvisioningState:        doc.OpenShiftCluster.Properties.ProvisioningState,
 			StartTime:                time.Now().UTC(),
+			APIVersion:               r.URL.Query().Get("api-version"),
 		},
 	})
 	if err != nil {
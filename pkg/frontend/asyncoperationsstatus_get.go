@@ -51,6 +51,9 @@ func (f *frontend) _getAsyncOperationsStatus(ctx context.Context, r *http.Reques
 
 	asyncdoc.AsyncOperation.MissingFields = api.MissingFields{}
 	asyncdoc.AsyncOperation.InitialProvisioningState = ""
+	asyncdoc.AsyncOperation.ARMCalls = nil
+	asyncdoc.AsyncOperation.APIVersion = ""
+	asyncdoc.AsyncOperation.FailedStep = ""
 
 	h := &codec.JsonHandle{
 		Indent: 4,
@@ -0,0 +1,51 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+// loadClientThrottles returns the request rates allowed to throttled client
+// applications from CLIENT_THROTTLES, a JSON object keyed by AAD application
+// ID, e.g. {"00000000-0000-0000-0000-000000000000": {"requestsPerSecond": 0.5,
+// "burst": 10}}.  If it is unset, no client application is throttled.
+func loadClientThrottles() (map[string]middleware.ClientThrottle, error) {
+	s, found := os.LookupEnv("CLIENT_THROTTLES")
+	if !found {
+		return nil, nil
+	}
+
+	return parseClientThrottles([]byte(s))
+}
+
+func parseClientThrottles(b []byte) (map[string]middleware.ClientThrottle, error) {
+	var throttles map[string]middleware.ClientThrottle
+	err := json.Unmarshal(b, &throttles)
+	if err != nil {
+		return nil, fmt.Errorf("CLIENT_THROTTLES is invalid: %v", err)
+	}
+
+	clientThrottles := make(map[string]middleware.ClientThrottle, len(throttles))
+	for clientAppID, t := range throttles {
+		if _, err := uuid.FromString(clientAppID); err != nil {
+			return nil, fmt.Errorf("CLIENT_THROTTLES is invalid: client application ID %q is not a UUID", clientAppID)
+		}
+
+		if t.RequestsPerSecond <= 0 || t.Burst < 1 {
+			return nil, fmt.Errorf("CLIENT_THROTTLES is invalid: client application ID %q must have a positive requestsPerSecond and burst", clientAppID)
+		}
+
+		clientThrottles[strings.ToLower(clientAppID)] = t
+	}
+
+	return clientThrottles, nil
+}
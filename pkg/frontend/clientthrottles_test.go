@@ -0,0 +1,58 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+func TestParseClientThrottles(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		config  string
+		want    map[string]middleware.ClientThrottle
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			config: `{"00000000-0000-0000-0000-00000000000A": {"requestsPerSecond": 0.5, "burst": 10}}`,
+			want: map[string]middleware.ClientThrottle{
+				"00000000-0000-0000-0000-00000000000a": {
+					RequestsPerSecond: 0.5,
+					Burst:             10,
+				},
+			},
+		},
+		{
+			name:    "invalid client application ID",
+			config:  `{"azure-cli": {"requestsPerSecond": 0.5, "burst": 10}}`,
+			wantErr: `CLIENT_THROTTLES is invalid: client application ID "azure-cli" is not a UUID`,
+		},
+		{
+			name:    "missing burst",
+			config:  `{"00000000-0000-0000-0000-00000000000a": {"requestsPerSecond": 0.5}}`,
+			wantErr: `CLIENT_THROTTLES is invalid: client application ID "00000000-0000-0000-0000-00000000000a" must have a positive requestsPerSecond and burst`,
+		},
+		{
+			name:    "invalid json",
+			config:  `{`,
+			wantErr: "CLIENT_THROTTLES is invalid: unexpected end of JSON input",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseClientThrottles([]byte(tt.config))
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Error(got)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+const additionalInfoTypeClusterQuota = "ClusterQuota"
+
+// clusterQuotas limits the number of clusters which may be created, so that
+// runaway automation in one subscription cannot use up the capacity of the
+// region.  A zero limit is not enforced.
+type clusterQuotas struct {
+	MaxClustersPerSubscription int `json:"maxClustersPerSubscription,omitempty"`
+	MaxClustersPerRegion       int `json:"maxClustersPerRegion,omitempty"`
+
+	// ExemptSubscriptions are not subject to either limit, e.g. the
+	// subscriptions of first party services which run many clusters
+	ExemptSubscriptions []string `json:"exemptSubscriptions,omitempty"`
+}
+
+// clusterQuotaInfo is the additional information of a QuotaExceeded error,
+// from which clients can tell which limit was hit
+type clusterQuotaInfo struct {
+	Scope   string `json:"scope"`
+	Limit   int    `json:"limit"`
+	Current int    `json:"current"`
+}
+
+// loadClusterQuotas returns the cluster quotas from CLUSTER_QUOTAS, a JSON
+// object, e.g. {"maxClustersPerSubscription": 50, "maxClustersPerRegion":
+// 5000, "exemptSubscriptions": ["00000000-0000-0000-0000-000000000000"]}.  If
+// it is unset, cluster creation is not limited.
+func loadClusterQuotas() (*clusterQuotas, error) {
+	s, found := os.LookupEnv("CLUSTER_QUOTAS")
+	if !found {
+		return nil, nil
+	}
+
+	return parseClusterQuotas([]byte(s))
+}
+
+func parseClusterQuotas(b []byte) (*clusterQuotas, error) {
+	var q *clusterQuotas
+	err := json.Unmarshal(b, &q)
+	if err != nil {
+		return nil, fmt.Errorf("CLUSTER_QUOTAS is invalid: %v", err)
+	}
+	if q == nil {
+		return nil, nil
+	}
+
+	if q.MaxClustersPerSubscription < 0 || q.MaxClustersPerRegion < 0 {
+		return nil, fmt.Errorf("CLUSTER_QUOTAS is invalid: limits must not be negative")
+	}
+
+	for i, subscriptionID := range q.ExemptSubscriptions {
+		if _, err := uuid.FromString(subscriptionID); err != nil {
+			return nil, fmt.Errorf("CLUSTER_QUOTAS is invalid: exempt subscription %q is not a UUID", subscriptionID)
+		}
+
+		q.ExemptSubscriptions[i] = strings.ToLower(subscriptionID)
+	}
+
+	return q, nil
+}
+
+func (q *clusterQuotas) exempt(subscriptionID string) bool {
+	for _, exempt := range q.ExemptSubscriptions {
+		if strings.EqualFold(exempt, subscriptionID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateClusterQuotas returns a QuotaExceeded error if creating a cluster
+// in the subscription would exceed the cluster quotas
+func (f *frontend) validateClusterQuotas(ctx context.Context, subscriptionID string) error {
+	if f.quotas == nil || f.quotas.exempt(subscriptionID) {
+		return nil
+	}
+
+	if f.quotas.MaxClustersPerSubscription > 0 {
+		count, err := f.dbOpenShiftClusters.CountBySubscription(ctx, subscriptionID)
+		if err != nil {
+			return err
+		}
+
+		if count >= f.quotas.MaxClustersPerSubscription {
+			return f.clusterQuotaExceeded("subscription", f.quotas.MaxClustersPerSubscription, count)
+		}
+	}
+
+	if f.quotas.MaxClustersPerRegion > 0 {
+		count, err := f.dbOpenShiftClusters.Count(ctx, "OpenShiftClusters")
+		if err != nil {
+			return err
+		}
+
+		if count >= f.quotas.MaxClustersPerRegion {
+			return f.clusterQuotaExceeded("region", f.quotas.MaxClustersPerRegion, count)
+		}
+	}
+
+	return nil
+}
+
+func (f *frontend) clusterQuotaExceeded(scope string, limit, current int) error {
+	f.m.EmitGauge("frontend.clusterquota.rejected.count", 1, map[string]string{
+		"scope": scope,
+	})
+
+	var message string
+	switch scope {
+	case "subscription":
+		message = fmt.Sprintf("The subscription has reached its quota of %d clusters in region '%s'. Delete unused clusters, or contact support to raise the quota.", limit, f.env.Location())
+	default:
+		message = fmt.Sprintf("Region '%s' has reached its capacity of %d clusters. Create the cluster in another region.", f.env.Location(), limit)
+	}
+
+	return &api.CloudError{
+		StatusCode: http.StatusBadRequest,
+		CloudErrorBody: &api.CloudErrorBody{
+			Code:    api.CloudErrorCodeQuotaExceeded,
+			Message: message,
+			AdditionalInfo: []api.CloudErrorAdditionalInfo{
+				{
+					Type: additionalInfoTypeClusterQuota,
+					Info: &clusterQuotaInfo{
+						Scope:   scope,
+						Limit:   limit,
+						Current: current,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,177 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestParseClusterQuotas(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		config  string
+		want    *clusterQuotas
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			config: `{"maxClustersPerSubscription": 50, "maxClustersPerRegion": 5000, "exemptSubscriptions": ["00000000-0000-0000-0000-00000000000A"]}`,
+			want: &clusterQuotas{
+				MaxClustersPerSubscription: 50,
+				MaxClustersPerRegion:       5000,
+				ExemptSubscriptions:        []string{"00000000-0000-0000-0000-00000000000a"},
+			},
+		},
+		{
+			name:   "null",
+			config: `null`,
+		},
+		{
+			name:    "negative limit",
+			config:  `{"maxClustersPerRegion": -1}`,
+			wantErr: "CLUSTER_QUOTAS is invalid: limits must not be negative",
+		},
+		{
+			name:    "invalid exempt subscription",
+			config:  `{"exemptSubscriptions": ["sre"]}`,
+			wantErr: `CLUSTER_QUOTAS is invalid: exempt subscription "sre" is not a UUID`,
+		},
+		{
+			name:    "invalid json",
+			config:  `{`,
+			wantErr: "CLUSTER_QUOTAS is invalid: unexpected end of JSON input",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseClusterQuotas([]byte(tt.config))
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Error(got)
+			}
+		})
+	}
+}
+
+func TestValidateClusterQuotas(t *testing.T) {
+	ctx := context.Background()
+
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	otherSubID := "11111111-1111-1111-1111-111111111111"
+
+	for _, tt := range []struct {
+		name           string
+		quotas         *clusterQuotas
+		subscriptionID string
+		wantErr        string
+		wantInfo       *clusterQuotaInfo
+	}{
+		{
+			name:           "no quotas",
+			subscriptionID: mockSubID,
+		},
+		{
+			name: "under quotas",
+			quotas: &clusterQuotas{
+				MaxClustersPerSubscription: 3,
+				MaxClustersPerRegion:       4,
+			},
+			subscriptionID: mockSubID,
+		},
+		{
+			name: "subscription quota exceeded",
+			quotas: &clusterQuotas{
+				MaxClustersPerSubscription: 2,
+			},
+			subscriptionID: mockSubID,
+			wantErr:        "400: QuotaExceeded: : The subscription has reached its quota of 2 clusters in region 'eastus'. Delete unused clusters, or contact support to raise the quota.",
+			wantInfo: &clusterQuotaInfo{
+				Scope:   "subscription",
+				Limit:   2,
+				Current: 2,
+			},
+		},
+		{
+			name: "subscription quota applies per subscription",
+			quotas: &clusterQuotas{
+				MaxClustersPerSubscription: 2,
+			},
+			subscriptionID: otherSubID,
+		},
+		{
+			name: "region quota exceeded",
+			quotas: &clusterQuotas{
+				MaxClustersPerRegion: 3,
+			},
+			subscriptionID: otherSubID,
+			wantErr:        "400: QuotaExceeded: : Region 'eastus' has reached its capacity of 3 clusters. Create the cluster in another region.",
+			wantInfo: &clusterQuotaInfo{
+				Scope:   "region",
+				Limit:   3,
+				Current: 3,
+			},
+		},
+		{
+			name: "exempt subscription",
+			quotas: &clusterQuotas{
+				MaxClustersPerSubscription: 1,
+				MaxClustersPerRegion:       1,
+				ExemptSubscriptions:        []string{mockSubID},
+			},
+			subscriptionID: mockSubID,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithOpenShiftClusters()
+			defer ti.done()
+
+			err := ti.buildFixtures(func(f *testdatabase.Fixture) {
+				for _, key := range []string{
+					"/subscriptions/" + mockSubID + "/resourcegroups/resourcegroup/providers/microsoft.redhatopenshift/openshiftclusters/one",
+					"/subscriptions/" + mockSubID + "/resourcegroups/resourcegroup/providers/microsoft.redhatopenshift/openshiftclusters/two",
+					"/subscriptions/" + otherSubID + "/resourcegroups/resourcegroup/providers/microsoft.redhatopenshift/openshiftclusters/three",
+				} {
+					f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+						Key: key,
+						OpenShiftCluster: &api.OpenShiftCluster{
+							ID: key,
+						},
+					})
+				}
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f := &frontend{
+				env:                 ti.env,
+				dbOpenShiftClusters: ti.openShiftClustersDatabase,
+				quotas:              tt.quotas,
+				m:                   &noop.Noop{},
+			}
+
+			err = f.validateClusterQuotas(ctx, tt.subscriptionID)
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Fatal(err)
+			}
+
+			if tt.wantInfo != nil {
+				info := err.(*api.CloudError).AdditionalInfo
+				if len(info) != 1 || info[0].Type != additionalInfoTypeClusterQuota || !reflect.DeepEqual(info[0].Info, tt.wantInfo) {
+					t.Error(info)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+// loadDeprecations returns the retirement schedule of the API versions from
+// API_VERSION_DEPRECATIONS, a JSON object keyed by API version, e.g.
+// {"2020-04-30": {"deprecated": "2021-01-01T00:00:00Z", "sunset":
+// "2021-07-01T00:00:00Z"}}.  If it is unset, no API version is deprecated.
+func loadDeprecations(apis map[string]*api.Version) (map[string]middleware.Deprecation, error) {
+	s, found := os.LookupEnv("API_VERSION_DEPRECATIONS")
+	if !found {
+		return nil, nil
+	}
+
+	return parseDeprecations([]byte(s), apis)
+}
+
+func parseDeprecations(b []byte, apis map[string]*api.Version) (map[string]middleware.Deprecation, error) {
+	var deprecations map[string]middleware.Deprecation
+	err := json.Unmarshal(b, &deprecations)
+	if err != nil {
+		return nil, fmt.Errorf("API_VERSION_DEPRECATIONS is invalid: %v", err)
+	}
+
+	for apiVersion, d := range deprecations {
+		if _, found := apis[apiVersion]; !found {
+			return nil, fmt.Errorf("API_VERSION_DEPRECATIONS is invalid: unknown API version %q", apiVersion)
+		}
+
+		if !d.Deprecated.IsZero() && !d.Sunset.IsZero() && d.Sunset.Before(d.Deprecated) {
+			return nil, fmt.Errorf("API_VERSION_DEPRECATIONS is invalid: API version %q is sunset before it is deprecated", apiVersion)
+		}
+	}
+
+	return deprecations, nil
+}
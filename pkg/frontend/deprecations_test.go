@@ -0,0 +1,66 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+func TestParseDeprecations(t *testing.T) {
+	apis := map[string]*api.Version{
+		"2020-04-30":         {},
+		"2020-10-31-preview": {},
+	}
+
+	for _, tt := range []struct {
+		name    string
+		config  string
+		want    map[string]middleware.Deprecation
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			config: `{"2020-04-30": {"deprecated": "2021-01-01T00:00:00Z", "sunset": "2021-07-01T00:00:00Z", "link": "https://docs.microsoft.com/azure/openshift/"}}`,
+			want: map[string]middleware.Deprecation{
+				"2020-04-30": {
+					Deprecated: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					Sunset:     time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC),
+					Link:       "https://docs.microsoft.com/azure/openshift/",
+				},
+			},
+		},
+		{
+			name:    "unknown api version",
+			config:  `{"2019-12-31-preview": {"deprecated": "2021-01-01T00:00:00Z"}}`,
+			wantErr: `API_VERSION_DEPRECATIONS is invalid: unknown API version "2019-12-31-preview"`,
+		},
+		{
+			name:    "sunset before deprecation",
+			config:  `{"2020-04-30": {"deprecated": "2021-07-01T00:00:00Z", "sunset": "2021-01-01T00:00:00Z"}}`,
+			wantErr: `API_VERSION_DEPRECATIONS is invalid: API version "2020-04-30" is sunset before it is deprecated`,
+		},
+		{
+			name:    "invalid json",
+			config:  `{`,
+			wantErr: "API_VERSION_DEPRECATIONS is invalid: unexpected end of JSON input",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseDeprecations([]byte(tt.config), apis)
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Error(got)
+			}
+		})
+	}
+}
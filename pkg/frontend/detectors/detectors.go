@@ -0,0 +1,157 @@
+package detectors
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/compute"
+	"github.com/Azure/ARO-RP/pkg/util/restconfig"
+	"github.com/Azure/ARO-RP/pkg/util/subnet"
+)
+
+// Interface runs curated, read-only diagnostic checks against a cluster on
+// behalf of the customer
+type Interface interface {
+	List() []*api.Detector
+	Get(ctx context.Context, name string) (*api.Detector, error)
+}
+
+type detector struct {
+	title       string
+	description string
+	detect      func(context.Context) ([]api.DetectorFinding, error)
+}
+
+type detectors struct {
+	log *logrus.Entry
+	env env.Interface
+	oc  *api.OpenShiftCluster
+
+	kubernetescli kubernetes.Interface
+	subnets       subnet.Manager
+
+	// usages are read as the cluster service principal, so the client can
+	// only be created at detection time
+	newSPUsageClient func(context.Context) (compute.UsageClient, error)
+
+	detectors map[string]*detector
+}
+
+// New returns a detectors Interface
+func New(log *logrus.Entry, env env.Interface, oc *api.OpenShiftCluster,
+	subscriptionDoc *api.SubscriptionDocument) (Interface, error) {
+
+	restConfig, err := restconfig.RestConfig(env, oc)
+	if err != nil {
+		return nil, err
+	}
+
+	kubernetescli, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	fpAuthorizer, err := env.FPAuthorizer(subscriptionDoc.Subscription.Properties.TenantID,
+		env.Environment().ResourceManagerEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &detectors{
+		log: log,
+		env: env,
+		oc:  oc,
+
+		kubernetescli: kubernetescli,
+		subnets:       subnet.NewManager(subscriptionDoc.ID, fpAuthorizer),
+	}
+
+	d.newSPUsageClient = d._newSPUsageClient
+	d.init()
+
+	return d, nil
+}
+
+func (d *detectors) init() {
+	d.detectors = map[string]*detector{
+		"serviceprincipal": {
+			title:       "Service principal",
+			description: "Checks that the cluster service principal can authenticate.",
+			detect:      d.detectServicePrincipal,
+		},
+		"networksecuritygroups": {
+			title:       "Network security groups",
+			description: "Checks that the cluster subnets are attached to the network security groups managed by Azure Red Hat OpenShift.",
+			detect:      d.detectNetworkSecurityGroups,
+		},
+		"quota": {
+			title:       "Quota",
+			description: "Checks that there is enough compute quota in the cluster subscription and location to add a worker node.",
+			detect:      d.detectQuota,
+		},
+		"nodehealth": {
+			title:       "Node health",
+			description: "Checks that all cluster nodes are ready and not under resource pressure.",
+			detect:      d.detectNodeHealth,
+		},
+	}
+}
+
+// List returns the available detectors, without running them
+func (d *detectors) List() []*api.Detector {
+	ds := make([]*api.Detector, 0, len(d.detectors))
+
+	for _, name := range []string{"serviceprincipal", "networksecuritygroups", "quota", "nodehealth"} {
+		ds = append(ds, d.detector(name))
+	}
+
+	return ds
+}
+
+// Get runs the named detector and returns its findings
+func (d *detectors) Get(ctx context.Context, name string) (*api.Detector, error) {
+	name = strings.ToLower(name)
+
+	det := d.detectors[name]
+	if det == nil {
+		return nil, api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeNotFound, "", "The detector '%s' was not found.", name)
+	}
+
+	out := d.detector(name)
+
+	findings, err := det.detect(ctx)
+	switch {
+	case err != nil:
+		// don't return internal errors to the customer
+		d.log.Warnf("detector %s: %s", name, err)
+		out.Properties.Status = api.DetectorStatusUnknown
+	case len(findings) > 0:
+		out.Properties.Status = api.DetectorStatusUnhealthy
+		out.Properties.Findings = findings
+	default:
+		out.Properties.Status = api.DetectorStatusHealthy
+	}
+
+	return out, nil
+}
+
+func (d *detectors) detector(name string) *api.Detector {
+	return &api.Detector{
+		ID:   d.oc.ID + "/detectors/" + name,
+		Name: name,
+		Type: d.oc.Type + "/detectors",
+		Properties: api.DetectorProperties{
+			Title:       d.detectors[name].title,
+			Description: d.detectors[name].description,
+		},
+	}
+}
@@ -0,0 +1,116 @@
+package detectors
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+func TestGet(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name       string
+		detectName string
+		findings   []api.DetectorFinding
+		err        error
+		want       *api.Detector
+		wantErr    string
+	}{
+		{
+			name:       "healthy",
+			detectName: "test",
+			want: &api.Detector{
+				ID:   "/subscriptions/subscriptionId/resourceGroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName/detectors/test",
+				Name: "test",
+				Type: "Microsoft.RedHatOpenShift/openShiftClusters/detectors",
+				Properties: api.DetectorProperties{
+					Title:       "title",
+					Description: "description",
+					Status:      api.DetectorStatusHealthy,
+				},
+			},
+		},
+		{
+			name:       "unhealthy",
+			detectName: "Test",
+			findings: []api.DetectorFinding{
+				{
+					Message: "message",
+				},
+			},
+			want: &api.Detector{
+				ID:   "/subscriptions/subscriptionId/resourceGroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName/detectors/test",
+				Name: "test",
+				Type: "Microsoft.RedHatOpenShift/openShiftClusters/detectors",
+				Properties: api.DetectorProperties{
+					Title:       "title",
+					Description: "description",
+					Status:      api.DetectorStatusUnhealthy,
+					Findings: []api.DetectorFinding{
+						{
+							Message: "message",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:       "error is not returned",
+			detectName: "test",
+			err:        errors.New("internal error"),
+			want: &api.Detector{
+				ID:   "/subscriptions/subscriptionId/resourceGroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName/detectors/test",
+				Name: "test",
+				Type: "Microsoft.RedHatOpenShift/openShiftClusters/detectors",
+				Properties: api.DetectorProperties{
+					Title:       "title",
+					Description: "description",
+					Status:      api.DetectorStatusUnknown,
+				},
+			},
+		},
+		{
+			name:       "not found",
+			detectName: "missing",
+			wantErr:    "404: NotFound: : The detector 'missing' was not found.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &detectors{
+				log: logrus.NewEntry(logrus.StandardLogger()),
+				oc: &api.OpenShiftCluster{
+					ID:   "/subscriptions/subscriptionId/resourceGroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName",
+					Type: "Microsoft.RedHatOpenShift/openShiftClusters",
+				},
+				detectors: map[string]*detector{
+					"test": {
+						title:       "title",
+						description: "description",
+						detect: func(context.Context) ([]api.DetectorFinding, error) {
+							return tt.findings, tt.err
+						},
+					},
+				},
+			}
+
+			got, err := d.Get(ctx, tt.detectName)
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Error(got)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package detectors
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/subnet"
+)
+
+func (d *detectors) detectNetworkSecurityGroups(ctx context.Context) ([]api.DetectorFinding, error) {
+	subnetIDs := []string{d.oc.Properties.MasterProfile.SubnetID}
+	for _, wp := range d.oc.Properties.WorkerProfiles {
+		subnetIDs = append(subnetIDs, wp.SubnetID)
+	}
+
+	var findings []api.DetectorFinding
+	seen := map[string]struct{}{}
+
+	for _, subnetID := range subnetIDs {
+		if _, found := seen[strings.ToLower(subnetID)]; found {
+			continue
+		}
+		seen[strings.ToLower(subnetID)] = struct{}{}
+
+		nsgID, err := subnet.NetworkSecurityGroupID(d.oc, subnetID)
+		if err != nil {
+			return nil, err
+		}
+
+		s, err := d.subnets.Get(ctx, subnetID)
+		if err != nil {
+			return nil, err
+		}
+
+		if s.SubnetPropertiesFormat == nil ||
+			s.SubnetPropertiesFormat.NetworkSecurityGroup == nil ||
+			!strings.EqualFold(*s.SubnetPropertiesFormat.NetworkSecurityGroup.ID, nsgID) {
+			findings = append(findings, api.DetectorFinding{
+				Message:     fmt.Sprintf("The subnet '%s' is not attached to the network security group '%s'.", subnetID, nsgID),
+				Remediation: "Reattach the network security group to the subnet.  Network security groups on cluster subnets are managed by Azure Red Hat OpenShift and must not be changed.",
+			})
+		}
+	}
+
+	return findings, nil
+}
@@ -0,0 +1,115 @@
+package detectors
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	mgmtnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-07-01/network"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/golang/mock/gomock"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	mock_subnet "github.com/Azure/ARO-RP/pkg/util/mocks/subnet"
+)
+
+func TestDetectNetworkSecurityGroups(t *testing.T) {
+	ctx := context.Background()
+
+	masterSubnetID := "/subscriptions/subscriptionId/resourceGroups/vnetResourceGroup/providers/Microsoft.Network/virtualNetworks/vnet/subnets/master"
+	workerSubnetID := "/subscriptions/subscriptionId/resourceGroups/vnetResourceGroup/providers/Microsoft.Network/virtualNetworks/vnet/subnets/worker"
+	nsgID := "/subscriptions/subscriptionId/resourceGroups/clusterResourceGroup/providers/Microsoft.Network/networkSecurityGroups/infra-nsg"
+
+	for _, tt := range []struct {
+		name         string
+		workerNSG    *mgmtnetwork.SecurityGroup
+		wantFindings []api.DetectorFinding
+	}{
+		{
+			name: "no drift",
+			workerNSG: &mgmtnetwork.SecurityGroup{
+				ID: to.StringPtr(nsgID),
+			},
+		},
+		{
+			name: "nsg detached",
+			wantFindings: []api.DetectorFinding{
+				{
+					Message:     "The subnet '" + workerSubnetID + "' is not attached to the network security group '" + nsgID + "'.",
+					Remediation: "Reattach the network security group to the subnet.  Network security groups on cluster subnets are managed by Azure Red Hat OpenShift and must not be changed.",
+				},
+			},
+		},
+		{
+			name: "nsg replaced",
+			workerNSG: &mgmtnetwork.SecurityGroup{
+				ID: to.StringPtr("/subscriptions/subscriptionId/resourceGroups/vnetResourceGroup/providers/Microsoft.Network/networkSecurityGroups/custom"),
+			},
+			wantFindings: []api.DetectorFinding{
+				{
+					Message:     "The subnet '" + workerSubnetID + "' is not attached to the network security group '" + nsgID + "'.",
+					Remediation: "Reattach the network security group to the subnet.  Network security groups on cluster subnets are managed by Azure Red Hat OpenShift and must not be changed.",
+				},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			subnets := mock_subnet.NewMockManager(controller)
+			subnets.EXPECT().
+				Get(gomock.Any(), masterSubnetID).
+				Return(&mgmtnetwork.Subnet{
+					SubnetPropertiesFormat: &mgmtnetwork.SubnetPropertiesFormat{
+						NetworkSecurityGroup: &mgmtnetwork.SecurityGroup{
+							ID: to.StringPtr(nsgID),
+						},
+					},
+				}, nil)
+			subnets.EXPECT().
+				Get(gomock.Any(), workerSubnetID).
+				Return(&mgmtnetwork.Subnet{
+					SubnetPropertiesFormat: &mgmtnetwork.SubnetPropertiesFormat{
+						NetworkSecurityGroup: tt.workerNSG,
+					},
+				}, nil)
+
+			d := &detectors{
+				oc: &api.OpenShiftCluster{
+					Properties: api.OpenShiftClusterProperties{
+						ArchitectureVersion: api.ArchitectureVersionV2,
+						InfraID:             "infra",
+						ClusterProfile: api.ClusterProfile{
+							ResourceGroupID: "/subscriptions/subscriptionId/resourceGroups/clusterResourceGroup",
+						},
+						MasterProfile: api.MasterProfile{
+							SubnetID: masterSubnetID,
+						},
+						WorkerProfiles: []api.WorkerProfile{
+							{
+								SubnetID: workerSubnetID,
+							},
+							{
+								SubnetID: workerSubnetID,
+							},
+						},
+					},
+				},
+				subnets: subnets,
+			}
+
+			findings, err := d.detectNetworkSecurityGroups(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(findings, tt.wantFindings) {
+				t.Error(findings)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package detectors
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+func (d *detectors) detectNodeHealth(ctx context.Context) ([]api.DetectorFinding, error) {
+	nodes, err := d.kubernetescli.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var findings []api.DetectorFinding
+	for _, node := range nodes.Items {
+		for _, c := range node.Status.Conditions {
+			switch {
+			case c.Type == corev1.NodeReady && c.Status != corev1.ConditionTrue:
+				findings = append(findings, api.DetectorFinding{
+					Message:     fmt.Sprintf("Node '%s' is not ready: %s", node.Name, c.Message),
+					Remediation: "Check the state of the virtual machine backing the node in the cluster resource group.  If the virtual machine is running, try restarting it.",
+				})
+			case (c.Type == corev1.NodeMemoryPressure ||
+				c.Type == corev1.NodeDiskPressure ||
+				c.Type == corev1.NodePIDPressure) && c.Status == corev1.ConditionTrue:
+				findings = append(findings, api.DetectorFinding{
+					Message:     fmt.Sprintf("Node '%s' has condition %s: %s", node.Name, c.Type, c.Message),
+					Remediation: "Reduce the workload scheduled on the node, or scale up the cluster.",
+				})
+			}
+		}
+	}
+
+	return findings, nil
+}
@@ -0,0 +1,94 @@
+package detectors
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+func TestDetectNodeHealth(t *testing.T) {
+	ctx := context.Background()
+
+	d := &detectors{
+		kubernetescli: fake.NewSimpleClientset(
+			&corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "healthy",
+				},
+				Status: corev1.NodeStatus{
+					Conditions: []corev1.NodeCondition{
+						{
+							Type:   corev1.NodeReady,
+							Status: corev1.ConditionTrue,
+						},
+						{
+							Type:   corev1.NodeMemoryPressure,
+							Status: corev1.ConditionFalse,
+						},
+					},
+				},
+			},
+			&corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "notready",
+				},
+				Status: corev1.NodeStatus{
+					Conditions: []corev1.NodeCondition{
+						{
+							Type:    corev1.NodeReady,
+							Status:  corev1.ConditionUnknown,
+							Message: "Kubelet stopped posting node status.",
+						},
+					},
+				},
+			},
+			&corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "pressure",
+				},
+				Status: corev1.NodeStatus{
+					Conditions: []corev1.NodeCondition{
+						{
+							Type:   corev1.NodeReady,
+							Status: corev1.ConditionTrue,
+						},
+						{
+							Type:    corev1.NodeDiskPressure,
+							Status:  corev1.ConditionTrue,
+							Message: "kubelet has disk pressure",
+						},
+					},
+				},
+			},
+		),
+	}
+
+	findings, err := d.detectNodeHealth(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []api.DetectorFinding{
+		{
+			Message:     "Node 'notready' is not ready: Kubelet stopped posting node status.",
+			Remediation: "Check the state of the virtual machine backing the node in the cluster resource group.  If the virtual machine is running, try restarting it.",
+		},
+		{
+			Message:     "Node 'pressure' has condition DiskPressure: kubelet has disk pressure",
+			Remediation: "Reduce the workload scheduled on the node, or scale up the cluster.",
+		},
+	}
+
+	if !reflect.DeepEqual(findings, want) {
+		t.Error(findings)
+	}
+}
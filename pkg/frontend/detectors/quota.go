@@ -0,0 +1,51 @@
+package detectors
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/validate"
+)
+
+func (d *detectors) detectQuota(ctx context.Context) ([]api.DetectorFinding, error) {
+	requiredResources := map[string]int{}
+	for _, wp := range d.oc.Properties.WorkerProfiles {
+		wpRequiredResources, err := validate.RequiredResources(wp.VMSize, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		for k, v := range wpRequiredResources {
+			if v > requiredResources[k] {
+				requiredResources[k] = v
+			}
+		}
+	}
+
+	usageClient, err := d.newSPUsageClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	usages, err := usageClient.List(ctx, d.oc.Location)
+	if err != nil {
+		return nil, err
+	}
+
+	var findings []api.DetectorFinding
+	for _, usage := range usages {
+		required, present := requiredResources[*usage.Name.Value]
+		if present && int64(required) > (*usage.Limit-int64(*usage.CurrentValue)) {
+			findings = append(findings, api.DetectorFinding{
+				Message:     fmt.Sprintf("There is not enough %s quota in location '%s' to add a worker node.  Maximum allowed: %d, Current in use: %d, Required per node: %d.", *usage.Name.Value, d.oc.Location, *usage.Limit, *usage.CurrentValue, required),
+				Remediation: "Request a quota increase for the subscription before scaling up the cluster.",
+			})
+		}
+	}
+
+	return findings, nil
+}
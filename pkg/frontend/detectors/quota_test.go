@@ -0,0 +1,77 @@
+package detectors
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	mgmtcompute "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/golang/mock/gomock"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/compute"
+	mock_compute "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/mgmt/compute"
+)
+
+func TestDetectQuota(t *testing.T) {
+	ctx := context.Background()
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	usageClient := mock_compute.NewMockUsageClient(controller)
+	usageClient.EXPECT().
+		List(gomock.Any(), "eastus").
+		Return([]mgmtcompute.Usage{
+			{
+				Name: &mgmtcompute.UsageName{
+					Value: to.StringPtr("cores"),
+				},
+				CurrentValue: to.Int32Ptr(98),
+				Limit:        to.Int64Ptr(100),
+			},
+			{
+				Name: &mgmtcompute.UsageName{
+					Value: to.StringPtr("standardDSv3Family"),
+				},
+				CurrentValue: to.Int32Ptr(10),
+				Limit:        to.Int64Ptr(100),
+			},
+		}, nil)
+
+	d := &detectors{
+		oc: &api.OpenShiftCluster{
+			Location: "eastus",
+			Properties: api.OpenShiftClusterProperties{
+				WorkerProfiles: []api.WorkerProfile{
+					{
+						VMSize: api.VMSizeStandardD4sV3,
+					},
+				},
+			},
+		},
+		newSPUsageClient: func(context.Context) (compute.UsageClient, error) {
+			return usageClient, nil
+		},
+	}
+
+	findings, err := d.detectQuota(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []api.DetectorFinding{
+		{
+			Message:     "There is not enough cores quota in location 'eastus' to add a worker node.  Maximum allowed: 100, Current in use: 98, Required per node: 4.",
+			Remediation: "Request a quota increase for the subscription before scaling up the cluster.",
+		},
+	}
+
+	if !reflect.DeepEqual(findings, want) {
+		t.Error(findings)
+	}
+}
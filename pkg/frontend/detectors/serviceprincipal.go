@@ -0,0 +1,61 @@
+package detectors
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/aad"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/compute"
+	"github.com/Azure/ARO-RP/pkg/util/refreshable"
+)
+
+// servicePrincipalTimeout bounds how long a detector request waits for the
+// service principal to authenticate, which aad.GetToken otherwise retries for
+// some time
+const servicePrincipalTimeout = 30 * time.Second
+
+func (d *detectors) getSPToken(ctx context.Context) (refreshable.Authorizer, error) {
+	ctx, cancel := context.WithTimeout(ctx, servicePrincipalTimeout)
+	defer cancel()
+
+	token, err := aad.GetToken(ctx, d.log, d.oc, d.env.Environment().ResourceManagerEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return refreshable.NewAuthorizer(token), nil
+}
+
+func (d *detectors) detectServicePrincipal(ctx context.Context) ([]api.DetectorFinding, error) {
+	_, err := d.getSPToken(ctx)
+	if err != nil {
+		return []api.DetectorFinding{
+			{
+				Message:     "The cluster service principal could not authenticate.",
+				Remediation: "Check that the service principal with client ID '" + d.oc.Properties.ServicePrincipalProfile.ClientID + "' still exists and that its client secret has not expired.  If the secret has expired, create a new one and update the cluster with it.",
+			},
+		}, nil
+	}
+
+	return nil, nil
+}
+
+func (d *detectors) _newSPUsageClient(ctx context.Context) (compute.UsageClient, error) {
+	r, err := azure.ParseResourceID(d.oc.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	spAuthorizer, err := d.getSPToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return compute.NewUsageClient(r.SubscriptionID, spAuthorizer), nil
+}
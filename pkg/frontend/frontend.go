@@ -22,10 +22,14 @@ import (
 	"github.com/Azure/ARO-RP/pkg/database"
 	"github.com/Azure/ARO-RP/pkg/env"
 	"github.com/Azure/ARO-RP/pkg/frontend/adminactions"
+	"github.com/Azure/ARO-RP/pkg/frontend/detectors"
 	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
 	"github.com/Azure/ARO-RP/pkg/metrics"
+	"github.com/Azure/ARO-RP/pkg/util/archive"
 	"github.com/Azure/ARO-RP/pkg/util/bucket"
 	"github.com/Azure/ARO-RP/pkg/util/clusterdata"
+	"github.com/Azure/ARO-RP/pkg/util/deletepreflight"
+	"github.com/Azure/ARO-RP/pkg/util/egress"
 	"github.com/Azure/ARO-RP/pkg/util/encryption"
 	"github.com/Azure/ARO-RP/pkg/util/heartbeat"
 	"github.com/Azure/ARO-RP/pkg/util/recover"
@@ -47,13 +51,28 @@ type frontend struct {
 	dbAsyncOperations   database.AsyncOperations
 	dbOpenShiftClusters database.OpenShiftClusters
 	dbSubscriptions     database.Subscriptions
+	dbOpenShiftVersions database.OpenShiftVersions
 
-	apis   map[string]*api.Version
-	m      metrics.Interface
-	cipher encryption.Cipher
+	dbClusterInventories database.ClusterInventories
 
-	ocEnricher          clusterdata.OpenShiftClusterEnricher
-	adminActionsFactory adminActionsFactory
+	apis         map[string]*api.Version
+	deprecations map[string]middleware.Deprecation
+	throttles    map[string]middleware.ClientThrottle
+	quotas       *clusterQuotas
+	readOnly     bool
+	m            metrics.Interface
+	cipher       encryption.Cipher
+
+	ocEnricher             clusterdata.OpenShiftClusterEnricher
+	adminActionsFactory    adminActionsFactory
+	detectorsFactory       detectorsFactory
+	egressFactory          egressFactory
+	deletePreflightFactory deletePreflightFactory
+	archive                archive.Manager
+
+	// completedAsyncOperationTTL is the time in seconds for which an
+	// asyncOperation restored from the archive is kept in the database
+	completedAsyncOperationTTL int
 
 	l net.Listener
 	s *http.Server
@@ -76,6 +95,8 @@ func NewFrontend(ctx context.Context,
 	dbAsyncOperations database.AsyncOperations,
 	dbOpenShiftClusters database.OpenShiftClusters,
 	dbSubscriptions database.Subscriptions,
+	dbOpenShiftVersions database.OpenShiftVersions,
+	dbClusterInventories database.ClusterInventories,
 	apis map[string]*api.Version,
 	m metrics.Interface,
 	cipher encryption.Cipher,
@@ -86,24 +107,62 @@ func NewFrontend(ctx context.Context,
 		dbAsyncOperations:   dbAsyncOperations,
 		dbOpenShiftClusters: dbOpenShiftClusters,
 		dbSubscriptions:     dbSubscriptions,
-		apis:                apis,
-		m:                   m,
-		cipher:              cipher,
-		adminActionsFactory: adminActionsFactory,
+		dbOpenShiftVersions: dbOpenShiftVersions,
+
+		dbClusterInventories: dbClusterInventories,
+		apis:                 apis,
+		m:                    m,
+		cipher:               cipher,
+		adminActionsFactory:  adminActionsFactory,
 
-		ocEnricher: clusterdata.NewBestEffortEnricher(baseLog, _env, m),
+		ocEnricher:       clusterdata.NewBestEffortEnricher(baseLog, _env, m),
+		detectorsFactory: detectors.New,
+		egressFactory:    egress.New,
+
+		deletePreflightFactory: deletepreflight.New,
 
 		bucketAllocator: &bucket.Random{},
 
 		startTime: time.Now(),
 	}
 
+	var err error
+	f.deprecations, err = loadDeprecations(apis)
+	if err != nil {
+		return nil, err
+	}
+
+	f.throttles, err = loadClientThrottles()
+	if err != nil {
+		return nil, err
+	}
+
+	f.quotas, err = loadClusterQuotas()
+	if err != nil {
+		return nil, err
+	}
+
+	f.readOnly = readOnlyMode()
+	if f.readOnly {
+		f.baseLog.Warn("serving in read-only mode")
+	}
+
+	f.archive, err = archive.NewManager(ctx, _env)
+	if err != nil {
+		return nil, err
+	}
+
+	f.completedAsyncOperationTTL, err = database.CompletedAsyncOperationTTL()
+	if err != nil {
+		return nil, err
+	}
+
 	l, err := f.env.Listen()
 	if err != nil {
 		return nil, err
 	}
 
-	key, certs, err := f.env.ServiceKeyvault().GetCertificateSecret(ctx, env.RPServerSecretName)
+	key, certs, err := f.env.ServiceSecrets().GetCertificateSecret(ctx, env.RPServerSecretName)
 	if err != nil {
 		return nil, err
 	}
@@ -145,6 +204,11 @@ func NewFrontend(ctx context.Context,
 
 func (f *frontend) unauthenticatedRoutes(r *mux.Router) {
 	r.Path("/healthz/ready").Methods(http.MethodGet).HandlerFunc(f.getReady).Name("getReady")
+
+	// the ARO operator of each cluster authenticates with its inventory client
+	// certificate
+	r.Path("/inventory/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}").
+		Methods(http.MethodPost).HandlerFunc(f.postOpenShiftClusterInventory).Name("postOpenShiftClusterInventory")
 }
 
 func (f *frontend) authenticatedRoutes(r *mux.Router) {
@@ -193,6 +257,49 @@ func (f *frontend) authenticatedRoutes(r *mux.Router) {
 
 	s.Methods(http.MethodPost).HandlerFunc(f.postOpenShiftClusterCredentials).Name("postOpenShiftClusterCredentials")
 
+	s = r.
+		Path("/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/deletepreflight").
+		Queries("api-version", "{api-version}").
+		Subrouter()
+
+	s.Methods(http.MethodPost).HandlerFunc(f.postOpenShiftClusterDeletePreflight).Name("postOpenShiftClusterDeletePreflight")
+
+	s = r.
+		Path("/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/detectors").
+		Queries("api-version", "{api-version}").
+		Subrouter()
+
+	s.Methods(http.MethodGet).HandlerFunc(f.getOpenShiftClusterDetectors).Name("getOpenShiftClusterDetectors")
+
+	s = r.
+		Path("/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/detectors/{detectorName}").
+		Queries("api-version", "{api-version}").
+		Subrouter()
+
+	s.Methods(http.MethodGet).HandlerFunc(f.getOpenShiftClusterDetector).Name("getOpenShiftClusterDetector")
+
+	s = r.
+		Path("/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/egress").
+		Queries("api-version", "{api-version}").
+		Subrouter()
+
+	s.Methods(http.MethodGet).HandlerFunc(f.getOpenShiftClusterEgress).Name("getOpenShiftClusterEgress")
+
+	s = r.
+		Path("/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/failuresummary").
+		Queries("api-version", "{api-version}").
+		Subrouter()
+
+	s.Methods(http.MethodGet).HandlerFunc(f.getOpenShiftClusterFailureSummary).Name("getOpenShiftClusterFailureSummary")
+
+	s = r.
+		Path("/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/workerprofiles/{workerProfileName}").
+		Queries("api-version", "{api-version}").
+		Subrouter()
+
+	s.Methods(http.MethodDelete).HandlerFunc(f.deleteOpenShiftClusterWorkerProfile).Name("deleteOpenShiftClusterWorkerProfile")
+	s.Methods(http.MethodPost).HandlerFunc(f.postOpenShiftClusterWorkerProfile).Name("postOpenShiftClusterWorkerProfile")
+
 	// Admin actions
 	s = r.
 		Path("/admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/kubernetesobjects").
@@ -214,17 +321,165 @@ func (f *frontend) authenticatedRoutes(r *mux.Router) {
 
 	s.Methods(http.MethodGet).HandlerFunc(f.getAdminOpenShiftClusterSerialConsole).Name("getAdminOpenShiftClusterSerialConsole")
 
+	s = r.
+		Path("/admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/podlogs").
+		Subrouter()
+
+	s.Methods(http.MethodGet).HandlerFunc(f.getAdminOpenShiftClusterPodLogs).Name("getAdminOpenShiftClusterPodLogs")
+
+	s = r.
+		Path("/admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/proxy").
+		Subrouter()
+
+	s.Methods(http.MethodGet).HandlerFunc(f.getAdminOpenShiftClusterProxy).Name("getAdminOpenShiftClusterProxy")
+
+	s = r.
+		Path("/admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/exec").
+		Subrouter()
+
+	s.Methods(http.MethodGet).HandlerFunc(f.getAdminOpenShiftClusterExec).Name("getAdminOpenShiftClusterExec")
+
+	s = r.
+		Path("/admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/attach").
+		Subrouter()
+
+	s.Methods(http.MethodGet).HandlerFunc(f.getAdminOpenShiftClusterAttach).Name("getAdminOpenShiftClusterAttach")
+
+	s = r.
+		Path("/admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/events").
+		Subrouter()
+
+	s.Methods(http.MethodGet).HandlerFunc(f.getAdminOpenShiftClusterEvents).Name("getAdminOpenShiftClusterEvents")
+
 	s = r.
 		Path("/admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/redeployvm").
 		Subrouter()
 
-	s.Methods(http.MethodPost).HandlerFunc(f.postAdminOpenShiftClusterRedeployVM).Name("postAdminOpenShiftClusterRedeployVM")
+	s.Methods(http.MethodPost).HandlerFunc(f.idempotent(f.postAdminOpenShiftClusterRedeployVM)).Name("postAdminOpenShiftClusterRedeployVM")
 
 	s = r.
 		Path("/admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/upgrade").
 		Subrouter()
 
-	s.Methods(http.MethodPost).HandlerFunc(f.postAdminOpenShiftUpgrade).Name("postAdminOpenShiftUpgrade")
+	s.Methods(http.MethodPost).HandlerFunc(f.idempotent(f.postAdminOpenShiftUpgrade)).Name("postAdminOpenShiftUpgrade")
+
+	s = r.
+		Path("/admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/asyncoperations").
+		Subrouter()
+
+	s.Methods(http.MethodGet).HandlerFunc(f.listAdminOpenShiftClusterAsyncOperations).Name("listAdminOpenShiftClusterAsyncOperations")
+
+	s = r.
+		Path("/admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/asyncoperations/{operationId}/replay").
+		Subrouter()
+
+	s.Methods(http.MethodPost).HandlerFunc(f.postAdminOpenShiftClusterReplayAsyncOperation).Name("postAdminOpenShiftClusterReplayAsyncOperation")
+
+	s = r.
+		Path("/admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/whatif").
+		Subrouter()
+
+	s.Methods(http.MethodGet).HandlerFunc(f.getAdminOpenShiftClusterWhatIf).Name("getAdminOpenShiftClusterWhatIf")
+
+	s = r.
+		Path("/admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/drift").
+		Subrouter()
+
+	s.Methods(http.MethodGet).HandlerFunc(f.getAdminOpenShiftClusterDrift).Name("getAdminOpenShiftClusterDrift")
+
+	s = r.
+		Path("/admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/history").
+		Subrouter()
+
+	s.Methods(http.MethodGet).HandlerFunc(f.getAdminOpenShiftClusterHistory).Name("getAdminOpenShiftClusterHistory")
+
+	s = r.
+		Path("/admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/restoresnapshot").
+		Subrouter()
+
+	s.Methods(http.MethodPost).HandlerFunc(f.idempotent(f.postAdminOpenShiftClusterRestoreSnapshot)).Name("postAdminOpenShiftClusterRestoreSnapshot")
+
+	s = r.
+		Path("/admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/operatorflags").
+		Subrouter()
+
+	s.Methods(http.MethodGet).HandlerFunc(f.getAdminOpenShiftClusterOperatorFlags).Name("getAdminOpenShiftClusterOperatorFlags")
+	s.Methods(http.MethodPatch).HandlerFunc(f.patchAdminOpenShiftClusterOperatorFlags).Name("patchAdminOpenShiftClusterOperatorFlags")
+
+	s = r.
+		Path("/admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/resizeworkerdisks").
+		Subrouter()
+
+	s.Methods(http.MethodPost).HandlerFunc(f.idempotent(f.postAdminOpenShiftClusterResizeWorkerDisks)).Name("postAdminOpenShiftClusterResizeWorkerDisks")
+
+	s = r.
+		Path("/admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/rotatesshkey").
+		Subrouter()
+
+	s.Methods(http.MethodPost).HandlerFunc(f.idempotent(f.postAdminOpenShiftClusterRotateSSHKey)).Name("postAdminOpenShiftClusterRotateSSHKey")
+
+	s = r.
+		Path("/admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/refreshcredentials").
+		Subrouter()
+
+	s.Methods(http.MethodPost).HandlerFunc(f.idempotent(f.postAdminOpenShiftClusterRefreshCredentials)).Name("postAdminOpenShiftClusterRefreshCredentials")
+
+	s = r.
+		Path("/admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/reimagemachine").
+		Subrouter()
+
+	s.Methods(http.MethodPost).HandlerFunc(f.idempotent(f.postAdminOpenShiftClusterReimageMachine)).Name("postAdminOpenShiftClusterReimageMachine")
+
+	s = r.
+		Path("/admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/repairprivateendpoint").
+		Subrouter()
+
+	s.Methods(http.MethodPost).HandlerFunc(f.idempotent(f.postAdminOpenShiftClusterRepairPrivateEndpoint)).Name("postAdminOpenShiftClusterRepairPrivateEndpoint")
+
+	s = r.
+		Path("/admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/runchecks").
+		Subrouter()
+
+	s.Methods(http.MethodPost).HandlerFunc(f.postAdminOpenShiftClusterRunChecks).Name("postAdminOpenShiftClusterRunChecks")
+
+	s = r.
+		Path("/admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/clusterhealth").
+		Subrouter()
+
+	s.Methods(http.MethodGet).HandlerFunc(f.getAdminOpenShiftClusterClusterHealth).Name("getAdminOpenShiftClusterClusterHealth")
+
+	s = r.
+		Path("/admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/rebootnodes").
+		Subrouter()
+
+	s.Methods(http.MethodGet).HandlerFunc(f.getAdminOpenShiftClusterRebootNodes).Name("getAdminOpenShiftClusterRebootNodes")
+	s.Methods(http.MethodPost).HandlerFunc(f.idempotent(f.postAdminOpenShiftClusterRebootNodes)).Name("postAdminOpenShiftClusterRebootNodes")
+
+	s = r.
+		Path("/admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/drmetadata").
+		Subrouter()
+
+	s.Methods(http.MethodGet).HandlerFunc(f.getAdminOpenShiftClusterDRMetadata).Name("getAdminOpenShiftClusterDRMetadata")
+	s.Methods(http.MethodPut).HandlerFunc(f.putAdminOpenShiftClusterDRMetadata).Name("putAdminOpenShiftClusterDRMetadata")
+
+	s = r.
+		Path("/admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/example").
+		Subrouter()
+
+	s.Methods(http.MethodGet).HandlerFunc(f.getAdminOpenShiftClusterExample).Name("getAdminOpenShiftClusterExample")
+
+	s = r.
+		Path("/admin/versions").
+		Subrouter()
+
+	s.Methods(http.MethodGet).HandlerFunc(f.getAdminOpenShiftVersions).Name("getAdminOpenShiftVersions")
+	s.Methods(http.MethodPut).HandlerFunc(f.putAdminOpenShiftVersion).Name("putAdminOpenShiftVersion")
+
+	s = r.
+		Path("/admin/asyncoperationfailures").
+		Subrouter()
+
+	s.Methods(http.MethodGet).HandlerFunc(f.getAdminAsyncOperationFailures).Name("getAdminAsyncOperationFailures")
 
 	s = r.
 		Path("/admin/providers/{resourceProviderNamespace}/{resourceType}").
@@ -232,6 +487,12 @@ func (f *frontend) authenticatedRoutes(r *mux.Router) {
 
 	s.Methods(http.MethodGet).HandlerFunc(f.getAdminOpenShiftClusters).Name("getAdminOpenShiftClusters")
 
+	s = r.
+		Path("/admin/providers/{resourceProviderNamespace}/{resourceType}/batchget").
+		Subrouter()
+
+	s.Methods(http.MethodPost).HandlerFunc(f.postAdminOpenShiftClustersBatchGet).Name("postAdminOpenShiftClustersBatchGet")
+
 	// Operations
 	s = r.
 		Path("/providers/{resourceProviderNamespace}/operations").
@@ -253,9 +514,15 @@ func (f *frontend) setupRouter() *mux.Router {
 	r.Use(middleware.Log(f.baseLog.WithField("component", "access")))
 	r.Use(middleware.Metrics(f.m))
 	r.Use(middleware.Panic)
-	r.Use(middleware.Headers(f.env.DeploymentMode()))
+	r.Use(middleware.ClientThrottles(f.m, f.throttles))
+	r.Use(middleware.Limits(f.m, defaultLimit, routeLimits))
+	r.Use(middleware.Headers(f.env))
+	r.Use(middleware.ReadOnly(f.m, f.readOnly, readOnlyRoutes))
+	r.Use(middleware.Gzip)
+	r.Use(middleware.Deprecations(f.m, f.deprecations))
 	r.Use(middleware.Validate(f.env, f.apis))
 	r.Use(middleware.Body)
+	r.Use(middleware.CachedReads)
 
 	r.NotFoundHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -294,11 +561,12 @@ func (f *frontend) Run(ctx context.Context, stop <-chan struct{}, done chan<- st
 	}
 
 	f.s = &http.Server{
-		Handler:     middleware.Lowercase(f.setupRouter()),
-		ReadTimeout: 10 * time.Second,
-		IdleTimeout: 2 * time.Minute,
-		ErrorLog:    log.New(f.baseLog.Writer(), "", 0),
-		BaseContext: func(net.Listener) context.Context { return ctx },
+		Handler:           middleware.Lowercase(f.setupRouter()),
+		ReadHeaderTimeout: 5 * time.Second,
+		ReadTimeout:       10 * time.Second,
+		IdleTimeout:       2 * time.Minute,
+		ErrorLog:          log.New(f.baseLog.Writer(), "", 0),
+		BaseContext:       func(net.Listener) context.Context { return ctx },
 	}
 
 	go heartbeat.EmitHeartbeat(f.baseLog, f.m, "frontend.heartbeat", stop, f.checkReady)
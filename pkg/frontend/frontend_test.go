@@ -23,6 +23,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/frontend/adminactions"
 	"github.com/Azure/ARO-RP/pkg/util/deployment"
 	mock_env "github.com/Azure/ARO-RP/pkg/util/mocks/env"
 	testlog "github.com/Azure/ARO-RP/test/util/log"
@@ -177,6 +178,8 @@ func TestRoutesAreNamedWithLowerCasePaths(t *testing.T) {
 
 	_env := mock_env.NewMockInterface(controller)
 	_env.EXPECT().DeploymentMode().AnyTimes().Return(deployment.Production)
+	_env.EXPECT().IsLocalDevelopment().AnyTimes().Return(false)
+	_env.EXPECT().RelaxedVMSizeValidation().AnyTimes().Return(false)
 
 	f := &frontend{
 		baseLog: logrus.NewEntry(logrus.StandardLogger()),
@@ -214,3 +217,82 @@ func TestRoutesAreNamedWithLowerCasePaths(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestReadOnlyRoutesArePostRoutes(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	_env := mock_env.NewMockInterface(controller)
+	_env.EXPECT().DeploymentMode().AnyTimes().Return(deployment.Production)
+	_env.EXPECT().IsLocalDevelopment().AnyTimes().Return(false)
+	_env.EXPECT().RelaxedVMSizeValidation().AnyTimes().Return(false)
+
+	f := &frontend{
+		baseLog: logrus.NewEntry(logrus.StandardLogger()),
+		env:     _env,
+	}
+	router := f.setupRouter()
+
+	found := map[string]bool{}
+	err := router.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		methods, err := route.GetMethods()
+		if err != nil {
+			return nil
+		}
+
+		if readOnlyRoutes[route.GetName()] {
+			if len(methods) != 1 || methods[0] != http.MethodPost {
+				t.Errorf("%s: %v", route.GetName(), methods)
+			}
+			found[route.GetName()] = true
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name := range readOnlyRoutes {
+		if !found[name] {
+			t.Errorf("route %s not found", name)
+		}
+	}
+}
+
+func TestRouteLimits(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	_env := mock_env.NewMockInterface(controller)
+	_env.EXPECT().DeploymentMode().AnyTimes().Return(deployment.Production)
+	_env.EXPECT().IsLocalDevelopment().AnyTimes().Return(false)
+	_env.EXPECT().RelaxedVMSizeValidation().AnyTimes().Return(false)
+
+	f := &frontend{
+		baseLog: logrus.NewEntry(logrus.StandardLogger()),
+		env:     _env,
+	}
+	router := f.setupRouter()
+
+	found := map[string]bool{}
+	err := router.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		found[route.GetName()] = true
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name := range routeLimits {
+		if !found[name] {
+			t.Errorf("route %s not found", name)
+		}
+	}
+
+	// the request must outlive the wait for the operator, so that a slow check
+	// run is reported as such rather than cut off
+	if routeLimits["postAdminOpenShiftClusterRunChecks"].Timeout <= adminactions.CheckTimeout {
+		t.Error(routeLimits["postAdminOpenShiftClusterRunChecks"].Timeout)
+	}
+}
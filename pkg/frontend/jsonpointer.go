@@ -0,0 +1,160 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+// additionalInfoTypeJSONPointer is the type of the error additional
+// information which locates the error target in the request body
+const additionalInfoTypeJSONPointer = "JsonPointer"
+
+type targetSegment struct {
+	key   string // object member
+	index int    // array element by position, if key and name are empty
+	name  string // array element by its "name" property
+}
+
+// withTargetJSONPointer adds the RFC 6901 JSON pointer of the target of err
+// within body to err's additional information, so that clients can point at
+// the exact property in error.  Other errors are returned unchanged.
+func withTargetJSONPointer(err error, body []byte) error {
+	cloudErr, ok := err.(*api.CloudError)
+	if !ok || cloudErr.CloudErrorBody == nil || cloudErr.Target == "" {
+		return err
+	}
+
+	pointer, ok := targetJSONPointer(body, cloudErr.Target)
+	if !ok {
+		return err
+	}
+
+	cloudErr.AdditionalInfo = append(cloudErr.AdditionalInfo, api.CloudErrorAdditionalInfo{
+		Type: additionalInfoTypeJSONPointer,
+		Info: map[string]string{
+			"pointer": pointer,
+		},
+	})
+
+	return cloudErr
+}
+
+// targetJSONPointer converts an error target such as
+// "properties.workerProfiles['worker'].vmSize" to a JSON pointer into body.
+// Array elements selected by name are resolved to their index in body; object
+// members are matched case-insensitively, as ARM does.  Members missing
+// from body are kept, so that the pointer locates a missing property.
+func targetJSONPointer(body []byte, target string) (string, bool) {
+	segments, ok := parseTarget(target)
+	if !ok {
+		return "", false
+	}
+
+	var cur interface{}
+	_ = json.Unmarshal(body, &cur)
+
+	var sb strings.Builder
+	for _, s := range segments {
+		switch {
+		case s.key != "":
+			key := s.key
+			m, _ := cur.(map[string]interface{})
+			cur = nil
+			for k, v := range m {
+				if strings.EqualFold(k, s.key) {
+					key, cur = k, v
+					break
+				}
+			}
+
+			sb.WriteString("/" + escapeJSONPointer(key))
+
+		case s.name != "":
+			a, _ := cur.([]interface{})
+			cur = nil
+			index := -1
+			for i, v := range a {
+				if m, ok := v.(map[string]interface{}); ok && m["name"] == s.name {
+					index, cur = i, v
+					break
+				}
+			}
+			if index == -1 {
+				return "", false
+			}
+
+			sb.WriteString("/" + strconv.Itoa(index))
+
+		default:
+			a, _ := cur.([]interface{})
+			cur = nil
+			if s.index < len(a) {
+				cur = a[s.index]
+			}
+
+			sb.WriteString("/" + strconv.Itoa(s.index))
+		}
+	}
+
+	return sb.String(), true
+}
+
+// parseTarget splits an error target into its segments.  Targets are dotted
+// property names with optional [0], ['name'] or ["key"] selectors.
+func parseTarget(target string) ([]targetSegment, bool) {
+	var segments []targetSegment
+
+	for len(target) > 0 {
+		switch target[0] {
+		case '.':
+			target = target[1:]
+
+		case '[':
+			end := strings.IndexByte(target, ']')
+			if end == -1 {
+				return nil, false
+			}
+			sel := target[1:end]
+			target = target[end+1:]
+
+			switch {
+			case len(sel) >= 2 && sel[0] == '\'' && sel[len(sel)-1] == '\'':
+				segments = append(segments, targetSegment{name: sel[1 : len(sel)-1]})
+
+			case len(sel) >= 2 && sel[0] == '"':
+				key, err := strconv.Unquote(sel)
+				if err != nil || key == "" {
+					return nil, false
+				}
+				segments = append(segments, targetSegment{key: key})
+
+			default:
+				index, err := strconv.Atoi(sel)
+				if err != nil || index < 0 {
+					return nil, false
+				}
+				segments = append(segments, targetSegment{index: index})
+			}
+
+		default:
+			end := strings.IndexAny(target, ".[")
+			if end == -1 {
+				end = len(target)
+			}
+			segments = append(segments, targetSegment{key: target[:end]})
+			target = target[end:]
+		}
+	}
+
+	return segments, len(segments) > 0
+}
+
+func escapeJSONPointer(s string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(s)
+}
@@ -0,0 +1,99 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+func TestTargetJSONPointer(t *testing.T) {
+	body := []byte(`{
+	"location": "eastus",
+	"Properties": {
+		"workerProfiles": [
+			{"name": "worker", "vmSize": "Standard_D2s_v3"},
+			{"name": "infra", "vmSize": "Standard_D2s_v3"}
+		],
+		"tags": {"a/b": "c"}
+	}
+}`)
+
+	for _, tt := range []struct {
+		name        string
+		target      string
+		wantPointer string
+		wantOK      bool
+	}{
+		{
+			name:        "top level",
+			target:      "location",
+			wantPointer: "/location",
+			wantOK:      true,
+		},
+		{
+			name:        "case insensitive member",
+			target:      "properties.workerProfiles",
+			wantPointer: "/Properties/workerProfiles",
+			wantOK:      true,
+		},
+		{
+			name:        "element by name",
+			target:      "properties.workerProfiles['infra'].vmSize",
+			wantPointer: "/Properties/workerProfiles/1/vmSize",
+			wantOK:      true,
+		},
+		{
+			name:        "element by index",
+			target:      "properties.workerProfiles[0].vmSize",
+			wantPointer: "/Properties/workerProfiles/0/vmSize",
+			wantOK:      true,
+		},
+		{
+			name:        "map key is escaped",
+			target:      `properties.tags["a/b"]`,
+			wantPointer: "/Properties/tags/a~1b",
+			wantOK:      true,
+		},
+		{
+			name:        "missing property",
+			target:      "properties.clusterProfile.pullSecret",
+			wantPointer: "/Properties/clusterProfile/pullSecret",
+			wantOK:      true,
+		},
+		{
+			name:   "unknown element name",
+			target: "properties.workerProfiles['other'].vmSize",
+		},
+		{
+			name:   "malformed",
+			target: "properties.workerProfiles[0",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			pointer, ok := targetJSONPointer(body, tt.target)
+			if pointer != tt.wantPointer || ok != tt.wantOK {
+				t.Error(pointer, ok)
+			}
+		})
+	}
+}
+
+func TestWithTargetJSONPointer(t *testing.T) {
+	err := withTargetJSONPointer(api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "location", "The provided location '%s' is invalid.", "nowhere"), []byte(`{"location":"nowhere"}`))
+
+	if !reflect.DeepEqual(err.(*api.CloudError).AdditionalInfo, []api.CloudErrorAdditionalInfo{
+		{
+			Type: additionalInfoTypeJSONPointer,
+			Info: map[string]string{
+				"pointer": "/location",
+			},
+		},
+	}) {
+		t.Error(err.(*api.CloudError).AdditionalInfo)
+	}
+}
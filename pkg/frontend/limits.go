@@ -0,0 +1,75 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/frontend/adminactions"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+// defaultLimit applies to every route not listed in routeLimits.  ARM expects
+// a response within a minute, and request bodies are small.
+var defaultLimit = middleware.Limit{
+	Timeout:       time.Minute,
+	MaxBodyBytes:  1048576,
+	MaxConcurrent: 200,
+}
+
+// routeLimits holds the limits for routes whose requests are unusually long,
+// large or expensive
+var routeLimits = map[string]middleware.Limit{
+	// streams the boot diagnostics blob from the cluster's storage account
+	"getAdminOpenShiftClusterSerialConsole": {
+		Timeout:       5 * time.Minute,
+		MaxConcurrent: 10,
+	},
+	// stream pod logs and events for as long as the SRE follows them
+	"getAdminOpenShiftClusterPodLogs": {
+		Timeout:       30 * time.Minute,
+		MaxConcurrent: 10,
+	},
+	"getAdminOpenShiftClusterEvents": {
+		Timeout:       30 * time.Minute,
+		MaxConcurrent: 10,
+	},
+	// interactive sessions last as long as the SRE keeps them open
+	"getAdminOpenShiftClusterExec": {
+		Timeout:       time.Hour,
+		MaxConcurrent: 10,
+	},
+	"getAdminOpenShiftClusterAttach": {
+		Timeout:       time.Hour,
+		MaxConcurrent: 10,
+	},
+	// dashboards load many resources at once, and some queries are slow
+	"getAdminOpenShiftClusterProxy": {
+		Timeout:       5 * time.Minute,
+		MaxConcurrent: 20,
+	},
+	// lists, e.g., all the pods of a cluster
+	"getAdminKubernetesObjects": {
+		Timeout:       2 * time.Minute,
+		MaxConcurrent: 20,
+	},
+	"postAdminKubernetesObjects": {
+		MaxConcurrent: 20,
+	},
+	// lists every resource in the cluster resource group
+	"listAdminOpenShiftClusterResources": {
+		Timeout:       2 * time.Minute,
+		MaxConcurrent: 20,
+	},
+	// waits for the operator to run the checks, with time to spare to report
+	// that it didn't
+	"postAdminOpenShiftClusterRunChecks": {
+		Timeout:       adminactions.CheckTimeout + time.Minute,
+		MaxConcurrent: 20,
+	},
+	// queries several Azure APIs and the cluster
+	"getOpenShiftClusterDetector": {
+		MaxConcurrent: 50,
+	},
+}
@@ -20,8 +20,10 @@ func Authenticated(env env.Interface) func(http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			vars := mux.Vars(r)
 
+			isAdmin := vars["api-version"] == admin.APIVersion || strings.HasPrefix(r.URL.Path, "/admin")
+
 			var clientAuthorizer clientauthorizer.ClientAuthorizer
-			if vars["api-version"] == admin.APIVersion || strings.HasPrefix(r.URL.Path, "/admin") {
+			if isAdmin {
 				clientAuthorizer = env.AdminClientAuthorizer()
 			} else {
 				clientAuthorizer = env.ArmClientAuthorizer()
@@ -32,6 +34,18 @@ func Authenticated(env env.Interface) func(http.Handler) http.Handler {
 				return
 			}
 
+			if isAdmin {
+				var action string
+				if route := mux.CurrentRoute(r); route != nil {
+					action = route.GetName()
+				}
+
+				if !env.AdminPolicyAuthorizer().IsAuthorized(r, action) {
+					api.WriteError(w, http.StatusForbidden, api.CloudErrorCodeForbidden, "", "Forbidden.")
+					return
+				}
+			}
+
 			h.ServeHTTP(w, r)
 		})
 	}
@@ -12,11 +12,18 @@ import (
 	"github.com/Azure/ARO-RP/pkg/api"
 )
 
+const defaultMaxBodyBytes = 1048576
+
 func Body(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
 		case http.MethodPatch, http.MethodPost, http.MethodPut:
-			body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, 1048576))
+			maxBodyBytes := int64(defaultMaxBodyBytes)
+			if n, ok := r.Context().Value(ContextKeyMaxBodyBytes).(int64); ok {
+				maxBodyBytes = n
+			}
+
+			body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxBodyBytes))
 			if err != nil {
 				api.WriteError(w, http.StatusBadRequest, api.CloudErrorCodeInvalidResource, "", "The resource definition is invalid.")
 				return
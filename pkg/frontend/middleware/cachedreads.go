@@ -0,0 +1,26 @@
+package middleware
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/Azure/ARO-RP/pkg/database"
+)
+
+// CachedReads lets admin GETs read cluster documents from the frontend's
+// cache.  ARM requests always read them from the database: the cache only
+// sees a write made through another frontend once it next reads the change
+// feed, and a client polling its PUT or PATCH must never be shown the
+// cluster's previous state.
+func CachedReads(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/admin/") {
+			r = r.WithContext(database.WithCachedReads(r.Context()))
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
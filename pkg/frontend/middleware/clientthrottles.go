@@ -0,0 +1,61 @@
+package middleware
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"golang.org/x/time/rate"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/metrics"
+)
+
+// ClientThrottle is the request rate allowed to a client application
+type ClientThrottle struct {
+	// RequestsPerSecond is the sustained request rate which is accepted
+	RequestsPerSecond float64 `json:"requestsPerSecond,omitempty"`
+
+	// Burst is the number of requests which may exceed the sustained rate at
+	// once
+	Burst int `json:"burst,omitempty"`
+}
+
+// ClientThrottles limits the request rate of the client applications listed in
+// throttles, keyed by lower case AAD application ID as sent by ARM in the
+// X-Ms-Client-App-Id header.  It is intended to rein in misbehaving automation
+// without affecting other callers; requests beyond the rate are rejected with
+// a 429 and counted.
+func ClientThrottles(m metrics.Interface, throttles map[string]ClientThrottle) func(http.Handler) http.Handler {
+	limiters := make(map[string]*rate.Limiter, len(throttles))
+	for clientAppID, t := range throttles {
+		limiters[strings.ToLower(clientAppID)] = rate.NewLimiter(rate.Limit(t.RequestsPerSecond), t.Burst)
+	}
+
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			clientAppID := strings.ToLower(r.Header.Get("X-Ms-Client-App-Id"))
+
+			l, found := limiters[clientAppID]
+			if !found || l.Allow() {
+				h.ServeHTTP(w, r)
+				return
+			}
+
+			var routeName string
+			if route := mux.CurrentRoute(r); route != nil {
+				routeName = route.GetName()
+			}
+
+			m.EmitGauge("frontend.client.throttled.count", 1, map[string]string{
+				"client-app-id": clientAppID,
+				"route":         routeName,
+			})
+			w.Header().Set("Retry-After", "10")
+			api.WriteError(w, http.StatusTooManyRequests, api.CloudErrorCodeTooManyRequests, "", "Too many requests from this client application. Please retry later.")
+		})
+	}
+}
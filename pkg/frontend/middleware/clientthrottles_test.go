@@ -0,0 +1,68 @@
+package middleware
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+
+	mock_metrics "github.com/Azure/ARO-RP/pkg/util/mocks/metrics"
+)
+
+func TestClientThrottles(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	m := mock_metrics.NewMockInterface(controller)
+
+	router := mux.NewRouter()
+	router.Use(ClientThrottles(m, map[string]ClientThrottle{
+		"00000000-0000-0000-0000-00000000000A": {RequestsPerSecond: 0.001, Burst: 1},
+	}))
+
+	router.Path("/test").Methods(http.MethodGet).HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}).Name("getTest")
+
+	serve := func(clientAppID string) *httptest.ResponseRecorder {
+		r, err := http.NewRequest(http.MethodGet, "/test", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if clientAppID != "" {
+			r.Header.Set("X-Ms-Client-App-Id", clientAppID)
+		}
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+		return w
+	}
+
+	// the burst is served
+	if w := serve("00000000-0000-0000-0000-00000000000a"); w.Code != http.StatusOK {
+		t.Error(w.Code)
+	}
+
+	m.EXPECT().EmitGauge("frontend.client.throttled.count", int64(1), map[string]string{
+		"client-app-id": "00000000-0000-0000-0000-00000000000a",
+		"route":         "getTest",
+	})
+
+	w := serve("00000000-0000-0000-0000-00000000000a")
+	if w.Code != http.StatusTooManyRequests {
+		t.Error(w.Code)
+	}
+	if w.Header().Get("Retry-After") == "" {
+		t.Error("missing Retry-After header")
+	}
+
+	// other clients are unaffected
+	for _, clientAppID := range []string{"00000000-0000-0000-0000-00000000000b", ""} {
+		if w := serve(clientAppID); w.Code != http.StatusOK {
+			t.Error(clientAppID, w.Code)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package middleware
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/Azure/ARO-RP/pkg/metrics"
+)
+
+// Deprecation is the retirement schedule of an API version
+type Deprecation struct {
+	// Deprecated is when the API version is, or was, deprecated
+	Deprecated time.Time `json:"deprecated,omitempty"`
+
+	// Sunset is when the API version is expected to stop being served
+	Sunset time.Time `json:"sunset,omitempty"`
+
+	// Link, if set, documents the migration to a newer API version
+	Link string `json:"link,omitempty"`
+}
+
+// Deprecations signals the retirement schedule of the API version of each
+// request whose API version is listed in deprecations.  Deprecation and Sunset
+// headers are added to the response (see RFC 8594) and the use of the API
+// version is counted, so that callers which still need to migrate can be
+// found.
+func Deprecations(m metrics.Interface, deprecations map[string]Deprecation) func(http.Handler) http.Handler {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			apiVersion := r.URL.Query().Get("api-version")
+
+			d, found := deprecations[apiVersion]
+			if !found {
+				h.ServeHTTP(w, r)
+				return
+			}
+
+			var routeName string
+			if route := mux.CurrentRoute(r); route != nil {
+				routeName = route.GetName()
+			}
+
+			if !d.Deprecated.IsZero() {
+				w.Header().Set("Deprecation", d.Deprecated.UTC().Format(http.TimeFormat))
+			}
+			if !d.Sunset.IsZero() {
+				w.Header().Set("Sunset", d.Sunset.UTC().Format(http.TimeFormat))
+			}
+			if d.Link != "" {
+				w.Header().Add("Link", fmt.Sprintf(`<%s>; rel="deprecation"`, d.Link))
+			}
+
+			m.EmitGauge("frontend.deprecated.count", 1, map[string]string{
+				"api-version": apiVersion,
+				"route":       routeName,
+				"deprecated":  fmt.Sprint(!d.Deprecated.IsZero() && !time.Now().Before(d.Deprecated)),
+			})
+
+			h.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package middleware
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+
+	mock_metrics "github.com/Azure/ARO-RP/pkg/util/mocks/metrics"
+)
+
+func TestDeprecations(t *testing.T) {
+	deprecations := map[string]Deprecation{
+		"2019-12-31-preview": {
+			Deprecated: time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC),
+			Sunset:     time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			Link:       "https://docs.microsoft.com/azure/openshift/",
+		},
+		"2020-04-30": {
+			Deprecated: time.Now().Add(24 * time.Hour),
+		},
+	}
+
+	for _, tt := range []struct {
+		name            string
+		apiVersion      string
+		wantMetric      map[string]string
+		wantDeprecation string
+		wantSunset      string
+		wantLink        string
+	}{
+		{
+			name:            "deprecated api version",
+			apiVersion:      "2019-12-31-preview",
+			wantDeprecation: "Mon, 01 Jun 2020 00:00:00 GMT",
+			wantSunset:      "Fri, 01 Jan 2021 00:00:00 GMT",
+			wantLink:        `<https://docs.microsoft.com/azure/openshift/>; rel="deprecation"`,
+			wantMetric: map[string]string{
+				"api-version": "2019-12-31-preview",
+				"route":       "getOpenShiftCluster",
+				"deprecated":  "true",
+			},
+		},
+		{
+			name:            "api version scheduled for deprecation",
+			apiVersion:      "2020-04-30",
+			wantDeprecation: deprecations["2020-04-30"].Deprecated.UTC().Format(http.TimeFormat),
+			wantMetric: map[string]string{
+				"api-version": "2020-04-30",
+				"route":       "getOpenShiftCluster",
+				"deprecated":  "false",
+			},
+		},
+		{
+			name:       "supported api version",
+			apiVersion: "2020-10-31-preview",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			m := mock_metrics.NewMockInterface(controller)
+			if tt.wantMetric != nil {
+				m.EXPECT().EmitGauge("frontend.deprecated.count", int64(1), tt.wantMetric)
+			}
+
+			router := mux.NewRouter()
+			router.Use(Deprecations(m, deprecations))
+			router.Path("/cluster").Queries("api-version", "{api-version}").Methods(http.MethodGet).HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}).Name("getOpenShiftCluster")
+
+			r, err := http.NewRequest(http.MethodGet, "/cluster?api-version="+tt.apiVersion, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, r)
+
+			if w.Header().Get("Deprecation") != tt.wantDeprecation {
+				t.Error(w.Header().Get("Deprecation"))
+			}
+			if w.Header().Get("Sunset") != tt.wantSunset {
+				t.Error(w.Header().Get("Sunset"))
+			}
+			if w.Header().Get("Link") != tt.wantLink {
+				t.Error(w.Header().Get("Link"))
+			}
+		})
+	}
+}
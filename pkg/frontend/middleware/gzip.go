@@ -0,0 +1,109 @@
+package middleware
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"compress/gzip"
+	"net/http"
+	"strings"
+)
+
+type gzipResponseWriter struct {
+	http.ResponseWriter
+
+	method string
+	gw     *gzip.Writer
+}
+
+// start decides whether the response is compressed once its status code is
+// known.  Responses without a body are left alone.
+func (w *gzipResponseWriter) start(statusCode int) {
+	if w.gw != nil || w.method == http.MethodHead ||
+		statusCode == http.StatusNoContent || statusCode == http.StatusNotModified {
+		return
+	}
+
+	w.Header().Del("Content-Length")
+	w.Header().Set("Content-Encoding", "gzip")
+	w.gw = gzip.NewWriter(w.ResponseWriter)
+}
+
+func (w *gzipResponseWriter) WriteHeader(statusCode int) {
+	w.start(statusCode)
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *gzipResponseWriter) Write(b []byte) (int, error) {
+	w.start(http.StatusOK)
+	if w.gw == nil {
+		return w.ResponseWriter.Write(b)
+	}
+	return w.gw.Write(b)
+}
+
+// Flush sends what has been compressed so far, so that handlers which stream
+// their response keep working
+func (w *gzipResponseWriter) Flush() {
+	if w.gw != nil {
+		_ = w.gw.Flush()
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap gives http.ResponseController access to the underlying connection;
+// a hijacked connection is never compressed
+func (w *gzipResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *gzipResponseWriter) close() error {
+	if w.gw == nil {
+		return nil
+	}
+	return w.gw.Close()
+}
+
+// Gzip compresses responses for clients which accept it.  Large list
+// responses shrink by an order of magnitude, and since the compressed length
+// is not known in advance they are sent chunked as they are written.
+func Gzip(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept-Encoding")
+
+		if !acceptsGzip(r) {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		gw := &gzipResponseWriter{ResponseWriter: w, method: r.Method}
+		defer gw.close()
+
+		h.ServeHTTP(gw, r)
+	})
+}
+
+func acceptsGzip(r *http.Request) bool {
+	for _, v := range r.Header.Values("Accept-Encoding") {
+		for _, encoding := range strings.Split(v, ",") {
+			params := strings.Split(encoding, ";")
+			if !strings.EqualFold(strings.TrimSpace(params[0]), "gzip") {
+				continue
+			}
+
+			// "gzip;q=0" explicitly refuses gzip
+			for _, param := range params[1:] {
+				if q := strings.TrimSpace(param); strings.HasPrefix(q, "q=") &&
+					strings.Trim(q[2:], "0.") == "" {
+					return false
+				}
+			}
+
+			return true
+		}
+	}
+
+	return false
+}
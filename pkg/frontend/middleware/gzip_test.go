@@ -0,0 +1,105 @@
+package middleware
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGzip(t *testing.T) {
+	body := strings.Repeat(`{"name":"cluster"},`, 1000)
+
+	for _, tt := range []struct {
+		name           string
+		method         string
+		acceptEncoding string
+		statusCode     int
+		wantGzip       bool
+		wantBody       string
+	}{
+		{
+			name:     "not accepted",
+			method:   http.MethodGet,
+			wantBody: body,
+		},
+		{
+			name:           "accepted",
+			method:         http.MethodGet,
+			acceptEncoding: "deflate, gzip;q=1.0, *;q=0.5",
+			wantGzip:       true,
+			wantBody:       body,
+		},
+		{
+			name:           "refused",
+			method:         http.MethodGet,
+			acceptEncoding: "gzip;q=0",
+			wantBody:       body,
+		},
+		{
+			name:           "no content",
+			method:         http.MethodDelete,
+			acceptEncoding: "gzip",
+			statusCode:     http.StatusNoContent,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := http.NewRequest(tt.method, "/", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.acceptEncoding != "" {
+				r.Header.Set("Accept-Encoding", tt.acceptEncoding)
+			}
+
+			w := httptest.NewRecorder()
+
+			Gzip(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.statusCode != 0 {
+					w.WriteHeader(tt.statusCode)
+					return
+				}
+
+				// write in pieces and flush, as streaming handlers do
+				for _, s := range strings.SplitAfter(body, ",") {
+					_, _ = w.Write([]byte(s))
+				}
+				w.(http.Flusher).Flush()
+			})).ServeHTTP(w, r)
+
+			if w.Header().Get("Vary") != "Accept-Encoding" {
+				t.Error(w.Header().Get("Vary"))
+			}
+
+			if (w.Header().Get("Content-Encoding") == "gzip") != tt.wantGzip {
+				t.Fatal(w.Header().Get("Content-Encoding"))
+			}
+
+			b := w.Body.Bytes()
+			if tt.wantGzip {
+				if len(b) >= len(body) {
+					t.Error(len(b))
+				}
+
+				gr, err := gzip.NewReader(w.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				b, err = ioutil.ReadAll(gr)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if string(b) != tt.wantBody {
+				t.Error(string(b))
+			}
+		})
+	}
+}
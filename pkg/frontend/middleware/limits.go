@@ -0,0 +1,107 @@
+package middleware
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/metrics"
+)
+
+// Limit bounds the resources which requests to a route may consume.  Zero
+// fields take the value of the default Limit.
+type Limit struct {
+	// Timeout is the time after which the request context is cancelled
+	Timeout time.Duration
+
+	// MaxBodyBytes is the largest request body which is accepted
+	MaxBodyBytes int64
+
+	// MaxConcurrent is the number of requests to the route which may be in
+	// flight at once; further requests are rejected with a 429
+	MaxConcurrent int
+}
+
+func (l Limit) withDefaults(d Limit) Limit {
+	if l.Timeout == 0 {
+		l.Timeout = d.Timeout
+	}
+	if l.MaxBodyBytes == 0 {
+		l.MaxBodyBytes = d.MaxBodyBytes
+	}
+	if l.MaxConcurrent == 0 {
+		l.MaxConcurrent = d.MaxConcurrent
+	}
+	return l
+}
+
+type routeLimit struct {
+	Limit
+	inflight chan struct{}
+}
+
+// Limits applies the per-route limits in routeLimits, keyed by route name,
+// falling back to defaultLimit for routes which are not listed
+func Limits(m metrics.Interface, defaultLimit Limit, routeLimits map[string]Limit) func(http.Handler) http.Handler {
+	var mu sync.Mutex
+	limits := map[string]*routeLimit{}
+
+	// get returns the limit of the named route, creating it on first use
+	get := func(routeName string) *routeLimit {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if limits[routeName] == nil {
+			l := routeLimits[routeName].withDefaults(defaultLimit)
+			limits[routeName] = &routeLimit{
+				Limit:    l,
+				inflight: make(chan struct{}, l.MaxConcurrent),
+			}
+		}
+
+		return limits[routeName]
+	}
+
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var routeName string
+			if route := mux.CurrentRoute(r); route != nil {
+				routeName = route.GetName()
+			}
+
+			l := get(routeName)
+
+			select {
+			case l.inflight <- struct{}{}:
+				defer func() { <-l.inflight }()
+			default:
+				m.EmitGauge("frontend.throttled.count", 1, map[string]string{
+					"route": routeName,
+				})
+				w.Header().Set("Retry-After", "10")
+				api.WriteError(w, http.StatusTooManyRequests, api.CloudErrorCodeTooManyRequests, "", "Too many requests. Please retry later.")
+				return
+			}
+
+			ctx, cancel := context.WithTimeout(r.Context(), l.Timeout)
+			defer cancel()
+
+			ctx = context.WithValue(ctx, ContextKeyMaxBodyBytes, l.MaxBodyBytes)
+
+			h.ServeHTTP(w, r.WithContext(ctx))
+
+			if ctx.Err() == context.DeadlineExceeded {
+				m.EmitGauge("frontend.timeout.count", 1, map[string]string{
+					"route": routeName,
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package middleware
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+
+	mock_metrics "github.com/Azure/ARO-RP/pkg/util/mocks/metrics"
+)
+
+func TestLimits(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	m := mock_metrics.NewMockInterface(controller)
+
+	block := make(chan struct{})
+	started := make(chan struct{})
+
+	router := mux.NewRouter()
+	router.Use(Limits(m, Limit{Timeout: time.Minute, MaxBodyBytes: 1048576, MaxConcurrent: 1}, map[string]Limit{
+		"postSmall": {MaxBodyBytes: 4},
+		"getFast":   {Timeout: time.Millisecond},
+	}))
+	router.Use(Body)
+
+	router.Path("/slow").Methods(http.MethodGet).HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-block
+	}).Name("getSlow")
+
+	router.Path("/small").Methods(http.MethodPost).HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}).Name("postSmall")
+
+	router.Path("/fast").Methods(http.MethodGet).HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}).Name("getFast")
+
+	serve := func(method, path string, body []byte) *httptest.ResponseRecorder {
+		r, err := http.NewRequestWithContext(context.Background(), method, path, bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		r.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+		return w
+	}
+
+	t.Run("concurrent requests above the ceiling are rejected", func(t *testing.T) {
+		done := make(chan struct{})
+		go func() {
+			serve(http.MethodGet, "/slow", nil)
+			close(done)
+		}()
+		<-started
+
+		m.EXPECT().EmitGauge("frontend.throttled.count", int64(1), map[string]string{"route": "getSlow"})
+
+		w := serve(http.MethodGet, "/slow", nil)
+		if w.Code != http.StatusTooManyRequests {
+			t.Error(w.Code)
+		}
+		if w.Header().Get("Retry-After") == "" {
+			t.Error("missing Retry-After header")
+		}
+
+		close(block)
+		<-done
+	})
+
+	t.Run("per-route body size limit", func(t *testing.T) {
+		if w := serve(http.MethodPost, "/small", []byte("1234")); w.Code != http.StatusOK {
+			t.Error(w.Code)
+		}
+		if w := serve(http.MethodPost, "/small", []byte("12345")); w.Code != http.StatusBadRequest {
+			t.Error(w.Code)
+		}
+	})
+
+	t.Run("per-route timeout", func(t *testing.T) {
+		m.EXPECT().EmitGauge("frontend.timeout.count", int64(1), map[string]string{"route": "getFast"})
+
+		serve(http.MethodGet, "/fast", nil)
+	})
+}
@@ -37,6 +37,19 @@ func (w *logResponseWriter) WriteHeader(statusCode int) {
 	w.statusCode = statusCode
 }
 
+// Flush is needed by handlers which stream their response
+func (w *logResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap lets handlers which proxy WebSocket connections hijack the
+// underlying connection through an http.ResponseController
+func (w *logResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
 type logReadCloser struct {
 	io.ReadCloser
 
@@ -64,6 +77,8 @@ func Log(baseLog *logrus.Entry) func(http.Handler) http.Handler {
 				CorrelationID:   r.Header.Get("X-Ms-Correlation-Request-Id"),
 				RequestID:       uuid.NewV4().String(),
 				RequestTime:     t,
+				ClientAppID:     strings.ToLower(r.Header.Get("X-Ms-Client-App-Id")),
+				UserAgent:       r.UserAgent(),
 			}
 
 			if vars["api-version"] == admin.APIVersion ||
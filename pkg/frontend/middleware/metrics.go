@@ -4,47 +4,97 @@ package middleware
 // Licensed under the Apache License 2.0.
 
 import (
+	"context"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gorilla/mux"
 
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/admin"
 	"github.com/Azure/ARO-RP/pkg/metrics"
 )
 
+// metricsDimensions holds the request metric dimensions which are only known
+// once the handler has run
+type metricsDimensions struct {
+	subscriptionState api.SubscriptionState
+}
+
+// SetSubscriptionState records the state of the subscription targeted by the
+// request, so that the request metrics can be dimensioned by it
+func SetSubscriptionState(ctx context.Context, state api.SubscriptionState) {
+	if d, ok := ctx.Value(ContextKeyMetricsDimensions).(*metricsDimensions); ok {
+		d.subscriptionState = state
+	}
+}
+
 // Metric records request metrics for tracking
 func Metrics(m metrics.Interface) func(http.Handler) http.Handler {
 	return func(h http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			vars := mux.Vars(r)
 			t := time.Now()
-			var routeName string
+			var routeName, routeTemplate string
 			if route := mux.CurrentRoute(r); route != nil {
 				routeName = route.GetName()
+				routeTemplate, _ = route.GetPathTemplate()
 			} else {
 				routeName = "unknown"
 			}
 
+			// admin routes don't carry an api-version parameter
+			apiVersion := vars["api-version"]
+			if apiVersion == "" && strings.HasPrefix(r.URL.Path, "/admin") {
+				apiVersion = admin.APIVersion
+			}
+
+			d := &metricsDimensions{}
+			r = r.WithContext(context.WithValue(r.Context(), ContextKeyMetricsDimensions, d))
+
 			w = &logResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
 
 			defer func() {
-				m.EmitGauge("frontend.count", 1, map[string]string{
-					"verb":        r.Method,
-					"api-version": vars["api-version"],
-					"code":        strconv.Itoa(w.(*logResponseWriter).statusCode),
-					"route":       routeName,
-				})
-
-				m.EmitGauge("frontend.duration", time.Since(t).Milliseconds(), map[string]string{
-					"verb":        r.Method,
-					"api-version": vars["api-version"],
-					"code":        strconv.Itoa(w.(*logResponseWriter).statusCode),
-					"route":       routeName,
-				})
+				dims := map[string]string{
+					"verb":               r.Method,
+					"api-version":        apiVersion,
+					"code":               strconv.Itoa(w.(*logResponseWriter).statusCode),
+					"route":              routeName,
+					"route-template":     routeTemplate,
+					"subscription-state": string(d.subscriptionState),
+				}
+
+				m.EmitGauge("frontend.count", 1, dims)
+				m.EmitGauge("frontend.duration", time.Since(t).Milliseconds(), dims)
+
+				// ARM identifies the calling application of the requests it
+				// proxies, so that noisy automation can be found
+				if clientAppID := r.Header.Get("X-Ms-Client-App-Id"); clientAppID != "" {
+					m.EmitGauge("frontend.client.count", 1, map[string]string{
+						"client-app-id": strings.ToLower(clientAppID),
+						"user-agent":    userAgentProduct(r.UserAgent()),
+						"code":          dims["code"],
+						"route":         routeName,
+					})
+				}
 			}()
 
 			h.ServeHTTP(w, r)
 		})
 	}
 }
+
+// userAgentProduct returns the product name of the first product in a
+// User-Agent header (e.g. "azsdk-go-armredhatopenshift" for
+// "azsdk-go-armredhatopenshift/v1.0.0 (go1.15; linux)"), dropping the versions
+// and comments which would make the metric dimension unbounded
+func userAgentProduct(userAgent string) string {
+	product := strings.Fields(userAgent)
+	if len(product) == 0 {
+		return ""
+	}
+
+	return strings.SplitN(product[0], "/", 2)[0]
+}
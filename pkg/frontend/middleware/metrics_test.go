@@ -0,0 +1,125 @@
+package middleware
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	mock_metrics "github.com/Azure/ARO-RP/pkg/util/mocks/metrics"
+)
+
+func TestMetrics(t *testing.T) {
+	for _, tt := range []struct {
+		name           string
+		url            string
+		clientAppID    string
+		userAgent      string
+		wantDims       map[string]string
+		wantClientDims map[string]string
+	}{
+		{
+			name: "arm route",
+			url:  "/subscriptions/00000000-0000-0000-0000-000000000000/test?api-version=2020-04-30",
+			wantDims: map[string]string{
+				"verb":               http.MethodGet,
+				"api-version":        "2020-04-30",
+				"code":               "404",
+				"route":              "getTest",
+				"route-template":     "/subscriptions/{subscriptionId}/test",
+				"subscription-state": string(api.SubscriptionStateWarned),
+			},
+		},
+		{
+			name:        "arm route with client application",
+			url:         "/subscriptions/00000000-0000-0000-0000-000000000000/test?api-version=2020-04-30",
+			clientAppID: "00000000-0000-0000-0000-00000000000A",
+			userAgent:   "azsdk-go-armredhatopenshift/v1.0.0 (go1.15; linux)",
+			wantDims: map[string]string{
+				"verb":               http.MethodGet,
+				"api-version":        "2020-04-30",
+				"code":               "404",
+				"route":              "getTest",
+				"route-template":     "/subscriptions/{subscriptionId}/test",
+				"subscription-state": string(api.SubscriptionStateWarned),
+			},
+			wantClientDims: map[string]string{
+				"client-app-id": "00000000-0000-0000-0000-00000000000a",
+				"user-agent":    "azsdk-go-armredhatopenshift",
+				"code":          "404",
+				"route":         "getTest",
+			},
+		},
+		{
+			name: "admin route",
+			url:  "/admin/test",
+			wantDims: map[string]string{
+				"verb":               http.MethodGet,
+				"api-version":        "admin",
+				"code":               "200",
+				"route":              "getAdminTest",
+				"route-template":     "/admin/test",
+				"subscription-state": "",
+			},
+		},
+		{
+			name: "unknown route",
+			url:  "/unknown",
+			wantDims: map[string]string{
+				"verb":               http.MethodGet,
+				"api-version":        "",
+				"code":               "404",
+				"route":              "unknown",
+				"route-template":     "",
+				"subscription-state": "",
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			m := mock_metrics.NewMockInterface(controller)
+			m.EXPECT().EmitGauge("frontend.count", int64(1), tt.wantDims)
+			m.EXPECT().EmitGauge("frontend.duration", gomock.Any(), tt.wantDims)
+			if tt.wantClientDims != nil {
+				m.EXPECT().EmitGauge("frontend.client.count", int64(1), tt.wantClientDims)
+			}
+
+			router := mux.NewRouter()
+			router.Use(Metrics(m))
+			router.NotFoundHandler = Metrics(m)(http.NotFoundHandler())
+
+			router.Path("/subscriptions/{subscriptionId}/test").
+				Queries("api-version", "{api-version}").
+				Methods(http.MethodGet).
+				HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					SetSubscriptionState(r.Context(), api.SubscriptionStateWarned)
+					w.WriteHeader(http.StatusNotFound)
+				}).
+				Name("getTest")
+
+			router.Path("/admin/test").
+				Methods(http.MethodGet).
+				HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}).
+				Name("getAdminTest")
+
+			r, err := http.NewRequest(http.MethodGet, tt.url, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.clientAppID != "" {
+				r.Header.Set("X-Ms-Client-App-Id", tt.clientAppID)
+			}
+			r.Header.Set("User-Agent", tt.userAgent)
+
+			router.ServeHTTP(httptest.NewRecorder(), r)
+		})
+	}
+}
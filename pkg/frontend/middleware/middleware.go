@@ -10,4 +10,6 @@ const (
 	ContextKeyOriginalPath
 	ContextKeyBody
 	ContextKeyCorrelationData
+	ContextKeyMetricsDimensions
+	ContextKeyMaxBodyBytes
 )
@@ -0,0 +1,47 @@
+package middleware
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database"
+	"github.com/Azure/ARO-RP/pkg/metrics"
+)
+
+// ReadOnly puts the frontend in read-only mode if enabled, e.g. while the
+// region's write path is serviced.  GET and HEAD requests, and requests to the
+// routes named in readOnlyRoutes (POSTs which change nothing), are served
+// from the database's read replicas.  All other requests are rejected with a
+// 503 and a Retry-After header, which ARM and clients treat as retriable, and
+// counted.
+func ReadOnly(m metrics.Interface, enabled bool, readOnlyRoutes map[string]bool) func(http.Handler) http.Handler {
+	return func(h http.Handler) http.Handler {
+		if !enabled {
+			return h
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var routeName string
+			if route := mux.CurrentRoute(r); route != nil {
+				routeName = route.GetName()
+			}
+
+			if r.Method == http.MethodGet || r.Method == http.MethodHead ||
+				readOnlyRoutes[routeName] {
+				h.ServeHTTP(w, r.WithContext(database.WithReadReplicas(r.Context())))
+				return
+			}
+
+			m.EmitGauge("frontend.readonly.rejected.count", 1, map[string]string{
+				"route": routeName,
+			})
+			w.Header().Set("Retry-After", "60")
+			api.WriteError(w, http.StatusServiceUnavailable, api.CloudErrorCodeServiceUnavailable, "", "The resource provider is in read-only mode for planned maintenance. Please retry later.")
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package middleware
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+
+	mock_metrics "github.com/Azure/ARO-RP/pkg/util/mocks/metrics"
+)
+
+func TestReadOnly(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		enabled  bool
+		method   string
+		path     string
+		wantCode int
+	}{
+		{
+			name:     "disabled, write is served",
+			method:   http.MethodPut,
+			path:     "/test",
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "enabled, read is served",
+			enabled:  true,
+			method:   http.MethodGet,
+			path:     "/test",
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "enabled, read-only POST is served",
+			enabled:  true,
+			method:   http.MethodPost,
+			path:     "/test/list",
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "enabled, write is rejected",
+			enabled:  true,
+			method:   http.MethodPut,
+			path:     "/test",
+			wantCode: http.StatusServiceUnavailable,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			m := mock_metrics.NewMockInterface(controller)
+			if tt.wantCode == http.StatusServiceUnavailable {
+				m.EXPECT().EmitGauge("frontend.readonly.rejected.count", int64(1), map[string]string{
+					"route": "putTest",
+				})
+			}
+
+			router := mux.NewRouter()
+			router.Use(ReadOnly(m, tt.enabled, map[string]bool{"postTestList": true}))
+
+			router.Path("/test").Methods(http.MethodGet).HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}).Name("getTest")
+			router.Path("/test").Methods(http.MethodPut).HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}).Name("putTest")
+			router.Path("/test/list").Methods(http.MethodPost).HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}).Name("postTestList")
+
+			r, err := http.NewRequest(tt.method, tt.path, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, r)
+
+			if w.Code != tt.wantCode {
+				t.Error(w.Code)
+			}
+
+			if tt.wantCode == http.StatusServiceUnavailable &&
+				w.Header().Get("Retry-After") == "" {
+				t.Error("missing Retry-After header")
+			}
+		})
+	}
+}
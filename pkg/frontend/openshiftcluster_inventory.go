@@ -0,0 +1,87 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+// postOpenShiftClusterInventory receives the inventory which the ARO operator
+// of a cluster reports.  It is not called by ARM: the operator authenticates
+// with the inventory client certificate of the cluster instead.
+func (f *frontend) postOpenShiftClusterInventory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	body := r.Context().Value(middleware.ContextKeyBody).([]byte)
+
+	err := f._postOpenShiftClusterInventory(ctx, r, log, body)
+	if err == nil {
+		err = statusCodeError(http.StatusNoContent)
+	}
+
+	reply(log, w, nil, nil, err)
+}
+
+func (f *frontend) _postOpenShiftClusterInventory(ctx context.Context, r *http.Request, log *logrus.Entry, body []byte) error {
+	resourceID := strings.TrimPrefix(r.URL.Path, "/inventory")
+
+	// a caller without the certificate learns nothing, not even whether the
+	// cluster exists
+	errForbidden := api.NewCloudError(http.StatusForbidden, api.CloudErrorCodeForbidden, "", "Forbidden.")
+
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return errForbidden
+	}
+
+	doc, err := f.dbOpenShiftClusters.Get(ctx, resourceID)
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		return errForbidden
+	case err != nil:
+		return err
+	}
+
+	cert := doc.OpenShiftCluster.Properties.InventoryClientCertificate
+	if cert == nil || !bytes.Equal(r.TLS.PeerCertificates[0].Raw, cert) {
+		return errForbidden
+	}
+
+	var inventory api.ClusterInventory
+	err = json.Unmarshal(body, &inventory)
+	if err != nil {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidRequestContent, "", "The request content was invalid and could not be deserialized: %q.", err)
+	}
+
+	inventory.ReceivedAt = time.Now().UTC()
+
+	log.WithFields(logrus.Fields{
+		"clusterVersion":  inventory.ClusterVersion,
+		"operatorVersion": inventory.OperatorVersion,
+		"reportedAt":      inventory.ReportedAt,
+	}).Info("received inventory")
+
+	if !inventory.ReportedAt.IsZero() {
+		f.m.EmitGauge("frontend.inventory.delay", int64(inventory.ReceivedAt.Sub(inventory.ReportedAt).Seconds()), map[string]string{
+			"operatorVersion": inventory.OperatorVersion,
+		})
+	}
+
+	_, err = f.dbClusterInventories.Put(ctx, &api.ClusterInventoryDocument{
+		ID:               doc.ID,
+		Key:              doc.Key,
+		ClusterInventory: &inventory,
+	})
+	return err
+}
@@ -0,0 +1,179 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestPostOpenShiftClusterInventory(t *testing.T) {
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	resourceID := testdatabase.GetResourcePath(mockSubID, "resourceName")
+	mockClusterDocID := "11111111-1111-1111-1111-111111111111"
+
+	ctx := context.Background()
+
+	inventory := &api.ClusterInventory{
+		ClusterVersion: "4.5.16",
+		Nodes: []api.InventoryNodeSummary{
+			{Role: "master", Count: 3, Ready: 3},
+		},
+	}
+
+	for _, tt := range []struct {
+		name           string
+		fixture        func(*testdatabase.Fixture)
+		header         http.Header
+		body           interface{}
+		wantStatusCode int
+		wantError      string
+		wantInventory  bool
+	}{
+		{
+			name: "inventory stored",
+			fixture: func(f *testdatabase.Fixture) {
+				f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					ID:  mockClusterDocID,
+					Key: strings.ToLower(resourceID),
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID: resourceID,
+						Properties: api.OpenShiftClusterProperties{
+							InventoryClientCertificate: clientcerts[0].Raw,
+						},
+					},
+				})
+			},
+			header: http.Header{
+				"Content-Type": []string{"application/json"},
+			},
+			body:           inventory,
+			wantStatusCode: http.StatusNoContent,
+			wantInventory:  true,
+		},
+		{
+			name: "wrong certificate",
+			fixture: func(f *testdatabase.Fixture) {
+				f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					ID:  mockClusterDocID,
+					Key: strings.ToLower(resourceID),
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID: resourceID,
+						Properties: api.OpenShiftClusterProperties{
+							InventoryClientCertificate: servercerts[0].Raw,
+						},
+					},
+				})
+			},
+			header: http.Header{
+				"Content-Type": []string{"application/json"},
+			},
+			body:           inventory,
+			wantStatusCode: http.StatusForbidden,
+			wantError:      "403: Forbidden: : Forbidden.",
+		},
+		{
+			name: "cluster without a certificate",
+			fixture: func(f *testdatabase.Fixture) {
+				f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					ID:  mockClusterDocID,
+					Key: strings.ToLower(resourceID),
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID: resourceID,
+					},
+				})
+			},
+			header: http.Header{
+				"Content-Type": []string{"application/json"},
+			},
+			body:           inventory,
+			wantStatusCode: http.StatusForbidden,
+			wantError:      "403: Forbidden: : Forbidden.",
+		},
+		{
+			name:    "cluster not found",
+			fixture: func(f *testdatabase.Fixture) {},
+			header: http.Header{
+				"Content-Type": []string{"application/json"},
+			},
+			body:           inventory,
+			wantStatusCode: http.StatusForbidden,
+			wantError:      "403: Forbidden: : Forbidden.",
+		},
+		{
+			name: "invalid body",
+			fixture: func(f *testdatabase.Fixture) {
+				f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					ID:  mockClusterDocID,
+					Key: strings.ToLower(resourceID),
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID: resourceID,
+						Properties: api.OpenShiftClusterProperties{
+							InventoryClientCertificate: clientcerts[0].Raw,
+						},
+					},
+				})
+			},
+			header: http.Header{
+				"Content-Type": []string{"application/json"},
+			},
+			body:           []string{},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `400: InvalidRequestContent: : The request content was invalid and could not be deserialized: "json: cannot unmarshal array into Go value of type api.ClusterInventory".`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithOpenShiftClusters().WithClusterInventories()
+			defer ti.done()
+
+			err := ti.buildFixtures(tt.fixture)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, api.APIs, &noop.Noop{}, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodPost,
+				fmt.Sprintf("https://server/inventory%s", resourceID),
+				tt.header, tt.body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, nil)
+			if err != nil {
+				t.Error(err)
+			}
+
+			if !tt.wantInventory {
+				return
+			}
+
+			doc, err := ti.clusterInventoriesDatabase.Get(ctx, mockClusterDocID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if doc.Key != strings.ToLower(resourceID) ||
+				doc.ClusterInventory == nil ||
+				doc.ClusterInventory.ClusterVersion != "4.5.16" ||
+				len(doc.ClusterInventory.Nodes) != 1 ||
+				doc.ClusterInventory.ReceivedAt.IsZero() {
+				t.Error(doc)
+			}
+		})
+	}
+}
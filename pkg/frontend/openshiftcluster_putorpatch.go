@@ -33,7 +33,7 @@ func (f *frontend) putOrPatchOpenShiftCluster(w http.ResponseWriter, r *http.Req
 	var b []byte
 	err := cosmosdb.RetryOnPreconditionFailed(func() error {
 		var err error
-		b, err = f._putOrPatchOpenShiftCluster(ctx, r, &header, f.apis[vars["api-version"]].OpenShiftClusterConverter(), f.apis[vars["api-version"]].OpenShiftClusterStaticValidator(f.env.Location(), f.env.Domain(), f.env.DeploymentMode(), r.URL.Path))
+		b, err = f._putOrPatchOpenShiftCluster(ctx, r, &header, f.apis[vars["api-version"]].OpenShiftClusterConverter(), f.apis[vars["api-version"]].OpenShiftClusterStaticValidator(f.env.Location(), f.env.Domain(), f.env, r.URL.Path))
 		return err
 	})
 
@@ -57,6 +57,16 @@ func (f *frontend) _putOrPatchOpenShiftCluster(ctx context.Context, r *http.Requ
 	isCreate := doc == nil
 
 	if isCreate {
+		err = validateRegionEnabled(f.env.Location())
+		if err != nil {
+			return nil, err
+		}
+
+		err = f.validateClusterQuotas(ctx, subdoc.ID)
+		if err != nil {
+			return nil, err
+		}
+
 		originalPath := r.Context().Value(middleware.ContextKeyOriginalPath).(string)
 		originalR, err := azure.ParseResourceID(originalPath)
 		if err != nil {
@@ -107,6 +117,10 @@ func (f *frontend) _putOrPatchOpenShiftCluster(ctx context.Context, r *http.Requ
 		}
 	}
 
+	if doc.OpenShiftCluster.Properties.EncryptionAtRestProfile.IsInaccessible() {
+		return nil, doc.OpenShiftCluster.Properties.EncryptionAtRestProfile.InaccessibleError()
+	}
+
 	if !isCreate {
 		timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
 		defer cancel()
@@ -129,6 +143,7 @@ func (f *frontend) _putOrPatchOpenShiftCluster(ctx context.Context, r *http.Requ
 				ServicePrincipalProfile: api.ServicePrincipalProfile{
 					ClientSecret: doc.OpenShiftCluster.Properties.ServicePrincipalProfile.ClientSecret,
 				},
+				EncryptionAtRestProfile: readOnlyEncryptionAtRestProfile(doc.OpenShiftCluster.Properties.EncryptionAtRestProfile),
 			},
 		})
 
@@ -147,14 +162,28 @@ func (f *frontend) _putOrPatchOpenShiftCluster(ctx context.Context, r *http.Requ
 		err = staticValidator.Static(ext, doc.OpenShiftCluster)
 	}
 	if err != nil {
-		return nil, err
+		return nil, withTargetJSONPointer(err, body)
 	}
 
 	oldID, oldName, oldType := doc.OpenShiftCluster.ID, doc.OpenShiftCluster.Name, doc.OpenShiftCluster.Type
+	oldServicePrincipalProfile := doc.OpenShiftCluster.Properties.ServicePrincipalProfile
 	converter.ToInternal(ext, doc.OpenShiftCluster)
 	doc.OpenShiftCluster.ID, doc.OpenShiftCluster.Name, doc.OpenShiftCluster.Type = oldID, oldName, oldType
 
+	// a customer update which changes the service principal or its secret
+	// rotates the cluster's credentials to Azure
+	if !isCreate &&
+		(doc.OpenShiftCluster.Properties.ServicePrincipalProfile.ClientID != oldServicePrincipalProfile.ClientID ||
+			doc.OpenShiftCluster.Properties.ServicePrincipalProfile.ClientSecret != oldServicePrincipalProfile.ClientSecret) {
+		doc.OpenShiftCluster.Properties.CredentialsProfile.ServicePrincipal.Rotate(time.Now(), nil)
+	}
+
 	if isCreate {
+		err = f.validateInstallVersion(ctx, doc.OpenShiftCluster.Properties.ClusterProfile.Version)
+		if err != nil {
+			return nil, withTargetJSONPointer(err, body)
+		}
+
 		// on create, make the cluster resourcegroup ID lower case to work
 		// around LB/PLS bug
 		doc.OpenShiftCluster.Properties.ClusterProfile.ResourceGroupID = strings.ToLower(doc.OpenShiftCluster.Properties.ClusterProfile.ResourceGroupID)
@@ -174,6 +203,14 @@ func (f *frontend) _putOrPatchOpenShiftCluster(ctx context.Context, r *http.Requ
 		// TODO: Get rid of the special case
 		vars := mux.Vars(r)
 		if vars["api-version"] == admin.APIVersion {
+			// admin updates (which also renew the cluster's certificates) are
+			// maintenance: honour the customer's maintenance windows unless
+			// SRE explicitly overrides them, e.g. to mitigate an incident
+			if !doc.OpenShiftCluster.Properties.MaintenanceProfile.Allows(time.Now()) &&
+				!strings.EqualFold(r.URL.Query().Get("ignoreMaintenanceWindows"), "true") {
+				return nil, api.NewCloudError(http.StatusConflict, api.CloudErrorCodeRequestNotAllowed, "", "Request is not allowed outside the cluster's maintenance windows. Retry within a window, or set ignoreMaintenanceWindows=true to override.")
+			}
+
 			doc.OpenShiftCluster.Properties.ProvisioningState = api.ProvisioningStateAdminUpdating
 			doc.OpenShiftCluster.Properties.LastAdminUpdateError = ""
 		} else {
@@ -223,3 +260,15 @@ func (f *frontend) _putOrPatchOpenShiftCluster(ctx context.Context, r *http.Requ
 	}
 	return b, err
 }
+
+// readOnlyEncryptionAtRestProfile returns the read-only fields of p, which a
+// PUT does not have to repeat
+func readOnlyEncryptionAtRestProfile(p *api.EncryptionAtRestProfile) *api.EncryptionAtRestProfile {
+	if p == nil {
+		return nil
+	}
+
+	return &api.EncryptionAtRestProfile{
+		KeyState: p.KeyState,
+	}
+}
@@ -32,7 +32,7 @@ func TestPutOrPatchOpenShiftClusterAdminAPI(t *testing.T) {
 	apis := map[string]*api.Version{
 		"admin": {
 			OpenShiftClusterConverter: api.APIs["admin"].OpenShiftClusterConverter,
-			OpenShiftClusterStaticValidator: func(string, string, deployment.Mode, string) api.OpenShiftClusterStaticValidator {
+			OpenShiftClusterStaticValidator: func(string, string, deployment.Capabilities, string) api.OpenShiftClusterStaticValidator {
 				return &dummyOpenShiftClusterValidator{}
 			},
 			OpenShiftClusterCredentialsConverter: api.APIs["admin"].OpenShiftClusterCredentialsConverter,
@@ -45,6 +45,7 @@ func TestPutOrPatchOpenShiftClusterAdminAPI(t *testing.T) {
 		name           string
 		request        func(*admin.OpenShiftCluster)
 		isPatch        bool
+		query          string
 		fixture        func(*testdatabase.Fixture)
 		wantStatusCode int
 		wantEnriched   []string
@@ -54,6 +55,11 @@ func TestPutOrPatchOpenShiftClusterAdminAPI(t *testing.T) {
 		wantError      string
 	}
 
+	// no day allows maintenance
+	neverMaintained := &api.MaintenanceProfile{
+		ExcludedDays: []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	}
+
 	for _, tt := range []*test{
 		{
 			name: "patch with empty request",
@@ -87,6 +93,7 @@ func TestPutOrPatchOpenShiftClusterAdminAPI(t *testing.T) {
 				c.AddAsyncOperationDocuments(&api.AsyncOperationDocument{
 					OpenShiftClusterKey: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "resourceName")),
 					AsyncOperation: &api.AsyncOperation{
+						APIVersion:               "admin",
 						InitialProvisioningState: api.ProvisioningStateAdminUpdating,
 						ProvisioningState:        api.ProvisioningStateAdminUpdating,
 					},
@@ -156,6 +163,7 @@ func TestPutOrPatchOpenShiftClusterAdminAPI(t *testing.T) {
 				c.AddAsyncOperationDocuments(&api.AsyncOperationDocument{
 					OpenShiftClusterKey: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "resourceName")),
 					AsyncOperation: &api.AsyncOperation{
+						APIVersion:               "admin",
 						InitialProvisioningState: api.ProvisioningStateAdminUpdating,
 						ProvisioningState:        api.ProvisioningStateAdminUpdating,
 					},
@@ -193,6 +201,111 @@ func TestPutOrPatchOpenShiftClusterAdminAPI(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "patch outside the maintenance windows is not allowed",
+			isPatch: true,
+			fixture: func(f *testdatabase.Fixture) {
+				f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+					ID: mockSubID,
+					Subscription: &api.Subscription{
+						State: api.SubscriptionStateRegistered,
+						Properties: &api.SubscriptionProperties{
+							TenantID: "11111111-1111-1111-1111-111111111111",
+						},
+					},
+				})
+				f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					Key: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "resourceName")),
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID:   testdatabase.GetResourcePath(mockSubID, "resourceName"),
+						Type: "Microsoft.RedHatOpenShift/openShiftClusters",
+						Properties: api.OpenShiftClusterProperties{
+							ProvisioningState:  api.ProvisioningStateSucceeded,
+							MaintenanceProfile: neverMaintained,
+						},
+					},
+				})
+			},
+			wantDocuments: func(c *testdatabase.Checker) {
+				c.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					Key: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "resourceName")),
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID:   testdatabase.GetResourcePath(mockSubID, "resourceName"),
+						Type: "Microsoft.RedHatOpenShift/openShiftClusters",
+						Properties: api.OpenShiftClusterProperties{
+							ProvisioningState:  api.ProvisioningStateSucceeded,
+							MaintenanceProfile: neverMaintained,
+						},
+					},
+				})
+			},
+			wantEnriched:   []string{testdatabase.GetResourcePath(mockSubID, "resourceName")},
+			wantStatusCode: http.StatusConflict,
+			wantError:      "409: RequestNotAllowed: : Request is not allowed outside the cluster's maintenance windows. Retry within a window, or set ignoreMaintenanceWindows=true to override.",
+		},
+		{
+			name:    "patch outside the maintenance windows with override",
+			isPatch: true,
+			query:   "&ignoreMaintenanceWindows=true",
+			fixture: func(f *testdatabase.Fixture) {
+				f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+					ID: mockSubID,
+					Subscription: &api.Subscription{
+						State: api.SubscriptionStateRegistered,
+						Properties: &api.SubscriptionProperties{
+							TenantID: "11111111-1111-1111-1111-111111111111",
+						},
+					},
+				})
+				f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					Key: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "resourceName")),
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID:   testdatabase.GetResourcePath(mockSubID, "resourceName"),
+						Type: "Microsoft.RedHatOpenShift/openShiftClusters",
+						Properties: api.OpenShiftClusterProperties{
+							ProvisioningState:  api.ProvisioningStateSucceeded,
+							MaintenanceProfile: neverMaintained,
+						},
+					},
+				})
+			},
+			wantEnriched: []string{testdatabase.GetResourcePath(mockSubID, "resourceName")},
+			wantDocuments: func(c *testdatabase.Checker) {
+				c.AddAsyncOperationDocuments(&api.AsyncOperationDocument{
+					OpenShiftClusterKey: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "resourceName")),
+					AsyncOperation: &api.AsyncOperation{
+						APIVersion:               "admin",
+						InitialProvisioningState: api.ProvisioningStateAdminUpdating,
+						ProvisioningState:        api.ProvisioningStateAdminUpdating,
+					},
+				})
+				c.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					Key: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "resourceName")),
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID:   testdatabase.GetResourcePath(mockSubID, "resourceName"),
+						Type: "Microsoft.RedHatOpenShift/openShiftClusters",
+						Properties: api.OpenShiftClusterProperties{
+							ProvisioningState:     api.ProvisioningStateAdminUpdating,
+							LastProvisioningState: api.ProvisioningStateSucceeded,
+							MaintenanceProfile:    neverMaintained,
+						},
+					},
+				})
+			},
+			wantAsync:      true,
+			wantStatusCode: http.StatusOK,
+			wantResponse: &admin.OpenShiftCluster{
+				ID:   testdatabase.GetResourcePath(mockSubID, "resourceName"),
+				Type: "Microsoft.RedHatOpenShift/openShiftClusters",
+				Properties: admin.OpenShiftClusterProperties{
+					ProvisioningState:     admin.ProvisioningStateAdminUpdating,
+					LastProvisioningState: admin.ProvisioningStateSucceeded,
+					MaintenanceProfile: &admin.MaintenanceProfile{
+						ExcludedDays: neverMaintained.ExcludedDays,
+					},
+				},
+			},
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			ti := newTestInfra(t).
@@ -206,7 +319,7 @@ func TestPutOrPatchOpenShiftClusterAdminAPI(t *testing.T) {
 				t.Fatal(err)
 			}
 
-			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, apis, &noop.Noop{}, nil, nil)
+			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, apis, &noop.Noop{}, nil, nil)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -226,7 +339,7 @@ func TestPutOrPatchOpenShiftClusterAdminAPI(t *testing.T) {
 			}
 
 			resp, b, err := ti.request(method,
-				"https://server"+testdatabase.GetResourcePath(mockSubID, "resourceName")+"?api-version=admin",
+				"https://server"+testdatabase.GetResourcePath(mockSubID, "resourceName")+"?api-version=admin"+tt.query,
 				http.Header{
 					"Content-Type": []string{"application/json"},
 				}, oc)
@@ -276,7 +389,7 @@ func TestPutOrPatchOpenShiftCluster(t *testing.T) {
 	apis := map[string]*api.Version{
 		"2020-04-30": {
 			OpenShiftClusterConverter: api.APIs["2020-04-30"].OpenShiftClusterConverter,
-			OpenShiftClusterStaticValidator: func(string, string, deployment.Mode, string) api.OpenShiftClusterStaticValidator {
+			OpenShiftClusterStaticValidator: func(string, string, deployment.Capabilities, string) api.OpenShiftClusterStaticValidator {
 				return &dummyOpenShiftClusterValidator{}
 			},
 			OpenShiftClusterCredentialsConverter: api.APIs["2020-04-30"].OpenShiftClusterCredentialsConverter,
@@ -302,7 +415,7 @@ func TestPutOrPatchOpenShiftCluster(t *testing.T) {
 		{
 			name: "create a new cluster",
 			request: func(oc *v20200430.OpenShiftCluster) {
-				oc.Properties.ClusterProfile.Version = "4.3.0"
+				oc.Properties.ClusterProfile.Version = version.InstallStream.Version.String()
 			},
 			fixture: func(f *testdatabase.Fixture) {
 				f.AddSubscriptionDocuments(&api.SubscriptionDocument{
@@ -319,6 +432,7 @@ func TestPutOrPatchOpenShiftCluster(t *testing.T) {
 				c.AddAsyncOperationDocuments(&api.AsyncOperationDocument{
 					OpenShiftClusterKey: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "resourceName")),
 					AsyncOperation: &api.AsyncOperation{
+						APIVersion:               "2020-04-30",
 						InitialProvisioningState: api.ProvisioningStateCreating,
 						ProvisioningState:        api.ProvisioningStateCreating,
 					},
@@ -336,7 +450,7 @@ func TestPutOrPatchOpenShiftCluster(t *testing.T) {
 							ProvisionedBy:       version.GitCommit,
 							CreatedBy:           version.GitCommit,
 							ClusterProfile: api.ClusterProfile{
-								Version: "4.3.0",
+								Version: version.InstallStream.Version.String(),
 							},
 							ServicePrincipalProfile: api.ServicePrincipalProfile{
 								TenantID: "11111111-1111-1111-1111-111111111111",
@@ -355,11 +469,37 @@ func TestPutOrPatchOpenShiftCluster(t *testing.T) {
 				Properties: v20200430.OpenShiftClusterProperties{
 					ProvisioningState: v20200430.ProvisioningStateCreating,
 					ClusterProfile: v20200430.ClusterProfile{
-						Version: "4.3.0",
+						Version: version.InstallStream.Version.String(),
 					},
 				},
 			},
 		},
+		{
+			name: "create a new cluster with a deprecated version",
+			request: func(oc *v20200430.OpenShiftCluster) {
+				oc.Properties.ClusterProfile.Version = version.InstallStream.Version.String()
+			},
+			fixture: func(f *testdatabase.Fixture) {
+				f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+					ID: mockSubID,
+					Subscription: &api.Subscription{
+						State: api.SubscriptionStateRegistered,
+						Properties: &api.SubscriptionProperties{
+							TenantID: "11111111-1111-1111-1111-111111111111",
+						},
+					},
+				})
+				f.AddOpenShiftVersionDocuments(&api.OpenShiftVersionDocument{
+					ID: version.InstallStream.Version.String(),
+					OpenShiftVersion: &api.OpenShiftVersion{
+						Version:           version.InstallStream.Version.String(),
+						OpenShiftPullspec: version.InstallStream.PullSpec,
+					},
+				})
+			},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      fmt.Sprintf("400: InvalidParameter: properties.clusterProfile.version: The provided version '%s' is invalid.", version.InstallStream.Version.String()),
+		},
 		{
 			name: "update a cluster from succeeded",
 			request: func(oc *v20200430.OpenShiftCluster) {
@@ -401,6 +541,7 @@ func TestPutOrPatchOpenShiftCluster(t *testing.T) {
 				c.AddAsyncOperationDocuments(&api.AsyncOperationDocument{
 					OpenShiftClusterKey: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "resourceName")),
 					AsyncOperation: &api.AsyncOperation{
+						APIVersion:               "2020-04-30",
 						InitialProvisioningState: api.ProvisioningStateUpdating,
 						ProvisioningState:        api.ProvisioningStateUpdating,
 					},
@@ -475,6 +616,7 @@ func TestPutOrPatchOpenShiftCluster(t *testing.T) {
 				c.AddAsyncOperationDocuments(&api.AsyncOperationDocument{
 					OpenShiftClusterKey: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "resourceName")),
 					AsyncOperation: &api.AsyncOperation{
+						APIVersion:               "2020-04-30",
 						InitialProvisioningState: api.ProvisioningStateUpdating,
 						ProvisioningState:        api.ProvisioningStateUpdating,
 					},
@@ -610,6 +752,7 @@ func TestPutOrPatchOpenShiftCluster(t *testing.T) {
 				c.AddAsyncOperationDocuments(&api.AsyncOperationDocument{
 					OpenShiftClusterKey: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "resourceName")),
 					AsyncOperation: &api.AsyncOperation{
+						APIVersion:               "2020-04-30",
 						InitialProvisioningState: api.ProvisioningStateUpdating,
 						ProvisioningState:        api.ProvisioningStateUpdating,
 					},
@@ -687,6 +830,7 @@ func TestPutOrPatchOpenShiftCluster(t *testing.T) {
 				c.AddAsyncOperationDocuments(&api.AsyncOperationDocument{
 					OpenShiftClusterKey: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "resourceName")),
 					AsyncOperation: &api.AsyncOperation{
+						APIVersion:               "2020-04-30",
 						InitialProvisioningState: api.ProvisioningStateUpdating,
 						ProvisioningState:        api.ProvisioningStateUpdating,
 					},
@@ -876,7 +1020,8 @@ func TestPutOrPatchOpenShiftCluster(t *testing.T) {
 			ti := newTestInfra(t).
 				WithOpenShiftClusters().
 				WithSubscriptions().
-				WithAsyncOperations()
+				WithAsyncOperations().
+				WithOpenShiftVersions()
 			defer ti.done()
 
 			err := ti.buildFixtures(tt.fixture)
@@ -884,7 +1029,7 @@ func TestPutOrPatchOpenShiftCluster(t *testing.T) {
 				t.Fatal(err)
 			}
 
-			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, apis, &noop.Noop{}, nil, nil)
+			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, apis, &noop.Noop{}, nil, nil)
 			if err != nil {
 				t.Fatal(err)
 			}
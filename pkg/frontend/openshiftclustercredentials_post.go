@@ -63,6 +63,10 @@ func (f *frontend) _postOpenShiftClusterCredentials(ctx context.Context, r *http
 		return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeRequestNotAllowed, "", "Request is not allowed in provisioningState '%s'.", doc.OpenShiftCluster.Properties.ProvisioningState)
 	}
 
+	if doc.OpenShiftCluster.Properties.EncryptionAtRestProfile.IsInaccessible() {
+		return nil, doc.OpenShiftCluster.Properties.EncryptionAtRestProfile.InaccessibleError()
+	}
+
 	doc.OpenShiftCluster.Properties.ClusterProfile.PullSecret = ""
 	doc.OpenShiftCluster.Properties.ServicePrincipalProfile.ClientSecret = ""
 
@@ -157,6 +157,39 @@ func TestPostOpenShiftClusterCredentials(t *testing.T) {
 			wantStatusCode: http.StatusBadRequest,
 			wantError:      `400: RequestNotAllowed: : Request is not allowed in provisioningState 'Deleting'.`,
 		},
+		{
+			name:       "cluster encryption key is inaccessible",
+			resourceID: fmt.Sprintf("/subscriptions/%s/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName", mockSubID),
+			fixture: func(f *testdatabase.Fixture) {
+				f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					Key: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "resourceName")),
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID:   testdatabase.GetResourcePath(mockSubID, "resourceName"),
+						Name: "resourceName",
+						Type: "Microsoft.RedHatOpenShift/openshiftClusters",
+						Properties: api.OpenShiftClusterProperties{
+							ProvisioningState: api.ProvisioningStateSucceeded,
+							EncryptionAtRestProfile: &api.EncryptionAtRestProfile{
+								KeyVaultKeyID:   "https://vault.vault.azure.net/keys/key/0123456789abcdef0123456789abcdef",
+								KeyState:        api.EncryptionKeyStateInaccessible,
+								KeyStateMessage: "access denied",
+							},
+						},
+					},
+				})
+				f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+					ID: mockSubID,
+					Subscription: &api.Subscription{
+						State: api.SubscriptionStateRegistered,
+						Properties: &api.SubscriptionProperties{
+							TenantID: "11111111-1111-1111-1111-111111111111",
+						},
+					},
+				})
+			},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `400: EncryptionKeyInaccessible: properties.encryptionAtRestProfile.keyVaultKeyId: The key vault key 'https://vault.vault.azure.net/keys/key/0123456789abcdef0123456789abcdef' which encrypts the cluster's secrets is inaccessible: access denied. Restore the resource provider's wrapKey and unwrapKey permissions on the key and retry.`,
+		},
 		{
 			name:       "cluster failed to create",
 			resourceID: fmt.Sprintf("/subscriptions/%s/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName", mockSubID),
@@ -266,7 +299,7 @@ func TestPostOpenShiftClusterCredentials(t *testing.T) {
 				t.Fatal(err)
 			}
 
-			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, apis, &noop.Noop{}, nil, nil)
+			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, apis, &noop.Noop{}, nil, nil)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -0,0 +1,76 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+	"github.com/Azure/ARO-RP/pkg/util/deletepreflight"
+)
+
+type deletePreflightFactory func(*logrus.Entry, env.Interface, *api.OpenShiftCluster, *api.SubscriptionDocument) (deletepreflight.Interface, error)
+
+func (f *frontend) postOpenShiftClusterDeletePreflight(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	vars := mux.Vars(r)
+
+	if f.apis[vars["api-version"]].DeletePreflightConverter == nil {
+		api.WriteError(w, http.StatusBadRequest, api.CloudErrorCodeInvalidResourceType, "", "The resource type '%s' could not be found in the namespace '%s' for api version '%s'.", vars["resourceType"], vars["resourceProviderNamespace"], vars["api-version"])
+		return
+	}
+
+	body := r.Context().Value(middleware.ContextKeyBody).([]byte)
+	if len(body) > 0 && !json.Valid(body) {
+		api.WriteError(w, http.StatusBadRequest, api.CloudErrorCodeInvalidRequestContent, "", "The request content was invalid and could not be deserialized.")
+		return
+	}
+
+	b, err := f._postOpenShiftClusterDeletePreflight(ctx, log, r, f.apis[vars["api-version"]].DeletePreflightConverter())
+
+	reply(log, w, nil, b, err)
+}
+
+func (f *frontend) _postOpenShiftClusterDeletePreflight(ctx context.Context, log *logrus.Entry, r *http.Request, converter api.DeletePreflightConverter) ([]byte, error) {
+	vars := mux.Vars(r)
+	resourceID := filepath.Dir(r.URL.Path)
+
+	// the preflight is allowed in the same subscription states as the delete
+	// itself, and in any provisioning state: an operation in progress is
+	// reported as a blocking condition
+	subscriptionDoc, err := f.validateSubscriptionState(ctx, resourceID, api.SubscriptionStateRegistered, api.SubscriptionStateWarned, api.SubscriptionStateSuspended)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := f.dbOpenShiftClusters.Get(ctx, resourceID)
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		return nil, api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeResourceNotFound, "", "The Resource '%s/%s' under resource group '%s' was not found.", vars["resourceType"], vars["resourceName"], vars["resourceGroupName"])
+	case err != nil:
+		return nil, err
+	}
+
+	d, err := f.deletePreflightFactory(log, f.env, doc.OpenShiftCluster, subscriptionDoc)
+	if err != nil {
+		return nil, err
+	}
+
+	dp, err := d.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(converter.ToExternal(dp), "", "    ")
+}
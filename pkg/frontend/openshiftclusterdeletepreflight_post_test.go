@@ -0,0 +1,216 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	v20201031preview "github.com/Azure/ARO-RP/pkg/api/v20201031preview"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	"github.com/Azure/ARO-RP/pkg/util/deletepreflight"
+	mock_deletepreflight "github.com/Azure/ARO-RP/pkg/util/mocks/deletepreflight"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestPostOpenShiftClusterDeletePreflight(t *testing.T) {
+	ctx := context.Background()
+
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	resourceID := testdatabase.GetResourcePath(mockSubID, "resourceName")
+
+	subscription := func(state api.SubscriptionState) func(*testdatabase.Fixture) {
+		return func(f *testdatabase.Fixture) {
+			f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+				ID: mockSubID,
+				Subscription: &api.Subscription{
+					State: state,
+					Properties: &api.SubscriptionProperties{
+						TenantID: "11111111-1111-1111-1111-111111111111",
+					},
+				},
+			})
+		}
+	}
+
+	fixture := func(subscriptionState api.SubscriptionState) func(*testdatabase.Fixture) {
+		return func(f *testdatabase.Fixture) {
+			f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				Key: strings.ToLower(resourceID),
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID:   resourceID,
+					Name: "resourceName",
+					Type: "Microsoft.RedHatOpenShift/openShiftClusters",
+					Properties: api.OpenShiftClusterProperties{
+						ProvisioningState:       api.ProvisioningStateFailed,
+						FailedProvisioningState: api.ProvisioningStateCreating,
+					},
+				},
+			})
+			subscription(subscriptionState)(f)
+		}
+	}
+
+	type test struct {
+		name           string
+		apiVersion     string
+		fixture        func(*testdatabase.Fixture)
+		mocks          func(*mock_deletepreflight.MockInterface)
+		wantStatusCode int
+		wantResponse   *v20201031preview.DeletePreflight
+		wantError      string
+	}
+
+	for _, tt := range []*test{
+		{
+			name:    "delete preflight is returned",
+			fixture: fixture(api.SubscriptionStateRegistered),
+			mocks: func(d *mock_deletepreflight.MockInterface) {
+				d.EXPECT().
+					Get(gomock.Any()).
+					Return(&api.DeletePreflight{
+						ID:   resourceID + "/deletePreflight",
+						Name: "deletePreflight",
+						Type: "Microsoft.RedHatOpenShift/openShiftClusters/deletePreflight",
+						Properties: api.DeletePreflightProperties{
+							BlockingConditions: []api.DeletePreflightCondition{
+								{
+									Code:       api.DeletePreflightConditionCodeDeleteProtected,
+									Message:    "message",
+									ResourceID: "lockId",
+								},
+							},
+							DeletedResources: []api.DeletePreflightResource{
+								{
+									ID:     "diskId",
+									Type:   "Microsoft.Compute/disks",
+									Impact: "impact",
+								},
+							},
+						},
+					}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse: &v20201031preview.DeletePreflight{
+				ID:   resourceID + "/deletePreflight",
+				Name: "deletePreflight",
+				Type: "Microsoft.RedHatOpenShift/openShiftClusters/deletePreflight",
+				Properties: v20201031preview.DeletePreflightProperties{
+					BlockingConditions: []v20201031preview.DeletePreflightCondition{
+						{
+							Code:       v20201031preview.DeletePreflightConditionCodeDeleteProtected,
+							Message:    "message",
+							ResourceID: "lockId",
+						},
+					},
+					DeletedResources: []v20201031preview.DeletePreflightResource{
+						{
+							ID:     "diskId",
+							Type:   "Microsoft.Compute/disks",
+							Impact: "impact",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "delete preflight is allowed in a suspended subscription",
+			fixture: fixture(api.SubscriptionStateSuspended),
+			mocks: func(d *mock_deletepreflight.MockInterface) {
+				d.EXPECT().
+					Get(gomock.Any()).
+					Return(&api.DeletePreflight{
+						Properties: api.DeletePreflightProperties{
+							CanDelete: true,
+						},
+					}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse: &v20201031preview.DeletePreflight{
+				Properties: v20201031preview.DeletePreflightProperties{
+					CanDelete: true,
+				},
+			},
+		},
+		{
+			name:    "internal error",
+			fixture: fixture(api.SubscriptionStateRegistered),
+			mocks: func(d *mock_deletepreflight.MockInterface) {
+				d.EXPECT().
+					Get(gomock.Any()).
+					Return(nil, fmt.Errorf("random error"))
+			},
+			wantStatusCode: http.StatusInternalServerError,
+			wantError:      `500: InternalServerError: : Internal server error.`,
+		},
+		{
+			name:           "delete preflight is not available in the API version",
+			apiVersion:     "2020-04-30",
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `400: InvalidResourceType: : The resource type 'openshiftclusters' could not be found in the namespace 'microsoft.redhatopenshift' for api version '2020-04-30'.`,
+		},
+		{
+			name:           "cluster not found in db",
+			fixture:        subscription(api.SubscriptionStateRegistered),
+			wantStatusCode: http.StatusNotFound,
+			wantError:      `404: ResourceNotFound: : The Resource 'openshiftclusters/resourcename' under resource group 'resourcegroup' was not found.`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithOpenShiftClusters().WithSubscriptions()
+			defer ti.done()
+
+			d := mock_deletepreflight.NewMockInterface(ti.controller)
+			if tt.mocks != nil {
+				tt.mocks(d)
+			}
+
+			err := ti.buildFixtures(tt.fixture)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, api.APIs, &noop.Noop{}, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f.(*frontend).deletePreflightFactory = func(*logrus.Entry, env.Interface, *api.OpenShiftCluster, *api.SubscriptionDocument) (deletepreflight.Interface, error) {
+				return d, nil
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			reqAPIVersion := v20201031preview.APIVersion
+			if tt.apiVersion != "" {
+				reqAPIVersion = tt.apiVersion
+			}
+
+			resp, b, err := ti.request(http.MethodPost,
+				fmt.Sprintf("https://server%s/deletepreflight?api-version=%s", resourceID, reqAPIVersion),
+				nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var wantResponse interface{}
+			if tt.wantResponse != nil {
+				wantResponse = tt.wantResponse
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, wantResponse)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+func (f *frontend) getOpenShiftClusterDetector(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	vars := mux.Vars(r)
+
+	if f.apis[vars["api-version"]].DetectorConverter == nil {
+		api.WriteError(w, http.StatusBadRequest, api.CloudErrorCodeInvalidResourceType, "", "The resource type '%s' could not be found in the namespace '%s' for api version '%s'.", vars["resourceType"], vars["resourceProviderNamespace"], vars["api-version"])
+		return
+	}
+
+	b, err := f._getOpenShiftClusterDetector(ctx, r, log, f.apis[vars["api-version"]].DetectorConverter())
+
+	reply(log, w, nil, b, err)
+}
+
+func (f *frontend) _getOpenShiftClusterDetector(ctx context.Context, r *http.Request, log *logrus.Entry, converter api.DetectorConverter) ([]byte, error) {
+	vars := mux.Vars(r)
+
+	d, err := f.newDetectors(ctx, r, log, filepath.Dir(filepath.Dir(r.URL.Path)))
+	if err != nil {
+		return nil, err
+	}
+
+	detector, err := d.Get(ctx, vars["detectorName"])
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(converter.ToExternal(detector), "", "    ")
+}
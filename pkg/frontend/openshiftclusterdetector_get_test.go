@@ -0,0 +1,194 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	v20201031preview "github.com/Azure/ARO-RP/pkg/api/v20201031preview"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/frontend/detectors"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	mock_detectors "github.com/Azure/ARO-RP/pkg/util/mocks/detectors"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestGetOpenShiftClusterDetector(t *testing.T) {
+	ctx := context.Background()
+
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	resourceID := testdatabase.GetResourcePath(mockSubID, "resourceName")
+
+	fixture := func(provisioningState api.ProvisioningState) func(*testdatabase.Fixture) {
+		return func(f *testdatabase.Fixture) {
+			f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				Key: strings.ToLower(resourceID),
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID:   resourceID,
+					Name: "resourceName",
+					Type: "Microsoft.RedHatOpenShift/openShiftClusters",
+					Properties: api.OpenShiftClusterProperties{
+						ProvisioningState: provisioningState,
+					},
+				},
+			})
+			f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+				ID: mockSubID,
+				Subscription: &api.Subscription{
+					State: api.SubscriptionStateRegistered,
+					Properties: &api.SubscriptionProperties{
+						TenantID: "11111111-1111-1111-1111-111111111111",
+					},
+				},
+			})
+		}
+	}
+
+	type test struct {
+		name           string
+		apiVersion     string
+		fixture        func(*testdatabase.Fixture)
+		mocks          func(*mock_detectors.MockInterface)
+		wantStatusCode int
+		wantResponse   *v20201031preview.Detector
+		wantError      string
+	}
+
+	for _, tt := range []*test{
+		{
+			name:    "detector runs",
+			fixture: fixture(api.ProvisioningStateSucceeded),
+			mocks: func(d *mock_detectors.MockInterface) {
+				d.EXPECT().
+					Get(gomock.Any(), "nodehealth").
+					Return(&api.Detector{
+						ID:   resourceID + "/detectors/nodehealth",
+						Name: "nodehealth",
+						Type: "Microsoft.RedHatOpenShift/openShiftClusters/detectors",
+						Properties: api.DetectorProperties{
+							Title:  "Node health",
+							Status: api.DetectorStatusUnhealthy,
+							Findings: []api.DetectorFinding{
+								{
+									Message:     "Node 'worker' is not ready: ",
+									Remediation: "remediation",
+								},
+							},
+						},
+					}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse: &v20201031preview.Detector{
+				ID:   resourceID + "/detectors/nodehealth",
+				Name: "nodehealth",
+				Type: "Microsoft.RedHatOpenShift/openShiftClusters/detectors",
+				Properties: v20201031preview.DetectorProperties{
+					Title:  "Node health",
+					Status: v20201031preview.DetectorStatusUnhealthy,
+					Findings: []v20201031preview.DetectorFinding{
+						{
+							Message:     "Node 'worker' is not ready: ",
+							Remediation: "remediation",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "detector not found",
+			fixture: fixture(api.ProvisioningStateSucceeded),
+			mocks: func(d *mock_detectors.MockInterface) {
+				d.EXPECT().
+					Get(gomock.Any(), "nodehealth").
+					Return(nil, api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeNotFound, "", "The detector 'nodehealth' was not found."))
+			},
+			wantStatusCode: http.StatusNotFound,
+			wantError:      `404: NotFound: : The detector 'nodehealth' was not found.`,
+		},
+		{
+			name:           "detectors are not available in the API version",
+			apiVersion:     "2020-04-30",
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `400: InvalidResourceType: : The resource type 'openshiftclusters' could not be found in the namespace 'microsoft.redhatopenshift' for api version '2020-04-30'.`,
+		},
+		{
+			name:           "cluster in creating state",
+			fixture:        fixture(api.ProvisioningStateCreating),
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `400: RequestNotAllowed: : Request is not allowed in provisioningState 'Creating'.`,
+		},
+		{
+			name: "cluster not found in db",
+			fixture: func(f *testdatabase.Fixture) {
+				f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+					ID: mockSubID,
+					Subscription: &api.Subscription{
+						State: api.SubscriptionStateRegistered,
+						Properties: &api.SubscriptionProperties{
+							TenantID: "11111111-1111-1111-1111-111111111111",
+						},
+					},
+				})
+			},
+			wantStatusCode: http.StatusNotFound,
+			wantError:      `404: ResourceNotFound: : The Resource 'openshiftclusters/resourcename' under resource group 'resourcegroup' was not found.`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithOpenShiftClusters().WithSubscriptions()
+			defer ti.done()
+
+			d := mock_detectors.NewMockInterface(ti.controller)
+			if tt.mocks != nil {
+				tt.mocks(d)
+			}
+
+			err := ti.buildFixtures(tt.fixture)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, api.APIs, &noop.Noop{}, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f.(*frontend).detectorsFactory = func(*logrus.Entry, env.Interface, *api.OpenShiftCluster, *api.SubscriptionDocument) (detectors.Interface, error) {
+				return d, nil
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			reqAPIVersion := v20201031preview.APIVersion
+			if tt.apiVersion != "" {
+				reqAPIVersion = tt.apiVersion
+			}
+
+			resp, b, err := ti.request(http.MethodGet,
+				fmt.Sprintf("https://server%s/detectors/nodehealth?api-version=%s", resourceID, reqAPIVersion),
+				nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var wantResponse interface{}
+			if tt.wantResponse != nil {
+				wantResponse = tt.wantResponse
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, wantResponse)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
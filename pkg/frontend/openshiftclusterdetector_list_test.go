@@ -0,0 +1,108 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	v20201031preview "github.com/Azure/ARO-RP/pkg/api/v20201031preview"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/frontend/detectors"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	mock_detectors "github.com/Azure/ARO-RP/pkg/util/mocks/detectors"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestGetOpenShiftClusterDetectors(t *testing.T) {
+	ctx := context.Background()
+
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	resourceID := testdatabase.GetResourcePath(mockSubID, "resourceName")
+
+	ti := newTestInfra(t).WithOpenShiftClusters().WithSubscriptions()
+	defer ti.done()
+
+	d := mock_detectors.NewMockInterface(ti.controller)
+	d.EXPECT().
+		List().
+		Return([]*api.Detector{
+			{
+				ID:   resourceID + "/detectors/nodehealth",
+				Name: "nodehealth",
+				Type: "Microsoft.RedHatOpenShift/openShiftClusters/detectors",
+				Properties: api.DetectorProperties{
+					Title:       "Node health",
+					Description: "description",
+				},
+			},
+		})
+
+	err := ti.buildFixtures(func(f *testdatabase.Fixture) {
+		f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+			Key: strings.ToLower(resourceID),
+			OpenShiftCluster: &api.OpenShiftCluster{
+				ID:   resourceID,
+				Name: "resourceName",
+				Type: "Microsoft.RedHatOpenShift/openShiftClusters",
+				Properties: api.OpenShiftClusterProperties{
+					ProvisioningState: api.ProvisioningStateSucceeded,
+				},
+			},
+		})
+		f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+			ID: mockSubID,
+			Subscription: &api.Subscription{
+				State: api.SubscriptionStateRegistered,
+				Properties: &api.SubscriptionProperties{
+					TenantID: "11111111-1111-1111-1111-111111111111",
+				},
+			},
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, api.APIs, &noop.Noop{}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f.(*frontend).detectorsFactory = func(*logrus.Entry, env.Interface, *api.OpenShiftCluster, *api.SubscriptionDocument) (detectors.Interface, error) {
+		return d, nil
+	}
+
+	go f.Run(ctx, nil, nil)
+
+	resp, b, err := ti.request(http.MethodGet,
+		fmt.Sprintf("https://server%s/detectors?api-version=%s", resourceID, v20201031preview.APIVersion),
+		nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = validateResponse(resp, b, http.StatusOK, "", &v20201031preview.DetectorList{
+		Detectors: []*v20201031preview.Detector{
+			{
+				ID:   resourceID + "/detectors/nodehealth",
+				Name: "nodehealth",
+				Type: "Microsoft.RedHatOpenShift/openShiftClusters/detectors",
+				Properties: v20201031preview.DetectorProperties{
+					Title:       "Node health",
+					Description: "description",
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
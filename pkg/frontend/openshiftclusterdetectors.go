@@ -0,0 +1,61 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/frontend/detectors"
+)
+
+type detectorsFactory func(*logrus.Entry, env.Interface, *api.OpenShiftCluster,
+	*api.SubscriptionDocument) (detectors.Interface, error)
+
+// newDetectors returns the detectors for the cluster with the given resource
+// ID, checking that the cluster exists and is in a state where it can be
+// diagnosed
+func (f *frontend) newDetectors(ctx context.Context, r *http.Request, log *logrus.Entry, resourceID string) (detectors.Interface, error) {
+	doc, subscriptionDoc, err := f.getInstalledOpenShiftCluster(ctx, r, resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.detectorsFactory(log, f.env, doc.OpenShiftCluster, subscriptionDoc)
+}
+
+// getInstalledOpenShiftCluster returns the cluster with the given resource ID
+// and its subscription, checking that the cluster exists and is neither being
+// created nor deleted, i.e. that it can be queried
+func (f *frontend) getInstalledOpenShiftCluster(ctx context.Context, r *http.Request, resourceID string) (*api.OpenShiftClusterDocument, *api.SubscriptionDocument, error) {
+	vars := mux.Vars(r)
+
+	subscriptionDoc, err := f.validateSubscriptionState(ctx, resourceID, api.SubscriptionStateRegistered)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	doc, err := f.dbOpenShiftClusters.Get(ctx, resourceID)
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		return nil, nil, api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeResourceNotFound, "", "The Resource '%s/%s' under resource group '%s' was not found.", vars["resourceType"], vars["resourceName"], vars["resourceGroupName"])
+	case err != nil:
+		return nil, nil, err
+	}
+
+	if doc.OpenShiftCluster.Properties.ProvisioningState == api.ProvisioningStateCreating ||
+		doc.OpenShiftCluster.Properties.ProvisioningState == api.ProvisioningStateDeleting ||
+		doc.OpenShiftCluster.Properties.ProvisioningState == api.ProvisioningStateFailed && doc.OpenShiftCluster.Properties.FailedProvisioningState == api.ProvisioningStateCreating ||
+		doc.OpenShiftCluster.Properties.ProvisioningState == api.ProvisioningStateFailed && doc.OpenShiftCluster.Properties.FailedProvisioningState == api.ProvisioningStateDeleting {
+		return nil, nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeRequestNotAllowed, "", "Request is not allowed in provisioningState '%s'.", doc.OpenShiftCluster.Properties.ProvisioningState)
+	}
+
+	return doc, subscriptionDoc, nil
+}
@@ -0,0 +1,55 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+	"github.com/Azure/ARO-RP/pkg/util/egress"
+)
+
+type egressFactory func(env.Interface, *api.OpenShiftCluster, *api.SubscriptionDocument) (egress.Interface, error)
+
+func (f *frontend) getOpenShiftClusterEgress(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	vars := mux.Vars(r)
+
+	if f.apis[vars["api-version"]].EgressConverter == nil {
+		api.WriteError(w, http.StatusBadRequest, api.CloudErrorCodeInvalidResourceType, "", "The resource type '%s' could not be found in the namespace '%s' for api version '%s'.", vars["resourceType"], vars["resourceProviderNamespace"], vars["api-version"])
+		return
+	}
+
+	b, err := f._getOpenShiftClusterEgress(ctx, r, f.apis[vars["api-version"]].EgressConverter())
+
+	reply(log, w, nil, b, err)
+}
+
+func (f *frontend) _getOpenShiftClusterEgress(ctx context.Context, r *http.Request, converter api.EgressConverter) ([]byte, error) {
+	doc, subscriptionDoc, err := f.getInstalledOpenShiftCluster(ctx, r, filepath.Dir(r.URL.Path))
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := f.egressFactory(f.env, doc.OpenShiftCluster, subscriptionDoc)
+	if err != nil {
+		return nil, err
+	}
+
+	eg, err := e.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(converter.ToExternal(eg), "", "    ")
+}
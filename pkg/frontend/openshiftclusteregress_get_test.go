@@ -0,0 +1,188 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	v20201031preview "github.com/Azure/ARO-RP/pkg/api/v20201031preview"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	"github.com/Azure/ARO-RP/pkg/util/egress"
+	mock_egress "github.com/Azure/ARO-RP/pkg/util/mocks/egress"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestGetOpenShiftClusterEgress(t *testing.T) {
+	ctx := context.Background()
+
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	resourceID := testdatabase.GetResourcePath(mockSubID, "resourceName")
+
+	subscription := func(f *testdatabase.Fixture) {
+		f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+			ID: mockSubID,
+			Subscription: &api.Subscription{
+				State: api.SubscriptionStateRegistered,
+				Properties: &api.SubscriptionProperties{
+					TenantID: "11111111-1111-1111-1111-111111111111",
+				},
+			},
+		})
+	}
+
+	fixture := func(provisioningState, failedProvisioningState api.ProvisioningState) func(*testdatabase.Fixture) {
+		return func(f *testdatabase.Fixture) {
+			f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				Key: strings.ToLower(resourceID),
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID:   resourceID,
+					Name: "resourceName",
+					Type: "Microsoft.RedHatOpenShift/openShiftClusters",
+					Properties: api.OpenShiftClusterProperties{
+						ProvisioningState:       provisioningState,
+						FailedProvisioningState: failedProvisioningState,
+					},
+				},
+			})
+			subscription(f)
+		}
+	}
+
+	type test struct {
+		name           string
+		apiVersion     string
+		fixture        func(*testdatabase.Fixture)
+		mocks          func(*mock_egress.MockInterface)
+		wantStatusCode int
+		wantResponse   *v20201031preview.Egress
+		wantError      string
+	}
+
+	for _, tt := range []*test{
+		{
+			name:    "egress is returned",
+			fixture: fixture(api.ProvisioningStateSucceeded, ""),
+			mocks: func(e *mock_egress.MockInterface) {
+				e.EXPECT().
+					Get(gomock.Any()).
+					Return(&api.Egress{
+						ID:   resourceID + "/egress",
+						Name: "egress",
+						Type: "Microsoft.RedHatOpenShift/openShiftClusters/egress",
+						Properties: api.EgressProperties{
+							OutboundIPs: []string{"20.0.0.1"},
+							RequiredEndpoints: []api.EgressEndpoint{
+								{
+									Host:    "arosvc.azurecr.io",
+									Port:    443,
+									Purpose: "purpose",
+								},
+							},
+						},
+					}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse: &v20201031preview.Egress{
+				ID:   resourceID + "/egress",
+				Name: "egress",
+				Type: "Microsoft.RedHatOpenShift/openShiftClusters/egress",
+				Properties: v20201031preview.EgressProperties{
+					OutboundIPs: []string{"20.0.0.1"},
+					RequiredEndpoints: []v20201031preview.EgressEndpoint{
+						{
+							Host:    "arosvc.azurecr.io",
+							Port:    443,
+							Purpose: "purpose",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "internal error",
+			fixture: fixture(api.ProvisioningStateSucceeded, ""),
+			mocks: func(e *mock_egress.MockInterface) {
+				e.EXPECT().
+					Get(gomock.Any()).
+					Return(nil, fmt.Errorf("random error"))
+			},
+			wantStatusCode: http.StatusInternalServerError,
+			wantError:      `500: InternalServerError: : Internal server error.`,
+		},
+		{
+			name:           "egress is not available in the API version",
+			apiVersion:     "2020-04-30",
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `400: InvalidResourceType: : The resource type 'openshiftclusters' could not be found in the namespace 'microsoft.redhatopenshift' for api version '2020-04-30'.`,
+		},
+		{
+			name:           "cluster failed to create",
+			fixture:        fixture(api.ProvisioningStateFailed, api.ProvisioningStateCreating),
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `400: RequestNotAllowed: : Request is not allowed in provisioningState 'Failed'.`,
+		},
+		{
+			name:           "cluster not found in db",
+			fixture:        subscription,
+			wantStatusCode: http.StatusNotFound,
+			wantError:      `404: ResourceNotFound: : The Resource 'openshiftclusters/resourcename' under resource group 'resourcegroup' was not found.`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithOpenShiftClusters().WithSubscriptions()
+			defer ti.done()
+
+			e := mock_egress.NewMockInterface(ti.controller)
+			if tt.mocks != nil {
+				tt.mocks(e)
+			}
+
+			err := ti.buildFixtures(tt.fixture)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, api.APIs, &noop.Noop{}, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f.(*frontend).egressFactory = func(env.Interface, *api.OpenShiftCluster, *api.SubscriptionDocument) (egress.Interface, error) {
+				return e, nil
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			reqAPIVersion := v20201031preview.APIVersion
+			if tt.apiVersion != "" {
+				reqAPIVersion = tt.apiVersion
+			}
+
+			resp, b, err := ti.request(http.MethodGet,
+				fmt.Sprintf("https://server%s/egress?api-version=%s", resourceID, reqAPIVersion),
+				nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var wantResponse interface{}
+			if tt.wantResponse != nil {
+				wantResponse = tt.wantResponse
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, wantResponse)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
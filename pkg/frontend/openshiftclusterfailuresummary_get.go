@@ -0,0 +1,74 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+func (f *frontend) getOpenShiftClusterFailureSummary(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	vars := mux.Vars(r)
+
+	if f.apis[vars["api-version"]].FailureSummaryConverter == nil {
+		api.WriteError(w, http.StatusBadRequest, api.CloudErrorCodeInvalidResourceType, "", "The resource type '%s' could not be found in the namespace '%s' for api version '%s'.", vars["resourceType"], vars["resourceProviderNamespace"], vars["api-version"])
+		return
+	}
+
+	b, err := f._getOpenShiftClusterFailureSummary(ctx, r, f.apis[vars["api-version"]].FailureSummaryConverter())
+
+	reply(log, w, nil, b, err)
+}
+
+func (f *frontend) _getOpenShiftClusterFailureSummary(ctx context.Context, r *http.Request, converter api.FailureSummaryConverter) ([]byte, error) {
+	vars := mux.Vars(r)
+	resourceID := filepath.Dir(r.URL.Path)
+
+	_, err := f.validateSubscriptionState(ctx, resourceID, api.SubscriptionStateRegistered)
+	if err != nil {
+		return nil, err
+	}
+
+	// unlike the other sub-resources, the failure summary is served whatever
+	// the provisioning state: failed installs are what it is for
+	doc, err := f.dbOpenShiftClusters.Get(ctx, resourceID)
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		return nil, api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeResourceNotFound, "", "The Resource '%s/%s' under resource group '%s' was not found.", vars["resourceType"], vars["resourceName"], vars["resourceGroupName"])
+	case err != nil:
+		return nil, err
+	}
+
+	failure := doc.OpenShiftCluster.Properties.LastOperationFailure
+	if failure == nil {
+		return nil, api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeNotFound, "", "No failed install or update of the Resource '%s/%s' under resource group '%s' was recorded.", vars["resourceType"], vars["resourceName"], vars["resourceGroupName"])
+	}
+
+	fs := &api.FailureSummary{
+		ID:   doc.OpenShiftCluster.ID + "/failureSummary",
+		Name: "failureSummary",
+		Type: doc.OpenShiftCluster.Type + "/failureSummary",
+		Properties: api.FailureSummaryProperties{
+			Operation:      failure.Operation,
+			FailedAt:       failure.Time,
+			Category:       failure.Category,
+			Code:           failure.Code,
+			Message:        failure.Message,
+			AzureErrorCode: failure.AzureErrorCode,
+		},
+	}
+
+	return json.MarshalIndent(converter.ToExternal(fs), "", "    ")
+}
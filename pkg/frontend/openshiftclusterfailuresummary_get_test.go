@@ -0,0 +1,152 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	v20201031preview "github.com/Azure/ARO-RP/pkg/api/v20201031preview"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestGetOpenShiftClusterFailureSummary(t *testing.T) {
+	ctx := context.Background()
+
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	resourceID := testdatabase.GetResourcePath(mockSubID, "resourceName")
+	failedAt := time.Date(2020, time.November, 1, 12, 0, 0, 0, time.UTC)
+
+	subscription := func(f *testdatabase.Fixture) {
+		f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+			ID: mockSubID,
+			Subscription: &api.Subscription{
+				State: api.SubscriptionStateRegistered,
+				Properties: &api.SubscriptionProperties{
+					TenantID: "11111111-1111-1111-1111-111111111111",
+				},
+			},
+		})
+	}
+
+	fixture := func(failure *api.OperationFailure) func(*testdatabase.Fixture) {
+		return func(f *testdatabase.Fixture) {
+			f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				Key: strings.ToLower(resourceID),
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID:   resourceID,
+					Name: "resourceName",
+					Type: "Microsoft.RedHatOpenShift/openShiftClusters",
+					Properties: api.OpenShiftClusterProperties{
+						ProvisioningState:       api.ProvisioningStateFailed,
+						FailedProvisioningState: api.ProvisioningStateCreating,
+						LastOperationFailure:    failure,
+					},
+				},
+			})
+			subscription(f)
+		}
+	}
+
+	type test struct {
+		name           string
+		apiVersion     string
+		fixture        func(*testdatabase.Fixture)
+		wantStatusCode int
+		wantResponse   *v20201031preview.FailureSummary
+		wantError      string
+	}
+
+	for _, tt := range []*test{
+		{
+			name: "failure summary is returned without the failed step",
+			fixture: fixture(&api.OperationFailure{
+				Operation:      api.ProvisioningStateCreating,
+				Time:           failedAt,
+				Category:       api.FailureCategoryQuota,
+				Code:           api.CloudErrorCodeDeploymentFailed,
+				Message:        "Deployment failed.",
+				AzureErrorCode: "SkuNotAvailable",
+				FailedStep:     "[Action github.com/Azure/ARO-RP/pkg/cluster.(*manager).deployResourceTemplate-fm]",
+			}),
+			wantStatusCode: http.StatusOK,
+			wantResponse: &v20201031preview.FailureSummary{
+				ID:   resourceID + "/failureSummary",
+				Name: "failureSummary",
+				Type: "Microsoft.RedHatOpenShift/openShiftClusters/failureSummary",
+				Properties: v20201031preview.FailureSummaryProperties{
+					Operation:      v20201031preview.ProvisioningStateCreating,
+					FailedAt:       failedAt,
+					Category:       v20201031preview.FailureCategoryQuota,
+					Code:           api.CloudErrorCodeDeploymentFailed,
+					Message:        "Deployment failed.",
+					AzureErrorCode: "SkuNotAvailable",
+				},
+			},
+		},
+		{
+			name:           "no failure recorded",
+			fixture:        fixture(nil),
+			wantStatusCode: http.StatusNotFound,
+			wantError:      `404: NotFound: : No failed install or update of the Resource 'openshiftclusters/resourcename' under resource group 'resourcegroup' was recorded.`,
+		},
+		{
+			name:           "failure summary is not available in the API version",
+			apiVersion:     "2020-04-30",
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `400: InvalidResourceType: : The resource type 'openshiftclusters' could not be found in the namespace 'microsoft.redhatopenshift' for api version '2020-04-30'.`,
+		},
+		{
+			name:           "cluster not found in db",
+			fixture:        subscription,
+			wantStatusCode: http.StatusNotFound,
+			wantError:      `404: ResourceNotFound: : The Resource 'openshiftclusters/resourcename' under resource group 'resourcegroup' was not found.`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithOpenShiftClusters().WithSubscriptions()
+			defer ti.done()
+
+			err := ti.buildFixtures(tt.fixture)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, api.APIs, &noop.Noop{}, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			reqAPIVersion := v20201031preview.APIVersion
+			if tt.apiVersion != "" {
+				reqAPIVersion = tt.apiVersion
+			}
+
+			resp, b, err := ti.request(http.MethodGet,
+				fmt.Sprintf("https://server%s/failureSummary?api-version=%s", resourceID, reqAPIVersion),
+				nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var wantResponse interface{}
+			if tt.wantResponse != nil {
+				wantResponse = tt.wantResponse
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, wantResponse)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
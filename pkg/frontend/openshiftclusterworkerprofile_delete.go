@@ -0,0 +1,77 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+func (f *frontend) deleteOpenShiftClusterWorkerProfile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	vars := mux.Vars(r)
+
+	if f.apis[vars["api-version"]].WorkerProfileConverter == nil {
+		api.WriteError(w, http.StatusBadRequest, api.CloudErrorCodeInvalidResourceType, "", "The resource type '%s' could not be found in the namespace '%s' for api version '%s'.", vars["resourceType"], vars["resourceProviderNamespace"], vars["api-version"])
+		return
+	}
+
+	resourceID := filepath.Dir(filepath.Dir(r.URL.Path))
+
+	var header http.Header
+	_, err := f.dbOpenShiftClusters.Patch(ctx, resourceID, func(doc *api.OpenShiftClusterDocument) error {
+		return f._deleteOpenShiftClusterWorkerProfile(ctx, r, &header, doc)
+	})
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		err = api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeResourceNotFound, "", "The Resource '%s/%s' under resource group '%s' was not found.", vars["resourceType"], vars["resourceName"], vars["resourceGroupName"])
+	case err == nil:
+		err = statusCodeError(http.StatusAccepted)
+	}
+
+	reply(log, w, header, nil, err)
+}
+
+func (f *frontend) _deleteOpenShiftClusterWorkerProfile(ctx context.Context, r *http.Request, header *http.Header, doc *api.OpenShiftClusterDocument) error {
+	correlationData := r.Context().Value(middleware.ContextKeyCorrelationData).(*api.CorrelationData)
+	vars := mux.Vars(r)
+
+	_, err := f.validateSubscriptionState(ctx, doc.Key, api.SubscriptionStateRegistered, api.SubscriptionStateWarned)
+	if err != nil {
+		return err
+	}
+
+	err = validateWorkerProfileProvisioningState(doc)
+	if err != nil {
+		return err
+	}
+
+	wps := doc.OpenShiftCluster.Properties.AdditionalWorkerProfiles[:0]
+	var found bool
+	for _, wp := range doc.OpenShiftCluster.Properties.AdditionalWorkerProfiles {
+		if strings.EqualFold(wp.Name, vars["workerProfileName"]) {
+			found = true
+			continue
+		}
+		wps = append(wps, wp)
+	}
+
+	if !found {
+		return api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeNotFound, "", "The worker profile '%s' was not found.", vars["workerProfileName"])
+	}
+
+	doc.OpenShiftCluster.Properties.AdditionalWorkerProfiles = wps
+
+	return f.startWorkerProfileUpdate(ctx, r, header, doc, correlationData)
+}
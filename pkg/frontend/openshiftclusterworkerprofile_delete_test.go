@@ -0,0 +1,175 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	v20201031preview "github.com/Azure/ARO-RP/pkg/api/v20201031preview"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestDeleteOpenShiftClusterWorkerProfile(t *testing.T) {
+	ctx := context.Background()
+
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	resourceID := testdatabase.GetResourcePath(mockSubID, "resourceName")
+
+	fixture := func(provisioningState api.ProvisioningState, wps ...api.WorkerProfile) func(*testdatabase.Fixture) {
+		return func(f *testdatabase.Fixture) {
+			f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				Key: strings.ToLower(resourceID),
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID:   resourceID,
+					Name: "resourceName",
+					Type: "Microsoft.RedHatOpenShift/openShiftClusters",
+					Properties: api.OpenShiftClusterProperties{
+						ProvisioningState:        provisioningState,
+						AdditionalWorkerProfiles: wps,
+					},
+				},
+			})
+			f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+				ID: mockSubID,
+				Subscription: &api.Subscription{
+					State: api.SubscriptionStateRegistered,
+					Properties: &api.SubscriptionProperties{
+						TenantID: "11111111-1111-1111-1111-111111111111",
+					},
+				},
+			})
+		}
+	}
+
+	type test struct {
+		name           string
+		fixture        func(*testdatabase.Fixture)
+		wantDocuments  func(*testdatabase.Checker)
+		wantStatusCode int
+		wantError      string
+	}
+
+	for _, tt := range []*test{
+		{
+			name:    "worker profile is removed",
+			fixture: fixture(api.ProvisioningStateSucceeded, api.WorkerProfile{Name: "gpu"}, api.WorkerProfile{Name: "infra"}),
+			wantDocuments: func(c *testdatabase.Checker) {
+				c.AddAsyncOperationDocuments(&api.AsyncOperationDocument{
+					OpenShiftClusterKey: strings.ToLower(resourceID),
+					AsyncOperation: &api.AsyncOperation{
+						APIVersion:               v20201031preview.APIVersion,
+						InitialProvisioningState: api.ProvisioningStateUpdating,
+						ProvisioningState:        api.ProvisioningStateUpdating,
+					},
+				})
+				c.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					Key: strings.ToLower(resourceID),
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID:   resourceID,
+						Name: "resourceName",
+						Type: "Microsoft.RedHatOpenShift/openShiftClusters",
+						Properties: api.OpenShiftClusterProperties{
+							ProvisioningState:     api.ProvisioningStateUpdating,
+							LastProvisioningState: api.ProvisioningStateSucceeded,
+							AdditionalWorkerProfiles: []api.WorkerProfile{
+								{
+									Name: "infra",
+								},
+							},
+						},
+					},
+				})
+			},
+			wantStatusCode: http.StatusAccepted,
+		},
+		{
+			name:           "worker profile not found",
+			fixture:        fixture(api.ProvisioningStateSucceeded, api.WorkerProfile{Name: "infra"}),
+			wantStatusCode: http.StatusNotFound,
+			wantError:      `404: NotFound: : The worker profile 'gpu' was not found.`,
+		},
+		{
+			name:           "cluster failed to create",
+			fixture:        fixture(api.ProvisioningStateFailed, api.WorkerProfile{Name: "gpu"}),
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `400: RequestNotAllowed: : Request is not allowed in provisioningState 'Failed'.`,
+		},
+		{
+			name: "cluster not found in db",
+			fixture: func(f *testdatabase.Fixture) {
+				f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+					ID: mockSubID,
+					Subscription: &api.Subscription{
+						State: api.SubscriptionStateRegistered,
+						Properties: &api.SubscriptionProperties{
+							TenantID: "11111111-1111-1111-1111-111111111111",
+						},
+					},
+				})
+			},
+			wantStatusCode: http.StatusNotFound,
+			wantError:      `404: ResourceNotFound: : The Resource 'openshiftclusters/resourcename' under resource group 'resourcegroup' was not found.`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).
+				WithOpenShiftClusters().
+				WithAsyncOperations().
+				WithSubscriptions()
+			defer ti.done()
+
+			err := ti.buildFixtures(tt.fixture)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, api.APIs, &noop.Noop{}, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodDelete,
+				fmt.Sprintf("https://server%s/workerprofiles/gpu?api-version=%s", resourceID, v20201031preview.APIVersion),
+				nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			location := resp.Header.Get("Location")
+			if tt.wantStatusCode == http.StatusAccepted {
+				if !strings.HasPrefix(location, fmt.Sprintf("/subscriptions/%s/providers/microsoft.redhatopenshift/locations/%s/operationresults/", mockSubID, ti.env.Location())) {
+					t.Error(location)
+				}
+			} else if location != "" {
+				t.Error(location)
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, nil)
+			if err != nil {
+				t.Error(err)
+			}
+
+			if tt.wantDocuments != nil {
+				tt.wantDocuments(ti.checker)
+
+				errs := ti.checker.CheckOpenShiftClusters(ti.openShiftClustersClient)
+				for _, i := range errs {
+					t.Error(i)
+				}
+				errs = ti.checker.CheckAsyncOperations(ti.asyncOperationsClient)
+				for _, i := range errs {
+					t.Error(i)
+				}
+			}
+		})
+	}
+}
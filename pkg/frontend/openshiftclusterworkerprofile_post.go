@@ -0,0 +1,150 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"path/filepath"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/validate"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+	"github.com/Azure/ARO-RP/pkg/util/feature"
+)
+
+// featureArm64WorkerPools must be registered on a subscription before arm64
+// worker profiles can be added to its clusters
+const featureArm64WorkerPools = "Microsoft.RedHatOpenShift/Arm64WorkerPools"
+
+func (f *frontend) postOpenShiftClusterWorkerProfile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	vars := mux.Vars(r)
+
+	if f.apis[vars["api-version"]].WorkerProfileConverter == nil {
+		api.WriteError(w, http.StatusBadRequest, api.CloudErrorCodeInvalidResourceType, "", "The resource type '%s' could not be found in the namespace '%s' for api version '%s'.", vars["resourceType"], vars["resourceProviderNamespace"], vars["api-version"])
+		return
+	}
+
+	resourceID := filepath.Dir(filepath.Dir(r.URL.Path))
+
+	var header http.Header
+	var b []byte
+	_, err := f.dbOpenShiftClusters.Patch(ctx, resourceID, func(doc *api.OpenShiftClusterDocument) error {
+		var err error
+		b, err = f._postOpenShiftClusterWorkerProfile(ctx, r, &header, doc, f.apis[vars["api-version"]].WorkerProfileConverter(), f.apis[vars["api-version"]].WorkerProfileStaticValidator(f.env))
+		return err
+	})
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		err = api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeResourceNotFound, "", "The Resource '%s/%s' under resource group '%s' was not found.", vars["resourceType"], vars["resourceName"], vars["resourceGroupName"])
+	case err == nil:
+		err = statusCodeError(http.StatusAccepted)
+	}
+
+	reply(log, w, header, b, err)
+}
+
+func (f *frontend) _postOpenShiftClusterWorkerProfile(ctx context.Context, r *http.Request, header *http.Header, doc *api.OpenShiftClusterDocument, converter api.WorkerProfileConverter, staticValidator api.WorkerProfileStaticValidator) ([]byte, error) {
+	body := r.Context().Value(middleware.ContextKeyBody).([]byte)
+	correlationData := r.Context().Value(middleware.ContextKeyCorrelationData).(*api.CorrelationData)
+	vars := mux.Vars(r)
+
+	subdoc, err := f.validateSubscriptionState(ctx, doc.Key, api.SubscriptionStateRegistered)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateWorkerProfileProvisioningState(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	ext := converter.ToExternal(&api.WorkerProfile{})
+
+	err = json.Unmarshal(body, &ext)
+	if err != nil {
+		return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidRequestContent, "", "The request content was invalid and could not be deserialized: %q.", err)
+	}
+
+	var wp api.WorkerProfile
+	converter.ToInternal(ext, &wp)
+
+	// the name is always taken from the URL
+	wp.Name = vars["workerProfileName"]
+	ext = converter.ToExternal(&wp)
+
+	err = staticValidator.Static(ext, doc.OpenShiftCluster)
+	if err != nil {
+		return nil, err
+	}
+
+	if validate.VMSizeIsArm64(wp.VMSize) &&
+		!feature.IsRegisteredForFeature(subdoc.Subscription.Properties, featureArm64WorkerPools) {
+		return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "properties.workerProfiles['"+wp.Name+"'].vmSize", "The provided worker VM size '%s' is invalid: the subscription is not registered for the '%s' feature.", wp.VMSize, featureArm64WorkerPools)
+	}
+
+	doc.OpenShiftCluster.Properties.AdditionalWorkerProfiles = append(doc.OpenShiftCluster.Properties.AdditionalWorkerProfiles, wp)
+
+	err = f.startWorkerProfileUpdate(ctx, r, header, doc, correlationData)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(ext, "", "    ")
+}
+
+// validateWorkerProfileProvisioningState returns an error if the worker
+// profiles of the cluster in doc cannot currently be changed
+func validateWorkerProfileProvisioningState(doc *api.OpenShiftClusterDocument) error {
+	err := validateTerminalProvisioningState(doc.OpenShiftCluster.Properties.ProvisioningState)
+	if err != nil {
+		return err
+	}
+
+	if doc.OpenShiftCluster.Properties.ProvisioningState == api.ProvisioningStateFailed &&
+		doc.OpenShiftCluster.Properties.FailedProvisioningState != api.ProvisioningStateUpdating {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeRequestNotAllowed, "", "Request is not allowed in provisioningState '%s'.", doc.OpenShiftCluster.Properties.ProvisioningState)
+	}
+
+	return nil
+}
+
+// startWorkerProfileUpdate moves the cluster in doc to the Updating state so
+// that the backend reconciles its machinesets, and sets the async operation
+// headers on the response
+func (f *frontend) startWorkerProfileUpdate(ctx context.Context, r *http.Request, header *http.Header, doc *api.OpenShiftClusterDocument, correlationData *api.CorrelationData) error {
+	doc.OpenShiftCluster.Properties.LastProvisioningState = doc.OpenShiftCluster.Properties.ProvisioningState
+	doc.OpenShiftCluster.Properties.ProvisioningState = api.ProvisioningStateUpdating
+	doc.CorrelationData = correlationData
+	doc.Dequeues = 0
+
+	var err error
+	doc.AsyncOperationID, err = f.newAsyncOperation(ctx, r, doc)
+	if err != nil {
+		return err
+	}
+
+	u, err := url.Parse(r.Header.Get("Referer"))
+	if err != nil {
+		return err
+	}
+
+	*header = http.Header{}
+
+	u.Path = f.operationResultsPath(r, doc.AsyncOperationID)
+	(*header)["Location"] = []string{u.String()}
+
+	u.Path = f.operationsPath(r, doc.AsyncOperationID)
+	(*header)["Azure-AsyncOperation"] = []string{u.String()}
+
+	return nil
+}
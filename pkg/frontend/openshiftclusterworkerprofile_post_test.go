@@ -0,0 +1,271 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	v20201031preview "github.com/Azure/ARO-RP/pkg/api/v20201031preview"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestPostOpenShiftClusterWorkerProfile(t *testing.T) {
+	ctx := context.Background()
+
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	resourceID := testdatabase.GetResourcePath(mockSubID, "resourceName")
+	subnetPrefix := "/subscriptions/" + mockSubID + "/resourceGroups/vnet/providers/Microsoft.Network/virtualNetworks/test-vnet/subnets/"
+
+	fixture := func(provisioningState api.ProvisioningState, wps ...api.WorkerProfile) func(*testdatabase.Fixture) {
+		return func(f *testdatabase.Fixture) {
+			f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				Key: strings.ToLower(resourceID),
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID:   resourceID,
+					Name: "resourceName",
+					Type: "Microsoft.RedHatOpenShift/openShiftClusters",
+					Properties: api.OpenShiftClusterProperties{
+						ProvisioningState: provisioningState,
+						MasterProfile: api.MasterProfile{
+							SubnetID: subnetPrefix + "master",
+						},
+						AdditionalWorkerProfiles: wps,
+					},
+				},
+			})
+			f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+				ID: mockSubID,
+				Subscription: &api.Subscription{
+					State: api.SubscriptionStateRegistered,
+					Properties: &api.SubscriptionProperties{
+						TenantID: "11111111-1111-1111-1111-111111111111",
+					},
+				},
+			})
+		}
+	}
+
+	wp := api.WorkerProfile{
+		Name:       "gpu",
+		VMSize:     api.VMSizeStandardD4sV3,
+		DiskSizeGB: 128,
+		SubnetID:   subnetPrefix + "gpu",
+		Count:      3,
+	}
+
+	type test struct {
+		name           string
+		apiVersion     string
+		fixture        func(*testdatabase.Fixture)
+		body           *v20201031preview.WorkerProfile
+		wantDocuments  func(*testdatabase.Checker)
+		wantStatusCode int
+		wantResponse   *v20201031preview.WorkerProfile
+		wantError      string
+	}
+
+	for _, tt := range []*test{
+		{
+			name:    "worker profile is added",
+			fixture: fixture(api.ProvisioningStateSucceeded),
+			body: &v20201031preview.WorkerProfile{
+				Name:       "ignored",
+				VMSize:     v20201031preview.VMSizeStandardD4sV3,
+				DiskSizeGB: 128,
+				SubnetID:   subnetPrefix + "gpu",
+				Count:      3,
+			},
+			wantDocuments: func(c *testdatabase.Checker) {
+				c.AddAsyncOperationDocuments(&api.AsyncOperationDocument{
+					OpenShiftClusterKey: strings.ToLower(resourceID),
+					AsyncOperation: &api.AsyncOperation{
+						APIVersion:               v20201031preview.APIVersion,
+						InitialProvisioningState: api.ProvisioningStateUpdating,
+						ProvisioningState:        api.ProvisioningStateUpdating,
+					},
+				})
+				c.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					Key: strings.ToLower(resourceID),
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID:   resourceID,
+						Name: "resourceName",
+						Type: "Microsoft.RedHatOpenShift/openShiftClusters",
+						Properties: api.OpenShiftClusterProperties{
+							ProvisioningState:     api.ProvisioningStateUpdating,
+							LastProvisioningState: api.ProvisioningStateSucceeded,
+							MasterProfile: api.MasterProfile{
+								SubnetID: subnetPrefix + "master",
+							},
+							AdditionalWorkerProfiles: []api.WorkerProfile{wp},
+						},
+					},
+				})
+			},
+			wantStatusCode: http.StatusAccepted,
+			wantResponse: &v20201031preview.WorkerProfile{
+				Name:       "gpu",
+				VMSize:     v20201031preview.VMSizeStandardD4sV3,
+				DiskSizeGB: 128,
+				SubnetID:   subnetPrefix + "gpu",
+				Count:      3,
+			},
+		},
+		{
+			name:    "worker profile name in use",
+			fixture: fixture(api.ProvisioningStateSucceeded, wp),
+			body: &v20201031preview.WorkerProfile{
+				VMSize:     v20201031preview.VMSizeStandardD4sV3,
+				DiskSizeGB: 128,
+				SubnetID:   subnetPrefix + "gpu",
+				Count:      3,
+			},
+			wantStatusCode: http.StatusConflict,
+			wantError:      `409: InvalidParameter: properties.workerProfiles['gpu'].name: The provided worker name 'gpu' is already in use.`,
+		},
+		{
+			name:    "worker profile invalid",
+			fixture: fixture(api.ProvisioningStateSucceeded),
+			body: &v20201031preview.WorkerProfile{
+				VMSize:     v20201031preview.VMSizeStandardD4sV3,
+				DiskSizeGB: 128,
+				SubnetID:   subnetPrefix + "gpu",
+				Count:      1,
+			},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `400: InvalidParameter: properties.workerProfiles['gpu'].count: The provided worker count '1' is invalid.`,
+		},
+		{
+			name:    "arm64 worker profile without feature registration",
+			fixture: fixture(api.ProvisioningStateSucceeded),
+			body: &v20201031preview.WorkerProfile{
+				VMSize:     v20201031preview.VMSizeStandardD4psV5,
+				DiskSizeGB: 128,
+				SubnetID:   subnetPrefix + "gpu",
+				Count:      3,
+			},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `400: InvalidParameter: properties.workerProfiles['gpu'].vmSize: The provided worker VM size 'Standard_D4ps_v5' is invalid: the subscription is not registered for the 'Microsoft.RedHatOpenShift/Arm64WorkerPools' feature.`,
+		},
+		{
+			name:    "trusted launch worker profile",
+			fixture: fixture(api.ProvisioningStateSucceeded),
+			body: &v20201031preview.WorkerProfile{
+				VMSize:       v20201031preview.VMSizeStandardD4sV3,
+				DiskSizeGB:   128,
+				SubnetID:     subnetPrefix + "gpu",
+				Count:        3,
+				SecurityType: v20201031preview.SecurityTypeTrustedLaunch,
+			},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `400: InvalidParameter: properties.workerProfiles['gpu'].securityType: The provided security type 'TrustedLaunch' is invalid: trusted launch is only supported for master VMs.`,
+		},
+		{
+			name:           "cluster in updating state",
+			fixture:        fixture(api.ProvisioningStateUpdating),
+			body:           &v20201031preview.WorkerProfile{},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `400: RequestNotAllowed: : Request is not allowed in provisioningState 'Updating'.`,
+		},
+		{
+			name: "cluster not found in db",
+			fixture: func(f *testdatabase.Fixture) {
+				f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+					ID: mockSubID,
+					Subscription: &api.Subscription{
+						State: api.SubscriptionStateRegistered,
+						Properties: &api.SubscriptionProperties{
+							TenantID: "11111111-1111-1111-1111-111111111111",
+						},
+					},
+				})
+			},
+			body:           &v20201031preview.WorkerProfile{},
+			wantStatusCode: http.StatusNotFound,
+			wantError:      `404: ResourceNotFound: : The Resource 'openshiftclusters/resourcename' under resource group 'resourcegroup' was not found.`,
+		},
+		{
+			name:           "worker profiles are not available in the API version",
+			apiVersion:     "2020-04-30",
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      `400: InvalidResourceType: : The resource type 'openshiftclusters' could not be found in the namespace 'microsoft.redhatopenshift' for api version '2020-04-30'.`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).
+				WithOpenShiftClusters().
+				WithAsyncOperations().
+				WithSubscriptions()
+			defer ti.done()
+
+			err := ti.buildFixtures(tt.fixture)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.log, ti.env, ti.asyncOperationsDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, ti.clusterInventoriesDatabase, api.APIs, &noop.Noop{}, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			reqAPIVersion := v20201031preview.APIVersion
+			if tt.apiVersion != "" {
+				reqAPIVersion = tt.apiVersion
+			}
+
+			var body interface{}
+			if tt.body != nil {
+				body = tt.body
+			}
+
+			resp, b, err := ti.request(http.MethodPost,
+				fmt.Sprintf("https://server%s/workerprofiles/gpu?api-version=%s", resourceID, reqAPIVersion),
+				http.Header{
+					"Content-Type": []string{"application/json"},
+				}, body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			azureAsyncOperation := resp.Header.Get("Azure-AsyncOperation")
+			if tt.wantStatusCode == http.StatusAccepted {
+				if !strings.HasPrefix(azureAsyncOperation, fmt.Sprintf("/subscriptions/%s/providers/microsoft.redhatopenshift/locations/%s/operationsstatus/", mockSubID, ti.env.Location())) {
+					t.Error(azureAsyncOperation)
+				}
+			} else if azureAsyncOperation != "" {
+				t.Error(azureAsyncOperation)
+			}
+
+			var wantResponse interface{}
+			if tt.wantResponse != nil {
+				wantResponse = tt.wantResponse
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, wantResponse)
+			if err != nil {
+				t.Error(err)
+			}
+
+			if tt.wantDocuments != nil {
+				tt.wantDocuments(ti.checker)
+
+				errs := ti.checker.CheckOpenShiftClusters(ti.openShiftClustersClient)
+				for _, i := range errs {
+					t.Error(i)
+				}
+				errs = ti.checker.CheckAsyncOperations(ti.asyncOperationsClient)
+				for _, i := range errs {
+					t.Error(i)
+				}
+			}
+		})
+	}
+}
@@ -72,6 +72,60 @@ func (f *frontend) getOperations(w http.ResponseWriter, r *http.Request) {
 				},
 				Origin: "user,system",
 			},
+			{
+				Name: "Microsoft.RedHatOpenShift/openShiftClusters/deletePreflight/action",
+				Display: api.Display{
+					Provider:  "Azure Red Hat OpenShift",
+					Resource:  "openShiftClusters",
+					Operation: "Check whether an OpenShift cluster can be deleted and what deleting it destroys",
+				},
+				Origin: "user,system",
+			},
+			{
+				Name: "Microsoft.RedHatOpenShift/openShiftClusters/detectors/read",
+				Display: api.Display{
+					Provider:  "Azure Red Hat OpenShift",
+					Resource:  "openShiftClusters/detectors",
+					Operation: "Read OpenShift cluster detectors",
+				},
+				Origin: "user,system",
+			},
+			{
+				Name: "Microsoft.RedHatOpenShift/openShiftClusters/egress/read",
+				Display: api.Display{
+					Provider:  "Azure Red Hat OpenShift",
+					Resource:  "openShiftClusters/egress",
+					Operation: "Read OpenShift cluster egress",
+				},
+				Origin: "user,system",
+			},
+			{
+				Name: "Microsoft.RedHatOpenShift/openShiftClusters/failureSummary/read",
+				Display: api.Display{
+					Provider:  "Azure Red Hat OpenShift",
+					Resource:  "openShiftClusters/failureSummary",
+					Operation: "Read OpenShift cluster failure summary",
+				},
+				Origin: "user,system",
+			},
+			{
+				Name: "Microsoft.RedHatOpenShift/openShiftClusters/workerProfiles/action",
+				Display: api.Display{
+					Provider:  "Azure Red Hat OpenShift",
+					Resource:  "openShiftClusters/workerProfiles",
+					Operation: "Add a worker profile to an OpenShift cluster",
+				},
+				Origin: "user,system",
+			},
+			{
+				Name: "Microsoft.RedHatOpenShift/openShiftClusters/workerProfiles/delete",
+				Display: api.Display{
+					Provider:  "Azure Red Hat OpenShift",
+					Resource:  "openShiftClusters/workerProfiles",
+					Operation: "Delete a worker profile from an OpenShift cluster",
+				},
+				Origin: "user,system",
+			},
 			{
 				Name: "Microsoft.RedHatOpenShift/operations/read",
 				Display: api.Display{
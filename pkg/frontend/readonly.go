@@ -0,0 +1,24 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"os"
+)
+
+// readOnlyRoutes holds the POST routes which change nothing, and so are still
+// served in read-only mode
+var readOnlyRoutes = map[string]bool{
+	"postOpenShiftClusterCredentials":     true,
+	"postOpenShiftClusterDeletePreflight": true,
+	"postAdminOpenShiftClustersBatchGet":  true,
+}
+
+// readOnlyMode returns true if READ_ONLY_MODE is set in the environment.  The
+// frontend is put in read-only mode during planned maintenance of the region:
+// it keeps serving reads from the database's read replicas and rejects
+// requests which would write with a retriable error.
+func readOnlyMode() bool {
+	return os.Getenv("READ_ONLY_MODE") != ""
+}
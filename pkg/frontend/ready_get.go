@@ -8,7 +8,6 @@ import (
 	"time"
 
 	"github.com/Azure/ARO-RP/pkg/api"
-	"github.com/Azure/ARO-RP/pkg/util/deployment"
 )
 
 // checkReady checks the ready status of the frontend to make it consistent
@@ -16,7 +15,7 @@ import (
 // minutes before indicating health.  This ensures that there will be a gap in
 // our health metric if we crash or restart.
 func (f *frontend) checkReady() bool {
-	if f.env.DeploymentMode() != deployment.Development &&
+	if !f.env.IsLocalDevelopment() &&
 		time.Since(f.startTime) < 2*time.Minute {
 		return false
 	}
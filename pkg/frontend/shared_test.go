@@ -24,10 +24,11 @@ import (
 	"github.com/Azure/ARO-RP/pkg/database"
 	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
 	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/util/adminpolicy"
 	"github.com/Azure/ARO-RP/pkg/util/clientauthorizer"
 	"github.com/Azure/ARO-RP/pkg/util/deployment"
 	mock_env "github.com/Azure/ARO-RP/pkg/util/mocks/env"
-	mock_keyvault "github.com/Azure/ARO-RP/pkg/util/mocks/keyvault"
+	mock_secretstore "github.com/Azure/ARO-RP/pkg/util/mocks/secretstore"
 	utiltls "github.com/Azure/ARO-RP/pkg/util/tls"
 	testdatabase "github.com/Azure/ARO-RP/test/database"
 	testclusterdata "github.com/Azure/ARO-RP/test/util/clusterdata"
@@ -65,14 +66,18 @@ type testInfra struct {
 	fixture    *testdatabase.Fixture
 	checker    *testdatabase.Checker
 
-	openShiftClustersClient   *cosmosdb.FakeOpenShiftClusterDocumentClient
-	openShiftClustersDatabase database.OpenShiftClusters
-	asyncOperationsClient     *cosmosdb.FakeAsyncOperationDocumentClient
-	asyncOperationsDatabase   database.AsyncOperations
-	billingClient             *cosmosdb.FakeBillingDocumentClient
-	billingDatabase           database.Billing
-	subscriptionsClient       *cosmosdb.FakeSubscriptionDocumentClient
-	subscriptionsDatabase     database.Subscriptions
+	openShiftClustersClient    *cosmosdb.FakeOpenShiftClusterDocumentClient
+	openShiftClustersDatabase  database.OpenShiftClusters
+	asyncOperationsClient      *cosmosdb.FakeAsyncOperationDocumentClient
+	asyncOperationsDatabase    database.AsyncOperations
+	billingClient              *cosmosdb.FakeBillingDocumentClient
+	billingDatabase            database.Billing
+	subscriptionsClient        *cosmosdb.FakeSubscriptionDocumentClient
+	subscriptionsDatabase      database.Subscriptions
+	openShiftVersionsClient    *cosmosdb.FakeOpenShiftVersionDocumentClient
+	openShiftVersionsDatabase  database.OpenShiftVersions
+	clusterInventoriesClient   *cosmosdb.FakeClusterInventoryDocumentClient
+	clusterInventoriesDatabase database.ClusterInventories
 }
 
 func newTestInfra(t *testing.T) *testInfra {
@@ -83,15 +88,18 @@ func newTestInfra(t *testing.T) *testInfra {
 
 	controller := gomock.NewController(t)
 
-	keyvault := mock_keyvault.NewMockManager(controller)
-	keyvault.EXPECT().GetCertificateSecret(gomock.Any(), env.RPServerSecretName).AnyTimes().Return(serverkey, servercerts, nil)
+	secrets := mock_secretstore.NewMockStore(controller)
+	secrets.EXPECT().GetCertificateSecret(gomock.Any(), env.RPServerSecretName).AnyTimes().Return(serverkey, servercerts, nil)
 
 	_env := mock_env.NewMockInterface(controller)
 	_env.EXPECT().DeploymentMode().AnyTimes().Return(deployment.Production)
+	_env.EXPECT().IsLocalDevelopment().AnyTimes().Return(false)
+	_env.EXPECT().RelaxedVMSizeValidation().AnyTimes().Return(false)
 	_env.EXPECT().Location().AnyTimes().Return("eastus")
-	_env.EXPECT().ServiceKeyvault().AnyTimes().Return(keyvault)
+	_env.EXPECT().ServiceSecrets().AnyTimes().Return(secrets)
 	_env.EXPECT().ArmClientAuthorizer().AnyTimes().Return(clientauthorizer.NewOne(clientcerts[0].Raw))
 	_env.EXPECT().AdminClientAuthorizer().AnyTimes().Return(clientauthorizer.NewOne(clientcerts[0].Raw))
+	_env.EXPECT().AdminPolicyAuthorizer().AnyTimes().Return(adminpolicy.NewAll())
 	_env.EXPECT().Domain().AnyTimes().Return("")
 	_env.EXPECT().Listen().AnyTimes().Return(l, nil)
 
@@ -151,6 +159,18 @@ func (ti *testInfra) WithAsyncOperations() *testInfra {
 	return ti
 }
 
+func (ti *testInfra) WithOpenShiftVersions() *testInfra {
+	ti.openShiftVersionsDatabase, ti.openShiftVersionsClient = testdatabase.NewFakeOpenShiftVersions()
+	ti.fixture.WithOpenShiftVersions(ti.openShiftVersionsDatabase)
+	return ti
+}
+
+func (ti *testInfra) WithClusterInventories() *testInfra {
+	ti.clusterInventoriesDatabase, ti.clusterInventoriesClient = testdatabase.NewFakeClusterInventories()
+	ti.fixture.WithClusterInventories(ti.clusterInventoriesDatabase)
+	return ti
+}
+
 func (ti *testInfra) done() {
 	ti.controller.Finish()
 	ti.cli.CloseIdleConnections()
@@ -13,7 +13,9 @@ import (
 
 	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
 	pkgnamespace "github.com/Azure/ARO-RP/pkg/util/namespace"
+	"github.com/Azure/ARO-RP/pkg/util/regions"
 )
 
 func validateTerminalProvisioningState(state api.ProvisioningState) error {
@@ -24,6 +26,22 @@ func validateTerminalProvisioningState(state api.ProvisioningState) error {
 	return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeRequestNotAllowed, "", "Request is not allowed in provisioningState '%s'.", state)
 }
 
+// validateRegionEnabled checks that the region catalog allows new clusters in
+// the RP's region.  Existing clusters can still be updated in a region which
+// is disabled.
+func validateRegionEnabled(location string) error {
+	region, err := regions.Get(location)
+	if err != nil {
+		return err
+	}
+
+	if !region.Enabled {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidLocation, "location", "The provided location '%s' is not enabled.", location)
+	}
+
+	return nil
+}
+
 func (f *frontend) getSubscriptionDocument(ctx context.Context, key string) (*api.SubscriptionDocument, error) {
 	r, err := azure.ParseResourceID(key)
 	if err != nil {
@@ -32,9 +50,14 @@ func (f *frontend) getSubscriptionDocument(ctx context.Context, key string) (*ap
 
 	doc, err := f.dbSubscriptions.Get(ctx, r.SubscriptionID)
 	if cosmosdb.IsErrorStatusCode(err, http.StatusNotFound) {
+		middleware.SetSubscriptionState(ctx, api.SubscriptionStateUnregistered)
 		return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidSubscriptionState, "", "Request is not allowed in unregistered subscription '%s'.", r.SubscriptionID)
 	}
 
+	if err == nil {
+		middleware.SetSubscriptionState(ctx, doc.Subscription.State)
+	}
+
 	return doc, err
 }
 
@@ -72,6 +95,18 @@ func (f *frontend) validateOpenShiftUniqueKey(ctx context.Context, doc *api.Open
 	return api.NewCloudError(http.StatusInternalServerError, api.CloudErrorCodeInternalServerError, "", "Internal server error.")
 }
 
+// validateInstallVersion returns an error if version is not installable
+func (f *frontend) validateInstallVersion(ctx context.Context, version string) error {
+	v, err := f.dbOpenShiftVersions.GetInstallable(ctx, version)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "properties.clusterProfile.version", "The provided version '%s' is invalid.", version)
+	}
+	return nil
+}
+
 // rxKubernetesString is weaker than Kubernetes validation, but strong enough to
 // prevent mischief
 var rxKubernetesString = regexp.MustCompile(`(?i)^[-a-z0-9.]{0,255}$`)
@@ -113,3 +148,94 @@ func validateAdminVMName(vmName string) error {
 
 	return nil
 }
+
+func validateAdminMachineName(machineName string) error {
+	if machineName == "" || !rxKubernetesString.MatchString(machineName) {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "", "The provided machineName '%s' is invalid.", machineName)
+	}
+
+	return nil
+}
+
+// validateAdminPodLogs restricts log streaming to pods in OpenShift namespaces:
+// the logs of customer workloads may contain customer data
+func validateAdminPodLogs(namespace, podName, containerName string) error {
+	if namespace == "" || !rxKubernetesString.MatchString(namespace) {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "", "The provided namespace '%s' is invalid.", namespace)
+	}
+	if !pkgnamespace.IsOpenShift(namespace) {
+		return api.NewCloudError(http.StatusForbidden, api.CloudErrorCodeForbidden, "", "Access to the provided namespace '%s' is forbidden.", namespace)
+	}
+
+	if podName == "" || !rxKubernetesString.MatchString(podName) {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "", "The provided pod name '%s' is invalid.", podName)
+	}
+
+	if !rxKubernetesString.MatchString(containerName) {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "", "The provided container name '%s' is invalid.", containerName)
+	}
+
+	return nil
+}
+
+// validateAdminPodExec applies the restrictions of validateAdminPodLogs and
+// requires a command to run
+func validateAdminPodExec(namespace, podName, containerName string, command []string) error {
+	err := validateAdminPodLogs(namespace, podName, containerName)
+	if err != nil {
+		return err
+	}
+
+	if len(command) == 0 || command[0] == "" {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "", "The provided command is invalid.")
+	}
+
+	return nil
+}
+
+func validateAdminEvents(namespace string) error {
+	if namespace == "" || !rxKubernetesString.MatchString(namespace) {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "", "The provided namespace '%s' is invalid.", namespace)
+	}
+	if !pkgnamespace.IsOpenShift(namespace) {
+		return api.NewCloudError(http.StatusForbidden, api.CloudErrorCodeForbidden, "", "Access to the provided namespace '%s' is forbidden.", namespace)
+	}
+
+	return nil
+}
+
+// validateAdminProxy restricts proxying to services and pods in OpenShift
+// namespaces, and to paths below their proxy subresource
+func validateAdminProxy(namespace, resource, name, port, path string) error {
+	if namespace == "" || !rxKubernetesString.MatchString(namespace) {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "", "The provided namespace '%s' is invalid.", namespace)
+	}
+	if !pkgnamespace.IsOpenShift(namespace) {
+		return api.NewCloudError(http.StatusForbidden, api.CloudErrorCodeForbidden, "", "Access to the provided namespace '%s' is forbidden.", namespace)
+	}
+
+	if resource == "" {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "", "Exactly one of service and pod must be provided.")
+	}
+
+	if name == "" || !rxKubernetesString.MatchString(name) {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "", "The provided name '%s' is invalid.", name)
+	}
+
+	if port == "" || !rxKubernetesString.MatchString(port) {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "", "The provided port '%s' is invalid.", port)
+	}
+
+	// the path is joined onto the proxy subresource path, so ".." could reach
+	// other API server paths
+	if !strings.HasPrefix(path, "/") {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "", "The provided path '%s' is invalid.", path)
+	}
+	for _, segment := range strings.Split(path, "/") {
+		if segment == ".." {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "", "The provided path '%s' is invalid.", path)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"testing"
+)
+
+func TestValidateRegionEnabled(t *testing.T) {
+	for _, tt := range []struct {
+		location string
+		wantErr  string
+	}{
+		{
+			location: "eastus",
+		},
+		{
+			location: "usgovarizona",
+			wantErr:  "400: InvalidLocation: location: The provided location 'usgovarizona' is not enabled.",
+		},
+		{
+			location: "moon",
+			wantErr:  "region moon is not in the region catalog",
+		},
+	} {
+		t.Run(tt.location, func(t *testing.T) {
+			err := validateRegionEnabled(tt.location)
+			if err == nil && tt.wantErr != "" ||
+				err != nil && err.Error() != tt.wantErr {
+				t.Error(err)
+			}
+		})
+	}
+}
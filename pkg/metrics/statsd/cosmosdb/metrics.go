@@ -65,6 +65,8 @@ func (t *tracerRoundTripper) RoundTrip(req *http.Request) (resp *http.Response,
 			})
 		}
 
+		coll := collection(parts)
+
 		if resp != nil {
 			// Sometimes we get request-charge="" because pkranges API is free
 			requestCharge := strings.Trim(resp.Header.Get("x-ms-request-charge"), `"`)
@@ -76,9 +78,73 @@ func (t *tracerRoundTripper) RoundTrip(req *http.Request) (resp *http.Response,
 					"verb": req.Method,
 					"path": path,
 				})
+
+				if coll != "" {
+					t.m.EmitFloat("client.cosmosdb.collection.requestunits", ru, map[string]string{
+						"collection": coll,
+						"operation":  operation(req, parts),
+					})
+				}
+			}
+
+			// requests are throttled once the provisioned throughput is
+			// exhausted; they are retried by the database client
+			if statusCode == http.StatusTooManyRequests && coll != "" {
+				t.m.EmitGauge("client.cosmosdb.collection.throttled", 1, map[string]string{
+					"collection": coll,
+					"operation":  operation(req, parts),
+				})
 			}
 		}
 	}()
 
 	return t.tr.RoundTrip(req)
 }
+
+// collection returns the collection of a request path, e.g. OpenShiftClusters
+// for /dbs/ARO/colls/OpenShiftClusters/docs/{id}, or "" for account and
+// database requests
+func collection(parts []string) string {
+	for i := 0; i < len(parts)-1; i++ {
+		if parts[i] == "colls" {
+			return parts[i+1]
+		}
+	}
+
+	return ""
+}
+
+// operation returns the kind of operation of a request to a collection, so
+// that the cost of e.g. queries and change feed reads can be told apart
+func operation(req *http.Request, parts []string) string {
+	switch {
+	case len(parts) >= 2 && parts[len(parts)-2] == "docs":
+		switch req.Method {
+		case http.MethodGet:
+			return "read"
+		case http.MethodPut:
+			return "replace"
+		case http.MethodDelete:
+			return "delete"
+		}
+
+	case parts[len(parts)-1] == "docs":
+		switch req.Method {
+		case http.MethodGet:
+			if req.Header.Get("A-IM") == "Incremental feed" {
+				return "changefeed"
+			}
+			return "list"
+		case http.MethodPost:
+			if strings.EqualFold(req.Header.Get("X-Ms-Documentdb-Isquery"), "true") {
+				return "query"
+			}
+			return "create"
+		}
+
+	case parts[len(parts)-1] == "pkranges":
+		return "pkranges"
+	}
+
+	return "other"
+}
@@ -26,7 +26,9 @@ func (rt *testRoundTripper) RoundTrip(req *http.Request) (*http.Response, error)
 func TestTracerRoundTripperRoundTrip(t *testing.T) {
 	for _, tt := range []struct {
 		name               string
+		method             string
 		url                string
+		header             http.Header
 		rt                 http.RoundTripper
 		mocks              func(*mock_metrics.MockInterface)
 		wantErr            string
@@ -110,6 +112,82 @@ func TestTracerRoundTripperRoundTrip(t *testing.T) {
 			},
 			wantRespStatusCode: http.StatusOK,
 		},
+		{
+			name:   "collection query",
+			method: http.MethodPost,
+			url:    "http://example.com/dbs/ARO/colls/OpenShiftClusters/docs",
+			header: http.Header{
+				"X-Ms-Documentdb-Isquery": {"True"},
+			},
+			rt: &testRoundTripper{
+				resp: &http.Response{
+					StatusCode: http.StatusOK,
+					Header: http.Header{
+						"X-Ms-Request-Charge": {`"4.56"`},
+					},
+				},
+			},
+			mocks: func(m *mock_metrics.MockInterface) {
+				m.EXPECT().EmitGauge("client.cosmosdb.count", int64(1), map[string]string{
+					"verb": http.MethodPost,
+					"path": "/dbs/ARO/colls/OpenShiftClusters/docs",
+					"code": "200",
+				})
+				m.EXPECT().EmitGauge("client.cosmosdb.duration", gomock.Any(), map[string]string{
+					"verb": http.MethodPost,
+					"path": "/dbs/ARO/colls/OpenShiftClusters/docs",
+					"code": "200",
+				})
+				m.EXPECT().EmitFloat("client.cosmosdb.requestunits", 4.56, map[string]string{
+					"verb": http.MethodPost,
+					"path": "/dbs/ARO/colls/OpenShiftClusters/docs",
+					"code": "200",
+				})
+				m.EXPECT().EmitFloat("client.cosmosdb.collection.requestunits", 4.56, map[string]string{
+					"collection": "OpenShiftClusters",
+					"operation":  "query",
+				})
+			},
+			wantRespStatusCode: http.StatusOK,
+		},
+		{
+			name: "collection read throttled",
+			url:  "http://example.com/dbs/ARO/colls/Subscriptions/docs/random-id",
+			rt: &testRoundTripper{
+				resp: &http.Response{
+					StatusCode: http.StatusTooManyRequests,
+					Header: http.Header{
+						"X-Ms-Request-Charge": {`"0.5"`},
+					},
+				},
+			},
+			mocks: func(m *mock_metrics.MockInterface) {
+				m.EXPECT().EmitGauge("client.cosmosdb.count", int64(1), map[string]string{
+					"verb": http.MethodGet,
+					"path": "/dbs/ARO/colls/Subscriptions/docs/{id}",
+					"code": "429",
+				})
+				m.EXPECT().EmitGauge("client.cosmosdb.duration", gomock.Any(), map[string]string{
+					"verb": http.MethodGet,
+					"path": "/dbs/ARO/colls/Subscriptions/docs/{id}",
+					"code": "429",
+				})
+				m.EXPECT().EmitFloat("client.cosmosdb.requestunits", 0.5, map[string]string{
+					"verb": http.MethodGet,
+					"path": "/dbs/ARO/colls/Subscriptions/docs/{id}",
+					"code": "429",
+				})
+				m.EXPECT().EmitFloat("client.cosmosdb.collection.requestunits", 0.5, map[string]string{
+					"collection": "Subscriptions",
+					"operation":  "read",
+				})
+				m.EXPECT().EmitGauge("client.cosmosdb.collection.throttled", int64(1), map[string]string{
+					"collection": "Subscriptions",
+					"operation":  "read",
+				})
+			},
+			wantRespStatusCode: http.StatusTooManyRequests,
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			controller := gomock.NewController(t)
@@ -129,10 +207,18 @@ func TestTracerRoundTripperRoundTrip(t *testing.T) {
 				url = tt.url
 			}
 
-			req, err := http.NewRequest(http.MethodGet, url, nil)
+			method := http.MethodGet
+			if tt.method != "" {
+				method = tt.method
+			}
+
+			req, err := http.NewRequest(method, url, nil)
 			if err != nil {
 				t.Fatal(err)
 			}
+			for k, v := range tt.header {
+				req.Header[k] = v
+			}
 
 			resp, err := tripper.RoundTrip(req)
 			if err != nil && err.Error() != tt.wantErr ||
@@ -15,7 +15,6 @@ import (
 
 	"github.com/Azure/ARO-RP/pkg/env"
 	"github.com/Azure/ARO-RP/pkg/metrics"
-	"github.com/Azure/ARO-RP/pkg/util/deployment"
 	"github.com/Azure/ARO-RP/pkg/util/recover"
 )
 
@@ -114,7 +113,7 @@ func (s *statsd) run() {
 
 func (s *statsd) dial() (err error) {
 	path := "/var/etw/mdm_statsd.socket"
-	if s.env.DeploymentMode() == deployment.Development {
+	if s.env.IsLocalDevelopment() {
 		path = "mdm_statsd.socket"
 	}
 
@@ -135,7 +134,7 @@ func (s *statsd) write(m *metric) (err error) {
 	if s.conn == nil {
 		err = s.dial()
 		if err != nil {
-			if s.env.DeploymentMode() == deployment.Development {
+			if s.env.IsLocalDevelopment() {
 				err = nil
 			}
 			return
@@ -0,0 +1,95 @@
+package mirror
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/containers/image/v5/copy"
+	"github.com/containers/image/v5/manifest"
+	"github.com/containers/image/v5/types"
+)
+
+// Architectures are the node architectures whose images may be mirrored, in
+// the naming used by both the release graph and image manifest lists
+var Architectures = []string{"amd64", "arm64"}
+
+// ParseArchitectures parses a comma separated list of architectures, e.g.
+// "amd64,arm64".  An empty string returns nil, which Copy takes to mean the
+// current platform only.
+func ParseArchitectures(s string) ([]string, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	var archs []string
+	for _, arch := range strings.Split(s, ",") {
+		arch = strings.TrimSpace(arch)
+
+		var found bool
+		for _, a := range Architectures {
+			if arch == a {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unsupported architecture %q", arch)
+		}
+
+		archs = append(archs, arch)
+	}
+
+	return archs, nil
+}
+
+// selectArchitectures sets options to copy the instances of the manifest list
+// at ref which are built for archs, as well as the list itself.  It leaves
+// options alone if ref is not a manifest list.  Architectures missing from
+// the list are skipped, as not every image is built for every architecture.
+func selectArchitectures(ctx context.Context, ref types.ImageReference, options *copy.Options, archs []string) error {
+	src, err := ref.NewImageSource(ctx, options.SourceCtx)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	b, mimeType, err := src.GetManifest(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if !manifest.MIMETypeIsMultiImage(mimeType) {
+		return nil
+	}
+
+	list, err := manifest.ListFromBlob(b, mimeType)
+	if err != nil {
+		return err
+	}
+
+	instances := options.Instances[:0]
+	for _, arch := range archs {
+		d, err := list.ChooseInstance(&types.SystemContext{
+			OSChoice:           "linux",
+			ArchitectureChoice: arch,
+		})
+		if err != nil {
+			continue
+		}
+
+		instances = append(instances, d)
+	}
+
+	if len(instances) == 0 {
+		return fmt.Errorf("no image found for architectures %v", archs)
+	}
+
+	options.ImageListSelection = copy.CopySpecificImages
+	options.Instances = instances
+
+	return nil
+}
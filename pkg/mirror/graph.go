@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"mime"
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/Azure/ARO-RP/pkg/util/version"
@@ -19,14 +20,19 @@ type Node struct {
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
 }
 
-// AddFromGraph adds all nodes whose version is of the form x.y.z (no suffix)
-// and >= min
-func AddFromGraph(min *version.Version) ([]Node, error) {
+// AddFromGraph adds all nodes of the release graph for arch whose version is
+// of the form x.y.z (no suffix) and >= min
+func AddFromGraph(min *version.Version, arch string) ([]Node, error) {
 	req, err := http.NewRequest(http.MethodGet, "https://openshift-release.svc.ci.openshift.org/graph", nil)
 	if err != nil {
 		return nil, err
 	}
 
+	// the graph defaults to amd64
+	if arch != "amd64" {
+		req.URL.RawQuery = url.Values{"arch": []string{arch}}.Encode()
+	}
+
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return nil, err
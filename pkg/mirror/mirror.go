@@ -17,7 +17,10 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-func Copy(ctx context.Context, dstreference, srcreference string, dstauth, srcauth *types.DockerAuthConfig) error {
+// Copy copies srcreference to dstreference.  If srcreference is a manifest
+// list, only the images for archs are copied with it; if archs is empty, only
+// the image for the current platform is copied, without the list.
+func Copy(ctx context.Context, dstreference, srcreference string, dstauth, srcauth *types.DockerAuthConfig, archs []string) error {
 	policyctx, err := signature.NewPolicyContext(&signature.Policy{
 		Default: signature.PolicyRequirements{
 			signature.NewPRInsecureAcceptAnything(),
@@ -37,14 +40,23 @@ func Copy(ctx context.Context, dstreference, srcreference string, dstauth, srcau
 		return err
 	}
 
-	_, err = copy.Image(ctx, policyctx, dst, src, &copy.Options{
+	options := &copy.Options{
 		SourceCtx: &types.SystemContext{
 			DockerAuthConfig: srcauth,
 		},
 		DestinationCtx: &types.SystemContext{
 			DockerAuthConfig: dstauth,
 		},
-	})
+	}
+
+	if len(archs) > 0 {
+		err = selectArchitectures(ctx, src, options, archs)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = copy.Image(ctx, policyctx, dst, src, options)
 
 	return err
 }
@@ -53,7 +65,9 @@ func Dest(repo, reference string) string {
 	return repo + reference[strings.IndexByte(reference, '/'):]
 }
 
-func Mirror(ctx context.Context, log *logrus.Entry, dstrepo, srcrelease string, dstauth, srcauth *types.DockerAuthConfig) error {
+// Mirror copies the release payload srcrelease and the images it references
+// to dstrepo.  archs is passed to Copy for each image.
+func Mirror(ctx context.Context, log *logrus.Entry, dstrepo, srcrelease string, dstauth, srcauth *types.DockerAuthConfig, archs []string) error {
 	log.Printf("reading imagestream from %s", srcrelease)
 	is, err := getReleaseImageStream(ctx, srcrelease, srcauth)
 	if err != nil {
@@ -80,7 +94,7 @@ func Mirror(ctx context.Context, log *logrus.Entry, dstrepo, srcrelease string,
 				log.Printf("mirroring %s", w.tag)
 				var err error
 				for retry := 0; retry < 3; retry++ {
-					err = Copy(ctx, w.dstreference, w.srcreference, w.dstauth, w.srcauth)
+					err = Copy(ctx, w.dstreference, w.srcreference, w.dstauth, w.srcauth, archs)
 					if err == nil {
 						break
 					}
@@ -0,0 +1,165 @@
+package mirror
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/metrics"
+	"github.com/Azure/ARO-RP/pkg/util/recover"
+	"github.com/Azure/ARO-RP/pkg/util/version"
+)
+
+// Watcher periodically reads the release graphs and mirrors each accepted
+// release which it has not mirrored yet.  Releases which fail to mirror are
+// retried at the next poll.
+type Watcher struct {
+	log *logrus.Entry
+	m   metrics.Interface
+
+	interval time.Duration
+	min      *version.Version
+	archs    []string
+
+	addFromGraph  func(*version.Version, string) ([]Node, error)
+	mirrorRelease func(context.Context, string, Node) error
+	mirrorImages  func(context.Context) error
+
+	mirrored map[string]map[string]*version.Version
+}
+
+// NewWatcher returns a Watcher which polls the release graphs of archs every
+// interval.  mirrorRelease is called for each new release of an arch;
+// mirrorImages, which may be nil, is called once per poll for the images
+// which are not part of a release.
+func NewWatcher(log *logrus.Entry, m metrics.Interface, interval time.Duration, min *version.Version, archs []string, mirrorRelease func(context.Context, string, Node) error, mirrorImages func(context.Context) error) *Watcher {
+	mirrored := map[string]map[string]*version.Version{}
+	for _, arch := range archs {
+		mirrored[arch] = map[string]*version.Version{}
+	}
+
+	return &Watcher{
+		log: log,
+		m:   m,
+
+		interval: interval,
+		min:      min,
+		archs:    archs,
+
+		addFromGraph:  AddFromGraph,
+		mirrorRelease: mirrorRelease,
+		mirrorImages:  mirrorImages,
+
+		mirrored: mirrored,
+	}
+}
+
+// Run polls until stop is closed
+func (w *Watcher) Run(ctx context.Context, stop <-chan struct{}) {
+	defer recover.Panic(w.log)
+
+	t := time.NewTicker(w.interval)
+	defer t.Stop()
+
+	for {
+		w.poll(ctx)
+
+		select {
+		case <-t.C:
+		case <-stop:
+			return
+		}
+	}
+}
+
+func (w *Watcher) poll(ctx context.Context) {
+	for _, arch := range w.archs {
+		w.log.Printf("reading %s release graph", arch)
+		releases, err := w.addFromGraph(w.min, arch)
+		if err != nil {
+			w.log.Error(err)
+			w.m.EmitGauge("mirror.graph.errors", 1, map[string]string{
+				"arch": arch,
+			})
+			continue
+		}
+
+		var failed int64
+		for _, release := range releases {
+			if _, found := w.mirrored[arch][release.Version]; found {
+				continue
+			}
+
+			vsn, err := version.ParseVersion(release.Version)
+			if err != nil {
+				w.log.Error(err)
+				continue
+			}
+
+			w.log.Printf("mirroring %s release %s", arch, release.Version)
+			err = w.mirrorRelease(ctx, arch, release)
+			if err != nil {
+				w.log.Errorf("%s: %s", release.Version, err)
+				failed++
+				continue
+			}
+
+			w.mirrored[arch][release.Version] = vsn
+			w.m.EmitGauge("mirror.release.mirrored", 1, map[string]string{
+				"arch":    arch,
+				"version": release.Version,
+			})
+		}
+
+		w.m.EmitGauge("mirror.release.errors", failed, map[string]string{
+			"arch": arch,
+		})
+	}
+
+	if w.mirrorImages != nil {
+		err := w.mirrorImages(ctx)
+		if err != nil {
+			w.log.Error(err)
+		}
+	}
+
+	w.emitNewer()
+}
+
+// emitNewer reports, for each stream which the RP supports, the newest
+// mirrored z-stream release of the same minor version if it is newer than the
+// stream
+func (w *Watcher) emitNewer() {
+	for _, arch := range w.archs {
+		for _, stream := range version.Streams {
+			newest := stream.Version
+			var count int64
+
+			for _, vsn := range w.mirrored[arch] {
+				if vsn.V[0] != stream.Version.V[0] || vsn.V[1] != stream.Version.V[1] ||
+					!stream.Version.Lt(vsn) {
+					continue
+				}
+
+				count++
+				if newest.Lt(vsn) {
+					newest = vsn
+				}
+			}
+
+			if count > 0 {
+				w.log.Infof("%s release %s is mirrored and newer than the RP's stream %s", arch, newest, stream.Version)
+			}
+
+			w.m.EmitGauge("mirror.stream.newer", count, map[string]string{
+				"arch":   arch,
+				"stream": stream.Version.String(),
+				"newest": newest.String(),
+			})
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package mirror
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	"github.com/Azure/ARO-RP/pkg/util/version"
+)
+
+func TestWatcherPoll(t *testing.T) {
+	ctx := context.Background()
+
+	graph := []Node{
+		{Version: "4.5.1", Payload: "quay.io/openshift-release-dev/ocp-release:4.5.1"},
+		{Version: "4.5.2", Payload: "quay.io/openshift-release-dev/ocp-release:4.5.2"},
+	}
+
+	var mirrored []string
+	fail := map[string]bool{"4.5.2": true}
+
+	w := NewWatcher(logrus.NewEntry(logrus.StandardLogger()), &noop.Noop{}, time.Hour, version.NewVersion(4, 3), []string{"amd64"},
+		func(ctx context.Context, arch string, release Node) error {
+			if fail[release.Version] {
+				return errors.New("failed")
+			}
+			mirrored = append(mirrored, arch+"/"+release.Version)
+			return nil
+		}, nil)
+
+	w.addFromGraph = func(min *version.Version, arch string) ([]Node, error) {
+		return graph, nil
+	}
+
+	// the failed release is retried at the next poll, the mirrored one isn't
+	w.poll(ctx)
+	delete(fail, "4.5.2")
+	graph = append(graph, Node{Version: "4.5.3", Payload: "quay.io/openshift-release-dev/ocp-release:4.5.3"})
+	w.poll(ctx)
+	w.poll(ctx)
+
+	want := []string{"amd64/4.5.1", "amd64/4.5.2", "amd64/4.5.3"}
+	if !reflect.DeepEqual(mirrored, want) {
+		t.Error(mirrored)
+	}
+}
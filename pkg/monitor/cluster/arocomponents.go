@@ -0,0 +1,142 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	"github.com/Azure/ARO-RP/pkg/util/ready"
+)
+
+// aroComponent is a workload which the RP or the ARO operator runs on the
+// cluster
+type aroComponent struct {
+	kind      string
+	namespace string
+	name      string
+
+	// flag is the operator flag which enables the component, if any
+	flag string
+}
+
+var aroComponents = []aroComponent{
+	{kind: "Deployment", namespace: "openshift-azure-operator", name: "aro-operator-master"},
+	{kind: "Deployment", namespace: "openshift-azure-operator", name: "aro-operator-worker"},
+	{kind: "DaemonSet", namespace: "openshift-azure-logging", name: "mdsd"},
+	{kind: "DaemonSet", namespace: "openshift-azure-routefix", name: "routefix", flag: operator.FlagRouteFixEnabled},
+	{kind: "DaemonSet", namespace: "openshift-azure-nodeproblemdetector", name: "node-problem-detector", flag: operator.FlagNodeProblemDetectorEnabled},
+}
+
+// emitAroComponentAvailability emits whether each ARO component has all its
+// replicas available.  When it does not, the cause dimension tells whether the
+// missing replicas are all on nodes which are not ready ("cluster"), in which
+// case the component is a casualty of a broken cluster, or not ("component"),
+// in which case it is the component itself which is broken.
+func (mon *Monitor) emitAroComponentAvailability(ctx context.Context) error {
+	flags := operator.OperatorFlags(mon.oc.Properties.OperatorFlags)
+
+	for _, c := range aroComponents {
+		if c.flag != "" && flags[c.flag] != "true" {
+			continue
+		}
+
+		desired, available, selector, found, err := mon.aroComponentReplicas(ctx, &c)
+		if err != nil {
+			return err
+		}
+
+		value, cause := int64(1), "none"
+		if !found {
+			value, cause = 0, "component"
+		} else if available < desired {
+			value = 0
+			cause, err = mon.aroComponentUnavailableCause(ctx, &c, selector, desired-available)
+			if err != nil {
+				return err
+			}
+		}
+
+		mon.emitGauge("arocomponent.availability", value, map[string]string{
+			"cause":     cause,
+			"kind":      c.kind,
+			"name":      c.name,
+			"namespace": c.namespace,
+		})
+	}
+
+	return nil
+}
+
+// aroComponentReplicas returns the desired and available replicas of the
+// workload of c and its pod selector, and whether the workload exists
+func (mon *Monitor) aroComponentReplicas(ctx context.Context, c *aroComponent) (desired, available int32, selector *metav1.LabelSelector, found bool, err error) {
+	switch c.kind {
+	case "Deployment":
+		d, err := mon.cli.AppsV1().Deployments(c.namespace).Get(ctx, c.name, metav1.GetOptions{})
+		if kerrors.IsNotFound(err) {
+			return 0, 0, nil, false, nil
+		}
+		if err != nil {
+			return 0, 0, nil, false, err
+		}
+
+		desired = 1
+		if d.Spec.Replicas != nil {
+			desired = *d.Spec.Replicas
+		}
+
+		return desired, d.Status.AvailableReplicas, d.Spec.Selector, true, nil
+
+	default:
+		ds, err := mon.cli.AppsV1().DaemonSets(c.namespace).Get(ctx, c.name, metav1.GetOptions{})
+		if kerrors.IsNotFound(err) {
+			return 0, 0, nil, false, nil
+		}
+		if err != nil {
+			return 0, 0, nil, false, err
+		}
+
+		return ds.Status.DesiredNumberScheduled, ds.Status.NumberAvailable, ds.Spec.Selector, true, nil
+	}
+}
+
+// aroComponentUnavailableCause returns "cluster" if at least unavailable pods
+// of c are on nodes which are not ready, and "component" otherwise
+func (mon *Monitor) aroComponentUnavailableCause(ctx context.Context, c *aroComponent, selector *metav1.LabelSelector, unavailable int32) (string, error) {
+	ns, err := mon.listNodes(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	nodeReady := map[string]bool{}
+	for i := range ns.Items {
+		nodeReady[ns.Items[i].Name] = ready.NodeIsReady(&ns.Items[i])
+	}
+
+	ps, err := mon.cli.CoreV1().Pods(c.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: metav1.FormatLabelSelector(selector),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var onNotReadyNodes int32
+	for _, p := range ps.Items {
+		if p.Spec.NodeName == "" || nodeReady[p.Spec.NodeName] {
+			continue
+		}
+
+		onNotReadyNodes++
+	}
+
+	if onNotReadyNodes >= unavailable {
+		return "cluster", nil
+	}
+
+	return "component", nil
+}
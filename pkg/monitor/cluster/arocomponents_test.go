@@ -0,0 +1,146 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/golang/mock/gomock"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/operator"
+	mock_metrics "github.com/Azure/ARO-RP/pkg/util/mocks/metrics"
+)
+
+func TestEmitAroComponentAvailability(t *testing.T) {
+	ctx := context.Background()
+
+	selector := &metav1.LabelSelector{
+		MatchLabels: map[string]string{"app": "mdsd"},
+	}
+
+	pod := func(name, nodeName string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "openshift-azure-logging",
+				Labels:    map[string]string{"app": "mdsd"},
+			},
+			Spec: corev1.PodSpec{
+				NodeName: nodeName,
+			},
+		}
+	}
+
+	node := func(name string, status corev1.ConditionStatus) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{
+					{
+						Type:   corev1.NodeReady,
+						Status: status,
+					},
+				},
+			},
+		}
+	}
+
+	cli := fake.NewSimpleClientset(
+		&appsv1.Deployment{ // available
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "aro-operator-master",
+				Namespace: "openshift-azure-operator",
+			},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: to.Int32Ptr(1),
+			},
+			Status: appsv1.DeploymentStatus{
+				AvailableReplicas: 1,
+			},
+		},
+		&appsv1.Deployment{ // unavailable, no pods
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "aro-operator-worker",
+				Namespace: "openshift-azure-operator",
+			},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: to.Int32Ptr(1),
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "aro-operator-worker"},
+				},
+			},
+		},
+		&appsv1.DaemonSet{ // unavailable on a node which is not ready
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "mdsd",
+				Namespace: "openshift-azure-logging",
+			},
+			Spec: appsv1.DaemonSetSpec{
+				Selector: selector,
+			},
+			Status: appsv1.DaemonSetStatus{
+				DesiredNumberScheduled: 3,
+				NumberAvailable:        2,
+			},
+		},
+		pod("mdsd-1", "node-1"),
+		pod("mdsd-2", "node-2"),
+		pod("mdsd-3", "node-3"),
+		node("node-1", corev1.ConditionTrue),
+		node("node-2", corev1.ConditionTrue),
+		node("node-3", corev1.ConditionUnknown),
+		// routefix is disabled; node-problem-detector is missing
+	)
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	m := mock_metrics.NewMockInterface(controller)
+
+	mon := &Monitor{
+		cli: cli,
+		m:   m,
+		oc: &api.OpenShiftCluster{
+			Properties: api.OpenShiftClusterProperties{
+				OperatorFlags: map[string]string{
+					operator.FlagRouteFixEnabled: "false",
+				},
+			},
+		},
+	}
+
+	for _, want := range []struct {
+		value     int64
+		cause     string
+		kind      string
+		namespace string
+		name      string
+	}{
+		{1, "none", "Deployment", "openshift-azure-operator", "aro-operator-master"},
+		{0, "component", "Deployment", "openshift-azure-operator", "aro-operator-worker"},
+		{0, "cluster", "DaemonSet", "openshift-azure-logging", "mdsd"},
+		{0, "component", "DaemonSet", "openshift-azure-nodeproblemdetector", "node-problem-detector"},
+	} {
+		m.EXPECT().EmitGauge("arocomponent.availability", want.value, map[string]string{
+			"cause":     want.cause,
+			"kind":      want.kind,
+			"name":      want.name,
+			"namespace": want.namespace,
+		})
+	}
+
+	err := mon.emitAroComponentAvailability(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -17,6 +17,32 @@ import (
 var aroOperatorConditionsExpected = map[status.ConditionType]corev1.ConditionStatus{
 	arov1alpha1.InternetReachableFromMaster: corev1.ConditionTrue,
 	arov1alpha1.InternetReachableFromWorker: corev1.ConditionTrue,
+	arov1alpha1.AzureAPINotThrottled:        corev1.ConditionTrue,
+	arov1alpha1.GenevaLoggingHealthy:        corev1.ConditionTrue,
+	arov1alpha1.NodeProblemsNotDetected:     corev1.ConditionTrue,
+	arov1alpha1.RBACValid:                   corev1.ConditionTrue,
+	arov1alpha1.ServicePrincipalValid:       corev1.ConditionTrue,
+	arov1alpha1.EtcdSpaceAvailable:          corev1.ConditionTrue,
+	arov1alpha1.ACRTokenValid:               corev1.ConditionTrue,
+	arov1alpha1.DNSValid:                    corev1.ConditionTrue,
+	arov1alpha1.WorkaroundsNotExpired:       corev1.ConditionTrue,
+	arov1alpha1.AutoscalerConfigValid:       corev1.ConditionTrue,
+	arov1alpha1.NodeSizingApplied:           corev1.ConditionTrue,
+	arov1alpha1.ImageRegistryConfigValid:    corev1.ConditionTrue,
+	arov1alpha1.GenevaTrustBundleValid:      corev1.ConditionTrue,
+	arov1alpha1.DeniedWritesNotDetected:     corev1.ConditionTrue,
+	arov1alpha1.ManagedPodsNotCrashLooping:  corev1.ConditionTrue,
+	arov1alpha1.NodeClocksSynchronized:      corev1.ConditionTrue,
+	arov1alpha1.IMDSReachableFromMaster:     corev1.ConditionTrue,
+	arov1alpha1.IMDSReachableFromWorker:     corev1.ConditionTrue,
+	arov1alpha1.PriorityClassesValid:        corev1.ConditionTrue,
+	arov1alpha1.ClusterVersionPolicyValid:   corev1.ConditionTrue,
+	arov1alpha1.NodeCertificatesRotating:    corev1.ConditionTrue,
+	arov1alpha1.ManagedDaemonSetsScheduled:  corev1.ConditionTrue,
+	arov1alpha1.ImagePolicyValid:            corev1.ConditionTrue,
+	arov1alpha1.VnetDNSServersValid:         corev1.ConditionTrue,
+	arov1alpha1.NetworkValid:                corev1.ConditionTrue,
+	arov1alpha1.ResourceQuotaValid:          corev1.ConditionTrue,
 }
 
 func (mon *Monitor) emitAroOperatorConditions(ctx context.Context) error {
@@ -0,0 +1,86 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/util/ready"
+)
+
+// aroOperatorHeartbeatThreshold is how old the heartbeat of the operator may
+// get before the operator is considered stale.  The checkers run hourly.
+const aroOperatorHeartbeatThreshold = 3 * time.Hour
+
+// emitAroOperatorLiveness emits arooperator.down when an operator deployment
+// is unavailable ("unavailable") or when the operator has stopped running its
+// checkers ("stale").  Every in-cluster check relies on the operator, so when
+// it is down the conditions which the other arooperator metrics report are no
+// longer current.  Operators which predate the heartbeat are never stale.
+func (mon *Monitor) emitAroOperatorLiveness(ctx context.Context) error {
+	aroOperatorDeploymentsReady := map[string]bool{
+		"aro-operator-master": false,
+		"aro-operator-worker": false,
+	}
+
+	dl, err := mon.listDeployments(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range dl.Items {
+		if d.Namespace != "openshift-azure-operator" {
+			continue
+		}
+
+		if _, present := aroOperatorDeploymentsReady[d.Name]; present {
+			aroOperatorDeploymentsReady[d.Name] = ready.DeploymentIsReady(&d)
+		}
+	}
+
+	for n, r := range aroOperatorDeploymentsReady {
+		if r {
+			continue
+		}
+
+		mon.emitAroOperatorDown("unavailable", n, nil)
+	}
+
+	cluster, err := mon.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	heartbeat := cluster.Status.LastHeartbeatTime
+	if !heartbeat.IsZero() && time.Since(heartbeat.Time) > aroOperatorHeartbeatThreshold {
+		mon.emitAroOperatorDown("stale", "aro-operator-master", &heartbeat)
+	}
+
+	return nil
+}
+
+func (mon *Monitor) emitAroOperatorDown(cause, name string, heartbeat *metav1.Time) {
+	mon.emitGauge("arooperator.down", 1, map[string]string{
+		"cause": cause,
+		"name":  name,
+	})
+
+	if mon.hourlyRun {
+		fields := logrus.Fields{
+			"metric": "arooperator.down",
+			"cause":  cause,
+			"name":   name,
+		}
+		if heartbeat != nil {
+			fields["lastHeartbeatTime"] = heartbeat
+		}
+
+		mon.log.WithFields(fields).Print()
+	}
+}
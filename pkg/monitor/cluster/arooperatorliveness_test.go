@@ -0,0 +1,125 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/golang/mock/gomock"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	mock_metrics "github.com/Azure/ARO-RP/pkg/util/mocks/metrics"
+)
+
+func TestEmitAroOperatorLiveness(t *testing.T) {
+	ctx := context.Background()
+
+	deployment := func(name string, available int32) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Namespace:  "openshift-azure-operator",
+				Generation: 1,
+			},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: to.Int32Ptr(1),
+			},
+			Status: appsv1.DeploymentStatus{
+				Replicas:           1,
+				AvailableReplicas:  available,
+				UpdatedReplicas:    1,
+				ObservedGeneration: 1,
+			},
+		}
+	}
+
+	for _, tt := range []struct {
+		name        string
+		deployments []runtime.Object
+		heartbeat   time.Time
+		wantDown    map[string]string
+	}{
+		{
+			name: "live",
+			deployments: []runtime.Object{
+				deployment("aro-operator-master", 1),
+				deployment("aro-operator-worker", 1),
+			},
+			heartbeat: time.Now().Add(-time.Hour),
+			wantDown:  map[string]string{},
+		},
+		{
+			name: "heartbeat predates the operator",
+			deployments: []runtime.Object{
+				deployment("aro-operator-master", 1),
+				deployment("aro-operator-worker", 1),
+			},
+			wantDown: map[string]string{},
+		},
+		{
+			name: "master unavailable, worker missing",
+			deployments: []runtime.Object{
+				deployment("aro-operator-master", 0),
+			},
+			heartbeat: time.Now().Add(-time.Hour),
+			wantDown: map[string]string{
+				"aro-operator-master": "unavailable",
+				"aro-operator-worker": "unavailable",
+			},
+		},
+		{
+			name: "stale",
+			deployments: []runtime.Object{
+				deployment("aro-operator-master", 1),
+				deployment("aro-operator-worker", 1),
+			},
+			heartbeat: time.Now().Add(-4 * time.Hour),
+			wantDown: map[string]string{
+				"aro-operator-master": "stale",
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+			}
+			if !tt.heartbeat.IsZero() {
+				cluster.Status.LastHeartbeatTime = metav1.NewTime(tt.heartbeat)
+			}
+
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			m := mock_metrics.NewMockInterface(controller)
+
+			mon := &Monitor{
+				cli:    fake.NewSimpleClientset(tt.deployments...),
+				arocli: arofake.NewSimpleClientset(cluster).AroV1alpha1(),
+				m:      m,
+			}
+
+			for name, cause := range tt.wantDown {
+				m.EXPECT().EmitGauge("arooperator.down", int64(1), map[string]string{
+					"cause": cause,
+					"name":  name,
+				})
+			}
+
+			err := mon.emitAroOperatorLiveness(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
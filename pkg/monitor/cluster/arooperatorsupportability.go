@@ -0,0 +1,40 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+func (mon *Monitor) emitAroOperatorSupportability(ctx context.Context) error {
+	cluster, err := mon.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	if cluster.Status.Supportability == nil {
+		return nil
+	}
+
+	for _, u := range cluster.Status.Supportability.UnsupportedConfigurations {
+		mon.emitGauge("arooperator.unsupportedconfigurations", 1, map[string]string{
+			"type": u.Type,
+		})
+
+		if mon.hourlyRun {
+			mon.log.WithFields(logrus.Fields{
+				"metric":  "arooperator.unsupportedconfigurations",
+				"type":    u.Type,
+				"message": u.Message,
+			}).Print()
+		}
+	}
+
+	return nil
+}
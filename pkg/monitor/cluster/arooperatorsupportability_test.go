@@ -0,0 +1,55 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	mock_metrics "github.com/Azure/ARO-RP/pkg/util/mocks/metrics"
+)
+
+func TestEmitAroOperatorSupportability(t *testing.T) {
+	ctx := context.Background()
+
+	arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: arov1alpha1.SingletonClusterName,
+		},
+		Status: arov1alpha1.ClusterStatus{
+			Supportability: &arov1alpha1.SupportabilityStatus{
+				UnsupportedConfigurations: []arov1alpha1.UnsupportedConfiguration{
+					{
+						Type:    arov1alpha1.UnsupportedAROOperatorRBAC,
+						Message: "clusterrolebinding aro-operator-worker is missing",
+					},
+				},
+			},
+		},
+	})
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	m := mock_metrics.NewMockInterface(controller)
+
+	mon := &Monitor{
+		arocli: arocli.AroV1alpha1(),
+		m:      m,
+	}
+
+	m.EXPECT().EmitGauge("arooperator.unsupportedconfigurations", int64(1), map[string]string{
+		"type": arov1alpha1.UnsupportedAROOperatorRBAC,
+	})
+
+	err := mon.emitAroOperatorSupportability(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,41 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+func (mon *Monitor) emitAroOperatorWorkarounds(ctx context.Context) error {
+	cluster, err := mon.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, w := range cluster.Status.ActiveWorkarounds {
+		expired := time.Now().After(w.Expires.Time)
+
+		mon.emitGauge("arooperator.workarounds", 1, map[string]string{
+			"name":    w.Name,
+			"expired": strconv.FormatBool(expired),
+		})
+
+		if mon.hourlyRun {
+			mon.log.WithFields(logrus.Fields{
+				"metric":  "arooperator.workarounds",
+				"name":    w.Name,
+				"expires": w.Expires,
+			}).Print()
+		}
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ package cluster
 
 import (
 	"context"
+	"crypto/x509"
 	"net/http"
 	"reflect"
 	"runtime"
@@ -12,10 +13,12 @@ import (
 	"github.com/Azure/go-autorest/autorest/azure"
 	configv1 "github.com/openshift/api/config/v1"
 	configclient "github.com/openshift/client-go/config/clientset/versioned"
+	maoclient "github.com/openshift/machine-api-operator/pkg/generated/clientset/versioned"
 	mcoclient "github.com/openshift/machine-config-operator/pkg/generated/clientset/versioned"
 	"github.com/sirupsen/logrus"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 
@@ -34,9 +37,20 @@ type Monitor struct {
 	restconfig *rest.Config
 	cli        kubernetes.Interface
 	configcli  configclient.Interface
+	maocli     maoclient.Interface
 	mcocli     mcoclient.Interface
 	m          metrics.Interface
 	arocli     aroclient.AroV1alpha1Interface
+	dyn        dynamic.Interface
+
+	resolvers map[string]resolver
+
+	// tlsRoots and ingressDial are overridden in unit tests; nil means the
+	// system roots and a direct dial respectively
+	tlsRoots    *x509.CertPool
+	ingressDial dialContextFunc
+
+	slis SLIs
 
 	// access below only via the helper functions in cache.go
 	cache struct {
@@ -70,6 +84,11 @@ func NewMonitor(ctx context.Context, log *logrus.Entry, restConfig *rest.Config,
 		return nil, err
 	}
 
+	maocli, err := maoclient.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	mcocli, err := mcoclient.NewForConfig(restConfig)
 	if err != nil {
 		return nil, err
@@ -80,6 +99,11 @@ func NewMonitor(ctx context.Context, log *logrus.Entry, restConfig *rest.Config,
 		return nil, err
 	}
 
+	dyn, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Monitor{
 		log:       log,
 		hourlyRun: hourlyRun,
@@ -90,9 +114,13 @@ func NewMonitor(ctx context.Context, log *logrus.Entry, restConfig *rest.Config,
 		restconfig: restConfig,
 		cli:        cli,
 		configcli:  configcli,
+		maocli:     maocli,
 		mcocli:     mcocli,
 		arocli:     arocli,
+		dyn:        dyn,
 		m:          m,
+
+		resolvers: newResolvers(),
 	}, nil
 }
 
@@ -100,6 +128,21 @@ func NewMonitor(ctx context.Context, log *logrus.Entry, restConfig *rest.Config,
 func (mon *Monitor) Monitor(ctx context.Context) (errs []error) {
 	mon.log.Debug("monitoring")
 
+	// DNS and TLS certificates are probed from the RP side and credentials
+	// are tracked in the cluster document, so don't depend on the API server
+	for _, f := range []func(context.Context) error{
+		mon.emitCredentials,
+		mon.emitDNSResolution,
+		mon.emitTLSCertificates,
+	} {
+		err := f(ctx)
+		if err != nil {
+			errs = append(errs, err)
+			mon.log.Printf("%s: %s", runtime.FuncForPC(reflect.ValueOf(f).Pointer()).Name(), err)
+			mon.emitGauge("monitor.clustererrors", 1, map[string]string{"monitor": runtime.FuncForPC(reflect.ValueOf(f).Pointer()).Name()})
+		}
+	}
+
 	// If API is not returning 200, don't need to run the next checks
 	statusCode, err := mon.emitAPIServerHealthzCode(ctx)
 	if err != nil {
@@ -107,13 +150,21 @@ func (mon *Monitor) Monitor(ctx context.Context) (errs []error) {
 		mon.log.Printf("%s: %s", runtime.FuncForPC(reflect.ValueOf(mon.emitAPIServerHealthzCode).Pointer()).Name(), err)
 		mon.emitGauge("monitor.clustererrors", 1, map[string]string{"monitor": runtime.FuncForPC(reflect.ValueOf(mon.emitAPIServerHealthzCode).Pointer()).Name()})
 	}
+	apiServerAvailable := statusCode == http.StatusOK
+	mon.slis.APIServerAvailable = &apiServerAvailable
+
 	if statusCode != http.StatusOK {
+		mon.emitResourceHealthUnavailable()
 		return
 	}
 
 	for _, f := range []func(context.Context) error{
+		mon.emitAroComponentAvailability,
 		mon.emitAroOperatorHeartbeat,
+		mon.emitAroOperatorLiveness,
 		mon.emitAroOperatorConditions,
+		mon.emitAroOperatorSupportability,
+		mon.emitAroOperatorWorkarounds,
 		mon.emitClusterOperatorConditions,
 		mon.emitClusterOperatorVersions,
 		mon.emitClusterVersionConditions,
@@ -126,6 +177,10 @@ func (mon *Monitor) Monitor(ctx context.Context) (errs []error) {
 		mon.emitReplicasetStatuses,
 		mon.emitStatefulsetStatuses,
 		mon.emitSummary,
+		mon.emitZoneHealth,
+		mon.emitResourceHealth,
+		mon.sampleIngressAvailability,
+		mon.emitSyntheticProbe,
 		mon.emitPrometheusAlerts, // at the end for now because it's the slowest/least reliable
 	} {
 		err = f(ctx)
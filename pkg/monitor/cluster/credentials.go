@@ -0,0 +1,45 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+// emitCredentials emits the age of each of the cluster's credentials and,
+// where it is known, the number of days until it expires, from the issuance
+// recorded in the cluster document.  Credentials which predate tracking are
+// skipped.
+func (mon *Monitor) emitCredentials(ctx context.Context) error {
+	p := &mon.oc.Properties.CredentialsProfile
+
+	for _, c := range []struct {
+		name   string
+		record *api.CredentialRecord
+	}{
+		{name: "kubeadminPassword", record: &p.KubeadminPassword},
+		{name: "aroServiceKubeconfig", record: &p.AROServiceKubeconfig},
+		{name: "servicePrincipal", record: &p.ServicePrincipal},
+		{name: "sshKey", record: &p.SSHKey},
+	} {
+		if c.record.IssuedAt.IsZero() {
+			continue
+		}
+
+		mon.emitGauge("credential.age.days", int64(time.Since(c.record.IssuedAt).Hours()/24), map[string]string{
+			"credential": c.name,
+		})
+
+		if c.record.ExpiresAt != nil {
+			mon.emitGauge("credential.daysremaining", int64(time.Until(*c.record.ExpiresAt).Hours()/24), map[string]string{
+				"credential": c.name,
+			})
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	mock_metrics "github.com/Azure/ARO-RP/pkg/util/mocks/metrics"
+)
+
+func TestEmitCredentials(t *testing.T) {
+	ctx := context.Background()
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	m := mock_metrics.NewMockInterface(controller)
+
+	now := time.Now()
+	expiresAt := now.Add(30*24*time.Hour + time.Hour)
+
+	mon := &Monitor{
+		m: m,
+		oc: &api.OpenShiftCluster{
+			Properties: api.OpenShiftClusterProperties{
+				CredentialsProfile: api.CredentialsProfile{
+					AROServiceKubeconfig: api.CredentialRecord{
+						IssuedAt:  now.Add(-10*24*time.Hour - time.Hour),
+						ExpiresAt: &expiresAt,
+					},
+					SSHKey: api.CredentialRecord{
+						IssuedAt: now.Add(-time.Hour),
+					},
+				},
+			},
+		},
+	}
+
+	m.EXPECT().EmitGauge("credential.age.days", int64(10), map[string]string{
+		"credential": "aroServiceKubeconfig",
+	})
+	m.EXPECT().EmitGauge("credential.daysremaining", int64(30), map[string]string{
+		"credential": "aroServiceKubeconfig",
+	})
+	m.EXPECT().EmitGauge("credential.age.days", int64(0), map[string]string{
+		"credential": "sshKey",
+	})
+
+	err := mon.emitCredentials(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
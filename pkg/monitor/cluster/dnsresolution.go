@@ -0,0 +1,106 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"time"
+)
+
+// publicDNSServer is used to resolve cluster records the way a client on the
+// internet would, as opposed to via the resolver configured in the RP VNet
+const publicDNSServer = "8.8.8.8:53"
+
+type resolver interface {
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+func newResolvers() map[string]resolver {
+	return map[string]resolver{
+		"private": net.DefaultResolver,
+		"public": &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, network, publicDNSServer)
+			},
+		},
+	}
+}
+
+// emitDNSResolution resolves the cluster's api and *.apps records from the RP
+// and checks that they point at the expected IPs.  This catches DNS zone
+// misconfigurations which can't be seen from inside the cluster.
+func (mon *Monitor) emitDNSResolution(ctx context.Context) error {
+	var ingressIP string
+	if len(mon.oc.Properties.IngressProfiles) > 0 {
+		ingressIP = mon.oc.Properties.IngressProfiles[0].IP
+	}
+
+	for _, record := range []struct {
+		name string
+		url  string
+		ip   string
+	}{
+		{
+			name: "api",
+			url:  mon.oc.Properties.APIServerProfile.URL,
+			ip:   mon.oc.Properties.APIServerProfile.IP,
+		},
+		{
+			name: "apps",
+			url:  mon.oc.Properties.ConsoleProfile.URL,
+			ip:   ingressIP,
+		},
+	} {
+		if record.url == "" {
+			continue
+		}
+
+		u, err := url.Parse(record.url)
+		if err != nil {
+			return err
+		}
+
+		for resolverName, r := range mon.resolvers {
+			start := time.Now()
+			addrs, err := r.LookupHost(ctx, u.Hostname())
+			duration := time.Since(start)
+
+			result := "success"
+			switch {
+			case err != nil:
+				result = "failure"
+			case record.ip != "" && !contains(addrs, record.ip):
+				result = "mismatch"
+			}
+
+			mon.emitGauge("dns.resolution", 1, map[string]string{
+				"record":   record.name,
+				"resolver": resolverName,
+				"result":   result,
+			})
+
+			if err == nil {
+				mon.emitGauge("dns.resolution.duration", duration.Milliseconds(), map[string]string{
+					"record":   record.name,
+					"resolver": resolverName,
+				})
+			}
+		}
+	}
+
+	return nil
+}
+
+func contains(addrs []string, addr string) bool {
+	for _, a := range addrs {
+		if a == addr {
+			return true
+		}
+	}
+
+	return false
+}
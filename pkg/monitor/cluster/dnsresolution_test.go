@@ -0,0 +1,111 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	mock_metrics "github.com/Azure/ARO-RP/pkg/util/mocks/metrics"
+)
+
+type fakeResolver map[string][]string
+
+func (r fakeResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	addrs, found := r[host]
+	if !found {
+		return nil, errors.New("no such host")
+	}
+
+	return addrs, nil
+}
+
+func TestEmitDNSResolution(t *testing.T) {
+	ctx := context.Background()
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	m := mock_metrics.NewMockInterface(controller)
+
+	mon := &Monitor{
+		m: m,
+		oc: &api.OpenShiftCluster{
+			Properties: api.OpenShiftClusterProperties{
+				APIServerProfile: api.APIServerProfile{
+					URL: "https://api.cluster.location.aroapp.io:6443/",
+					IP:  "1.2.3.4",
+				},
+				ConsoleProfile: api.ConsoleProfile{
+					URL: "https://console-openshift-console.apps.cluster.location.aroapp.io/",
+				},
+				IngressProfiles: []api.IngressProfile{
+					{
+						IP: "5.6.7.8",
+					},
+				},
+			},
+		},
+		resolvers: map[string]resolver{
+			"private": fakeResolver{
+				"api.cluster.location.aroapp.io":                            {"1.2.3.4"},
+				"console-openshift-console.apps.cluster.location.aroapp.io": {"5.6.7.8"},
+			},
+			"public": fakeResolver{
+				"api.cluster.location.aroapp.io": {"4.3.2.1"},
+			},
+		},
+	}
+
+	for _, dims := range []map[string]string{
+		{
+			"record":   "api",
+			"resolver": "private",
+			"result":   "success",
+		},
+		{
+			"record":   "apps",
+			"resolver": "private",
+			"result":   "success",
+		},
+		{
+			"record":   "api",
+			"resolver": "public",
+			"result":   "mismatch",
+		},
+		{
+			"record":   "apps",
+			"resolver": "public",
+			"result":   "failure",
+		},
+	} {
+		m.EXPECT().EmitGauge("dns.resolution", int64(1), dims)
+	}
+
+	for _, dims := range []map[string]string{
+		{
+			"record":   "api",
+			"resolver": "private",
+		},
+		{
+			"record":   "apps",
+			"resolver": "private",
+		},
+		{
+			"record":   "api",
+			"resolver": "public",
+		},
+	} {
+		m.EXPECT().EmitGauge("dns.resolution.duration", gomock.Any(), dims)
+	}
+
+	err := mon.emitDNSResolution(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
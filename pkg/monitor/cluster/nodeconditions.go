@@ -15,6 +15,12 @@ var nodeConditionsExpected = map[v1.NodeConditionType]v1.ConditionStatus{
 	v1.NodeMemoryPressure: v1.ConditionFalse,
 	v1.NodePIDPressure:    v1.ConditionFalse,
 	v1.NodeReady:          v1.ConditionTrue,
+
+	// set by the operator's node problem detector
+	"KernelDeadlock":            v1.ConditionFalse,
+	"ReadonlyFilesystem":        v1.ConditionFalse,
+	"FilesystemCorrupted":       v1.ConditionFalse,
+	"ContainerRuntimeUnhealthy": v1.ConditionFalse,
 }
 
 func (mon *Monitor) emitNodeConditions(ctx context.Context) error {
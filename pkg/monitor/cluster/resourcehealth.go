@@ -0,0 +1,101 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+
+	configv1 "github.com/openshift/api/config/v1"
+	v1 "k8s.io/api/core/v1"
+)
+
+// Azure Resource Health availability states
+const (
+	availabilityStateAvailable   = "Available"
+	availabilityStateDegraded    = "Degraded"
+	availabilityStateUnavailable = "Unavailable"
+)
+
+// emitResourceHealth emits the cluster availability shown to the customer by
+// Azure Resource Health.  It relies on the API server being reachable: see
+// emitResourceHealthUnavailable for the case when it is not.
+func (mon *Monitor) emitResourceHealth(ctx context.Context) error {
+	ns, err := mon.listNodes(ctx)
+	if err != nil {
+		return err
+	}
+
+	cos, err := mon.listClusterOperators(ctx)
+	if err != nil {
+		return err
+	}
+
+	state, reason := resourceHealth(ns, cos)
+	mon.emitResourceHealthState(state, reason)
+
+	return nil
+}
+
+func (mon *Monitor) emitResourceHealthUnavailable() {
+	mon.emitResourceHealthState(availabilityStateUnavailable, "APIServerNotReachable")
+}
+
+func (mon *Monitor) emitResourceHealthState(state, reason string) {
+	mon.emitGauge("cluster.resourcehealth", 1, map[string]string{
+		"availabilityState": state,
+		"reason":            reason,
+	})
+}
+
+// resourceHealth maps the state of the cluster's nodes and operators to an
+// availability state and a reason for it
+func resourceHealth(ns *v1.NodeList, cos *configv1.ClusterOperatorList) (string, string) {
+	var workerCount, workersReady, notReady int
+	for _, n := range ns.Items {
+		ready := nodeIsReady(&n)
+		if !ready {
+			notReady++
+		}
+
+		if _, ok := n.Labels[workerRoleLabel]; ok {
+			workerCount++
+			if ready {
+				workersReady++
+			}
+		}
+	}
+
+	// with no worker nodes, no customer workload can run
+	if workerCount > 0 && workersReady == 0 {
+		return availabilityStateUnavailable, "NoWorkerNodesReady"
+	}
+
+	if notReady > 0 {
+		return availabilityStateDegraded, "NodesNotReady"
+	}
+
+	for _, co := range cos.Items {
+		for _, c := range co.Status.Conditions {
+			if c.Type != configv1.OperatorAvailable && c.Type != configv1.OperatorDegraded {
+				continue
+			}
+
+			if !clusterOperatorConditionIsExpected(&co, &c) {
+				return availabilityStateDegraded, "ClusterOperatorsNotHealthy"
+			}
+		}
+	}
+
+	return availabilityStateAvailable, ""
+}
+
+func nodeIsReady(n *v1.Node) bool {
+	for _, c := range n.Status.Conditions {
+		if c.Type == v1.NodeReady {
+			return c.Status == v1.ConditionTrue
+		}
+	}
+
+	return false
+}
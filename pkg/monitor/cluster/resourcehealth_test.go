@@ -0,0 +1,141 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	configv1 "github.com/openshift/api/config/v1"
+	configfake "github.com/openshift/client-go/config/clientset/versioned/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	mock_metrics "github.com/Azure/ARO-RP/pkg/util/mocks/metrics"
+)
+
+func TestEmitResourceHealth(t *testing.T) {
+	ctx := context.Background()
+
+	node := func(name, role string, ready corev1.ConditionStatus) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Labels: map[string]string{
+					role: "",
+				},
+			},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{
+					{
+						Type:   corev1.NodeReady,
+						Status: ready,
+					},
+				},
+			},
+		}
+	}
+
+	operator := func(name string, available, degraded configv1.ConditionStatus) *configv1.ClusterOperator {
+		return &configv1.ClusterOperator{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Status: configv1.ClusterOperatorStatus{
+				Conditions: []configv1.ClusterOperatorStatusCondition{
+					{
+						Type:   configv1.OperatorAvailable,
+						Status: available,
+					},
+					{
+						Type:   configv1.OperatorDegraded,
+						Status: degraded,
+					},
+					{
+						// not customer visible, so ignored
+						Type:   configv1.OperatorUpgradeable,
+						Status: configv1.ConditionFalse,
+					},
+				},
+			},
+		}
+	}
+
+	for _, tt := range []struct {
+		name       string
+		nodes      []runtime.Object
+		operators  []runtime.Object
+		wantState  string
+		wantReason string
+	}{
+		{
+			name: "available",
+			nodes: []runtime.Object{
+				node("master-0", masterRoleLabel, corev1.ConditionTrue),
+				node("worker-0", workerRoleLabel, corev1.ConditionTrue),
+			},
+			operators: []runtime.Object{
+				operator("console", configv1.ConditionTrue, configv1.ConditionFalse),
+			},
+			wantState: availabilityStateAvailable,
+		},
+		{
+			name: "node not ready",
+			nodes: []runtime.Object{
+				node("master-0", masterRoleLabel, corev1.ConditionUnknown),
+				node("worker-0", workerRoleLabel, corev1.ConditionTrue),
+			},
+			wantState:  availabilityStateDegraded,
+			wantReason: "NodesNotReady",
+		},
+		{
+			name: "no workers ready",
+			nodes: []runtime.Object{
+				node("master-0", masterRoleLabel, corev1.ConditionTrue),
+				node("worker-0", workerRoleLabel, corev1.ConditionFalse),
+			},
+			wantState:  availabilityStateUnavailable,
+			wantReason: "NoWorkerNodesReady",
+		},
+		{
+			name: "operator degraded",
+			nodes: []runtime.Object{
+				node("master-0", masterRoleLabel, corev1.ConditionTrue),
+				node("worker-0", workerRoleLabel, corev1.ConditionTrue),
+			},
+			operators: []runtime.Object{
+				operator("console", configv1.ConditionTrue, configv1.ConditionFalse),
+				operator("ingress", configv1.ConditionTrue, configv1.ConditionTrue),
+			},
+			wantState:  availabilityStateDegraded,
+			wantReason: "ClusterOperatorsNotHealthy",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			m := mock_metrics.NewMockInterface(controller)
+
+			mon := &Monitor{
+				cli:       fake.NewSimpleClientset(tt.nodes...),
+				configcli: configfake.NewSimpleClientset(tt.operators...),
+				m:         m,
+			}
+
+			m.EXPECT().EmitGauge("cluster.resourcehealth", int64(1), map[string]string{
+				"availabilityState": tt.wantState,
+				"reason":            tt.wantReason,
+			})
+
+			err := mon.emitResourceHealth(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
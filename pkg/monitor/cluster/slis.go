@@ -0,0 +1,49 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+
+	configv1 "github.com/openshift/api/config/v1"
+)
+
+// SLIs holds the service level indicators sampled by a monitoring run.  An
+// indicator is nil if it could not be sampled.
+type SLIs struct {
+	APIServerAvailable *bool
+	IngressAvailable   *bool
+}
+
+// SLIs returns the service level indicators sampled by the last call to
+// Monitor
+func (mon *Monitor) SLIs() SLIs {
+	return mon.slis
+}
+
+// sampleIngressAvailability samples whether the ingress cluster operator
+// reports the default ingress controller available
+func (mon *Monitor) sampleIngressAvailability(ctx context.Context) error {
+	cos, err := mon.listClusterOperators(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, co := range cos.Items {
+		if co.Name != "ingress" {
+			continue
+		}
+
+		available := false
+		for _, c := range co.Status.Conditions {
+			if c.Type == configv1.OperatorAvailable {
+				available = c.Status == configv1.ConditionTrue
+			}
+		}
+
+		mon.slis.IngressAvailable = &available
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/openshift/client-go/config/clientset/versioned/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSampleIngressAvailability(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name   string
+		status configv1.ConditionStatus
+		want   bool
+	}{
+		{
+			name:   "available",
+			status: configv1.ConditionTrue,
+			want:   true,
+		},
+		{
+			name:   "unavailable",
+			status: configv1.ConditionFalse,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			configcli := fake.NewSimpleClientset(&configv1.ClusterOperator{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress",
+				},
+				Status: configv1.ClusterOperatorStatus{
+					Conditions: []configv1.ClusterOperatorStatusCondition{
+						{
+							Type:   configv1.OperatorAvailable,
+							Status: tt.status,
+						},
+					},
+				},
+			})
+
+			mon := &Monitor{
+				configcli: configcli,
+			}
+
+			err := mon.sampleIngressAvailability(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			slis := mon.SLIs()
+			if slis.IngressAvailable == nil || *slis.IngressAvailable != tt.want {
+				t.Error(slis.IngressAvailable)
+			}
+			if slis.APIServerAvailable != nil {
+				t.Error(*slis.APIServerAvailable)
+			}
+		})
+	}
+}
@@ -0,0 +1,365 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/to"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/operator"
+)
+
+const (
+	syntheticProbeNamespace = "openshift-azure-syntheticprobe"
+	syntheticProbeName      = "syntheticprobe"
+
+	// syntheticProbeTimeout is how long a probe has to serve before it is
+	// reported as timed out.  It allows for the image to be pulled on a
+	// node which doesn't have it yet.
+	syntheticProbeTimeout = 15 * time.Minute
+
+	syntheticProbeRequestTimeout = 10 * time.Second
+)
+
+var routeGVR = schema.GroupVersionResource{
+	Group:    "route.openshift.io",
+	Version:  "v1",
+	Resource: "routes",
+}
+
+// syntheticProbeStages are the stages which a probe passes through in order,
+// after its namespace is created, until it serves via its route
+var syntheticProbeStages = []string{"scheduled", "ready", "admitted", "serving"}
+
+// emitSyntheticProbe runs the synthetic workload probe on clusters which have
+// the FlagSyntheticProbeClass operator flag set.  Unlike the other monitors,
+// which check that the control plane reports itself healthy, the probe
+// exercises the path which a customer workload takes: once an hour it deploys
+// a tiny web server with a service and a route, and on each following run it
+// checks how far the workload has got.  When the route serves via the
+// cluster's public ingress, or the probe times out, the duration of each stage
+// and the result are emitted and the probe is deleted.
+func (mon *Monitor) emitSyntheticProbe(ctx context.Context) error {
+	class := operator.OperatorFlags(mon.oc.Properties.OperatorFlags)[operator.FlagSyntheticProbeClass]
+
+	// the route is requested from the RP, so needs a public ingress
+	enabled := class != "" &&
+		len(mon.oc.Properties.IngressProfiles) > 0 &&
+		mon.oc.Properties.IngressProfiles[0].Visibility != api.VisibilityPrivate
+
+	// probes left over when the flag is unset are cleaned up hourly, so that
+	// clusters without the flag cost no API calls in between
+	if !enabled && !mon.hourlyRun {
+		return nil
+	}
+
+	ns, err := mon.cli.CoreV1().Namespaces().Get(ctx, syntheticProbeNamespace, metav1.GetOptions{})
+	switch {
+	case kerrors.IsNotFound(err):
+		if enabled && mon.hourlyRun {
+			return mon.startSyntheticProbe(ctx)
+		}
+		return nil
+	case err != nil:
+		return err
+	case ns.DeletionTimestamp != nil:
+		// the previous probe is still being deleted
+		return nil
+	case !enabled:
+		return mon.deleteSyntheticProbe(ctx)
+	}
+
+	start := ns.CreationTimestamp.Time
+
+	reached, err := mon.syntheticProbeReached(ctx)
+	if err != nil {
+		return err
+	}
+
+	result := "success"
+	if reached["serving"].IsZero() {
+		if time.Since(start) < syntheticProbeTimeout {
+			return nil
+		}
+		result = "timeout"
+	}
+
+	stage := "created"
+	for _, s := range syntheticProbeStages {
+		if reached[s].IsZero() {
+			break
+		}
+		stage = s
+
+		mon.emitGauge("syntheticprobe.duration", reached[s].Sub(start).Milliseconds(), map[string]string{
+			"class": class,
+			"stage": s,
+		})
+	}
+
+	if result != "success" {
+		mon.log.Infof("synthetic probe timed out after stage %s", stage)
+	}
+
+	mon.emitGauge("syntheticprobe.result", 1, map[string]string{
+		"class":  class,
+		"result": result,
+		"stage":  stage,
+	})
+
+	return mon.deleteSyntheticProbe(ctx)
+}
+
+// syntheticProbeReached returns the time at which the probe reached each of
+// syntheticProbeStages.  Stages which have not been reached are absent.
+func (mon *Monitor) syntheticProbeReached(ctx context.Context) (map[string]time.Time, error) {
+	reached := map[string]time.Time{}
+
+	pods, err := mon.cli.CoreV1().Pods(syntheticProbeNamespace).List(ctx, metav1.ListOptions{
+		LabelSelector: "app=" + syntheticProbeName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pod := range pods.Items {
+		for _, c := range pod.Status.Conditions {
+			if c.Status != corev1.ConditionTrue {
+				continue
+			}
+
+			var s string
+			switch c.Type {
+			case corev1.PodScheduled:
+				s = "scheduled"
+			case corev1.PodReady:
+				s = "ready"
+			default:
+				continue
+			}
+
+			if t, found := reached[s]; !found || c.LastTransitionTime.Time.Before(t) {
+				reached[s] = c.LastTransitionTime.Time
+			}
+		}
+	}
+
+	route, err := mon.dyn.Resource(routeGVR).Namespace(syntheticProbeNamespace).Get(ctx, syntheticProbeName, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return reached, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	host, admitted := routeAdmitted(route)
+	if host == "" {
+		return reached, nil
+	}
+	reached["admitted"] = admitted
+
+	if mon.syntheticProbeServes(ctx, host) {
+		reached["serving"] = time.Now()
+	}
+
+	return reached, nil
+}
+
+// routeAdmitted returns the host of route and the time at which a router
+// admitted it, or "" if no router has admitted it
+func routeAdmitted(route *unstructured.Unstructured) (string, time.Time) {
+	ingresses, _, _ := unstructured.NestedSlice(route.Object, "status", "ingress")
+
+	for _, ingress := range ingresses {
+		ingress, ok := ingress.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		host, _, _ := unstructured.NestedString(ingress, "host")
+		conditions, _, _ := unstructured.NestedSlice(ingress, "conditions")
+
+		for _, c := range conditions {
+			c, ok := c.(map[string]interface{})
+			if !ok || c["type"] != "Admitted" || c["status"] != "True" {
+				continue
+			}
+
+			s, _ := c["lastTransitionTime"].(string)
+			t, err := time.Parse(time.RFC3339, s)
+			if err != nil || host == "" {
+				continue
+			}
+
+			return host, t
+		}
+	}
+
+	return "", time.Time{}
+}
+
+// syntheticProbeServes returns true if the probe answers a request to its
+// route via the cluster's ingress
+func (mon *Monitor) syntheticProbeServes(ctx context.Context, host string) bool {
+	ingressDial := mon.ingressDial
+	if ingressDial == nil {
+		ingressDial = (&net.Dialer{}).DialContext
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, syntheticProbeRequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://"+host+"/", nil)
+	if err != nil {
+		return false
+	}
+
+	cli := &http.Client{
+		Transport: &http.Transport{
+			DialContext:       ingressDial,
+			DisableKeepAlives: true,
+		},
+	}
+
+	resp, err := cli.Do(req)
+	if err != nil {
+		mon.log.Debugf("synthetic probe request failed: %s", err)
+		return false
+	}
+	defer resp.Body.Close()
+
+	return resp.StatusCode == http.StatusOK
+}
+
+// startSyntheticProbe creates the probe.  Its workload serves HTTP using the
+// image of the console's downloads deployment, which is part of every release
+// and has python, so the probe depends on nothing outside the cluster.
+func (mon *Monitor) startSyntheticProbe(ctx context.Context) error {
+	downloads, err := mon.cli.AppsV1().Deployments("openshift-console").Get(ctx, "downloads", metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if len(downloads.Spec.Template.Spec.Containers) == 0 {
+		return fmt.Errorf("deployment openshift-console/downloads has no containers")
+	}
+
+	labels := map[string]string{"app": syntheticProbeName}
+
+	_, err = mon.cli.CoreV1().Namespaces().Create(ctx, &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: syntheticProbeNamespace,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+
+	_, err = mon.cli.AppsV1().Deployments(syntheticProbeNamespace).Create(ctx, &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: syntheticProbeName,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: to.Int32Ptr(1),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:       syntheticProbeName,
+							Image:      downloads.Spec.Template.Spec.Containers[0].Image,
+							Command:    []string{"python3", "-m", "http.server", "8080"},
+							WorkingDir: "/tmp",
+							Ports: []corev1.ContainerPort{
+								{
+									ContainerPort: 8080,
+								},
+							},
+							ReadinessProbe: &corev1.Probe{
+								Handler: corev1.Handler{
+									HTTPGet: &corev1.HTTPGetAction{
+										Path: "/",
+										Port: intstr.FromInt(8080),
+									},
+								},
+							},
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceCPU:    resource.MustParse("10m"),
+									corev1.ResourceMemory: resource.MustParse("32Mi"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+
+	_, err = mon.cli.CoreV1().Services(syntheticProbeNamespace).Create(ctx, &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: syntheticProbeName,
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: labels,
+			Ports: []corev1.ServicePort{
+				{
+					Port:       8080,
+					TargetPort: intstr.FromInt(8080),
+				},
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+
+	_, err = mon.dyn.Resource(routeGVR).Namespace(syntheticProbeNamespace).Create(ctx, &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "route.openshift.io/v1",
+			"kind":       "Route",
+			"metadata": map[string]interface{}{
+				"name":      syntheticProbeName,
+				"namespace": syntheticProbeNamespace,
+			},
+			"spec": map[string]interface{}{
+				"to": map[string]interface{}{
+					"kind": "Service",
+					"name": syntheticProbeName,
+				},
+			},
+		},
+	}, metav1.CreateOptions{})
+	return err
+}
+
+// deleteSyntheticProbe deletes the probe's namespace and everything in it
+func (mon *Monitor) deleteSyntheticProbe(ctx context.Context) error {
+	err := mon.cli.CoreV1().Namespaces().Delete(ctx, syntheticProbeNamespace, metav1.DeleteOptions{})
+	if kerrors.IsNotFound(err) {
+		err = nil
+	}
+	return err
+}
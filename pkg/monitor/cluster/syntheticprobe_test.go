@@ -0,0 +1,278 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/operator"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+	mock_metrics "github.com/Azure/ARO-RP/pkg/util/mocks/metrics"
+)
+
+func TestEmitSyntheticProbe(t *testing.T) {
+	ctx := context.Background()
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Host != "syntheticprobe-openshift-azure-syntheticprobe.apps.cluster.location.aroapp.io" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer s.Close()
+
+	created := time.Now().Add(-5 * time.Minute).Truncate(time.Second)
+
+	namespace := func(created time.Time) *corev1.Namespace {
+		return &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              syntheticProbeNamespace,
+				CreationTimestamp: metav1.NewTime(created),
+			},
+		}
+	}
+
+	pod := func(conditions ...corev1.PodConditionType) *corev1.Pod {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "syntheticprobe-abcde",
+				Namespace: syntheticProbeNamespace,
+				Labels:    map[string]string{"app": syntheticProbeName},
+			},
+		}
+		for i, c := range conditions {
+			pod.Status.Conditions = append(pod.Status.Conditions, corev1.PodCondition{
+				Type:               c,
+				Status:             corev1.ConditionTrue,
+				LastTransitionTime: metav1.NewTime(created.Add(time.Duration(i+1) * time.Minute)),
+			})
+		}
+		return pod
+	}
+
+	route := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "route.openshift.io/v1",
+			"kind":       "Route",
+			"metadata": map[string]interface{}{
+				"name":      syntheticProbeName,
+				"namespace": syntheticProbeNamespace,
+			},
+			"status": map[string]interface{}{
+				"ingress": []interface{}{
+					map[string]interface{}{
+						"host": "syntheticprobe-openshift-azure-syntheticprobe.apps.cluster.location.aroapp.io",
+						"conditions": []interface{}{
+							map[string]interface{}{
+								"type":               "Admitted",
+								"status":             "True",
+								"lastTransitionTime": created.Add(3 * time.Minute).Format(time.RFC3339),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	downloads := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "downloads",
+			Namespace: "openshift-console",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Image: "quay.io/openshift-release-dev/ocp-v4.0-art-dev@sha256:cli-artifacts",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range []struct {
+		name          string
+		class         string
+		visibility    api.Visibility
+		hourlyRun     bool
+		objects       []runtime.Object
+		route         bool
+		mocks         func(*mock_metrics.MockInterface)
+		wantStarted   bool
+		wantNamespace bool
+	}{
+		{
+			name:      "not enabled",
+			hourlyRun: true,
+		},
+		{
+			name:        "enabled, hourly run starts a probe",
+			class:       "canary",
+			hourlyRun:   true,
+			objects:     []runtime.Object{downloads},
+			wantStarted: true,
+		},
+		{
+			name:    "enabled, other runs don't start a probe",
+			class:   "canary",
+			objects: []runtime.Object{downloads},
+		},
+		{
+			name:    "probe in progress",
+			class:   "canary",
+			objects: []runtime.Object{namespace(created), pod(corev1.PodScheduled)},
+			// a pending probe is left alone
+			wantNamespace: true,
+		},
+		{
+			name:    "probe serves",
+			class:   "canary",
+			objects: []runtime.Object{namespace(created), pod(corev1.PodScheduled, corev1.PodReady)},
+			route:   true,
+			mocks: func(m *mock_metrics.MockInterface) {
+				for i, stage := range []string{"scheduled", "ready", "admitted"} {
+					m.EXPECT().EmitGauge("syntheticprobe.duration", int64(time.Duration(i+1)*time.Minute/time.Millisecond), map[string]string{
+						"class": "canary",
+						"stage": stage,
+					})
+				}
+				m.EXPECT().EmitGauge("syntheticprobe.duration", gomock.Any(), map[string]string{
+					"class": "canary",
+					"stage": "serving",
+				})
+				m.EXPECT().EmitGauge("syntheticprobe.result", int64(1), map[string]string{
+					"class":  "canary",
+					"result": "success",
+					"stage":  "serving",
+				})
+			},
+		},
+		{
+			name:    "probe times out",
+			class:   "internal",
+			objects: []runtime.Object{namespace(created.Add(-time.Hour)), pod(corev1.PodScheduled)},
+			mocks: func(m *mock_metrics.MockInterface) {
+				m.EXPECT().EmitGauge("syntheticprobe.duration", gomock.Any(), map[string]string{
+					"class": "internal",
+					"stage": "scheduled",
+				})
+				m.EXPECT().EmitGauge("syntheticprobe.result", int64(1), map[string]string{
+					"class":  "internal",
+					"result": "timeout",
+					"stage":  "scheduled",
+				})
+			},
+		},
+		{
+			name:      "flag unset, hourly run deletes the probe",
+			hourlyRun: true,
+			objects:   []runtime.Object{namespace(created)},
+		},
+		{
+			name:          "flag unset, other runs leave the probe",
+			objects:       []runtime.Object{namespace(created)},
+			wantNamespace: true,
+		},
+		{
+			name:       "private ingress deletes the probe",
+			class:      "canary",
+			visibility: api.VisibilityPrivate,
+			hourlyRun:  true,
+			objects:    []runtime.Object{namespace(created)},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			m := mock_metrics.NewMockInterface(controller)
+			if tt.mocks != nil {
+				tt.mocks(m)
+			}
+
+			var routes []runtime.Object
+			if tt.route {
+				routes = append(routes, route.DeepCopy())
+			}
+
+			visibility := tt.visibility
+			if visibility == "" {
+				visibility = api.VisibilityPublic
+			}
+
+			mon := &Monitor{
+				log:       utillog.GetLogger(),
+				hourlyRun: tt.hourlyRun,
+				oc: &api.OpenShiftCluster{
+					Properties: api.OpenShiftClusterProperties{
+						IngressProfiles: []api.IngressProfile{
+							{
+								Visibility: visibility,
+							},
+						},
+						OperatorFlags: map[string]string{
+							operator.FlagSyntheticProbeClass: tt.class,
+						},
+					},
+				},
+				cli: fake.NewSimpleClientset(tt.objects...),
+				dyn: dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), routes...),
+				m:   m,
+				ingressDial: func(ctx context.Context, network, address string) (net.Conn, error) {
+					return (&net.Dialer{}).DialContext(ctx, network, s.Listener.Addr().String())
+				},
+			}
+
+			err := mon.emitSyntheticProbe(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = mon.cli.CoreV1().Namespaces().Get(ctx, syntheticProbeNamespace, metav1.GetOptions{})
+			if kerrors.IsNotFound(err) == (tt.wantStarted || tt.wantNamespace) {
+				t.Errorf("namespace: %v", err)
+			}
+
+			d, err := mon.cli.AppsV1().Deployments(syntheticProbeNamespace).Get(ctx, syntheticProbeName, metav1.GetOptions{})
+			if tt.wantStarted {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if d.Spec.Template.Spec.Containers[0].Image != downloads.Spec.Template.Spec.Containers[0].Image {
+					t.Error(d.Spec.Template.Spec.Containers[0].Image)
+				}
+
+				_, err = mon.cli.CoreV1().Services(syntheticProbeNamespace).Get(ctx, syntheticProbeName, metav1.GetOptions{})
+				if err != nil {
+					t.Error(err)
+				}
+
+				_, err = mon.dyn.Resource(routeGVR).Namespace(syntheticProbeNamespace).Get(ctx, syntheticProbeName, metav1.GetOptions{})
+				if err != nil {
+					t.Error(err)
+				}
+			} else if !kerrors.IsNotFound(err) {
+				t.Errorf("deployment: %v", err)
+			}
+		})
+	}
+}
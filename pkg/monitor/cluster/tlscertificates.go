@@ -0,0 +1,187 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+const tlsHandshakeTimeout = 10 * time.Second
+
+type dialContextFunc func(ctx context.Context, network, address string) (net.Conn, error)
+
+type tlsEndpoint struct {
+	name string
+	url  string
+	port string
+	dial dialContextFunc
+}
+
+// emitTLSCertificates completes a TLS handshake with the cluster's API server
+// and, if it is public, its ingress, and validates the served certificate
+// chains against the roots trusted by the RP.  This catches certificates
+// which have been replaced (e.g. by a self-signed one), have expired or don't
+// match the cluster's hostnames, none of which can be seen from inside the
+// cluster.
+func (mon *Monitor) emitTLSCertificates(ctx context.Context) error {
+	var apiDial dialContextFunc = (&net.Dialer{}).DialContext
+	if mon.restconfig != nil && mon.restconfig.Dial != nil {
+		// the API server is reached via its private endpoint
+		apiDial = mon.restconfig.Dial
+	}
+
+	ingressDial := mon.ingressDial
+	if ingressDial == nil {
+		ingressDial = (&net.Dialer{}).DialContext
+	}
+
+	endpoints := []tlsEndpoint{
+		{
+			name: "api",
+			url:  mon.oc.Properties.APIServerProfile.URL,
+			port: "6443",
+			dial: apiDial,
+		},
+	}
+
+	if len(mon.oc.Properties.IngressProfiles) > 0 &&
+		mon.oc.Properties.IngressProfiles[0].Visibility != api.VisibilityPrivate {
+		endpoints = append(endpoints, tlsEndpoint{
+			name: "ingress",
+			url:  mon.oc.Properties.ConsoleProfile.URL,
+			port: "443",
+			dial: ingressDial,
+		})
+	}
+
+	for _, endpoint := range endpoints {
+		if endpoint.url == "" {
+			continue
+		}
+
+		u, err := url.Parse(endpoint.url)
+		if err != nil {
+			return err
+		}
+
+		port := u.Port()
+		if port == "" {
+			port = endpoint.port
+		}
+
+		certs, err := mon.fetchCertificates(ctx, endpoint.dial, u.Hostname(), port)
+		if err != nil {
+			mon.log.Infof("tls handshake with %s endpoint failed: %s", endpoint.name, err)
+			mon.emitGauge("tls.certificate", 1, map[string]string{
+				"endpoint": endpoint.name,
+				"result":   "failure",
+			})
+			continue
+		}
+
+		result, err := mon.verifyCertificates(certs, u.Hostname())
+		if err != nil && mon.hourlyRun {
+			mon.log.Infof("%s endpoint certificate (issuer %q) is %s: %s", endpoint.name, certs[0].Issuer.String(), result, err)
+		}
+
+		mon.emitGauge("tls.certificate", 1, map[string]string{
+			"endpoint":   endpoint.name,
+			"result":     result,
+			"selfSigned": strconv.FormatBool(isSelfSigned(certs[0])),
+		})
+
+		mon.emitGauge("tls.certificate.daysremaining", int64(time.Until(certs[0].NotAfter).Hours()/24), map[string]string{
+			"endpoint": endpoint.name,
+		})
+	}
+
+	return nil
+}
+
+// fetchCertificates completes a TLS handshake with host:port via dial and
+// returns the certificate chain which is served for host.  The chain is not
+// verified here, so that an invalid chain can be classified by the caller.
+func (mon *Monitor) fetchCertificates(ctx context.Context, dial dialContextFunc, host, port string) ([]*x509.Certificate, error) {
+	ctx, cancel := context.WithTimeout(ctx, tlsHandshakeTimeout)
+	defer cancel()
+
+	conn, err := dial(ctx, "tcp", net.JoinHostPort(host, port))
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		err = conn.SetDeadline(deadline)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tlsConn := tls.Client(conn, &tls.Config{
+		ServerName:         host,
+		InsecureSkipVerify: true,
+	})
+
+	err = tlsConn.Handshake()
+	if err != nil {
+		return nil, err
+	}
+
+	certs := tlsConn.ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return nil, errors.New("no certificates served")
+	}
+
+	return certs, nil
+}
+
+// verifyCertificates verifies the served certificate chain certs for host
+// against the roots trusted by the RP and classifies the result
+func (mon *Monitor) verifyCertificates(certs []*x509.Certificate, host string) (string, error) {
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	_, err := certs[0].Verify(x509.VerifyOptions{
+		DNSName:       host,
+		Roots:         mon.tlsRoots, // nil uses the system roots
+		Intermediates: intermediates,
+	})
+
+	var hostnameErr x509.HostnameError
+	var unknownAuthorityErr x509.UnknownAuthorityError
+	var invalidErr x509.CertificateInvalidError
+
+	switch {
+	case err == nil:
+		return "valid", nil
+	case errors.As(err, &hostnameErr):
+		return "hostnamemismatch", err
+	case errors.As(err, &unknownAuthorityErr):
+		return "untrusted", err
+	case errors.As(err, &invalidErr) && invalidErr.Reason == x509.Expired:
+		return "expired", err
+	default:
+		return "invalid", err
+	}
+}
+
+// isSelfSigned returns true if cert is signed by its own key, whether or not
+// it is marked as a CA
+func isSelfSigned(cert *x509.Certificate) bool {
+	return bytes.Equal(cert.RawIssuer, cert.RawSubject) &&
+		cert.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature) == nil
+}
@@ -0,0 +1,169 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"k8s.io/client-go/rest"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+	mock_metrics "github.com/Azure/ARO-RP/pkg/util/mocks/metrics"
+	utiltls "github.com/Azure/ARO-RP/pkg/util/tls"
+)
+
+// fakeTLSDialer returns a dial function which serves certificate on an
+// in-memory connection
+func fakeTLSDialer(certificate tls.Certificate) dialContextFunc {
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+		client, server := net.Pipe()
+
+		go func() {
+			defer server.Close()
+			_ = tls.Server(server, &tls.Config{
+				Certificates: []tls.Certificate{certificate},
+			}).Handshake()
+		}()
+
+		return client, nil
+	}
+}
+
+func TestEmitTLSCertificates(t *testing.T) {
+	ctx := context.Background()
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	m := mock_metrics.NewMockInterface(controller)
+
+	caKey, caCerts, err := utiltls.GenerateKeyAndCertificate("ca", nil, nil, true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	apiKey, apiCerts, err := utiltls.GenerateKeyAndCertificate("api.cluster.location.aroapp.io", caKey, caCerts[0], false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the ingress certificate has been replaced by a self-signed one
+	ingressKey, ingressCerts, err := utiltls.GenerateKeyAndCertificate("*.apps.cluster.location.aroapp.io", nil, nil, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	roots := x509.NewCertPool()
+	roots.AddCert(caCerts[0])
+
+	mon := &Monitor{
+		log: utillog.GetLogger(),
+		m:   m,
+		oc: &api.OpenShiftCluster{
+			Properties: api.OpenShiftClusterProperties{
+				APIServerProfile: api.APIServerProfile{
+					URL: "https://api.cluster.location.aroapp.io:6443/",
+				},
+				ConsoleProfile: api.ConsoleProfile{
+					URL: "https://console-openshift-console.apps.cluster.location.aroapp.io/",
+				},
+				IngressProfiles: []api.IngressProfile{
+					{
+						Visibility: api.VisibilityPublic,
+					},
+				},
+			},
+		},
+		restconfig: &rest.Config{
+			Dial: fakeTLSDialer(tls.Certificate{
+				Certificate: [][]byte{apiCerts[0].Raw, caCerts[0].Raw},
+				PrivateKey:  apiKey,
+			}),
+		},
+		tlsRoots: roots,
+		ingressDial: fakeTLSDialer(tls.Certificate{
+			Certificate: [][]byte{ingressCerts[0].Raw},
+			PrivateKey:  ingressKey,
+		}),
+	}
+
+	m.EXPECT().EmitGauge("tls.certificate", int64(1), map[string]string{
+		"endpoint":   "api",
+		"result":     "valid",
+		"selfSigned": "false",
+	})
+	m.EXPECT().EmitGauge("tls.certificate", int64(1), map[string]string{
+		"endpoint":   "ingress",
+		"result":     "untrusted",
+		"selfSigned": "true",
+	})
+	for _, endpoint := range []string{"api", "ingress"} {
+		m.EXPECT().EmitGauge("tls.certificate.daysremaining", gomock.Any(), map[string]string{
+			"endpoint": endpoint,
+		})
+	}
+
+	err = mon.emitTLSCertificates(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestVerifyCertificates(t *testing.T) {
+	caKey, caCerts, err := utiltls.GenerateKeyAndCertificate("ca", nil, nil, true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	roots := x509.NewCertPool()
+	roots.AddCert(caCerts[0])
+
+	mon := &Monitor{
+		tlsRoots: roots,
+	}
+
+	signed := func(commonName string, parentKey *rsa.PrivateKey, parentCert *x509.Certificate) []*x509.Certificate {
+		_, certs, err := utiltls.GenerateKeyAndCertificate(commonName, parentKey, parentCert, false, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return certs
+	}
+
+	for _, tt := range []struct {
+		name       string
+		certs      []*x509.Certificate
+		wantResult string
+	}{
+		{
+			name:       "valid",
+			certs:      signed("api.cluster.location.aroapp.io", caKey, caCerts[0]),
+			wantResult: "valid",
+		},
+		{
+			name:       "hostname mismatch",
+			certs:      signed("api.other.location.aroapp.io", caKey, caCerts[0]),
+			wantResult: "hostnamemismatch",
+		},
+		{
+			name:       "self-signed",
+			certs:      signed("api.cluster.location.aroapp.io", nil, nil),
+			wantResult: "untrusted",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			result, _ := mon.verifyCertificates(tt.certs, "api.cluster.location.aroapp.io")
+			if result != tt.wantResult {
+				t.Error(result)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	machineZoneLabel = "machine.openshift.io/zone"
+	unknownZone      = "unknown"
+)
+
+type zoneHealth struct {
+	nodes              int64
+	nodesNotReady      int64
+	machines           int64
+	machinesNotRunning int64
+}
+
+// emitZoneHealth emits node and machine health per Azure availability zone.
+// Every zone which has a node or a machine is emitted, including its zero
+// counts, so that an incident in a single zone stands out when the metrics are
+// aggregated across the fleet by zone and location.
+func (mon *Monitor) emitZoneHealth(ctx context.Context) error {
+	zones := map[string]*zoneHealth{}
+	zone := func(name string) *zoneHealth {
+		if name == "" {
+			name = unknownZone
+		}
+		if zones[name] == nil {
+			zones[name] = &zoneHealth{}
+		}
+		return zones[name]
+	}
+
+	ns, err := mon.listNodes(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, n := range ns.Items {
+		z := zone(nodeZone(&n))
+		z.nodes++
+		if !nodeReady(&n) {
+			z.nodesNotReady++
+		}
+	}
+
+	machines, err := mon.maocli.MachineV1beta1().Machines("openshift-machine-api").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, m := range machines.Items {
+		z := zone(m.Labels[machineZoneLabel])
+		z.machines++
+		if m.Status.Phase == nil || *m.Status.Phase != "Running" {
+			z.machinesNotRunning++
+		}
+	}
+
+	for name, z := range zones {
+		mon.emitGauge("zone.nodes.count", z.nodes, map[string]string{"zone": name})
+		mon.emitGauge("zone.nodes.notready", z.nodesNotReady, map[string]string{"zone": name})
+		mon.emitGauge("zone.machines.count", z.machines, map[string]string{"zone": name})
+		mon.emitGauge("zone.machines.notrunning", z.machinesNotRunning, map[string]string{"zone": name})
+	}
+
+	return nil
+}
+
+// nodeZone returns the availability zone of a node in the form which the
+// machine API uses: the Azure cloud provider labels nodes with
+// "<location>-<zone>", and with "0" in locations without availability zones
+func nodeZone(n *v1.Node) string {
+	zone, ok := n.Labels[v1.LabelZoneFailureDomainStable]
+	if !ok {
+		zone = n.Labels[v1.LabelZoneFailureDomain]
+	}
+
+	if i := strings.LastIndexByte(zone, '-'); i != -1 {
+		zone = zone[i+1:]
+	}
+
+	return zone
+}
+
+func nodeReady(n *v1.Node) bool {
+	for _, c := range n.Status.Conditions {
+		if c.Type == v1.NodeReady {
+			return c.Status == v1.ConditionTrue
+		}
+	}
+
+	return false
+}
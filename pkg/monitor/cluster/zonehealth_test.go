@@ -0,0 +1,108 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/golang/mock/gomock"
+	machinev1beta1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
+	maofake "github.com/openshift/machine-api-operator/pkg/generated/clientset/versioned/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	mock_metrics "github.com/Azure/ARO-RP/pkg/util/mocks/metrics"
+)
+
+func TestEmitZoneHealth(t *testing.T) {
+	ctx := context.Background()
+
+	node := func(name, zone string, ready corev1.ConditionStatus) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Labels: map[string]string{
+					corev1.LabelZoneFailureDomainStable: zone,
+				},
+			},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{
+					{
+						Type:   corev1.NodeReady,
+						Status: ready,
+					},
+				},
+			},
+		}
+	}
+
+	machine := func(name, zone, phase string) *machinev1beta1.Machine {
+		return &machinev1beta1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "openshift-machine-api",
+				Labels: map[string]string{
+					machineZoneLabel: zone,
+				},
+			},
+			Status: machinev1beta1.MachineStatus{
+				Phase: to.StringPtr(phase),
+			},
+		}
+	}
+
+	cli := fake.NewSimpleClientset(
+		node("aro-master-0", "eastus-1", corev1.ConditionTrue),
+		node("aro-master-1", "eastus-2", corev1.ConditionFalse),
+		node("aro-worker-1", "eastus-2", corev1.ConditionTrue),
+		&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "unlabelled",
+			},
+		},
+	)
+	maocli := maofake.NewSimpleClientset(
+		machine("aro-master-0", "1", "Running"),
+		machine("aro-master-1", "2", "Failed"),
+		machine("aro-worker-1", "2", "Running"),
+		machine("aro-worker-3", "3", "Provisioning"),
+	)
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	m := mock_metrics.NewMockInterface(controller)
+
+	mon := &Monitor{
+		cli:    cli,
+		maocli: maocli,
+		m:      m,
+	}
+
+	for _, z := range []struct {
+		zone               string
+		nodes              int64
+		nodesNotReady      int64
+		machines           int64
+		machinesNotRunning int64
+	}{
+		{zone: "1", nodes: 1, machines: 1},
+		{zone: "2", nodes: 2, nodesNotReady: 1, machines: 2, machinesNotRunning: 1},
+		{zone: "3", machines: 1, machinesNotRunning: 1},
+		{zone: "unknown", nodes: 1, nodesNotReady: 1},
+	} {
+		m.EXPECT().EmitGauge("zone.nodes.count", z.nodes, map[string]string{"zone": z.zone})
+		m.EXPECT().EmitGauge("zone.nodes.notready", z.nodesNotReady, map[string]string{"zone": z.zone})
+		m.EXPECT().EmitGauge("zone.machines.count", z.machines, map[string]string{"zone": z.zone})
+		m.EXPECT().EmitGauge("zone.machines.notrunning", z.machinesNotRunning, map[string]string{"zone": z.zone})
+	}
+
+	err := mon.emitZoneHealth(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/bucket"
 )
 
 // master updates the monitor document with the list of buckets balanced between
@@ -62,63 +63,5 @@ func (mon *monitor) balance(monitors []string, doc *api.MonitorDocument) {
 		doc.Monitor = &api.Monitor{}
 	}
 
-	// ensure len(doc.Monitor.Buckets) == mon.bucketCount: this should only do
-	// anything on the very first run
-	if len(doc.Monitor.Buckets) < mon.bucketCount {
-		doc.Monitor.Buckets = append(doc.Monitor.Buckets, make([]string, mon.bucketCount-len(doc.Monitor.Buckets))...)
-	}
-	if len(doc.Monitor.Buckets) > mon.bucketCount { // should never happen
-		doc.Monitor.Buckets = doc.Monitor.Buckets[:mon.bucketCount]
-	}
-
-	var unallocated []int
-	m := make(map[string][]int, len(monitors)) // map of monitor to list of buckets it owns
-	for _, monitor := range monitors {
-		m[monitor] = nil
-	}
-
-	var target int // target number of buckets per monitor
-	if len(monitors) > 0 {
-		target = mon.bucketCount / len(monitors)
-		if mon.bucketCount%len(monitors) != 0 {
-			target++
-		}
-	}
-
-	// load the current bucket allocations into the map
-	for i, monitor := range doc.Monitor.Buckets {
-		if buckets, found := m[monitor]; found && len(buckets) < target {
-			// if the current bucket is allocated to a known monitor and doesn't
-			// take its number of buckets above the target, keep it there...
-			m[monitor] = append(m[monitor], i)
-		} else {
-			// ...otherwise we'll reallocate it below
-			unallocated = append(unallocated, i)
-		}
-	}
-
-	// reallocate all unallocated buckets, appending to the least loaded monitor
-	if len(monitors) > 0 {
-		for _, i := range unallocated {
-			var leastMonitor string
-			for monitor := range m {
-				if leastMonitor == "" ||
-					len(m[monitor]) < len(m[leastMonitor]) {
-					leastMonitor = monitor
-				}
-			}
-
-			m[leastMonitor] = append(m[leastMonitor], i)
-		}
-	}
-
-	// write the updated bucket allocations back to the document
-	for _, i := range unallocated {
-		doc.Monitor.Buckets[i] = "" // should only happen if there are no known monitors
-	}
-	for monitor, buckets := range m {
-		for _, i := range buckets {
-			doc.Monitor.Buckets[i] = monitor
-		}
-	}
+	doc.Monitor.Buckets = bucket.Balance(monitors, doc.Monitor.Buckets, mon.bucketCount)
 }
@@ -25,6 +25,7 @@ type monitor struct {
 	baseLog *logrus.Entry
 	dialer  proxy.Dialer
 
+	dbAsyncOperations   database.AsyncOperations
 	dbMonitors          database.Monitors
 	dbOpenShiftClusters database.OpenShiftClusters
 	dbSubscriptions     database.Subscriptions
@@ -42,17 +43,21 @@ type monitor struct {
 	lastBucketlist atomic.Value //time.Time
 	lastChangefeed atomic.Value //time.Time
 	startTime      time.Time
+
+	slis    *sliRecorder
+	lastSLO time.Time
 }
 
 type Runnable interface {
 	Run(context.Context) error
 }
 
-func NewMonitor(log *logrus.Entry, dialer proxy.Dialer, dbMonitors database.Monitors, dbOpenShiftClusters database.OpenShiftClusters, dbSubscriptions database.Subscriptions, m, clusterm metrics.Interface) Runnable {
+func NewMonitor(log *logrus.Entry, dialer proxy.Dialer, dbAsyncOperations database.AsyncOperations, dbMonitors database.Monitors, dbOpenShiftClusters database.OpenShiftClusters, dbSubscriptions database.Subscriptions, m, clusterm metrics.Interface) Runnable {
 	return &monitor{
 		baseLog: log,
 		dialer:  dialer,
 
+		dbAsyncOperations:   dbAsyncOperations,
 		dbMonitors:          dbMonitors,
 		dbOpenShiftClusters: dbOpenShiftClusters,
 		dbSubscriptions:     dbSubscriptions,
@@ -66,6 +71,8 @@ func NewMonitor(log *logrus.Entry, dialer proxy.Dialer, dbMonitors database.Moni
 		buckets:     map[int]struct{}{},
 
 		startTime: time.Now(),
+
+		slis: newSLIRecorder(),
 	}
 }
 
@@ -106,6 +113,16 @@ func (mon *monitor) Run(ctx context.Context) error {
 			mon.lastBucketlist.Store(time.Now())
 		}
 
+		// emit the SLO metrics; the provisioning SLO is only emitted by the
+		// master, as it covers the whole region
+		if time.Since(mon.lastSLO) >= sloInterval {
+			err = mon.emitSLOs(ctx, time.Now())
+			if err != nil {
+				mon.baseLog.Error(err)
+			}
+			mon.lastSLO = time.Now()
+		}
+
 		<-t.C
 	}
 }
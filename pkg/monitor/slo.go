@@ -0,0 +1,222 @@
+package monitor
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/metrics"
+)
+
+// Service level indicators.  The availability SLIs are cluster weighted: every
+// check of a cluster contributes one good or bad event.
+const (
+	sliAPIServerAvailability = "apiserveravailability"
+	sliIngressAvailability   = "ingressavailability"
+	sliProvisioningSuccess   = "provisioningsuccess"
+)
+
+// sloObjectives are the service level objectives of the SLIs
+var sloObjectives = map[string]float64{
+	sliAPIServerAvailability: 0.999,
+	sliIngressAvailability:   0.999,
+	sliProvisioningSuccess:   0.99,
+}
+
+// sloWindows are the windows over which SLO attainment and error budget burn
+// are reported.  A short and a long window together tell a fast burn which
+// needs paging from a slow one which needs a ticket.
+var sloWindows = map[string]time.Duration{
+	"1h":  time.Hour,
+	"6h":  6 * time.Hour,
+	"24h": 24 * time.Hour,
+}
+
+// sloMaxWindow is the longest of sloWindows
+const sloMaxWindow = 24 * time.Hour
+
+// customerErrorCodes are the codes of operation failures caused by the
+// customer's configuration, which don't count against the provisioning SLO
+var customerErrorCodes = map[string]struct{}{
+	api.CloudErrorCodeInvalidLinkedRouteTable:            {},
+	api.CloudErrorCodeInvalidLinkedVNet:                  {},
+	api.CloudErrorCodeInvalidResourceProviderPermissions: {},
+	api.CloudErrorCodeInvalidServicePrincipalClaims:      {},
+	api.CloudErrorCodeInvalidServicePrincipalCredentials: {},
+	api.CloudErrorCodeInvalidServicePrincipalPermissions: {},
+	api.CloudErrorCodeQuotaExceeded:                      {},
+	api.CloudErrorCodeResourceQuotaExceeded:              {},
+}
+
+type sliMinute struct {
+	minute int64
+	good   int64
+	total  int64
+}
+
+// sliRecorder counts the good and total events of the availability SLIs per
+// minute over sloMaxWindow.  Each monitor only counts the clusters in its own
+// buckets.
+type sliRecorder struct {
+	mu      sync.Mutex
+	minutes map[string][]sliMinute
+}
+
+func newSLIRecorder() *sliRecorder {
+	return &sliRecorder{
+		minutes: map[string][]sliMinute{},
+	}
+}
+
+func (r *sliRecorder) record(sli string, good bool, now time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.minutes[sli] == nil {
+		r.minutes[sli] = make([]sliMinute, sloMaxWindow/time.Minute)
+	}
+
+	minute := now.Unix() / 60
+	m := &r.minutes[sli][minute%int64(len(r.minutes[sli]))]
+
+	if m.minute != minute {
+		*m = sliMinute{minute: minute}
+	}
+
+	m.total++
+	if good {
+		m.good++
+	}
+}
+
+// counts returns the good and total events of the SLI in the window ending
+// at now
+func (r *sliRecorder) counts(sli string, window time.Duration, now time.Time) (good, total int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	minute := now.Unix() / 60
+	for _, m := range r.minutes[sli] {
+		if m.minute > minute-int64(window/time.Minute) && m.minute <= minute {
+			good += m.good
+			total += m.total
+		}
+	}
+
+	return good, total
+}
+
+// sloInterval is the interval at which the SLO metrics are emitted
+const sloInterval = time.Minute
+
+// emitSLOs emits the availability SLOs of the clusters monitored by this
+// monitor and, on the master, the provisioning SLO of the region.  The
+// monitor.slo.good and monitor.slo.total gauges of the availability SLIs can be
+// summed over the monitors of the region.
+func (mon *monitor) emitSLOs(ctx context.Context, now time.Time) error {
+	for _, sli := range []string{sliAPIServerAvailability, sliIngressAvailability} {
+		for window, duration := range sloWindows {
+			good, total := mon.slis.counts(sli, duration, now)
+			emitSLO(mon.m, sli, window, good, total)
+		}
+	}
+
+	if !mon.isMaster {
+		return nil
+	}
+
+	counts, err := mon.provisioningCounts(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	for window, c := range counts {
+		emitSLO(mon.m, sliProvisioningSuccess, window, c[0], c[1])
+	}
+
+	return nil
+}
+
+// provisioningCounts returns the good and total operations completed in each
+// of sloWindows
+func (mon *monitor) provisioningCounts(ctx context.Context, now time.Time) (map[string][2]int64, error) {
+	counts := make(map[string][2]int64, len(sloWindows))
+
+	i := mon.dbAsyncOperations.ListCompletedSince(now.Add(-sloMaxWindow))
+	for {
+		docs, err := i.Next(ctx, -1)
+		if err != nil {
+			return nil, err
+		}
+		if docs == nil {
+			break
+		}
+
+		for _, doc := range docs.AsyncOperationDocuments {
+			good := doc.AsyncOperation.ProvisioningState == api.ProvisioningStateSucceeded
+			if !good && doc.AsyncOperation.Error != nil {
+				if _, found := customerErrorCodes[doc.AsyncOperation.Error.Code]; found {
+					continue
+				}
+			}
+
+			for window, duration := range sloWindows {
+				if int64(doc.Timestamp) < now.Add(-duration).Unix() {
+					continue
+				}
+
+				c := counts[window]
+				if good {
+					c[0]++
+				}
+				c[1]++
+				counts[window] = c
+			}
+		}
+	}
+
+	return counts, nil
+}
+
+// emitSLO emits the SLI, whether the SLO is attained and the error budget burn
+// rate of an SLI over a window.  A burn rate of 1 spends the error budget
+// exactly over the window.
+func emitSLO(m metrics.Interface, sli, window string, good, total int64) {
+	if total == 0 {
+		return
+	}
+
+	dims := map[string]string{
+		"sli":    sli,
+		"window": window,
+	}
+
+	value := float64(good) / float64(total)
+	objective := sloObjectives[sli]
+
+	var attained int64
+	if value >= objective {
+		attained = 1
+	}
+
+	m.EmitGauge("monitor.slo.good", good, dims)
+	m.EmitGauge("monitor.slo.total", total, dims)
+	m.EmitFloat("monitor.slo.sli", value, dims)
+	m.EmitGauge("monitor.slo.attained", attained, dims)
+	m.EmitFloat("monitor.slo.errorbudgetburn", (1-value)/(1-objective), dims)
+}
+
+// recordSLIs records the SLIs sampled by a cluster monitoring run
+func (mon *monitor) recordSLIs(apiServerAvailable, ingressAvailable *bool, now time.Time) {
+	if apiServerAvailable != nil {
+		mon.slis.record(sliAPIServerAvailability, *apiServerAvailable, now)
+	}
+
+	if ingressAvailable != nil {
+		mon.slis.record(sliIngressAvailability, *ingressAvailable, now)
+	}
+}
@@ -0,0 +1,163 @@
+package monitor
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	mock_metrics "github.com/Azure/ARO-RP/pkg/util/mocks/metrics"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestSLIRecorder(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+
+	r := newSLIRecorder()
+	r.record(sliAPIServerAvailability, true, now.Add(-25*time.Hour)) // overwritten
+	r.record(sliAPIServerAvailability, false, now.Add(-2*time.Hour))
+	r.record(sliAPIServerAvailability, true, now.Add(-30*time.Minute))
+	r.record(sliAPIServerAvailability, true, now)
+	r.record(sliAPIServerAvailability, false, now)
+	r.record(sliIngressAvailability, true, now)
+
+	for _, tt := range []struct {
+		window    time.Duration
+		wantGood  int64
+		wantTotal int64
+	}{
+		{
+			window:    time.Hour,
+			wantGood:  2,
+			wantTotal: 3,
+		},
+		{
+			window:    6 * time.Hour,
+			wantGood:  2,
+			wantTotal: 4,
+		},
+		{
+			window:    24 * time.Hour,
+			wantGood:  2,
+			wantTotal: 4,
+		},
+	} {
+		t.Run(tt.window.String(), func(t *testing.T) {
+			good, total := r.counts(sliAPIServerAvailability, tt.window, now)
+			if good != tt.wantGood {
+				t.Error(good)
+			}
+			if total != tt.wantTotal {
+				t.Error(total)
+			}
+		})
+	}
+}
+
+func TestEmitSLO(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	m := mock_metrics.NewMockInterface(controller)
+
+	dims := map[string]string{
+		"sli":    sliProvisioningSuccess,
+		"window": "1h",
+	}
+
+	m.EXPECT().EmitGauge("monitor.slo.good", int64(97), dims)
+	m.EXPECT().EmitGauge("monitor.slo.total", int64(100), dims)
+	m.EXPECT().EmitFloat("monitor.slo.sli", 0.97, dims)
+	m.EXPECT().EmitGauge("monitor.slo.attained", int64(0), dims)
+	m.EXPECT().EmitFloat("monitor.slo.errorbudgetburn", gomock.Any(), dims).Do(func(metric string, value float64, dims map[string]string) {
+		if value < 2.99 || value > 3.01 {
+			t.Error(value)
+		}
+	})
+
+	emitSLO(m, sliProvisioningSuccess, "1h", 97, 100)
+
+	// no events, no metrics
+	emitSLO(m, sliProvisioningSuccess, "1h", 0, 0)
+}
+
+func TestProvisioningCounts(t *testing.T) {
+	ctx := context.Background()
+
+	now := time.Unix(1600000000, 0)
+
+	dbAsyncOperations, client := testdatabase.NewFakeAsyncOperations()
+
+	for _, doc := range []*api.AsyncOperationDocument{
+		{
+			ID:        "succeeded",
+			Timestamp: int(now.Add(-10 * time.Minute).Unix()),
+			AsyncOperation: &api.AsyncOperation{
+				ProvisioningState: api.ProvisioningStateSucceeded,
+			},
+		},
+		{
+			ID:        "failed",
+			Timestamp: int(now.Add(-3 * time.Hour).Unix()),
+			AsyncOperation: &api.AsyncOperation{
+				ProvisioningState: api.ProvisioningStateFailed,
+				Error: &api.CloudErrorBody{
+					Code: api.CloudErrorCodeInternalServerError,
+				},
+			},
+		},
+		{
+			ID:        "customer",
+			Timestamp: int(now.Add(-10 * time.Minute).Unix()),
+			AsyncOperation: &api.AsyncOperation{
+				ProvisioningState: api.ProvisioningStateFailed,
+				Error: &api.CloudErrorBody{
+					Code: api.CloudErrorCodeInvalidServicePrincipalCredentials,
+				},
+			},
+		},
+		{
+			ID:        "inprogress",
+			Timestamp: int(now.Add(-10 * time.Minute).Unix()),
+			AsyncOperation: &api.AsyncOperation{
+				ProvisioningState: api.ProvisioningStateCreating,
+			},
+		},
+		{
+			ID:        "old",
+			Timestamp: int(now.Add(-25 * time.Hour).Unix()),
+			AsyncOperation: &api.AsyncOperation{
+				ProvisioningState: api.ProvisioningStateFailed,
+			},
+		},
+	} {
+		_, err := client.Create(ctx, "", doc, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	mon := &monitor{
+		dbAsyncOperations: dbAsyncOperations,
+	}
+
+	counts, err := mon.provisioningCounts(ctx, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for window, want := range map[string][2]int64{
+		"1h":  {1, 1},
+		"6h":  {1, 2},
+		"24h": {1, 2},
+	} {
+		if counts[window] != want {
+			t.Error(window, counts[window])
+		}
+	}
+}
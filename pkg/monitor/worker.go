@@ -12,6 +12,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database"
 	"github.com/Azure/ARO-RP/pkg/monitor/cluster"
 	utillog "github.com/Azure/ARO-RP/pkg/util/log"
 	"github.com/Azure/ARO-RP/pkg/util/recover"
@@ -47,6 +48,9 @@ func (mon *monitor) listBuckets(ctx context.Context) error {
 func (mon *monitor) changefeed(ctx context.Context, baseLog *logrus.Entry, stop <-chan struct{}) {
 	defer recover.Panic(baseLog)
 
+	// enumerating the clusters and subscriptions needn't load the write region
+	ctx = database.WithReadReplicas(ctx)
+
 	clustersIterator := mon.dbOpenShiftClusters.ChangeFeed()
 	subscriptionsIterator := mon.dbSubscriptions.ChangeFeed()
 
@@ -205,4 +209,7 @@ func (mon *monitor) workOne(ctx context.Context, log *logrus.Entry, doc *api.Ope
 	}
 
 	c.Monitor(ctx)
+
+	slis := c.SLIs()
+	mon.recordSLIs(slis.APIServerAvailable, slis.IngressAvailable, time.Now())
 }
@@ -5,6 +5,7 @@ package v1alpha1
 
 import (
 	"github.com/operator-framework/operator-sdk/pkg/status"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -13,10 +14,63 @@ const (
 	InternetReachableFromMaster status.ConditionType = "InternetReachableFromMaster"
 	InternetReachableFromWorker status.ConditionType = "InternetReachableFromWorker"
 	MachineValid                status.ConditionType = "MachineValid"
+	ProxyValid                  status.ConditionType = "ProxyValid"
+	AzureAPINotThrottled        status.ConditionType = "AzureAPINotThrottled"
+	GenevaLoggingHealthy        status.ConditionType = "GenevaLoggingHealthy"
+	NodeProblemsNotDetected     status.ConditionType = "NodeProblemsNotDetected"
+	RBACValid                   status.ConditionType = "RBACValid"
+	ServicePrincipalValid       status.ConditionType = "ServicePrincipalValid"
+	EtcdSpaceAvailable          status.ConditionType = "EtcdSpaceAvailable"
+	ACRTokenValid               status.ConditionType = "ACRTokenValid"
+	DNSValid                    status.ConditionType = "DNSValid"
+	WorkaroundsNotExpired       status.ConditionType = "WorkaroundsNotExpired"
+	AutoscalerConfigValid       status.ConditionType = "AutoscalerConfigValid"
+	NodeSizingApplied           status.ConditionType = "NodeSizingApplied"
+	ImageRegistryConfigValid    status.ConditionType = "ImageRegistryConfigValid"
+	GenevaTrustBundleValid      status.ConditionType = "GenevaTrustBundleValid"
+	DeniedWritesNotDetected     status.ConditionType = "DeniedWritesNotDetected"
+	ManagedPodsNotCrashLooping  status.ConditionType = "ManagedPodsNotCrashLooping"
+	NodeClocksSynchronized      status.ConditionType = "NodeClocksSynchronized"
+	IMDSReachableFromMaster     status.ConditionType = "IMDSReachableFromMaster"
+	IMDSReachableFromWorker     status.ConditionType = "IMDSReachableFromWorker"
+	PriorityClassesValid        status.ConditionType = "PriorityClassesValid"
+	ClusterVersionPolicyValid   status.ConditionType = "ClusterVersionPolicyValid"
+	NodeCertificatesRotating    status.ConditionType = "NodeCertificatesRotating"
+	ManagedDaemonSetsScheduled  status.ConditionType = "ManagedDaemonSetsScheduled"
+	ImagePolicyValid            status.ConditionType = "ImagePolicyValid"
+	VnetDNSServersValid         status.ConditionType = "VnetDNSServersValid"
+	NetworkValid                status.ConditionType = "NetworkValid"
+	ResourceQuotaValid          status.ConditionType = "ResourceQuotaValid"
+)
+
+// Managed components whose resources can be overridden in ComponentResources
+const (
+	ComponentGenevaLogging       = "genevalogging"
+	ComponentNodeProblemDetector = "nodeproblemdetector"
+)
+
+// Types of UnsupportedConfiguration reported in the SupportabilityStatus
+const (
+	UnsupportedMachineCIDR           = "MachineCIDRChanged"
+	UnsupportedNetworkOperatorConfig = "NetworkOperatorConfigModified"
+	UnsupportedAROOperatorRBAC       = "AROOperatorRBACModified"
+	UnsupportedNodeAgents            = "UnsupportedNodeAgentsInstalled"
+)
+
+// Reasons of the MachineCheckFailures reported in MachineCheckStatus, which
+// are also the reasons of the events recorded on the offending objects
+const (
+	MachineInvalidProviderSpec    = "InvalidProviderSpec"
+	MachineInvalidVMSize          = "InvalidVMSize"
+	MachineInvalidDiskSize        = "InvalidDiskSize"
+	MachineInvalidImage           = "InvalidImage"
+	MachineInvalidSecurityProfile = "InvalidSecurityProfile"
+	MachineInvalidManagedIdentity = "InvalidManagedIdentity"
+	MachineMissingRoleLabel       = "MissingRoleLabel"
 )
 
 func AllConditionTypes() []status.ConditionType {
-	return []status.ConditionType{InternetReachableFromMaster, InternetReachableFromWorker, MachineValid}
+	return []status.ConditionType{InternetReachableFromMaster, InternetReachableFromWorker, MachineValid, ProxyValid, AzureAPINotThrottled, GenevaLoggingHealthy, NodeProblemsNotDetected, RBACValid, ServicePrincipalValid, EtcdSpaceAvailable, ACRTokenValid, DNSValid, WorkaroundsNotExpired, AutoscalerConfigValid, NodeSizingApplied, ImageRegistryConfigValid, GenevaTrustBundleValid, DeniedWritesNotDetected, ManagedPodsNotCrashLooping, NodeClocksSynchronized, IMDSReachableFromMaster, IMDSReachableFromWorker, PriorityClassesValid, ClusterVersionPolicyValid, NodeCertificatesRotating, ManagedDaemonSetsScheduled, ImagePolicyValid, VnetDNSServersValid, NetworkValid, ResourceQuotaValid}
 }
 
 type GenevaLoggingSpec struct {
@@ -24,12 +78,90 @@ type GenevaLoggingSpec struct {
 	ConfigVersion string `json:"configVersion,omitempty"`
 	// +kubebuilder:validation:Enum=DiagnosticsProd;Test
 	MonitoringGCSEnvironment string `json:"monitoringGCSEnvironment,omitempty"`
+
+	// MonitoringEndpoint is the Geneva endpoint which mdsd connects to.  The
+	// operator checks that the trusted CA bundle verifies its certificate.
+	MonitoringEndpoint string `json:"monitoringEndpoint,omitempty"`
 }
 
 type InternetCheckerSpec struct {
 	URLs []string `json:"urls,omitempty"`
 }
 
+// DNSCheckerSpec holds what the operator needs to check the custom DNS
+// servers which may be set on the cluster VNet
+type DNSCheckerSpec struct {
+	// VnetID is the Azure resourceId of the cluster VNet
+	VnetID string `json:"vnetId,omitempty"`
+
+	// Names are the names of the endpoints which the cluster depends on,
+	// which the DNS servers must resolve
+	Names []string `json:"names,omitempty"`
+}
+
+// NetworkCheckerSpec holds what the operator needs to check the Azure
+// network resources of the cluster
+type NetworkCheckerSpec struct {
+	// Subnets are the master and worker subnets of the cluster
+	Subnets []SubnetSpec `json:"subnets,omitempty"`
+}
+
+// SubnetSpec is a subnet of the cluster and the network security group which
+// the RP attached to it
+type SubnetSpec struct {
+	// ID is the Azure resourceId of the subnet
+	ID string `json:"id"`
+
+	// NetworkSecurityGroupID is the Azure resourceId of the network security
+	// group which must be attached to the subnet
+	NetworkSecurityGroupID string `json:"networkSecurityGroupId,omitempty"`
+}
+
+type ConsoleNotificationSpec struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Enum=Information;Maintenance;Deprecation
+	Type string `json:"type"`
+	// +kubebuilder:validation:Enum=BannerTop;BannerBottom;BannerTopBottom
+	Location string `json:"location,omitempty"`
+	Text     string `json:"text"`
+	LinkText string `json:"linkText,omitempty"`
+	LinkURL  string `json:"linkUrl,omitempty"`
+}
+
+// AutoscalerSpec is the cluster autoscaler configuration requested by the
+// RP.  Its fields are not omitempty for the same reason as
+// ConsoleNotifications: clearing a value must overwrite the existing one.
+type AutoscalerSpec struct {
+	MaxNodesTotal          int                  `json:"maxNodesTotal"`
+	ScaleDownDelayAfterAdd string               `json:"scaleDownDelayAfterAdd"`
+	ScaleDownUnneededTime  string               `json:"scaleDownUnneededTime"`
+	Pools                  []AutoscalerPoolSpec `json:"pools"`
+}
+
+// AutoscalerPoolSpec bounds the number of nodes of a worker profile
+type AutoscalerPoolSpec struct {
+	Name        string `json:"name"`
+	MinReplicas int    `json:"minReplicas"`
+	MaxReplicas int    `json:"maxReplicas"`
+}
+
+// WorkerPoolSpec is the node metadata requested via the RP for the nodes of
+// a worker profile.  Its fields are not omitempty for the same reason as
+// ConsoleNotifications: clearing a value must overwrite the existing one.
+type WorkerPoolSpec struct {
+	Name       string            `json:"name"`
+	NodeLabels map[string]string `json:"nodeLabels"`
+	NodeTaints []NodeTaintSpec   `json:"nodeTaints"`
+}
+
+// NodeTaintSpec is a taint on the nodes of a worker profile
+type NodeTaintSpec struct {
+	Key   string `json:"key"`
+	Value string `json:"value,omitempty"`
+	// +kubebuilder:validation:Enum=NoSchedule;PreferNoSchedule;NoExecute
+	Effect string `json:"effect"`
+}
+
 // ClusterSpec defines the desired state of Cluster
 type ClusterSpec struct {
 	// ResourceID is the Azure resourceId of the cluster
@@ -39,12 +171,186 @@ type ClusterSpec struct {
 	Location        string              `json:"location,omitempty"`
 	GenevaLogging   GenevaLoggingSpec   `json:"genevaLogging,omitempty"`
 	InternetChecker InternetCheckerSpec `json:"internetChecker,omitempty"`
+	DNSChecker      DNSCheckerSpec      `json:"dnsChecker,omitempty"`
+	NetworkChecker  NetworkCheckerSpec  `json:"networkChecker,omitempty"`
+
+	// InventoryURL is where the operator reports the inventory of the
+	// cluster to the RP.  If it is empty, the operator doesn't report.
+	InventoryURL string `json:"inventoryUrl,omitempty"`
+
+	// MachineCIDR is the machine network which the RP wrote into the
+	// install config, if the customer specified one
+	MachineCIDR string `json:"machineCidr,omitempty"`
+
+	// PricingTier is the tier at which the RP bills the cluster.  The
+	// operator copies it onto the nodes with the rest of the node metadata.
+	PricingTier string `json:"pricingTier,omitempty"`
+
+	// ConsoleNotifications is deliberately not omitempty: the operator deploy
+	// code merges the spec onto the existing object, so removing the last
+	// notification must be expressed as an explicit null.
+	// +optional
+	// +nullable
+	ConsoleNotifications []ConsoleNotificationSpec `json:"consoleNotifications"`
+
+	// Autoscaler is the cluster autoscaler configuration.  If it is null,
+	// the operator removes the autoscaler resources it created.
+	// +optional
+	// +nullable
+	Autoscaler *AutoscalerSpec `json:"autoscaler"`
+
+	// WorkerPools holds the node labels and taints of each worker profile.
+	// The operator removes the labels and taints it set from the nodes of
+	// worker profiles which are not listed.
+	// +optional
+	// +nullable
+	WorkerPools []WorkerPoolSpec `json:"workerPools"`
+
+	// ComponentResources overrides the resource requests and limits of the
+	// containers of managed components, keyed by component.  The RP sets it
+	// to shrink the managed overhead of small clusters; components without
+	// an entry keep their defaults.  It is not omitempty for the same reason
+	// as ConsoleNotifications.
+	// +optional
+	// +nullable
+	ComponentResources map[string]corev1.ResourceRequirements `json:"componentResources"`
+
+	// OperatorFlags holds every flag of the operator's catalog, set to its
+	// default or to the value set on the cluster via the admin API
+	OperatorFlags map[string]string `json:"operatorFlags,omitempty"`
+
+	// Checks overrides whether and how often each checker runs, keyed by
+	// checker name, e.g. MachineChecker.  The RP never sets it, so that
+	// changes made by SREs on the cluster survive cluster updates.
+	Checks map[string]CheckPolicy `json:"checks,omitempty"`
+}
+
+// CheckPolicy overrides the schedule of a checker
+type CheckPolicy struct {
+	// Enabled switches the checker off if set to false.  The conditions
+	// which the checker last set are left as they were.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Interval is how often the checker runs.  It defaults to an hour and
+	// is at least a minute.
+	Interval *metav1.Duration `json:"interval,omitempty"`
+}
+
+// UnsupportedConfiguration is a configuration found on the cluster which is
+// outside the ARO support policy
+type UnsupportedConfiguration struct {
+	Type    string `json:"type"`
+	Message string `json:"message,omitempty"`
+}
+
+// SupportabilityStatus is the result of the operator's most recent scan for
+// unsupported configurations
+type SupportabilityStatus struct {
+	LastTransitionTime        metav1.Time                `json:"lastTransitionTime,omitempty"`
+	UnsupportedConfigurations []UnsupportedConfiguration `json:"unsupportedConfigurations,omitempty"`
+}
+
+// ConditionTransition records a change of status of a condition, so that
+// problems which have since resolved themselves can still be diagnosed
+type ConditionTransition struct {
+	Type               status.ConditionType   `json:"type"`
+	Status             corev1.ConditionStatus `json:"status"`
+	Reason             status.ConditionReason `json:"reason,omitempty"`
+	Message            string                 `json:"message,omitempty"`
+	LastTransitionTime metav1.Time            `json:"lastTransitionTime,omitempty"`
+}
+
+// ActiveWorkaround is a workaround which the operator currently applies to
+// the cluster, and the date after which it is expected to be no longer needed
+type ActiveWorkaround struct {
+	Name    string      `json:"name"`
+	Expires metav1.Time `json:"expires,omitempty"`
+}
+
+// MachineCheckStatus is a machine or machineset which failed the most recent
+// machine check, and why
+type MachineCheckStatus struct {
+	// Kind is Machine or MachineSet
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+
+	// Role is the cluster-api-machine-role label of the machine, or of the
+	// machines of the machineset
+	Role string `json:"role,omitempty"`
+
+	Failures []MachineCheckFailure `json:"failures"`
+}
+
+// MachineCheckFailure is a single problem found with a machine or machineset
+type MachineCheckFailure struct {
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
+
+// MachineConfigStatus is a MachineConfig which ARO applies to the nodes, and
+// its state in each machine config pool which selects it
+type MachineConfigStatus struct {
+	Name string `json:"name"`
+
+	// Hash is the SHA-256 hash of the spec of the MachineConfig
+	Hash string `json:"hash"`
+
+	Pools []MachineConfigPoolState `json:"pools,omitempty"`
+}
+
+// States of a MachineConfig in a machine config pool
+const (
+	// MachineConfigStatePending means that the MachineConfig is not yet part
+	// of the pool's rendered config
+	MachineConfigStatePending = "Pending"
+
+	// MachineConfigStateUpdating means that the pool's nodes are being
+	// updated to the rendered config which includes the MachineConfig
+	MachineConfigStateUpdating = "Updating"
+
+	// MachineConfigStateApplied means that all the pool's nodes run the
+	// rendered config which includes the MachineConfig
+	MachineConfigStateApplied = "Applied"
+)
+
+// MachineConfigPoolState is the state of a MachineConfig in a machine config
+// pool.  A node runs the MachineConfig as intended if the node's
+// machineconfiguration.openshift.io/currentConfig annotation is
+// RenderedConfig.
+type MachineConfigPoolState struct {
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Enum=Pending;Updating;Applied
+	State string `json:"state"`
+
+	// RenderedConfig is the rendered config of the pool which includes the
+	// current spec of the MachineConfig, if any
+	RenderedConfig string `json:"renderedConfig,omitempty"`
 }
 
 // ClusterStatus defines the observed state of Cluster
 type ClusterStatus struct {
-	OperatorVersion string            `json:"operatorVersion,omitempty"`
-	Conditions      status.Conditions `json:"conditions,omitempty"`
+	OperatorVersion   string                `json:"operatorVersion,omitempty"`
+	Conditions        status.Conditions     `json:"conditions,omitempty"`
+	Supportability    *SupportabilityStatus `json:"supportability,omitempty"`
+	ActiveWorkarounds []ActiveWorkaround    `json:"activeWorkarounds,omitempty"`
+
+	// ConditionHistory holds the most recent transitions of each condition,
+	// oldest first
+	ConditionHistory []ConditionTransition `json:"conditionHistory,omitempty"`
+
+	// LastHeartbeatTime is when the master operator last completed a periodic
+	// run of its checkers, so that an operator which is down or wedged can be
+	// told apart from one which has nothing to report
+	LastHeartbeatTime metav1.Time `json:"lastHeartbeatTime,omitempty"`
+
+	// MachineConfigs are the MachineConfigs which ARO applies to the nodes,
+	// sorted by name
+	MachineConfigs []MachineConfigStatus `json:"machineConfigs,omitempty"`
+
+	// MachineChecks are the machines and machinesets which failed the most
+	// recent machine check.  The MachineValid condition summarises them.
+	MachineChecks []MachineCheckStatus `json:"machineChecks,omitempty"`
 }
 
 // +kubebuilder:object:root=true
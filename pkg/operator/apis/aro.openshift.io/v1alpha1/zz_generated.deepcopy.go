@@ -6,9 +6,87 @@ package v1alpha1
 
 import (
 	"github.com/operator-framework/operator-sdk/pkg/status"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActiveWorkaround) DeepCopyInto(out *ActiveWorkaround) {
+	*out = *in
+	in.Expires.DeepCopyInto(&out.Expires)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActiveWorkaround.
+func (in *ActiveWorkaround) DeepCopy() *ActiveWorkaround {
+	if in == nil {
+		return nil
+	}
+	out := new(ActiveWorkaround)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalerPoolSpec) DeepCopyInto(out *AutoscalerPoolSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalerPoolSpec.
+func (in *AutoscalerPoolSpec) DeepCopy() *AutoscalerPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalerPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalerSpec) DeepCopyInto(out *AutoscalerSpec) {
+	*out = *in
+	if in.Pools != nil {
+		in, out := &in.Pools, &out.Pools
+		*out = make([]AutoscalerPoolSpec, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalerSpec.
+func (in *AutoscalerSpec) DeepCopy() *AutoscalerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CheckPolicy) DeepCopyInto(out *CheckPolicy) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CheckPolicy.
+func (in *CheckPolicy) DeepCopy() *CheckPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CheckPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Cluster) DeepCopyInto(out *Cluster) {
 	*out = *in
@@ -73,6 +151,46 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 	*out = *in
 	out.GenevaLogging = in.GenevaLogging
 	in.InternetChecker.DeepCopyInto(&out.InternetChecker)
+	in.DNSChecker.DeepCopyInto(&out.DNSChecker)
+	in.NetworkChecker.DeepCopyInto(&out.NetworkChecker)
+	if in.ConsoleNotifications != nil {
+		in, out := &in.ConsoleNotifications, &out.ConsoleNotifications
+		*out = make([]ConsoleNotificationSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.Autoscaler != nil {
+		in, out := &in.Autoscaler, &out.Autoscaler
+		*out = new(AutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkerPools != nil {
+		in, out := &in.WorkerPools, &out.WorkerPools
+		*out = make([]WorkerPoolSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ComponentResources != nil {
+		in, out := &in.ComponentResources, &out.ComponentResources
+		*out = make(map[string]v1.ResourceRequirements, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.OperatorFlags != nil {
+		in, out := &in.OperatorFlags, &out.OperatorFlags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Checks != nil {
+		in, out := &in.Checks, &out.Checks
+		*out = make(map[string]CheckPolicy, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSpec.
@@ -95,6 +213,40 @@ func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Supportability != nil {
+		in, out := &in.Supportability, &out.Supportability
+		*out = new(SupportabilityStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ActiveWorkarounds != nil {
+		in, out := &in.ActiveWorkarounds, &out.ActiveWorkarounds
+		*out = make([]ActiveWorkaround, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConditionHistory != nil {
+		in, out := &in.ConditionHistory, &out.ConditionHistory
+		*out = make([]ConditionTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.LastHeartbeatTime.DeepCopyInto(&out.LastHeartbeatTime)
+	if in.MachineConfigs != nil {
+		in, out := &in.MachineConfigs, &out.MachineConfigs
+		*out = make([]MachineConfigStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MachineChecks != nil {
+		in, out := &in.MachineChecks, &out.MachineChecks
+		*out = make([]MachineCheckStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStatus.
@@ -107,6 +259,57 @@ func (in *ClusterStatus) DeepCopy() *ClusterStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConditionTransition) DeepCopyInto(out *ConditionTransition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionTransition.
+func (in *ConditionTransition) DeepCopy() *ConditionTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(ConditionTransition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsoleNotificationSpec) DeepCopyInto(out *ConsoleNotificationSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleNotificationSpec.
+func (in *ConsoleNotificationSpec) DeepCopy() *ConsoleNotificationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsoleNotificationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSCheckerSpec) DeepCopyInto(out *DNSCheckerSpec) {
+	*out = *in
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSCheckerSpec.
+func (in *DNSCheckerSpec) DeepCopy() *DNSCheckerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSCheckerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GenevaLoggingSpec) DeepCopyInto(out *GenevaLoggingSpec) {
 	*out = *in
@@ -141,3 +344,186 @@ func (in *InternetCheckerSpec) DeepCopy() *InternetCheckerSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineCheckFailure) DeepCopyInto(out *MachineCheckFailure) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineCheckFailure.
+func (in *MachineCheckFailure) DeepCopy() *MachineCheckFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineCheckFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineCheckStatus) DeepCopyInto(out *MachineCheckStatus) {
+	*out = *in
+	if in.Failures != nil {
+		in, out := &in.Failures, &out.Failures
+		*out = make([]MachineCheckFailure, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineCheckStatus.
+func (in *MachineCheckStatus) DeepCopy() *MachineCheckStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineCheckStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineConfigPoolState) DeepCopyInto(out *MachineConfigPoolState) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineConfigPoolState.
+func (in *MachineConfigPoolState) DeepCopy() *MachineConfigPoolState {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineConfigPoolState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineConfigStatus) DeepCopyInto(out *MachineConfigStatus) {
+	*out = *in
+	if in.Pools != nil {
+		in, out := &in.Pools, &out.Pools
+		*out = make([]MachineConfigPoolState, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineConfigStatus.
+func (in *MachineConfigStatus) DeepCopy() *MachineConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkCheckerSpec) DeepCopyInto(out *NetworkCheckerSpec) {
+	*out = *in
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]SubnetSpec, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkCheckerSpec.
+func (in *NetworkCheckerSpec) DeepCopy() *NetworkCheckerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkCheckerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeTaintSpec) DeepCopyInto(out *NodeTaintSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeTaintSpec.
+func (in *NodeTaintSpec) DeepCopy() *NodeTaintSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeTaintSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetSpec) DeepCopyInto(out *SubnetSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetSpec.
+func (in *SubnetSpec) DeepCopy() *SubnetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SupportabilityStatus) DeepCopyInto(out *SupportabilityStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	if in.UnsupportedConfigurations != nil {
+		in, out := &in.UnsupportedConfigurations, &out.UnsupportedConfigurations
+		*out = make([]UnsupportedConfiguration, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupportabilityStatus.
+func (in *SupportabilityStatus) DeepCopy() *SupportabilityStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SupportabilityStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnsupportedConfiguration) DeepCopyInto(out *UnsupportedConfiguration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnsupportedConfiguration.
+func (in *UnsupportedConfiguration) DeepCopy() *UnsupportedConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(UnsupportedConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerPoolSpec) DeepCopyInto(out *WorkerPoolSpec) {
+	*out = *in
+	if in.NodeLabels != nil {
+		in, out := &in.NodeLabels, &out.NodeLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeTaints != nil {
+		in, out := &in.NodeTaints, &out.NodeTaints
+		*out = make([]NodeTaintSpec, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerPoolSpec.
+func (in *WorkerPoolSpec) DeepCopy() *WorkerPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
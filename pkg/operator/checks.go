@@ -0,0 +1,75 @@
+package operator
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+const (
+	// CheckRequestAnnotation is set on the Cluster resource by the RP to ask
+	// the master checker controller to run checks immediately.  Its value is a
+	// JSON CheckRequest.
+	CheckRequestAnnotation = "aro.openshift.io/check-request"
+
+	// CheckResultAnnotation is set on the Cluster resource by the master
+	// checker controller once it has run the checks of a CheckRequest.  Its
+	// value is a JSON CheckResult.
+	CheckResultAnnotation = "aro.openshift.io/check-result"
+)
+
+// CheckRequest asks for checks to be run now, rather than waiting for the
+// next periodic run
+type CheckRequest struct {
+	// ID identifies the request; the result of the request carries the same
+	// ID
+	ID string `json:"id"`
+
+	// Checks are the names of the checks to run, e.g. "EtcdChecker".  If
+	// empty, all checks are run.
+	Checks []string `json:"checks,omitempty"`
+}
+
+// CheckResult is the outcome of a CheckRequest
+type CheckResult struct {
+	ID string `json:"id"`
+
+	StartTime      metav1.Time `json:"startTime"`
+	CompletionTime metav1.Time `json:"completionTime"`
+
+	Checks []CheckOutcome `json:"checks"`
+
+	// Conditions are the conditions of the Cluster resource once the checks
+	// have run
+	Conditions status.Conditions `json:"conditions,omitempty"`
+}
+
+// CheckOutcome is the outcome of a single check.  Error is set if the check
+// could not be run; a check which ran reports its findings in a condition.
+type CheckOutcome struct {
+	Name  string `json:"name"`
+	Error string `json:"error,omitempty"`
+}
+
+// ClusterHealth is what the operator last recorded on the Cluster resource
+// about the health of the cluster.  Unlike a CheckResult, it is read without
+// running any checks.
+type ClusterHealth struct {
+	OperatorVersion string `json:"operatorVersion,omitempty"`
+
+	// LastHeartbeatTime is when the checkers last ran, so that stale
+	// conditions can be told apart
+	LastHeartbeatTime metav1.Time `json:"lastHeartbeatTime,omitempty"`
+
+	Conditions     status.Conditions                 `json:"conditions,omitempty"`
+	MachineChecks  []arov1alpha1.MachineCheckStatus  `json:"machineChecks,omitempty"`
+	Supportability *arov1alpha1.SupportabilityStatus `json:"supportability,omitempty"`
+
+	// CheckPolicies are the overrides of the schedule of the checkers, so
+	// that a condition left behind by a disabled checker can be recognised
+	CheckPolicies map[string]arov1alpha1.CheckPolicy `json:"checkPolicies,omitempty"`
+}
@@ -9,4 +9,8 @@ const (
 
 	Namespace  = "openshift-azure-operator"
 	SecretName = "cluster"
+
+	// WorkerProfileLabel is set on machinesets which the RP manages on behalf
+	// of an additional worker profile; its value is the worker profile name
+	WorkerProfileLabel = "aro.openshift.io/workerprofile"
 )
@@ -0,0 +1,280 @@
+package autoscaler
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"sort"
+
+	machinev1beta1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
+	maoclient "github.com/openshift/machine-api-operator/pkg/generated/clientset/versioned"
+	"github.com/sirupsen/logrus"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+	"github.com/Azure/ARO-RP/pkg/util/dynamichelper"
+	_ "github.com/Azure/ARO-RP/pkg/util/scheme"
+)
+
+const (
+	clusterAutoscalerGroupKind = "ClusterAutoscaler.autoscaling.openshift.io"
+	machineAutoscalerGroupKind = "MachineAutoscaler.autoscaling.openshift.io"
+
+	// autoscalerLabel marks the autoscaler resources which are owned by this
+	// controller, so that stale ones can be found and removed
+	autoscalerLabel = "aro.openshift.io/autoscaler"
+
+	// clusterAutoscalerName is the only name which the cluster autoscaler
+	// operator acts on
+	clusterAutoscalerName = "default"
+
+	machineSetsNamespace = "openshift-machine-api"
+)
+
+// AutoscalerReconciler renders the ClusterAutoscaler and MachineAutoscalers
+// corresponding to the autoscaler configuration set on the cluster via the RP
+type AutoscalerReconciler struct {
+	maocli     maoclient.Interface
+	arocli     aroclient.AroV1alpha1Interface
+	restConfig *rest.Config
+	log        *logrus.Entry
+}
+
+func NewReconciler(log *logrus.Entry, maocli maoclient.Interface, arocli aroclient.AroV1alpha1Interface, restConfig *rest.Config) *AutoscalerReconciler {
+	return &AutoscalerReconciler{
+		maocli:     maocli,
+		arocli:     arocli,
+		restConfig: restConfig,
+		log:        log,
+	}
+}
+
+// Reconcile makes sure that the autoscaler resources on the cluster match the
+// cluster spec.  Requests for MachineSets are reconciled as requests for the
+// Cluster, as the MachineAutoscalers follow the machinesets of each pool.
+func (r *AutoscalerReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
+	// TODO(mj): controller-runtime master fixes the need for this (https://github.com/kubernetes-sigs/controller-runtime/blob/master/pkg/reconcile/reconcile.go#L93) but it's not yet released.
+	ctx := context.Background()
+
+	instance, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	machinesets, err := r.maocli.MachineV1beta1().MachineSets(machineSetsNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	dh, err := dynamichelper.New(r.log, r.restConfig)
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	err = r.reconcileAutoscaler(ctx, dh, instance, machinesets.Items)
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *AutoscalerReconciler) reconcileAutoscaler(ctx context.Context, dh dynamichelper.Interface, instance *arov1alpha1.Cluster, machinesets []machinev1beta1.MachineSet) error {
+	wanted := map[string]map[string]struct{}{
+		clusterAutoscalerGroupKind: {},
+		machineAutoscalerGroupKind: {},
+	}
+
+	var resources []runtime.Object
+	if instance.Spec.Autoscaler != nil {
+		resources = append(resources, clusterAutoscaler(instance.Spec.Autoscaler))
+		wanted[clusterAutoscalerGroupKind][clusterAutoscalerName] = struct{}{}
+
+		for _, pool := range instance.Spec.Autoscaler.Pools {
+			for _, un := range machineAutoscalers(&pool, poolMachineSets(machinesets, pool.Name)) {
+				resources = append(resources, un)
+				wanted[machineAutoscalerGroupKind][un.GetName()] = struct{}{}
+			}
+		}
+	}
+
+	err := dynamichelper.SetControllerReferences(resources, instance)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources {
+		err = dh.Ensure(ctx, resource.(*unstructured.Unstructured))
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, gk := range []string{machineAutoscalerGroupKind, clusterAutoscalerGroupKind} {
+		namespace := ""
+		if gk == machineAutoscalerGroupKind {
+			namespace = machineSetsNamespace
+		}
+
+		existing, err := dh.List(ctx, gk, namespace)
+		if err != nil {
+			return err
+		}
+
+		for _, un := range existing.Items {
+			if _, ok := un.GetLabels()[autoscalerLabel]; !ok {
+				continue
+			}
+
+			if _, found := wanted[gk][un.GetName()]; found {
+				continue
+			}
+
+			r.log.Printf("deleting %s %s", un.GetKind(), un.GetName())
+			err = dh.Delete(ctx, gk, namespace, un.GetName())
+			if err != nil && !kerrors.IsNotFound(err) {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// poolMachineSets returns the machinesets of the worker profile called name,
+// sorted by name: the installer's worker machinesets for the "worker" profile,
+// and the machinesets labelled by the RP for an additional worker profile
+func poolMachineSets(machinesets []machinev1beta1.MachineSet, name string) []machinev1beta1.MachineSet {
+	var results []machinev1beta1.MachineSet
+
+	for _, machineset := range machinesets {
+		profile, ok := machineset.Labels[operator.WorkerProfileLabel]
+		if !ok {
+			if machineset.Spec.Template.Labels["machine.openshift.io/cluster-api-machine-role"] != operator.RoleWorker {
+				continue
+			}
+			profile = "worker"
+		}
+
+		if profile == name {
+			results = append(results, machineset)
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Name < results[j].Name })
+
+	return results
+}
+
+// clusterAutoscaler returns the ClusterAutoscaler corresponding to spec.  The
+// autoscaling.openshift.io types are not vendored, so it is built as an
+// unstructured object.
+func clusterAutoscaler(spec *arov1alpha1.AutoscalerSpec) *unstructured.Unstructured {
+	scaleDown := map[string]interface{}{
+		"enabled": true,
+	}
+	if spec.ScaleDownDelayAfterAdd != "" {
+		scaleDown["delayAfterAdd"] = spec.ScaleDownDelayAfterAdd
+	}
+	if spec.ScaleDownUnneededTime != "" {
+		scaleDown["unneededTime"] = spec.ScaleDownUnneededTime
+	}
+
+	s := map[string]interface{}{
+		"scaleDown": scaleDown,
+	}
+	if spec.MaxNodesTotal > 0 {
+		s["resourceLimits"] = map[string]interface{}{
+			"maxNodesTotal": int64(spec.MaxNodesTotal),
+		}
+	}
+
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "autoscaling.openshift.io/v1",
+			"kind":       "ClusterAutoscaler",
+			"metadata": map[string]interface{}{
+				"name": clusterAutoscalerName,
+				"labels": map[string]interface{}{
+					autoscalerLabel: "true",
+				},
+			},
+			"spec": s,
+		},
+	}
+}
+
+// machineAutoscalers returns a MachineAutoscaler for each of machinesets,
+// spreading the bounds of pool across them as the RP spreads the replicas of
+// a worker profile.  A machineset whose share of the maximum is zero is left
+// alone, as the cluster autoscaler does not accept a maximum of zero.
+func machineAutoscalers(pool *arov1alpha1.AutoscalerPoolSpec, machinesets []machinev1beta1.MachineSet) []*unstructured.Unstructured {
+	var results []*unstructured.Unstructured
+
+	for i, machineset := range machinesets {
+		maxReplicas := replicas(pool.MaxReplicas, len(machinesets), i)
+		if maxReplicas == 0 {
+			continue
+		}
+
+		results = append(results, &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "autoscaling.openshift.io/v1beta1",
+				"kind":       "MachineAutoscaler",
+				"metadata": map[string]interface{}{
+					"name":      machineset.Name,
+					"namespace": machineSetsNamespace,
+					"labels": map[string]interface{}{
+						autoscalerLabel: "true",
+					},
+				},
+				"spec": map[string]interface{}{
+					"minReplicas": replicas(pool.MinReplicas, len(machinesets), i),
+					"maxReplicas": maxReplicas,
+					"scaleTargetRef": map[string]interface{}{
+						"apiVersion": "machine.openshift.io/v1beta1",
+						"kind":       "MachineSet",
+						"name":       machineset.Name,
+					},
+				},
+			},
+		})
+	}
+
+	return results
+}
+
+// replicas spreads count as evenly as possible across n machinesets and
+// returns the share of the i'th machineset
+func replicas(count, n, i int) int64 {
+	r := count / n
+	if i < count%n {
+		r++
+	}
+	return int64(r)
+}
+
+// SetupWithManager setup our mananger
+func (r *AutoscalerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}).
+		Watches(&source.Kind{Type: &machinev1beta1.MachineSet{}}, &handler.EnqueueRequestForObject{}).
+		Named(controllers.AutoscalerControllerName).
+		Complete(r)
+}
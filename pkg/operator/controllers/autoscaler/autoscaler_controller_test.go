@@ -0,0 +1,196 @@
+package autoscaler
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	machinev1beta1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+	mock_dynamichelper "github.com/Azure/ARO-RP/pkg/util/mocks/dynamichelper"
+)
+
+func TestReconcileAutoscaler(t *testing.T) {
+	ctx := context.Background()
+
+	machineset := func(name, role, profile string) machinev1beta1.MachineSet {
+		ms := machinev1beta1.MachineSet{}
+		ms.Name = name
+		ms.Spec.Template.Labels = map[string]string{
+			"machine.openshift.io/cluster-api-machine-role": role,
+		}
+		if profile != "" {
+			ms.Labels = map[string]string{
+				operator.WorkerProfileLabel: profile,
+			}
+		}
+		return ms
+	}
+
+	machinesets := []machinev1beta1.MachineSet{
+		machineset("cluster-master", operator.RoleMaster, ""),
+		machineset("cluster-worker-eastus2", operator.RoleWorker, ""),
+		machineset("cluster-worker-eastus1", operator.RoleWorker, ""),
+		machineset("cluster-worker-eastus3", operator.RoleWorker, ""),
+		machineset("cluster-gpu-eastus1", operator.RoleWorker, "gpu"),
+		machineset("cluster-gpu-eastus2", operator.RoleWorker, "gpu"),
+	}
+
+	existing := func(name string, managed bool) unstructured.Unstructured {
+		un := unstructured.Unstructured{}
+		un.SetName(name)
+		if managed {
+			un.SetLabels(map[string]string{autoscalerLabel: "true"})
+		}
+		return un
+	}
+
+	for _, tt := range []struct {
+		name                   string
+		autoscaler             *arov1alpha1.AutoscalerSpec
+		wantClusterAutoscaler  map[string]interface{}
+		wantMachineAutoscalers map[string][2]int64
+		wantDeleted            []string
+	}{
+		{
+			name: "autoscaler configured",
+			autoscaler: &arov1alpha1.AutoscalerSpec{
+				MaxNodesTotal:          20,
+				ScaleDownDelayAfterAdd: "10m",
+				Pools: []arov1alpha1.AutoscalerPoolSpec{
+					{
+						Name:        "worker",
+						MinReplicas: 4,
+						MaxReplicas: 8,
+					},
+					{
+						Name:        "gpu",
+						MinReplicas: 0,
+						MaxReplicas: 1,
+					},
+				},
+			},
+			wantClusterAutoscaler: map[string]interface{}{
+				"scaleDown": map[string]interface{}{
+					"enabled":       true,
+					"delayAfterAdd": "10m",
+				},
+				"resourceLimits": map[string]interface{}{
+					"maxNodesTotal": int64(20),
+				},
+			},
+			wantMachineAutoscalers: map[string][2]int64{
+				"cluster-worker-eastus1": {2, 3},
+				"cluster-worker-eastus2": {1, 3},
+				"cluster-worker-eastus3": {1, 2},
+				"cluster-gpu-eastus1":    {0, 1},
+			},
+			wantDeleted: []string{
+				"MachineAutoscaler.autoscaling.openshift.io/cluster-stale",
+			},
+		},
+		{
+			name: "autoscaler removed",
+			wantDeleted: []string{
+				"MachineAutoscaler.autoscaling.openshift.io/cluster-worker-eastus1",
+				"MachineAutoscaler.autoscaling.openshift.io/cluster-stale",
+				"ClusterAutoscaler.autoscaling.openshift.io/default",
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			instance := &arov1alpha1.Cluster{
+				Spec: arov1alpha1.ClusterSpec{
+					Autoscaler: tt.autoscaler,
+				},
+			}
+			instance.Name = arov1alpha1.SingletonClusterName
+			instance.UID = "uid"
+
+			dh := mock_dynamichelper.NewMockInterface(controller)
+
+			var gotClusterAutoscaler map[string]interface{}
+			gotMachineAutoscalers := map[string][2]int64{}
+
+			dh.EXPECT().Ensure(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, objs ...*unstructured.Unstructured) error {
+				for _, o := range objs {
+					if len(o.GetOwnerReferences()) != 1 || o.GetOwnerReferences()[0].Name != arov1alpha1.SingletonClusterName {
+						t.Error(o.GetOwnerReferences())
+					}
+					if o.GetLabels()[autoscalerLabel] != "true" {
+						t.Error(o.GetLabels())
+					}
+
+					switch o.GetKind() {
+					case "ClusterAutoscaler":
+						gotClusterAutoscaler = o.Object["spec"].(map[string]interface{})
+					case "MachineAutoscaler":
+						spec := o.Object["spec"].(map[string]interface{})
+						if spec["scaleTargetRef"].(map[string]interface{})["name"] != o.GetName() {
+							t.Error(spec["scaleTargetRef"])
+						}
+						gotMachineAutoscalers[o.GetName()] = [2]int64{spec["minReplicas"].(int64), spec["maxReplicas"].(int64)}
+					default:
+						t.Error(o.GetKind())
+					}
+				}
+				return nil
+			}).AnyTimes()
+
+			dh.EXPECT().List(gomock.Any(), machineAutoscalerGroupKind, machineSetsNamespace).Return(&unstructured.UnstructuredList{
+				Items: []unstructured.Unstructured{
+					existing("cluster-worker-eastus1", true),
+					existing("cluster-stale", true),
+					existing("customer", false),
+				},
+			}, nil)
+
+			dh.EXPECT().List(gomock.Any(), clusterAutoscalerGroupKind, "").Return(&unstructured.UnstructuredList{
+				Items: []unstructured.Unstructured{
+					existing(clusterAutoscalerName, true),
+				},
+			}, nil)
+
+			var gotDeleted []string
+			dh.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, groupKind, namespace, name string) error {
+				gotDeleted = append(gotDeleted, groupKind+"/"+name)
+				return nil
+			}).AnyTimes()
+
+			r := &AutoscalerReconciler{
+				log: utillog.GetLogger(),
+			}
+
+			err := r.reconcileAutoscaler(ctx, dh, instance, machinesets)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(gotClusterAutoscaler, tt.wantClusterAutoscaler) {
+				t.Error(gotClusterAutoscaler)
+			}
+
+			if tt.wantMachineAutoscalers == nil {
+				tt.wantMachineAutoscalers = map[string][2]int64{}
+			}
+			if !reflect.DeepEqual(gotMachineAutoscalers, tt.wantMachineAutoscalers) {
+				t.Error(gotMachineAutoscalers)
+			}
+
+			if !reflect.DeepEqual(gotDeleted, tt.wantDeleted) {
+				t.Error(gotDeleted)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+	"github.com/Azure/ARO-RP/pkg/util/pullsecret"
+)
+
+// ACRTokenChecker asks the ACR for a token with the credentials which the RP
+// issued for the cluster, so that an expired or revoked token is reported
+// before nodes fail to pull release images with it
+type ACRTokenChecker struct {
+	kubernetescli kubernetes.Interface
+	arocli        aroclient.AroV1alpha1Interface
+	recorder      record.EventRecorder
+	log           *logrus.Entry
+	role          string
+
+	client  simpleHTTPClient
+	backoff wait.Backoff
+}
+
+func NewACRTokenChecker(log *logrus.Entry, kubernetescli kubernetes.Interface, arocli aroclient.AroV1alpha1Interface, recorder record.EventRecorder, role string) *ACRTokenChecker {
+	return &ACRTokenChecker{
+		kubernetescli: kubernetescli,
+		arocli:        arocli,
+		recorder:      recorder,
+		log:           log,
+		role:          role,
+
+		client:  &http.Client{},
+		backoff: checkBackoff,
+	}
+}
+
+func (r *ACRTokenChecker) Name() string {
+	return "ACRTokenChecker"
+}
+
+// Check sets the ACRTokenValid condition to False if the ACR rejects the
+// credentials of the ACR in the operator secret.  A failure to reach the ACR
+// is left to the InternetChecker to report.
+func (r *ACRTokenChecker) Check(ctx context.Context) error {
+	instance, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	if instance.Spec.ACRDomain == "" {
+		return nil
+	}
+
+	mysec, err := r.kubernetescli.CoreV1().Secrets(operator.Namespace).Get(ctx, operator.SecretName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	// clusters created in development mode may have no ACR token
+	auth, found, err := pullsecret.Auth(string(mysec.Data[corev1.DockerConfigJsonKey]), instance.Spec.ACRDomain)
+	if err != nil || !found {
+		return err
+	}
+
+	statusCode, err := r.requestToken(instance.Spec.ACRDomain, auth)
+	if err != nil {
+		return err
+	}
+
+	cond := &status.Condition{
+		Type:    arov1alpha1.ACRTokenValid,
+		Status:  corev1.ConditionTrue,
+		Message: "ACR token is valid",
+		Reason:  "CheckDone",
+	}
+
+	switch statusCode {
+	case http.StatusOK:
+	case http.StatusUnauthorized:
+		cond.Status = corev1.ConditionFalse
+		cond.Reason = "CheckFailed"
+		cond.Message = fmt.Sprintf("%s rejected the ACR token", instance.Spec.ACRDomain)
+		r.log.Warn(cond.Message)
+	default:
+		return fmt.Errorf("unexpected status code %d from %s", statusCode, instance.Spec.ACRDomain)
+	}
+
+	return controllers.SetCondition(ctx, r.arocli, r.recorder, cond, r.role)
+}
+
+// requestToken requests a token from the ACR with the given basic auth
+// credentials, the same way that a docker login does, and returns the status
+// code of the response
+func (r *ACRTokenChecker) requestToken(registry, auth string) (statusCode int, err error) {
+	err = retry.OnError(r.backoff, func(_ error) bool { return true }, func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://"+registry+"/oauth2/token?service="+url.QueryEscape(registry), nil)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Basic "+auth)
+
+		resp, err := r.client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		statusCode = resp.StatusCode
+		return nil
+	})
+
+	return statusCode, err
+}
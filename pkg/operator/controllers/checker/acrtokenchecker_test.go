@@ -0,0 +1,169 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+)
+
+type recordingClient struct {
+	testClient
+	requests []*http.Request
+}
+
+func (c *recordingClient) Do(req *http.Request) (*http.Response, error) {
+	c.requests = append(c.requests, req)
+	return c.testClient.Do(req)
+}
+
+func TestACRTokenCheckerCheck(t *testing.T) {
+	ctx := context.Background()
+
+	response := func(statusCode int) *fakeResponse {
+		return &fakeResponse{
+			httpResponse: &http.Response{
+				StatusCode: statusCode,
+				Body:       ioutil.NopCloser(&bytes.Buffer{}),
+			},
+		}
+	}
+
+	for _, tt := range []struct {
+		name        string
+		acrDomain   string
+		pullSecret  string
+		responses   []*fakeResponse
+		wantStatus  corev1.ConditionStatus
+		wantMessage string
+		wantErr     bool
+	}{
+		{
+			name:        "token is valid",
+			acrDomain:   "arosvc.azurecr.io",
+			pullSecret:  `{"auths":{"arosvc.azurecr.io":{"auth":"ZnJlZDplbnRlcg=="}}}`,
+			responses:   []*fakeResponse{response(http.StatusOK)},
+			wantStatus:  corev1.ConditionTrue,
+			wantMessage: "ACR token is valid",
+		},
+		{
+			name:        "token is rejected",
+			acrDomain:   "arosvc.azurecr.io",
+			pullSecret:  `{"auths":{"arosvc.azurecr.io":{"auth":"ZnJlZDplbnRlcg=="}}}`,
+			responses:   []*fakeResponse{response(http.StatusUnauthorized)},
+			wantStatus:  corev1.ConditionFalse,
+			wantMessage: "arosvc.azurecr.io rejected the ACR token",
+		},
+		{
+			name:       "unexpected status code",
+			acrDomain:  "arosvc.azurecr.io",
+			pullSecret: `{"auths":{"arosvc.azurecr.io":{"auth":"ZnJlZDplbnRlcg=="}}}`,
+			responses:  []*fakeResponse{response(http.StatusInternalServerError)},
+			wantErr:    true,
+		},
+		{
+			name:       "acr unreachable",
+			acrDomain:  "arosvc.azurecr.io",
+			pullSecret: `{"auths":{"arosvc.azurecr.io":{"auth":"ZnJlZDplbnRlcg=="}}}`,
+			responses:  []*fakeResponse{networkUnreach, networkUnreach},
+			wantErr:    true,
+		},
+		{
+			name:       "no token",
+			acrDomain:  "arosvc.azurecr.io",
+			pullSecret: `{"auths":{"registry.redhat.io":{"auth":"eA=="}}}`,
+		},
+		{
+			name:       "no acr",
+			pullSecret: `{"auths":{"arosvc.azurecr.io":{"auth":"ZnJlZDplbnRlcg=="}}}`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					ACRDomain: tt.acrDomain,
+				},
+			}
+
+			arocli := arofake.NewSimpleClientset(cluster)
+			kubernetescli := fake.NewSimpleClientset(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      operator.SecretName,
+					Namespace: operator.Namespace,
+				},
+				Data: map[string][]byte{
+					corev1.DockerConfigJsonKey: []byte(tt.pullSecret),
+				},
+			})
+
+			client := &recordingClient{testClient: testClient{responses: tt.responses}}
+
+			r := &ACRTokenChecker{
+				kubernetescli: kubernetescli,
+				arocli:        arocli.AroV1alpha1(),
+				log:           logrus.NewEntry(logrus.StandardLogger()),
+				role:          operator.RoleMaster,
+				client:        client,
+				backoff:       wait.Backoff{Steps: 2},
+			}
+
+			err := r.Check(ctx)
+			if err != nil && !tt.wantErr ||
+				err == nil && tt.wantErr {
+				t.Fatal(err)
+			}
+
+			if len(client.responses) != 0 {
+				t.Error(len(client.responses))
+			}
+
+			for _, req := range client.requests {
+				if req.URL.String() != "https://arosvc.azurecr.io/oauth2/token?service=arosvc.azurecr.io" {
+					t.Error(req.URL)
+				}
+				if req.Header.Get("Authorization") != "Basic ZnJlZDplbnRlcg==" {
+					t.Error(req.Header.Get("Authorization"))
+				}
+			}
+
+			cluster, err = arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cond := cluster.Status.Conditions.GetCondition(arov1alpha1.ACRTokenValid)
+			if tt.wantStatus == "" {
+				if cond != nil {
+					t.Error(cond)
+				}
+				return
+			}
+			if cond == nil {
+				t.Fatal("condition not set")
+			}
+			if cond.Status != tt.wantStatus {
+				t.Error(cond.Status)
+			}
+			if cond.Message != tt.wantMessage {
+				t.Error(cond.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	maoclient "github.com/openshift/machine-api-operator/pkg/generated/clientset/versioned"
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+	"github.com/Azure/ARO-RP/pkg/util/dynamichelper"
+)
+
+const (
+	clusterAutoscalerGroupKind = "ClusterAutoscaler.autoscaling.openshift.io"
+	machineAutoscalerGroupKind = "MachineAutoscaler.autoscaling.openshift.io"
+
+	machineSetLabel = "machine.openshift.io/cluster-api-machineset"
+)
+
+// AutoscalerChecker looks for cluster autoscaler configurations which can
+// never work, whether they were set via the RP or by the customer directly.
+// They otherwise show up as clusters which silently don't scale.
+type AutoscalerChecker struct {
+	maocli     maoclient.Interface
+	arocli     aroclient.AroV1alpha1Interface
+	restConfig *rest.Config
+	recorder   record.EventRecorder
+	log        *logrus.Entry
+	role       string
+}
+
+func NewAutoscalerChecker(log *logrus.Entry, maocli maoclient.Interface, arocli aroclient.AroV1alpha1Interface, restConfig *rest.Config, recorder record.EventRecorder, role string) *AutoscalerChecker {
+	return &AutoscalerChecker{
+		maocli:     maocli,
+		arocli:     arocli,
+		restConfig: restConfig,
+		recorder:   recorder,
+		log:        log,
+		role:       role,
+	}
+}
+
+func (r *AutoscalerChecker) Name() string {
+	return "AutoscalerChecker"
+}
+
+// +kubebuilder:rbac:groups=autoscaling.openshift.io,resources=clusterautoscalers;machineautoscalers,verbs=list
+
+// Check sets the AutoscalerConfigValid condition to False if a
+// MachineAutoscaler has inconsistent bounds or targets a missing machineset,
+// if the ClusterAutoscaler's node limit is below the sum of the minimums, or
+// if machines of an autoscaled machineset failed for lack of quota
+func (r *AutoscalerChecker) Check(ctx context.Context) error {
+	dh, err := dynamichelper.New(r.log, r.restConfig)
+	if err != nil {
+		return err
+	}
+
+	problems, err := r.problems(ctx, dh)
+	if err != nil {
+		return err
+	}
+
+	cond := &status.Condition{
+		Type:    arov1alpha1.AutoscalerConfigValid,
+		Status:  corev1.ConditionTrue,
+		Message: "autoscaler configuration is valid",
+		Reason:  "CheckDone",
+	}
+
+	if len(problems) > 0 {
+		for _, problem := range problems {
+			r.log.Warn(problem)
+		}
+
+		cond.Status = corev1.ConditionFalse
+		cond.Reason = "CheckFailed"
+		cond.Message = strings.Join(problems, "\n")
+	}
+
+	return controllers.SetCondition(ctx, r.arocli, r.recorder, cond, r.role)
+}
+
+func (r *AutoscalerChecker) problems(ctx context.Context, dh dynamichelper.Interface) ([]string, error) {
+	machineautoscalers, err := dh.List(ctx, machineAutoscalerGroupKind, machineSetsNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterautoscalers, err := dh.List(ctx, clusterAutoscalerGroupKind, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(machineautoscalers.Items) == 0 && len(clusterautoscalers.Items) == 0 {
+		return nil, nil
+	}
+
+	machinesets, err := r.maocli.MachineV1beta1().MachineSets(machineSetsNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	machines, err := r.maocli.MachineV1beta1().Machines(machineSetsNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	existing := map[string]struct{}{}
+	for _, machineset := range machinesets.Items {
+		existing[machineset.Name] = struct{}{}
+	}
+
+	var problems []string
+	targets := map[string]string{}
+	minReplicas := 0
+
+	for _, ma := range machineautoscalers.Items {
+		min, _, _ := unstructured.NestedInt64(ma.Object, "spec", "minReplicas")
+		max, _, _ := unstructured.NestedInt64(ma.Object, "spec", "maxReplicas")
+		kind, _, _ := unstructured.NestedString(ma.Object, "spec", "scaleTargetRef", "kind")
+		name, _, _ := unstructured.NestedString(ma.Object, "spec", "scaleTargetRef", "name")
+
+		if min > max {
+			problems = append(problems, fmt.Sprintf("machineautoscaler %s: minReplicas %d is greater than maxReplicas %d", ma.GetName(), min, max))
+		}
+		if max < 1 {
+			problems = append(problems, fmt.Sprintf("machineautoscaler %s: maxReplicas must be at least 1", ma.GetName()))
+		}
+
+		if kind != "MachineSet" {
+			problems = append(problems, fmt.Sprintf("machineautoscaler %s: scale target kind %q is not MachineSet", ma.GetName(), kind))
+			continue
+		}
+
+		if _, found := existing[name]; !found {
+			problems = append(problems, fmt.Sprintf("machineautoscaler %s: machineset %s does not exist", ma.GetName(), name))
+			continue
+		}
+
+		if other, found := targets[name]; found {
+			problems = append(problems, fmt.Sprintf("machineautoscaler %s: machineset %s is also targeted by machineautoscaler %s", ma.GetName(), name, other))
+			continue
+		}
+
+		targets[name] = ma.GetName()
+		minReplicas += int(min)
+	}
+
+	masters := 0
+	for _, machine := range machines.Items {
+		if machine.Labels["machine.openshift.io/cluster-api-machine-role"] == operator.RoleMaster {
+			masters++
+		}
+
+		ma, found := targets[machine.Labels[machineSetLabel]]
+		if !found || machine.Status.ErrorMessage == nil ||
+			!strings.Contains(strings.ToLower(*machine.Status.ErrorMessage), "quota") {
+			continue
+		}
+
+		problems = append(problems, fmt.Sprintf("machineautoscaler %s: machine %s failed for lack of quota: %s", ma, machine.Name, *machine.Status.ErrorMessage))
+	}
+
+	for _, ca := range clusterautoscalers.Items {
+		maxNodesTotal, found, _ := unstructured.NestedInt64(ca.Object, "spec", "resourceLimits", "maxNodesTotal")
+		if !found || maxNodesTotal == 0 {
+			continue
+		}
+
+		if int(maxNodesTotal) < masters+minReplicas {
+			problems = append(problems, fmt.Sprintf("clusterautoscaler %s: maxNodesTotal %d is less than the %d masters plus the sum of the machineautoscaler minReplicas, %d", ca.GetName(), maxNodesTotal, masters, minReplicas))
+		}
+	}
+
+	sort.Strings(problems)
+
+	return problems, nil
+}
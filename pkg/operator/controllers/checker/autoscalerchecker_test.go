@@ -0,0 +1,162 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	machinev1beta1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
+	maofake "github.com/openshift/machine-api-operator/pkg/generated/clientset/versioned/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	mock_dynamichelper "github.com/Azure/ARO-RP/pkg/util/mocks/dynamichelper"
+)
+
+func TestAutoscalerCheckerProblems(t *testing.T) {
+	ctx := context.Background()
+
+	machineAutoscaler := func(name string, min, max int64, kind, target string) unstructured.Unstructured {
+		return unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name": name,
+				},
+				"spec": map[string]interface{}{
+					"minReplicas": min,
+					"maxReplicas": max,
+					"scaleTargetRef": map[string]interface{}{
+						"kind": kind,
+						"name": target,
+					},
+				},
+			},
+		}
+	}
+
+	clusterAutoscaler := func(maxNodesTotal int64) unstructured.Unstructured {
+		return unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name": "default",
+				},
+				"spec": map[string]interface{}{
+					"resourceLimits": map[string]interface{}{
+						"maxNodesTotal": maxNodesTotal,
+					},
+				},
+			},
+		}
+	}
+
+	machineset := func(name string) *machinev1beta1.MachineSet {
+		return &machinev1beta1.MachineSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: machineSetsNamespace,
+			},
+		}
+	}
+
+	machine := func(name, role, machineset, errorMessage string) *machinev1beta1.Machine {
+		m := &machinev1beta1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: machineSetsNamespace,
+				Labels: map[string]string{
+					"machine.openshift.io/cluster-api-machine-role": role,
+				},
+			},
+		}
+		if machineset != "" {
+			m.Labels[machineSetLabel] = machineset
+		}
+		if errorMessage != "" {
+			m.Status.ErrorMessage = &errorMessage
+		}
+		return m
+	}
+
+	objects := []runtime.Object{
+		machineset("cluster-worker-eastus1"),
+		machineset("cluster-worker-eastus2"),
+		machine("cluster-master-0", operator.RoleMaster, "", ""),
+		machine("cluster-master-1", operator.RoleMaster, "", ""),
+		machine("cluster-master-2", operator.RoleMaster, "", ""),
+		machine("cluster-worker-eastus1-abcde", operator.RoleWorker, "cluster-worker-eastus1", ""),
+	}
+
+	for _, tt := range []struct {
+		name               string
+		machineAutoscalers []unstructured.Unstructured
+		clusterAutoscalers []unstructured.Unstructured
+		objects            []runtime.Object
+		wantProblems       []string
+	}{
+		{
+			name: "no autoscaler",
+		},
+		{
+			name: "valid",
+			machineAutoscalers: []unstructured.Unstructured{
+				machineAutoscaler("worker-eastus1", 1, 3, "MachineSet", "cluster-worker-eastus1"),
+				machineAutoscaler("worker-eastus2", 1, 3, "MachineSet", "cluster-worker-eastus2"),
+			},
+			clusterAutoscalers: []unstructured.Unstructured{
+				clusterAutoscaler(5),
+			},
+		},
+		{
+			name: "invalid",
+			machineAutoscalers: []unstructured.Unstructured{
+				machineAutoscaler("worker-eastus1", 4, 3, "MachineSet", "cluster-worker-eastus1"),
+				machineAutoscaler("worker-eastus1-again", 0, 3, "MachineSet", "cluster-worker-eastus1"),
+				machineAutoscaler("worker-eastus2", 0, 0, "MachineDeployment", "cluster-worker-eastus2"),
+				machineAutoscaler("worker-eastus3", 1, 3, "MachineSet", "cluster-worker-eastus3"),
+			},
+			clusterAutoscalers: []unstructured.Unstructured{
+				clusterAutoscaler(6),
+			},
+			objects: []runtime.Object{
+				machine("cluster-worker-eastus1-fghij", operator.RoleWorker, "cluster-worker-eastus1", "failed to create VM: Operation could not be completed as it results in exceeding approved standardDSv3Family Cores quota"),
+			},
+			wantProblems: []string{
+				"clusterautoscaler default: maxNodesTotal 6 is less than the 3 masters plus the sum of the machineautoscaler minReplicas, 4",
+				"machineautoscaler worker-eastus1-again: machineset cluster-worker-eastus1 is also targeted by machineautoscaler worker-eastus1",
+				"machineautoscaler worker-eastus1: machine cluster-worker-eastus1-fghij failed for lack of quota: failed to create VM: Operation could not be completed as it results in exceeding approved standardDSv3Family Cores quota",
+				"machineautoscaler worker-eastus1: minReplicas 4 is greater than maxReplicas 3",
+				"machineautoscaler worker-eastus2: maxReplicas must be at least 1",
+				"machineautoscaler worker-eastus2: scale target kind \"MachineDeployment\" is not MachineSet",
+				"machineautoscaler worker-eastus3: machineset cluster-worker-eastus3 does not exist",
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			dh := mock_dynamichelper.NewMockInterface(controller)
+			dh.EXPECT().List(gomock.Any(), machineAutoscalerGroupKind, machineSetsNamespace).Return(&unstructured.UnstructuredList{Items: tt.machineAutoscalers}, nil)
+			dh.EXPECT().List(gomock.Any(), clusterAutoscalerGroupKind, "").Return(&unstructured.UnstructuredList{Items: tt.clusterAutoscalers}, nil)
+
+			r := &AutoscalerChecker{
+				maocli: maofake.NewSimpleClientset(append(objects, tt.objects...)...),
+			}
+
+			problems, err := r.problems(ctx, dh)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(problems, tt.wantProblems) {
+				t.Errorf("%#v", problems)
+			}
+		})
+	}
+}
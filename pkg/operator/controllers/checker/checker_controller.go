@@ -5,11 +5,19 @@ package checker
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"time"
 
+	configclient "github.com/openshift/client-go/config/clientset/versioned"
 	machinev1beta1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
 	maoclient "github.com/openshift/machine-api-operator/pkg/generated/clientset/versioned"
 	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -22,24 +30,62 @@ import (
 	"github.com/Azure/ARO-RP/pkg/util/deployment"
 )
 
-// CheckerController runs a number of checkers
+const (
+	// defaultCheckInterval is how often a checker runs unless its policy in
+	// the cluster spec says otherwise
+	defaultCheckInterval = time.Hour
+
+	// minCheckInterval stops a policy from running a checker in a hot loop
+	minCheckInterval = time.Minute
+)
+
+// CheckerController runs a number of checkers, each on its own schedule
 type CheckerController struct {
 	log      *logrus.Entry
+	arocli   aroclient.AroV1alpha1Interface
 	role     string
 	checkers []Checker
+
+	// lastRun is when each checker last ran and lastHeartbeat when the
+	// heartbeat was last recorded.  The controller runs a single worker, so
+	// Reconcile never runs concurrently.
+	lastRun       map[string]time.Time
+	lastHeartbeat time.Time
 }
 
-func NewReconciler(log *logrus.Entry, maocli maoclient.Interface, arocli aroclient.AroV1alpha1Interface, role string, deploymentMode deployment.Mode) *CheckerController {
-	checkers := []Checker{NewInternetChecker(log, arocli, role)}
+func NewReconciler(log *logrus.Entry, kubernetescli kubernetes.Interface, configcli configclient.Interface, maocli maoclient.Interface, arocli aroclient.AroV1alpha1Interface, restConfig *rest.Config, recorder record.EventRecorder, role string, capabilities deployment.Capabilities) *CheckerController {
+	checkers := []Checker{
+		NewInternetChecker(log, arocli, recorder, role),
+		NewIMDSChecker(log, arocli, recorder, role),
+	}
 
 	if role == operator.RoleMaster {
-		checkers = append(checkers, NewMachineChecker(log, maocli, arocli, role, deploymentMode))
+		checkers = append(checkers,
+			NewMachineChecker(log, maocli, arocli, recorder, role, capabilities),
+			NewThrottlingChecker(log, kubernetescli, arocli, recorder, role),
+			NewGenevaLoggingChecker(log, kubernetescli, arocli, recorder, role),
+			NewNodeProblemChecker(log, kubernetescli, arocli, recorder, role),
+			NewServicePrincipalChecker(log, kubernetescli, arocli, recorder, role),
+			NewEtcdChecker(log, kubernetescli, arocli, recorder, role),
+			NewACRTokenChecker(log, kubernetescli, arocli, recorder, role),
+			NewAutoscalerChecker(log, maocli, arocli, restConfig, recorder, role),
+			NewDeniedWritesChecker(log, kubernetescli, arocli, recorder, role),
+			NewClockSkewChecker(log, kubernetescli, arocli, recorder, role),
+			NewNodeCertificateChecker(log, kubernetescli, arocli, recorder, role),
+			NewDaemonSetChecker(log, kubernetescli, arocli, recorder, role),
+			NewImagePolicyChecker(log, configcli, arocli, recorder, role),
+			NewVnetDNSChecker(log, kubernetescli, arocli, recorder, role),
+			NewNetworkChecker(log, kubernetescli, arocli, recorder, role),
+			NewQuotaChecker(log, kubernetescli, maocli, arocli, recorder, role),
+		)
 	}
 
 	return &CheckerController{
 		log:      log,
+		arocli:   arocli,
 		role:     role,
 		checkers: checkers,
+		lastRun:  map[string]time.Time{},
 	}
 }
 
@@ -50,20 +96,223 @@ func NewReconciler(log *logrus.Entry, maocli maoclient.Interface, arocli aroclie
 // +kubebuilder:rbac:groups=aro.openshift.io,resources=clusters/status,verbs=get;update;patch
 
 // Reconcile will keep checking that the cluster can connect to essential services.
+// Each checker runs when its interval has passed, unless its policy in the
+// cluster spec switches it off; as the policy is read on every reconcile,
+// changes to it take effect straight away.  A machine event makes the
+// MachineChecker due.  On the master, a pending check request from the RP is
+// run instead.
 func (r *CheckerController) Reconcile(request ctrl.Request) (ctrl.Result, error) {
 	// TODO(mj): controller-runtime master fixes the need for this (https://github.com/kubernetes-sigs/controller-runtime/blob/master/pkg/reconcile/reconcile.go#L93) but it's not yet released.
 	ctx := context.Background()
-	var err error
+
+	if r.role == operator.RoleMaster {
+		req, err := r.pendingCheckRequest(ctx)
+		if err != nil {
+			r.log.Error(err)
+		}
+
+		if req != nil {
+			return reconcile.Result{RequeueAfter: minCheckInterval, Requeue: true}, r.runCheckRequest(ctx, req)
+		}
+	}
+
+	instance, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	now := time.Now()
+	requeueAfter := defaultCheckInterval
+	var ran bool
+
 	for _, c := range r.checkers {
+		enabled, interval := checkPolicy(instance, c.Name())
+		if !enabled {
+			continue
+		}
+
+		if next := r.lastRun[c.Name()].Add(interval); now.Before(next) &&
+			!(request.Namespace != "" && c.Name() == "MachineChecker") {
+			if next.Sub(now) < requeueAfter {
+				requeueAfter = next.Sub(now)
+			}
+			continue
+		}
+
 		thisErr := c.Check(ctx)
 		if thisErr != nil {
 			// do all checks even if there is an error
 			err = thisErr
 			r.log.Errorf("checker %s failed with %v", c.Name(), err)
+			checkErrorsTotal.WithLabelValues(c.Name()).Inc()
+		}
+
+		r.lastRun[c.Name()] = now
+		ran = true
+		if interval < requeueAfter {
+			requeueAfter = interval
+		}
+	}
+
+	// the RP relies on the heartbeat to tell that the operator is up, so it
+	// is recorded at least hourly even if no checker is due
+	if r.role == operator.RoleMaster &&
+		(ran || !now.Before(r.lastHeartbeat.Add(defaultCheckInterval))) {
+		thisErr := r.heartbeat(ctx)
+		if thisErr != nil {
+			err = thisErr
+			r.log.Error(err)
+		} else {
+			r.lastHeartbeat = now
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: requeueAfter, Requeue: true}, err
+}
+
+// checkPolicy returns whether the named checker is enabled and how often it
+// runs, according to its policy in the cluster spec
+func checkPolicy(instance *arov1alpha1.Cluster, name string) (bool, time.Duration) {
+	policy := instance.Spec.Checks[name]
+
+	if policy.Enabled != nil && !*policy.Enabled {
+		return false, 0
+	}
+
+	if policy.Interval == nil {
+		return true, defaultCheckInterval
+	}
+
+	if policy.Interval.Duration < minCheckInterval {
+		return true, minCheckInterval
+	}
+
+	return true, policy.Interval.Duration
+}
+
+// heartbeat records on the cluster status that the checkers have run, so that
+// the RP can tell when the operator is down or wedged, and exports the
+// conditions which they set as metrics
+func (r *CheckerController) heartbeat(ctx context.Context) error {
+	var cluster *arov1alpha1.Cluster
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() (err error) {
+		cluster, err = r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		cluster.Status.LastHeartbeatTime = metav1.Now()
+
+		cluster, err = r.arocli.Clusters().UpdateStatus(ctx, cluster, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	recordCheckStatus(cluster.Status.Conditions)
+
+	return nil
+}
+
+// pendingCheckRequest returns the check request on the Cluster resource, if
+// its result has not been recorded yet
+func (r *CheckerController) pendingCheckRequest(ctx context.Context) (*operator.CheckRequest, error) {
+	cluster, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if cluster.Annotations[operator.CheckRequestAnnotation] == "" {
+		return nil, nil
+	}
+
+	var req *operator.CheckRequest
+	err = json.Unmarshal([]byte(cluster.Annotations[operator.CheckRequestAnnotation]), &req)
+	if err != nil {
+		return nil, fmt.Errorf("invalid check request: %v", err)
+	}
+
+	if cluster.Annotations[operator.CheckResultAnnotation] != "" {
+		var result *operator.CheckResult
+		err = json.Unmarshal([]byte(cluster.Annotations[operator.CheckResultAnnotation]), &result)
+		if err == nil && result.ID == req.ID {
+			return nil, nil
+		}
+	}
+
+	return req, nil
+}
+
+// runCheckRequest runs the requested checks and records their outcome, and
+// the conditions which they set, on the Cluster resource.  Checkers switched
+// off by their policy are run too, as they were asked for explicitly.
+func (r *CheckerController) runCheckRequest(ctx context.Context, req *operator.CheckRequest) error {
+	r.log.Infof("running check request %s", req.ID)
+
+	result := &operator.CheckResult{
+		ID:        req.ID,
+		StartTime: metav1.Now(),
+	}
+
+	checkers := map[string]Checker{}
+	for _, c := range r.checkers {
+		checkers[c.Name()] = c
+	}
+
+	names := req.Checks
+	if len(names) == 0 {
+		for _, c := range r.checkers {
+			names = append(names, c.Name())
+		}
+	}
+
+	for _, name := range names {
+		outcome := operator.CheckOutcome{
+			Name: name,
+		}
+
+		c, found := checkers[name]
+		if found {
+			err := c.Check(ctx)
+			if err != nil {
+				r.log.Errorf("checker %s failed with %v", name, err)
+				checkErrorsTotal.WithLabelValues(name).Inc()
+				outcome.Error = err.Error()
+			}
+			r.lastRun[name] = time.Now()
+		} else {
+			outcome.Error = "unknown check"
 		}
+
+		result.Checks = append(result.Checks, outcome)
 	}
 
-	return reconcile.Result{RequeueAfter: time.Hour, Requeue: true}, err
+	result.CompletionTime = metav1.Now()
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		result.Conditions = cluster.Status.Conditions
+
+		b, err := json.Marshal(result)
+		if err != nil {
+			return err
+		}
+
+		if cluster.Annotations == nil {
+			cluster.Annotations = map[string]string{}
+		}
+		cluster.Annotations[operator.CheckResultAnnotation] = string(b)
+
+		_, err = r.arocli.Clusters().Update(ctx, cluster, metav1.UpdateOptions{})
+		return err
+	})
 }
 
 // SetupWithManager setup our mananger
@@ -0,0 +1,334 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+)
+
+type fakeChecker struct {
+	name string
+	err  error
+	runs int
+}
+
+func (c *fakeChecker) Check(context.Context) error {
+	c.runs++
+	return c.err
+}
+
+func (c *fakeChecker) Name() string {
+	return c.name
+}
+
+func TestCheckerControllerReconcile(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name          string
+		annotations   map[string]string
+		checks        map[string]arov1alpha1.CheckPolicy
+		lastRun       map[string]time.Time
+		lastHeartbeat time.Time
+		wantRuns      []int
+		wantResult    *operator.CheckResult
+		wantHeartbeat bool
+	}{
+		{
+			name:          "periodic run",
+			wantRuns:      []int{1, 1},
+			wantHeartbeat: true,
+		},
+		{
+			name: "disabled checker is not run",
+			checks: map[string]arov1alpha1.CheckPolicy{
+				"BrokenChecker": {
+					Enabled: to.BoolPtr(false),
+				},
+			},
+			wantRuns:      []int{1, 0},
+			wantHeartbeat: true,
+		},
+		{
+			name: "checker is not run before its interval has passed",
+			checks: map[string]arov1alpha1.CheckPolicy{
+				"BrokenChecker": {
+					Interval: &metav1.Duration{Duration: 5 * time.Minute},
+				},
+			},
+			lastRun: map[string]time.Time{
+				"WorkingChecker": time.Now().Add(-10 * time.Minute),
+				"BrokenChecker":  time.Now().Add(-10 * time.Minute),
+			},
+			wantRuns:      []int{0, 1},
+			wantHeartbeat: true,
+		},
+		{
+			name: "no checker is due",
+			lastRun: map[string]time.Time{
+				"WorkingChecker": time.Now(),
+				"BrokenChecker":  time.Now(),
+			},
+			lastHeartbeat: time.Now(),
+			wantRuns:      []int{0, 0},
+		},
+		{
+			name: "heartbeat is recorded hourly even if no checker is due",
+			lastRun: map[string]time.Time{
+				"WorkingChecker": time.Now(),
+				"BrokenChecker":  time.Now(),
+			},
+			lastHeartbeat: time.Now().Add(-time.Hour),
+			wantRuns:      []int{0, 0},
+			wantHeartbeat: true,
+		},
+		{
+			name: "requested checks are run, even if disabled",
+			annotations: map[string]string{
+				operator.CheckRequestAnnotation: `{"id":"request","checks":["BrokenChecker","MissingChecker"]}`,
+			},
+			checks: map[string]arov1alpha1.CheckPolicy{
+				"BrokenChecker": {
+					Enabled: to.BoolPtr(false),
+				},
+			},
+			wantRuns: []int{0, 1},
+			wantResult: &operator.CheckResult{
+				ID: "request",
+				Checks: []operator.CheckOutcome{
+					{
+						Name:  "BrokenChecker",
+						Error: "random error",
+					},
+					{
+						Name:  "MissingChecker",
+						Error: "unknown check",
+					},
+				},
+			},
+		},
+		{
+			name: "all checks are run if none are named",
+			annotations: map[string]string{
+				operator.CheckRequestAnnotation: `{"id":"request"}`,
+				operator.CheckResultAnnotation:  `{"id":"previous"}`,
+			},
+			wantRuns: []int{1, 1},
+			wantResult: &operator.CheckResult{
+				ID: "request",
+				Checks: []operator.CheckOutcome{
+					{
+						Name: "WorkingChecker",
+					},
+					{
+						Name:  "BrokenChecker",
+						Error: "random error",
+					},
+				},
+			},
+		},
+		{
+			name: "completed request is not rerun",
+			annotations: map[string]string{
+				operator.CheckRequestAnnotation: `{"id":"request","checks":["BrokenChecker"]}`,
+				operator.CheckResultAnnotation:  `{"id":"request"}`,
+			},
+			wantRuns:      []int{1, 1},
+			wantHeartbeat: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			conditions := status.Conditions{
+				{
+					Type:   arov1alpha1.EtcdSpaceAvailable,
+					Status: corev1.ConditionTrue,
+				},
+			}
+
+			arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        arov1alpha1.SingletonClusterName,
+					Annotations: tt.annotations,
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					Checks: tt.checks,
+				},
+				Status: arov1alpha1.ClusterStatus{
+					Conditions: conditions,
+				},
+			})
+
+			checkers := []*fakeChecker{
+				{
+					name: "WorkingChecker",
+				},
+				{
+					name: "BrokenChecker",
+					err:  fmt.Errorf("random error"),
+				},
+			}
+
+			r := &CheckerController{
+				log:      utillog.GetLogger(),
+				arocli:   arocli.AroV1alpha1(),
+				role:     operator.RoleMaster,
+				checkers: []Checker{checkers[0], checkers[1]},
+				lastRun:  map[string]time.Time{},
+
+				lastHeartbeat: tt.lastHeartbeat,
+			}
+			for name, lastRun := range tt.lastRun {
+				r.lastRun[name] = lastRun
+			}
+
+			_, _ = r.Reconcile(ctrl.Request{})
+
+			for i, c := range checkers {
+				if c.runs != tt.wantRuns[i] {
+					t.Errorf("%s: %d", c.name, c.runs)
+				}
+			}
+
+			cluster, err := arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if cluster.Status.LastHeartbeatTime.IsZero() == tt.wantHeartbeat {
+				t.Error(cluster.Status.LastHeartbeatTime)
+			}
+
+			if tt.wantResult == nil {
+				return
+			}
+
+			var result *operator.CheckResult
+			err = json.Unmarshal([]byte(cluster.Annotations[operator.CheckResultAnnotation]), &result)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if result.StartTime.IsZero() || result.CompletionTime.IsZero() {
+				t.Error(result.StartTime, result.CompletionTime)
+			}
+			result.StartTime, result.CompletionTime = metav1.Time{}, metav1.Time{}
+
+			tt.wantResult.Conditions = conditions
+			if !reflect.DeepEqual(result, tt.wantResult) {
+				t.Errorf("%#v", result)
+			}
+		})
+	}
+}
+
+func TestCheckerControllerReconcileMachineEvent(t *testing.T) {
+	arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: arov1alpha1.SingletonClusterName,
+		},
+	})
+
+	checkers := []*fakeChecker{
+		{
+			name: "WorkingChecker",
+		},
+		{
+			name: "MachineChecker",
+		},
+	}
+
+	r := &CheckerController{
+		log:      utillog.GetLogger(),
+		arocli:   arocli.AroV1alpha1(),
+		role:     operator.RoleMaster,
+		checkers: []Checker{checkers[0], checkers[1]},
+		lastRun: map[string]time.Time{
+			"WorkingChecker": time.Now(),
+			"MachineChecker": time.Now(),
+		},
+	}
+
+	_, err := r.Reconcile(ctrl.Request{
+		NamespacedName: types.NamespacedName{Namespace: "openshift-machine-api", Name: "machine"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if checkers[0].runs != 0 || checkers[1].runs != 1 {
+		t.Error(checkers[0].runs, checkers[1].runs)
+	}
+}
+
+func TestCheckPolicy(t *testing.T) {
+	for _, tt := range []struct {
+		name         string
+		policy       *arov1alpha1.CheckPolicy
+		wantEnabled  bool
+		wantInterval time.Duration
+	}{
+		{
+			name:         "no policy",
+			wantEnabled:  true,
+			wantInterval: defaultCheckInterval,
+		},
+		{
+			name: "disabled",
+			policy: &arov1alpha1.CheckPolicy{
+				Enabled: to.BoolPtr(false),
+			},
+		},
+		{
+			name: "interval",
+			policy: &arov1alpha1.CheckPolicy{
+				Enabled:  to.BoolPtr(true),
+				Interval: &metav1.Duration{Duration: 15 * time.Minute},
+			},
+			wantEnabled:  true,
+			wantInterval: 15 * time.Minute,
+		},
+		{
+			name: "interval below the minimum",
+			policy: &arov1alpha1.CheckPolicy{
+				Interval: &metav1.Duration{Duration: time.Second},
+			},
+			wantEnabled:  true,
+			wantInterval: minCheckInterval,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &arov1alpha1.Cluster{}
+			if tt.policy != nil {
+				instance.Spec.Checks = map[string]arov1alpha1.CheckPolicy{
+					"MachineChecker": *tt.policy,
+				}
+			}
+
+			enabled, interval := checkPolicy(instance, "MachineChecker")
+			if enabled != tt.wantEnabled {
+				t.Error(enabled)
+			}
+			if interval != tt.wantInterval {
+				t.Error(interval)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/nodeproblemdetector"
+)
+
+const (
+	nodeLeaseNamespace = "kube-node-lease"
+
+	// maxClockSkew is the largest difference between the clock of a node
+	// and that of the API server which is not reported
+	maxClockSkew = 5 * time.Second
+
+	// timeSyncEventWindow is how far back time synchronization problems
+	// reported by the node problem detector are taken into account
+	timeSyncEventWindow = time.Hour
+)
+
+// ClockSkewChecker reports nodes whose clocks are skewed or which have lost
+// time synchronization.  Skew breaks TLS certificate and token validation in
+// ways which are hard to diagnose from the errors it causes.
+type ClockSkewChecker struct {
+	kubernetescli kubernetes.Interface
+	arocli        aroclient.AroV1alpha1Interface
+	recorder      record.EventRecorder
+	log           *logrus.Entry
+	role          string
+
+	now func() time.Time
+}
+
+func NewClockSkewChecker(log *logrus.Entry, kubernetescli kubernetes.Interface, arocli aroclient.AroV1alpha1Interface, recorder record.EventRecorder, role string) *ClockSkewChecker {
+	return &ClockSkewChecker{
+		kubernetescli: kubernetescli,
+		arocli:        arocli,
+		recorder:      recorder,
+		log:           log,
+		role:          role,
+
+		now: time.Now,
+	}
+}
+
+func (r *ClockSkewChecker) Name() string {
+	return "ClockSkewChecker"
+}
+
+// +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=list
+// +kubebuilder:rbac:groups="",resources=events,verbs=list
+
+// Check sets the NodeClocksSynchronized condition to False if the clock of a
+// node is skewed from that of the API server by more than maxClockSkew, or if
+// chronyd on a node has recently lost its time sources.
+//
+// The skew of a node is measured on its kubelet's lease: the renew time is
+// set from the node's clock, and the time of the kubelet's last update in the
+// lease's managed fields is set from the API server's.
+func (r *ClockSkewChecker) Check(ctx context.Context) error {
+	cond := &status.Condition{
+		Type:    arov1alpha1.NodeClocksSynchronized,
+		Status:  corev1.ConditionTrue,
+		Message: "node clocks are synchronized",
+		Reason:  "CheckDone",
+	}
+
+	leases, err := r.kubernetescli.CoordinationV1().Leases(nodeLeaseNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	problems := map[string][]string{}
+
+	for i := range leases.Items {
+		skew, ok := r.clockSkew(&leases.Items[i])
+		if !ok {
+			continue
+		}
+
+		switch {
+		case skew > maxClockSkew:
+			problems[leases.Items[i].Name] = append(problems[leases.Items[i].Name], fmt.Sprintf("clock is %s ahead", skew))
+		case skew < -maxClockSkew:
+			problems[leases.Items[i].Name] = append(problems[leases.Items[i].Name], fmt.Sprintf("clock is %s behind", -skew))
+		}
+	}
+
+	events, err := r.kubernetescli.CoreV1().Events(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		FieldSelector: "involvedObject.kind=Node,reason=" + nodeproblemdetector.EventTimeSyncLost,
+	})
+	if err != nil {
+		return err
+	}
+
+	lost := map[string]bool{}
+	for _, ev := range events.Items {
+		if ev.InvolvedObject.Kind != "Node" ||
+			ev.Reason != nodeproblemdetector.EventTimeSyncLost ||
+			r.now().Sub(ev.LastTimestamp.Time) > timeSyncEventWindow ||
+			lost[ev.InvolvedObject.Name] {
+			continue
+		}
+
+		lost[ev.InvolvedObject.Name] = true
+		problems[ev.InvolvedObject.Name] = append(problems[ev.InvolvedObject.Name], "time synchronization lost: "+strings.TrimSpace(ev.Message))
+	}
+
+	if len(problems) > 0 {
+		nodes := make([]string, 0, len(problems))
+		for node := range problems {
+			nodes = append(nodes, node)
+		}
+		sort.Strings(nodes)
+
+		sb := &strings.Builder{}
+		for _, node := range nodes {
+			r.log.Warnf("%s: %s", node, strings.Join(problems[node], "; "))
+			fmt.Fprintf(sb, "%s: %s\n", node, strings.Join(problems[node], "; "))
+		}
+
+		cond.Status = corev1.ConditionFalse
+		cond.Reason = "CheckFailed"
+		cond.Message = sb.String()
+	}
+
+	return controllers.SetCondition(ctx, r.arocli, r.recorder, cond, r.role)
+}
+
+// clockSkew returns by how much the clock of the node of lease is ahead of
+// the API server's, or false if it can't be told
+func (r *ClockSkewChecker) clockSkew(lease *coordinationv1.Lease) (time.Duration, bool) {
+	if lease.Spec.RenewTime == nil {
+		return 0, false
+	}
+
+	var serverTime time.Time
+	for _, f := range lease.ManagedFields {
+		if f.Manager == "kubelet" && f.Time != nil && f.Time.After(serverTime) {
+			serverTime = f.Time.Time
+		}
+	}
+
+	if serverTime.IsZero() {
+		// without managed fields, only a renew time in the future stands out:
+		// one in the past could also be a kubelet which has stopped renewing
+		skew := lease.Spec.RenewTime.Sub(r.now())
+		return skew, skew > 0
+	}
+
+	// the managed fields time has a resolution of one second, which is well
+	// within maxClockSkew
+	return lease.Spec.RenewTime.Sub(serverTime).Round(time.Second), true
+}
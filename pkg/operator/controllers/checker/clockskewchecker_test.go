@@ -0,0 +1,201 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/nodeproblemdetector"
+)
+
+func TestClockSkewCheckerCheck(t *testing.T) {
+	ctx := context.Background()
+
+	now := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	// lease returns the lease of a node whose clock is skew ahead of the
+	// API server's, as last renewed at serverTime
+	lease := func(name string, serverTime time.Time, skew time.Duration) *coordinationv1.Lease {
+		return &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nodeLeaseNamespace,
+				ManagedFields: []metav1.ManagedFieldsEntry{
+					{
+						Manager: "kubelet",
+						Time:    &metav1.Time{Time: serverTime.Truncate(time.Second)},
+					},
+				},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				RenewTime: &metav1.MicroTime{Time: serverTime.Add(skew)},
+			},
+		}
+	}
+
+	event := func(node, reason string, lastTimestamp time.Time) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      node + "." + reason,
+				Namespace: "default",
+			},
+			InvolvedObject: corev1.ObjectReference{
+				Kind: "Node",
+				Name: node,
+			},
+			Reason:        reason,
+			Message:       "Can't synchronise: no selectable sources",
+			LastTimestamp: metav1.Time{Time: lastTimestamp},
+		}
+	}
+
+	for _, tt := range []struct {
+		name        string
+		objects     []runtime.Object
+		wantStatus  corev1.ConditionStatus
+		wantMessage string
+	}{
+		{
+			name: "clocks synchronized",
+			objects: []runtime.Object{
+				lease("master-0", now.Add(-5*time.Second), 0),
+				lease("worker-0", now.Add(-500*time.Millisecond), 1500*time.Millisecond),
+				lease("worker-1", now, -4*time.Second),
+				event("worker-1", nodeproblemdetector.EventTimeSyncLost, now.Add(-2*time.Hour)),
+				event("worker-1", nodeproblemdetector.EventClockStepped, now),
+			},
+			wantStatus:  corev1.ConditionTrue,
+			wantMessage: "node clocks are synchronized",
+		},
+		{
+			name: "skewed clocks and lost time synchronization",
+			objects: []runtime.Object{
+				lease("master-0", now, 0),
+				lease("worker-0", now.Add(-time.Second), 2*time.Minute),
+				lease("worker-1", now, -30*time.Second),
+				event("worker-1", nodeproblemdetector.EventTimeSyncLost, now.Add(-time.Minute)),
+				event("worker-2", nodeproblemdetector.EventTimeSyncLost, now.Add(-10*time.Minute)),
+			},
+			wantStatus:  corev1.ConditionFalse,
+			wantMessage: "worker-0: clock is 2m0s ahead\nworker-1: clock is 30s behind; time synchronization lost: Can't synchronise: no selectable sources\nworker-2: time synchronization lost: Can't synchronise: no selectable sources\n",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+			})
+
+			r := &ClockSkewChecker{
+				kubernetescli: fake.NewSimpleClientset(tt.objects...),
+				arocli:        arocli.AroV1alpha1(),
+				log:           logrus.NewEntry(logrus.StandardLogger()),
+				role:          operator.RoleMaster,
+				now:           func() time.Time { return now },
+			}
+
+			err := r.Check(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cluster, err := arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cond := cluster.Status.Conditions.GetCondition(arov1alpha1.NodeClocksSynchronized)
+			if cond == nil {
+				t.Fatal("condition not set")
+			}
+			if cond.Status != tt.wantStatus {
+				t.Error(cond.Status)
+			}
+			if cond.Message != tt.wantMessage {
+				t.Error(cond.Message)
+			}
+		})
+	}
+}
+
+func TestClockSkew(t *testing.T) {
+	now := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	r := &ClockSkewChecker{
+		now: func() time.Time { return now },
+	}
+
+	for _, tt := range []struct {
+		name     string
+		lease    *coordinationv1.Lease
+		wantSkew time.Duration
+		wantOK   bool
+	}{
+		{
+			name:  "never renewed",
+			lease: &coordinationv1.Lease{},
+		},
+		{
+			name: "no managed fields, renewed in the future",
+			lease: &coordinationv1.Lease{
+				Spec: coordinationv1.LeaseSpec{
+					RenewTime: &metav1.MicroTime{Time: now.Add(time.Minute)},
+				},
+			},
+			wantSkew: time.Minute,
+			wantOK:   true,
+		},
+		{
+			name: "no managed fields, renewed in the past",
+			lease: &coordinationv1.Lease{
+				Spec: coordinationv1.LeaseSpec{
+					RenewTime: &metav1.MicroTime{Time: now.Add(-time.Minute)},
+				},
+			},
+			wantSkew: -time.Minute,
+		},
+		{
+			name: "kubelet managed fields",
+			lease: &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{
+					ManagedFields: []metav1.ManagedFieldsEntry{
+						{
+							Manager: "kubelet",
+							Time:    &metav1.Time{Time: now},
+						},
+						{
+							Manager: "other",
+							Time:    &metav1.Time{Time: now.Add(time.Hour)},
+						},
+					},
+				},
+				Spec: coordinationv1.LeaseSpec{
+					RenewTime: &metav1.MicroTime{Time: now.Add(-2 * time.Minute)},
+				},
+			},
+			wantSkew: -2 * time.Minute,
+			wantOK:   true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			skew, ok := r.clockSkew(tt.lease)
+			if skew != tt.wantSkew || ok != tt.wantOK {
+				t.Error(skew, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+)
+
+// newNodeGracePeriod is how long a new node has to start the pods of the
+// DaemonSets before it is reported
+const newNodeGracePeriod = 10 * time.Minute
+
+// daemonSetNamespaces are the namespaces of the DaemonSets which the operator
+// deploys
+var daemonSetNamespaces = []string{
+	"openshift-azure-logging",
+	"openshift-azure-nodeproblemdetector",
+	"openshift-azure-routefix",
+}
+
+// DaemonSetChecker reports nodes on which a DaemonSet deployed by the
+// operator is not running, e.g. because the node is tainted or relabelled,
+// or has no room for the pod.  Such nodes silently go without logging or
+// workarounds.
+type DaemonSetChecker struct {
+	kubernetescli kubernetes.Interface
+	arocli        aroclient.AroV1alpha1Interface
+	recorder      record.EventRecorder
+	log           *logrus.Entry
+	role          string
+
+	now func() time.Time
+}
+
+func NewDaemonSetChecker(log *logrus.Entry, kubernetescli kubernetes.Interface, arocli aroclient.AroV1alpha1Interface, recorder record.EventRecorder, role string) *DaemonSetChecker {
+	return &DaemonSetChecker{
+		kubernetescli: kubernetescli,
+		arocli:        arocli,
+		recorder:      recorder,
+		log:           log,
+		role:          role,
+
+		now: time.Now,
+	}
+}
+
+func (r *DaemonSetChecker) Name() string {
+	return "DaemonSetChecker"
+}
+
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=list
+// +kubebuilder:rbac:groups="",resources=pods,verbs=list
+// +kubebuilder:rbac:groups=apps,resources=daemonsets,verbs=list
+
+// Check sets the ManagedDaemonSetsScheduled condition to False if a DaemonSet
+// deployed by the operator has no running pod on a node, with the reason for
+// each node where it can be told.  Nodes younger than newNodeGracePeriod are
+// left out.
+func (r *DaemonSetChecker) Check(ctx context.Context) error {
+	cond := &status.Condition{
+		Type:    arov1alpha1.ManagedDaemonSetsScheduled,
+		Status:  corev1.ConditionTrue,
+		Message: "managed daemonsets are running on all nodes",
+		Reason:  "CheckDone",
+	}
+
+	nodes, err := r.kubernetescli.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	problems := map[string][]string{}
+
+	for _, namespace := range daemonSetNamespaces {
+		daemonSets, err := r.kubernetescli.AppsV1().DaemonSets(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+
+		pods, err := r.kubernetescli.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+
+		for i := range daemonSets.Items {
+			ds := &daemonSets.Items[i]
+			if !controlledByCluster(&ds.ObjectMeta) {
+				continue
+			}
+
+			podsByNode := daemonSetPodsByNode(ds, pods.Items)
+
+			for j := range nodes.Items {
+				node := &nodes.Items[j]
+				if r.now().Sub(node.CreationTimestamp.Time) < newNodeGracePeriod {
+					continue
+				}
+
+				if problem := missingReason(ds, node, podsByNode[node.Name]); problem != "" {
+					problems[node.Name] = append(problems[node.Name], ds.Namespace+"/"+ds.Name+": "+problem)
+				}
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		names := make([]string, 0, len(problems))
+		for name := range problems {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		sb := &strings.Builder{}
+		for _, name := range names {
+			r.log.Warnf("%s: %s", name, strings.Join(problems[name], "; "))
+			fmt.Fprintf(sb, "%s: %s\n", name, strings.Join(problems[name], "; "))
+		}
+
+		cond.Status = corev1.ConditionFalse
+		cond.Reason = "CheckFailed"
+		cond.Message = sb.String()
+	}
+
+	return controllers.SetCondition(ctx, r.arocli, r.recorder, cond, r.role)
+}
+
+// missingReason returns why ds has no running pod on node, or "" if it has
+// one
+func missingReason(ds *appsv1.DaemonSet, node *corev1.Node, pod *corev1.Pod) string {
+	if pod != nil {
+		if pod.Status.Phase == corev1.PodRunning {
+			return ""
+		}
+
+		for _, c := range pod.Status.Conditions {
+			if c.Type == corev1.PodScheduled && c.Status == corev1.ConditionFalse && c.Message != "" {
+				return fmt.Sprintf("pod %s is %s: %s", pod.Name, pod.Status.Phase, c.Message)
+			}
+		}
+
+		return fmt.Sprintf("pod %s is %s", pod.Name, pod.Status.Phase)
+	}
+
+	if !labels.SelectorFromSet(ds.Spec.Template.Spec.NodeSelector).Matches(labels.Set(node.Labels)) {
+		return "node does not match the nodeSelector"
+	}
+
+	for i := range node.Spec.Taints {
+		taint := &node.Spec.Taints[i]
+		if !tolerated(ds.Spec.Template.Spec.Tolerations, taint) {
+			return fmt.Sprintf("taint %s is not tolerated", taint.ToString())
+		}
+	}
+
+	return "no pod is scheduled"
+}
+
+// tolerated returns true if taint doesn't keep the pods of a DaemonSet with
+// the given tolerations off a node
+func tolerated(tolerations []corev1.Toleration, taint *corev1.Taint) bool {
+	// the DaemonSet controller adds tolerations for the node condition and
+	// unschedulable taints to its pods itself
+	if taint.Effect == corev1.TaintEffectPreferNoSchedule ||
+		strings.HasPrefix(taint.Key, "node.kubernetes.io/") {
+		return true
+	}
+
+	for i := range tolerations {
+		if tolerations[i].ToleratesTaint(taint) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// daemonSetPodsByNode returns the pods of ds, keyed by the node which they
+// run on or, for pods which are not yet scheduled, the node which they are
+// meant for.  Running pods take precedence.
+func daemonSetPodsByNode(ds *appsv1.DaemonSet, pods []corev1.Pod) map[string]*corev1.Pod {
+	m := map[string]*corev1.Pod{}
+
+	for i := range pods {
+		pod := &pods[i]
+
+		owner := metav1.GetControllerOfNoCopy(pod)
+		if owner == nil || owner.UID != ds.UID {
+			continue
+		}
+
+		node := pod.Spec.NodeName
+		if node == "" {
+			node = targetNode(pod)
+		}
+		if node == "" {
+			continue
+		}
+
+		if m[node] == nil || m[node].Status.Phase != corev1.PodRunning {
+			m[node] = pod
+		}
+	}
+
+	return m
+}
+
+// targetNode returns the node which an unscheduled DaemonSet pod is meant
+// for: the DaemonSet controller pins each pod to its node with a node
+// affinity on the node's name
+func targetNode(pod *corev1.Pod) string {
+	if pod.Spec.Affinity == nil ||
+		pod.Spec.Affinity.NodeAffinity == nil ||
+		pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return ""
+	}
+
+	for _, term := range pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+		for _, f := range term.MatchFields {
+			if f.Key == "metadata.name" && f.Operator == corev1.NodeSelectorOpIn && len(f.Values) == 1 {
+				return f.Values[0]
+			}
+		}
+	}
+
+	return ""
+}
+
+// controlledByCluster returns true if meta is rendered by an operator
+// controller
+func controlledByCluster(meta *metav1.ObjectMeta) bool {
+	owner := metav1.GetControllerOfNoCopy(meta)
+	return owner != nil &&
+		owner.APIVersion == arov1alpha1.GroupVersion.String() &&
+		owner.Kind == "Cluster"
+}
@@ -0,0 +1,220 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+)
+
+func TestDaemonSetCheckerCheck(t *testing.T) {
+	ctx := context.Background()
+
+	now := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	node := func(name string, created time.Time, taints ...corev1.Taint) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				CreationTimestamp: metav1.Time{Time: created},
+				Labels: map[string]string{
+					"kubernetes.io/os": "linux",
+				},
+			},
+			Spec: corev1.NodeSpec{
+				Taints: taints,
+			},
+		}
+	}
+
+	daemonSet := func(name string, owner string, nodeSelector map[string]string) *appsv1.DaemonSet {
+		return &appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "openshift-azure-logging",
+				UID:       types.UID(name),
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: arov1alpha1.GroupVersion.String(),
+						Kind:       owner,
+						Name:       arov1alpha1.SingletonClusterName,
+						Controller: to.BoolPtr(true),
+					},
+				},
+			},
+			Spec: appsv1.DaemonSetSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						NodeSelector: nodeSelector,
+						Tolerations: []corev1.Toleration{
+							{
+								Key:      "node-role.kubernetes.io/master",
+								Operator: corev1.TolerationOpExists,
+								Effect:   corev1.TaintEffectNoSchedule,
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	pod := func(name, ds, nodeName, targetNodeName string, phase corev1.PodPhase, conditions ...corev1.PodCondition) *corev1.Pod {
+		p := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "openshift-azure-logging",
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: "apps/v1",
+						Kind:       "DaemonSet",
+						Name:       ds,
+						UID:        types.UID(ds),
+						Controller: to.BoolPtr(true),
+					},
+				},
+			},
+			Spec: corev1.PodSpec{
+				NodeName: nodeName,
+			},
+			Status: corev1.PodStatus{
+				Phase:      phase,
+				Conditions: conditions,
+			},
+		}
+
+		if targetNodeName != "" {
+			p.Spec.Affinity = &corev1.Affinity{
+				NodeAffinity: &corev1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+						NodeSelectorTerms: []corev1.NodeSelectorTerm{
+							{
+								MatchFields: []corev1.NodeSelectorRequirement{
+									{
+										Key:      "metadata.name",
+										Operator: corev1.NodeSelectorOpIn,
+										Values:   []string{targetNodeName},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+		}
+
+		return p
+	}
+
+	masterTaint := corev1.Taint{
+		Key:    "node-role.kubernetes.io/master",
+		Effect: corev1.TaintEffectNoSchedule,
+	}
+
+	for _, tt := range []struct {
+		name        string
+		objects     []runtime.Object
+		wantStatus  corev1.ConditionStatus
+		wantMessage string
+	}{
+		{
+			name: "daemonsets running on all nodes",
+			objects: []runtime.Object{
+				node("master-0", now.Add(-time.Hour), masterTaint),
+				node("worker-0", now.Add(-time.Hour), corev1.Taint{
+					Key:    "node.kubernetes.io/unschedulable",
+					Effect: corev1.TaintEffectNoSchedule,
+				}),
+				node("worker-1", now.Add(-time.Minute)), // new
+				daemonSet("mdsd", "Cluster", nil),
+				daemonSet("other", "Other", nil), // not managed
+				pod("mdsd-1", "mdsd", "master-0", "", corev1.PodRunning),
+				pod("mdsd-2", "mdsd", "worker-0", "", corev1.PodFailed),
+				pod("mdsd-3", "mdsd", "worker-0", "", corev1.PodRunning),
+			},
+			wantStatus:  corev1.ConditionTrue,
+			wantMessage: "managed daemonsets are running on all nodes",
+		},
+		{
+			name: "daemonsets missing from nodes",
+			objects: []runtime.Object{
+				node("master-0", now.Add(-time.Hour), masterTaint),
+				node("worker-0", now.Add(-time.Hour), corev1.Taint{
+					Key:    "dedicated",
+					Value:  "gpu",
+					Effect: corev1.TaintEffectNoSchedule,
+				}),
+				node("worker-1", now.Add(-time.Hour)),
+				node("worker-2", now.Add(-time.Hour)),
+				daemonSet("mdsd", "Cluster", nil),
+				daemonSet("routefix", "Cluster", map[string]string{
+					"kubernetes.io/os": "windows",
+				}),
+				pod("mdsd-1", "mdsd", "master-0", "", corev1.PodRunning),
+				pod("mdsd-2", "mdsd", "", "worker-1", corev1.PodPending, corev1.PodCondition{
+					Type:    corev1.PodScheduled,
+					Status:  corev1.ConditionFalse,
+					Message: "0/4 nodes are available: 1 Insufficient memory.",
+				}),
+				pod("mdsd-3", "mdsd", "worker-2", "", corev1.PodPending),
+			},
+			wantStatus: corev1.ConditionFalse,
+			wantMessage: "master-0: openshift-azure-logging/routefix: node does not match the nodeSelector\n" +
+				"worker-0: openshift-azure-logging/mdsd: taint dedicated=gpu:NoSchedule is not tolerated; openshift-azure-logging/routefix: node does not match the nodeSelector\n" +
+				"worker-1: openshift-azure-logging/mdsd: pod mdsd-2 is Pending: 0/4 nodes are available: 1 Insufficient memory.; openshift-azure-logging/routefix: node does not match the nodeSelector\n" +
+				"worker-2: openshift-azure-logging/mdsd: pod mdsd-3 is Pending; openshift-azure-logging/routefix: node does not match the nodeSelector\n",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+			})
+
+			r := &DaemonSetChecker{
+				kubernetescli: fake.NewSimpleClientset(tt.objects...),
+				arocli:        arocli.AroV1alpha1(),
+				log:           logrus.NewEntry(logrus.StandardLogger()),
+				role:          operator.RoleMaster,
+				now:           func() time.Time { return now },
+			}
+
+			err := r.Check(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cluster, err := arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cond := cluster.Status.Conditions.GetCondition(arov1alpha1.ManagedDaemonSetsScheduled)
+			if cond == nil {
+				t.Fatal("condition not set")
+			}
+			if cond.Status != tt.wantStatus {
+				t.Error(cond.Status)
+			}
+			if cond.Message != tt.wantMessage {
+				t.Error(cond.Message)
+			}
+		})
+	}
+}
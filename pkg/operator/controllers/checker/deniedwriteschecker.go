@@ -0,0 +1,189 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	mgmtinsights "github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure/auth"
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/cloudproviderconfig"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/insights"
+)
+
+const (
+	// deniedWritesWindow is how far back the activity log is searched.  The
+	// checkers run hourly.
+	deniedWritesWindow = time.Hour
+
+	// deniedWritesThreshold is the number of denied writes by one caller
+	// within deniedWritesWindow which counts as repeated attempts, rather
+	// than as a one-off mistake
+	deniedWritesThreshold = 3
+)
+
+// DeniedWritesChecker looks in the activity log of the cluster resource
+// group for writes which the ARO deny assignment blocked.  Repeated attempts
+// tell SRE that a customer is trying to modify the managed infrastructure,
+// which usually explains the problem they raise next.
+type DeniedWritesChecker struct {
+	kubernetescli kubernetes.Interface
+	arocli        aroclient.AroV1alpha1Interface
+	recorder      record.EventRecorder
+	log           *logrus.Entry
+	role          string
+
+	newActivityLogsClient func(subscriptionID string, authorizer autorest.Authorizer) insights.ActivityLogsClient
+}
+
+func NewDeniedWritesChecker(log *logrus.Entry, kubernetescli kubernetes.Interface, arocli aroclient.AroV1alpha1Interface, recorder record.EventRecorder, role string) *DeniedWritesChecker {
+	return &DeniedWritesChecker{
+		kubernetescli: kubernetescli,
+		arocli:        arocli,
+		recorder:      recorder,
+		log:           log,
+		role:          role,
+
+		newActivityLogsClient: insights.NewActivityLogsClient,
+	}
+}
+
+func (r *DeniedWritesChecker) Name() string {
+	return "DeniedWritesChecker"
+}
+
+// Check sets the DeniedWritesNotDetected condition to False if a caller has
+// been denied deniedWritesThreshold or more writes to the cluster resource
+// group within deniedWritesWindow
+func (r *DeniedWritesChecker) Check(ctx context.Context) error {
+	mysec, err := r.kubernetescli.CoreV1().Secrets(operator.Namespace).Get(ctx, operator.SecretName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	// the secret is written by older RPs without the expected config
+	if _, found := mysec.Data[cloudproviderconfig.ConfigKey]; !found {
+		return nil
+	}
+
+	var config *cloudproviderconfig.Config
+	err = json.Unmarshal(mysec.Data[cloudproviderconfig.ConfigKey], &config)
+	if err != nil {
+		return err
+	}
+
+	authorizer, err := auth.NewClientCredentialsConfig(config.AADClientID, config.AADClientSecret, config.TenantID).Authorizer()
+	if err != nil {
+		return err
+	}
+
+	activityLogs := r.newActivityLogsClient(config.SubscriptionID, authorizer)
+
+	now := time.Now().UTC()
+	filter := fmt.Sprintf("eventTimestamp ge '%s' and eventTimestamp le '%s' and resourceGroupName eq '%s'", now.Add(-deniedWritesWindow).Format(time.RFC3339), now.Format(time.RFC3339), config.ResourceGroup)
+
+	events, err := activityLogs.List(ctx, filter, "authorization,caller,status,subStatus")
+	if err != nil {
+		return err
+	}
+
+	cond := &status.Condition{
+		Type:    arov1alpha1.DeniedWritesNotDetected,
+		Status:  corev1.ConditionTrue,
+		Message: "no repeated denied writes to the cluster resource group",
+		Reason:  "CheckDone",
+	}
+
+	if message := deniedWritesMessage(events); message != "" {
+		r.log.Warn(message)
+		cond.Status = corev1.ConditionFalse
+		cond.Reason = "CheckFailed"
+		cond.Message = message
+	}
+
+	return controllers.SetCondition(ctx, r.arocli, r.recorder, cond, r.role)
+}
+
+// deniedWritesMessage returns a line for each caller which was denied
+// deniedWritesThreshold or more writes in events, or "" if there is none
+func deniedWritesMessage(events []mgmtinsights.EventData) string {
+	actions := map[string]map[string]int{}
+	for _, e := range events {
+		if !isDeniedWrite(&e) {
+			continue
+		}
+
+		caller := "unknown caller"
+		if e.Caller != nil {
+			caller = *e.Caller
+		}
+
+		if actions[caller] == nil {
+			actions[caller] = map[string]int{}
+		}
+		actions[caller][*e.Authorization.Action]++
+	}
+
+	callers := make([]string, 0, len(actions))
+	for caller := range actions {
+		callers = append(callers, caller)
+	}
+	sort.Strings(callers)
+
+	sb := &strings.Builder{}
+	for _, caller := range callers {
+		var count int
+		names := make([]string, 0, len(actions[caller]))
+		for action, n := range actions[caller] {
+			count += n
+			names = append(names, action)
+		}
+
+		if count < deniedWritesThreshold {
+			continue
+		}
+
+		sort.Strings(names)
+		fmt.Fprintf(sb, "%s: %d denied writes (%s)\n", caller, count, strings.Join(names, ", "))
+	}
+
+	return sb.String()
+}
+
+// isDeniedWrite returns true if e records a write or delete which failed
+// authorization.  The deny assignment excludes the cluster and RP service
+// principals, so only other callers are denied.
+func isDeniedWrite(e *mgmtinsights.EventData) bool {
+	if e.Status == nil || e.Status.Value == nil || !strings.EqualFold(*e.Status.Value, "Failed") {
+		return false
+	}
+
+	if e.SubStatus == nil || e.SubStatus.Value == nil || !strings.EqualFold(*e.SubStatus.Value, "Forbidden") {
+		return false
+	}
+
+	if e.Authorization == nil || e.Authorization.Action == nil {
+		return false
+	}
+
+	action := strings.ToLower(*e.Authorization.Action)
+	return strings.HasSuffix(action, "/write") || strings.HasSuffix(action, "/delete") || strings.HasSuffix(action, "/action")
+}
@@ -0,0 +1,155 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	mgmtinsights "github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/insights"
+)
+
+type fakeActivityLogsClient struct {
+	events []mgmtinsights.EventData
+	filter string
+}
+
+func (c *fakeActivityLogsClient) List(ctx context.Context, filter string, selectParameter string) ([]mgmtinsights.EventData, error) {
+	c.filter = filter
+	return c.events, nil
+}
+
+func TestDeniedWritesCheckerCheck(t *testing.T) {
+	ctx := context.Background()
+
+	event := func(caller, action, status, subStatus string) mgmtinsights.EventData {
+		return mgmtinsights.EventData{
+			Authorization: &mgmtinsights.SenderAuthorization{
+				Action: to.StringPtr(action),
+			},
+			Caller: to.StringPtr(caller),
+			Status: &mgmtinsights.LocalizableString{
+				Value: to.StringPtr(status),
+			},
+			SubStatus: &mgmtinsights.LocalizableString{
+				Value: to.StringPtr(subStatus),
+			},
+		}
+	}
+
+	denied := func(caller, action string) mgmtinsights.EventData {
+		return event(caller, action, "Failed", "Forbidden")
+	}
+
+	for _, tt := range []struct {
+		name        string
+		events      []mgmtinsights.EventData
+		wantStatus  corev1.ConditionStatus
+		wantMessage string
+	}{
+		{
+			name: "no denied writes",
+			events: []mgmtinsights.EventData{
+				event("user@example.com", "Microsoft.Network/networkSecurityGroups/write", "Succeeded", "OK"),
+				event("user@example.com", "Microsoft.Network/networkSecurityGroups/write", "Failed", "Conflict"),
+			},
+			wantStatus:  corev1.ConditionTrue,
+			wantMessage: "no repeated denied writes to the cluster resource group",
+		},
+		{
+			name: "one-off denied writes",
+			events: []mgmtinsights.EventData{
+				denied("user@example.com", "Microsoft.Network/networkSecurityGroups/write"),
+				denied("user@example.com", "Microsoft.Network/networkSecurityGroups/write"),
+				denied("other@example.com", "Microsoft.Compute/virtualMachines/delete"),
+				denied("other@example.com", "Microsoft.Compute/virtualMachines/read"),
+			},
+			wantStatus:  corev1.ConditionTrue,
+			wantMessage: "no repeated denied writes to the cluster resource group",
+		},
+		{
+			name: "repeated denied writes",
+			events: []mgmtinsights.EventData{
+				denied("user@example.com", "Microsoft.Network/networkSecurityGroups/write"),
+				denied("user@example.com", "Microsoft.Network/networkSecurityGroups/securityRules/write"),
+				denied("user@example.com", "Microsoft.Network/networkSecurityGroups/write"),
+				denied("other@example.com", "Microsoft.Compute/virtualMachines/delete"),
+			},
+			wantStatus:  corev1.ConditionFalse,
+			wantMessage: "user@example.com: 3 denied writes (Microsoft.Network/networkSecurityGroups/securityRules/write, Microsoft.Network/networkSecurityGroups/write)\n",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			kubernetescli := fake.NewSimpleClientset(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      operator.SecretName,
+					Namespace: operator.Namespace,
+				},
+				Data: map[string][]byte{
+					"cloudProviderConfig": []byte(`{"tenantId":"tenant","subscriptionId":"subscription","resourceGroup":"cluster-rg","aadClientId":"client","aadClientSecret":"secret"}`),
+				},
+			})
+
+			arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+			})
+
+			activityLogs := &fakeActivityLogsClient{
+				events: tt.events,
+			}
+
+			r := &DeniedWritesChecker{
+				kubernetescli: kubernetescli,
+				arocli:        arocli.AroV1alpha1(),
+				log:           logrus.NewEntry(logrus.StandardLogger()),
+				role:          operator.RoleMaster,
+				newActivityLogsClient: func(subscriptionID string, authorizer autorest.Authorizer) insights.ActivityLogsClient {
+					if subscriptionID != "subscription" {
+						t.Error(subscriptionID)
+					}
+					return activityLogs
+				},
+			}
+
+			err := r.Check(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !strings.HasSuffix(activityLogs.filter, "and resourceGroupName eq 'cluster-rg'") {
+				t.Error(activityLogs.filter)
+			}
+
+			cluster, err := arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cond := cluster.Status.Conditions.GetCondition(arov1alpha1.DeniedWritesNotDetected)
+			if cond == nil {
+				t.Fatal("condition not set")
+			}
+			if cond.Status != tt.wantStatus {
+				t.Error(cond.Status)
+			}
+			if cond.Message != tt.wantMessage {
+				t.Error(cond.Message)
+			}
+		})
+	}
+}
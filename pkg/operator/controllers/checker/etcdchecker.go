@@ -0,0 +1,108 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+	"github.com/Azure/ARO-RP/pkg/util/etcd"
+)
+
+// etcdSizeWarningRatio is the proportion of the quota beyond which a member's
+// database is reported, leaving time to defragment before writes are refused
+const etcdSizeWarningRatio = 0.8
+
+// EtcdChecker reports etcd members whose database is close to its quota
+type EtcdChecker struct {
+	kubernetescli kubernetes.Interface
+	arocli        aroclient.AroV1alpha1Interface
+	recorder      record.EventRecorder
+	log           *logrus.Entry
+	role          string
+
+	newEtcdClient func(context.Context, kubernetes.Interface) (etcd.Client, error)
+}
+
+func NewEtcdChecker(log *logrus.Entry, kubernetescli kubernetes.Interface, arocli aroclient.AroV1alpha1Interface, recorder record.EventRecorder, role string) *EtcdChecker {
+	return &EtcdChecker{
+		kubernetescli: kubernetescli,
+		arocli:        arocli,
+		recorder:      recorder,
+		log:           log,
+		role:          role,
+
+		newEtcdClient: etcd.NewClient,
+	}
+}
+
+func (r *EtcdChecker) Name() string {
+	return "EtcdChecker"
+}
+
+// Check sets the EtcdSpaceAvailable condition to False if a member has raised
+// a NOSPACE alarm or its database has grown close to the quota.  The message
+// includes fragmentation, which tells whether defragmentation would help.
+func (r *EtcdChecker) Check(ctx context.Context) error {
+	etcdcli, err := r.newEtcdClient(ctx, r.kubernetescli)
+	if err != nil {
+		return err
+	}
+
+	members, err := etcdcli.Members(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(members) == 0 {
+		return fmt.Errorf("no etcd members found")
+	}
+
+	cond := &status.Condition{
+		Type:    arov1alpha1.EtcdSpaceAvailable,
+		Status:  corev1.ConditionTrue,
+		Message: "etcd databases are within quota",
+		Reason:  "CheckDone",
+	}
+
+	sb := &strings.Builder{}
+	for _, m := range members {
+		s, err := etcdcli.Status(ctx, m)
+		if err != nil {
+			return err
+		}
+
+		if float64(s.DBSize) >= etcdSizeWarningRatio*etcd.QuotaBackendBytes {
+			fmt.Fprintf(sb, "%s: database is %dMiB, %.0f%% of the %dMiB quota, and %.0f%% fragmented\n", m.Name, s.DBSize>>20, 100*float64(s.DBSize)/etcd.QuotaBackendBytes, etcd.QuotaBackendBytes>>20, 100*s.Fragmentation())
+		}
+	}
+
+	alarms, err := etcdcli.Alarms(ctx, members[0])
+	if err != nil {
+		return err
+	}
+
+	for _, a := range alarms {
+		fmt.Fprintf(sb, "member %x: %s alarm raised\n", a.MemberID, a.Alarm)
+	}
+
+	if sb.Len() > 0 {
+		r.log.Warn(sb.String())
+		cond.Status = corev1.ConditionFalse
+		cond.Reason = "CheckFailed"
+		cond.Message = sb.String()
+	}
+
+	return controllers.SetCondition(ctx, r.arocli, r.recorder, cond, r.role)
+}
@@ -0,0 +1,110 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	"github.com/Azure/ARO-RP/pkg/util/etcd"
+	mock_etcd "github.com/Azure/ARO-RP/pkg/util/mocks/etcd"
+)
+
+func TestEtcdCheckerCheck(t *testing.T) {
+	ctx := context.Background()
+
+	members := []etcd.Member{
+		{Name: "etcd-master-0", Endpoint: "https://10.0.0.6:2379"},
+		{Name: "etcd-master-1", Endpoint: "https://10.0.0.7:2379"},
+	}
+
+	status := func(dbSize, dbSizeInUse int64) *etcd.Status {
+		return &etcd.Status{DBSize: dbSize, DBSizeInUse: dbSizeInUse}
+	}
+
+	for _, tt := range []struct {
+		name        string
+		statuses    []*etcd.Status
+		alarms      []etcd.Alarm
+		wantStatus  corev1.ConditionStatus
+		wantMessage string
+	}{
+		{
+			name:        "within quota",
+			statuses:    []*etcd.Status{status(100<<20, 80<<20), status(6<<30, 1<<30)},
+			wantStatus:  corev1.ConditionTrue,
+			wantMessage: "etcd databases are within quota",
+		},
+		{
+			name:        "close to quota",
+			statuses:    []*etcd.Status{status(100<<20, 80<<20), status(7<<30, 7<<29)},
+			wantStatus:  corev1.ConditionFalse,
+			wantMessage: "etcd-master-1: database is 7168MiB, 88% of the 8192MiB quota, and 50% fragmented\n",
+		},
+		{
+			name:        "alarm raised",
+			statuses:    []*etcd.Status{status(100<<20, 80<<20), status(100<<20, 80<<20)},
+			alarms:      []etcd.Alarm{{MemberID: 0xabc, Alarm: etcd.AlarmNoSpace}},
+			wantStatus:  corev1.ConditionFalse,
+			wantMessage: "member abc: NOSPACE alarm raised\n",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			etcdcli := mock_etcd.NewMockClient(controller)
+			etcdcli.EXPECT().Members(gomock.Any()).Return(members, nil)
+			for i, m := range members {
+				etcdcli.EXPECT().Status(gomock.Any(), m).Return(tt.statuses[i], nil)
+			}
+			etcdcli.EXPECT().Alarms(gomock.Any(), members[0]).Return(tt.alarms, nil)
+
+			arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+			})
+
+			r := &EtcdChecker{
+				arocli: arocli.AroV1alpha1(),
+				log:    logrus.NewEntry(logrus.StandardLogger()),
+				role:   operator.RoleMaster,
+				newEtcdClient: func(context.Context, kubernetes.Interface) (etcd.Client, error) {
+					return etcdcli, nil
+				},
+			}
+
+			err := r.Check(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cluster, err := arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cond := cluster.Status.Conditions.GetCondition(arov1alpha1.EtcdSpaceAvailable)
+			if cond == nil {
+				t.Fatal("condition not set")
+			}
+			if cond.Status != tt.wantStatus {
+				t.Error(cond.Status)
+			}
+			if cond.Message != tt.wantMessage {
+				t.Error(cond.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	uuid "github.com/satori/go.uuid"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/genevalogging"
+)
+
+const genevaLoggingNamespace = "openshift-azure-logging"
+
+// pipelineMetrics holds the counters served by the fluent-bit HTTP server at
+// /api/v1/metrics, keyed by plugin instance name
+type pipelineMetrics struct {
+	Input  map[string]pipelineInputMetrics  `json:"input"`
+	Filter map[string]pipelineFilterMetrics `json:"filter"`
+	Output map[string]pipelineOutputMetrics `json:"output"`
+}
+
+type pipelineInputMetrics struct {
+	Records int64 `json:"records"`
+}
+
+type pipelineFilterMetrics struct {
+	DropRecords int64 `json:"drop_records"`
+	AddRecords  int64 `json:"add_records"`
+}
+
+type pipelineOutputMetrics struct {
+	ProcRecords   int64 `json:"proc_records"`
+	RetriesFailed int64 `json:"retries_failed"`
+}
+
+// read returns the number of records read by the pipeline
+func (m *pipelineMetrics) read() (n int64) {
+	for _, i := range m.Input {
+		n += i.Records
+	}
+	return
+}
+
+// done returns the number of records the pipeline has finished with: those
+// forwarded to mdsd plus those deliberately dropped by its filters
+func (m *pipelineMetrics) done() (n int64) {
+	for _, f := range m.Filter {
+		n += f.DropRecords - f.AddRecords
+	}
+	for _, o := range m.Output {
+		n += o.ProcRecords
+	}
+	return
+}
+
+// retriesFailed returns the number of chunks the pipeline gave up forwarding
+// to mdsd
+func (m *pipelineMetrics) retriesFailed() (n int64) {
+	for _, o := range m.Output {
+		n += o.RetriesFailed
+	}
+	return
+}
+
+// GenevaLoggingChecker verifies that the logging pipeline on the master
+// operator's node forwards records to mdsd.  It logs a check record carrying
+// a nonce and waits for the pipeline's counters to show that everything read
+// up to and including that record was forwarded.  The counters can't
+// identify the record itself, so the nonce is also put in the condition
+// message, from where it can be looked up in Geneva.
+type GenevaLoggingChecker struct {
+	kubernetescli kubernetes.Interface
+	arocli        aroclient.AroV1alpha1Interface
+	recorder      record.EventRecorder
+	log           *logrus.Entry
+	role          string
+
+	podName      string
+	pollInterval time.Duration
+	timeout      time.Duration
+
+	metrics func(ctx context.Context, pod *corev1.Pod) (*pipelineMetrics, error)
+}
+
+func NewGenevaLoggingChecker(log *logrus.Entry, kubernetescli kubernetes.Interface, arocli aroclient.AroV1alpha1Interface, recorder record.EventRecorder, role string) *GenevaLoggingChecker {
+	// the hostname of a pod is its name
+	podName, err := os.Hostname()
+	if err != nil {
+		log.Error(err)
+	}
+
+	return &GenevaLoggingChecker{
+		kubernetescli: kubernetescli,
+		arocli:        arocli,
+		recorder:      recorder,
+		log:           log,
+		role:          role,
+
+		podName:      podName,
+		pollInterval: 5 * time.Second,
+		timeout:      2 * time.Minute,
+
+		metrics: getPipelineMetrics,
+	}
+}
+
+func (r *GenevaLoggingChecker) Name() string {
+	return "GenevaLoggingChecker"
+}
+
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list
+
+// Check sets the GenevaLoggingHealthy condition according to whether the
+// check record made it through the pipeline
+func (r *GenevaLoggingChecker) Check(ctx context.Context) error {
+	cond := &status.Condition{
+		Type:   arov1alpha1.GenevaLoggingHealthy,
+		Status: corev1.ConditionTrue,
+		Reason: "CheckDone",
+	}
+
+	nonce := uuid.NewV4().String()
+
+	message, err := r.checkPipeline(ctx, nonce)
+	if err != nil {
+		return err
+	}
+
+	if message != "" {
+		r.log.Warn(message)
+		cond.Status = corev1.ConditionFalse
+		cond.Reason = "CheckFailed"
+		cond.Message = message
+	} else {
+		cond.Message = fmt.Sprintf("check record %s forwarded to mdsd", nonce)
+	}
+
+	return controllers.SetCondition(ctx, r.arocli, r.recorder, cond, r.role)
+}
+
+// checkPipeline returns a message describing why the pipeline is broken, or
+// an empty string if the check record was forwarded
+func (r *GenevaLoggingChecker) checkPipeline(ctx context.Context, nonce string) (string, error) {
+	pod, err := r.mdsdPod(ctx)
+	if err != nil {
+		return "", err
+	}
+	if pod == nil {
+		return "no running mdsd pod on the master operator's node", nil
+	}
+
+	before, err := r.metrics(ctx, pod)
+	if err != nil {
+		return fmt.Sprintf("%s: pipeline metrics unavailable: %v", pod.Name, err), nil
+	}
+
+	r.log.WithField("nonce", nonce).Info("logging pipeline check record")
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var message string
+	target := int64(-1) // number of records read once our record was read
+	err = wait.PollImmediateUntil(r.pollInterval, func() (bool, error) {
+		m, err := r.metrics(timeoutCtx, pod)
+		if err != nil {
+			message = fmt.Sprintf("%s: pipeline metrics unavailable: %v", pod.Name, err)
+			return false, nil
+		}
+
+		if m.retriesFailed() > before.retriesFailed() {
+			message = fmt.Sprintf("%s: pipeline dropped records it failed to forward to mdsd", pod.Name)
+			return true, nil
+		}
+
+		if target == -1 {
+			if m.read() <= before.read() {
+				message = fmt.Sprintf("%s: check record %s not read by the pipeline within %s", pod.Name, nonce, r.timeout)
+				return false, nil
+			}
+			target = m.read()
+		}
+
+		message = fmt.Sprintf("%s: check record %s not forwarded to mdsd within %s", pod.Name, nonce, r.timeout)
+		if m.done() < target {
+			return false, nil
+		}
+
+		message = ""
+		return true, nil
+	}, timeoutCtx.Done())
+	if err != nil && err != wait.ErrWaitTimeout {
+		return "", err
+	}
+
+	return message, nil
+}
+
+// mdsdPod returns the running mdsd pod on the node of the master operator,
+// whose logs carry the check record, or nil if there is none
+func (r *GenevaLoggingChecker) mdsdPod(ctx context.Context) (*corev1.Pod, error) {
+	self, err := r.kubernetescli.CoreV1().Pods(operator.Namespace).Get(ctx, r.podName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := r.kubernetescli.CoreV1().Pods(genevaLoggingNamespace).List(ctx, metav1.ListOptions{
+		LabelSelector: "app=mdsd",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Spec.NodeName == self.Spec.NodeName &&
+			pod.Status.Phase == corev1.PodRunning &&
+			pod.Status.PodIP != "" {
+			return pod, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func getPipelineMetrics(ctx context.Context, pod *corev1.Pod) (*pipelineMetrics, error) {
+	u := "http://" + net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(genevalogging.PipelineMetricsPort)) + "/api/v1/metrics"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &http.Client{
+		Timeout: 10 * time.Second,
+	}
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	var m *pipelineMetrics
+	err = json.NewDecoder(resp.Body).Decode(&m)
+	return m, err
+}
@@ -0,0 +1,223 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+)
+
+func TestPipelineMetrics(t *testing.T) {
+	// as served by fluent-bit for the containers pipeline
+	body := `{
+	"input": {"tail.0": {"records": 120, "bytes": 51200, "files_opened": 40, "files_closed": 2, "files_rotated": 0}},
+	"filter": {"parser.0": {"drop_records": 0, "add_records": 0}, "grep.1": {"drop_records": 20, "add_records": 0}},
+	"output": {"forward.0": {"proc_records": 95, "proc_bytes": 40960, "errors": 0, "retries": 3, "retries_failed": 1}}
+}`
+
+	var m *pipelineMetrics
+	err := json.Unmarshal([]byte(body), &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.read() != 120 {
+		t.Error(m.read())
+	}
+	if m.done() != 115 {
+		t.Error(m.done())
+	}
+	if m.retriesFailed() != 1 {
+		t.Error(m.retriesFailed())
+	}
+}
+
+func TestGenevaLoggingCheckerCheckPipeline(t *testing.T) {
+	ctx := context.Background()
+
+	metrics := func(read, forwarded, dropped, retriesFailed int64) *pipelineMetrics {
+		return &pipelineMetrics{
+			Input: map[string]pipelineInputMetrics{
+				"tail.0": {Records: read},
+			},
+			Filter: map[string]pipelineFilterMetrics{
+				"grep.1": {DropRecords: dropped},
+			},
+			Output: map[string]pipelineOutputMetrics{
+				"forward.0": {ProcRecords: forwarded, RetriesFailed: retriesFailed},
+			},
+		}
+	}
+
+	for _, tt := range []struct {
+		name        string
+		nodeName    string
+		metrics     []*pipelineMetrics
+		metricsErr  error
+		wantMessage string
+	}{
+		{
+			name:     "record forwarded",
+			nodeName: "master-0",
+			metrics: []*pipelineMetrics{
+				metrics(100, 90, 10, 0),
+				metrics(100, 90, 10, 0),
+				metrics(105, 92, 10, 0),
+				metrics(107, 96, 11, 0),
+			},
+		},
+		{
+			name:        "no mdsd pod on node",
+			nodeName:    "master-1",
+			wantMessage: "no running mdsd pod on the master operator's node",
+		},
+		{
+			name:        "metrics unavailable",
+			nodeName:    "master-0",
+			metricsErr:  errors.New("connection refused"),
+			wantMessage: "mdsd-master-0: pipeline metrics unavailable: connection refused",
+		},
+		{
+			name:     "record not read",
+			nodeName: "master-0",
+			metrics: []*pipelineMetrics{
+				metrics(100, 90, 10, 0),
+			},
+			wantMessage: "mdsd-master-0: check record nonce not read by the pipeline within 50ms",
+		},
+		{
+			name:     "record not forwarded",
+			nodeName: "master-0",
+			metrics: []*pipelineMetrics{
+				metrics(100, 90, 10, 0),
+				metrics(105, 90, 10, 0),
+			},
+			wantMessage: "mdsd-master-0: check record nonce not forwarded to mdsd within 50ms",
+		},
+		{
+			name:     "retries failed",
+			nodeName: "master-0",
+			metrics: []*pipelineMetrics{
+				metrics(100, 90, 10, 0),
+				metrics(105, 90, 10, 1),
+			},
+			wantMessage: "mdsd-master-0: pipeline dropped records it failed to forward to mdsd",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int
+
+			r := &GenevaLoggingChecker{
+				kubernetescli: fake.NewSimpleClientset(
+					&corev1.Pod{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: operator.Namespace,
+							Name:      "aro-operator-master-0",
+						},
+						Spec: corev1.PodSpec{
+							NodeName: tt.nodeName,
+						},
+					},
+					&corev1.Pod{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: genevaLoggingNamespace,
+							Name:      "mdsd-master-0",
+							Labels:    map[string]string{"app": "mdsd"},
+						},
+						Spec: corev1.PodSpec{
+							NodeName: "master-0",
+						},
+						Status: corev1.PodStatus{
+							Phase: corev1.PodRunning,
+							PodIP: "10.128.0.10",
+						},
+					},
+				),
+				log:          logrus.NewEntry(logrus.StandardLogger()),
+				podName:      "aro-operator-master-0",
+				pollInterval: time.Millisecond,
+				timeout:      50 * time.Millisecond,
+				metrics: func(ctx context.Context, pod *corev1.Pod) (*pipelineMetrics, error) {
+					if tt.metricsErr != nil {
+						return nil, tt.metricsErr
+					}
+
+					// keep returning the last counters once they run out
+					m := tt.metrics[len(tt.metrics)-1]
+					if calls < len(tt.metrics) {
+						m = tt.metrics[calls]
+					}
+					calls++
+
+					return m, nil
+				},
+			}
+
+			message, err := r.checkPipeline(ctx, "nonce")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if message != tt.wantMessage {
+				t.Error(message)
+			}
+		})
+	}
+}
+
+func TestGenevaLoggingCheckerCheck(t *testing.T) {
+	ctx := context.Background()
+
+	arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: arov1alpha1.SingletonClusterName,
+		},
+	})
+
+	r := &GenevaLoggingChecker{
+		kubernetescli: fake.NewSimpleClientset(&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: operator.Namespace,
+				Name:      "aro-operator-master-0",
+			},
+		}),
+		arocli:  arocli.AroV1alpha1(),
+		log:     logrus.NewEntry(logrus.StandardLogger()),
+		role:    operator.RoleMaster,
+		podName: "aro-operator-master-0",
+	}
+
+	err := r.Check(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cluster, err := arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cond := cluster.Status.Conditions.GetCondition(arov1alpha1.GenevaLoggingHealthy)
+	if cond == nil {
+		t.Fatal("condition not set")
+	}
+	if cond.Status != corev1.ConditionFalse {
+		t.Error(cond.Status)
+	}
+	if cond.Message != "no running mdsd pod on the master operator's node" {
+		t.Error(cond.Message)
+	}
+}
@@ -0,0 +1,179 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	configclient "github.com/openshift/client-go/config/clientset/versioned"
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+)
+
+// releaseImageRepository is where the release images are pulled from.  The
+// nodes pull them by their quay.io references, which are mirrored to the ACR,
+// so the registry policy applies to both.
+const releaseImageRepository = "quay.io/openshift-release-dev"
+
+// ImagePolicyChecker looks for a cluster-wide image policy which blocks the
+// registries which ARO needs.  The policy only takes effect on the nodes as
+// the machine config operator rolls it out, so it otherwise shows up as image
+// pulls failing after nodes reboot.
+type ImagePolicyChecker struct {
+	configcli configclient.Interface
+	arocli    aroclient.AroV1alpha1Interface
+	recorder  record.EventRecorder
+	log       *logrus.Entry
+	role      string
+}
+
+func NewImagePolicyChecker(log *logrus.Entry, configcli configclient.Interface, arocli aroclient.AroV1alpha1Interface, recorder record.EventRecorder, role string) *ImagePolicyChecker {
+	return &ImagePolicyChecker{
+		configcli: configcli,
+		arocli:    arocli,
+		recorder:  recorder,
+		log:       log,
+		role:      role,
+	}
+}
+
+func (r *ImagePolicyChecker) Name() string {
+	return "ImagePolicyChecker"
+}
+
+// +kubebuilder:rbac:groups=config.openshift.io,resources=images,verbs=get
+
+// Check sets the ImagePolicyValid condition to False if the registry sources
+// of the cluster image configuration block, fail to allow or mark as insecure
+// a registry which ARO needs
+func (r *ImagePolicyChecker) Check(ctx context.Context) error {
+	instance, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	cond := &status.Condition{
+		Type:    arov1alpha1.ImagePolicyValid,
+		Status:  corev1.ConditionTrue,
+		Message: "image policy allows the required registries",
+		Reason:  "CheckDone",
+	}
+
+	image, err := r.configcli.ConfigV1().Images().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	switch {
+	case kerrors.IsNotFound(err):
+	case err != nil:
+		return err
+	default:
+		problems := registrySourcesProblems(requiredRegistries(instance), image.Spec.RegistrySources.AllowedRegistries, image.Spec.RegistrySources.BlockedRegistries, image.Spec.RegistrySources.InsecureRegistries)
+		if len(problems) > 0 {
+			for _, problem := range problems {
+				r.log.Warn(problem)
+			}
+
+			cond.Status = corev1.ConditionFalse
+			cond.Reason = "CheckFailed"
+			cond.Message = strings.Join(problems, "\n") + "\n"
+		}
+	}
+
+	return controllers.SetCondition(ctx, r.arocli, r.recorder, cond, r.role)
+}
+
+// requiredRegistries returns the registries, or repositories within them,
+// which the nodes must be able to pull from
+func requiredRegistries(instance *arov1alpha1.Cluster) []string {
+	registries := []string{releaseImageRepository}
+
+	if instance.Spec.ACRDomain != "" {
+		registries = append(registries, instance.Spec.ACRDomain)
+	}
+
+	return registries
+}
+
+// registrySourcesProblems returns how the given registry sources get in the
+// way of pulling from the required registries
+func registrySourcesProblems(required, allowed, blocked, insecure []string) (problems []string) {
+	if len(allowed) > 0 && len(blocked) > 0 {
+		problems = append(problems, "allowedRegistries and blockedRegistries are both set")
+	}
+
+	for _, registry := range required {
+		if len(allowed) > 0 {
+			var found bool
+			for _, entry := range allowed {
+				if registryCovers(entry, registry) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				problems = append(problems, fmt.Sprintf("%s is not in allowedRegistries", registry))
+			}
+		}
+
+		for _, entry := range blocked {
+			if registryOverlaps(entry, registry) {
+				problems = append(problems, fmt.Sprintf("%s is blocked by blockedRegistries entry %s", registry, entry))
+			}
+		}
+
+		for _, entry := range insecure {
+			if registryOverlaps(entry, registry) {
+				problems = append(problems, fmt.Sprintf("%s is made insecure by insecureRegistries entry %s", registry, entry))
+			}
+		}
+	}
+
+	return problems
+}
+
+// splitRegistry splits a registry source entry, e.g. "*.example.com" or
+// "example.com:5000/repository", into its host and repository path
+func splitRegistry(s string) (host, path string) {
+	if i := strings.IndexByte(s, '/'); i != -1 {
+		return strings.ToLower(s[:i]), s[i+1:]
+	}
+
+	return strings.ToLower(s), ""
+}
+
+// registryHostMatches returns true if the host of an entry, which may be a
+// wildcard, matches host
+func registryHostMatches(entryHost, host string) bool {
+	if strings.HasPrefix(entryHost, "*.") {
+		return strings.HasSuffix(host, entryHost[1:])
+	}
+
+	return entryHost == host
+}
+
+// registryCovers returns true if entry applies to all of registry
+func registryCovers(entry, registry string) bool {
+	entryHost, entryPath := splitRegistry(entry)
+	host, path := splitRegistry(registry)
+
+	return registryHostMatches(entryHost, host) &&
+		(entryPath == "" || entryPath == path || strings.HasPrefix(path, entryPath+"/"))
+}
+
+// registryOverlaps returns true if entry applies to any of registry
+func registryOverlaps(entry, registry string) bool {
+	entryHost, entryPath := splitRegistry(entry)
+	host, path := splitRegistry(registry)
+
+	return registryCovers(entry, registry) ||
+		registryHostMatches(entryHost, host) && (path == "" || strings.HasPrefix(entryPath, path+"/"))
+}
@@ -0,0 +1,143 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	configfake "github.com/openshift/client-go/config/clientset/versioned/fake"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+)
+
+func TestImagePolicyCheckerCheck(t *testing.T) {
+	ctx := context.Background()
+
+	image := func(sources configv1.RegistrySources) *configv1.Image {
+		return &configv1.Image{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: arov1alpha1.SingletonClusterName,
+			},
+			Spec: configv1.ImageSpec{
+				RegistrySources: sources,
+			},
+		}
+	}
+
+	for _, tt := range []struct {
+		name        string
+		objects     []runtime.Object
+		wantStatus  corev1.ConditionStatus
+		wantMessage string
+	}{
+		{
+			name:        "no image configuration",
+			wantStatus:  corev1.ConditionTrue,
+			wantMessage: "image policy allows the required registries",
+		},
+		{
+			name: "unrelated registries",
+			objects: []runtime.Object{
+				image(configv1.RegistrySources{
+					BlockedRegistries:  []string{"docker.io", "quay.io/someone"},
+					InsecureRegistries: []string{"registry.example.com"},
+				}),
+			},
+			wantStatus:  corev1.ConditionTrue,
+			wantMessage: "image policy allows the required registries",
+		},
+		{
+			name: "required registries allowed",
+			objects: []runtime.Object{
+				image(configv1.RegistrySources{
+					AllowedRegistries: []string{"registry.example.com", "Quay.io/openshift-release-dev", "*.azurecr.io"},
+				}),
+			},
+			wantStatus:  corev1.ConditionTrue,
+			wantMessage: "image policy allows the required registries",
+		},
+		{
+			name: "required registries blocked",
+			objects: []runtime.Object{
+				image(configv1.RegistrySources{
+					BlockedRegistries: []string{"quay.io/openshift-release-dev/ocp-release", "*.azurecr.io"},
+				}),
+			},
+			wantStatus: corev1.ConditionFalse,
+			wantMessage: "quay.io/openshift-release-dev is blocked by blockedRegistries entry quay.io/openshift-release-dev/ocp-release\n" +
+				"arosvc.azurecr.io is blocked by blockedRegistries entry *.azurecr.io\n",
+		},
+		{
+			name: "required registries not allowed",
+			objects: []runtime.Object{
+				image(configv1.RegistrySources{
+					AllowedRegistries: []string{"quay.io/openshift-release-dev/ocp-release", "arosvc.azurecr.io/openshift-release-dev"},
+				}),
+			},
+			wantStatus: corev1.ConditionFalse,
+			wantMessage: "quay.io/openshift-release-dev is not in allowedRegistries\n" +
+				"arosvc.azurecr.io is not in allowedRegistries\n",
+		},
+		{
+			name: "required registry insecure, both allowed and blocked set",
+			objects: []runtime.Object{
+				image(configv1.RegistrySources{
+					AllowedRegistries:  []string{"quay.io", "arosvc.azurecr.io"},
+					BlockedRegistries:  []string{"docker.io"},
+					InsecureRegistries: []string{"arosvc.azurecr.io"},
+				}),
+			},
+			wantStatus: corev1.ConditionFalse,
+			wantMessage: "allowedRegistries and blockedRegistries are both set\n" +
+				"arosvc.azurecr.io is made insecure by insecureRegistries entry arosvc.azurecr.io\n",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					ACRDomain: "arosvc.azurecr.io",
+				},
+			})
+
+			r := &ImagePolicyChecker{
+				configcli: configfake.NewSimpleClientset(tt.objects...),
+				arocli:    arocli.AroV1alpha1(),
+				log:       logrus.NewEntry(logrus.StandardLogger()),
+				role:      operator.RoleMaster,
+			}
+
+			err := r.Check(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cluster, err := arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cond := cluster.Status.Conditions.GetCondition(arov1alpha1.ImagePolicyValid)
+			if cond == nil {
+				t.Fatal("condition not set")
+			}
+			if cond.Status != tt.wantStatus {
+				t.Error(cond.Status)
+			}
+			if cond.Message != tt.wantMessage {
+				t.Error(cond.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+)
+
+// imdsEndpoints are the Azure Instance Metadata Service endpoints which the
+// nodes depend on: the kubelet and the Azure cloud provider read the instance
+// metadata to attach disks and to provision load balancers, and the identity
+// endpoint issues the node's tokens.  IMDS answers the identity endpoint with
+// an error when the VM has no managed identity, which still shows that it is
+// reachable.
+var imdsEndpoints = []string{
+	"http://169.254.169.254/metadata/instance?api-version=2019-03-11",
+	"http://169.254.169.254/metadata/identity/oauth2/token?api-version=2018-02-01&resource=https%3A%2F%2Fmanagement.azure.com%2F",
+}
+
+// IMDSChecker checks that the Azure Instance Metadata Service is reachable,
+// i.e. that it is not blocked by a customer egress firewall or network policy.
+// Its loss breaks disk attach and load balancer provisioning with symptoms
+// which don't point at it.
+type IMDSChecker struct {
+	arocli   aroclient.AroV1alpha1Interface
+	recorder record.EventRecorder
+	log      *logrus.Entry
+	role     string
+
+	client  simpleHTTPClient
+	backoff wait.Backoff
+}
+
+func NewIMDSChecker(log *logrus.Entry, arocli aroclient.AroV1alpha1Interface, recorder record.EventRecorder, role string) *IMDSChecker {
+	return &IMDSChecker{
+		arocli:   arocli,
+		recorder: recorder,
+		log:      log,
+		role:     role,
+
+		// IMDS must not be reached via a proxy
+		client:  &http.Client{Transport: &http.Transport{Proxy: nil}},
+		backoff: checkBackoff,
+	}
+}
+
+func (r *IMDSChecker) Name() string {
+	return "IMDSChecker"
+}
+
+// Check sets the IMDSReachableFrom{Master,Worker} condition to False if an
+// IMDS endpoint can't be reached from the node role which the operator runs
+// on
+func (r *IMDSChecker) Check(ctx context.Context) error {
+	var failures []string
+	for _, url := range imdsEndpoints {
+		err := r.checkWithRetry(url)
+		if err != nil {
+			r.log.Infof("IMDS check failed with error %s", err)
+			failures = append(failures, err.Error())
+		}
+	}
+
+	condition := &status.Condition{
+		Type:    r.conditionType(),
+		Status:  corev1.ConditionTrue,
+		Message: "Instance Metadata Service is reachable",
+		Reason:  "CheckDone",
+	}
+
+	if len(failures) > 0 {
+		condition.Status = corev1.ConditionFalse
+		condition.Message = "Instance Metadata Service is not reachable; check that egress firewalls and network policies allow 169.254.169.254:\n" + strings.Join(failures, "\n")
+		condition.Reason = "CheckFailed"
+	}
+
+	return controllers.SetCondition(ctx, r.arocli, r.recorder, condition, r.role)
+}
+
+// checkWithRetry requests url, retrying a failed request a few times.  Any
+// HTTP response shows that the endpoint is reachable.
+func (r *IMDSChecker) checkWithRetry(url string) error {
+	return retry.OnError(r.backoff, func(_ error) bool { return true }, func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return fmt.Errorf("%s: %s", url, err)
+		}
+		req.Header.Set("Metadata", "true")
+
+		resp, err := r.client.Do(req)
+		if err != nil {
+			return fmt.Errorf("%s: %s", url, err)
+		}
+		defer resp.Body.Close()
+
+		return nil
+	})
+}
+
+func (r *IMDSChecker) conditionType() status.ConditionType {
+	switch r.role {
+	case operator.RoleMaster:
+		return arov1alpha1.IMDSReachableFromMaster
+	default:
+		return arov1alpha1.IMDSReachableFromWorker
+	}
+}
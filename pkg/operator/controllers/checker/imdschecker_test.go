@@ -0,0 +1,95 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+)
+
+func TestIMDSCheckerCheck(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name          string
+		role          string
+		responses     []*fakeResponse
+		wantCondition status.ConditionType
+		wantStatus    corev1.ConditionStatus
+		wantMessage   string
+	}{
+		{
+			name: "reachable from master",
+			role: operator.RoleMaster,
+			// the identity endpoint answers 400 without a managed identity
+			responses:     []*fakeResponse{okResp, badReq},
+			wantCondition: arov1alpha1.IMDSReachableFromMaster,
+			wantStatus:    corev1.ConditionTrue,
+			wantMessage:   "Instance Metadata Service is reachable",
+		},
+		{
+			name:          "eventually reachable from worker",
+			role:          operator.RoleWorker,
+			responses:     []*fakeResponse{timedoutReq, okResp, okResp},
+			wantCondition: arov1alpha1.IMDSReachableFromWorker,
+			wantStatus:    corev1.ConditionTrue,
+			wantMessage:   "Instance Metadata Service is reachable",
+		},
+		{
+			name:          "blocked",
+			role:          operator.RoleWorker,
+			responses:     []*fakeResponse{timedoutReq, timedoutReq, timedoutReq, timedoutReq, timedoutReq, okResp},
+			wantCondition: arov1alpha1.IMDSReachableFromWorker,
+			wantStatus:    corev1.ConditionFalse,
+			wantMessage: "Instance Metadata Service is not reachable; check that egress firewalls and network policies allow 169.254.169.254:\n" +
+				"http://169.254.169.254/metadata/instance?api-version=2019-03-11: context deadline exceeded",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+			})
+
+			r := &IMDSChecker{
+				arocli:  arocli.AroV1alpha1(),
+				log:     utillog.GetLogger(),
+				role:    tt.role,
+				client:  &testClient{responses: tt.responses},
+				backoff: testBackoff,
+			}
+
+			err := r.Check(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cluster, err := arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cond := cluster.Status.Conditions.GetCondition(tt.wantCondition)
+			if cond == nil {
+				t.Fatal("condition not set")
+			}
+			if cond.Status != tt.wantStatus {
+				t.Error(cond.Status)
+			}
+			if cond.Message != tt.wantMessage {
+				t.Error(cond.Message)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sort"
 	"strings"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 
 	"github.com/Azure/ARO-RP/pkg/operator"
@@ -34,16 +36,18 @@ var checkBackoff = wait.Backoff{
 
 // InternetChecker reconciles a Cluster object
 type InternetChecker struct {
-	arocli aroclient.AroV1alpha1Interface
-	log    *logrus.Entry
-	role   string
+	arocli   aroclient.AroV1alpha1Interface
+	recorder record.EventRecorder
+	log      *logrus.Entry
+	role     string
 }
 
-func NewInternetChecker(log *logrus.Entry, arocli aroclient.AroV1alpha1Interface, role string) *InternetChecker {
+func NewInternetChecker(log *logrus.Entry, arocli aroclient.AroV1alpha1Interface, recorder record.EventRecorder, role string) *InternetChecker {
 	return &InternetChecker{
-		arocli: arocli,
-		log:    log,
-		role:   role,
+		arocli:   arocli,
+		recorder: recorder,
+		log:      log,
+		role:     role,
 	}
 }
 
@@ -77,17 +81,24 @@ func (r *InternetChecker) Check(ctx context.Context) error {
 		}(url)
 	}
 
-	sb := &strings.Builder{}
-	checkFailed := false
-
+	var failures []string
 	for i := 0; i < checkCount; i++ {
 		if err = <-ch; err != nil {
 			r.log.Infof("URL check failed with error %s", err)
-			fmt.Fprintf(sb, "%s\n", err)
-			checkFailed = true
+			failures = append(failures, err.Error())
 		}
 	}
 
+	// one "<url>: <error>" line per blocked URL, in a stable order; the RP
+	// reads the blocked URLs back out of this message at install time
+	sort.Strings(failures)
+
+	sb := &strings.Builder{}
+	for _, failure := range failures {
+		fmt.Fprintf(sb, "%s\n", failure)
+	}
+	checkFailed := len(failures) > 0
+
 	var condition *status.Condition
 
 	if checkFailed {
@@ -107,7 +118,7 @@ func (r *InternetChecker) Check(ctx context.Context) error {
 
 	}
 
-	return controllers.SetCondition(ctx, r.arocli, condition, r.role)
+	return controllers.SetCondition(ctx, r.arocli, r.recorder, condition, r.role)
 }
 
 // check the URL, retrying a failed query a few times according to the given backoff
@@ -6,6 +6,8 @@ package checker
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"sort"
 	"strings"
 
 	machinev1beta1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
@@ -14,38 +16,67 @@ import (
 	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
 	azureproviderv1beta1 "sigs.k8s.io/cluster-api-provider-azure/pkg/apis/azureprovider/v1beta1"
 
 	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/api/validate"
+	"github.com/Azure/ARO-RP/pkg/operator"
 	aro "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
 	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers"
 	"github.com/Azure/ARO-RP/pkg/util/deployment"
 	_ "github.com/Azure/ARO-RP/pkg/util/scheme"
+	"github.com/Azure/ARO-RP/pkg/util/trustedlaunch"
 )
 
 const (
 	machineSetsNamespace = "openshift-machine-api"
+	machineRoleLabel     = "machine.openshift.io/cluster-api-machine-role"
 )
 
+// MachineError is a problem with the provider spec of a machine or
+// machineset, with a machine-readable reason
+type MachineError struct {
+	// Prefix names the machine or machineset, e.g. "machine foo"
+	Prefix  string
+	Reason  string
+	Message string
+}
+
+func (err *MachineError) Error() string {
+	return err.Prefix + ": " + err.Message
+}
+
+func machineError(prefix, reason, format string, a ...interface{}) *MachineError {
+	return &MachineError{
+		Prefix:  prefix,
+		Reason:  reason,
+		Message: fmt.Sprintf(format, a...),
+	}
+}
+
 // MachineChecker reconciles the alertmanager webhook
 type MachineChecker struct {
-	clustercli     maoclient.Interface
-	arocli         aroclient.AroV1alpha1Interface
-	log            *logrus.Entry
-	deploymentMode deployment.Mode
-	role           string
+	clustercli   maoclient.Interface
+	arocli       aroclient.AroV1alpha1Interface
+	recorder     record.EventRecorder
+	log          *logrus.Entry
+	capabilities deployment.Capabilities
+	role         string
 }
 
-func NewMachineChecker(log *logrus.Entry, clustercli maoclient.Interface, arocli aroclient.AroV1alpha1Interface, role string, deploymentMode deployment.Mode) *MachineChecker {
+func NewMachineChecker(log *logrus.Entry, clustercli maoclient.Interface, arocli aroclient.AroV1alpha1Interface, recorder record.EventRecorder, role string, capabilities deployment.Capabilities) *MachineChecker {
 	return &MachineChecker{
-		clustercli:     clustercli,
-		arocli:         arocli,
-		log:            log,
-		deploymentMode: deploymentMode,
-		role:           role,
+		clustercli:   clustercli,
+		arocli:       arocli,
+		recorder:     recorder,
+		log:          log,
+		capabilities: capabilities,
+		role:         role,
 	}
 }
 
@@ -64,65 +95,160 @@ func (r *MachineChecker) workerReplicas(ctx context.Context) (int, error) {
 }
 
 func (r *MachineChecker) machineValid(ctx context.Context, machine *machinev1beta1.Machine, isMaster bool) (errs []error) {
-	if machine.Spec.ProviderSpec.Value == nil {
-		return []error{fmt.Errorf("machine %s: provider spec missing", machine.Name)}
-	}
+	_, isWorkerProfile := machine.Labels[operator.WorkerProfileLabel]
+	return ProviderSpecValid(r.capabilities, "machine "+machine.Name, &machine.Spec.ProviderSpec, isMaster, isWorkerProfile)
+}
+
+// machineSetValid validates the provider spec of a machineset which the RP
+// manages on behalf of an additional worker profile, so that problems are
+// reported even before the machineset has any machines
+func (r *MachineChecker) machineSetValid(ctx context.Context, machineset *machinev1beta1.MachineSet) (errs []error) {
+	return ProviderSpecValid(r.capabilities, "machineset "+machineset.Name, &machineset.Spec.Template.Spec.ProviderSpec, false, true)
+}
 
-	o, _, err := scheme.Codecs.UniversalDeserializer().Decode(machine.Spec.ProviderSpec.Value.Raw, nil, nil)
+// ProviderSpecValid validates a machine provider spec and returns a
+// *MachineError for each problem.  arm64 VM sizes are only valid for the
+// machines of additional worker profiles.  It is shared with the machine
+// admission webhook, which rejects the same problems up front.
+func ProviderSpecValid(capabilities deployment.Capabilities, prefix string, providerSpec *machinev1beta1.ProviderSpec, isMaster, isWorkerProfile bool) (errs []error) {
+	machineProviderSpec, err := azureProviderSpec(providerSpec)
 	if err != nil {
-		return []error{err}
+		return []error{machineError(prefix, aro.MachineInvalidProviderSpec, "%v", err)}
 	}
 
-	machineProviderSpec, ok := o.(*azureproviderv1beta1.AzureMachineProviderSpec)
-	if !ok {
-		// This should never happen: codecs uses scheme that has only one registered type
-		// and if something is wrong with the provider spec - decoding should fail
-		return []error{fmt.Errorf("machine %s: failed to read provider spec: %T", machine.Name, o)}
+	profile := validate.InstallProfileFor(capabilities)
+
+	vmSizeIsValid := profile.WorkerVMSizeIsValid
+	if isMaster {
+		vmSizeIsValid = profile.MasterVMSizeIsValid
 	}
 
-	if !validate.VMSizeIsValid(api.VMSize(machineProviderSpec.VMSize), r.deploymentMode, isMaster) {
-		errs = append(errs, fmt.Errorf("machine %s: invalid VM size '%s'", machine.Name, machineProviderSpec.VMSize))
+	isArm64 := isWorkerProfile && profile.AllowArm64 && validate.VMSizeIsArm64(api.VMSize(machineProviderSpec.VMSize))
+
+	if !vmSizeIsValid(api.VMSize(machineProviderSpec.VMSize)) && !isArm64 {
+		errs = append(errs, machineError(prefix, aro.MachineInvalidVMSize, "invalid VM size '%s'", machineProviderSpec.VMSize))
 	}
 
-	if !isMaster && !validate.DiskSizeIsValid(int(machineProviderSpec.OSDisk.DiskSizeGB)) {
-		errs = append(errs, fmt.Errorf("machine %s: invalid disk size '%d'", machine.Name, machineProviderSpec.OSDisk.DiskSizeGB))
+	if !isMaster && !profile.DiskSizeIsValid(int(machineProviderSpec.OSDisk.DiskSizeGB)) {
+		errs = append(errs, machineError(prefix, aro.MachineInvalidDiskSize, "invalid disk size '%d'", machineProviderSpec.OSDisk.DiskSizeGB))
 	}
 
 	// to begin with, just check that the image publisher and offer are correct
 	if machineProviderSpec.Image.Publisher != "azureopenshift" || machineProviderSpec.Image.Offer != "aro4" {
-		errs = append(errs, fmt.Errorf("machine %s: invalid image '%v'", machine.Name, machineProviderSpec.Image))
+		errs = append(errs, machineError(prefix, aro.MachineInvalidImage, "invalid image '%v'", machineProviderSpec.Image))
+	}
+
+	// arm64 and amd64 boot images are published under separate SKUs, and a
+	// machine booted from the wrong one never joins the cluster
+	if strings.HasSuffix(machineProviderSpec.Image.SKU, "_arm64") != isArm64 {
+		errs = append(errs, machineError(prefix, aro.MachineInvalidImage, "image SKU '%s' does not match VM size '%s'", machineProviderSpec.Image.SKU, machineProviderSpec.VMSize))
+	}
+
+	// the security profile is read from the raw provider spec, as the vendored
+	// AzureMachineProviderSpec predates trusted launch
+	sp, err := trustedlaunch.GetSecurityProfile(providerSpec.Value.Raw)
+	if err != nil {
+		return append(errs, machineError(prefix, aro.MachineInvalidProviderSpec, "%v", err))
+	}
+
+	if sp.IsTrustedLaunch() {
+		if !sp.SecureBootEnabled() || !sp.VTPMEnabled() {
+			errs = append(errs, machineError(prefix, aro.MachineInvalidSecurityProfile, "trusted launch requires secure boot and vTPM to be enabled"))
+		}
+
+		if !validate.VMSizeSupportsTrustedLaunch(api.VMSize(machineProviderSpec.VMSize)) {
+			errs = append(errs, machineError(prefix, aro.MachineInvalidSecurityProfile, "VM size '%s' does not support trusted launch", machineProviderSpec.VMSize))
+		}
+	}
+
+	// trusted launch machines need the Gen2 variant of the boot image
+	if strings.HasSuffix(machineProviderSpec.Image.SKU, trustedlaunch.BootImageSKUSuffix) != sp.IsTrustedLaunch() {
+		errs = append(errs, machineError(prefix, aro.MachineInvalidImage, "image SKU '%s' does not match security type", machineProviderSpec.Image.SKU))
 	}
 
 	if machineProviderSpec.ManagedIdentity != "" {
-		errs = append(errs, fmt.Errorf("machine %s: invalid managedIdentity '%s'", machine.Name, machineProviderSpec.ManagedIdentity))
+		errs = append(errs, machineError(prefix, aro.MachineInvalidManagedIdentity, "invalid managedIdentity '%s'", machineProviderSpec.ManagedIdentity))
 	}
 
 	return errs
 }
 
-func (r *MachineChecker) checkMachines(ctx context.Context) (errs []error) {
+// azureProviderSpec decodes a machine provider spec
+func azureProviderSpec(providerSpec *machinev1beta1.ProviderSpec) (*azureproviderv1beta1.AzureMachineProviderSpec, error) {
+	if providerSpec.Value == nil {
+		return nil, fmt.Errorf("provider spec missing")
+	}
+
+	o, _, err := scheme.Codecs.UniversalDeserializer().Decode(providerSpec.Value.Raw, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	machineProviderSpec, ok := o.(*azureproviderv1beta1.AzureMachineProviderSpec)
+	if !ok {
+		// This should never happen: codecs uses scheme that has only one registered type
+		// and if something is wrong with the provider spec - decoding should fail
+		return nil, fmt.Errorf("failed to read provider spec: %T", o)
+	}
+
+	return machineProviderSpec, nil
+}
+
+func (r *MachineChecker) checkMachineSets(ctx context.Context, previous map[string][]aro.MachineCheckFailure) (errs []error, statuses []aro.MachineCheckStatus) {
+	machinesets, err := r.clustercli.MachineV1beta1().MachineSets(machineSetsNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return []error{err}, nil
+	}
+
+	for _, machineset := range machinesets.Items {
+		if _, ok := machineset.Labels[operator.WorkerProfileLabel]; !ok {
+			continue
+		}
+
+		machinesetErrs := r.machineSetValid(ctx, &machineset)
+		errs = append(errs, machinesetErrs...)
+
+		if len(machinesetErrs) > 0 {
+			statuses = append(statuses, r.machineCheckStatus(&machineset, "MachineSet", machineset.Name, machineset.Spec.Template.Labels[machineRoleLabel], machinesetErrs, previous))
+		}
+	}
+
+	return errs, statuses
+}
+
+func (r *MachineChecker) checkMachines(ctx context.Context, previous map[string][]aro.MachineCheckFailure) (errs []error, statuses []aro.MachineCheckStatus) {
 	actualWorkers := 0
 	actualMasters := 0
 
 	expectedMasters := 3
 	expectedWorkers, err := r.workerReplicas(ctx)
 	if err != nil {
-		return []error{err}
+		return []error{err}, nil
 	}
 
 	machines, err := r.clustercli.MachineV1beta1().Machines(machineSetsNamespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
-		return []error{err}
+		return []error{err}, nil
 	}
 
+	machineCheckFailures.Reset()
+
 	for _, machine := range machines.Items {
 		isMaster, err := isMasterRole(&machine)
 		if err != nil {
 			errs = append(errs, err)
+			statuses = append(statuses, r.machineCheckStatus(&machine, "Machine", machine.Name, "", []error{err}, previous))
+			machineCheckFailures.WithLabelValues(machine.Name).Set(1)
 			continue
 		}
 
-		errs = append(errs, r.machineValid(ctx, &machine, isMaster)...)
+		machineErrs := r.machineValid(ctx, &machine, isMaster)
+		errs = append(errs, machineErrs...)
+		machineCheckFailures.WithLabelValues(machine.Name).Set(float64(len(machineErrs)))
+
+		if len(machineErrs) > 0 {
+			statuses = append(statuses, r.machineCheckStatus(&machine, "Machine", machine.Name, machine.Labels[machineRoleLabel], machineErrs, previous))
+		}
 
 		if isMaster {
 			actualMasters++
@@ -139,14 +265,131 @@ func (r *MachineChecker) checkMachines(ctx context.Context) (errs []error) {
 		errs = append(errs, fmt.Errorf("invalid number of worker machines %d, expected %d", actualWorkers, expectedWorkers))
 	}
 
-	return errs
+	return errs, statuses
+}
+
+// machineCheckStatus returns the status of a machine or machineset which
+// failed the check.  An event is recorded on it for each failure, unless its
+// failures are the same as in the previous status, so that a machine which
+// stays invalid doesn't collect the same events on every check.
+func (r *MachineChecker) machineCheckStatus(obj runtime.Object, kind, name, role string, errs []error, previous map[string][]aro.MachineCheckFailure) aro.MachineCheckStatus {
+	s := aro.MachineCheckStatus{
+		Kind: kind,
+		Name: name,
+		Role: role,
+	}
+
+	for _, err := range errs {
+		failure := aro.MachineCheckFailure{
+			Reason:  aro.MachineInvalidProviderSpec,
+			Message: err.Error(),
+		}
+
+		if err, ok := err.(*MachineError); ok {
+			failure.Reason = err.Reason
+			failure.Message = err.Message
+		}
+
+		s.Failures = append(s.Failures, failure)
+	}
+
+	if r.recorder != nil && !reflect.DeepEqual(previous[machineCheckKey(kind, name)], s.Failures) {
+		for _, failure := range s.Failures {
+			r.recorder.Event(obj, corev1.EventTypeWarning, failure.Reason, failure.Message)
+		}
+	}
+
+	return s
+}
+
+func machineCheckKey(kind, name string) string {
+	return kind + "/" + name
+}
+
+// previousMachineChecks returns the failures of each machine and machineset
+// in the MachineChecks status of the previous check
+func (r *MachineChecker) previousMachineChecks(ctx context.Context) (map[string][]aro.MachineCheckFailure, error) {
+	cluster, err := r.arocli.Clusters().Get(ctx, aro.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	previous := map[string][]aro.MachineCheckFailure{}
+	for _, s := range cluster.Status.MachineChecks {
+		previous[machineCheckKey(s.Kind, s.Name)] = s.Failures
+	}
+
+	return previous, nil
+}
+
+// machineCheckSummary returns the message of the MachineValid condition: the
+// number of invalid machines and machinesets by failure reason, which are
+// detailed in the MachineChecks status, followed by the errors which don't
+// concern a single machine or machineset
+func machineCheckSummary(errs []error, statuses []aro.MachineCheckStatus) string {
+	var sb strings.Builder
+
+	if len(statuses) > 0 {
+		counts := map[string]int{}
+		for _, s := range statuses {
+			reasons := map[string]struct{}{}
+			for _, failure := range s.Failures {
+				reasons[failure.Reason] = struct{}{}
+			}
+			for reason := range reasons {
+				counts[reason]++
+			}
+		}
+
+		reasons := make([]string, 0, len(counts))
+		for reason, count := range counts {
+			reasons = append(reasons, fmt.Sprintf("%s: %d", reason, count))
+		}
+		sort.Strings(reasons)
+
+		fmt.Fprintf(&sb, "invalid machines and machinesets: %d (%s), see status.machineChecks\n", len(statuses), strings.Join(reasons, ", "))
+	}
+
+	for _, err := range errs {
+		if _, ok := err.(*MachineError); ok {
+			continue
+		}
+		sb.WriteString(err.Error())
+		sb.WriteByte('\n')
+	}
+
+	return sb.String()
+}
+
+// setMachineChecks records the machines and machinesets which failed the
+// check on the cluster status
+func (r *MachineChecker) setMachineChecks(ctx context.Context, statuses []aro.MachineCheckStatus) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster, err := r.arocli.Clusters().Get(ctx, aro.SingletonClusterName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		// only update the status when it changes, otherwise every status
+		// update would trigger another reconcile
+		if reflect.DeepEqual(cluster.Status.MachineChecks, statuses) {
+			return nil
+		}
+
+		cluster.Status.MachineChecks = statuses
+
+		_, err = r.arocli.Clusters().UpdateStatus(ctx, cluster, metav1.UpdateOptions{})
+		return err
+	})
 }
 
 func (r *MachineChecker) Name() string {
 	return "MachineChecker"
 }
 
-// Reconcile makes sure that the Machines are in a supportable state
+// Reconcile makes sure that the Machines are in a supportable state.  The
+// MachineValid condition summarises the problems found, and the MachineChecks
+// status details them per machine and machineset.
 func (r *MachineChecker) Check(ctx context.Context) error {
 	cond := &status.Condition{
 		Type:    aro.MachineValid,
@@ -155,26 +398,33 @@ func (r *MachineChecker) Check(ctx context.Context) error {
 		Reason:  "CheckDone",
 	}
 
-	errs := r.checkMachines(ctx)
+	previous, err := r.previousMachineChecks(ctx)
+	if err != nil {
+		return err
+	}
+
+	errs, statuses := r.checkMachines(ctx, previous)
+	machinesetErrs, machinesetStatuses := r.checkMachineSets(ctx, previous)
+	errs = append(errs, machinesetErrs...)
+	statuses = append(statuses, machinesetStatuses...)
+
+	err = r.setMachineChecks(ctx, statuses)
+	if err != nil {
+		return err
+	}
 	if len(errs) > 0 {
 		cond.Status = corev1.ConditionFalse
 		cond.Reason = "CheckFailed"
-
-		var sb strings.Builder
-		for _, err := range errs {
-			sb.WriteString(err.Error())
-			sb.WriteByte('\n')
-		}
-		cond.Message = sb.String()
+		cond.Message = machineCheckSummary(errs, statuses)
 	}
 
-	return controllers.SetCondition(ctx, r.arocli, cond, r.role)
+	return controllers.SetCondition(ctx, r.arocli, r.recorder, cond, r.role)
 }
 
 func isMasterRole(m *machinev1beta1.Machine) (bool, error) {
-	role, ok := m.Labels["machine.openshift.io/cluster-api-machine-role"]
+	role, ok := m.Labels[machineRoleLabel]
 	if !ok {
-		return false, fmt.Errorf("machine %s: cluster-api-machine-role label not found", m.Name)
+		return false, machineError("machine "+m.Name, aro.MachineMissingRoleLabel, "cluster-api-machine-role label not found")
 	}
 	return role == "master", nil
 }
@@ -10,13 +10,25 @@ import (
 	"testing"
 
 	machinev1beta1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
+	maofake "github.com/openshift/machine-api-operator/pkg/generated/clientset/versioned/fake"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	"github.com/Azure/ARO-RP/pkg/util/deployment"
 )
 
 func TestMachineValid(t *testing.T) {
 	ctx := context.Background()
 
+	capabilities, err := deployment.NewCapabilities(deployment.Production)
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	tests := []struct {
 		name     string
 		machine  *machinev1beta1.Machine
@@ -88,7 +100,11 @@ func TestMachineValid(t *testing.T) {
 				},
 			},
 			wantErrs: []error{
-				errors.New("machine foo-hx8z7-master-0: invalid VM size 'Standard_D2s_V3'"),
+				&MachineError{
+					Prefix:  "machine foo-hx8z7-master-0",
+					Reason:  arov1alpha1.MachineInvalidVMSize,
+					Message: "invalid VM size 'Standard_D2s_V3'",
+				},
 			},
 		},
 		{
@@ -124,7 +140,11 @@ func TestMachineValid(t *testing.T) {
 				},
 			},
 			wantErrs: []error{
-				errors.New("machine foo-hx8z7-master-0: invalid disk size '64'"),
+				&MachineError{
+					Prefix:  "machine foo-hx8z7-master-0",
+					Reason:  arov1alpha1.MachineInvalidDiskSize,
+					Message: "invalid disk size '64'",
+				},
 			},
 		},
 		{
@@ -160,13 +180,200 @@ func TestMachineValid(t *testing.T) {
 				},
 			},
 			wantErrs: []error{
-				errors.New("machine foo-hx8z7-master-0: invalid image '{xyzcorp bananas   }'"),
+				&MachineError{
+					Prefix:  "machine foo-hx8z7-master-0",
+					Reason:  arov1alpha1.MachineInvalidImage,
+					Message: "invalid image '{xyzcorp bananas   }'",
+				},
+			},
+		},
+		{
+			name: "arm64 worker profile",
+			machine: &machinev1beta1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo-hx8z7-arm-eastus1-abcde",
+					Namespace: machineSetsNamespace,
+					Labels:    map[string]string{"machine.openshift.io/cluster-api-machine-role": "worker", operator.WorkerProfileLabel: "arm"},
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							Kind: "MachineSet",
+						},
+					},
+				},
+				Spec: machinev1beta1.MachineSpec{
+					ProviderSpec: machinev1beta1.ProviderSpec{
+						Value: &runtime.RawExtension{
+							Raw: []byte(`{
+"apiVersion": "azureproviderconfig.openshift.io/v1beta1",
+"kind": "AzureMachineProviderSpec",
+"osDisk": {
+"diskSizeGB": 128
+},
+"image": {
+"publisher": "azureopenshift",
+"offer": "aro4",
+"sku": "aro_45_arm64"
+},
+"vmSize": "Standard_D4ps_v5"
+}`),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "arm64 outside worker profile",
+			machine: &machinev1beta1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo-hx8z7-arm-eastus1-abcde",
+					Namespace: machineSetsNamespace,
+					Labels:    map[string]string{"machine.openshift.io/cluster-api-machine-role": "worker"},
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							Kind: "MachineSet",
+						},
+					},
+				},
+				Spec: machinev1beta1.MachineSpec{
+					ProviderSpec: machinev1beta1.ProviderSpec{
+						Value: &runtime.RawExtension{
+							Raw: []byte(`{
+"apiVersion": "azureproviderconfig.openshift.io/v1beta1",
+"kind": "AzureMachineProviderSpec",
+"osDisk": {
+"diskSizeGB": 128
+},
+"image": {
+"publisher": "azureopenshift",
+"offer": "aro4",
+"sku": "aro_45_arm64"
+},
+"vmSize": "Standard_D4ps_v5"
+}`),
+						},
+					},
+				},
+			},
+			wantErrs: []error{
+				&MachineError{
+					Prefix:  "machine foo-hx8z7-arm-eastus1-abcde",
+					Reason:  arov1alpha1.MachineInvalidVMSize,
+					Message: "invalid VM size 'Standard_D4ps_v5'",
+				},
+				&MachineError{
+					Prefix:  "machine foo-hx8z7-arm-eastus1-abcde",
+					Reason:  arov1alpha1.MachineInvalidImage,
+					Message: "image SKU 'aro_45_arm64' does not match VM size 'Standard_D4ps_v5'",
+				},
+			},
+		},
+		{
+			name: "trusted launch worker",
+			machine: &machinev1beta1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo-hx8z7-worker-eastus1-abcde",
+					Namespace: machineSetsNamespace,
+					Labels:    map[string]string{"machine.openshift.io/cluster-api-machine-role": "worker"},
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							Kind: "MachineSet",
+						},
+					},
+				},
+				Spec: machinev1beta1.MachineSpec{
+					ProviderSpec: machinev1beta1.ProviderSpec{
+						Value: &runtime.RawExtension{
+							Raw: []byte(`{
+"apiVersion": "azureproviderconfig.openshift.io/v1beta1",
+"kind": "AzureMachineProviderSpec",
+"osDisk": {
+"diskSizeGB": 128
+},
+"image": {
+"publisher": "azureopenshift",
+"offer": "aro4",
+"sku": "aro_45_gen2"
+},
+"securityProfile": {
+"settings": {
+"securityType": "TrustedLaunch",
+"trustedLaunch": {
+"uefiSettings": {
+"secureBoot": "Enabled",
+"virtualizedTrustedPlatformModule": "Enabled"
+}
+}
+}
+},
+"vmSize": "Standard_D4s_v3"
+}`),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "trusted launch worker without secure boot or Gen2 image",
+			machine: &machinev1beta1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo-hx8z7-worker-eastus1-abcde",
+					Namespace: machineSetsNamespace,
+					Labels:    map[string]string{"machine.openshift.io/cluster-api-machine-role": "worker"},
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							Kind: "MachineSet",
+						},
+					},
+				},
+				Spec: machinev1beta1.MachineSpec{
+					ProviderSpec: machinev1beta1.ProviderSpec{
+						Value: &runtime.RawExtension{
+							Raw: []byte(`{
+"apiVersion": "azureproviderconfig.openshift.io/v1beta1",
+"kind": "AzureMachineProviderSpec",
+"osDisk": {
+"diskSizeGB": 128
+},
+"image": {
+"publisher": "azureopenshift",
+"offer": "aro4",
+"sku": "aro_45"
+},
+"securityProfile": {
+"settings": {
+"securityType": "TrustedLaunch",
+"trustedLaunch": {
+"uefiSettings": {
+"virtualizedTrustedPlatformModule": "Enabled"
+}
+}
+}
+},
+"vmSize": "Standard_D4s_v3"
+}`),
+						},
+					},
+				},
+			},
+			wantErrs: []error{
+				&MachineError{
+					Prefix:  "machine foo-hx8z7-worker-eastus1-abcde",
+					Reason:  arov1alpha1.MachineInvalidSecurityProfile,
+					Message: "trusted launch requires secure boot and vTPM to be enabled",
+				},
+				&MachineError{
+					Prefix:  "machine foo-hx8z7-worker-eastus1-abcde",
+					Reason:  arov1alpha1.MachineInvalidImage,
+					Message: "image SKU 'aro_45' does not match security type",
+				},
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r := &MachineChecker{}
+			r := &MachineChecker{
+				capabilities: capabilities,
+			}
 
 			isMaster, err := isMasterRole(tt.machine)
 			if err != nil {
@@ -181,3 +388,258 @@ func TestMachineValid(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckMachineSets(t *testing.T) {
+	ctx := context.Background()
+
+	capabilities, err := deployment.NewCapabilities(deployment.Production)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	machineset := func(name, workerProfile, vmSize, sku string) *machinev1beta1.MachineSet {
+		ms := &machinev1beta1.MachineSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: machineSetsNamespace,
+				Labels:    map[string]string{},
+			},
+			Spec: machinev1beta1.MachineSetSpec{
+				Template: machinev1beta1.MachineTemplateSpec{
+					ObjectMeta: machinev1beta1.ObjectMeta{
+						Labels: map[string]string{machineRoleLabel: "worker"},
+					},
+					Spec: machinev1beta1.MachineSpec{
+						ProviderSpec: machinev1beta1.ProviderSpec{
+							Value: &runtime.RawExtension{
+								Raw: []byte(`{
+"apiVersion": "azureproviderconfig.openshift.io/v1beta1",
+"kind": "AzureMachineProviderSpec",
+"osDisk": {
+	"diskSizeGB": 128
+},
+"image": {
+	"publisher": "azureopenshift",
+	"offer": "aro4",
+	"sku": "` + sku + `"
+},
+"vmSize": "` + vmSize + `"
+}`),
+							},
+						},
+					},
+				},
+			},
+		}
+		if workerProfile != "" {
+			ms.Labels[operator.WorkerProfileLabel] = workerProfile
+		}
+		return ms
+	}
+
+	r := &MachineChecker{
+		capabilities: capabilities,
+		clustercli: maofake.NewSimpleClientset(
+			machineset("foo-hx8z7-worker-eastus1", "", "Standard_A1", "aro_45"), // not managed by the RP
+			machineset("foo-hx8z7-gpu-eastus1", "gpu", "Standard_D4s_v3", "aro_45"),
+			machineset("foo-hx8z7-bad-eastus1", "bad", "Standard_A1", "aro_45"),
+			machineset("foo-hx8z7-arm-eastus1", "arm", "Standard_D4ps_v5", "aro_45_arm64"),
+			machineset("foo-hx8z7-armbad-eastus1", "armbad", "Standard_D4ps_v5", "aro_45"),
+		),
+	}
+
+	errs, statuses := r.checkMachineSets(ctx, nil)
+
+	wantErrs := []error{
+		&MachineError{
+			Prefix:  "machineset foo-hx8z7-bad-eastus1",
+			Reason:  arov1alpha1.MachineInvalidVMSize,
+			Message: "invalid VM size 'Standard_A1'",
+		},
+		&MachineError{
+			Prefix:  "machineset foo-hx8z7-armbad-eastus1",
+			Reason:  arov1alpha1.MachineInvalidImage,
+			Message: "image SKU 'aro_45' does not match VM size 'Standard_D4ps_v5'",
+		},
+	}
+	if !reflect.DeepEqual(errs, wantErrs) {
+		t.Errorf("MachineChecker.checkMachineSets() = %v, want %v", errs, wantErrs)
+	}
+
+	wantStatuses := []arov1alpha1.MachineCheckStatus{
+		{
+			Kind: "MachineSet",
+			Name: "foo-hx8z7-bad-eastus1",
+			Role: "worker",
+			Failures: []arov1alpha1.MachineCheckFailure{
+				{
+					Reason:  arov1alpha1.MachineInvalidVMSize,
+					Message: "invalid VM size 'Standard_A1'",
+				},
+			},
+		},
+		{
+			Kind: "MachineSet",
+			Name: "foo-hx8z7-armbad-eastus1",
+			Role: "worker",
+			Failures: []arov1alpha1.MachineCheckFailure{
+				{
+					Reason:  arov1alpha1.MachineInvalidImage,
+					Message: "image SKU 'aro_45' does not match VM size 'Standard_D4ps_v5'",
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(statuses, wantStatuses) {
+		t.Errorf("MachineChecker.checkMachineSets() = %#v, want %#v", statuses, wantStatuses)
+	}
+}
+
+func TestMachineCheckerCheck(t *testing.T) {
+	ctx := context.Background()
+
+	capabilities, err := deployment.NewCapabilities(deployment.Production)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	machine := func(name, role, vmSize string) *machinev1beta1.Machine {
+		return &machinev1beta1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: machineSetsNamespace,
+				Labels:    map[string]string{machineRoleLabel: role},
+			},
+			Spec: machinev1beta1.MachineSpec{
+				ProviderSpec: machinev1beta1.ProviderSpec{
+					Value: &runtime.RawExtension{
+						Raw: []byte(`{
+"apiVersion": "azureproviderconfig.openshift.io/v1beta1",
+"kind": "AzureMachineProviderSpec",
+"osDisk": {
+	"diskSizeGB": 128
+},
+"image": {
+	"publisher": "azureopenshift",
+	"offer": "aro4"
+},
+"vmSize": "` + vmSize + `"
+}`),
+					},
+				},
+			},
+		}
+	}
+
+	arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: arov1alpha1.SingletonClusterName,
+		},
+	})
+
+	recorder := record.NewFakeRecorder(10)
+
+	r := &MachineChecker{
+		clustercli: maofake.NewSimpleClientset(
+			machine("foo-hx8z7-master-0", "master", "Standard_D8s_v3"),
+			machine("foo-hx8z7-master-1", "master", "Standard_D8s_v3"),
+			machine("foo-hx8z7-master-2", "master", "Standard_A1"),
+		),
+		arocli:       arocli.AroV1alpha1(),
+		recorder:     recorder,
+		capabilities: capabilities,
+		role:         operator.RoleMaster,
+	}
+
+	err = r.Check(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cluster, err := arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cond := cluster.Status.Conditions.GetCondition(arov1alpha1.MachineValid)
+	if cond == nil || cond.Message != "invalid machines and machinesets: 1 (InvalidVMSize: 1), see status.machineChecks\n" {
+		t.Error(cond)
+	}
+
+	wantStatuses := []arov1alpha1.MachineCheckStatus{
+		{
+			Kind: "Machine",
+			Name: "foo-hx8z7-master-2",
+			Role: "master",
+			Failures: []arov1alpha1.MachineCheckFailure{
+				{
+					Reason:  arov1alpha1.MachineInvalidVMSize,
+					Message: "invalid VM size 'Standard_A1'",
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(cluster.Status.MachineChecks, wantStatuses) {
+		t.Errorf("%#v", cluster.Status.MachineChecks)
+	}
+
+	select {
+	case event := <-recorder.Events:
+		if event != "Warning InvalidVMSize invalid VM size 'Standard_A1'" {
+			t.Error(event)
+		}
+	default:
+		t.Error("no event recorded")
+	}
+
+	// the condition transition
+	<-recorder.Events
+
+	// nothing changed: the failures are not recorded again
+	err = r.Check(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case event := <-recorder.Events:
+		t.Error(event)
+	default:
+	}
+}
+
+func TestMachineCheckSummary(t *testing.T) {
+	errs := []error{
+		&MachineError{Prefix: "machine foo-hx8z7-worker-a", Reason: arov1alpha1.MachineInvalidVMSize, Message: "invalid VM size 'Standard_A1'"},
+		&MachineError{Prefix: "machine foo-hx8z7-worker-a", Reason: arov1alpha1.MachineInvalidImage, Message: "invalid image '{xyzcorp bananas   }'"},
+		&MachineError{Prefix: "machine foo-hx8z7-worker-a", Reason: arov1alpha1.MachineInvalidImage, Message: "image SKU '' does not match VM size 'Standard_A1'"},
+		&MachineError{Prefix: "machine foo-hx8z7-worker-b", Reason: arov1alpha1.MachineInvalidVMSize, Message: "invalid VM size 'Standard_A1'"},
+		errors.New("invalid number of worker machines 2, expected 3"),
+	}
+
+	statuses := []arov1alpha1.MachineCheckStatus{
+		{
+			Kind: "Machine",
+			Name: "foo-hx8z7-worker-a",
+			Failures: []arov1alpha1.MachineCheckFailure{
+				{Reason: arov1alpha1.MachineInvalidVMSize},
+				{Reason: arov1alpha1.MachineInvalidImage},
+				{Reason: arov1alpha1.MachineInvalidImage},
+			},
+		},
+		{
+			Kind: "Machine",
+			Name: "foo-hx8z7-worker-b",
+			Failures: []arov1alpha1.MachineCheckFailure{
+				{Reason: arov1alpha1.MachineInvalidVMSize},
+			},
+		},
+	}
+
+	want := "invalid machines and machinesets: 2 (InvalidImage: 1, InvalidVMSize: 2), see status.machineChecks\n" +
+		"invalid number of worker machines 2, expected 3\n"
+
+	if got := machineCheckSummary(errs, statuses); got != want {
+		t.Error(got)
+	}
+}
@@ -0,0 +1,62 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// The checker metrics are registered with the controller-runtime registry,
+// which the master operator serves on its metrics endpoint.  They are scraped
+// by the cluster monitoring stack through the ServiceMonitor which the
+// servicemonitor controller maintains.
+var (
+	checkStatus = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "aro_operator_check_status",
+			Help: "Status of the conditions on the Cluster resource: 1 if True, 0 if False, -1 if Unknown.",
+		},
+		[]string{"check", "reason"},
+	)
+
+	checkErrorsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "aro_operator_check_errors_total",
+			Help: "Number of times a checker failed to run.",
+		},
+		[]string{"checker"},
+	)
+
+	machineCheckFailures = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "aro_operator_machine_check_failures",
+			Help: "Number of problems which the MachineChecker found with each machine.",
+		},
+		[]string{"machine"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(checkStatus, checkErrorsTotal, machineCheckFailures)
+}
+
+// recordCheckStatus replaces the status metrics with conditions
+func recordCheckStatus(conditions status.Conditions) {
+	checkStatus.Reset()
+
+	for _, cond := range conditions {
+		value := -1.
+		switch cond.Status {
+		case corev1.ConditionTrue:
+			value = 1
+		case corev1.ConditionFalse:
+			value = 0
+		}
+
+		checkStatus.WithLabelValues(string(cond.Type), string(cond.Reason)).Set(value)
+	}
+}
@@ -0,0 +1,67 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	corev1 "k8s.io/api/core/v1"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+func TestRecordCheckStatus(t *testing.T) {
+	recordCheckStatus(status.Conditions{
+		{
+			Type:   arov1alpha1.InternetReachableFromMaster,
+			Status: corev1.ConditionTrue,
+			Reason: "CheckDone",
+		},
+	})
+
+	recordCheckStatus(status.Conditions{
+		{
+			Type:   arov1alpha1.MachineValid,
+			Status: corev1.ConditionFalse,
+			Reason: "CheckFailed",
+		},
+		{
+			Type:   arov1alpha1.InternetReachableFromWorker,
+			Status: corev1.ConditionUnknown,
+		},
+	})
+
+	want := map[string]float64{
+		string(arov1alpha1.MachineValid) + "/CheckFailed":     0,
+		string(arov1alpha1.InternetReachableFromWorker) + "/": -1,
+	}
+
+	ch := make(chan prometheus.Metric, 10)
+	checkStatus.Collect(ch)
+	close(ch)
+
+	got := map[string]float64{}
+	for metric := range ch {
+		m := &dto.Metric{}
+		err := metric.Write(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		labels := map[string]string{}
+		for _, l := range m.Label {
+			labels[l.GetName()] = l.GetValue()
+		}
+
+		got[labels["check"]+"/"+labels["reason"]] = m.Gauge.GetValue()
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Error(got)
+	}
+}
@@ -0,0 +1,261 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	mgmtnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-07-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/azure/auth"
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/cloudproviderconfig"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/network"
+	"github.com/Azure/ARO-RP/pkg/util/subnet"
+)
+
+// NetworkChecker checks that the master and worker subnets of the cluster,
+// which live in the customer's VNet, are still configured as the RP set them
+// up: the ARO network security group attached, the ACR service endpoint in
+// place and no route table sending the default route anywhere but the
+// Internet or a firewall.  Customers can change all of these at any time, and
+// the cluster breaks in ways which are hard to trace back to the change.
+type NetworkChecker struct {
+	kubernetescli kubernetes.Interface
+	arocli        aroclient.AroV1alpha1Interface
+	recorder      record.EventRecorder
+	log           *logrus.Entry
+	role          string
+
+	newSubnetsClient     func(subscriptionID string, authorizer autorest.Authorizer) network.SubnetsClient
+	newRouteTablesClient func(subscriptionID string, authorizer autorest.Authorizer) network.RouteTablesClient
+}
+
+func NewNetworkChecker(log *logrus.Entry, kubernetescli kubernetes.Interface, arocli aroclient.AroV1alpha1Interface, recorder record.EventRecorder, role string) *NetworkChecker {
+	return &NetworkChecker{
+		kubernetescli: kubernetescli,
+		arocli:        arocli,
+		recorder:      recorder,
+		log:           log,
+		role:          role,
+
+		newSubnetsClient:     network.NewSubnetsClient,
+		newRouteTablesClient: network.NewRouteTablesClient,
+	}
+}
+
+func (r *NetworkChecker) Name() string {
+	return "NetworkChecker"
+}
+
+// Check sets the NetworkValid condition to False if a subnet in the cluster's
+// NetworkChecker spec, or the route table attached to it, has drifted from
+// the configuration which ARO requires
+func (r *NetworkChecker) Check(ctx context.Context) error {
+	instance, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	// the spec is set by older RPs without the network checker
+	if len(instance.Spec.NetworkChecker.Subnets) == 0 {
+		return nil
+	}
+
+	mysec, err := r.kubernetescli.CoreV1().Secrets(operator.Namespace).Get(ctx, operator.SecretName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	// the secret is written by older RPs without the expected config
+	if _, found := mysec.Data[cloudproviderconfig.ConfigKey]; !found {
+		return nil
+	}
+
+	var config *cloudproviderconfig.Config
+	err = json.Unmarshal(mysec.Data[cloudproviderconfig.ConfigKey], &config)
+	if err != nil {
+		return err
+	}
+
+	authorizer, err := auth.NewClientCredentialsConfig(config.AADClientID, config.AADClientSecret, config.TenantID).Authorizer()
+	if err != nil {
+		return err
+	}
+
+	var sb strings.Builder
+	routeTablesChecked := map[string]struct{}{}
+
+	for _, s := range instance.Spec.NetworkChecker.Subnets {
+		errs, routeTableID, err := r.checkSubnet(ctx, authorizer, &s)
+		if err != nil {
+			return err
+		}
+		for _, e := range errs {
+			sb.WriteString(e)
+			sb.WriteByte('\n')
+		}
+
+		if _, found := routeTablesChecked[strings.ToLower(routeTableID)]; found || routeTableID == "" {
+			continue
+		}
+		routeTablesChecked[strings.ToLower(routeTableID)] = struct{}{}
+
+		errs, err = r.checkRouteTable(ctx, authorizer, routeTableID)
+		if err != nil {
+			return err
+		}
+		for _, e := range errs {
+			sb.WriteString(e)
+			sb.WriteByte('\n')
+		}
+	}
+
+	cond := &status.Condition{
+		Type:    arov1alpha1.NetworkValid,
+		Status:  corev1.ConditionTrue,
+		Message: "the cluster subnets and their route tables are valid",
+		Reason:  "CheckDone",
+	}
+
+	if message := sb.String(); message != "" {
+		r.log.Warn(message)
+		cond.Status = corev1.ConditionFalse
+		cond.Reason = "CheckFailed"
+		cond.Message = message
+	}
+
+	return controllers.SetCondition(ctx, r.arocli, r.recorder, cond, r.role)
+}
+
+// checkSubnet returns a message for each problem with the subnet in spec, and
+// the ID of the route table attached to it, if any
+func (r *NetworkChecker) checkSubnet(ctx context.Context, authorizer autorest.Authorizer, spec *arov1alpha1.SubnetSpec) ([]string, string, error) {
+	vnetID, subnetName, err := subnet.Split(spec.ID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	vnetr, err := azure.ParseResourceID(vnetID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	name := vnetr.ResourceName + "/" + subnetName
+
+	s, err := r.newSubnetsClient(vnetr.SubscriptionID, authorizer).Get(ctx, vnetr.ResourceGroup, vnetr.ResourceName, subnetName, "")
+	if isNotFound(err) {
+		return []string{fmt.Sprintf("subnet %s: not found", name)}, "", nil
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	if s.SubnetPropertiesFormat == nil {
+		s.SubnetPropertiesFormat = &mgmtnetwork.SubnetPropertiesFormat{}
+	}
+
+	var errs []string
+
+	if spec.NetworkSecurityGroupID != "" {
+		switch {
+		case s.NetworkSecurityGroup == nil || s.NetworkSecurityGroup.ID == nil:
+			errs = append(errs, fmt.Sprintf("subnet %s: no network security group attached, expected '%s'", name, spec.NetworkSecurityGroupID))
+		case !strings.EqualFold(*s.NetworkSecurityGroup.ID, spec.NetworkSecurityGroupID):
+			errs = append(errs, fmt.Sprintf("subnet %s: network security group '%s' attached, expected '%s'", name, *s.NetworkSecurityGroup.ID, spec.NetworkSecurityGroupID))
+		}
+	}
+
+	var found bool
+	if s.ServiceEndpoints != nil {
+		for _, se := range *s.ServiceEndpoints {
+			if se.Service != nil && strings.EqualFold(*se.Service, "Microsoft.ContainerRegistry") &&
+				se.ProvisioningState == mgmtnetwork.Succeeded {
+				found = true
+				break
+			}
+		}
+	}
+	if !found {
+		errs = append(errs, fmt.Sprintf("subnet %s: Microsoft.ContainerRegistry service endpoint missing", name))
+	}
+
+	var routeTableID string
+	if s.RouteTable != nil && s.RouteTable.ID != nil {
+		routeTableID = *s.RouteTable.ID
+	}
+
+	return errs, routeTableID, nil
+}
+
+// checkRouteTable returns a message for each route of the route table which
+// sends the default route, without which the cluster can't reach the
+// endpoints it depends on, anywhere but the Internet or a virtual appliance.
+// Egress through a customer firewall or proxy is supported: whether the
+// endpoints are reachable through it is reported by the InternetChecker.
+func (r *NetworkChecker) checkRouteTable(ctx context.Context, authorizer autorest.Authorizer, routeTableID string) ([]string, error) {
+	rtr, err := azure.ParseResourceID(routeTableID)
+	if err != nil {
+		return nil, err
+	}
+
+	rt, err := r.newRouteTablesClient(rtr.SubscriptionID, authorizer).Get(ctx, rtr.ResourceGroup, rtr.ResourceName, "")
+	if isNotFound(err) {
+		return []string{fmt.Sprintf("route table %s: not found", rtr.ResourceName)}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if rt.RouteTablePropertiesFormat == nil || rt.Routes == nil {
+		return nil, nil
+	}
+
+	var errs []string
+	for _, route := range *rt.Routes {
+		if route.RoutePropertiesFormat == nil || route.AddressPrefix == nil ||
+			*route.AddressPrefix != "0.0.0.0/0" {
+			continue
+		}
+
+		switch route.NextHopType {
+		case mgmtnetwork.RouteNextHopTypeInternet, mgmtnetwork.RouteNextHopTypeVirtualAppliance:
+			continue
+		}
+
+		nextHop := string(route.NextHopType)
+		if route.NextHopIPAddress != nil {
+			nextHop += " " + *route.NextHopIPAddress
+		}
+
+		var routeName string
+		if route.Name != nil {
+			routeName = *route.Name
+		}
+
+		errs = append(errs, fmt.Sprintf("route table %s: route %s sends 0.0.0.0/0 to %s", rtr.ResourceName, routeName, nextHop))
+	}
+
+	return errs, nil
+}
+
+func isNotFound(err error) bool {
+	detailedErr, ok := err.(autorest.DetailedError)
+	return ok && detailedErr.StatusCode == http.StatusNotFound
+}
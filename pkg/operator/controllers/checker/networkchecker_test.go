@@ -0,0 +1,235 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	mgmtnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-07-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/network"
+	mock_network "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/mgmt/network"
+)
+
+func TestNetworkCheckerCheck(t *testing.T) {
+	ctx := context.Background()
+
+	vnetID := "/subscriptions/subscription/resourceGroups/vnet-rg/providers/Microsoft.Network/virtualNetworks/vnet"
+	nsgID := "/subscriptions/subscription/resourceGroups/cluster-rg/providers/Microsoft.Network/networkSecurityGroups/aro-nsg"
+	routeTableID := "/subscriptions/subscription/resourceGroups/vnet-rg/providers/Microsoft.Network/routeTables/rt"
+
+	validSubnet := func() mgmtnetwork.Subnet {
+		return mgmtnetwork.Subnet{
+			SubnetPropertiesFormat: &mgmtnetwork.SubnetPropertiesFormat{
+				NetworkSecurityGroup: &mgmtnetwork.SecurityGroup{
+					ID: to.StringPtr(nsgID),
+				},
+				ServiceEndpoints: &[]mgmtnetwork.ServiceEndpointPropertiesFormat{
+					{
+						Service:           to.StringPtr("Microsoft.ContainerRegistry"),
+						ProvisioningState: mgmtnetwork.Succeeded,
+					},
+				},
+			},
+		}
+	}
+
+	for _, tt := range []struct {
+		name        string
+		mocks       func(*mock_network.MockSubnetsClient, *mock_network.MockRouteTablesClient)
+		wantStatus  corev1.ConditionStatus
+		wantMessage string
+	}{
+		{
+			name: "valid",
+			mocks: func(subnets *mock_network.MockSubnetsClient, routeTables *mock_network.MockRouteTablesClient) {
+				subnets.EXPECT().Get(gomock.Any(), "vnet-rg", "vnet", gomock.Any(), "").Return(validSubnet(), nil).Times(2)
+			},
+			wantStatus:  corev1.ConditionTrue,
+			wantMessage: "the cluster subnets and their route tables are valid",
+		},
+		{
+			name: "shared route table with internet default route is checked once",
+			mocks: func(subnets *mock_network.MockSubnetsClient, routeTables *mock_network.MockRouteTablesClient) {
+				s := validSubnet()
+				s.RouteTable = &mgmtnetwork.RouteTable{ID: to.StringPtr(routeTableID)}
+				subnets.EXPECT().Get(gomock.Any(), "vnet-rg", "vnet", gomock.Any(), "").Return(s, nil).Times(2)
+				routeTables.EXPECT().Get(gomock.Any(), "vnet-rg", "rt", "").Return(mgmtnetwork.RouteTable{
+					RouteTablePropertiesFormat: &mgmtnetwork.RouteTablePropertiesFormat{
+						Routes: &[]mgmtnetwork.Route{
+							{
+								Name: to.StringPtr("default"),
+								RoutePropertiesFormat: &mgmtnetwork.RoutePropertiesFormat{
+									AddressPrefix: to.StringPtr("0.0.0.0/0"),
+									NextHopType:   mgmtnetwork.RouteNextHopTypeInternet,
+								},
+							},
+							{
+								Name: to.StringPtr("onprem"),
+								RoutePropertiesFormat: &mgmtnetwork.RoutePropertiesFormat{
+									AddressPrefix:    to.StringPtr("192.168.0.0/16"),
+									NextHopType:      mgmtnetwork.RouteNextHopTypeVirtualAppliance,
+									NextHopIPAddress: to.StringPtr("10.1.0.4"),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantStatus:  corev1.ConditionTrue,
+			wantMessage: "the cluster subnets and their route tables are valid",
+		},
+		{
+			name: "firewall default route is valid",
+			mocks: func(subnets *mock_network.MockSubnetsClient, routeTables *mock_network.MockRouteTablesClient) {
+				s := validSubnet()
+				s.RouteTable = &mgmtnetwork.RouteTable{ID: to.StringPtr(routeTableID)}
+				subnets.EXPECT().Get(gomock.Any(), "vnet-rg", "vnet", gomock.Any(), "").Return(s, nil).Times(2)
+				routeTables.EXPECT().Get(gomock.Any(), "vnet-rg", "rt", "").Return(mgmtnetwork.RouteTable{
+					RouteTablePropertiesFormat: &mgmtnetwork.RouteTablePropertiesFormat{
+						Routes: &[]mgmtnetwork.Route{
+							{
+								Name: to.StringPtr("firewall"),
+								RoutePropertiesFormat: &mgmtnetwork.RoutePropertiesFormat{
+									AddressPrefix:    to.StringPtr("0.0.0.0/0"),
+									NextHopType:      mgmtnetwork.RouteNextHopTypeVirtualAppliance,
+									NextHopIPAddress: to.StringPtr("10.1.0.4"),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantStatus:  corev1.ConditionTrue,
+			wantMessage: "the cluster subnets and their route tables are valid",
+		},
+		{
+			name: "drifted",
+			mocks: func(subnets *mock_network.MockSubnetsClient, routeTables *mock_network.MockRouteTablesClient) {
+				master := validSubnet()
+				master.NetworkSecurityGroup.ID = to.StringPtr("/subscriptions/subscription/resourceGroups/vnet-rg/providers/Microsoft.Network/networkSecurityGroups/custom")
+				master.ServiceEndpoints = nil
+				master.RouteTable = &mgmtnetwork.RouteTable{ID: to.StringPtr(routeTableID)}
+				subnets.EXPECT().Get(gomock.Any(), "vnet-rg", "vnet", "master", "").Return(master, nil)
+
+				worker := validSubnet()
+				worker.NetworkSecurityGroup = nil
+				subnets.EXPECT().Get(gomock.Any(), "vnet-rg", "vnet", "worker", "").Return(worker, nil)
+
+				routeTables.EXPECT().Get(gomock.Any(), "vnet-rg", "rt", "").Return(mgmtnetwork.RouteTable{
+					RouteTablePropertiesFormat: &mgmtnetwork.RouteTablePropertiesFormat{
+						Routes: &[]mgmtnetwork.Route{
+							{
+								Name: to.StringPtr("blackhole"),
+								RoutePropertiesFormat: &mgmtnetwork.RoutePropertiesFormat{
+									AddressPrefix: to.StringPtr("0.0.0.0/0"),
+									NextHopType:   mgmtnetwork.RouteNextHopTypeNone,
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantStatus: corev1.ConditionFalse,
+			wantMessage: "subnet vnet/master: network security group '/subscriptions/subscription/resourceGroups/vnet-rg/providers/Microsoft.Network/networkSecurityGroups/custom' attached, expected '" + nsgID + "'\n" +
+				"subnet vnet/master: Microsoft.ContainerRegistry service endpoint missing\n" +
+				"route table rt: route blackhole sends 0.0.0.0/0 to None\n" +
+				"subnet vnet/worker: no network security group attached, expected '" + nsgID + "'\n",
+		},
+		{
+			name: "subnet deleted",
+			mocks: func(subnets *mock_network.MockSubnetsClient, routeTables *mock_network.MockRouteTablesClient) {
+				subnets.EXPECT().Get(gomock.Any(), "vnet-rg", "vnet", "master", "").Return(validSubnet(), nil)
+				subnets.EXPECT().Get(gomock.Any(), "vnet-rg", "vnet", "worker", "").Return(mgmtnetwork.Subnet{}, autorest.DetailedError{StatusCode: http.StatusNotFound})
+			},
+			wantStatus:  corev1.ConditionFalse,
+			wantMessage: "subnet vnet/worker: not found\n",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			kubernetescli := fake.NewSimpleClientset(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      operator.SecretName,
+					Namespace: operator.Namespace,
+				},
+				Data: map[string][]byte{
+					"cloudProviderConfig": []byte(`{"tenantId":"tenant","subscriptionId":"subscription","resourceGroup":"cluster-rg","aadClientId":"client","aadClientSecret":"secret"}`),
+				},
+			})
+
+			arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					NetworkChecker: arov1alpha1.NetworkCheckerSpec{
+						Subnets: []arov1alpha1.SubnetSpec{
+							{
+								ID:                     vnetID + "/subnets/master",
+								NetworkSecurityGroupID: nsgID,
+							},
+							{
+								ID:                     vnetID + "/subnets/worker",
+								NetworkSecurityGroupID: nsgID,
+							},
+						},
+					},
+				},
+			})
+
+			subnets := mock_network.NewMockSubnetsClient(controller)
+			routeTables := mock_network.NewMockRouteTablesClient(controller)
+			tt.mocks(subnets, routeTables)
+
+			r := &NetworkChecker{
+				kubernetescli: kubernetescli,
+				arocli:        arocli.AroV1alpha1(),
+				log:           logrus.NewEntry(logrus.StandardLogger()),
+				role:          operator.RoleMaster,
+				newSubnetsClient: func(subscriptionID string, authorizer autorest.Authorizer) network.SubnetsClient {
+					return subnets
+				},
+				newRouteTablesClient: func(subscriptionID string, authorizer autorest.Authorizer) network.RouteTablesClient {
+					return routeTables
+				},
+			}
+
+			err := r.Check(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cluster, err := arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cond := cluster.Status.Conditions.GetCondition(arov1alpha1.NetworkValid)
+			if cond == nil {
+				t.Fatal("condition not set")
+			}
+			if cond.Status != tt.wantStatus {
+				t.Error(cond.Status)
+			}
+			if cond.Message != tt.wantMessage {
+				t.Error(cond.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,359 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	certificatesv1beta1 "k8s.io/api/certificates/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/nodeproblemdetector"
+	utilpem "github.com/Azure/ARO-RP/pkg/util/pem"
+)
+
+const (
+	// rotationOverdueFraction is the fraction of the lifetime of a
+	// certificate after which it should have been rotated.  The kubelet
+	// rotates its certificates at a random point between 70% and 90% of
+	// their lifetime.
+	rotationOverdueFraction = 0.9
+
+	// csrPendingThreshold is how long a node's certificate signing request
+	// may stay pending before it is reported.  The machine approver normally
+	// approves node requests within seconds.
+	csrPendingThreshold = 30 * time.Minute
+
+	// rotationFailedEventWindow is how far back certificate rotation
+	// failures reported by the node problem detector are taken into account
+	rotationFailedEventWindow = time.Hour
+
+	defaultKubeletPort = 10250
+	kubeletDialTimeout = 10 * time.Second
+
+	nodeUserPrefix = "system:node:"
+)
+
+// signers are the secrets holding the certificate authorities which node
+// certificates depend on.  If one of them isn't rotated in time, every node
+// certificate which it signs or verifies stops working at once.
+var signers = []struct {
+	namespace string
+	name      string
+}{
+	{
+		// signs the kubelets' client and serving certificates
+		namespace: "openshift-kube-controller-manager",
+		name:      "csr-signer",
+	},
+	{
+		// signs the client certificate which the API servers present to the
+		// kubelets
+		namespace: "openshift-kube-apiserver-operator",
+		name:      "kube-apiserver-to-kubelet-signer",
+	},
+}
+
+// NodeCertificateChecker reports clusters whose node certificates are not
+// being rotated.  Kubelet certificates are short lived: if their rotation
+// stalls, e.g. because a cluster was stopped for longer than their lifetime,
+// every node drops out of the cluster at once and pending certificate
+// signing requests have to be approved by hand to recover it.
+type NodeCertificateChecker struct {
+	kubernetescli kubernetes.Interface
+	arocli        aroclient.AroV1alpha1Interface
+	recorder      record.EventRecorder
+	log           *logrus.Entry
+	role          string
+
+	now                func() time.Time
+	servingCertificate func(ctx context.Context, address string) (*x509.Certificate, error)
+}
+
+func NewNodeCertificateChecker(log *logrus.Entry, kubernetescli kubernetes.Interface, arocli aroclient.AroV1alpha1Interface, recorder record.EventRecorder, role string) *NodeCertificateChecker {
+	return &NodeCertificateChecker{
+		kubernetescli: kubernetescli,
+		arocli:        arocli,
+		recorder:      recorder,
+		log:           log,
+		role:          role,
+
+		now:                time.Now,
+		servingCertificate: servingCertificate,
+	}
+}
+
+func (r *NodeCertificateChecker) Name() string {
+	return "NodeCertificateChecker"
+}
+
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=list
+// +kubebuilder:rbac:groups="",resources=events,verbs=list
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get
+// +kubebuilder:rbac:groups=certificates.k8s.io,resources=certificatesigningrequests,verbs=list
+
+// Check sets the NodeCertificatesRotating condition to False if the serving
+// certificate of a kubelet has expired or is overdue for rotation, if a
+// certificate signing request of a node has been pending for longer than
+// csrPendingThreshold, if the node problem detector has recently seen a
+// kubelet fail to rotate its certificates, or if a certificate authority
+// which node certificates depend on has expired or is overdue for rotation.
+func (r *NodeCertificateChecker) Check(ctx context.Context) error {
+	cond := &status.Condition{
+		Type:    arov1alpha1.NodeCertificatesRotating,
+		Status:  corev1.ConditionTrue,
+		Message: "node certificates are rotating",
+		Reason:  "CheckDone",
+	}
+
+	problems := map[string][]string{}
+
+	nodes, err := r.kubernetescli.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	for i := range nodes.Items {
+		address := kubeletAddress(&nodes.Items[i])
+		if address == "" {
+			continue
+		}
+
+		cert, err := r.servingCertificate(ctx, address)
+		if err != nil {
+			// the node may be down, which is reported elsewhere
+			r.log.Infof("%s: %s", nodes.Items[i].Name, err)
+			continue
+		}
+
+		if problem := r.rotationProblem(cert); problem != "" {
+			problems[nodes.Items[i].Name] = append(problems[nodes.Items[i].Name], "kubelet serving certificate "+problem)
+		}
+	}
+
+	csrs, err := r.kubernetescli.CertificatesV1beta1().CertificateSigningRequests().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	pending := map[string]int{}
+	for i := range csrs.Items {
+		csr := &csrs.Items[i]
+		if !isPending(csr) || r.now().Sub(csr.CreationTimestamp.Time) < csrPendingThreshold {
+			continue
+		}
+
+		node := csrNodeName(csr)
+		if node == "" {
+			continue
+		}
+
+		pending[node]++
+	}
+
+	for node, count := range pending {
+		problems[node] = append(problems[node], fmt.Sprintf("%d certificate signing request(s) pending for more than %s", count, csrPendingThreshold))
+	}
+
+	events, err := r.kubernetescli.CoreV1().Events(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		FieldSelector: "involvedObject.kind=Node,reason=" + nodeproblemdetector.EventCertificateRotationFailed,
+	})
+	if err != nil {
+		return err
+	}
+
+	failed := map[string]bool{}
+	for _, ev := range events.Items {
+		if ev.InvolvedObject.Kind != "Node" ||
+			ev.Reason != nodeproblemdetector.EventCertificateRotationFailed ||
+			r.now().Sub(ev.LastTimestamp.Time) > rotationFailedEventWindow ||
+			failed[ev.InvolvedObject.Name] {
+			continue
+		}
+
+		failed[ev.InvolvedObject.Name] = true
+		problems[ev.InvolvedObject.Name] = append(problems[ev.InvolvedObject.Name], "certificate rotation failed: "+strings.TrimSpace(ev.Message))
+	}
+
+	for _, signer := range signers {
+		cert, err := r.signerCertificate(ctx, signer.namespace, signer.name)
+		if err != nil {
+			return err
+		}
+		if cert == nil {
+			continue
+		}
+
+		if problem := r.rotationProblem(cert); problem != "" {
+			key := signer.namespace + "/" + signer.name
+			problems[key] = append(problems[key], "certificate authority "+problem)
+		}
+	}
+
+	if len(problems) > 0 {
+		names := make([]string, 0, len(problems))
+		for name := range problems {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		sb := &strings.Builder{}
+		for _, name := range names {
+			r.log.Warnf("%s: %s", name, strings.Join(problems[name], "; "))
+			fmt.Fprintf(sb, "%s: %s\n", name, strings.Join(problems[name], "; "))
+		}
+
+		cond.Status = corev1.ConditionFalse
+		cond.Reason = "CheckFailed"
+		cond.Message = sb.String()
+	}
+
+	return controllers.SetCondition(ctx, r.arocli, r.recorder, cond, r.role)
+}
+
+// rotationProblem returns why cert needs attention, or "" if it is not yet
+// due for rotation
+func (r *NodeCertificateChecker) rotationProblem(cert *x509.Certificate) string {
+	now := r.now()
+	lifetime := cert.NotAfter.Sub(cert.NotBefore)
+
+	switch {
+	case !now.Before(cert.NotAfter):
+		return fmt.Sprintf("expired at %s", cert.NotAfter.UTC().Format(time.RFC3339))
+	case now.Sub(cert.NotBefore) > time.Duration(float64(lifetime)*rotationOverdueFraction):
+		return fmt.Sprintf("is overdue for rotation and expires at %s", cert.NotAfter.UTC().Format(time.RFC3339))
+	default:
+		return ""
+	}
+}
+
+// signerCertificate returns the certificate of the certificate authority held
+// in the given secret, or nil if the secret doesn't exist
+func (r *NodeCertificateChecker) signerCertificate(ctx context.Context, namespace, name string) (*x509.Certificate, error) {
+	s, err := r.kubernetescli.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	_, certs, err := utilpem.Parse(s.Data[corev1.TLSCertKey])
+	if err != nil {
+		return nil, err
+	}
+
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("%s/%s: no certificate found", namespace, name)
+	}
+
+	return certs[0], nil
+}
+
+// kubeletAddress returns the address on which the kubelet of node serves, or
+// "" if node has no internal IP
+func kubeletAddress(node *corev1.Node) string {
+	port := int(node.Status.DaemonEndpoints.KubeletEndpoint.Port)
+	if port == 0 {
+		port = defaultKubeletPort
+	}
+
+	for _, a := range node.Status.Addresses {
+		if a.Type == corev1.NodeInternalIP {
+			return net.JoinHostPort(a.Address, strconv.Itoa(port))
+		}
+	}
+
+	return ""
+}
+
+// isPending returns true if csr has been neither approved nor denied
+func isPending(csr *certificatesv1beta1.CertificateSigningRequest) bool {
+	for _, c := range csr.Status.Conditions {
+		if c.Type == certificatesv1beta1.CertificateApproved ||
+			c.Type == certificatesv1beta1.CertificateDenied {
+			return false
+		}
+	}
+
+	return true
+}
+
+// csrNodeName returns the node whose certificate csr requests, or "" if it
+// isn't a node certificate.  The node is taken from the request rather than
+// the requestor: a node whose client certificate has expired requests a new
+// one as the node bootstrapper.
+func csrNodeName(csr *certificatesv1beta1.CertificateSigningRequest) string {
+	block, _ := pem.Decode(csr.Spec.Request)
+	if block == nil {
+		return ""
+	}
+
+	req, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return ""
+	}
+
+	if !strings.HasPrefix(req.Subject.CommonName, nodeUserPrefix) {
+		return ""
+	}
+
+	return strings.TrimPrefix(req.Subject.CommonName, nodeUserPrefix)
+}
+
+// servingCertificate completes a TLS handshake with the kubelet serving at
+// address and returns its certificate.  The certificate is only inspected,
+// not trusted, so it isn't verified.
+func servingCertificate(ctx context.Context, address string) (*x509.Certificate, error) {
+	ctx, cancel := context.WithTimeout(ctx, kubeletDialTimeout)
+	defer cancel()
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		err = conn.SetDeadline(deadline)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tlsConn := tls.Client(conn, &tls.Config{
+		InsecureSkipVerify: true,
+	})
+
+	err = tlsConn.Handshake()
+	if err != nil {
+		return nil, err
+	}
+
+	certs := tlsConn.ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return nil, errors.New("no certificates served")
+	}
+
+	return certs[0], nil
+}
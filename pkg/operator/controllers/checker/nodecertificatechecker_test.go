@@ -0,0 +1,263 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	certificatesv1beta1 "k8s.io/api/certificates/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/nodeproblemdetector"
+	utiltls "github.com/Azure/ARO-RP/pkg/util/tls"
+)
+
+func TestNodeCertificateCheckerCheck(t *testing.T) {
+	ctx := context.Background()
+
+	key, certs, err := utiltls.GenerateKeyAndCertificate("kubelet-signer", nil, nil, true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the signer is valid for a year from now: advance the clock by 11
+	// months to make it overdue for rotation
+	now := certs[0].NotBefore.Add(time.Hour)
+	overdue := certs[0].NotBefore.AddDate(0, 11, 0)
+
+	signer := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "csr-signer",
+			Namespace: "openshift-kube-controller-manager",
+		},
+		Data: map[string][]byte{
+			corev1.TLSCertKey: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certs[0].Raw}),
+		},
+	}
+
+	node := func(name, ip string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Status: corev1.NodeStatus{
+				Addresses: []corev1.NodeAddress{
+					{
+						Type:    corev1.NodeInternalIP,
+						Address: ip,
+					},
+				},
+			},
+		}
+	}
+
+	csr := func(name, commonName string, created time.Time, conditions ...certificatesv1beta1.CertificateSigningRequestCondition) *certificatesv1beta1.CertificateSigningRequest {
+		b, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+			Subject: pkix.Name{CommonName: commonName},
+		}, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return &certificatesv1beta1.CertificateSigningRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				CreationTimestamp: metav1.Time{Time: created},
+			},
+			Spec: certificatesv1beta1.CertificateSigningRequestSpec{
+				Request: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: b}),
+			},
+			Status: certificatesv1beta1.CertificateSigningRequestStatus{
+				Conditions: conditions,
+			},
+		}
+	}
+
+	event := func(node string, lastTimestamp time.Time) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      node + "." + nodeproblemdetector.EventCertificateRotationFailed,
+				Namespace: "default",
+			},
+			InvolvedObject: corev1.ObjectReference{
+				Kind: "Node",
+				Name: node,
+			},
+			Reason:        nodeproblemdetector.EventCertificateRotationFailed,
+			Message:       "Failed while requesting a signed certificate from the master: cannot create certificate signing request: Unauthorized",
+			LastTimestamp: metav1.Time{Time: lastTimestamp},
+		}
+	}
+
+	// servingCertificates returns the certificates served by the kubelets at
+	// now, keyed by address: the first is fresh, the second overdue for
+	// rotation and the third expired
+	servingCertificates := func(now time.Time) map[string]*x509.Certificate {
+		return map[string]*x509.Certificate{
+			"10.0.0.1:10250": {
+				NotBefore: now.Add(-24 * time.Hour),
+				NotAfter:  now.Add(29 * 24 * time.Hour),
+			},
+			"10.0.0.2:10250": {
+				NotBefore: now.Add(-28 * 24 * time.Hour),
+				NotAfter:  now.Add(2 * 24 * time.Hour),
+			},
+			"10.0.0.3:10250": {
+				NotBefore: now.Add(-31 * 24 * time.Hour),
+				NotAfter:  now.Add(-24 * time.Hour),
+			},
+		}
+	}
+
+	approved := certificatesv1beta1.CertificateSigningRequestCondition{
+		Type: certificatesv1beta1.CertificateApproved,
+	}
+
+	for _, tt := range []struct {
+		name        string
+		now         time.Time
+		objects     []runtime.Object
+		wantStatus  corev1.ConditionStatus
+		wantMessage string
+	}{
+		{
+			name: "certificates are rotating",
+			now:  now,
+			objects: []runtime.Object{
+				signer,
+				node("master-0", "10.0.0.1"),
+				node("worker-0", "10.0.0.4"), // unreachable
+				csr("csr-1", "system:node:master-0", now.Add(-time.Hour), approved),
+				csr("csr-2", "system:node:master-0", now.Add(-time.Minute)),
+				csr("csr-3", "system:serviceaccount:openshift-monitoring:prometheus-k8s", now.Add(-2*time.Hour)),
+				event("master-0", now.Add(-2*time.Hour)),
+			},
+			wantStatus:  corev1.ConditionTrue,
+			wantMessage: "node certificates are rotating",
+		},
+		{
+			name: "certificates are not rotating",
+			now:  overdue,
+			objects: []runtime.Object{
+				signer,
+				node("master-0", "10.0.0.1"),
+				node("worker-0", "10.0.0.2"),
+				node("worker-1", "10.0.0.3"),
+				csr("csr-1", "system:node:worker-1", overdue.Add(-time.Hour)),
+				csr("csr-2", "system:node:worker-1", overdue.Add(-2*time.Hour)),
+				event("worker-1", overdue.Add(-time.Minute)),
+			},
+			wantStatus: corev1.ConditionFalse,
+			wantMessage: "openshift-kube-controller-manager/csr-signer: certificate authority is overdue for rotation and expires at " + certs[0].NotAfter.UTC().Format(time.RFC3339) + "\n" +
+				"worker-0: kubelet serving certificate is overdue for rotation and expires at " + overdue.Add(2*24*time.Hour).UTC().Format(time.RFC3339) + "\n" +
+				"worker-1: kubelet serving certificate expired at " + overdue.Add(-24*time.Hour).UTC().Format(time.RFC3339) + "; 2 certificate signing request(s) pending for more than 30m0s; certificate rotation failed: Failed while requesting a signed certificate from the master: cannot create certificate signing request: Unauthorized\n",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+			})
+
+			r := &NodeCertificateChecker{
+				kubernetescli: fake.NewSimpleClientset(tt.objects...),
+				arocli:        arocli.AroV1alpha1(),
+				log:           logrus.NewEntry(logrus.StandardLogger()),
+				role:          operator.RoleMaster,
+				now:           func() time.Time { return tt.now },
+				servingCertificate: func(ctx context.Context, address string) (*x509.Certificate, error) {
+					cert, found := servingCertificates(tt.now)[address]
+					if !found {
+						return nil, errors.New("connection refused")
+					}
+					return cert, nil
+				},
+			}
+
+			err := r.Check(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cluster, err := arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cond := cluster.Status.Conditions.GetCondition(arov1alpha1.NodeCertificatesRotating)
+			if cond == nil {
+				t.Fatal("condition not set")
+			}
+			if cond.Status != tt.wantStatus {
+				t.Error(cond.Status)
+			}
+			if cond.Message != tt.wantMessage {
+				t.Error(cond.Message)
+			}
+		})
+	}
+}
+
+func TestKubeletAddress(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		node *corev1.Node
+		want string
+	}{
+		{
+			name: "no internal IP",
+			node: &corev1.Node{
+				Status: corev1.NodeStatus{
+					Addresses: []corev1.NodeAddress{
+						{
+							Type:    corev1.NodeHostName,
+							Address: "master-0",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "kubelet endpoint port",
+			node: &corev1.Node{
+				Status: corev1.NodeStatus{
+					DaemonEndpoints: corev1.NodeDaemonEndpoints{
+						KubeletEndpoint: corev1.DaemonEndpoint{
+							Port: 10251,
+						},
+					},
+					Addresses: []corev1.NodeAddress{
+						{
+							Type:    corev1.NodeInternalIP,
+							Address: "10.0.0.1",
+						},
+					},
+				},
+			},
+			want: "10.0.0.1:10251",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := kubeletAddress(tt.node)
+			if got != tt.want {
+				t.Error(got)
+			}
+		})
+	}
+}
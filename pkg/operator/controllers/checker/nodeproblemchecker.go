@@ -0,0 +1,89 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/nodeproblemdetector"
+)
+
+// NodeProblemChecker summarises the node conditions set by the node problem
+// detector daemonset, so that they can be seen on the Cluster resource
+type NodeProblemChecker struct {
+	kubernetescli kubernetes.Interface
+	arocli        aroclient.AroV1alpha1Interface
+	recorder      record.EventRecorder
+	log           *logrus.Entry
+	role          string
+}
+
+func NewNodeProblemChecker(log *logrus.Entry, kubernetescli kubernetes.Interface, arocli aroclient.AroV1alpha1Interface, recorder record.EventRecorder, role string) *NodeProblemChecker {
+	return &NodeProblemChecker{
+		kubernetescli: kubernetescli,
+		arocli:        arocli,
+		recorder:      recorder,
+		log:           log,
+		role:          role,
+	}
+}
+
+func (r *NodeProblemChecker) Name() string {
+	return "NodeProblemChecker"
+}
+
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=list
+
+// Check sets the NodeProblemsNotDetected condition to False if the node
+// problem detector has set any of its conditions on a node
+func (r *NodeProblemChecker) Check(ctx context.Context) error {
+	cond := &status.Condition{
+		Type:    arov1alpha1.NodeProblemsNotDetected,
+		Status:  corev1.ConditionTrue,
+		Message: "no node problems detected",
+		Reason:  "CheckDone",
+	}
+
+	nodes, err := r.kubernetescli.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	problems := map[corev1.NodeConditionType]bool{}
+	for _, t := range nodeproblemdetector.Conditions() {
+		problems[t] = true
+	}
+
+	sb := &strings.Builder{}
+	for _, node := range nodes.Items {
+		for _, c := range node.Status.Conditions {
+			if !problems[c.Type] || c.Status != corev1.ConditionTrue {
+				continue
+			}
+
+			r.log.Warnf("%s: %s: %s", node.Name, c.Type, c.Message)
+			fmt.Fprintf(sb, "%s: %s: %s\n", node.Name, c.Type, c.Message)
+		}
+	}
+
+	if sb.Len() > 0 {
+		cond.Status = corev1.ConditionFalse
+		cond.Reason = "CheckFailed"
+		cond.Message = sb.String()
+	}
+
+	return controllers.SetCondition(ctx, r.arocli, r.recorder, cond, r.role)
+}
@@ -0,0 +1,107 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+)
+
+func TestNodeProblemCheckerCheck(t *testing.T) {
+	ctx := context.Background()
+
+	node := func(name string, conditions ...corev1.NodeCondition) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Status: corev1.NodeStatus{
+				Conditions: conditions,
+			},
+		}
+	}
+
+	for _, tt := range []struct {
+		name        string
+		nodes       []runtime.Object
+		wantStatus  corev1.ConditionStatus
+		wantMessage string
+	}{
+		{
+			name: "no problems",
+			nodes: []runtime.Object{
+				node("master-0",
+					corev1.NodeCondition{Type: "KernelDeadlock", Status: corev1.ConditionFalse},
+					corev1.NodeCondition{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+				),
+				node("worker-0"),
+			},
+			wantStatus:  corev1.ConditionTrue,
+			wantMessage: "no node problems detected",
+		},
+		{
+			name: "problems on several nodes",
+			nodes: []runtime.Object{
+				node("master-0",
+					corev1.NodeCondition{Type: "KernelDeadlock", Status: corev1.ConditionFalse},
+				),
+				node("worker-0",
+					corev1.NodeCondition{Type: "FilesystemCorrupted", Status: corev1.ConditionTrue, Message: "XFS (sda4): Corruption detected."},
+					corev1.NodeCondition{Type: corev1.NodeDiskPressure, Status: corev1.ConditionTrue},
+				),
+				node("worker-1",
+					corev1.NodeCondition{Type: "ContainerRuntimeUnhealthy", Status: corev1.ConditionTrue, Message: "crio.service: Failed with result 'exit-code'."},
+				),
+			},
+			wantStatus:  corev1.ConditionFalse,
+			wantMessage: "worker-0: FilesystemCorrupted: XFS (sda4): Corruption detected.\nworker-1: ContainerRuntimeUnhealthy: crio.service: Failed with result 'exit-code'.\n",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+			})
+
+			r := &NodeProblemChecker{
+				kubernetescli: fake.NewSimpleClientset(tt.nodes...),
+				arocli:        arocli.AroV1alpha1(),
+				log:           logrus.NewEntry(logrus.StandardLogger()),
+				role:          operator.RoleMaster,
+			}
+
+			err := r.Check(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cluster, err := arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cond := cluster.Status.Conditions.GetCondition(arov1alpha1.NodeProblemsNotDetected)
+			if cond == nil {
+				t.Fatal("condition not set")
+			}
+			if cond.Status != tt.wantStatus {
+				t.Error(cond.Status)
+			}
+			if cond.Message != tt.wantMessage {
+				t.Error(cond.Message)
+			}
+		})
+	}
+}
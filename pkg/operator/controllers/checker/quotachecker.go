@@ -0,0 +1,327 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	mgmtcompute "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure/auth"
+	machinev1beta1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
+	maoclient "github.com/openshift/machine-api-operator/pkg/generated/clientset/versioned"
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/validate"
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/cloudproviderconfig"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/compute"
+)
+
+// QuotaChecker checks that the subscription has the compute quota left for
+// the machines which the machinesets still have to create, and that their VM
+// sizes are offered to the subscription in the region and zone of each
+// machineset.  Otherwise the machines stay in Provisioning for ever.
+type QuotaChecker struct {
+	kubernetescli kubernetes.Interface
+	maocli        maoclient.Interface
+	arocli        aroclient.AroV1alpha1Interface
+	recorder      record.EventRecorder
+	log           *logrus.Entry
+	role          string
+
+	newUsageClient        func(subscriptionID string, authorizer autorest.Authorizer) compute.UsageClient
+	newResourceSkusClient func(subscriptionID string, authorizer autorest.Authorizer) compute.ResourceSkusClient
+}
+
+func NewQuotaChecker(log *logrus.Entry, kubernetescli kubernetes.Interface, maocli maoclient.Interface, arocli aroclient.AroV1alpha1Interface, recorder record.EventRecorder, role string) *QuotaChecker {
+	return &QuotaChecker{
+		kubernetescli: kubernetescli,
+		maocli:        maocli,
+		arocli:        arocli,
+		recorder:      recorder,
+		log:           log,
+		role:          role,
+
+		newUsageClient:        compute.NewUsageClient,
+		newResourceSkusClient: compute.NewResourceSkusClient,
+	}
+}
+
+func (r *QuotaChecker) Name() string {
+	return "QuotaChecker"
+}
+
+// pendingMachineSet is a machineset which has machines left to create
+type pendingMachineSet struct {
+	name    string
+	vmSize  string
+	zone    string
+	pending int
+}
+
+// Check sets the ResourceQuotaValid condition to False if the machinesets
+// would exceed the remaining compute quota of the subscription, naming each
+// quota and the machinesets which need it, or if their VM size is not
+// available in their region or zone
+func (r *QuotaChecker) Check(ctx context.Context) error {
+	mysec, err := r.kubernetescli.CoreV1().Secrets(operator.Namespace).Get(ctx, operator.SecretName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	// the secret is written by older RPs without the expected config
+	if _, found := mysec.Data[cloudproviderconfig.ConfigKey]; !found {
+		return nil
+	}
+
+	var config *cloudproviderconfig.Config
+	err = json.Unmarshal(mysec.Data[cloudproviderconfig.ConfigKey], &config)
+	if err != nil {
+		return err
+	}
+
+	machinesets, err := r.pendingMachineSets(ctx)
+	if err != nil {
+		return err
+	}
+
+	cond := &status.Condition{
+		Type:    arov1alpha1.ResourceQuotaValid,
+		Status:  corev1.ConditionTrue,
+		Message: "the machinesets fit in the compute quota of the subscription",
+		Reason:  "CheckDone",
+	}
+
+	if len(machinesets) > 0 {
+		authorizer, err := auth.NewClientCredentialsConfig(config.AADClientID, config.AADClientSecret, config.TenantID).Authorizer()
+		if err != nil {
+			return err
+		}
+
+		errs, err := r.checkQuota(ctx, r.newUsageClient(config.SubscriptionID, authorizer), config.Location, machinesets)
+		if err != nil {
+			return err
+		}
+
+		skuErrs, err := r.checkSKUs(ctx, r.newResourceSkusClient(config.SubscriptionID, authorizer), config.Location, machinesets)
+		if err != nil {
+			return err
+		}
+		errs = append(errs, skuErrs...)
+
+		if len(errs) > 0 {
+			message := strings.Join(errs, "\n") + "\n"
+			r.log.Warn(message)
+			cond.Status = corev1.ConditionFalse
+			cond.Reason = "CheckFailed"
+			cond.Message = message
+		}
+	}
+
+	return controllers.SetCondition(ctx, r.arocli, r.recorder, cond, r.role)
+}
+
+// pendingMachineSets returns the machinesets which want more replicas than
+// they have machines with a VM.  Machines without a provider ID have no VM
+// yet, so they don't count against the quota either.
+func (r *QuotaChecker) pendingMachineSets(ctx context.Context) ([]pendingMachineSet, error) {
+	machinesets, err := r.maocli.MachineV1beta1().MachineSets(machineSetsNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	machines, err := r.maocli.MachineV1beta1().Machines(machineSetsNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	provisioned := map[string]int{}
+	for _, machine := range machines.Items {
+		if machine.Spec.ProviderID != nil && *machine.Spec.ProviderID != "" {
+			provisioned[machine.Labels[machineSetLabel]]++
+		}
+	}
+
+	var pending []pendingMachineSet
+	for _, machineset := range machinesets.Items {
+		count := int(replicas(&machineset)) - provisioned[machineset.Name]
+		if count <= 0 {
+			continue
+		}
+
+		spec, err := azureProviderSpec(&machineset.Spec.Template.Spec.ProviderSpec)
+		if err != nil {
+			// the MachineChecker reports invalid provider specs
+			r.log.Warnf("machineset %s: %v", machineset.Name, err)
+			continue
+		}
+
+		ms := pendingMachineSet{
+			name:    machineset.Name,
+			vmSize:  spec.VMSize,
+			pending: count,
+		}
+		if spec.Zone != nil {
+			ms.zone = *spec.Zone
+		}
+
+		pending = append(pending, ms)
+	}
+
+	return pending, nil
+}
+
+// checkQuota returns a message for each quota of the subscription in location
+// which the pending machines of the machinesets would exceed
+func (r *QuotaChecker) checkQuota(ctx context.Context, usageClient compute.UsageClient, location string, machinesets []pendingMachineSet) ([]string, error) {
+	required := map[string]int{}
+	requiredBy := map[string][]string{}
+
+	for _, ms := range machinesets {
+		resources, err := validate.RequiredResources(api.VMSize(ms.vmSize), ms.pending)
+		if err != nil {
+			// the MachineChecker reports unsupported VM sizes
+			continue
+		}
+
+		for name, count := range resources {
+			required[name] += count
+			requiredBy[name] = append(requiredBy[name], ms.name)
+		}
+	}
+
+	if len(required) == 0 {
+		return nil, nil
+	}
+
+	usages, err := usageClient.List(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []string
+	for _, usage := range usages {
+		if usage.Name == nil || usage.Name.Value == nil || usage.Limit == nil || usage.CurrentValue == nil {
+			continue
+		}
+
+		name := *usage.Name.Value
+		available := *usage.Limit - int64(*usage.CurrentValue)
+
+		if count, found := required[name]; found && int64(count) > available {
+			errs = append(errs, fmt.Sprintf("quota %s exceeded: %d required by machinesets %s, %d of %d available", name, count, strings.Join(requiredBy[name], ", "), available, *usage.Limit))
+		}
+	}
+
+	sort.Strings(errs)
+
+	return errs, nil
+}
+
+// checkSKUs returns a message for each machineset whose VM size is not
+// offered to the subscription in location, or in the zone of the machineset
+func (r *QuotaChecker) checkSKUs(ctx context.Context, skusClient compute.ResourceSkusClient, location string, machinesets []pendingMachineSet) ([]string, error) {
+	skus, err := skusClient.List(ctx, fmt.Sprintf("location eq '%s'", location))
+	if err != nil {
+		return nil, err
+	}
+
+	vmSkus := map[string]*mgmtcompute.ResourceSku{}
+	for i := range skus {
+		if skus[i].ResourceType != nil && *skus[i].ResourceType == "virtualMachines" && skus[i].Name != nil {
+			vmSkus[strings.ToLower(*skus[i].Name)] = &skus[i]
+		}
+	}
+
+	var errs []string
+	for _, ms := range machinesets {
+		sku := vmSkus[strings.ToLower(ms.vmSize)]
+		if sku == nil || skuRestricted(sku, location, "") {
+			errs = append(errs, fmt.Sprintf("machineset %s: VM size '%s' is not available in region %s", ms.name, ms.vmSize, location))
+			continue
+		}
+
+		if ms.zone != "" && skuRestricted(sku, location, ms.zone) {
+			errs = append(errs, fmt.Sprintf("machineset %s: VM size '%s' is not available in zone %s of region %s", ms.name, ms.vmSize, ms.zone, location))
+		}
+	}
+
+	return errs, nil
+}
+
+// skuRestricted returns true if sku is not offered in location, or, if zone is
+// set, in that zone of location
+func skuRestricted(sku *mgmtcompute.ResourceSku, location, zone string) bool {
+	if zone != "" && sku.LocationInfo != nil {
+		var offered bool
+		for _, li := range *sku.LocationInfo {
+			if li.Location == nil || !strings.EqualFold(*li.Location, location) || li.Zones == nil {
+				continue
+			}
+			for _, z := range *li.Zones {
+				if z == zone {
+					offered = true
+				}
+			}
+		}
+		if !offered {
+			return true
+		}
+	}
+
+	if sku.Restrictions == nil {
+		return false
+	}
+
+	for _, restriction := range *sku.Restrictions {
+		if restriction.ReasonCode != mgmtcompute.NotAvailableForSubscription || restriction.RestrictionInfo == nil {
+			continue
+		}
+
+		switch restriction.Type {
+		case mgmtcompute.Location:
+			if zone == "" && restriction.RestrictionInfo.Locations != nil &&
+				containsFold(*restriction.RestrictionInfo.Locations, location) {
+				return true
+			}
+		case mgmtcompute.Zone:
+			if zone != "" && restriction.RestrictionInfo.Zones != nil &&
+				containsFold(*restriction.RestrictionInfo.Zones, zone) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func containsFold(haystack []string, needle string) bool {
+	for _, s := range haystack {
+		if strings.EqualFold(s, needle) {
+			return true
+		}
+	}
+	return false
+}
+
+func replicas(machineset *machinev1beta1.MachineSet) int32 {
+	if machineset.Spec.Replicas == nil {
+		return 1
+	}
+	return *machineset.Spec.Replicas
+}
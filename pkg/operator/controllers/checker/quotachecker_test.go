@@ -0,0 +1,226 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"testing"
+
+	mgmtcompute "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/golang/mock/gomock"
+	machinev1beta1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
+	maofake "github.com/openshift/machine-api-operator/pkg/generated/clientset/versioned/fake"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/compute"
+	mock_compute "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/mgmt/compute"
+)
+
+func TestQuotaCheckerCheck(t *testing.T) {
+	ctx := context.Background()
+
+	machineset := func(name, vmSize, zone string, replicas int32) *machinev1beta1.MachineSet {
+		return &machinev1beta1.MachineSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: machineSetsNamespace,
+			},
+			Spec: machinev1beta1.MachineSetSpec{
+				Replicas: &replicas,
+				Template: machinev1beta1.MachineTemplateSpec{
+					Spec: machinev1beta1.MachineSpec{
+						ProviderSpec: machinev1beta1.ProviderSpec{
+							Value: &kruntime.RawExtension{
+								Raw: []byte(`{
+"apiVersion": "azureproviderconfig.openshift.io/v1beta1",
+"kind": "AzureMachineProviderSpec",
+"vmSize": "` + vmSize + `",
+"zone": "` + zone + `"
+}`),
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	machine := func(name, machineset string, provisioned bool) *machinev1beta1.Machine {
+		m := &machinev1beta1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: machineSetsNamespace,
+				Labels:    map[string]string{machineSetLabel: machineset},
+			},
+		}
+		if provisioned {
+			m.Spec.ProviderID = to.StringPtr("azure:///" + name)
+		}
+		return m
+	}
+
+	usage := func(name string, current int32, limit int64) mgmtcompute.Usage {
+		return mgmtcompute.Usage{
+			Name:         &mgmtcompute.UsageName{Value: to.StringPtr(name)},
+			CurrentValue: to.Int32Ptr(current),
+			Limit:        to.Int64Ptr(limit),
+		}
+	}
+
+	sku := func(name string, zones []string, restrictions []mgmtcompute.ResourceSkuRestrictions) mgmtcompute.ResourceSku {
+		return mgmtcompute.ResourceSku{
+			Name:         to.StringPtr(name),
+			ResourceType: to.StringPtr("virtualMachines"),
+			LocationInfo: &[]mgmtcompute.ResourceSkuLocationInfo{
+				{
+					Location: to.StringPtr("eastus"),
+					Zones:    &zones,
+				},
+			},
+			Restrictions: &restrictions,
+		}
+	}
+
+	for _, tt := range []struct {
+		name        string
+		objects     []kruntime.Object
+		mocks       func(*mock_compute.MockUsageClient, *mock_compute.MockResourceSkusClient)
+		wantStatus  corev1.ConditionStatus
+		wantMessage string
+	}{
+		{
+			name: "no machines pending",
+			objects: []kruntime.Object{
+				machineset("worker-eastus1", "Standard_D4s_v3", "1", 1),
+				machine("worker-eastus1-abcde", "worker-eastus1", true),
+			},
+			mocks:       func(*mock_compute.MockUsageClient, *mock_compute.MockResourceSkusClient) {},
+			wantStatus:  corev1.ConditionTrue,
+			wantMessage: "the machinesets fit in the compute quota of the subscription",
+		},
+		{
+			name: "pending machines fit",
+			objects: []kruntime.Object{
+				machineset("worker-eastus1", "Standard_D4s_v3", "1", 2),
+				machine("worker-eastus1-abcde", "worker-eastus1", true),
+				machine("worker-eastus1-fghij", "worker-eastus1", false),
+			},
+			mocks: func(usageClient *mock_compute.MockUsageClient, skusClient *mock_compute.MockResourceSkusClient) {
+				usageClient.EXPECT().List(gomock.Any(), "eastus").Return([]mgmtcompute.Usage{
+					usage("standardDSv3Family", 92, 100),
+					usage("cores", 92, 100),
+				}, nil)
+				skusClient.EXPECT().List(gomock.Any(), "location eq 'eastus'").Return([]mgmtcompute.ResourceSku{
+					sku("Standard_D4s_v3", []string{"1", "2", "3"}, nil),
+				}, nil)
+			},
+			wantStatus:  corev1.ConditionTrue,
+			wantMessage: "the machinesets fit in the compute quota of the subscription",
+		},
+		{
+			name: "quota exceeded and VM sizes unavailable",
+			objects: []kruntime.Object{
+				machineset("worker-eastus1", "Standard_D4s_v3", "1", 3),
+				machineset("worker-eastus2", "Standard_D8s_v3", "2", 1),
+				machineset("big-eastus3", "Standard_E8s_v3", "3", 1),
+			},
+			mocks: func(usageClient *mock_compute.MockUsageClient, skusClient *mock_compute.MockResourceSkusClient) {
+				usageClient.EXPECT().List(gomock.Any(), "eastus").Return([]mgmtcompute.Usage{
+					usage("standardDSv3Family", 92, 100),
+					usage("standardESv3Family", 0, 100),
+					usage("cores", 92, 110),
+				}, nil)
+				skusClient.EXPECT().List(gomock.Any(), "location eq 'eastus'").Return([]mgmtcompute.ResourceSku{
+					sku("Standard_D4s_v3", []string{"1", "2", "3"}, nil),
+					sku("Standard_D8s_v3", []string{"1", "2", "3"}, []mgmtcompute.ResourceSkuRestrictions{
+						{
+							Type:       mgmtcompute.Zone,
+							ReasonCode: mgmtcompute.NotAvailableForSubscription,
+							RestrictionInfo: &mgmtcompute.ResourceSkuRestrictionInfo{
+								Zones: &[]string{"2"},
+							},
+						},
+					}),
+				}, nil)
+			},
+			wantStatus: corev1.ConditionFalse,
+			wantMessage: "quota cores exceeded: 28 required by machinesets worker-eastus1, worker-eastus2, big-eastus3, 18 of 110 available\n" +
+				"quota standardDSv3Family exceeded: 20 required by machinesets worker-eastus1, worker-eastus2, 8 of 100 available\n" +
+				"machineset worker-eastus2: VM size 'Standard_D8s_v3' is not available in zone 2 of region eastus\n" +
+				"machineset big-eastus3: VM size 'Standard_E8s_v3' is not available in region eastus\n",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			kubernetescli := fake.NewSimpleClientset(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      operator.SecretName,
+					Namespace: operator.Namespace,
+				},
+				Data: map[string][]byte{
+					"cloudProviderConfig": []byte(`{"tenantId":"tenant","subscriptionId":"subscription","resourceGroup":"cluster-rg","location":"eastus","aadClientId":"client","aadClientSecret":"secret"}`),
+				},
+			})
+
+			arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+			})
+
+			usageClient := mock_compute.NewMockUsageClient(controller)
+			skusClient := mock_compute.NewMockResourceSkusClient(controller)
+			tt.mocks(usageClient, skusClient)
+
+			r := &QuotaChecker{
+				kubernetescli: kubernetescli,
+				maocli:        maofake.NewSimpleClientset(tt.objects...),
+				arocli:        arocli.AroV1alpha1(),
+				log:           logrus.NewEntry(logrus.StandardLogger()),
+				role:          operator.RoleMaster,
+				newUsageClient: func(subscriptionID string, authorizer autorest.Authorizer) compute.UsageClient {
+					if subscriptionID != "subscription" {
+						t.Error(subscriptionID)
+					}
+					return usageClient
+				},
+				newResourceSkusClient: func(subscriptionID string, authorizer autorest.Authorizer) compute.ResourceSkusClient {
+					return skusClient
+				},
+			}
+
+			err := r.Check(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cluster, err := arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cond := cluster.Status.Conditions.GetCondition(arov1alpha1.ResourceQuotaValid)
+			if cond == nil {
+				t.Fatal("condition not set")
+			}
+			if cond.Status != tt.wantStatus {
+				t.Error(cond.Status)
+			}
+			if cond.Message != tt.wantMessage {
+				t.Error(cond.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/cloudproviderconfig"
+)
+
+// credentialsSecrets are the secrets holding the cluster service principal
+// which the cloud credential operator and the machine API use to manage Azure
+// resources.  If they are edited to hold another service principal, scaling
+// and machine replacement fail without an obvious cause.
+var credentialsSecrets = []types.NamespacedName{
+	{Namespace: "kube-system", Name: "azure-credentials"},
+	{Namespace: "openshift-machine-api", Name: "azure-cloud-credentials"},
+}
+
+// ServicePrincipalChecker compares the service principal in the cluster
+// credentials secrets with the one recorded by the RP
+type ServicePrincipalChecker struct {
+	kubernetescli kubernetes.Interface
+	arocli        aroclient.AroV1alpha1Interface
+	recorder      record.EventRecorder
+	log           *logrus.Entry
+	role          string
+}
+
+func NewServicePrincipalChecker(log *logrus.Entry, kubernetescli kubernetes.Interface, arocli aroclient.AroV1alpha1Interface, recorder record.EventRecorder, role string) *ServicePrincipalChecker {
+	return &ServicePrincipalChecker{
+		kubernetescli: kubernetescli,
+		arocli:        arocli,
+		recorder:      recorder,
+		log:           log,
+		role:          role,
+	}
+}
+
+func (r *ServicePrincipalChecker) Name() string {
+	return "ServicePrincipalChecker"
+}
+
+// Check sets the ServicePrincipalValid condition to False if a credentials
+// secret is missing or holds a client ID or tenant other than the RP's
+func (r *ServicePrincipalChecker) Check(ctx context.Context) error {
+	mysec, err := r.kubernetescli.CoreV1().Secrets(operator.Namespace).Get(ctx, operator.SecretName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	// the secret is written by older RPs without the expected config
+	if _, found := mysec.Data[cloudproviderconfig.ConfigKey]; !found {
+		return nil
+	}
+
+	var config *cloudproviderconfig.Config
+	err = json.Unmarshal(mysec.Data[cloudproviderconfig.ConfigKey], &config)
+	if err != nil {
+		return err
+	}
+
+	cond := &status.Condition{
+		Type:    arov1alpha1.ServicePrincipalValid,
+		Status:  corev1.ConditionTrue,
+		Message: "service principal credentials match",
+		Reason:  "CheckDone",
+	}
+
+	sb := &strings.Builder{}
+	for _, name := range credentialsSecrets {
+		s, err := r.kubernetescli.CoreV1().Secrets(name.Namespace).Get(ctx, name.Name, metav1.GetOptions{})
+		if kerrors.IsNotFound(err) {
+			fmt.Fprintf(sb, "%s: secret not found\n", name)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		// client IDs and tenants are GUIDs, whose case is not significant
+		for _, field := range []struct {
+			key  string
+			want string
+		}{
+			{key: "azure_client_id", want: config.AADClientID},
+			{key: "azure_tenant_id", want: config.TenantID},
+		} {
+			if !strings.EqualFold(string(s.Data[field.key]), field.want) {
+				fmt.Fprintf(sb, "%s: %s '%s' does not match '%s'\n", name, field.key, string(s.Data[field.key]), field.want)
+			}
+		}
+	}
+
+	if sb.Len() > 0 {
+		r.log.Warn(sb.String())
+		cond.Status = corev1.ConditionFalse
+		cond.Reason = "CheckFailed"
+		cond.Message = sb.String()
+	}
+
+	return controllers.SetCondition(ctx, r.arocli, r.recorder, cond, r.role)
+}
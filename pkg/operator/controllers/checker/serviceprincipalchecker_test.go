@@ -0,0 +1,133 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+)
+
+func TestServicePrincipalCheckerCheck(t *testing.T) {
+	ctx := context.Background()
+
+	secret := func(namespace, name string, data map[string]string) *corev1.Secret {
+		s := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			Data: map[string][]byte{},
+		}
+		for k, v := range data {
+			s.Data[k] = []byte(v)
+		}
+		return s
+	}
+
+	operatorSecret := secret(operator.Namespace, operator.SecretName, map[string]string{
+		"cloudProviderConfig": `{"tenantId":"tenant","aadClientId":"client"}`,
+	})
+
+	credentials := func(namespace, name, clientID string) *corev1.Secret {
+		return secret(namespace, name, map[string]string{
+			"azure_client_id": clientID,
+			"azure_tenant_id": "tenant",
+		})
+	}
+
+	for _, tt := range []struct {
+		name        string
+		objects     []runtime.Object
+		wantStatus  corev1.ConditionStatus
+		wantMessage string
+	}{
+		{
+			name: "credentials match",
+			objects: []runtime.Object{
+				operatorSecret,
+				credentials("kube-system", "azure-credentials", "client"),
+				credentials("openshift-machine-api", "azure-cloud-credentials", "CLIENT"),
+			},
+			wantStatus:  corev1.ConditionTrue,
+			wantMessage: "service principal credentials match",
+		},
+		{
+			name: "credentials edited",
+			objects: []runtime.Object{
+				operatorSecret,
+				credentials("kube-system", "azure-credentials", "client"),
+				credentials("openshift-machine-api", "azure-cloud-credentials", "other"),
+			},
+			wantStatus:  corev1.ConditionFalse,
+			wantMessage: "openshift-machine-api/azure-cloud-credentials: azure_client_id 'other' does not match 'client'\n",
+		},
+		{
+			name: "credentials missing",
+			objects: []runtime.Object{
+				operatorSecret,
+				credentials("openshift-machine-api", "azure-cloud-credentials", "client"),
+			},
+			wantStatus:  corev1.ConditionFalse,
+			wantMessage: "kube-system/azure-credentials: secret not found\n",
+		},
+		{
+			name: "no config recorded by the RP",
+			objects: []runtime.Object{
+				secret(operator.Namespace, operator.SecretName, nil),
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+			})
+
+			r := &ServicePrincipalChecker{
+				kubernetescli: fake.NewSimpleClientset(tt.objects...),
+				arocli:        arocli.AroV1alpha1(),
+				log:           logrus.NewEntry(logrus.StandardLogger()),
+				role:          operator.RoleMaster,
+			}
+
+			err := r.Check(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cluster, err := arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cond := cluster.Status.Conditions.GetCondition(arov1alpha1.ServicePrincipalValid)
+			if tt.wantStatus == "" {
+				if cond != nil {
+					t.Error(cond)
+				}
+				return
+			}
+			if cond == nil {
+				t.Fatal("condition not set")
+			}
+			if cond.Status != tt.wantStatus {
+				t.Error(cond.Status)
+			}
+			if cond.Message != tt.wantMessage {
+				t.Error(cond.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+)
+
+const (
+	// throttlingWindowSeconds matches the hourly requeue of the checker
+	// controller, so that each log line is looked at once
+	throttlingWindowSeconds = 3600
+
+	// throttlingThreshold is the number of throttled responses a component
+	// may log in the window before the throttling is considered sustained
+	throttlingThreshold = 10
+)
+
+// rxThrottled matches the ways in which the Azure SDK and the cloud provider
+// log an HTTP 429 response from ARM
+var rxThrottled = regexp.MustCompile(`StatusCode(=|: ?)429\b|TooManyRequests`)
+
+// throttlingComponent is an in-cluster component which calls ARM and whose
+// logs record the throttled responses it receives
+type throttlingComponent struct {
+	name      string
+	namespace string
+	selector  string
+	container string
+}
+
+var throttlingComponents = []throttlingComponent{
+	{
+		// the Azure cloud provider runs in kube-controller-manager, and
+		// reconciles load balancers, routes and disks
+		name:      "kube-controller-manager",
+		namespace: "openshift-kube-controller-manager",
+		selector:  "app=kube-controller-manager",
+		container: "kube-controller-manager",
+	},
+	{
+		name:      "machine-api-controllers",
+		namespace: "openshift-machine-api",
+		selector:  "k8s-app=controller",
+		container: "machine-controller",
+	},
+}
+
+// ThrottlingChecker reports sustained ARM throttling of in-cluster components.
+// Throttled components retry silently, so without this check the only
+// symptom is that load balancer and machine reconciliation falls behind.
+type ThrottlingChecker struct {
+	kubernetescli kubernetes.Interface
+	arocli        aroclient.AroV1alpha1Interface
+	recorder      record.EventRecorder
+	log           *logrus.Entry
+	role          string
+
+	podLogs func(ctx context.Context, namespace, name, container string) (io.ReadCloser, error)
+}
+
+func NewThrottlingChecker(log *logrus.Entry, kubernetescli kubernetes.Interface, arocli aroclient.AroV1alpha1Interface, recorder record.EventRecorder, role string) *ThrottlingChecker {
+	r := &ThrottlingChecker{
+		kubernetescli: kubernetescli,
+		arocli:        arocli,
+		recorder:      recorder,
+		log:           log,
+		role:          role,
+	}
+
+	r.podLogs = r.recentPodLogs
+
+	return r
+}
+
+func (r *ThrottlingChecker) Name() string {
+	return "ThrottlingChecker"
+}
+
+// +kubebuilder:rbac:groups="",resources=pods,verbs=list
+// +kubebuilder:rbac:groups="",resources=pods/log,verbs=get
+
+// Check counts the throttled ARM responses logged by each component in the
+// last window and sets the AzureAPINotThrottled condition accordingly
+func (r *ThrottlingChecker) Check(ctx context.Context) error {
+	cond := &status.Condition{
+		Type:    arov1alpha1.AzureAPINotThrottled,
+		Status:  corev1.ConditionTrue,
+		Message: "no sustained Azure API throttling",
+		Reason:  "CheckDone",
+	}
+
+	sb := &strings.Builder{}
+	for _, c := range throttlingComponents {
+		count, err := r.throttledResponses(ctx, &c)
+		if err != nil {
+			return err
+		}
+
+		if count >= throttlingThreshold {
+			r.log.Warnf("%s: %d throttled Azure API responses", c.name, count)
+			fmt.Fprintf(sb, "%s: %d throttled Azure API responses in the last hour\n", c.name, count)
+		}
+	}
+
+	if sb.Len() > 0 {
+		cond.Status = corev1.ConditionFalse
+		cond.Reason = "CheckFailed"
+		cond.Message = sb.String()
+	}
+
+	return controllers.SetCondition(ctx, r.arocli, r.recorder, cond, r.role)
+}
+
+// throttledResponses returns the number of throttled responses logged in
+// the last window across all the pods of the component
+func (r *ThrottlingChecker) throttledResponses(ctx context.Context, c *throttlingComponent) (int, error) {
+	pods, err := r.kubernetescli.CoreV1().Pods(c.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: c.selector,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var total int
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+
+		rc, err := r.podLogs(ctx, pod.Namespace, pod.Name, c.container)
+		if err != nil {
+			return 0, err
+		}
+
+		count, err := countThrottled(rc)
+		rc.Close()
+		if err != nil {
+			return 0, err
+		}
+
+		total += count
+	}
+
+	return total, nil
+}
+
+func (r *ThrottlingChecker) recentPodLogs(ctx context.Context, namespace, name, container string) (io.ReadCloser, error) {
+	sinceSeconds := int64(throttlingWindowSeconds)
+
+	return r.kubernetescli.CoreV1().Pods(namespace).GetLogs(name, &corev1.PodLogOptions{
+		Container:    container,
+		SinceSeconds: &sinceSeconds,
+	}).Stream(ctx)
+}
+
+// countThrottled returns the number of log lines in rc which record a
+// throttled response
+func countThrottled(rc io.Reader) (int, error) {
+	var count int
+
+	scanner := bufio.NewScanner(rc)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if rxThrottled.Match(scanner.Bytes()) {
+			count++
+		}
+	}
+
+	return count, scanner.Err()
+}
@@ -0,0 +1,141 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+)
+
+func TestCountThrottled(t *testing.T) {
+	logs := `I1117 10:00:00.000000       1 controller.go:100] reconciling machine foo
+E1117 10:00:01.000000       1 actuator.go:80] failed to reconcile: compute.VirtualMachinesClient#Get: Failure responding to request: StatusCode=429 -- Original Error: autorest/azure: Service returned an error. Status=429 Code="TooManyRequests"
+E1117 10:00:02.000000       1 azure_backoff.go:136] GetVirtualMachineWithRetry(foo): backoff failure, will retry, err=Retriable: true, RetryAfter: 30s, HTTPStatusCode: 429, RawError: ...
+I1117 10:00:03.000000       1 controller.go:100] StatusCode=4290 is not a throttling response
+`
+
+	count, err := countThrottled(strings.NewReader(logs))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error(count)
+	}
+}
+
+func TestThrottlingCheckerCheck(t *testing.T) {
+	ctx := context.Background()
+
+	pod := func(namespace, name, app string, phase corev1.PodPhase) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+				Labels:    map[string]string{"app": app, "k8s-app": app},
+			},
+			Status: corev1.PodStatus{
+				Phase: phase,
+			},
+		}
+	}
+
+	throttled := strings.Repeat("StatusCode=429\n", throttlingThreshold)
+
+	for _, tt := range []struct {
+		name        string
+		logs        map[string]string
+		wantStatus  corev1.ConditionStatus
+		wantMessage string
+	}{
+		{
+			name: "not throttled",
+			logs: map[string]string{
+				"kube-controller-manager-master-0": "StatusCode=429\n",
+			},
+			wantStatus:  corev1.ConditionTrue,
+			wantMessage: "no sustained Azure API throttling",
+		},
+		{
+			name: "throttling summed across pods",
+			logs: map[string]string{
+				"kube-controller-manager-master-0": strings.Repeat("StatusCode=429\n", throttlingThreshold/2),
+				"kube-controller-manager-master-1": strings.Repeat("StatusCode=429\n", throttlingThreshold/2),
+			},
+			wantStatus:  corev1.ConditionFalse,
+			wantMessage: "kube-controller-manager: 10 throttled Azure API responses in the last hour\n",
+		},
+		{
+			name: "pods which are not running are ignored",
+			logs: map[string]string{
+				"machine-api-controllers-pending": throttled,
+			},
+			wantStatus:  corev1.ConditionTrue,
+			wantMessage: "no sustained Azure API throttling",
+		},
+		{
+			name: "machine api throttled",
+			logs: map[string]string{
+				"machine-api-controllers-0": throttled,
+			},
+			wantStatus:  corev1.ConditionFalse,
+			wantMessage: "machine-api-controllers: 10 throttled Azure API responses in the last hour\n",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+			})
+
+			r := &ThrottlingChecker{
+				kubernetescli: fake.NewSimpleClientset(
+					pod("openshift-kube-controller-manager", "kube-controller-manager-master-0", "kube-controller-manager", corev1.PodRunning),
+					pod("openshift-kube-controller-manager", "kube-controller-manager-master-1", "kube-controller-manager", corev1.PodRunning),
+					pod("openshift-machine-api", "machine-api-controllers-0", "controller", corev1.PodRunning),
+					pod("openshift-machine-api", "machine-api-controllers-pending", "controller", corev1.PodPending),
+				),
+				arocli: arocli.AroV1alpha1(),
+				log:    logrus.NewEntry(logrus.StandardLogger()),
+				role:   operator.RoleMaster,
+				podLogs: func(ctx context.Context, namespace, name, container string) (io.ReadCloser, error) {
+					return ioutil.NopCloser(strings.NewReader(tt.logs[name])), nil
+				},
+			}
+
+			err := r.Check(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cluster, err := arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cond := cluster.Status.Conditions.GetCondition(arov1alpha1.AzureAPINotThrottled)
+			if cond == nil {
+				t.Fatal("condition not set")
+			}
+			if cond.Status != tt.wantStatus {
+				t.Error(cond.Status)
+			}
+			if cond.Message != tt.wantMessage {
+				t.Error(cond.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/azure/auth"
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/cloudproviderconfig"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/network"
+	"github.com/Azure/ARO-RP/pkg/util/resolver"
+)
+
+// vnetDNSServerTimeout bounds the lookups against each DNS server
+const vnetDNSServerTimeout = 30 * time.Second
+
+// VnetDNSChecker checks that the custom DNS servers set on the cluster VNet
+// resolve the endpoints which the cluster depends on.  The RP can only check
+// DNS servers which it can reach when the cluster is created or updated, and
+// customers change the DNS servers of their VNets afterwards: nodes pick the
+// change up when they renew their DHCP lease or reboot, at which point they
+// can no longer pull images or authenticate.
+type VnetDNSChecker struct {
+	kubernetescli kubernetes.Interface
+	arocli        aroclient.AroV1alpha1Interface
+	recorder      record.EventRecorder
+	log           *logrus.Entry
+	role          string
+
+	newVirtualNetworksClient func(subscriptionID string, authorizer autorest.Authorizer) network.VirtualNetworksClient
+	newResolver              func(server string) resolver.Resolver
+}
+
+func NewVnetDNSChecker(log *logrus.Entry, kubernetescli kubernetes.Interface, arocli aroclient.AroV1alpha1Interface, recorder record.EventRecorder, role string) *VnetDNSChecker {
+	return &VnetDNSChecker{
+		kubernetescli: kubernetescli,
+		arocli:        arocli,
+		recorder:      recorder,
+		log:           log,
+		role:          role,
+
+		newVirtualNetworksClient: network.NewVirtualNetworksClient,
+		newResolver:              resolver.NewForServer,
+	}
+}
+
+func (r *VnetDNSChecker) Name() string {
+	return "VnetDNSChecker"
+}
+
+// Check sets the VnetDNSServersValid condition to False if a custom DNS
+// server of the cluster VNet can't be reached or doesn't resolve one of the
+// names in the cluster's DNSChecker spec
+func (r *VnetDNSChecker) Check(ctx context.Context) error {
+	instance, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	// the spec is set by older RPs without the DNS checker
+	if instance.Spec.DNSChecker.VnetID == "" || len(instance.Spec.DNSChecker.Names) == 0 {
+		return nil
+	}
+
+	vnetr, err := azure.ParseResourceID(instance.Spec.DNSChecker.VnetID)
+	if err != nil {
+		return err
+	}
+
+	mysec, err := r.kubernetescli.CoreV1().Secrets(operator.Namespace).Get(ctx, operator.SecretName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	// the secret is written by older RPs without the expected config
+	if _, found := mysec.Data[cloudproviderconfig.ConfigKey]; !found {
+		return nil
+	}
+
+	var config *cloudproviderconfig.Config
+	err = json.Unmarshal(mysec.Data[cloudproviderconfig.ConfigKey], &config)
+	if err != nil {
+		return err
+	}
+
+	authorizer, err := auth.NewClientCredentialsConfig(config.AADClientID, config.AADClientSecret, config.TenantID).Authorizer()
+	if err != nil {
+		return err
+	}
+
+	vnet, err := r.newVirtualNetworksClient(vnetr.SubscriptionID, authorizer).Get(ctx, vnetr.ResourceGroup, vnetr.ResourceName, "")
+	if err != nil {
+		return err
+	}
+
+	cond := &status.Condition{
+		Type:    arov1alpha1.VnetDNSServersValid,
+		Status:  corev1.ConditionTrue,
+		Message: "the VNet uses Azure DNS",
+		Reason:  "CheckDone",
+	}
+
+	if vnet.VirtualNetworkPropertiesFormat != nil &&
+		vnet.DhcpOptions != nil &&
+		vnet.DhcpOptions.DNSServers != nil &&
+		len(*vnet.DhcpOptions.DNSServers) > 0 {
+		cond.Message = "the custom DNS servers of the VNet resolve all required names"
+
+		if message := r.dnsServersMessage(ctx, *vnet.DhcpOptions.DNSServers, instance.Spec.DNSChecker.Names); message != "" {
+			r.log.Warn(message)
+			cond.Status = corev1.ConditionFalse
+			cond.Reason = "CheckFailed"
+			cond.Message = message
+		}
+	}
+
+	return controllers.SetCondition(ctx, r.arocli, r.recorder, cond, r.role)
+}
+
+// dnsServersMessage returns a line for each of servers which is not
+// reachable or doesn't resolve all of names, or "" if there is none
+func (r *VnetDNSChecker) dnsServersMessage(ctx context.Context, servers, names []string) string {
+	var sb strings.Builder
+
+	for _, server := range servers {
+		timeoutCtx, cancel := context.WithTimeout(ctx, vnetDNSServerTimeout)
+		unresolvable, err := resolver.Unresolvable(timeoutCtx, r.newResolver(server), names)
+		cancel()
+
+		switch {
+		case err != nil:
+			fmt.Fprintf(&sb, "DNS server %s is not reachable: %v\n", server, err)
+		case len(unresolvable) > 0:
+			fmt.Fprintf(&sb, "DNS server %s cannot resolve %s\n", server, strings.Join(unresolvable, ", "))
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,162 @@
+package checker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	mgmtnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-07-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/network"
+	mock_network "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/mgmt/network"
+	"github.com/Azure/ARO-RP/pkg/util/resolver"
+)
+
+type fakeResolver map[string]error
+
+func (f fakeResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	if err := f[host]; err != nil {
+		return nil, err
+	}
+
+	return []string{"1.2.3.4"}, nil
+}
+
+func TestVnetDNSCheckerCheck(t *testing.T) {
+	ctx := context.Background()
+
+	vnetID := "/subscriptions/subscription/resourceGroups/vnet-rg/providers/Microsoft.Network/virtualNetworks/vnet"
+
+	for _, tt := range []struct {
+		name        string
+		servers     *[]string
+		resolvers   map[string]fakeResolver
+		wantStatus  corev1.ConditionStatus
+		wantMessage string
+	}{
+		{
+			name:        "azure dns",
+			wantStatus:  corev1.ConditionTrue,
+			wantMessage: "the VNet uses Azure DNS",
+		},
+		{
+			name:    "custom dns resolves all names",
+			servers: &[]string{"10.0.0.4", "10.0.0.5"},
+			resolvers: map[string]fakeResolver{
+				"10.0.0.4": {},
+				"10.0.0.5": {},
+			},
+			wantStatus:  corev1.ConditionTrue,
+			wantMessage: "the custom DNS servers of the VNet resolve all required names",
+		},
+		{
+			name:    "custom dns fails",
+			servers: &[]string{"10.0.0.4", "10.0.0.5", "10.0.0.6"},
+			resolvers: map[string]fakeResolver{
+				"10.0.0.4": {
+					"arosvc.azurecr.io":             &net.DNSError{Err: "no such host", IsNotFound: true},
+					"arosvc.eastus.data.azurecr.io": &net.DNSError{Err: "no such host", IsNotFound: true},
+				},
+				"10.0.0.5": {},
+				"10.0.0.6": {
+					"arosvc.azurecr.io": &net.DNSError{Err: "i/o timeout", Name: "arosvc.azurecr.io", Server: "10.0.0.6:53", IsTimeout: true},
+				},
+			},
+			wantStatus:  corev1.ConditionFalse,
+			wantMessage: "DNS server 10.0.0.4 cannot resolve arosvc.azurecr.io, arosvc.eastus.data.azurecr.io\nDNS server 10.0.0.6 is not reachable: lookup arosvc.azurecr.io on 10.0.0.6:53: i/o timeout\n",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			kubernetescli := fake.NewSimpleClientset(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      operator.SecretName,
+					Namespace: operator.Namespace,
+				},
+				Data: map[string][]byte{
+					"cloudProviderConfig": []byte(`{"tenantId":"tenant","subscriptionId":"subscription","resourceGroup":"cluster-rg","aadClientId":"client","aadClientSecret":"secret"}`),
+				},
+			})
+
+			arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					DNSChecker: arov1alpha1.DNSCheckerSpec{
+						VnetID: vnetID,
+						Names: []string{
+							"arosvc.azurecr.io",
+							"arosvc.eastus.data.azurecr.io",
+							"management.azure.com",
+						},
+					},
+				},
+			})
+
+			virtualNetworks := mock_network.NewMockVirtualNetworksClient(controller)
+			virtualNetworks.EXPECT().Get(gomock.Any(), "vnet-rg", "vnet", "").Return(mgmtnetwork.VirtualNetwork{
+				VirtualNetworkPropertiesFormat: &mgmtnetwork.VirtualNetworkPropertiesFormat{
+					DhcpOptions: &mgmtnetwork.DhcpOptions{
+						DNSServers: tt.servers,
+					},
+				},
+			}, nil)
+
+			r := &VnetDNSChecker{
+				kubernetescli: kubernetescli,
+				arocli:        arocli.AroV1alpha1(),
+				log:           logrus.NewEntry(logrus.StandardLogger()),
+				role:          operator.RoleMaster,
+				newVirtualNetworksClient: func(subscriptionID string, authorizer autorest.Authorizer) network.VirtualNetworksClient {
+					if subscriptionID != "subscription" {
+						t.Error(subscriptionID)
+					}
+					return virtualNetworks
+				},
+				newResolver: func(server string) resolver.Resolver {
+					r, found := tt.resolvers[server]
+					if !found {
+						t.Fatalf("unexpected query to %s", server)
+					}
+					return r
+				},
+			}
+
+			err := r.Check(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cluster, err := arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cond := cluster.Status.Conditions.GetCondition(arov1alpha1.VnetDNSServersValid)
+			if cond == nil {
+				t.Fatal("condition not set")
+			}
+			if cond.Status != tt.wantStatus {
+				t.Error(cond.Status)
+			}
+			if cond.Message != tt.wantMessage {
+				t.Error(cond.Message)
+			}
+		})
+	}
+}
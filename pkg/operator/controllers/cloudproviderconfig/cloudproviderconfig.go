@@ -0,0 +1,124 @@
+package cloudproviderconfig
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"github.com/ghodss/yaml"
+)
+
+// ConfigKey is the key in the operator secret under which the RP stores the
+// expected cloud provider config
+const ConfigKey = "cloudProviderConfig"
+
+// Config holds the values of the Azure cloud provider config which are known
+// to the RP.  The cloud provider reads the service principal from the
+// azure-cloud-provider secret; the remaining fields live in cloud.conf.
+type Config struct {
+	Cloud          string `json:"cloud,omitempty"`
+	TenantID       string `json:"tenantId,omitempty"`
+	SubscriptionID string `json:"subscriptionId,omitempty"`
+	ResourceGroup  string `json:"resourceGroup,omitempty"`
+	Location       string `json:"location,omitempty"`
+
+	AADClientID     string `json:"aadClientId,omitempty"`
+	AADClientSecret string `json:"aadClientSecret,omitempty"`
+}
+
+// cloudConf returns the cloud.conf fields which the operator enforces.  The
+// installer leaves vmType empty, which the cloud provider treats as
+// "standard"; "vmss" breaks LoadBalancer services and disk attach on ARO
+// nodes, which are not in scale sets.
+func (c *Config) cloudConf() map[string]interface{} {
+	return map[string]interface{}{
+		"cloud":                       c.Cloud,
+		"tenantId":                    c.TenantID,
+		"subscriptionId":              c.SubscriptionID,
+		"resourceGroup":               c.ResourceGroup,
+		"location":                    c.Location,
+		"useManagedIdentityExtension": false,
+		"loadBalancerSku":             "standard",
+		"vmType":                      "",
+	}
+}
+
+// credentials returns the fields of the azure-cloud-provider secret
+func (c *Config) credentials() map[string]interface{} {
+	return map[string]interface{}{
+		"aadClientId":     c.AADClientID,
+		"aadClientSecret": c.AADClientSecret,
+	}
+}
+
+// merge overwrites the fields of have which differ from want and returns the
+// names of the fields which it changed
+func merge(have, want map[string]interface{}) []string {
+	var changed []string
+
+	for k, v := range want {
+		if !reflect.DeepEqual(have[k], v) {
+			have[k] = v
+			changed = append(changed, k)
+		}
+	}
+
+	sort.Strings(changed)
+
+	return changed
+}
+
+// fixCloudConf corrects the enforced fields of the JSON cloud.conf
+func fixCloudConf(data string, c *Config) (string, []string, error) {
+	have := map[string]interface{}{}
+
+	err := json.Unmarshal([]byte(data), &have)
+	if err != nil {
+		return "", nil, err
+	}
+	if have == nil {
+		have = map[string]interface{}{}
+	}
+
+	changed := merge(have, c.cloudConf())
+	if changed == nil {
+		return data, nil, nil
+	}
+
+	// match the indentation of the installer
+	b, err := json.MarshalIndent(have, "", "\t")
+	if err != nil {
+		return "", nil, err
+	}
+
+	return string(b) + "\n", changed, nil
+}
+
+// fixCredentials corrects the service principal in the YAML cloud-config of
+// the azure-cloud-provider secret
+func fixCredentials(data []byte, c *Config) ([]byte, []string, error) {
+	have := map[string]interface{}{}
+
+	err := yaml.Unmarshal(data, &have)
+	if err != nil {
+		return nil, nil, err
+	}
+	if have == nil {
+		have = map[string]interface{}{}
+	}
+
+	changed := merge(have, c.credentials())
+	if changed == nil {
+		return data, nil, nil
+	}
+
+	b, err := yaml.Marshal(have)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return b, changed, nil
+}
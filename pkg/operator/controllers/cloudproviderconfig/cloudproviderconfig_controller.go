@@ -0,0 +1,213 @@
+package cloudproviderconfig
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+)
+
+var (
+	// cloudConfigName is the cloud.conf from which the kube-controller-manager
+	// operator and the MCO render the cloud provider config of the cluster
+	cloudConfigName = types.NamespacedName{Name: "cloud-provider-config", Namespace: "openshift-config"}
+
+	// credentialsName is the secret from which the cloud provider reads its
+	// service principal
+	credentialsName = types.NamespacedName{Name: "azure-cloud-provider", Namespace: "kube-system"}
+)
+
+const (
+	cloudConfigKey = "config"
+	credentialsKey = "cloud-config"
+)
+
+// CloudProviderConfigReconciler repairs the Azure cloud provider config if it
+// no longer matches the values known to the RP
+type CloudProviderConfigReconciler struct {
+	kubernetescli kubernetes.Interface
+	arocli        aroclient.AroV1alpha1Interface
+	log           *logrus.Entry
+}
+
+func NewReconciler(log *logrus.Entry, kubernetescli kubernetes.Interface, arocli aroclient.AroV1alpha1Interface) *CloudProviderConfigReconciler {
+	return &CloudProviderConfigReconciler{
+		kubernetescli: kubernetescli,
+		arocli:        arocli,
+		log:           log,
+	}
+}
+
+// Reconcile makes sure that the RP-known fields of cloud.conf and the service
+// principal in the azure-cloud-provider secret are correct.
+func (r *CloudProviderConfigReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
+	// TODO(mj): controller-runtime master fixes the need for this (https://github.com/kubernetes-sigs/controller-runtime/blob/master/pkg/reconcile/reconcile.go#L93) but it's not yet released.
+	ctx := context.Background()
+
+	instance, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if !controllers.FlagEnabled(instance, operator.FlagCloudProviderConfigEnabled) {
+		r.log.Debug("cloud provider config repair is disabled")
+		return reconcile.Result{}, nil
+	}
+
+	mysec, err := r.kubernetescli.CoreV1().Secrets(operator.Namespace).Get(ctx, operator.SecretName, metav1.GetOptions{})
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// the secret is written by older RPs without the expected config
+	if _, found := mysec.Data[ConfigKey]; !found {
+		return reconcile.Result{}, nil
+	}
+
+	var config *Config
+	err = json.Unmarshal(mysec.Data[ConfigKey], &config)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	err = r.reconcileCloudConf(ctx, config)
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	err = r.reconcileCredentials(ctx, config)
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *CloudProviderConfigReconciler) reconcileCloudConf(ctx context.Context, config *Config) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := r.kubernetescli.CoreV1().ConfigMaps(cloudConfigName.Namespace).Get(ctx, cloudConfigName.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		// the remainder of cloud.conf isn't known to the RP, so an invalid
+		// cloud.conf can't be repaired
+		data, changed, err := fixCloudConf(cm.Data[cloudConfigKey], config)
+		if err != nil {
+			return fmt.Errorf("cloud provider config is invalid: %v", err)
+		}
+		if changed == nil {
+			return nil
+		}
+
+		r.log.Infof("repairing cloud provider config fields %s", strings.Join(changed, ", "))
+		cm.Data[cloudConfigKey] = data
+
+		_, err = r.kubernetescli.CoreV1().ConfigMaps(cm.Namespace).Update(ctx, cm, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+func (r *CloudProviderConfigReconciler) reconcileCredentials(ctx context.Context, config *Config) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		s, err := r.kubernetescli.CoreV1().Secrets(credentialsName.Namespace).Get(ctx, credentialsName.Name, metav1.GetOptions{})
+		isCreate := apierrors.IsNotFound(err)
+		switch {
+		case isCreate:
+			s = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      credentialsName.Name,
+					Namespace: credentialsName.Namespace,
+				},
+				Type: corev1.SecretTypeOpaque,
+			}
+		case err != nil:
+			return err
+		}
+
+		if s.Data == nil {
+			s.Data = map[string][]byte{}
+		}
+
+		data, changed, err := fixCredentials(s.Data[credentialsKey], config)
+		if err != nil {
+			r.log.Infof("cloud provider credentials are invalid - recreating: %v", err)
+			data, changed, err = fixCredentials(nil, config)
+			if err != nil {
+				return err
+			}
+		}
+		if !isCreate && changed == nil {
+			return nil
+		}
+
+		s.Data[credentialsKey] = data
+
+		if isCreate {
+			r.log.Info("re-creating cloud provider credentials")
+			_, err = r.kubernetescli.CoreV1().Secrets(s.Namespace).Create(ctx, s, metav1.CreateOptions{})
+		} else {
+			r.log.Infof("repairing cloud provider credentials fields %s", strings.Join(changed, ", "))
+			_, err = r.kubernetescli.CoreV1().Secrets(s.Namespace).Update(ctx, s, metav1.UpdateOptions{})
+		}
+		return err
+	})
+}
+
+func triggerReconcile(meta metav1.Object) bool {
+	name := types.NamespacedName{Name: meta.GetName(), Namespace: meta.GetNamespace()}
+
+	switch meta.(type) {
+	case *arov1alpha1.Cluster:
+		return true
+	case *corev1.ConfigMap:
+		return name == cloudConfigName
+	case *corev1.Secret:
+		return name == credentialsName ||
+			name == types.NamespacedName{Name: operator.SecretName, Namespace: operator.Namespace}
+	}
+
+	return false
+}
+
+// SetupWithManager setup our manager
+func (r *CloudProviderConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	isCloudProviderConfig := predicate.Funcs{
+		UpdateFunc:  func(e event.UpdateEvent) bool { return triggerReconcile(e.MetaNew) },
+		CreateFunc:  func(e event.CreateEvent) bool { return triggerReconcile(e.Meta) },
+		DeleteFunc:  func(e event.DeleteEvent) bool { return triggerReconcile(e.Meta) },
+		GenericFunc: func(e event.GenericEvent) bool { return triggerReconcile(e.Meta) },
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}).
+		Watches(&source.Kind{Type: &corev1.ConfigMap{}}, &handler.EnqueueRequestForObject{}).
+		Watches(&source.Kind{Type: &corev1.Secret{}}, &handler.EnqueueRequestForObject{}).
+		WithEventFilter(isCloudProviderConfig).
+		Named(controllers.CloudProviderConfigControllerName).
+		Complete(r)
+}
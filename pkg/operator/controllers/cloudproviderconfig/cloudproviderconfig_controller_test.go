@@ -0,0 +1,275 @@
+package cloudproviderconfig
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+)
+
+func TestCloudProviderConfigReconciler(t *testing.T) {
+	ctx := context.Background()
+
+	config := &Config{
+		Cloud:           "AzurePublicCloud",
+		TenantID:        "tenant",
+		SubscriptionID:  "subscription",
+		ResourceGroup:   "resourcegroup",
+		Location:        "eastus",
+		AADClientID:     "clientid",
+		AADClientSecret: "secret",
+	}
+
+	validCloudConf := map[string]interface{}{
+		"cloud":                       "AzurePublicCloud",
+		"tenantId":                    "tenant",
+		"subscriptionId":              "subscription",
+		"resourceGroup":               "resourcegroup",
+		"location":                    "eastus",
+		"useManagedIdentityExtension": false,
+		"loadBalancerSku":             "standard",
+		"vmType":                      "",
+		"vnetName":                    "vnet",
+		"cloudProviderBackoff":        true,
+	}
+
+	validCredentials := map[string]interface{}{
+		"aadClientId":     "clientid",
+		"aadClientSecret": "secret",
+	}
+
+	modified := func(m map[string]interface{}, modify func(map[string]interface{})) map[string]interface{} {
+		c := map[string]interface{}{}
+		for k, v := range m {
+			c[k] = v
+		}
+		if modify != nil {
+			modify(c)
+		}
+		return c
+	}
+
+	operatorSecret := func(config *Config) *corev1.Secret {
+		s := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      operator.SecretName,
+				Namespace: operator.Namespace,
+			},
+			Data: map[string][]byte{},
+		}
+
+		if config != nil {
+			b, err := json.Marshal(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			s.Data[ConfigKey] = b
+		}
+
+		return s
+	}
+
+	cloudConf := func(modify func(map[string]interface{})) *corev1.ConfigMap {
+		b, err := json.MarshalIndent(modified(validCloudConf, modify), "", "\t")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      cloudConfigName.Name,
+				Namespace: cloudConfigName.Namespace,
+			},
+			Data: map[string]string{
+				cloudConfigKey: string(b) + "\n",
+			},
+		}
+	}
+
+	credentials := func(modify func(map[string]interface{})) *corev1.Secret {
+		b, err := yaml.Marshal(modified(validCredentials, modify))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      credentialsName.Name,
+				Namespace: credentialsName.Namespace,
+			},
+			Data: map[string][]byte{
+				credentialsKey: b,
+			},
+		}
+	}
+
+	for _, tt := range []struct {
+		name            string
+		operatorFlags   map[string]string
+		objects         []runtime.Object
+		wantCloudConf   map[string]interface{}
+		wantCredentials map[string]interface{}
+		wantErr         string
+	}{
+		{
+			name: "valid",
+			objects: []runtime.Object{
+				operatorSecret(config),
+				cloudConf(nil),
+				credentials(nil),
+			},
+			wantCloudConf:   validCloudConf,
+			wantCredentials: validCredentials,
+		},
+		{
+			name: "cloud.conf modified",
+			objects: []runtime.Object{
+				operatorSecret(config),
+				cloudConf(func(m map[string]interface{}) {
+					m["resourceGroup"] = "other"
+					m["vmType"] = "vmss"
+					m["loadBalancerSku"] = "basic"
+					delete(m, "tenantId")
+				}),
+				credentials(nil),
+			},
+			wantCloudConf:   validCloudConf,
+			wantCredentials: validCredentials,
+		},
+		{
+			name: "service principal modified",
+			objects: []runtime.Object{
+				operatorSecret(config),
+				cloudConf(nil),
+				credentials(func(m map[string]interface{}) {
+					m["aadClientSecret"] = "stale"
+				}),
+			},
+			wantCloudConf:   validCloudConf,
+			wantCredentials: validCredentials,
+		},
+		{
+			name: "service principal secret deleted",
+			objects: []runtime.Object{
+				operatorSecret(config),
+				cloudConf(nil),
+			},
+			wantCloudConf:   validCloudConf,
+			wantCredentials: validCredentials,
+		},
+		{
+			name: "cloud.conf invalid",
+			objects: []runtime.Object{
+				operatorSecret(config),
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      cloudConfigName.Name,
+						Namespace: cloudConfigName.Namespace,
+					},
+					Data: map[string]string{
+						cloudConfigKey: "{",
+					},
+				},
+				credentials(nil),
+			},
+			wantErr: "cloud provider config is invalid: unexpected end of JSON input",
+		},
+		{
+			name: "disabled",
+			operatorFlags: map[string]string{
+				operator.FlagCloudProviderConfigEnabled: "false",
+			},
+			objects: []runtime.Object{
+				operatorSecret(config),
+				cloudConf(func(m map[string]interface{}) {
+					m["vmType"] = "vmss"
+				}),
+			},
+			wantCloudConf: modified(validCloudConf, func(m map[string]interface{}) {
+				m["vmType"] = "vmss"
+			}),
+		},
+		{
+			name: "no expected config",
+			objects: []runtime.Object{
+				operatorSecret(nil),
+				cloudConf(func(m map[string]interface{}) {
+					m["vmType"] = "vmss"
+				}),
+			},
+			wantCloudConf: modified(validCloudConf, func(m map[string]interface{}) {
+				m["vmType"] = "vmss"
+			}),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			kubernetescli := fake.NewSimpleClientset(tt.objects...)
+			arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					OperatorFlags: tt.operatorFlags,
+				},
+			})
+
+			r := NewReconciler(logrus.NewEntry(logrus.StandardLogger()), kubernetescli, arocli.AroV1alpha1())
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: types.NamespacedName{Name: arov1alpha1.SingletonClusterName}})
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Fatal(err)
+			}
+
+			if tt.wantCloudConf != nil {
+				cm, err := kubernetescli.CoreV1().ConfigMaps(cloudConfigName.Namespace).Get(ctx, cloudConfigName.Name, metav1.GetOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var m map[string]interface{}
+				err = json.Unmarshal([]byte(cm.Data[cloudConfigKey]), &m)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if !reflect.DeepEqual(m, tt.wantCloudConf) {
+					t.Error(cm.Data[cloudConfigKey])
+				}
+			}
+
+			if tt.wantCredentials != nil {
+				s, err := kubernetescli.CoreV1().Secrets(credentialsName.Namespace).Get(ctx, credentialsName.Name, metav1.GetOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var m map[string]interface{}
+				err = yaml.Unmarshal(s.Data[credentialsKey], &m)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if !reflect.DeepEqual(m, tt.wantCredentials) {
+					t.Error(string(s.Data[credentialsKey]))
+				}
+			}
+		})
+	}
+}
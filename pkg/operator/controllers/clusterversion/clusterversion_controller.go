@@ -0,0 +1,239 @@
+package clusterversion
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	configclient "github.com/openshift/client-go/config/clientset/versioned"
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+	"github.com/Azure/ARO-RP/pkg/util/version"
+)
+
+const (
+	// clusterVersionName is the name of the ClusterVersion singleton
+	clusterVersionName = "version"
+
+	// upstream is the update service which the RP pins clusters to: empty
+	// means the default OpenShift update service, from which the RP's
+	// upgrade testing draws its releases.  The RP clears the upstream at
+	// install.
+	upstream configv1.URL = ""
+
+	// driftReportPeriod is how long the ClusterVersionPolicyValid condition
+	// stays False after the ClusterVersion was repaired, so that the monitor
+	// gets to see it
+	driftReportPeriod = time.Hour
+)
+
+// rxChannel matches the update channels which ARO supports
+var rxChannel = regexp.MustCompile(`^stable-(\d+)\.(\d+)$`)
+
+// ClusterVersionReconciler keeps the update service and channel of the
+// ClusterVersion within the ARO update policy
+type ClusterVersionReconciler struct {
+	configcli configclient.Interface
+	arocli    aroclient.AroV1alpha1Interface
+	recorder  record.EventRecorder
+	log       *logrus.Entry
+}
+
+func NewReconciler(log *logrus.Entry, configcli configclient.Interface, arocli aroclient.AroV1alpha1Interface, recorder record.EventRecorder) *ClusterVersionReconciler {
+	return &ClusterVersionReconciler{
+		configcli: configcli,
+		arocli:    arocli,
+		recorder:  recorder,
+		log:       log,
+	}
+}
+
+// +kubebuilder:rbac:groups=config.openshift.io,resources=clusterversions,verbs=get;list;watch;update
+
+// Reconcile restores the ARO update service on the ClusterVersion and reports
+// an update channel which ARO does not support in the
+// ClusterVersionPolicyValid condition.  Unsupported channels are only cleared
+// if the channel policy is enforced on the cluster.
+func (r *ClusterVersionReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
+	// TODO(mj): controller-runtime master fixes the need for this (https://github.com/kubernetes-sigs/controller-runtime/blob/master/pkg/reconcile/reconcile.go#L93) but it's not yet released.
+	ctx := context.Background()
+
+	instance, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if !controllers.FlagEnabled(instance, operator.FlagClusterVersionEnabled) {
+		r.log.Debug("cluster version repair is disabled")
+		return reconcile.Result{}, nil
+	}
+
+	overrides, restored, err := r.ensureClusterVersion(ctx, controllers.FlagEnabled(instance, operator.FlagClusterVersionChannelEnforced))
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	for _, drift := range restored {
+		r.log.Warnf("restored %s", drift)
+		r.recorder.Eventf(instance, corev1.EventTypeWarning, "ClusterVersionRestored", "restored %s", drift)
+	}
+
+	cond := &status.Condition{
+		Type:    arov1alpha1.ClusterVersionPolicyValid,
+		Status:  corev1.ConditionTrue,
+		Message: "ClusterVersion follows the ARO update policy",
+		Reason:  "CheckDone",
+	}
+
+	switch {
+	case len(restored) > 0:
+		cond.Status = corev1.ConditionFalse
+		cond.Reason = "Restored"
+		cond.Message = fmt.Sprintf("restored %s", strings.Join(restored, "; "))
+		if len(overrides) > 0 {
+			cond.Message += "; " + strings.Join(overrides, "; ")
+		}
+
+		return reconcile.Result{RequeueAfter: driftReportPeriod}, controllers.SetCondition(ctx, r.arocli, r.recorder, cond, operator.RoleMaster)
+
+	case len(overrides) > 0:
+		cond.Status = corev1.ConditionFalse
+		cond.Reason = "OverrideFound"
+		cond.Message = strings.Join(overrides, "; ")
+
+		return reconcile.Result{}, controllers.SetCondition(ctx, r.arocli, r.recorder, cond, operator.RoleMaster)
+	}
+
+	// leave a recent report of drift in place until the report period is up
+	previous := instance.Status.Conditions.GetCondition(arov1alpha1.ClusterVersionPolicyValid)
+	if previous != nil && previous.Status == corev1.ConditionFalse && previous.Reason == "Restored" {
+		if remaining := driftReportPeriod - time.Since(previous.LastTransitionTime.Time); remaining > 0 {
+			return reconcile.Result{RequeueAfter: remaining}, nil
+		}
+	}
+
+	return reconcile.Result{}, controllers.SetCondition(ctx, r.arocli, r.recorder, cond, operator.RoleMaster)
+}
+
+// ensureClusterVersion restores the ARO update service and, if enforceChannel
+// is set, clears an unsupported channel.  It returns a description of each
+// customer override which it left in place and of each which it restored.
+func (r *ClusterVersionReconciler) ensureClusterVersion(ctx context.Context, enforceChannel bool) (overrides, restored []string, err error) {
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		overrides, restored = nil, nil
+
+		cv, err := r.configcli.ConfigV1().ClusterVersions().Get(ctx, clusterVersionName, metav1.GetOptions{})
+		switch {
+		case kerrors.IsNotFound(err):
+			return nil
+		case err != nil:
+			return err
+		}
+
+		if cv.Spec.Upstream != upstream {
+			restored = append(restored, fmt.Sprintf("the ARO update service, which was overridden with %s", cv.Spec.Upstream))
+			cv.Spec.Upstream = upstream
+		}
+
+		if !supportedChannel(cv.Spec.Channel, cv.Status.Desired.Version) {
+			if enforceChannel {
+				restored = append(restored, fmt.Sprintf("the update channel, which was set to the unsupported channel %s", cv.Spec.Channel))
+				cv.Spec.Channel = ""
+			} else {
+				overrides = append(overrides, fmt.Sprintf("update channel %s is not supported", cv.Spec.Channel))
+			}
+		}
+
+		if len(restored) == 0 {
+			return nil
+		}
+
+		_, err = r.configcli.ConfigV1().ClusterVersions().Update(ctx, cv, metav1.UpdateOptions{})
+		return err
+	})
+
+	return overrides, restored, err
+}
+
+// supportedChannel returns true if channel is empty, i.e. the cluster is
+// upgraded only via the RP, or is the stable channel of the cluster's current
+// or next minor version.  If the current version is not known, any stable
+// channel is supported.
+func supportedChannel(channel, currentVersion string) bool {
+	if channel == "" {
+		return true
+	}
+
+	m := rxChannel.FindStringSubmatch(channel)
+	if m == nil {
+		return false
+	}
+
+	current, err := version.ParseVersion(currentVersion)
+	if err != nil {
+		return true
+	}
+
+	major, err := strconv.ParseUint(m[1], 10, 32)
+	if err != nil {
+		return false
+	}
+
+	minor, err := strconv.ParseUint(m[2], 10, 32)
+	if err != nil {
+		return false
+	}
+
+	return uint32(major) == current.V[0] &&
+		(uint32(minor) == current.V[1] || uint32(minor) == current.V[1]+1)
+}
+
+// SetupWithManager setup our manager
+func (r *ClusterVersionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	triggerReconcile := func(meta metav1.Object, o runtime.Object) bool {
+		if _, ok := o.(*arov1alpha1.Cluster); ok {
+			return true
+		}
+
+		return meta.GetName() == clusterVersionName
+	}
+
+	isClusterVersion := predicate.Funcs{
+		UpdateFunc:  func(e event.UpdateEvent) bool { return triggerReconcile(e.MetaNew, e.ObjectNew) },
+		CreateFunc:  func(e event.CreateEvent) bool { return triggerReconcile(e.Meta, e.Object) },
+		DeleteFunc:  func(e event.DeleteEvent) bool { return triggerReconcile(e.Meta, e.Object) },
+		GenericFunc: func(e event.GenericEvent) bool { return triggerReconcile(e.Meta, e.Object) },
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}).
+		Watches(&source.Kind{Type: &configv1.ClusterVersion{}}, &handler.EnqueueRequestForObject{}).
+		WithEventFilter(isClusterVersion).
+		Named(controllers.ClusterVersionControllerName).
+		Complete(r)
+}
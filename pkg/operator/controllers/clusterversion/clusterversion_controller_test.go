@@ -0,0 +1,200 @@
+package clusterversion
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	configfake "github.com/openshift/client-go/config/clientset/versioned/fake"
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+)
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name         string
+		flags        map[string]string
+		condition    *status.Condition
+		upstream     configv1.URL
+		channel      string
+		wantUpstream configv1.URL
+		wantChannel  string
+		wantStatus   corev1.ConditionStatus
+		wantMessage  string
+		wantEvents   int
+	}{
+		{
+			name:        "policy followed",
+			wantStatus:  corev1.ConditionTrue,
+			wantMessage: "ClusterVersion follows the ARO update policy",
+		},
+		{
+			name:        "supported channel",
+			channel:     "stable-4.7",
+			wantChannel: "stable-4.7",
+			wantStatus:  corev1.ConditionTrue,
+			wantMessage: "ClusterVersion follows the ARO update policy",
+		},
+		{
+			name:        "unsupported channel is reported",
+			channel:     "candidate-4.7",
+			wantChannel: "candidate-4.7",
+			wantStatus:  corev1.ConditionFalse,
+			wantMessage: "update channel candidate-4.7 is not supported",
+		},
+		{
+			name:        "unsupported channel is cleared if enforced",
+			flags:       map[string]string{operator.FlagClusterVersionChannelEnforced: "true"},
+			channel:     "stable-4.9",
+			wantStatus:  corev1.ConditionFalse,
+			wantMessage: "restored the update channel, which was set to the unsupported channel stable-4.9",
+			wantEvents:  1,
+		},
+		{
+			name:        "upstream is restored",
+			upstream:    "https://updates.example.com/api/upgrades_info/v1/graph",
+			channel:     "fast-4.6",
+			wantChannel: "fast-4.6",
+			wantStatus:  corev1.ConditionFalse,
+			wantMessage: "restored the ARO update service, which was overridden with https://updates.example.com/api/upgrades_info/v1/graph; update channel fast-4.6 is not supported",
+			wantEvents:  1,
+		},
+		{
+			name: "recent restore is still reported",
+			condition: &status.Condition{
+				Type:               arov1alpha1.ClusterVersionPolicyValid,
+				Status:             corev1.ConditionFalse,
+				Reason:             "Restored",
+				Message:            "restored the ARO update service",
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Minute)),
+			},
+			wantStatus:  corev1.ConditionFalse,
+			wantMessage: "restored the ARO update service",
+		},
+		{
+			name:         "disabled",
+			flags:        map[string]string{operator.FlagClusterVersionEnabled: "false"},
+			upstream:     "https://updates.example.com/",
+			wantUpstream: "https://updates.example.com/",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					OperatorFlags: tt.flags,
+				},
+			}
+			if tt.condition != nil {
+				cluster.Status.Conditions = status.Conditions{*tt.condition}
+			}
+
+			arocli := arofake.NewSimpleClientset(cluster)
+			configcli := configfake.NewSimpleClientset(&configv1.ClusterVersion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: clusterVersionName,
+				},
+				Spec: configv1.ClusterVersionSpec{
+					Upstream: tt.upstream,
+					Channel:  tt.channel,
+				},
+				Status: configv1.ClusterVersionStatus{
+					Desired: configv1.Update{
+						Version: "4.6.17",
+					},
+				},
+			})
+			recorder := record.NewFakeRecorder(10)
+
+			r := NewReconciler(logrus.NewEntry(logrus.StandardLogger()), configcli, arocli.AroV1alpha1(), recorder)
+
+			_, err := r.Reconcile(ctrl.Request{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cv, err := configcli.ConfigV1().ClusterVersions().Get(ctx, clusterVersionName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cv.Spec.Upstream != tt.wantUpstream {
+				t.Error(cv.Spec.Upstream)
+			}
+			if cv.Spec.Channel != tt.wantChannel {
+				t.Error(cv.Spec.Channel)
+			}
+
+			if tt.wantStatus == "" {
+				return
+			}
+
+			cluster, err = arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cond := cluster.Status.Conditions.GetCondition(arov1alpha1.ClusterVersionPolicyValid)
+			if cond == nil {
+				t.Fatal("condition not set")
+			}
+			if cond.Status != tt.wantStatus {
+				t.Error(cond.Status)
+			}
+			if cond.Message != tt.wantMessage {
+				t.Error(cond.Message)
+			}
+
+			var restored int
+			for len(recorder.Events) > 0 {
+				e := <-recorder.Events
+				if strings.HasPrefix(e, "Warning ClusterVersionRestored") {
+					restored++
+				}
+			}
+			if restored != tt.wantEvents {
+				t.Error(restored)
+			}
+		})
+	}
+}
+
+func TestSupportedChannel(t *testing.T) {
+	for _, tt := range []struct {
+		channel        string
+		currentVersion string
+		want           bool
+	}{
+		{channel: "", currentVersion: "4.6.17", want: true},
+		{channel: "stable-4.6", currentVersion: "4.6.17", want: true},
+		{channel: "stable-4.7", currentVersion: "4.6.17", want: true},
+		{channel: "stable-4.8", currentVersion: "4.6.17", want: false},
+		{channel: "stable-4.5", currentVersion: "4.6.17", want: false},
+		{channel: "stable-5.6", currentVersion: "4.6.17", want: false},
+		{channel: "fast-4.6", currentVersion: "4.6.17", want: false},
+		{channel: "eus-4.6", currentVersion: "4.6.17", want: false},
+		{channel: "stable-4.9", currentVersion: "", want: true},
+	} {
+		t.Run(tt.channel+"/"+tt.currentVersion, func(t *testing.T) {
+			if got := supportedChannel(tt.channel, tt.currentVersion); got != tt.want {
+				t.Error(got)
+			}
+		})
+	}
+}
@@ -5,9 +5,12 @@ package controllers
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/operator-framework/operator-sdk/pkg/status"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 
 	"github.com/Azure/ARO-RP/pkg/operator"
@@ -16,15 +19,41 @@ import (
 	"github.com/Azure/ARO-RP/pkg/util/version"
 )
 
-func SetCondition(ctx context.Context, arocli aroclient.AroV1alpha1Interface, cond *status.Condition, role string) error {
-	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
-		cluster, err := arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+// maxConditionHistory is the number of transitions kept in the cluster
+// status for each condition type
+const maxConditionHistory = 10
+
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// SetCondition sets cond on the cluster status.  If the status of the
+// condition changes, the transition is appended to the condition history and,
+// if recorder is not nil, recorded as an event on the cluster object.
+func SetCondition(ctx context.Context, arocli aroclient.AroV1alpha1Interface, recorder record.EventRecorder, cond *status.Condition, role string) error {
+	var cluster *arov1alpha1.Cluster
+	var transitioned bool
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() (err error) {
+		cluster, err = arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
 		if err != nil {
 			return err
 		}
 
+		previous := cluster.Status.Conditions.GetCondition(cond.Type)
+		transitioned = previous == nil || previous.Status != cond.Status
+
 		changed := cluster.Status.Conditions.SetCondition(*cond)
 
+		if transitioned {
+			c := cluster.Status.Conditions.GetCondition(cond.Type)
+			cluster.Status.ConditionHistory = appendConditionHistory(cluster.Status.ConditionHistory, arov1alpha1.ConditionTransition{
+				Type:               c.Type,
+				Status:             c.Status,
+				Reason:             c.Reason,
+				Message:            c.Message,
+				LastTransitionTime: c.LastTransitionTime,
+			})
+		}
+
 		if setStaticStatus(cluster, role) {
 			changed = true
 		}
@@ -36,6 +65,61 @@ func SetCondition(ctx context.Context, arocli aroclient.AroV1alpha1Interface, co
 		_, err = arocli.Clusters().UpdateStatus(ctx, cluster, metav1.UpdateOptions{})
 		return err
 	})
+	if err != nil {
+		return err
+	}
+
+	if transitioned && recorder != nil {
+		recordConditionEvent(recorder, cluster, cond)
+	}
+
+	return nil
+}
+
+// recordConditionEvent records a transition of cond as an event.  All the
+// operator's condition types are healthy when True, so any other status is
+// recorded as a warning.
+func recordConditionEvent(recorder record.EventRecorder, cluster *arov1alpha1.Cluster, cond *status.Condition) {
+	eventtype := corev1.EventTypeNormal
+	if cond.Status != corev1.ConditionTrue {
+		eventtype = corev1.EventTypeWarning
+	}
+
+	reason := string(cond.Reason)
+	if reason == "" {
+		reason = string(cond.Type)
+	}
+
+	message := fmt.Sprintf("%s is %s", cond.Type, cond.Status)
+	if cond.Message != "" {
+		message += ": " + cond.Message
+	}
+
+	recorder.Event(cluster, eventtype, reason, message)
+}
+
+// appendConditionHistory appends t to history, dropping the oldest
+// transitions of the same type beyond maxConditionHistory
+func appendConditionHistory(history []arov1alpha1.ConditionTransition, t arov1alpha1.ConditionTransition) []arov1alpha1.ConditionTransition {
+	history = append(history, t)
+
+	var count int
+	for _, h := range history {
+		if h.Type == t.Type {
+			count++
+		}
+	}
+
+	trimmed := make([]arov1alpha1.ConditionTransition, 0, len(history))
+	for _, h := range history {
+		if h.Type == t.Type && count > maxConditionHistory {
+			count--
+			continue
+		}
+		trimmed = append(trimmed, h)
+	}
+
+	return trimmed
 }
 
 func setStaticStatus(cluster *arov1alpha1.Cluster, role string) (changed bool) {
@@ -57,6 +141,17 @@ func setStaticStatus(cluster *arov1alpha1.Cluster, role string) (changed bool) {
 
 	cluster.Status.Conditions = conditions
 
+	var history []arov1alpha1.ConditionTransition
+	for _, t := range cluster.Status.ConditionHistory {
+		if _, ok := current[t.Type]; ok {
+			history = append(history, t)
+		} else {
+			changed = true
+		}
+	}
+
+	cluster.Status.ConditionHistory = history
+
 	if role == operator.RoleMaster && cluster.Status.OperatorVersion != version.GitCommit {
 		cluster.Status.OperatorVersion = version.GitCommit
 		changed = true
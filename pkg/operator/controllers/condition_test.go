@@ -0,0 +1,108 @@
+package controllers
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+)
+
+func TestSetCondition(t *testing.T) {
+	ctx := context.Background()
+
+	arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: arov1alpha1.SingletonClusterName,
+		},
+	})
+	recorder := record.NewFakeRecorder(10)
+
+	set := func(s corev1.ConditionStatus, reason status.ConditionReason, message string) {
+		err := SetCondition(ctx, arocli.AroV1alpha1(), recorder, &status.Condition{
+			Type:    arov1alpha1.InternetReachableFromMaster,
+			Status:  s,
+			Reason:  reason,
+			Message: message,
+		}, operator.RoleMaster)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	set(corev1.ConditionTrue, "CheckDone", "")
+	set(corev1.ConditionTrue, "CheckDone", "")
+	set(corev1.ConditionFalse, "CheckFailed", "no route to host")
+	set(corev1.ConditionTrue, "CheckDone", "")
+
+	cluster, err := arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var statuses []corev1.ConditionStatus
+	for _, h := range cluster.Status.ConditionHistory {
+		statuses = append(statuses, h.Status)
+	}
+	// the repeated True does not count as a transition
+	if !reflect.DeepEqual(statuses, []corev1.ConditionStatus{corev1.ConditionTrue, corev1.ConditionFalse, corev1.ConditionTrue}) {
+		t.Error(statuses)
+	}
+	if cluster.Status.ConditionHistory[1].Message != "no route to host" {
+		t.Error(cluster.Status.ConditionHistory[1].Message)
+	}
+
+	close(recorder.Events)
+	var events []string
+	for e := range recorder.Events {
+		events = append(events, e)
+	}
+	if !reflect.DeepEqual(events, []string{
+		"Normal CheckDone InternetReachableFromMaster is True",
+		"Warning CheckFailed InternetReachableFromMaster is False: no route to host",
+		"Normal CheckDone InternetReachableFromMaster is True",
+	}) {
+		t.Error(events)
+	}
+}
+
+func TestAppendConditionHistory(t *testing.T) {
+	var history []arov1alpha1.ConditionTransition
+
+	history = append(history, arov1alpha1.ConditionTransition{
+		Type:   arov1alpha1.MachineValid,
+		Status: corev1.ConditionTrue,
+	})
+
+	for i := 0; i < maxConditionHistory+5; i++ {
+		history = appendConditionHistory(history, arov1alpha1.ConditionTransition{
+			Type:    arov1alpha1.InternetReachableFromMaster,
+			Message: fmt.Sprint(i),
+		})
+	}
+
+	if len(history) != maxConditionHistory+1 {
+		t.Fatal(len(history))
+	}
+
+	// other condition types are untouched
+	if history[0].Type != arov1alpha1.MachineValid {
+		t.Error(history[0].Type)
+	}
+
+	// the oldest transitions are dropped
+	if history[1].Message != "5" || history[maxConditionHistory].Message != fmt.Sprint(maxConditionHistory+4) {
+		t.Error(history)
+	}
+}
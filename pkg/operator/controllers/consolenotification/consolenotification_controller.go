@@ -0,0 +1,186 @@
+package consolenotification
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+	"github.com/Azure/ARO-RP/pkg/util/dynamichelper"
+	_ "github.com/Azure/ARO-RP/pkg/util/scheme"
+)
+
+const (
+	consoleNotificationGroupKind = "ConsoleNotification.console.openshift.io"
+
+	// consoleNotificationLabel marks the ConsoleNotifications which are owned
+	// by this controller, so that stale ones can be found and removed
+	consoleNotificationLabel = "aro.openshift.io/consolenotification"
+
+	namePrefix = "aro-"
+)
+
+// ConsoleNotificationReconciler manages the console banners requested by the
+// RP in the cluster spec
+type ConsoleNotificationReconciler struct {
+	arocli     aroclient.AroV1alpha1Interface
+	restConfig *rest.Config
+	log        *logrus.Entry
+}
+
+func NewReconciler(log *logrus.Entry, arocli aroclient.AroV1alpha1Interface, restConfig *rest.Config) *ConsoleNotificationReconciler {
+	return &ConsoleNotificationReconciler{
+		arocli:     arocli,
+		restConfig: restConfig,
+		log:        log,
+	}
+}
+
+// Reconcile makes sure that the ConsoleNotifications on the cluster match the
+// cluster spec.
+func (r *ConsoleNotificationReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
+	// TODO(mj): controller-runtime master fixes the need for this (https://github.com/kubernetes-sigs/controller-runtime/blob/master/pkg/reconcile/reconcile.go#L93) but it's not yet released.
+	ctx := context.Background()
+	if request.Name != arov1alpha1.SingletonClusterName {
+		return reconcile.Result{}, nil
+	}
+
+	instance, err := r.arocli.Clusters().Get(ctx, request.Name, metav1.GetOptions{})
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	dh, err := dynamichelper.New(r.log, r.restConfig)
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	err = r.reconcileConsoleNotifications(ctx, dh, instance)
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *ConsoleNotificationReconciler) reconcileConsoleNotifications(ctx context.Context, dh dynamichelper.Interface, instance *arov1alpha1.Cluster) error {
+	wanted := map[string]struct{}{}
+
+	resources := make([]runtime.Object, 0, len(instance.Spec.ConsoleNotifications))
+	for _, n := range instance.Spec.ConsoleNotifications {
+		un := consoleNotification(&n)
+		wanted[un.GetName()] = struct{}{}
+		resources = append(resources, un)
+	}
+
+	err := dynamichelper.SetControllerReferences(resources, instance)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources {
+		err = dh.Ensure(ctx, resource.(*unstructured.Unstructured))
+		if err != nil {
+			return err
+		}
+	}
+
+	existing, err := dh.List(ctx, consoleNotificationGroupKind, "")
+	if err != nil {
+		return err
+	}
+
+	for _, un := range existing.Items {
+		if _, ok := un.GetLabels()[consoleNotificationLabel]; !ok {
+			continue
+		}
+
+		if _, found := wanted[un.GetName()]; found {
+			continue
+		}
+
+		r.log.Printf("deleting ConsoleNotification %s", un.GetName())
+		err = dh.Delete(ctx, consoleNotificationGroupKind, "", un.GetName())
+		if err != nil && !kerrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// consoleNotification returns the ConsoleNotification object corresponding to
+// n.  The console.openshift.io types are not vendored, so it is built as an
+// unstructured object.
+func consoleNotification(n *arov1alpha1.ConsoleNotificationSpec) *unstructured.Unstructured {
+	location := n.Location
+	if location == "" {
+		location = "BannerTop"
+	}
+
+	color, backgroundColor := colors(n.Type)
+
+	spec := map[string]interface{}{
+		"text":            n.Text,
+		"location":        location,
+		"color":           color,
+		"backgroundColor": backgroundColor,
+	}
+
+	if n.LinkURL != "" {
+		spec["link"] = map[string]interface{}{
+			"href": n.LinkURL,
+			"text": n.LinkText,
+		}
+	}
+
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "console.openshift.io/v1",
+			"kind":       "ConsoleNotification",
+			"metadata": map[string]interface{}{
+				"name": namePrefix + n.Name,
+				"labels": map[string]interface{}{
+					consoleNotificationLabel: "true",
+				},
+			},
+			"spec": spec,
+		},
+	}
+}
+
+// colors returns the foreground and background colours for a banner of the
+// given type, following the PatternFly palette used by the console
+func colors(t string) (string, string) {
+	switch t {
+	case "Maintenance":
+		return "#151515", "#f0ab00"
+	case "Deprecation":
+		return "#fff", "#c9190b"
+	default:
+		return "#fff", "#2b9af3"
+	}
+}
+
+// SetupWithManager setup our mananger
+func (r *ConsoleNotificationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}).
+		Named(controllers.ConsoleNotificationControllerName).
+		Complete(r)
+}
@@ -0,0 +1,100 @@
+package consolenotification
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+	mock_dynamichelper "github.com/Azure/ARO-RP/pkg/util/mocks/dynamichelper"
+)
+
+func TestReconcileConsoleNotifications(t *testing.T) {
+	ctx := context.Background()
+
+	instance := &arov1alpha1.Cluster{
+		Spec: arov1alpha1.ClusterSpec{
+			ConsoleNotifications: []arov1alpha1.ConsoleNotificationSpec{
+				{
+					Name:     "maintenance",
+					Type:     "Maintenance",
+					Text:     "Planned maintenance on Saturday",
+					LinkText: "Details",
+					LinkURL:  "https://example.com/",
+				},
+			},
+		},
+	}
+	instance.Name = arov1alpha1.SingletonClusterName
+	instance.UID = "uid"
+
+	existing := func(name string, managed bool) unstructured.Unstructured {
+		un := unstructured.Unstructured{}
+		un.SetName(name)
+		if managed {
+			un.SetLabels(map[string]string{consoleNotificationLabel: "true"})
+		}
+		return un
+	}
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dh := mock_dynamichelper.NewMockInterface(controller)
+
+	dh.EXPECT().Ensure(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, objs ...*unstructured.Unstructured) error {
+		if len(objs) != 1 {
+			t.Fatal(len(objs))
+		}
+		o := objs[0]
+
+		if o.GetName() != "aro-maintenance" {
+			t.Error(o.GetName())
+		}
+		if len(o.GetOwnerReferences()) != 1 || o.GetOwnerReferences()[0].Name != arov1alpha1.SingletonClusterName {
+			t.Error(o.GetOwnerReferences())
+		}
+
+		wantSpec := map[string]interface{}{
+			"text":            "Planned maintenance on Saturday",
+			"location":        "BannerTop",
+			"color":           "#151515",
+			"backgroundColor": "#f0ab00",
+			"link": map[string]interface{}{
+				"href": "https://example.com/",
+				"text": "Details",
+			},
+		}
+		if !reflect.DeepEqual(o.Object["spec"], wantSpec) {
+			t.Error(o.Object["spec"])
+		}
+
+		return nil
+	})
+
+	dh.EXPECT().List(gomock.Any(), consoleNotificationGroupKind, "").Return(&unstructured.UnstructuredList{
+		Items: []unstructured.Unstructured{
+			existing("aro-maintenance", true),
+			existing("aro-stale", true),
+			existing("customer", false),
+		},
+	}, nil)
+
+	dh.EXPECT().Delete(gomock.Any(), consoleNotificationGroupKind, "", "aro-stale").Return(nil)
+
+	r := &ConsoleNotificationReconciler{
+		log: utillog.GetLogger(),
+	}
+
+	err := r.reconcileConsoleNotifications(ctx, dh, instance)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
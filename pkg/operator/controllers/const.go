@@ -10,4 +10,32 @@ const (
 	WorkaroundControllerName    = "Workaround"
 	CheckerControllerName       = "Checker"
 	RouteFixControllerName      = "RouteFix"
+
+	ConsoleNotificationControllerName = "ConsoleNotification"
+	SupportabilityControllerName      = "Supportability"
+	ProxyControllerName               = "Proxy"
+	NetworkPolicyControllerName       = "NetworkPolicy"
+	CloudProviderConfigControllerName = "CloudProviderConfig"
+	NodeProblemDetectorControllerName = "NodeProblemDetector"
+	RBACControllerName                = "RBAC"
+	EtcdDefragControllerName          = "EtcdDefrag"
+	DNSControllerName                 = "DNS"
+	InventoryControllerName           = "Inventory"
+	AutoscalerControllerName          = "Autoscaler"
+	NodeSizingControllerName          = "NodeSizing"
+	ImageRegistryControllerName       = "ImageRegistry"
+	TrustBundleControllerName         = "TrustBundle"
+	WorkerPoolControllerName          = "WorkerPool"
+	PodSupervisorControllerName       = "PodSupervisor"
+	StatusDashboardControllerName     = "StatusDashboard"
+	NodeReadinessControllerName       = "NodeReadiness"
+	PriorityClassControllerName       = "PriorityClass"
+	ClusterVersionControllerName      = "ClusterVersion"
+	RemediationControllerName         = "Remediation"
+	MachineConfigControllerName       = "MachineConfig"
+	DebugLogControllerName            = "DebugLog"
+	RebootCoordinatorControllerName   = "RebootCoordinator"
+	NodeMetadataControllerName        = "NodeMetadata"
+	MachineWebhookControllerName      = "MachineWebhook"
+	ServiceMonitorControllerName      = "ServiceMonitor"
 )
@@ -0,0 +1,103 @@
+package debuglog
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+)
+
+// DebugLogReconciler raises the log level of the controllers named by the
+// debug operator flags for the time which they give, so that debug logs can
+// be captured on a cluster without redeploying the operator
+type DebugLogReconciler struct {
+	arocli  aroclient.AroV1alpha1Interface
+	log     *logrus.Entry
+	loggers *Loggers
+
+	now func() time.Time
+}
+
+func NewReconciler(log *logrus.Entry, arocli aroclient.AroV1alpha1Interface, loggers *Loggers) *DebugLogReconciler {
+	return &DebugLogReconciler{
+		arocli:  arocli,
+		log:     log,
+		loggers: loggers,
+
+		now: time.Now,
+	}
+}
+
+// Reconcile applies the debug operator flags to the controller loggers and
+// requeues for when the capture ends, at which point the controllers return
+// to the normal log level.  The capture is tagged with its end time, which
+// is logged in the debug_capture field of its entries.
+func (r *DebugLogReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
+	// TODO(mj): controller-runtime master fixes the need for this (https://github.com/kubernetes-sigs/controller-runtime/blob/master/pkg/reconcile/reconcile.go#L93) but it's not yet released.
+	ctx := context.Background()
+
+	instance, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	names := map[string]bool{}
+	for _, name := range strings.Split(instance.Spec.OperatorFlags[operator.FlagDebugControllers], ",") {
+		name = strings.TrimSpace(name)
+		if name != "" {
+			names[name] = true
+		}
+	}
+
+	var capture string
+	var remaining time.Duration
+
+	if until := instance.Spec.OperatorFlags[operator.FlagDebugUntil]; until != "" && len(names) > 0 {
+		// the flag is validated by the RP, which only accepts times up to
+		// operator.MaxDebugDuration ahead
+		t, err := time.Parse(time.RFC3339, until)
+		if err != nil {
+			r.log.Warnf("invalid %s %q: %v", operator.FlagDebugUntil, until, err)
+		} else {
+			remaining = t.Sub(r.now())
+			if remaining > 0 {
+				capture = until
+			}
+		}
+	}
+
+	for _, name := range r.loggers.capture(names, capture) {
+		if capture != "" {
+			r.log.Infof("capturing debug logs of controller %s until %s", name, capture)
+		} else {
+			r.log.Infof("stopped capturing debug logs of controller %s", name)
+		}
+	}
+
+	if capture == "" {
+		return reconcile.Result{}, nil
+	}
+
+	return reconcile.Result{RequeueAfter: remaining}, nil
+}
+
+// SetupWithManager setup our mananger
+func (r *DebugLogReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}).
+		Named(controllers.DebugLogControllerName).
+		Complete(r)
+}
@@ -0,0 +1,160 @@
+package debuglog
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+)
+
+func TestDebugLogReconciler(t *testing.T) {
+	now := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		name             string
+		flags            map[string]string
+		wantDebug        []string
+		wantRequeueAfter time.Duration
+	}{
+		{
+			name: "no capture",
+		},
+		{
+			name: "named controllers",
+			flags: map[string]string{
+				operator.FlagDebugControllers: "Checker,DNS",
+				operator.FlagDebugUntil:       "2020-10-01T13:00:00Z",
+			},
+			wantDebug:        []string{"Checker", "DNS"},
+			wantRequeueAfter: time.Hour,
+		},
+		{
+			name: "all controllers",
+			flags: map[string]string{
+				operator.FlagDebugControllers: "*",
+				operator.FlagDebugUntil:       "2020-10-01T12:30:00Z",
+			},
+			wantDebug:        []string{"Checker", "DNS", "RBAC"},
+			wantRequeueAfter: 30 * time.Minute,
+		},
+		{
+			name: "capture ended",
+			flags: map[string]string{
+				operator.FlagDebugControllers: "*",
+				operator.FlagDebugUntil:       "2020-10-01T11:00:00Z",
+			},
+		},
+		{
+			name: "no end time",
+			flags: map[string]string{
+				operator.FlagDebugControllers: "*",
+			},
+		},
+		{
+			name: "invalid end time",
+			flags: map[string]string{
+				operator.FlagDebugControllers: "*",
+				operator.FlagDebugUntil:       "soon",
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					OperatorFlags: tt.flags,
+				},
+			})
+
+			logger := logrus.New()
+			logger.SetLevel(logrus.InfoLevel)
+			loggers := NewLoggers(logrus.NewEntry(logger))
+			for _, name := range []string{"Checker", "DNS", "RBAC"} {
+				loggers.Controller(name)
+			}
+
+			// a capture in progress is always replaced
+			loggers.capture(map[string]bool{"RBAC": true}, "2020-10-01T12:10:00Z")
+
+			r := &DebugLogReconciler{
+				arocli:  arocli.AroV1alpha1(),
+				log:     logrus.NewEntry(logrus.StandardLogger()),
+				loggers: loggers,
+				now:     func() time.Time { return now },
+			}
+
+			result, err := r.Reconcile(ctrl.Request{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if result.RequeueAfter != tt.wantRequeueAfter {
+				t.Error(result.RequeueAfter)
+			}
+
+			wantDebug := map[string]bool{}
+			for _, name := range tt.wantDebug {
+				wantDebug[name] = true
+			}
+
+			for name, l := range loggers.loggers {
+				if l.IsLevelEnabled(logrus.DebugLevel) != wantDebug[name] {
+					t.Error(name, l.GetLevel())
+				}
+			}
+		})
+	}
+}
+
+func TestLoggers(t *testing.T) {
+	buf := &bytes.Buffer{}
+
+	logger := logrus.New()
+	logger.SetLevel(logrus.InfoLevel)
+	logger.SetOutput(buf)
+	logger.SetFormatter(&logrus.TextFormatter{DisableTimestamp: true})
+
+	loggers := NewLoggers(logrus.NewEntry(logger).WithField("role", "master"))
+
+	checker := loggers.Controller("Checker")
+	dns := loggers.Controller("DNS")
+
+	if loggers.Controller("Checker").Logger != checker.Logger {
+		t.Error("controller got a second logger")
+	}
+
+	loggers.capture(map[string]bool{"Checker": true}, "2020-10-01T13:00:00Z")
+
+	checker.Debug("checker debug")
+	dns.Debug("dns debug")
+	dns.Info("dns info")
+
+	loggers.capture(map[string]bool{"Checker": true}, "")
+
+	checker.Debug("checker debug after capture")
+
+	want := `level=debug msg="checker debug" controller=Checker debug_capture="2020-10-01T13:00:00Z" role=master
+level=info msg="dns info" controller=DNS role=master
+`
+	if buf.String() != want {
+		t.Error(buf.String())
+	}
+
+	// the capture tag must not leak into the entry's shared fields
+	if _, found := checker.Data["debug_capture"]; found || strings.Contains(buf.String(), "after capture") {
+		t.Error(checker.Data)
+	}
+}
@@ -0,0 +1,121 @@
+package debuglog
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Loggers hands out a separate logger to each controller, so that the log
+// level of individual controllers can be raised while debug logs are
+// captured.  The loggers write through the formatter, hooks and output of the
+// base logger.
+type Loggers struct {
+	base *logrus.Entry
+
+	mu      sync.Mutex
+	loggers map[string]*logrus.Logger
+	tags    map[string]*captureFormatter
+}
+
+func NewLoggers(base *logrus.Entry) *Loggers {
+	return &Loggers{
+		base:    base,
+		loggers: map[string]*logrus.Logger{},
+		tags:    map[string]*captureFormatter{},
+	}
+}
+
+// Controller returns the log of the named controller
+func (l *Loggers) Controller(name string) *logrus.Entry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	logger, found := l.loggers[name]
+	if !found {
+		f := &captureFormatter{Formatter: l.base.Logger.Formatter}
+
+		logger = &logrus.Logger{
+			Out:          l.base.Logger.Out,
+			Hooks:        l.base.Logger.Hooks,
+			Formatter:    f,
+			ReportCaller: l.base.Logger.ReportCaller,
+			Level:        l.base.Logger.GetLevel(),
+			ExitFunc:     l.base.Logger.ExitFunc,
+		}
+
+		l.loggers[name] = logger
+		l.tags[name] = f
+	}
+
+	return logrus.NewEntry(logger).WithFields(l.base.Data).WithField("controller", name)
+}
+
+// capture sets the named controllers, or all controllers if names contains
+// "*", to log at debug level with their entries tagged with capture, and
+// returns the others to the level of the base logger.  An empty capture
+// returns all controllers to the level of the base logger.
+func (l *Loggers) capture(names map[string]bool, capture string) (changed []string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for name, logger := range l.loggers {
+		level, tag := l.base.Logger.GetLevel(), ""
+		if capture != "" && (names[name] || names["*"]) && level < logrus.DebugLevel {
+			level, tag = logrus.DebugLevel, capture
+		}
+
+		if logger.GetLevel() != level || l.tags[name].tag() != tag {
+			logger.SetLevel(level)
+			l.tags[name].setTag(tag)
+			changed = append(changed, name)
+		}
+	}
+
+	return changed
+}
+
+// captureFormatter adds the debug_capture field to entries while a capture is
+// in progress, so that the logs of a capture can be retrieved together
+type captureFormatter struct {
+	logrus.Formatter
+
+	mu sync.RWMutex
+	t  string
+}
+
+func (f *captureFormatter) tag() string {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	return f.t
+}
+
+func (f *captureFormatter) setTag(t string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.t = t
+}
+
+func (f *captureFormatter) Format(e *logrus.Entry) ([]byte, error) {
+	t := f.tag()
+	if t == "" {
+		return f.Formatter.Format(e)
+	}
+
+	// the entry's data is shared with its parent entry, so it must be copied
+	data := make(logrus.Fields, len(e.Data)+1)
+	for k, v := range e.Data {
+		data[k] = v
+	}
+	data["debug_capture"] = t
+
+	tagged := *e
+	tagged.Data = data
+
+	return f.Formatter.Format(&tagged)
+}
@@ -0,0 +1,221 @@
+package dns
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	operatorclient "github.com/openshift/client-go/operator/clientset/versioned"
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+)
+
+// driftReportPeriod is how long the DNSValid condition stays False after
+// forwarding zones were removed, so that the monitor gets to see it
+const driftReportPeriod = time.Hour
+
+// dnsName is the name of the DNS operator config
+const dnsName = "default"
+
+// DNSReconciler removes forwarding zones from the DNS operator config which
+// would send lookups of the endpoints the cluster depends on to customer DNS
+// servers
+type DNSReconciler struct {
+	operatorcli operatorclient.Interface
+	arocli      aroclient.AroV1alpha1Interface
+	recorder    record.EventRecorder
+	log         *logrus.Entry
+}
+
+func NewReconciler(log *logrus.Entry, operatorcli operatorclient.Interface, arocli aroclient.AroV1alpha1Interface, recorder record.EventRecorder) *DNSReconciler {
+	return &DNSReconciler{
+		operatorcli: operatorcli,
+		arocli:      arocli,
+		recorder:    recorder,
+		log:         log,
+	}
+}
+
+// +kubebuilder:rbac:groups=operator.openshift.io,resources=dnses,verbs=get;update
+
+// Reconcile makes sure that no DNS server configured on the DNS operator
+// forwards a zone which contains an endpoint checked by the internet checker.
+// Customers may still forward other zones as they wish.
+func (r *DNSReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
+	// TODO(mj): controller-runtime master fixes the need for this (https://github.com/kubernetes-sigs/controller-runtime/blob/master/pkg/reconcile/reconcile.go#L93) but it's not yet released.
+	ctx := context.Background()
+
+	instance, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if !controllers.FlagEnabled(instance, operator.FlagDNSEnabled) {
+		r.log.Debug("dns repair is disabled")
+		return reconcile.Result{}, nil
+	}
+
+	drifted, err := r.ensureServers(ctx, requiredHosts(instance))
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	for _, drift := range drifted {
+		r.log.Warnf("removed %s", drift)
+		r.recorder.Eventf(instance, corev1.EventTypeWarning, "DNSRestored", "removed %s", drift)
+	}
+
+	cond := &status.Condition{
+		Type:    arov1alpha1.DNSValid,
+		Status:  corev1.ConditionTrue,
+		Message: "ARO DNS resolution is intact",
+		Reason:  "CheckDone",
+	}
+
+	if len(drifted) > 0 {
+		cond.Status = corev1.ConditionFalse
+		cond.Reason = "Restored"
+		cond.Message = fmt.Sprintf("removed %s", strings.Join(drifted, "; "))
+
+		return reconcile.Result{RequeueAfter: driftReportPeriod}, controllers.SetCondition(ctx, r.arocli, r.recorder, cond, operator.RoleMaster)
+	}
+
+	// leave a recent report of drift in place until the report period is up
+	previous := instance.Status.Conditions.GetCondition(arov1alpha1.DNSValid)
+	if previous != nil && previous.Status == corev1.ConditionFalse {
+		if remaining := driftReportPeriod - time.Since(previous.LastTransitionTime.Time); remaining > 0 {
+			return reconcile.Result{RequeueAfter: remaining}, nil
+		}
+	}
+
+	return reconcile.Result{}, controllers.SetCondition(ctx, r.arocli, r.recorder, cond, operator.RoleMaster)
+}
+
+// ensureServers removes the zones which cover any of hosts from the servers of
+// the DNS operator config, and any server which is left with no zones.  It
+// returns a description of each zone it removed.
+func (r *DNSReconciler) ensureServers(ctx context.Context, hosts []string) (drifted []string, err error) {
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		drifted = nil
+
+		dns, err := r.operatorcli.OperatorV1().DNSes().Get(ctx, dnsName, metav1.GetOptions{})
+		switch {
+		case kerrors.IsNotFound(err):
+			return nil
+		case err != nil:
+			return err
+		}
+
+		servers := make([]operatorv1.Server, 0, len(dns.Spec.Servers))
+		for _, s := range dns.Spec.Servers {
+			zones := make([]string, 0, len(s.Zones))
+			for _, zone := range s.Zones {
+				if host := coveredHost(zone, hosts); host != "" {
+					drifted = append(drifted, fmt.Sprintf("zone %s of dns server %s, which forwarded %s", zone, s.Name, host))
+					continue
+				}
+				zones = append(zones, zone)
+			}
+
+			if len(zones) == 0 {
+				continue
+			}
+
+			s.Zones = zones
+			servers = append(servers, s)
+		}
+
+		if len(drifted) == 0 {
+			return nil
+		}
+
+		dns.Spec.Servers = servers
+		_, err = r.operatorcli.OperatorV1().DNSes().Update(ctx, dns, metav1.UpdateOptions{})
+		return err
+	})
+
+	return drifted, err
+}
+
+// requiredHosts returns the hosts of the internet checker URLs and the ACR,
+// whose lookups must not be forwarded away from the cluster's resolvers
+func requiredHosts(instance *arov1alpha1.Cluster) []string {
+	hosts := make([]string, 0, len(instance.Spec.InternetChecker.URLs)+1)
+
+	if instance.Spec.ACRDomain != "" {
+		hosts = append(hosts, strings.ToLower(instance.Spec.ACRDomain))
+	}
+
+	for _, rawurl := range instance.Spec.InternetChecker.URLs {
+		u, err := url.Parse(rawurl)
+		if err != nil || u.Hostname() == "" {
+			continue
+		}
+		hosts = append(hosts, strings.ToLower(u.Hostname()))
+	}
+
+	return hosts
+}
+
+// coveredHost returns the first of hosts which is in zone, or "" if there is
+// none
+func coveredHost(zone string, hosts []string) string {
+	zone = strings.ToLower(strings.TrimSuffix(zone, "."))
+
+	for _, host := range hosts {
+		if zone == "" || host == zone || strings.HasSuffix(host, "."+zone) {
+			return host
+		}
+	}
+
+	return ""
+}
+
+// SetupWithManager setup our manager
+func (r *DNSReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	triggerReconcile := func(meta metav1.Object, o runtime.Object) bool {
+		if _, ok := o.(*arov1alpha1.Cluster); ok {
+			return true
+		}
+
+		return meta.GetName() == dnsName
+	}
+
+	isDNS := predicate.Funcs{
+		UpdateFunc:  func(e event.UpdateEvent) bool { return triggerReconcile(e.MetaNew, e.ObjectNew) },
+		CreateFunc:  func(e event.CreateEvent) bool { return triggerReconcile(e.Meta, e.Object) },
+		DeleteFunc:  func(e event.DeleteEvent) bool { return triggerReconcile(e.Meta, e.Object) },
+		GenericFunc: func(e event.GenericEvent) bool { return triggerReconcile(e.Meta, e.Object) },
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}).
+		Watches(&source.Kind{Type: &operatorv1.DNS{}}, &handler.EnqueueRequestForObject{}).
+		WithEventFilter(isDNS).
+		Named(controllers.DNSControllerName).
+		Complete(r)
+}
@@ -0,0 +1,193 @@
+package dns
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	operatorfake "github.com/openshift/client-go/operator/clientset/versioned/fake"
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+)
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+
+	corpDNS := operatorv1.Server{
+		Name:          "corp",
+		Zones:         []string{"corp.example.com"},
+		ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"10.0.0.10"}},
+	}
+
+	condition := func(s corev1.ConditionStatus, lastTransitionTime time.Time) *status.Condition {
+		return &status.Condition{
+			Type:               arov1alpha1.DNSValid,
+			Status:             s,
+			LastTransitionTime: metav1.NewTime(lastTransitionTime),
+		}
+	}
+
+	for _, tt := range []struct {
+		name        string
+		flags       map[string]string
+		servers     []operatorv1.Server
+		condition   *status.Condition
+		wantServers []operatorv1.Server
+		wantStatus  corev1.ConditionStatus
+		wantMessage string
+		wantEvents  int
+	}{
+		{
+			name:        "customer zones are left alone",
+			servers:     []operatorv1.Server{corpDNS},
+			wantServers: []operatorv1.Server{corpDNS},
+			wantStatus:  corev1.ConditionTrue,
+			wantMessage: "ARO DNS resolution is intact",
+		},
+		{
+			name: "conflicting zones are removed",
+			servers: []operatorv1.Server{
+				corpDNS,
+				{
+					Name:          "azure",
+					Zones:         []string{"azure.com.", "corp.example.org"},
+					ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"10.0.0.11"}},
+				},
+				{
+					Name:          "acr",
+					Zones:         []string{"ARO.azurecr.io"},
+					ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"10.0.0.12"}},
+				},
+			},
+			wantServers: []operatorv1.Server{
+				corpDNS,
+				{
+					Name:          "azure",
+					Zones:         []string{"corp.example.org"},
+					ForwardPlugin: operatorv1.ForwardPlugin{Upstreams: []string{"10.0.0.11"}},
+				},
+			},
+			wantStatus:  corev1.ConditionFalse,
+			wantMessage: "removed zone azure.com. of dns server azure, which forwarded management.azure.com; zone ARO.azurecr.io of dns server acr, which forwarded aro.azurecr.io",
+			wantEvents:  2,
+		},
+		{
+			name:        "recent drift is still reported",
+			servers:     []operatorv1.Server{corpDNS},
+			condition:   condition(corev1.ConditionFalse, time.Now().Add(-time.Minute)),
+			wantServers: []operatorv1.Server{corpDNS},
+			wantStatus:  corev1.ConditionFalse,
+		},
+		{
+			name:        "old drift is cleared",
+			servers:     []operatorv1.Server{corpDNS},
+			condition:   condition(corev1.ConditionFalse, time.Now().Add(-2*driftReportPeriod)),
+			wantServers: []operatorv1.Server{corpDNS},
+			wantStatus:  corev1.ConditionTrue,
+			wantMessage: "ARO DNS resolution is intact",
+		},
+		{
+			name:  "disabled",
+			flags: map[string]string{operator.FlagDNSEnabled: "false"},
+			servers: []operatorv1.Server{
+				{Name: "azure", Zones: []string{"azure.com"}},
+			},
+			wantServers: []operatorv1.Server{
+				{Name: "azure", Zones: []string{"azure.com"}},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					ACRDomain: "aro.azurecr.io",
+					InternetChecker: arov1alpha1.InternetCheckerSpec{
+						URLs: []string{
+							"https://aro.azurecr.io/",
+							"https://login.microsoftonline.com/",
+							"https://management.azure.com/",
+						},
+					},
+					OperatorFlags: tt.flags,
+				},
+			}
+			if tt.condition != nil {
+				cluster.Status.Conditions = status.Conditions{*tt.condition}
+			}
+
+			arocli := arofake.NewSimpleClientset(cluster)
+			operatorcli := operatorfake.NewSimpleClientset(&operatorv1.DNS{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: dnsName,
+				},
+				Spec: operatorv1.DNSSpec{
+					Servers: tt.servers,
+				},
+			})
+			recorder := record.NewFakeRecorder(10)
+
+			r := NewReconciler(logrus.NewEntry(logrus.StandardLogger()), operatorcli, arocli.AroV1alpha1(), recorder)
+
+			_, err := r.Reconcile(ctrl.Request{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			dns, err := operatorcli.OperatorV1().DNSes().Get(ctx, dnsName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(dns.Spec.Servers, tt.wantServers) {
+				t.Error(dns.Spec.Servers)
+			}
+
+			if tt.flags != nil {
+				return
+			}
+
+			cluster, err = arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cond := cluster.Status.Conditions.GetCondition(arov1alpha1.DNSValid)
+			if cond == nil {
+				t.Fatal("condition not set")
+			}
+			if cond.Status != tt.wantStatus {
+				t.Error(cond.Status)
+			}
+			if cond.Message != tt.wantMessage {
+				t.Error(cond.Message)
+			}
+
+			var restored int
+			for len(recorder.Events) > 0 {
+				e := <-recorder.Events
+				if strings.HasPrefix(e, "Warning DNSRestored") {
+					restored++
+				}
+			}
+			if restored != tt.wantEvents {
+				t.Error(restored)
+			}
+		})
+	}
+}
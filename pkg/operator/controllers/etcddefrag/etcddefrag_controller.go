@@ -0,0 +1,221 @@
+package etcddefrag
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+	"github.com/Azure/ARO-RP/pkg/util/etcd"
+)
+
+// A member stops serving requests while it is defragmented, so
+// defragmentation only starts in the quiet hours, between these hours UTC
+const (
+	quietHoursStart = 2
+	quietHoursEnd   = 5
+)
+
+const (
+	// fragmentationThreshold is the proportion of free space in a member's
+	// database beyond which the member is defragmented
+	fragmentationThreshold = 0.5
+
+	// minDefragSize is the database size below which defragmentation is not
+	// worth the disruption, however fragmented the database is
+	minDefragSize = 1 << 30
+)
+
+// EtcdDefragReconciler defragments the fragmented databases of the etcd
+// members one at a time, so that space freed by compaction is returned before
+// the quota is reached
+type EtcdDefragReconciler struct {
+	kubernetescli kubernetes.Interface
+	arocli        aroclient.AroV1alpha1Interface
+	recorder      record.EventRecorder
+	log           *logrus.Entry
+
+	newEtcdClient func(context.Context, kubernetes.Interface) (etcd.Client, error)
+	now           func() time.Time
+	pollInterval  time.Duration
+}
+
+func NewReconciler(log *logrus.Entry, kubernetescli kubernetes.Interface, arocli aroclient.AroV1alpha1Interface, recorder record.EventRecorder) *EtcdDefragReconciler {
+	return &EtcdDefragReconciler{
+		kubernetescli: kubernetescli,
+		arocli:        arocli,
+		recorder:      recorder,
+		log:           log,
+
+		newEtcdClient: etcd.NewClient,
+		now:           time.Now,
+		pollInterval:  10 * time.Second,
+	}
+}
+
+// Reconcile defragments the members which need it during the quiet hours,
+// followers first and the leader last, waiting for each member to serve again
+// before moving to the next.  Afterwards it disarms the NOSPACE alarms of
+// members which are back within quota.
+func (r *EtcdDefragReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
+	// TODO(mj): controller-runtime master fixes the need for this (https://github.com/kubernetes-sigs/controller-runtime/blob/master/pkg/reconcile/reconcile.go#L93) but it's not yet released.
+	ctx := context.Background()
+
+	instance, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if !controllers.FlagEnabled(instance, operator.FlagEtcdDefragEnabled) {
+		r.log.Debug("etcd defragmentation is disabled")
+		return reconcile.Result{}, nil
+	}
+
+	if wait := untilQuietHours(r.now()); wait > 0 {
+		return reconcile.Result{RequeueAfter: wait}, nil
+	}
+
+	etcdcli, err := r.newEtcdClient(ctx, r.kubernetescli)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	members, err := etcdcli.Members(ctx)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// don't disrupt a cluster of which any member is already unavailable
+	statuses := make(map[string]*etcd.Status, len(members))
+	var followers, leaders []etcd.Member
+	for _, m := range members {
+		s, err := etcdcli.Status(ctx, m)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		statuses[m.Name] = s
+
+		if s.DBSize < minDefragSize || s.Fragmentation() < fragmentationThreshold {
+			continue
+		}
+
+		if s.IsLeader() {
+			leaders = append(leaders, m)
+		} else {
+			followers = append(followers, m)
+		}
+	}
+
+	for _, m := range append(followers, leaders...) {
+		before := statuses[m.Name]
+
+		r.log.Infof("defragmenting %s", m.Name)
+		err = etcdcli.Defragment(ctx, m)
+		if err != nil {
+			r.recorder.Eventf(instance, corev1.EventTypeWarning, "EtcdDefragFailed", "defragmenting %s: %v", m.Name, err)
+			return reconcile.Result{}, err
+		}
+
+		after, err := r.waitForMember(ctx, etcdcli, m)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		statuses[m.Name] = after
+
+		r.recorder.Eventf(instance, corev1.EventTypeNormal, "EtcdDefragmented", "defragmented %s from %dMiB to %dMiB", m.Name, before.DBSize>>20, after.DBSize>>20)
+	}
+
+	err = r.disarmNoSpaceAlarms(ctx, instance, etcdcli, members, statuses)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: time.Hour}, nil
+}
+
+// waitForMember waits for a defragmented member to report its status again
+func (r *EtcdDefragReconciler) waitForMember(ctx context.Context, etcdcli etcd.Client, m etcd.Member) (s *etcd.Status, err error) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	err = wait.PollImmediateUntil(r.pollInterval, func() (bool, error) {
+		s, err = etcdcli.Status(timeoutCtx, m)
+		if err != nil {
+			r.log.Info(err)
+		}
+		return err == nil, nil
+	}, timeoutCtx.Done())
+
+	return s, err
+}
+
+func (r *EtcdDefragReconciler) disarmNoSpaceAlarms(ctx context.Context, instance *arov1alpha1.Cluster, etcdcli etcd.Client, members []etcd.Member, statuses map[string]*etcd.Status) error {
+	if len(members) == 0 {
+		return nil
+	}
+
+	alarms, err := etcdcli.Alarms(ctx, members[0])
+	if err != nil {
+		return err
+	}
+
+	for _, a := range alarms {
+		if a.Alarm != etcd.AlarmNoSpace {
+			continue
+		}
+
+		for _, m := range members {
+			s := statuses[m.Name]
+			if s.Header.MemberID != a.MemberID || s.DBSize >= etcd.QuotaBackendBytes {
+				continue
+			}
+
+			err = etcdcli.Disarm(ctx, m, a)
+			if err != nil {
+				return err
+			}
+
+			r.recorder.Eventf(instance, corev1.EventTypeNormal, "EtcdAlarmDisarmed", "disarmed %s alarm of %s", a.Alarm, m.Name)
+		}
+	}
+
+	return nil
+}
+
+// untilQuietHours returns how long it is until the next quiet hours start, or
+// zero if they have started
+func untilQuietHours(now time.Time) time.Duration {
+	now = now.UTC()
+	if now.Hour() >= quietHoursStart && now.Hour() < quietHoursEnd {
+		return 0
+	}
+
+	start := time.Date(now.Year(), now.Month(), now.Day(), quietHoursStart, 0, 0, 0, time.UTC)
+	if start.Before(now) {
+		start = start.AddDate(0, 0, 1)
+	}
+
+	return start.Sub(now)
+}
+
+// SetupWithManager setup our manager
+func (r *EtcdDefragReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}).
+		Named(controllers.EtcdDefragControllerName).
+		Complete(r)
+}
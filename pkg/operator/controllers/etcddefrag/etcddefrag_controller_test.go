@@ -0,0 +1,134 @@
+package etcddefrag
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	"github.com/Azure/ARO-RP/pkg/util/etcd"
+	mock_etcd "github.com/Azure/ARO-RP/pkg/util/mocks/etcd"
+)
+
+func TestReconcile(t *testing.T) {
+	members := []etcd.Member{
+		{Name: "etcd-master-0", Endpoint: "https://10.0.0.6:2379"},
+		{Name: "etcd-master-1", Endpoint: "https://10.0.0.7:2379"},
+		{Name: "etcd-master-2", Endpoint: "https://10.0.0.8:2379"},
+	}
+
+	// master-0 leads.  master-1 is not worth defragmenting.
+	status := func(id uint64, dbSize, dbSizeInUse int64) *etcd.Status {
+		s := &etcd.Status{Leader: 1, DBSize: dbSize, DBSizeInUse: dbSizeInUse}
+		s.Header.MemberID = id
+		return s
+	}
+
+	quiet := time.Date(2020, 11, 1, 3, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		name        string
+		flags       map[string]string
+		now         time.Time
+		mocks       func(*mock_etcd.MockClient)
+		wantRequeue time.Duration
+		wantErr     string
+	}{
+		{
+			name: "disabled",
+			now:  quiet,
+		},
+		{
+			name:        "outside quiet hours",
+			flags:       map[string]string{operator.FlagEtcdDefragEnabled: "true"},
+			now:         time.Date(2020, 11, 1, 12, 30, 0, 0, time.UTC),
+			wantRequeue: 13*time.Hour + 30*time.Minute,
+		},
+		{
+			name:  "fragmented members are defragmented leader last",
+			flags: map[string]string{operator.FlagEtcdDefragEnabled: "true"},
+			now:   quiet,
+			mocks: func(etcdcli *mock_etcd.MockClient) {
+				etcdcli.EXPECT().Members(gomock.Any()).Return(members, nil)
+				etcdcli.EXPECT().Status(gomock.Any(), members[0]).Return(status(1, 6<<30, 2<<30), nil)
+				etcdcli.EXPECT().Status(gomock.Any(), members[1]).Return(status(2, 512<<20, 100<<20), nil)
+				etcdcli.EXPECT().Status(gomock.Any(), members[2]).Return(status(3, 8<<30, 2<<30), nil)
+
+				gomock.InOrder(
+					etcdcli.EXPECT().Defragment(gomock.Any(), members[2]).Return(nil),
+					etcdcli.EXPECT().Status(gomock.Any(), members[2]).Return(status(3, 2<<30, 2<<30), nil),
+					etcdcli.EXPECT().Defragment(gomock.Any(), members[0]).Return(nil),
+					etcdcli.EXPECT().Status(gomock.Any(), members[0]).Return(status(1, 2<<30, 2<<30), nil),
+				)
+
+				etcdcli.EXPECT().Alarms(gomock.Any(), members[0]).Return([]etcd.Alarm{{MemberID: 3, Alarm: etcd.AlarmNoSpace}}, nil)
+				etcdcli.EXPECT().Disarm(gomock.Any(), members[2], etcd.Alarm{MemberID: 3, Alarm: etcd.AlarmNoSpace}).Return(nil)
+			},
+			wantRequeue: time.Hour,
+		},
+		{
+			name:  "unavailable member prevents defragmentation",
+			flags: map[string]string{operator.FlagEtcdDefragEnabled: "true"},
+			now:   quiet,
+			mocks: func(etcdcli *mock_etcd.MockClient) {
+				etcdcli.EXPECT().Members(gomock.Any()).Return(members, nil)
+				etcdcli.EXPECT().Status(gomock.Any(), members[0]).Return(status(1, 6<<30, 2<<30), nil)
+				etcdcli.EXPECT().Status(gomock.Any(), members[1]).Return(nil, fmt.Errorf("connection refused"))
+			},
+			wantErr: "connection refused",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			etcdcli := mock_etcd.NewMockClient(controller)
+			if tt.mocks != nil {
+				tt.mocks(etcdcli)
+			}
+
+			arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					OperatorFlags: tt.flags,
+				},
+			})
+
+			r := &EtcdDefragReconciler{
+				arocli:   arocli.AroV1alpha1(),
+				recorder: record.NewFakeRecorder(10),
+				log:      logrus.NewEntry(logrus.StandardLogger()),
+				newEtcdClient: func(context.Context, kubernetes.Interface) (etcd.Client, error) {
+					return etcdcli, nil
+				},
+				now:          func() time.Time { return tt.now },
+				pollInterval: time.Millisecond,
+			}
+
+			result, err := r.Reconcile(ctrl.Request{})
+			if err == nil && tt.wantErr != "" ||
+				err != nil && err.Error() != tt.wantErr {
+				t.Fatal(err)
+			}
+
+			if result.RequeueAfter != tt.wantRequeue {
+				t.Error(result.RequeueAfter)
+			}
+		})
+	}
+}
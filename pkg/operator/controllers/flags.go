@@ -0,0 +1,21 @@
+package controllers
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+// FlagEnabled returns whether the boolean operator flag is enabled on the
+// cluster.  Clusters whose operator was deployed by an older RP do not have
+// the flags set, in which case the default applies.
+func FlagEnabled(instance *arov1alpha1.Cluster, flag string) bool {
+	v, found := instance.Spec.OperatorFlags[flag]
+	if !found {
+		v = operator.DefaultOperatorFlags[flag]
+	}
+
+	return v == "true"
+}
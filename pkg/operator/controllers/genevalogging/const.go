@@ -8,6 +8,10 @@ const (
 	kubeServiceAccount     = "system:serviceaccount:" + kubeNamespace + ":geneva"
 	certificatesSecretName = "certificates"
 
+	// PipelineMetricsPort is the port of the fluent-bit HTTP server of the
+	// containers pipeline, which serves its record and retry counters
+	PipelineMetricsPort = 2020
+
 	ClusterLogsNamespace = "AROClusterLogs"
 	parsersConf          = `
 [PARSER]
@@ -50,6 +54,9 @@ const (
 	containersConf = `
 [SERVICE]
 	Parsers_File /etc/td-agent-bit/parsers.conf
+	HTTP_Server On
+	HTTP_Listen 0.0.0.0
+	HTTP_Port 2020
 
 [INPUT]
 	Name tail
@@ -18,12 +18,21 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/priorityclass"
 	"github.com/Azure/ARO-RP/pkg/util/version"
 )
 
 const (
 	GenevaCertName = "gcscert.pem"
 	GenevaKeyName  = "gcskey.pem"
+
+	// TrustedCABundleConfigMapName is the config map into which the cluster
+	// network operator injects the cluster's trusted CA bundle, under
+	// TrustedCABundleKey.  mdsd verifies the Geneva endpoints with it, so that
+	// rotations of the roots on the nodes reach mdsd.
+	TrustedCABundleConfigMapName = "trusted-ca-bundle"
+	TrustedCABundleKey           = "ca-bundle.crt"
 )
 
 func (g *GenevaloggingReconciler) securityContextConstraints(ctx context.Context, name, serviceAccountName string) (*securityv1.SecurityContextConstraints, error) {
@@ -104,8 +113,19 @@ func (g *GenevaloggingReconciler) daemonset(cluster *arov1alpha1.Cluster) (*apps
 								},
 							},
 						},
+						{
+							Name: "trusted-ca-bundle",
+							VolumeSource: v1.VolumeSource{
+								ConfigMap: &v1.ConfigMapVolumeSource{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: TrustedCABundleConfigMapName,
+									},
+								},
+							},
+						},
 					},
 					ServiceAccountName: "geneva",
+					PriorityClassName:  priorityclass.NodeCritical,
 					Tolerations: []v1.Toleration{
 						{
 							Effect:   v1.TaintEffectNoExecute,
@@ -164,6 +184,12 @@ func (g *GenevaloggingReconciler) daemonset(cluster *arov1alpha1.Cluster) (*apps
 								"-c",
 								"/etc/td-agent-bit/containers.conf",
 							},
+							Ports: []v1.ContainerPort{
+								{
+									Name:          "metrics",
+									ContainerPort: PipelineMetricsPort,
+								},
+							},
 							// TODO: specify requests/limits
 							SecurityContext: &v1.SecurityContext{
 								Privileged: to.BoolPtr(true),
@@ -308,8 +334,12 @@ func (g *GenevaloggingReconciler) daemonset(cluster *arov1alpha1.Cluster) (*apps
 									Name:  "RESOURCE_NAME",
 									Value: strings.ToLower(r.ResourceName),
 								},
+								{
+									Name:  "SSL_CERT_FILE",
+									Value: "/etc/mdsd.d/trust/" + TrustedCABundleKey,
+								},
 							},
-							Resources: v1.ResourceRequirements{
+							Resources: controllers.ComponentResources(cluster, arov1alpha1.ComponentGenevaLogging, v1.ResourceRequirements{
 								Limits: v1.ResourceList{
 									v1.ResourceCPU:    resource.MustParse("200m"),
 									v1.ResourceMemory: resource.MustParse("1000Mi"),
@@ -318,7 +348,7 @@ func (g *GenevaloggingReconciler) daemonset(cluster *arov1alpha1.Cluster) (*apps
 									v1.ResourceCPU:    resource.MustParse("10m"),
 									v1.ResourceMemory: resource.MustParse("100Mi"),
 								},
-							},
+							}),
 							SecurityContext: &v1.SecurityContext{
 								Privileged: to.BoolPtr(true),
 								RunAsUser:  to.Int64Ptr(0),
@@ -328,6 +358,11 @@ func (g *GenevaloggingReconciler) daemonset(cluster *arov1alpha1.Cluster) (*apps
 									Name:      "certificates",
 									MountPath: "/etc/mdsd.d/secret",
 								},
+								{
+									Name:      "trusted-ca-bundle",
+									MountPath: "/etc/mdsd.d/trust",
+									ReadOnly:  true,
+								},
 							},
 						},
 					},
@@ -377,6 +412,15 @@ func (g *GenevaloggingReconciler) resources(ctx context.Context, cluster *arov1a
 				"parsers.conf":    parsersConf,
 			},
 		},
+		// the config map is deliberately created without data, which the
+		// cluster network operator injects and the merge of Ensure leaves alone
+		&v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      TrustedCABundleConfigMapName,
+				Namespace: kubeNamespace,
+				Labels:    map[string]string{"config.openshift.io/inject-trusted-cabundle": "true"},
+			},
+		},
 		&v1.ServiceAccount{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "geneva",
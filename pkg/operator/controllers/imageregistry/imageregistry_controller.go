@@ -0,0 +1,293 @@
+package imageregistry
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+	"github.com/Azure/ARO-RP/pkg/util/dynamichelper"
+)
+
+const (
+	// driftReportPeriod is how long the ImageRegistryConfigValid condition
+	// stays False after a change was reverted, so that the monitor gets to
+	// see it
+	driftReportPeriod = time.Hour
+
+	configGroupKind      = "Config.imageregistry.operator.openshift.io"
+	imageStreamGroupKind = "ImageStream.image.openshift.io"
+
+	// configName is the name of the image registry operator config
+	configName = "cluster"
+
+	// internalRegistryHost prefixes the references of images which are
+	// stored in the integrated registry
+	internalRegistryHost = "image-registry.openshift-image-registry.svc:5000/"
+)
+
+// configGVK is the GroupVersionKind of the image registry operator config,
+// whose API types aren't vendored
+var configGVK = schema.GroupVersionKind{
+	Group:   "imageregistry.operator.openshift.io",
+	Version: "v1",
+	Kind:    "Config",
+}
+
+// ImageRegistryReconciler reverts changes to the image registry operator
+// config which would lose images or leave the registry unsupported: switching
+// away from the Azure storage account, pointing the registry at another
+// storage account than the managed one, and removing the registry (which
+// deletes the managed storage account) while it stores images
+type ImageRegistryReconciler struct {
+	arocli     aroclient.AroV1alpha1Interface
+	restConfig *rest.Config
+	recorder   record.EventRecorder
+	log        *logrus.Entry
+}
+
+func NewReconciler(log *logrus.Entry, arocli aroclient.AroV1alpha1Interface, restConfig *rest.Config, recorder record.EventRecorder) *ImageRegistryReconciler {
+	return &ImageRegistryReconciler{
+		arocli:     arocli,
+		restConfig: restConfig,
+		recorder:   recorder,
+		log:        log,
+	}
+}
+
+// +kubebuilder:rbac:groups=imageregistry.operator.openshift.io,resources=configs,verbs=get;update;watch
+// +kubebuilder:rbac:groups=image.openshift.io,resources=imagestreams,verbs=list
+
+// Reconcile restores the supported image registry configuration and reports
+// each reverted change as an event and in the ImageRegistryConfigValid
+// condition.  The registry operator may act on a change before it is
+// reverted, so this limits the damage rather than preventing it.
+func (r *ImageRegistryReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
+	// TODO(mj): controller-runtime master fixes the need for this (https://github.com/kubernetes-sigs/controller-runtime/blob/master/pkg/reconcile/reconcile.go#L93) but it's not yet released.
+	ctx := context.Background()
+
+	instance, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if !controllers.FlagEnabled(instance, operator.FlagImageRegistryEnabled) {
+		r.log.Debug("image registry guardrails are disabled")
+		return reconcile.Result{}, nil
+	}
+
+	dh, err := dynamichelper.New(r.log, r.restConfig)
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	drifted, err := r.ensureConfig(ctx, dh)
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	for _, drift := range drifted {
+		r.log.Warnf("reverted %s", drift)
+		r.recorder.Eventf(instance, corev1.EventTypeWarning, "ImageRegistryConfigRestored", "reverted %s", drift)
+	}
+
+	cond := &status.Condition{
+		Type:    arov1alpha1.ImageRegistryConfigValid,
+		Status:  corev1.ConditionTrue,
+		Message: "image registry configuration is supported",
+		Reason:  "CheckDone",
+	}
+
+	if len(drifted) > 0 {
+		cond.Status = corev1.ConditionFalse
+		cond.Reason = "Restored"
+		cond.Message = fmt.Sprintf("reverted %s", strings.Join(drifted, "; "))
+
+		return reconcile.Result{RequeueAfter: driftReportPeriod}, controllers.SetCondition(ctx, r.arocli, r.recorder, cond, operator.RoleMaster)
+	}
+
+	// leave a recent report of drift in place until the report period is up
+	previous := instance.Status.Conditions.GetCondition(arov1alpha1.ImageRegistryConfigValid)
+	if previous != nil && previous.Status == corev1.ConditionFalse {
+		if remaining := driftReportPeriod - time.Since(previous.LastTransitionTime.Time); remaining > 0 {
+			return reconcile.Result{RequeueAfter: remaining}, nil
+		}
+	}
+
+	return reconcile.Result{}, controllers.SetCondition(ctx, r.arocli, r.recorder, cond, operator.RoleMaster)
+}
+
+// ensureConfig reverts the unsupported changes to the image registry operator
+// config.  The Azure storage which the registry operator reports in the
+// config status is the storage to restore.  It returns a description of each
+// change it reverted.
+func (r *ImageRegistryReconciler) ensureConfig(ctx context.Context, dh dynamichelper.Interface) (drifted []string, err error) {
+	var storesImages *bool
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		drifted = nil
+
+		config, err := dh.Get(ctx, configGroupKind, "", configName)
+		switch {
+		case kerrors.IsNotFound(err):
+			return nil
+		case err != nil:
+			return err
+		}
+
+		managementState, _, _ := unstructured.NestedString(config.Object, "spec", "managementState")
+		if managementState == "Removed" {
+			if storesImages == nil {
+				b, err := r.storesImages(ctx, dh)
+				if err != nil {
+					return err
+				}
+				storesImages = &b
+			}
+
+			if *storesImages {
+				err = unstructured.SetNestedField(config.Object, "Managed", "spec", "managementState")
+				if err != nil {
+					return err
+				}
+				drifted = append(drifted, "removal of the image registry, which stores images")
+			}
+		}
+
+		statusAzure, found, _ := unstructured.NestedStringMap(config.Object, "status", "storage", "azure")
+		if found && statusAzure["accountName"] != "" {
+			specStorage, _, _ := unstructured.NestedMap(config.Object, "spec", "storage")
+			specAzure, isAzure, _ := unstructured.NestedStringMap(config.Object, "spec", "storage", "azure")
+
+			switch {
+			case !isAzure && len(specStorage) > 0:
+				drifted = append(drifted, fmt.Sprintf("image registry storage %s to storage account %s", storageTypes(specStorage), statusAzure["accountName"]))
+				err = setAzureStorage(config, statusAzure)
+
+			case isAzure && managedStorage(config) &&
+				(specAzure["accountName"] != "" && specAzure["accountName"] != statusAzure["accountName"] ||
+					specAzure["container"] != "" && specAzure["container"] != statusAzure["container"]):
+				drifted = append(drifted, fmt.Sprintf("image registry storage account %s/%s to managed storage account %s/%s", specAzure["accountName"], specAzure["container"], statusAzure["accountName"], statusAzure["container"]))
+				err = setAzureStorage(config, statusAzure)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(drifted) == 0 {
+			return nil
+		}
+
+		return dh.CreateOrUpdate(ctx, config)
+	})
+
+	return drifted, err
+}
+
+// storesImages returns true if any image stream has an image in the
+// integrated registry
+func (r *ImageRegistryReconciler) storesImages(ctx context.Context, dh dynamichelper.Interface) (bool, error) {
+	imagestreams, err := dh.List(ctx, imageStreamGroupKind, "")
+	if err != nil {
+		return false, err
+	}
+
+	for _, is := range imagestreams.Items {
+		tags, _, _ := unstructured.NestedSlice(is.Object, "status", "tags")
+		for _, tag := range tags {
+			items, _, _ := unstructured.NestedSlice(tag.(map[string]interface{}), "items")
+			for _, item := range items {
+				ref, _, _ := unstructured.NestedString(item.(map[string]interface{}), "dockerImageReference")
+				if strings.HasPrefix(ref, internalRegistryHost) {
+					return true, nil
+				}
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// managedStorage returns true if the registry operator created, and therefore
+// manages, the storage account
+func managedStorage(config *unstructured.Unstructured) bool {
+	managed, _, _ := unstructured.NestedBool(config.Object, "status", "storageManaged")
+	return managed
+}
+
+func setAzureStorage(config *unstructured.Unstructured, azure map[string]string) error {
+	storage := map[string]interface{}{}
+	for k, v := range azure {
+		storage[k] = v
+	}
+
+	return unstructured.SetNestedMap(config.Object, map[string]interface{}{"azure": storage}, "spec", "storage")
+}
+
+// storageTypes returns the configured storage types, e.g. "emptyDir"
+func storageTypes(storage map[string]interface{}) string {
+	types := make([]string, 0, len(storage))
+	for k := range storage {
+		types = append(types, k)
+	}
+	sort.Strings(types)
+
+	return strings.Join(types, ", ")
+}
+
+// SetupWithManager setup our manager
+func (r *ImageRegistryReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	config := &unstructured.Unstructured{}
+	config.SetGroupVersionKind(configGVK)
+
+	triggerReconcile := func(meta metav1.Object, o runtime.Object) bool {
+		if _, ok := o.(*arov1alpha1.Cluster); ok {
+			return true
+		}
+
+		return meta.GetName() == configName
+	}
+
+	isConfig := predicate.Funcs{
+		UpdateFunc:  func(e event.UpdateEvent) bool { return triggerReconcile(e.MetaNew, e.ObjectNew) },
+		CreateFunc:  func(e event.CreateEvent) bool { return triggerReconcile(e.Meta, e.Object) },
+		DeleteFunc:  func(e event.DeleteEvent) bool { return triggerReconcile(e.Meta, e.Object) },
+		GenericFunc: func(e event.GenericEvent) bool { return triggerReconcile(e.Meta, e.Object) },
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}).
+		Watches(&source.Kind{Type: config}, &handler.EnqueueRequestForObject{}).
+		WithEventFilter(isConfig).
+		Named(controllers.ImageRegistryControllerName).
+		Complete(r)
+}
@@ -0,0 +1,163 @@
+package imageregistry
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+	mock_dynamichelper "github.com/Azure/ARO-RP/pkg/util/mocks/dynamichelper"
+)
+
+func TestEnsureConfig(t *testing.T) {
+	ctx := context.Background()
+
+	managedAzure := map[string]interface{}{
+		"accountName": "imageregistryabcde",
+		"container":   "abcde-image-registry",
+	}
+
+	imageStream := func(ref string) unstructured.Unstructured {
+		return unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"status": map[string]interface{}{
+					"tags": []interface{}{
+						map[string]interface{}{
+							"tag": "latest",
+							"items": []interface{}{
+								map[string]interface{}{
+									"dockerImageReference": ref,
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	for _, tt := range []struct {
+		name         string
+		spec         map[string]interface{}
+		imageStreams []unstructured.Unstructured
+		wantSpec     map[string]interface{}
+		wantDrifted  []string
+	}{
+		{
+			name: "supported config is left alone",
+			spec: map[string]interface{}{
+				"managementState": "Managed",
+				"storage":         map[string]interface{}{"azure": map[string]interface{}{}},
+			},
+		},
+		{
+			name: "emptyDir storage is reverted",
+			spec: map[string]interface{}{
+				"managementState": "Managed",
+				"storage":         map[string]interface{}{"emptyDir": map[string]interface{}{}},
+			},
+			wantSpec: map[string]interface{}{
+				"managementState": "Managed",
+				"storage":         map[string]interface{}{"azure": managedAzure},
+			},
+			wantDrifted: []string{"image registry storage emptyDir to storage account imageregistryabcde"},
+		},
+		{
+			name: "other storage account is reverted",
+			spec: map[string]interface{}{
+				"managementState": "Managed",
+				"storage": map[string]interface{}{
+					"azure": map[string]interface{}{
+						"accountName": "customer",
+						"container":   "registry",
+					},
+				},
+			},
+			wantSpec: map[string]interface{}{
+				"managementState": "Managed",
+				"storage":         map[string]interface{}{"azure": managedAzure},
+			},
+			wantDrifted: []string{"image registry storage account customer/registry to managed storage account imageregistryabcde/abcde-image-registry"},
+		},
+		{
+			name: "removal of a registry storing images is reverted",
+			spec: map[string]interface{}{
+				"managementState": "Removed",
+				"storage":         map[string]interface{}{"azure": managedAzure},
+			},
+			imageStreams: []unstructured.Unstructured{
+				imageStream("registry.redhat.io/ubi8/ubi@sha256:0000000000000000000000000000000000000000000000000000000000000000"),
+				imageStream("image-registry.openshift-image-registry.svc:5000/customer/app@sha256:0000000000000000000000000000000000000000000000000000000000000000"),
+			},
+			wantSpec: map[string]interface{}{
+				"managementState": "Managed",
+				"storage":         map[string]interface{}{"azure": managedAzure},
+			},
+			wantDrifted: []string{"removal of the image registry, which stores images"},
+		},
+		{
+			name: "removal of an unused registry is allowed",
+			spec: map[string]interface{}{
+				"managementState": "Removed",
+				"storage":         map[string]interface{}{"azure": managedAzure},
+			},
+			imageStreams: []unstructured.Unstructured{
+				imageStream("registry.redhat.io/ubi8/ubi@sha256:0000000000000000000000000000000000000000000000000000000000000000"),
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			config := &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "imageregistry.operator.openshift.io/v1",
+					"kind":       "Config",
+					"metadata": map[string]interface{}{
+						"name": configName,
+					},
+					"spec": tt.spec,
+					"status": map[string]interface{}{
+						"storage":        map[string]interface{}{"azure": managedAzure},
+						"storageManaged": true,
+					},
+				},
+			}
+
+			dh := mock_dynamichelper.NewMockInterface(controller)
+			dh.EXPECT().Get(gomock.Any(), configGroupKind, "", configName).Return(config, nil)
+
+			if tt.imageStreams != nil {
+				dh.EXPECT().List(gomock.Any(), imageStreamGroupKind, "").Return(&unstructured.UnstructuredList{Items: tt.imageStreams}, nil)
+			}
+
+			if tt.wantSpec != nil {
+				dh.EXPECT().CreateOrUpdate(gomock.Any(), gomock.Any()).Do(func(ctx context.Context, o *unstructured.Unstructured) {
+					if !reflect.DeepEqual(o.Object["spec"], tt.wantSpec) {
+						t.Error(o.Object["spec"])
+					}
+				}).Return(nil)
+			}
+
+			r := &ImageRegistryReconciler{
+				log: utillog.GetLogger(),
+			}
+
+			drifted, err := r.ensureConfig(ctx, dh)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(drifted, tt.wantDrifted) {
+				t.Error(drifted)
+			}
+		})
+	}
+}
@@ -0,0 +1,285 @@
+package inventory
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	configclient "github.com/openshift/client-go/config/clientset/versioned"
+	maoclient "github.com/openshift/machine-api-operator/pkg/generated/clientset/versioned"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+	"github.com/Azure/ARO-RP/pkg/util/version"
+)
+
+// ClientCertName and ClientKeyName are the keys of the operator secret which
+// hold the TLS client certificate that authenticates the inventory reports
+const (
+	ClientCertName = "inventory-cert.pem"
+	ClientKeyName  = "inventory-key.pem"
+)
+
+// reportInterval is how often the inventory is reported
+const reportInterval = 15 * time.Minute
+
+const (
+	masterRoleLabel      = "node-role.kubernetes.io/master"
+	machineSetsNamespace = "openshift-machine-api"
+)
+
+// InventoryReconciler reports a summary of the state of the cluster to the
+// RP, so that the fleet can be queried even when the RP can't monitor the
+// cluster directly
+type InventoryReconciler struct {
+	kubernetescli kubernetes.Interface
+	configcli     configclient.Interface
+	maocli        maoclient.Interface
+	arocli        aroclient.AroV1alpha1Interface
+	log           *logrus.Entry
+
+	// rootCAs verify the RP's server certificate; if nil, the system roots
+	// are used
+	rootCAs *x509.CertPool
+	now     func() time.Time
+}
+
+func NewReconciler(log *logrus.Entry, kubernetescli kubernetes.Interface, configcli configclient.Interface, maocli maoclient.Interface, arocli aroclient.AroV1alpha1Interface) *InventoryReconciler {
+	return &InventoryReconciler{
+		kubernetescli: kubernetescli,
+		configcli:     configcli,
+		maocli:        maocli,
+		arocli:        arocli,
+		log:           log,
+
+		now: time.Now,
+	}
+}
+
+// Reconcile posts the inventory of the cluster to the URL in the cluster
+// spec, and does so again every reportInterval
+func (r *InventoryReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
+	// TODO(mj): controller-runtime master fixes the need for this (https://github.com/kubernetes-sigs/controller-runtime/blob/master/pkg/reconcile/reconcile.go#L93) but it's not yet released.
+	ctx := context.Background()
+
+	instance, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if instance.Spec.InventoryURL == "" {
+		r.log.Debug("inventory reporting is not configured")
+		return reconcile.Result{}, nil
+	}
+
+	s, err := r.kubernetescli.CoreV1().Secrets(operator.Namespace).Get(ctx, operator.SecretName, metav1.GetOptions{})
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if len(s.Data[ClientCertName]) == 0 || len(s.Data[ClientKeyName]) == 0 {
+		r.log.Debug("inventory client certificate is not set")
+		return reconcile.Result{}, nil
+	}
+
+	cert, err := tls.X509KeyPair(s.Data[ClientCertName], s.Data[ClientKeyName])
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	inventory, err := r.inventory(ctx, instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	err = r.report(ctx, instance.Spec.InventoryURL, cert, inventory)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: reportInterval}, nil
+}
+
+// inventory summarises the state of the cluster
+func (r *InventoryReconciler) inventory(ctx context.Context, instance *arov1alpha1.Cluster) (*api.ClusterInventory, error) {
+	inventory := &api.ClusterInventory{
+		ReportedAt:      r.now().UTC(),
+		OperatorVersion: version.GitCommit,
+		OperatorFlags:   instance.Spec.OperatorFlags,
+	}
+
+	cv, err := r.configcli.ConfigV1().ClusterVersions().Get(ctx, "version", metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	// history is most recent first
+	for _, h := range cv.Status.History {
+		if h.State == configv1.CompletedUpdate {
+			inventory.ClusterVersion = h.Version
+			break
+		}
+	}
+
+	nodes, err := r.kubernetescli.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	nodeSummaries := map[string]*api.InventoryNodeSummary{}
+	for _, node := range nodes.Items {
+		role := "worker"
+		if _, ok := node.Labels[masterRoleLabel]; ok {
+			role = "master"
+		}
+
+		if nodeSummaries[role] == nil {
+			nodeSummaries[role] = &api.InventoryNodeSummary{Role: role}
+		}
+		nodeSummaries[role].Count++
+
+		for _, c := range node.Status.Conditions {
+			if c.Type == corev1.NodeReady && c.Status == corev1.ConditionTrue {
+				nodeSummaries[role].Ready++
+			}
+		}
+	}
+
+	for _, s := range nodeSummaries {
+		inventory.Nodes = append(inventory.Nodes, *s)
+	}
+	sort.Slice(inventory.Nodes, func(i, j int) bool { return inventory.Nodes[i].Role < inventory.Nodes[j].Role })
+
+	machines, err := r.maocli.MachineV1beta1().Machines(machineSetsNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	machineSummaries := map[string]*api.InventoryMachineSummary{}
+	for _, machine := range machines.Items {
+		phase := "Unknown"
+		if machine.Status.Phase != nil {
+			phase = *machine.Status.Phase
+		}
+
+		if machineSummaries[phase] == nil {
+			machineSummaries[phase] = &api.InventoryMachineSummary{Phase: phase}
+		}
+		machineSummaries[phase].Count++
+	}
+
+	for _, s := range machineSummaries {
+		inventory.Machines = append(inventory.Machines, *s)
+	}
+	sort.Slice(inventory.Machines, func(i, j int) bool { return inventory.Machines[i].Phase < inventory.Machines[j].Phase })
+
+	for _, c := range instance.Status.Conditions {
+		inventory.Conditions = append(inventory.Conditions, api.InventoryCondition{
+			Type:   string(c.Type),
+			Status: string(c.Status),
+			Reason: string(c.Reason),
+		})
+	}
+
+	cos, err := r.configcli.ConfigV1().ClusterOperators().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, co := range cos.Items {
+		ico := api.InventoryClusterOperator{
+			Name: co.Name,
+		}
+
+		for _, v := range co.Status.Versions {
+			if v.Name == "operator" {
+				ico.Version = v.Version
+			}
+		}
+
+		for _, c := range co.Status.Conditions {
+			switch c.Type {
+			case configv1.OperatorAvailable:
+				ico.Available = c.Status == configv1.ConditionTrue
+			case configv1.OperatorDegraded:
+				ico.Degraded = c.Status == configv1.ConditionTrue
+			}
+		}
+
+		inventory.ClusterOperators = append(inventory.ClusterOperators, ico)
+	}
+	sort.Slice(inventory.ClusterOperators, func(i, j int) bool { return inventory.ClusterOperators[i].Name < inventory.ClusterOperators[j].Name })
+
+	return inventory, nil
+}
+
+// report posts the inventory to the RP, authenticating with the cluster's
+// client certificate
+func (r *InventoryReconciler) report(ctx context.Context, url string, cert tls.Certificate, inventory *api.ClusterInventory) error {
+	b, err := json.Marshal(inventory)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	cli := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs:      r.rootCAs,
+				Certificates: []tls.Certificate{cert},
+				MinVersion:   tls.VersionTLS12,
+			},
+		},
+		Timeout: time.Minute,
+	}
+	defer cli.CloseIdleConnections()
+
+	resp, err := cli.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("unexpected status code %d reporting inventory", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// SetupWithManager setup our manager
+func (r *InventoryReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// the conditions change often; report them on the next interval rather
+	// than every time they do
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Named(controllers.InventoryControllerName).
+		Complete(r)
+}
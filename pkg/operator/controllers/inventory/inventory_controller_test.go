@@ -0,0 +1,252 @@
+package inventory
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	configfake "github.com/openshift/client-go/config/clientset/versioned/fake"
+	machinev1beta1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
+	maofake "github.com/openshift/machine-api-operator/pkg/generated/clientset/versioned/fake"
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	utiltls "github.com/Azure/ARO-RP/pkg/util/tls"
+	"github.com/Azure/ARO-RP/pkg/util/version"
+)
+
+func TestReconcile(t *testing.T) {
+	now := time.Date(2020, 11, 1, 12, 0, 0, 0, time.UTC)
+
+	clientKey, clientCerts, err := utiltls.GenerateKeyAndCertificate("client", nil, nil, false, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clientKeyPEM, err := utiltls.PrivateKeyAsBytes(clientKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clientCertPEM, err := utiltls.CertAsBytes(clientCerts[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	running := "Running"
+
+	node := func(name string, labels map[string]string, ready corev1.ConditionStatus) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{
+					{Type: corev1.NodeReady, Status: ready},
+				},
+			},
+		}
+	}
+
+	machine := func(name string, phase *string) *machinev1beta1.Machine {
+		return &machinev1beta1.Machine{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: machineSetsNamespace},
+			Status:     machinev1beta1.MachineStatus{Phase: phase},
+		}
+	}
+
+	wantInventory := &api.ClusterInventory{
+		ReportedAt:      now,
+		OperatorVersion: version.GitCommit,
+		ClusterVersion:  "4.5.16",
+		Nodes: []api.InventoryNodeSummary{
+			{Role: "master", Count: 3, Ready: 3},
+			{Role: "worker", Count: 2, Ready: 1},
+		},
+		Machines: []api.InventoryMachineSummary{
+			{Phase: "Running", Count: 2},
+			{Phase: "Unknown", Count: 1},
+		},
+		Conditions: []api.InventoryCondition{
+			{Type: "InternetReachableFromMaster", Status: "True", Reason: "CheckDone"},
+		},
+		ClusterOperators: []api.InventoryClusterOperator{
+			{Name: "dns", Version: "4.5.16", Available: true},
+			{Name: "ingress", Version: "4.5.16", Degraded: true},
+		},
+		OperatorFlags: map[string]string{operator.FlagDNSEnabled: "true"},
+	}
+
+	for _, tt := range []struct {
+		name          string
+		inventoryURL  string
+		withCert      bool
+		statusCode    int
+		wantRequeue   time.Duration
+		wantErr       string
+		wantInventory *api.ClusterInventory
+	}{
+		{
+			name: "not configured",
+		},
+		{
+			name:         "no client certificate",
+			inventoryURL: "/inventory",
+		},
+		{
+			name:          "reported",
+			inventoryURL:  "/inventory",
+			withCert:      true,
+			statusCode:    http.StatusNoContent,
+			wantRequeue:   reportInterval,
+			wantInventory: wantInventory,
+		},
+		{
+			name:          "rejected",
+			inventoryURL:  "/inventory",
+			withCert:      true,
+			statusCode:    http.StatusForbidden,
+			wantErr:       "unexpected status code 403 reporting inventory",
+			wantInventory: wantInventory,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotInventory *api.ClusterInventory
+			rp := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/inventory" {
+					t.Error(r.Method, r.URL.Path)
+				}
+				if len(r.TLS.PeerCertificates) != 1 || !r.TLS.PeerCertificates[0].Equal(clientCerts[0]) {
+					t.Error(r.TLS.PeerCertificates)
+				}
+
+				err := json.NewDecoder(r.Body).Decode(&gotInventory)
+				if err != nil {
+					t.Error(err)
+				}
+
+				w.WriteHeader(tt.statusCode)
+			}))
+			rp.TLS = &tls.Config{
+				ClientAuth: tls.RequireAnyClientCert,
+			}
+			rp.StartTLS()
+			defer rp.Close()
+
+			rootCAs := x509.NewCertPool()
+			rootCAs.AddCert(rp.Certificate())
+
+			data := map[string][]byte{}
+			if tt.withCert {
+				data[ClientCertName] = clientCertPEM
+				data[ClientKeyName] = clientKeyPEM
+			}
+
+			instance := &arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					OperatorFlags: map[string]string{operator.FlagDNSEnabled: "true"},
+				},
+				Status: arov1alpha1.ClusterStatus{
+					Conditions: status.Conditions{
+						{
+							Type:   arov1alpha1.InternetReachableFromMaster,
+							Status: corev1.ConditionTrue,
+							Reason: "CheckDone",
+						},
+					},
+				},
+			}
+			if tt.inventoryURL != "" {
+				instance.Spec.InventoryURL = rp.URL + tt.inventoryURL
+			}
+
+			r := &InventoryReconciler{
+				kubernetescli: fake.NewSimpleClientset(
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      operator.SecretName,
+							Namespace: operator.Namespace,
+						},
+						Data: data,
+					},
+					node("master-0", map[string]string{masterRoleLabel: ""}, corev1.ConditionTrue),
+					node("master-1", map[string]string{masterRoleLabel: ""}, corev1.ConditionTrue),
+					node("master-2", map[string]string{masterRoleLabel: ""}, corev1.ConditionTrue),
+					node("worker-0", nil, corev1.ConditionTrue),
+					node("worker-1", nil, corev1.ConditionFalse),
+				),
+				configcli: configfake.NewSimpleClientset(
+					&configv1.ClusterVersion{
+						ObjectMeta: metav1.ObjectMeta{Name: "version"},
+						Status: configv1.ClusterVersionStatus{
+							History: []configv1.UpdateHistory{
+								{State: configv1.PartialUpdate, Version: "4.5.17"},
+								{State: configv1.CompletedUpdate, Version: "4.5.16"},
+							},
+						},
+					},
+					&configv1.ClusterOperator{
+						ObjectMeta: metav1.ObjectMeta{Name: "ingress"},
+						Status: configv1.ClusterOperatorStatus{
+							Versions: []configv1.OperandVersion{{Name: "operator", Version: "4.5.16"}},
+							Conditions: []configv1.ClusterOperatorStatusCondition{
+								{Type: configv1.OperatorAvailable, Status: configv1.ConditionFalse},
+								{Type: configv1.OperatorDegraded, Status: configv1.ConditionTrue},
+							},
+						},
+					},
+					&configv1.ClusterOperator{
+						ObjectMeta: metav1.ObjectMeta{Name: "dns"},
+						Status: configv1.ClusterOperatorStatus{
+							Versions: []configv1.OperandVersion{{Name: "operator", Version: "4.5.16"}},
+							Conditions: []configv1.ClusterOperatorStatusCondition{
+								{Type: configv1.OperatorAvailable, Status: configv1.ConditionTrue},
+								{Type: configv1.OperatorDegraded, Status: configv1.ConditionFalse},
+							},
+						},
+					},
+				),
+				maocli: maofake.NewSimpleClientset(
+					machine("master-0", &running),
+					machine("master-1", &running),
+					machine("worker-0", nil),
+				),
+				arocli:  arofake.NewSimpleClientset(instance).AroV1alpha1(),
+				log:     logrus.NewEntry(logrus.StandardLogger()),
+				rootCAs: rootCAs,
+				now:     func() time.Time { return now },
+			}
+
+			result, err := r.Reconcile(ctrl.Request{})
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Error(err)
+			}
+			if result.RequeueAfter != tt.wantRequeue {
+				t.Error(result.RequeueAfter)
+			}
+
+			if !reflect.DeepEqual(gotInventory, tt.wantInventory) {
+				b, _ := json.Marshal(gotInventory)
+				t.Error(string(b))
+			}
+		})
+	}
+}
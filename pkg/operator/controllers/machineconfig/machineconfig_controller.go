@@ -0,0 +1,234 @@
+package machineconfig
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+
+	mcv1 "github.com/openshift/machine-config-operator/pkg/apis/machineconfiguration.openshift.io/v1"
+	mcoclient "github.com/openshift/machine-config-operator/pkg/generated/clientset/versioned"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+	_ "github.com/Azure/ARO-RP/pkg/util/scheme"
+)
+
+// aroKubeletConfigPrefix is the name prefix of the KubeletConfigs which the
+// operator applies.  The MCO renders each of them into a MachineConfig which
+// it owns.
+const aroKubeletConfigPrefix = "aro-"
+
+// MachineConfigReconciler records the MachineConfigs which ARO applies to the
+// nodes in the Cluster status, with the hash of their content and how far
+// each machine config pool has rolled them out, so that support can tell
+// whether the configuration of a node is the one which was intended
+type MachineConfigReconciler struct {
+	mcocli mcoclient.Interface
+	arocli aroclient.AroV1alpha1Interface
+	log    *logrus.Entry
+}
+
+func NewReconciler(log *logrus.Entry, mcocli mcoclient.Interface, arocli aroclient.AroV1alpha1Interface) *MachineConfigReconciler {
+	return &MachineConfigReconciler{
+		mcocli: mcocli,
+		arocli: arocli,
+		log:    log,
+	}
+}
+
+// Reconcile updates the MachineConfigs in the Cluster status.  Requests for
+// MachineConfigs and MachineConfigPools are reconciled as requests for the
+// Cluster.
+func (r *MachineConfigReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
+	// TODO(mj): controller-runtime master fixes the need for this (https://github.com/kubernetes-sigs/controller-runtime/blob/master/pkg/reconcile/reconcile.go#L93) but it's not yet released.
+	ctx := context.Background()
+
+	mcs, err := r.mcocli.MachineconfigurationV1().MachineConfigs().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	pools, err := r.mcocli.MachineconfigurationV1().MachineConfigPools().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	statuses, err := machineConfigStatuses(mcs.Items, pools.Items)
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	err = r.setMachineConfigStatus(ctx, statuses)
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *MachineConfigReconciler) setMachineConfigStatus(ctx context.Context, statuses []arov1alpha1.MachineConfigStatus) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		// only update the status when it changes, otherwise every status
+		// update would trigger another reconcile
+		if reflect.DeepEqual(cluster.Status.MachineConfigs, statuses) {
+			return nil
+		}
+
+		cluster.Status.MachineConfigs = statuses
+
+		_, err = r.arocli.Clusters().UpdateStatus(ctx, cluster, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// isARO returns true if ARO applies mc: the 99-<role>-ssh MachineConfigs,
+// whose keys the RP manages, and those which the MCO renders from the
+// operator's KubeletConfigs
+func isARO(mc *mcv1.MachineConfig) bool {
+	for _, role := range []string{operator.RoleMaster, operator.RoleWorker} {
+		if mc.Name == "99-"+role+"-ssh" {
+			return true
+		}
+	}
+
+	for _, ref := range mc.OwnerReferences {
+		if ref.Kind == "KubeletConfig" && strings.HasPrefix(ref.Name, aroKubeletConfigPrefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hash returns the SHA-256 hash of the spec of mc
+func hash(mc *mcv1.MachineConfig) (string, error) {
+	b, err := json.Marshal(mc.Spec)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.Sum256(b)
+	return hex.EncodeToString(h[:]), nil
+}
+
+func machineConfigStatuses(mcs []mcv1.MachineConfig, pools []mcv1.MachineConfigPool) ([]arov1alpha1.MachineConfigStatus, error) {
+	var statuses []arov1alpha1.MachineConfigStatus
+
+	for i := range mcs {
+		mc := &mcs[i]
+		if !isARO(mc) {
+			continue
+		}
+
+		h, err := hash(mc)
+		if err != nil {
+			return nil, err
+		}
+
+		status := arov1alpha1.MachineConfigStatus{
+			Name: mc.Name,
+			Hash: h,
+		}
+
+		for j := range pools {
+			state, err := poolState(mc, &pools[j])
+			if err != nil {
+				return nil, err
+			}
+			if state != nil {
+				status.Pools = append(status.Pools, *state)
+			}
+		}
+
+		sort.Slice(status.Pools, func(i, j int) bool { return status.Pools[i].Name < status.Pools[j].Name })
+
+		statuses = append(statuses, status)
+	}
+
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+
+	return statuses, nil
+}
+
+// poolState returns the state of mc in pool, or nil if pool doesn't select
+// mc.  The MCO renders the MachineConfigs of a pool into the config in its
+// spec, and updates the config in its status once all its nodes run it.
+func poolState(mc *mcv1.MachineConfig, pool *mcv1.MachineConfigPool) (*arov1alpha1.MachineConfigPoolState, error) {
+	if pool.Spec.MachineConfigSelector == nil {
+		return nil, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(pool.Spec.MachineConfigSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	if selector.Empty() || !selector.Matches(labels.Set(mc.Labels)) {
+		return nil, nil
+	}
+
+	state := &arov1alpha1.MachineConfigPoolState{
+		Name:  pool.Name,
+		State: arov1alpha1.MachineConfigStatePending,
+	}
+
+	if !hasSource(&pool.Spec.Configuration, mc.Name) {
+		return state, nil
+	}
+
+	state.RenderedConfig = pool.Spec.Configuration.Name
+
+	if pool.Status.Configuration.Name == pool.Spec.Configuration.Name {
+		state.State = arov1alpha1.MachineConfigStateApplied
+	} else {
+		state.State = arov1alpha1.MachineConfigStateUpdating
+	}
+
+	return state, nil
+}
+
+func hasSource(configuration *mcv1.MachineConfigPoolStatusConfiguration, name string) bool {
+	for _, source := range configuration.Source {
+		if source.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SetupWithManager setup our mananger
+func (r *MachineConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}).
+		Watches(&source.Kind{Type: &mcv1.MachineConfig{}}, &handler.EnqueueRequestForObject{}).
+		Watches(&source.Kind{Type: &mcv1.MachineConfigPool{}}, &handler.EnqueueRequestForObject{}).
+		Named(controllers.MachineConfigControllerName).
+		Complete(r)
+}
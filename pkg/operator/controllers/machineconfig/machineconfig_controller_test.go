@@ -0,0 +1,174 @@
+package machineconfig
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	mcv1 "github.com/openshift/machine-config-operator/pkg/apis/machineconfiguration.openshift.io/v1"
+	fakemcoclient "github.com/openshift/machine-config-operator/pkg/generated/clientset/versioned/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+)
+
+func machineConfig(name, role string, ownerReferences ...metav1.OwnerReference) *mcv1.MachineConfig {
+	return &mcv1.MachineConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				"machineconfiguration.openshift.io/role": role,
+			},
+			OwnerReferences: ownerReferences,
+		},
+		Spec: mcv1.MachineConfigSpec{
+			Config: runtime.RawExtension{
+				Raw: []byte(`{"ignition":{"version":"2.2.0"}}`),
+			},
+		},
+	}
+}
+
+func machineConfigPool(name, specConfig, statusConfig string, sources ...string) *mcv1.MachineConfigPool {
+	pool := &mcv1.MachineConfigPool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: mcv1.MachineConfigPoolSpec{
+			MachineConfigSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"machineconfiguration.openshift.io/role": name,
+				},
+			},
+		},
+	}
+
+	pool.Spec.Configuration.Name = specConfig
+	for _, source := range sources {
+		pool.Spec.Configuration.Source = append(pool.Spec.Configuration.Source, corev1.ObjectReference{Name: source})
+	}
+	pool.Status.Configuration.Name = statusConfig
+
+	return pool
+}
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+
+	kubeletConfigOwner := metav1.OwnerReference{
+		Kind: "KubeletConfig",
+		Name: "aro-limits",
+	}
+
+	mcocli := fakemcoclient.NewSimpleClientset(
+		machineConfig("00-worker", "worker"),
+		machineConfig("99-master-ssh", "master"),
+		machineConfig("99-worker-ssh", "worker"),
+		machineConfig("99-worker-generated-kubelet", "worker", kubeletConfigOwner),
+		machineConfig("99-worker-custom-kubelet", "worker", metav1.OwnerReference{
+			Kind: "KubeletConfig",
+			Name: "custom",
+		}),
+		machineConfigPool("master", "rendered-master-1", "rendered-master-1", "99-master-ssh"),
+		machineConfigPool("worker", "rendered-worker-2", "rendered-worker-1", "00-worker", "99-worker-ssh"),
+	)
+
+	arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: arov1alpha1.SingletonClusterName,
+		},
+	})
+
+	r := NewReconciler(utillog.GetLogger(), mcocli, arocli.AroV1alpha1())
+
+	_, err := r.Reconcile(ctrl.Request{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cluster, err := arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// every MachineConfig has the same spec
+	h, err := hash(machineConfig("", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []arov1alpha1.MachineConfigStatus{
+		{
+			Name: "99-master-ssh",
+			Hash: h,
+			Pools: []arov1alpha1.MachineConfigPoolState{
+				{
+					Name:           "master",
+					State:          arov1alpha1.MachineConfigStateApplied,
+					RenderedConfig: "rendered-master-1",
+				},
+			},
+		},
+		{
+			Name: "99-worker-generated-kubelet",
+			Hash: h,
+			Pools: []arov1alpha1.MachineConfigPoolState{
+				{
+					Name:  "worker",
+					State: arov1alpha1.MachineConfigStatePending,
+				},
+			},
+		},
+		{
+			Name: "99-worker-ssh",
+			Hash: h,
+			Pools: []arov1alpha1.MachineConfigPoolState{
+				{
+					Name:           "worker",
+					State:          arov1alpha1.MachineConfigStateUpdating,
+					RenderedConfig: "rendered-worker-2",
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(cluster.Status.MachineConfigs, want) {
+		t.Errorf("%#v", cluster.Status.MachineConfigs)
+	}
+}
+
+func TestHash(t *testing.T) {
+	mc := machineConfig("99-worker-ssh", "worker")
+
+	h1, err := hash(mc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the hash only covers the spec
+	mc.Labels = nil
+	h2, err := hash(mc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h1 != h2 {
+		t.Error(h2)
+	}
+
+	mc.Spec.KernelArguments = []string{"nosmt"}
+	h3, err := hash(mc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h1 == h3 {
+		t.Error(h3)
+	}
+}
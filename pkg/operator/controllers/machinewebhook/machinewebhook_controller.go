@@ -0,0 +1,206 @@
+package machinewebhook
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/sirupsen/logrus"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+	"github.com/Azure/ARO-RP/pkg/util/deployment"
+)
+
+const (
+	// WebhookPath is the path at which the master operator serves the webhook
+	WebhookPath = "/validate-machine"
+
+	webhookConfigurationName = "aro-machine-validation"
+
+	// injectCABundleAnnotation has the service CA operator inject the CA
+	// which signs the operator's serving certificate into the configuration
+	injectCABundleAnnotation = "service.beta.openshift.io/inject-cabundle"
+
+	serviceName = "aro-operator-master"
+)
+
+// MachineWebhookReconciler keeps the ValidatingWebhookConfiguration of the
+// machine webhook in place
+type MachineWebhookReconciler struct {
+	kubernetescli kubernetes.Interface
+	arocli        aroclient.AroV1alpha1Interface
+	log           *logrus.Entry
+	capabilities  deployment.Capabilities
+}
+
+func NewReconciler(log *logrus.Entry, kubernetescli kubernetes.Interface, arocli aroclient.AroV1alpha1Interface, capabilities deployment.Capabilities) *MachineWebhookReconciler {
+	return &MachineWebhookReconciler{
+		kubernetescli: kubernetescli,
+		arocli:        arocli,
+		log:           log,
+		capabilities:  capabilities,
+	}
+}
+
+// +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=validatingwebhookconfigurations,verbs=get;list;watch;create;update;delete
+
+// Reconcile creates or updates the ValidatingWebhookConfiguration which sends
+// creates and updates of machines and machinesets to the webhook, or deletes
+// it if the webhook is switched off with aro.machinewebhook.enabled.  The
+// webhook fails open: while the operator is down, machines are not validated
+// up front, but the MachineChecker still reports them.
+func (r *MachineWebhookReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
+	// TODO(mj): controller-runtime master fixes the need for this (https://github.com/kubernetes-sigs/controller-runtime/blob/master/pkg/reconcile/reconcile.go#L93) but it's not yet released.
+	ctx := context.Background()
+
+	instance, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	if !controllers.FlagEnabled(instance, operator.FlagMachineWebhookEnabled) {
+		err = r.kubernetescli.AdmissionregistrationV1().ValidatingWebhookConfigurations().Delete(ctx, webhookConfigurationName, metav1.DeleteOptions{})
+		if err != nil && !kerrors.IsNotFound(err) {
+			r.log.Error(err)
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{}, nil
+	}
+
+	err = r.ensure(ctx, instance)
+	if err != nil {
+		r.log.Error(err)
+	}
+	return reconcile.Result{}, err
+}
+
+func (r *MachineWebhookReconciler) ensure(ctx context.Context, instance *arov1alpha1.Cluster) error {
+	want := webhookConfiguration()
+	want.OwnerReferences = []metav1.OwnerReference{
+		*metav1.NewControllerRef(instance, arov1alpha1.GroupVersion.WithKind("Cluster")),
+	}
+
+	cli := r.kubernetescli.AdmissionregistrationV1().ValidatingWebhookConfigurations()
+
+	config, err := cli.Get(ctx, want.Name, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		r.log.Printf("creating ValidatingWebhookConfiguration %s", want.Name)
+		_, err = cli.Create(ctx, want, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	// keep the CA bundle which the service CA operator injected
+	for i := range want.Webhooks {
+		for _, wh := range config.Webhooks {
+			if wh.Name == want.Webhooks[i].Name {
+				want.Webhooks[i].ClientConfig.CABundle = wh.ClientConfig.CABundle
+			}
+		}
+	}
+
+	if reflect.DeepEqual(config.Webhooks, want.Webhooks) &&
+		config.Annotations[injectCABundleAnnotation] == "true" &&
+		metav1.IsControlledBy(config, instance) {
+		return nil
+	}
+
+	if config.Annotations == nil {
+		config.Annotations = map[string]string{}
+	}
+	config.Annotations[injectCABundleAnnotation] = "true"
+	config.Webhooks = want.Webhooks
+	config.OwnerReferences = want.OwnerReferences
+
+	r.log.Printf("updating ValidatingWebhookConfiguration %s", want.Name)
+	_, err = cli.Update(ctx, config, metav1.UpdateOptions{})
+	return err
+}
+
+// webhookConfiguration returns the ValidatingWebhookConfiguration of the
+// webhook.  Fields which the API server would otherwise default are set, so
+// that the configuration read back compares equal.
+func webhookConfiguration() *admissionregistrationv1.ValidatingWebhookConfiguration {
+	path := WebhookPath
+	port := int32(443)
+	scope := admissionregistrationv1.NamespacedScope
+	failurePolicy := admissionregistrationv1.Ignore
+	matchPolicy := admissionregistrationv1.Equivalent
+	sideEffects := admissionregistrationv1.SideEffectClassNone
+	timeoutSeconds := int32(5)
+
+	return &admissionregistrationv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: webhookConfigurationName,
+			Annotations: map[string]string{
+				injectCABundleAnnotation: "true",
+			},
+		},
+		Webhooks: []admissionregistrationv1.ValidatingWebhook{
+			{
+				Name: "machines.aro.openshift.io",
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{
+						Namespace: operator.Namespace,
+						Name:      serviceName,
+						Path:      &path,
+						Port:      &port,
+					},
+				},
+				Rules: []admissionregistrationv1.RuleWithOperations{
+					{
+						Operations: []admissionregistrationv1.OperationType{
+							admissionregistrationv1.Create,
+							admissionregistrationv1.Update,
+						},
+						Rule: admissionregistrationv1.Rule{
+							APIGroups:   []string{"machine.openshift.io"},
+							APIVersions: []string{"v1beta1"},
+							Resources:   []string{"machines", "machinesets"},
+							Scope:       &scope,
+						},
+					},
+				},
+				FailurePolicy:           &failurePolicy,
+				MatchPolicy:             &matchPolicy,
+				NamespaceSelector:       &metav1.LabelSelector{},
+				ObjectSelector:          &metav1.LabelSelector{},
+				SideEffects:             &sideEffects,
+				TimeoutSeconds:          &timeoutSeconds,
+				AdmissionReviewVersions: []string{"v1beta1"},
+			},
+		},
+	}
+}
+
+// SetupWithManager registers the webhook with the manager's webhook server
+// and sets up the controller
+func (r *MachineWebhookReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	mgr.GetWebhookServer().Register(WebhookPath, &webhook.Admission{
+		Handler: &validator{
+			log:          r.log,
+			capabilities: r.capabilities,
+		},
+	})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}).
+		Owns(&admissionregistrationv1.ValidatingWebhookConfiguration{}).
+		Named(controllers.MachineWebhookControllerName).
+		Complete(r)
+}
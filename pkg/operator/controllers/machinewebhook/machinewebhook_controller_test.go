@@ -0,0 +1,108 @@
+package machinewebhook
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+)
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+
+	injected := webhookConfiguration()
+	injected.Webhooks[0].ClientConfig.CABundle = []byte("ca")
+	injected.Webhooks[0].Rules = nil
+
+	for _, tt := range []struct {
+		name         string
+		flag         string
+		objects      []runtime.Object
+		wantCABundle []byte
+		wantDeleted  bool
+	}{
+		{
+			name: "configuration is created",
+		},
+		{
+			name:         "configuration is repaired, keeping the injected CA bundle",
+			objects:      []runtime.Object{injected},
+			wantCABundle: []byte("ca"),
+		},
+		{
+			name:        "disabled webhook is removed",
+			flag:        "false",
+			objects:     []runtime.Object{injected},
+			wantDeleted: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+			}
+			if tt.flag != "" {
+				cluster.Spec.OperatorFlags = map[string]string{
+					operator.FlagMachineWebhookEnabled: tt.flag,
+				}
+			}
+
+			kubernetescli := fake.NewSimpleClientset(tt.objects...)
+
+			r := NewReconciler(utillog.GetLogger(), kubernetescli, arofake.NewSimpleClientset(cluster).AroV1alpha1(), nil)
+
+			_, err := r.Reconcile(ctrl.Request{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			config, err := kubernetescli.AdmissionregistrationV1().ValidatingWebhookConfigurations().Get(ctx, webhookConfigurationName, metav1.GetOptions{})
+			if tt.wantDeleted {
+				if !kerrors.IsNotFound(err) {
+					t.Error(err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := webhookConfiguration().Webhooks
+			want[0].ClientConfig.CABundle = tt.wantCABundle
+
+			if !reflect.DeepEqual(config.Webhooks, want) {
+				t.Error(config.Webhooks)
+			}
+			if config.Annotations[injectCABundleAnnotation] != "true" {
+				t.Error(config.Annotations)
+			}
+			if len(config.OwnerReferences) != 1 || config.OwnerReferences[0].Name != arov1alpha1.SingletonClusterName {
+				t.Error(config.OwnerReferences)
+			}
+		})
+	}
+}
+
+// the webhook must never block the machine API while the operator is down
+func TestWebhookConfigurationFailsOpen(t *testing.T) {
+	for _, wh := range webhookConfiguration().Webhooks {
+		if *wh.FailurePolicy != admissionregistrationv1.Ignore {
+			t.Error(wh.Name, *wh.FailurePolicy)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package machinewebhook
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+
+	machinev1beta1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
+	"github.com/sirupsen/logrus"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/checker"
+	"github.com/Azure/ARO-RP/pkg/util/deployment"
+)
+
+const (
+	// SkipValidationAnnotation lets SREs create or update a machine or
+	// machineset which the webhook would reject.  It is only honoured on
+	// requests made by the RP, i.e. through the admin API.
+	SkipValidationAnnotation = "aro.openshift.io/skip-machine-validation"
+
+	// rpUsername is the user of the RP's admin kubeconfig
+	rpUsername = "system:aro-service"
+
+	machineSetsNamespace = "openshift-machine-api"
+	machineRoleLabel     = "machine.openshift.io/cluster-api-machine-role"
+)
+
+// validator rejects machines and machinesets whose provider spec the
+// MachineChecker would report as invalid, before any VM is created from them
+type validator struct {
+	log          *logrus.Entry
+	capabilities deployment.Capabilities
+}
+
+var _ admission.Handler = &validator{}
+
+func (v *validator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Namespace != machineSetsNamespace {
+		return admission.Allowed("")
+	}
+
+	var errs []error
+	var err error
+	switch req.Kind.Kind {
+	case "Machine":
+		errs, err = v.validateMachine(req)
+	case "MachineSet":
+		errs, err = v.validateMachineSet(req)
+	default:
+		return admission.Allowed("")
+	}
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if len(errs) == 0 {
+		return admission.Allowed("")
+	}
+
+	msgs := make([]string, 0, len(errs))
+	for _, err := range errs {
+		msgs = append(msgs, err.Error())
+	}
+
+	v.log.Infof("denied %s of %s %s/%s by %s: %s", req.Operation, req.Kind.Kind, req.Namespace, req.Name, req.UserInfo.Username, strings.Join(msgs, "; "))
+
+	return admission.Denied(strings.Join(msgs, "; "))
+}
+
+// validateMachine validates a created machine, or an updated machine whose
+// provider spec changed.  Other updates, e.g. of the labels and finalizers
+// which the machine controller maintains, are never rejected, even if the
+// machine was invalid to begin with.
+func (v *validator) validateMachine(req admission.Request) ([]error, error) {
+	var machine machinev1beta1.Machine
+	err := json.Unmarshal(req.Object.Raw, &machine)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.skip(req, machine.DeletionTimestamp != nil, machine.Annotations) {
+		return nil, nil
+	}
+
+	if req.Operation == admissionv1beta1.Update {
+		var old machinev1beta1.Machine
+		err = json.Unmarshal(req.OldObject.Raw, &old)
+		if err != nil {
+			return nil, err
+		}
+
+		changed, err := providerSpecChanged(&old.Spec.ProviderSpec, &machine.Spec.ProviderSpec)
+		if err != nil || !changed {
+			return nil, err
+		}
+	}
+
+	_, isWorkerProfile := machine.Labels[operator.WorkerProfileLabel]
+	isMaster := machine.Labels[machineRoleLabel] == "master"
+
+	return checker.ProviderSpecValid(v.capabilities, "machine "+machine.Name, &machine.Spec.ProviderSpec, isMaster, isWorkerProfile), nil
+}
+
+// validateMachineSet validates a created machineset, or an updated machineset
+// whose provider spec changed or which was scaled up
+func (v *validator) validateMachineSet(req admission.Request) ([]error, error) {
+	var machineset machinev1beta1.MachineSet
+	err := json.Unmarshal(req.Object.Raw, &machineset)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.skip(req, machineset.DeletionTimestamp != nil, machineset.Annotations) {
+		return nil, nil
+	}
+
+	if req.Operation == admissionv1beta1.Update {
+		var old machinev1beta1.MachineSet
+		err = json.Unmarshal(req.OldObject.Raw, &old)
+		if err != nil {
+			return nil, err
+		}
+
+		changed, err := providerSpecChanged(&old.Spec.Template.Spec.ProviderSpec, &machineset.Spec.Template.Spec.ProviderSpec)
+		if err != nil {
+			return nil, err
+		}
+
+		if !changed && replicas(&machineset) <= replicas(&old) {
+			return nil, nil
+		}
+	}
+
+	_, isWorkerProfile := machineset.Labels[operator.WorkerProfileLabel]
+
+	return checker.ProviderSpecValid(v.capabilities, "machineset "+machineset.Name, &machineset.Spec.Template.Spec.ProviderSpec, false, isWorkerProfile), nil
+}
+
+// skip returns true if the object is being deleted or validation is skipped
+// with the break-glass annotation by the RP
+func (v *validator) skip(req admission.Request, deleting bool, annotations map[string]string) bool {
+	if deleting {
+		return true
+	}
+
+	if annotations[SkipValidationAnnotation] != "true" {
+		return false
+	}
+
+	if req.UserInfo.Username != rpUsername {
+		v.log.Infof("ignoring %s on %s %s/%s set by %s", SkipValidationAnnotation, req.Kind.Kind, req.Namespace, req.Name, req.UserInfo.Username)
+		return false
+	}
+
+	v.log.Infof("skipping validation of %s %s/%s", req.Kind.Kind, req.Namespace, req.Name)
+	return true
+}
+
+// providerSpecChanged compares provider specs by their JSON content, as
+// their raw encoding may differ
+func providerSpecChanged(old, new *machinev1beta1.ProviderSpec) (bool, error) {
+	if old.Value == nil || new.Value == nil {
+		return old.Value != new.Value, nil
+	}
+
+	var o, n interface{}
+	err := json.Unmarshal(old.Value.Raw, &o)
+	if err != nil {
+		return false, err
+	}
+
+	err = json.Unmarshal(new.Value.Raw, &n)
+	if err != nil {
+		return false, err
+	}
+
+	return !reflect.DeepEqual(o, n), nil
+}
+
+func replicas(machineset *machinev1beta1.MachineSet) int32 {
+	if machineset.Spec.Replicas == nil {
+		return 1
+	}
+	return *machineset.Spec.Replicas
+}
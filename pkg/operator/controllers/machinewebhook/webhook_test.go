@@ -0,0 +1,212 @@
+package machinewebhook
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	machinev1beta1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/Azure/ARO-RP/pkg/util/deployment"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+)
+
+func providerSpec(vmSize string) machinev1beta1.ProviderSpec {
+	return machinev1beta1.ProviderSpec{
+		Value: &runtime.RawExtension{
+			Raw: []byte(`{
+"apiVersion": "azureproviderconfig.openshift.io/v1beta1",
+"kind": "AzureMachineProviderSpec",
+"osDisk": {
+"diskSizeGB": 128
+},
+"image": {
+"publisher": "azureopenshift",
+"offer": "aro4"
+},
+"vmSize": "` + vmSize + `"
+}`),
+		},
+	}
+}
+
+func TestHandle(t *testing.T) {
+	ctx := context.Background()
+
+	capabilities, err := deployment.NewCapabilities(deployment.Production)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	machine := func(vmSize string, annotations map[string]string) *machinev1beta1.Machine {
+		return &machinev1beta1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "machine",
+				Namespace:   machineSetsNamespace,
+				Labels:      map[string]string{machineRoleLabel: "worker"},
+				Annotations: annotations,
+			},
+			Spec: machinev1beta1.MachineSpec{
+				ProviderSpec: providerSpec(vmSize),
+			},
+		}
+	}
+
+	machineset := func(vmSize string, replicas int32) *machinev1beta1.MachineSet {
+		return &machinev1beta1.MachineSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "machineset",
+				Namespace: machineSetsNamespace,
+			},
+			Spec: machinev1beta1.MachineSetSpec{
+				Replicas: &replicas,
+				Template: machinev1beta1.MachineTemplateSpec{
+					Spec: machinev1beta1.MachineSpec{
+						ProviderSpec: providerSpec(vmSize),
+					},
+				},
+			},
+		}
+	}
+
+	for _, tt := range []struct {
+		name        string
+		kind        string
+		namespace   string
+		operation   admissionv1beta1.Operation
+		username    string
+		object      interface{}
+		oldObject   interface{}
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "valid machine created",
+			kind:        "Machine",
+			operation:   admissionv1beta1.Create,
+			object:      machine("Standard_D4s_v3", nil),
+			wantAllowed: true,
+		},
+		{
+			name:       "machine with invalid VM size created",
+			kind:       "Machine",
+			operation:  admissionv1beta1.Create,
+			object:     machine("Standard_A1", nil),
+			wantReason: "machine machine: invalid VM size 'Standard_A1'",
+		},
+		{
+			name:       "machine updated to invalid VM size",
+			kind:       "Machine",
+			operation:  admissionv1beta1.Update,
+			object:     machine("Standard_A1", nil),
+			oldObject:  machine("Standard_D4s_v3", nil),
+			wantReason: "machine machine: invalid VM size 'Standard_A1'",
+		},
+		{
+			name:        "invalid machine updated without changing its provider spec",
+			kind:        "Machine",
+			operation:   admissionv1beta1.Update,
+			object:      machine("Standard_A1", map[string]string{"key": "value"}),
+			oldObject:   machine("Standard_A1", nil),
+			wantAllowed: true,
+		},
+		{
+			name:        "machine in another namespace",
+			kind:        "Machine",
+			namespace:   "other",
+			operation:   admissionv1beta1.Create,
+			object:      machine("Standard_A1", nil),
+			wantAllowed: true,
+		},
+		{
+			name:        "break-glass annotation set by the RP",
+			kind:        "Machine",
+			operation:   admissionv1beta1.Create,
+			username:    rpUsername,
+			object:      machine("Standard_A1", map[string]string{SkipValidationAnnotation: "true"}),
+			wantAllowed: true,
+		},
+		{
+			name:       "break-glass annotation set by someone else",
+			kind:       "Machine",
+			operation:  admissionv1beta1.Create,
+			username:   "kube:admin",
+			object:     machine("Standard_A1", map[string]string{SkipValidationAnnotation: "true"}),
+			wantReason: "machine machine: invalid VM size 'Standard_A1'",
+		},
+		{
+			name:       "invalid machineset scaled up",
+			kind:       "MachineSet",
+			operation:  admissionv1beta1.Update,
+			object:     machineset("Standard_A1", 3),
+			oldObject:  machineset("Standard_A1", 2),
+			wantReason: "machineset machineset: invalid VM size 'Standard_A1'",
+		},
+		{
+			name:        "invalid machineset scaled down",
+			kind:        "MachineSet",
+			operation:   admissionv1beta1.Update,
+			object:      machineset("Standard_A1", 1),
+			oldObject:   machineset("Standard_A1", 2),
+			wantAllowed: true,
+		},
+		{
+			name:        "valid machineset scaled up",
+			kind:        "MachineSet",
+			operation:   admissionv1beta1.Update,
+			object:      machineset("Standard_D4s_v3", 3),
+			oldObject:   machineset("Standard_D4s_v3", 2),
+			wantAllowed: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &validator{
+				log:          utillog.GetLogger(),
+				capabilities: capabilities,
+			}
+
+			namespace := machineSetsNamespace
+			if tt.namespace != "" {
+				namespace = tt.namespace
+			}
+
+			req := admission.Request{
+				AdmissionRequest: admissionv1beta1.AdmissionRequest{
+					Kind:      metav1.GroupVersionKind{Group: "machine.openshift.io", Version: "v1beta1", Kind: tt.kind},
+					Namespace: namespace,
+					Operation: tt.operation,
+					UserInfo:  authenticationv1.UserInfo{Username: tt.username},
+				},
+			}
+
+			req.Object.Raw, err = json.Marshal(tt.object)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.oldObject != nil {
+				req.OldObject.Raw, err = json.Marshal(tt.oldObject)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			resp := v.Handle(ctx, req)
+
+			if resp.Allowed != tt.wantAllowed {
+				t.Error(resp.Allowed)
+			}
+			if !tt.wantAllowed && resp.Result.Reason != metav1.StatusReason(tt.wantReason) {
+				t.Error(resp.Result.Reason)
+			}
+		})
+	}
+}
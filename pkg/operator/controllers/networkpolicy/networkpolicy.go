@@ -0,0 +1,156 @@
+package networkpolicy
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/genevalogging"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/servicemonitor"
+)
+
+const (
+	loggingNamespace = "openshift-azure-logging"
+
+	// alertWebhookPort is the port of the alertmanager webhook served by the
+	// master operator (see the alertwebhook controller)
+	alertWebhookPort = 8080
+
+	// machineWebhookPort is the port of the machine admission webhook served
+	// by the master operator (see the machinewebhook controller)
+	machineWebhookPort = 8443
+)
+
+// managedNamespaces are the namespaces whose ingress traffic is restricted.
+// None of the pods in them need to accept traffic from other pods except
+// where a policy below allows it explicitly.
+var managedNamespaces = []string{
+	operator.Namespace,
+	loggingNamespace,
+}
+
+// policies returns the NetworkPolicies to be maintained in namespace
+func policies(namespace string) []*networkingv1.NetworkPolicy {
+	policies := []*networkingv1.NetworkPolicy{
+		{
+			// a policy selecting every pod with no ingress rules denies all
+			// ingress traffic not allowed by another policy
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "aro-default-deny-ingress",
+				Namespace: namespace,
+			},
+			Spec: networkingv1.NetworkPolicySpec{
+				PodSelector: metav1.LabelSelector{},
+				PolicyTypes: []networkingv1.PolicyType{
+					networkingv1.PolicyTypeIngress,
+				},
+			},
+		},
+	}
+
+	switch namespace {
+	case operator.Namespace:
+		policies = append(policies, allowPolicy(namespace, "aro-allow-alertmanager-webhook",
+			map[string]string{"app": "aro-operator-master"},
+			"openshift-monitoring", map[string]string{"app": "alertmanager"},
+			alertWebhookPort))
+
+		// the API server calls admission webhooks from the host network, which
+		// no namespace or pod selector matches
+		machineWebhook := allowPolicy(namespace, "aro-allow-machine-webhook",
+			map[string]string{"app": "aro-operator-master"},
+			"", nil, machineWebhookPort)
+		machineWebhook.Spec.Ingress[0].From = nil
+		policies = append(policies, machineWebhook)
+
+		// the cluster monitoring Prometheus scrapes the master operator's
+		// metrics
+		policies = append(policies, allowPolicy(namespace, "aro-allow-prometheus-metrics",
+			map[string]string{"app": "aro-operator-master"},
+			"openshift-monitoring", map[string]string{"app": "prometheus"},
+			servicemonitor.MetricsPort))
+
+	case loggingNamespace:
+		// the master operator's logging pipeline checker reads the pipeline
+		// counters of the mdsd pods
+		policies = append(policies, allowPolicy(namespace, "aro-allow-operator-pipeline-metrics",
+			map[string]string{"app": "mdsd"},
+			operator.Namespace, map[string]string{"app": "aro-operator-master"},
+			genevalogging.PipelineMetricsPort))
+	}
+
+	return policies
+}
+
+// allowPolicy returns a NetworkPolicy allowing TCP traffic to port of the pods
+// matching podLabels from the pods matching fromPodLabels in fromNamespace
+func allowPolicy(namespace, name string, podLabels map[string]string, fromNamespace string, fromPodLabels map[string]string, port int) *networkingv1.NetworkPolicy {
+	tcp := corev1.ProtocolTCP
+	p := intstr.FromInt(port)
+
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: podLabels,
+			},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{
+				{
+					From: []networkingv1.NetworkPolicyPeer{
+						{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"name": fromNamespace},
+							},
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: fromPodLabels,
+							},
+						},
+					},
+					Ports: []networkingv1.NetworkPolicyPort{
+						{
+							Protocol: &tcp,
+							Port:     &p,
+						},
+					},
+				},
+			},
+			PolicyTypes: []networkingv1.PolicyType{
+				networkingv1.PolicyTypeIngress,
+			},
+		},
+	}
+}
+
+// resources returns the NetworkPolicies of the managed namespaces which exist.
+// The logging namespace is created by the genevalogging controller, so it may
+// not exist yet; missing reports whether any namespace was skipped.
+func (r *NetworkPolicyReconciler) resources(ctx context.Context) (resources []runtime.Object, missing bool, err error) {
+	for _, namespace := range managedNamespaces {
+		_, err := r.kubernetescli.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			missing = true
+			continue
+		}
+		if err != nil {
+			return nil, false, err
+		}
+
+		for _, policy := range policies(namespace) {
+			resources = append(resources, policy)
+		}
+	}
+
+	return resources, missing, nil
+}
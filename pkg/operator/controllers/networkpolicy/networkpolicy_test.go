@@ -0,0 +1,130 @@
+package networkpolicy
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/genevalogging"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/servicemonitor"
+)
+
+func TestResources(t *testing.T) {
+	ctx := context.Background()
+
+	namespace := func(name string) *corev1.Namespace {
+		return &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		}
+	}
+
+	for _, tt := range []struct {
+		name        string
+		objects     []runtime.Object
+		wantNames   []string
+		wantMissing bool
+	}{
+		{
+			name: "all namespaces present",
+			objects: []runtime.Object{
+				namespace(operator.Namespace),
+				namespace(loggingNamespace),
+			},
+			wantNames: []string{
+				operator.Namespace + "/aro-default-deny-ingress",
+				operator.Namespace + "/aro-allow-alertmanager-webhook",
+				operator.Namespace + "/aro-allow-machine-webhook",
+				operator.Namespace + "/aro-allow-prometheus-metrics",
+				loggingNamespace + "/aro-default-deny-ingress",
+				loggingNamespace + "/aro-allow-operator-pipeline-metrics",
+			},
+		},
+		{
+			name: "logging namespace not yet created",
+			objects: []runtime.Object{
+				namespace(operator.Namespace),
+			},
+			wantNames: []string{
+				operator.Namespace + "/aro-default-deny-ingress",
+				operator.Namespace + "/aro-allow-alertmanager-webhook",
+				operator.Namespace + "/aro-allow-machine-webhook",
+				operator.Namespace + "/aro-allow-prometheus-metrics",
+			},
+			wantMissing: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &NetworkPolicyReconciler{
+				kubernetescli: fake.NewSimpleClientset(tt.objects...),
+			}
+
+			resources, missing, err := r.resources(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if missing != tt.wantMissing {
+				t.Error(missing)
+			}
+
+			var names []string
+			for _, resource := range resources {
+				policy := resource.(*networkingv1.NetworkPolicy)
+				names = append(names, policy.Namespace+"/"+policy.Name)
+			}
+
+			if !reflect.DeepEqual(names, tt.wantNames) {
+				t.Error(names)
+			}
+		})
+	}
+}
+
+func TestPoliciesOnlyAllowExpectedPorts(t *testing.T) {
+	allowedPorts := map[string]int{
+		"aro-allow-alertmanager-webhook":      alertWebhookPort,
+		"aro-allow-machine-webhook":           machineWebhookPort,
+		"aro-allow-operator-pipeline-metrics": genevalogging.PipelineMetricsPort,
+		"aro-allow-prometheus-metrics":        servicemonitor.MetricsPort,
+	}
+
+	for _, namespace := range managedNamespaces {
+		for _, policy := range policies(namespace) {
+			for _, rule := range policy.Spec.Ingress {
+				port, found := allowedPorts[policy.Name]
+				if !found {
+					t.Errorf("%s/%s: unexpected ingress rule", namespace, policy.Name)
+					continue
+				}
+
+				if len(rule.Ports) != 1 || rule.Ports[0].Port.IntValue() != port {
+					t.Errorf("%s/%s: unexpected ports %v", namespace, policy.Name, rule.Ports)
+				}
+
+				// only the webhook called by the API server on the host
+				// network may be reached from anywhere
+				if len(rule.From) == 0 && policy.Name != "aro-allow-machine-webhook" {
+					t.Errorf("%s/%s: ingress allowed from anywhere", namespace, policy.Name)
+				}
+
+				for _, peer := range rule.From {
+					if peer.NamespaceSelector == nil || peer.PodSelector == nil || peer.IPBlock != nil {
+						t.Errorf("%s/%s: overly broad peer %v", namespace, policy.Name, peer)
+					}
+				}
+			}
+		}
+	}
+}
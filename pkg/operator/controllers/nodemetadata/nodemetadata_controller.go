@@ -0,0 +1,283 @@
+package nodemetadata
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	machinev1beta1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
+	maoclient "github.com/openshift/machine-api-operator/pkg/generated/clientset/versioned"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/workerpool"
+)
+
+const (
+	// metadataPrefix is the namespace of the node labels and annotations
+	// which this controller owns.  Any label or annotation under it which the
+	// controller doesn't set is removed.
+	metadataPrefix = "metadata.aro.openshift.io/"
+
+	RegionLabel         = metadataPrefix + "region"
+	ResourceIDHashLabel = metadataPrefix + "resource-id-hash"
+	PoolLabel           = metadataPrefix + "pool"
+	PricingTierLabel    = metadataPrefix + "pricing-tier"
+
+	// ResourceIDAnnotation holds the whole Azure resourceId of the cluster,
+	// which is too long for a label value
+	ResourceIDAnnotation = metadataPrefix + "resource-id"
+
+	// machineAnnotation is set on each node by the machine API operator to
+	// the namespace/name of its machine
+	machineAnnotation = "machine.openshift.io/machine"
+
+	machineRoleLabel = "machine.openshift.io/cluster-api-machine-role"
+	machineSetLabel  = "machine.openshift.io/cluster-api-machineset"
+)
+
+// NodeMetadataReconciler labels and annotates every node with the metadata
+// known to the RP (region, cluster resource ID, worker pool and pricing
+// tier), so that customer scheduling policies and support tooling don't need
+// to infer it from the machine names
+type NodeMetadataReconciler struct {
+	kubernetescli kubernetes.Interface
+	maocli        maoclient.Interface
+	arocli        aroclient.AroV1alpha1Interface
+	log           *logrus.Entry
+}
+
+func NewReconciler(log *logrus.Entry, kubernetescli kubernetes.Interface, maocli maoclient.Interface, arocli aroclient.AroV1alpha1Interface) *NodeMetadataReconciler {
+	return &NodeMetadataReconciler{
+		kubernetescli: kubernetescli,
+		maocli:        maocli,
+		arocli:        arocli,
+		log:           log,
+	}
+}
+
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=machine.openshift.io,resources=machines;machinesets,verbs=get;list;watch
+
+// Reconcile makes sure that the metadata labels and annotations of the node
+// match the cluster spec.  Requests for the Cluster are reconciled as
+// requests for every node.
+func (r *NodeMetadataReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
+	// TODO(mj): controller-runtime master fixes the need for this (https://github.com/kubernetes-sigs/controller-runtime/blob/master/pkg/reconcile/reconcile.go#L93) but it's not yet released.
+	ctx := context.Background()
+
+	instance, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	if !controllers.FlagEnabled(instance, operator.FlagNodeMetadataEnabled) {
+		r.log.Debug("node metadata is disabled")
+		return reconcile.Result{}, nil
+	}
+
+	node, err := r.kubernetescli.CoreV1().Nodes().Get(ctx, request.Name, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return reconcile.Result{}, nil
+	}
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	pool, err := r.poolName(ctx, node)
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	labels, annotations := metadata(instance, pool)
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		node, err := r.kubernetescli.CoreV1().Nodes().Get(ctx, request.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		changed := apply(&node.Labels, labels)
+		changed = apply(&node.Annotations, annotations) || changed
+		if !changed {
+			return nil
+		}
+
+		r.log.Printf("updating node %s", node.Name)
+		_, err = r.kubernetescli.CoreV1().Nodes().Update(ctx, node, metav1.UpdateOptions{})
+		return err
+	})
+	if kerrors.IsNotFound(err) {
+		err = nil
+	}
+	if err != nil {
+		r.log.Error(err)
+	}
+
+	return reconcile.Result{}, err
+}
+
+// poolName returns the pool of node: "master" for the masters, the worker
+// pool of the machineset of its machine for the workers, or "" if its machine
+// is unknown, e.g. for a node which isn't managed by the machine API
+func (r *NodeMetadataReconciler) poolName(ctx context.Context, node *corev1.Node) (string, error) {
+	parts := strings.Split(node.Annotations[machineAnnotation], "/")
+	if len(parts) != 2 {
+		return "", nil
+	}
+	namespace, name := parts[0], parts[1]
+
+	machine, err := r.maocli.MachineV1beta1().Machines(namespace).Get(ctx, name, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if machine.Labels[machineRoleLabel] == operator.RoleMaster {
+		return operator.RoleMaster, nil
+	}
+
+	machineSetName := machine.Labels[machineSetLabel]
+	if machineSetName == "" {
+		return "", nil
+	}
+
+	machineset, err := r.maocli.MachineV1beta1().MachineSets(namespace).Get(ctx, machineSetName, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	pool, _ := workerpool.PoolName(machineset)
+	return pool, nil
+}
+
+// metadata returns the labels and annotations which are wanted on a node of
+// pool.  Values which are unknown are left out, so that they are removed.
+func metadata(instance *arov1alpha1.Cluster, pool string) (labels, annotations map[string]string) {
+	labels = map[string]string{}
+	annotations = map[string]string{}
+
+	if instance.Spec.Location != "" {
+		labels[RegionLabel] = instance.Spec.Location
+	}
+
+	if instance.Spec.ResourceID != "" {
+		labels[ResourceIDHashLabel] = resourceIDHash(instance.Spec.ResourceID)
+		annotations[ResourceIDAnnotation] = instance.Spec.ResourceID
+	}
+
+	if pool != "" {
+		labels[PoolLabel] = pool
+	}
+
+	if instance.Spec.PricingTier != "" {
+		labels[PricingTierLabel] = instance.Spec.PricingTier
+	}
+
+	return labels, annotations
+}
+
+// resourceIDHash returns a digest of the case-insensitive Azure resourceId of
+// the cluster which fits in a label value.  Support tooling matches it
+// against the hash of a known resource ID; the ID itself is in the
+// ResourceIDAnnotation.
+func resourceIDHash(resourceID string) string {
+	h := sha256.Sum256([]byte(strings.ToLower(resourceID)))
+	return hex.EncodeToString(h[:16])
+}
+
+// apply sets want on m and removes the other keys under metadataPrefix.  It
+// returns whether anything changed.
+func apply(m *map[string]string, want map[string]string) bool {
+	updated := map[string]string{}
+	for k, v := range *m {
+		if !strings.HasPrefix(k, metadataPrefix) {
+			updated[k] = v
+		}
+	}
+
+	for k, v := range want {
+		updated[k] = v
+	}
+
+	if len(updated) == len(*m) {
+		changed := false
+		for k, v := range updated {
+			if old, ok := (*m)[k]; !ok || old != v {
+				changed = true
+				break
+			}
+		}
+		if !changed {
+			return false
+		}
+	}
+
+	*m = updated
+	return true
+}
+
+// SetupWithManager setup our manager
+func (r *NodeMetadataReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// a change of the Cluster is reconciled as a request for every node
+	clusterToNodes := handler.ToRequestsFunc(func(o handler.MapObject) []reconcile.Request {
+		nodes, err := r.kubernetescli.CoreV1().Nodes().List(context.Background(), metav1.ListOptions{})
+		if err != nil {
+			r.log.Error(err)
+			return nil
+		}
+
+		requests := make([]reconcile.Request, 0, len(nodes.Items))
+		for _, node := range nodes.Items {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: node.Name},
+			})
+		}
+
+		return requests
+	})
+
+	// a change of a machine is reconciled as a request for its node
+	machineToNode := handler.ToRequestsFunc(func(o handler.MapObject) []reconcile.Request {
+		machine, ok := o.Object.(*machinev1beta1.Machine)
+		if !ok || machine.Status.NodeRef == nil {
+			return nil
+		}
+
+		return []reconcile.Request{
+			{NamespacedName: types.NamespacedName{Name: machine.Status.NodeRef.Name}},
+		}
+	})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&corev1.Node{}).
+		Watches(&source.Kind{Type: &arov1alpha1.Cluster{}}, &handler.EnqueueRequestsFromMapFunc{ToRequests: clusterToNodes}).
+		Watches(&source.Kind{Type: &machinev1beta1.Machine{}}, &handler.EnqueueRequestsFromMapFunc{ToRequests: machineToNode}).
+		Named(controllers.NodeMetadataControllerName).
+		Complete(r)
+}
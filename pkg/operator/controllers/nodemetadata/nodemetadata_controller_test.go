@@ -0,0 +1,199 @@
+package nodemetadata
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	machinev1beta1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
+	maofake "github.com/openshift/machine-api-operator/pkg/generated/clientset/versioned/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+)
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+
+	resourceID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName"
+
+	node := func(labels, annotations map[string]string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "node",
+				Labels:      labels,
+				Annotations: annotations,
+			},
+		}
+	}
+
+	machine := func(role string) *machinev1beta1.Machine {
+		return &machinev1beta1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "machine",
+				Namespace: "openshift-machine-api",
+				Labels: map[string]string{
+					machineRoleLabel: role,
+					machineSetLabel:  "machineset",
+				},
+			},
+		}
+	}
+
+	machineset := &machinev1beta1.MachineSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "machineset",
+			Namespace: "openshift-machine-api",
+			Labels: map[string]string{
+				operator.WorkerProfileLabel: "gpu",
+			},
+		},
+	}
+
+	machineAnnotations := func(extra map[string]string) map[string]string {
+		annotations := map[string]string{
+			machineAnnotation: "openshift-machine-api/machine",
+		}
+		for k, v := range extra {
+			annotations[k] = v
+		}
+		return annotations
+	}
+
+	for _, tt := range []struct {
+		name            string
+		flag            string
+		objects         []runtime.Object
+		maoObjects      []runtime.Object
+		wantLabels      map[string]string
+		wantAnnotations map[string]string
+	}{
+		{
+			name: "worker node is labelled with its pool",
+			objects: []runtime.Object{
+				node(map[string]string{"customer": "value"}, machineAnnotations(nil)),
+			},
+			maoObjects: []runtime.Object{
+				machine("worker"),
+				machineset,
+			},
+			wantLabels: map[string]string{
+				"customer":          "value",
+				RegionLabel:         "eastus",
+				ResourceIDHashLabel: resourceIDHash(resourceID),
+				PoolLabel:           "gpu",
+				PricingTierLabel:    "Standard",
+			},
+			wantAnnotations: machineAnnotations(map[string]string{
+				ResourceIDAnnotation: resourceID,
+			}),
+		},
+		{
+			name: "master node is labelled master",
+			objects: []runtime.Object{
+				node(nil, machineAnnotations(nil)),
+			},
+			maoObjects: []runtime.Object{
+				machine("master"),
+			},
+			wantLabels: map[string]string{
+				RegionLabel:         "eastus",
+				ResourceIDHashLabel: resourceIDHash(resourceID),
+				PoolLabel:           "master",
+				PricingTierLabel:    "Standard",
+			},
+			wantAnnotations: machineAnnotations(map[string]string{
+				ResourceIDAnnotation: resourceID,
+			}),
+		},
+		{
+			name: "node without a machine has no pool, stale keys are removed",
+			objects: []runtime.Object{
+				node(map[string]string{
+					PoolLabel:                   "old",
+					metadataPrefix + "obsolete": "value",
+				}, map[string]string{
+					metadataPrefix + "obsolete": "value",
+				}),
+			},
+			wantLabels: map[string]string{
+				RegionLabel:         "eastus",
+				ResourceIDHashLabel: resourceIDHash(resourceID),
+				PricingTierLabel:    "Standard",
+			},
+			wantAnnotations: map[string]string{
+				ResourceIDAnnotation: resourceID,
+			},
+		},
+		{
+			name: "disabled controller leaves the node alone",
+			flag: "false",
+			objects: []runtime.Object{
+				node(map[string]string{PoolLabel: "old"}, nil),
+			},
+			wantLabels: map[string]string{
+				PoolLabel: "old",
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					Location:    "eastus",
+					ResourceID:  resourceID,
+					PricingTier: "Standard",
+				},
+			}
+			if tt.flag != "" {
+				cluster.Spec.OperatorFlags = map[string]string{
+					operator.FlagNodeMetadataEnabled: tt.flag,
+				}
+			}
+
+			kubernetescli := fake.NewSimpleClientset(tt.objects...)
+
+			r := NewReconciler(utillog.GetLogger(), kubernetescli, maofake.NewSimpleClientset(tt.maoObjects...), arofake.NewSimpleClientset(cluster).AroV1alpha1())
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: types.NamespacedName{Name: "node"}})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			n, err := kubernetescli.CoreV1().Nodes().Get(ctx, "node", metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(n.Labels, tt.wantLabels) {
+				t.Error(n.Labels)
+			}
+			if !reflect.DeepEqual(n.Annotations, tt.wantAnnotations) {
+				t.Error(n.Annotations)
+			}
+		})
+	}
+}
+
+func TestResourceIDHash(t *testing.T) {
+	h := resourceIDHash("/subscriptions/ID/resourceGroups/RG/providers/Microsoft.RedHatOpenShift/openShiftClusters/NAME")
+	if h != resourceIDHash("/subscriptions/id/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/name") {
+		t.Error("hash is case sensitive")
+	}
+	if len(h) != 32 {
+		t.Error(h)
+	}
+}
@@ -0,0 +1,574 @@
+package nodeproblemdetector
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest/to"
+	projectv1 "github.com/openshift/api/project/v1"
+	securityv1 "github.com/openshift/api/security/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/priorityclass"
+	"github.com/Azure/ARO-RP/pkg/util/version"
+)
+
+const (
+	kubeName           = "node-problem-detector"
+	kubeNamespace      = "openshift-azure-nodeproblemdetector"
+	kubeServiceAccount = "system:serviceaccount:" + kubeNamespace + ":" + kubeName
+)
+
+// Node conditions set by the node problem detector.  They are False on a
+// healthy node.
+const (
+	ConditionKernelDeadlock            v1.NodeConditionType = "KernelDeadlock"
+	ConditionReadonlyFilesystem        v1.NodeConditionType = "ReadonlyFilesystem"
+	ConditionFilesystemCorrupted       v1.NodeConditionType = "FilesystemCorrupted"
+	ConditionContainerRuntimeUnhealthy v1.NodeConditionType = "ContainerRuntimeUnhealthy"
+)
+
+// Reasons of the events which the node problem detector records against a
+// node when chronyd reports a time synchronization problem
+const (
+	EventTimeSyncLost = "TimeSyncLost"
+	EventClockStepped = "ClockStepped"
+)
+
+// EventCertificateRotationFailed is the reason of the events which the node
+// problem detector records against a node when its kubelet fails to rotate
+// its certificates
+const EventCertificateRotationFailed = "CertificateRotationFailed"
+
+// ConditionUnsupportedAgentPresent is set by the node problem detector on a
+// node on which it finds one of the unsupportedAgents.  It is not a node
+// problem as such: it is reported as an unsupported configuration.
+const ConditionUnsupportedAgentPresent v1.NodeConditionType = "UnsupportedAgentPresent"
+
+// Conditions returns the node conditions set by the node problem detector
+func Conditions() []v1.NodeConditionType {
+	return []v1.NodeConditionType{ConditionKernelDeadlock, ConditionReadonlyFilesystem, ConditionFilesystemCorrupted, ConditionContainerRuntimeUnhealthy}
+}
+
+// unsupportedAgents are the signatures of node agents which customers install
+// and which are known to break ARO nodes, mostly by replacing the CNI
+// configuration or by rewriting the iptables rules of the SDN.  An agent is
+// found if any of its paths exists on the host or any of its processes runs
+// there.  Process names are matched against /proc/<pid>/comm, so must not be
+// longer than 15 characters.
+var unsupportedAgents = []struct {
+	name      string
+	paths     []string
+	processes []string
+}{
+	{
+		name:      "Calico",
+		paths:     []string{"/etc/cni/net.d/10-calico.conflist", "/opt/cni/bin/calico"},
+		processes: []string{"calico-node", "calico-felix"},
+	},
+	{
+		name:      "Cilium",
+		paths:     []string{"/etc/cni/net.d/05-cilium.conf", "/opt/cni/bin/cilium-cni"},
+		processes: []string{"cilium-agent"},
+	},
+	{
+		name:      "Weave Net",
+		paths:     []string{"/etc/cni/net.d/10-weave.conflist"},
+		processes: []string{"weaver"},
+	},
+	{
+		name:      "Flannel",
+		paths:     []string{"/etc/cni/net.d/10-flannel.conflist", "/run/flannel/subnet.env"},
+		processes: []string{"flanneld"},
+	},
+	{
+		name:      "CrowdStrike Falcon",
+		paths:     []string{"/opt/CrowdStrike"},
+		processes: []string{"falcon-sensor"},
+	},
+	{
+		name:  "Prisma Cloud Defender",
+		paths: []string{"/var/lib/twistlock"},
+	},
+	{
+		name:  "Aqua Enforcer",
+		paths: []string{"/opt/aquasec"},
+	},
+	{
+		name:      "firewalld",
+		processes: []string{"firewalld"},
+	},
+}
+
+// unsupportedAgentsScript returns the custom plugin of the node problem
+// detector which looks for the unsupportedAgents on the host, whose root is
+// mounted at /host.  It exits 1, listing the agents found, if there are any.
+func unsupportedAgentsScript() string {
+	sb := &strings.Builder{}
+
+	sb.WriteString("#!/bin/sh\n\nfound=\n\n")
+
+	for _, agent := range unsupportedAgents {
+		var tests []string
+		for _, path := range agent.paths {
+			tests = append(tests, fmt.Sprintf("[ -e '/host%s' ]", path))
+		}
+		for _, process := range agent.processes {
+			tests = append(tests, fmt.Sprintf("grep -qsxF '%s' /host/proc/[0-9]*/comm", process))
+		}
+
+		fmt.Fprintf(sb, "if %s; then\n\tfound=\"${found:+$found, }%s\"\nfi\n", strings.Join(tests, " || "), agent.name)
+	}
+
+	sb.WriteString(`
+if [ -n "$found" ]; then
+	echo "unsupported node agents found: $found"
+	exit 1
+fi
+
+echo "no unsupported node agents found"
+`)
+
+	return sb.String()
+}
+
+// pluginMonitors holds the custom plugin monitor configs of the node problem
+// detector, keyed by file name.  Their plugins are in the same directory.
+var pluginMonitors = map[string]string{
+	"agent-monitor.json": `{
+	"plugin": "custom",
+	"pluginConfig": {
+		"invoke_interval": "10m",
+		"timeout": "1m",
+		"max_output_length": 256,
+		"concurrency": 1,
+		"enable_message_change_based_condition_update": true
+	},
+	"source": "agent-monitor",
+	"conditions": [
+		{
+			"type": "UnsupportedAgentPresent",
+			"reason": "NoUnsupportedAgent",
+			"message": "no unsupported node agents found"
+		}
+	],
+	"rules": [
+		{
+			"type": "permanent",
+			"condition": "UnsupportedAgentPresent",
+			"reason": "UnsupportedAgentFound",
+			"path": "/config/unsupported-agents.sh",
+			"timeout": "1m"
+		}
+	]
+}
+`,
+}
+
+// monitors holds the system log monitor configs of the node problem detector,
+// keyed by file name.  Problems which last are reported as node conditions;
+// transient ones only as events.
+var monitors = map[string]string{
+	"kernel-monitor.json": `{
+	"plugin": "kmsg",
+	"logPath": "/dev/kmsg",
+	"lookback": "5m",
+	"bufferSize": 10,
+	"source": "kernel-monitor",
+	"conditions": [
+		{
+			"type": "KernelDeadlock",
+			"reason": "KernelHasNoDeadlock",
+			"message": "kernel has no deadlock"
+		},
+		{
+			"type": "ReadonlyFilesystem",
+			"reason": "FilesystemIsNotReadOnly",
+			"message": "Filesystem is not read-only"
+		},
+		{
+			"type": "FilesystemCorrupted",
+			"reason": "FilesystemIsNotCorrupted",
+			"message": "Filesystem is not corrupted"
+		}
+	],
+	"rules": [
+		{
+			"type": "temporary",
+			"reason": "OOMKilling",
+			"pattern": "Killed process \\d+ (.+) total-vm:\\d+kB, anon-rss:\\d+kB, file-rss:\\d+kB.*"
+		},
+		{
+			"type": "temporary",
+			"reason": "TaskHung",
+			"pattern": "task [\\S ]+:\\w+ blocked for more than \\w+ seconds\\."
+		},
+		{
+			"type": "temporary",
+			"reason": "KernelOops",
+			"pattern": "BUG: unable to handle kernel NULL pointer dereference at .*"
+		},
+		{
+			"type": "permanent",
+			"condition": "KernelDeadlock",
+			"reason": "DockerHung",
+			"pattern": "task docker:\\w+ blocked for more than \\w+ seconds\\."
+		},
+		{
+			"type": "permanent",
+			"condition": "KernelDeadlock",
+			"reason": "CrioHung",
+			"pattern": "task crio:\\w+ blocked for more than \\w+ seconds\\."
+		},
+		{
+			"type": "permanent",
+			"condition": "ReadonlyFilesystem",
+			"reason": "FilesystemIsReadOnly",
+			"pattern": "Remounting filesystem read-only"
+		},
+		{
+			"type": "permanent",
+			"condition": "FilesystemCorrupted",
+			"reason": "XFSCorruptionDetected",
+			"pattern": "XFS \\(\\S+\\): Corruption detected.*"
+		},
+		{
+			"type": "permanent",
+			"condition": "FilesystemCorrupted",
+			"reason": "Ext4Error",
+			"pattern": "EXT4-fs error .*"
+		}
+	]
+}
+`,
+	"chrony-monitor.json": `{
+	"plugin": "journald",
+	"pluginConfig": {
+		"source": "chronyd"
+	},
+	"logPath": "/var/log/journal",
+	"lookback": "5m",
+	"bufferSize": 10,
+	"source": "chrony-monitor",
+	"conditions": [],
+	"rules": [
+		{
+			"type": "temporary",
+			"reason": "TimeSyncLost",
+			"pattern": "Can't synchronise: no selectable sources.*"
+		},
+		{
+			"type": "temporary",
+			"reason": "ClockStepped",
+			"pattern": "System clock wrong by .*"
+		}
+	]
+}
+`,
+	"kubelet-monitor.json": `{
+	"plugin": "journald",
+	"pluginConfig": {
+		"source": "hyperkube"
+	},
+	"logPath": "/var/log/journal",
+	"lookback": "5m",
+	"bufferSize": 10,
+	"source": "kubelet-monitor",
+	"conditions": [],
+	"rules": [
+		{
+			"type": "temporary",
+			"reason": "CertificateRotationFailed",
+			"pattern": ".*certificate_manager.go:[0-9]+\\] (Failed while requesting a signed certificate|Certificate request was not signed).*"
+		}
+	]
+}
+`,
+	"crio-monitor.json": `{
+	"plugin": "journald",
+	"pluginConfig": {
+		"source": "systemd"
+	},
+	"logPath": "/var/log/journal",
+	"lookback": "5m",
+	"bufferSize": 10,
+	"source": "crio-monitor",
+	"conditions": [
+		{
+			"type": "ContainerRuntimeUnhealthy",
+			"reason": "ContainerRuntimeIsHealthy",
+			"message": "Container runtime is healthy"
+		}
+	],
+	"rules": [
+		{
+			"type": "temporary",
+			"reason": "CrioStart",
+			"pattern": "Started Open Container Initiative Daemon.*"
+		},
+		{
+			"type": "permanent",
+			"condition": "ContainerRuntimeUnhealthy",
+			"reason": "CrioFailed",
+			"pattern": "crio.service: (Main process exited|Failed with result).*"
+		}
+	]
+}
+`,
+}
+
+func (r *NodeProblemDetectorReconciler) securityContextConstraints(ctx context.Context, name, serviceAccountName string) (*securityv1.SecurityContextConstraints, error) {
+	scc, err := r.securitycli.SecurityV1().SecurityContextConstraints().Get(ctx, "privileged", metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	scc.ObjectMeta = metav1.ObjectMeta{
+		Name: name,
+	}
+	scc.Groups = []string{}
+	scc.Users = []string{serviceAccountName}
+	return scc, nil
+}
+
+func (r *NodeProblemDetectorReconciler) resources(ctx context.Context, cluster *arov1alpha1.Cluster) ([]runtime.Object, error) {
+	scc, err := r.securityContextConstraints(ctx, "privileged-node-problem-detector", kubeServiceAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]runtime.Object{scc}, staticResources(cluster)...), nil
+}
+
+// staticResources returns the resources of the node problem detector other
+// than its SCC, which is copied from the cluster
+func staticResources(cluster *arov1alpha1.Cluster) []runtime.Object {
+	configs := make([]string, 0, len(monitors))
+	for name := range monitors {
+		configs = append(configs, "/config/"+name)
+	}
+	sort.Strings(configs)
+
+	pluginConfigs := make([]string, 0, len(pluginMonitors))
+	for name := range pluginMonitors {
+		pluginConfigs = append(pluginConfigs, "/config/"+name)
+	}
+	sort.Strings(pluginConfigs)
+
+	data := map[string]string{
+		"unsupported-agents.sh": unsupportedAgentsScript(),
+	}
+	for name, config := range monitors {
+		data[name] = config
+	}
+	for name, config := range pluginMonitors {
+		data[name] = config
+	}
+
+	hostPathVolume := func(name, path string) v1.Volume {
+		return v1.Volume{
+			Name: name,
+			VolumeSource: v1.VolumeSource{
+				HostPath: &v1.HostPathVolumeSource{
+					Path: path,
+				},
+			},
+		}
+	}
+
+	return []runtime.Object{
+		&v1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        kubeNamespace,
+				Annotations: map[string]string{projectv1.ProjectNodeSelector: ""},
+			},
+		},
+		&v1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      kubeName,
+				Namespace: kubeNamespace,
+			},
+		},
+		&rbacv1.ClusterRole{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "system:aro-node-problem-detector",
+			},
+			Rules: []rbacv1.PolicyRule{
+				{
+					APIGroups: []string{""},
+					Resources: []string{"nodes"},
+					Verbs:     []string{"get"},
+				},
+				{
+					APIGroups: []string{""},
+					Resources: []string{"nodes/status"},
+					Verbs:     []string{"patch"},
+				},
+				{
+					APIGroups: []string{""},
+					Resources: []string{"events"},
+					Verbs:     []string{"create", "patch", "update"},
+				},
+			},
+		},
+		&rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "system:aro-node-problem-detector",
+			},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: "rbac.authorization.k8s.io",
+				Kind:     "ClusterRole",
+				Name:     "system:aro-node-problem-detector",
+			},
+			Subjects: []rbacv1.Subject{
+				{
+					Kind:      "ServiceAccount",
+					Name:      kubeName,
+					Namespace: kubeNamespace,
+				},
+			},
+		},
+		&v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      kubeName,
+				Namespace: kubeNamespace,
+			},
+			Data: data,
+		},
+		&appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      kubeName,
+				Namespace: kubeNamespace,
+			},
+			Spec: appsv1.DaemonSetSpec{
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": kubeName},
+				},
+				Template: v1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{"app": kubeName},
+					},
+					Spec: v1.PodSpec{
+						ServiceAccountName: kubeName,
+						PriorityClassName:  priorityclass.NodeCritical,
+						Containers: []v1.Container{
+							{
+								Name:  kubeName,
+								Image: version.NodeProblemDetectorImage(cluster.Spec.ACRDomain),
+								Command: []string{
+									"/node-problem-detector",
+								},
+								Args: []string{
+									"--logtostderr",
+									"--config.system-log-monitor=" + strings.Join(configs, ","),
+									"--config.custom-plugin-monitor=" + strings.Join(pluginConfigs, ","),
+								},
+								Env: []v1.EnvVar{
+									{
+										Name: "NODE_NAME",
+										ValueFrom: &v1.EnvVarSource{
+											FieldRef: &v1.ObjectFieldSelector{
+												FieldPath: "spec.nodeName",
+											},
+										},
+									},
+								},
+								Resources: controllers.ComponentResources(cluster, arov1alpha1.ComponentNodeProblemDetector, v1.ResourceRequirements{
+									Limits: v1.ResourceList{
+										v1.ResourceCPU:    resource.MustParse("100m"),
+										v1.ResourceMemory: resource.MustParse("100Mi"),
+									},
+									Requests: v1.ResourceList{
+										v1.ResourceCPU:    resource.MustParse("10m"),
+										v1.ResourceMemory: resource.MustParse("50Mi"),
+									},
+								}),
+								SecurityContext: &v1.SecurityContext{
+									Privileged: to.BoolPtr(true),
+								},
+								VolumeMounts: []v1.VolumeMount{
+									{
+										Name:      "config",
+										MountPath: "/config",
+										ReadOnly:  true,
+									},
+									{
+										Name:      "kmsg",
+										MountPath: "/dev/kmsg",
+										ReadOnly:  true,
+									},
+									{
+										Name:      "journal",
+										MountPath: "/var/log/journal",
+										ReadOnly:  true,
+									},
+									{
+										Name:      "machine-id",
+										MountPath: "/etc/machine-id",
+										ReadOnly:  true,
+									},
+									{
+										Name:      "localtime",
+										MountPath: "/etc/localtime",
+										ReadOnly:  true,
+									},
+									{
+										Name:      "host",
+										MountPath: "/host",
+										ReadOnly:  true,
+									},
+									{
+										Name:      "host-proc",
+										MountPath: "/host/proc",
+										ReadOnly:  true,
+									},
+								},
+							},
+						},
+						Volumes: []v1.Volume{
+							{
+								Name: "config",
+								VolumeSource: v1.VolumeSource{
+									ConfigMap: &v1.ConfigMapVolumeSource{
+										LocalObjectReference: v1.LocalObjectReference{
+											Name: kubeName,
+										},
+										// the plugins are run directly
+										DefaultMode: to.Int32Ptr(0755),
+									},
+								},
+							},
+							hostPathVolume("kmsg", "/dev/kmsg"),
+							hostPathVolume("journal", "/var/log/journal"),
+							hostPathVolume("machine-id", "/etc/machine-id"),
+							hostPathVolume("localtime", "/etc/localtime"),
+							hostPathVolume("host", "/"),
+							hostPathVolume("host-proc", "/proc"),
+						},
+						Tolerations: []v1.Toleration{
+							{
+								Effect:   v1.TaintEffectNoExecute,
+								Operator: v1.TolerationOpExists,
+							},
+							{
+								Effect:   v1.TaintEffectNoSchedule,
+								Operator: v1.TolerationOpExists,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,106 @@
+package nodeproblemdetector
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+
+	securityclient "github.com/openshift/client-go/security/clientset/versioned"
+	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+	"github.com/Azure/ARO-RP/pkg/util/dynamichelper"
+)
+
+// NodeProblemDetectorReconciler is the controller struct
+type NodeProblemDetectorReconciler struct {
+	kubernetescli kubernetes.Interface
+	securitycli   securityclient.Interface
+	arocli        aroclient.AroV1alpha1Interface
+	restConfig    *rest.Config
+	log           *logrus.Entry
+}
+
+// NewReconciler creates a new Reconciler
+func NewReconciler(log *logrus.Entry, kubernetescli kubernetes.Interface, securitycli securityclient.Interface, arocli aroclient.AroV1alpha1Interface, restConfig *rest.Config) *NodeProblemDetectorReconciler {
+	return &NodeProblemDetectorReconciler{
+		securitycli:   securitycli,
+		kubernetescli: kubernetescli,
+		arocli:        arocli,
+		restConfig:    restConfig,
+		log:           log,
+	}
+}
+
+// Reconcile ensures the node problem detector daemonset and its resources
+func (r *NodeProblemDetectorReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	if request.Name != arov1alpha1.SingletonClusterName {
+		return reconcile.Result{}, nil
+	}
+
+	instance, err := r.arocli.Clusters().Get(ctx, request.Name, metav1.GetOptions{})
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	if !controllers.FlagEnabled(instance, operator.FlagNodeProblemDetectorEnabled) {
+		r.log.Debug("node problem detector is disabled")
+		return reconcile.Result{}, nil
+	}
+
+	// TODO: dh should be a field in r, but the fact that it is initialised here
+	// each time currently saves us in the case that the controller runs before
+	// the SCC API is registered.
+	dh, err := dynamichelper.New(r.log, r.restConfig)
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	resources, err := r.resources(ctx, instance)
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	err = dynamichelper.SetControllerReferences(resources, instance)
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	uns, err := dynamichelper.Prepare(resources)
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	err = dh.Ensure(ctx, uns...)
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// SetupWithManager creates the controller
+func (r *NodeProblemDetectorReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}).
+		Owns(&appsv1.DaemonSet{}).
+		Named(controllers.NodeProblemDetectorControllerName).
+		Complete(r)
+}
@@ -0,0 +1,199 @@
+package nodeproblemdetector
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+func TestMonitors(t *testing.T) {
+	wantConditions := map[v1.NodeConditionType]bool{}
+	for _, c := range Conditions() {
+		wantConditions[c] = true
+	}
+
+	gotConditions := map[v1.NodeConditionType]bool{}
+
+	for name, config := range monitors {
+		var monitor struct {
+			Conditions []struct {
+				Type v1.NodeConditionType `json:"type"`
+			} `json:"conditions"`
+			Rules []struct {
+				Type      string               `json:"type"`
+				Condition v1.NodeConditionType `json:"condition"`
+				Pattern   string               `json:"pattern"`
+			} `json:"rules"`
+		}
+
+		err := json.Unmarshal([]byte(config), &monitor)
+		if err != nil {
+			t.Fatal(name, err)
+		}
+
+		declared := map[v1.NodeConditionType]bool{}
+		for _, c := range monitor.Conditions {
+			if !wantConditions[c.Type] {
+				t.Error(name, "unexpected condition", c.Type)
+			}
+			declared[c.Type] = true
+			gotConditions[c.Type] = true
+		}
+
+		for _, rule := range monitor.Rules {
+			_, err := regexp.Compile(rule.Pattern)
+			if err != nil {
+				t.Error(name, err)
+			}
+
+			if rule.Type == "permanent" && !declared[rule.Condition] {
+				t.Error(name, "undeclared condition", rule.Condition)
+			}
+		}
+	}
+
+	for c := range wantConditions {
+		if !gotConditions[c] {
+			t.Error("missing condition", c)
+		}
+	}
+}
+
+func TestStaticResources(t *testing.T) {
+	cluster := &arov1alpha1.Cluster{
+		Spec: arov1alpha1.ClusterSpec{
+			ACRDomain: "acrDomain",
+		},
+	}
+
+	var ds *appsv1.DaemonSet
+	for _, o := range staticResources(cluster) {
+		if o, ok := o.(*appsv1.DaemonSet); ok {
+			ds = o
+		}
+	}
+	if ds == nil {
+		t.Fatal("daemonset not found")
+	}
+
+	c := ds.Spec.Template.Spec.Containers[0]
+	if c.Image != "acrDomain/node-problem-detector/node-problem-detector:v0.8.5" {
+		t.Error(c.Image)
+	}
+	if c.Args[1] != "--config.system-log-monitor=/config/chrony-monitor.json,/config/crio-monitor.json,/config/kernel-monitor.json,/config/kubelet-monitor.json" {
+		t.Error(c.Args[1])
+	}
+	if c.Args[2] != "--config.custom-plugin-monitor=/config/agent-monitor.json" {
+		t.Error(c.Args[2])
+	}
+}
+
+func TestPluginMonitors(t *testing.T) {
+	var monitor struct {
+		Conditions []struct {
+			Type v1.NodeConditionType `json:"type"`
+		} `json:"conditions"`
+		Rules []struct {
+			Condition v1.NodeConditionType `json:"condition"`
+			Path      string               `json:"path"`
+		} `json:"rules"`
+	}
+
+	err := json.Unmarshal([]byte(pluginMonitors["agent-monitor.json"]), &monitor)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(monitor.Conditions) != 1 || monitor.Conditions[0].Type != ConditionUnsupportedAgentPresent {
+		t.Error(monitor.Conditions)
+	}
+	if len(monitor.Rules) != 1 ||
+		monitor.Rules[0].Condition != ConditionUnsupportedAgentPresent ||
+		monitor.Rules[0].Path != "/config/unsupported-agents.sh" {
+		t.Error(monitor.Rules)
+	}
+
+	for _, agent := range unsupportedAgents {
+		for _, process := range agent.processes {
+			if len(process) > 15 {
+				t.Error(agent.name, "process name too long", process)
+			}
+		}
+	}
+}
+
+func TestUnsupportedAgentsScript(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		files      map[string]string
+		wantOutput string
+		wantErr    bool
+	}{
+		{
+			name: "no agents",
+			files: map[string]string{
+				"proc/1/comm":                        "systemd\n",
+				"etc/cni/net.d/80-openshift-network": "{}",
+			},
+			wantOutput: "no unsupported node agents found\n",
+		},
+		{
+			name: "agents found by path and process",
+			files: map[string]string{
+				"proc/1/comm":                "systemd\n",
+				"proc/100/comm":              "calico-node\n",
+				"opt/CrowdStrike/falconctl":  "",
+				"proc/200/comm":              "firewalld-extra\n",
+				"etc/cni/net.d/05-cilium.cf": "",
+			},
+			wantOutput: "unsupported node agents found: Calico, CrowdStrike Falcon\n",
+			wantErr:    true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			root, err := ioutil.TempDir("", "host")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(root)
+
+			for name, content := range tt.files {
+				path := filepath.Join(root, name)
+
+				err = os.MkdirAll(filepath.Dir(path), 0777)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				err = ioutil.WriteFile(path, []byte(content), 0666)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			script := strings.ReplaceAll(unsupportedAgentsScript(), "/host", root)
+
+			out, err := exec.Command("/bin/sh", "-c", script).Output()
+			if (err != nil) != tt.wantErr {
+				t.Error(err)
+			}
+
+			if string(out) != tt.wantOutput {
+				t.Error(string(out))
+			}
+		})
+	}
+}
@@ -0,0 +1,277 @@
+package nodereadiness
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+)
+
+const (
+	// taintKey is the NoSchedule taint which holds a new worker node until
+	// the managed daemonsets run on it.  The managed daemonsets tolerate all
+	// NoSchedule taints, so they are scheduled regardless.
+	taintKey = "aro.openshift.io/managed-components-pending"
+
+	// initializedAnnotation marks the nodes which have been released, so that
+	// a node is never tainted again, e.g. when a managed daemonset is rolled
+	// out later
+	initializedAnnotation = "aro.openshift.io/node-initialized"
+
+	workerNodeLabel = "node-role.kubernetes.io/worker"
+
+	// readinessTimeout bounds how long a node is held: a managed component
+	// which fails to start must not cost the customer the node's capacity
+	readinessTimeout = 30 * time.Minute
+
+	resyncInterval = time.Minute
+)
+
+// requiredDaemonSets are the managed daemonsets which must be running on a
+// worker node before customer workloads are scheduled to it.  Those which
+// don't exist on the cluster, e.g. because their feature is switched off, are
+// not waited for.
+var requiredDaemonSets = []types.NamespacedName{
+	{Namespace: "openshift-azure-logging", Name: "mdsd"},
+	{Namespace: "openshift-azure-nodeproblemdetector", Name: "node-problem-detector"},
+	{Namespace: "openshift-azure-routefix", Name: "routefix"},
+}
+
+// NodeReadinessReconciler taints new worker nodes NoSchedule until the
+// managed daemonsets are running on them, so that customer workloads don't
+// land on nodes without logging or the networking fixes
+type NodeReadinessReconciler struct {
+	kubernetescli kubernetes.Interface
+	arocli        aroclient.AroV1alpha1Interface
+	log           *logrus.Entry
+
+	now func() time.Time
+}
+
+func NewReconciler(log *logrus.Entry, kubernetescli kubernetes.Interface, arocli aroclient.AroV1alpha1Interface) *NodeReadinessReconciler {
+	return &NodeReadinessReconciler{
+		kubernetescli: kubernetescli,
+		arocli:        arocli,
+		log:           log,
+
+		now: time.Now,
+	}
+}
+
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=apps,resources=daemonsets,verbs=get;list;watch
+
+// Reconcile taints a worker node which has not been initialized while the
+// managed daemonsets are not ready on it, and releases it (removing the taint
+// and marking it initialized) once they are, or once readinessTimeout has
+// passed since the node was created.  Nodes which predate the controller run
+// the managed daemonsets already, so they are released without being tainted.
+func (r *NodeReadinessReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
+	// TODO(mj): controller-runtime master fixes the need for this (https://github.com/kubernetes-sigs/controller-runtime/blob/master/pkg/reconcile/reconcile.go#L93) but it's not yet released.
+	ctx := context.Background()
+
+	instance, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	node, err := r.kubernetescli.CoreV1().Nodes().Get(ctx, request.Name, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return reconcile.Result{}, nil
+	}
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	if _, ok := node.Labels[workerNodeLabel]; !ok {
+		return reconcile.Result{}, nil
+	}
+
+	// switching the controller off releases the nodes which it holds
+	if !controllers.FlagEnabled(instance, operator.FlagNodeReadinessEnabled) {
+		r.log.Debug("node readiness is disabled")
+		return reconcile.Result{}, r.updateNode(ctx, node.Name, false, false)
+	}
+
+	if _, ok := node.Annotations[initializedAnnotation]; ok {
+		return reconcile.Result{}, r.updateNode(ctx, node.Name, false, true)
+	}
+
+	missing, err := r.missingDaemonSets(ctx, node)
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	if len(missing) == 0 {
+		r.log.Printf("releasing node %s: managed daemonsets are ready", node.Name)
+		return reconcile.Result{}, r.updateNode(ctx, node.Name, false, true)
+	}
+
+	if r.now().Sub(node.CreationTimestamp.Time) >= readinessTimeout {
+		r.log.Warnf("releasing node %s: managed daemonsets %v are not ready after %s", node.Name, missing, readinessTimeout)
+		return reconcile.Result{}, r.updateNode(ctx, node.Name, false, true)
+	}
+
+	err = r.updateNode(ctx, node.Name, true, false)
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: resyncInterval}, nil
+}
+
+// missingDaemonSets returns the required daemonsets which should run on node
+// but of which no pod is ready on it
+func (r *NodeReadinessReconciler) missingDaemonSets(ctx context.Context, node *corev1.Node) ([]string, error) {
+	var missing []string
+
+	for _, name := range requiredDaemonSets {
+		ds, err := r.kubernetescli.AppsV1().DaemonSets(name.Namespace).Get(ctx, name.Name, metav1.GetOptions{})
+		if kerrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if !labels.SelectorFromSet(ds.Spec.Template.Spec.NodeSelector).Matches(labels.Set(node.Labels)) {
+			continue
+		}
+
+		pods, err := r.kubernetescli.CoreV1().Pods(name.Namespace).List(ctx, metav1.ListOptions{
+			FieldSelector: "spec.nodeName=" + node.Name,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if !hasReadyPod(ds, pods.Items, node.Name) {
+			missing = append(missing, name.String())
+		}
+	}
+
+	return missing, nil
+}
+
+// hasReadyPod returns true if one of pods is a ready pod of ds on nodeName
+func hasReadyPod(ds *appsv1.DaemonSet, pods []corev1.Pod, nodeName string) bool {
+	for _, pod := range pods {
+		if pod.Spec.NodeName != nodeName {
+			continue
+		}
+
+		owner := metav1.GetControllerOf(&pod)
+		if owner == nil || owner.UID != ds.UID {
+			continue
+		}
+
+		for _, c := range pod.Status.Conditions {
+			if c.Type == corev1.PodReady && c.Status == corev1.ConditionTrue {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// updateNode sets or removes the taint on the node called name and marks it
+// initialized if requested
+func (r *NodeReadinessReconciler) updateNode(ctx context.Context, name string, tainted, initialized bool) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		node, err := r.kubernetescli.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		var changed bool
+
+		var taints []corev1.Taint
+		var found bool
+		for _, t := range node.Spec.Taints {
+			if t.Key == taintKey {
+				found = true
+				if !tainted {
+					changed = true
+					continue
+				}
+			}
+			taints = append(taints, t)
+		}
+		if tainted && !found {
+			taints = append(taints, corev1.Taint{
+				Key:    taintKey,
+				Effect: corev1.TaintEffectNoSchedule,
+			})
+			changed = true
+		}
+		node.Spec.Taints = taints
+
+		if _, ok := node.Annotations[initializedAnnotation]; initialized && !ok {
+			if node.Annotations == nil {
+				node.Annotations = map[string]string{}
+			}
+			node.Annotations[initializedAnnotation] = "true"
+			changed = true
+		}
+
+		if !changed {
+			return nil
+		}
+
+		_, err = r.kubernetescli.CoreV1().Nodes().Update(ctx, node, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// SetupWithManager setup our manager
+func (r *NodeReadinessReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// a change of a managed pod is reconciled as a request for its node
+	podToNode := handler.ToRequestsFunc(func(o handler.MapObject) []reconcile.Request {
+		pod, ok := o.Object.(*corev1.Pod)
+		if !ok || pod.Spec.NodeName == "" {
+			return nil
+		}
+
+		for _, name := range requiredDaemonSets {
+			if pod.Namespace == name.Namespace {
+				return []reconcile.Request{
+					{NamespacedName: types.NamespacedName{Name: pod.Spec.NodeName}},
+				}
+			}
+		}
+
+		return nil
+	})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&corev1.Node{}).
+		Watches(&source.Kind{Type: &corev1.Pod{}}, &handler.EnqueueRequestsFromMapFunc{ToRequests: podToNode}).
+		Named(controllers.NodeReadinessControllerName).
+		Complete(r)
+}
@@ -0,0 +1,204 @@
+package nodereadiness
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/to"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+)
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+
+	now := time.Now()
+
+	taint := corev1.Taint{
+		Key:    taintKey,
+		Effect: corev1.TaintEffectNoSchedule,
+	}
+	customerTaint := corev1.Taint{
+		Key:    "customer",
+		Effect: corev1.TaintEffectNoExecute,
+	}
+
+	mdsd := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mdsd",
+			Namespace: "openshift-azure-logging",
+			UID:       "mdsd-uid",
+		},
+	}
+
+	mdsdPod := func(ready corev1.ConditionStatus) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "mdsd-abcde",
+				Namespace: "openshift-azure-logging",
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: "apps/v1",
+						Kind:       "DaemonSet",
+						Name:       "mdsd",
+						UID:        "mdsd-uid",
+						Controller: to.BoolPtr(true),
+					},
+				},
+			},
+			Spec: corev1.PodSpec{
+				NodeName: "worker",
+			},
+			Status: corev1.PodStatus{
+				Conditions: []corev1.PodCondition{
+					{
+						Type:   corev1.PodReady,
+						Status: ready,
+					},
+				},
+			},
+		}
+	}
+
+	node := func(age time.Duration, annotations map[string]string, taints ...corev1.Taint) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "worker",
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+				Labels: map[string]string{
+					workerNodeLabel: "",
+				},
+				Annotations: annotations,
+			},
+			Spec: corev1.NodeSpec{
+				Taints: taints,
+			},
+		}
+	}
+
+	initialized := map[string]string{initializedAnnotation: "true"}
+
+	for _, tt := range []struct {
+		name            string
+		flag            string
+		objects         []runtime.Object
+		wantTaints      []corev1.Taint
+		wantAnnotations map[string]string
+		wantRequeue     bool
+	}{
+		{
+			name: "new node without managed pods is tainted",
+			objects: []runtime.Object{
+				node(time.Minute, nil, customerTaint),
+				mdsd,
+			},
+			wantTaints:  []corev1.Taint{customerTaint, taint},
+			wantRequeue: true,
+		},
+		{
+			name: "new node with unready managed pods stays tainted",
+			objects: []runtime.Object{
+				node(time.Minute, nil, taint),
+				mdsd,
+				mdsdPod(corev1.ConditionFalse),
+			},
+			wantTaints:  []corev1.Taint{taint},
+			wantRequeue: true,
+		},
+		{
+			name: "node with ready managed pods is released",
+			objects: []runtime.Object{
+				node(time.Minute, nil, customerTaint, taint),
+				mdsd,
+				mdsdPod(corev1.ConditionTrue),
+			},
+			wantTaints:      []corev1.Taint{customerTaint},
+			wantAnnotations: initialized,
+		},
+		{
+			name: "absent daemonsets are not waited for",
+			objects: []runtime.Object{
+				node(time.Minute, nil, taint),
+			},
+			wantAnnotations: initialized,
+		},
+		{
+			name: "node is released after the timeout",
+			objects: []runtime.Object{
+				node(readinessTimeout, nil, taint),
+				mdsd,
+			},
+			wantAnnotations: initialized,
+		},
+		{
+			name: "initialized node is not tainted again",
+			objects: []runtime.Object{
+				node(time.Minute, initialized),
+				mdsd,
+			},
+			wantAnnotations: initialized,
+		},
+		{
+			name: "disabled controller releases the node",
+			flag: "false",
+			objects: []runtime.Object{
+				node(time.Minute, nil, taint),
+				mdsd,
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+			}
+			if tt.flag != "" {
+				cluster.Spec.OperatorFlags = map[string]string{
+					operator.FlagNodeReadinessEnabled: tt.flag,
+				}
+			}
+
+			kubernetescli := fake.NewSimpleClientset(tt.objects...)
+
+			r := NewReconciler(utillog.GetLogger(), kubernetescli, arofake.NewSimpleClientset(cluster).AroV1alpha1())
+			r.now = func() time.Time { return now }
+
+			result, err := r.Reconcile(ctrl.Request{NamespacedName: types.NamespacedName{Name: "worker"}})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if (result.RequeueAfter != 0) != tt.wantRequeue {
+				t.Error(result)
+			}
+
+			n, err := kubernetescli.CoreV1().Nodes().Get(ctx, "worker", metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(n.Spec.Taints, tt.wantTaints) {
+				t.Error(n.Spec.Taints)
+			}
+			if !reflect.DeepEqual(n.Annotations, tt.wantAnnotations) {
+				t.Error(n.Annotations)
+			}
+		})
+	}
+}
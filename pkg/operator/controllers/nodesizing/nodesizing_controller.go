@@ -0,0 +1,311 @@
+package nodesizing
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	mcv1 "github.com/openshift/machine-config-operator/pkg/apis/machineconfiguration.openshift.io/v1"
+	mcoclient "github.com/openshift/machine-config-operator/pkg/generated/clientset/versioned"
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+	"github.com/Azure/ARO-RP/pkg/util/dynamichelper"
+	_ "github.com/Azure/ARO-RP/pkg/util/scheme"
+)
+
+const (
+	// labelName selects the machine config pool which the KubeletConfig
+	// applies to.  The label and KubeletConfig names are those of the static
+	// system reserved workaround which this controller supersedes, so that
+	// clusters which have it are taken over in place.
+	labelName                   = "aro.openshift.io/limits"
+	labelValue                  = ""
+	kubeletConfigName           = "aro-limits"
+	workerMachineConfigPoolName = "worker"
+
+	workerNodeLabel = "node-role.kubernetes.io/worker"
+
+	// hardEviction is reserved on top of the system reserved memory
+	hardEviction = 500 * 1024 * 1024
+
+	// minMemoryReserved and minCPUReserved are the reservations applied before
+	// node sizing (the workaround's memory and the OpenShift default CPU):
+	// node sizing never reserves less than them
+	minMemoryReserved = 2000 * 1024 * 1024
+	minCPUReserved    = 500
+)
+
+// tier reserves basisPoints/10000 of the next size units of a node's
+// capacity; a size of 0 covers the remainder of the capacity
+type tier struct {
+	size        int64
+	basisPoints int64
+}
+
+// memoryTiers (in MiB) and cpuTiers (in millicores) follow the GKE and
+// OpenShift node sizing recommendations: larger nodes run more pods and
+// therefore need more memory for the kubelet, CRI-O and the system slice
+var (
+	memoryTiers = []tier{
+		{size: 4 * 1024, basisPoints: 2500},
+		{size: 4 * 1024, basisPoints: 2000},
+		{size: 8 * 1024, basisPoints: 1000},
+		{size: 112 * 1024, basisPoints: 600},
+		{basisPoints: 200},
+	}
+
+	cpuTiers = []tier{
+		{size: 1000, basisPoints: 600},
+		{size: 1000, basisPoints: 100},
+		{size: 2000, basisPoints: 50},
+		{basisPoints: 25},
+	}
+)
+
+// NodeSizingReconciler sets the kubelet system reserved resources of the
+// worker nodes according to their size, to stop large nodes running out of
+// memory under the default reservation
+type NodeSizingReconciler struct {
+	kubernetescli kubernetes.Interface
+	mcocli        mcoclient.Interface
+	arocli        aroclient.AroV1alpha1Interface
+	restConfig    *rest.Config
+	recorder      record.EventRecorder
+	log           *logrus.Entry
+}
+
+func NewReconciler(log *logrus.Entry, kubernetescli kubernetes.Interface, mcocli mcoclient.Interface, arocli aroclient.AroV1alpha1Interface, restConfig *rest.Config, recorder record.EventRecorder) *NodeSizingReconciler {
+	return &NodeSizingReconciler{
+		kubernetescli: kubernetescli,
+		mcocli:        mcocli,
+		arocli:        arocli,
+		restConfig:    restConfig,
+		recorder:      recorder,
+		log:           log,
+	}
+}
+
+// Reconcile sizes the system reserved resources for the largest worker node,
+// as one KubeletConfig applies to the whole worker machine config pool, and
+// then verifies from the nodes' allocatable resources that every worker node
+// runs with them.  Requests for Nodes are reconciled as requests for the
+// Cluster.
+func (r *NodeSizingReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
+	// TODO(mj): controller-runtime master fixes the need for this (https://github.com/kubernetes-sigs/controller-runtime/blob/master/pkg/reconcile/reconcile.go#L93) but it's not yet released.
+	ctx := context.Background()
+
+	instance, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	if !controllers.FlagEnabled(instance, operator.FlagNodeSizingEnabled) {
+		r.log.Debug("node sizing is disabled")
+		return reconcile.Result{}, nil
+	}
+
+	nodes, err := r.kubernetescli.CoreV1().Nodes().List(ctx, metav1.ListOptions{LabelSelector: workerNodeLabel})
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	if len(nodes.Items) == 0 {
+		return reconcile.Result{}, nil
+	}
+
+	memoryReserved, cpuReserved := systemReserved(nodes.Items)
+
+	dh, err := dynamichelper.New(r.log, r.restConfig)
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	err = r.ensure(ctx, dh, memoryReserved, cpuReserved)
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	err = r.setNodeSizingAppliedCondition(ctx, notApplied(nodes.Items, memoryReserved, cpuReserved))
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// ensure labels the worker machine config pool and applies the KubeletConfig
+// which selects it
+func (r *NodeSizingReconciler) ensure(ctx context.Context, dh dynamichelper.Interface, memoryReserved, cpuReserved int64) error {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		mcp, err := r.mcocli.MachineconfigurationV1().MachineConfigPools().Get(ctx, workerMachineConfigPoolName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if _, ok := mcp.Labels[labelName]; ok {
+			return nil
+		}
+
+		if mcp.Labels == nil {
+			mcp.Labels = map[string]string{}
+		}
+		mcp.Labels[labelName] = labelValue
+
+		_, err = r.mcocli.MachineconfigurationV1().MachineConfigPools().Update(ctx, mcp, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	un, err := kubeletConfig(memoryReserved, cpuReserved)
+	if err != nil {
+		return err
+	}
+
+	return dh.Ensure(ctx, un)
+}
+
+func (r *NodeSizingReconciler) setNodeSizingAppliedCondition(ctx context.Context, notApplied []string) error {
+	cond := &status.Condition{
+		Type:    arov1alpha1.NodeSizingApplied,
+		Status:  corev1.ConditionTrue,
+		Message: "node sizing is applied to all worker nodes",
+		Reason:  "CheckDone",
+	}
+
+	if len(notApplied) > 0 {
+		cond.Status = corev1.ConditionFalse
+		cond.Reason = "CheckFailed"
+		cond.Message = fmt.Sprintf("node sizing is not yet applied to %s", strings.Join(notApplied, ", "))
+	}
+
+	return controllers.SetCondition(ctx, r.arocli, r.recorder, cond, operator.RoleMaster)
+}
+
+// systemReserved returns the memory (in bytes) and CPU (in millicores) to
+// reserve for the largest of the nodes
+func systemReserved(nodes []corev1.Node) (memoryReserved int64, cpuReserved int64) {
+	var memory, cpu int64
+	for _, node := range nodes {
+		if m := node.Status.Capacity.Memory().Value(); m > memory {
+			memory = m
+		}
+		if c := node.Status.Capacity.Cpu().MilliValue(); c > cpu {
+			cpu = c
+		}
+	}
+
+	memoryReserved = reserve(memory/1024/1024, memoryTiers) * 1024 * 1024
+	if memoryReserved < minMemoryReserved {
+		memoryReserved = minMemoryReserved
+	}
+
+	cpuReserved = reserve(cpu, cpuTiers)
+	if cpuReserved < minCPUReserved {
+		cpuReserved = minCPUReserved
+	}
+
+	return memoryReserved, cpuReserved
+}
+
+func reserve(capacity int64, tiers []tier) (reserved int64) {
+	for _, t := range tiers {
+		n := capacity
+		if t.size != 0 && n > t.size {
+			n = t.size
+		}
+
+		reserved += n * t.basisPoints / 10000
+		capacity -= n
+	}
+
+	return reserved
+}
+
+// notApplied returns the names of the nodes whose allocatable resources don't
+// yet reflect the reservation: the machine config operator rolls the
+// KubeletConfig out to one node at a time
+func notApplied(nodes []corev1.Node, memoryReserved, cpuReserved int64) (names []string) {
+	for _, node := range nodes {
+		memory := node.Status.Capacity.Memory().Value() - node.Status.Allocatable.Memory().Value()
+		cpu := node.Status.Capacity.Cpu().MilliValue() - node.Status.Allocatable.Cpu().MilliValue()
+
+		if memory != memoryReserved+hardEviction || cpu != cpuReserved {
+			names = append(names, node.Name)
+		}
+	}
+
+	return names
+}
+
+func kubeletConfig(memoryReserved, cpuReserved int64) (*unstructured.Unstructured, error) {
+	kc := &mcv1.KubeletConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   kubeletConfigName,
+			Labels: map[string]string{labelName: labelValue},
+		},
+		Spec: mcv1.KubeletConfigSpec{
+			MachineConfigPoolSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{labelName: labelValue},
+			},
+			KubeletConfig: &runtime.RawExtension{
+				Object: &unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"systemReserved": map[string]interface{}{
+							"memory": resource.NewQuantity(memoryReserved, resource.BinarySI).String(),
+							"cpu":    resource.NewMilliQuantity(cpuReserved, resource.DecimalSI).String(),
+						},
+						"evictionHard": map[string]interface{}{
+							"memory.available": resource.NewQuantity(hardEviction, resource.BinarySI).String(),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	un := &unstructured.Unstructured{}
+	err := scheme.Scheme.Convert(kc, un, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return un, nil
+}
+
+// SetupWithManager setup our mananger
+func (r *NodeSizingReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}).
+		Watches(&source.Kind{Type: &corev1.Node{}}, &handler.EnqueueRequestForObject{}).
+		Named(controllers.NodeSizingControllerName).
+		Complete(r)
+}
@@ -0,0 +1,155 @@
+package nodesizing
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mcv1 "github.com/openshift/machine-config-operator/pkg/apis/machineconfiguration.openshift.io/v1"
+	fakemcoclient "github.com/openshift/machine-config-operator/pkg/generated/clientset/versioned/fake"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+	mock_dynamichelper "github.com/Azure/ARO-RP/pkg/util/mocks/dynamichelper"
+)
+
+func node(name, memory, cpu, allocatableMemory, allocatableCPU string) corev1.Node {
+	return corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Status: corev1.NodeStatus{
+			Capacity: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse(memory),
+				corev1.ResourceCPU:    resource.MustParse(cpu),
+			},
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse(allocatableMemory),
+				corev1.ResourceCPU:    resource.MustParse(allocatableCPU),
+			},
+		},
+	}
+}
+
+func quantity(s string) int64 {
+	q := resource.MustParse(s)
+	return q.Value()
+}
+
+func TestSystemReserved(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		nodes         []corev1.Node
+		wantMemory    string
+		wantCPUMillis int64
+	}{
+		{
+			name: "small node gets the minimum",
+			nodes: []corev1.Node{
+				node("worker-1", "8Gi", "2", "8Gi", "2"),
+			},
+			wantMemory:    "2000Mi",
+			wantCPUMillis: 500,
+		},
+		{
+			name: "Standard_D4s_v3",
+			nodes: []corev1.Node{
+				node("worker-1", "16Gi", "4", "16Gi", "4"),
+			},
+			wantMemory:    "2662Mi",
+			wantCPUMillis: 500,
+		},
+		{
+			name: "largest node counts",
+			nodes: []corev1.Node{
+				node("worker-1", "16Gi", "4", "16Gi", "4"),
+				node("worker-2", "432Gi", "64", "432Gi", "64"),
+				node("worker-3", "16Gi", "416", "16Gi", "416"),
+			},
+			wantMemory:    "15768Mi",
+			wantCPUMillis: 1110,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			memory, cpu := systemReserved(tt.nodes)
+
+			if memory != quantity(tt.wantMemory) {
+				t.Error(resource.NewQuantity(memory, resource.BinarySI))
+			}
+			if cpu != tt.wantCPUMillis {
+				t.Error(cpu)
+			}
+		})
+	}
+}
+
+func TestNotApplied(t *testing.T) {
+	memoryReserved := quantity("2662Mi")
+
+	nodes := []corev1.Node{
+		// 16Gi - 2662Mi - 500Mi, 4 - 500m
+		node("worker-applied", "16Gi", "4", "13222Mi", "3500m"),
+		node("worker-default", "16Gi", "4", "15Gi", "3500m"),
+	}
+
+	got := notApplied(nodes, memoryReserved, 500)
+	if !reflect.DeepEqual(got, []string{"worker-default"}) {
+		t.Error(got)
+	}
+}
+
+func TestEnsure(t *testing.T) {
+	ctx := context.Background()
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mcocli := fakemcoclient.NewSimpleClientset(&mcv1.MachineConfigPool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "worker",
+		},
+	})
+
+	dh := mock_dynamichelper.NewMockInterface(controller)
+	dh.EXPECT().Ensure(gomock.Any(), gomock.Any()).Do(func(ctx context.Context, uns ...*unstructured.Unstructured) {
+		if len(uns) != 1 || uns[0].GetName() != kubeletConfigName {
+			t.Fatal(uns)
+		}
+
+		systemReserved, _, _ := unstructured.NestedStringMap(uns[0].Object, "spec", "kubeletConfig", "systemReserved")
+		if !reflect.DeepEqual(systemReserved, map[string]string{"memory": "2662Mi", "cpu": "500m"}) {
+			t.Error(systemReserved)
+		}
+
+		evictionHard, _, _ := unstructured.NestedStringMap(uns[0].Object, "spec", "kubeletConfig", "evictionHard")
+		if !reflect.DeepEqual(evictionHard, map[string]string{"memory.available": "500Mi"}) {
+			t.Error(evictionHard)
+		}
+	}).Return(nil)
+
+	r := &NodeSizingReconciler{
+		mcocli: mcocli,
+		log:    utillog.GetLogger(),
+	}
+
+	err := r.ensure(ctx, dh, quantity("2662Mi"), 500)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mcp, err := mcocli.MachineconfigurationV1().MachineConfigPools().Get(ctx, "worker", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := mcp.Labels[labelName]; !ok {
+		t.Error(mcp.Labels)
+	}
+}
@@ -0,0 +1,346 @@
+package podsupervisor
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+)
+
+const (
+	// resyncInterval is how often the managed namespaces are checked for
+	// crash looping pods
+	resyncInterval = 2 * time.Minute
+
+	// maxRemediationAttempts is the number of times the pods of a workload
+	// are remediated before it is reported as persistently failing
+	maxRemediationAttempts = 3
+
+	// remediationBackoff is the wait after the first remediation of a
+	// workload before the next one; it doubles after each attempt
+	remediationBackoff = 5 * time.Minute
+
+	maxTerminationMessageLength = 256
+)
+
+// managedNamespaces are the namespaces of the pods which the operator
+// deploys.  The operator's own namespace is left out: a crash looping
+// operator cannot supervise itself.
+var managedNamespaces = []string{
+	"openshift-azure-ifreload",
+	"openshift-azure-logging",
+	"openshift-azure-nodeproblemdetector",
+	"openshift-azure-routefix",
+}
+
+// remediation tracks the remediation attempts of a crash looping workload
+type remediation struct {
+	attempts int
+	next     time.Time
+}
+
+// PodSupervisorReconciler remediates the ARO-managed pods which are in
+// CrashLoopBackOff, and reports those which keep crash looping after a
+// bounded number of attempts
+type PodSupervisorReconciler struct {
+	kubernetescli kubernetes.Interface
+	arocli        aroclient.AroV1alpha1Interface
+	recorder      record.EventRecorder
+	log           *logrus.Entry
+
+	now func() time.Time
+
+	remediations map[string]*remediation
+}
+
+func NewReconciler(log *logrus.Entry, kubernetescli kubernetes.Interface, arocli aroclient.AroV1alpha1Interface, recorder record.EventRecorder) *PodSupervisorReconciler {
+	return &PodSupervisorReconciler{
+		kubernetescli: kubernetescli,
+		arocli:        arocli,
+		recorder:      recorder,
+		log:           log,
+
+		now: time.Now,
+
+		remediations: map[string]*remediation{},
+	}
+}
+
+// Reconcile remediates the crash looping pods of each managed workload, with
+// backoff between attempts.  The first attempt deletes the pods; later
+// attempts also delete the configmaps and secrets which the pods mount and
+// which the operator renders, so that the owning controller renders them
+// again.  The remediation state is kept in memory: an operator restart gives
+// every workload a fresh set of attempts.
+func (r *PodSupervisorReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
+	// TODO(mj): controller-runtime master fixes the need for this (https://github.com/kubernetes-sigs/controller-runtime/blob/master/pkg/reconcile/reconcile.go#L93) but it's not yet released.
+	ctx := context.Background()
+
+	instance, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	if !controllers.FlagEnabled(instance, operator.FlagPodSupervisorEnabled) {
+		r.log.Debug("pod supervisor is disabled")
+		return reconcile.Result{}, nil
+	}
+
+	workloads := map[string][]corev1.Pod{}
+	for _, namespace := range managedNamespaces {
+		pods, err := r.kubernetescli.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			r.log.Error(err)
+			return reconcile.Result{}, err
+		}
+
+		for _, pod := range pods.Items {
+			if crashLoopingContainer(&pod) != nil {
+				key := workloadKey(&pod)
+				workloads[key] = append(workloads[key], pod)
+			}
+		}
+	}
+
+	// workloads which are no longer crash looping have recovered
+	for key := range r.remediations {
+		if _, found := workloads[key]; !found {
+			r.log.Printf("%s recovered", key)
+			delete(r.remediations, key)
+		}
+	}
+
+	keys := make([]string, 0, len(workloads))
+	for key := range workloads {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var failing []string
+	for _, key := range keys {
+		pods := workloads[key]
+
+		rem := r.remediations[key]
+		if rem == nil {
+			rem = &remediation{}
+			r.remediations[key] = rem
+		}
+
+		if rem.attempts >= maxRemediationAttempts {
+			failing = append(failing, fmt.Sprintf("%s (%s)", key, terminationMessage(&pods[0])))
+			continue
+		}
+
+		if r.now().Before(rem.next) {
+			continue
+		}
+
+		rem.attempts++
+		rem.next = r.now().Add(remediationBackoff << (rem.attempts - 1))
+
+		r.log.Printf("remediating %s (attempt %d of %d)", key, rem.attempts, maxRemediationAttempts)
+		r.recorder.Eventf(instance, corev1.EventTypeWarning, "CrashLoopRemediated", "%s is crash looping (%s): remediation attempt %d of %d", key, terminationMessage(&pods[0]), rem.attempts, maxRemediationAttempts)
+
+		err = r.remediate(ctx, pods, rem.attempts > 1)
+		if err != nil {
+			r.log.Error(err)
+			return reconcile.Result{}, err
+		}
+	}
+
+	err = r.setCondition(ctx, failing)
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: resyncInterval}, nil
+}
+
+// remediate deletes pods and, if rerender is set, the configmaps and secrets
+// which they mount and which are controlled by the Cluster
+func (r *PodSupervisorReconciler) remediate(ctx context.Context, pods []corev1.Pod, rerender bool) error {
+	if rerender {
+		configMaps, secrets := mountedConfig(pods)
+
+		for _, name := range configMaps {
+			cm, err := r.kubernetescli.CoreV1().ConfigMaps(pods[0].Namespace).Get(ctx, name, metav1.GetOptions{})
+			if kerrors.IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			if !controlledByCluster(&cm.ObjectMeta) {
+				continue
+			}
+
+			r.log.Printf("deleting configmap %s/%s", cm.Namespace, cm.Name)
+			err = r.kubernetescli.CoreV1().ConfigMaps(cm.Namespace).Delete(ctx, cm.Name, metav1.DeleteOptions{})
+			if err != nil && !kerrors.IsNotFound(err) {
+				return err
+			}
+		}
+
+		for _, name := range secrets {
+			s, err := r.kubernetescli.CoreV1().Secrets(pods[0].Namespace).Get(ctx, name, metav1.GetOptions{})
+			if kerrors.IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			if !controlledByCluster(&s.ObjectMeta) {
+				continue
+			}
+
+			r.log.Printf("deleting secret %s/%s", s.Namespace, s.Name)
+			err = r.kubernetescli.CoreV1().Secrets(s.Namespace).Delete(ctx, s.Name, metav1.DeleteOptions{})
+			if err != nil && !kerrors.IsNotFound(err) {
+				return err
+			}
+		}
+	}
+
+	for _, pod := range pods {
+		r.log.Printf("deleting pod %s/%s", pod.Namespace, pod.Name)
+		err := r.kubernetescli.CoreV1().Pods(pod.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{})
+		if err != nil && !kerrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *PodSupervisorReconciler) setCondition(ctx context.Context, failing []string) error {
+	cond := &status.Condition{
+		Type:    arov1alpha1.ManagedPodsNotCrashLooping,
+		Status:  corev1.ConditionTrue,
+		Message: "no managed pods are persistently crash looping",
+		Reason:  "CheckDone",
+	}
+	if len(failing) > 0 {
+		cond.Status = corev1.ConditionFalse
+		cond.Reason = "CheckFailed"
+		cond.Message = fmt.Sprintf("still crash looping after %d remediation attempts: %s", maxRemediationAttempts, strings.Join(failing, "; "))
+	}
+	return controllers.SetCondition(ctx, r.arocli, r.recorder, cond, operator.RoleMaster)
+}
+
+// crashLoopingContainer returns the status of the first container of pod
+// which is in CrashLoopBackOff, or nil if there is none
+func crashLoopingContainer(pod *corev1.Pod) *corev1.ContainerStatus {
+	for _, statuses := range [][]corev1.ContainerStatus{pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses} {
+		for i := range statuses {
+			if statuses[i].State.Waiting != nil && statuses[i].State.Waiting.Reason == "CrashLoopBackOff" {
+				return &statuses[i]
+			}
+		}
+	}
+
+	return nil
+}
+
+// terminationMessage returns the termination message of the last crash of
+// the crash looping container of pod, or its exit code if it has none
+func terminationMessage(pod *corev1.Pod) string {
+	cs := crashLoopingContainer(pod)
+	if cs == nil {
+		return ""
+	}
+
+	message := "no termination state"
+	if t := cs.LastTerminationState.Terminated; t != nil {
+		message = strings.TrimSpace(t.Message)
+		if message == "" {
+			message = fmt.Sprintf("exit code %d (%s)", t.ExitCode, t.Reason)
+		}
+	}
+
+	if len(message) > maxTerminationMessageLength {
+		message = message[:maxTerminationMessageLength] + "..."
+	}
+
+	return fmt.Sprintf("container %s: %s", cs.Name, message)
+}
+
+// workloadKey identifies the workload which pod belongs to, so that the
+// replacement pods of a workload share its remediation attempts
+func workloadKey(pod *corev1.Pod) string {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		return fmt.Sprintf("%s/Pod/%s", pod.Namespace, pod.Name)
+	}
+
+	kind, name := owner.Kind, owner.Name
+	if hash, ok := pod.Labels["pod-template-hash"]; ok && kind == "ReplicaSet" {
+		kind, name = "Deployment", strings.TrimSuffix(name, "-"+hash)
+	}
+
+	return fmt.Sprintf("%s/%s/%s", pod.Namespace, kind, name)
+}
+
+// mountedConfig returns the names of the configmaps and secrets mounted by
+// pods
+func mountedConfig(pods []corev1.Pod) (configMaps []string, secrets []string) {
+	seen := map[string]struct{}{}
+
+	for _, pod := range pods {
+		for _, v := range pod.Spec.Volumes {
+			switch {
+			case v.ConfigMap != nil:
+				if _, found := seen["cm/"+v.ConfigMap.Name]; !found {
+					seen["cm/"+v.ConfigMap.Name] = struct{}{}
+					configMaps = append(configMaps, v.ConfigMap.Name)
+				}
+			case v.Secret != nil:
+				if _, found := seen["secret/"+v.Secret.SecretName]; !found {
+					seen["secret/"+v.Secret.SecretName] = struct{}{}
+					secrets = append(secrets, v.Secret.SecretName)
+				}
+			}
+		}
+	}
+
+	return configMaps, secrets
+}
+
+// controlledByCluster returns true if meta is rendered by an operator
+// controller, which renders it again once it is deleted
+func controlledByCluster(meta *metav1.ObjectMeta) bool {
+	owner := metav1.GetControllerOfNoCopy(meta)
+	return owner != nil &&
+		owner.APIVersion == arov1alpha1.GroupVersion.String() &&
+		owner.Kind == "Cluster"
+}
+
+// SetupWithManager setup our mananger
+func (r *PodSupervisorReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}).
+		Named(controllers.PodSupervisorControllerName).
+		Complete(r)
+}
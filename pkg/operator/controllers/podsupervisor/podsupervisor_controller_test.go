@@ -0,0 +1,289 @@
+package podsupervisor
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+)
+
+func crashLoopingPod(name string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "openshift-azure-logging",
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: "apps/v1",
+					Kind:       "DaemonSet",
+					Name:       "mdsd",
+					Controller: boolPtr(true),
+				},
+			},
+		},
+		Spec: corev1.PodSpec{
+			Volumes: []corev1.Volume{
+				{
+					Name: "config",
+					VolumeSource: corev1.VolumeSource{
+						ConfigMap: &corev1.ConfigMapVolumeSource{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "mdsd-config",
+							},
+						},
+					},
+				},
+				{
+					Name: "certificates",
+					VolumeSource: corev1.VolumeSource{
+						Secret: &corev1.SecretVolumeSource{
+							SecretName: "certificates",
+						},
+					},
+				},
+			},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name: "mdsd",
+					State: corev1.ContainerState{
+						Waiting: &corev1.ContainerStateWaiting{
+							Reason: "CrashLoopBackOff",
+						},
+					},
+					LastTerminationState: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{
+							ExitCode: 1,
+							Message:  "invalid configuration\n",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+
+	cluster := &arov1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: arov1alpha1.SingletonClusterName,
+			UID:  "cluster-uid",
+		},
+	}
+
+	clusterOwner := []metav1.OwnerReference{
+		{
+			APIVersion: arov1alpha1.GroupVersion.String(),
+			Kind:       "Cluster",
+			Name:       arov1alpha1.SingletonClusterName,
+			UID:        "cluster-uid",
+			Controller: boolPtr(true),
+		},
+	}
+
+	kubernetescli := fake.NewSimpleClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "mdsd-config",
+				Namespace:       "openshift-azure-logging",
+				OwnerReferences: clusterOwner,
+			},
+		},
+		// not rendered by the operator, so it must not be deleted
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "certificates",
+				Namespace: "openshift-azure-logging",
+			},
+		},
+		// healthy pods and pods outside the managed namespaces are left alone
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "mdsd-healthy",
+				Namespace: "openshift-azure-logging",
+			},
+		},
+	)
+	otherPod := crashLoopingPod("customer")
+	otherPod.Namespace = "customer"
+	_, err := kubernetescli.CoreV1().Pods(otherPod.Namespace).Create(ctx, otherPod, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	arocli := arofake.NewSimpleClientset(cluster)
+
+	now := time.Now()
+
+	r := NewReconciler(utillog.GetLogger(), kubernetescli, arocli.AroV1alpha1(), record.NewFakeRecorder(100))
+	r.now = func() time.Time { return now }
+
+	// reconcile recreates the crash looping pod, as its daemonset would, and
+	// runs the reconciler after wait
+	reconcile := func(wait time.Duration) {
+		now = now.Add(wait)
+
+		_, err := kubernetescli.CoreV1().Pods("openshift-azure-logging").Get(ctx, "mdsd-abcde", metav1.GetOptions{})
+		if err != nil {
+			_, err = kubernetescli.CoreV1().Pods("openshift-azure-logging").Create(ctx, crashLoopingPod("mdsd-abcde"), metav1.CreateOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		_, err = r.Reconcile(ctrl.Request{})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	podExists := func(namespace, name string) bool {
+		_, err := kubernetescli.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+		return err == nil
+	}
+
+	condition := func() *arov1alpha1.Cluster {
+		c, err := arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+
+	const key = "openshift-azure-logging/DaemonSet/mdsd"
+
+	// first attempt: the pod is deleted, its configuration is kept
+	reconcile(0)
+	if podExists("openshift-azure-logging", "mdsd-abcde") {
+		t.Error("pod not deleted")
+	}
+	if _, err := kubernetescli.CoreV1().ConfigMaps("openshift-azure-logging").Get(ctx, "mdsd-config", metav1.GetOptions{}); err != nil {
+		t.Error(err)
+	}
+	if r.remediations[key].attempts != 1 {
+		t.Error(r.remediations[key])
+	}
+
+	// within the backoff: nothing is done
+	reconcile(time.Minute)
+	if !podExists("openshift-azure-logging", "mdsd-abcde") {
+		t.Error("pod deleted within the backoff")
+	}
+
+	// second attempt: the operator-rendered configuration is deleted too
+	reconcile(remediationBackoff)
+	if podExists("openshift-azure-logging", "mdsd-abcde") {
+		t.Error("pod not deleted")
+	}
+	if _, err := kubernetescli.CoreV1().ConfigMaps("openshift-azure-logging").Get(ctx, "mdsd-config", metav1.GetOptions{}); err == nil {
+		t.Error("configmap not deleted")
+	}
+	if _, err := kubernetescli.CoreV1().Secrets("openshift-azure-logging").Get(ctx, "certificates", metav1.GetOptions{}); err != nil {
+		t.Error(err)
+	}
+
+	// third attempt, after the doubled backoff
+	reconcile(2 * remediationBackoff)
+	if r.remediations[key].attempts != 3 {
+		t.Error(r.remediations[key])
+	}
+	if c := condition().Status.Conditions.GetCondition(arov1alpha1.ManagedPodsNotCrashLooping); c.Status != corev1.ConditionTrue {
+		t.Error(c)
+	}
+
+	// attempts exhausted: the workload is reported
+	reconcile(4 * remediationBackoff)
+	if !podExists("openshift-azure-logging", "mdsd-abcde") {
+		t.Error("pod deleted after the attempts are exhausted")
+	}
+	c := condition().Status.Conditions.GetCondition(arov1alpha1.ManagedPodsNotCrashLooping)
+	if c.Status != corev1.ConditionFalse ||
+		!strings.Contains(c.Message, key+" (container mdsd: invalid configuration)") {
+		t.Error(c)
+	}
+
+	if !podExists("customer", "customer") || !podExists("openshift-azure-logging", "mdsd-healthy") {
+		t.Error("unmanaged or healthy pod deleted")
+	}
+
+	// recovery resets the attempts and the condition
+	err = kubernetescli.CoreV1().Pods("openshift-azure-logging").Delete(ctx, "mdsd-abcde", metav1.DeleteOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = r.Reconcile(ctrl.Request{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, found := r.remediations[key]; found {
+		t.Error(r.remediations)
+	}
+	if c := condition().Status.Conditions.GetCondition(arov1alpha1.ManagedPodsNotCrashLooping); c.Status != corev1.ConditionTrue {
+		t.Error(c)
+	}
+}
+
+func TestWorkloadKey(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		pod  *corev1.Pod
+		want string
+	}{
+		{
+			name: "unowned pod",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pod",
+					Namespace: "ns",
+				},
+			},
+			want: "ns/Pod/pod",
+		},
+		{
+			name: "deployment pod",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "deployment-5d4f8c9b7-abcde",
+					Namespace: "ns",
+					Labels: map[string]string{
+						"pod-template-hash": "5d4f8c9b7",
+					},
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							Kind:       "ReplicaSet",
+							Name:       "deployment-5d4f8c9b7",
+							Controller: boolPtr(true),
+						},
+					},
+				},
+			},
+			want: "ns/Deployment/deployment",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := workloadKey(tt.pod); got != tt.want {
+				t.Error(got)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+package priorityclass
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+)
+
+// NodeCritical is the PriorityClass of the managed daemonsets which must run
+// on every node.  Its value is the highest which a PriorityClass other than
+// the system ones may have, so that neither preemption nor the kubelet's
+// eviction under node pressure picks the managed pods over customer pods.
+const NodeCritical = "aro-node-critical"
+
+// resyncInterval is how often the managed workloads are checked for their
+// PriorityClass
+const resyncInterval = 10 * time.Minute
+
+// priorityClasses are the PriorityClasses which the controller keeps in place
+var priorityClasses = []*schedulingv1.PriorityClass{
+	{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: NodeCritical,
+		},
+		Value:       1000000000,
+		Description: "Used for Azure Red Hat OpenShift managed pods which must run on every node.",
+	},
+}
+
+// managedNamespaces are the namespaces of the daemonsets and deployments
+// which the operator deploys, including the operator itself
+var managedNamespaces = []string{
+	"openshift-azure-logging",
+	"openshift-azure-nodeproblemdetector",
+	"openshift-azure-operator",
+	"openshift-azure-routefix",
+}
+
+// PriorityClassReconciler keeps the ARO PriorityClasses in place and reports
+// managed workloads which don't use one
+type PriorityClassReconciler struct {
+	kubernetescli kubernetes.Interface
+	arocli        aroclient.AroV1alpha1Interface
+	recorder      record.EventRecorder
+	log           *logrus.Entry
+}
+
+func NewReconciler(log *logrus.Entry, kubernetescli kubernetes.Interface, arocli aroclient.AroV1alpha1Interface, recorder record.EventRecorder) *PriorityClassReconciler {
+	return &PriorityClassReconciler{
+		kubernetescli: kubernetescli,
+		arocli:        arocli,
+		recorder:      recorder,
+		log:           log,
+	}
+}
+
+// +kubebuilder:rbac:groups=scheduling.k8s.io,resources=priorityclasses,verbs=get;list;watch;create;update;delete
+// +kubebuilder:rbac:groups=apps,resources=daemonsets;deployments,verbs=get;list;watch
+
+// Reconcile creates the ARO PriorityClasses, recreating any whose value was
+// changed (the value of a PriorityClass is immutable), and sets the
+// PriorityClassesValid condition to False if a managed daemonset or deployment
+// doesn't use an ARO or system PriorityClass.  There is no flag to switch
+// the controller off: the managed daemonsets can't schedule pods without
+// their PriorityClass.
+func (r *PriorityClassReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
+	// TODO(mj): controller-runtime master fixes the need for this (https://github.com/kubernetes-sigs/controller-runtime/blob/master/pkg/reconcile/reconcile.go#L93) but it's not yet released.
+	ctx := context.Background()
+
+	instance, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	for _, pc := range priorityClasses {
+		err = r.ensure(ctx, instance, pc)
+		if err != nil {
+			r.log.Error(err)
+			return reconcile.Result{}, err
+		}
+	}
+
+	unprioritized, err := r.unprioritizedWorkloads(ctx)
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	err = r.setCondition(ctx, unprioritized)
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: resyncInterval}, nil
+}
+
+func (r *PriorityClassReconciler) ensure(ctx context.Context, instance *arov1alpha1.Cluster, want *schedulingv1.PriorityClass) error {
+	want = want.DeepCopy()
+	want.OwnerReferences = []metav1.OwnerReference{
+		*metav1.NewControllerRef(instance, arov1alpha1.GroupVersion.WithKind("Cluster")),
+	}
+
+	pc, err := r.kubernetescli.SchedulingV1().PriorityClasses().Get(ctx, want.Name, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		r.log.Printf("creating PriorityClass %s", want.Name)
+		_, err = r.kubernetescli.SchedulingV1().PriorityClasses().Create(ctx, want, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	if pc.Value != want.Value {
+		r.log.Printf("recreating PriorityClass %s with value %d", want.Name, want.Value)
+		r.recorder.Eventf(instance, corev1.EventTypeWarning, "PriorityClassRecreated", "PriorityClass %s had value %d and was recreated with value %d", want.Name, pc.Value, want.Value)
+
+		err = r.kubernetescli.SchedulingV1().PriorityClasses().Delete(ctx, want.Name, metav1.DeleteOptions{})
+		if err != nil && !kerrors.IsNotFound(err) {
+			return err
+		}
+
+		_, err = r.kubernetescli.SchedulingV1().PriorityClasses().Create(ctx, want, metav1.CreateOptions{})
+		return err
+	}
+
+	if pc.Description == want.Description &&
+		pc.GlobalDefault == want.GlobalDefault &&
+		metav1.IsControlledBy(pc, instance) {
+		return nil
+	}
+
+	pc.Description = want.Description
+	pc.GlobalDefault = want.GlobalDefault
+	pc.OwnerReferences = want.OwnerReferences
+
+	_, err = r.kubernetescli.SchedulingV1().PriorityClasses().Update(ctx, pc, metav1.UpdateOptions{})
+	return err
+}
+
+// unprioritizedWorkloads returns the managed daemonsets and deployments whose
+// pods don't use an ARO or system PriorityClass, and so may be preempted or
+// evicted in favour of customer pods
+func (r *PriorityClassReconciler) unprioritizedWorkloads(ctx context.Context) ([]string, error) {
+	var unprioritized []string
+
+	for _, namespace := range managedNamespaces {
+		daemonsets, err := r.kubernetescli.AppsV1().DaemonSets(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ds := range daemonsets.Items {
+			if !prioritized(ds.Spec.Template.Spec.PriorityClassName) {
+				unprioritized = append(unprioritized, fmt.Sprintf("%s/DaemonSet/%s", ds.Namespace, ds.Name))
+			}
+		}
+
+		deployments, err := r.kubernetescli.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, d := range deployments.Items {
+			if !prioritized(d.Spec.Template.Spec.PriorityClassName) {
+				unprioritized = append(unprioritized, fmt.Sprintf("%s/Deployment/%s", d.Namespace, d.Name))
+			}
+		}
+	}
+
+	return unprioritized, nil
+}
+
+// prioritized returns true if name is an ARO or system PriorityClass
+func prioritized(name string) bool {
+	if strings.HasPrefix(name, "system-") {
+		return true
+	}
+
+	for _, pc := range priorityClasses {
+		if name == pc.Name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *PriorityClassReconciler) setCondition(ctx context.Context, unprioritized []string) error {
+	cond := &status.Condition{
+		Type:    arov1alpha1.PriorityClassesValid,
+		Status:  corev1.ConditionTrue,
+		Message: "managed workloads use the ARO or system PriorityClasses",
+		Reason:  "CheckDone",
+	}
+	if len(unprioritized) > 0 {
+		cond.Status = corev1.ConditionFalse
+		cond.Reason = "CheckFailed"
+		cond.Message = "managed workloads without an ARO or system PriorityClass: " + strings.Join(unprioritized, ", ")
+	}
+	return controllers.SetCondition(ctx, r.arocli, r.recorder, cond, operator.RoleMaster)
+}
+
+// SetupWithManager setup our mananger
+func (r *PriorityClassReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}).
+		Owns(&schedulingv1.PriorityClass{}).
+		Named(controllers.PriorityClassControllerName).
+		Complete(r)
+}
@@ -0,0 +1,147 @@
+package priorityclass
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+)
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+
+	daemonset := func(namespace, name, priorityClassName string) *appsv1.DaemonSet {
+		return &appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			Spec: appsv1.DaemonSetSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						PriorityClassName: priorityClassName,
+					},
+				},
+			},
+		}
+	}
+
+	deployment := func(namespace, name, priorityClassName string) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						PriorityClassName: priorityClassName,
+					},
+				},
+			},
+		}
+	}
+
+	for _, tt := range []struct {
+		name        string
+		objects     []runtime.Object
+		wantStatus  corev1.ConditionStatus
+		wantMessage string
+	}{
+		{
+			name: "PriorityClass is created",
+			objects: []runtime.Object{
+				daemonset("openshift-azure-logging", "mdsd", NodeCritical),
+				deployment("openshift-azure-operator", "aro-operator-master", "system-cluster-critical"),
+			},
+			wantStatus:  corev1.ConditionTrue,
+			wantMessage: "managed workloads use the ARO or system PriorityClasses",
+		},
+		{
+			name: "PriorityClass with a changed value is recreated",
+			objects: []runtime.Object{
+				&schedulingv1.PriorityClass{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: NodeCritical,
+					},
+					Value: 0,
+				},
+			},
+			wantStatus:  corev1.ConditionTrue,
+			wantMessage: "managed workloads use the ARO or system PriorityClasses",
+		},
+		{
+			name: "unprioritized workloads are reported",
+			objects: []runtime.Object{
+				daemonset("openshift-azure-logging", "mdsd", ""),
+				daemonset("openshift-azure-routefix", "routefix", NodeCritical),
+				deployment("openshift-azure-operator", "aro-operator-master", "customer-high"),
+				// not managed
+				daemonset("customer", "customer", ""),
+			},
+			wantStatus:  corev1.ConditionFalse,
+			wantMessage: "managed workloads without an ARO or system PriorityClass: openshift-azure-logging/DaemonSet/mdsd, openshift-azure-operator/Deployment/aro-operator-master",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+			})
+			kubernetescli := fake.NewSimpleClientset(tt.objects...)
+
+			r := NewReconciler(utillog.GetLogger(), kubernetescli, arocli.AroV1alpha1(), record.NewFakeRecorder(10))
+
+			result, err := r.Reconcile(ctrl.Request{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result != (reconcile.Result{RequeueAfter: resyncInterval}) {
+				t.Error(result)
+			}
+
+			pc, err := kubernetescli.SchedulingV1().PriorityClasses().Get(ctx, NodeCritical, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if pc.Value != 1000000000 {
+				t.Error(pc.Value)
+			}
+			if len(pc.OwnerReferences) != 1 || pc.OwnerReferences[0].Kind != "Cluster" {
+				t.Error(pc.OwnerReferences)
+			}
+
+			cluster, err := arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cond := cluster.Status.Conditions.GetCondition(arov1alpha1.PriorityClassesValid)
+			if cond == nil {
+				t.Fatal("condition not set")
+			}
+			if cond.Status != tt.wantStatus {
+				t.Error(cond.Status)
+			}
+			if cond.Message != tt.wantMessage {
+				t.Error(cond.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package proxy
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+// requiredNoProxy returns the destinations which must bypass the cluster-wide
+// proxy: the Azure platform endpoints used by the nodes, and the ACR from
+// which the cluster pulls its release and ARO images
+func requiredNoProxy(cluster *arov1alpha1.Cluster) []string {
+	noProxy := []string{
+		"168.63.129.16",   // Azure wireserver
+		"169.254.169.254", // Azure instance metadata service
+	}
+
+	if cluster.Spec.ACRDomain != "" {
+		noProxy = append(noProxy, cluster.Spec.ACRDomain)
+	}
+
+	return noProxy
+}
+
+// check returns the problems found with the proxy configuration.  A non-nil
+// error means that the check itself could not be completed.
+func (r *ProxyReconciler) check(ctx context.Context, cluster *arov1alpha1.Cluster) ([]error, error) {
+	proxy, err := r.configcli.ConfigV1().Proxies().Get(ctx, "cluster", metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if proxy.Spec.HTTPProxy == "" && proxy.Spec.HTTPSProxy == "" {
+		return nil, nil
+	}
+
+	err = r.ensureNoProxy(ctx, requiredNoProxy(cluster))
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []error
+	for i, proxyURL := range []string{proxy.Spec.HTTPProxy, proxy.Spec.HTTPSProxy} {
+		if proxyURL == "" || (i == 1 && proxyURL == proxy.Spec.HTTPProxy) {
+			continue
+		}
+
+		err = r.checkReachable(ctx, proxyURL)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs, nil
+}
+
+// ensureNoProxy adds any of the required entries which are missing to the
+// noProxy list of the cluster Proxy, leaving the customer's entries alone
+func (r *ProxyReconciler) ensureNoProxy(ctx context.Context, required []string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		proxy, err := r.configcli.ConfigV1().Proxies().Get(ctx, "cluster", metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		var entries []string
+		for _, entry := range strings.Split(proxy.Spec.NoProxy, ",") {
+			entry = strings.TrimSpace(entry)
+			if entry != "" {
+				entries = append(entries, entry)
+			}
+		}
+
+		var missing []string
+		for _, host := range required {
+			if !noProxyCovers(entries, host) {
+				missing = append(missing, host)
+			}
+		}
+
+		if len(missing) == 0 {
+			return nil
+		}
+
+		r.log.Infof("adding missing noProxy entries %v", missing)
+
+		proxy.Spec.NoProxy = strings.Join(append(entries, missing...), ",")
+
+		_, err = r.configcli.ConfigV1().Proxies().Update(ctx, proxy, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// noProxyCovers returns true if host is matched by one of the noProxy
+// entries, either exactly or by a domain suffix entry such as ".azurecr.io"
+func noProxyCovers(entries []string, host string) bool {
+	for _, entry := range entries {
+		if entry == "*" ||
+			strings.EqualFold(entry, host) ||
+			strings.HasPrefix(entry, ".") && strings.HasSuffix(strings.ToLower(host), strings.ToLower(entry)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkReachable tries to open a TCP connection to the proxy.  Proxy URLs may
+// contain credentials, so only the host and port are included in errors.
+func (r *ProxyReconciler) checkReachable(ctx context.Context, proxyURL string) error {
+	u, err := url.Parse(proxyURL)
+	if err != nil || u.Hostname() == "" {
+		return fmt.Errorf("proxy URL is invalid")
+	}
+
+	port := u.Port()
+	if port == "" {
+		switch u.Scheme {
+		case "https":
+			port = "443"
+		default:
+			port = "80"
+		}
+	}
+
+	hostPort := net.JoinHostPort(u.Hostname(), port)
+
+	conn, err := r.dialContext(ctx, "tcp", hostPort)
+	if err != nil {
+		return fmt.Errorf("proxy %s is unreachable: %v", hostPort, err)
+	}
+
+	return conn.Close()
+}
@@ -0,0 +1,107 @@
+package proxy
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net"
+	"strings"
+	"time"
+
+	configclient "github.com/openshift/client-go/config/clientset/versioned"
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+)
+
+// ProxyReconciler validates the cluster-wide proxy configuration, if any, and
+// keeps the noProxy entries which ARO depends on in place
+type ProxyReconciler struct {
+	configcli configclient.Interface
+	arocli    aroclient.AroV1alpha1Interface
+	recorder  record.EventRecorder
+	log       *logrus.Entry
+
+	dialContext func(context.Context, string, string) (net.Conn, error)
+}
+
+func NewReconciler(log *logrus.Entry, configcli configclient.Interface, arocli aroclient.AroV1alpha1Interface, recorder record.EventRecorder) *ProxyReconciler {
+	return &ProxyReconciler{
+		configcli: configcli,
+		arocli:    arocli,
+		recorder:  recorder,
+		log:       log,
+
+		dialContext: (&net.Dialer{Timeout: 10 * time.Second}).DialContext,
+	}
+}
+
+// +kubebuilder:rbac:groups=config.openshift.io,resources=proxies,verbs=get;update
+
+// Reconcile repairs the managed noProxy entries of the cluster Proxy, checks
+// that the configured proxies are reachable and reports the result in the
+// ProxyValid condition.
+func (r *ProxyReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
+	// TODO(mj): controller-runtime master fixes the need for this (https://github.com/kubernetes-sigs/controller-runtime/blob/master/pkg/reconcile/reconcile.go#L93) but it's not yet released.
+	ctx := context.Background()
+	if request.Name != arov1alpha1.SingletonClusterName {
+		return reconcile.Result{}, nil
+	}
+
+	cluster, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	errs, err := r.check(ctx, cluster)
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	cond := &status.Condition{
+		Type:    arov1alpha1.ProxyValid,
+		Status:  corev1.ConditionTrue,
+		Message: "proxy configuration valid",
+		Reason:  "CheckDone",
+	}
+
+	if len(errs) > 0 {
+		cond.Status = corev1.ConditionFalse
+		cond.Reason = "CheckFailed"
+
+		var sb strings.Builder
+		for _, err := range errs {
+			sb.WriteString(err.Error())
+			sb.WriteByte('\n')
+		}
+		cond.Message = sb.String()
+	}
+
+	err = controllers.SetCondition(ctx, r.arocli, r.recorder, cond, operator.RoleMaster)
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: time.Hour, Requeue: true}, nil
+}
+
+// SetupWithManager setup our mananger
+func (r *ProxyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}).
+		Named(controllers.ProxyControllerName).
+		Complete(r)
+}
@@ -16,6 +16,8 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
 )
 
 func TestPullSecretReconciler(t *testing.T) {
@@ -39,14 +41,15 @@ func TestPullSecretReconciler(t *testing.T) {
 		return fake.NewSimpleClientset(s, c)
 	}
 	tests := []struct {
-		name        string
-		request     ctrl.Request
-		fakecli     *fake.Clientset
-		wantErr     bool
-		want        string
-		wantCreated bool
-		wantDeleted bool
-		wantUpdated bool
+		name          string
+		request       ctrl.Request
+		fakecli       *fake.Clientset
+		operatorFlags map[string]string
+		wantErr       bool
+		want          string
+		wantCreated   bool
+		wantDeleted   bool
+		wantUpdated   bool
 	}{
 		{
 			name: "deleted pull secret",
@@ -56,6 +59,21 @@ func TestPullSecretReconciler(t *testing.T) {
 			want:        `{"auths":{"arosvc.azurecr.io":{"auth":"ZnJlZDplbnRlcg=="}}}`,
 			wantCreated: true,
 		},
+		{
+			name: "modified arosvc pull secret, repair disabled",
+			fakecli: newFakecli(&v1.Secret{
+				Data: map[string][]byte{
+					v1.DockerConfigJsonKey: []byte(`{"auths":{"arosvc.azurecr.io":{"auth":""}}}`),
+				},
+			}, &v1.Secret{
+				Data: map[string][]byte{
+					v1.DockerConfigJsonKey: []byte(`{"auths":{"arosvc.azurecr.io":{"auth":"ZnJlZDplbnRlcg=="}}}`),
+				}}),
+			operatorFlags: map[string]string{
+				operator.FlagPullSecretEnabled: "false",
+			},
+			want: `{"auths":{"arosvc.azurecr.io":{"auth":""}}}`,
+		},
 		{
 			name: "missing arosvc pull secret",
 			fakecli: newFakecli(&v1.Secret{}, &v1.Secret{Data: map[string][]byte{
@@ -131,8 +149,18 @@ func TestPullSecretReconciler(t *testing.T) {
 				return false, nil, nil
 			})
 
+			arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					OperatorFlags: tt.operatorFlags,
+				},
+			})
+
 			r := &PullSecretReconciler{
 				kubernetescli: tt.fakecli,
+				arocli:        arocli.AroV1alpha1(),
 				log:           logrus.NewEntry(logrus.StandardLogger()),
 			}
 			if tt.request.Name == "" {
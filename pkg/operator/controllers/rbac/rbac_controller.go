@@ -0,0 +1,233 @@
+package rbac
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+	"github.com/Azure/ARO-RP/pkg/operator/deploy"
+	_ "github.com/Azure/ARO-RP/pkg/util/scheme"
+)
+
+// driftReportPeriod is how long the RBACValid condition stays False after
+// drift was restored, so that the monitor gets to see it
+const driftReportPeriod = time.Hour
+
+// RBACReconciler re-applies the ClusterRoles and ClusterRoleBindings which the
+// RP deploys with the operator, and reports any drift it had to restore
+type RBACReconciler struct {
+	kubernetescli kubernetes.Interface
+	arocli        aroclient.AroV1alpha1Interface
+	recorder      record.EventRecorder
+	log           *logrus.Entry
+}
+
+func NewReconciler(log *logrus.Entry, kubernetescli kubernetes.Interface, arocli aroclient.AroV1alpha1Interface, recorder record.EventRecorder) *RBACReconciler {
+	return &RBACReconciler{
+		kubernetescli: kubernetescli,
+		arocli:        arocli,
+		recorder:      recorder,
+		log:           log,
+	}
+}
+
+// Reconcile makes sure that the ARO ClusterRoles and ClusterRoleBindings
+// exist and match the static resources of the operator
+func (r *RBACReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
+	// TODO(mj): controller-runtime master fixes the need for this (https://github.com/kubernetes-sigs/controller-runtime/blob/master/pkg/reconcile/reconcile.go#L93) but it's not yet released.
+	ctx := context.Background()
+
+	instance, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if !controllers.FlagEnabled(instance, operator.FlagRBACEnabled) {
+		r.log.Debug("rbac repair is disabled")
+		return reconcile.Result{}, nil
+	}
+
+	resources, err := deploy.RBAC()
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	var drifted []string
+	for _, o := range resources {
+		var drift string
+		switch o := o.(type) {
+		case *rbacv1.ClusterRole:
+			drift, err = r.ensureClusterRole(ctx, o)
+		case *rbacv1.ClusterRoleBinding:
+			drift, err = r.ensureClusterRoleBinding(ctx, o)
+		}
+		if err != nil {
+			r.log.Error(err)
+			return reconcile.Result{}, err
+		}
+
+		if drift != "" {
+			r.log.Warnf("restored %s", drift)
+			r.recorder.Eventf(instance, corev1.EventTypeWarning, "RBACRestored", "restored %s", drift)
+			drifted = append(drifted, drift)
+		}
+	}
+
+	cond := &status.Condition{
+		Type:    arov1alpha1.RBACValid,
+		Status:  corev1.ConditionTrue,
+		Message: "ARO RBAC is intact",
+		Reason:  "CheckDone",
+	}
+
+	if len(drifted) > 0 {
+		cond.Status = corev1.ConditionFalse
+		cond.Reason = "Restored"
+		cond.Message = fmt.Sprintf("restored %s", strings.Join(drifted, "; "))
+
+		return reconcile.Result{RequeueAfter: driftReportPeriod}, controllers.SetCondition(ctx, r.arocli, r.recorder, cond, operator.RoleMaster)
+	}
+
+	// leave a recent report of drift in place until the report period is up
+	previous := instance.Status.Conditions.GetCondition(arov1alpha1.RBACValid)
+	if previous != nil && previous.Status == corev1.ConditionFalse {
+		if remaining := driftReportPeriod - time.Since(previous.LastTransitionTime.Time); remaining > 0 {
+			return reconcile.Result{RequeueAfter: remaining}, nil
+		}
+	}
+
+	return reconcile.Result{}, controllers.SetCondition(ctx, r.arocli, r.recorder, cond, operator.RoleMaster)
+}
+
+// ensureClusterRole creates or restores the rules of cr.  It returns a
+// description of the drift it found, if any.
+func (r *RBACReconciler) ensureClusterRole(ctx context.Context, cr *rbacv1.ClusterRole) (drift string, err error) {
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		drift = ""
+
+		existing, err := r.kubernetescli.RbacV1().ClusterRoles().Get(ctx, cr.Name, metav1.GetOptions{})
+		switch {
+		case kerrors.IsNotFound(err):
+			drift = fmt.Sprintf("clusterrole %s, which was missing", cr.Name)
+			_, err = r.kubernetescli.RbacV1().ClusterRoles().Create(ctx, cr, metav1.CreateOptions{})
+			return err
+		case err != nil:
+			return err
+		}
+
+		if reflect.DeepEqual(existing.Rules, cr.Rules) {
+			return nil
+		}
+
+		drift = fmt.Sprintf("clusterrole %s, which was modified", cr.Name)
+		existing.Rules = cr.Rules
+		_, err = r.kubernetescli.RbacV1().ClusterRoles().Update(ctx, existing, metav1.UpdateOptions{})
+		return err
+	})
+
+	return drift, err
+}
+
+// ensureClusterRoleBinding creates or restores crb.  The role of a binding is
+// immutable, so a binding to another role is recreated.  It returns a
+// description of the drift it found, if any.
+func (r *RBACReconciler) ensureClusterRoleBinding(ctx context.Context, crb *rbacv1.ClusterRoleBinding) (drift string, err error) {
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		drift = ""
+
+		existing, err := r.kubernetescli.RbacV1().ClusterRoleBindings().Get(ctx, crb.Name, metav1.GetOptions{})
+		switch {
+		case kerrors.IsNotFound(err):
+			drift = fmt.Sprintf("clusterrolebinding %s, which was missing", crb.Name)
+			_, err = r.kubernetescli.RbacV1().ClusterRoleBindings().Create(ctx, crb, metav1.CreateOptions{})
+			return err
+		case err != nil:
+			return err
+		}
+
+		if !reflect.DeepEqual(existing.RoleRef, crb.RoleRef) {
+			drift = fmt.Sprintf("clusterrolebinding %s, which was bound to %s %s", crb.Name, strings.ToLower(existing.RoleRef.Kind), existing.RoleRef.Name)
+
+			err = r.kubernetescli.RbacV1().ClusterRoleBindings().Delete(ctx, crb.Name, metav1.DeleteOptions{})
+			if err != nil && !kerrors.IsNotFound(err) {
+				return err
+			}
+
+			_, err = r.kubernetescli.RbacV1().ClusterRoleBindings().Create(ctx, crb, metav1.CreateOptions{})
+			return err
+		}
+
+		if reflect.DeepEqual(existing.Subjects, crb.Subjects) {
+			return nil
+		}
+
+		drift = fmt.Sprintf("clusterrolebinding %s, which was modified", crb.Name)
+		existing.Subjects = crb.Subjects
+		_, err = r.kubernetescli.RbacV1().ClusterRoleBindings().Update(ctx, existing, metav1.UpdateOptions{})
+		return err
+	})
+
+	return drift, err
+}
+
+// SetupWithManager setup our manager
+func (r *RBACReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	resources, err := deploy.RBAC()
+	if err != nil {
+		return err
+	}
+
+	names := map[string]bool{}
+	for _, o := range resources {
+		names[fmt.Sprintf("%T/%s", o, o.(metav1.Object).GetName())] = true
+	}
+
+	triggerReconcile := func(meta metav1.Object, o runtime.Object) bool {
+		if _, ok := o.(*arov1alpha1.Cluster); ok {
+			return true
+		}
+
+		return names[fmt.Sprintf("%T/%s", o, meta.GetName())]
+	}
+
+	isARORBAC := predicate.Funcs{
+		UpdateFunc:  func(e event.UpdateEvent) bool { return triggerReconcile(e.MetaNew, e.ObjectNew) },
+		CreateFunc:  func(e event.CreateEvent) bool { return triggerReconcile(e.Meta, e.Object) },
+		DeleteFunc:  func(e event.DeleteEvent) bool { return triggerReconcile(e.Meta, e.Object) },
+		GenericFunc: func(e event.GenericEvent) bool { return triggerReconcile(e.Meta, e.Object) },
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}).
+		Watches(&source.Kind{Type: &rbacv1.ClusterRole{}}, &handler.EnqueueRequestForObject{}).
+		Watches(&source.Kind{Type: &rbacv1.ClusterRoleBinding{}}, &handler.EnqueueRequestForObject{}).
+		WithEventFilter(isARORBAC).
+		Named(controllers.RBACControllerName).
+		Complete(r)
+}
@@ -0,0 +1,203 @@
+package rbac
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	"github.com/Azure/ARO-RP/pkg/operator/deploy"
+)
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+
+	rbac, err := deploy.RBAC()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rbac) == 0 {
+		t.Fatal("no rbac resources")
+	}
+
+	// intact returns copies of the RBAC resources deployed with the operator,
+	// modified by the given functions
+	intact := func(modify ...func(runtime.Object)) []runtime.Object {
+		objects := make([]runtime.Object, 0, len(rbac))
+		for _, o := range rbac {
+			o = o.DeepCopyObject()
+			for _, f := range modify {
+				f(o)
+			}
+			objects = append(objects, o)
+		}
+		return objects
+	}
+
+	condition := func(s corev1.ConditionStatus, lastTransitionTime time.Time) *status.Condition {
+		return &status.Condition{
+			Type:               arov1alpha1.RBACValid,
+			Status:             s,
+			LastTransitionTime: metav1.NewTime(lastTransitionTime),
+		}
+	}
+
+	for _, tt := range []struct {
+		name        string
+		flags       map[string]string
+		objects     []runtime.Object
+		condition   *status.Condition
+		wantStatus  corev1.ConditionStatus
+		wantMessage string
+		wantEvents  int
+	}{
+		{
+			name:        "intact",
+			objects:     intact(),
+			wantStatus:  corev1.ConditionTrue,
+			wantMessage: "ARO RBAC is intact",
+		},
+		{
+			name:        "missing resources are recreated",
+			wantStatus:  corev1.ConditionFalse,
+			wantMessage: "restored clusterrolebinding aro-operator-master, which was missing; clusterrole aro-operator-worker, which was missing; clusterrolebinding aro-operator-worker, which was missing",
+			wantEvents:  3,
+		},
+		{
+			name: "modified resources are restored",
+			objects: intact(func(o runtime.Object) {
+				switch o := o.(type) {
+				case *rbacv1.ClusterRole:
+					o.Rules = nil
+				case *rbacv1.ClusterRoleBinding:
+					if o.Name == "aro-operator-master" {
+						o.RoleRef.Name = "view"
+					} else {
+						o.Subjects = append(o.Subjects, rbacv1.Subject{Kind: "User", Name: "mallory"})
+					}
+				}
+			}),
+			wantStatus:  corev1.ConditionFalse,
+			wantMessage: "restored clusterrolebinding aro-operator-master, which was bound to clusterrole view; clusterrole aro-operator-worker, which was modified; clusterrolebinding aro-operator-worker, which was modified",
+			wantEvents:  3,
+		},
+		{
+			name:       "recent drift is still reported",
+			objects:    intact(),
+			condition:  condition(corev1.ConditionFalse, time.Now().Add(-time.Minute)),
+			wantStatus: corev1.ConditionFalse,
+		},
+		{
+			name:        "old drift is cleared",
+			objects:     intact(),
+			condition:   condition(corev1.ConditionFalse, time.Now().Add(-2*driftReportPeriod)),
+			wantStatus:  corev1.ConditionTrue,
+			wantMessage: "ARO RBAC is intact",
+		},
+		{
+			name:  "disabled",
+			flags: map[string]string{operator.FlagRBACEnabled: "false"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					OperatorFlags: tt.flags,
+				},
+			}
+			if tt.condition != nil {
+				cluster.Status.Conditions = status.Conditions{*tt.condition}
+			}
+
+			arocli := arofake.NewSimpleClientset(cluster)
+			kubernetescli := fake.NewSimpleClientset(tt.objects...)
+			recorder := record.NewFakeRecorder(10)
+
+			r := NewReconciler(logrus.NewEntry(logrus.StandardLogger()), kubernetescli, arocli.AroV1alpha1(), recorder)
+
+			_, err := r.Reconcile(ctrl.Request{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.flags != nil {
+				_, err = kubernetescli.RbacV1().ClusterRoles().Get(ctx, "aro-operator-worker", metav1.GetOptions{})
+				if err == nil {
+					t.Error("unexpected clusterrole")
+				}
+				return
+			}
+
+			for _, o := range rbac {
+				switch o := o.(type) {
+				case *rbacv1.ClusterRole:
+					existing, err := kubernetescli.RbacV1().ClusterRoles().Get(ctx, o.Name, metav1.GetOptions{})
+					if err != nil {
+						t.Fatal(err)
+					}
+					if !reflect.DeepEqual(existing.Rules, o.Rules) {
+						t.Error(existing.Rules)
+					}
+				case *rbacv1.ClusterRoleBinding:
+					existing, err := kubernetescli.RbacV1().ClusterRoleBindings().Get(ctx, o.Name, metav1.GetOptions{})
+					if err != nil {
+						t.Fatal(err)
+					}
+					if !reflect.DeepEqual(existing.RoleRef, o.RoleRef) {
+						t.Error(existing.RoleRef)
+					}
+					if !reflect.DeepEqual(existing.Subjects, o.Subjects) {
+						t.Error(existing.Subjects)
+					}
+				}
+			}
+
+			cluster, err = arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cond := cluster.Status.Conditions.GetCondition(arov1alpha1.RBACValid)
+			if cond == nil {
+				t.Fatal("condition not set")
+			}
+			if cond.Status != tt.wantStatus {
+				t.Error(cond.Status)
+			}
+			if cond.Message != tt.wantMessage {
+				t.Error(cond.Message)
+			}
+
+			var restored int
+			for len(recorder.Events) > 0 {
+				e := <-recorder.Events
+				if strings.HasPrefix(e, "Warning RBACRestored") {
+					restored++
+				}
+			}
+			if restored != tt.wantEvents {
+				t.Error(restored)
+			}
+		})
+	}
+}
@@ -0,0 +1,521 @@
+package rebootcoordinator
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/azure/auth"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/cloudproviderconfig"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/compute"
+)
+
+const (
+	// drainTimeout is how long the pods of a node have to be evicted, e.g.
+	// while a PodDisruptionBudget holds them, before the rollout stops
+	drainTimeout = 30 * time.Minute
+
+	// rebootTimeout is how long a node has to come back with a new boot ID
+	// and be ready after its VM is restarted, before the rollout stops
+	rebootTimeout = 30 * time.Minute
+
+	// requeueInterval is how often a rollout in progress is checked
+	requeueInterval = 30 * time.Second
+
+	masterRoleLabel     = "node-role.kubernetes.io/master"
+	mirrorPodAnnotation = "kubernetes.io/config.mirror"
+)
+
+// RebootCoordinatorReconciler carries out rolling reboots of all the nodes of
+// the cluster requested by the RP, so that a kernel fix can be rolled out
+// across the fleet without upgrading it.  Nodes are rebooted one at a time,
+// workers first and alternating between availability zones: each node is
+// cordoned and drained through the eviction API, so that PodDisruptionBudgets
+// are respected, its VM is restarted, and it is uncordoned once it is ready
+// with a new boot ID.  No node is taken out while another is not ready.  The
+// progress of each node is recorded in the RebootStatusAnnotation on the
+// Cluster resource, and the rollout stops at the first node which can't be
+// drained or doesn't come back.
+type RebootCoordinatorReconciler struct {
+	kubernetescli kubernetes.Interface
+	arocli        aroclient.AroV1alpha1Interface
+	recorder      record.EventRecorder
+	log           *logrus.Entry
+
+	newVirtualMachinesClient func(subscriptionID string, authorizer autorest.Authorizer) compute.VirtualMachinesClient
+	now                      func() time.Time
+}
+
+func NewReconciler(log *logrus.Entry, kubernetescli kubernetes.Interface, arocli aroclient.AroV1alpha1Interface, recorder record.EventRecorder) *RebootCoordinatorReconciler {
+	return &RebootCoordinatorReconciler{
+		kubernetescli: kubernetescli,
+		arocli:        arocli,
+		recorder:      recorder,
+		log:           log,
+
+		newVirtualMachinesClient: compute.NewVirtualMachinesClient,
+		now:                      time.Now,
+	}
+}
+
+// Reconcile starts the rollout of a new RebootRequest, or moves the rollout
+// in progress on by a step, and records its progress
+func (r *RebootCoordinatorReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
+	// TODO(mj): controller-runtime master fixes the need for this (https://github.com/kubernetes-sigs/controller-runtime/blob/master/pkg/reconcile/reconcile.go#L93) but it's not yet released.
+	ctx := context.Background()
+
+	instance, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if !controllers.FlagEnabled(instance, operator.FlagRebootCoordinatorEnabled) {
+		r.log.Debug("reboot coordination is disabled")
+		return reconcile.Result{}, nil
+	}
+
+	if instance.Annotations[operator.RebootRequestAnnotation] == "" {
+		return reconcile.Result{}, nil
+	}
+
+	var req *operator.RebootRequest
+	err = json.Unmarshal([]byte(instance.Annotations[operator.RebootRequestAnnotation]), &req)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("invalid reboot request: %v", err)
+	}
+
+	var status *operator.RebootStatus
+	if instance.Annotations[operator.RebootStatusAnnotation] != "" {
+		err = json.Unmarshal([]byte(instance.Annotations[operator.RebootStatusAnnotation]), &status)
+		if err != nil {
+			r.log.Warnf("ignoring invalid reboot status: %v", err)
+			status = nil
+		}
+	}
+
+	if status == nil || status.ID != req.ID {
+		status, err = r.newRebootStatus(ctx, req)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		r.recorder.Eventf(instance, corev1.EventTypeNormal, "RebootStarted", "starting reboot %s of %d nodes", status.ID, len(status.Nodes))
+	}
+
+	if status.CompletionTime != nil {
+		return reconcile.Result{}, nil
+	}
+
+	// the status is recorded even if the step failed, so that the
+	// transitions made before the failure are kept
+	err = r.step(ctx, instance, status)
+	if err != nil {
+		r.log.Error(err)
+	}
+
+	updateErr := r.setRebootStatus(ctx, status)
+	if err == nil {
+		err = updateErr
+	}
+
+	if status.CompletionTime != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: requeueInterval}, err
+}
+
+// newRebootStatus lists the nodes to be rebooted, in the order in which they
+// are rebooted
+func (r *RebootCoordinatorReconciler) newRebootStatus(ctx context.Context, req *operator.RebootRequest) (*operator.RebootStatus, error) {
+	nodes, err := r.kubernetescli.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	now := metav1.NewTime(r.now())
+
+	status := &operator.RebootStatus{
+		ID:        req.ID,
+		StartTime: now,
+		Nodes:     []operator.NodeRebootStatus{},
+	}
+
+	for _, name := range rebootOrder(nodes.Items) {
+		status.Nodes = append(status.Nodes, operator.NodeRebootStatus{
+			Name:               name,
+			State:              operator.NodeRebootStatePending,
+			LastTransitionTime: now,
+		})
+	}
+
+	return status, nil
+}
+
+// rebootOrder returns the names of nodes, workers before masters so that a
+// problem with the rebooted nodes shows up before the control plane is
+// touched, and within each role taking a node from each availability zone in
+// turn so that consecutive reboots hit different zones
+func rebootOrder(nodes []corev1.Node) []string {
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+
+	var names []string
+	for _, master := range []bool{false, true} {
+		zones := map[string][]string{}
+		for _, node := range nodes {
+			_, isMaster := node.Labels[masterRoleLabel]
+			if isMaster != master {
+				continue
+			}
+
+			zone := node.Labels[corev1.LabelZoneFailureDomainStable]
+			if zone == "" {
+				zone = node.Labels[corev1.LabelZoneFailureDomain]
+			}
+			zones[zone] = append(zones[zone], node.Name)
+		}
+
+		keys := make([]string, 0, len(zones))
+		for zone := range zones {
+			keys = append(keys, zone)
+		}
+		sort.Strings(keys)
+
+		for found := true; found; {
+			found = false
+			for _, zone := range keys {
+				if len(zones[zone]) > 0 {
+					names = append(names, zones[zone][0])
+					zones[zone] = zones[zone][1:]
+					found = true
+				}
+			}
+		}
+	}
+
+	return names
+}
+
+// step moves the rollout on: it drains or waits for the node in progress, or
+// else cordons the next node, or else completes the rollout
+func (r *RebootCoordinatorReconciler) step(ctx context.Context, instance *arov1alpha1.Cluster, status *operator.RebootStatus) error {
+	nodes, err := r.kubernetescli.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	nodesByName := make(map[string]*corev1.Node, len(nodes.Items))
+	for i := range nodes.Items {
+		nodesByName[nodes.Items[i].Name] = &nodes.Items[i]
+	}
+
+	for i := range status.Nodes {
+		ns := &status.Nodes[i]
+		if ns.State != operator.NodeRebootStateDraining && ns.State != operator.NodeRebootStateRebooting {
+			continue
+		}
+
+		node := nodesByName[ns.Name]
+		if node == nil {
+			r.transition(ns, operator.NodeRebootStateSkipped, "the node was deleted")
+			continue
+		}
+
+		if ns.State == operator.NodeRebootStateDraining {
+			return r.drain(ctx, instance, status, ns, node)
+		}
+		return r.waitForReboot(ctx, instance, status, ns, node)
+	}
+
+	for i := range status.Nodes {
+		ns := &status.Nodes[i]
+		if ns.State != operator.NodeRebootStatePending {
+			continue
+		}
+
+		node := nodesByName[ns.Name]
+		if node == nil {
+			r.transition(ns, operator.NodeRebootStateSkipped, "the node was deleted")
+			continue
+		}
+
+		var notReady []string
+		for _, n := range nodes.Items {
+			if !nodeReady(&n) {
+				notReady = append(notReady, n.Name)
+			}
+		}
+		if len(notReady) > 0 {
+			ns.Message = fmt.Sprintf("waiting for nodes %s to be ready", strings.Join(notReady, ", "))
+			return nil
+		}
+
+		ns.WasUnschedulable = node.Spec.Unschedulable
+		err = r.setUnschedulable(ctx, node.Name, true)
+		if err != nil {
+			return err
+		}
+
+		r.transition(ns, operator.NodeRebootStateDraining, "")
+		return r.drain(ctx, instance, status, ns, node)
+	}
+
+	now := metav1.NewTime(r.now())
+	status.CompletionTime = &now
+
+	r.recorder.Eventf(instance, corev1.EventTypeNormal, "RebootCompleted", "completed reboot %s", status.ID)
+
+	return nil
+}
+
+// drain evicts the pods of the node and restarts the node's VM once they are
+// gone
+func (r *RebootCoordinatorReconciler) drain(ctx context.Context, instance *arov1alpha1.Cluster, status *operator.RebootStatus, ns *operator.NodeRebootStatus, node *corev1.Node) error {
+	pods, err := r.kubernetescli.CoreV1().Pods("").List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", node.Name).String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	var remaining []string
+	for _, pod := range pods.Items {
+		if pod.Spec.NodeName != node.Name || !evictable(&pod) {
+			continue
+		}
+
+		remaining = append(remaining, pod.Namespace+"/"+pod.Name)
+
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+
+		err = r.kubernetescli.PolicyV1beta1().Evictions(pod.Namespace).Evict(ctx, &policyv1beta1.Eviction{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      pod.Name,
+				Namespace: pod.Namespace,
+			},
+		})
+		switch {
+		case kerrors.IsNotFound(err):
+		case kerrors.IsTooManyRequests(err):
+			// a PodDisruptionBudget doesn't allow the eviction yet
+			r.log.Infof("eviction of %s/%s not allowed yet: %v", pod.Namespace, pod.Name, err)
+		case err != nil:
+			return err
+		}
+	}
+
+	if len(remaining) > 0 {
+		if r.now().Sub(ns.LastTransitionTime.Time) > drainTimeout {
+			r.fail(instance, status, ns, fmt.Sprintf("timed out evicting pods %s", strings.Join(remaining, ", ")))
+			return r.setUnschedulable(ctx, node.Name, ns.WasUnschedulable)
+		}
+
+		ns.Message = fmt.Sprintf("waiting for %d pods to be evicted", len(remaining))
+		return nil
+	}
+
+	return r.reboot(ctx, instance, status, ns, node)
+}
+
+// evictable returns true if pod has to be evicted before its node is
+// rebooted.  DaemonSet and static pods are tied to the node, and pods which
+// no controller would recreate elsewhere are left for the kubelet to restart
+// after the reboot rather than being deleted for good.
+func evictable(pod *corev1.Pod) bool {
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return false
+	}
+
+	if _, found := pod.Annotations[mirrorPodAnnotation]; found {
+		return false
+	}
+
+	owner := metav1.GetControllerOf(pod)
+	return owner != nil && owner.Kind != "DaemonSet"
+}
+
+// reboot restarts the VM of the node.  A failed restart is retried on the
+// next reconcile, until the drain times out.
+func (r *RebootCoordinatorReconciler) reboot(ctx context.Context, instance *arov1alpha1.Cluster, status *operator.RebootStatus, ns *operator.NodeRebootStatus, node *corev1.Node) error {
+	res, err := azure.ParseResourceID(strings.TrimPrefix(node.Spec.ProviderID, "azure://"))
+	if err != nil {
+		r.fail(instance, status, ns, fmt.Sprintf("invalid providerID %q", node.Spec.ProviderID))
+		return r.setUnschedulable(ctx, node.Name, ns.WasUnschedulable)
+	}
+
+	authorizer, err := r.authorizer(ctx)
+	if err != nil {
+		return err
+	}
+
+	r.log.Infof("rebooting %s", node.Name)
+
+	err = r.newVirtualMachinesClient(res.SubscriptionID, authorizer).RestartAndWait(ctx, res.ResourceGroup, res.ResourceName)
+	if err != nil {
+		if r.now().Sub(ns.LastTransitionTime.Time) > drainTimeout {
+			r.fail(instance, status, ns, fmt.Sprintf("restarting the VM: %v", err))
+			return r.setUnschedulable(ctx, node.Name, ns.WasUnschedulable)
+		}
+		return err
+	}
+
+	ns.BootID = node.Status.NodeInfo.BootID
+	r.transition(ns, operator.NodeRebootStateRebooting, "")
+
+	r.recorder.Eventf(instance, corev1.EventTypeNormal, "NodeRebooting", "rebooting %s", node.Name)
+
+	return nil
+}
+
+// waitForReboot uncordons the node once it is ready with a new boot ID
+func (r *RebootCoordinatorReconciler) waitForReboot(ctx context.Context, instance *arov1alpha1.Cluster, status *operator.RebootStatus, ns *operator.NodeRebootStatus, node *corev1.Node) error {
+	if node.Status.NodeInfo.BootID == ns.BootID || !nodeReady(node) {
+		if r.now().Sub(ns.LastTransitionTime.Time) > rebootTimeout {
+			r.fail(instance, status, ns, "timed out waiting for the node to reboot and be ready")
+			return r.setUnschedulable(ctx, node.Name, ns.WasUnschedulable)
+		}
+
+		return nil
+	}
+
+	err := r.setUnschedulable(ctx, node.Name, ns.WasUnschedulable)
+	if err != nil {
+		return err
+	}
+
+	r.transition(ns, operator.NodeRebootStateCompleted, "")
+
+	r.recorder.Eventf(instance, corev1.EventTypeNormal, "NodeRebooted", "rebooted %s", node.Name)
+
+	return nil
+}
+
+// fail marks the node as failed and stops the rollout, leaving the nodes
+// after it pending
+func (r *RebootCoordinatorReconciler) fail(instance *arov1alpha1.Cluster, status *operator.RebootStatus, ns *operator.NodeRebootStatus, message string) {
+	r.log.Warnf("reboot of %s failed: %s", ns.Name, message)
+	r.transition(ns, operator.NodeRebootStateFailed, message)
+
+	now := metav1.NewTime(r.now())
+	status.CompletionTime = &now
+
+	r.recorder.Eventf(instance, corev1.EventTypeWarning, "RebootFailed", "stopped reboot %s: %s: %s", status.ID, ns.Name, message)
+}
+
+func (r *RebootCoordinatorReconciler) transition(ns *operator.NodeRebootStatus, state, message string) {
+	ns.State = state
+	ns.Message = message
+	ns.LastTransitionTime = metav1.NewTime(r.now())
+}
+
+func (r *RebootCoordinatorReconciler) setUnschedulable(ctx context.Context, name string, unschedulable bool) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		node, err := r.kubernetescli.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if node.Spec.Unschedulable == unschedulable {
+			return nil
+		}
+
+		node.Spec.Unschedulable = unschedulable
+		_, err = r.kubernetescli.CoreV1().Nodes().Update(ctx, node, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// authorizer returns an authorizer for the cluster service principal, which
+// may restart the VMs in the cluster resource group
+func (r *RebootCoordinatorReconciler) authorizer(ctx context.Context) (autorest.Authorizer, error) {
+	mysec, err := r.kubernetescli.CoreV1().Secrets(operator.Namespace).Get(ctx, operator.SecretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := mysec.Data[cloudproviderconfig.ConfigKey]; !found {
+		return nil, fmt.Errorf("secret %s/%s has no %s", operator.Namespace, operator.SecretName, cloudproviderconfig.ConfigKey)
+	}
+
+	var config *cloudproviderconfig.Config
+	err = json.Unmarshal(mysec.Data[cloudproviderconfig.ConfigKey], &config)
+	if err != nil {
+		return nil, err
+	}
+
+	return auth.NewClientCredentialsConfig(config.AADClientID, config.AADClientSecret, config.TenantID).Authorizer()
+}
+
+// setRebootStatus records status on the Cluster resource, if it has changed
+func (r *RebootCoordinatorReconciler) setRebootStatus(ctx context.Context, status *operator.RebootStatus) error {
+	b, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if cluster.Annotations[operator.RebootStatusAnnotation] == string(b) {
+			return nil
+		}
+
+		if cluster.Annotations == nil {
+			cluster.Annotations = map[string]string{}
+		}
+		cluster.Annotations[operator.RebootStatusAnnotation] = string(b)
+
+		_, err = r.arocli.Clusters().Update(ctx, cluster, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+func nodeReady(node *corev1.Node) bool {
+	for _, c := range node.Status.Conditions {
+		if c.Type == corev1.NodeReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+// SetupWithManager setup our manager
+func (r *RebootCoordinatorReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}).
+		Named(controllers.RebootCoordinatorControllerName).
+		Complete(r)
+}
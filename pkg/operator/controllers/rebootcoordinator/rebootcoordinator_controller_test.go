@@ -0,0 +1,418 @@
+package rebootcoordinator
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	ktesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/compute"
+	mock_compute "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/mgmt/compute"
+)
+
+func TestRebootOrder(t *testing.T) {
+	node := func(name, zone string, master bool) corev1.Node {
+		n := corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Labels: map[string]string{
+					corev1.LabelZoneFailureDomainStable: zone,
+				},
+			},
+		}
+		if master {
+			n.Labels[masterRoleLabel] = ""
+		}
+		return n
+	}
+
+	got := rebootOrder([]corev1.Node{
+		node("master-0", "eastus-1", true),
+		node("master-1", "eastus-2", true),
+		node("master-2", "eastus-3", true),
+		node("worker-eastus1-a", "eastus-1", false),
+		node("worker-eastus1-b", "eastus-1", false),
+		node("worker-eastus1-c", "eastus-1", false),
+		node("worker-eastus2-a", "eastus-2", false),
+		node("worker-eastus3-a", "eastus-3", false),
+	})
+
+	want := []string{
+		"worker-eastus1-a",
+		"worker-eastus2-a",
+		"worker-eastus3-a",
+		"worker-eastus1-b",
+		"worker-eastus1-c",
+		"master-0",
+		"master-1",
+		"master-2",
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Error(got)
+	}
+}
+
+func TestRebootCoordinatorReconcile(t *testing.T) {
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	node := func(name, bootID string, ready, unschedulable bool) *corev1.Node {
+		status := corev1.ConditionTrue
+		if !ready {
+			status = corev1.ConditionFalse
+		}
+
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: corev1.NodeSpec{
+				ProviderID:    "azure:///subscriptions/subscription/resourceGroups/cluster-rg/providers/Microsoft.Compute/virtualMachines/" + name,
+				Unschedulable: unschedulable,
+			},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{
+					{
+						Type:   corev1.NodeReady,
+						Status: status,
+					},
+				},
+				NodeInfo: corev1.NodeSystemInfo{
+					BootID: bootID,
+				},
+			},
+		}
+	}
+
+	pod := func(name, node, ownerKind string) *corev1.Pod {
+		p := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "customer",
+			},
+			Spec: corev1.PodSpec{
+				NodeName: node,
+			},
+		}
+		if ownerKind != "" {
+			p.OwnerReferences = []metav1.OwnerReference{
+				{
+					Kind:       ownerKind,
+					Name:       "owner",
+					Controller: func() *bool { b := true; return &b }(),
+				},
+			}
+		}
+		return p
+	}
+
+	nodeStatus := func(name, state string, since time.Duration, bootID string, wasUnschedulable bool) operator.NodeRebootStatus {
+		return operator.NodeRebootStatus{
+			Name:               name,
+			State:              state,
+			LastTransitionTime: metav1.NewTime(now.Add(-since)),
+			BootID:             bootID,
+			WasUnschedulable:   wasUnschedulable,
+		}
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      operator.SecretName,
+			Namespace: operator.Namespace,
+		},
+		Data: map[string][]byte{
+			"cloudProviderConfig": []byte(`{"tenantId":"tenant","subscriptionId":"subscription","resourceGroup":"cluster-rg","aadClientId":"client","aadClientSecret":"secret"}`),
+		},
+	}
+
+	for _, tt := range []struct {
+		name              string
+		flags             map[string]string
+		noRequest         bool
+		status            []operator.NodeRebootStatus
+		objects           []runtime.Object
+		mocks             func(*mock_compute.MockVirtualMachinesClient)
+		wantStates        []string
+		wantMessages      []string
+		wantCompleted     bool
+		wantEvicted       []string
+		wantUnschedulable map[string]bool
+	}{
+		{
+			name:      "no request",
+			noRequest: true,
+			objects:   []runtime.Object{node("worker-a", "boot", true, false)},
+		},
+		{
+			name:    "disabled",
+			flags:   map[string]string{operator.FlagRebootCoordinatorEnabled: "false"},
+			objects: []runtime.Object{node("worker-a", "boot", true, false)},
+		},
+		{
+			name: "new request cordons and drains the first node",
+			objects: []runtime.Object{
+				node("worker-a", "boot", true, false),
+				node("worker-b", "boot", true, false),
+				pod("replicaset-pod", "worker-a", "ReplicaSet"),
+				pod("daemonset-pod", "worker-a", "DaemonSet"),
+				pod("bare-pod", "worker-a", ""),
+				pod("other-node-pod", "worker-b", "ReplicaSet"),
+			},
+			wantStates:        []string{operator.NodeRebootStateDraining, operator.NodeRebootStatePending},
+			wantMessages:      []string{"waiting for 1 pods to be evicted", ""},
+			wantEvicted:       []string{"replicaset-pod"},
+			wantUnschedulable: map[string]bool{"worker-a": true, "worker-b": false},
+		},
+		{
+			name: "drained node is rebooted",
+			status: []operator.NodeRebootStatus{
+				nodeStatus("worker-a", operator.NodeRebootStateDraining, time.Minute, "", false),
+				nodeStatus("worker-b", operator.NodeRebootStatePending, time.Hour, "", false),
+			},
+			objects: []runtime.Object{
+				node("worker-a", "boot", true, true),
+				node("worker-b", "boot", true, false),
+				pod("daemonset-pod", "worker-a", "DaemonSet"),
+			},
+			mocks: func(vms *mock_compute.MockVirtualMachinesClient) {
+				vms.EXPECT().RestartAndWait(gomock.Any(), "cluster-rg", "worker-a").Return(nil)
+			},
+			wantStates:        []string{operator.NodeRebootStateRebooting, operator.NodeRebootStatePending},
+			wantMessages:      []string{"", ""},
+			wantUnschedulable: map[string]bool{"worker-a": true},
+		},
+		{
+			name: "node which has not rebooted yet is waited for",
+			status: []operator.NodeRebootStatus{
+				nodeStatus("worker-a", operator.NodeRebootStateRebooting, time.Minute, "boot", false),
+			},
+			objects: []runtime.Object{
+				node("worker-a", "boot", true, true),
+			},
+			wantStates:        []string{operator.NodeRebootStateRebooting},
+			wantMessages:      []string{""},
+			wantUnschedulable: map[string]bool{"worker-a": true},
+		},
+		{
+			name: "rebooted node is uncordoned",
+			status: []operator.NodeRebootStatus{
+				nodeStatus("worker-a", operator.NodeRebootStateRebooting, time.Minute, "boot", false),
+				nodeStatus("worker-b", operator.NodeRebootStatePending, time.Hour, "", false),
+			},
+			objects: []runtime.Object{
+				node("worker-a", "newboot", true, true),
+				node("worker-b", "boot", true, false),
+			},
+			wantStates:        []string{operator.NodeRebootStateCompleted, operator.NodeRebootStatePending},
+			wantMessages:      []string{"", ""},
+			wantUnschedulable: map[string]bool{"worker-a": false, "worker-b": false},
+		},
+		{
+			name: "next node waits for the nodes to be ready",
+			status: []operator.NodeRebootStatus{
+				nodeStatus("worker-a", operator.NodeRebootStateCompleted, time.Minute, "boot", false),
+				nodeStatus("worker-b", operator.NodeRebootStatePending, time.Hour, "", false),
+			},
+			objects: []runtime.Object{
+				node("worker-a", "newboot", false, false),
+				node("worker-b", "boot", true, false),
+			},
+			wantStates:        []string{operator.NodeRebootStateCompleted, operator.NodeRebootStatePending},
+			wantMessages:      []string{"", "waiting for nodes worker-a to be ready"},
+			wantUnschedulable: map[string]bool{"worker-b": false},
+		},
+		{
+			name: "node cordoned by the customer stays cordoned",
+			status: []operator.NodeRebootStatus{
+				nodeStatus("worker-a", operator.NodeRebootStateRebooting, time.Minute, "boot", true),
+			},
+			objects: []runtime.Object{
+				node("worker-a", "newboot", true, true),
+			},
+			wantStates:        []string{operator.NodeRebootStateCompleted},
+			wantMessages:      []string{""},
+			wantUnschedulable: map[string]bool{"worker-a": true},
+		},
+		{
+			name: "drain timeout stops the rollout",
+			status: []operator.NodeRebootStatus{
+				nodeStatus("worker-a", operator.NodeRebootStateDraining, time.Hour, "", false),
+				nodeStatus("worker-b", operator.NodeRebootStatePending, time.Hour, "", false),
+			},
+			objects: []runtime.Object{
+				node("worker-a", "boot", true, true),
+				node("worker-b", "boot", true, false),
+				func() *corev1.Pod {
+					p := pod("replicaset-pod", "worker-a", "ReplicaSet")
+					p.DeletionTimestamp = &metav1.Time{Time: now}
+					return p
+				}(),
+			},
+			wantStates:        []string{operator.NodeRebootStateFailed, operator.NodeRebootStatePending},
+			wantMessages:      []string{"timed out evicting pods customer/replicaset-pod", ""},
+			wantCompleted:     true,
+			wantUnschedulable: map[string]bool{"worker-a": false, "worker-b": false},
+		},
+		{
+			name: "reboot timeout stops the rollout",
+			status: []operator.NodeRebootStatus{
+				nodeStatus("worker-a", operator.NodeRebootStateRebooting, time.Hour, "boot", false),
+			},
+			objects: []runtime.Object{
+				node("worker-a", "newboot", false, true),
+			},
+			wantStates:        []string{operator.NodeRebootStateFailed},
+			wantMessages:      []string{"timed out waiting for the node to reboot and be ready"},
+			wantCompleted:     true,
+			wantUnschedulable: map[string]bool{"worker-a": false},
+		},
+		{
+			name: "deleted node is skipped",
+			status: []operator.NodeRebootStatus{
+				nodeStatus("worker-a", operator.NodeRebootStateCompleted, time.Hour, "boot", false),
+				nodeStatus("worker-b", operator.NodeRebootStatePending, time.Hour, "", false),
+			},
+			objects: []runtime.Object{
+				node("worker-a", "newboot", true, false),
+			},
+			wantStates:    []string{operator.NodeRebootStateCompleted, operator.NodeRebootStateSkipped},
+			wantMessages:  []string{"", "the node was deleted"},
+			wantCompleted: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			vms := mock_compute.NewMockVirtualMachinesClient(controller)
+			if tt.mocks != nil {
+				tt.mocks(vms)
+			}
+
+			cluster := &arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        arov1alpha1.SingletonClusterName,
+					Annotations: map[string]string{},
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					OperatorFlags: tt.flags,
+				},
+			}
+			if !tt.noRequest {
+				cluster.Annotations[operator.RebootRequestAnnotation] = `{"id":"request"}`
+			}
+			if tt.status != nil {
+				b, err := json.Marshal(&operator.RebootStatus{
+					ID:        "request",
+					StartTime: metav1.NewTime(now.Add(-2 * time.Hour)),
+					Nodes:     tt.status,
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				cluster.Annotations[operator.RebootStatusAnnotation] = string(b)
+			}
+
+			kubernetescli := fake.NewSimpleClientset(append(tt.objects, secret)...)
+
+			var evicted []string
+			kubernetescli.PrependReactor("create", "pods", func(action ktesting.Action) (bool, runtime.Object, error) {
+				if action.GetSubresource() != "eviction" {
+					return false, nil, nil
+				}
+				evicted = append(evicted, action.(ktesting.CreateAction).GetObject().(metav1.Object).GetName())
+				return true, nil, nil
+			})
+
+			arocli := arofake.NewSimpleClientset(cluster)
+
+			r := &RebootCoordinatorReconciler{
+				kubernetescli: kubernetescli,
+				arocli:        arocli.AroV1alpha1(),
+				recorder:      record.NewFakeRecorder(10),
+				log:           logrus.NewEntry(logrus.StandardLogger()),
+				newVirtualMachinesClient: func(subscriptionID string, authorizer autorest.Authorizer) compute.VirtualMachinesClient {
+					if subscriptionID != "subscription" {
+						t.Error(subscriptionID)
+					}
+					return vms
+				},
+				now: func() time.Time { return now },
+			}
+
+			_, err := r.Reconcile(ctrl.Request{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(evicted, tt.wantEvicted) {
+				t.Error(evicted)
+			}
+
+			cluster, err = arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.wantStates == nil {
+				if cluster.Annotations[operator.RebootStatusAnnotation] != "" {
+					t.Error(cluster.Annotations[operator.RebootStatusAnnotation])
+				}
+				return
+			}
+
+			var status *operator.RebootStatus
+			err = json.Unmarshal([]byte(cluster.Annotations[operator.RebootStatusAnnotation]), &status)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var states, messages []string
+			for _, ns := range status.Nodes {
+				states = append(states, ns.State)
+				messages = append(messages, ns.Message)
+			}
+
+			if !reflect.DeepEqual(states, tt.wantStates) {
+				t.Error(states)
+			}
+			if !reflect.DeepEqual(messages, tt.wantMessages) {
+				t.Error(messages)
+			}
+			if (status.CompletionTime != nil) != tt.wantCompleted {
+				t.Error(status.CompletionTime)
+			}
+
+			for name, want := range tt.wantUnschedulable {
+				node, err := kubernetescli.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if node.Spec.Unschedulable != want {
+					t.Errorf("%s: unschedulable %v", name, node.Spec.Unschedulable)
+				}
+			}
+		})
+	}
+}
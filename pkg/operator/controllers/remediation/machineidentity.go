@@ -0,0 +1,85 @@
+package remediation
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	machinev1beta1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
+	maoclient "github.com/openshift/machine-api-operator/pkg/generated/clientset/versioned"
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+// machineIdentityPlaybook clears the managed identity which was set on the
+// provider spec of machines and machinesets.  ARO machines authenticate with
+// the cluster service principal; a managed identity makes the machine API
+// fail to create them.  The MachineChecker reports it in MachineValid.
+type machineIdentityPlaybook struct {
+	log    *logrus.Entry
+	maocli maoclient.Interface
+}
+
+func (p *machineIdentityPlaybook) Name() string {
+	return "MachineIdentity"
+}
+
+func (p *machineIdentityPlaybook) Flag() string {
+	return operator.FlagRemediationMachineIdentity
+}
+
+func (p *machineIdentityPlaybook) Condition() status.ConditionType {
+	return arov1alpha1.MachineValid
+}
+
+func (p *machineIdentityPlaybook) Applies(cond *status.Condition) bool {
+	return strings.Contains(cond.Message, "invalid managedIdentity")
+}
+
+// +kubebuilder:rbac:groups=machine.openshift.io,resources=machines;machinesets,verbs=list;get;update
+
+func (p *machineIdentityPlaybook) Run(ctx context.Context) ([]string, error) {
+	return updateProviderSpecs(ctx, p.maocli, func(providerSpec *machinev1beta1.ProviderSpec) (string, error) {
+		identity, err := clearManagedIdentity(providerSpec)
+		if err != nil || identity == "" {
+			return "", err
+		}
+
+		return fmt.Sprintf("cleared managedIdentity '%s'", identity), nil
+	})
+}
+
+// clearManagedIdentity clears the managed identity of providerSpec and returns
+// the identity which was set, or "" if none was
+func clearManagedIdentity(providerSpec *machinev1beta1.ProviderSpec) (string, error) {
+	machineProviderSpec, err := decodeProviderSpec(providerSpec)
+	if err != nil || machineProviderSpec == nil {
+		return "", err
+	}
+
+	identity := machineProviderSpec.ManagedIdentity
+	if identity == "" {
+		return "", nil
+	}
+
+	machineProviderSpec.ManagedIdentity = ""
+
+	b, err := json.Marshal(machineProviderSpec)
+	if err != nil {
+		return "", err
+	}
+
+	providerSpec.Value = &runtime.RawExtension{
+		Raw: b,
+	}
+
+	return identity, nil
+}
@@ -0,0 +1,122 @@
+package remediation
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	machinev1beta1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
+	maofake "github.com/openshift/machine-api-operator/pkg/generated/clientset/versioned/fake"
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+)
+
+func providerSpec(managedIdentity string) machinev1beta1.ProviderSpec {
+	return machinev1beta1.ProviderSpec{
+		Value: &runtime.RawExtension{
+			Raw: []byte(`{
+"apiVersion": "azureproviderconfig.openshift.io/v1beta1",
+"kind": "AzureMachineProviderSpec",
+"managedIdentity": "` + managedIdentity + `",
+"vmSize": "Standard_D4s_v3"
+}`),
+		},
+	}
+}
+
+func TestMachineIdentityPlaybook(t *testing.T) {
+	ctx := context.Background()
+
+	maocli := maofake.NewSimpleClientset(
+		&machinev1beta1.MachineSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "worker-eastus1",
+				Namespace: machineSetsNamespace,
+			},
+			Spec: machinev1beta1.MachineSetSpec{
+				Template: machinev1beta1.MachineTemplateSpec{
+					Spec: machinev1beta1.MachineSpec{
+						ProviderSpec: providerSpec("identity"),
+					},
+				},
+			},
+		},
+		&machinev1beta1.MachineSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "worker-eastus2",
+				Namespace: machineSetsNamespace,
+			},
+			Spec: machinev1beta1.MachineSetSpec{
+				Template: machinev1beta1.MachineTemplateSpec{
+					Spec: machinev1beta1.MachineSpec{
+						ProviderSpec: providerSpec(""),
+					},
+				},
+			},
+		},
+		&machinev1beta1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "worker-eastus1-a",
+				Namespace: machineSetsNamespace,
+			},
+			Spec: machinev1beta1.MachineSpec{
+				ProviderSpec: providerSpec("identity"),
+			},
+		},
+	)
+
+	p := &machineIdentityPlaybook{
+		log:    utillog.GetLogger(),
+		maocli: maocli,
+	}
+
+	if !p.Applies(&status.Condition{Message: "machine worker-eastus1-a: invalid managedIdentity 'identity'\n"}) {
+		t.Error("playbook does not apply")
+	}
+	if p.Applies(&status.Condition{Message: "machine worker-eastus1-a: invalid VM size 'Standard_D2s_v3'\n"}) {
+		t.Error("playbook applies")
+	}
+
+	changes, err := p.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantChanges := []string{
+		"cleared managedIdentity 'identity' of machineset worker-eastus1",
+		"cleared managedIdentity 'identity' of machine worker-eastus1-a",
+	}
+	if !reflect.DeepEqual(changes, wantChanges) {
+		t.Error(changes)
+	}
+
+	machineset, err := maocli.MachineV1beta1().MachineSets(machineSetsNamespace).Get(ctx, "worker-eastus1", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if identity, err := clearManagedIdentity(&machineset.Spec.Template.Spec.ProviderSpec); err != nil || identity != "" {
+		t.Error(identity, err)
+	}
+
+	machine, err := maocli.MachineV1beta1().Machines(machineSetsNamespace).Get(ctx, "worker-eastus1-a", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if identity, err := clearManagedIdentity(&machine.Spec.ProviderSpec); err != nil || identity != "" {
+		t.Error(identity, err)
+	}
+
+	changes, err = p.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 0 {
+		t.Error(changes)
+	}
+}
@@ -0,0 +1,144 @@
+package remediation
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	machinev1beta1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
+	maoclient "github.com/openshift/machine-api-operator/pkg/generated/clientset/versioned"
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	azureproviderv1beta1 "sigs.k8s.io/cluster-api-provider-azure/pkg/apis/azureprovider/v1beta1"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/validate"
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+const (
+	imagePublisher = "azureopenshift"
+	imageOffer     = "aro4"
+
+	masterRoleSelector = "machine.openshift.io/cluster-api-machine-role=master"
+)
+
+// machineImagePlaybook restores the ARO boot image on machines and
+// machinesets whose provider spec was pointed at another image, which the
+// MachineChecker reports in MachineValid.  The correct image is taken from the
+// master machines, which the installer created from the boot image of the
+// cluster version.  Arm64 machines are left alone, as their image is published
+// under another SKU than the masters'.
+type machineImagePlaybook struct {
+	log    *logrus.Entry
+	maocli maoclient.Interface
+}
+
+func (p *machineImagePlaybook) Name() string {
+	return "MachineImage"
+}
+
+func (p *machineImagePlaybook) Flag() string {
+	return operator.FlagRemediationMachineImage
+}
+
+func (p *machineImagePlaybook) Condition() status.ConditionType {
+	return arov1alpha1.MachineValid
+}
+
+func (p *machineImagePlaybook) Applies(cond *status.Condition) bool {
+	return strings.Contains(cond.Message, "invalid image")
+}
+
+// +kubebuilder:rbac:groups=machine.openshift.io,resources=machines;machinesets,verbs=list;get;update
+
+func (p *machineImagePlaybook) Run(ctx context.Context) ([]string, error) {
+	image, err := p.masterImage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return updateProviderSpecs(ctx, p.maocli, func(providerSpec *machinev1beta1.ProviderSpec) (string, error) {
+		previous, err := restoreImage(providerSpec, image)
+		if err != nil || previous == nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("restored image '%v' (was '%v')", *image, *previous), nil
+	})
+}
+
+// masterImage returns the image of the first master machine which uses the
+// ARO boot image
+func (p *machineImagePlaybook) masterImage(ctx context.Context) (*azureproviderv1beta1.Image, error) {
+	machines, err := p.maocli.MachineV1beta1().Machines(machineSetsNamespace).List(ctx, metav1.ListOptions{
+		LabelSelector: masterRoleSelector,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, machine := range machines.Items {
+		machineProviderSpec, err := decodeProviderSpec(&machine.Spec.ProviderSpec)
+		if err != nil {
+			return nil, err
+		}
+		if machineProviderSpec == nil {
+			continue
+		}
+
+		if imageValid(&machineProviderSpec.Image) {
+			return &machineProviderSpec.Image, nil
+		}
+	}
+
+	return nil, errors.New("no master machine uses the ARO boot image")
+}
+
+// restoreImage sets the image of providerSpec to image if it is not an ARO
+// boot image and returns the image which was set, or nil if it left
+// providerSpec alone.  Only the image field of the raw provider spec is
+// replaced, so that fields which the vendored AzureMachineProviderSpec does
+// not know, e.g. the security profile, are kept.
+func restoreImage(providerSpec *machinev1beta1.ProviderSpec, image *azureproviderv1beta1.Image) (*azureproviderv1beta1.Image, error) {
+	machineProviderSpec, err := decodeProviderSpec(providerSpec)
+	if err != nil || machineProviderSpec == nil {
+		return nil, err
+	}
+
+	if imageValid(&machineProviderSpec.Image) || validate.VMSizeIsArm64(api.VMSize(machineProviderSpec.VMSize)) {
+		return nil, nil
+	}
+
+	var m map[string]interface{}
+	err = json.Unmarshal(providerSpec.Value.Raw, &m)
+	if err != nil {
+		return nil, err
+	}
+
+	m["image"] = image
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	providerSpec.Value = &runtime.RawExtension{
+		Raw: b,
+	}
+
+	return &machineProviderSpec.Image, nil
+}
+
+// imageValid returns true if image is an amd64 ARO boot image
+func imageValid(image *azureproviderv1beta1.Image) bool {
+	return image.Publisher == imagePublisher && image.Offer == imageOffer && !strings.HasSuffix(image.SKU, "_arm64")
+}
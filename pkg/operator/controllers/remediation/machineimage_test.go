@@ -0,0 +1,190 @@
+package remediation
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	machinev1beta1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
+	maofake "github.com/openshift/machine-api-operator/pkg/generated/clientset/versioned/fake"
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+)
+
+func imageProviderSpec(publisher, offer, vmSize string) machinev1beta1.ProviderSpec {
+	return machinev1beta1.ProviderSpec{
+		Value: &runtime.RawExtension{
+			Raw: []byte(`{
+"apiVersion": "azureproviderconfig.openshift.io/v1beta1",
+"kind": "AzureMachineProviderSpec",
+"image": {
+"publisher": "` + publisher + `",
+"offer": "` + offer + `",
+"sku": "aro_47",
+"version": "47.83.20210522"
+},
+"securityProfile": {
+"securityType": "TrustedLaunch"
+},
+"vmSize": "` + vmSize + `"
+}`),
+		},
+	}
+}
+
+func TestMachineImagePlaybook(t *testing.T) {
+	ctx := context.Background()
+
+	master := &machinev1beta1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "master-0",
+			Namespace: machineSetsNamespace,
+			Labels: map[string]string{
+				"machine.openshift.io/cluster-api-machine-role": "master",
+			},
+		},
+		Spec: machinev1beta1.MachineSpec{
+			ProviderSpec: imageProviderSpec("azureopenshift", "aro4", "Standard_D8s_v3"),
+		},
+	}
+
+	for _, tt := range []struct {
+		name        string
+		objects     []runtime.Object
+		wantChanges []string
+		wantErr     string
+	}{
+		{
+			name: "restores image",
+			objects: []runtime.Object{
+				master,
+				&machinev1beta1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "worker-eastus1",
+						Namespace: machineSetsNamespace,
+					},
+					Spec: machinev1beta1.MachineSetSpec{
+						Template: machinev1beta1.MachineTemplateSpec{
+							Spec: machinev1beta1.MachineSpec{
+								ProviderSpec: imageProviderSpec("xyzcorp", "bananas", "Standard_D4s_v3"),
+							},
+						},
+					},
+				},
+				&machinev1beta1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "worker-eastus2",
+						Namespace: machineSetsNamespace,
+					},
+					Spec: machinev1beta1.MachineSetSpec{
+						Template: machinev1beta1.MachineTemplateSpec{
+							Spec: machinev1beta1.MachineSpec{
+								ProviderSpec: imageProviderSpec("azureopenshift", "aro4", "Standard_D4s_v3"),
+							},
+						},
+					},
+				},
+				&machinev1beta1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "worker-eastus1-a",
+						Namespace: machineSetsNamespace,
+					},
+					Spec: machinev1beta1.MachineSpec{
+						ProviderSpec: imageProviderSpec("xyzcorp", "bananas", "Standard_D4s_v3"),
+					},
+				},
+				&machinev1beta1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "arm64-a",
+						Namespace: machineSetsNamespace,
+					},
+					Spec: machinev1beta1.MachineSpec{
+						ProviderSpec: imageProviderSpec("xyzcorp", "bananas", "Standard_D4ps_v5"),
+					},
+				},
+			},
+			wantChanges: []string{
+				"restored image '{azureopenshift aro4 aro_47 47.83.20210522 }' (was '{xyzcorp bananas aro_47 47.83.20210522 }') of machineset worker-eastus1",
+				"restored image '{azureopenshift aro4 aro_47 47.83.20210522 }' (was '{xyzcorp bananas aro_47 47.83.20210522 }') of machine worker-eastus1-a",
+			},
+		},
+		{
+			name: "no valid master image",
+			objects: []runtime.Object{
+				&machinev1beta1.Machine{
+					ObjectMeta: master.ObjectMeta,
+					Spec: machinev1beta1.MachineSpec{
+						ProviderSpec: imageProviderSpec("xyzcorp", "bananas", "Standard_D8s_v3"),
+					},
+				},
+			},
+			wantErr: "no master machine uses the ARO boot image",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			maocli := maofake.NewSimpleClientset(tt.objects...)
+
+			p := &machineImagePlaybook{
+				log:    utillog.GetLogger(),
+				maocli: maocli,
+			}
+
+			changes, err := p.Run(ctx)
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(changes, tt.wantChanges) {
+				t.Error(changes)
+			}
+
+			if tt.wantErr != "" {
+				return
+			}
+
+			machine, err := maocli.MachineV1beta1().Machines(machineSetsNamespace).Get(ctx, "worker-eastus1-a", metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			machineProviderSpec, err := decodeProviderSpec(&machine.Spec.ProviderSpec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !imageValid(&machineProviderSpec.Image) {
+				t.Error(machineProviderSpec.Image)
+			}
+			if !strings.Contains(string(machine.Spec.ProviderSpec.Value.Raw), `"securityProfile"`) {
+				t.Error(string(machine.Spec.ProviderSpec.Value.Raw))
+			}
+
+			changes, err = p.Run(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changes) != 0 {
+				t.Error(changes)
+			}
+		})
+	}
+}
+
+func TestMachineImagePlaybookApplies(t *testing.T) {
+	p := &machineImagePlaybook{}
+
+	if !p.Applies(&status.Condition{Message: "machine worker-eastus1-a: invalid image '{xyzcorp bananas   }'\n"}) {
+		t.Error("playbook does not apply")
+	}
+
+	if p.Applies(&status.Condition{Message: "machine worker-eastus1-a: invalid managedIdentity 'identity'\n"}) {
+		t.Error("playbook applies")
+	}
+}
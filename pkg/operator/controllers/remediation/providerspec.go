@@ -0,0 +1,109 @@
+package remediation
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+
+	machinev1beta1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
+	maoclient "github.com/openshift/machine-api-operator/pkg/generated/clientset/versioned"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/util/retry"
+	azureproviderv1beta1 "sigs.k8s.io/cluster-api-provider-azure/pkg/apis/azureprovider/v1beta1"
+
+	_ "github.com/Azure/ARO-RP/pkg/util/scheme"
+)
+
+const machineSetsNamespace = "openshift-machine-api"
+
+// providerSpecUpdater changes providerSpec in place and returns a description
+// of the change, or "" if it left providerSpec alone
+type providerSpecUpdater func(providerSpec *machinev1beta1.ProviderSpec) (string, error)
+
+// updateProviderSpecs applies update to the provider spec of every machineset
+// and machine in openshift-machine-api, machinesets first so that the
+// machines they create from then on are not affected, and returns the changes
+// it made
+func updateProviderSpecs(ctx context.Context, maocli maoclient.Interface, update providerSpecUpdater) ([]string, error) {
+	var changes []string
+
+	machinesets, err := maocli.MachineV1beta1().MachineSets(machineSetsNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, machineset := range machinesets.Items {
+		err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			machineset, err := maocli.MachineV1beta1().MachineSets(machineSetsNamespace).Get(ctx, machineset.Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+
+			change, err := update(&machineset.Spec.Template.Spec.ProviderSpec)
+			if err != nil || change == "" {
+				return err
+			}
+
+			_, err = maocli.MachineV1beta1().MachineSets(machineSetsNamespace).Update(ctx, machineset, metav1.UpdateOptions{})
+			if err == nil {
+				changes = append(changes, fmt.Sprintf("%s of machineset %s", change, machineset.Name))
+			}
+			return err
+		})
+		if err != nil {
+			return changes, err
+		}
+	}
+
+	machines, err := maocli.MachineV1beta1().Machines(machineSetsNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return changes, err
+	}
+
+	for _, machine := range machines.Items {
+		err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			machine, err := maocli.MachineV1beta1().Machines(machineSetsNamespace).Get(ctx, machine.Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+
+			change, err := update(&machine.Spec.ProviderSpec)
+			if err != nil || change == "" {
+				return err
+			}
+
+			_, err = maocli.MachineV1beta1().Machines(machineSetsNamespace).Update(ctx, machine, metav1.UpdateOptions{})
+			if err == nil {
+				changes = append(changes, fmt.Sprintf("%s of machine %s", change, machine.Name))
+			}
+			return err
+		})
+		if err != nil {
+			return changes, err
+		}
+	}
+
+	return changes, nil
+}
+
+// decodeProviderSpec decodes providerSpec, or returns nil if it is empty
+func decodeProviderSpec(providerSpec *machinev1beta1.ProviderSpec) (*azureproviderv1beta1.AzureMachineProviderSpec, error) {
+	if providerSpec.Value == nil {
+		return nil, nil
+	}
+
+	o, _, err := scheme.Codecs.UniversalDeserializer().Decode(providerSpec.Value.Raw, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	machineProviderSpec, ok := o.(*azureproviderv1beta1.AzureMachineProviderSpec)
+	if !ok {
+		return nil, fmt.Errorf("failed to read provider spec: %T", o)
+	}
+
+	return machineProviderSpec, nil
+}
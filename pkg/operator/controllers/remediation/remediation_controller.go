@@ -0,0 +1,208 @@
+package remediation
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	maoclient "github.com/openshift/machine-api-operator/pkg/generated/clientset/versioned"
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+)
+
+const (
+	// maxRuns is the number of times a playbook may run within
+	// rateLimitWindow.  A failure which comes back after that many runs is
+	// not one which the playbook can fix, and is left to SREs.
+	maxRuns         = 3
+	rateLimitWindow = 24 * time.Hour
+
+	// minRunInterval is the least time between two runs of a playbook, so
+	// that the checkers get to report the outcome of a run before the next
+	minRunInterval = 30 * time.Minute
+
+	// remediatedReason is the reason of a condition whose failure a playbook
+	// remediated, until the condition's checker runs again
+	remediatedReason = "Remediated"
+)
+
+// playbook remediates a specific failure which is reported in a condition of
+// the Cluster resource
+type playbook interface {
+	// Name identifies the playbook in events and logs
+	Name() string
+
+	// Flag is the operator flag which enables the playbook
+	Flag() string
+
+	// Condition is the condition type whose failure the playbook remediates
+	Condition() status.ConditionType
+
+	// Applies returns true if cond, which is False, reports the failure
+	// which the playbook remediates
+	Applies(cond *status.Condition) bool
+
+	// Run remediates the failure and returns a description of each change
+	// it made
+	Run(ctx context.Context) ([]string, error)
+}
+
+// RemediationReconciler runs remediation playbooks for the failures reported
+// in the conditions of the Cluster resource.  Each playbook is switched on by
+// its own operator flag, runs at most maxRuns times in rateLimitWindow, and
+// records every run and change as an event on the Cluster resource.
+type RemediationReconciler struct {
+	arocli   aroclient.AroV1alpha1Interface
+	recorder record.EventRecorder
+	log      *logrus.Entry
+
+	playbooks []playbook
+
+	now func() time.Time
+
+	runs map[string][]time.Time
+}
+
+func NewReconciler(log *logrus.Entry, maocli maoclient.Interface, arocli aroclient.AroV1alpha1Interface, recorder record.EventRecorder) *RemediationReconciler {
+	return &RemediationReconciler{
+		arocli:   arocli,
+		recorder: recorder,
+		log:      log,
+
+		playbooks: []playbook{
+			&machineIdentityPlaybook{
+				log:    log,
+				maocli: maocli,
+			},
+			&machineImagePlaybook{
+				log:    log,
+				maocli: maocli,
+			},
+		},
+
+		now: time.Now,
+
+		runs: map[string][]time.Time{},
+	}
+}
+
+// Reconcile runs the enabled playbooks whose failure is reported.  The run
+// history is kept in memory: an operator restart gives every playbook a fresh
+// set of runs.
+func (r *RemediationReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
+	// TODO(mj): controller-runtime master fixes the need for this (https://github.com/kubernetes-sigs/controller-runtime/blob/master/pkg/reconcile/reconcile.go#L93) but it's not yet released.
+	ctx := context.Background()
+
+	instance, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	var requeueAfter time.Duration
+
+	for _, pb := range r.playbooks {
+		if !controllers.FlagEnabled(instance, pb.Flag()) {
+			continue
+		}
+
+		cond := instance.Status.Conditions.GetCondition(pb.Condition())
+		if cond == nil || cond.Status != corev1.ConditionFalse || !pb.Applies(cond) {
+			continue
+		}
+
+		if wait := r.wait(pb.Name()); wait > 0 {
+			r.log.Infof("playbook %s is rate limited for %s", pb.Name(), wait)
+			if requeueAfter == 0 || wait < requeueAfter {
+				requeueAfter = wait
+			}
+			continue
+		}
+
+		r.runs[pb.Name()] = append(r.runs[pb.Name()], r.now())
+
+		r.log.Printf("running playbook %s for %s: %s", pb.Name(), cond.Type, strings.TrimSpace(cond.Message))
+		r.recorder.Eventf(instance, corev1.EventTypeWarning, "RemediationStarted", "running playbook %s for %s=False", pb.Name(), cond.Type)
+
+		changes, err := pb.Run(ctx)
+		for _, change := range changes {
+			r.log.Printf("%s: %s", pb.Name(), change)
+			r.recorder.Eventf(instance, corev1.EventTypeWarning, "RemediationApplied", "%s: %s", pb.Name(), change)
+		}
+		if err != nil {
+			r.log.Error(err)
+			r.recorder.Eventf(instance, corev1.EventTypeWarning, "RemediationFailed", "%s: %s", pb.Name(), err)
+			continue
+		}
+
+		if len(changes) == 0 {
+			r.recorder.Eventf(instance, corev1.EventTypeNormal, "RemediationCompleted", "%s: nothing to change", pb.Name())
+			continue
+		}
+
+		// the condition stays False until its checker confirms the fix on its
+		// next run; the new message no longer matches the playbook
+		err = controllers.SetCondition(ctx, r.arocli, r.recorder, &status.Condition{
+			Type:    cond.Type,
+			Status:  corev1.ConditionFalse,
+			Reason:  remediatedReason,
+			Message: fmt.Sprintf("%s: %s", pb.Name(), strings.Join(changes, "; ")),
+		}, operator.RoleMaster)
+		if err != nil {
+			r.log.Error(err)
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// wait returns how long the playbook called name must wait before it may run
+// again, or 0 if it may run now.  Runs older than rateLimitWindow are
+// forgotten.
+func (r *RemediationReconciler) wait(name string) time.Duration {
+	now := r.now()
+
+	runs := r.runs[name][:0]
+	for _, t := range r.runs[name] {
+		if now.Sub(t) < rateLimitWindow {
+			runs = append(runs, t)
+		}
+	}
+	r.runs[name] = runs
+
+	if len(runs) == 0 {
+		return 0
+	}
+
+	wait := runs[len(runs)-1].Add(minRunInterval).Sub(now)
+	if len(runs) >= maxRuns {
+		wait = runs[len(runs)-maxRuns].Add(rateLimitWindow).Sub(now)
+	}
+
+	if wait < 0 {
+		return 0
+	}
+	return wait
+}
+
+// SetupWithManager setup our manager
+func (r *RemediationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}).
+		Named(controllers.RemediationControllerName).
+		Complete(r)
+}
@@ -0,0 +1,244 @@
+package remediation
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+)
+
+const testFlag = "aro.remediation.test.enabled"
+
+type fakePlaybook struct {
+	changes []string
+	err     error
+	runs    int
+}
+
+func (p *fakePlaybook) Name() string                    { return "Test" }
+func (p *fakePlaybook) Flag() string                    { return testFlag }
+func (p *fakePlaybook) Condition() status.ConditionType { return arov1alpha1.MachineValid }
+
+func (p *fakePlaybook) Applies(cond *status.Condition) bool {
+	return cond.Reason == "CheckFailed"
+}
+
+func (p *fakePlaybook) Run(ctx context.Context) ([]string, error) {
+	p.runs++
+	return p.changes, p.err
+}
+
+func TestReconcile(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		flag       string
+		condition  *status.Condition
+		changes    []string
+		err        error
+		wantRuns   int
+		wantEvents []string
+		wantReason string
+	}{
+		{
+			name: "runs",
+			flag: "true",
+			condition: &status.Condition{
+				Type:   arov1alpha1.MachineValid,
+				Status: corev1.ConditionFalse,
+				Reason: "CheckFailed",
+			},
+			changes:  []string{"fixed something"},
+			wantRuns: 1,
+			wantEvents: []string{
+				"Warning RemediationStarted running playbook Test for MachineValid=False",
+				"Warning RemediationApplied Test: fixed something",
+			},
+			wantReason: remediatedReason,
+		},
+		{
+			name: "reports failure",
+			flag: "true",
+			condition: &status.Condition{
+				Type:   arov1alpha1.MachineValid,
+				Status: corev1.ConditionFalse,
+				Reason: "CheckFailed",
+			},
+			err:      errors.New("random error"),
+			wantRuns: 1,
+			wantEvents: []string{
+				"Warning RemediationStarted running playbook Test for MachineValid=False",
+				"Warning RemediationFailed Test: random error",
+			},
+			wantReason: "CheckFailed",
+		},
+		{
+			name: "flag not set",
+			condition: &status.Condition{
+				Type:   arov1alpha1.MachineValid,
+				Status: corev1.ConditionFalse,
+				Reason: "CheckFailed",
+			},
+		},
+		{
+			name: "condition true",
+			flag: "true",
+			condition: &status.Condition{
+				Type:   arov1alpha1.MachineValid,
+				Status: corev1.ConditionTrue,
+				Reason: "CheckDone",
+			},
+		},
+		{
+			name: "other failure",
+			flag: "true",
+			condition: &status.Condition{
+				Type:   arov1alpha1.MachineValid,
+				Status: corev1.ConditionFalse,
+				Reason: "Other",
+			},
+		},
+		{
+			name: "no condition",
+			flag: "true",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					OperatorFlags: map[string]string{},
+				},
+			}
+			if tt.flag != "" {
+				instance.Spec.OperatorFlags[testFlag] = tt.flag
+			}
+			if tt.condition != nil {
+				instance.Status.Conditions = status.Conditions{*tt.condition}
+			}
+
+			pb := &fakePlaybook{
+				changes: tt.changes,
+				err:     tt.err,
+			}
+			recorder := record.NewFakeRecorder(10)
+			arocli := arofake.NewSimpleClientset(instance).AroV1alpha1()
+
+			r := &RemediationReconciler{
+				arocli:    arocli,
+				recorder:  recorder,
+				log:       utillog.GetLogger(),
+				playbooks: []playbook{pb},
+				now:       time.Now,
+				runs:      map[string][]time.Time{},
+			}
+
+			_, err := r.Reconcile(ctrl.Request{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if pb.runs != tt.wantRuns {
+				t.Error(pb.runs)
+			}
+
+			close(recorder.Events)
+			var events []string
+			for event := range recorder.Events {
+				events = append(events, event)
+			}
+			if !reflect.DeepEqual(events, tt.wantEvents) {
+				t.Error(events)
+			}
+
+			if tt.wantReason != "" {
+				cluster, err := arocli.Clusters().Get(context.Background(), arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				cond := cluster.Status.Conditions.GetCondition(arov1alpha1.MachineValid)
+				if cond.Status != corev1.ConditionFalse || cond.Reason != status.ConditionReason(tt.wantReason) {
+					t.Error(cond)
+				}
+			}
+		})
+	}
+}
+
+func TestWait(t *testing.T) {
+	now := time.Now()
+
+	for _, tt := range []struct {
+		name     string
+		runs     []time.Time
+		want     time.Duration
+		wantRuns int
+	}{
+		{
+			name: "never run",
+		},
+		{
+			name:     "run recently",
+			runs:     []time.Time{now.Add(-10 * time.Minute)},
+			want:     minRunInterval - 10*time.Minute,
+			wantRuns: 1,
+		},
+		{
+			name:     "run a while ago",
+			runs:     []time.Time{now.Add(-time.Hour)},
+			wantRuns: 1,
+		},
+		{
+			name: "run too often",
+			runs: []time.Time{
+				now.Add(-20 * time.Hour),
+				now.Add(-10 * time.Hour),
+				now.Add(-5 * time.Hour),
+			},
+			want:     4 * time.Hour,
+			wantRuns: 3,
+		},
+		{
+			name: "old runs are forgotten",
+			runs: []time.Time{
+				now.Add(-30 * time.Hour),
+				now.Add(-10 * time.Hour),
+				now.Add(-5 * time.Hour),
+			},
+			wantRuns: 2,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &RemediationReconciler{
+				now: func() time.Time { return now },
+				runs: map[string][]time.Time{
+					"Test": tt.runs,
+				},
+			}
+
+			if got := r.wait("Test"); got != tt.want {
+				t.Error(got)
+			}
+
+			if len(r.runs["Test"]) != tt.wantRuns {
+				t.Error(len(r.runs["Test"]))
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package controllers
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+// ComponentResources returns the resource requirements of the containers of
+// component: the defaults, with any quantity which the Cluster resource
+// overrides for the component replaced
+func ComponentResources(instance *arov1alpha1.Cluster, component string, defaults corev1.ResourceRequirements) corev1.ResourceRequirements {
+	resources := *defaults.DeepCopy()
+
+	override, found := instance.Spec.ComponentResources[component]
+	if !found {
+		return resources
+	}
+
+	for name, q := range override.Requests {
+		if resources.Requests == nil {
+			resources.Requests = corev1.ResourceList{}
+		}
+		resources.Requests[name] = q.DeepCopy()
+	}
+
+	for name, q := range override.Limits {
+		if resources.Limits == nil {
+			resources.Limits = corev1.ResourceList{}
+		}
+		resources.Limits[name] = q.DeepCopy()
+	}
+
+	return resources
+}
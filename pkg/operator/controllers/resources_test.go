@@ -0,0 +1,95 @@
+package controllers
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+func TestComponentResources(t *testing.T) {
+	defaults := corev1.ResourceRequirements{
+		Limits: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("100m"),
+			corev1.ResourceMemory: resource.MustParse("100Mi"),
+		},
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("10m"),
+			corev1.ResourceMemory: resource.MustParse("50Mi"),
+		},
+	}
+
+	for _, tt := range []struct {
+		name              string
+		overrides         map[string]corev1.ResourceRequirements
+		wantRequestCPU    string
+		wantRequestMemory string
+		wantLimitCPU      string
+	}{
+		{
+			name:              "no overrides",
+			wantRequestCPU:    "10m",
+			wantRequestMemory: "50Mi",
+			wantLimitCPU:      "100m",
+		},
+		{
+			name: "other component overridden",
+			overrides: map[string]corev1.ResourceRequirements{
+				"other": {
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("1m"),
+					},
+				},
+			},
+			wantRequestCPU:    "10m",
+			wantRequestMemory: "50Mi",
+			wantLimitCPU:      "100m",
+		},
+		{
+			name: "quantities are overridden individually",
+			overrides: map[string]corev1.ResourceRequirements{
+				"component": {
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("5m"),
+					},
+					Limits: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("50m"),
+					},
+				},
+			},
+			wantRequestCPU:    "5m",
+			wantRequestMemory: "50Mi",
+			wantLimitCPU:      "50m",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &arov1alpha1.Cluster{
+				Spec: arov1alpha1.ClusterSpec{
+					ComponentResources: tt.overrides,
+				},
+			}
+
+			r := ComponentResources(instance, "component", defaults)
+
+			if r.Requests.Cpu().String() != tt.wantRequestCPU {
+				t.Error(r.Requests.Cpu())
+			}
+			if r.Requests.Memory().String() != tt.wantRequestMemory {
+				t.Error(r.Requests.Memory())
+			}
+			if r.Limits.Cpu().String() != tt.wantLimitCPU {
+				t.Error(r.Limits.Cpu())
+			}
+
+			// the defaults must not be modified
+			if defaults.Requests.Cpu().String() != "10m" {
+				t.Error(defaults.Requests.Cpu())
+			}
+		})
+	}
+}
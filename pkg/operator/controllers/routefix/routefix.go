@@ -15,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/priorityclass"
 	"github.com/Azure/ARO-RP/pkg/util/version"
 )
 
@@ -87,7 +88,8 @@ func (r *RouteFixReconciler) resources(ctx context.Context, cluster *arov1alpha1
 								},
 							},
 						},
-						HostNetwork: true,
+						HostNetwork:       true,
+						PriorityClassName: priorityclass.NodeCritical,
 						Tolerations: []v1.Toleration{
 							{
 								Effect:   v1.TaintEffectNoExecute,
@@ -0,0 +1,215 @@
+package servicemonitor
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	rbacv1 "k8s.io/api/rbac/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+	"github.com/Azure/ARO-RP/pkg/util/dynamichelper"
+	_ "github.com/Azure/ARO-RP/pkg/util/scheme"
+)
+
+const (
+	// MetricsPort is the port on which the master operator serves its
+	// metrics
+	MetricsPort = 8383
+
+	// monitoringNamespace is where the cluster monitoring stack runs
+	monitoringNamespace = "openshift-monitoring"
+
+	// prometheusName is the name of the cluster monitoring Prometheus
+	// service account, and of the Role and RoleBinding which let it discover
+	// the operator's metrics endpoint
+	prometheusName = "prometheus-k8s"
+
+	serviceName = "aro-operator-master"
+
+	serviceMonitorGroupKind = "ServiceMonitor.monitoring.coreos.com"
+)
+
+// ServiceMonitorReconciler has the cluster monitoring stack scrape the
+// metrics of the master operator
+type ServiceMonitorReconciler struct {
+	arocli     aroclient.AroV1alpha1Interface
+	restConfig *rest.Config
+	log        *logrus.Entry
+}
+
+func NewReconciler(log *logrus.Entry, arocli aroclient.AroV1alpha1Interface, restConfig *rest.Config) *ServiceMonitorReconciler {
+	return &ServiceMonitorReconciler{
+		arocli:     arocli,
+		restConfig: restConfig,
+		log:        log,
+	}
+}
+
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=servicemonitors,verbs=get;create;update;delete
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles;rolebindings,verbs=get;create;update;delete
+
+// Reconcile creates or updates the ServiceMonitor of the master operator's
+// metrics endpoint and the Role and RoleBinding which the cluster monitoring
+// Prometheus needs to discover it, or deletes them if the ServiceMonitor is
+// switched off with aro.servicemonitor.enabled.  The operator namespace is
+// labelled for cluster monitoring by the RP.
+func (r *ServiceMonitorReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
+	// TODO(mj): controller-runtime master fixes the need for this (https://github.com/kubernetes-sigs/controller-runtime/blob/master/pkg/reconcile/reconcile.go#L93) but it's not yet released.
+	ctx := context.Background()
+
+	instance, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	dh, err := dynamichelper.New(r.log, r.restConfig)
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	if !controllers.FlagEnabled(instance, operator.FlagServiceMonitorEnabled) {
+		err = r.remove(ctx, dh)
+	} else {
+		err = r.ensure(ctx, dh, instance)
+	}
+	if err != nil {
+		r.log.Error(err)
+	}
+
+	return reconcile.Result{}, err
+}
+
+func (r *ServiceMonitorReconciler) ensure(ctx context.Context, dh dynamichelper.Interface, instance *arov1alpha1.Cluster) error {
+	sm := serviceMonitor()
+	resources := []runtime.Object{
+		role(),
+		roleBinding(),
+	}
+
+	err := dynamichelper.SetControllerReferences(append(resources, sm), instance)
+	if err != nil {
+		return err
+	}
+
+	uns, err := dynamichelper.Prepare(resources)
+	if err != nil {
+		return err
+	}
+
+	return dh.Ensure(ctx, append(uns, sm)...)
+}
+
+func (r *ServiceMonitorReconciler) remove(ctx context.Context, dh dynamichelper.Interface) error {
+	for _, gk := range []string{serviceMonitorGroupKind, "RoleBinding.rbac.authorization.k8s.io", "Role.rbac.authorization.k8s.io"} {
+		name := prometheusName
+		if gk == serviceMonitorGroupKind {
+			name = serviceName
+		}
+
+		err := dh.Delete(ctx, gk, operator.Namespace, name)
+		if err != nil && !kerrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// role returns the Role which lets Prometheus discover the endpoints of the
+// services in the operator namespace
+func role() *rbacv1.Role {
+	return &rbacv1.Role{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: rbacv1.SchemeGroupVersion.String(),
+			Kind:       "Role",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      prometheusName,
+			Namespace: operator.Namespace,
+		},
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{""},
+				Resources: []string{"services", "endpoints", "pods"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
+		},
+	}
+}
+
+func roleBinding() *rbacv1.RoleBinding {
+	return &rbacv1.RoleBinding{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: rbacv1.SchemeGroupVersion.String(),
+			Kind:       "RoleBinding",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      prometheusName,
+			Namespace: operator.Namespace,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     prometheusName,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      prometheusName,
+				Namespace: monitoringNamespace,
+			},
+		},
+	}
+}
+
+// serviceMonitor returns the ServiceMonitor of the metrics port of the master
+// operator service.  The monitoring.coreos.com types are not vendored, so it is
+// built as an unstructured object.
+func serviceMonitor() *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "monitoring.coreos.com/v1",
+			"kind":       "ServiceMonitor",
+			"metadata": map[string]interface{}{
+				"name":      serviceName,
+				"namespace": operator.Namespace,
+			},
+			"spec": map[string]interface{}{
+				"endpoints": []interface{}{
+					map[string]interface{}{
+						"port":     "metrics",
+						"interval": "30s",
+					},
+				},
+				"selector": map[string]interface{}{
+					"matchLabels": map[string]interface{}{
+						"app": serviceName,
+					},
+				},
+			},
+		},
+	}
+}
+
+// SetupWithManager setup our manager
+func (r *ServiceMonitorReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}).
+		Named(controllers.ServiceMonitorControllerName).
+		Complete(r)
+}
@@ -0,0 +1,91 @@
+package servicemonitor
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+	mock_dynamichelper "github.com/Azure/ARO-RP/pkg/util/mocks/dynamichelper"
+)
+
+func TestEnsure(t *testing.T) {
+	ctx := context.Background()
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	instance := &arov1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: arov1alpha1.SingletonClusterName,
+		},
+	}
+
+	dh := mock_dynamichelper.NewMockInterface(controller)
+	dh.EXPECT().Ensure(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, uns ...*unstructured.Unstructured) error {
+		var kinds []string
+		for _, un := range uns {
+			kinds = append(kinds, un.GroupVersionKind().GroupKind().String())
+
+			if un.GetNamespace() != operator.Namespace {
+				t.Error(un.GetNamespace())
+			}
+			if !metav1.IsControlledBy(un, instance) {
+				t.Error(un.GetOwnerReferences())
+			}
+		}
+
+		want := []string{"Role.rbac.authorization.k8s.io", "RoleBinding.rbac.authorization.k8s.io", serviceMonitorGroupKind}
+		if len(kinds) != len(want) {
+			t.Fatal(kinds)
+		}
+		for i := range want {
+			if kinds[i] != want[i] {
+				t.Error(kinds)
+			}
+		}
+
+		return nil
+	})
+
+	r := &ServiceMonitorReconciler{
+		log: utillog.GetLogger(),
+	}
+
+	err := r.ensure(ctx, dh, instance)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRemove(t *testing.T) {
+	ctx := context.Background()
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dh := mock_dynamichelper.NewMockInterface(controller)
+	dh.EXPECT().Delete(gomock.Any(), serviceMonitorGroupKind, operator.Namespace, serviceName).Return(nil)
+	dh.EXPECT().Delete(gomock.Any(), "RoleBinding.rbac.authorization.k8s.io", operator.Namespace, prometheusName).
+		Return(kerrors.NewNotFound(schema.GroupResource{}, prometheusName))
+	dh.EXPECT().Delete(gomock.Any(), "Role.rbac.authorization.k8s.io", operator.Namespace, prometheusName).Return(nil)
+
+	r := &ServiceMonitorReconciler{
+		log: utillog.GetLogger(),
+	}
+
+	err := r.remove(ctx, dh)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
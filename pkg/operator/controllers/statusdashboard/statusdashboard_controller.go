@@ -0,0 +1,198 @@
+package statusdashboard
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+)
+
+const (
+	// ConfigMapName is the name of the configmap in the operator namespace
+	// which holds the status of the managed layer of the cluster
+	ConfigMapName = "aro-status"
+
+	statusHealthy  = "Healthy"
+	statusDegraded = "Degraded"
+)
+
+// conditionStatus is the customer-facing form of an operator condition
+type conditionStatus struct {
+	Type               string      `json:"type"`
+	Status             string      `json:"status"`
+	Reason             string      `json:"reason,omitempty"`
+	Message            string      `json:"message,omitempty"`
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// StatusDashboardReconciler publishes the health of the managed layer of the
+// cluster (the operator's conditions, when its checks last ran and its
+// version) in a configmap, so that cluster admins can answer "is ARO healthy"
+// without opening a support case
+type StatusDashboardReconciler struct {
+	kubernetescli kubernetes.Interface
+	arocli        aroclient.AroV1alpha1Interface
+	log           *logrus.Entry
+}
+
+func NewReconciler(log *logrus.Entry, kubernetescli kubernetes.Interface, arocli aroclient.AroV1alpha1Interface) *StatusDashboardReconciler {
+	return &StatusDashboardReconciler{
+		kubernetescli: kubernetescli,
+		arocli:        arocli,
+		log:           log,
+	}
+}
+
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;create;update
+
+// Reconcile writes the status configmap from the Cluster resource status
+func (r *StatusDashboardReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
+	// TODO(mj): controller-runtime master fixes the need for this (https://github.com/kubernetes-sigs/controller-runtime/blob/master/pkg/reconcile/reconcile.go#L93) but it's not yet released.
+	ctx := context.Background()
+
+	instance, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	data, err := statusData(instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	err = r.ensureConfigMap(ctx, data)
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// statusData renders the status of the cluster as the configmap data
+func statusData(instance *arov1alpha1.Cluster) (map[string]string, error) {
+	var conditions []conditionStatus
+	var unhealthy int
+
+	for _, ct := range arov1alpha1.AllConditionTypes() {
+		c := instance.Status.Conditions.GetCondition(ct)
+		if c == nil {
+			continue
+		}
+
+		// all the operator's condition types are healthy when True
+		if c.Status != corev1.ConditionTrue {
+			unhealthy++
+		}
+
+		conditions = append(conditions, conditionStatus{
+			Type:               string(c.Type),
+			Status:             string(c.Status),
+			Reason:             string(c.Reason),
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
+
+	b, err := json.MarshalIndent(conditions, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	summary := statusHealthy
+	if unhealthy > 0 {
+		summary = fmt.Sprintf("%s: %d of %d checks are failing", statusDegraded, unhealthy, len(conditions))
+	}
+
+	var lastCheckTime string
+	if !instance.Status.LastHeartbeatTime.IsZero() {
+		lastCheckTime = instance.Status.LastHeartbeatTime.UTC().Format(time.RFC3339)
+	}
+
+	return map[string]string{
+		"summary":         summary,
+		"lastCheckTime":   lastCheckTime,
+		"operatorVersion": instance.Status.OperatorVersion,
+		"conditions.json": string(b),
+	}, nil
+}
+
+// ensureConfigMap creates the status configmap or updates its data, leaving
+// it alone if it is current so that its watchers aren't woken needlessly
+func (r *StatusDashboardReconciler) ensureConfigMap(ctx context.Context, data map[string]string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := r.kubernetescli.CoreV1().ConfigMaps(operator.Namespace).Get(ctx, ConfigMapName, metav1.GetOptions{})
+		if kerrors.IsNotFound(err) {
+			_, err = r.kubernetescli.CoreV1().ConfigMaps(operator.Namespace).Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      ConfigMapName,
+					Namespace: operator.Namespace,
+				},
+				Data: data,
+			}, metav1.CreateOptions{})
+			return err
+		}
+		if err != nil {
+			return err
+		}
+
+		if reflect.DeepEqual(cm.Data, data) {
+			return nil
+		}
+
+		cm.Data = data
+
+		_, err = r.kubernetescli.CoreV1().ConfigMaps(operator.Namespace).Update(ctx, cm, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// SetupWithManager setup our manager
+func (r *StatusDashboardReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// conditions are set on the status, so every change of the Cluster
+	// resource counts; the configmap is watched to undo edits and deletions
+	triggerReconcile := func(meta metav1.Object, o runtime.Object) bool {
+		if _, ok := o.(*arov1alpha1.Cluster); ok {
+			return true
+		}
+
+		return meta.GetNamespace() == operator.Namespace && meta.GetName() == ConfigMapName
+	}
+
+	isStatus := predicate.Funcs{
+		UpdateFunc:  func(e event.UpdateEvent) bool { return triggerReconcile(e.MetaNew, e.ObjectNew) },
+		CreateFunc:  func(e event.CreateEvent) bool { return triggerReconcile(e.Meta, e.Object) },
+		DeleteFunc:  func(e event.DeleteEvent) bool { return triggerReconcile(e.Meta, e.Object) },
+		GenericFunc: func(e event.GenericEvent) bool { return triggerReconcile(e.Meta, e.Object) },
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}).
+		Watches(&source.Kind{Type: &corev1.ConfigMap{}}, &handler.EnqueueRequestForObject{}).
+		WithEventFilter(isStatus).
+		Named(controllers.StatusDashboardControllerName).
+		Complete(r)
+}
@@ -0,0 +1,151 @@
+package statusdashboard
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+)
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+
+	transitionTime := metav1.NewTime(time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC))
+
+	cluster := func(conditions ...status.Condition) *arov1alpha1.Cluster {
+		return &arov1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: arov1alpha1.SingletonClusterName,
+			},
+			Status: arov1alpha1.ClusterStatus{
+				OperatorVersion:   "abcdef",
+				Conditions:        conditions,
+				LastHeartbeatTime: metav1.NewTime(time.Date(2020, 10, 1, 12, 30, 0, 0, time.UTC)),
+			},
+		}
+	}
+
+	for _, tt := range []struct {
+		name     string
+		cluster  *arov1alpha1.Cluster
+		existing []runtime.Object
+		wantData map[string]string
+	}{
+		{
+			name: "healthy, configmap created",
+			cluster: cluster(
+				status.Condition{
+					Type:               arov1alpha1.DNSValid,
+					Status:             corev1.ConditionTrue,
+					Reason:             "CheckDone",
+					LastTransitionTime: transitionTime,
+				},
+				status.Condition{
+					Type:   "RemovedCondition",
+					Status: corev1.ConditionFalse,
+				},
+			),
+			wantData: map[string]string{
+				"summary":         "Healthy",
+				"lastCheckTime":   "2020-10-01T12:30:00Z",
+				"operatorVersion": "abcdef",
+				"conditions.json": `[
+  {
+    "type": "DNSValid",
+    "status": "True",
+    "reason": "CheckDone",
+    "lastTransitionTime": "2020-10-01T12:00:00Z"
+  }
+]`,
+			},
+		},
+		{
+			name: "degraded, configmap updated",
+			cluster: cluster(
+				status.Condition{
+					Type:               arov1alpha1.InternetReachableFromMaster,
+					Status:             corev1.ConditionTrue,
+					Reason:             "CheckDone",
+					LastTransitionTime: transitionTime,
+				},
+				status.Condition{
+					Type:               arov1alpha1.DNSValid,
+					Status:             corev1.ConditionFalse,
+					Reason:             "CheckFailed",
+					Message:            "forwarding zone removed",
+					LastTransitionTime: transitionTime,
+				},
+			),
+			existing: []runtime.Object{
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      ConfigMapName,
+						Namespace: operator.Namespace,
+					},
+					Data: map[string]string{
+						"summary": "edited",
+					},
+				},
+			},
+			wantData: map[string]string{
+				"summary":         "Degraded: 1 of 2 checks are failing",
+				"lastCheckTime":   "2020-10-01T12:30:00Z",
+				"operatorVersion": "abcdef",
+				"conditions.json": `[
+  {
+    "type": "InternetReachableFromMaster",
+    "status": "True",
+    "reason": "CheckDone",
+    "lastTransitionTime": "2020-10-01T12:00:00Z"
+  },
+  {
+    "type": "DNSValid",
+    "status": "False",
+    "reason": "CheckFailed",
+    "message": "forwarding zone removed",
+    "lastTransitionTime": "2020-10-01T12:00:00Z"
+  }
+]`,
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			kubernetescli := fake.NewSimpleClientset(tt.existing...)
+
+			r := &StatusDashboardReconciler{
+				kubernetescli: kubernetescli,
+				arocli:        arofake.NewSimpleClientset(tt.cluster).AroV1alpha1(),
+				log:           logrus.NewEntry(logrus.StandardLogger()),
+			}
+
+			_, err := r.Reconcile(ctrl.Request{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cm, err := kubernetescli.CoreV1().ConfigMaps(operator.Namespace).Get(ctx, ConfigMapName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(cm.Data, tt.wantData) {
+				t.Error(cm.Data)
+			}
+		})
+	}
+}
@@ -0,0 +1,237 @@
+package supportability
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/openshift/installer/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/nodeproblemdetector"
+)
+
+// dummyMachineCIDR is the machine network which the RP writes into the
+// install config of clusters created without one; ARO does not use it, and
+// changing it is unsupported
+const dummyMachineCIDR = "127.0.0.0/8"
+
+// check runs all the supportability checks.  A check returns a non-nil error
+// only if it was unable to run; unsupported configurations are returned as
+// findings.
+func (r *SupportabilityReconciler) check(ctx context.Context) ([]arov1alpha1.UnsupportedConfiguration, error) {
+	var unsupported []arov1alpha1.UnsupportedConfiguration
+
+	for _, f := range []func(context.Context) ([]arov1alpha1.UnsupportedConfiguration, error){
+		r.checkMachineCIDR,
+		r.checkNetworkOperatorConfig,
+		r.checkAROOperatorRBAC,
+		r.checkNodeAgents,
+	} {
+		u, err := f(ctx)
+		if err != nil {
+			return nil, err
+		}
+		unsupported = append(unsupported, u...)
+	}
+
+	return unsupported, nil
+}
+
+func (r *SupportabilityReconciler) checkMachineCIDR(ctx context.Context) ([]arov1alpha1.UnsupportedConfiguration, error) {
+	cm, err := r.kubernetescli.CoreV1().ConfigMaps("kube-system").Get(ctx, "cluster-config-v1", metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var ic types.InstallConfig
+	err = yaml.Unmarshal([]byte(cm.Data["install-config"]), &ic)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	expected := dummyMachineCIDR
+	if cluster.Spec.MachineCIDR != "" {
+		expected = cluster.Spec.MachineCIDR
+	}
+
+	var cidrs []string
+	if ic.Networking != nil {
+		for _, n := range ic.Networking.MachineNetwork {
+			cidrs = append(cidrs, n.CIDR.String())
+		}
+		if ic.Networking.DeprecatedMachineCIDR != nil {
+			cidrs = append(cidrs, ic.Networking.DeprecatedMachineCIDR.String())
+		}
+	}
+
+	for _, cidr := range cidrs {
+		if cidr != expected {
+			return []arov1alpha1.UnsupportedConfiguration{
+				{
+					Type:    arov1alpha1.UnsupportedMachineCIDR,
+					Message: fmt.Sprintf("machine network is %s, expected %s", strings.Join(cidrs, ","), expected),
+				},
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *SupportabilityReconciler) checkNetworkOperatorConfig(ctx context.Context) ([]arov1alpha1.UnsupportedConfiguration, error) {
+	network, err := r.configcli.ConfigV1().Networks().Get(ctx, "cluster", metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	operatorNetwork, err := r.operatorcli.OperatorV1().Networks().Get(ctx, "cluster", metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []string
+
+	if operatorNetwork.Spec.DefaultNetwork.Type != operatorv1.NetworkTypeOpenShiftSDN {
+		messages = append(messages, fmt.Sprintf("default network type is %q", operatorNetwork.Spec.DefaultNetwork.Type))
+	}
+
+	clusterNetwork := make([]string, 0, len(operatorNetwork.Spec.ClusterNetwork))
+	for _, n := range operatorNetwork.Spec.ClusterNetwork {
+		clusterNetwork = append(clusterNetwork, fmt.Sprintf("%s/%d", n.CIDR, n.HostPrefix))
+	}
+	expectedClusterNetwork := make([]string, 0, len(network.Spec.ClusterNetwork))
+	for _, n := range network.Spec.ClusterNetwork {
+		expectedClusterNetwork = append(expectedClusterNetwork, fmt.Sprintf("%s/%d", n.CIDR, n.HostPrefix))
+	}
+	if !reflect.DeepEqual(clusterNetwork, expectedClusterNetwork) {
+		messages = append(messages, fmt.Sprintf("cluster network is %v, expected %v", clusterNetwork, expectedClusterNetwork))
+	}
+
+	if !reflect.DeepEqual(operatorNetwork.Spec.ServiceNetwork, network.Spec.ServiceNetwork) {
+		messages = append(messages, fmt.Sprintf("service network is %v, expected %v", operatorNetwork.Spec.ServiceNetwork, network.Spec.ServiceNetwork))
+	}
+
+	if len(messages) == 0 {
+		return nil, nil
+	}
+
+	return []arov1alpha1.UnsupportedConfiguration{
+		{
+			Type:    arov1alpha1.UnsupportedNetworkOperatorConfig,
+			Message: strings.Join(messages, "; "),
+		},
+	}, nil
+}
+
+func (r *SupportabilityReconciler) checkAROOperatorRBAC(ctx context.Context) ([]arov1alpha1.UnsupportedConfiguration, error) {
+	var messages []string
+
+	for _, crb := range []*rbacv1.ClusterRoleBinding{
+		expectedClusterRoleBinding("aro-operator-master", "cluster-admin"),
+		expectedClusterRoleBinding("aro-operator-worker", "aro-operator-worker"),
+	} {
+		existing, err := r.kubernetescli.RbacV1().ClusterRoleBindings().Get(ctx, crb.Name, metav1.GetOptions{})
+		switch {
+		case kerrors.IsNotFound(err):
+			messages = append(messages, fmt.Sprintf("clusterrolebinding %s is missing", crb.Name))
+			continue
+		case err != nil:
+			return nil, err
+		}
+
+		if !reflect.DeepEqual(existing.RoleRef, crb.RoleRef) ||
+			!reflect.DeepEqual(existing.Subjects, crb.Subjects) {
+			messages = append(messages, fmt.Sprintf("clusterrolebinding %s is modified", crb.Name))
+		}
+	}
+
+	_, err := r.kubernetescli.RbacV1().ClusterRoles().Get(ctx, "aro-operator-worker", metav1.GetOptions{})
+	switch {
+	case kerrors.IsNotFound(err):
+		messages = append(messages, "clusterrole aro-operator-worker is missing")
+	case err != nil:
+		return nil, err
+	}
+
+	if len(messages) == 0 {
+		return nil, nil
+	}
+
+	return []arov1alpha1.UnsupportedConfiguration{
+		{
+			Type:    arov1alpha1.UnsupportedAROOperatorRBAC,
+			Message: strings.Join(messages, "; "),
+		},
+	}, nil
+}
+
+// checkNodeAgents reports the nodes on which the node problem detector has
+// found third party agents which are known to break ARO nodes
+func (r *SupportabilityReconciler) checkNodeAgents(ctx context.Context) ([]arov1alpha1.UnsupportedConfiguration, error) {
+	nodes, err := r.kubernetescli.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []string
+	for _, node := range nodes.Items {
+		for _, c := range node.Status.Conditions {
+			if c.Type == nodeproblemdetector.ConditionUnsupportedAgentPresent && c.Status == corev1.ConditionTrue {
+				messages = append(messages, fmt.Sprintf("%s: %s", node.Name, strings.TrimSpace(c.Message)))
+			}
+		}
+	}
+
+	if len(messages) == 0 {
+		return nil, nil
+	}
+
+	sort.Strings(messages)
+
+	return []arov1alpha1.UnsupportedConfiguration{
+		{
+			Type:    arov1alpha1.UnsupportedNodeAgents,
+			Message: strings.Join(messages, "; "),
+		},
+	}, nil
+}
+
+// expectedClusterRoleBinding returns the ClusterRoleBinding, as deployed by
+// the RP, which binds the service account of the same name to role
+func expectedClusterRoleBinding(name, role string) *rbacv1.ClusterRoleBinding {
+	return &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: "rbac.authorization.k8s.io",
+			Kind:     "ClusterRole",
+			Name:     role,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      "ServiceAccount",
+				Name:      name,
+				Namespace: operator.Namespace,
+			},
+		},
+	}
+}
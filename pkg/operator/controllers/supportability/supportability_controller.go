@@ -0,0 +1,106 @@
+package supportability
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	configclient "github.com/openshift/client-go/config/clientset/versioned"
+	operatorclient "github.com/openshift/client-go/operator/clientset/versioned"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+)
+
+// SupportabilityReconciler scans the cluster for configurations which are
+// outside the ARO support policy and records them in the Cluster status,
+// where they are picked up by the RP monitor
+type SupportabilityReconciler struct {
+	kubernetescli kubernetes.Interface
+	configcli     configclient.Interface
+	operatorcli   operatorclient.Interface
+	arocli        aroclient.AroV1alpha1Interface
+	log           *logrus.Entry
+}
+
+func NewReconciler(log *logrus.Entry, kubernetescli kubernetes.Interface, configcli configclient.Interface, operatorcli operatorclient.Interface, arocli aroclient.AroV1alpha1Interface) *SupportabilityReconciler {
+	return &SupportabilityReconciler{
+		kubernetescli: kubernetescli,
+		configcli:     configcli,
+		operatorcli:   operatorcli,
+		arocli:        arocli,
+		log:           log,
+	}
+}
+
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=list
+// +kubebuilder:rbac:groups=config.openshift.io,resources=networks,verbs=get
+// +kubebuilder:rbac:groups=operator.openshift.io,resources=networks,verbs=get
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles;clusterrolebindings,verbs=get
+
+// Reconcile runs the supportability checks and updates the Cluster status
+// with their results.
+func (r *SupportabilityReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
+	// TODO(mj): controller-runtime master fixes the need for this (https://github.com/kubernetes-sigs/controller-runtime/blob/master/pkg/reconcile/reconcile.go#L93) but it's not yet released.
+	ctx := context.Background()
+	if request.Name != arov1alpha1.SingletonClusterName {
+		return reconcile.Result{}, nil
+	}
+
+	unsupported, err := r.check(ctx)
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	err = r.setSupportabilityStatus(ctx, unsupported)
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: time.Hour, Requeue: true}, nil
+}
+
+func (r *SupportabilityReconciler) setSupportabilityStatus(ctx context.Context, unsupported []arov1alpha1.UnsupportedConfiguration) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		// only update the status when the findings change, otherwise every
+		// status update would trigger another reconcile
+		if cluster.Status.Supportability != nil &&
+			reflect.DeepEqual(cluster.Status.Supportability.UnsupportedConfigurations, unsupported) {
+			return nil
+		}
+
+		cluster.Status.Supportability = &arov1alpha1.SupportabilityStatus{
+			LastTransitionTime:        metav1.Now(),
+			UnsupportedConfigurations: unsupported,
+		}
+
+		_, err = r.arocli.Clusters().UpdateStatus(ctx, cluster, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// SetupWithManager setup our mananger
+func (r *SupportabilityReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}).
+		Named(controllers.SupportabilityControllerName).
+		Complete(r)
+}
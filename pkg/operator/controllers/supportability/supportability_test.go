@@ -0,0 +1,233 @@
+package supportability
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	configfake "github.com/openshift/client-go/config/clientset/versioned/fake"
+	operatorfake "github.com/openshift/client-go/operator/clientset/versioned/fake"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/nodeproblemdetector"
+)
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+
+	installConfig := func(machineCIDR string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cluster-config-v1",
+				Namespace: "kube-system",
+			},
+			Data: map[string]string{
+				"install-config": "networking:\n  machineNetwork:\n  - cidr: " + machineCIDR + "\n",
+			},
+		}
+	}
+
+	network := &configv1.Network{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "cluster",
+		},
+		Spec: configv1.NetworkSpec{
+			ClusterNetwork: []configv1.ClusterNetworkEntry{
+				{
+					CIDR:       "10.128.0.0/14",
+					HostPrefix: 23,
+				},
+			},
+			ServiceNetwork: []string{"172.30.0.0/16"},
+		},
+	}
+
+	operatorNetwork := func(mutate func(*operatorv1.Network)) *operatorv1.Network {
+		n := &operatorv1.Network{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "cluster",
+			},
+			Spec: operatorv1.NetworkSpec{
+				ClusterNetwork: []operatorv1.ClusterNetworkEntry{
+					{
+						CIDR:       "10.128.0.0/14",
+						HostPrefix: 23,
+					},
+				},
+				ServiceNetwork: []string{"172.30.0.0/16"},
+				DefaultNetwork: operatorv1.DefaultNetworkDefinition{
+					Type: operatorv1.NetworkTypeOpenShiftSDN,
+				},
+			},
+		}
+		if mutate != nil {
+			mutate(n)
+		}
+		return n
+	}
+
+	node := func(name string, status corev1.ConditionStatus, message string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{
+					{
+						Type:    nodeproblemdetector.ConditionUnsupportedAgentPresent,
+						Status:  status,
+						Message: message,
+					},
+				},
+			},
+		}
+	}
+
+	rbac := []runtime.Object{
+		expectedClusterRoleBinding("aro-operator-master", "cluster-admin"),
+		expectedClusterRoleBinding("aro-operator-worker", "aro-operator-worker"),
+		&rbacv1.ClusterRole{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "aro-operator-worker",
+			},
+		},
+	}
+
+	for _, tt := range []struct {
+		name            string
+		kubernetescli   *fake.Clientset
+		machineCIDR     string
+		operatorNetwork *operatorv1.Network
+		want            []arov1alpha1.UnsupportedConfiguration
+	}{
+		{
+			name:            "supported",
+			kubernetescli:   fake.NewSimpleClientset(append(rbac, installConfig("127.0.0.0/8"))...),
+			operatorNetwork: operatorNetwork(nil),
+		},
+		{
+			name:            "machine cidr changed",
+			kubernetescli:   fake.NewSimpleClientset(append(rbac, installConfig("10.0.0.0/16"))...),
+			operatorNetwork: operatorNetwork(nil),
+			want: []arov1alpha1.UnsupportedConfiguration{
+				{
+					Type:    arov1alpha1.UnsupportedMachineCIDR,
+					Message: "machine network is 10.0.0.0/16, expected 127.0.0.0/8",
+				},
+			},
+		},
+		{
+			name:            "custom machine cidr",
+			kubernetescli:   fake.NewSimpleClientset(append(rbac, installConfig("10.0.0.0/22"))...),
+			machineCIDR:     "10.0.0.0/22",
+			operatorNetwork: operatorNetwork(nil),
+		},
+		{
+			name:            "custom machine cidr changed",
+			kubernetescli:   fake.NewSimpleClientset(append(rbac, installConfig("10.0.0.0/16"))...),
+			machineCIDR:     "10.0.0.0/22",
+			operatorNetwork: operatorNetwork(nil),
+			want: []arov1alpha1.UnsupportedConfiguration{
+				{
+					Type:    arov1alpha1.UnsupportedMachineCIDR,
+					Message: "machine network is 10.0.0.0/16, expected 10.0.0.0/22",
+				},
+			},
+		},
+		{
+			name:          "network operator config modified",
+			kubernetescli: fake.NewSimpleClientset(append(rbac, installConfig("127.0.0.0/8"))...),
+			operatorNetwork: operatorNetwork(func(n *operatorv1.Network) {
+				n.Spec.ServiceNetwork = []string{"172.31.0.0/16"}
+				n.Spec.DefaultNetwork.Type = operatorv1.NetworkTypeOVNKubernetes
+			}),
+			want: []arov1alpha1.UnsupportedConfiguration{
+				{
+					Type:    arov1alpha1.UnsupportedNetworkOperatorConfig,
+					Message: `default network type is "OVNKubernetes"; service network is [172.31.0.0/16], expected [172.30.0.0/16]`,
+				},
+			},
+		},
+		{
+			name: "unsupported node agents",
+			kubernetescli: fake.NewSimpleClientset(append(rbac,
+				installConfig("127.0.0.0/8"),
+				node("worker-b", corev1.ConditionTrue, "unsupported node agents found: Calico"),
+				node("worker-a", corev1.ConditionTrue, "unsupported node agents found: Calico, CrowdStrike Falcon\n"),
+				node("master-0", corev1.ConditionFalse, "no unsupported node agents found"),
+			)...),
+			operatorNetwork: operatorNetwork(nil),
+			want: []arov1alpha1.UnsupportedConfiguration{
+				{
+					Type:    arov1alpha1.UnsupportedNodeAgents,
+					Message: "worker-a: unsupported node agents found: Calico, CrowdStrike Falcon; worker-b: unsupported node agents found: Calico",
+				},
+			},
+		},
+		{
+			name: "aro rbac removed or modified",
+			kubernetescli: fake.NewSimpleClientset(
+				installConfig("127.0.0.0/8"),
+				expectedClusterRoleBinding("aro-operator-master", "view"),
+			),
+			operatorNetwork: operatorNetwork(nil),
+			want: []arov1alpha1.UnsupportedConfiguration{
+				{
+					Type:    arov1alpha1.UnsupportedAROOperatorRBAC,
+					Message: "clusterrolebinding aro-operator-master is modified; clusterrolebinding aro-operator-worker is missing; clusterrole aro-operator-worker is missing",
+				},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					MachineCIDR: tt.machineCIDR,
+				},
+			})
+
+			r := NewReconciler(logrus.NewEntry(logrus.StandardLogger()),
+				tt.kubernetescli, configfake.NewSimpleClientset(network),
+				operatorfake.NewSimpleClientset(tt.operatorNetwork), arocli.AroV1alpha1())
+
+			result, err := r.Reconcile(ctrl.Request{NamespacedName: types.NamespacedName{Name: arov1alpha1.SingletonClusterName}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(result, reconcile.Result{RequeueAfter: time.Hour, Requeue: true}) {
+				t.Error(result)
+			}
+
+			cluster, err := arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if cluster.Status.Supportability == nil {
+				t.Fatal("supportability status not set")
+			}
+			if !reflect.DeepEqual(cluster.Status.Supportability.UnsupportedConfigurations, tt.want) {
+				t.Error(cluster.Status.Supportability.UnsupportedConfigurations)
+			}
+		})
+	}
+}
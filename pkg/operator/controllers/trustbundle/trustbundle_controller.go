@@ -0,0 +1,240 @@
+package trustbundle
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/operator-framework/operator-sdk/pkg/status"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/genevalogging"
+)
+
+const (
+	loggingNamespace = "openshift-azure-logging"
+	mdsdName         = "mdsd"
+
+	// bundleHashAnnotation on the mdsd pod template holds the hash of the
+	// trusted CA bundle, so that mdsd restarts and reloads the bundle when it
+	// changes
+	bundleHashAnnotation = "aro.openshift.io/trusted-ca-bundle-hash"
+
+	// checkInterval is how often the Geneva endpoints are verified when the
+	// bundle doesn't change
+	checkInterval = 10 * time.Minute
+
+	dialTimeout = 30 * time.Second
+)
+
+// TrustBundleReconciler keeps the CA trust of mdsd fresh: it restarts mdsd
+// when the trusted CA bundle injected by the cluster network operator changes
+// (e.g. when the Microsoft PKI roots rotate), and verifies that the bundle
+// trusts the Geneva endpoints, so that a broken trust shows up as a condition
+// rather than as silently lost logs
+type TrustBundleReconciler struct {
+	kubernetescli kubernetes.Interface
+	arocli        aroclient.AroV1alpha1Interface
+	recorder      record.EventRecorder
+	log           *logrus.Entry
+}
+
+func NewReconciler(log *logrus.Entry, kubernetescli kubernetes.Interface, arocli aroclient.AroV1alpha1Interface, recorder record.EventRecorder) *TrustBundleReconciler {
+	return &TrustBundleReconciler{
+		kubernetescli: kubernetescli,
+		arocli:        arocli,
+		recorder:      recorder,
+		log:           log,
+	}
+}
+
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+// +kubebuilder:rbac:groups=apps,resources=daemonsets,verbs=get;update
+
+// Reconcile rolls the trusted CA bundle out to mdsd and reports whether it
+// verifies the Geneva endpoints in the GenevaTrustBundleValid condition
+func (r *TrustBundleReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
+	// TODO(mj): controller-runtime master fixes the need for this (https://github.com/kubernetes-sigs/controller-runtime/blob/master/pkg/reconcile/reconcile.go#L93) but it's not yet released.
+	ctx := context.Background()
+
+	instance, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if !controllers.FlagEnabled(instance, operator.FlagTrustBundleEnabled) {
+		r.log.Debug("trust bundle management is disabled")
+		return reconcile.Result{}, nil
+	}
+
+	cond := &status.Condition{
+		Type:    arov1alpha1.GenevaTrustBundleValid,
+		Status:  corev1.ConditionTrue,
+		Message: "the trusted CA bundle verifies the Geneva endpoints",
+		Reason:  "CheckDone",
+	}
+
+	bundle, err := r.bundle(ctx)
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	pool := x509.NewCertPool()
+	switch {
+	case bundle == "":
+		// the cluster network operator hasn't injected the bundle yet; the
+		// injection triggers a reconcile
+		cond.Status = corev1.ConditionFalse
+		cond.Reason = "BundleMissing"
+		cond.Message = "the trusted CA bundle has not been injected"
+
+	case !pool.AppendCertsFromPEM([]byte(bundle)):
+		cond.Status = corev1.ConditionFalse
+		cond.Reason = "BundleInvalid"
+		cond.Message = "the trusted CA bundle contains no certificates"
+
+	default:
+		err = r.rollOutBundle(ctx, bundle)
+		if err != nil {
+			r.log.Error(err)
+			return reconcile.Result{}, err
+		}
+
+		err = verifyEndpoint(instance.Spec.GenevaLogging.MonitoringEndpoint, pool)
+		if err != nil {
+			r.log.Warn(err)
+			cond.Status = corev1.ConditionFalse
+			cond.Reason = "VerificationFailed"
+			cond.Message = err.Error()
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: checkInterval}, controllers.SetCondition(ctx, r.arocli, r.recorder, cond, operator.RoleMaster)
+}
+
+// bundle returns the trusted CA bundle, or "" if it hasn't been injected yet
+func (r *TrustBundleReconciler) bundle(ctx context.Context) (string, error) {
+	cm, err := r.kubernetescli.CoreV1().ConfigMaps(loggingNamespace).Get(ctx, genevalogging.TrustedCABundleConfigMapName, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return cm.Data[genevalogging.TrustedCABundleKey], nil
+}
+
+// rollOutBundle annotates the mdsd pod template with the hash of the bundle.
+// mdsd reads the bundle when it starts, so a changed hash rolls out the new
+// bundle by restarting mdsd.  The genevalogging controller merges its
+// daemonset onto the existing one, so it leaves the annotation alone.
+func (r *TrustBundleReconciler) rollOutBundle(ctx context.Context, bundle string) error {
+	h := sha256.Sum256([]byte(bundle))
+	hash := hex.EncodeToString(h[:])
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		ds, err := r.kubernetescli.AppsV1().DaemonSets(loggingNamespace).Get(ctx, mdsdName, metav1.GetOptions{})
+		if kerrors.IsNotFound(err) {
+			// the genevalogging controller hasn't created mdsd yet, and mdsd
+			// will read the current bundle when it starts
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if ds.Spec.Template.Annotations[bundleHashAnnotation] == hash {
+			return nil
+		}
+
+		if ds.Spec.Template.Annotations == nil {
+			ds.Spec.Template.Annotations = map[string]string{}
+		}
+		ds.Spec.Template.Annotations[bundleHashAnnotation] = hash
+
+		r.log.Infof("rolling out trusted CA bundle %s to mdsd", hash)
+		_, err = r.kubernetescli.AppsV1().DaemonSets(loggingNamespace).Update(ctx, ds, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// verifyEndpoint checks that pool verifies the certificate which endpoint
+// serves
+func verifyEndpoint(endpoint string, pool *x509.CertPool) error {
+	if endpoint == "" {
+		return nil
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return err
+	}
+
+	port := u.Port()
+	if port == "" {
+		port = "443"
+	}
+
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: dialTimeout}, "tcp", net.JoinHostPort(u.Hostname(), port), &tls.Config{
+		RootCAs:    pool,
+		ServerName: u.Hostname(),
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %s", u.Host, err)
+	}
+
+	return conn.Close()
+}
+
+// SetupWithManager setup our manager
+func (r *TrustBundleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	triggerReconcile := func(meta metav1.Object, o runtime.Object) bool {
+		if _, ok := o.(*arov1alpha1.Cluster); ok {
+			return true
+		}
+
+		return meta.GetNamespace() == loggingNamespace && meta.GetName() == genevalogging.TrustedCABundleConfigMapName
+	}
+
+	isBundle := predicate.Funcs{
+		UpdateFunc:  func(e event.UpdateEvent) bool { return triggerReconcile(e.MetaNew, e.ObjectNew) },
+		CreateFunc:  func(e event.CreateEvent) bool { return triggerReconcile(e.Meta, e.Object) },
+		DeleteFunc:  func(e event.DeleteEvent) bool { return triggerReconcile(e.Meta, e.Object) },
+		GenericFunc: func(e event.GenericEvent) bool { return triggerReconcile(e.Meta, e.Object) },
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}).
+		Watches(&source.Kind{Type: &corev1.ConfigMap{}}, &handler.EnqueueRequestForObject{}).
+		WithEventFilter(isBundle).
+		Named(controllers.TrustBundleControllerName).
+		Complete(r)
+}
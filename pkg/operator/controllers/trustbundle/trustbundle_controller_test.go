@@ -0,0 +1,164 @@
+package trustbundle
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/genevalogging"
+	utiltls "github.com/Azure/ARO-RP/pkg/util/tls"
+)
+
+func TestReconcile(t *testing.T) {
+	endpoint := httptest.NewTLSServer(http.NotFoundHandler())
+	defer endpoint.Close()
+
+	endpointBundle, err := utiltls.CertAsBytes(endpoint.Certificate())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, otherCerts, err := utiltls.GenerateKeyAndCertificate("other", nil, nil, true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	otherBundle, err := utiltls.CertAsBytes(otherCerts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	configMap := func(bundle string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      genevalogging.TrustedCABundleConfigMapName,
+				Namespace: loggingNamespace,
+			},
+			Data: map[string]string{
+				genevalogging.TrustedCABundleKey: bundle,
+			},
+		}
+	}
+
+	for _, tt := range []struct {
+		name           string
+		flags          map[string]string
+		objects        []runtime.Object
+		wantCondition  *corev1.ConditionStatus
+		wantReason     string
+		wantAnnotation bool
+	}{
+		{
+			name:  "disabled",
+			flags: map[string]string{operator.FlagTrustBundleEnabled: "false"},
+			objects: []runtime.Object{
+				configMap(string(endpointBundle)),
+			},
+		},
+		{
+			name:          "bundle not injected yet",
+			wantCondition: conditionStatus(corev1.ConditionFalse),
+			wantReason:    "BundleMissing",
+		},
+		{
+			name: "bundle without certificates",
+			objects: []runtime.Object{
+				configMap("not a certificate"),
+			},
+			wantCondition: conditionStatus(corev1.ConditionFalse),
+			wantReason:    "BundleInvalid",
+		},
+		{
+			name: "bundle verifies the endpoint",
+			objects: []runtime.Object{
+				configMap(string(endpointBundle)),
+			},
+			wantCondition:  conditionStatus(corev1.ConditionTrue),
+			wantReason:     "CheckDone",
+			wantAnnotation: true,
+		},
+		{
+			name: "bundle does not verify the endpoint",
+			objects: []runtime.Object{
+				configMap(string(otherBundle)),
+			},
+			wantCondition:  conditionStatus(corev1.ConditionFalse),
+			wantReason:     "VerificationFailed",
+			wantAnnotation: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			kubernetescli := fake.NewSimpleClientset(append(tt.objects, &appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      mdsdName,
+					Namespace: loggingNamespace,
+				},
+			})...)
+
+			arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					GenevaLogging: arov1alpha1.GenevaLoggingSpec{
+						MonitoringEndpoint: endpoint.URL + "/",
+					},
+					OperatorFlags: tt.flags,
+				},
+			})
+
+			r := NewReconciler(logrus.NewEntry(logrus.StandardLogger()), kubernetescli, arocli.AroV1alpha1(), record.NewFakeRecorder(10))
+
+			_, err := r.Reconcile(ctrl.Request{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cluster, err := arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cond := cluster.Status.Conditions.GetCondition(arov1alpha1.GenevaTrustBundleValid)
+			switch {
+			case tt.wantCondition == nil && cond != nil:
+				t.Error(cond)
+			case tt.wantCondition != nil && cond == nil:
+				t.Error("condition not set")
+			case tt.wantCondition != nil && (cond.Status != *tt.wantCondition || string(cond.Reason) != tt.wantReason):
+				t.Error(cond.Status, cond.Reason, cond.Message)
+			}
+
+			ds, err := kubernetescli.AppsV1().DaemonSets(loggingNamespace).Get(ctx, mdsdName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if _, found := ds.Spec.Template.Annotations[bundleHashAnnotation]; found != tt.wantAnnotation {
+				t.Error(ds.Spec.Template.Annotations)
+			}
+		})
+	}
+}
+
+func conditionStatus(s corev1.ConditionStatus) *corev1.ConditionStatus {
+	return &s
+}
@@ -5,6 +5,7 @@ package workaround
 
 import (
 	"context"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -30,6 +31,10 @@ func (i *ifReload) IsRequired(clusterVersion *version.Version) bool {
 	return clusterVersion.Lt(i.versionFixed)
 }
 
+func (*ifReload) Expires() time.Time {
+	return time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+}
+
 func (*ifReload) Ensure(ctx context.Context) error {
 	return nil
 }
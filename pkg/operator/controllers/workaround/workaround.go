@@ -5,6 +5,7 @@ package workaround
 
 import (
 	"context"
+	"time"
 
 	"github.com/Azure/ARO-RP/pkg/util/version"
 )
@@ -15,6 +16,10 @@ type Workaround interface {
 	// IsRequired returns true when the clusterversion is indicates that the cluster
 	// is effected by the bug that the workaround fixes.
 	IsRequired(clusterVersion *version.Version) bool
+	// Expires returns the date by which the workaround is expected to be no
+	// longer required.  A workaround still required after this date is
+	// reported in the WorkaroundsNotExpired condition.
+	Expires() time.Time
 	// Ensure will apply the workaround to the cluster.
 	Ensure(context.Context) error
 	// Remove will remove the workaround from the cluster
@@ -6,22 +6,27 @@ package workaround
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"strings"
 	"time"
 
 	configv1 "github.com/openshift/api/config/v1"
 	configclient "github.com/openshift/client-go/config/clientset/versioned"
-	mcoclient "github.com/openshift/machine-config-operator/pkg/generated/clientset/versioned"
+	"github.com/operator-framework/operator-sdk/pkg/status"
 	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/Azure/ARO-RP/pkg/operator"
 	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
 	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers"
-	"github.com/Azure/ARO-RP/pkg/util/dynamichelper"
 	"github.com/Azure/ARO-RP/pkg/util/version"
 )
 
@@ -32,23 +37,24 @@ type WorkaroundReconciler struct {
 	configcli     configclient.Interface
 	arocli        aroclient.AroV1alpha1Interface
 	restConfig    *rest.Config
+	recorder      record.EventRecorder
 	workarounds   []Workaround
 	log           *logrus.Entry
-}
 
-func NewReconciler(log *logrus.Entry, kubernetescli kubernetes.Interface, configcli configclient.Interface, mcocli mcoclient.Interface, arocli aroclient.AroV1alpha1Interface, restConfig *rest.Config) *WorkaroundReconciler {
-	dh, err := dynamichelper.New(log, restConfig)
-	if err != nil {
-		panic(err)
-	}
+	now func() time.Time
+}
 
+func NewReconciler(log *logrus.Entry, kubernetescli kubernetes.Interface, configcli configclient.Interface, arocli aroclient.AroV1alpha1Interface, restConfig *rest.Config, recorder record.EventRecorder) *WorkaroundReconciler {
 	return &WorkaroundReconciler{
 		kubernetescli: kubernetescli,
 		configcli:     configcli,
 		arocli:        arocli,
 		restConfig:    restConfig,
-		workarounds:   []Workaround{NewSystemReserved(log, mcocli, dh), NewIfReload(log, kubernetescli)},
+		recorder:      recorder,
+		workarounds:   []Workaround{NewIfReload(log, kubernetescli)},
 		log:           log,
+
+		now: time.Now,
 	}
 }
 
@@ -76,8 +82,11 @@ func (r *WorkaroundReconciler) Reconcile(request ctrl.Request) (ctrl.Result, err
 		return reconcile.Result{}, err
 	}
 
+	var active []arov1alpha1.ActiveWorkaround
+	var expired []string
 	for _, wa := range r.workarounds {
-		if wa.IsRequired(clusterVersion) {
+		required := wa.IsRequired(clusterVersion)
+		if required {
 			err = wa.Ensure(ctx)
 		} else {
 			err = wa.Remove(ctx)
@@ -87,10 +96,70 @@ func (r *WorkaroundReconciler) Reconcile(request ctrl.Request) (ctrl.Result, err
 			r.log.Errorf("workaround %s returned error %v", wa.Name(), err)
 			return reconcile.Result{}, err
 		}
+
+		if required {
+			active = append(active, arov1alpha1.ActiveWorkaround{
+				Name:    wa.Name(),
+				Expires: metav1.NewTime(wa.Expires()),
+			})
+
+			if r.now().After(wa.Expires()) {
+				expired = append(expired, fmt.Sprintf("%s expired on %s", wa.Name(), wa.Expires().Format("2006-01-02")))
+			}
+		}
+	}
+
+	err = r.setActiveWorkarounds(ctx, active)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	err = r.setWorkaroundsNotExpiredCondition(ctx, expired)
+	if err != nil {
+		return reconcile.Result{}, err
 	}
+
 	return reconcile.Result{RequeueAfter: time.Hour, Requeue: true}, nil
 }
 
+func (r *WorkaroundReconciler) setActiveWorkarounds(ctx context.Context, active []arov1alpha1.ActiveWorkaround) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		// only update the status when the active workarounds change, otherwise
+		// every status update would trigger another reconcile
+		if reflect.DeepEqual(cluster.Status.ActiveWorkarounds, active) {
+			return nil
+		}
+
+		cluster.Status.ActiveWorkarounds = active
+
+		_, err = r.arocli.Clusters().UpdateStatus(ctx, cluster, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+func (r *WorkaroundReconciler) setWorkaroundsNotExpiredCondition(ctx context.Context, expired []string) error {
+	cond := &status.Condition{
+		Type:    arov1alpha1.WorkaroundsNotExpired,
+		Status:  corev1.ConditionTrue,
+		Message: "no workaround is active beyond its expiry",
+		Reason:  "CheckDone",
+	}
+
+	if len(expired) > 0 {
+		r.log.Warnf("workarounds active beyond their expiry: %s", strings.Join(expired, ", "))
+		cond.Status = corev1.ConditionFalse
+		cond.Reason = "CheckFailed"
+		cond.Message = strings.Join(expired, "\n")
+	}
+
+	return controllers.SetCondition(ctx, r.arocli, r.recorder, cond, operator.RoleMaster)
+}
+
 // SetupWithManager setup our mananger
 func (r *WorkaroundReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
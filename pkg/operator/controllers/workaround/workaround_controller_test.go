@@ -4,6 +4,7 @@ package workaround
 // Licensed under the Apache License 2.0.
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"testing"
@@ -12,10 +13,13 @@ import (
 	"github.com/golang/mock/gomock"
 	configv1 "github.com/openshift/api/config/v1"
 	fakeconfigclient "github.com/openshift/client-go/config/clientset/versioned/fake"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
 	utillog "github.com/Azure/ARO-RP/pkg/util/log"
 	mock_workaround "github.com/Azure/ARO-RP/pkg/util/mocks/operator/controllers/workaround"
 )
@@ -40,19 +44,53 @@ func clusterVersion(ver string) *configv1.ClusterVersion {
 }
 
 func TestWorkaroundReconciler(t *testing.T) {
+	now := time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC)
+	expires := time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC)
+
 	tests := []struct {
-		name    string
-		want    ctrl.Result
-		mocker  func(mw *mock_workaround.MockWorkaround)
-		wantErr bool
+		name                 string
+		want                 ctrl.Result
+		mocker               func(mw *mock_workaround.MockWorkaround)
+		wantActive           []arov1alpha1.ActiveWorkaround
+		wantConditionStatus  corev1.ConditionStatus
+		wantConditionMessage string
+		wantErr              bool
 	}{
 		{
 			name: "is required",
 			mocker: func(mw *mock_workaround.MockWorkaround) {
 				c := mw.EXPECT().IsRequired(gomock.Any()).Return(true)
 				mw.EXPECT().Ensure(gomock.Any()).After(c).Return(nil)
+				mw.EXPECT().Name().Return("test").AnyTimes()
+				mw.EXPECT().Expires().Return(expires).AnyTimes()
 			},
 			want: ctrl.Result{Requeue: true, RequeueAfter: time.Hour},
+			wantActive: []arov1alpha1.ActiveWorkaround{
+				{
+					Name:    "test",
+					Expires: metav1.NewTime(expires),
+				},
+			},
+			wantConditionStatus:  corev1.ConditionTrue,
+			wantConditionMessage: "no workaround is active beyond its expiry",
+		},
+		{
+			name: "is required beyond expiry",
+			mocker: func(mw *mock_workaround.MockWorkaround) {
+				c := mw.EXPECT().IsRequired(gomock.Any()).Return(true)
+				mw.EXPECT().Ensure(gomock.Any()).After(c).Return(nil)
+				mw.EXPECT().Name().Return("test").AnyTimes()
+				mw.EXPECT().Expires().Return(now.AddDate(0, -1, 0)).AnyTimes()
+			},
+			want: ctrl.Result{Requeue: true, RequeueAfter: time.Hour},
+			wantActive: []arov1alpha1.ActiveWorkaround{
+				{
+					Name:    "test",
+					Expires: metav1.NewTime(now.AddDate(0, -1, 0)),
+				},
+			},
+			wantConditionStatus:  corev1.ConditionFalse,
+			wantConditionMessage: "test expired on 2021-02-01",
 		},
 		{
 			name: "is not required",
@@ -60,7 +98,9 @@ func TestWorkaroundReconciler(t *testing.T) {
 				c := mw.EXPECT().IsRequired(gomock.Any()).Return(false)
 				mw.EXPECT().Remove(gomock.Any()).After(c).Return(nil)
 			},
-			want: ctrl.Result{Requeue: true, RequeueAfter: time.Hour},
+			want:                 ctrl.Result{Requeue: true, RequeueAfter: time.Hour},
+			wantConditionStatus:  corev1.ConditionTrue,
+			wantConditionMessage: "no workaround is active beyond its expiry",
 		},
 		{
 			name: "has error",
@@ -75,14 +115,24 @@ func TestWorkaroundReconciler(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
 			controller := gomock.NewController(t)
 			defer controller.Finish()
 
+			arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+			})
+
 			mwa := mock_workaround.NewMockWorkaround(controller)
 			r := &WorkaroundReconciler{
 				configcli:   fakeconfigclient.NewSimpleClientset(clusterVersion("4.4.10")),
+				arocli:      arocli.AroV1alpha1(),
 				workarounds: []Workaround{mwa},
 				log:         utillog.GetLogger(),
+				now:         func() time.Time { return now },
 			}
 			tt.mocker(mwa)
 			got, err := r.Reconcile(reconcile.Request{})
@@ -93,6 +143,29 @@ func TestWorkaroundReconciler(t *testing.T) {
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("WorkaroundReconciler.Reconcile() = %v, want %v", got, tt.want)
 			}
+			if tt.wantErr {
+				return
+			}
+
+			cluster, err := arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(cluster.Status.ActiveWorkarounds, tt.wantActive) {
+				t.Error(cluster.Status.ActiveWorkarounds)
+			}
+
+			cond := cluster.Status.Conditions.GetCondition(arov1alpha1.WorkaroundsNotExpired)
+			if cond == nil {
+				t.Fatal("condition not set")
+			}
+			if cond.Status != tt.wantConditionStatus {
+				t.Error(cond.Status)
+			}
+			if cond.Message != tt.wantConditionMessage {
+				t.Error(cond.Message)
+			}
 		})
 	}
 }
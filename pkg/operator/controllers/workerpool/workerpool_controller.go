@@ -0,0 +1,323 @@
+package workerpool
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+
+	machinev1beta1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
+	maoclient "github.com/openshift/machine-api-operator/pkg/generated/clientset/versioned"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers"
+)
+
+const (
+	// nodeLabelsAnnotation and nodeTaintsAnnotation record the node labels
+	// and taints which were applied by this controller, so that the ones
+	// which are no longer wanted can be removed without touching those added
+	// by the customer
+	nodeLabelsAnnotation = "aro.openshift.io/node-labels"
+	nodeTaintsAnnotation = "aro.openshift.io/node-taints"
+
+	machineSetLabel = "machine.openshift.io/cluster-api-machineset"
+
+	machineSetsNamespace = "openshift-machine-api"
+)
+
+// WorkerPoolReconciler keeps the node labels and taints of each worker pool
+// consistent with the cluster spec on its machinesets, machines and nodes
+type WorkerPoolReconciler struct {
+	kubernetescli kubernetes.Interface
+	maocli        maoclient.Interface
+	arocli        aroclient.AroV1alpha1Interface
+	log           *logrus.Entry
+}
+
+func NewReconciler(log *logrus.Entry, kubernetescli kubernetes.Interface, maocli maoclient.Interface, arocli aroclient.AroV1alpha1Interface) *WorkerPoolReconciler {
+	return &WorkerPoolReconciler{
+		kubernetescli: kubernetescli,
+		maocli:        maocli,
+		arocli:        arocli,
+		log:           log,
+	}
+}
+
+// Reconcile makes sure that the node labels and taints of every worker pool
+// match the cluster spec.  Requests for MachineSets and Machines are
+// reconciled as requests for the Cluster.
+func (r *WorkerPoolReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
+	// TODO(mj): controller-runtime master fixes the need for this (https://github.com/kubernetes-sigs/controller-runtime/blob/master/pkg/reconcile/reconcile.go#L93) but it's not yet released.
+	ctx := context.Background()
+
+	instance, err := r.arocli.Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	pools := map[string]*arov1alpha1.WorkerPoolSpec{}
+	for i := range instance.Spec.WorkerPools {
+		pools[instance.Spec.WorkerPools[i].Name] = &instance.Spec.WorkerPools[i]
+	}
+
+	machinesets, err := r.maocli.MachineV1beta1().MachineSets(machineSetsNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		r.log.Error(err)
+		return reconcile.Result{}, err
+	}
+
+	for _, machineset := range machinesets.Items {
+		name, ok := PoolName(&machineset)
+		if !ok {
+			continue
+		}
+
+		err = r.reconcileMachineSet(ctx, machineset.Name, pools[name])
+		if err != nil {
+			r.log.Error(err)
+			return reconcile.Result{}, err
+		}
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// reconcileMachineSet applies the metadata of pool to the machineset called
+// name and to its machines and nodes.  A nil pool removes the metadata which
+// was previously applied.
+func (r *WorkerPoolReconciler) reconcileMachineSet(ctx context.Context, name string, pool *arov1alpha1.WorkerPoolSpec) error {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		machineset, err := r.maocli.MachineV1beta1().MachineSets(machineSetsNamespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		spec := &machineset.Spec.Template.Spec
+		if !apply(&machineset.ObjectMeta, &spec.ObjectMeta.Labels, &spec.Taints, pool) {
+			return nil
+		}
+
+		r.log.Printf("updating machineset %s", name)
+		_, err = r.maocli.MachineV1beta1().MachineSets(machineSetsNamespace).Update(ctx, machineset, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	machines, err := r.maocli.MachineV1beta1().Machines(machineSetsNamespace).List(ctx, metav1.ListOptions{
+		LabelSelector: machineSetLabel + "=" + name,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, machine := range machines.Items {
+		err = r.reconcileMachine(ctx, machine.Name, pool)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reconcileMachine applies the metadata of pool to the machine called name
+// and to its node.  The machine is updated as well as the node, as the
+// machine API operator otherwise copies stale labels back onto the node.
+func (r *WorkerPoolReconciler) reconcileMachine(ctx context.Context, name string, pool *arov1alpha1.WorkerPoolSpec) error {
+	var nodeRef *corev1.ObjectReference
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		machine, err := r.maocli.MachineV1beta1().Machines(machineSetsNamespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		nodeRef = machine.Status.NodeRef
+
+		if !apply(&machine.ObjectMeta, &machine.Spec.ObjectMeta.Labels, &machine.Spec.Taints, pool) {
+			return nil
+		}
+
+		r.log.Printf("updating machine %s", name)
+		_, err = r.maocli.MachineV1beta1().Machines(machineSetsNamespace).Update(ctx, machine, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil || nodeRef == nil {
+		return err
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		node, err := r.kubernetescli.CoreV1().Nodes().Get(ctx, nodeRef.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if !apply(&node.ObjectMeta, &node.Labels, &node.Spec.Taints, pool) {
+			return nil
+		}
+
+		r.log.Printf("updating node %s", node.Name)
+		_, err = r.kubernetescli.CoreV1().Nodes().Update(ctx, node, metav1.UpdateOptions{})
+		return err
+	})
+	if kerrors.IsNotFound(err) {
+		// the node is being deleted or has not yet joined the cluster
+		return nil
+	}
+
+	return err
+}
+
+// apply sets the node labels and taints of pool on labels and taints, and
+// removes those which meta records as previously applied but which are no
+// longer wanted.  The record on meta is updated to match.  It returns whether
+// anything changed.
+func apply(meta *metav1.ObjectMeta, labels *map[string]string, taints *[]corev1.Taint, pool *arov1alpha1.WorkerPoolSpec) bool {
+	var wantLabels map[string]string
+	var wantTaints []arov1alpha1.NodeTaintSpec
+	if pool != nil {
+		wantLabels = pool.NodeLabels
+		wantTaints = pool.NodeTaints
+	}
+
+	oldAnnotations := meta.Annotations
+	oldLabels := *labels
+	oldTaints := *taints
+
+	// labels
+	newLabels := map[string]string{}
+	for k, v := range oldLabels {
+		newLabels[k] = v
+	}
+
+	for _, k := range split(meta.Annotations[nodeLabelsAnnotation]) {
+		if _, found := wantLabels[k]; !found {
+			delete(newLabels, k)
+		}
+	}
+
+	labelKeys := make([]string, 0, len(wantLabels))
+	for k, v := range wantLabels {
+		newLabels[k] = v
+		labelKeys = append(labelKeys, k)
+	}
+	sort.Strings(labelKeys)
+
+	// taints
+	applied := map[string]struct{}{}
+	for _, k := range split(meta.Annotations[nodeTaintsAnnotation]) {
+		applied[k] = struct{}{}
+	}
+
+	wanted := map[string]struct{}{}
+	taintKeys := make([]string, 0, len(wantTaints))
+	for _, t := range wantTaints {
+		k := t.Key + ":" + string(t.Effect)
+		wanted[k] = struct{}{}
+		taintKeys = append(taintKeys, k)
+	}
+
+	var newTaints []corev1.Taint
+	for _, t := range oldTaints {
+		k := t.Key + ":" + string(t.Effect)
+		if _, found := wanted[k]; found {
+			continue
+		}
+		if _, found := applied[k]; found {
+			continue
+		}
+		newTaints = append(newTaints, t)
+	}
+
+	for _, t := range wantTaints {
+		newTaints = append(newTaints, corev1.Taint{
+			Key:    t.Key,
+			Value:  t.Value,
+			Effect: corev1.TaintEffect(t.Effect),
+		})
+	}
+
+	// annotations
+	newAnnotations := map[string]string{}
+	for k, v := range oldAnnotations {
+		newAnnotations[k] = v
+	}
+	delete(newAnnotations, nodeLabelsAnnotation)
+	delete(newAnnotations, nodeTaintsAnnotation)
+	if len(labelKeys) > 0 {
+		newAnnotations[nodeLabelsAnnotation] = strings.Join(labelKeys, ",")
+	}
+	if len(taintKeys) > 0 {
+		newAnnotations[nodeTaintsAnnotation] = strings.Join(taintKeys, ",")
+	}
+
+	var changed bool
+
+	if len(newLabels) != len(oldLabels) || !reflect.DeepEqual(newLabels, oldLabels) && len(newLabels) > 0 {
+		*labels = newLabels
+		changed = true
+	}
+
+	if len(newTaints) != len(oldTaints) || !reflect.DeepEqual(newTaints, oldTaints) && len(newTaints) > 0 {
+		*taints = newTaints
+		changed = true
+	}
+
+	if len(newAnnotations) != len(oldAnnotations) || !reflect.DeepEqual(newAnnotations, oldAnnotations) && len(newAnnotations) > 0 {
+		meta.Annotations = newAnnotations
+		changed = true
+	}
+
+	return changed
+}
+
+func split(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
+
+// PoolName returns the worker pool of machineset: the "worker" pool for the
+// installer's worker machinesets, and the pool labelled by the RP for an
+// additional worker profile
+func PoolName(machineset *machinev1beta1.MachineSet) (string, bool) {
+	if name, ok := machineset.Labels[operator.WorkerProfileLabel]; ok {
+		return name, true
+	}
+
+	if machineset.Spec.Template.Labels["machine.openshift.io/cluster-api-machine-role"] == operator.RoleWorker {
+		return "worker", true
+	}
+
+	return "", false
+}
+
+// SetupWithManager setup our mananger
+func (r *WorkerPoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}).
+		Watches(&source.Kind{Type: &machinev1beta1.MachineSet{}}, &handler.EnqueueRequestForObject{}).
+		Watches(&source.Kind{Type: &machinev1beta1.Machine{}}, &handler.EnqueueRequestForObject{}).
+		Named(controllers.WorkerPoolControllerName).
+		Complete(r)
+}
@@ -0,0 +1,282 @@
+package workerpool
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	machinev1beta1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
+	maofake "github.com/openshift/machine-api-operator/pkg/generated/clientset/versioned/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+)
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+
+	machineset := func(name, role, profile string) *machinev1beta1.MachineSet {
+		ms := &machinev1beta1.MachineSet{}
+		ms.Name = name
+		ms.Namespace = machineSetsNamespace
+		ms.Spec.Template.Labels = map[string]string{
+			"machine.openshift.io/cluster-api-machine-role": role,
+		}
+		if profile != "" {
+			ms.Labels = map[string]string{
+				operator.WorkerProfileLabel: profile,
+			}
+		}
+		return ms
+	}
+
+	machine := func(name, machineset, node string) *machinev1beta1.Machine {
+		m := &machinev1beta1.Machine{}
+		m.Name = name
+		m.Namespace = machineSetsNamespace
+		m.Labels = map[string]string{
+			machineSetLabel: machineset,
+		}
+		if node != "" {
+			m.Status.NodeRef = &corev1.ObjectReference{Name: node}
+		}
+		return m
+	}
+
+	// the worker machineset carries a label added by the customer and a
+	// label which was previously applied by the operator
+	workerMachineSet := machineset("cluster-worker-eastus1", operator.RoleWorker, "")
+	workerMachineSet.Annotations = map[string]string{
+		nodeLabelsAnnotation: "stale",
+	}
+	workerMachineSet.Spec.Template.Spec.ObjectMeta.Labels = map[string]string{
+		"customer": "true",
+		"stale":    "true",
+	}
+
+	// the worker node carries a taint added by the customer and a taint which
+	// was previously applied by the operator
+	workerNode := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "worker-node",
+			Annotations: map[string]string{
+				nodeTaintsAnnotation: "stale:NoSchedule",
+			},
+		},
+		Spec: corev1.NodeSpec{
+			Taints: []corev1.Taint{
+				{
+					Key:    "customer",
+					Effect: corev1.TaintEffectNoExecute,
+				},
+				{
+					Key:    "stale",
+					Effect: corev1.TaintEffectNoSchedule,
+				},
+			},
+		},
+	}
+
+	masterMachineSet := machineset("cluster-master", operator.RoleMaster, "")
+
+	kubernetescli := fake.NewSimpleClientset(workerNode)
+	maocli := maofake.NewSimpleClientset(
+		masterMachineSet,
+		workerMachineSet,
+		machineset("cluster-gpu-eastus1", operator.RoleWorker, "gpu"),
+		machine("cluster-worker-eastus1-abcde", "cluster-worker-eastus1", "worker-node"),
+		machine("cluster-gpu-eastus1-abcde", "cluster-gpu-eastus1", "missing-node"),
+		machine("cluster-gpu-eastus1-fghij", "cluster-gpu-eastus1", ""),
+	)
+	arocli := arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: arov1alpha1.SingletonClusterName,
+		},
+		Spec: arov1alpha1.ClusterSpec{
+			WorkerPools: []arov1alpha1.WorkerPoolSpec{
+				{
+					Name: "worker",
+					NodeLabels: map[string]string{
+						"tier": "general",
+					},
+				},
+				{
+					Name: "gpu",
+					NodeTaints: []arov1alpha1.NodeTaintSpec{
+						{
+							Key:    "nvidia.com/gpu",
+							Value:  "present",
+							Effect: "NoSchedule",
+						},
+					},
+				},
+			},
+		},
+	})
+
+	r := NewReconciler(utillog.GetLogger(), kubernetescli, maocli, arocli.AroV1alpha1())
+
+	_, err := r.Reconcile(ctrl.Request{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gpuTaints := []corev1.Taint{
+		{
+			Key:    "nvidia.com/gpu",
+			Value:  "present",
+			Effect: corev1.TaintEffectNoSchedule,
+		},
+	}
+
+	ms, err := maocli.MachineV1beta1().MachineSets(machineSetsNamespace).Get(ctx, "cluster-worker-eastus1", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ms.Spec.Template.Spec.ObjectMeta.Labels, map[string]string{"customer": "true", "tier": "general"}) {
+		t.Error(ms.Spec.Template.Spec.ObjectMeta.Labels)
+	}
+	if ms.Annotations[nodeLabelsAnnotation] != "tier" {
+		t.Error(ms.Annotations)
+	}
+
+	ms, err = maocli.MachineV1beta1().MachineSets(machineSetsNamespace).Get(ctx, "cluster-gpu-eastus1", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ms.Spec.Template.Spec.Taints, gpuTaints) {
+		t.Error(ms.Spec.Template.Spec.Taints)
+	}
+	if ms.Annotations[nodeTaintsAnnotation] != "nvidia.com/gpu:NoSchedule" {
+		t.Error(ms.Annotations)
+	}
+
+	ms, err = maocli.MachineV1beta1().MachineSets(machineSetsNamespace).Get(ctx, "cluster-master", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ms, masterMachineSet) {
+		t.Error(ms)
+	}
+
+	m, err := maocli.MachineV1beta1().Machines(machineSetsNamespace).Get(ctx, "cluster-worker-eastus1-abcde", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(m.Spec.ObjectMeta.Labels, map[string]string{"tier": "general"}) {
+		t.Error(m.Spec.ObjectMeta.Labels)
+	}
+
+	for _, name := range []string{"cluster-gpu-eastus1-abcde", "cluster-gpu-eastus1-fghij"} {
+		m, err = maocli.MachineV1beta1().Machines(machineSetsNamespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(m.Spec.Taints, gpuTaints) {
+			t.Error(name, m.Spec.Taints)
+		}
+	}
+
+	node, err := kubernetescli.CoreV1().Nodes().Get(ctx, "worker-node", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if node.Labels["tier"] != "general" {
+		t.Error(node.Labels)
+	}
+	if !reflect.DeepEqual(node.Spec.Taints, []corev1.Taint{{Key: "customer", Effect: corev1.TaintEffectNoExecute}}) {
+		t.Error(node.Spec.Taints)
+	}
+	if _, found := node.Annotations[nodeTaintsAnnotation]; found {
+		t.Error(node.Annotations)
+	}
+}
+
+func TestApply(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		annotations map[string]string
+		labels      map[string]string
+		taints      []corev1.Taint
+		pool        *arov1alpha1.WorkerPoolSpec
+		wantChanged bool
+	}{
+		{
+			name: "nothing to do",
+		},
+		{
+			name: "already applied",
+			annotations: map[string]string{
+				nodeLabelsAnnotation: "a,b",
+				nodeTaintsAnnotation: "c:NoSchedule",
+			},
+			labels: map[string]string{
+				"a": "1",
+				"b": "2",
+			},
+			taints: []corev1.Taint{
+				{
+					Key:    "c",
+					Effect: corev1.TaintEffectNoSchedule,
+				},
+			},
+			pool: &arov1alpha1.WorkerPoolSpec{
+				NodeLabels: map[string]string{
+					"b": "2",
+					"a": "1",
+				},
+				NodeTaints: []arov1alpha1.NodeTaintSpec{
+					{
+						Key:    "c",
+						Effect: "NoSchedule",
+					},
+				},
+			},
+		},
+		{
+			name: "pool removed",
+			annotations: map[string]string{
+				nodeLabelsAnnotation: "a",
+			},
+			labels: map[string]string{
+				"a": "1",
+			},
+			wantChanged: true,
+		},
+		{
+			name: "label value changed",
+			annotations: map[string]string{
+				nodeLabelsAnnotation: "a",
+			},
+			labels: map[string]string{
+				"a": "1",
+			},
+			pool: &arov1alpha1.WorkerPoolSpec{
+				NodeLabels: map[string]string{
+					"a": "2",
+				},
+			},
+			wantChanged: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			meta := &metav1.ObjectMeta{Annotations: tt.annotations}
+			labels := tt.labels
+			taints := tt.taints
+
+			changed := apply(meta, &labels, &taints, tt.pool)
+			if changed != tt.wantChanged {
+				t.Error(changed)
+			}
+		})
+	}
+}
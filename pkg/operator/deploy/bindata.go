@@ -86,7 +86,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _aroOpenshiftIo_clustersYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\xcd\x72\xe3\xc8\x0d\xbe\xeb\x29\x50\xce\xc1\x87\x58\xf4\x4c\xed\x25\xd1\x6d\xca\xb3\x49\xb9\xb2\xd9\xb8\xc6\xae\xb9\xac\xf7\x00\x35\x21\x12\x71\xb3\x9b\x41\x83\xf6\x68\x52\x79\xf7\x14\xba\x49\x89\x92\xa5\xd8\xbb\x55\xd1\x45\x45\x34\xfe\x1a\x3f\x1f\x40\x2e\x96\xcb\xe5\x02\x7b\xfe\x4a\x92\x38\x86\x15\x60\xcf\xf4\x4d\x29\xd8\x53\xaa\x9e\xfe\x94\x2a\x8e\xd7\xcf\x1f\xd7\xa4\xf8\x71\xf1\xc4\xa1\x5e\xc1\xcd\x90\x34\x76\x5f\x28\xc5\x41\x1c\x7d\xa6\x0d\x07\x56\x8e\x61\xd1\x91\x62\x8d\x8a\xab\x05\x00\x86\x10\x15\x8d\x9c\xec\x11\xc0\xc5\xa0\x12\xbd\x27\x59\x36\x14\xaa\xa7\x61\x4d\xeb\x81\x7d\x4d\x92\x2d\x4c\xf6\x9f\x3f\x54\x3f\x54\x1f\x16\x00\x4e\x28\x8b\x3f\x70\x47\x49\xb1\xeb\x57\x10\x06\xef\x17\x00\x01\x3b\x5a\x81\xf3\x43\x52\x92\x54\xa1\xc4\x2a\xf6\x14\x52\xcb\x1b\xad\x38\x2e\x52\x4f\xce\x6c\x36\x12\x87\x7e\x05\xaf\xce\x8b\x86\xd1\xad\xf1\x4a\x45\x59\xa6\x78\x4e\xfa\xb7\x39\xf5\x27\x4e\x9a\x4f\x7a\x3f\x08\xfa\xbd\xe9\x4c\x4c\x1c\x9a\xc1\xa3\xec\xc8\x0b\x80\xe4\x62\x4f\x73\xad\x69\x58\xcb\x18\xaf\xd1\x6e\x52\xd4\x21\xad\xe0\xdf\xff\x59\x00\x3c\xa3\xe7\x3a\xdf\xb6\x1c\x9a\xbb\x9f\xee\x6e\xbf\xfe\x70\xef\x5a\xea\xb0\x10\x01\x6a\x4a\x4e\xb8\xcf\x7c\x93\x72\xe0\x04\xda\x12\x14\x4e\xd8\x44\xc9\x8f\x93\x8b\xf0\xe9\xee\x76\x94\xee\x25\xf6\x24\xca\x93\x07\xf6\x9b\x65\x7e\x47\x3b\xb2\x73\x69\x8e\x14\x1e\xa8\x2d\xd7\x54\x0c\x8e\x19\xa3\x1a\x52\x31\x1d\x37\xa0\x2d\x27\x10\xea\x85\x12\x85\x92\x7d\x23\x63\x80\xb8\xfe\x27\x39\xad\xe0\x9e\xc4\x04\x21\xb5\x71\xf0\xb5\x15\xc5\x33\x89\x82\x90\x8b\x4d\xe0\xef\x3b\x6d\x09\x34\x66\x33\x1e\x95\x92\x02\x07\x25\x09\xe8\x2d\x54\x03\x5d\x01\x86\x1a\x3a\xdc\x82\x90\xe9\x85\x21\xcc\x34\x64\x96\x54\xc1\xdf\xa3\x10\x70\xd8\xc4\x15\xb4\xaa\x7d\x5a\x5d\x5f\x37\xac\x53\x4d\xbb\xd8\x75\x43\x60\xdd\x5e\xe7\xca\xe4\xf5\xa0\x51\xd2\x75\x4d\xcf\xe4\xaf\x13\x37\x4b\x14\xd7\xb2\x92\xd3\x41\xe8\x1a\x7b\x5e\x66\x67\x43\x2e\xe9\xaa\xab\xff\xb0\x4b\xe8\xe5\x2c\x74\xba\xb5\xc4\x27\x15\x0e\xcd\x8e\x9c\x6b\xec\x6c\x7c\xad\xd6\x2c\x8b\x38\x8a\x15\xff\xf7\x61\x34\x92\x45\xe2\xcb\x8f\xf7\x0f\x30\x19\x2d\xa1\x2e\x51\xdd\xb3\xa6\x7d\x80\x2d\x38\x1c\x36\x24\x85\x73\x23\xb1\xcb\x5a\x28\xd4\x7d\xe4\xa0\x63\x95\x30\x05\xb5\xea\xec\x58\x2d\x73\xff\x1a\x28\xa9\xc5\xbe\x82\x9b\xdc\xc1\xb0\x26\x18\xfa\x1a\x95\xea\x0a\x6e\x03\xdc\x60\x47\xfe\x06\x13\xfd\xdf\xc3\x6b\x91\x4c\x4b\x0b\xdd\xdb\x01\x9e\x03\xcf\x21\x63\x89\xd0\x8e\x3c\x41\xc3\xc9\x4c\x8c\x1d\x75\xdf\x93\x3b\xa8\xf4\x9a\x12\x8b\x55\xa6\xa2\x92\xd5\xf3\x1c\x2d\xce\xf7\x56\xee\x2f\x27\x9f\x63\x87\x1c\x0e\xc9\x67\xae\x31\x4a\xfc\x6c\xf8\xf6\x5e\xfe\x86\x02\x3d\xe3\x4f\xb1\x69\x38\x34\xc7\x52\xe7\xdc\x82\x82\xc7\x1b\x6e\x4e\x74\xff\x4e\x18\xd5\x7a\x6e\x05\x97\xbf\x7c\x58\xfe\xf9\xd7\x3f\x56\xe5\xef\xf2\x04\xeb\x59\xef\xec\xd7\xc5\xc0\x1a\xed\xe8\xaf\x37\xf7\x3f\x86\x67\x96\x18\x3a\x0a\x7a\xca\x26\x85\xa1\x3b\x45\x5f\xc2\x67\xc6\x26\xc4\xa4\xec\xd2\x9d\xc4\xfa\x24\xcf\x03\x25\xfd\x6d\xde\x9d\x2c\x12\xfb\x15\xbc\x21\xbd\x69\xc9\x3d\x91\xfc\x96\xc0\x0e\xe2\x4f\x50\x01\x58\xa9\x3b\x79\xf0\x46\xfc\xa6\x63\x14\xc1\xed\x7b\xfd\xf7\xd1\xcd\xe6\xc9\x3b\x2c\x4d\xc0\x72\x5b\x1f\x8b\x1c\xf4\xc8\x34\xf5\x6f\x3f\x4f\x83\xe7\xd3\xf7\x41\x68\x26\x5e\xe6\x00\xcd\xa6\xe1\x3b\xec\x9f\x6e\xd6\x32\x22\xdf\x6a\xd7\xcc\x75\xd0\xb0\x71\x9d\x0c\x05\x7f\x57\xc7\xba\x18\x6a\x9e\xad\x2d\xe7\x8c\xef\xd8\x46\xe8\x26\xcd\x76\x26\x32\x70\x48\x8a\xc1\x51\xaa\x8e\xd4\x9c\x29\x83\x03\xed\x17\x7b\x3d\x7b\x6c\x2f\x63\xd4\x6e\xf6\x7a\xb0\x5e\xa6\x72\xd7\x6a\xee\x18\x0a\x19\xc7\x6e\x9d\x83\x8e\x5c\x8b\x81\x53\x97\xcb\x3b\xd4\x54\xdb\x94\x35\x84\x4f\x54\xc3\x4b\x4b\x61\xc4\x3b\x45\xf6\x69\x67\x60\x6f\xd2\x34\xda\x50\x40\xe8\x85\xa3\x30\x3c\x85\xf8\x12\x20\x0a\xbc\xe4\x71\x9e\xcf\xfa\xde\x6f\x4d\x2f\x7a\xbf\x8f\x42\x56\x06\x0d\x3f\x53\x00\x1b\x78\x15\x3c\x86\xb9\xaf\xe3\x3e\xb0\x26\xc0\x7a\xf4\x8b\xbe\xf5\x9e\x1d\xab\xdf\x96\x35\x61\x3b\xcb\x19\x68\x8b\x6a\x6e\x4b\xca\xab\x80\x8b\x5d\x1f\x43\x8e\x92\xcb\xd7\x5e\xc7\x41\x41\x50\xdb\x3c\x00\x31\xe4\xd9\xc6\x52\x66\x69\x4c\x74\xa0\x2b\xc7\x20\x0f\x4b\x83\xf9\x3c\x2a\x63\x96\x9c\xdd\x3d\x55\xf0\x8f\xe0\x68\xac\xb3\xfa\x2a\x47\xaa\x23\x0c\xa6\x32\x5f\x6e\x9f\x33\x87\x01\xc6\xd9\x69\x01\x6f\xa8\x06\x94\x35\xab\xa0\xb0\xdf\xc2\x12\xd8\xce\x5c\xec\x28\x41\x8f\xa2\x53\xcb\x7c\xba\xbb\x2d\x9b\x4d\x8b\xa5\x90\x13\x76\x04\x6b\x74\x4f\x2f\x28\x75\x5a\xe6\xb3\x4d\x94\xf2\x64\x77\x46\xe5\x35\x7b\xd6\x1c\x22\x47\x12\xc6\xac\x6d\xc7\x0b\x1c\x69\xaf\x2e\x5e\xd5\xdd\xff\x42\x32\x00\x8f\x49\x1f\x04\x43\xe2\x69\x15\x3f\x0d\x60\x9b\x28\x1d\xea\x0a\x6c\x51\x58\x2a\x77\xf4\x7b\x60\xae\xa3\x94\xb0\x39\x63\xe1\x0d\x59\x21\x4c\xa7\xc7\xd8\xb9\xc6\xfd\x92\x25\xac\x7b\x8f\x9a\x01\x21\x06\x5a\xbe\x44\xa9\xaf\xf6\xeb\xce\x89\xad\x36\x83\x1c\x2a\x35\x51\xb6\xf6\xec\x70\x48\xb4\x3b\x18\x44\xf2\x6a\x95\xd1\xa9\x82\x5b\x3d\x61\x29\xb7\x1d\x87\x9c\x3b\x36\xd9\x41\xfb\x41\xaf\x20\x0d\xae\x05\x4c\xd9\x0f\xcf\x81\xc0\x5e\x96\x9c\x7a\x68\x0c\x65\x46\x26\xab\x05\x0e\x90\x86\xae\x43\xe1\xef\xb9\x0c\x5d\x31\x3b\xf6\x5b\x76\xe8\x15\xfe\xbc\x2b\x9c\xaf\xa1\xf7\xdd\xa2\xf9\xf8\xed\x3c\xec\x21\xee\x61\xdb\xd3\x34\x4c\x4c\x78\x17\xc2\x5d\x3f\x61\x59\x90\x75\xdb\xb3\x43\xef\xb7\xd6\x6c\x53\x62\x6a\xb0\x4c\x19\x04\xa5\x36\x8a\x42\xdf\x4a\xde\x4e\xe7\xf0\x92\xd5\xee\x30\x86\x43\xcd\x96\xb7\x71\x3a\x70\x01\xbd\xc7\x0b\x5c\x07\xab\x62\xbf\x54\x19\xe8\xf1\x02\xfa\xe8\x51\x58\xb7\x15\xfc\x25\x0a\xd0\x37\xec\x7a\x4f\x57\xc0\xc7\xde\x4d\xfa\x52\x41\x50\x34\x41\x76\xdb\x92\xef\xfc\x66\x77\x35\x5a\xe0\x54\xde\xf4\x1e\x2f\xc0\x59\x4d\x71\xb2\xf6\x5b\xe3\xda\x00\xb3\x35\x68\x95\xee\x0a\x52\x3c\x32\xb0\xc7\x46\xbb\x3d\xd5\xf0\x78\x71\x1b\x46\x45\x27\x3a\xfa\xcd\x1c\x15\x28\xa4\x57\x83\xde\x56\xa8\x92\xf8\x13\x07\xa6\xf1\x15\xf9\xec\xfa\x71\x7e\x67\x31\xb4\x41\x8d\x72\x66\xf1\x7c\xff\x7e\x70\x44\xda\x7f\x40\xf8\x88\xbe\x6f\xf1\xe3\x9e\x96\xeb\x78\x39\x7e\x36\x98\x1d\x03\x94\x35\x61\x05\x96\xf1\xf1\xad\x3c\x8a\x41\x50\xa1\xec\xbb\x00\x9d\xa3\x5e\xa9\xfe\xf9\xf8\xc3\xc1\xc5\xc5\xc1\x97\x81\xfc\x38\x5b\x21\xe0\x97\x5f\x17\x45\x2b\xd5\x5f\x27\x6f\x8c\xf8\xdf\x00\x00\x00\xff\xff\x86\x5b\x86\x9c\x78\x11\x00\x00")
+var _aroOpenshiftIo_clustersYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3c\xdd\x73\xdb\xb8\x73\xef\xfa\x2b\x76\xd2\xce\x38\xe9\x59\xf2\x5d\x7f\xd3\x4e\xab\x3e\xdc\xf8\x67\xe7\xee\x3c\xbf\xc4\xe7\xb1\x7d\xd7\x87\x24\x9d\x81\xc8\x95\x88\x1a\x04\x58\x00\x94\xac\xeb\xf5\x7f\xef\x2c\x3e\xf8\x25\x92\xa2\x9c\x64\xda\x87\x44\x79\xb0\x08\x70\xb1\xbb\xd8\x6f\x2c\x34\x9b\xcf\xe7\x33\x56\xf0\xdf\x51\x1b\xae\xe4\x12\x58\xc1\xf1\xd9\xa2\xa4\x6f\x66\xf1\xf4\x2f\x66\xc1\xd5\xc5\xf6\x87\x15\x5a\xf6\xc3\xec\x89\xcb\x74\x09\x57\xa5\xb1\x2a\xbf\x47\xa3\x4a\x9d\xe0\x35\xae\xb9\xe4\x96\x2b\x39\xcb\xd1\xb2\x94\x59\xb6\x9c\x01\x30\x29\x95\x65\xf4\xd8\xd0\x57\x80\x44\x49\xab\x95\x10\xa8\xe7\x1b\x94\x8b\xa7\x72\x85\xab\x92\x8b\x14\xb5\x5b\x21\xae\xbf\xfd\x7e\xf1\x97\xc5\xf7\x33\x80\x44\xa3\x7b\xfd\x91\xe7\x68\x2c\xcb\x8b\x25\xc8\x52\x88\x19\x80\x64\x39\x2e\x21\x11\xa5\xb1\xa8\xcd\x82\x69\xb5\x50\x05\x4a\x93\xf1\xb5\x5d\x70\x35\x33\x05\x26\xb4\xe6\x46\xab\xb2\x58\xc2\xc1\xb8\x87\x10\xd0\x0a\x24\x79\x60\xee\x89\xe0\xc6\xfe\xad\xf9\xf4\x1d\x37\xd6\x8d\x14\xa2\xd4\x4c\xd4\x4b\xbb\x87\x86\xcb\x4d\x29\x98\xae\x1e\xcf\x00\x4c\xa2\x0a\x6c\x42\x35\xe5\x4a\x07\x7e\x85\x75\x8d\x65\xb6\x34\x4b\xf8\xef\xff\x99\x01\x6c\x99\xe0\xa9\xa3\xd6\x0f\x12\xba\x97\x77\x37\xbf\xff\xe5\x21\xc9\x30\x77\xfc\xa4\xc7\x29\x9a\x44\xf3\xc2\xcd\x8b\xc0\x81\x1b\xb0\x19\x82\x9f\x09\x6b\xa5\xdd\xd7\x88\x22\x5c\xde\xdd\x84\xb7\x0b\xad\x0a\xd4\x96\x47\xca\xe9\xd3\xd8\xf9\xea\x59\x67\x9d\x33\x42\xc4\xcf\x81\x94\xf6\x1a\xfd\x82\x5b\xff\x0c\x53\x30\x7e\x69\xb5\x06\x9b\x71\x03\x1a\x0b\x8d\x06\xa5\xdf\x7d\x50\x6b\x60\x12\xd4\xea\x3f\x31\xb1\x0b\x78\x40\x4d\x2f\x82\xc9\x54\x29\x52\x12\x8a\x2d\x6a\x0b\x1a\x13\xb5\x91\xfc\x8f\x0a\x9a\x01\xab\xdc\x32\x82\x59\x34\x16\xb8\xb4\xa8\x25\x13\xc4\xaa\x12\xcf\x81\xc9\x14\x72\xb6\x07\x8d\x04\x17\x4a\xd9\x80\xe0\xa6\x98\x05\xbc\x57\x1a\x81\xcb\xb5\x5a\x42\x66\x6d\x61\x96\x17\x17\x1b\x6e\xa3\x4c\x27\x2a\xcf\x4b\xc9\xed\xfe\xc2\x49\x26\x5f\x95\x56\x69\x73\x91\xe2\x16\xc5\x85\xe1\x9b\x39\xd3\x49\xc6\x2d\x26\xb6\xd4\x78\xc1\x0a\x3e\x77\xc8\x4a\x22\xca\x2c\xf2\xf4\xef\xaa\x0d\x3d\x6b\xb0\xce\xee\x69\xe3\x8d\xd5\x5c\x6e\xaa\xc7\x4e\xc6\x06\xf9\x4b\xb2\x46\xbb\xc8\xc2\x6b\x9e\xc4\x9a\x8d\xf4\x88\x38\x71\xff\xf6\xe1\x11\xe2\xa2\x9e\xd5\x9e\xab\xf5\x54\x53\x33\x98\x98\xc3\xe5\x1a\x49\x1c\xb8\x81\xb5\x56\xb9\xe3\x27\xca\xb4\x50\x5c\xda\x20\x25\x1c\xa5\x05\x53\xae\x72\x6e\x69\xe7\xfe\xab\x44\x63\x89\xf7\x0b\xb8\x72\x1a\x0c\x2b\x84\xb2\x48\x99\xc5\x74\x01\x37\x12\xae\x58\x8e\xe2\x8a\x19\xfc\xea\xec\x25\x4e\x9a\x39\xb1\xee\x38\x83\x9b\x86\x27\xfe\xf3\x13\x3d\x87\xaa\xc7\xd1\x34\xf4\xee\x44\xd0\xa8\x87\x02\x93\x96\xa4\xa7\x68\xb8\x26\xc9\xb4\xcc\x22\xc9\x73\x98\xd8\x80\xd3\xa7\x5b\xf4\x61\x89\xbe\x56\x39\xe3\x2d\xf5\x1a\x24\x23\xbc\x71\x4b\xf6\x6d\xf2\xfc\xd2\x2a\x93\x30\x81\xba\xfb\x4a\x8b\xb6\xcb\x6a\x5a\x34\x18\xc1\x42\x34\x00\x90\x36\xae\xf9\xa6\xd4\x4e\x71\x17\x00\x37\x6b\xe0\x96\xe6\x93\xe1\x3d\x77\xbc\x20\x32\x99\x55\x1a\x34\xe6\x6a\x1b\x18\xd4\x00\x51\x29\x05\xbd\xe9\x4c\x38\xa6\x8b\x0e\x62\x04\x8d\xad\x04\x2e\xc1\xea\x12\x3b\x83\x43\x9c\xa4\x4f\xce\x9e\x6f\x55\x8a\xe6\x51\x59\x26\x0e\x87\x23\x97\xc8\x56\x6c\x5a\xdb\x13\x40\x2b\x25\x7a\xa0\x02\x70\x8b\x79\xef\xc0\x20\x13\xef\x94\x12\x4e\x4e\x56\xaa\x94\xa9\xe7\x82\x2c\xf3\x15\x6a\x92\x0f\x49\x48\xd2\x1f\x0c\x76\x4a\x3f\xa1\x86\x42\xab\x35\x17\x5d\x5a\x8f\x53\x5c\xd1\x7d\x8f\x85\xe0\x09\x1b\x9c\x72\x8c\xf6\x00\x88\xcb\x2f\x03\x48\xf6\x88\xe8\x04\x61\x8d\x1f\x32\x34\xa4\x52\xfd\x20\xe6\x4d\x82\x87\x66\x70\x79\x64\x06\xa1\xd8\x3b\xd4\x6b\x18\xea\x8f\x1f\x66\x5a\xb3\xfd\xc1\xa8\x13\xf2\x6b\xb5\x93\xd7\x28\xd8\xfe\x72\x6d\x51\x5f\xa6\xbd\x54\x8c\xb2\xa0\x02\xf3\x9b\x94\x88\x29\xa6\x14\xe3\x9c\x08\x65\x88\x85\xf3\xb6\x96\x1c\x8c\x3a\x25\x38\x78\xda\x4f\xd8\xf0\xb4\x26\xe2\xb3\x89\xec\x4d\x32\x4c\x9e\x0e\xe4\x8e\xa5\xa9\x0b\x1e\x99\xb8\x1b\xd1\x83\x96\x1a\x5e\x11\xa0\x3b\x25\x78\xb2\x07\xb5\x45\xad\x79\x1a\x0c\x11\x45\x22\x69\x29\x9c\x91\x66\x7e\xc1\x1e\x11\x1e\x57\x38\x94\x64\x9b\xd2\x09\xe6\xe0\xad\x9f\x09\x66\xc7\x6d\x92\x05\x14\xc2\xa2\xa0\xd6\x6b\xe0\x6b\x30\x68\x29\x92\x59\x33\x41\x2e\x13\x1e\x69\x86\x92\x9e\x64\x03\xbb\x8c\x27\x59\xeb\x35\xc1\x8c\x75\x2f\x31\x8d\x20\x70\x6d\x81\x39\xb8\x7b\xd8\xa1\xc6\xae\x25\x6d\x72\x7c\xa5\x94\x40\x26\x7b\x66\x90\x2a\xeb\x2d\x13\x13\x48\xba\x09\x53\xc9\xe8\x67\x6a\x07\x6a\x6d\x51\xb6\x10\xd4\xa5\x34\xe4\x1a\x2c\xb9\x48\x56\x0a\x17\x2d\x50\x84\x97\xa9\x52\xbb\xb0\x8c\x42\x19\x0b\x02\x89\x12\x46\x8a\x5a\xda\x51\xc4\x07\x94\x64\x44\x4d\x5b\x28\x3b\x69\x30\x0d\x41\xd8\x65\x68\x33\xf4\xb8\xd4\x34\x20\x4b\xb2\x16\x11\xe7\xf0\x84\x7b\x4c\x61\xb5\xaf\x1e\x93\xc9\x38\x07\x5c\x6c\x16\xf0\x9e\x25\x19\x97\xe8\x80\xa3\x0e\x3b\x77\x7f\x07\x12\xb7\xa8\x69\x83\x0c\x70\x7b\x0e\x86\x82\x54\x66\x21\xc9\x98\xdc\x20\x45\x5d\x29\x12\xc4\x87\xfb\xb7\x06\x94\x6c\x39\x5a\x53\xea\x2d\xdf\xd6\xdf\x7d\x58\x65\x16\xb3\x89\x74\x27\x2a\x2f\x94\x44\x69\x63\xe6\xf5\x25\x74\x29\xc2\xba\xf7\xe6\x24\x47\x69\x4d\x98\xb1\x8a\x12\xad\xf2\xa2\xb4\x58\x87\x9e\xc1\xf4\xb8\xb9\x8b\x13\xb5\x4b\x70\x8a\x34\xfb\x46\xa6\xa2\x1f\xe6\xca\xfd\xaf\xeb\xa1\xc1\xf9\x24\x1f\x36\x1f\x97\xbf\x40\x0d\xb3\x94\x78\x2c\xe1\x3f\x5e\x7f\xfc\xee\xcf\xf9\x9b\x1f\x5f\xbf\xfe\xf0\xfd\xfc\x5f\x3f\x7d\xf7\xfa\xe3\xc2\xfd\xf1\x0f\x6f\x7e\x7c\xf3\x67\xfc\xf2\xdd\x9b\x37\xaf\x5f\x7f\xf8\xdb\xfb\x9f\x1f\xef\xde\x7e\xe2\x6f\xfe\xfc\x20\xcb\xfc\xc9\x7f\xfb\xf3\xf5\x07\x7c\xfb\x69\x22\x90\x37\x6f\x7e\xfc\xfb\x01\x84\x9e\xe7\x94\x39\x6b\x89\x16\xcd\x9c\x4b\x3b\x57\x7a\xee\x29\xe8\x0d\xa7\x7a\xb6\xfc\xec\x9d\xdb\x83\xce\x2e\xe7\xec\x99\xe7\x65\x0e\x2c\x57\xa5\xb4\x64\x40\xbb\xfb\x6e\x80\x09\xa1\x76\x98\xf6\x86\xfe\x35\x56\x14\xfd\xa7\x2a\x31\x94\x57\x25\x58\x58\x73\xd1\x8a\x2b\x2f\x72\x26\xd9\x06\xe7\x01\xfc\xbc\x02\x4f\xf9\x95\x65\x5c\xa2\xbe\x38\x9b\x1d\xd2\x30\x6a\x11\xa2\x47\xa4\xec\xe5\x9b\x70\xfd\x5f\x0a\xd7\x7d\xcc\x21\x3b\xe2\xc5\xe5\x51\xf1\x8a\x21\xcd\x82\x12\x8f\x0a\x0e\x37\xa0\x72\x6e\x2d\xa6\xae\xb8\xc1\xa0\x12\x93\x73\xe0\x6d\x2f\x14\x04\x9b\x53\x21\x82\xb9\xc4\x05\x9f\x29\x46\xe4\x56\xec\x5d\xea\xc7\xd7\x1c\xd3\x73\x50\xe4\x1f\x76\xdc\x60\x70\x5d\x3c\x2f\x04\xe6\xb1\x62\x31\xf7\xb9\x5f\xa8\x23\xfc\xbf\x14\xf6\x91\xc1\xd6\x6e\x5c\x1d\xb8\x8c\x4e\xc0\x14\xf1\xa9\x53\x7f\xf2\x9a\xde\x48\x93\x0d\xa0\xad\xab\x30\x75\x4f\xbc\xf6\xa6\xb5\x37\x6a\x39\xd2\xf8\xb0\x76\x98\xc1\x55\x12\xa7\x4d\xa6\xb9\x7c\x0a\xd6\xc6\x43\x21\x6c\x32\x64\x29\x41\x36\x39\x13\x22\x7a\x47\xf3\x6f\x8d\x15\x60\xc7\x6d\xa6\x4a\x4b\x61\x06\x4a\xab\xf7\xf0\x84\x58\x10\x20\xae\x2b\x01\xf0\x41\x09\x25\xab\xca\x3a\x89\xc1\xbc\xb0\xfb\xaa\x20\x66\x58\x4e\xa6\x8c\x19\x25\x29\xa4\xba\x52\xd2\x28\x81\xb7\xca\xf2\x35\x4f\xdc\xbe\x9b\x93\xf2\xd4\xc1\x2d\x48\x7a\x20\x2f\xc7\x36\xe9\xac\x0f\x17\x12\xde\x14\x05\x5f\x51\xba\x8d\x62\xdf\xa6\x6a\xd9\xce\xc5\x53\x2c\x84\xda\x43\xa2\x52\x84\x1c\xf5\x26\x6c\x2e\x49\x3c\x28\x19\x0a\x69\xf8\xcc\x8d\xab\x25\x79\x9c\x5d\xec\xe2\x92\xf8\x58\x5f\x72\xa1\xa7\x6c\x20\x01\x79\x69\x5c\x01\x08\x9f\xa9\xa2\x67\x30\x25\xce\x31\x59\x69\x95\xe3\xd0\xe2\x6c\x36\x29\x9b\x3e\x16\x17\xc8\xa7\x47\x7c\xb6\x7d\x63\x70\xcc\x96\xd2\xcb\xbf\x69\xf1\xb2\x77\x55\xd2\x28\xbc\x76\xff\xa1\x2c\xf3\xfe\x91\x39\xfc\x95\x49\x89\xfa\x51\x15\xa3\xe3\x7f\x55\xd6\xaa\xfc\x18\x88\x91\x59\x47\xf0\x1f\xce\xc4\x8f\xbc\x68\x5f\xca\x6d\x07\xf7\x64\x6e\xdd\xc8\xb5\xd2\xb9\x63\xf5\xc0\x8c\xf7\x8c\x12\x15\xc9\x64\xd2\xef\x67\xe6\x70\x4d\x75\xce\x64\x18\xc6\x28\xe2\xd1\xbb\x2c\x67\x13\x6b\x05\x73\xc7\xa2\xbe\xc7\xfb\x02\x4f\x31\xc9\x13\xec\xc8\x61\xb9\x21\x95\x26\x24\x1f\xa3\xc6\xe3\xfa\xf6\x21\x4c\x73\xe5\xa8\x4c\x89\x94\xd2\x4a\x66\xdb\x16\x82\xb2\x75\x97\xa8\xb9\x54\xa7\x03\x10\xdc\xdc\xc4\x9d\xea\xc0\xf5\xed\x03\x98\x50\x43\xf6\xe9\x29\x55\x92\x57\x48\x39\x4f\x37\xa3\xf9\xfd\x16\xed\x6c\xba\x96\x37\x4e\x5d\x46\x28\xa2\xea\xa7\x71\xe9\x2f\x2d\xe5\xde\x89\x9e\x28\xd6\xae\x5b\x89\xf3\x41\x2d\xb6\xfe\xa4\x58\x20\x55\xe7\x94\x3c\x6f\xbc\xd1\xa4\xd0\x59\x38\x72\x82\x62\x8b\xa7\x15\x06\xa7\x28\xc9\x40\x15\x69\x2b\xd1\xde\xa4\x47\x19\xf1\x3b\x4d\xbb\x8e\xe5\xda\xcb\x3f\x4a\x5d\xfb\xeb\x9b\xb4\xf2\xce\xc3\x7b\x71\x04\xcd\x41\x89\xdd\xa0\xc4\x2d\x7b\xa7\x36\x1b\x0a\xfc\x4e\xd8\x60\x1f\xe7\xf7\x1c\x28\x1d\x04\xbc\x67\x3e\x18\x0d\x31\xe9\xd9\x69\x88\x03\xe4\x4a\x72\xab\x68\xe8\x6d\x10\x89\xa3\xdc\x7c\x7f\xf0\x4a\xe4\xec\xcf\x8e\xdc\x4a\xb8\x82\xa4\xe4\xa9\xa1\x20\x47\x4a\x4c\xc2\x81\x08\x3c\x36\x35\xca\x29\x12\x01\x08\xaa\x66\x35\xed\x43\x0a\x57\x97\xb0\x2a\x65\x2a\xdc\x01\x19\xc5\x9b\x54\x28\x30\x90\x90\x52\xb8\x78\x00\x17\x2f\xa7\xf6\xe7\xab\x87\xb7\x72\xcb\xb5\x92\x39\xf6\xd3\x3c\x64\x82\xe7\x70\xcd\xd9\x46\x2a\x63\x79\x62\xee\xb4\xea\xd6\xf6\xe8\x33\x87\x47\x0c\x27\x9d\x93\xb1\x1b\x14\x22\xb2\xe5\x94\x0f\x0e\x18\xb1\x31\x31\x2a\xf5\xc9\x65\xfa\x51\xfe\x8d\x69\xe3\x08\xfe\x5b\x94\x56\xe9\x7d\x4f\x60\xd1\x12\xac\x9b\x6a\xe2\xfd\x3b\x12\xa9\x5d\x86\x1a\xdb\xd6\x57\x63\xa1\x34\x49\x51\x86\x35\xdc\x0e\x4c\xe8\x2a\x74\x08\xdb\xee\xef\x9a\x07\x31\x2e\xa6\xed\x9c\xc4\xa4\x0a\x8d\x3c\xb3\x61\x95\xc5\x6c\x22\x67\x86\x02\x9f\xc1\x17\xf2\x50\x12\xe3\xe9\xf8\x51\x53\x2c\x9d\xdd\x5c\xdf\x47\x15\x0b\xaf\x82\x44\xbb\x53\xfa\xa9\x61\x8c\xef\xef\x60\xa7\x95\x3d\x34\xbe\x3c\xc6\xad\x5c\x1a\xeb\x12\x03\x67\x5c\xce\xa9\xa8\x5a\xbb\x2b\xd4\x75\x5e\x07\x4a\xe2\x54\x5a\x02\x22\x03\xc2\xd9\x22\xe7\xb6\x35\xf5\x73\xbd\xac\x37\xe3\x91\x0f\xd1\x9c\xd7\xb9\x56\xaf\x37\x1b\x53\x16\x53\xae\x24\xf6\x17\x3d\x5a\x64\x3c\xf8\x79\x95\x63\xcd\x19\x2d\xe4\x8a\xa4\xe1\x8c\x2a\x40\x0a\xa8\xf4\xc0\x83\x01\xf4\x8e\xe8\x66\x0f\x1a\x8e\x8b\x54\x27\x0e\xe8\x77\xc8\x77\x58\xd9\xac\xe2\x53\x2f\x58\x00\x83\x49\xa9\xb9\xdd\xfb\x46\x8f\xb6\x50\x31\x6b\x19\x1d\x06\xd0\x6e\x70\x3b\xeb\x79\x7b\xd4\x93\x05\xa2\x7a\x9d\x74\x0f\x55\xc7\xdd\xb4\xa7\x73\x10\xda\xa0\xa0\xd6\x9f\xc0\x8b\x87\x40\xf4\xcf\x44\xf3\xcd\x54\x04\x6f\xfb\x5e\x1e\x44\x7a\x10\x66\x65\xa4\x02\x32\xfd\x5b\x10\xd3\xc6\xc6\x1e\x8c\x40\xfc\x12\xdc\x19\x8e\xed\xe9\x33\x07\x9e\xce\x86\xe1\xf6\x18\xff\x17\x7b\x8d\x68\x0a\x7e\x12\x6c\x73\x20\x56\xd3\x4a\x90\x23\xc4\xb6\xb6\xf4\xd7\xe6\x52\xc1\x24\xd1\xe1\xc4\x1e\xd6\x82\x6d\xe2\x46\x45\x84\xce\x0c\x24\xcc\x32\xa1\x36\xe7\x07\x2b\x86\x33\x2a\x0a\x53\x42\x31\x05\x54\xe5\x7f\x5c\x11\xac\x2f\xf8\xdf\x72\xe6\x44\x81\xa5\x39\x3f\x4c\xc7\xea\xb6\xa3\xa3\x3c\x2b\x34\x4f\xb8\xdc\x3c\xf2\x23\x86\xf8\xae\x9e\x17\x05\xd7\x72\x32\x61\xb6\xad\xfa\x2b\x2e\x44\x38\xb9\xf0\xe6\xca\x17\xa3\x3a\xa0\xeb\xbd\x82\x44\x15\x3e\x4c\xab\x8b\x25\xfe\x04\x9f\xca\x4e\xb1\x4c\x66\x2b\xd1\xf7\x55\x16\xdf\x70\x32\xd9\xdb\xd6\xca\x35\x4a\x63\xac\xd3\x4d\x8e\xfb\xa7\xae\xef\x6d\xfc\x5d\x5f\x07\x44\x0b\x81\x7f\xaf\xe7\x05\x99\xaa\x68\x16\x6c\x85\x82\xea\x3f\x29\x50\xfd\xd5\x3a\xa7\x45\xc7\x6a\x1d\x78\xd0\x69\x7a\x58\xc0\xe3\x50\xe3\xc8\x21\x48\xee\x4e\x3f\x0f\x20\x56\xed\x4b\x55\x6b\x45\x7b\x8d\x98\x11\x92\x77\xa3\x4a\x19\xf5\xf0\x1d\x36\x9e\x0c\x38\xa9\x16\x03\xce\x6a\x0e\x44\x2f\x75\xb0\xeb\xb1\x5a\x4a\xbd\x66\x9c\x1d\xc0\x83\x28\x89\xb1\xf6\x38\xd8\x0f\x42\xa1\x9d\x35\xb0\xe6\x48\x69\x7b\x44\xbe\x2a\x5e\xf6\x40\x3e\xa1\x9c\x49\x0d\x89\xc8\x48\x12\x81\x05\x35\x26\xcb\xdc\x03\x94\xaa\xb0\x3b\xcd\x2d\x76\x0a\x85\x12\x0f\x8a\x7b\xe3\xc1\xc8\x67\x94\xa3\x88\x47\xef\x9c\x38\x7c\xfe\xf9\xcd\x91\xa5\x46\x6c\x51\x8d\xcb\xa3\x93\xc7\xfe\x15\x46\x82\x9d\x8e\x34\xdd\x46\x48\x75\xc4\xe3\x74\x27\x1a\xd3\x03\xc9\x18\x00\x0a\x51\x62\x06\xc6\x8f\xc7\x32\x00\xb8\x5e\x63\x32\x50\xf2\x1b\x4f\x1e\xe3\xbf\x39\xdc\xaa\x87\xd0\x62\x31\x3a\xed\x4e\xe3\x1a\xf5\xc4\xc9\xb7\xea\xed\x33\x26\x65\x4f\x0e\x70\xc2\x8e\xd2\xff\x27\xdc\x2f\x3f\x17\x86\xd3\x93\xcf\x84\x32\x1e\x8d\x10\x1b\xfd\x56\x0c\x0e\x3f\xe1\x7e\xd6\x3b\x74\x4c\x70\xc7\x62\x96\x17\x55\x40\x6b\xad\x1c\x18\x74\xc2\x6d\x66\x27\xe0\xf9\x82\x6a\x68\x2f\xb4\xd0\x40\x3d\x1b\xd0\xbc\xd8\xcc\xe9\x66\xb5\xda\x39\xd5\xca\x55\xff\x5e\xd8\xcf\x69\xf9\x16\xc9\x45\x30\xed\x3a\xff\x96\xb3\x49\xa6\xa1\x85\xda\x65\x07\x88\xb7\x0b\xbb\xfa\x7b\x1d\xce\xd4\x31\x4a\xa9\x35\x4a\x3a\xc7\x64\x45\x21\x28\x5c\xb1\xaa\x19\x07\x9c\x57\x39\x13\x35\x95\x00\xa3\xd6\xb4\xe0\x13\x43\xe5\xe0\xb9\xc0\x84\x0a\x54\x56\x51\x6c\x2e\x15\x08\x25\x37\xd4\xf4\xe2\x3a\xd0\x66\xa7\x59\x14\x7c\x2e\xb8\xee\x1f\x02\x3a\x72\xcb\x99\x5d\x3a\x4c\xe6\xf6\xb0\x43\x6c\x92\x22\xbd\xd0\x91\x9c\x2c\xe3\x23\x92\x3a\xa4\x4b\x55\x03\xd7\x2f\xdc\x50\xe1\x67\x39\x1b\xd9\xec\xab\xce\xe4\x46\x54\x95\x2b\x57\x7c\x4e\xa8\x05\xdb\x6a\x26\x4d\xe8\x0a\x0b\x61\x55\xbd\xce\x39\x28\x91\x52\x08\xba\xe6\xda\xd8\x17\x48\x5c\x85\xc4\x63\xb5\x0c\x2d\xac\x34\x45\x1d\xa1\x79\x89\xa2\x39\xd2\x88\x32\xf8\xa3\xc6\xea\xb1\xcb\xa9\xd0\x6a\x25\x30\x8f\xc1\x56\xc6\xb6\x08\x86\x4b\x77\x7c\xec\x4a\xe8\x4e\x02\x73\x83\x82\x02\xbc\x84\x49\x30\x96\x0b\x41\xf2\x96\xfa\xea\xe3\xc9\x82\x46\x87\x92\x35\xd2\x43\x7d\x92\x5f\x48\xe6\x72\x34\x86\x6d\x5e\x22\x76\x10\x62\xb1\xfe\x57\xfb\xf7\xe2\xde\x47\x6f\xdc\xb8\x56\x12\x99\x56\xba\xc9\x28\xf2\x9a\xef\x94\x4e\xcf\xeb\x7e\xfb\x9e\x6b\x15\xa4\xed\x54\x50\xde\x90\x58\x51\x47\x05\x2b\x0d\x56\x03\xde\x60\x84\x2d\x5d\x84\xb3\xf1\xce\x4a\x25\x9d\xe9\x72\x49\x92\x96\x50\x3b\x84\x2a\x6d\x51\x52\x5b\x5b\x99\x64\x74\xd6\x4b\x78\x08\x2a\xdc\x51\x77\x4f\x62\x05\x6c\xd0\x56\x93\xc8\xe0\x70\x09\xa6\xcc\x73\xa6\xf9\x1f\x14\x67\xaa\xc4\x2f\x1b\x2a\x5a\x0e\x21\xb3\x78\x09\x3b\x0f\xad\xfb\xe4\x57\x87\xcf\x27\x5b\xfb\xf0\xaa\x56\x8a\x7d\x81\x31\xce\xa7\x97\x2b\x16\xc6\x09\xb1\xad\xd1\xee\x0b\x9e\x30\x41\x46\xb8\xde\x98\x94\x22\xb7\x94\x32\x66\x93\x29\x6d\xa1\xc8\xb4\xbb\x1e\xf1\x51\xd6\x5b\xed\xa8\xad\x2e\xbd\x70\x99\xba\x83\x80\xe0\x80\xb8\x0f\x05\x3f\xbe\x62\x2b\x49\x96\x53\xcc\xc9\x31\x7e\x7c\x05\x85\x12\x8c\xea\x5b\x0b\xf8\x49\x69\xc0\x67\x46\x6d\x2a\x75\x01\xb4\x02\x1e\xe1\x91\x5e\xa2\x04\x46\x2f\x52\x6f\xac\xdb\x6f\x77\xb5\xe8\x3c\xac\xc0\x0d\x25\x02\x3c\xfd\xf8\x0a\x12\x66\x1c\xd1\xa4\xd3\x6c\x25\xf6\x21\x1c\xd5\x79\x50\xf7\xe6\x02\x01\xef\x15\x89\x9b\x10\x98\xc2\xc7\x57\x37\x32\x00\x5a\xbc\x3a\x7d\x8f\xc6\x8c\x34\xf1\xa4\x34\x5f\xe0\xd4\xf5\xa8\xf5\x3e\x90\xae\x7e\x35\x35\xe1\x6e\x0e\x49\xfe\xba\xb1\xa5\xae\x2e\x2d\x93\x43\xf9\x9e\x62\x90\x6b\xe1\xab\xf5\x9a\x52\xeb\x10\x9c\x1c\xde\x9c\x3a\x33\x5e\x5a\x16\x4d\xc4\x28\x61\x74\xed\x18\xe1\xbe\x1e\xe4\x48\xb6\x9c\x9b\xbc\x6b\x52\x9c\xa2\x3b\xe9\xa0\x6d\x4e\xd1\x32\x2e\x4c\xb5\x40\xbd\x64\x4c\x41\x19\x14\x9a\x2b\xcd\xe1\x49\xaa\x9d\x24\xe1\xde\x39\x11\x70\x63\x45\x41\xe2\xa2\xa8\x1d\xb0\xe6\x82\x03\x06\x1b\xbe\x45\x09\x74\xa3\xa9\xad\x00\x95\xec\x93\x79\x4b\x03\x5e\x8d\xe6\x2c\x77\xf7\x67\xdf\xf0\x05\xde\xe1\x94\x86\x0e\x69\x49\xfb\x1a\xcd\x40\x09\x21\xc9\x56\xd4\x0f\xa4\x19\x75\x72\xd1\x5c\x19\x84\x8a\xac\x90\xcd\x94\xc1\x16\x2c\x67\xec\xdc\x6d\x28\xba\xc7\xe3\x8a\x09\xae\x07\xac\x49\xbb\x59\xc0\xaf\xe4\xca\x42\xf7\x97\x57\x99\x1c\x99\x24\x90\x8e\xb8\x8a\x1a\xe7\xda\xc2\xe5\x28\x62\x38\x75\x32\x31\xbd\xe2\x56\x33\xcd\xc5\x1e\xe6\xd4\xf0\xb4\xc2\x44\xd1\xd9\x75\xc1\x74\x55\x3b\xba\xbc\xbb\xf1\x81\x5a\xc6\x42\x8b\x0e\x75\x25\xad\x58\xf2\xb4\x63\x3a\x35\x73\x37\xb6\x56\xda\x7f\x23\x9a\x99\xe5\x2b\x2e\xa8\xc2\x4a\x36\x1a\xb5\x0c\xbb\xb6\xf7\x4d\x6c\x5d\xe8\x3d\xda\x58\xf3\xe1\x9b\x7f\xfd\xe6\x5f\xbf\xf9\xd7\x6f\xfe\xf5\xeb\xfa\x57\xb2\x28\xbf\x20\xd3\x76\x85\xcc\xf6\x19\x94\x96\x94\xbc\xeb\xce\x0e\x47\xe8\xb2\x79\x48\x59\x65\xc1\x04\xdb\xf5\x85\x0a\xa4\x54\x96\x41\x81\x9a\xab\x94\x27\x74\xa3\x83\xc4\x8a\xce\x30\xc2\x6d\x0e\x13\x18\xcd\x5c\xdb\x68\x05\x22\xe4\xc4\x06\xd2\xe8\xd8\x30\x25\xfb\x4d\x26\x7d\x45\x0d\xc1\x22\x05\xe6\xac\xaa\x77\x13\x12\xab\x6c\xc7\xb5\x96\x66\xa4\x87\x56\x85\xd3\xf6\xd9\x69\x56\xf2\xe8\xd9\x7a\xef\x15\xa9\xde\xd3\x75\x37\xb3\x71\x9a\xeb\x0e\xd8\x4d\xb8\x17\xed\xbf\x60\xd5\x28\xb5\x66\x9c\xc4\xaa\x9b\x74\x86\x89\xfe\xfe\x4b\x68\x70\x09\xf0\x7f\x27\x31\x6e\x8a\xa8\x8f\xf2\x0d\x15\x1e\x32\xcc\x5f\x12\xf3\x34\x31\x0f\x65\x19\x17\x59\x45\x2c\x94\x6e\x60\x7e\x02\xe2\xde\xa3\xee\xb2\xfd\x89\xae\x8f\x98\x52\x0e\x16\x31\x06\x28\x3a\x42\xd7\x4f\x1e\x66\x08\x19\xb9\xdc\x08\x8c\x59\x33\xac\x7d\x6d\x87\xce\x94\x06\x88\x1e\x58\x6d\x9c\x8c\x09\xae\xf5\x88\xf0\x4d\x75\xb3\x93\xc1\x0c\xdb\x9f\x68\x6c\x02\xba\x83\xe3\x1e\x8d\x81\xe1\x11\xa3\x34\x6e\x9a\xfa\x6f\xe3\x0f\x6c\x6b\xbc\x96\x1f\xb6\x97\x1c\x50\xf8\xf3\x61\x60\xa7\xbe\x4e\x45\x0b\x40\x2b\x81\x13\x10\xbe\x57\xa2\x72\xb3\xa1\x2a\x38\xa7\x7b\xf5\x41\xbe\xe6\x04\xc6\x9f\xb8\x45\x07\x1c\x46\xce\x89\xb6\xf6\x23\xd3\xfd\x8e\xf6\xcb\xba\xa1\xa8\x7c\x3d\x43\xb4\x3f\x5f\xb1\x7a\x17\x28\xba\x72\xed\x44\x07\x1a\xd5\xe2\xe8\xfb\xd6\xd4\xca\xd8\x76\x1e\x7b\x4b\x75\x79\xff\x6b\xb7\x34\x4b\xc5\x73\xe7\x85\x34\x39\xab\xd5\xbe\x8f\x84\x53\x0c\xa7\x5b\xaf\x69\x39\x5b\x03\x47\xf1\x20\x2b\x49\xfe\xb1\x8a\x8a\xe8\xec\x36\x6e\x70\x68\xaf\x72\xd7\x83\x03\x24\x83\xc2\xf5\x3e\x72\x7b\xa2\x61\xcd\x98\xc9\xfa\x9e\x77\xc8\xfa\x85\x99\x2c\xca\xeb\xc3\x2f\x97\xf3\x7f\xfc\xa7\x7f\x26\x27\x9b\x45\xd1\xf3\xd7\x17\xd6\x87\x2c\x3f\x5d\x12\x3f\x43\xf9\x06\x7f\x34\xe0\x25\x2e\xc2\xa1\xef\x7e\x3b\xc0\xef\x41\x48\x02\xe3\x39\x44\x77\x47\xb9\x04\xd6\xb7\x41\x0b\x80\x4b\x77\xfa\x42\x61\x8f\x39\x64\x10\xb0\x46\x26\x12\xc2\x59\x9a\x7e\x66\x22\xb4\xd6\x25\xa5\xd6\xaf\xe3\x5c\xf8\x0c\xc3\x46\x48\xd5\xcf\xf8\x10\xb6\xf7\x94\xdc\x68\x4c\xfd\xe0\xe2\x33\xfc\xd5\xf0\x7e\x4c\xda\x95\x68\x60\x9a\xe8\x8c\x81\x6b\xed\x46\x9b\x8a\xb8\x0b\x11\x58\x64\x74\x90\xbc\x86\x42\x70\x99\x88\x32\xde\x9c\x0a\x6c\x1a\x96\x52\x57\xa8\x63\x72\x3f\x1b\x44\x6a\x1a\x91\x4e\x5d\x3f\xef\xd4\xf6\x0e\x65\x3a\xb6\x04\xcd\xf9\xad\xa0\x1f\x20\x3a\x32\xe9\xd2\x19\x96\x74\x36\x30\xe1\x4b\x45\x07\xbd\xa6\x3e\x0e\x3a\x7e\x7c\x8d\xc8\x60\xcc\x5d\x91\x5d\x9a\x4d\x44\xf4\x05\x3e\x29\x66\x27\x03\xad\xf3\x83\x4c\x35\x65\x41\x9d\xc5\xcc\x97\x88\x96\xb3\x11\xa1\x7f\x68\x4d\xad\xbd\x48\x68\x6f\x72\x4d\x5f\x07\x2d\x63\xcd\x98\xdb\x50\x86\x44\xed\x27\xa5\x0c\xcb\x56\xba\x12\xec\x88\x99\x4d\xb7\x03\x94\xc9\xb9\x88\x79\xa8\xe4\x34\xa5\xe0\x34\x2a\x6c\x0d\x34\xaf\x5a\x58\x2e\x67\x27\x99\xf2\x16\x17\x7f\x1b\x00\x4a\x9c\x64\x6d\x6e\x84\x78\xbf\xd3\x35\x57\xa5\x9f\xaa\xb4\x86\xa7\xbe\xe3\x86\x62\x87\x00\x37\xd4\x16\x66\x2f\xb3\xab\x47\xb3\x80\x51\x8e\x35\xa6\xbc\x1c\xc0\xb8\x76\x0f\x94\x19\x8e\xa8\xcd\x98\xea\x0c\xbe\xd8\xf3\xb8\xf3\x28\xfc\x82\xd9\x12\xb6\x3f\x30\x51\x64\xec\x87\xfa\x99\xe3\xf0\x3c\xfc\xd2\x5c\x63\x98\x9a\x8e\xa9\x77\xa0\xd1\xbc\x40\xa7\xba\xc4\x73\xff\xa4\xae\xab\xb1\x84\x2e\xbf\x63\x7a\xdb\xfd\xad\xb9\x57\xaf\x5a\x3f\x26\xe7\xbe\x56\x89\xb6\x59\xc2\x87\x4f\xf4\x0b\x72\x56\x69\x4c\x83\x3d\x30\x4b\xf8\xf0\x69\xf6\xbf\x03\x00\xc0\x8e\x64\x1f\xab\x4f\x00\x00")
 
 func aroOpenshiftIo_clustersYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -106,7 +106,7 @@ func aroOpenshiftIo_clustersYaml() (*asset, error) {
 	return a, nil
 }
 
-var _masterDeploymentYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x52\xbd\x8e\xdb\x30\x0c\xde\xfd\x14\xc4\xed\xbe\x9f\xed\xa0\xed\xd0\x0b\xba\x14\x41\xd1\xf4\xba\x33\x32\x93\x08\x91\x44\x81\xa4\x83\x4b\x9f\xbe\x50\x1d\x2b\x0e\x0a\xa4\x9c\x0c\x7e\xfc\x7e\x48\x19\x4b\xf8\x45\xa2\x81\xb3\x03\x2c\x45\x9f\x4e\x2f\xdd\x31\xe4\xc1\xc1\x3b\x95\xc8\xe7\x44\xd9\xba\x44\x86\x03\x1a\xba\x0e\x20\xe2\x96\xa2\xd6\x2f\xa8\x04\x07\x28\xdc\x73\x21\x41\x63\xe9\x13\xaa\x91\x74\x00\x19\x13\xdd\xc3\xb4\xa0\x27\x07\x5c\x28\xeb\x21\xec\xac\xc7\xdf\xa3\x50\x1b\xee\xb4\x90\xaf\x26\x42\x25\x06\x8f\xea\xe0\xa5\x03\x50\x8a\xe4\x8d\x65\xb2\x4f\x68\xfe\xf0\x6d\x91\xe7\x6e\x22\x35\x41\xa3\xfd\x79\x1a\x15\x8e\x31\xe4\xfd\x47\x19\xd0\x68\x66\x27\xfc\xdc\x8c\xb2\xa7\xc9\xec\xd2\xf9\xc8\x78\xc2\x10\x71\x1b\xc9\xc1\x73\x07\x60\x94\x4a\x6c\xac\xe5\x6d\x6a\xc5\x9b\x3c\x77\x13\x01\xcc\x5b\xd6\xf2\x9c\x0d\x43\x26\x69\xe4\x1e\x3c\xa7\x84\x79\xb8\xaa\xf5\x55\xea\xaa\x2d\x7b\x5d\x62\xed\x7a\xd7\xd6\xc2\xac\x56\x48\x58\xd7\xfb\xba\x5a\xaf\x7e\xbc\xfd\x5c\xbd\x37\xe0\xdf\xf7\x6a\x50\x61\xb1\x1b\x9b\x96\xf4\x3b\x8b\x39\x78\x7d\x7e\x7d\x6e\xe8\xac\x74\x30\x2b\x97\x66\xe6\x81\x36\x37\x0f\x37\x77\x7b\xe1\x48\x8f\xc7\x71\x4b\x92\xc9\x48\x1f\x03\x3f\x4d\x81\x1d\x3c\x3c\x5c\x46\x95\xe4\x14\x3c\xbd\x79\xcf\x63\xb6\xf5\x9d\xff\xea\x6f\x58\x09\x2c\xc1\xce\x5f\x22\xaa\x4e\xc3\x7a\x56\xa3\xd4\xfb\x38\xd6\xb9\xde\x4b\xb0\xe0\x31\x5e\x08\xc6\xb1\xea\x04\xce\x8b\xbb\x1f\xe9\xec\xfe\x93\xb0\x2d\x32\xe7\x70\xb0\xfa\x0c\x6a\xda\x00\xda\xed\xc8\x9b\x83\x35\x6f\xfc\x81\x86\x31\x52\xf7\x27\x00\x00\xff\xff\xf5\xb6\xb6\x54\x6e\x03\x00\x00")
+var _masterDeploymentYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x53\x4d\x6f\xdb\x30\x0c\xbd\xfb\x57\x10\xbd\xab\x69\xd1\x1e\x02\xdd\x8a\x35\xd8\x65\xeb\x8a\x65\xdd\x9d\x91\x99\x58\x88\xbe\x40\xd1\x59\xbd\x5f\x3f\x68\xb1\x35\x07\xc1\x5c\xd8\x80\x6d\xbe\xf7\xf4\x9e\x48\x19\x93\xfd\x49\x9c\x6d\x0c\x1a\x30\xa5\xbc\x3a\xdd\x37\x47\x1b\x5a\x0d\xcf\x94\x5c\x1c\x3c\x05\x69\x3c\x09\xb6\x28\xa8\x1b\x00\x87\x3b\x72\xb9\xbc\x41\x11\x68\x40\x8e\x2a\x26\x62\x94\xc8\xca\x63\x16\xe2\x06\x20\xa0\xa7\x25\x2c\x27\x34\xa4\x21\x26\x0a\xb9\xb3\x7b\x51\xf8\xbb\x67\xaa\xe4\x26\x27\x32\xc5\x84\x29\x39\x6b\x30\x6b\xb8\x6f\x00\x32\x39\x32\x12\xb9\x20\x00\x1e\xc5\x74\x5f\x66\x79\x16\x13\x65\x61\x14\x3a\x0c\x67\x2d\x47\xe7\x6c\x38\xbc\xa5\x16\x85\x26\xb5\xc7\xf7\x6d\xcf\x07\x3a\x9b\x8d\x95\xb7\x80\x27\xb4\x0e\x77\x8e\x34\xdc\x35\x00\x42\x3e\xb9\xaa\x9a\xf7\x06\xe0\xb2\x3f\x1f\x24\x02\x98\x76\x59\x2e\x13\x83\xa0\x0d\xc4\x55\xac\xc0\x44\xef\x31\xb4\x53\x01\x40\x95\xa5\xea\x17\xf2\x61\xe6\xa4\x60\xb2\x98\x95\x66\x66\xe5\xb6\x1e\xcb\xf6\x3e\x6f\x5e\x36\xdf\x9f\x7e\x6c\x9e\x2b\x70\x3d\xaf\x0a\xa5\xc8\x72\x61\x53\x93\xbe\x46\x16\x0d\xeb\xbb\xf5\x5d\x45\xa7\x95\x3a\x91\xb4\x20\x79\x7c\x7c\xb8\x92\xfc\xa2\x5d\x17\xe3\x71\x41\xf5\xb0\xbe\x56\x79\x12\xb6\x26\xd7\xfa\x29\xba\xde\xd3\xd7\xd8\x87\xcb\xd0\xbe\x54\x5e\x51\x3a\x0d\x2b\xf1\x69\x75\x5c\x67\x35\x3a\xaa\x4c\x7c\x22\x5e\x95\x87\x0d\x07\x65\x88\x25\x5f\x19\x4d\xe4\x82\xce\x40\x26\x6c\xbf\x05\x37\x68\x10\xee\x69\x04\x42\x6c\x69\x7b\x71\x56\xa7\xaa\xe2\xe8\xe8\xf6\xd8\xef\x88\x03\x09\xe5\x5b\x1b\x57\xe7\x19\x69\xb8\xb9\x19\xa9\x7f\x83\x18\x7a\x32\xa6\x84\x7e\x59\xf8\x95\x0a\x3d\xb1\x8d\x6c\x65\xf8\xe4\x30\xe7\x33\x39\x0f\x59\xc8\x2b\xe3\xfa\xc2\x53\x86\xad\x58\x83\x6e\x14\x48\x74\x65\xc4\x36\x86\xda\x21\x05\x47\x1a\xf4\x07\x09\x47\x2e\xd4\x73\xa6\x61\xf3\x6e\xf3\xac\x57\xb4\xdf\x93\x11\x0d\x2f\x71\x6b\x3a\x6a\x7b\x37\x35\xe4\x3c\x95\x99\xdd\x42\x4f\x33\x19\x26\x99\xa8\xff\x2a\xff\xed\x43\x9d\xa3\x21\x96\xe6\xcf\x00\x13\x2f\xf8\xa6\xd2\x04\x00\x00")
 
 func masterDeploymentYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -126,7 +126,7 @@ func masterDeploymentYaml() (*asset, error) {
 	return a, nil
 }
 
-var _masterRolebindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8e\xb1\x4e\x03\x31\x0c\x40\x77\x7f\x85\x7f\x20\x87\xd8\x50\x36\x60\x60\x2f\x12\xbb\x9b\xb8\xd4\xf4\x62\x47\x8e\xd3\xa1\x5f\x8f\xaa\xa2\x5b\x90\x6e\xb5\xdf\xf3\x33\x75\xf9\x62\x1f\x62\x9a\xd1\x8f\x54\x16\x9a\x71\x36\x97\x1b\x85\x98\x2e\x97\x97\xb1\x88\x3d\x5d\x9f\xe1\x22\x5a\x33\xbe\xaf\x73\x04\xfb\xc1\x56\x7e\x13\xad\xa2\xdf\xd0\x38\xa8\x52\x50\x06\x44\xa5\xc6\x19\xc9\x2d\x59\x67\xa7\x30\x4f\x8d\xee\x02\xb8\xad\x7c\xe0\xd3\x1d\xa2\x2e\x1f\x6e\xb3\xef\x04\x01\xf1\x5f\x6f\x3b\x5f\x1e\xb3\x44\xb5\x89\xc2\x98\xc7\x1f\x2e\x31\x32\xa4\x3f\xe7\x93\xfd\x2a\x85\x5f\x4b\xb1\xa9\xb1\xfb\xd5\x63\x37\x3a\x15\xce\x68\x9d\x75\x9c\xe5\x14\x89\x6e\xd3\x79\x83\xe1\x37\x00\x00\xff\xff\x4f\x98\xa4\x7c\x24\x01\x00\x00")
+var _masterRolebindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8e\xb1\x4e\x43\x31\x0c\x45\xf7\x7c\x85\x7f\x20\x0f\xb1\xa1\x6c\xc0\xc0\x5e\x24\xf6\xdb\x3c\x97\x9a\xbe\xd8\x91\xe3\x74\xe8\xd7\xa3\xaa\x88\xa5\x52\x67\xfb\xdc\x73\xd0\xe5\x8b\x7d\x88\x69\x21\xdf\xa3\x2e\x98\x71\x34\x97\x0b\x42\x4c\x97\xd3\xcb\x58\xc4\x9e\xce\xcf\xe9\x24\xba\x16\x7a\xdf\xe6\x08\xf6\x9d\x6d\xfc\x26\xba\x8a\x7e\xa7\xc6\x81\x15\x81\x92\x88\x14\x8d\x0b\xc1\x2d\x5b\x67\x47\x98\xe7\x86\x2b\x90\xdc\x36\xde\xf1\xe1\xfa\x84\x2e\x1f\x6e\xb3\x3f\x10\x26\xa2\x3b\xdf\xff\x7c\xbd\x35\x64\xac\x4d\x34\x8d\xb9\xff\xe1\x1a\xa3\xa4\xfc\xc7\x7c\xb2\x9f\xa5\xf2\x6b\xad\x36\x35\x1e\x56\xdd\x6e\xa3\xa3\x72\x21\xeb\xac\xe3\x28\x87\xc8\xb8\x4c\xe7\x6c\x9d\x1d\x61\x9e\x7e\x07\x00\x4f\x98\xa4\x7c\x24\x01\x00\x00")
 
 func masterRolebindingYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -146,7 +146,7 @@ func masterRolebindingYaml() (*asset, error) {
 	return a, nil
 }
 
-var _masterServiceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x8d\x41\xca\xc2\x40\x0c\x46\xf7\x73\x8a\x5c\x60\xa0\xff\xae\xcc\x29\x7e\x10\xdc\x87\xe9\xa7\x1d\xb4\x93\x90\xc4\x2e\x3c\xbd\xd4\x16\x5d\xb9\x0b\xef\x7b\xbc\xb0\xb6\x33\xcc\x9b\xf4\x42\xeb\x5f\xba\xb5\x3e\x15\x3a\xc1\xd6\x56\x91\x16\x04\x4f\x1c\x5c\x12\x51\xe7\x05\x85\xd8\x24\x8b\xc2\x38\xc4\xf2\xc2\x1e\xb0\x63\x73\xe5\x8a\x42\xa2\xe8\x3e\xb7\x4b\x64\x7e\x3e\x0c\x1f\x39\xb9\xa2\x6e\x1d\xc7\x1d\x35\xc4\xb6\x9b\x88\x55\x7f\x45\x55\x2c\x7c\xb7\xf2\xf1\x7d\x8e\xd0\x37\xd8\xd7\x42\xe3\x30\x0e\x07\x08\xb6\x2b\xe2\xff\x8b\x5f\x01\x00\x00\xff\xff\x10\x70\xf6\x36\xda\x00\x00\x00")
+var _masterServiceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x8f\x51\x6a\xc3\x30\x10\x44\xff\x75\x8a\xbd\x80\xd2\x14\xe7\xc3\xe8\x14\x85\x42\xff\xd7\xca\x34\x16\xb1\xb5\x62\xb5\x4d\xa1\xa7\x2f\x71\xe4\x80\xc1\xa5\x9f\x9a\x99\xa7\x99\xe5\x92\x3e\xa0\x35\x49\x0e\x74\x7b\x75\xd7\x94\xcf\x81\xde\xa1\xb7\x14\xe1\x66\x18\x9f\xd9\x38\x38\x22\xce\x59\x8c\x2d\x49\xae\xf7\x27\x51\x7d\x84\x0e\x03\x8c\x0f\x52\x90\xeb\x98\x3e\xed\x90\xe4\x65\x71\xf2\xc5\x47\xa8\xf9\x8a\xa8\x30\x9f\x79\x46\x20\x56\xf1\x52\xa0\x6c\xa2\x7e\xe6\x6a\x50\xff\x8d\x61\x14\xb9\x2e\x69\x47\x34\xf1\x80\xa9\x55\x70\x29\xbb\x8c\x23\xfa\xf3\xbf\xe6\xd5\xc2\x11\x81\x9e\xbb\x3c\xff\x7c\x29\x9e\x61\x57\x0b\xe2\xbd\xa4\x62\x42\x34\xd1\x7f\x0b\x8b\xa8\xb5\x59\xbe\xb5\x8f\x66\x65\x11\x1e\x6e\xa0\xfe\xd8\x1f\x9b\x60\xac\x17\xd8\xdb\x56\x5e\xc1\x76\xf2\x86\x3d\x9d\xba\x3d\x74\x95\x57\x74\x86\x69\x8a\x75\x83\xf6\x5d\xbf\xcb\x76\x7d\xe7\x7e\x07\x00\x25\x07\xa5\x40\xe0\x01\x00\x00")
 
 func masterServiceYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -166,7 +166,7 @@ func masterServiceYaml() (*asset, error) {
 	return a, nil
 }
 
-var _masterServiceaccountYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\xca\x31\x8e\x02\x31\x0c\x05\xd0\x3e\xa7\xf0\x05\x52\x6c\xeb\x6e\xcf\x80\x44\xff\x95\xf9\x08\x0b\xc5\x8e\x1c\xcf\x14\x9c\x9e\x06\x51\xbf\x87\x65\x77\xe6\xb6\x70\x95\xeb\xaf\xbd\xcc\x0f\x95\x1b\xf3\xb2\xc1\xff\x31\xe2\xf4\x6a\x93\x85\x03\x05\x6d\x22\x8e\x49\x15\x64\xf4\x58\x4c\x54\x64\x9f\xd8\xc5\xfc\xda\x5e\x18\x54\x89\x45\xdf\x4f\x7b\x54\xc7\xfb\x4c\xfe\x72\xfb\x04\x00\x00\xff\xff\xe4\xf5\x04\x25\x70\x00\x00\x00")
+var _masterServiceaccountYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x70\x00\x8f\xff\x61\x70\x69\x56\x65\x72\x73\x69\x6f\x6e\x3a\x20\x76\x31\x0a\x6b\x69\x6e\x64\x3a\x20\x53\x65\x72\x76\x69\x63\x65\x41\x63\x63\x6f\x75\x6e\x74\x0a\x6d\x65\x74\x61\x64\x61\x74\x61\x3a\x0a\x20\x20\x6e\x61\x6d\x65\x3a\x20\x61\x72\x6f\x2d\x6f\x70\x65\x72\x61\x74\x6f\x72\x2d\x6d\x61\x73\x74\x65\x72\x0a\x20\x20\x6e\x61\x6d\x65\x73\x70\x61\x63\x65\x3a\x20\x6f\x70\x65\x6e\x73\x68\x69\x66\x74\x2d\x61\x7a\x75\x72\x65\x2d\x6f\x70\x65\x72\x61\x74\x6f\x72\x0a\x03\x00\xe4\xf5\x04\x25\x70\x00\x00\x00")
 
 func masterServiceaccountYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -186,7 +186,7 @@ func masterServiceaccountYaml() (*asset, error) {
 	return a, nil
 }
 
-var _namespaceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x44\xca\xb1\x0d\x02\x31\x0c\x05\xd0\x3e\x53\x58\xd7\x07\x44\x9b\x21\x28\xe9\xbf\x2e\x1f\x61\x41\xec\x28\x36\x14\x4c\x8f\xa8\xae\x7f\x98\x7a\xe3\x0a\x75\x6b\xf2\xb9\x94\xa7\x5a\x6f\x72\xc5\x60\x4c\xec\x2c\x83\x89\x8e\x44\x2b\x22\x86\xc1\x26\x3e\x69\xf1\xd0\x7b\x56\x7c\xdf\x8b\xd5\x27\x17\xd2\x57\x11\x81\x99\x27\x52\xdd\xe2\xef\xe5\xb0\x27\xf5\xb3\x79\x67\x0d\xbe\xb8\xa7\xaf\x26\xdb\x56\x7e\x01\x00\x00\xff\xff\xc1\xaf\xa6\x4c\x7c\x00\x00\x00")
+var _namespaceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xcb\x31\x72\x02\x31\x0c\x46\xe1\xde\xa7\xd0\xb8\x77\x32\x69\x7d\x88\x94\xe9\x95\xdd\x1f\xd0\x60\x4b\x1e\x49\x4b\xc1\xe9\x19\x2a\x0a\x1a\xea\xf7\x3d\x5e\xf2\x07\x0f\x31\xed\x74\xfb\x29\x57\xd1\xbd\xd3\x2f\x4f\xc4\xe2\x0d\x65\x22\x79\xe7\xe4\x5e\x88\x94\x27\x3a\xd9\x82\xc6\x45\x4e\xd9\xf8\x7e\x38\x9a\x2d\x38\xa7\x79\x21\x1a\xfc\x8f\x11\x4f\xfa\x01\xa6\x57\xfc\x12\xfb\xde\xc6\x11\x09\x6f\xd3\x54\xd2\x5c\xf4\xdc\xa9\xa6\x1f\xa8\x85\x88\x55\x2d\x39\xc5\x34\xfa\xfb\xaa\xb6\xa3\x05\x06\xb6\x34\xef\x54\x6b\x79\x0c\x00\x6b\x32\x41\xdf\xd5\x00\x00\x00")
 
 func namespaceYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -206,7 +206,7 @@ func namespaceYaml() (*asset, error) {
 	return a, nil
 }
 
-var _workerDeploymentYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x52\xb1\x6e\xdc\x30\x0c\xdd\xf5\x15\x44\x76\x25\xcd\xaa\x2d\x68\x0e\x5d\x8a\x0c\x4d\xd3\x9d\x27\xb3\x8e\x70\x92\x28\x90\xf4\xb5\xee\xd7\x17\x82\xef\x7c\x0e\x02\x98\x93\xc1\xf7\x1e\xdf\x23\x2d\x6c\xe9\x17\x89\x26\xae\x01\xb0\x35\x7d\x38\x3f\xba\x53\xaa\x43\x80\x67\x6a\x99\xe7\x42\xd5\x5c\x21\xc3\x01\x0d\x83\x03\xc8\x78\xa4\xac\xfd\x0b\xba\x20\x00\x0a\x7b\x6e\x24\x68\x2c\xfe\x0f\xcb\x89\xc4\x01\x54\x2c\xb4\x87\x69\xc3\x48\x01\xb8\x51\xd5\xf7\xf4\xdb\x3c\xfe\x9b\x84\x56\xb2\xd3\x46\xb1\x9b\x08\xb5\x9c\x22\x6a\x80\x47\x07\xa0\x94\x29\x1a\xcb\x62\x5f\xd0\xe2\xfb\xf7\x4d\x9e\xdd\x44\x6a\x82\x46\xe3\xbc\x50\x85\x73\x4e\x75\x7c\x6b\x03\x1a\x5d\xd5\x05\xff\xbe\x4e\x32\xd2\x62\x76\xe9\xbc\x55\x3c\x63\xca\x78\xcc\x14\xe0\x8b\x03\x30\x2a\x2d\xaf\xaa\xed\x6d\x7a\xe5\x0f\x79\x76\x13\x01\x5c\xb7\xec\x15\xb9\x1a\xa6\x4a\xb2\x8a\x3d\x44\x2e\x05\xeb\x70\x9b\xe6\xfb\xa8\xdb\x6c\x19\x75\x8b\xad\xd7\xbb\xb5\x36\x66\xbd\x52\xc1\xbe\xde\xb7\xc3\xcb\xe1\xc7\xd3\xcf\xc3\xf3\x0a\x7c\xfe\x5f\x17\xa8\xf2\x40\xaf\x1f\xce\x7e\xed\x7a\xe1\x4c\xf7\xa7\xe9\x48\x52\xc9\x48\xef\x13\x3f\x2c\x76\x01\xee\xee\x2e\x54\x25\x39\xa7\x48\x4f\x31\xf2\x54\xed\x65\xe7\x55\xf4\x6a\x92\x58\x92\xcd\x5f\x33\xaa\x2e\x64\x9d\xd5\xa8\xf8\x98\x27\x35\x12\x1f\x25\x59\x8a\x98\xdd\xff\x00\x00\x00\xff\xff\x1c\x57\xc1\x70\xb9\x02\x00\x00")
+var _workerDeploymentYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x52\xbd\x6e\xdc\x30\x0c\xde\xf5\x14\x44\x76\x25\xcd\xaa\x2d\x68\x0e\x5d\x8a\x0c\x4d\xd3\x9d\x27\xb3\x3e\xe1\x24\x51\x20\xe9\x6b\xdd\xa7\x2f\x04\x9f\x0d\x1f\x0a\x5c\x61\x0f\xd2\xf7\xa3\x8f\xa4\x84\x2d\xfd\x20\xd1\xc4\x35\x00\xb6\xa6\x4f\x97\x67\x77\x4e\x75\x08\xf0\x4a\x2d\xf3\x5c\xa8\x9a\x2b\x64\x38\xa0\x61\x70\x00\x19\x8f\x94\xb5\xaf\xa0\x1b\x02\xa0\xb0\xe7\x46\x82\xc6\xe2\x7f\xb1\x9c\x49\x1c\x40\xc5\x42\xf7\x38\x6d\x18\x29\x00\x37\xaa\x7a\x4a\x3f\xcd\xe3\x9f\x49\x68\x13\x3b\x6d\x14\x7b\x88\x50\xcb\x29\xa2\x06\x78\x76\x00\x4a\x99\xa2\xb1\x74\x06\xa0\xa0\xc5\xd3\xd7\x5d\x3d\x77\x2b\x52\x13\x34\x1a\xe7\xc5\x2b\x9c\x73\xaa\xe3\x47\x1b\xd0\x68\x75\x17\xfc\xfd\x3e\xc9\x48\x4b\xd8\x15\xf9\xa8\x78\xc1\x94\xf1\x98\x29\xc0\x27\x07\x60\x54\x5a\xde\x5c\xfb\xd9\x00\xdc\xce\xe7\x3f\x15\x01\xac\x5d\xf6\x2f\x72\x35\x4c\x95\x64\x33\x7b\x88\x5c\x0a\xd6\x61\x05\x00\x7c\x3f\x6a\xdb\xa1\x8c\xbb\x24\x0f\x6b\xc4\x0e\xda\x85\xf5\x3f\x15\xec\xed\x7d\x39\xbc\x1d\xbe\xbd\x7c\x3f\xbc\x6e\xc4\xbf\xf7\x75\xa5\x2a\x0f\xf4\x7e\x33\xf6\x15\xf5\xc2\x99\x1e\xcf\xd3\x91\xa4\x92\x91\x3e\x26\x7e\x5a\xe2\x02\x3c\x3c\x5c\xa5\x4a\x72\x49\x91\x5e\x62\xe4\xa9\xda\xdb\x9d\x57\xd1\xe5\x4d\x12\x4b\xb2\xf9\x73\x46\xd5\x45\xac\xb3\x1a\x15\x1f\xf3\xa4\x46\xe2\xa3\x24\x4b\x11\xb3\xfb\x3b\x00\x1c\x57\xc1\x70\xb9\x02\x00\x00")
 
 func workerDeploymentYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -226,7 +226,7 @@ func workerDeploymentYaml() (*asset, error) {
 	return a, nil
 }
 
-var _workerRoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x8e\xb1\x6e\x2c\x31\x08\x45\x7b\xbe\x82\x1f\xb0\x57\xaf\x7b\x72\x9b\x22\x7d\x14\xa5\x67\x3d\x24\x83\xc6\x63\x2c\xc0\xbb\x52\xbe\x3e\x9a\xd9\x6d\x53\xa5\xe2\x0a\x1d\x0e\x17\x52\x4a\x40\x43\x3e\xd8\x5c\xb4\x17\xb4\x2b\xd5\x4c\x33\x56\x35\xf9\xa6\x10\xed\x79\xfb\xef\x59\xf4\x72\xfb\x07\x9b\xf4\xa5\xe0\x4b\x9b\x1e\x6c\x6f\xda\x18\x76\x0e\x5a\x28\xa8\x00\x62\x35\x3e\x0f\xde\x65\x67\x0f\xda\x47\xc1\x3e\x5b\x03\xc4\x4e\x3b\x17\x24\xd3\xa4\x83\x8d\x42\x2d\xdd\xd5\x36\x36\xb0\xd9\xd8\x0b\x24\xa4\x21\xaf\xa6\x73\xf8\x61\x4a\x07\x9b\x75\x70\xf7\x55\x3e\x23\x8b\x02\xa2\xb1\xeb\xb4\xca\x4f\xa2\x3e\x5a\x38\x20\xde\xd8\xae\xcf\xed\x17\xc7\x39\x9b\xf8\x23\xdc\x29\xea\xfa\x17\xff\xc5\x83\x62\xfe\xf2\x66\x9c\xf6\x23\xcd\xb1\x50\x30\xfc\x04\x00\x00\xff\xff\x30\x78\x19\x41\x50\x01\x00\x00")
+var _workerRoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x8f\xb1\x4e\xc3\x40\x0c\x86\x77\x3f\x85\xd5\xfd\x52\xb1\xa1\x5b\x19\xd8\x11\x62\x77\x13\x43\xac\x5c\xce\x27\xdb\x97\x4a\x3c\x3d\x22\xe9\x44\xc5\xd4\xc9\xbf\x7e\xc9\xdf\x67\x43\x4a\x09\xa8\xc9\x07\x9b\x8b\xd6\x8c\x76\xa1\x71\xa0\x1e\xb3\x9a\x7c\x53\x88\xd6\x61\x79\xf6\x41\xf4\xbc\x3d\xc1\x22\x75\xca\xf8\x52\xba\x07\xdb\x9b\x16\x86\x95\x83\x26\x0a\xca\x80\x38\x1a\xef\x0b\xef\xb2\xb2\x07\xad\x2d\x63\xed\xa5\x00\x62\xa5\x95\x33\x92\x69\xd2\xc6\x46\xa1\x96\xae\x6a\x0b\x1b\x58\x2f\xec\x19\x12\x52\x93\x57\xd3\xde\xfc\x97\x94\xf0\x74\x02\x44\x63\xd7\x6e\x23\xdf\x3a\xde\xb8\x86\x03\xe2\xc6\x76\xb9\x75\xbb\x93\xf7\xd8\x28\xc6\xf9\x9e\x44\xa6\x83\x36\xae\x3e\xcb\x67\x0c\xa2\xf7\xdc\xf1\xf8\xe7\x0f\xf9\x8b\x63\x9f\x45\xfc\x08\xd7\x47\xf9\x67\x0f\x8a\xfe\x8f\xe6\xb8\x1e\x31\x61\x6f\x13\x05\xc3\xcf\x00\x4e\xd5\x1b\x92\x9a\x01\x00\x00")
 
 func workerRoleYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -246,7 +246,7 @@ func workerRoleYaml() (*asset, error) {
 	return a, nil
 }
 
-var _workerRolebindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8d\x31\x6e\xc3\x30\x0c\x45\x77\x9d\x82\x17\x90\x8b\x6e\x85\xb6\xb6\x43\x77\x17\xe8\x4e\xcb\x74\xcd\xda\x26\x05\x8a\x72\x01\x9f\x3e\x08\x12\x64\x09\xe0\xf9\xbf\xf7\x1f\x16\xfe\x21\xab\xac\x92\xc0\x06\xcc\x1d\x36\x9f\xd5\xf8\x40\x67\x95\x6e\x79\xab\x1d\xeb\xcb\xfe\x1a\x16\x96\x31\xc1\xe7\xda\xaa\x93\xf5\xba\xd2\x07\xcb\xc8\xf2\x1b\x36\x72\x1c\xd1\x31\x05\x00\xc1\x8d\x12\xa0\x69\xd4\x42\x86\xae\x16\xff\xd5\x16\xb2\x60\xba\x52\x4f\xd3\x15\xc2\xc2\x5f\xa6\xad\x9c\x04\x03\xc0\x53\xef\xf4\xbe\xb6\xe1\x8f\xb2\xd7\x14\xe2\xdd\xfc\x26\xdb\x39\xd3\x7b\xce\xda\xc4\x4f\xe5\xdb\x56\x0b\x66\x4a\xa0\x85\xa4\xce\x3c\x79\xc4\xa3\x19\x3d\xe0\x70\x09\x00\x00\xff\xff\x73\xce\x57\x9b\x2a\x01\x00\x00")
+var _workerRolebindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8d\x31\x4e\xc4\x30\x10\x45\x7b\x9f\x62\x2e\xe0\x20\x3a\xe4\x0e\x28\xe8\x17\x89\x7e\xd6\xf9\xcb\x0e\xc9\xce\x58\xe3\x71\x90\x72\x7a\x84\xa0\x5b\x29\xf5\xff\xef\x3d\x6e\xf2\x01\xef\x62\x5a\xc8\xcf\x5c\x27\x1e\x71\x35\x97\x9d\x43\x4c\xa7\xe5\xa9\x4f\x62\x0f\xdb\x63\x5a\x44\xe7\x42\xaf\xeb\xe8\x01\x3f\xd9\x8a\x17\xd1\x59\xf4\x33\xdd\x10\x3c\x73\x70\x49\x44\xca\x37\x14\x62\xb7\x6c\x0d\xce\x61\x9e\xbf\xcd\x17\x78\x72\x5b\x71\xc2\xe5\xf7\xc4\x4d\xde\xdc\x46\x3b\x08\x26\xa2\xbb\xde\xa1\xbe\x8f\xf3\x17\x6a\xf4\x92\xf2\x3f\xf9\x0e\xdf\xa4\xe2\xb9\x56\x1b\x1a\x87\xf0\xdf\xd6\x1b\x57\x14\xb2\x06\xed\x57\xb9\x44\xe6\x7d\x38\xb2\x35\x38\x87\x79\xfa\x19\x00\x73\xce\x57\x9b\x2a\x01\x00\x00")
 
 func workerRolebindingYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -266,7 +266,7 @@ func workerRolebindingYaml() (*asset, error) {
 	return a, nil
 }
 
-var _workerServiceaccountYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\xca\x31\x8a\xc3\x40\x0c\x05\xd0\x7e\x4e\xa1\x0b\x4c\xb1\xad\xba\x3d\x43\x20\xfd\x67\xfc\x43\x84\xb1\x34\x68\x64\x07\x72\xfa\x34\x21\xf5\x7b\x98\x76\x67\x2e\x0b\x57\xb9\xfe\xda\x6e\xbe\xa9\xdc\x98\x97\x0d\xfe\x8f\x11\xa7\x57\x3b\x58\xd8\x50\xd0\x26\xe2\x38\xa8\x82\x8c\x1e\x93\x89\x8a\xec\xaf\xc8\x9d\xf9\xb5\x35\x31\xa8\x12\x93\xbe\x9e\xf6\xa8\x8e\xf7\x99\xfc\xe5\xf6\x09\x00\x00\xff\xff\xe3\x3c\x43\x66\x70\x00\x00\x00")
+var _workerServiceaccountYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x70\x00\x8f\xff\x61\x70\x69\x56\x65\x72\x73\x69\x6f\x6e\x3a\x20\x76\x31\x0a\x6b\x69\x6e\x64\x3a\x20\x53\x65\x72\x76\x69\x63\x65\x41\x63\x63\x6f\x75\x6e\x74\x0a\x6d\x65\x74\x61\x64\x61\x74\x61\x3a\x0a\x20\x20\x6e\x61\x6d\x65\x3a\x20\x61\x72\x6f\x2d\x6f\x70\x65\x72\x61\x74\x6f\x72\x2d\x77\x6f\x72\x6b\x65\x72\x0a\x20\x20\x6e\x61\x6d\x65\x73\x70\x61\x63\x65\x3a\x20\x6f\x70\x65\x6e\x73\x68\x69\x66\x74\x2d\x61\x7a\x75\x72\x65\x2d\x6f\x70\x65\x72\x61\x74\x6f\x72\x0a\x03\x00\xe3\x3c\x43\x66\x70\x00\x00\x00")
 
 func workerServiceaccountYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -354,11 +354,13 @@ var _bindata = map[string]func() (*asset, error){
 // directory embedded in the file by go-bindata.
 // For example if you run go-bindata on data/... and data contains the
 // following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
+//
+//	data/
+//	  foo.txt
+//	  img/
+//	    a.png
+//	    b.png
+//
 // then AssetDir("data") would return []string{"foo.txt", "img"}
 // AssetDir("data/img") would return []string{"a.png", "b.png"}
 // AssetDir("foo.txt") and AssetDir("notexist") would return an error
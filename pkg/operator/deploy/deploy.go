@@ -5,7 +5,12 @@ package deploy
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/json"
 	"fmt"
+	"os"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/Azure/go-autorest/autorest/azure"
@@ -13,9 +18,11 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	extv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	extensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -25,20 +32,30 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/validate"
 	"github.com/Azure/ARO-RP/pkg/env"
 	pkgoperator "github.com/Azure/ARO-RP/pkg/operator"
 	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
 	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/typed/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/cloudproviderconfig"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/genevalogging"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/inventory"
 	"github.com/Azure/ARO-RP/pkg/util/deployment"
 	"github.com/Azure/ARO-RP/pkg/util/dynamichelper"
+	"github.com/Azure/ARO-RP/pkg/util/egress"
 	"github.com/Azure/ARO-RP/pkg/util/pullsecret"
 	"github.com/Azure/ARO-RP/pkg/util/ready"
 	"github.com/Azure/ARO-RP/pkg/util/restconfig"
+	"github.com/Azure/ARO-RP/pkg/util/stringutils"
+	"github.com/Azure/ARO-RP/pkg/util/subnet"
 	"github.com/Azure/ARO-RP/pkg/util/tls"
 	"github.com/Azure/ARO-RP/pkg/util/version"
 )
 
+// pricingTier is the tier copied onto the nodes.  ARO bills every cluster at
+// the same tier today.
+const pricingTier = "Standard"
+
 type Operator interface {
 	CreateOrUpdate(context.Context) error
 	IsReady(context.Context) (bool, error)
@@ -105,6 +122,13 @@ func (o *operator) resources() ([]runtime.Object, error) {
 						Name:  "RP_MODE",
 						Value: "development",
 					})
+
+					if disabled := os.Getenv(deployment.CapabilitiesDisabledEnvVar); disabled != "" {
+						d.Spec.Template.Spec.Containers[i].Env = append(d.Spec.Template.Spec.Containers[i].Env, corev1.EnvVar{
+							Name:  deployment.CapabilitiesDisabledEnvVar,
+							Value: disabled,
+						})
+					}
 				}
 			}
 		}
@@ -128,14 +152,60 @@ func (o *operator) resources() ([]runtime.Object, error) {
 		return nil, err
 	}
 
-	var monitoringEndpoint string
-	switch o.env.Environment().Name {
-	case azure.PublicCloud.Name:
-		monitoringEndpoint = "https://gcs.prod.monitoring.core.windows.net/"
-	case azure.USGovernmentCloud.Name:
-		monitoringEndpoint = "https://gcs.monitoring.core.usgovcloudapi.net/"
-	default:
-		return nil, fmt.Errorf("unsupported cloud environment")
+	cpc, err := o.cloudProviderConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	monitoringEndpoint, err := egress.MonitoringEndpoint(o.env)
+	if err != nil {
+		return nil, err
+	}
+
+	requiredEndpoints, err := egress.RequiredEndpoints(o.env, o.oc)
+	if err != nil {
+		return nil, err
+	}
+
+	dnsNames := make([]string, 0, len(requiredEndpoints))
+	for _, e := range requiredEndpoints {
+		dnsNames = append(dnsNames, e.Host)
+	}
+
+	vnetID, _, err := subnet.Split(o.oc.Properties.MasterProfile.SubnetID)
+	if err != nil {
+		return nil, err
+	}
+
+	networkChecker, err := networkCheckerSpec(o.oc)
+	if err != nil {
+		return nil, err
+	}
+
+	var inventoryURL string
+	var inventoryCertBytes, inventoryKeyBytes []byte
+	if o.env.InventoryURL() != "" && o.oc.Properties.InventoryClientCertificate != nil {
+		inventoryURL = strings.TrimSuffix(o.env.InventoryURL(), "/") + "/inventory" + strings.ToLower(o.oc.ID)
+
+		inventoryKey, err := x509.ParsePKCS1PrivateKey(o.oc.Properties.InventoryClientKey)
+		if err != nil {
+			return nil, err
+		}
+
+		inventoryKeyBytes, err = tls.PrivateKeyAsBytes(inventoryKey)
+		if err != nil {
+			return nil, err
+		}
+
+		inventoryCert, err := x509.ParseCertificate(o.oc.Properties.InventoryClientCertificate)
+		if err != nil {
+			return nil, err
+		}
+
+		inventoryCertBytes, err = tls.CertAsBytes(inventoryCert)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// create a secret here for genevalogging, later we will copy it to
@@ -147,9 +217,12 @@ func (o *operator) resources() ([]runtime.Object, error) {
 				Namespace: pkgoperator.Namespace,
 			},
 			Data: map[string][]byte{
-				genevalogging.GenevaCertName: gcsCertBytes,
-				genevalogging.GenevaKeyName:  gcsKeyBytes,
-				v1.DockerConfigJsonKey:       []byte(ps),
+				genevalogging.GenevaCertName:  gcsCertBytes,
+				genevalogging.GenevaKeyName:   gcsKeyBytes,
+				v1.DockerConfigJsonKey:        []byte(ps),
+				cloudproviderconfig.ConfigKey: cpc,
+				inventory.ClientCertName:      inventoryCertBytes,
+				inventory.ClientKeyName:       inventoryKeyBytes,
 			},
 		},
 		&arov1alpha1.Cluster{
@@ -163,6 +236,7 @@ func (o *operator) resources() ([]runtime.Object, error) {
 				GenevaLogging: arov1alpha1.GenevaLoggingSpec{
 					ConfigVersion:            o.env.ClustersGenevaLoggingConfigVersion(),
 					MonitoringGCSEnvironment: o.env.ClustersGenevaLoggingEnvironment(),
+					MonitoringEndpoint:       monitoringEndpoint,
 				},
 				InternetChecker: arov1alpha1.InternetCheckerSpec{
 					URLs: []string{
@@ -172,11 +246,219 @@ func (o *operator) resources() ([]runtime.Object, error) {
 						monitoringEndpoint,
 					},
 				},
+				DNSChecker: arov1alpha1.DNSCheckerSpec{
+					VnetID: vnetID,
+					Names:  dnsNames,
+				},
+				NetworkChecker:       networkChecker,
+				InventoryURL:         inventoryURL,
+				MachineCIDR:          o.oc.Properties.NetworkProfile.MachineCIDR,
+				PricingTier:          pricingTier,
+				ConsoleNotifications: consoleNotifications(o.oc),
+				Autoscaler:           AutoscalerSpec(o.oc),
+				WorkerPools:          WorkerPoolsSpec(o.oc),
+				ComponentResources:   ComponentResourcesSpec(o.oc),
+				OperatorFlags:        pkgoperator.OperatorFlags(o.oc.Properties.OperatorFlags),
 			},
 		},
 	), nil
 }
 
+// RBAC returns the ClusterRoles and ClusterRoleBindings among the static
+// resources.  The RP applies them at install and update time, and the operator
+// keeps them applied in between.
+func RBAC() ([]runtime.Object, error) {
+	// AssetNames iterates over a map; sort it so that drift is always
+	// reported in the same order
+	assetNames := AssetNames()
+	sort.Strings(assetNames)
+
+	var results []runtime.Object
+	for _, assetName := range assetNames {
+		b, err := Asset(assetName)
+		if err != nil {
+			return nil, err
+		}
+
+		obj, _, err := scheme.Codecs.UniversalDeserializer().Decode(b, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		switch obj.(type) {
+		case *rbacv1.ClusterRole, *rbacv1.ClusterRoleBinding:
+			results = append(results, obj)
+		}
+	}
+
+	return results, nil
+}
+
+// cloudProviderConfig returns the cloud provider config values which the
+// operator enforces
+func (o *operator) cloudProviderConfig() ([]byte, error) {
+	r, err := azure.ParseResourceID(o.oc.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&cloudproviderconfig.Config{
+		Cloud:           o.env.Environment().Name,
+		TenantID:        o.oc.Properties.ServicePrincipalProfile.TenantID,
+		SubscriptionID:  r.SubscriptionID,
+		ResourceGroup:   stringutils.LastTokenByte(o.oc.Properties.ClusterProfile.ResourceGroupID, '/'),
+		Location:        o.oc.Location,
+		AADClientID:     o.oc.Properties.ServicePrincipalProfile.ClientID,
+		AADClientSecret: string(o.oc.Properties.ServicePrincipalProfile.ClientSecret),
+	})
+}
+
+func consoleNotifications(oc *api.OpenShiftCluster) []arov1alpha1.ConsoleNotificationSpec {
+	if len(oc.Properties.ConsoleNotifications) == 0 {
+		return nil
+	}
+
+	ns := make([]arov1alpha1.ConsoleNotificationSpec, 0, len(oc.Properties.ConsoleNotifications))
+	for _, n := range oc.Properties.ConsoleNotifications {
+		ns = append(ns, arov1alpha1.ConsoleNotificationSpec{
+			Name:     n.Name,
+			Type:     string(n.Type),
+			Location: string(n.Location),
+			Text:     n.Text,
+			LinkText: n.LinkText,
+			LinkURL:  n.LinkURL,
+		})
+	}
+
+	return ns
+}
+
+// AutoscalerSpec returns the autoscaler configuration of the Cluster resource
+// corresponding to the autoscaler profile of oc, or nil if it has none
+func AutoscalerSpec(oc *api.OpenShiftCluster) *arov1alpha1.AutoscalerSpec {
+	if oc.Properties.AutoscalerProfile == nil {
+		return nil
+	}
+
+	spec := &arov1alpha1.AutoscalerSpec{
+		MaxNodesTotal:          oc.Properties.AutoscalerProfile.MaxNodesTotal,
+		ScaleDownDelayAfterAdd: oc.Properties.AutoscalerProfile.ScaleDownDelayAfterAdd,
+		ScaleDownUnneededTime:  oc.Properties.AutoscalerProfile.ScaleDownUnneededTime,
+		Pools:                  make([]arov1alpha1.AutoscalerPoolSpec, 0, len(oc.Properties.AutoscalerProfile.Pools)),
+	}
+
+	for _, p := range oc.Properties.AutoscalerProfile.Pools {
+		spec.Pools = append(spec.Pools, arov1alpha1.AutoscalerPoolSpec{
+			Name:        p.Name,
+			MinReplicas: p.MinReplicas,
+			MaxReplicas: p.MaxReplicas,
+		})
+	}
+
+	return spec
+}
+
+// WorkerPoolsSpec returns the worker pools of the Cluster resource
+// corresponding to the worker profiles of oc which have node labels or taints,
+// or nil if there are none
+func WorkerPoolsSpec(oc *api.OpenShiftCluster) []arov1alpha1.WorkerPoolSpec {
+	var pools []arov1alpha1.WorkerPoolSpec
+
+	wps := append(append([]api.WorkerProfile{}, oc.Properties.WorkerProfiles...), oc.Properties.AdditionalWorkerProfiles...)
+	for _, wp := range wps {
+		if len(wp.NodeLabels) == 0 && len(wp.NodeTaints) == 0 {
+			continue
+		}
+
+		pool := arov1alpha1.WorkerPoolSpec{
+			Name:       wp.Name,
+			NodeLabels: map[string]string{},
+			NodeTaints: make([]arov1alpha1.NodeTaintSpec, 0, len(wp.NodeTaints)),
+		}
+
+		for k, v := range wp.NodeLabels {
+			pool.NodeLabels[k] = v
+		}
+
+		for _, t := range wp.NodeTaints {
+			pool.NodeTaints = append(pool.NodeTaints, arov1alpha1.NodeTaintSpec{
+				Key:    t.Key,
+				Value:  t.Value,
+				Effect: string(t.Effect),
+			})
+		}
+
+		pools = append(pools, pool)
+	}
+
+	return pools
+}
+
+// networkCheckerSpec returns the master and worker subnets of oc, each once,
+// with the network security group which the RP attaches to it
+func networkCheckerSpec(oc *api.OpenShiftCluster) (arov1alpha1.NetworkCheckerSpec, error) {
+	var spec arov1alpha1.NetworkCheckerSpec
+
+	subnetIDs := []string{oc.Properties.MasterProfile.SubnetID}
+	for _, wp := range append(append([]api.WorkerProfile{}, oc.Properties.WorkerProfiles...), oc.Properties.AdditionalWorkerProfiles...) {
+		subnetIDs = append(subnetIDs, wp.SubnetID)
+	}
+
+	seen := map[string]struct{}{}
+	for _, subnetID := range subnetIDs {
+		if _, found := seen[strings.ToLower(subnetID)]; found || subnetID == "" {
+			continue
+		}
+		seen[strings.ToLower(subnetID)] = struct{}{}
+
+		nsgID, err := subnet.NetworkSecurityGroupID(oc, subnetID)
+		if err != nil {
+			return arov1alpha1.NetworkCheckerSpec{}, err
+		}
+
+		spec.Subnets = append(spec.Subnets, arov1alpha1.SubnetSpec{
+			ID:                     subnetID,
+			NetworkSecurityGroupID: nsgID,
+		})
+	}
+
+	return spec, nil
+}
+
+// smallClusterComponentResources are the reduced resource requests of the
+// managed components on small clusters.  Their limits are left alone.
+var smallClusterComponentResources = map[string]corev1.ResourceRequirements{
+	arov1alpha1.ComponentGenevaLogging: {
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("5m"),
+			corev1.ResourceMemory: resource.MustParse("50Mi"),
+		},
+	},
+	arov1alpha1.ComponentNodeProblemDetector: {
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("5m"),
+			corev1.ResourceMemory: resource.MustParse("20Mi"),
+		},
+	},
+}
+
+// ComponentResourcesSpec returns the resource overrides of the managed
+// components of the Cluster resource: the reduced requests if oc fits the
+// small install profile, so that the managed overhead doesn't dominate it, or
+// nil otherwise
+func ComponentResourcesSpec(oc *api.OpenShiftCluster) map[string]corev1.ResourceRequirements {
+	if validate.InstallProfileSmall.Validate(oc) != nil {
+		return nil
+	}
+
+	resources := make(map[string]corev1.ResourceRequirements, len(smallClusterComponentResources))
+	for component, r := range smallClusterComponentResources {
+		resources[component] = *r.DeepCopy()
+	}
+
+	return resources
+}
+
 func (o *operator) CreateOrUpdate(ctx context.Context) error {
 	resources, err := o.resources()
 	if err != nil {
@@ -276,6 +558,14 @@ func (o *operator) IsReady(ctx context.Context) (bool, error) {
 		if cond == nil {
 			return false, nil
 		}
+		// an expired workaround is for us to follow up, an autoscaler
+		// configuration which can't work or an unsupported update channel is
+		// for the customer to fix, and node sizing is only applied once the
+		// machine config pool has rolled out; none must hold up the cluster
+		if ct == arov1alpha1.WorkaroundsNotExpired || ct == arov1alpha1.AutoscalerConfigValid ||
+			ct == arov1alpha1.NodeSizingApplied || ct == arov1alpha1.ClusterVersionPolicyValid {
+			continue
+		}
 		if cond.Status != corev1.ConditionTrue {
 			return false, nil
 		}
@@ -0,0 +1,95 @@
+package operator
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"time"
+)
+
+// Operator flags are set on the cluster document via the admin API and copied
+// into the Cluster resource spec by the RP.  They let SREs switch off
+// behaviour of the operator on an individual cluster, e.g. a repair which
+// fights with a manual mitigation, or to switch on behaviour which is off by
+// default.
+const (
+	FlagCloudProviderConfigEnabled    = "aro.cloudproviderconfig.enabled"
+	FlagClusterVersionEnabled         = "aro.clusterversion.enabled"
+	FlagClusterVersionChannelEnforced = "aro.clusterversion.channelenforced"
+	FlagDebugControllers              = "aro.debug.controllers"
+	FlagDebugUntil                    = "aro.debug.until"
+	FlagDNSEnabled                    = "aro.dns.enabled"
+	FlagEtcdDefragEnabled             = "aro.etcddefrag.enabled"
+	FlagImageRegistryEnabled          = "aro.imageregistry.enabled"
+	FlagMachineWebhookEnabled         = "aro.machinewebhook.enabled"
+	FlagNodeMetadataEnabled           = "aro.nodemetadata.enabled"
+	FlagNodeProblemDetectorEnabled    = "aro.nodeproblemdetector.enabled"
+	FlagNodeReadinessEnabled          = "aro.nodereadiness.enabled"
+	FlagNodeSizingEnabled             = "aro.nodesizing.enabled"
+	FlagPodSupervisorEnabled          = "aro.podsupervisor.enabled"
+	FlagPullSecretEnabled             = "aro.pullsecret.enabled"
+	FlagRBACEnabled                   = "aro.rbac.enabled"
+	FlagRebootCoordinatorEnabled      = "aro.rebootcoordinator.enabled"
+	FlagRemediationMachineIdentity    = "aro.remediation.machineidentity.enabled"
+	FlagRemediationMachineImage       = "aro.remediation.machineimage.enabled"
+	FlagRouteFixEnabled               = "aro.routefix.enabled"
+	FlagServiceMonitorEnabled         = "aro.servicemonitor.enabled"
+	FlagSyntheticProbeClass           = "aro.syntheticprobe.class"
+	FlagTrustBundleEnabled            = "aro.trustbundle.enabled"
+)
+
+// MaxDebugDuration is how far ahead FlagDebugUntil may be set, so that debug
+// logging is never left on by mistake
+const MaxDebugDuration = 24 * time.Hour
+
+// DefaultOperatorFlags is the catalog of the supported operator flags and
+// their default values.  All flags are booleans, which take the values "true"
+// or "false", except for the debug flags: FlagDebugControllers is a
+// comma-separated list of controller names, or "*" for all controllers, whose
+// logs are raised to debug level until the RFC3339 time in FlagDebugUntil.
+// FlagSyntheticProbeClass is read by the monitor rather than the operator: if
+// it is set, e.g. to "canary" or "internal", the monitor runs the synthetic
+// workload probe against the cluster and reports the results under that class.
+var DefaultOperatorFlags = map[string]string{
+	FlagCloudProviderConfigEnabled:    "true",
+	FlagClusterVersionEnabled:         "true",
+	FlagClusterVersionChannelEnforced: "false",
+	FlagDebugControllers:              "",
+	FlagDebugUntil:                    "",
+	FlagDNSEnabled:                    "true",
+	FlagEtcdDefragEnabled:             "false",
+	FlagImageRegistryEnabled:          "true",
+	FlagMachineWebhookEnabled:         "true",
+	FlagNodeMetadataEnabled:           "true",
+	FlagNodeProblemDetectorEnabled:    "true",
+	FlagNodeReadinessEnabled:          "true",
+	FlagNodeSizingEnabled:             "true",
+	FlagPodSupervisorEnabled:          "true",
+	FlagPullSecretEnabled:             "true",
+	FlagRBACEnabled:                   "true",
+	FlagRebootCoordinatorEnabled:      "true",
+	FlagRemediationMachineIdentity:    "false",
+	FlagRemediationMachineImage:       "false",
+	FlagRouteFixEnabled:               "true",
+	FlagServiceMonitorEnabled:         "true",
+	FlagSyntheticProbeClass:           "",
+	FlagTrustBundleEnabled:            "true",
+}
+
+// OperatorFlags returns the catalog's default flags overridden by the flags
+// which are set on a cluster
+func OperatorFlags(set map[string]string) map[string]string {
+	flags := make(map[string]string, len(DefaultOperatorFlags))
+
+	for k, v := range DefaultOperatorFlags {
+		flags[k] = v
+	}
+
+	for k, v := range set {
+		if _, found := DefaultOperatorFlags[k]; found {
+			flags[k] = v
+		}
+	}
+
+	return flags
+}
@@ -0,0 +1,71 @@
+package operator
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// RebootRequestAnnotation is set on the Cluster resource by the RP to ask
+	// the reboot coordinator controller to reboot every node of the cluster.
+	// Its value is a JSON RebootRequest.
+	RebootRequestAnnotation = "aro.openshift.io/reboot-request"
+
+	// RebootStatusAnnotation is set on the Cluster resource by the reboot
+	// coordinator controller as it works through a RebootRequest.  Its value
+	// is a JSON RebootStatus.
+	RebootStatusAnnotation = "aro.openshift.io/reboot-status"
+)
+
+// RebootRequest asks for a rolling reboot of all the nodes of the cluster,
+// e.g. to pick up a kernel fix without an upgrade
+type RebootRequest struct {
+	// ID identifies the request; the status of the request carries the same
+	// ID
+	ID string `json:"id"`
+}
+
+// RebootStatus is the progress of a RebootRequest.  CompletionTime is set
+// once every node has rebooted, or once the rollout stopped because a node
+// failed to.
+type RebootStatus struct {
+	ID string `json:"id"`
+
+	StartTime      metav1.Time  `json:"startTime"`
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Nodes are in the order in which they are rebooted
+	Nodes []NodeRebootStatus `json:"nodes"`
+}
+
+// States of a node in a RebootStatus
+const (
+	NodeRebootStatePending   = "Pending"
+	NodeRebootStateDraining  = "Draining"
+	NodeRebootStateRebooting = "Rebooting"
+	NodeRebootStateCompleted = "Completed"
+	NodeRebootStateFailed    = "Failed"
+
+	// NodeRebootStateSkipped means that the node was deleted before its turn
+	// came
+	NodeRebootStateSkipped = "Skipped"
+)
+
+// NodeRebootStatus is the progress of the reboot of a single node
+type NodeRebootStatus struct {
+	Name string `json:"name"`
+
+	State              string      `json:"state"`
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+	Message            string      `json:"message,omitempty"`
+
+	// BootID is the boot ID of the node before it was rebooted: the node has
+	// rebooted once it reports another one
+	BootID string `json:"bootId,omitempty"`
+
+	// WasUnschedulable is true if the node was already cordoned before the
+	// reboot, in which case it is left cordoned afterwards
+	WasUnschedulable bool `json:"wasUnschedulable,omitempty"`
+}
@@ -110,8 +110,8 @@ func (d *dev) DialContext(ctx context.Context, network, address string) (net.Con
 	return &conn{Conn: c, r: r}, nil
 }
 
-func NewDialer(deploymentMode deployment.Mode) (Dialer, error) {
-	if deploymentMode != deployment.Development {
+func NewDialer(capabilities deployment.Capabilities) (Dialer, error) {
+	if !capabilities.IsLocalDevelopment() {
 		return &prod{}, nil
 	}
 
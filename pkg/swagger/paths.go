@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+
+	"github.com/Azure/ARO-RP/pkg/api/validate"
 )
 
 // populateParameters populates a parameters block.  Always expect an
@@ -43,6 +45,8 @@ func populateParameters(n int, typ, friendlyName string) (s []interface{}) {
 			Description: "The name of the " + friendlyName + " resource.",
 			Required:    true,
 			Type:        "string",
+			MinLength:   validate.ClusterNameMinLength,
+			Pattern:     validate.ClusterNamePattern,
 		})
 	}
 
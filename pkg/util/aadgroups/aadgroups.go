@@ -0,0 +1,202 @@
+package aadgroups
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	azgraphrbac "github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/form3tech-oss/jwt-go"
+
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/graphrbac"
+)
+
+// cacheTTL bounds how long a caller's group membership is trusted without
+// being looked up again, so that removal from a group takes effect within
+// this time even for long-lived tokens
+const cacheTTL = 10 * time.Minute
+
+// Resolver validates AAD access tokens and returns the object IDs of the AAD
+// groups of which the caller is a member
+type Resolver interface {
+	Groups(ctx context.Context, token string) ([]string, error)
+}
+
+// claims are the claims of an AAD access token.  jwt.StandardClaims isn't
+// used because it can't decode the single string audience issued by AAD.
+type claims struct {
+	Audience  string   `json:"aud,omitempty"`
+	Issuer    string   `json:"iss,omitempty"`
+	ExpiresAt int64    `json:"exp,omitempty"`
+	NotBefore int64    `json:"nbf,omitempty"`
+	ObjectID  string   `json:"oid,omitempty"`
+	Groups    []string `json:"groups,omitempty"`
+
+	// AAD omits the groups claim if the caller is a member of too many
+	// groups, and sets one of the following instead
+	ClaimNames map[string]string `json:"_claim_names,omitempty"`
+	HasGroups  bool              `json:"hasgroups,omitempty"`
+}
+
+// Valid checks the lifetime of the token; it is called by the jwt parser
+func (c *claims) Valid() error {
+	now := jwt.TimeFunc().Unix()
+
+	if c.ExpiresAt == 0 {
+		return fmt.Errorf("token has no expiry")
+	}
+	if now >= c.ExpiresAt {
+		return fmt.Errorf("token is expired")
+	}
+	if now < c.NotBefore {
+		return fmt.Errorf("token is not valid yet")
+	}
+
+	return nil
+}
+
+type cacheEntry struct {
+	groups  []string
+	expires time.Time
+}
+
+type resolver struct {
+	issuers  []string
+	audience string
+	keys     *keySet
+	users    graphrbac.UsersClient
+
+	now func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]*cacheEntry
+}
+
+// NewResolver returns a Resolver accepting tokens issued by tenantID for
+// audience.  Groups which don't fit in the token are looked up using users,
+// which must be authorized to read the tenant's group memberships.
+func NewResolver(environment *azure.Environment, tenantID, audience string, users graphrbac.UsersClient) Resolver {
+	return &resolver{
+		issuers: []string{
+			"https://sts.windows.net/" + tenantID + "/",              // v1.0 tokens
+			environment.ActiveDirectoryEndpoint + tenantID + "/v2.0", // v2.0 tokens
+		},
+		audience: audience,
+		keys:     newKeySet(environment.ActiveDirectoryEndpoint + tenantID + "/discovery/v2.0/keys"),
+		users:    users,
+
+		now: time.Now,
+
+		cache: map[string]*cacheEntry{},
+	}
+}
+
+func (r *resolver) Groups(ctx context.Context, token string) ([]string, error) {
+	sum := sha256.Sum256([]byte(token))
+	key := hex.EncodeToString(sum[:])
+
+	r.mu.Lock()
+	e := r.cache[key]
+	r.mu.Unlock()
+
+	if e != nil && r.now().Before(e.expires) {
+		return e.groups, nil
+	}
+
+	c, err := r.validate(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := c.Groups
+	if _, found := c.ClaimNames["groups"]; found || c.HasGroups {
+		groups, err = r.memberGroups(ctx, c.ObjectID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	expires := r.now().Add(cacheTTL)
+	if exp := time.Unix(c.ExpiresAt, 0); exp.Before(expires) {
+		expires = exp
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for k, e := range r.cache {
+		if !r.now().Before(e.expires) {
+			delete(r.cache, k)
+		}
+	}
+
+	r.cache[key] = &cacheEntry{
+		groups:  groups,
+		expires: expires,
+	}
+
+	return groups, nil
+}
+
+// validate checks the signature, lifetime, audience and issuer of token and
+// returns its claims
+func (r *resolver) validate(ctx context.Context, token string) (*claims, error) {
+	c := &claims{}
+
+	p := &jwt.Parser{
+		ValidMethods: []string{jwt.SigningMethodRS256.Name},
+	}
+
+	_, err := p.ParseWithClaims(token, c, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return r.keys.key(ctx, kid)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Audience != r.audience {
+		return nil, fmt.Errorf("token audience %q not allowed", c.Audience)
+	}
+
+	var found bool
+	for _, issuer := range r.issuers {
+		if c.Issuer == issuer {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("token issuer %q not allowed", c.Issuer)
+	}
+
+	return c, nil
+}
+
+// memberGroups looks up the security groups of the user with objectID
+func (r *resolver) memberGroups(ctx context.Context, objectID string) ([]string, error) {
+	if objectID == "" {
+		return nil, fmt.Errorf("token has no object ID")
+	}
+
+	res, err := r.users.GetMemberGroups(ctx, objectID, azgraphrbac.UserGetMemberGroupsParameters{
+		SecurityEnabledOnly: to.BoolPtr(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Value == nil {
+		return nil, nil
+	}
+
+	return *res.Value, nil
+}
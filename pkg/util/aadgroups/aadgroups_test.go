@@ -0,0 +1,232 @@
+package aadgroups
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	azgraphrbac "github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/form3tech-oss/jwt-go"
+	"github.com/golang/mock/gomock"
+	jose "gopkg.in/square/go-jose.v2"
+
+	mock_graphrbac "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/graphrbac"
+)
+
+const (
+	testIssuer   = "https://sts.windows.net/tenant/"
+	testAudience = "https://admin.aro.example.com"
+)
+
+func TestResolverGroups(t *testing.T) {
+	ctx := context.Background()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(&jose.JSONWebKeySet{
+			Keys: []jose.JSONWebKey{
+				{
+					Key:       &key.PublicKey,
+					KeyID:     "kid",
+					Algorithm: "RS256",
+					Use:       "sig",
+				},
+			},
+		})
+	}))
+	defer srv.Close()
+
+	sign := func(key *rsa.PrivateKey, c *claims) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, c)
+		token.Header["kid"] = "kid"
+
+		s, err := token.SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return s
+	}
+
+	validClaims := func() *claims {
+		return &claims{
+			Audience:  testAudience,
+			Issuer:    testIssuer,
+			ExpiresAt: time.Now().Add(time.Hour).Unix(),
+			ObjectID:  "user",
+			Groups:    []string{"group1", "group2"},
+		}
+	}
+
+	for _, tt := range []struct {
+		name       string
+		key        *rsa.PrivateKey
+		modify     func(*claims)
+		mocks      func(*mock_graphrbac.MockUsersClient)
+		wantGroups []string
+		wantErr    string
+	}{
+		{
+			name:       "valid",
+			wantGroups: []string{"group1", "group2"},
+		},
+		{
+			name: "groups overage",
+			modify: func(c *claims) {
+				c.Groups = nil
+				c.ClaimNames = map[string]string{"groups": "src1"}
+			},
+			mocks: func(users *mock_graphrbac.MockUsersClient) {
+				users.EXPECT().GetMemberGroups(gomock.Any(), "user", gomock.Any()).
+					Return(azgraphrbac.UserGetMemberGroupsResult{
+						Value: &[]string{"group3"},
+					}, nil)
+			},
+			wantGroups: []string{"group3"},
+		},
+		{
+			name: "wrong audience",
+			modify: func(c *claims) {
+				c.Audience = "https://management.azure.com/"
+			},
+			wantErr: `token audience "https://management.azure.com/" not allowed`,
+		},
+		{
+			name: "wrong issuer",
+			modify: func(c *claims) {
+				c.Issuer = "https://sts.windows.net/othertenant/"
+			},
+			wantErr: `token issuer "https://sts.windows.net/othertenant/" not allowed`,
+		},
+		{
+			name: "no expiry",
+			modify: func(c *claims) {
+				c.ExpiresAt = 0
+			},
+			wantErr: "token has no expiry",
+		},
+		{
+			name: "expired",
+			modify: func(c *claims) {
+				c.ExpiresAt = time.Now().Add(-time.Hour).Unix()
+			},
+			wantErr: "token is expired",
+		},
+		{
+			name: "not yet valid",
+			modify: func(c *claims) {
+				c.NotBefore = time.Now().Add(time.Hour).Unix()
+			},
+			wantErr: "token is not valid yet",
+		},
+		{
+			name:    "bad signature",
+			key:     otherKey,
+			wantErr: "crypto/rsa: verification error",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			users := mock_graphrbac.NewMockUsersClient(controller)
+			if tt.mocks != nil {
+				tt.mocks(users)
+			}
+
+			r := &resolver{
+				issuers:  []string{testIssuer},
+				audience: testAudience,
+				keys: &keySet{
+					url:    srv.URL,
+					client: srv.Client(),
+					now:    time.Now,
+				},
+				users: users,
+				now:   time.Now,
+				cache: map[string]*cacheEntry{},
+			}
+
+			c := validClaims()
+			if tt.modify != nil {
+				tt.modify(c)
+			}
+
+			signingKey := key
+			if tt.key != nil {
+				signingKey = tt.key
+			}
+
+			token := sign(signingKey, c)
+
+			// the second call must be served from the cache: the graph
+			// mock fails the test if it is called more than once
+			for i := 0; i < 2; i++ {
+				groups, err := r.Groups(ctx, token)
+				if err != nil && err.Error() != tt.wantErr ||
+					err == nil && tt.wantErr != "" {
+					t.Fatal(err)
+				}
+
+				if !reflect.DeepEqual(groups, tt.wantGroups) {
+					t.Error(groups)
+				}
+			}
+		})
+	}
+}
+
+func TestResolverCacheExpiry(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	sum := sha256.Sum256([]byte("token"))
+	key := hex.EncodeToString(sum[:])
+
+	r := &resolver{
+		keys: &keySet{},
+		now:  func() time.Time { return now },
+		cache: map[string]*cacheEntry{
+			key: {
+				groups:  []string{"group1"},
+				expires: now.Add(time.Second),
+			},
+		},
+	}
+
+	groups, err := r.Groups(ctx, "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(groups, []string{"group1"}) {
+		t.Error(groups)
+	}
+
+	// once the entry has expired, the token must be validated again
+	now = now.Add(time.Second)
+
+	_, err = r.Groups(ctx, "token")
+	if err == nil || err.Error() != "token contains an invalid number of segments" {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,108 @@
+package aadgroups
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	jose "gopkg.in/square/go-jose.v2"
+)
+
+const (
+	// keySetMaxAge is how often the signing keys are refetched, so that
+	// keys withdrawn by AAD stop being accepted
+	keySetMaxAge = 24 * time.Hour
+
+	// keySetMinAge limits how often an unknown key ID causes a refetch
+	keySetMinAge = time.Minute
+)
+
+// keySet caches the signing keys published by AAD.  AAD rolls its keys, so
+// the set is refetched when a token is signed by an unknown key.
+type keySet struct {
+	url    string
+	client *http.Client
+	now    func() time.Time
+
+	mu      sync.Mutex
+	keys    map[string]interface{}
+	fetched time.Time
+}
+
+func newKeySet(url string) *keySet {
+	return &keySet{
+		url: url,
+		client: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+		now: time.Now,
+	}
+}
+
+// key returns the public key with the given ID
+func (ks *keySet) key(ctx context.Context, kid string) (interface{}, error) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	_, found := ks.keys[kid]
+	age := ks.now().Sub(ks.fetched)
+
+	if (!found && age > keySetMinAge) || age > keySetMaxAge {
+		// count failed fetches too, so that AAD is not hammered while it is
+		// unavailable
+		ks.fetched = ks.now()
+
+		err := ks.fetch(ctx)
+		if err != nil && ks.keys == nil {
+			return nil, err
+		}
+		// otherwise carry on with the keys we have
+	}
+
+	key, found := ks.keys[kid]
+	if !found {
+		return nil, fmt.Errorf("token signed by unknown key %q", kid)
+	}
+
+	return key, nil
+}
+
+func (ks *keySet) fetch(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ks.url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := ks.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d fetching signing keys", resp.StatusCode)
+	}
+
+	var jwks jose.JSONWebKeySet
+	err = json.NewDecoder(resp.Body).Decode(&jwks)
+	if err != nil {
+		return err
+	}
+
+	keys := map[string]interface{}{}
+	for _, k := range jwks.Keys {
+		if k.Use == "" || k.Use == "sig" {
+			keys[k.KeyID] = k.Key
+		}
+	}
+
+	ks.keys = keys
+
+	return nil
+}
@@ -0,0 +1,283 @@
+package adminpolicy
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/util/aadgroups"
+	"github.com/Azure/ARO-RP/pkg/util/recover"
+)
+
+// Policy restricts access to the admin API beyond the client certificate
+// checks made by the admin ClientAuthorizer.  Every restriction is optional:
+// the zero Policy allows everything.
+type Policy struct {
+	// ClientCertificateThumbprints, if set, is the allow-list of client
+	// certificates, identified by the hex SHA-256 digest of their DER
+	// encoding.
+	ClientCertificateThumbprints []string `json:"clientCertificateThumbprints,omitempty"`
+
+	// SourceAddressPrefixes, if set, is the list of CIDRs from which admin
+	// API requests are accepted.
+	SourceAddressPrefixes []string `json:"sourceAddressPrefixes,omitempty"`
+
+	// Groups maps group names to client certificate thumbprints.
+	Groups map[string][]string `json:"groups,omitempty"`
+
+	// AADGroups maps group names to AAD group object IDs.  A caller which
+	// presents a valid AAD access token is a member of a group if it is a
+	// member of any of the group's AAD groups.
+	AADGroups map[string][]string `json:"aadGroups,omitempty"`
+
+	// Actions maps admin API route names (e.g.
+	// "postAdminOpenShiftClusterRedeployVM") to the groups which may invoke
+	// them.  Actions which are not listed may be invoked by any client.
+	Actions map[string][]string `json:"actions,omitempty"`
+
+	sourceNets []*net.IPNet
+}
+
+// Parse parses and validates a JSON encoded Policy.  Empty input returns the
+// zero Policy.
+func Parse(b []byte) (*Policy, error) {
+	p := &Policy{}
+
+	if len(strings.TrimSpace(string(b))) == 0 {
+		return p, nil
+	}
+
+	err := json.Unmarshal(b, p)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, prefix := range p.SourceAddressPrefixes {
+		_, ipnet, err := net.ParseCIDR(prefix)
+		if err != nil {
+			return nil, err
+		}
+		p.sourceNets = append(p.sourceNets, ipnet)
+	}
+
+	for action, groups := range p.Actions {
+		for _, group := range groups {
+			_, foundGroup := p.Groups[group]
+			_, foundAADGroup := p.AADGroups[group]
+			if !foundGroup && !foundAADGroup {
+				return nil, fmt.Errorf("action %q references unknown group %q", action, group)
+			}
+		}
+	}
+
+	return p, nil
+}
+
+// IsAuthorized returns nil if the policy allows r to invoke action.
+// aadGroupIDs holds the AAD groups of the caller, if it presented a token.
+func (p *Policy) IsAuthorized(r *http.Request, action string, aadGroupIDs []string) error {
+	var thumbprint string
+	if r.TLS != nil && len(r.TLS.PeerCertificates) > 0 {
+		sum := sha256.Sum256(r.TLS.PeerCertificates[0].Raw)
+		thumbprint = hex.EncodeToString(sum[:])
+	}
+
+	if len(p.ClientCertificateThumbprints) > 0 && !containsFold(p.ClientCertificateThumbprints, thumbprint) {
+		return fmt.Errorf("client certificate %q not allowed", thumbprint)
+	}
+
+	if len(p.sourceNets) > 0 {
+		host, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			return err
+		}
+
+		ip := net.ParseIP(host)
+		if ip == nil {
+			return fmt.Errorf("invalid source address %q", host)
+		}
+
+		var found bool
+		for _, ipnet := range p.sourceNets {
+			if ipnet.Contains(ip) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("source address %q not allowed", host)
+		}
+	}
+
+	if groups, found := p.Actions[action]; found {
+		for _, group := range groups {
+			if containsFold(p.Groups[group], thumbprint) {
+				return nil
+			}
+			for _, aadGroupID := range aadGroupIDs {
+				if containsFold(p.AADGroups[group], aadGroupID) {
+					return nil
+				}
+			}
+		}
+
+		return fmt.Errorf("client certificate %q not in any group allowed to invoke %q", thumbprint, action)
+	}
+
+	return nil
+}
+
+func containsFold(haystack []string, needle string) bool {
+	if needle == "" {
+		return false
+	}
+
+	for _, s := range haystack {
+		if strings.EqualFold(s, needle) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Authorizer authorizes admin API requests against the current Policy.
+type Authorizer interface {
+	IsAuthorized(r *http.Request, action string) bool
+}
+
+// Loader returns the JSON encoded Policy.
+type Loader func(context.Context) ([]byte, error)
+
+type all struct{}
+
+// NewAll returns an Authorizer which allows every request.
+func NewAll() Authorizer {
+	return &all{}
+}
+
+func (all) IsAuthorized(*http.Request, string) bool {
+	return true
+}
+
+type authorizer struct {
+	log      *logrus.Entry
+	load     Loader
+	resolver aadgroups.Resolver
+
+	mu sync.RWMutex
+	p  *Policy
+}
+
+// New returns an Authorizer which periodically reloads its Policy using load,
+// so that policy changes take effect without a restart.  Until the policy has
+// been loaded successfully, all requests are denied.  Thereafter, if a reload
+// fails, the last good Policy remains in force.  If resolver is not nil,
+// callers may present an AAD access token as a bearer token to be authorized
+// by their AAD group membership as well as by their client certificate.
+func New(ctx context.Context, log *logrus.Entry, load Loader, resolver aadgroups.Resolver) Authorizer {
+	a := &authorizer{
+		log:      log,
+		load:     load,
+		resolver: resolver,
+	}
+
+	err := a.refreshOnce(ctx)
+	if err != nil {
+		log.Error(err)
+	}
+
+	go a.refresh(ctx)
+
+	return a
+}
+
+func (a *authorizer) IsAuthorized(r *http.Request, action string) bool {
+	a.mu.RLock()
+	p := a.p
+	a.mu.RUnlock()
+
+	if p == nil {
+		a.log.Error("admin API policy not loaded")
+		return false
+	}
+
+	var aadGroupIDs []string
+	if token := bearerToken(r); token != "" && a.resolver != nil {
+		var err error
+		aadGroupIDs, err = a.resolver.Groups(r.Context(), token)
+		if err != nil {
+			// a caller presenting an invalid token is refused outright
+			a.log.Info(err)
+			return false
+		}
+	}
+
+	err := p.IsAuthorized(r, action, aadGroupIDs)
+	if err != nil {
+		a.log.Info(err)
+		return false
+	}
+
+	return true
+}
+
+func bearerToken(r *http.Request) string {
+	auth := r.Header.Get("Authorization")
+	if len(auth) < len("Bearer ") || !strings.EqualFold(auth[:len("Bearer ")], "Bearer ") {
+		return ""
+	}
+
+	return strings.TrimSpace(auth[len("Bearer "):])
+}
+
+func (a *authorizer) refresh(ctx context.Context) {
+	defer recover.Panic(a.log)
+
+	t := time.NewTicker(5 * time.Minute)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			return
+		}
+
+		err := a.refreshOnce(ctx)
+		if err != nil {
+			a.log.Error(err)
+		}
+	}
+}
+
+func (a *authorizer) refreshOnce(ctx context.Context) error {
+	b, err := a.load(ctx)
+	if err != nil {
+		return err
+	}
+
+	p, err := Parse(b)
+	if err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.p = p
+
+	return nil
+}
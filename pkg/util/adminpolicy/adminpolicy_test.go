@@ -0,0 +1,306 @@
+package adminpolicy
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	utiltls "github.com/Azure/ARO-RP/pkg/util/tls"
+)
+
+func TestParse(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		policy  string
+		wantErr string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:   "valid",
+			policy: `{"sourceAddressPrefixes":["10.0.0.0/8"],"groups":{"sre":["aa"]},"actions":{"postAdminOpenShiftUpgrade":["sre"]}}`,
+		},
+		{
+			name:    "invalid json",
+			policy:  `{`,
+			wantErr: "unexpected end of JSON input",
+		},
+		{
+			name:    "invalid cidr",
+			policy:  `{"sourceAddressPrefixes":["10.0.0.0"]}`,
+			wantErr: "invalid CIDR address: 10.0.0.0",
+		},
+		{
+			name:   "valid aad groups",
+			policy: `{"aadGroups":{"sre":["00000000-0000-0000-0000-000000000001"]},"actions":{"postAdminOpenShiftUpgrade":["sre"]}}`,
+		},
+		{
+			name:    "unknown group",
+			policy:  `{"actions":{"postAdminOpenShiftUpgrade":["sre"]}}`,
+			wantErr: `action "postAdminOpenShiftUpgrade" references unknown group "sre"`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.policy))
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestPolicyIsAuthorized(t *testing.T) {
+	_, sreCerts, err := utiltls.GenerateKeyAndCertificate("sre", nil, nil, false, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, otherCerts, err := utiltls.GenerateKeyAndCertificate("other", nil, nil, false, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	thumbprint := func(cert *x509.Certificate) string {
+		sum := sha256.Sum256(cert.Raw)
+		return hex.EncodeToString(sum[:])
+	}
+
+	request := func(remoteAddr string, cert *x509.Certificate) *http.Request {
+		r := &http.Request{
+			RemoteAddr: remoteAddr,
+		}
+		if cert != nil {
+			r.TLS = &tls.ConnectionState{
+				PeerCertificates: []*x509.Certificate{cert},
+			}
+		}
+		return r
+	}
+
+	for _, tt := range []struct {
+		name        string
+		policy      string
+		r           *http.Request
+		action      string
+		aadGroupIDs []string
+		wantErr     string
+	}{
+		{
+			name:   "empty policy allows everything",
+			r:      request("192.168.0.1:1234", nil),
+			action: "postAdminOpenShiftUpgrade",
+		},
+		{
+			name:   "allowed certificate",
+			policy: fmt.Sprintf(`{"clientCertificateThumbprints":[%q]}`, thumbprint(sreCerts[0])),
+			r:      request("10.0.0.1:1234", sreCerts[0]),
+		},
+		{
+			name:    "certificate not on allow-list",
+			policy:  fmt.Sprintf(`{"clientCertificateThumbprints":[%q]}`, thumbprint(sreCerts[0])),
+			r:       request("10.0.0.1:1234", otherCerts[0]),
+			wantErr: fmt.Sprintf("client certificate %q not allowed", thumbprint(otherCerts[0])),
+		},
+		{
+			name:   "allowed source address",
+			policy: `{"sourceAddressPrefixes":["10.0.0.0/8","192.168.0.0/16"]}`,
+			r:      request("192.168.0.1:1234", sreCerts[0]),
+		},
+		{
+			name:    "source address not allowed",
+			policy:  `{"sourceAddressPrefixes":["10.0.0.0/8"]}`,
+			r:       request("192.168.0.1:1234", sreCerts[0]),
+			wantErr: `source address "192.168.0.1" not allowed`,
+		},
+		{
+			name:   "action allowed to group member",
+			policy: fmt.Sprintf(`{"groups":{"sre":[%q]},"actions":{"postAdminOpenShiftUpgrade":["sre"]}}`, thumbprint(sreCerts[0])),
+			r:      request("10.0.0.1:1234", sreCerts[0]),
+			action: "postAdminOpenShiftUpgrade",
+		},
+		{
+			name:    "action denied to non group member",
+			policy:  fmt.Sprintf(`{"groups":{"sre":[%q]},"actions":{"postAdminOpenShiftUpgrade":["sre"]}}`, thumbprint(sreCerts[0])),
+			r:       request("10.0.0.1:1234", otherCerts[0]),
+			action:  "postAdminOpenShiftUpgrade",
+			wantErr: fmt.Sprintf(`client certificate %q not in any group allowed to invoke "postAdminOpenShiftUpgrade"`, thumbprint(otherCerts[0])),
+		},
+		{
+			name:        "action allowed to AAD group member",
+			policy:      `{"aadGroups":{"sre":["00000000-0000-0000-0000-000000000001"]},"actions":{"postAdminOpenShiftUpgrade":["sre"]}}`,
+			r:           request("10.0.0.1:1234", otherCerts[0]),
+			action:      "postAdminOpenShiftUpgrade",
+			aadGroupIDs: []string{"00000000-0000-0000-0000-000000000002", "00000000-0000-0000-0000-000000000001"},
+		},
+		{
+			name:        "action denied to non AAD group member",
+			policy:      `{"aadGroups":{"sre":["00000000-0000-0000-0000-000000000001"]},"actions":{"postAdminOpenShiftUpgrade":["sre"]}}`,
+			r:           request("10.0.0.1:1234", otherCerts[0]),
+			action:      "postAdminOpenShiftUpgrade",
+			aadGroupIDs: []string{"00000000-0000-0000-0000-000000000002"},
+			wantErr:     fmt.Sprintf(`client certificate %q not in any group allowed to invoke "postAdminOpenShiftUpgrade"`, thumbprint(otherCerts[0])),
+		},
+		{
+			name:   "unlisted action allowed",
+			policy: fmt.Sprintf(`{"groups":{"sre":[%q]},"actions":{"postAdminOpenShiftUpgrade":["sre"]}}`, thumbprint(sreCerts[0])),
+			r:      request("10.0.0.1:1234", otherCerts[0]),
+			action: "getAdminKubernetesObjects",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := Parse([]byte(tt.policy))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = p.IsAuthorized(tt.r, tt.action, tt.aadGroupIDs)
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestAuthorizerRefresh(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	r := &http.Request{
+		RemoteAddr: "192.168.0.1:1234",
+	}
+
+	policy := []byte(`{"sourceAddressPrefixes":["192.168.0.0/16"]}`)
+	var loadErr error
+
+	a := New(ctx, logrus.NewEntry(logrus.StandardLogger()), func(context.Context) ([]byte, error) {
+		return policy, loadErr
+	}, nil).(*authorizer)
+
+	if !a.IsAuthorized(r, "") {
+		t.Error("expected initial policy to allow request")
+	}
+
+	policy = []byte(`{"sourceAddressPrefixes":["10.0.0.0/8"]}`)
+	err := a.refreshOnce(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if a.IsAuthorized(r, "") {
+		t.Error("expected reloaded policy to deny request")
+	}
+
+	// a failed reload keeps the last good policy
+	policy, loadErr = nil, fmt.Errorf("random error")
+	err = a.refreshOnce(ctx)
+	if err == nil || err.Error() != "random error" {
+		t.Error(err)
+	}
+
+	if a.IsAuthorized(r, "") {
+		t.Error("expected last good policy to deny request")
+	}
+}
+
+func TestAuthorizerNotLoaded(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	a := New(ctx, logrus.NewEntry(logrus.StandardLogger()), func(context.Context) ([]byte, error) {
+		return nil, fmt.Errorf("random error")
+	}, nil)
+
+	if a.IsAuthorized(&http.Request{}, "") {
+		t.Error("expected request to be denied before policy is loaded")
+	}
+}
+
+type fakeResolver map[string][]string
+
+func (f fakeResolver) Groups(ctx context.Context, token string) ([]string, error) {
+	groups, found := f[token]
+	if !found {
+		return nil, fmt.Errorf("invalid token")
+	}
+	return groups, nil
+}
+
+func TestAuthorizerBearerToken(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	policy := []byte(`{"aadGroups":{"sre":["sregroup"]},"actions":{"postAdminOpenShiftUpgrade":["sre"]}}`)
+
+	a := New(ctx, logrus.NewEntry(logrus.StandardLogger()), func(context.Context) ([]byte, error) {
+		return policy, nil
+	}, fakeResolver{
+		"sretoken":   {"sregroup"},
+		"othertoken": {"othergroup"},
+	})
+
+	for _, tt := range []struct {
+		name   string
+		header string
+		action string
+		want   bool
+	}{
+		{
+			name:   "member of allowed group",
+			header: "Bearer sretoken",
+			action: "postAdminOpenShiftUpgrade",
+			want:   true,
+		},
+		{
+			name:   "scheme is case insensitive",
+			header: "bearer sretoken",
+			action: "postAdminOpenShiftUpgrade",
+			want:   true,
+		},
+		{
+			name:   "not member of allowed group",
+			header: "Bearer othertoken",
+			action: "postAdminOpenShiftUpgrade",
+		},
+		{
+			name:   "no token",
+			action: "postAdminOpenShiftUpgrade",
+		},
+		{
+			name:   "invalid token denied even for unlisted action",
+			header: "Bearer badtoken",
+			action: "getAdminKubernetesObjects",
+		},
+		{
+			name:   "unlisted action allowed",
+			header: "Bearer othertoken",
+			action: "getAdminKubernetesObjects",
+			want:   true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &http.Request{
+				Header: http.Header{},
+			}
+			if tt.header != "" {
+				r.Header.Set("Authorization", tt.header)
+			}
+
+			if got := a.IsAuthorized(r, tt.action); got != tt.want {
+				t.Error(got)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package analytics
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	azstorage "github.com/Azure/azure-sdk-for-go/storage"
+
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/storage"
+)
+
+const analyticsContainerName = "analytics"
+
+// Tables into which records are exported
+const (
+	TableOpenShiftClusters = "openshiftclusters"
+	TableAsyncOperations   = "asyncoperations"
+)
+
+// Manager exports records for offline analytics as JSON-lines blobs, one blob
+// per export, named <table>/<yyyy>/<mm>/<dd>/<hhmmss>.jsonl.  It also keeps
+// the change feed continuation from which the next export of each table
+// resumes, so that exports survive restarts of the RP.
+type Manager interface {
+	GetContinuation(ctx context.Context, table string) (string, error)
+	PutRecords(ctx context.Context, table string, records []interface{}, continuation string, now time.Time) error
+}
+
+type manager struct {
+	container *azstorage.Container
+}
+
+// NewManager returns a new analytics Manager backed by the storage account
+// named in ANALYTICS_EXPORT_STORAGE_ACCOUNT in the RP resource group.  If the
+// environment variable is unset, export is disabled and nil is returned.
+func NewManager(ctx context.Context, _env env.Core) (Manager, error) {
+	storageAccountName := os.Getenv("ANALYTICS_EXPORT_STORAGE_ACCOUNT")
+	if storageAccountName == "" {
+		return nil, nil
+	}
+
+	rpAuthorizer, err := _env.NewRPAuthorizer(_env.Environment().ResourceManagerEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := storage.NewAccountsClient(_env.SubscriptionID(), rpAuthorizer)
+
+	keys, err := accounts.ListKeys(ctx, _env.ResourceGroup(), storageAccountName, "")
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := azstorage.NewBasicClientOnSovereignCloud(storageAccountName, *(*keys.Keys)[0].Value, *_env.Environment())
+	if err != nil {
+		return nil, err
+	}
+
+	blobcli := client.GetBlobService()
+
+	container := blobcli.GetContainerReference(analyticsContainerName)
+	_, err = container.CreateIfNotExists(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &manager{
+		container: container,
+	}, nil
+}
+
+// GetContinuation returns the change feed continuation from which the next
+// export of table resumes, or the empty string if table has not been exported
+// before
+func (m *manager) GetContinuation(ctx context.Context, table string) (string, error) {
+	rc, err := m.container.GetBlobReference(continuationBlobName(table)).Get(nil)
+	if serviceErr, ok := err.(azstorage.AzureStorageServiceError); ok &&
+		serviceErr.StatusCode == http.StatusNotFound {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+
+	b, err := ioutil.ReadAll(rc)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// PutRecords writes records to a new blob of table, one JSON object per line,
+// and then records continuation as the point from which the next export of
+// table resumes.  If the continuation cannot be written, the next export
+// repeats these records.
+func (m *manager) PutRecords(ctx context.Context, table string, records []interface{}, continuation string, now time.Time) error {
+	if len(records) > 0 {
+		buf := &bytes.Buffer{}
+		e := json.NewEncoder(buf)
+		for _, record := range records {
+			err := e.Encode(record)
+			if err != nil {
+				return err
+			}
+		}
+
+		err := m.container.GetBlobReference(recordsBlobName(table, now)).CreateBlockBlobFromReader(buf, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return m.container.GetBlobReference(continuationBlobName(table)).CreateBlockBlobFromReader(strings.NewReader(continuation), nil)
+}
+
+// recordsBlobName returns the name of the blob holding the records of table
+// exported at now.  Blobs are partitioned by day so that they can be ingested
+// incrementally.
+func recordsBlobName(table string, now time.Time) string {
+	return table + "/" + now.UTC().Format("2006/01/02/150405") + ".jsonl"
+}
+
+func continuationBlobName(table string) string {
+	return "continuations/" + table
+}
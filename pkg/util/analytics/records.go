@@ -0,0 +1,193 @@
+package analytics
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+// ClusterRecord is the exported metadata of an OpenShiftClusterDocument.  It
+// holds no customer identifiers, names, network configuration or secrets: the
+// cluster and subscription are identified by pseudonyms, so that the records
+// of a cluster can be joined with each other and with those of its
+// asyncOperations, but not traced back to the customer.
+type ClusterRecord struct {
+	ClusterID      string `json:"clusterId,omitempty"`
+	SubscriptionID string `json:"subscriptionId,omitempty"`
+	Location       string `json:"location,omitempty"`
+
+	// Timestamp is the time at which the document was last written, in
+	// seconds since the epoch.  Export is at least once: consumers should
+	// deduplicate on ClusterID and Timestamp.
+	Timestamp int `json:"timestamp,omitempty"`
+
+	ArchitectureVersion     api.ArchitectureVersion `json:"architectureVersion"`
+	ProvisioningState       api.ProvisioningState   `json:"provisioningState,omitempty"`
+	LastProvisioningState   api.ProvisioningState   `json:"lastProvisioningState,omitempty"`
+	FailedProvisioningState api.ProvisioningState   `json:"failedProvisioningState,omitempty"`
+	CreatedBy               string                  `json:"createdBy,omitempty"`
+	ProvisionedBy           string                  `json:"provisionedBy,omitempty"`
+
+	Version             string                `json:"version,omitempty"`
+	APIServerVisibility api.Visibility        `json:"apiServerVisibility,omitempty"`
+	MasterVMSize        api.VMSize            `json:"masterVmSize,omitempty"`
+	WorkerProfiles      []WorkerProfileRecord `json:"workerProfiles,omitempty"`
+	AutoscalerEnabled   bool                  `json:"autoscalerEnabled,omitempty"`
+
+	LastOperationFailure *OperationFailureRecord `json:"lastOperationFailure,omitempty"`
+}
+
+// WorkerProfileRecord is the exported sizing of a worker profile
+type WorkerProfileRecord struct {
+	VMSize     api.VMSize `json:"vmSize,omitempty"`
+	DiskSizeGB int        `json:"diskSizeGB,omitempty"`
+	Count      int        `json:"count,omitempty"`
+}
+
+// OperationFailureRecord is the exported classification of the last failed
+// install or update of a cluster.  The message is not exported: it may quote
+// the customer's resources.
+type OperationFailureRecord struct {
+	Operation      api.ProvisioningState `json:"operation,omitempty"`
+	Time           time.Time             `json:"time,omitempty"`
+	Category       api.FailureCategory   `json:"category,omitempty"`
+	Code           string                `json:"code,omitempty"`
+	AzureErrorCode string                `json:"azureErrorCode,omitempty"`
+	FailedStep     string                `json:"failedStep,omitempty"`
+}
+
+// AsyncOperationRecord is the exported metadata of an AsyncOperationDocument
+type AsyncOperationRecord struct {
+	OperationID    string `json:"operationId,omitempty"`
+	ClusterID      string `json:"clusterId,omitempty"`
+	SubscriptionID string `json:"subscriptionId,omitempty"`
+
+	// Timestamp is the time at which the document was last written, in
+	// seconds since the epoch.  Export is at least once: consumers should
+	// deduplicate on OperationID and Timestamp.
+	Timestamp int `json:"timestamp,omitempty"`
+
+	InitialProvisioningState api.ProvisioningState `json:"initialProvisioningState,omitempty"`
+	ProvisioningState        api.ProvisioningState `json:"provisioningState,omitempty"`
+	StartTime                time.Time             `json:"startTime,omitempty"`
+	EndTime                  *time.Time            `json:"endTime,omitempty"`
+	APIVersion               string                `json:"apiVersion,omitempty"`
+
+	ErrorCode  string `json:"errorCode,omitempty"`
+	FailedStep string `json:"failedStep,omitempty"`
+
+	ARMCalls          int `json:"armCalls,omitempty"`
+	ARMCallsThrottled int `json:"armCallsThrottled,omitempty"`
+}
+
+// NewClusterRecord returns the ClusterRecord of doc
+func NewClusterRecord(doc *api.OpenShiftClusterDocument) *ClusterRecord {
+	r := &ClusterRecord{
+		ClusterID:      pseudonym(doc.Key),
+		SubscriptionID: pseudonym(subscriptionID(doc.Key)),
+		Timestamp:      doc.Timestamp,
+	}
+
+	oc := doc.OpenShiftCluster
+	if oc == nil {
+		return r
+	}
+
+	r.Location = oc.Location
+	r.ArchitectureVersion = oc.Properties.ArchitectureVersion
+	r.ProvisioningState = oc.Properties.ProvisioningState
+	r.LastProvisioningState = oc.Properties.LastProvisioningState
+	r.FailedProvisioningState = oc.Properties.FailedProvisioningState
+	r.CreatedBy = oc.Properties.CreatedBy
+	r.ProvisionedBy = oc.Properties.ProvisionedBy
+	r.Version = oc.Properties.ClusterProfile.Version
+	r.APIServerVisibility = oc.Properties.APIServerProfile.Visibility
+	r.MasterVMSize = oc.Properties.MasterProfile.VMSize
+	r.AutoscalerEnabled = oc.Properties.AutoscalerProfile != nil
+
+	for _, wp := range oc.Properties.WorkerProfiles {
+		r.WorkerProfiles = append(r.WorkerProfiles, WorkerProfileRecord{
+			VMSize:     wp.VMSize,
+			DiskSizeGB: wp.DiskSizeGB,
+			Count:      wp.Count,
+		})
+	}
+
+	if f := oc.Properties.LastOperationFailure; f != nil {
+		r.LastOperationFailure = &OperationFailureRecord{
+			Operation:      f.Operation,
+			Time:           f.Time,
+			Category:       f.Category,
+			Code:           f.Code,
+			AzureErrorCode: f.AzureErrorCode,
+			FailedStep:     f.FailedStep,
+		}
+	}
+
+	return r
+}
+
+// NewAsyncOperationRecord returns the AsyncOperationRecord of doc.  The
+// OpenShiftCluster snapshot held in the document is not exported.
+func NewAsyncOperationRecord(doc *api.AsyncOperationDocument) *AsyncOperationRecord {
+	r := &AsyncOperationRecord{
+		OperationID:    pseudonym(doc.ID),
+		ClusterID:      pseudonym(doc.OpenShiftClusterKey),
+		SubscriptionID: pseudonym(subscriptionID(doc.OpenShiftClusterKey)),
+		Timestamp:      doc.Timestamp,
+	}
+
+	op := doc.AsyncOperation
+	if op == nil {
+		return r
+	}
+
+	r.InitialProvisioningState = op.InitialProvisioningState
+	r.ProvisioningState = op.ProvisioningState
+	r.StartTime = op.StartTime
+	r.EndTime = op.EndTime
+	r.APIVersion = op.APIVersion
+	r.FailedStep = op.FailedStep
+
+	if op.Error != nil {
+		r.ErrorCode = op.Error.Code
+	}
+
+	if op.ARMCalls != nil {
+		r.ARMCalls = op.ARMCalls.Calls
+		r.ARMCallsThrottled = op.ARMCalls.Throttled
+	}
+
+	return r
+}
+
+// subscriptionID returns the subscription of the cluster with the given key,
+// or the empty string if the key does not parse
+func subscriptionID(key string) string {
+	r, err := azure.ParseResourceID(key)
+	if err != nil {
+		return ""
+	}
+
+	return r.SubscriptionID
+}
+
+// pseudonym returns a stable, non-reversible replacement for the identifier
+// s, or the empty string if s is empty.  Identifiers are compared case
+// insensitively by ARM, so s is lower cased first.
+func pseudonym(s string) string {
+	if s == "" {
+		return ""
+	}
+
+	h := sha256.Sum256([]byte(strings.ToLower(s)))
+	return hex.EncodeToString(h[:])
+}
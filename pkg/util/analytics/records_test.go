@@ -0,0 +1,189 @@
+package analytics
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+const (
+	clusterKey       = "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourcegroup/providers/microsoft.redhatopenshift/openshiftclusters/resourcename"
+	mockSubID        = "00000000-0000-0000-0000-000000000000"
+	asyncOperationID = "11111111-1111-1111-1111-111111111111"
+)
+
+func TestNewClusterRecord(t *testing.T) {
+	failedAt := time.Date(2020, time.November, 1, 12, 0, 0, 0, time.UTC)
+
+	doc := &api.OpenShiftClusterDocument{
+		Key:       clusterKey,
+		Timestamp: 1604232000,
+		OpenShiftCluster: &api.OpenShiftCluster{
+			ID:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName",
+			Name:     "resourceName",
+			Location: "eastus",
+			Tags: map[string]string{
+				"owner": "someone@example.com",
+			},
+			Properties: api.OpenShiftClusterProperties{
+				ArchitectureVersion:     api.ArchitectureVersionV2,
+				ProvisioningState:       api.ProvisioningStateFailed,
+				FailedProvisioningState: api.ProvisioningStateCreating,
+				CreatedBy:               "abcdef",
+				ClusterProfile: api.ClusterProfile{
+					PullSecret:      "secret",
+					Domain:          "example.com",
+					Version:         "4.6.8",
+					ResourceGroupID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/cluster",
+				},
+				MasterProfile: api.MasterProfile{
+					VMSize:   api.VMSizeStandardD8sV3,
+					SubnetID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/vnet/providers/Microsoft.Network/virtualNetworks/vnet/subnets/master",
+				},
+				WorkerProfiles: []api.WorkerProfile{
+					{
+						Name:       "worker",
+						VMSize:     api.VMSizeStandardD4sV3,
+						DiskSizeGB: 128,
+						Count:      3,
+						SubnetID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/vnet/providers/Microsoft.Network/virtualNetworks/vnet/subnets/worker",
+					},
+				},
+				APIServerProfile: api.APIServerProfile{
+					Visibility: api.VisibilityPrivate,
+					URL:        "https://api.example.com:6443/",
+				},
+				LastOperationFailure: &api.OperationFailure{
+					Operation:      api.ProvisioningStateCreating,
+					Time:           failedAt,
+					Category:       api.FailureCategoryQuota,
+					Code:           api.CloudErrorCodeDeploymentFailed,
+					Message:        "Deployment of resourceName failed.",
+					AzureErrorCode: "SkuNotAvailable",
+					FailedStep:     "deployResourceTemplate",
+				},
+				KubeadminPassword: "password",
+			},
+		},
+	}
+
+	want := &ClusterRecord{
+		ClusterID:               pseudonym(clusterKey),
+		SubscriptionID:          pseudonym(mockSubID),
+		Location:                "eastus",
+		Timestamp:               1604232000,
+		ArchitectureVersion:     api.ArchitectureVersionV2,
+		ProvisioningState:       api.ProvisioningStateFailed,
+		FailedProvisioningState: api.ProvisioningStateCreating,
+		CreatedBy:               "abcdef",
+		Version:                 "4.6.8",
+		APIServerVisibility:     api.VisibilityPrivate,
+		MasterVMSize:            api.VMSizeStandardD8sV3,
+		WorkerProfiles: []WorkerProfileRecord{
+			{
+				VMSize:     api.VMSizeStandardD4sV3,
+				DiskSizeGB: 128,
+				Count:      3,
+			},
+		},
+		LastOperationFailure: &OperationFailureRecord{
+			Operation:      api.ProvisioningStateCreating,
+			Time:           failedAt,
+			Category:       api.FailureCategoryQuota,
+			Code:           api.CloudErrorCodeDeploymentFailed,
+			AzureErrorCode: "SkuNotAvailable",
+			FailedStep:     "deployResourceTemplate",
+		},
+	}
+
+	got := NewClusterRecord(doc)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("%#v", got)
+	}
+
+	b, err := json.Marshal(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, s := range []string{mockSubID, "resourcegroup", "resourcename", "example.com", "secret", "password", "vnet"} {
+		if strings.Contains(strings.ToLower(string(b)), s) {
+			t.Errorf("record contains %q: %s", s, string(b))
+		}
+	}
+}
+
+func TestNewAsyncOperationRecord(t *testing.T) {
+	startTime := time.Date(2020, time.November, 1, 11, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(40 * time.Minute)
+
+	doc := &api.AsyncOperationDocument{
+		ID:                  asyncOperationID,
+		Timestamp:           1604232000,
+		OpenShiftClusterKey: clusterKey,
+		AsyncOperation: &api.AsyncOperation{
+			ID:                       "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.RedHatOpenShift/locations/eastus/operationsstatus/" + asyncOperationID,
+			InitialProvisioningState: api.ProvisioningStateCreating,
+			ProvisioningState:        api.ProvisioningStateFailed,
+			StartTime:                startTime,
+			EndTime:                  &endTime,
+			Error: &api.CloudErrorBody{
+				Code:    api.CloudErrorCodeInternalServerError,
+				Message: "Internal server error.",
+			},
+			ARMCalls: &api.ARMCallStatistics{
+				Calls:     120,
+				Throttled: 2,
+			},
+			APIVersion: "2020-04-30",
+			FailedStep: "bootstrapConfigMapReady",
+		},
+		OpenShiftCluster: &api.OpenShiftCluster{
+			Properties: api.OpenShiftClusterProperties{
+				KubeadminPassword: "password",
+			},
+		},
+	}
+
+	want := &AsyncOperationRecord{
+		OperationID:              pseudonym(asyncOperationID),
+		ClusterID:                pseudonym(clusterKey),
+		SubscriptionID:           pseudonym(mockSubID),
+		Timestamp:                1604232000,
+		InitialProvisioningState: api.ProvisioningStateCreating,
+		ProvisioningState:        api.ProvisioningStateFailed,
+		StartTime:                startTime,
+		EndTime:                  &endTime,
+		APIVersion:               "2020-04-30",
+		ErrorCode:                api.CloudErrorCodeInternalServerError,
+		FailedStep:               "bootstrapConfigMapReady",
+		ARMCalls:                 120,
+		ARMCallsThrottled:        2,
+	}
+
+	got := NewAsyncOperationRecord(doc)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("%#v", got)
+	}
+}
+
+func TestPseudonym(t *testing.T) {
+	if pseudonym("") != "" {
+		t.Error("empty identifier")
+	}
+
+	if pseudonym(strings.ToUpper(clusterKey)) != pseudonym(clusterKey) {
+		t.Error("pseudonym is case sensitive")
+	}
+
+	if strings.Contains(pseudonym(clusterKey), mockSubID) {
+		t.Error(pseudonym(clusterKey))
+	}
+}
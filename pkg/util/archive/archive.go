@@ -0,0 +1,157 @@
+package archive
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"strings"
+
+	azstorage "github.com/Azure/azure-sdk-for-go/storage"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/storage"
+)
+
+const asyncOperationsContainerName = "asyncoperations"
+
+// Manager stores completed AsyncOperationDocuments outside of the database so
+// that they can be queried after they have expired from it
+type Manager interface {
+	PutAsyncOperation(context.Context, *api.AsyncOperationDocument) error
+	GetAsyncOperation(ctx context.Context, openShiftClusterKey, id string) (*api.AsyncOperationDocument, error)
+	ListAsyncOperations(ctx context.Context, openShiftClusterKey string) ([]*api.AsyncOperation, error)
+}
+
+type manager struct {
+	container *azstorage.Container
+}
+
+// NewManager returns a new archive Manager backed by the storage account named
+// in ASYNCOPERATIONS_ARCHIVE_STORAGE_ACCOUNT in the RP resource group.  If the
+// environment variable is unset, archival is disabled and nil is returned.
+func NewManager(ctx context.Context, _env env.Core) (Manager, error) {
+	storageAccountName := os.Getenv("ASYNCOPERATIONS_ARCHIVE_STORAGE_ACCOUNT")
+	if storageAccountName == "" {
+		return nil, nil
+	}
+
+	rpAuthorizer, err := _env.NewRPAuthorizer(_env.Environment().ResourceManagerEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := storage.NewAccountsClient(_env.SubscriptionID(), rpAuthorizer)
+
+	keys, err := accounts.ListKeys(ctx, _env.ResourceGroup(), storageAccountName, "")
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := azstorage.NewBasicClientOnSovereignCloud(storageAccountName, *(*keys.Keys)[0].Value, *_env.Environment())
+	if err != nil {
+		return nil, err
+	}
+
+	blobcli := client.GetBlobService()
+
+	container := blobcli.GetContainerReference(asyncOperationsContainerName)
+	_, err = container.CreateIfNotExists(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &manager{
+		container: container,
+	}, nil
+}
+
+// PutAsyncOperation archives the asyncOperation in doc.  Only the
+// asyncOperation itself is stored: the OpenShiftCluster snapshot held in the
+// document may contain secrets and is deliberately not archived.
+func (m *manager) PutAsyncOperation(ctx context.Context, doc *api.AsyncOperationDocument) error {
+	b, err := json.Marshal(&api.AsyncOperationDocument{
+		ID:                  doc.ID,
+		OpenShiftClusterKey: doc.OpenShiftClusterKey,
+		AsyncOperation:      doc.AsyncOperation,
+	})
+	if err != nil {
+		return err
+	}
+
+	return m.container.GetBlobReference(blobName(doc.OpenShiftClusterKey, doc.ID)).CreateBlockBlobFromReader(bytes.NewReader(b), nil)
+}
+
+// GetAsyncOperation returns the archived AsyncOperationDocument with the given
+// id of the cluster with the given key, or nil if there is none.  Its
+// OpenShiftCluster snapshot is not archived and is always nil.
+func (m *manager) GetAsyncOperation(ctx context.Context, openShiftClusterKey, id string) (*api.AsyncOperationDocument, error) {
+	doc, err := m.get(&azstorage.Blob{Name: blobName(openShiftClusterKey, id)})
+	if serviceErr, ok := err.(azstorage.AzureStorageServiceError); ok &&
+		serviceErr.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	return doc, err
+}
+
+// ListAsyncOperations returns all the archived asyncOperations for the cluster
+// with the given key
+func (m *manager) ListAsyncOperations(ctx context.Context, openShiftClusterKey string) ([]*api.AsyncOperation, error) {
+	var asyncOperations []*api.AsyncOperation
+
+	params := azstorage.ListBlobsParameters{
+		Prefix: blobName(openShiftClusterKey, ""),
+	}
+
+	for {
+		resp, err := m.container.ListBlobs(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, blob := range resp.Blobs {
+			doc, err := m.get(&blob)
+			if err != nil {
+				return nil, err
+			}
+
+			asyncOperations = append(asyncOperations, doc.AsyncOperation)
+		}
+
+		if resp.NextMarker == "" {
+			break
+		}
+		params.Marker = resp.NextMarker
+	}
+
+	return asyncOperations, nil
+}
+
+func (m *manager) get(blob *azstorage.Blob) (*api.AsyncOperationDocument, error) {
+	rc, err := m.container.GetBlobReference(blob.Name).Get(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	var doc *api.AsyncOperationDocument
+	err = json.NewDecoder(rc).Decode(&doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// blobName returns the name of the blob holding an archived asyncOperation.
+// Blobs are grouped under the cluster key so that they can be listed by
+// prefix.
+func blobName(openShiftClusterKey, id string) string {
+	return strings.TrimPrefix(openShiftClusterKey, "/") + "/" + id
+}
@@ -0,0 +1,151 @@
+package azureclient
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+type armCallsContextKey struct{}
+type stepContextKey struct{}
+type senderContextKey struct{}
+
+// ARMCallRecorder aggregates the ARM calls made with the contexts it is
+// attached to, so that they can be attributed to the operation on whose
+// behalf they were made.  It is safe for concurrent use.
+type ARMCallRecorder struct {
+	mu    sync.Mutex
+	total api.ARMCallStepStatistics
+	steps []*api.ARMCallStepStatistics
+}
+
+// NewARMCallRecorder returns a new ARMCallRecorder
+func NewARMCallRecorder() *ARMCallRecorder {
+	return &ARMCallRecorder{}
+}
+
+// WithARMCallRecorder returns a context whose ARM calls are recorded by r
+func WithARMCallRecorder(ctx context.Context, r *ARMCallRecorder) context.Context {
+	return context.WithValue(ctx, armCallsContextKey{}, r)
+}
+
+// WithStep returns a context whose ARM calls are attributed to the named step
+func WithStep(ctx context.Context, step string) context.Context {
+	return context.WithValue(ctx, stepContextKey{}, step)
+}
+
+func (r *ARMCallRecorder) record(step string, resp *http.Response) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	throttled := resp != nil && resp.StatusCode == http.StatusTooManyRequests
+
+	stats := []*api.ARMCallStepStatistics{&r.total}
+	if step != "" {
+		stats = append(stats, r.step(step))
+	}
+
+	for _, s := range stats {
+		s.Calls++
+		if throttled {
+			s.Throttled++
+		}
+	}
+}
+
+// step returns the statistics of the named step, adding them if necessary.
+// r.mu must be held.
+func (r *ARMCallRecorder) step(step string) *api.ARMCallStepStatistics {
+	for _, s := range r.steps {
+		if s.Step == step {
+			return s
+		}
+	}
+
+	s := &api.ARMCallStepStatistics{Step: step}
+	r.steps = append(r.steps, s)
+
+	return s
+}
+
+// Statistics returns the statistics of the calls recorded so far, or nil if
+// there were none.  It may be called on a nil ARMCallRecorder.
+func (r *ARMCallRecorder) Statistics() *api.ARMCallStatistics {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.total.Calls == 0 {
+		return nil
+	}
+
+	stats := &api.ARMCallStatistics{
+		Calls:     r.total.Calls,
+		Throttled: r.total.Throttled,
+	}
+	for _, s := range r.steps {
+		stats.Steps = append(stats.Steps, *s)
+	}
+
+	return stats
+}
+
+// WithSender returns a context whose ARM calls are sent with s instead of the
+// client's Sender.  It is intended for tests, which use it to send the calls
+// of the real clients to a fake ARM.
+func WithSender(ctx context.Context, s autorest.Sender) context.Context {
+	return context.WithValue(ctx, senderContextKey{}, s)
+}
+
+// DecorateSender returns a Sender which sends with s (or the autorest default
+// if s is nil), or with the Sender of the request context if there is one,
+// and records each attempt, retries included, with the ARMCallRecorder and
+// the CircuitBreaker of the request context if there are any.  While the
+// CircuitBreaker is open, the attempts of fail fast contexts are refused.
+func DecorateSender(s autorest.Sender) autorest.Sender {
+	if s == nil {
+		s = autorest.CreateSender()
+	}
+
+	return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		cbc := circuitBreakerFromContext(req.Context())
+		if cbc != nil && cbc.failFast && !cbc.cb.Allow() {
+			return nil, ErrCircuitOpen
+		}
+
+		sender := s
+		if cs, ok := req.Context().Value(senderContextKey{}).(autorest.Sender); ok {
+			sender = cs
+		}
+
+		resp, err := sender.Do(req)
+
+		if r := ARMCallRecorderFromContext(req.Context()); r != nil {
+			step, _ := req.Context().Value(stepContextKey{}).(string)
+			r.record(step, resp)
+		}
+
+		if cbc != nil {
+			cbc.cb.record(isARMFailure(req, resp, err))
+		}
+
+		return resp, err
+	})
+}
+
+// ARMCallRecorderFromContext returns the ARMCallRecorder attached to ctx, or
+// nil if there is none
+func ARMCallRecorderFromContext(ctx context.Context) *ARMCallRecorder {
+	r, _ := ctx.Value(armCallsContextKey{}).(*ARMCallRecorder)
+	return r
+}
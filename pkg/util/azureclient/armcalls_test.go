@@ -0,0 +1,74 @@
+package azureclient
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+func TestDecorateSender(t *testing.T) {
+	var statusCodes []int
+	s := DecorateSender(autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		statusCode := statusCodes[0]
+		statusCodes = statusCodes[1:]
+		return &http.Response{StatusCode: statusCode}, nil
+	}))
+
+	send := func(ctx context.Context, codes ...int) {
+		statusCodes = codes
+		for range codes {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://management.azure.com/", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = s.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	// calls without a recorder are not recorded and don't fail
+	send(context.Background(), http.StatusOK)
+
+	r := NewARMCallRecorder()
+	if r.Statistics() != nil {
+		t.Error("unexpected statistics")
+	}
+
+	ctx := WithARMCallRecorder(context.Background(), r)
+
+	send(ctx, http.StatusOK)
+	send(WithStep(ctx, "[Action ensureResourceGroup]"), http.StatusTooManyRequests, http.StatusCreated)
+	send(WithStep(ctx, "[Action deployStorageTemplate]"), http.StatusOK)
+	send(WithStep(ctx, "[Action ensureResourceGroup]"), http.StatusTooManyRequests)
+
+	want := &api.ARMCallStatistics{
+		Calls:     5,
+		Throttled: 2,
+		Steps: []api.ARMCallStepStatistics{
+			{
+				Step:      "[Action ensureResourceGroup]",
+				Calls:     3,
+				Throttled: 2,
+			},
+			{
+				Step:  "[Action deployStorageTemplate]",
+				Calls: 1,
+			},
+		},
+	}
+
+	if got := r.Statistics(); !reflect.DeepEqual(got, want) {
+		t.Errorf("%#v", got)
+	}
+}
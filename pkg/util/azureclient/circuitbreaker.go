@@ -0,0 +1,180 @@
+package azureclient
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+type circuitBreakerContextKey struct{}
+
+// ErrCircuitOpen is returned instead of sending the ARM calls of contexts
+// which fail fast while the circuit breaker is open
+var ErrCircuitOpen = errors.New("the ARM circuit breaker is open: ARM is failing in this region")
+
+// CircuitBreaker states
+const (
+	CircuitClosed   = "Closed"
+	CircuitOpen     = "Open"
+	CircuitHalfOpen = "HalfOpen"
+)
+
+const (
+	circuitBreakerWindow       = 5 * time.Minute
+	circuitBreakerMinCalls     = 20
+	circuitBreakerFailureRatio = 0.5
+	circuitBreakerCooldown     = 5 * time.Minute
+)
+
+// CircuitBreaker detects a sustained failure of ARM in the region, an outage,
+// from the outcomes of the ARM calls made with the contexts it is attached to.
+// It opens when at least half of the calls of a window of five minutes fail
+// (throttled, server errors or no response at all), provided that there were
+// enough of them to tell.  After a cooldown it half-opens, and the next call
+// either closes it again or reopens it.
+//
+// The circuit breaker doesn't hold up calls by itself: callers consult
+// Allow() before starting non-urgent work, and contexts attached with failFast
+// have their calls refused while it is open, so that background retries don't
+// use up the RP's ARM quota during an incident.  It is safe for concurrent
+// use, and a nil CircuitBreaker is always closed.
+type CircuitBreaker struct {
+	now func() time.Time
+
+	mu          sync.Mutex
+	state       string
+	windowStart time.Time
+	calls       int
+	failures    int
+	openedAt    time.Time
+}
+
+// NewCircuitBreaker returns a new, closed CircuitBreaker
+func NewCircuitBreaker() *CircuitBreaker {
+	return &CircuitBreaker{
+		now:   time.Now,
+		state: CircuitClosed,
+	}
+}
+
+type circuitBreakerContext struct {
+	cb       *CircuitBreaker
+	failFast bool
+}
+
+// WithCircuitBreaker returns a context whose ARM calls are recorded by cb.  If
+// failFast is set, the calls fail with ErrCircuitOpen while cb is open.
+func WithCircuitBreaker(ctx context.Context, cb *CircuitBreaker, failFast bool) context.Context {
+	return context.WithValue(ctx, circuitBreakerContextKey{}, &circuitBreakerContext{
+		cb:       cb,
+		failFast: failFast,
+	})
+}
+
+// State returns the state of the circuit breaker
+func (cb *CircuitBreaker) State() string {
+	if cb == nil {
+		return CircuitClosed
+	}
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	return cb.currentState()
+}
+
+// Allow returns true unless the circuit breaker is open, i.e. if non-urgent
+// work may go ahead
+func (cb *CircuitBreaker) Allow() bool {
+	return cb.State() != CircuitOpen
+}
+
+// currentState half-opens the circuit breaker once the cooldown has passed.
+// cb.mu must be held.
+func (cb *CircuitBreaker) currentState() string {
+	if cb.state == CircuitOpen && cb.now().Sub(cb.openedAt) >= circuitBreakerCooldown {
+		cb.state = CircuitHalfOpen
+	}
+
+	return cb.state
+}
+
+func (cb *CircuitBreaker) record(failed bool) {
+	if cb == nil {
+		return
+	}
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	now := cb.now()
+
+	switch cb.currentState() {
+	case CircuitOpen:
+		// calls already under way when the circuit breaker opened don't
+		// count towards the next window
+		return
+
+	case CircuitHalfOpen:
+		if failed {
+			cb.open(now)
+		} else {
+			cb.close(now)
+		}
+		return
+	}
+
+	if now.Sub(cb.windowStart) >= circuitBreakerWindow {
+		cb.windowStart = now
+		cb.calls = 0
+		cb.failures = 0
+	}
+
+	cb.calls++
+	if failed {
+		cb.failures++
+	}
+
+	if cb.calls >= circuitBreakerMinCalls &&
+		float64(cb.failures) >= circuitBreakerFailureRatio*float64(cb.calls) {
+		cb.open(now)
+	}
+}
+
+// open opens the circuit breaker.  cb.mu must be held.
+func (cb *CircuitBreaker) open(now time.Time) {
+	cb.state = CircuitOpen
+	cb.openedAt = now
+}
+
+// close closes the circuit breaker and starts a new window.  cb.mu must be
+// held.
+func (cb *CircuitBreaker) close(now time.Time) {
+	cb.state = CircuitClosed
+	cb.windowStart = now
+	cb.calls = 0
+	cb.failures = 0
+}
+
+// isARMFailure returns true if the outcome of an ARM call indicates that ARM
+// is failing, rather than that the request was bad
+func isARMFailure(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		// the caller giving up is not ARM's fault
+		return req.Context().Err() == nil
+	}
+
+	return resp == nil ||
+		resp.StatusCode == http.StatusTooManyRequests ||
+		resp.StatusCode >= http.StatusInternalServerError
+}
+
+func circuitBreakerFromContext(ctx context.Context) *circuitBreakerContext {
+	cbc, _ := ctx.Value(circuitBreakerContextKey{}).(*circuitBreakerContext)
+	return cbc
+}
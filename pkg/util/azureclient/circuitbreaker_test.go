@@ -0,0 +1,144 @@
+package azureclient
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Unix(0, 0)
+
+	cb := NewCircuitBreaker()
+	cb.now = func() time.Time { return now }
+
+	// too few calls to tell
+	for i := 0; i < circuitBreakerMinCalls-1; i++ {
+		cb.record(true)
+	}
+	if cb.State() != CircuitClosed {
+		t.Fatal(cb.State())
+	}
+
+	// a new window forgets the earlier failures
+	now = now.Add(circuitBreakerWindow)
+	for i := 0; i < circuitBreakerMinCalls/2; i++ {
+		cb.record(false)
+	}
+	for i := 0; i < circuitBreakerMinCalls/2-1; i++ {
+		cb.record(true)
+	}
+	if cb.State() != CircuitClosed {
+		t.Fatal(cb.State())
+	}
+
+	cb.record(true)
+	if cb.State() != CircuitOpen || cb.Allow() {
+		t.Fatal(cb.State())
+	}
+
+	// calls completing while open don't change anything
+	cb.record(false)
+	if cb.State() != CircuitOpen {
+		t.Fatal(cb.State())
+	}
+
+	now = now.Add(circuitBreakerCooldown)
+	if cb.State() != CircuitHalfOpen || !cb.Allow() {
+		t.Fatal(cb.State())
+	}
+
+	cb.record(true)
+	if cb.State() != CircuitOpen {
+		t.Fatal(cb.State())
+	}
+
+	now = now.Add(circuitBreakerCooldown)
+	cb.record(false)
+	if cb.State() != CircuitClosed {
+		t.Fatal(cb.State())
+	}
+
+	var nilcb *CircuitBreaker
+	nilcb.record(true)
+	if !nilcb.Allow() {
+		t.Error("nil circuit breaker not allowing")
+	}
+}
+
+func TestDecorateSenderCircuitBreaker(t *testing.T) {
+	var sent int
+	var statusCode int
+	var sendErr error
+	s := DecorateSender(autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		sent++
+		if sendErr != nil {
+			return nil, sendErr
+		}
+		return &http.Response{StatusCode: statusCode}, nil
+	}))
+
+	send := func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://management.azure.com/", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = s.Do(req)
+		return err
+	}
+
+	cb := NewCircuitBreaker()
+	urgent := WithCircuitBreaker(context.Background(), cb, false)
+	background := WithCircuitBreaker(context.Background(), cb, true)
+
+	// bad requests are not ARM failures
+	statusCode = http.StatusBadRequest
+	for i := 0; i < circuitBreakerMinCalls; i++ {
+		_ = send(urgent)
+	}
+	if cb.State() != CircuitClosed {
+		t.Fatal(cb.State())
+	}
+
+	// the caller giving up is not an ARM failure
+	canceled, cancel := context.WithCancel(urgent)
+	cancel()
+	sendErr = context.Canceled
+	for i := 0; i < circuitBreakerMinCalls; i++ {
+		_ = send(canceled)
+	}
+	if cb.State() != CircuitClosed {
+		t.Fatal(cb.State())
+	}
+
+	sendErr = errors.New("connection reset by peer")
+	for i := 0; i < circuitBreakerMinCalls*2; i++ {
+		_ = send(urgent)
+	}
+	if cb.State() != CircuitOpen {
+		t.Fatal(cb.State())
+	}
+
+	sent = 0
+
+	err := send(background)
+	if err != ErrCircuitOpen {
+		t.Error(err)
+	}
+	if sent != 0 {
+		t.Error(sent)
+	}
+
+	_ = send(urgent)
+	if sent != 1 {
+		t.Error(sent)
+	}
+}
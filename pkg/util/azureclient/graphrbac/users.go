@@ -0,0 +1,32 @@
+package graphrbac
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// UsersClient is a minimal interface for azure UsersClient
+type UsersClient interface {
+	GetMemberGroups(ctx context.Context, objectID string, parameters graphrbac.UserGetMemberGroupsParameters) (result graphrbac.UserGetMemberGroupsResult, err error)
+}
+
+type usersClient struct {
+	graphrbac.UsersClient
+}
+
+var _ UsersClient = &usersClient{}
+
+// NewUsersClient creates a new UsersClient
+func NewUsersClient(tenantID string, authorizer autorest.Authorizer) UsersClient {
+	client := graphrbac.NewUsersClient(tenantID)
+	client.Authorizer = authorizer
+
+	return &usersClient{
+		UsersClient: client,
+	}
+}
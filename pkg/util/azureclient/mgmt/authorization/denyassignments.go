@@ -0,0 +1,33 @@
+package authorization
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	mgmtauthorization "github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2018-09-01-preview/authorization"
+	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
+)
+
+// DenyAssignmentsClient is a minimal interface for azure DenyAssignmentsClient
+type DenyAssignmentsClient interface {
+	DenyAssignmentsClientAddons
+}
+
+type denyAssignmentsClient struct {
+	mgmtauthorization.DenyAssignmentsClient
+}
+
+var _ DenyAssignmentsClient = &denyAssignmentsClient{}
+
+// NewDenyAssignmentsClient creates a new DenyAssignmentsClient
+func NewDenyAssignmentsClient(subscriptionID string, authorizer autorest.Authorizer) DenyAssignmentsClient {
+	client := mgmtauthorization.NewDenyAssignmentsClient(subscriptionID)
+	client.Authorizer = authorizer
+	client.Sender = azureclient.DecorateSender(client.Sender)
+
+	return &denyAssignmentsClient{
+		DenyAssignmentsClient: client,
+	}
+}
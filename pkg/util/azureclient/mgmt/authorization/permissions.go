@@ -6,6 +6,8 @@ package authorization
 import (
 	mgmtauthorization "github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2018-09-01-preview/authorization"
 	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
 )
 
 // PermissionsClient is a minimal interface for azure PermissionsClient
@@ -23,6 +25,7 @@ var _ PermissionsClient = &permissionsClient{}
 func NewPermissionsClient(subscriptionID string, authorizer autorest.Authorizer) PermissionsClient {
 	client := mgmtauthorization.NewPermissionsClient(subscriptionID)
 	client.Authorizer = authorizer
+	client.Sender = azureclient.DecorateSender(client.Sender)
 
 	return &permissionsClient{
 		PermissionsClient: client,
@@ -8,6 +8,8 @@ import (
 
 	mgmtauthorization "github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2018-09-01-preview/authorization"
 	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
 )
 
 // RoleAssignmentsClient is a minimal interface for azure RoleAssignmentsClient
@@ -27,6 +29,7 @@ var _ RoleAssignmentsClient = &roleAssignmentsClient{}
 func NewRoleAssignmentsClient(subscriptionID string, authorizer autorest.Authorizer) RoleAssignmentsClient {
 	client := mgmtauthorization.NewRoleAssignmentsClient(subscriptionID)
 	client.Authorizer = authorizer
+	client.Sender = azureclient.DecorateSender(client.Sender)
 
 	return &roleAssignmentsClient{
 		RoleAssignmentsClient: client,
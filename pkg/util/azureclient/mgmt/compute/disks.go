@@ -6,6 +6,8 @@ package compute
 import (
 	mgmtcompute "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
 	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
 )
 
 // DisksClient is a minimal interface for azure DisksClient
@@ -23,6 +25,7 @@ var _ DisksClient = &disksClient{}
 func NewDisksClient(subscriptionID string, authorizer autorest.Authorizer) DisksClient {
 	client := mgmtcompute.NewDisksClient(subscriptionID)
 	client.Authorizer = authorizer
+	client.Sender = azureclient.DecorateSender(client.Sender)
 
 	return &disksClient{
 		DisksClient: client,
@@ -6,6 +6,8 @@ package compute
 import (
 	mgmtcompute "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
 	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
 )
 
 // ResourceSkusClient is a minimal interface for azure ResourceSkusClient
@@ -23,6 +25,7 @@ var _ ResourceSkusClient = &resourceSkusClient{}
 func NewResourceSkusClient(subscriptionID string, authorizer autorest.Authorizer) ResourceSkusClient {
 	client := mgmtcompute.NewResourceSkusClient(subscriptionID)
 	client.Authorizer = authorizer
+	client.Sender = azureclient.DecorateSender(client.Sender)
 
 	return &resourceSkusClient{
 		ResourceSkusClient: client,
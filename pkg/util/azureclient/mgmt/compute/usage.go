@@ -6,6 +6,8 @@ package compute
 import (
 	mgmtcompute "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
 	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
 )
 
 // UsageClient is a minimal interface for azure UsageClient
@@ -23,6 +25,7 @@ var _ UsageClient = &usageClient{}
 func NewUsageClient(tenantID string, authorizer autorest.Authorizer) UsageClient {
 	client := mgmtcompute.NewUsageClient(tenantID)
 	client.Authorizer = authorizer
+	client.Sender = azureclient.DecorateSender(client.Sender)
 
 	return &usageClient{
 		UsageClient: client,
@@ -0,0 +1,35 @@
+package compute
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+
+	mgmtcompute "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
+	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
+)
+
+// VirtualMachineImagesClient is a minimal interface for azure VirtualMachineImagesClient
+type VirtualMachineImagesClient interface {
+	List(ctx context.Context, location string, publisherName string, offer string, skus string, expand string, top *int32, orderby string) (result mgmtcompute.ListVirtualMachineImageResource, err error)
+}
+
+type virtualMachineImagesClient struct {
+	mgmtcompute.VirtualMachineImagesClient
+}
+
+var _ VirtualMachineImagesClient = &virtualMachineImagesClient{}
+
+// NewVirtualMachineImagesClient creates a new VirtualMachineImagesClient
+func NewVirtualMachineImagesClient(subscriptionID string, authorizer autorest.Authorizer) VirtualMachineImagesClient {
+	client := mgmtcompute.NewVirtualMachineImagesClient(subscriptionID)
+	client.Authorizer = authorizer
+	client.Sender = azureclient.DecorateSender(client.Sender)
+
+	return &virtualMachineImagesClient{
+		VirtualMachineImagesClient: client,
+	}
+}
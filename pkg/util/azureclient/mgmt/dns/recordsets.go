@@ -8,6 +8,8 @@ import (
 
 	mgmtdns "github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
 	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
 )
 
 // RecordSetsClient is a minimal interface for azure RecordSetsClient
@@ -27,6 +29,7 @@ var _ RecordSetsClient = &recordSetsClient{}
 func NewRecordSetsClient(subscriptionID string, authorizer autorest.Authorizer) RecordSetsClient {
 	client := mgmtdns.NewRecordSetsClient(subscriptionID)
 	client.Authorizer = authorizer
+	client.Sender = azureclient.DecorateSender(client.Sender)
 
 	return &recordSetsClient{
 		RecordSetsClient: client,
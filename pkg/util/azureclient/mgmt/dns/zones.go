@@ -8,6 +8,8 @@ import (
 
 	mgmtdns "github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
 	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
 )
 
 // ZonesClient is a minimal interface for azure ZonesClient
@@ -25,6 +27,7 @@ var _ ZonesClient = &zonesClient{}
 func NewZonesClient(subscriptionID string, authorizer autorest.Authorizer) ZonesClient {
 	client := mgmtdns.NewZonesClient(subscriptionID)
 	client.Authorizer = authorizer
+	client.Sender = azureclient.DecorateSender(client.Sender)
 
 	return &zonesClient{
 		ZonesClient: client,
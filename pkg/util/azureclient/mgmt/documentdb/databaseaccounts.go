@@ -8,10 +8,13 @@ import (
 
 	mgmtdocumentdb "github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2019-08-01/documentdb"
 	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
 )
 
 // DatabaseAccountsClient is a minimal interface for azure DatabaseAccountsClient
 type DatabaseAccountsClient interface {
+	Get(ctx context.Context, resourceGroupName string, accountName string) (result mgmtdocumentdb.DatabaseAccountGetResults, err error)
 	ListKeys(ctx context.Context, resourceGroupName string, accountName string) (result mgmtdocumentdb.DatabaseAccountListKeysResult, err error)
 }
 
@@ -25,6 +28,7 @@ var _ DatabaseAccountsClient = &databaseAccountsClient{}
 func NewDatabaseAccountsClient(subscriptionID string, authorizer autorest.Authorizer) DatabaseAccountsClient {
 	client := mgmtdocumentdb.NewDatabaseAccountsClient(subscriptionID, subscriptionID)
 	client.Authorizer = authorizer
+	client.Sender = azureclient.DecorateSender(client.Sender)
 
 	return &databaseAccountsClient{
 		DatabaseAccountsClient: client,
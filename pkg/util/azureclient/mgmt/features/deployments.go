@@ -9,11 +9,15 @@ import (
 
 	mgmtfeatures "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-07-01/features"
 	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
 )
 
 // DeploymentsClient is a minimal interface for azure DeploymentsClient
 type DeploymentsClient interface {
 	Get(ctx context.Context, resourceGroupName, deploymentName string) (mgmtfeatures.DeploymentExtended, error)
+	ExportTemplate(ctx context.Context, resourceGroupName string, deploymentName string) (mgmtfeatures.DeploymentExportResult, error)
+	ValidateAtSubscriptionScope(ctx context.Context, deploymentName string, parameters mgmtfeatures.Deployment) (mgmtfeatures.DeploymentValidateResult, error)
 	DeploymentsClientAddons
 }
 
@@ -27,6 +31,7 @@ var _ DeploymentsClient = &deploymentsClient{}
 func NewDeploymentsClient(subscriptionID string, authorizer autorest.Authorizer) DeploymentsClient {
 	client := mgmtfeatures.NewDeploymentsClient(subscriptionID)
 	client.Authorizer = authorizer
+	client.Sender = azureclient.DecorateSender(client.Sender)
 	client.PollingDelay = 10 * time.Second
 	client.PollingDuration = time.Hour
 
@@ -17,6 +17,7 @@ type DeploymentsClientAddons interface {
 	CreateOrUpdateAtSubscriptionScopeAndWait(ctx context.Context, deploymentName string, parameters mgmtfeatures.Deployment) error
 	DeleteAndWait(ctx context.Context, resourceGroupName string, deploymentName string) error
 	Wait(ctx context.Context, resourceGroupName string, deploymentName string) error
+	WhatIfAndWait(ctx context.Context, resourceGroupName string, deploymentName string, parameters mgmtfeatures.DeploymentWhatIf) (mgmtfeatures.WhatIfOperationResult, error)
 }
 
 func (c *deploymentsClient) CreateOrUpdateAtSubscriptionScopeAndWait(ctx context.Context, deploymentName string, parameters mgmtfeatures.Deployment) error {
@@ -46,6 +47,20 @@ func (c *deploymentsClient) DeleteAndWait(ctx context.Context, resourceGroupName
 	return future.WaitForCompletionRef(ctx, c.Client)
 }
 
+func (c *deploymentsClient) WhatIfAndWait(ctx context.Context, resourceGroupName string, deploymentName string, parameters mgmtfeatures.DeploymentWhatIf) (mgmtfeatures.WhatIfOperationResult, error) {
+	future, err := c.WhatIf(ctx, resourceGroupName, deploymentName, parameters)
+	if err != nil {
+		return mgmtfeatures.WhatIfOperationResult{}, err
+	}
+
+	err = future.WaitForCompletionRef(ctx, c.Client)
+	if err != nil {
+		return mgmtfeatures.WhatIfOperationResult{}, err
+	}
+
+	return future.Result(c.DeploymentsClient)
+}
+
 func (c *deploymentsClient) Wait(ctx context.Context, resourceGroupName string, deploymentName string) error {
 	return wait.Poll(c.Client.PollingDelay, c.Client.PollingDuration, func() (bool, error) {
 		deployment, err := c.Get(ctx, resourceGroupName, deploymentName)
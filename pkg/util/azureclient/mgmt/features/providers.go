@@ -6,6 +6,8 @@ package features
 import (
 	mgmtfeatures "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-07-01/features"
 	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
 )
 
 // ProvidersClient is a minimal interface for azure ProvidersClient
@@ -23,6 +25,7 @@ var _ ProvidersClient = &providersClient{}
 func NewProvidersClient(subscriptionID string, authorizer autorest.Authorizer) ProvidersClient {
 	client := mgmtfeatures.NewProvidersClient(subscriptionID)
 	client.Authorizer = authorizer
+	client.Sender = azureclient.DecorateSender(client.Sender)
 
 	return &providersClient{
 		ProvidersClient: client,
@@ -9,6 +9,8 @@ import (
 
 	mgmtfeatures "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-07-01/features"
 	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
 )
 
 // ResourceGroupsClient is a minimal interface for azure ResourceGroupsClient
@@ -29,6 +31,7 @@ var _ ResourceGroupsClient = &resourceGroupsClient{}
 func NewResourceGroupsClient(subscriptionID string, authorizer autorest.Authorizer) ResourceGroupsClient {
 	client := mgmtfeatures.NewResourceGroupsClient(subscriptionID)
 	client.Authorizer = authorizer
+	client.Sender = azureclient.DecorateSender(client.Sender)
 	client.PollingDelay = 10 * time.Second
 	client.PollingDuration = time.Hour
 
@@ -8,6 +8,8 @@ import (
 
 	mgmtfeatures "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-07-01/features"
 	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
 )
 
 // ResourcesClient is a minimal interface for azure ResourcesClient
@@ -27,6 +29,7 @@ var _ ResourcesClient = &resourcesClient{}
 func NewResourcesClient(subscriptionID string, authorizer autorest.Authorizer) ResourcesClient {
 	client := mgmtfeatures.NewResourcesClient(subscriptionID)
 	client.Authorizer = authorizer
+	client.Sender = azureclient.DecorateSender(client.Sender)
 
 	return &resourcesClient{
 		ResourcesClient: client,
@@ -6,6 +6,8 @@ package insights
 import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
 	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
 )
 
 // ActivityLogsClient is a minimal interface for azure ActivityLogsClient
@@ -23,6 +25,7 @@ var _ ActivityLogsClient = &activityLogsClient{}
 func NewActivityLogsClient(subscriptionID string, authorizer autorest.Authorizer) ActivityLogsClient {
 	client := insights.NewActivityLogsClient(subscriptionID)
 	client.Authorizer = authorizer
+	client.Sender = azureclient.DecorateSender(client.Sender)
 
 	return &activityLogsClient{
 		ActivityLogsClient: client,
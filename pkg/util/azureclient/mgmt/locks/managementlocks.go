@@ -0,0 +1,116 @@
+package locks
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
+)
+
+// The management locks API is not in the vendored SDK, so this client speaks
+// to it directly.  Only the subset of the API which the RP needs is
+// implemented.
+const (
+	defaultBaseURI = "https://management.azure.com"
+	apiVersion     = "2016-09-01"
+)
+
+// LockLevel is the level of a management lock
+type LockLevel string
+
+// LockLevel constants
+const (
+	CanNotDelete LockLevel = "CanNotDelete"
+	ReadOnly     LockLevel = "ReadOnly"
+)
+
+// ManagementLock is a management lock
+type ManagementLock struct {
+	ID         *string                   `json:"id,omitempty"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties *ManagementLockProperties `json:"properties,omitempty"`
+}
+
+// ManagementLockProperties are the properties of a management lock
+type ManagementLockProperties struct {
+	Level LockLevel `json:"level,omitempty"`
+	Notes *string   `json:"notes,omitempty"`
+}
+
+type managementLockListResult struct {
+	Value    []ManagementLock `json:"value,omitempty"`
+	NextLink *string          `json:"nextLink,omitempty"`
+}
+
+// ManagementLocksClient is a minimal interface for azure ManagementLocksClient
+type ManagementLocksClient interface {
+	ListAtScope(ctx context.Context, scope string) ([]ManagementLock, error)
+}
+
+type managementLocksClient struct {
+	autorest.Client
+}
+
+var _ ManagementLocksClient = &managementLocksClient{}
+
+// NewManagementLocksClient creates a new ManagementLocksClient
+func NewManagementLocksClient(authorizer autorest.Authorizer) ManagementLocksClient {
+	client := autorest.NewClientWithUserAgent("")
+	client.Authorizer = authorizer
+	client.Sender = azureclient.DecorateSender(client.Sender)
+
+	return &managementLocksClient{
+		Client: client,
+	}
+}
+
+// ListAtScope returns the management locks which apply to the resource,
+// resource group or subscription with the given ID, including those inherited
+// from its parents
+func (c *managementLocksClient) ListAtScope(ctx context.Context, scope string) (result []ManagementLock, err error) {
+	req, err := autorest.Prepare((&http.Request{}).WithContext(ctx),
+		autorest.AsGet(),
+		autorest.WithBaseURL(defaultBaseURI),
+		autorest.WithPath(scope+"/providers/Microsoft.Authorization/locks"),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": apiVersion,
+		}))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "locks.ManagementLocksClient", "ListAtScope", nil, "Failure preparing request")
+	}
+
+	for {
+		resp, err := c.Send(req, autorest.DoRetryForStatusCodes(c.RetryAttempts, c.RetryDuration, autorest.StatusCodesForRetry...))
+		if err != nil {
+			return nil, autorest.NewErrorWithError(err, "locks.ManagementLocksClient", "ListAtScope", resp, "Failure sending request")
+		}
+
+		var page managementLockListResult
+		err = autorest.Respond(resp,
+			azure.WithErrorUnlessStatusCode(http.StatusOK),
+			autorest.ByUnmarshallingJSON(&page),
+			autorest.ByClosing())
+		if err != nil {
+			return nil, autorest.NewErrorWithError(err, "locks.ManagementLocksClient", "ListAtScope", resp, "Failure responding to request")
+		}
+
+		result = append(result, page.Value...)
+
+		if page.NextLink == nil || *page.NextLink == "" {
+			return result, nil
+		}
+
+		req, err = autorest.Prepare((&http.Request{}).WithContext(ctx),
+			autorest.AsGet(),
+			autorest.WithBaseURL(*page.NextLink))
+		if err != nil {
+			return nil, autorest.NewErrorWithError(err, "locks.ManagementLocksClient", "ListAtScope", nil, "Failure preparing next results request")
+		}
+	}
+}
@@ -4,12 +4,17 @@ package network
 // Licensed under the Apache License 2.0.
 
 import (
+	"context"
+
 	mgmtnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-07-01/network"
 	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
 )
 
 // InterfacesClient is a minimal interface for azure InterfacesClient
 type InterfacesClient interface {
+	Get(ctx context.Context, resourceGroupName string, networkInterfaceName string, expand string) (result mgmtnetwork.Interface, err error)
 	InterfacesClientAddons
 }
 
@@ -23,6 +28,7 @@ var _ InterfacesClient = &interfacesClient{}
 func NewInterfacesClient(subscriptionID string, authorizer autorest.Authorizer) InterfacesClient {
 	client := mgmtnetwork.NewInterfacesClient(subscriptionID)
 	client.Authorizer = authorizer
+	client.Sender = azureclient.DecorateSender(client.Sender)
 
 	return &interfacesClient{
 		InterfacesClient: client,
@@ -8,6 +8,8 @@ import (
 
 	mgmtnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-07-01/network"
 	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
 )
 
 // LoadBalancersClient is a minimal interface for Azure LoadBalancersClient
@@ -26,6 +28,7 @@ var _ LoadBalancersClient = &loadBalancersClient{}
 func NewLoadBalancersClient(subscriptionID string, authorizer autorest.Authorizer) LoadBalancersClient {
 	client := mgmtnetwork.NewLoadBalancersClient(subscriptionID)
 	client.Authorizer = authorizer
+	client.Sender = azureclient.DecorateSender(client.Sender)
 
 	return &loadBalancersClient{
 		LoadBalancersClient: client,
@@ -8,6 +8,8 @@ import (
 
 	mgmtnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-07-01/network"
 	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
 )
 
 // PrivateEndpointsClient is a minimal interface for azure PrivateEndpointsClient
@@ -26,6 +28,7 @@ var _ PrivateEndpointsClient = &privateEndpointsClient{}
 func NewPrivateEndpointsClient(subscriptionID string, authorizer autorest.Authorizer) PrivateEndpointsClient {
 	client := mgmtnetwork.NewPrivateEndpointsClient(subscriptionID)
 	client.Authorizer = authorizer
+	client.Sender = azureclient.DecorateSender(client.Sender)
 
 	return &privateEndpointsClient{
 		PrivateEndpointsClient: client,
@@ -11,9 +11,19 @@ import (
 
 // PublicIPAddressesClientAddons contains addons for PublicIPAddressesClient
 type PublicIPAddressesClientAddons interface {
+	CreateOrUpdateAndWait(ctx context.Context, resourceGroupName string, publicIPAddressName string, parameters mgmtnetwork.PublicIPAddress) (err error)
 	DeleteAndWait(ctx context.Context, resourceGroupName string, publicIPAddressName string) (err error)
 }
 
+func (c *publicIPAddressesClient) CreateOrUpdateAndWait(ctx context.Context, resourceGroupName string, publicIPAddressName string, parameters mgmtnetwork.PublicIPAddress) error {
+	future, err := c.CreateOrUpdate(ctx, resourceGroupName, publicIPAddressName, parameters)
+	if err != nil {
+		return err
+	}
+
+	return future.WaitForCompletionRef(ctx, c.Client)
+}
+
 func (c *publicIPAddressesClient) DeleteAndWait(ctx context.Context, resourceGroupName string, publicIPAddressName string) error {
 	future, err := c.Delete(ctx, resourceGroupName, publicIPAddressName)
 	if err != nil {
@@ -8,6 +8,8 @@ import (
 
 	mgmtnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-07-01/network"
 	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
 )
 
 // RouteTablesClient is a minimal interface for azure RouteTablesClient
@@ -26,6 +28,7 @@ var _ RouteTablesClient = &routeTablesClient{}
 func NewRouteTablesClient(subscriptionID string, authorizer autorest.Authorizer) RouteTablesClient {
 	client := mgmtnetwork.NewRouteTablesClient(subscriptionID)
 	client.Authorizer = authorizer
+	client.Sender = azureclient.DecorateSender(client.Sender)
 
 	return &routeTablesClient{
 		RouteTablesClient: client,
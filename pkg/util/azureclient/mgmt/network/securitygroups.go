@@ -8,6 +8,8 @@ import (
 
 	mgmtnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-07-01/network"
 	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
 )
 
 // SecurityGroupsClient is a minimal interface for azure SecurityGroupsClient
@@ -26,6 +28,7 @@ var _ SecurityGroupsClient = &securityGroupsClient{}
 func NewSecurityGroupsClient(subscriptionID string, authorizer autorest.Authorizer) SecurityGroupsClient {
 	client := mgmtnetwork.NewSecurityGroupsClient(subscriptionID)
 	client.Authorizer = authorizer
+	client.Sender = azureclient.DecorateSender(client.Sender)
 
 	return &securityGroupsClient{
 		SecurityGroupsClient: client,
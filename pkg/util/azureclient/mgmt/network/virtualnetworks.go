@@ -8,6 +8,8 @@ import (
 
 	mgmtnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-07-01/network"
 	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
 )
 
 // VirtualNetworksClient is a minimal interface for azure VirtualNetworksClient
@@ -27,6 +29,7 @@ var _ VirtualNetworksClient = &virtualNetworksClient{}
 func NewVirtualNetworksClient(subscriptionID string, authorizer autorest.Authorizer) VirtualNetworksClient {
 	client := mgmtnetwork.NewVirtualNetworksClient(subscriptionID)
 	client.Authorizer = authorizer
+	client.Sender = azureclient.DecorateSender(client.Sender)
 
 	return &virtualNetworksClient{
 		VirtualNetworksClient: client,
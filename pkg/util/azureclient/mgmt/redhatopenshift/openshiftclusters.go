@@ -29,9 +29,9 @@ type openShiftClustersClient struct {
 var _ OpenShiftClustersClient = &openShiftClustersClient{}
 
 // NewOpenShiftClustersClient creates a new OpenShiftClustersClient
-func NewOpenShiftClustersClient(subscriptionID string, authorizer autorest.Authorizer) OpenShiftClustersClient {
+func NewOpenShiftClustersClient(capabilities deployment.Capabilities, subscriptionID string, authorizer autorest.Authorizer) OpenShiftClustersClient {
 	var client redhatopenshift.OpenShiftClustersClient
-	if deployment.NewMode() == deployment.Development {
+	if capabilities.IsLocalDevelopment() {
 		client = redhatopenshift.NewOpenShiftClustersClientWithBaseURI("https://localhost:8443", subscriptionID)
 		client.Sender = &http.Client{
 			Transport: &http.Transport{
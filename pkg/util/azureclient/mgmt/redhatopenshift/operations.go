@@ -25,9 +25,9 @@ type operationsClient struct {
 var _ OperationsClient = &operationsClient{}
 
 // NewOperationsClient creates a new OperationsClient
-func NewOperationsClient(subscriptionID string, authorizer autorest.Authorizer) OperationsClient {
+func NewOperationsClient(capabilities deployment.Capabilities, subscriptionID string, authorizer autorest.Authorizer) OperationsClient {
 	var client redhatopenshift.OperationsClient
-	if deployment.NewMode() == deployment.Development {
+	if capabilities.IsLocalDevelopment() {
 		client = redhatopenshift.NewOperationsClientWithBaseURI("https://localhost:8443", subscriptionID)
 		client.Sender = &http.Client{
 			Transport: &http.Transport{
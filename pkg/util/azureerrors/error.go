@@ -64,3 +64,81 @@ func IsDeploymentActiveError(err error) bool {
 	}
 	return false
 }
+
+// IsRequestDisallowedByPolicyError returns true it the error is, or contains,
+// a RequestDisallowedByPolicy error.  It also returns the ID of the offending
+// policy assignment (or, failing that, definition) if Azure reported one
+func IsRequestDisallowedByPolicyError(err error) (string, bool) {
+	serviceErr := serviceError(err)
+	if serviceErr == nil {
+		return "", false
+	}
+
+	if serviceErr.Code == "RequestDisallowedByPolicy" {
+		return policyID(serviceErr.AdditionalInfo), true
+	}
+
+	for _, d := range serviceErr.Details {
+		// details of failed deployments hold the error of each failed
+		// operation JSON encoded in their message, those of failed template
+		// validations are errors in their own right
+		var nested *azure.ServiceError
+		if message, ok := d["message"].(string); ok {
+			var body struct {
+				Error *azure.ServiceError `json:"error"`
+			}
+			if json.Unmarshal([]byte(message), &body) == nil {
+				nested = body.Error
+			}
+		}
+
+		if nested == nil {
+			b, err := json.Marshal(d)
+			if err != nil || json.Unmarshal(b, &nested) != nil {
+				continue
+			}
+		}
+
+		if policyID, ok := IsRequestDisallowedByPolicyError(nested); ok {
+			return policyID, true
+		}
+	}
+
+	return "", false
+}
+
+func serviceError(err error) *azure.ServiceError {
+	switch err := err.(type) {
+	case *azure.ServiceError:
+		return err
+	case *azure.RequestError:
+		return err.ServiceError
+	case azure.RequestError:
+		return err.ServiceError
+	case autorest.DetailedError:
+		return serviceError(err.Original)
+	}
+
+	return nil
+}
+
+func policyID(additionalInfo []map[string]interface{}) string {
+	for _, ai := range additionalInfo {
+		if typ, ok := ai["type"].(string); !ok || typ != "PolicyViolation" {
+			continue
+		}
+
+		info, ok := ai["info"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, key := range []string{"policyAssignmentId", "policyDefinitionId"} {
+			if id, ok := info[key].(string); ok && id != "" {
+				return id
+			}
+		}
+	}
+
+	return ""
+}
@@ -100,3 +100,112 @@ func TestIsDeploymentActiveError(t *testing.T) {
 		})
 	}
 }
+
+func TestIsRequestDisallowedByPolicyError(t *testing.T) {
+	for _, tt := range []struct {
+		name         string
+		err          error
+		wantPolicyID string
+		want         bool
+	}{
+		{
+			name: "Another error",
+			err:  errors.New("something happened"),
+		},
+		{
+			name: "Resource group disallowed by policy",
+			err: &azure.RequestError{
+				ServiceError: &azure.ServiceError{
+					Code:    "RequestDisallowedByPolicy",
+					Message: "Resource 'aro-test' was disallowed by policy. Policy identifiers: '[{\"policyAssignment\":{\"name\":\"Allowed locations\",\"id\":\"/subscriptions/225e02bc-43d0-43d1-a01a-17e584a4ef69/providers/Microsoft.Authorization/policyAssignments/e56962a6-4747-49cd-b67b-bf8b01975c4c\"},\"policyDefinition\":{\"name\":\"Allowed locations\",\"id\":\"/providers/Microsoft.Authorization/policyDefinitions/e56962a6-4747-49cd-b67b-bf8b01975c4c\"}}]'.",
+					AdditionalInfo: []map[string]interface{}{
+						{
+							"type": "PolicyViolation",
+							"info": map[string]interface{}{
+								"policyDefinitionDisplayName": "Allowed locations",
+								"policyDefinitionId":          "/providers/Microsoft.Authorization/policyDefinitions/e56962a6-4747-49cd-b67b-bf8b01975c4c",
+								"policyDefinitionName":        "e56962a6-4747-49cd-b67b-bf8b01975c4c",
+								"policyDefinitionEffect":      "deny",
+								"policyAssignmentId":          "/subscriptions/225e02bc-43d0-43d1-a01a-17e584a4ef69/providers/Microsoft.Authorization/policyAssignments/e56962a6-4747-49cd-b67b-bf8b01975c4c",
+								"policyAssignmentName":        "e56962a6-4747-49cd-b67b-bf8b01975c4c",
+								"policyAssignmentScope":       "/subscriptions/225e02bc-43d0-43d1-a01a-17e584a4ef69",
+							},
+						},
+					},
+				},
+			},
+			wantPolicyID: "/subscriptions/225e02bc-43d0-43d1-a01a-17e584a4ef69/providers/Microsoft.Authorization/policyAssignments/e56962a6-4747-49cd-b67b-bf8b01975c4c",
+			want:         true,
+		},
+		{
+			name: "Template validation failed due to policy violation",
+			err: autorest.DetailedError{
+				Original: &azure.ServiceError{
+					Code:    "InvalidTemplateDeployment",
+					Message: "The template deployment failed because of policy violation. Please see details for more information.",
+					Details: []map[string]interface{}{
+						{
+							"code":    "RequestDisallowedByPolicy",
+							"target":  "aro-test-master-0",
+							"message": "Resource 'aro-test-master-0' was disallowed by policy.",
+							"additionalInfo": []interface{}{
+								map[string]interface{}{
+									"type": "PolicyViolation",
+									"info": map[string]interface{}{
+										"policyDefinitionDisplayName": "Allowed virtual machine size SKUs",
+										"policyDefinitionId":          "/providers/Microsoft.Authorization/policyDefinitions/cccc23c7-8427-4f53-ad12-b6a63eb452b3",
+										"policyDefinitionEffect":      "deny",
+									},
+								},
+							},
+						},
+					},
+				},
+				PackageType: "features.DeploymentsClient",
+				Method:      "CreateOrUpdate",
+				StatusCode:  http.StatusBadRequest,
+				Message:     "Failure sending request",
+			},
+			wantPolicyID: "/providers/Microsoft.Authorization/policyDefinitions/cccc23c7-8427-4f53-ad12-b6a63eb452b3",
+			want:         true,
+		},
+		{
+			name: "Nested deployment operation disallowed by policy",
+			err: &azure.ServiceError{
+				Code:    "DeploymentFailed",
+				Message: "At least one resource deployment operation failed. Please list deployment operations for details. Please see https://aka.ms/DeployOperations for usage details.",
+				Details: []map[string]interface{}{
+					{
+						"code":    "Forbidden",
+						"message": "{\r\n  \"error\": {\r\n    \"code\": \"RequestDisallowedByPolicy\",\r\n    \"target\": \"clusterabcde\",\r\n    \"message\": \"Resource 'clusterabcde' was disallowed by policy.\",\r\n    \"additionalInfo\": [\r\n      {\r\n        \"type\": \"PolicyViolation\",\r\n        \"info\": {\r\n          \"policyDefinitionId\": \"/providers/Microsoft.Authorization/policyDefinitions/871b6d14-10aa-478d-b590-94f262ecfa99\",\r\n          \"policyAssignmentId\": \"/subscriptions/225e02bc-43d0-43d1-a01a-17e584a4ef69/providers/Microsoft.Authorization/policyAssignments/require-tags\"\r\n        }\r\n      }\r\n    ]\r\n  }\r\n}",
+					},
+				},
+			},
+			wantPolicyID: "/subscriptions/225e02bc-43d0-43d1-a01a-17e584a4ef69/providers/Microsoft.Authorization/policyAssignments/require-tags",
+			want:         true,
+		},
+		{
+			name: "Nested authorization failed",
+			err: &azure.ServiceError{
+				Code:    "DeploymentFailed",
+				Message: "At least one resource deployment operation failed. Please list deployment operations for details. Please see https://aka.ms/DeployOperations for usage details.",
+				Details: []map[string]interface{}{
+					{
+						"code":    "Forbidden",
+						"message": "{\r\n  \"error\": {\r\n    \"code\": \"AuthorizationFailed\",\r\n    \"message\": \"The client 'a0f3c32d-647d-416c-8997-fb2463b1dcd5' with object id 'a0f3c32d-647d-416c-8997-fb2463b1dcd5' does not have authorization to perform action 'Microsoft.Storage/storageAccounts/write' over scope '/subscriptions/225e02bc-43d0-43d1-a01a-17e584a4ef69/resourceGroups/test' or the scope is invalid. If access was recently granted, please refresh your credentials.\"\r\n  }\r\n}",
+					},
+				},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			policyID, got := IsRequestDisallowedByPolicyError(tt.err)
+			if got != tt.want {
+				t.Error(got)
+			}
+			if policyID != tt.wantPolicyID {
+				t.Error(policyID)
+			}
+		})
+	}
+}
@@ -162,7 +162,7 @@ func isSubscriptionRegisteredForE2E(sub *api.SubscriptionProperties) bool {
 // storage account. This is used later on by the billing e2e
 func (m *manager) createOrUpdateE2EBlob(ctx context.Context, doc *api.BillingDocument) error {
 	//skip updating the storage account if this is a dev scenario
-	if m.env.DeploymentMode() == deployment.Development {
+	if m.env.IsLocalDevelopment() {
 		return nil
 	}
 
@@ -203,6 +203,7 @@ func TestDelete(t *testing.T) {
 
 			_env := mock_env.NewMockInterface(controller)
 			_env.EXPECT().DeploymentMode().AnyTimes().Return(deployment.Production)
+			_env.EXPECT().IsLocalDevelopment().AnyTimes().Return(false)
 
 			log := logrus.NewEntry(logrus.StandardLogger())
 			openShiftClusterDatabase, _ := testdatabase.NewFakeOpenShiftClusters()
@@ -394,6 +395,7 @@ func TestEnsure(t *testing.T) {
 
 			_env := mock_env.NewMockInterface(controller)
 			_env.EXPECT().DeploymentMode().AnyTimes().Return(deployment.Production)
+			_env.EXPECT().IsLocalDevelopment().AnyTimes().Return(false)
 
 			log := logrus.NewEntry(logrus.StandardLogger())
 			openShiftClusterDatabase, _ := testdatabase.NewFakeOpenShiftClusters()
@@ -0,0 +1,74 @@
+package bucket
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+// Balance shares out bucketCount buckets over a slice of registered workers.
+// buckets holds the current owner of each bucket (it may be shorter than
+// bucketCount on the very first run); the returned slice holds the new owner
+// of each bucket.  Buckets stay with their current owner where possible, so
+// that ownership only moves when workers join or leave.
+func Balance(workers []string, buckets []string, bucketCount int) []string {
+	// ensure len(buckets) == bucketCount: this should only do anything on the
+	// very first run
+	buckets = append([]string{}, buckets...)
+	if len(buckets) < bucketCount {
+		buckets = append(buckets, make([]string, bucketCount-len(buckets))...)
+	}
+	if len(buckets) > bucketCount { // should never happen
+		buckets = buckets[:bucketCount]
+	}
+
+	var unallocated []int
+	m := make(map[string][]int, len(workers)) // map of worker to list of buckets it owns
+	for _, worker := range workers {
+		m[worker] = nil
+	}
+
+	var target int // target number of buckets per worker
+	if len(workers) > 0 {
+		target = bucketCount / len(workers)
+		if bucketCount%len(workers) != 0 {
+			target++
+		}
+	}
+
+	// load the current bucket allocations into the map
+	for i, worker := range buckets {
+		if owned, found := m[worker]; found && len(owned) < target {
+			// if the current bucket is allocated to a known worker and doesn't
+			// take its number of buckets above the target, keep it there...
+			m[worker] = append(m[worker], i)
+		} else {
+			// ...otherwise we'll reallocate it below
+			unallocated = append(unallocated, i)
+		}
+	}
+
+	// reallocate all unallocated buckets, appending to the least loaded worker
+	if len(workers) > 0 {
+		for _, i := range unallocated {
+			var leastWorker string
+			for worker := range m {
+				if leastWorker == "" ||
+					len(m[worker]) < len(m[leastWorker]) {
+					leastWorker = worker
+				}
+			}
+
+			m[leastWorker] = append(m[leastWorker], i)
+		}
+	}
+
+	// write the updated bucket allocations back
+	for _, i := range unallocated {
+		buckets[i] = "" // should only happen if there are no known workers
+	}
+	for worker, owned := range m {
+		for _, i := range owned {
+			buckets[i] = worker
+		}
+	}
+
+	return buckets
+}
@@ -0,0 +1,54 @@
+package bucket
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBalance(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		workers []string
+		buckets []string
+		want    []string
+	}{
+		{
+			name:    "first run",
+			workers: []string{"one"},
+			want:    []string{"one", "one", "one", "one"},
+		},
+		{
+			name:    "worker leaves",
+			workers: []string{"one", "two"},
+			buckets: []string{"one", "two", "three", "three"},
+			want:    []string{"one", "two", "one", "two"},
+		},
+		{
+			name:    "worker joins",
+			workers: []string{"one", "two"},
+			buckets: []string{"one", "one", "one", "one"},
+			want:    []string{"one", "one", "two", "two"},
+		},
+		{
+			name:    "no workers",
+			buckets: []string{"one", "two", "one", "two"},
+			want:    []string{"", "", "", ""},
+		},
+		{
+			name:    "too many buckets",
+			workers: []string{"one"},
+			buckets: []string{"one", "one", "one", "one", "one"},
+			want:    []string{"one", "one", "one", "one"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			buckets := Balance(tt.workers, tt.buckets, 4)
+			if !reflect.DeepEqual(buckets, tt.want) {
+				t.Error(buckets)
+			}
+		})
+	}
+}
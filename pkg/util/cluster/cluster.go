@@ -41,6 +41,7 @@ import (
 type Cluster struct {
 	log            *logrus.Entry
 	deploymentMode deployment.Mode
+	capabilities   deployment.Capabilities
 	instancemetadata.InstanceMetadata
 	ci bool
 
@@ -74,7 +75,12 @@ func (errs errors) Error() string {
 }
 
 func New(log *logrus.Entry, deploymentMode deployment.Mode, instancemetadata instancemetadata.InstanceMetadata, ci bool) (*Cluster, error) {
-	if deploymentMode == deployment.Development {
+	capabilities, err := deployment.NewCapabilities(deploymentMode)
+	if err != nil {
+		return nil, err
+	}
+
+	if capabilities.FakeFirstParty() {
 		for _, key := range []string{
 			"AZURE_FP_CLIENT_ID",
 		} {
@@ -97,12 +103,13 @@ func New(log *logrus.Entry, deploymentMode deployment.Mode, instancemetadata ins
 	return &Cluster{
 		log:              log,
 		deploymentMode:   deploymentMode,
+		capabilities:     capabilities,
 		InstanceMetadata: instancemetadata,
 		ci:               ci,
 
 		deployments:       features.NewDeploymentsClient(instancemetadata.SubscriptionID(), authorizer),
 		groups:            features.NewResourceGroupsClient(instancemetadata.SubscriptionID(), authorizer),
-		openshiftclusters: redhatopenshift.NewOpenShiftClustersClient(instancemetadata.SubscriptionID(), authorizer),
+		openshiftclusters: redhatopenshift.NewOpenShiftClustersClient(capabilities, instancemetadata.SubscriptionID(), authorizer),
 		applications:      graphrbac.NewApplicationsClient(instancemetadata.TenantID(), graphAuthorizer),
 		serviceprincipals: graphrbac.NewServicePrincipalClient(instancemetadata.TenantID(), graphAuthorizer),
 		securitygroups:    network.NewSecurityGroupsClient(instancemetadata.SubscriptionID(), authorizer),
@@ -120,13 +127,13 @@ func (c *Cluster) Create(ctx context.Context, clusterName string) error {
 	}
 
 	var fpClientID string
-	switch c.deploymentMode {
-	case deployment.Integration:
+	switch {
+	case c.capabilities.FakeFirstParty():
+		fpClientID = os.Getenv("AZURE_FP_CLIENT_ID")
+	case c.deploymentMode == deployment.Integration:
 		fpClientID = firstPartyClientIDIntegration
-	case deployment.Production:
-		fpClientID = firstPartyClientIDProduction
 	default:
-		fpClientID = os.Getenv("AZURE_FP_CLIENT_ID")
+		fpClientID = firstPartyClientIDProduction
 	}
 
 	fpSPID, err := c.getServicePrincipal(ctx, fpClientID)
@@ -361,7 +368,7 @@ func (c *Cluster) createCluster(ctx context.Context, clusterName, clientID, clie
 		Location: to.StringPtr(c.Location()),
 	}
 
-	if c.deploymentMode == deployment.Development {
+	if c.capabilities.RelaxedVMSizeValidation() {
 		(*oc.WorkerProfiles)[0].VMSize = mgmtredhatopenshift.VMSize1StandardD2sV3
 	}
 
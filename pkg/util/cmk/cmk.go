@@ -0,0 +1,329 @@
+package cmk
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	azkeyvault "github.com/Azure/azure-sdk-for-go/services/keyvault/v7.0/keyvault"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/keyvault"
+	"github.com/Azure/ARO-RP/pkg/util/encryption"
+	utilkeyvault "github.com/Azure/ARO-RP/pkg/util/keyvault"
+)
+
+const (
+	// keyCacheTTL is how long an unwrapped data key is used before it is
+	// unwrapped again.  It bounds how long the RP keeps using the secrets of
+	// a cluster after the customer revokes its key.
+	keyCacheTTL = time.Hour
+
+	// inaccessibleCacheTTL is how long a key which could not be unwrapped is
+	// reported inaccessible before unwrapping it is tried again
+	inaccessibleCacheTTL = time.Minute
+)
+
+// Manager seals and unseals the secrets of clusters which are encrypted at
+// rest with a customer-managed key.
+//
+// The sealed secrets are the admin kubeconfig, the kubeadmin password and the
+// service principal secret.  The ARO service kubeconfig is not sealed: the
+// monitor, which has no first party identity, needs it for every cluster.
+type Manager interface {
+	// Seal returns a copy of doc whose secrets are sealed.  The first seal
+	// of a cluster generates its data key.
+	Seal(context.Context, *api.OpenShiftClusterDocument) (*api.OpenShiftClusterDocument, error)
+
+	// Unseal restores the sealed secrets of doc in place.  If the customer's
+	// key cannot be used, the secrets are left sealed and the key state of
+	// doc is set to Inaccessible.
+	Unseal(context.Context, *api.OpenShiftClusterDocument) error
+}
+
+type sealedSecrets struct {
+	AdminKubeconfig   api.SecureBytes  `json:"adminKubeconfig,omitempty"`
+	KubeadminPassword api.SecureString `json:"kubeadminPassword,omitempty"`
+	ClientSecret      api.SecureString `json:"clientSecret,omitempty"`
+}
+
+type cachedKey struct {
+	cipher  encryption.Cipher
+	err     error
+	expires time.Time
+}
+
+type manager struct {
+	log *logrus.Entry
+
+	newKeyVaultClient func(tenantID string) (keyvault.BaseClient, error)
+	now               func() time.Time
+
+	mu   sync.Mutex
+	keys map[string]*cachedKey
+}
+
+// NewManager returns a new Manager.  The RP's first party identity wraps and
+// unwraps the data keys in the customer's tenant.
+func NewManager(log *logrus.Entry, _env env.Interface) Manager {
+	return &manager{
+		log: log,
+
+		newKeyVaultClient: func(tenantID string) (keyvault.BaseClient, error) {
+			fpKVAuthorizer, err := _env.FPAuthorizer(tenantID, _env.Environment().ResourceIdentifiers.KeyVault)
+			if err != nil {
+				return nil, err
+			}
+
+			return keyvault.New(fpKVAuthorizer), nil
+		},
+		now: time.Now,
+
+		keys: map[string]*cachedKey{},
+	}
+}
+
+func (m *manager) Seal(ctx context.Context, doc *api.OpenShiftClusterDocument) (*api.OpenShiftClusterDocument, error) {
+	if doc.OpenShiftCluster == nil || doc.OpenShiftCluster.Properties.EncryptionAtRestProfile == nil {
+		return doc, nil
+	}
+
+	// the fields which are changed below are not shared with doc
+	docCopy := *doc
+	oc := *doc.OpenShiftCluster
+	p := *oc.Properties.EncryptionAtRestProfile
+	docCopy.OpenShiftCluster = &oc
+	oc.Properties.EncryptionAtRestProfile = &p
+
+	secrets := &sealedSecrets{
+		AdminKubeconfig:   oc.Properties.AdminKubeconfig,
+		KubeadminPassword: oc.Properties.KubeadminPassword,
+		ClientSecret:      oc.Properties.ServicePrincipalProfile.ClientSecret,
+	}
+
+	oc.Properties.AdminKubeconfig = nil
+	oc.Properties.KubeadminPassword = ""
+	oc.Properties.ServicePrincipalProfile.ClientSecret = ""
+	p.Unsealed = false
+
+	// a document which was read while the key was inaccessible keeps the
+	// secrets which were sealed before.  It must not bring any new ones.
+	if p.SealedSecrets != nil && !doc.OpenShiftCluster.Properties.EncryptionAtRestProfile.Unsealed {
+		if secrets.AdminKubeconfig != nil || secrets.KubeadminPassword != "" || secrets.ClientSecret != "" {
+			return nil, fmt.Errorf("cannot seal the secrets of %s: they were not unsealed", doc.Key)
+		}
+		return &docCopy, nil
+	}
+
+	var cipher encryption.Cipher
+	var err error
+	if p.WrappedKey == nil {
+		cipher, p.WrappedKey, err = m.newKey(ctx, &oc)
+	} else {
+		cipher, err = m.cipher(ctx, &oc)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	p.SealedSecrets, err = cipher.Encrypt(b)
+	if err != nil {
+		return nil, err
+	}
+
+	p.KeyState = api.EncryptionKeyStateAccessible
+	p.KeyStateMessage = ""
+
+	return &docCopy, nil
+}
+
+func (m *manager) Unseal(ctx context.Context, doc *api.OpenShiftClusterDocument) error {
+	if doc.OpenShiftCluster == nil || doc.OpenShiftCluster.Properties.EncryptionAtRestProfile == nil {
+		return nil
+	}
+
+	oc := doc.OpenShiftCluster
+	p := oc.Properties.EncryptionAtRestProfile
+
+	if p.SealedSecrets == nil {
+		return nil
+	}
+
+	cipher, err := m.cipher(ctx, oc)
+	if isInaccessible(err) {
+		p.KeyState = api.EncryptionKeyStateInaccessible
+		p.KeyStateMessage = err.Error()
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	b, err := cipher.Decrypt(p.SealedSecrets)
+	if err != nil {
+		return err
+	}
+
+	var secrets *sealedSecrets
+	err = json.Unmarshal(b, &secrets)
+	if err != nil {
+		return err
+	}
+
+	oc.Properties.AdminKubeconfig = secrets.AdminKubeconfig
+	oc.Properties.KubeadminPassword = secrets.KubeadminPassword
+	oc.Properties.ServicePrincipalProfile.ClientSecret = secrets.ClientSecret
+
+	p.Unsealed = true
+	p.KeyState = api.EncryptionKeyStateAccessible
+	p.KeyStateMessage = ""
+
+	return nil
+}
+
+// newKey generates a new data key for oc and wraps it with the customer's
+// key.  A customer key which cannot be used is the customer's error.
+func (m *manager) newKey(ctx context.Context, oc *api.OpenShiftCluster) (encryption.Cipher, []byte, error) {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cipher, err := encryption.NewXChaCha20Poly1305(ctx, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wrappedKey, err := m.wrapKey(ctx, oc, key)
+	if isInaccessible(err) {
+		return nil, nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "properties.encryptionAtRestProfile.keyVaultKeyId", "The resource provider could not use the provided key vault key '%s': %s. Ensure that the key is enabled and that the resource provider has the wrapKey and unwrapKey permissions on it.", oc.Properties.EncryptionAtRestProfile.KeyVaultKeyID, err)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.keys[cacheKey(oc.Properties.EncryptionAtRestProfile.KeyVaultKeyID, wrappedKey)] = &cachedKey{
+		cipher:  cipher,
+		expires: m.now().Add(keyCacheTTL),
+	}
+
+	return cipher, wrappedKey, nil
+}
+
+// cipher returns the cipher of the data key of oc, unwrapping the data key if
+// it is not cached.  Transient failures are not cached.
+func (m *manager) cipher(ctx context.Context, oc *api.OpenShiftCluster) (encryption.Cipher, error) {
+	p := oc.Properties.EncryptionAtRestProfile
+	k := cacheKey(p.KeyVaultKeyID, p.WrappedKey)
+
+	m.mu.Lock()
+	cached := m.keys[k]
+	m.mu.Unlock()
+
+	if cached != nil && m.now().Before(cached.expires) {
+		return cached.cipher, cached.err
+	}
+
+	key, err := m.unwrapKey(ctx, oc)
+	if err != nil && !isInaccessible(err) {
+		return nil, err
+	}
+
+	cached = &cachedKey{
+		err:     err,
+		expires: m.now().Add(inaccessibleCacheTTL),
+	}
+	if err == nil {
+		cached.cipher, cached.err = encryption.NewXChaCha20Poly1305(ctx, key)
+		cached.expires = m.now().Add(keyCacheTTL)
+	}
+	if cached.err != nil {
+		m.log.Warnf("key %s is inaccessible: %s", p.KeyVaultKeyID, cached.err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// drop the expired keys of other clusters while the lock is held
+	for k, c := range m.keys {
+		if !m.now().Before(c.expires) {
+			delete(m.keys, k)
+		}
+	}
+	m.keys[k] = cached
+
+	return cached.cipher, cached.err
+}
+
+func (m *manager) wrapKey(ctx context.Context, oc *api.OpenShiftCluster, key []byte) ([]byte, error) {
+	return m.keyOperation(ctx, oc, key, keyvault.BaseClient.WrapKey)
+}
+
+func (m *manager) unwrapKey(ctx context.Context, oc *api.OpenShiftCluster) ([]byte, error) {
+	return m.keyOperation(ctx, oc, oc.Properties.EncryptionAtRestProfile.WrappedKey, keyvault.BaseClient.UnwrapKey)
+}
+
+func (m *manager) keyOperation(ctx context.Context, oc *api.OpenShiftCluster, value []byte, op func(keyvault.BaseClient, context.Context, string, string, string, azkeyvault.KeyOperationsParameters) (azkeyvault.KeyOperationResult, error)) ([]byte, error) {
+	vaultBaseURL, name, version, err := utilkeyvault.ParseKeyID(oc.Properties.EncryptionAtRestProfile.KeyVaultKeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	kv, err := m.newKeyVaultClient(oc.Properties.ServicePrincipalProfile.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := op(kv, ctx, vaultBaseURL, name, version, azkeyvault.KeyOperationsParameters{
+		Algorithm: azkeyvault.RSAOAEP256,
+		Value:     to.StringPtr(base64.RawURLEncoding.EncodeToString(value)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Result == nil {
+		return nil, fmt.Errorf("empty result from key vault key %s", oc.Properties.EncryptionAtRestProfile.KeyVaultKeyID)
+	}
+
+	return base64.RawURLEncoding.DecodeString(*result.Result)
+}
+
+func cacheKey(keyVaultKeyID string, wrappedKey []byte) string {
+	return keyVaultKeyID + "/" + base64.StdEncoding.EncodeToString(wrappedKey)
+}
+
+// isInaccessible returns true if err is Key Vault refusing to use the key,
+// e.g. because the key is disabled or deleted or the customer has revoked the
+// RP's access to it.  Throttling and server errors are transient.
+func isInaccessible(err error) bool {
+	if detailedErr, ok := err.(autorest.DetailedError); ok {
+		if statusCode, ok := detailedErr.StatusCode.(int); ok {
+			return statusCode >= 400 && statusCode < 500 &&
+				statusCode != http.StatusTooManyRequests
+		}
+	}
+
+	return false
+}
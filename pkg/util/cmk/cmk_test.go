@@ -0,0 +1,333 @@
+package cmk
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	azkeyvault "github.com/Azure/azure-sdk-for-go/services/keyvault/v7.0/keyvault"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/keyvault"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+)
+
+const keyVaultKeyID = "https://vault.vault.azure.net/keys/key/0123456789abcdef0123456789abcdef"
+
+// fakeKeyVault "wraps" a key by reversing it
+type fakeKeyVault struct {
+	keyvault.BaseClient
+
+	err     error
+	unwraps int
+}
+
+func reverse(b []byte) []byte {
+	out := make([]byte, len(b))
+	for i := range b {
+		out[len(b)-1-i] = b[i]
+	}
+	return out
+}
+
+func (kv *fakeKeyVault) operation(vaultBaseURL, keyName, keyVersion string, parameters azkeyvault.KeyOperationsParameters) (azkeyvault.KeyOperationResult, error) {
+	if kv.err != nil {
+		return azkeyvault.KeyOperationResult{}, kv.err
+	}
+
+	if vaultBaseURL != "https://vault.vault.azure.net/" || keyName != "key" || keyVersion != "0123456789abcdef0123456789abcdef" ||
+		parameters.Algorithm != azkeyvault.RSAOAEP256 {
+		return azkeyvault.KeyOperationResult{}, errors.New("bad request")
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(*parameters.Value)
+	if err != nil {
+		return azkeyvault.KeyOperationResult{}, err
+	}
+
+	return azkeyvault.KeyOperationResult{
+		Result: to.StringPtr(base64.RawURLEncoding.EncodeToString(reverse(b))),
+	}, nil
+}
+
+func (kv *fakeKeyVault) WrapKey(ctx context.Context, vaultBaseURL, keyName, keyVersion string, parameters azkeyvault.KeyOperationsParameters) (azkeyvault.KeyOperationResult, error) {
+	return kv.operation(vaultBaseURL, keyName, keyVersion, parameters)
+}
+
+func (kv *fakeKeyVault) UnwrapKey(ctx context.Context, vaultBaseURL, keyName, keyVersion string, parameters azkeyvault.KeyOperationsParameters) (azkeyvault.KeyOperationResult, error) {
+	kv.unwraps++
+	return kv.operation(vaultBaseURL, keyName, keyVersion, parameters)
+}
+
+func newTestManager(kv *fakeKeyVault, now *time.Time) *manager {
+	return &manager{
+		log: utillog.GetLogger(),
+
+		newKeyVaultClient: func(tenantID string) (keyvault.BaseClient, error) {
+			if tenantID != "tenant" {
+				return nil, errors.New("wrong tenant")
+			}
+			return kv, nil
+		},
+		now: func() time.Time { return *now },
+
+		keys: map[string]*cachedKey{},
+	}
+}
+
+func newTestDoc() *api.OpenShiftClusterDocument {
+	return &api.OpenShiftClusterDocument{
+		Key: "key",
+		OpenShiftCluster: &api.OpenShiftCluster{
+			Properties: api.OpenShiftClusterProperties{
+				ServicePrincipalProfile: api.ServicePrincipalProfile{
+					TenantID:     "tenant",
+					ClientSecret: "secret",
+				},
+				AdminKubeconfig:      api.SecureBytes("admin kubeconfig"),
+				AROServiceKubeconfig: api.SecureBytes("aro service kubeconfig"),
+				KubeadminPassword:    "password",
+				EncryptionAtRestProfile: &api.EncryptionAtRestProfile{
+					KeyVaultKeyID: keyVaultKeyID,
+				},
+			},
+		},
+	}
+}
+
+func forbidden() error {
+	return autorest.DetailedError{
+		StatusCode: http.StatusForbidden,
+		Message:    "access denied",
+	}
+}
+
+func TestSealUnseal(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	kv := &fakeKeyVault{}
+	m := newTestManager(kv, &now)
+
+	doc := newTestDoc()
+
+	sealed, err := m.Seal(ctx, doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Seal must not change its argument
+	if string(doc.OpenShiftCluster.Properties.AdminKubeconfig) != "admin kubeconfig" {
+		t.Error(string(doc.OpenShiftCluster.Properties.AdminKubeconfig))
+	}
+
+	p := sealed.OpenShiftCluster.Properties
+	if p.AdminKubeconfig != nil || p.KubeadminPassword != "" || p.ServicePrincipalProfile.ClientSecret != "" {
+		t.Error("secrets were not sealed")
+	}
+	if string(p.AROServiceKubeconfig) != "aro service kubeconfig" {
+		t.Error(string(p.AROServiceKubeconfig))
+	}
+	if len(p.EncryptionAtRestProfile.WrappedKey) != 32 || p.EncryptionAtRestProfile.SealedSecrets == nil {
+		t.Error("key was not wrapped or secrets were not sealed")
+	}
+	if p.EncryptionAtRestProfile.KeyState != api.EncryptionKeyStateAccessible {
+		t.Error(p.EncryptionAtRestProfile.KeyState)
+	}
+
+	// unseal with an empty cache, as another process would
+	m = newTestManager(kv, &now)
+
+	err = m.Unseal(ctx, sealed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p = sealed.OpenShiftCluster.Properties
+	if string(p.AdminKubeconfig) != "admin kubeconfig" || p.KubeadminPassword != "password" || p.ServicePrincipalProfile.ClientSecret != "secret" {
+		t.Error("secrets were not unsealed")
+	}
+	if !p.EncryptionAtRestProfile.Unsealed {
+		t.Error("not marked unsealed")
+	}
+	if kv.unwraps != 1 {
+		t.Error(kv.unwraps)
+	}
+
+	// the data key is kept when the secrets are sealed again
+	wrappedKey := p.EncryptionAtRestProfile.WrappedKey
+	sealed.OpenShiftCluster.Properties.KubeadminPassword = "new password"
+
+	sealed, err = m.Seal(ctx, sealed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(sealed.OpenShiftCluster.Properties.EncryptionAtRestProfile.WrappedKey, wrappedKey) {
+		t.Error("data key changed")
+	}
+
+	err = m.Unseal(ctx, sealed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sealed.OpenShiftCluster.Properties.KubeadminPassword != "new password" {
+		t.Error(sealed.OpenShiftCluster.Properties.KubeadminPassword)
+	}
+	if kv.unwraps != 1 {
+		t.Error(kv.unwraps)
+	}
+}
+
+func TestSealWithoutProfile(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	m := newTestManager(&fakeKeyVault{}, &now)
+
+	doc := newTestDoc()
+	doc.OpenShiftCluster.Properties.EncryptionAtRestProfile = nil
+
+	sealed, err := m.Seal(ctx, doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sealed != doc {
+		t.Error("document was copied")
+	}
+
+	err = m.Unseal(ctx, doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSealInaccessibleNewKey(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	m := newTestManager(&fakeKeyVault{err: forbidden()}, &now)
+
+	_, err := m.Seal(ctx, newTestDoc())
+	if _, ok := err.(*api.CloudError); !ok {
+		t.Fatal(err)
+	}
+	if err.(*api.CloudError).Target != "properties.encryptionAtRestProfile.keyVaultKeyId" {
+		t.Error(err)
+	}
+}
+
+func TestRevocation(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	kv := &fakeKeyVault{}
+	m := newTestManager(kv, &now)
+
+	sealed, err := m.Seal(ctx, newTestDoc())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kv.err = forbidden()
+
+	// the unwrapped key is used until it expires from the cache
+	doc := *sealed
+	oc := *sealed.OpenShiftCluster
+	p := *oc.Properties.EncryptionAtRestProfile
+	oc.Properties.EncryptionAtRestProfile = &p
+	doc.OpenShiftCluster = &oc
+
+	err = m.Unseal(ctx, &doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.Unsealed {
+		t.Error("not unsealed")
+	}
+
+	now = now.Add(keyCacheTTL)
+
+	err = m.Unseal(ctx, sealed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ep := sealed.OpenShiftCluster.Properties.EncryptionAtRestProfile
+	if ep.Unsealed || ep.KeyState != api.EncryptionKeyStateInaccessible || ep.KeyStateMessage == "" {
+		t.Errorf("%#v", ep)
+	}
+	if !ep.IsInaccessible() {
+		t.Error("not inaccessible")
+	}
+
+	// a document which was not unsealed keeps its sealed secrets
+	resealed, err := m.Seal(ctx, sealed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(resealed.OpenShiftCluster.Properties.EncryptionAtRestProfile.SealedSecrets, ep.SealedSecrets) {
+		t.Error("sealed secrets changed")
+	}
+
+	// but it cannot bring new secrets
+	sealed.OpenShiftCluster.Properties.KubeadminPassword = "new password"
+	_, err = m.Seal(ctx, sealed)
+	if err == nil || err.Error() != "cannot seal the secrets of key: they were not unsealed" {
+		t.Error(err)
+	}
+
+	// the key is tried again once the failure expires from the cache, and
+	// access is restored
+	kv.err = nil
+	now = now.Add(inaccessibleCacheTTL)
+
+	sealed.OpenShiftCluster.Properties.KubeadminPassword = ""
+	err = m.Unseal(ctx, sealed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ep.Unsealed || ep.KeyState != api.EncryptionKeyStateAccessible || ep.KeyStateMessage != "" {
+		t.Errorf("%#v", ep)
+	}
+}
+
+func TestUnsealTransientError(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	kv := &fakeKeyVault{}
+	m := newTestManager(kv, &now)
+
+	sealed, err := m.Seal(ctx, newTestDoc())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kv.err = autorest.DetailedError{
+		StatusCode: http.StatusTooManyRequests,
+	}
+	m = newTestManager(kv, &now)
+
+	err = m.Unseal(ctx, sealed)
+	if _, ok := err.(autorest.DetailedError); !ok {
+		t.Error(err)
+	}
+	if sealed.OpenShiftCluster.Properties.EncryptionAtRestProfile.KeyState != api.EncryptionKeyStateAccessible {
+		t.Error(sealed.OpenShiftCluster.Properties.EncryptionAtRestProfile.KeyState)
+	}
+
+	// transient errors are not cached
+	kv.err = nil
+
+	err = m.Unseal(ctx, sealed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kv.unwraps != 2 {
+		t.Error(kv.unwraps)
+	}
+}
@@ -0,0 +1,310 @@
+package deletepreflight
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/features"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/locks"
+	"github.com/Azure/ARO-RP/pkg/util/stringutils"
+	"github.com/Azure/ARO-RP/pkg/util/subnet"
+)
+
+// Interface runs the checks which tell a customer, before they delete a
+// cluster, whether the delete can succeed and what it will destroy
+type Interface interface {
+	Get(ctx context.Context) (*api.DeletePreflight, error)
+}
+
+type deletePreflight struct {
+	log *logrus.Entry
+	oc  *api.OpenShiftCluster
+
+	locks     locks.ManagementLocksClient
+	resources features.ResourcesClient
+}
+
+// New returns a delete preflight Interface
+func New(log *logrus.Entry, _env env.Interface, oc *api.OpenShiftCluster, subscriptionDoc *api.SubscriptionDocument) (Interface, error) {
+	fpAuthorizer, err := _env.FPAuthorizer(subscriptionDoc.Subscription.Properties.TenantID,
+		_env.Environment().ResourceManagerEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deletePreflight{
+		log: log,
+		oc:  oc,
+
+		locks:     locks.NewManagementLocksClient(fpAuthorizer),
+		resources: features.NewResourcesClient(subscriptionDoc.ID, fpAuthorizer),
+	}, nil
+}
+
+// Get returns the conditions which would prevent the cluster being deleted
+// now and the resources which deleting it would destroy or modify.  It makes
+// no changes.
+func (d *deletePreflight) Get(ctx context.Context) (*api.DeletePreflight, error) {
+	dp := &api.DeletePreflight{
+		ID:   d.oc.ID + "/deletePreflight",
+		Name: "deletePreflight",
+		Type: d.oc.Type + "/deletePreflight",
+	}
+
+	if !d.oc.Properties.ProvisioningState.IsTerminal() {
+		dp.Properties.BlockingConditions = append(dp.Properties.BlockingConditions, api.DeletePreflightCondition{
+			Code:    api.DeletePreflightConditionCodeOperationInProgress,
+			Message: fmt.Sprintf("The cluster is in provisioningState '%s'. Wait for the operation to complete before deleting the cluster.", d.oc.Properties.ProvisioningState),
+		})
+	}
+
+	err := d.checkClusterLocks(ctx, &dp.Properties)
+	if err != nil {
+		return nil, err
+	}
+
+	vnetIDs, err := d.vnetIDs()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, vnetID := range vnetIDs {
+		err = d.checkVnetLocks(ctx, &dp.Properties, vnetID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dp.Properties.DeletedResources, err = d.deletedResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dp.Properties.ModifiedResources = d.modifiedResources()
+
+	dp.Properties.CanDelete = len(dp.Properties.BlockingConditions) == 0
+
+	return dp, nil
+}
+
+// checkClusterLocks reports the locks which apply to the cluster resource.
+// ARM enforces these before the delete reaches the RP, so any lock,
+// whatever its level, prevents the delete.
+func (d *deletePreflight) checkClusterLocks(ctx context.Context, p *api.DeletePreflightProperties) error {
+	ls, err := d.listLocks(ctx, p, d.oc.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, l := range ls {
+		code := api.DeletePreflightConditionCodeResourceLocked
+		if l.Properties.Level == locks.CanNotDelete {
+			code = api.DeletePreflightConditionCodeDeleteProtected
+		}
+
+		p.BlockingConditions = append(p.BlockingConditions, api.DeletePreflightCondition{
+			Code:       code,
+			Message:    fmt.Sprintf("The %s lock '%s' applies to the cluster. Remove the lock to delete the cluster.", l.Properties.Level, *l.Name),
+			ResourceID: *l.ID,
+		})
+	}
+
+	return nil
+}
+
+// checkVnetLocks reports the ReadOnly locks which apply to a virtual network
+// of the cluster: the network security group of the cluster is detached from
+// its subnets during the delete, which a ReadOnly lock prevents.
+// CanNotDelete locks do not matter because the virtual network is not
+// deleted.
+func (d *deletePreflight) checkVnetLocks(ctx context.Context, p *api.DeletePreflightProperties, vnetID string) error {
+	ls, err := d.listLocks(ctx, p, vnetID)
+	if err != nil {
+		return err
+	}
+
+	for _, l := range ls {
+		if l.Properties.Level != locks.ReadOnly {
+			continue
+		}
+
+		p.BlockingConditions = append(p.BlockingConditions, api.DeletePreflightCondition{
+			Code:       api.DeletePreflightConditionCodeResourceLocked,
+			Message:    fmt.Sprintf("The ReadOnly lock '%s' applies to the virtual network '%s', whose subnets are updated when the cluster is deleted. Remove the lock to delete the cluster.", *l.Name, vnetID),
+			ResourceID: *l.ID,
+		})
+	}
+
+	return nil
+}
+
+// listLocks returns the locks which apply to the resource with the given ID.
+// If the RP may not read them, a warning is added to p instead: the preflight
+// is still useful without them.
+func (d *deletePreflight) listLocks(ctx context.Context, p *api.DeletePreflightProperties, resourceID string) ([]locks.ManagementLock, error) {
+	ls, err := d.locks.ListAtScope(ctx, resourceID)
+	if detailedErr, ok := err.(autorest.DetailedError); ok &&
+		detailedErr.StatusCode == http.StatusForbidden {
+		d.log.Info(err)
+		p.Warnings = append(p.Warnings, api.DeletePreflightCondition{
+			Code:       api.DeletePreflightConditionCodeLocksNotChecked,
+			Message:    "The locks which apply to the resource could not be read. Check that no lock prevents the resource being deleted or updated.",
+			ResourceID: resourceID,
+		})
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var valid []locks.ManagementLock
+	for _, l := range ls {
+		if l.ID != nil && l.Name != nil && l.Properties != nil {
+			valid = append(valid, l)
+		}
+	}
+
+	return valid, nil
+}
+
+// vnetIDs returns the IDs of the virtual networks of the subnets of the
+// cluster, in order
+func (d *deletePreflight) vnetIDs() ([]string, error) {
+	m := map[string]string{}
+	for _, subnetID := range d.subnetIDs() {
+		vnetID, _, err := subnet.Split(subnetID)
+		if err != nil {
+			return nil, err
+		}
+		m[strings.ToLower(vnetID)] = vnetID
+	}
+
+	vnetIDs := make([]string, 0, len(m))
+	for _, vnetID := range m {
+		vnetIDs = append(vnetIDs, vnetID)
+	}
+	sort.Strings(vnetIDs)
+
+	return vnetIDs, nil
+}
+
+// subnetIDs returns the IDs of the master and worker subnets of the cluster,
+// without duplicates
+func (d *deletePreflight) subnetIDs() []string {
+	var subnetIDs []string
+	seen := map[string]bool{}
+
+	add := func(subnetID string) {
+		if subnetID != "" && !seen[strings.ToLower(subnetID)] {
+			seen[strings.ToLower(subnetID)] = true
+			subnetIDs = append(subnetIDs, subnetID)
+		}
+	}
+
+	add(d.oc.Properties.MasterProfile.SubnetID)
+	for _, wp := range d.oc.Properties.WorkerProfiles {
+		add(wp.SubnetID)
+	}
+	for _, wp := range d.oc.Properties.AdditionalWorkerProfiles {
+		add(wp.SubnetID)
+	}
+
+	return subnetIDs
+}
+
+// deletedResources returns the managed resource group of the cluster and the
+// resources in it, which are all deleted with the cluster.  If the resource
+// group cannot be read, e.g. because a failed install never created it, only
+// the resource group is returned.
+func (d *deletePreflight) deletedResources(ctx context.Context) ([]api.DeletePreflightResource, error) {
+	resourceGroupID := d.oc.Properties.ClusterProfile.ResourceGroupID
+	if resourceGroupID == "" {
+		return nil, nil
+	}
+
+	deleted := []api.DeletePreflightResource{
+		{
+			ID:     resourceGroupID,
+			Type:   "Microsoft.Resources/resourceGroups",
+			Impact: "The managed resource group of the cluster is deleted with everything in it.",
+		},
+	}
+
+	resources, err := d.resources.ListByResourceGroup(ctx, stringutils.LastTokenByte(resourceGroupID, '/'), "", "", nil)
+	if detailedErr, ok := err.(autorest.DetailedError); ok &&
+		(detailedErr.StatusCode == http.StatusNotFound ||
+			detailedErr.StatusCode == http.StatusForbidden) {
+		return deleted, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range resources {
+		if r.ID == nil || r.Type == nil || r.Name == nil {
+			continue
+		}
+
+		deleted = append(deleted, api.DeletePreflightResource{
+			ID:     *r.ID,
+			Type:   *r.Type,
+			Impact: impact(*r.Type, *r.Name),
+		})
+	}
+
+	return deleted, nil
+}
+
+// impact returns what deleting the cluster means for a resource of the
+// managed resource group, emphasising the resources which hold customer data
+// or identities which cannot be recovered
+func impact(resourceType, name string) string {
+	switch strings.ToLower(resourceType) {
+	case "microsoft.compute/disks":
+		if strings.Contains(name, "-dynamic-pvc-") {
+			return "A persistent volume: the data stored on it is lost."
+		}
+		return "The operating system disk of a node."
+	case "microsoft.storage/storageaccounts":
+		if strings.HasPrefix(name, "imageregistry") {
+			return "The storage of the integrated image registry: the images pushed to it are lost."
+		}
+		if strings.HasPrefix(name, "cluster") {
+			return "The storage account of the cluster."
+		}
+		return "A storage account, which may hold the data of persistent volumes: the data stored in it is lost."
+	case "microsoft.network/publicipaddresses":
+		return "A public IP address of the cluster: the address is released and cannot be recovered."
+	case "microsoft.compute/virtualmachines":
+		return "A node of the cluster: the workloads running on it stop."
+	default:
+		return "Deleted with the cluster."
+	}
+}
+
+// modifiedResources returns the customer's subnets of the cluster, from which
+// the network security group of the cluster is detached during the delete
+func (d *deletePreflight) modifiedResources() []api.DeletePreflightResource {
+	var modified []api.DeletePreflightResource
+
+	for _, subnetID := range d.subnetIDs() {
+		modified = append(modified, api.DeletePreflightResource{
+			ID:     subnetID,
+			Type:   "Microsoft.Network/virtualNetworks/subnets",
+			Impact: "The network security group of the cluster is detached from the subnet. The subnet itself is not deleted.",
+		})
+	}
+
+	return modified
+}
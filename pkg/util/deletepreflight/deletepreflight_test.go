@@ -0,0 +1,229 @@
+package deletepreflight
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	mgmtfeatures "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-07-01/features"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/locks"
+	mock_features "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/mgmt/features"
+	mock_locks "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/mgmt/locks"
+)
+
+func TestGet(t *testing.T) {
+	ctx := context.Background()
+
+	clusterID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName"
+	vnetID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/vnet/providers/Microsoft.Network/virtualNetworks/vnet"
+	masterSubnetID := vnetID + "/subnets/master"
+	workerSubnetID := vnetID + "/subnets/worker"
+	resourceGroupID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/cluster"
+	pvcDiskID := resourceGroupID + "/providers/Microsoft.Compute/disks/cluster-dynamic-pvc-1"
+
+	wantModified := []api.DeletePreflightResource{
+		{
+			ID:     masterSubnetID,
+			Type:   "Microsoft.Network/virtualNetworks/subnets",
+			Impact: "The network security group of the cluster is detached from the subnet. The subnet itself is not deleted.",
+		},
+		{
+			ID:     workerSubnetID,
+			Type:   "Microsoft.Network/virtualNetworks/subnets",
+			Impact: "The network security group of the cluster is detached from the subnet. The subnet itself is not deleted.",
+		},
+	}
+
+	wantDeleted := []api.DeletePreflightResource{
+		{
+			ID:     resourceGroupID,
+			Type:   "Microsoft.Resources/resourceGroups",
+			Impact: "The managed resource group of the cluster is deleted with everything in it.",
+		},
+		{
+			ID:     pvcDiskID,
+			Type:   "Microsoft.Compute/disks",
+			Impact: "A persistent volume: the data stored on it is lost.",
+		},
+	}
+
+	lock := func(name string, level locks.LockLevel) locks.ManagementLock {
+		return locks.ManagementLock{
+			ID:   to.StringPtr("/lock/" + name),
+			Name: to.StringPtr(name),
+			Properties: &locks.ManagementLockProperties{
+				Level: level,
+			},
+		}
+	}
+
+	forbidden := autorest.DetailedError{StatusCode: http.StatusForbidden}
+
+	for _, tt := range []struct {
+		name              string
+		provisioningState api.ProvisioningState
+		mocks             func(*mock_locks.MockManagementLocksClient, *mock_features.MockResourcesClient)
+		want              api.DeletePreflightProperties
+	}{
+		{
+			name:              "cluster can be deleted",
+			provisioningState: api.ProvisioningStateSucceeded,
+			mocks: func(l *mock_locks.MockManagementLocksClient, r *mock_features.MockResourcesClient) {
+				l.EXPECT().ListAtScope(gomock.Any(), clusterID).Return(nil, nil)
+				l.EXPECT().ListAtScope(gomock.Any(), vnetID).Return([]locks.ManagementLock{lock("vnetlock", locks.CanNotDelete)}, nil)
+			},
+			want: api.DeletePreflightProperties{
+				CanDelete:         true,
+				DeletedResources:  wantDeleted,
+				ModifiedResources: wantModified,
+			},
+		},
+		{
+			name:              "locks and in progress operation block the delete",
+			provisioningState: api.ProvisioningStateUpdating,
+			mocks: func(l *mock_locks.MockManagementLocksClient, r *mock_features.MockResourcesClient) {
+				l.EXPECT().ListAtScope(gomock.Any(), clusterID).Return([]locks.ManagementLock{lock("clusterlock", locks.CanNotDelete)}, nil)
+				l.EXPECT().ListAtScope(gomock.Any(), vnetID).Return([]locks.ManagementLock{lock("vnetlock", locks.ReadOnly)}, nil)
+			},
+			want: api.DeletePreflightProperties{
+				BlockingConditions: []api.DeletePreflightCondition{
+					{
+						Code:    api.DeletePreflightConditionCodeOperationInProgress,
+						Message: "The cluster is in provisioningState 'Updating'. Wait for the operation to complete before deleting the cluster.",
+					},
+					{
+						Code:       api.DeletePreflightConditionCodeDeleteProtected,
+						Message:    "The CanNotDelete lock 'clusterlock' applies to the cluster. Remove the lock to delete the cluster.",
+						ResourceID: "/lock/clusterlock",
+					},
+					{
+						Code:       api.DeletePreflightConditionCodeResourceLocked,
+						Message:    "The ReadOnly lock 'vnetlock' applies to the virtual network '" + vnetID + "', whose subnets are updated when the cluster is deleted. Remove the lock to delete the cluster.",
+						ResourceID: "/lock/vnetlock",
+					},
+				},
+				DeletedResources:  wantDeleted,
+				ModifiedResources: wantModified,
+			},
+		},
+		{
+			name:              "unreadable locks are a warning",
+			provisioningState: api.ProvisioningStateFailed,
+			mocks: func(l *mock_locks.MockManagementLocksClient, r *mock_features.MockResourcesClient) {
+				l.EXPECT().ListAtScope(gomock.Any(), clusterID).Return(nil, nil)
+				l.EXPECT().ListAtScope(gomock.Any(), vnetID).Return(nil, forbidden)
+			},
+			want: api.DeletePreflightProperties{
+				CanDelete: true,
+				Warnings: []api.DeletePreflightCondition{
+					{
+						Code:       api.DeletePreflightConditionCodeLocksNotChecked,
+						Message:    "The locks which apply to the resource could not be read. Check that no lock prevents the resource being deleted or updated.",
+						ResourceID: vnetID,
+					},
+				},
+				DeletedResources:  wantDeleted,
+				ModifiedResources: wantModified,
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			l := mock_locks.NewMockManagementLocksClient(controller)
+			r := mock_features.NewMockResourcesClient(controller)
+			tt.mocks(l, r)
+			r.EXPECT().ListByResourceGroup(gomock.Any(), "cluster", "", "", nil).Return([]mgmtfeatures.GenericResourceExpanded{
+				{
+					ID:   to.StringPtr(pvcDiskID),
+					Name: to.StringPtr("cluster-dynamic-pvc-1"),
+					Type: to.StringPtr("Microsoft.Compute/disks"),
+				},
+			}, nil)
+
+			d := &deletePreflight{
+				log: logrus.NewEntry(logrus.StandardLogger()),
+				oc: &api.OpenShiftCluster{
+					ID:   clusterID,
+					Type: "Microsoft.RedHatOpenShift/openShiftClusters",
+					Properties: api.OpenShiftClusterProperties{
+						ProvisioningState: tt.provisioningState,
+						ClusterProfile: api.ClusterProfile{
+							ResourceGroupID: resourceGroupID,
+						},
+						MasterProfile: api.MasterProfile{
+							SubnetID: masterSubnetID,
+						},
+						WorkerProfiles: []api.WorkerProfile{
+							{
+								SubnetID: workerSubnetID,
+							},
+						},
+					},
+				},
+				locks:     l,
+				resources: r,
+			}
+
+			dp, err := d.Get(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if dp.ID != clusterID+"/deletePreflight" {
+				t.Error(dp.ID)
+			}
+
+			if !reflect.DeepEqual(dp.Properties, tt.want) {
+				t.Errorf("%#v", dp.Properties)
+			}
+		})
+	}
+}
+
+func TestImpact(t *testing.T) {
+	for _, tt := range []struct {
+		resourceType string
+		name         string
+		want         string
+	}{
+		{
+			resourceType: "Microsoft.Compute/disks",
+			name:         "cluster-abcde-master-0_OSDisk",
+			want:         "The operating system disk of a node.",
+		},
+		{
+			resourceType: "Microsoft.Storage/storageAccounts",
+			name:         "imageregistryabcde",
+			want:         "The storage of the integrated image registry: the images pushed to it are lost.",
+		},
+		{
+			resourceType: "Microsoft.Storage/storageAccounts",
+			name:         "clusterabcde",
+			want:         "The storage account of the cluster.",
+		},
+		{
+			resourceType: "Microsoft.Network/loadBalancers",
+			name:         "cluster-abcde",
+			want:         "Deleted with the cluster.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := impact(tt.resourceType, tt.name)
+			if got != tt.want {
+				t.Error(got)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package deployment
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Capabilities are the behaviours which differ between an RP running in Azure
+// and one running on a developer's machine.  Code which must behave
+// differently in development asks for the specific capability rather than
+// comparing the deployment mode, so that each behaviour is explicit and can
+// be switched off on its own.
+type Capabilities interface {
+	// IsLocalDevelopment is true when the RP (or a tool) runs outside Azure:
+	// its instance metadata, credentials, database name and metrics socket
+	// come from the environment, it reaches clusters via the development
+	// proxy and it serves the RP API on localhost.
+	IsLocalDevelopment() bool
+
+	// RelaxedVMSizeValidation is true when clusters are validated against
+	// the development install profile, which admits VM sizes too small to
+	// be supported.
+	RelaxedVMSizeValidation() bool
+
+	// FakeFirstParty is true when the first party service principal is a
+	// regular service principal: there is no ACR token management, no
+	// managed domain and resource groups can't be marked as managed by the
+	// cluster.
+	FakeFirstParty() bool
+
+	// DisableSignedCertificates is true when clusters keep the self-signed
+	// certificates which the installer generates.
+	DisableSignedCertificates() bool
+}
+
+const (
+	capabilityIsLocalDevelopment        = "IsLocalDevelopment"
+	capabilityRelaxedVMSizeValidation   = "RelaxedVMSizeValidation"
+	capabilityFakeFirstParty            = "FakeFirstParty"
+	capabilityDisableSignedCertificates = "DisableSignedCertificates"
+
+	// CapabilitiesDisabledEnvVar lists the capabilities which are switched
+	// off in development mode
+	CapabilitiesDisabledEnvVar = "RP_DEV_CAPABILITIES_DISABLED"
+)
+
+type production struct{}
+
+func (production) IsLocalDevelopment() bool {
+	return false
+}
+
+func (production) RelaxedVMSizeValidation() bool {
+	return false
+}
+
+func (production) FakeFirstParty() bool {
+	return false
+}
+
+func (production) DisableSignedCertificates() bool {
+	return false
+}
+
+// development holds whether each capability is enabled
+type development map[string]bool
+
+func (d development) IsLocalDevelopment() bool {
+	return d[capabilityIsLocalDevelopment]
+}
+
+func (d development) RelaxedVMSizeValidation() bool {
+	return d[capabilityRelaxedVMSizeValidation]
+}
+
+func (d development) FakeFirstParty() bool {
+	return d[capabilityFakeFirstParty]
+}
+
+func (d development) DisableSignedCertificates() bool {
+	return d[capabilityDisableSignedCertificates]
+}
+
+// NewCapabilities returns the capabilities of mode.  Outside development
+// mode no capability is enabled, whatever the environment says, so that
+// development behaviour can't leak into Azure.  In development mode every
+// capability is enabled except those listed, comma separated, in
+// RP_DEV_CAPABILITIES_DISABLED.
+func NewCapabilities(mode Mode) (Capabilities, error) {
+	if mode != Development {
+		return production{}, nil
+	}
+
+	d := development{
+		capabilityIsLocalDevelopment:        true,
+		capabilityRelaxedVMSizeValidation:   true,
+		capabilityFakeFirstParty:            true,
+		capabilityDisableSignedCertificates: true,
+	}
+
+	for _, name := range strings.Split(os.Getenv(CapabilitiesDisabledEnvVar), ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		if _, found := d[name]; !found {
+			return nil, fmt.Errorf("invalid %s: unknown capability %q", CapabilitiesDisabledEnvVar, name)
+		}
+
+		d[name] = false
+	}
+
+	return d, nil
+}
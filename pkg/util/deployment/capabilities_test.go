@@ -0,0 +1,81 @@
+package deployment
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"os"
+	"testing"
+)
+
+func TestNewCapabilities(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		mode     Mode
+		disabled string
+		want     map[string]bool
+		wantErr  string
+	}{
+		{
+			name: "production",
+			mode: Production,
+			want: map[string]bool{},
+		},
+		{
+			name:     "production ignores the environment",
+			mode:     Integration,
+			disabled: "nonsense",
+			want:     map[string]bool{},
+		},
+		{
+			name: "development",
+			mode: Development,
+			want: map[string]bool{
+				capabilityIsLocalDevelopment:        true,
+				capabilityRelaxedVMSizeValidation:   true,
+				capabilityFakeFirstParty:            true,
+				capabilityDisableSignedCertificates: true,
+			},
+		},
+		{
+			name:     "development with capabilities disabled",
+			mode:     Development,
+			disabled: "FakeFirstParty, DisableSignedCertificates,",
+			want: map[string]bool{
+				capabilityIsLocalDevelopment:      true,
+				capabilityRelaxedVMSizeValidation: true,
+			},
+		},
+		{
+			name:     "development with unknown capability",
+			mode:     Development,
+			disabled: "FakeFirstParty,Nonsense",
+			wantErr:  `invalid RP_DEV_CAPABILITIES_DISABLED: unknown capability "Nonsense"`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			defer os.Unsetenv(CapabilitiesDisabledEnvVar)
+			os.Setenv(CapabilitiesDisabledEnvVar, tt.disabled)
+
+			c, err := NewCapabilities(tt.mode)
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Fatal(err)
+			}
+			if err != nil {
+				return
+			}
+
+			for name, got := range map[string]bool{
+				capabilityIsLocalDevelopment:        c.IsLocalDevelopment(),
+				capabilityRelaxedVMSizeValidation:   c.RelaxedVMSizeValidation(),
+				capabilityFakeFirstParty:            c.FakeFirstParty(),
+				capabilityDisableSignedCertificates: c.DisableSignedCertificates(),
+			} {
+				if got != tt.want[name] {
+					t.Errorf("%s: got %v", name, got)
+				}
+			}
+		})
+	}
+}
@@ -24,6 +24,7 @@ type Manager interface {
 	Create(context.Context, *api.OpenShiftCluster) error
 	Update(context.Context, *api.OpenShiftCluster, string) error
 	CreateOrUpdateRouter(context.Context, *api.OpenShiftCluster, string) error
+	Get(context.Context, *api.OpenShiftCluster) (string, string, error)
 	Delete(context.Context, *api.OpenShiftCluster) error
 }
 
@@ -104,6 +105,45 @@ func (m *manager) CreateOrUpdateRouter(ctx context.Context, oc *api.OpenShiftClu
 	return err
 }
 
+// Get returns the IP addresses currently published in the api and *.apps
+// records of a cluster.  Missing records are returned as the empty string.
+func (m *manager) Get(ctx context.Context, oc *api.OpenShiftCluster) (string, string, error) {
+	prefix, err := m.managedDomainPrefix(oc.Properties.ClusterProfile.Domain)
+	if err != nil || prefix == "" {
+		return "", "", err
+	}
+
+	apiIP, err := m.getIP(ctx, "api."+prefix)
+	if err != nil {
+		return "", "", err
+	}
+
+	routerIP, err := m.getIP(ctx, "*.apps."+prefix)
+	if err != nil {
+		return "", "", err
+	}
+
+	return apiIP, routerIP, nil
+}
+
+func (m *manager) getIP(ctx context.Context, name string) (string, error) {
+	rs, err := m.recordsets.Get(ctx, m.env.ResourceGroup(), m.env.Domain(), name, mgmtdns.A)
+	if detailedErr, ok := err.(autorest.DetailedError); ok &&
+		detailedErr.StatusCode == http.StatusNotFound {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if rs.RecordSetProperties == nil || rs.ARecords == nil || len(*rs.ARecords) == 0 ||
+		(*rs.ARecords)[0].Ipv4Address == nil {
+		return "", nil
+	}
+
+	return *(*rs.ARecords)[0].Ipv4Address, nil
+}
+
 func (m *manager) Delete(ctx context.Context, oc *api.OpenShiftCluster) error {
 	prefix, err := m.managedDomainPrefix(oc.Properties.ClusterProfile.Domain)
 	if err != nil || prefix == "" {
@@ -354,6 +354,140 @@ func TestCreateOrUpdateRouter(t *testing.T) {
 	}
 }
 
+func TestGet(t *testing.T) {
+	ctx := context.Background()
+
+	managedOc := &api.OpenShiftCluster{
+		Properties: api.OpenShiftClusterProperties{
+			ClusterProfile: api.ClusterProfile{
+				Domain: "test.domain",
+			},
+		},
+	}
+
+	unmanagedOc := &api.OpenShiftCluster{
+		Properties: api.OpenShiftClusterProperties{
+			ClusterProfile: api.ClusterProfile{
+				Domain: "domain.notmanaged",
+			},
+		},
+	}
+
+	type test struct {
+		name         string
+		oc           *api.OpenShiftCluster
+		mocks        func(*test, *mock_dns.MockRecordSetsClient)
+		wantAPIIP    string
+		wantRouterIP string
+		wantErr      string
+	}
+
+	for _, tt := range []*test{
+		{
+			name: "managed, records exist",
+			oc:   managedOc,
+			mocks: func(tt *test, recordsets *mock_dns.MockRecordSetsClient) {
+				recordsets.EXPECT().
+					Get(ctx, "rpResourcegroup", "domain", "api.test", mgmtdns.A).
+					Return(mgmtdns.RecordSet{
+						RecordSetProperties: &mgmtdns.RecordSetProperties{
+							ARecords: &[]mgmtdns.ARecord{
+								{
+									Ipv4Address: to.StringPtr("1.2.3.4"),
+								},
+							},
+						},
+					}, nil)
+
+				recordsets.EXPECT().
+					Get(ctx, "rpResourcegroup", "domain", "*.apps.test", mgmtdns.A).
+					Return(mgmtdns.RecordSet{
+						RecordSetProperties: &mgmtdns.RecordSetProperties{
+							ARecords: &[]mgmtdns.ARecord{
+								{
+									Ipv4Address: to.StringPtr("5.6.7.8"),
+								},
+							},
+						},
+					}, nil)
+			},
+			wantAPIIP:    "1.2.3.4",
+			wantRouterIP: "5.6.7.8",
+		},
+		{
+			name: "managed, router record missing",
+			oc:   managedOc,
+			mocks: func(tt *test, recordsets *mock_dns.MockRecordSetsClient) {
+				recordsets.EXPECT().
+					Get(ctx, "rpResourcegroup", "domain", "api.test", mgmtdns.A).
+					Return(mgmtdns.RecordSet{
+						RecordSetProperties: &mgmtdns.RecordSetProperties{
+							ARecords: &[]mgmtdns.ARecord{
+								{
+									Ipv4Address: to.StringPtr("1.2.3.4"),
+								},
+							},
+						},
+					}, nil)
+
+				recordsets.EXPECT().
+					Get(ctx, "rpResourcegroup", "domain", "*.apps.test", mgmtdns.A).
+					Return(mgmtdns.RecordSet{}, autorest.DetailedError{
+						StatusCode: http.StatusNotFound,
+					})
+			},
+			wantAPIIP: "1.2.3.4",
+		},
+		{
+			name: "managed, error",
+			oc:   managedOc,
+			mocks: func(tt *test, recordsets *mock_dns.MockRecordSetsClient) {
+				recordsets.EXPECT().
+					Get(ctx, "rpResourcegroup", "domain", "api.test", mgmtdns.A).
+					Return(mgmtdns.RecordSet{}, fmt.Errorf("random error"))
+			},
+			wantErr: "random error",
+		},
+		{
+			name: "unmanaged",
+			oc:   unmanagedOc,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			env := mock_env.NewMockInterface(controller)
+			env.EXPECT().ResourceGroup().AnyTimes().Return("rpResourcegroup")
+			env.EXPECT().Domain().AnyTimes().Return("domain")
+
+			recordsets := mock_dns.NewMockRecordSetsClient(controller)
+			if tt.mocks != nil {
+				tt.mocks(tt, recordsets)
+			}
+
+			m := &manager{
+				env:        env,
+				recordsets: recordsets,
+			}
+
+			apiIP, routerIP, err := m.Get(ctx, tt.oc)
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Error(err)
+			}
+
+			if apiIP != tt.wantAPIIP {
+				t.Error(apiIP)
+			}
+
+			if routerIP != tt.wantRouterIP {
+				t.Error(routerIP)
+			}
+		})
+	}
+}
+
 func TestDelete(t *testing.T) {
 	ctx := context.Background()
 
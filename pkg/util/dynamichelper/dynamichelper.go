@@ -32,6 +32,7 @@ type Interface interface {
 	Ensure(ctx context.Context, objs ...*unstructured.Unstructured) error
 	Get(ctx context.Context, groupKind, namespace, name string) (*unstructured.Unstructured, error)
 	List(ctx context.Context, groupKind, namespace string) (*unstructured.UnstructuredList, error)
+	ListPage(ctx context.Context, groupKind, namespace string, limit int64, continueToken string) (*unstructured.UnstructuredList, error)
 }
 
 type dynamicHelper struct {
@@ -223,12 +224,22 @@ func (dh *dynamicHelper) Get(ctx context.Context, groupKind, namespace, name str
 }
 
 func (dh *dynamicHelper) List(ctx context.Context, groupKind, namespace string) (*unstructured.UnstructuredList, error) {
+	return dh.ListPage(ctx, groupKind, namespace, 0, "")
+}
+
+// ListPage lists at most limit objects (all of them if limit is 0), starting
+// from continueToken if it is set.  The continue token of the next page is in
+// the metadata of the returned list.
+func (dh *dynamicHelper) ListPage(ctx context.Context, groupKind, namespace string, limit int64, continueToken string) (*unstructured.UnstructuredList, error) {
 	gvr, err := dh.findGVR(groupKind, "")
 	if err != nil {
 		return nil, err
 	}
 
-	return dh.dyn.Resource(*gvr).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	return dh.dyn.Resource(*gvr).Namespace(namespace).List(ctx, metav1.ListOptions{
+		Limit:    limit,
+		Continue: continueToken,
+	})
 }
 
 func diff(existing, o *unstructured.Unstructured) string {
@@ -0,0 +1,138 @@
+package egress
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"strings"
+
+	mgmtnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-07-01/network"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/network"
+	"github.com/Azure/ARO-RP/pkg/util/stringutils"
+)
+
+// outboundRuleName is the outbound rule of the public load balancer, which
+// SNATs the outbound connections of the cluster
+const outboundRuleName = "outbound-rule-v4"
+
+// Interface returns the egress of a cluster, so that customers can program
+// their firewalls from it
+type Interface interface {
+	Get(ctx context.Context) (*api.Egress, error)
+}
+
+type egress struct {
+	env env.Interface
+	oc  *api.OpenShiftCluster
+
+	loadBalancers     network.LoadBalancersClient
+	publicIPAddresses network.PublicIPAddressesClient
+}
+
+// New returns an egress Interface
+func New(_env env.Interface, oc *api.OpenShiftCluster, subscriptionDoc *api.SubscriptionDocument) (Interface, error) {
+	fpAuthorizer, err := _env.FPAuthorizer(subscriptionDoc.Subscription.Properties.TenantID,
+		_env.Environment().ResourceManagerEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return &egress{
+		env: _env,
+		oc:  oc,
+
+		loadBalancers:     network.NewLoadBalancersClient(subscriptionDoc.ID, fpAuthorizer),
+		publicIPAddresses: network.NewPublicIPAddressesClient(subscriptionDoc.ID, fpAuthorizer),
+	}, nil
+}
+
+// Get returns the current outbound IPs of the cluster and the endpoints which
+// it must be able to reach
+func (e *egress) Get(ctx context.Context) (*api.Egress, error) {
+	endpoints, err := RequiredEndpoints(e.env, e.oc)
+	if err != nil {
+		return nil, err
+	}
+
+	ips, err := e.outboundIPs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Egress{
+		ID:   e.oc.ID + "/egress",
+		Name: "egress",
+		Type: e.oc.Type + "/egress",
+		Properties: api.EgressProperties{
+			OutboundIPs:       ips,
+			RequiredEndpoints: endpoints,
+		},
+	}, nil
+}
+
+// outboundIPs returns the addresses of the public IPs which the outbound rule
+// of the public load balancer uses.  These are read from Azure rather than
+// derived from the cluster document, so that they reflect a change of the
+// outbound IP count which is still being reconciled.
+func (e *egress) outboundIPs(ctx context.Context) ([]string, error) {
+	infraID := e.oc.Properties.InfraID
+	resourceGroup := stringutils.LastTokenByte(e.oc.Properties.ClusterProfile.ResourceGroupID, '/')
+
+	lb, err := e.loadBalancers.Get(ctx, resourceGroup, infraID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	pips, err := e.publicIPAddresses.List(ctx, resourceGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := map[string]string{}
+	for _, pip := range pips {
+		if pip.ID != nil && pip.PublicIPAddressPropertiesFormat != nil && pip.IPAddress != nil {
+			addresses[strings.ToLower(*pip.ID)] = *pip.IPAddress
+		}
+	}
+
+	if lb.LoadBalancerPropertiesFormat == nil || lb.FrontendIPConfigurations == nil || lb.OutboundRules == nil {
+		return nil, nil
+	}
+
+	frontends := map[string]mgmtnetwork.FrontendIPConfiguration{}
+	for _, f := range *lb.FrontendIPConfigurations {
+		if f.ID != nil {
+			frontends[strings.ToLower(*f.ID)] = f
+		}
+	}
+
+	var ips []string
+	for _, r := range *lb.OutboundRules {
+		if !strings.EqualFold(*r.Name, outboundRuleName) || r.OutboundRulePropertiesFormat == nil || r.FrontendIPConfigurations == nil {
+			continue
+		}
+
+		for _, ref := range *r.FrontendIPConfigurations {
+			if ref.ID == nil {
+				continue
+			}
+
+			f, found := frontends[strings.ToLower(*ref.ID)]
+			if !found || f.FrontendIPConfigurationPropertiesFormat == nil ||
+				f.PublicIPAddress == nil || f.PublicIPAddress.ID == nil {
+				continue
+			}
+
+			// a public IP which is still being allocated has no address yet
+			if address, found := addresses[strings.ToLower(*f.PublicIPAddress.ID)]; found {
+				ips = append(ips, address)
+			}
+		}
+	}
+
+	return ips, nil
+}
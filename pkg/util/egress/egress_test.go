@@ -0,0 +1,211 @@
+package egress
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	mgmtnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-07-01/network"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/golang/mock/gomock"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	mock_network "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/mgmt/network"
+	mock_env "github.com/Azure/ARO-RP/pkg/util/mocks/env"
+)
+
+func TestGet(t *testing.T) {
+	ctx := context.Background()
+
+	resourceGroupID := "/subscriptions/subscriptionId/resourceGroups/clusterResourceGroup"
+	lbID := resourceGroupID + "/providers/Microsoft.Network/loadBalancers/infra"
+	pipID := func(name string) string {
+		return resourceGroupID + "/providers/Microsoft.Network/publicIPAddresses/" + name
+	}
+
+	oc := &api.OpenShiftCluster{
+		ID:       "/subscriptions/subscriptionId/resourceGroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName",
+		Type:     "Microsoft.RedHatOpenShift/openShiftClusters",
+		Location: "eastus",
+		Properties: api.OpenShiftClusterProperties{
+			InfraID: "infra",
+			ClusterProfile: api.ClusterProfile{
+				ResourceGroupID: resourceGroupID,
+			},
+		},
+	}
+
+	frontend := func(name, pipName string) mgmtnetwork.FrontendIPConfiguration {
+		return mgmtnetwork.FrontendIPConfiguration{
+			ID:   to.StringPtr(lbID + "/frontendIPConfigurations/" + name),
+			Name: to.StringPtr(name),
+			FrontendIPConfigurationPropertiesFormat: &mgmtnetwork.FrontendIPConfigurationPropertiesFormat{
+				PublicIPAddress: &mgmtnetwork.PublicIPAddress{
+					ID: to.StringPtr(pipID(pipName)),
+				},
+			},
+		}
+	}
+
+	lb := mgmtnetwork.LoadBalancer{
+		ID: to.StringPtr(lbID),
+		LoadBalancerPropertiesFormat: &mgmtnetwork.LoadBalancerPropertiesFormat{
+			FrontendIPConfigurations: &[]mgmtnetwork.FrontendIPConfiguration{
+				frontend("public-lb-ip-v4", "infra-pip-v4"),
+				frontend("outbound-lb-ip-v4-1", "infra-outbound-pip-v4-1"),
+				// added by the cloud provider for a LoadBalancer service
+				frontend("service", "service-pip"),
+			},
+			OutboundRules: &[]mgmtnetwork.OutboundRule{
+				{
+					Name: to.StringPtr("outbound-rule-v4"),
+					OutboundRulePropertiesFormat: &mgmtnetwork.OutboundRulePropertiesFormat{
+						FrontendIPConfigurations: &[]mgmtnetwork.SubResource{
+							{ID: to.StringPtr(lbID + "/frontendIPConfigurations/public-lb-ip-v4")},
+							// differs in case from the frontend's ID
+							{ID: to.StringPtr(lbID + "/FrontendIPConfigurations/outbound-lb-ip-v4-1")},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	pip := func(name, address string) mgmtnetwork.PublicIPAddress {
+		return mgmtnetwork.PublicIPAddress{
+			ID:   to.StringPtr(pipID(name)),
+			Name: to.StringPtr(name),
+			PublicIPAddressPropertiesFormat: &mgmtnetwork.PublicIPAddressPropertiesFormat{
+				IPAddress: to.StringPtr(address),
+			},
+		}
+	}
+
+	for _, tt := range []struct {
+		name    string
+		mocks   func(*mock_network.MockLoadBalancersClient, *mock_network.MockPublicIPAddressesClient)
+		wantIPs []string
+		wantErr string
+	}{
+		{
+			name: "outbound IPs are returned",
+			mocks: func(loadBalancers *mock_network.MockLoadBalancersClient, publicIPAddresses *mock_network.MockPublicIPAddressesClient) {
+				loadBalancers.EXPECT().
+					Get(gomock.Any(), "clusterResourceGroup", "infra", "").
+					Return(lb, nil)
+				publicIPAddresses.EXPECT().
+					List(gomock.Any(), "clusterResourceGroup").
+					Return([]mgmtnetwork.PublicIPAddress{
+						pip("infra-pip-v4", "20.0.0.1"),
+						pip("infra-outbound-pip-v4-1", "20.0.0.2"),
+						pip("service-pip", "20.0.0.3"),
+					}, nil)
+			},
+			wantIPs: []string{"20.0.0.1", "20.0.0.2"},
+		},
+		{
+			name: "public IP which is being allocated is skipped",
+			mocks: func(loadBalancers *mock_network.MockLoadBalancersClient, publicIPAddresses *mock_network.MockPublicIPAddressesClient) {
+				loadBalancers.EXPECT().
+					Get(gomock.Any(), "clusterResourceGroup", "infra", "").
+					Return(lb, nil)
+				publicIPAddresses.EXPECT().
+					List(gomock.Any(), "clusterResourceGroup").
+					Return([]mgmtnetwork.PublicIPAddress{
+						pip("infra-pip-v4", "20.0.0.1"),
+						{
+							ID:                              to.StringPtr(pipID("infra-outbound-pip-v4-1")),
+							PublicIPAddressPropertiesFormat: &mgmtnetwork.PublicIPAddressPropertiesFormat{},
+						},
+					}, nil)
+			},
+			wantIPs: []string{"20.0.0.1"},
+		},
+		{
+			name: "load balancer error",
+			mocks: func(loadBalancers *mock_network.MockLoadBalancersClient, publicIPAddresses *mock_network.MockPublicIPAddressesClient) {
+				loadBalancers.EXPECT().
+					Get(gomock.Any(), "clusterResourceGroup", "infra", "").
+					Return(mgmtnetwork.LoadBalancer{}, fmt.Errorf("random error"))
+			},
+			wantErr: "random error",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			env := mock_env.NewMockInterface(controller)
+			env.EXPECT().Environment().AnyTimes().Return(&azure.PublicCloud)
+			env.EXPECT().ACRDomain().AnyTimes().Return("arosvc.azurecr.io")
+
+			loadBalancers := mock_network.NewMockLoadBalancersClient(controller)
+			publicIPAddresses := mock_network.NewMockPublicIPAddressesClient(controller)
+			tt.mocks(loadBalancers, publicIPAddresses)
+
+			e := &egress{
+				env:               env,
+				oc:                oc,
+				loadBalancers:     loadBalancers,
+				publicIPAddresses: publicIPAddresses,
+			}
+
+			eg, err := e.Get(ctx)
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Fatal(err)
+			}
+			if err != nil {
+				return
+			}
+
+			if eg.ID != oc.ID+"/egress" {
+				t.Error(eg.ID)
+			}
+			if !reflect.DeepEqual(eg.Properties.OutboundIPs, tt.wantIPs) {
+				t.Error(eg.Properties.OutboundIPs)
+			}
+			if len(eg.Properties.RequiredEndpoints) == 0 {
+				t.Error("no required endpoints")
+			}
+		})
+	}
+}
+
+func TestRequiredEndpoints(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	env := mock_env.NewMockInterface(controller)
+	env.EXPECT().Environment().AnyTimes().Return(&azure.PublicCloud)
+	env.EXPECT().ACRDomain().AnyTimes().Return("arosvc.azurecr.io")
+
+	endpoints, err := RequiredEndpoints(env, &api.OpenShiftCluster{Location: "eastus"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var hosts []string
+	for _, e := range endpoints {
+		if e.Port != 443 || e.Purpose == "" {
+			t.Error(e)
+		}
+		hosts = append(hosts, e.Host)
+	}
+
+	wantHosts := []string{
+		"arosvc.azurecr.io",
+		"arosvc.eastus.data.azurecr.io",
+		"login.microsoftonline.com",
+		"management.azure.com",
+		"gcs.prod.monitoring.core.windows.net",
+	}
+	if !reflect.DeepEqual(hosts, wantHosts) {
+		t.Error(hosts)
+	}
+}
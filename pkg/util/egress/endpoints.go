@@ -0,0 +1,90 @@
+package egress
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/env"
+)
+
+// MonitoringEndpoint returns the Geneva endpoint from which the cluster's
+// logging agents fetch their configuration
+func MonitoringEndpoint(_env env.Interface) (string, error) {
+	switch _env.Environment().Name {
+	case azure.PublicCloud.Name:
+		return "https://gcs.prod.monitoring.core.windows.net/", nil
+	case azure.USGovernmentCloud.Name:
+		return "https://gcs.monitoring.core.usgovcloudapi.net/", nil
+	default:
+		return "", fmt.Errorf("unsupported cloud environment")
+	}
+}
+
+// RequiredEndpoints returns the endpoints which the nodes of oc must be able
+// to reach.  They depend on the cloud and the cluster's region, but not on its
+// OpenShift version: the release payloads of all versions are mirrored into
+// the RP's container registry.
+func RequiredEndpoints(_env env.Interface, oc *api.OpenShiftCluster) ([]api.EgressEndpoint, error) {
+	monitoringEndpoint, err := MonitoringEndpoint(_env)
+	if err != nil {
+		return nil, err
+	}
+
+	// the registry serves image layers from its data endpoint in the
+	// cluster's region
+	acrName := strings.SplitN(_env.ACRDomain(), ".", 2)
+	if len(acrName) != 2 {
+		return nil, fmt.Errorf("invalid ACR domain %q", _env.ACRDomain())
+	}
+
+	endpoints := []api.EgressEndpoint{
+		{
+			Host:    _env.ACRDomain(),
+			Port:    443,
+			Purpose: "Azure Red Hat OpenShift and OpenShift container images",
+		},
+		{
+			Host:    fmt.Sprintf("%s.%s.data.%s", acrName[0], oc.Location, acrName[1]),
+			Port:    443,
+			Purpose: "Azure Red Hat OpenShift and OpenShift container image layers",
+		},
+	}
+
+	for _, e := range []struct {
+		url     string
+		purpose string
+	}{
+		{
+			url:     _env.Environment().ActiveDirectoryEndpoint,
+			purpose: "Azure Active Directory authentication",
+		},
+		{
+			url:     _env.Environment().ResourceManagerEndpoint,
+			purpose: "Azure Resource Manager, which the cluster manages its Azure resources through",
+		},
+		{
+			url:     monitoringEndpoint,
+			purpose: "Azure Red Hat OpenShift monitoring and logging",
+		},
+	} {
+		u, err := url.Parse(e.url)
+		if err != nil {
+			return nil, err
+		}
+
+		endpoints = append(endpoints, api.EgressEndpoint{
+			Host:    u.Hostname(),
+			Port:    443,
+			Purpose: e.purpose,
+		})
+	}
+
+	return endpoints, nil
+}
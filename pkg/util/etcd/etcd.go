@@ -0,0 +1,220 @@
+package etcd
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// QuotaBackendBytes is the size which an etcd member's database must stay
+// below.  Beyond it the member raises a NOSPACE alarm and the cluster refuses
+// writes until the alarm is disarmed.
+const QuotaBackendBytes = 8 * 1024 * 1024 * 1024
+
+// AlarmNoSpace is the alarm raised by a member which exceeded its quota
+const AlarmNoSpace = "NOSPACE"
+
+const (
+	etcdNamespace = "openshift-etcd"
+	clientPort    = "2379"
+)
+
+// Member is a member of the etcd cluster
+type Member struct {
+	Name     string
+	Endpoint string
+}
+
+// Status is the maintenance status which a member reports about itself
+type Status struct {
+	Header struct {
+		MemberID uint64 `json:"member_id,string"`
+	} `json:"header"`
+	Leader      uint64 `json:"leader,string"`
+	DBSize      int64  `json:"db_size,string"`
+	DBSizeInUse int64  `json:"db_size_in_use,string"`
+}
+
+// IsLeader returns whether the member is the leader of the etcd cluster
+func (s *Status) IsLeader() bool {
+	return s.Header.MemberID == s.Leader
+}
+
+// Fragmentation returns the proportion of the member's database file which
+// is free space that only defragmentation returns to the filesystem
+func (s *Status) Fragmentation() float64 {
+	if s.DBSize == 0 {
+		return 0
+	}
+
+	return float64(s.DBSize-s.DBSizeInUse) / float64(s.DBSize)
+}
+
+// Alarm is an alarm raised by a member
+type Alarm struct {
+	MemberID uint64 `json:"memberID,string"`
+	Alarm    string `json:"alarm"`
+}
+
+// Client talks to the etcd members via the grpc-gateway which etcd serves on
+// its client port, so that it needs no etcd client library
+type Client interface {
+	Members(context.Context) ([]Member, error)
+	Status(context.Context, Member) (*Status, error)
+	Defragment(context.Context, Member) error
+	Alarms(context.Context, Member) ([]Alarm, error)
+	Disarm(context.Context, Member, Alarm) error
+}
+
+type client struct {
+	kubernetescli kubernetes.Interface
+	cli           *http.Client
+}
+
+// NewClient returns a Client which authenticates with the etcd client
+// certificate which the kube-apiserver uses
+func NewClient(ctx context.Context, kubernetescli kubernetes.Interface) (Client, error) {
+	s, err := kubernetescli.CoreV1().Secrets("openshift-config").Get(ctx, "etcd-client", metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := tls.X509KeyPair(s.Data[corev1.TLSCertKey], s.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return nil, err
+	}
+
+	cm, err := kubernetescli.CoreV1().ConfigMaps("openshift-config").Get(ctx, "etcd-serving-ca", metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM([]byte(cm.Data["ca-bundle.crt"])) {
+		return nil, fmt.Errorf("etcd-serving-ca contains no certificates")
+	}
+
+	return &client{
+		kubernetescli: kubernetescli,
+		cli: &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					Certificates: []tls.Certificate{cert},
+					RootCAs:      pool,
+				},
+				// the members are dialled seldom, so don't keep idle
+				// connections open
+				DisableKeepAlives: true,
+			},
+		},
+	}, nil
+}
+
+// Members returns the etcd members, sorted by name.  The etcd pods run on the
+// host network, so the IP of each pod is the address of its member.
+func (c *client) Members(ctx context.Context) ([]Member, error) {
+	pods, err := c.kubernetescli.CoreV1().Pods(etcdNamespace).List(ctx, metav1.ListOptions{
+		LabelSelector: "app=etcd",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]Member, 0, len(pods.Items))
+	for _, pod := range pods.Items {
+		if pod.Status.PodIP == "" {
+			return nil, fmt.Errorf("pod %s has no IP", pod.Name)
+		}
+
+		members = append(members, Member{
+			Name:     pod.Name,
+			Endpoint: "https://" + net.JoinHostPort(pod.Status.PodIP, clientPort),
+		})
+	}
+
+	sort.Slice(members, func(i, j int) bool { return members[i].Name < members[j].Name })
+
+	return members, nil
+}
+
+func (c *client) Status(ctx context.Context, m Member) (*Status, error) {
+	var s *Status
+	err := c.do(ctx, m, "/v3/maintenance/status", struct{}{}, &s)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Defragment defragments the member's database.  The member stops serving
+// requests until it finishes.
+func (c *client) Defragment(ctx context.Context, m Member) error {
+	return c.do(ctx, m, "/v3/maintenance/defragment", struct{}{}, nil)
+}
+
+// Alarms returns the alarms raised by any member of the cluster
+func (c *client) Alarms(ctx context.Context, m Member) ([]Alarm, error) {
+	var resp struct {
+		Alarms []Alarm `json:"alarms"`
+	}
+	err := c.do(ctx, m, "/v3/maintenance/alarm", map[string]string{"action": "GET"}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Alarms, nil
+}
+
+// Disarm clears an alarm.  A member whose quota is still exceeded raises it
+// again straight away.
+func (c *client) Disarm(ctx context.Context, m Member, a Alarm) error {
+	return c.do(ctx, m, "/v3/maintenance/alarm", map[string]string{
+		"action":   "DEACTIVATE",
+		"memberID": strconv.FormatUint(a.MemberID, 10),
+		"alarm":    a.Alarm,
+	}, nil)
+}
+
+func (c *client) do(ctx context.Context, m Member, path string, in, out interface{}) error {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.Endpoint+path, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.cli.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: unexpected status code %d", m.Name, resp.StatusCode)
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -0,0 +1,131 @@
+package etcd
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestMembers(t *testing.T) {
+	ctx := context.Background()
+
+	pod := func(name, ip string, labels map[string]string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: etcdNamespace,
+				Labels:    labels,
+			},
+			Status: corev1.PodStatus{
+				PodIP: ip,
+			},
+		}
+	}
+
+	c := &client{
+		kubernetescli: fake.NewSimpleClientset(
+			pod("etcd-master-1", "10.0.0.7", map[string]string{"app": "etcd"}),
+			pod("etcd-master-0", "10.0.0.6", map[string]string{"app": "etcd"}),
+			pod("etcd-quorum-guard-abcde", "10.128.0.5", map[string]string{"app": "etcd-quorum-guard"}),
+		),
+	}
+
+	members, err := c.Members(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Member{
+		{Name: "etcd-master-0", Endpoint: "https://10.0.0.6:2379"},
+		{Name: "etcd-master-1", Endpoint: "https://10.0.0.7:2379"},
+	}
+	if !reflect.DeepEqual(members, want) {
+		t.Error(members)
+	}
+}
+
+func TestMaintenance(t *testing.T) {
+	ctx := context.Background()
+
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		err := json.NewDecoder(r.Body).Decode(&body)
+		if err != nil {
+			t.Error(err)
+		}
+		requests = append(requests, r.URL.Path+" "+body["action"]+body["memberID"]+body["alarm"])
+
+		switch r.URL.Path {
+		case "/v3/maintenance/status":
+			w.Write([]byte(`{"header":{"cluster_id":"1","member_id":"18446744073709551615"},"version":"3.4.9","db_size":"1000","leader":"18446744073709551615","db_size_in_use":"400"}`))
+		case "/v3/maintenance/defragment":
+			w.Write([]byte(`{"header":{}}`))
+		case "/v3/maintenance/alarm":
+			w.Write([]byte(`{"header":{},"alarms":[{"memberID":"42","alarm":"NOSPACE"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := &client{cli: srv.Client()}
+	m := Member{Name: "etcd-master-0", Endpoint: srv.URL}
+
+	s, err := c.Status(ctx, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !s.IsLeader() {
+		t.Error(s.Leader)
+	}
+	if s.DBSize != 1000 || s.DBSizeInUse != 400 {
+		t.Error(s.DBSize, s.DBSizeInUse)
+	}
+	if s.Fragmentation() != 0.6 {
+		t.Error(s.Fragmentation())
+	}
+
+	err = c.Defragment(ctx, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	alarms, err := c.Alarms(ctx, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(alarms, []Alarm{{MemberID: 42, Alarm: AlarmNoSpace}}) {
+		t.Error(alarms)
+	}
+
+	err = c.Disarm(ctx, m, alarms[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantRequests := []string{
+		"/v3/maintenance/status ",
+		"/v3/maintenance/defragment ",
+		"/v3/maintenance/alarm GET",
+		"/v3/maintenance/alarm DEACTIVATE42NOSPACE",
+	}
+	if !reflect.DeepEqual(requests, wantRequests) {
+		t.Error(requests)
+	}
+
+	_, err = c.Status(ctx, Member{Name: "etcd-master-0", Endpoint: srv.URL + "/missing"})
+	if err == nil || err.Error() != "etcd-master-0: unexpected status code 404" {
+		t.Error(err)
+	}
+}
@@ -47,8 +47,8 @@ func (im *instanceMetadata) Environment() *azure.Environment {
 	return im.environment
 }
 
-func New(ctx context.Context, deploymentMode deployment.Mode) (InstanceMetadata, error) {
-	if deploymentMode == deployment.Development {
+func New(ctx context.Context, capabilities deployment.Capabilities) (InstanceMetadata, error) {
+	if capabilities.IsLocalDevelopment() {
 		return NewDev()
 	}
 
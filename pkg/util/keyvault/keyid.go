@@ -0,0 +1,38 @@
+package keyvault
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var (
+	rxVaultHost = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]{1,22}[a-zA-Z0-9]\.vault\.[a-z0-9.]+[a-z0-9]$`)
+	rxKeyName   = regexp.MustCompile(`^[a-zA-Z0-9-]{1,127}$`)
+	rxVersion   = regexp.MustCompile(`^[a-f0-9]{32}$`)
+)
+
+// ParseKeyID parses the versioned ID of a Key Vault key, e.g.
+// https://vault.vault.azure.net/keys/key/0123456789abcdef0123456789abcdef,
+// into its vault base URL, key name and key version
+func ParseKeyID(id string) (vaultBaseURL, name, version string, err error) {
+	u, err := url.Parse(id)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	parts := strings.Split(strings.TrimPrefix(u.Path, "/"), "/")
+
+	if u.Scheme != "https" || !rxVaultHost.MatchString(u.Host) ||
+		u.User != nil || u.RawQuery != "" || u.Fragment != "" ||
+		len(parts) != 3 || parts[0] != "keys" ||
+		!rxKeyName.MatchString(parts[1]) || !rxVersion.MatchString(parts[2]) {
+		return "", "", "", fmt.Errorf("invalid versioned key ID %q", id)
+	}
+
+	return "https://" + u.Host + "/", parts[1], parts[2], nil
+}
@@ -0,0 +1,76 @@
+package keyvault
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"testing"
+)
+
+func TestParseKeyID(t *testing.T) {
+	for _, tt := range []struct {
+		name             string
+		id               string
+		wantVaultBaseURL string
+		wantName         string
+		wantVersion      string
+		wantErr          bool
+	}{
+		{
+			name:             "valid",
+			id:               "https://my-vault.vault.azure.net/keys/my-key/0123456789abcdef0123456789abcdef",
+			wantVaultBaseURL: "https://my-vault.vault.azure.net/",
+			wantName:         "my-key",
+			wantVersion:      "0123456789abcdef0123456789abcdef",
+		},
+		{
+			name:             "valid sovereign cloud",
+			id:               "https://my-vault.vault.usgovcloudapi.net/keys/my-key/0123456789abcdef0123456789abcdef",
+			wantVaultBaseURL: "https://my-vault.vault.usgovcloudapi.net/",
+			wantName:         "my-key",
+			wantVersion:      "0123456789abcdef0123456789abcdef",
+		},
+		{
+			name:    "unversioned",
+			id:      "https://my-vault.vault.azure.net/keys/my-key",
+			wantErr: true,
+		},
+		{
+			name:    "secret",
+			id:      "https://my-vault.vault.azure.net/secrets/my-key/0123456789abcdef0123456789abcdef",
+			wantErr: true,
+		},
+		{
+			name:    "http",
+			id:      "http://my-vault.vault.azure.net/keys/my-key/0123456789abcdef0123456789abcdef",
+			wantErr: true,
+		},
+		{
+			name:    "not a vault",
+			id:      "https://example.com/keys/my-key/0123456789abcdef0123456789abcdef",
+			wantErr: true,
+		},
+		{
+			name:    "query",
+			id:      "https://my-vault.vault.azure.net/keys/my-key/0123456789abcdef0123456789abcdef?x=y",
+			wantErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			vaultBaseURL, name, version, err := ParseKeyID(tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Fatal(err)
+			}
+
+			if vaultBaseURL != tt.wantVaultBaseURL {
+				t.Error(vaultBaseURL)
+			}
+			if name != tt.wantName {
+				t.Error(name)
+			}
+			if version != tt.wantVersion {
+				t.Error(version)
+			}
+		})
+	}
+}
@@ -16,15 +16,16 @@ import (
 	v1 "k8s.io/client-go/tools/clientcmd/api/v1"
 
 	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/redhatopenshift"
+	"github.com/Azure/ARO-RP/pkg/util/deployment"
 )
 
-func Get(ctx context.Context, log *logrus.Entry, authorizer autorest.Authorizer, resourceID string) (*v1.Config, error) {
+func Get(ctx context.Context, log *logrus.Entry, capabilities deployment.Capabilities, authorizer autorest.Authorizer, resourceID string) (*v1.Config, error) {
 	res, err := azure.ParseResourceID(resourceID)
 	if err != nil {
 		return nil, err
 	}
 
-	openshiftclusters := redhatopenshift.NewOpenShiftClustersClient(res.SubscriptionID, authorizer)
+	openshiftclusters := redhatopenshift.NewOpenShiftClustersClient(capabilities, res.SubscriptionID, authorizer)
 
 	oc, err := openshiftclusters.Get(ctx, res.ResourceGroup, res.ResourceName)
 	if err != nil {
@@ -90,6 +90,7 @@ func EnrichWithCorrelationData(log *logrus.Entry, correlationData *api.Correlati
 		"client_request_id":     correlationData.ClientRequestID,
 		"request_id":            correlationData.RequestID,
 		"client_principal_name": correlationData.ClientPrincipalName,
+		"client_app_id":         correlationData.ClientAppID,
 	})
 }
 
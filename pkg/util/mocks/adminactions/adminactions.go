@@ -7,11 +7,17 @@ package mock_adminactions
 import (
 	context "context"
 	http "net/http"
+	url "net/url"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
 	logrus "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
 	unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	api "github.com/Azure/ARO-RP/pkg/api"
+	cluster "github.com/Azure/ARO-RP/pkg/cluster"
+	operator "github.com/Azure/ARO-RP/pkg/operator"
 )
 
 // MockInterface is a mock of Interface interface
@@ -37,6 +43,50 @@ func (m *MockInterface) EXPECT() *MockInterfaceMockRecorder {
 	return m.recorder
 }
 
+// ClusterHealth mocks base method
+func (m *MockInterface) ClusterHealth(arg0 context.Context) (*operator.ClusterHealth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClusterHealth", arg0)
+	ret0, _ := ret[0].(*operator.ClusterHealth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClusterHealth indicates an expected call of ClusterHealth
+func (mr *MockInterfaceMockRecorder) ClusterHealth(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterHealth", reflect.TypeOf((*MockInterface)(nil).ClusterHealth), arg0)
+}
+
+// DriftReport mocks base method
+func (m *MockInterface) DriftReport(arg0 context.Context) (*cluster.DriftReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DriftReport", arg0)
+	ret0, _ := ret[0].(*cluster.DriftReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DriftReport indicates an expected call of DriftReport
+func (mr *MockInterfaceMockRecorder) DriftReport(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DriftReport", reflect.TypeOf((*MockInterface)(nil).DriftReport), arg0)
+}
+
+// K8sAttach mocks base method
+func (m *MockInterface) K8sAttach(arg0 context.Context, arg1 http.ResponseWriter, arg2 *http.Request, arg3, arg4 string, arg5 *v1.PodAttachOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "K8sAttach", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// K8sAttach indicates an expected call of K8sAttach
+func (mr *MockInterfaceMockRecorder) K8sAttach(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "K8sAttach", reflect.TypeOf((*MockInterface)(nil).K8sAttach), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
 // K8sCreateOrUpdate mocks base method
 func (m *MockInterface) K8sCreateOrUpdate(arg0 context.Context, arg1 *unstructured.Unstructured) error {
 	m.ctrl.T.Helper()
@@ -65,6 +115,34 @@ func (mr *MockInterfaceMockRecorder) K8sDelete(arg0, arg1, arg2, arg3 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "K8sDelete", reflect.TypeOf((*MockInterface)(nil).K8sDelete), arg0, arg1, arg2, arg3)
 }
 
+// K8sEvents mocks base method
+func (m *MockInterface) K8sEvents(arg0 context.Context, arg1 http.ResponseWriter, arg2 string, arg3 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "K8sEvents", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// K8sEvents indicates an expected call of K8sEvents
+func (mr *MockInterfaceMockRecorder) K8sEvents(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "K8sEvents", reflect.TypeOf((*MockInterface)(nil).K8sEvents), arg0, arg1, arg2, arg3)
+}
+
+// K8sExec mocks base method
+func (m *MockInterface) K8sExec(arg0 context.Context, arg1 http.ResponseWriter, arg2 *http.Request, arg3, arg4 string, arg5 *v1.PodExecOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "K8sExec", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// K8sExec indicates an expected call of K8sExec
+func (mr *MockInterfaceMockRecorder) K8sExec(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "K8sExec", reflect.TypeOf((*MockInterface)(nil).K8sExec), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
 // K8sGet mocks base method
 func (m *MockInterface) K8sGet(arg0 context.Context, arg1, arg2, arg3 string) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -81,18 +159,105 @@ func (mr *MockInterfaceMockRecorder) K8sGet(arg0, arg1, arg2, arg3 interface{})
 }
 
 // K8sList mocks base method
-func (m *MockInterface) K8sList(arg0 context.Context, arg1, arg2 string) ([]byte, error) {
+func (m *MockInterface) K8sList(arg0 context.Context, arg1, arg2 string, arg3 int64, arg4 string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "K8sList", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "K8sList", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // K8sList indicates an expected call of K8sList
-func (mr *MockInterfaceMockRecorder) K8sList(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockInterfaceMockRecorder) K8sList(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "K8sList", reflect.TypeOf((*MockInterface)(nil).K8sList), arg0, arg1, arg2, arg3, arg4)
+}
+
+// K8sPodLogs mocks base method
+func (m *MockInterface) K8sPodLogs(arg0 context.Context, arg1 http.ResponseWriter, arg2, arg3 string, arg4 *v1.PodLogOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "K8sPodLogs", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// K8sPodLogs indicates an expected call of K8sPodLogs
+func (mr *MockInterfaceMockRecorder) K8sPodLogs(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "K8sPodLogs", reflect.TypeOf((*MockInterface)(nil).K8sPodLogs), arg0, arg1, arg2, arg3, arg4)
+}
+
+// K8sProxy mocks base method
+func (m *MockInterface) K8sProxy(arg0 context.Context, arg1 http.ResponseWriter, arg2, arg3, arg4, arg5, arg6 string, arg7 url.Values) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "K8sProxy", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// K8sProxy indicates an expected call of K8sProxy
+func (mr *MockInterfaceMockRecorder) K8sProxy(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "K8sList", reflect.TypeOf((*MockInterface)(nil).K8sList), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "K8sProxy", reflect.TypeOf((*MockInterface)(nil).K8sProxy), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+}
+
+// OperatorFlagsSet mocks base method
+func (m *MockInterface) OperatorFlagsSet(arg0 context.Context, arg1 map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OperatorFlagsSet", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OperatorFlagsSet indicates an expected call of OperatorFlagsSet
+func (mr *MockInterfaceMockRecorder) OperatorFlagsSet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperatorFlagsSet", reflect.TypeOf((*MockInterface)(nil).OperatorFlagsSet), arg0, arg1)
+}
+
+// PrivateEndpointRepair mocks base method
+func (m *MockInterface) PrivateEndpointRepair(arg0 context.Context, arg1 *api.OpenShiftClusterDocument) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrivateEndpointRepair", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrivateEndpointRepair indicates an expected call of PrivateEndpointRepair
+func (mr *MockInterfaceMockRecorder) PrivateEndpointRepair(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivateEndpointRepair", reflect.TypeOf((*MockInterface)(nil).PrivateEndpointRepair), arg0, arg1)
+}
+
+// RebootNodes mocks base method
+func (m *MockInterface) RebootNodes(arg0 context.Context) (*operator.RebootRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebootNodes", arg0)
+	ret0, _ := ret[0].(*operator.RebootRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebootNodes indicates an expected call of RebootNodes
+func (mr *MockInterfaceMockRecorder) RebootNodes(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebootNodes", reflect.TypeOf((*MockInterface)(nil).RebootNodes), arg0)
+}
+
+// RebootStatus mocks base method
+func (m *MockInterface) RebootStatus(arg0 context.Context) (*operator.RebootStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebootStatus", arg0)
+	ret0, _ := ret[0].(*operator.RebootStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebootStatus indicates an expected call of RebootStatus
+func (mr *MockInterfaceMockRecorder) RebootStatus(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebootStatus", reflect.TypeOf((*MockInterface)(nil).RebootStatus), arg0)
 }
 
 // ResourcesList mocks base method
@@ -110,6 +275,35 @@ func (mr *MockInterfaceMockRecorder) ResourcesList(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResourcesList", reflect.TypeOf((*MockInterface)(nil).ResourcesList), arg0)
 }
 
+// RestoreSnapshot mocks base method
+func (m *MockInterface) RestoreSnapshot(arg0 context.Context, arg1 *api.OpenShiftClusterSnapshot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreSnapshot indicates an expected call of RestoreSnapshot
+func (mr *MockInterfaceMockRecorder) RestoreSnapshot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreSnapshot", reflect.TypeOf((*MockInterface)(nil).RestoreSnapshot), arg0, arg1)
+}
+
+// RunChecks mocks base method
+func (m *MockInterface) RunChecks(arg0 context.Context, arg1 []string) (*operator.CheckResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunChecks", arg0, arg1)
+	ret0, _ := ret[0].(*operator.CheckResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunChecks indicates an expected call of RunChecks
+func (mr *MockInterfaceMockRecorder) RunChecks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunChecks", reflect.TypeOf((*MockInterface)(nil).RunChecks), arg0, arg1)
+}
+
 // Upgrade mocks base method
 func (m *MockInterface) Upgrade(arg0 context.Context, arg1 bool) error {
 	m.ctrl.T.Helper()
@@ -151,3 +345,18 @@ func (mr *MockInterfaceMockRecorder) VMSerialConsole(arg0, arg1, arg2, arg3 inte
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VMSerialConsole", reflect.TypeOf((*MockInterface)(nil).VMSerialConsole), arg0, arg1, arg2, arg3)
 }
+
+// WhatIf mocks base method
+func (m *MockInterface) WhatIf(arg0 context.Context) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WhatIf", arg0)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WhatIf indicates an expected call of WhatIf
+func (mr *MockInterfaceMockRecorder) WhatIf(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WhatIf", reflect.TypeOf((*MockInterface)(nil).WhatIf), arg0)
+}
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/Azure/ARO-RP/pkg/util/azureclient/graphrbac (interfaces: ApplicationsClient,ServicePrincipalClient)
+// Source: github.com/Azure/ARO-RP/pkg/util/azureclient/graphrbac (interfaces: ApplicationsClient,ServicePrincipalClient,UsersClient)
 
 // Package mock_graphrbac is a generated GoMock package.
 package mock_graphrbac
@@ -148,3 +148,41 @@ func (mr *MockServicePrincipalClientMockRecorder) List(arg0, arg1 interface{}) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockServicePrincipalClient)(nil).List), arg0, arg1)
 }
+
+// MockUsersClient is a mock of UsersClient interface
+type MockUsersClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsersClientMockRecorder
+}
+
+// MockUsersClientMockRecorder is the mock recorder for MockUsersClient
+type MockUsersClientMockRecorder struct {
+	mock *MockUsersClient
+}
+
+// NewMockUsersClient creates a new mock instance
+func NewMockUsersClient(ctrl *gomock.Controller) *MockUsersClient {
+	mock := &MockUsersClient{ctrl: ctrl}
+	mock.recorder = &MockUsersClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockUsersClient) EXPECT() *MockUsersClientMockRecorder {
+	return m.recorder
+}
+
+// GetMemberGroups mocks base method
+func (m *MockUsersClient) GetMemberGroups(arg0 context.Context, arg1 string, arg2 graphrbac.UserGetMemberGroupsParameters) (graphrbac.UserGetMemberGroupsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMemberGroups", arg0, arg1, arg2)
+	ret0, _ := ret[0].(graphrbac.UserGetMemberGroupsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMemberGroups indicates an expected call of GetMemberGroups
+func (mr *MockUsersClientMockRecorder) GetMemberGroups(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberGroups", reflect.TypeOf((*MockUsersClient)(nil).GetMemberGroups), arg0, arg1, arg2)
+}
@@ -139,3 +139,33 @@ func (mr *MockBaseClientMockRecorder) SetSecret(arg0, arg1, arg2, arg3 interface
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSecret", reflect.TypeOf((*MockBaseClient)(nil).SetSecret), arg0, arg1, arg2, arg3)
 }
+
+// UnwrapKey mocks base method
+func (m *MockBaseClient) UnwrapKey(arg0 context.Context, arg1, arg2, arg3 string, arg4 keyvault.KeyOperationsParameters) (keyvault.KeyOperationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnwrapKey", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(keyvault.KeyOperationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnwrapKey indicates an expected call of UnwrapKey
+func (mr *MockBaseClientMockRecorder) UnwrapKey(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnwrapKey", reflect.TypeOf((*MockBaseClient)(nil).UnwrapKey), arg0, arg1, arg2, arg3, arg4)
+}
+
+// WrapKey mocks base method
+func (m *MockBaseClient) WrapKey(arg0 context.Context, arg1, arg2, arg3 string, arg4 keyvault.KeyOperationsParameters) (keyvault.KeyOperationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WrapKey", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(keyvault.KeyOperationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WrapKey indicates an expected call of WrapKey
+func (mr *MockBaseClientMockRecorder) WrapKey(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WrapKey", reflect.TypeOf((*MockBaseClient)(nil).WrapKey), arg0, arg1, arg2, arg3, arg4)
+}
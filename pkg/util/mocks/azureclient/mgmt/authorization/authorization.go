@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/authorization (interfaces: PermissionsClient,RoleAssignmentsClient)
+// Source: github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/authorization (interfaces: DenyAssignmentsClient,PermissionsClient,RoleAssignmentsClient)
 
 // Package mock_authorization is a generated GoMock package.
 package mock_authorization
@@ -12,6 +12,44 @@ import (
 	gomock "github.com/golang/mock/gomock"
 )
 
+// MockDenyAssignmentsClient is a mock of DenyAssignmentsClient interface
+type MockDenyAssignmentsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockDenyAssignmentsClientMockRecorder
+}
+
+// MockDenyAssignmentsClientMockRecorder is the mock recorder for MockDenyAssignmentsClient
+type MockDenyAssignmentsClientMockRecorder struct {
+	mock *MockDenyAssignmentsClient
+}
+
+// NewMockDenyAssignmentsClient creates a new mock instance
+func NewMockDenyAssignmentsClient(ctrl *gomock.Controller) *MockDenyAssignmentsClient {
+	mock := &MockDenyAssignmentsClient{ctrl: ctrl}
+	mock.recorder = &MockDenyAssignmentsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDenyAssignmentsClient) EXPECT() *MockDenyAssignmentsClientMockRecorder {
+	return m.recorder
+}
+
+// ListForResourceGroup mocks base method
+func (m *MockDenyAssignmentsClient) ListForResourceGroup(arg0 context.Context, arg1, arg2 string) ([]authorization.DenyAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForResourceGroup", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]authorization.DenyAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForResourceGroup indicates an expected call of ListForResourceGroup
+func (mr *MockDenyAssignmentsClientMockRecorder) ListForResourceGroup(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForResourceGroup", reflect.TypeOf((*MockDenyAssignmentsClient)(nil).ListForResourceGroup), arg0, arg1, arg2)
+}
+
 // MockPermissionsClient is a mock of PermissionsClient interface
 type MockPermissionsClient struct {
 	ctrl     *gomock.Controller
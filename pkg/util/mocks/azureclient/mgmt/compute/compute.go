@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/compute (interfaces: DisksClient,ResourceSkusClient,VirtualMachinesClient,UsageClient,VirtualMachineScaleSetVMsClient,VirtualMachineScaleSetsClient)
+// Source: github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/compute (interfaces: DisksClient,ResourceSkusClient,VirtualMachinesClient,VirtualMachineImagesClient,UsageClient,VirtualMachineScaleSetVMsClient,VirtualMachineScaleSetsClient)
 
 // Package mock_compute is a generated GoMock package.
 package mock_compute
@@ -182,6 +182,20 @@ func (mr *MockVirtualMachinesClientMockRecorder) RedeployAndWait(arg0, arg1, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedeployAndWait", reflect.TypeOf((*MockVirtualMachinesClient)(nil).RedeployAndWait), arg0, arg1, arg2)
 }
 
+// RestartAndWait mocks base method
+func (m *MockVirtualMachinesClient) RestartAndWait(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestartAndWait", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestartAndWait indicates an expected call of RestartAndWait
+func (mr *MockVirtualMachinesClientMockRecorder) RestartAndWait(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestartAndWait", reflect.TypeOf((*MockVirtualMachinesClient)(nil).RestartAndWait), arg0, arg1, arg2)
+}
+
 // StartAndWait mocks base method
 func (m *MockVirtualMachinesClient) StartAndWait(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -196,6 +210,44 @@ func (mr *MockVirtualMachinesClientMockRecorder) StartAndWait(arg0, arg1, arg2 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartAndWait", reflect.TypeOf((*MockVirtualMachinesClient)(nil).StartAndWait), arg0, arg1, arg2)
 }
 
+// MockVirtualMachineImagesClient is a mock of VirtualMachineImagesClient interface
+type MockVirtualMachineImagesClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockVirtualMachineImagesClientMockRecorder
+}
+
+// MockVirtualMachineImagesClientMockRecorder is the mock recorder for MockVirtualMachineImagesClient
+type MockVirtualMachineImagesClientMockRecorder struct {
+	mock *MockVirtualMachineImagesClient
+}
+
+// NewMockVirtualMachineImagesClient creates a new mock instance
+func NewMockVirtualMachineImagesClient(ctrl *gomock.Controller) *MockVirtualMachineImagesClient {
+	mock := &MockVirtualMachineImagesClient{ctrl: ctrl}
+	mock.recorder = &MockVirtualMachineImagesClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockVirtualMachineImagesClient) EXPECT() *MockVirtualMachineImagesClientMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method
+func (m *MockVirtualMachineImagesClient) List(arg0 context.Context, arg1, arg2, arg3, arg4, arg5 string, arg6 *int32, arg7 string) (compute.ListVirtualMachineImageResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	ret0, _ := ret[0].(compute.ListVirtualMachineImageResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List
+func (mr *MockVirtualMachineImagesClientMockRecorder) List(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockVirtualMachineImagesClient)(nil).List), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+}
+
 // MockUsageClient is a mock of UsageClient interface
 type MockUsageClient struct {
 	ctrl     *gomock.Controller
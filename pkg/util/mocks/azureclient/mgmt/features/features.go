@@ -78,6 +78,21 @@ func (mr *MockDeploymentsClientMockRecorder) DeleteAndWait(arg0, arg1, arg2 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAndWait", reflect.TypeOf((*MockDeploymentsClient)(nil).DeleteAndWait), arg0, arg1, arg2)
 }
 
+// ExportTemplate mocks base method
+func (m *MockDeploymentsClient) ExportTemplate(arg0 context.Context, arg1, arg2 string) (features.DeploymentExportResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportTemplate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(features.DeploymentExportResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportTemplate indicates an expected call of ExportTemplate
+func (mr *MockDeploymentsClientMockRecorder) ExportTemplate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportTemplate", reflect.TypeOf((*MockDeploymentsClient)(nil).ExportTemplate), arg0, arg1, arg2)
+}
+
 // Get mocks base method
 func (m *MockDeploymentsClient) Get(arg0 context.Context, arg1, arg2 string) (features.DeploymentExtended, error) {
 	m.ctrl.T.Helper()
@@ -93,6 +108,21 @@ func (mr *MockDeploymentsClientMockRecorder) Get(arg0, arg1, arg2 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDeploymentsClient)(nil).Get), arg0, arg1, arg2)
 }
 
+// ValidateAtSubscriptionScope mocks base method
+func (m *MockDeploymentsClient) ValidateAtSubscriptionScope(arg0 context.Context, arg1 string, arg2 features.Deployment) (features.DeploymentValidateResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateAtSubscriptionScope", arg0, arg1, arg2)
+	ret0, _ := ret[0].(features.DeploymentValidateResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateAtSubscriptionScope indicates an expected call of ValidateAtSubscriptionScope
+func (mr *MockDeploymentsClientMockRecorder) ValidateAtSubscriptionScope(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateAtSubscriptionScope", reflect.TypeOf((*MockDeploymentsClient)(nil).ValidateAtSubscriptionScope), arg0, arg1, arg2)
+}
+
 // Wait mocks base method
 func (m *MockDeploymentsClient) Wait(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -107,6 +137,21 @@ func (mr *MockDeploymentsClientMockRecorder) Wait(arg0, arg1, arg2 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Wait", reflect.TypeOf((*MockDeploymentsClient)(nil).Wait), arg0, arg1, arg2)
 }
 
+// WhatIfAndWait mocks base method
+func (m *MockDeploymentsClient) WhatIfAndWait(arg0 context.Context, arg1, arg2 string, arg3 features.DeploymentWhatIf) (features.WhatIfOperationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WhatIfAndWait", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(features.WhatIfOperationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WhatIfAndWait indicates an expected call of WhatIfAndWait
+func (mr *MockDeploymentsClientMockRecorder) WhatIfAndWait(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WhatIfAndWait", reflect.TypeOf((*MockDeploymentsClient)(nil).WhatIfAndWait), arg0, arg1, arg2, arg3)
+}
+
 // MockProvidersClient is a mock of ProvidersClient interface
 type MockProvidersClient struct {
 	ctrl     *gomock.Controller
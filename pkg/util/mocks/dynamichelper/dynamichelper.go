@@ -112,6 +112,21 @@ func (mr *MockInterfaceMockRecorder) List(arg0, arg1, arg2 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockInterface)(nil).List), arg0, arg1, arg2)
 }
 
+// ListPage mocks base method
+func (m *MockInterface) ListPage(arg0 context.Context, arg1, arg2 string, arg3 int64, arg4 string) (*unstructured.UnstructuredList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPage", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*unstructured.UnstructuredList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPage indicates an expected call of ListPage
+func (mr *MockInterfaceMockRecorder) ListPage(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPage", reflect.TypeOf((*MockInterface)(nil).ListPage), arg0, arg1, arg2, arg3, arg4)
+}
+
 // RefreshAPIResources mocks base method
 func (m *MockInterface) RefreshAPIResources() error {
 	m.ctrl.T.Helper()
@@ -15,10 +15,13 @@ import (
 	azure "github.com/Azure/go-autorest/autorest/azure"
 	gomock "github.com/golang/mock/gomock"
 
+	metrics "github.com/Azure/ARO-RP/pkg/metrics"
+	adminpolicy "github.com/Azure/ARO-RP/pkg/util/adminpolicy"
 	clientauthorizer "github.com/Azure/ARO-RP/pkg/util/clientauthorizer"
 	deployment "github.com/Azure/ARO-RP/pkg/util/deployment"
 	keyvault "github.com/Azure/ARO-RP/pkg/util/keyvault"
 	refreshable "github.com/Azure/ARO-RP/pkg/util/refreshable"
+	secretstore "github.com/Azure/ARO-RP/pkg/util/secretstore"
 )
 
 // MockInterface is a mock of Interface interface
@@ -100,6 +103,20 @@ func (mr *MockInterfaceMockRecorder) AdminClientAuthorizer() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminClientAuthorizer", reflect.TypeOf((*MockInterface)(nil).AdminClientAuthorizer))
 }
 
+// AdminPolicyAuthorizer mocks base method
+func (m *MockInterface) AdminPolicyAuthorizer() adminpolicy.Authorizer {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdminPolicyAuthorizer")
+	ret0, _ := ret[0].(adminpolicy.Authorizer)
+	return ret0
+}
+
+// AdminPolicyAuthorizer indicates an expected call of AdminPolicyAuthorizer
+func (mr *MockInterfaceMockRecorder) AdminPolicyAuthorizer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminPolicyAuthorizer", reflect.TypeOf((*MockInterface)(nil).AdminPolicyAuthorizer))
+}
+
 // ArmClientAuthorizer mocks base method
 func (m *MockInterface) ArmClientAuthorizer() clientauthorizer.ClientAuthorizer {
 	m.ctrl.T.Helper()
@@ -214,6 +231,20 @@ func (mr *MockInterfaceMockRecorder) DialContext(arg0, arg1, arg2 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DialContext", reflect.TypeOf((*MockInterface)(nil).DialContext), arg0, arg1, arg2)
 }
 
+// DisableSignedCertificates mocks base method
+func (m *MockInterface) DisableSignedCertificates() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableSignedCertificates")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// DisableSignedCertificates indicates an expected call of DisableSignedCertificates
+func (mr *MockInterfaceMockRecorder) DisableSignedCertificates() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableSignedCertificates", reflect.TypeOf((*MockInterface)(nil).DisableSignedCertificates))
+}
+
 // Domain mocks base method
 func (m *MockInterface) Domain() string {
 	m.ctrl.T.Helper()
@@ -257,6 +288,20 @@ func (mr *MockInterfaceMockRecorder) FPAuthorizer(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FPAuthorizer", reflect.TypeOf((*MockInterface)(nil).FPAuthorizer), arg0, arg1)
 }
 
+// FakeFirstParty mocks base method
+func (m *MockInterface) FakeFirstParty() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FakeFirstParty")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// FakeFirstParty indicates an expected call of FakeFirstParty
+func (mr *MockInterfaceMockRecorder) FakeFirstParty() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FakeFirstParty", reflect.TypeOf((*MockInterface)(nil).FakeFirstParty))
+}
+
 // InitializeAuthorizers mocks base method
 func (m *MockInterface) InitializeAuthorizers() error {
 	m.ctrl.T.Helper()
@@ -271,6 +316,46 @@ func (mr *MockInterfaceMockRecorder) InitializeAuthorizers() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitializeAuthorizers", reflect.TypeOf((*MockInterface)(nil).InitializeAuthorizers))
 }
 
+// InitializeSecretStoreMetrics mocks base method
+func (m *MockInterface) InitializeSecretStoreMetrics(arg0 metrics.Interface) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "InitializeSecretStoreMetrics", arg0)
+}
+
+// InitializeSecretStoreMetrics indicates an expected call of InitializeSecretStoreMetrics
+func (mr *MockInterfaceMockRecorder) InitializeSecretStoreMetrics(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitializeSecretStoreMetrics", reflect.TypeOf((*MockInterface)(nil).InitializeSecretStoreMetrics), arg0)
+}
+
+// InventoryURL mocks base method
+func (m *MockInterface) InventoryURL() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InventoryURL")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// InventoryURL indicates an expected call of InventoryURL
+func (mr *MockInterfaceMockRecorder) InventoryURL() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InventoryURL", reflect.TypeOf((*MockInterface)(nil).InventoryURL))
+}
+
+// IsLocalDevelopment mocks base method
+func (m *MockInterface) IsLocalDevelopment() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsLocalDevelopment")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsLocalDevelopment indicates an expected call of IsLocalDevelopment
+func (mr *MockInterfaceMockRecorder) IsLocalDevelopment() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsLocalDevelopment", reflect.TypeOf((*MockInterface)(nil).IsLocalDevelopment))
+}
+
 // Listen mocks base method
 func (m *MockInterface) Listen() (net.Listener, error) {
 	m.ctrl.T.Helper()
@@ -315,6 +400,20 @@ func (mr *MockInterfaceMockRecorder) NewRPAuthorizer(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRPAuthorizer", reflect.TypeOf((*MockInterface)(nil).NewRPAuthorizer), arg0)
 }
 
+// RelaxedVMSizeValidation mocks base method
+func (m *MockInterface) RelaxedVMSizeValidation() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelaxedVMSizeValidation")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// RelaxedVMSizeValidation indicates an expected call of RelaxedVMSizeValidation
+func (mr *MockInterfaceMockRecorder) RelaxedVMSizeValidation() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelaxedVMSizeValidation", reflect.TypeOf((*MockInterface)(nil).RelaxedVMSizeValidation))
+}
+
 // ResourceGroup mocks base method
 func (m *MockInterface) ResourceGroup() string {
 	m.ctrl.T.Helper()
@@ -329,18 +428,18 @@ func (mr *MockInterfaceMockRecorder) ResourceGroup() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResourceGroup", reflect.TypeOf((*MockInterface)(nil).ResourceGroup))
 }
 
-// ServiceKeyvault mocks base method
-func (m *MockInterface) ServiceKeyvault() keyvault.Manager {
+// ServiceSecrets mocks base method
+func (m *MockInterface) ServiceSecrets() secretstore.Store {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ServiceKeyvault")
-	ret0, _ := ret[0].(keyvault.Manager)
+	ret := m.ctrl.Call(m, "ServiceSecrets")
+	ret0, _ := ret[0].(secretstore.Store)
 	return ret0
 }
 
-// ServiceKeyvault indicates an expected call of ServiceKeyvault
-func (mr *MockInterfaceMockRecorder) ServiceKeyvault() *gomock.Call {
+// ServiceSecrets indicates an expected call of ServiceSecrets
+func (mr *MockInterfaceMockRecorder) ServiceSecrets() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceKeyvault", reflect.TypeOf((*MockInterface)(nil).ServiceKeyvault))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceSecrets", reflect.TypeOf((*MockInterface)(nil).ServiceSecrets))
 }
 
 // SubscriptionID mocks base method
@@ -371,6 +470,21 @@ func (mr *MockInterfaceMockRecorder) TenantID() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TenantID", reflect.TypeOf((*MockInterface)(nil).TenantID))
 }
 
+// TrustedLaunchAvailable mocks base method
+func (m *MockInterface) TrustedLaunchAvailable(arg0 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrustedLaunchAvailable", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TrustedLaunchAvailable indicates an expected call of TrustedLaunchAvailable
+func (mr *MockInterfaceMockRecorder) TrustedLaunchAvailable(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrustedLaunchAvailable", reflect.TypeOf((*MockInterface)(nil).TrustedLaunchAvailable), arg0)
+}
+
 // Zones mocks base method
 func (m *MockInterface) Zones(arg0 string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -7,6 +7,7 @@ package mock_workaround
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 
@@ -50,6 +51,20 @@ func (mr *MockWorkaroundMockRecorder) Ensure(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ensure", reflect.TypeOf((*MockWorkaround)(nil).Ensure), arg0)
 }
 
+// Expires mocks base method
+func (m *MockWorkaround) Expires() time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Expires")
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// Expires indicates an expected call of Expires
+func (mr *MockWorkaroundMockRecorder) Expires() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Expires", reflect.TypeOf((*MockWorkaround)(nil).Expires))
+}
+
 // IsRequired mocks base method
 func (m *MockWorkaround) IsRequired(arg0 *version.Version) bool {
 	m.ctrl.T.Helper()
@@ -5,6 +5,9 @@ package privateendpoint
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"strings"
 
 	mgmtnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-07-01/network"
 	"github.com/Azure/go-autorest/autorest"
@@ -13,14 +16,27 @@ import (
 	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/env"
 	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/network"
+	"github.com/Azure/ARO-RP/pkg/util/stringutils"
 )
 
 const prefix = "rp-pe-"
 
+// Connection statuses of the private endpoint as reported by Azure, plus
+// StatusMissing if the private endpoint does not exist at all
+const (
+	StatusApproved     = "Approved"
+	StatusPending      = "Pending"
+	StatusRejected     = "Rejected"
+	StatusDisconnected = "Disconnected"
+	StatusMissing      = "Missing"
+)
+
 type Manager interface {
 	Create(context.Context, *api.OpenShiftClusterDocument) error
 	Delete(context.Context, *api.OpenShiftClusterDocument) error
 	GetIP(context.Context, *api.OpenShiftClusterDocument) (string, error)
+	Status(context.Context, *api.OpenShiftClusterDocument) (string, error)
+	Repair(context.Context, *api.OpenShiftClusterDocument, network.PrivateLinkServicesClient) error
 }
 
 type manager struct {
@@ -70,3 +86,103 @@ func (m *manager) GetIP(ctx context.Context, doc *api.OpenShiftClusterDocument)
 
 	return *(*(*pe.PrivateEndpointProperties.NetworkInterfaces)[0].IPConfigurations)[0].PrivateIPAddress, nil
 }
+
+// Status returns the status of the connection between the private endpoint
+// and the private link service of the cluster
+func (m *manager) Status(ctx context.Context, doc *api.OpenShiftClusterDocument) (string, error) {
+	pe, err := m.privateendpoints.Get(ctx, m.env.ResourceGroup(), prefix+doc.ID, "")
+	if detailedErr, ok := err.(autorest.DetailedError); ok &&
+		detailedErr.StatusCode == http.StatusNotFound {
+		return StatusMissing, nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if pe.PrivateEndpointProperties == nil ||
+		pe.ManualPrivateLinkServiceConnections == nil ||
+		len(*pe.ManualPrivateLinkServiceConnections) == 0 {
+		return StatusMissing, nil
+	}
+
+	c := (*pe.ManualPrivateLinkServiceConnections)[0]
+	if c.PrivateLinkServiceConnectionProperties == nil ||
+		c.PrivateLinkServiceConnectionState == nil ||
+		c.PrivateLinkServiceConnectionState.Status == nil {
+		return StatusPending, nil
+	}
+
+	return *c.PrivateLinkServiceConnectionState.Status, nil
+}
+
+// Repair brings the connection between the private endpoint and the private
+// link service of the cluster back to Approved.  A missing private endpoint is
+// created, and a rejected or disconnected one recreated, since neither side can
+// reinstate such a connection.  A pending connection is approved on the private
+// link service using privateLinkServices, which must be authorized in the
+// cluster subscription.  The private endpoint IP changes if the private
+// endpoint is recreated.
+func (m *manager) Repair(ctx context.Context, doc *api.OpenShiftClusterDocument, privateLinkServices network.PrivateLinkServicesClient) error {
+	status, err := m.Status(ctx, doc)
+	if err != nil {
+		return err
+	}
+
+	switch status {
+	case StatusApproved:
+		return nil
+
+	case StatusRejected, StatusDisconnected:
+		err = m.Delete(ctx, doc)
+		if err != nil {
+			return err
+		}
+		fallthrough
+
+	case StatusMissing:
+		err = m.Create(ctx, doc)
+		if err != nil {
+			return err
+		}
+
+		// the private link service approves connections from the RP
+		// subscription itself unless its configuration has drifted too
+		status, err = m.Status(ctx, doc)
+		if err != nil || status == StatusApproved {
+			return err
+		}
+	}
+
+	return m.approve(ctx, doc, privateLinkServices)
+}
+
+func (m *manager) approve(ctx context.Context, doc *api.OpenShiftClusterDocument, privateLinkServices network.PrivateLinkServicesClient) error {
+	resourceGroup := stringutils.LastTokenByte(doc.OpenShiftCluster.Properties.ClusterProfile.ResourceGroupID, '/')
+	serviceName := doc.OpenShiftCluster.Properties.InfraID + "-pls"
+	privateEndpointID := "/subscriptions/" + m.env.SubscriptionID() + "/resourceGroups/" + m.env.ResourceGroup() + "/providers/Microsoft.Network/privateEndpoints/" + prefix + doc.ID
+
+	pls, err := privateLinkServices.Get(ctx, resourceGroup, serviceName, "")
+	if err != nil {
+		return err
+	}
+
+	if pls.PrivateLinkServiceProperties != nil && pls.PrivateEndpointConnections != nil {
+		for _, c := range *pls.PrivateEndpointConnections {
+			if c.PrivateEndpointConnectionProperties == nil ||
+				c.PrivateEndpoint == nil || c.PrivateEndpoint.ID == nil ||
+				!strings.EqualFold(*c.PrivateEndpoint.ID, privateEndpointID) {
+				continue
+			}
+
+			c.PrivateLinkServiceConnectionState = &mgmtnetwork.PrivateLinkServiceConnectionState{
+				Status:      to.StringPtr(StatusApproved),
+				Description: to.StringPtr("Approved by the RP"),
+			}
+
+			_, err = privateLinkServices.UpdatePrivateEndpointConnection(ctx, resourceGroup, serviceName, *c.Name, c)
+			return err
+		}
+	}
+
+	return fmt.Errorf("private link service %s has no connection from private endpoint %s", serviceName, prefix+doc.ID)
+}
@@ -6,9 +6,11 @@ package privateendpoint
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"testing"
 
 	mgmtnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-07-01/network"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/golang/mock/gomock"
 
@@ -256,3 +258,254 @@ func TestGetIP(t *testing.T) {
 		})
 	}
 }
+
+func privateEndpointWithStatus(status string) mgmtnetwork.PrivateEndpoint {
+	return mgmtnetwork.PrivateEndpoint{
+		PrivateEndpointProperties: &mgmtnetwork.PrivateEndpointProperties{
+			ManualPrivateLinkServiceConnections: &[]mgmtnetwork.PrivateLinkServiceConnection{
+				{
+					PrivateLinkServiceConnectionProperties: &mgmtnetwork.PrivateLinkServiceConnectionProperties{
+						PrivateLinkServiceConnectionState: &mgmtnetwork.PrivateLinkServiceConnectionState{
+							Status: to.StringPtr(status),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestStatus(t *testing.T) {
+	ctx := context.Background()
+
+	doc := &api.OpenShiftClusterDocument{
+		ID: "id",
+	}
+
+	type test struct {
+		name       string
+		mocks      func(*test, *mock_network.MockPrivateEndpointsClient)
+		wantStatus string
+		wantErr    string
+	}
+
+	for _, tt := range []*test{
+		{
+			name: "approved",
+			mocks: func(tt *test, privateendpoints *mock_network.MockPrivateEndpointsClient) {
+				privateendpoints.EXPECT().
+					Get(ctx, "rpResourcegroup", "rp-pe-id", "").
+					Return(privateEndpointWithStatus(StatusApproved), nil)
+			},
+			wantStatus: StatusApproved,
+		},
+		{
+			name: "rejected",
+			mocks: func(tt *test, privateendpoints *mock_network.MockPrivateEndpointsClient) {
+				privateendpoints.EXPECT().
+					Get(ctx, "rpResourcegroup", "rp-pe-id", "").
+					Return(privateEndpointWithStatus(StatusRejected), nil)
+			},
+			wantStatus: StatusRejected,
+		},
+		{
+			name: "missing",
+			mocks: func(tt *test, privateendpoints *mock_network.MockPrivateEndpointsClient) {
+				privateendpoints.EXPECT().
+					Get(ctx, "rpResourcegroup", "rp-pe-id", "").
+					Return(mgmtnetwork.PrivateEndpoint{}, autorest.DetailedError{
+						StatusCode: http.StatusNotFound,
+					})
+			},
+			wantStatus: StatusMissing,
+		},
+		{
+			name: "internal error",
+			mocks: func(tt *test, privateendpoints *mock_network.MockPrivateEndpointsClient) {
+				privateendpoints.EXPECT().
+					Get(ctx, "rpResourcegroup", "rp-pe-id", "").
+					Return(mgmtnetwork.PrivateEndpoint{}, fmt.Errorf("random error"))
+			},
+			wantErr: "random error",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			env := mock_env.NewMockInterface(controller)
+			env.EXPECT().ResourceGroup().AnyTimes().Return("rpResourcegroup")
+
+			privateendpoints := mock_network.NewMockPrivateEndpointsClient(controller)
+			tt.mocks(tt, privateendpoints)
+
+			m := &manager{
+				env:              env,
+				privateendpoints: privateendpoints,
+			}
+
+			status, err := m.Status(ctx, doc)
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Error(err)
+			}
+
+			if status != tt.wantStatus {
+				t.Error(status)
+			}
+		})
+	}
+}
+
+func TestRepair(t *testing.T) {
+	ctx := context.Background()
+
+	doc := &api.OpenShiftClusterDocument{
+		ID: "id",
+		OpenShiftCluster: &api.OpenShiftCluster{
+			Location: "eastus",
+			Properties: api.OpenShiftClusterProperties{
+				InfraID: "test-1234",
+				ClusterProfile: api.ClusterProfile{
+					ResourceGroupID: "/subscriptions/subscriptionId/resourceGroups/clusterResourceGroup",
+				},
+			},
+		},
+	}
+
+	pendingConnection := mgmtnetwork.PrivateEndpointConnection{
+		Name: to.StringPtr("rp-pe-id.guid"),
+		PrivateEndpointConnectionProperties: &mgmtnetwork.PrivateEndpointConnectionProperties{
+			PrivateEndpoint: &mgmtnetwork.PrivateEndpoint{
+				ID: to.StringPtr("/subscriptions/rpSubscriptionId/resourceGroups/rpResourcegroup/providers/Microsoft.Network/privateEndpoints/rp-pe-id"),
+			},
+			PrivateLinkServiceConnectionState: &mgmtnetwork.PrivateLinkServiceConnectionState{
+				Status: to.StringPtr(StatusPending),
+			},
+		},
+	}
+
+	approvedConnection := pendingConnection
+	approvedConnection.PrivateEndpointConnectionProperties = &mgmtnetwork.PrivateEndpointConnectionProperties{
+		PrivateEndpoint: pendingConnection.PrivateEndpoint,
+		PrivateLinkServiceConnectionState: &mgmtnetwork.PrivateLinkServiceConnectionState{
+			Status:      to.StringPtr(StatusApproved),
+			Description: to.StringPtr("Approved by the RP"),
+		},
+	}
+
+	expectApproval := func(privateLinkServices *mock_network.MockPrivateLinkServicesClient) {
+		privateLinkServices.EXPECT().
+			Get(ctx, "clusterResourceGroup", "test-1234-pls", "").
+			Return(mgmtnetwork.PrivateLinkService{
+				PrivateLinkServiceProperties: &mgmtnetwork.PrivateLinkServiceProperties{
+					PrivateEndpointConnections: &[]mgmtnetwork.PrivateEndpointConnection{
+						pendingConnection,
+					},
+				},
+			}, nil)
+		privateLinkServices.EXPECT().
+			UpdatePrivateEndpointConnection(ctx, "clusterResourceGroup", "test-1234-pls", "rp-pe-id.guid", approvedConnection).
+			Return(approvedConnection, nil)
+	}
+
+	type test struct {
+		name    string
+		mocks   func(*test, *mock_network.MockPrivateEndpointsClient, *mock_network.MockPrivateLinkServicesClient)
+		wantErr string
+	}
+
+	for _, tt := range []*test{
+		{
+			name: "approved",
+			mocks: func(tt *test, privateendpoints *mock_network.MockPrivateEndpointsClient, privateLinkServices *mock_network.MockPrivateLinkServicesClient) {
+				privateendpoints.EXPECT().
+					Get(ctx, "rpResourcegroup", "rp-pe-id", "").
+					Return(privateEndpointWithStatus(StatusApproved), nil)
+			},
+		},
+		{
+			name: "pending connection is approved",
+			mocks: func(tt *test, privateendpoints *mock_network.MockPrivateEndpointsClient, privateLinkServices *mock_network.MockPrivateLinkServicesClient) {
+				privateendpoints.EXPECT().
+					Get(ctx, "rpResourcegroup", "rp-pe-id", "").
+					Return(privateEndpointWithStatus(StatusPending), nil)
+				expectApproval(privateLinkServices)
+			},
+		},
+		{
+			name: "missing private endpoint is created",
+			mocks: func(tt *test, privateendpoints *mock_network.MockPrivateEndpointsClient, privateLinkServices *mock_network.MockPrivateLinkServicesClient) {
+				gomock.InOrder(
+					privateendpoints.EXPECT().
+						Get(ctx, "rpResourcegroup", "rp-pe-id", "").
+						Return(mgmtnetwork.PrivateEndpoint{}, autorest.DetailedError{
+							StatusCode: http.StatusNotFound,
+						}),
+					privateendpoints.EXPECT().
+						CreateOrUpdateAndWait(ctx, "rpResourcegroup", "rp-pe-id", gomock.Any()).
+						Return(nil),
+					privateendpoints.EXPECT().
+						Get(ctx, "rpResourcegroup", "rp-pe-id", "").
+						Return(privateEndpointWithStatus(StatusApproved), nil),
+				)
+			},
+		},
+		{
+			name: "rejected private endpoint is recreated and approved",
+			mocks: func(tt *test, privateendpoints *mock_network.MockPrivateEndpointsClient, privateLinkServices *mock_network.MockPrivateLinkServicesClient) {
+				gomock.InOrder(
+					privateendpoints.EXPECT().
+						Get(ctx, "rpResourcegroup", "rp-pe-id", "").
+						Return(privateEndpointWithStatus(StatusRejected), nil),
+					privateendpoints.EXPECT().
+						DeleteAndWait(ctx, "rpResourcegroup", "rp-pe-id").
+						Return(nil),
+					privateendpoints.EXPECT().
+						CreateOrUpdateAndWait(ctx, "rpResourcegroup", "rp-pe-id", gomock.Any()).
+						Return(nil),
+					privateendpoints.EXPECT().
+						Get(ctx, "rpResourcegroup", "rp-pe-id", "").
+						Return(privateEndpointWithStatus(StatusPending), nil),
+				)
+				expectApproval(privateLinkServices)
+			},
+		},
+		{
+			name: "connection not found on private link service",
+			mocks: func(tt *test, privateendpoints *mock_network.MockPrivateEndpointsClient, privateLinkServices *mock_network.MockPrivateLinkServicesClient) {
+				privateendpoints.EXPECT().
+					Get(ctx, "rpResourcegroup", "rp-pe-id", "").
+					Return(privateEndpointWithStatus(StatusPending), nil)
+				privateLinkServices.EXPECT().
+					Get(ctx, "clusterResourceGroup", "test-1234-pls", "").
+					Return(mgmtnetwork.PrivateLinkService{}, nil)
+			},
+			wantErr: "private link service test-1234-pls has no connection from private endpoint rp-pe-id",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			env := mock_env.NewMockInterface(controller)
+			env.EXPECT().SubscriptionID().AnyTimes().Return("rpSubscriptionId")
+			env.EXPECT().ResourceGroup().AnyTimes().Return("rpResourcegroup")
+
+			privateendpoints := mock_network.NewMockPrivateEndpointsClient(controller)
+			privateLinkServices := mock_network.NewMockPrivateLinkServicesClient(controller)
+			tt.mocks(tt, privateendpoints, privateLinkServices)
+
+			m := &manager{
+				env:              env,
+				privateendpoints: privateendpoints,
+			}
+
+			err := m.Repair(ctx, doc, privateLinkServices)
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Error(err)
+			}
+		})
+	}
+}
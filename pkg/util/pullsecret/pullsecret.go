@@ -107,6 +107,24 @@ func RemoveKey(_ps, key string) (string, error) {
 	return string(b), err
 }
 
+// Auth returns the base64 encoded credentials of the given registry in _ps,
+// and whether there are any
+func Auth(_ps, registry string) (string, bool, error) {
+	if _ps == "" {
+		_ps = "{}"
+	}
+
+	var ps *pullSecret
+
+	err := json.Unmarshal([]byte(_ps), &ps)
+	if err != nil {
+		return "", false, err
+	}
+
+	auth, ok := ps.Auths[registry]["auth"].(string)
+	return auth, ok && auth != "", nil
+}
+
 func Validate(_ps string) error {
 	if _ps == "" {
 		_ps = "{}"
@@ -121,3 +121,52 @@ func TestSetRegistryProfiles(t *testing.T) {
 		})
 	}
 }
+
+func TestAuth(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		ps        string
+		wantAuth  string
+		wantFound bool
+		wantErr   string
+	}{
+		{
+			name:      "found",
+			ps:        `{"auths":{"arosvc.azurecr.io":{"auth":"x"},"registry.redhat.io":{"auth":"y"}}}`,
+			wantAuth:  "x",
+			wantFound: true,
+		},
+		{
+			name: "missing registry",
+			ps:   `{"auths":{"registry.redhat.io":{"auth":"y"}}}`,
+		},
+		{
+			name: "missing auth",
+			ps:   `{"auths":{"arosvc.azurecr.io":{"email":"fred@example.com"}}}`,
+		},
+		{
+			name: "empty",
+		},
+		{
+			name:    "invalid",
+			ps:      "}",
+			wantErr: "invalid character '}' looking for beginning of value",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			auth, found, err := Auth(tt.ps, "arosvc.azurecr.io")
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Fatal(err)
+			}
+
+			if auth != tt.wantAuth {
+				t.Error(auth)
+			}
+
+			if found != tt.wantFound {
+				t.Error(found)
+			}
+		})
+	}
+}
@@ -0,0 +1,7 @@
+package regions
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+//go:generate go run ../../../vendor/github.com/go-bindata/go-bindata/go-bindata -nometadata -pkg $GOPACKAGE -ignore=generate.go -ignore=bindata.go -prefix ./catalog ./catalog/...
+//go:generate gofmt -s -l -w bindata.go
@@ -0,0 +1,54 @@
+package regions
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ghodss/yaml"
+)
+
+// Region is an entry of the region catalog in catalog/regions.yaml, which is
+// the single place where the RP's knowledge of its regions is kept
+type Region struct {
+	Name    string `json:"name"`
+	Cloud   string `json:"cloud"`
+	Enabled bool   `json:"enabled"`
+	Zonal   bool   `json:"zonal"`
+}
+
+// List returns the regions of the catalog
+func List() ([]*Region, error) {
+	b, err := Asset("regions.yaml")
+	if err != nil {
+		return nil, err
+	}
+
+	var catalog struct {
+		Regions []*Region `json:"regions"`
+	}
+	err = yaml.Unmarshal(b, &catalog)
+	if err != nil {
+		return nil, err
+	}
+
+	return catalog.Regions, nil
+}
+
+// Get returns the named region, which must be in the catalog
+func Get(name string) (*Region, error) {
+	regions, err := List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range regions {
+		if strings.EqualFold(r.Name, name) {
+			return r, nil
+		}
+	}
+
+	return nil, fmt.Errorf("region %s is not in the region catalog", name)
+}
@@ -0,0 +1,53 @@
+package regions
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+func TestCatalog(t *testing.T) {
+	regions, err := List()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(regions) == 0 {
+		t.Fatal("empty catalog")
+	}
+
+	names := map[string]bool{}
+	for _, r := range regions {
+		if r.Name == "" || strings.ToLower(r.Name) != r.Name || strings.ContainsRune(r.Name, ' ') {
+			t.Errorf("invalid name %q", r.Name)
+		}
+
+		if names[r.Name] {
+			t.Errorf("duplicate region %s", r.Name)
+		}
+		names[r.Name] = true
+
+		if _, err := azure.EnvironmentFromName(r.Cloud); err != nil {
+			t.Errorf("%s: %v", r.Name, err)
+		}
+	}
+}
+
+func TestGet(t *testing.T) {
+	r, err := Get("EastUS")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Name != "eastus" || r.Cloud != azure.PublicCloud.Name || !r.Enabled || !r.Zonal {
+		t.Error(r)
+	}
+
+	_, err = Get("moon")
+	if err == nil || err.Error() != "region moon is not in the region catalog" {
+		t.Error(err)
+	}
+}
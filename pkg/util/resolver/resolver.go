@@ -0,0 +1,50 @@
+package resolver
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net"
+)
+
+// Resolver looks up hosts.  *net.Resolver implements it.
+type Resolver interface {
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// NewForServer returns a Resolver which sends its queries to the DNS server at
+// the IP address server, rather than to the servers configured on the host
+func NewForServer(server string) Resolver {
+	address := net.JoinHostPort(server, "53")
+
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, network, address)
+		},
+	}
+}
+
+// Unresolvable returns the names which r fails to resolve.  A name is
+// unresolvable if r answers that it doesn't exist or fails to answer for it.
+// If a lookup times out, r is taken to be unreachable and the error is
+// returned, as nothing can be said about the remaining names.
+func Unresolvable(ctx context.Context, r Resolver, names []string) ([]string, error) {
+	var unresolvable []string
+
+	for _, name := range names {
+		_, err := r.LookupHost(ctx, name)
+		if err == nil {
+			continue
+		}
+
+		if err, ok := err.(*net.DNSError); ok && err.IsTimeout {
+			return nil, err
+		}
+
+		unresolvable = append(unresolvable, name)
+	}
+
+	return unresolvable, nil
+}
@@ -0,0 +1,64 @@
+package resolver
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"testing"
+)
+
+type fakeResolver map[string]error
+
+func (f fakeResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	if err := f[host]; err != nil {
+		return nil, err
+	}
+
+	return []string{"1.2.3.4"}, nil
+}
+
+func TestUnresolvable(t *testing.T) {
+	names := []string{"arosvc.azurecr.io", "management.azure.com", "login.microsoftonline.com"}
+
+	for _, tt := range []struct {
+		name             string
+		r                fakeResolver
+		wantUnresolvable []string
+		wantErr          string
+	}{
+		{
+			name: "all resolved",
+			r:    fakeResolver{},
+		},
+		{
+			name: "not found and server failure",
+			r: fakeResolver{
+				"arosvc.azurecr.io":         &net.DNSError{Err: "no such host", Name: "arosvc.azurecr.io", IsNotFound: true},
+				"login.microsoftonline.com": &net.DNSError{Err: "server misbehaving", Name: "login.microsoftonline.com", IsTemporary: true},
+			},
+			wantUnresolvable: []string{"arosvc.azurecr.io", "login.microsoftonline.com"},
+		},
+		{
+			name: "timeout",
+			r: fakeResolver{
+				"management.azure.com": &net.DNSError{Err: "i/o timeout", Name: "management.azure.com", Server: "10.0.0.4:53", IsTimeout: true},
+			},
+			wantErr: "lookup management.azure.com on 10.0.0.4:53: i/o timeout",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			unresolvable, err := Unresolvable(context.Background(), tt.r, names)
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(unresolvable, tt.wantUnresolvable) {
+				t.Error(unresolvable)
+			}
+		})
+	}
+}
@@ -38,8 +38,8 @@ func (prodRPAuthorizer) NewRPAuthorizer(resource string) (autorest.Authorizer, e
 	return auth.NewAuthorizerFromEnvironmentWithResource(resource)
 }
 
-func New(deploymentMode deployment.Mode) (RPAuthorizer, error) {
-	if deploymentMode == deployment.Development {
+func New(capabilities deployment.Capabilities) (RPAuthorizer, error) {
+	if capabilities.IsLocalDevelopment() {
 		for _, key := range []string{
 			"AZURE_RP_CLIENT_ID",
 			"AZURE_RP_CLIENT_SECRET",
@@ -4,6 +4,7 @@ package scheme
 // Licensed under the Apache License 2.0.
 
 import (
+	operatorv1 "github.com/openshift/api/operator/v1"
 	securityv1 "github.com/openshift/api/security/v1"
 	machinev1beta1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
 	mcv1 "github.com/openshift/machine-config-operator/pkg/apis/machineconfiguration.openshift.io/v1"
@@ -22,4 +23,5 @@ func init() {
 	runtime.Must(azureproviderv1beta1.SchemeBuilder.AddToScheme(scheme.Scheme))
 	runtime.Must(mcv1.AddToScheme(scheme.Scheme))
 	runtime.Must(machinev1beta1.SchemeBuilder.AddToScheme(scheme.Scheme))
+	runtime.Must(operatorv1.AddToScheme(scheme.Scheme))
 }
@@ -0,0 +1,41 @@
+package secretstore
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/Azure/ARO-RP/pkg/util/keyvault"
+)
+
+// BackendKeyvault stores secrets in an Azure key vault
+const BackendKeyvault = "keyvault"
+
+type keyvaultBackend struct {
+	kv keyvault.Manager
+}
+
+// NewKeyvaultBackend returns a Backend which reads secrets from the key vault
+// managed by kv
+func NewKeyvaultBackend(kv keyvault.Manager) Backend {
+	return &keyvaultBackend{
+		kv: kv,
+	}
+}
+
+func (b *keyvaultBackend) GetSecret(ctx context.Context, name string) ([]byte, error) {
+	bundle, err := b.kv.GetSecret(ctx, name)
+	if detailedErr, ok := err.(autorest.DetailedError); ok &&
+		detailedErr.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(*bundle.Value), nil
+}
@@ -0,0 +1,149 @@
+package secretstore
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/metrics"
+	"github.com/Azure/ARO-RP/pkg/util/pem"
+)
+
+// ErrNotFound is returned when a secret does not exist in the backend
+var ErrNotFound = errors.New("secret not found")
+
+// Store is a read-only store of the RP's own secrets: the certificates and
+// encryption keys which it loads at startup.
+type Store interface {
+	GetBase64Secret(context.Context, string) ([]byte, error)
+	GetCertificateSecret(context.Context, string) (*rsa.PrivateKey, []*x509.Certificate, error)
+	GetSecret(context.Context, string) ([]byte, error)
+}
+
+// Backend retrieves the raw value of a secret from a secret service.
+// Implementations return ErrNotFound if the secret does not exist.
+type Backend interface {
+	GetSecret(context.Context, string) ([]byte, error)
+}
+
+type entry struct {
+	value   []byte
+	expires time.Time
+}
+
+type store struct {
+	backendName string
+	backend     Backend
+	m           metrics.Interface
+	ttl         time.Duration
+	now         func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]entry
+}
+
+// New returns a Store which reads secrets from backend.  Secrets are cached
+// for ttl; a ttl of zero disables caching.  Each read from the backend is
+// reported to m, with backendName as the backend dimension.
+func New(backendName string, backend Backend, m metrics.Interface, ttl time.Duration) Store {
+	return &store{
+		backendName: backendName,
+		backend:     backend,
+		m:           m,
+		ttl:         ttl,
+		now:         time.Now,
+
+		cache: map[string]entry{},
+	}
+}
+
+func (s *store) GetBase64Secret(ctx context.Context, name string) ([]byte, error) {
+	value, err := s.GetSecret(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return base64.StdEncoding.DecodeString(string(value))
+}
+
+func (s *store) GetCertificateSecret(ctx context.Context, name string) (*rsa.PrivateKey, []*x509.Certificate, error) {
+	value, err := s.GetSecret(ctx, name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key, certs, err := pem.Parse(value)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if key == nil {
+		return nil, nil, fmt.Errorf("no private key found")
+	}
+
+	if len(certs) == 0 {
+		return nil, nil, fmt.Errorf("no certificate found")
+	}
+
+	return key, certs, nil
+}
+
+func (s *store) GetSecret(ctx context.Context, name string) ([]byte, error) {
+	s.mu.Lock()
+	e, found := s.cache[name]
+	s.mu.Unlock()
+
+	if found && s.now().Before(e.expires) {
+		s.emit("cached", 0)
+		return e.value, nil
+	}
+
+	start := s.now()
+	value, err := s.backend.GetSecret(ctx, name)
+	duration := s.now().Sub(start)
+
+	switch {
+	case err == ErrNotFound:
+		s.emit("notfound", duration)
+	case err != nil:
+		s.emit("error", duration)
+	default:
+		s.emit("success", duration)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if s.ttl > 0 {
+		s.mu.Lock()
+		s.cache[name] = entry{
+			value:   value,
+			expires: s.now().Add(s.ttl),
+		}
+		s.mu.Unlock()
+	}
+
+	return value, nil
+}
+
+func (s *store) emit(result string, duration time.Duration) {
+	dims := map[string]string{
+		"backend": s.backendName,
+		"result":  result,
+	}
+
+	s.m.EmitGauge("secretstore.count", 1, dims)
+
+	if result != "cached" {
+		s.m.EmitGauge("secretstore.duration", duration.Milliseconds(), dims)
+	}
+}
@@ -0,0 +1,238 @@
+package secretstore
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	mock_metrics "github.com/Azure/ARO-RP/pkg/util/mocks/metrics"
+	utiltls "github.com/Azure/ARO-RP/pkg/util/tls"
+)
+
+type fakeBackend struct {
+	secrets map[string][]byte
+	err     error
+	calls   int
+}
+
+func (b *fakeBackend) GetSecret(ctx context.Context, name string) ([]byte, error) {
+	b.calls++
+
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	value, found := b.secrets[name]
+	if !found {
+		return nil, ErrNotFound
+	}
+
+	return value, nil
+}
+
+func TestGetSecret(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name       string
+		backendErr error
+		secret     string
+		ttl        time.Duration
+		gets       int
+		wantCalls  int
+		wantResult []string
+		wantErr    error
+	}{
+		{
+			name:       "cached",
+			secret:     "secret",
+			ttl:        time.Minute,
+			gets:       2,
+			wantCalls:  1,
+			wantResult: []string{"success", "cached"},
+		},
+		{
+			name:       "caching disabled",
+			secret:     "secret",
+			gets:       2,
+			wantCalls:  2,
+			wantResult: []string{"success", "success"},
+		},
+		{
+			name:       "not found is not cached",
+			secret:     "missing",
+			ttl:        time.Minute,
+			gets:       2,
+			wantCalls:  2,
+			wantResult: []string{"notfound", "notfound"},
+			wantErr:    ErrNotFound,
+		},
+		{
+			name:       "backend error",
+			backendErr: errors.New("random error"),
+			secret:     "secret",
+			ttl:        time.Minute,
+			gets:       1,
+			wantCalls:  1,
+			wantResult: []string{"error"},
+			wantErr:    errors.New("random error"),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			m := mock_metrics.NewMockInterface(controller)
+
+			backend := &fakeBackend{
+				secrets: map[string][]byte{
+					"secret": []byte("value"),
+				},
+				err: tt.backendErr,
+			}
+
+			s := New("fake", backend, m, tt.ttl)
+
+			for _, result := range tt.wantResult {
+				dims := map[string]string{
+					"backend": "fake",
+					"result":  result,
+				}
+				m.EXPECT().EmitGauge("secretstore.count", int64(1), dims)
+				if result != "cached" {
+					m.EXPECT().EmitGauge("secretstore.duration", gomock.Any(), dims)
+				}
+			}
+
+			for i := 0; i < tt.gets; i++ {
+				value, err := s.GetSecret(ctx, tt.secret)
+				if tt.wantErr != nil {
+					if err == nil || err.Error() != tt.wantErr.Error() {
+						t.Fatal(err)
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				if string(value) != "value" {
+					t.Error(string(value))
+				}
+			}
+
+			if backend.calls != tt.wantCalls {
+				t.Error(backend.calls)
+			}
+		})
+	}
+}
+
+func TestGetSecretExpiry(t *testing.T) {
+	ctx := context.Background()
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	m := mock_metrics.NewMockInterface(controller)
+	m.EXPECT().EmitGauge(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	backend := &fakeBackend{
+		secrets: map[string][]byte{
+			"secret": []byte("value"),
+		},
+	}
+
+	now := time.Now()
+
+	s := New("fake", backend, m, time.Minute).(*store)
+	s.now = func() time.Time { return now }
+
+	for _, offset := range []time.Duration{0, 30 * time.Second, 2 * time.Minute} {
+		now = now.Add(offset)
+
+		_, err := s.GetSecret(ctx, "secret")
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if backend.calls != 2 {
+		t.Error(backend.calls)
+	}
+}
+
+func TestGetCertificateSecret(t *testing.T) {
+	ctx := context.Background()
+
+	key, certs, err := utiltls.GenerateKeyAndCertificate("test", nil, nil, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf := &bytes.Buffer{}
+	err = pem.Encode(buf, &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyOnly := append([]byte{}, buf.Bytes()...)
+
+	err = pem.Encode(buf, &pem.Block{Type: "CERTIFICATE", Bytes: certs[0].Raw})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		name    string
+		value   []byte
+		wantErr string
+	}{
+		{
+			name:  "valid",
+			value: buf.Bytes(),
+		},
+		{
+			name:    "no certificate",
+			value:   keyOnly,
+			wantErr: "no certificate found",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			m := mock_metrics.NewMockInterface(controller)
+			m.EXPECT().EmitGauge(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+			s := New("fake", &fakeBackend{
+				secrets: map[string][]byte{
+					"cert": tt.value,
+				},
+			}, m, 0)
+
+			gotKey, gotCerts, err := s.GetCertificateSecret(ctx, "cert")
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Fatal(err)
+			}
+			if tt.wantErr != "" {
+				return
+			}
+
+			if !gotKey.Equal(key) {
+				t.Error("key mismatch")
+			}
+			if len(gotCerts) != 1 || !gotCerts[0].Equal(certs[0]) {
+				t.Error(gotCerts)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package steps
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+// hookFunction is a function that takes a context and returns an error
+// describing how the invariant which it checks is broken.
+//
+// Suitable for checking that the inputs of a step are sane, or that a step
+// achieved what it should have.
+type hookFunction func(context.Context) error
+
+type hookKind string
+
+const (
+	hookKindPrecondition  hookKind = "precondition"
+	hookKindPostcondition hookKind = "postcondition"
+)
+
+// Hook is an invariant check which a step wrapped by Hooked runs before or
+// after itself.
+type Hook struct {
+	kind hookKind
+	f    hookFunction
+}
+
+// Precondition returns a Hook which checks `f` before the step runs.  A
+// failing precondition fails the step without running it.
+func Precondition(f hookFunction) Hook {
+	return Hook{kind: hookKindPrecondition, f: f}
+}
+
+// Postcondition returns a Hook which checks `f` after the step succeeds.  A
+// failing postcondition fails the step.
+func Postcondition(f hookFunction) Hook {
+	return Hook{kind: hookKindPostcondition, f: f}
+}
+
+// HookError is returned by a step wrapped by Hooked when one of its hooks
+// fails.  It names the broken invariant, so that it is reported where it is
+// detected rather than as a confusing error from a later step.
+type HookError struct {
+	Step string
+	Kind string
+	Hook string
+	Err  error
+}
+
+func (e *HookError) Error() string {
+	return fmt.Sprintf("%s %s of step %s failed: %s", e.Kind, e.Hook, e.Step, e.Err)
+}
+
+func (e *HookError) Unwrap() error {
+	return e.Err
+}
+
+// Hooked returns a wrapper Step which checks the preconditions among `hooks`
+// before running `step`, and the postconditions after it succeeds, in the
+// order in which they are given.  Errors from `step` are returned directly.
+// A failing hook's error is returned in a HookError, unless it is a
+// CloudError, which is returned directly so that it reaches the customer.
+func Hooked(step Step, hooks ...Hook) hookedStep {
+	return hookedStep{
+		step:  step,
+		hooks: hooks,
+	}
+}
+
+type hookedStep struct {
+	step  Step
+	hooks []Hook
+}
+
+func (s hookedStep) run(ctx context.Context, log *logrus.Entry) error {
+	err := s.check(ctx, log, hookKindPrecondition)
+	if err != nil {
+		return err
+	}
+
+	err = s.step.run(ctx, log)
+	if err != nil {
+		return err
+	}
+
+	return s.check(ctx, log, hookKindPostcondition)
+}
+
+func (s hookedStep) check(ctx context.Context, log *logrus.Entry, kind hookKind) error {
+	for _, h := range s.hooks {
+		if h.kind != kind {
+			continue
+		}
+
+		err := h.f(ctx)
+		if err == nil {
+			continue
+		}
+
+		if _, ok := err.(*api.CloudError); ok {
+			// the error doesn't name the hook, so log it here
+			log.Errorf("%s %s of step %s failed", kind, friendlyName(h.f), s.step)
+			return err
+		}
+
+		return &HookError{
+			Step: s.step.String(),
+			Kind: string(kind),
+			Hook: friendlyName(h.f),
+			Err:  err,
+		}
+	}
+
+	return nil
+}
+
+// String returns the name of the wrapped step: the hooks are checks of the
+// step rather than steps in their own right, so ARM calls, progress and
+// failures are attributed to the wrapped step
+func (s hookedStep) String() string {
+	return s.step.String()
+}
@@ -0,0 +1,117 @@
+package steps
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	testlog "github.com/Azure/ARO-RP/test/util/log"
+)
+
+func TestHooked(t *testing.T) {
+	var ran []string
+	record := func(name string, err error) func(context.Context) error {
+		return func(context.Context) error {
+			ran = append(ran, name)
+			return err
+		}
+	}
+
+	cloudErr := api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidServicePrincipalCredentials, "", "The provided service principal credentials are invalid.")
+
+	for _, tt := range []struct {
+		name      string
+		step      Step
+		wantRan   []string
+		wantErr   string
+		wantHook  string
+		wantCloud bool
+	}{
+		{
+			name: "hooks run in order around the step",
+			step: Hooked(Action(record("step", nil)),
+				Postcondition(record("post", nil)),
+				Precondition(record("pre1", nil)),
+				Precondition(record("pre2", nil)),
+			),
+			wantRan: []string{"pre1", "pre2", "step", "post"},
+		},
+		{
+			name: "failing precondition stops the step",
+			step: Hooked(Action(record("step", nil)),
+				Precondition(record("pre", errors.New("no infraID"))),
+				Postcondition(record("post", nil)),
+			),
+			wantRan:  []string{"pre"},
+			wantErr:  `^precondition github\.com/Azure/ARO-RP/pkg/util/steps\.TestHooked\.\S+ of step \[Action github\.com/Azure/ARO-RP/pkg/util/steps\.TestHooked\.\S+\] failed: no infraID$`,
+			wantHook: "precondition",
+		},
+		{
+			name: "failing step skips the postconditions",
+			step: Hooked(Action(record("step", errors.New("oh no!"))),
+				Postcondition(record("post", nil)),
+			),
+			wantRan: []string{"step"},
+			wantErr: "^oh no!$",
+		},
+		{
+			name: "failing postcondition fails the step",
+			step: Hooked(Action(record("step", nil)),
+				Postcondition(record("post", errors.New("load balancer not found"))),
+			),
+			wantRan:  []string{"step", "post"},
+			wantErr:  `^postcondition github\.com/Azure/ARO-RP/pkg/util/steps\.TestHooked\.\S+ of step \[Action github\.com/Azure/ARO-RP/pkg/util/steps\.TestHooked\.\S+\] failed: load balancer not found$`,
+			wantHook: "postcondition",
+		},
+		{
+			name: "CloudError from a hook is returned directly",
+			step: Hooked(Action(record("step", nil)),
+				Precondition(record("pre", cloudErr)),
+			),
+			wantRan:   []string{"pre"},
+			wantErr:   regexp.QuoteMeta(cloudErr.Error()),
+			wantCloud: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ran = nil
+			_, log := testlog.New()
+
+			err := Run(context.Background(), log, 25*time.Millisecond, []Step{tt.step})
+			if err != nil && !regexp.MustCompile(tt.wantErr).MatchString(err.Error()) ||
+				err == nil && tt.wantErr != "" {
+				t.Error(err)
+			}
+
+			if !reflect.DeepEqual(ran, tt.wantRan) {
+				t.Error(ran)
+			}
+
+			var hookErr *HookError
+			if errors.As(err, &hookErr) != (tt.wantHook != "") ||
+				hookErr != nil && hookErr.Kind != tt.wantHook {
+				t.Error(err)
+			}
+
+			if _, ok := err.(*api.CloudError); ok != tt.wantCloud {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestHookedString(t *testing.T) {
+	s := Hooked(Action(successfulFunc), Precondition(failingFunc))
+
+	if s.String() != "[Action github.com/Azure/ARO-RP/pkg/util/steps.successfulFunc]" {
+		t.Error(s.String())
+	}
+}
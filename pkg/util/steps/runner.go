@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
 )
 
 // friendlyName returns a "friendly" stringified name of the given func.
@@ -17,6 +19,46 @@ func friendlyName(f interface{}) string {
 	return runtime.FuncForPC(reflect.ValueOf(f).Pointer()).Name()
 }
 
+type failedStepContextKey struct{}
+
+// WithFailedStep returns a context in which Run records the name of the step
+// which fails, for FailedStep to return
+func WithFailedStep(ctx context.Context) context.Context {
+	return context.WithValue(ctx, failedStepContextKey{}, new(string))
+}
+
+// FailedStep returns the name of the step which failed in a Run with ctx, or
+// "" if none did or ctx was not returned by WithFailedStep
+func FailedStep(ctx context.Context) string {
+	if step, ok := ctx.Value(failedStepContextKey{}).(*string); ok {
+		return *step
+	}
+	return ""
+}
+
+type progressContextKey struct{}
+
+// ProgressFunc is called by Run before each step with the number of steps
+// which have completed so far
+type ProgressFunc func(ctx context.Context, step Step, completed int)
+
+// WithProgress returns a context in which Run reports its progress to f
+func WithProgress(ctx context.Context, f ProgressFunc) context.Context {
+	return context.WithValue(ctx, progressContextKey{}, f)
+}
+
+type timingContextKey struct{}
+
+// TimingFunc is called by Run after each step with the time which the step
+// took and the error which it returned, if any
+type TimingFunc func(ctx context.Context, step Step, duration time.Duration, err error)
+
+// WithTiming returns a context in which Run reports the duration of each step
+// to f
+func WithTiming(ctx context.Context, f TimingFunc) context.Context {
+	return context.WithValue(ctx, timingContextKey{}, f)
+}
+
 // Step is the interface for steps that Runner can execute.
 type Step interface {
 	run(ctx context.Context, log *logrus.Entry) error
@@ -24,14 +66,30 @@ type Step interface {
 }
 
 // Run executes the provided steps in order until one fails or all steps
-// are completed. Errors from failed steps are returned directly.
+// are completed. Errors from failed steps are returned directly.  ARM calls
+// made by a step are attributed to it.
 func Run(ctx context.Context, log *logrus.Entry, pollInterval time.Duration, steps []Step) error {
-	for _, step := range steps {
+	progress, _ := ctx.Value(progressContextKey{}).(ProgressFunc)
+	timing, _ := ctx.Value(timingContextKey{}).(TimingFunc)
+
+	for i, step := range steps {
+		if progress != nil {
+			progress(ctx, step, i)
+		}
+
 		log.Infof("running step %s", step)
-		err := step.run(ctx, log)
+		start := time.Now()
+		err := step.run(azureclient.WithStep(ctx, step.String()), log)
+
+		if timing != nil {
+			timing(ctx, step, time.Since(start), err)
+		}
 
 		if err != nil {
 			log.Errorf("step %s encountered error: %s", step, err.Error())
+			if failedStep, ok := ctx.Value(failedStepContextKey{}).(*string); ok {
+				*failedStep = step.String()
+			}
 			return err
 		}
 	}
@@ -6,6 +6,8 @@ package steps
 import (
 	"context"
 	"errors"
+	"fmt"
+	"reflect"
 	"testing"
 	"time"
 
@@ -312,3 +314,69 @@ func TestStepRunner(t *testing.T) {
 		})
 	}
 }
+
+func TestFailedStep(t *testing.T) {
+	_, log := testlog.New()
+
+	ctx := WithFailedStep(context.Background())
+
+	err := Run(ctx, log, 25*time.Millisecond, []Step{Action(successfulFunc), Action(failingFunc), Action(successfulFunc)})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if step := FailedStep(ctx); step != "[Action github.com/Azure/ARO-RP/pkg/util/steps.failingFunc]" {
+		t.Error(step)
+	}
+
+	if step := FailedStep(context.Background()); step != "" {
+		t.Error(step)
+	}
+}
+
+func TestProgress(t *testing.T) {
+	_, log := testlog.New()
+
+	var got []string
+	ctx := WithProgress(context.Background(), func(ctx context.Context, step Step, completed int) {
+		got = append(got, fmt.Sprintf("%d %s", completed, step))
+	})
+
+	err := Run(ctx, log, 25*time.Millisecond, []Step{Action(successfulFunc), Action(failingFunc), Action(successfulFunc)})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	want := []string{
+		"0 [Action github.com/Azure/ARO-RP/pkg/util/steps.successfulFunc]",
+		"1 [Action github.com/Azure/ARO-RP/pkg/util/steps.failingFunc]",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Error(got)
+	}
+}
+
+func TestTiming(t *testing.T) {
+	_, log := testlog.New()
+
+	var got []string
+	ctx := WithTiming(context.Background(), func(ctx context.Context, step Step, duration time.Duration, err error) {
+		if duration < 0 {
+			t.Error(duration)
+		}
+		got = append(got, fmt.Sprintf("%s %v", step, err))
+	})
+
+	err := Run(ctx, log, 25*time.Millisecond, []Step{Action(successfulFunc), Action(failingFunc), Action(successfulFunc)})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	want := []string{
+		"[Action github.com/Azure/ARO-RP/pkg/util/steps.successfulFunc] <nil>",
+		"[Action github.com/Azure/ARO-RP/pkg/util/steps.failingFunc] oh no!",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Error(got)
+	}
+}
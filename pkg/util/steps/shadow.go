@@ -0,0 +1,107 @@
+package steps
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+)
+
+// A new step which changes clusters can be rolled out in shadow mode first:
+// wrapped by Shadowed, it runs everywhere but must not change anything while
+// it is in shadow mode, and records the changes which it would have made
+// instead.  Once these have been reviewed across the fleet, the step is made
+// live by listing it in the LiveFunc of the context.
+//
+// A step function which supports shadow mode guards each change:
+//
+//	if steps.ShadowMode(ctx) {
+//		steps.RecordChange(ctx, "would delete machine %s", name)
+//		continue
+//	}
+
+type liveContextKey struct{}
+
+// LiveFunc returns true if a step wrapped by Shadowed should run for real
+type LiveFunc func(step Step) bool
+
+// WithLive returns a context in which Run runs the steps wrapped by Shadowed
+// for which f returns true for real.  All other such steps run in shadow mode.
+func WithLive(ctx context.Context, f LiveFunc) context.Context {
+	return context.WithValue(ctx, liveContextKey{}, f)
+}
+
+type shadowReportContextKey struct{}
+
+// ShadowReportFunc is called by a step wrapped by Shadowed after running in
+// shadow mode with the changes which the step recorded and the error which
+// it returned, if any
+type ShadowReportFunc func(ctx context.Context, step Step, changes []string, err error)
+
+// WithShadowReport returns a context in which steps run in shadow mode report
+// to f
+func WithShadowReport(ctx context.Context, f ShadowReportFunc) context.Context {
+	return context.WithValue(ctx, shadowReportContextKey{}, f)
+}
+
+type shadowContextKey struct{}
+
+// ShadowMode returns true if the step running with ctx is in shadow mode and
+// so must not change anything
+func ShadowMode(ctx context.Context) bool {
+	_, ok := ctx.Value(shadowContextKey{}).(*[]string)
+	return ok
+}
+
+// RecordChange records a change which the step running with ctx would have
+// made, if it is in shadow mode
+func RecordChange(ctx context.Context, format string, args ...interface{}) {
+	if changes, ok := ctx.Value(shadowContextKey{}).(*[]string); ok {
+		*changes = append(*changes, fmt.Sprintf(format, args...))
+	}
+}
+
+// Shadowed returns a wrapper Step which runs `step` in shadow mode, unless the
+// LiveFunc of the context makes it live.  In shadow mode the changes which
+// `step` records are logged and reported, and its errors are logged but not
+// returned, so that a new step can't fail operations before it is live.
+func Shadowed(step Step) shadowedStep {
+	return shadowedStep{
+		step: step,
+	}
+}
+
+type shadowedStep struct {
+	step Step
+}
+
+func (s shadowedStep) run(ctx context.Context, log *logrus.Entry) error {
+	if live, ok := ctx.Value(liveContextKey{}).(LiveFunc); ok && live(s.step) {
+		return s.step.run(ctx, log)
+	}
+
+	changes := []string{}
+	err := s.step.run(context.WithValue(ctx, shadowContextKey{}, &changes), log)
+
+	for _, change := range changes {
+		log.Infof("step %s in shadow mode: %s", s.step, change)
+	}
+	if err != nil {
+		log.Warnf("step %s in shadow mode encountered error: %s", s.step, err)
+	}
+
+	if report, ok := ctx.Value(shadowReportContextKey{}).(ShadowReportFunc); ok {
+		report(ctx, s.step, changes, err)
+	}
+
+	return nil
+}
+
+// String returns the name of the wrapped step, so that it is attributed the
+// same way in shadow mode and once live
+func (s shadowedStep) String() string {
+	return s.step.String()
+}
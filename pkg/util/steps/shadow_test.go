@@ -0,0 +1,120 @@
+package steps
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	testlog "github.com/Azure/ARO-RP/test/util/log"
+)
+
+func TestShadowed(t *testing.T) {
+	var deleted []string
+	deleteMachines := func(ctx context.Context) error {
+		for _, name := range []string{"machine-0", "machine-1"} {
+			if ShadowMode(ctx) {
+				RecordChange(ctx, "would delete %s", name)
+				continue
+			}
+			deleted = append(deleted, name)
+		}
+		return nil
+	}
+
+	failing := func(ctx context.Context) error {
+		RecordChange(ctx, "would fail")
+		return errors.New("oh no!")
+	}
+
+	for _, tt := range []struct {
+		name        string
+		step        func(context.Context) error
+		live        bool
+		wantErr     string
+		wantDeleted []string
+		wantReports []string
+	}{
+		{
+			name:        "shadow mode records changes",
+			step:        deleteMachines,
+			wantReports: []string{"[would delete machine-0 would delete machine-1] <nil>"},
+		},
+		{
+			name:        "live step makes changes",
+			step:        deleteMachines,
+			live:        true,
+			wantDeleted: []string{"machine-0", "machine-1"},
+		},
+		{
+			name:        "shadow mode errors are not returned",
+			step:        failing,
+			wantReports: []string{"[would fail] oh no!"},
+		},
+		{
+			name:    "live step errors are returned",
+			step:    failing,
+			live:    true,
+			wantErr: "oh no!",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, log := testlog.New()
+
+			deleted = nil
+			var reports []string
+
+			ctx := WithLive(context.Background(), func(step Step) bool {
+				if !strings.Contains(step.String(), "TestShadowed") {
+					t.Error(step)
+				}
+				return tt.live
+			})
+			ctx = WithShadowReport(ctx, func(ctx context.Context, step Step, changes []string, err error) {
+				reports = append(reports, fmt.Sprintf("%v %v", changes, err))
+			})
+
+			err := Run(ctx, log, time.Millisecond, []Step{Shadowed(Action(tt.step))})
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Error(err)
+			}
+
+			if !reflect.DeepEqual(deleted, tt.wantDeleted) {
+				t.Error(deleted)
+			}
+			if !reflect.DeepEqual(reports, tt.wantReports) {
+				t.Error(reports)
+			}
+		})
+	}
+}
+
+func TestShadowedDefaultsToShadowMode(t *testing.T) {
+	_, log := testlog.New()
+
+	var shadow bool
+	err := Run(context.Background(), log, time.Millisecond, []Step{
+		Shadowed(Action(func(ctx context.Context) error {
+			shadow = ShadowMode(ctx)
+			return nil
+		})),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !shadow {
+		t.Error("step was live")
+	}
+
+	if ShadowMode(context.Background()) {
+		t.Error("unwrapped step is in shadow mode")
+	}
+}
@@ -0,0 +1,114 @@
+package trustedlaunch
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"encoding/json"
+)
+
+const (
+	// SecurityTypeTrustedLaunch is the security type of VMs which boot with
+	// secure boot and a virtual TPM
+	SecurityTypeTrustedLaunch = "TrustedLaunch"
+
+	// Enabled is the value of an enabled UEFI setting
+	Enabled = "Enabled"
+
+	// BootImageSKUSuffix is appended to the SKU of an ARO boot image to get
+	// its Gen2 variant, which trusted launch VMs require
+	BootImageSKUSuffix = "_gen2"
+)
+
+// SecurityProfile is the securityProfile field of an Azure machine provider
+// spec.  The vendored AzureMachineProviderSpec predates trusted launch, so
+// this is read from and written to the raw provider spec.
+type SecurityProfile struct {
+	Settings SecuritySettings `json:"settings"`
+}
+
+// SecuritySettings holds the security type of a machine
+type SecuritySettings struct {
+	SecurityType  string         `json:"securityType,omitempty"`
+	TrustedLaunch *TrustedLaunch `json:"trustedLaunch,omitempty"`
+}
+
+// TrustedLaunch holds the settings of a trusted launch machine
+type TrustedLaunch struct {
+	UEFISettings UEFISettings `json:"uefiSettings"`
+}
+
+// UEFISettings holds the UEFI settings of a trusted launch machine
+type UEFISettings struct {
+	SecureBoot                       string `json:"secureBoot,omitempty"`
+	VirtualizedTrustedPlatformModule string `json:"virtualizedTrustedPlatformModule,omitempty"`
+}
+
+// NewSecurityProfile returns the security profile of a trusted launch machine
+// with secure boot and the virtual TPM enabled
+func NewSecurityProfile() *SecurityProfile {
+	return &SecurityProfile{
+		Settings: SecuritySettings{
+			SecurityType: SecurityTypeTrustedLaunch,
+			TrustedLaunch: &TrustedLaunch{
+				UEFISettings: UEFISettings{
+					SecureBoot:                       Enabled,
+					VirtualizedTrustedPlatformModule: Enabled,
+				},
+			},
+		},
+	}
+}
+
+// IsTrustedLaunch returns true if sp is the security profile of a trusted
+// launch machine.  sp may be nil.
+func (sp *SecurityProfile) IsTrustedLaunch() bool {
+	return sp != nil && sp.Settings.SecurityType == SecurityTypeTrustedLaunch
+}
+
+// SecureBootEnabled returns true if sp enables secure boot.  sp may be nil.
+func (sp *SecurityProfile) SecureBootEnabled() bool {
+	return sp.IsTrustedLaunch() && sp.Settings.TrustedLaunch != nil &&
+		sp.Settings.TrustedLaunch.UEFISettings.SecureBoot == Enabled
+}
+
+// VTPMEnabled returns true if sp enables the virtual TPM.  sp may be nil.
+func (sp *SecurityProfile) VTPMEnabled() bool {
+	return sp.IsTrustedLaunch() && sp.Settings.TrustedLaunch != nil &&
+		sp.Settings.TrustedLaunch.UEFISettings.VirtualizedTrustedPlatformModule == Enabled
+}
+
+// GetSecurityProfile returns the security profile of the raw provider spec, or
+// nil if it has none
+func GetSecurityProfile(providerSpec []byte) (*SecurityProfile, error) {
+	var spec struct {
+		SecurityProfile *SecurityProfile `json:"securityProfile,omitempty"`
+	}
+
+	err := json.Unmarshal(providerSpec, &spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return spec.SecurityProfile, nil
+}
+
+// SetSecurityProfile returns the raw provider spec with its security profile
+// set to sp, or removed if sp is nil.  The other fields of the provider spec
+// are preserved.
+func SetSecurityProfile(providerSpec []byte, sp *SecurityProfile) ([]byte, error) {
+	var spec map[string]interface{}
+
+	err := json.Unmarshal(providerSpec, &spec)
+	if err != nil {
+		return nil, err
+	}
+
+	if sp == nil {
+		delete(spec, "securityProfile")
+	} else {
+		spec["securityProfile"] = sp
+	}
+
+	return json.Marshal(spec)
+}
@@ -0,0 +1,66 @@
+package trustedlaunch
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSecurityProfile(t *testing.T) {
+	for _, tt := range []struct {
+		name           string
+		providerSpec   string
+		sp             *SecurityProfile
+		want           string
+		wantSecureBoot bool
+		wantVTPM       bool
+	}{
+		{
+			name:           "set on provider spec without security profile",
+			providerSpec:   `{"kind":"AzureMachineProviderSpec","vmSize":"Standard_D4s_v3"}`,
+			sp:             NewSecurityProfile(),
+			want:           `{"kind":"AzureMachineProviderSpec","securityProfile":{"settings":{"securityType":"TrustedLaunch","trustedLaunch":{"uefiSettings":{"secureBoot":"Enabled","virtualizedTrustedPlatformModule":"Enabled"}}}},"vmSize":"Standard_D4s_v3"}`,
+			wantSecureBoot: true,
+			wantVTPM:       true,
+		},
+		{
+			name:         "remove from provider spec",
+			providerSpec: `{"kind":"AzureMachineProviderSpec","securityProfile":{"settings":{"securityType":"TrustedLaunch"}}}`,
+			want:         `{"kind":"AzureMachineProviderSpec"}`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := SetSecurityProfile([]byte(tt.providerSpec), tt.sp)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(b) != tt.want {
+				t.Error(string(b))
+			}
+
+			sp, err := GetSecurityProfile(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(sp, tt.sp) {
+				t.Error(sp)
+			}
+
+			if sp.IsTrustedLaunch() != (tt.sp != nil) {
+				t.Error(sp.IsTrustedLaunch())
+			}
+
+			if sp.SecureBootEnabled() != tt.wantSecureBoot {
+				t.Error(sp.SecureBootEnabled())
+			}
+
+			if sp.VTPMEnabled() != tt.wantVTPM {
+				t.Error(sp.VTPMEnabled())
+			}
+		})
+	}
+}
@@ -50,3 +50,9 @@ func MdsdImage(acrDomain string) string {
 func RouteFixImage(acrDomain string) string {
 	return acrDomain + "/routefix:c5c4a5db"
 }
+
+// NodeProblemDetectorImage contains the location of the node problem detector
+// container image
+func NodeProblemDetectorImage(acrDomain string) string {
+	return acrDomain + "/node-problem-detector/node-problem-detector:v0.8.5"
+}
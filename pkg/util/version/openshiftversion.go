@@ -0,0 +1,36 @@
+package version
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+// DefaultOpenShiftVersion returns the InstallStream as an OpenShift version.
+// It is installable until the database holds a document which deprecates it.
+func DefaultOpenShiftVersion() *api.OpenShiftVersion {
+	return &api.OpenShiftVersion{
+		Version:           InstallStream.Version.String(),
+		OpenShiftPullspec: InstallStream.PullSpec,
+		Enabled:           true,
+	}
+}
+
+// Installable returns true if v is enabled and can be installed by the
+// installer which this RP vendors.  The installer only installs releases of
+// its own minor version, so a version of a new minor can be added to the
+// database before the RP which installs it is rolled out.
+func Installable(v *api.OpenShiftVersion) bool {
+	if !v.Enabled {
+		return false
+	}
+
+	vsn, err := ParseVersion(v.Version)
+	if err != nil || vsn.Suffix != "" {
+		return false
+	}
+
+	return vsn.V[0] == InstallStream.Version.V[0] &&
+		vsn.V[1] == InstallStream.Version.V[1]
+}
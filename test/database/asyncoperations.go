@@ -0,0 +1,93 @@
+package database
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+)
+
+func fakeAsyncOperationsUnarchivedQuery(client cosmosdb.AsyncOperationDocumentClient, query *cosmosdb.Query, options *cosmosdb.Options) cosmosdb.AsyncOperationDocumentRawIterator {
+	input, err := client.ListAll(context.Background(), nil)
+	if err != nil {
+		return cosmosdb.NewFakeAsyncOperationDocumentErroringRawIterator(err)
+	}
+
+	var results []*api.AsyncOperationDocument
+	for _, r := range input.AsyncOperationDocuments {
+		if r.Archived || r.AsyncOperation == nil || !r.AsyncOperation.ProvisioningState.IsTerminal() {
+			continue
+		}
+
+		results = append(results, r)
+	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i].ID < results[j].ID })
+
+	return cosmosdb.NewFakeAsyncOperationDocumentIterator(results, 0)
+}
+
+func fakeAsyncOperationsFailedSinceQuery(client cosmosdb.AsyncOperationDocumentClient, query *cosmosdb.Query, options *cosmosdb.Options) cosmosdb.AsyncOperationDocumentRawIterator {
+	input, err := client.ListAll(context.Background(), nil)
+	if err != nil {
+		return cosmosdb.NewFakeAsyncOperationDocumentErroringRawIterator(err)
+	}
+
+	since, err := strconv.Atoi(query.Parameters[0].Value)
+	if err != nil {
+		return cosmosdb.NewFakeAsyncOperationDocumentErroringRawIterator(err)
+	}
+
+	var results []*api.AsyncOperationDocument
+	for _, r := range input.AsyncOperationDocuments {
+		if r.AsyncOperation == nil || r.AsyncOperation.ProvisioningState != api.ProvisioningStateFailed || r.Timestamp < since {
+			continue
+		}
+
+		results = append(results, r)
+	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i].ID < results[j].ID })
+
+	return cosmosdb.NewFakeAsyncOperationDocumentIterator(results, 0)
+}
+
+func fakeAsyncOperationsCompletedSinceQuery(client cosmosdb.AsyncOperationDocumentClient, query *cosmosdb.Query, options *cosmosdb.Options) cosmosdb.AsyncOperationDocumentRawIterator {
+	input, err := client.ListAll(context.Background(), nil)
+	if err != nil {
+		return cosmosdb.NewFakeAsyncOperationDocumentErroringRawIterator(err)
+	}
+
+	since, err := strconv.Atoi(query.Parameters[0].Value)
+	if err != nil {
+		return cosmosdb.NewFakeAsyncOperationDocumentErroringRawIterator(err)
+	}
+
+	var results []*api.AsyncOperationDocument
+	for _, r := range input.AsyncOperationDocuments {
+		if r.AsyncOperation == nil || r.Timestamp < since {
+			continue
+		}
+
+		switch r.AsyncOperation.ProvisioningState {
+		case api.ProvisioningStateSucceeded, api.ProvisioningStateFailed:
+			results = append(results, r)
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i].ID < results[j].ID })
+
+	return cosmosdb.NewFakeAsyncOperationDocumentIterator(results, 0)
+}
+
+func injectAsyncOperations(c *cosmosdb.FakeAsyncOperationDocumentClient) {
+	c.SetQueryHandler(database.AsyncOperationsUnarchivedQuery, fakeAsyncOperationsUnarchivedQuery)
+	c.SetQueryHandler(database.AsyncOperationsFailedSinceQuery, fakeAsyncOperationsFailedSinceQuery)
+	c.SetQueryHandler(database.AsyncOperationsCompletedSinceQuery, fakeAsyncOperationsCompletedSinceQuery)
+}
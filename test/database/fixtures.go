@@ -17,11 +17,15 @@ type Fixture struct {
 	subscriptionDocuments     []*api.SubscriptionDocument
 	billingDocuments          []*api.BillingDocument
 	asyncOperationDocuments   []*api.AsyncOperationDocument
-
-	openShiftClustersDatabase database.OpenShiftClusters
-	billingDatabase           database.Billing
-	subscriptionsDatabase     database.Subscriptions
-	asyncOperationsDatabase   database.AsyncOperations
+	openShiftVersionDocuments []*api.OpenShiftVersionDocument
+	clusterInventoryDocuments []*api.ClusterInventoryDocument
+
+	openShiftClustersDatabase  database.OpenShiftClusters
+	billingDatabase            database.Billing
+	subscriptionsDatabase      database.Subscriptions
+	asyncOperationsDatabase    database.AsyncOperations
+	openShiftVersionsDatabase  database.OpenShiftVersions
+	clusterInventoriesDatabase database.ClusterInventories
 }
 
 func NewFixture() *Fixture {
@@ -48,6 +52,16 @@ func (f *Fixture) WithAsyncOperations(db database.AsyncOperations) *Fixture {
 	return f
 }
 
+func (f *Fixture) WithOpenShiftVersions(db database.OpenShiftVersions) *Fixture {
+	f.openShiftVersionsDatabase = db
+	return f
+}
+
+func (f *Fixture) WithClusterInventories(db database.ClusterInventories) *Fixture {
+	f.clusterInventoriesDatabase = db
+	return f
+}
+
 func (f *Fixture) AddOpenShiftClusterDocuments(docs ...*api.OpenShiftClusterDocument) {
 	f.openshiftClusterDocuments = append(f.openshiftClusterDocuments, docs...)
 }
@@ -64,6 +78,14 @@ func (f *Fixture) AddAsyncOperationDocuments(docs ...*api.AsyncOperationDocument
 	f.asyncOperationDocuments = append(f.asyncOperationDocuments, docs...)
 }
 
+func (f *Fixture) AddOpenShiftVersionDocuments(docs ...*api.OpenShiftVersionDocument) {
+	f.openShiftVersionDocuments = append(f.openShiftVersionDocuments, docs...)
+}
+
+func (f *Fixture) AddClusterInventoryDocuments(docs ...*api.ClusterInventoryDocument) {
+	f.clusterInventoryDocuments = append(f.clusterInventoryDocuments, docs...)
+}
+
 func (f *Fixture) Create() error {
 	ctx := context.Background()
 
@@ -98,5 +120,19 @@ func (f *Fixture) Create() error {
 		}
 	}
 
+	for _, i := range f.openShiftVersionDocuments {
+		_, err := f.openShiftVersionsDatabase.Create(ctx, i)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, i := range f.clusterInventoryDocuments {
+		_, err := f.clusterInventoriesDatabase.Put(ctx, i)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -44,6 +44,19 @@ func NewFakeBilling() (db database.Billing, client *cosmosdb.FakeBillingDocument
 
 func NewFakeAsyncOperations() (db database.AsyncOperations, client *cosmosdb.FakeAsyncOperationDocumentClient) {
 	client = cosmosdb.NewFakeAsyncOperationDocumentClient(jsonHandle)
+	injectAsyncOperations(client)
 	db = database.NewAsyncOperationsWithProvidedClient(client)
 	return db, client
 }
+
+func NewFakeOpenShiftVersions() (db database.OpenShiftVersions, client *cosmosdb.FakeOpenShiftVersionDocumentClient) {
+	client = cosmosdb.NewFakeOpenShiftVersionDocumentClient(jsonHandle)
+	db = database.NewOpenShiftVersionsWithProvidedClient(client)
+	return db, client
+}
+
+func NewFakeClusterInventories() (db database.ClusterInventories, client *cosmosdb.FakeClusterInventoryDocumentClient) {
+	client = cosmosdb.NewFakeClusterInventoryDocumentClient(jsonHandle)
+	db = database.NewClusterInventoriesWithProvidedClient(client)
+	return db, client
+}
@@ -68,6 +68,91 @@ func fakeOpenShiftClustersDequeueQuery(client cosmosdb.OpenShiftClusterDocumentC
 	return cosmosdb.NewFakeOpenShiftClusterDocumentIterator(docs, 0)
 }
 
+func fakeOpenShiftClustersDequeueBucketsQuery(client cosmosdb.OpenShiftClusterDocumentClient, query *cosmosdb.Query, options *cosmosdb.Options) cosmosdb.OpenShiftClusterDocumentRawIterator {
+	docs, err := getQueuedOpenShiftDocuments(client)
+	if err != nil {
+		return cosmosdb.NewFakeOpenShiftClusterDocumentErroringRawIterator(err)
+	}
+
+	var results []*api.OpenShiftClusterDocument
+	for _, r := range docs {
+		if strings.Contains(query.Parameters[0].Value, ","+strconv.Itoa(r.Bucket)+",") {
+			results = append(results, r)
+		}
+	}
+	return cosmosdb.NewFakeOpenShiftClusterDocumentIterator(results, 0)
+}
+
+func fakeOpenShiftClustersDequeuePriorityQuery(client cosmosdb.OpenShiftClusterDocumentClient, query *cosmosdb.Query, options *cosmosdb.Options) cosmosdb.OpenShiftClusterDocumentRawIterator {
+	docs, err := getQueuedOpenShiftDocuments(client)
+	if err != nil {
+		return cosmosdb.NewFakeOpenShiftClusterDocumentErroringRawIterator(err)
+	}
+
+	var results []*api.OpenShiftClusterDocument
+	for _, r := range docs {
+		if isPriorityOpenShiftDocument(r) && (len(query.Parameters) == 0 || strings.Contains(query.Parameters[0].Value, ","+strconv.Itoa(r.Bucket)+",")) {
+			results = append(results, r)
+		}
+	}
+	return cosmosdb.NewFakeOpenShiftClusterDocumentIterator(results, 0)
+}
+
+func fakeOpenShiftClustersPriorityQueueLengthQuery(client cosmosdb.OpenShiftClusterDocumentClient, query *cosmosdb.Query, options *cosmosdb.Options) cosmosdb.OpenShiftClusterDocumentRawIterator {
+	docs, err := getQueuedOpenShiftDocuments(client)
+	if err != nil {
+		return cosmosdb.NewFakeOpenShiftClusterDocumentErroringRawIterator(err)
+	}
+
+	var count int
+	for _, r := range docs {
+		if isPriorityOpenShiftDocument(r) {
+			count++
+		}
+	}
+	return &fakeOpenShiftClustersQueueLengthIterator{resultCount: count}
+}
+
+func fakeOpenShiftClustersCountQuery(client cosmosdb.OpenShiftClusterDocumentClient, query *cosmosdb.Query, options *cosmosdb.Options) cosmosdb.OpenShiftClusterDocumentRawIterator {
+	docs, err := fakeOpenShiftClustersGetAllDocuments(client)
+	if err != nil {
+		return cosmosdb.NewFakeOpenShiftClusterDocumentErroringRawIterator(err)
+	}
+
+	var count int
+	for _, r := range docs {
+		if len(query.Parameters) == 0 || strings.HasPrefix(r.Key, query.Parameters[0].Value) {
+			count++
+		}
+	}
+	return &fakeOpenShiftClustersQueueLengthIterator{resultCount: count}
+}
+
+func isPriorityOpenShiftDocument(doc *api.OpenShiftClusterDocument) bool {
+	switch doc.OpenShiftCluster.Properties.ProvisioningState {
+	case api.ProvisioningStateDeleting, api.ProvisioningStateUpdating:
+		return true
+	}
+	return false
+}
+
+func fakeOpenShiftClustersDequeueStaleQuery(client cosmosdb.OpenShiftClusterDocumentClient, query *cosmosdb.Query, options *cosmosdb.Options) cosmosdb.OpenShiftClusterDocumentRawIterator {
+	docs, err := getQueuedOpenShiftDocuments(client)
+	if err != nil {
+		return cosmosdb.NewFakeOpenShiftClusterDocumentErroringRawIterator(err)
+	}
+
+	stale := int(time.Now().Unix()) - 60
+
+	var results []*api.OpenShiftClusterDocument
+	for _, r := range docs {
+		if r.LeaseExpires < stale && r.Timestamp < stale {
+			results = append(results, r)
+		}
+	}
+	return cosmosdb.NewFakeOpenShiftClusterDocumentIterator(results, 0)
+}
+
 func fakeOpenshiftClustersMatchQuery(client cosmosdb.OpenShiftClusterDocumentClient, query *cosmosdb.Query, options *cosmosdb.Options) cosmosdb.OpenShiftClusterDocumentRawIterator {
 	var results []*api.OpenShiftClusterDocument
 
@@ -99,6 +184,21 @@ func fakeOpenshiftClustersMatchQuery(client cosmosdb.OpenShiftClusterDocumentCli
 	return cosmosdb.NewFakeOpenShiftClusterDocumentIterator(results, int(startingIndex))
 }
 
+func fakeOpenShiftClustersFollowUpTasksQuery(client cosmosdb.OpenShiftClusterDocumentClient, query *cosmosdb.Query, options *cosmosdb.Options) cosmosdb.OpenShiftClusterDocumentRawIterator {
+	docs, err := fakeOpenShiftClustersGetAllDocuments(client)
+	if err != nil {
+		return cosmosdb.NewFakeOpenShiftClusterDocumentErroringRawIterator(err)
+	}
+
+	var results []*api.OpenShiftClusterDocument
+	for _, r := range docs {
+		if len(r.FollowUpTasks) > 0 {
+			results = append(results, r)
+		}
+	}
+	return cosmosdb.NewFakeOpenShiftClusterDocumentIterator(results, 0)
+}
+
 func fakeOpenShiftClustersGetAllDocuments(client cosmosdb.OpenShiftClusterDocumentClient) ([]*api.OpenShiftClusterDocument, error) {
 	input, err := client.ListAll(context.Background(), nil)
 	if err != nil {
@@ -152,11 +252,19 @@ func openShiftClusterConflictChecker(one *api.OpenShiftClusterDocument, two *api
 
 func injectOpenShiftClusters(c *cosmosdb.FakeOpenShiftClusterDocumentClient) {
 	c.SetQueryHandler(database.OpenShiftClustersDequeueQuery, fakeOpenShiftClustersDequeueQuery)
+	c.SetQueryHandler(database.OpenShiftClustersDequeueBucketsQuery, fakeOpenShiftClustersDequeueBucketsQuery)
+	c.SetQueryHandler(database.OpenShiftClustersDequeuePriorityQuery, fakeOpenShiftClustersDequeuePriorityQuery)
+	c.SetQueryHandler(database.OpenShiftClustersDequeuePriorityBucketsQuery, fakeOpenShiftClustersDequeuePriorityQuery)
+	c.SetQueryHandler(database.OpenShiftClustersDequeueStaleQuery, fakeOpenShiftClustersDequeueStaleQuery)
 	c.SetQueryHandler(database.OpenShiftClustersQueueLengthQuery, fakeOpenShiftClustersQueueLengthQuery)
+	c.SetQueryHandler(database.OpenShiftClustersPriorityQueueLengthQuery, fakeOpenShiftClustersPriorityQueueLengthQuery)
 	c.SetQueryHandler(database.OpenShiftClustersGetQuery, fakeOpenshiftClustersMatchQuery)
 	c.SetQueryHandler(database.OpenshiftClustersClientIdQuery, fakeOpenshiftClustersMatchQuery)
 	c.SetQueryHandler(database.OpenshiftClustersResourceGroupQuery, fakeOpenshiftClustersMatchQuery)
 	c.SetQueryHandler(database.OpenshiftClustersPrefixQuery, fakeOpenshiftClustersPrefixQuery)
+	c.SetQueryHandler(database.OpenShiftClustersFollowUpTasksQuery, fakeOpenShiftClustersFollowUpTasksQuery)
+	c.SetQueryHandler(database.OpenShiftClustersCountQuery, fakeOpenShiftClustersCountQuery)
+	c.SetQueryHandler(database.OpenShiftClustersPrefixCountQuery, fakeOpenShiftClustersCountQuery)
 
 	c.SetTriggerHandler("renewLease", fakeOpenShiftClustersRenewLeaseTrigger)
 
@@ -31,7 +31,7 @@ import (
 	"github.com/Azure/ARO-RP/pkg/util/cluster"
 	"github.com/Azure/ARO-RP/pkg/util/deployment"
 	"github.com/Azure/ARO-RP/pkg/util/instancemetadata"
-	"github.com/Azure/ARO-RP/test/util/kubeadminkubeconfig"
+	"github.com/Azure/ARO-RP/pkg/util/kubeadminkubeconfig"
 )
 
 type clientSet struct {
@@ -52,6 +52,7 @@ type clientSet struct {
 var (
 	log            *logrus.Entry
 	deploymentMode deployment.Mode
+	capabilities   deployment.Capabilities
 	im             instancemetadata.InstanceMetadata
 	clusterName    string
 	clients        *clientSet
@@ -75,7 +76,7 @@ func newClientSet(ctx context.Context) (*clientSet, error) {
 		return nil, err
 	}
 
-	configv1, err := kubeadminkubeconfig.Get(ctx, log, authorizer, resourceIDFromEnv())
+	configv1, err := kubeadminkubeconfig.Get(ctx, log, capabilities, authorizer, resourceIDFromEnv())
 	if err != nil {
 		return nil, err
 	}
@@ -114,8 +115,8 @@ func newClientSet(ctx context.Context) (*clientSet, error) {
 	}
 
 	return &clientSet{
-		OpenshiftClusters: redhatopenshift.NewOpenShiftClustersClient(im.SubscriptionID(), authorizer),
-		Operations:        redhatopenshift.NewOperationsClient(im.SubscriptionID(), authorizer),
+		OpenshiftClusters: redhatopenshift.NewOpenShiftClustersClient(capabilities, im.SubscriptionID(), authorizer),
+		Operations:        redhatopenshift.NewOperationsClient(capabilities, im.SubscriptionID(), authorizer),
 		VirtualMachines:   compute.NewVirtualMachinesClient(im.SubscriptionID(), authorizer),
 		Resources:         features.NewResourcesClient(im.SubscriptionID(), authorizer),
 		ActivityLogs:      insights.NewActivityLogsClient(im.SubscriptionID(), authorizer),
@@ -134,6 +135,11 @@ func setup(ctx context.Context) error {
 	log.Infof("running in %s mode", deploymentMode)
 
 	var err error
+	capabilities, err = deployment.NewCapabilities(deploymentMode)
+	if err != nil {
+		return err
+	}
+
 	im, err = instancemetadata.NewDev()
 	if err != nil {
 		return err
@@ -0,0 +1,439 @@
+package fakearm
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// Server is a fake ARM for tests.  It stores the resources which are PUT to
+// it and serves them back, runs actions and long running operations, and
+// injects faults (latency, throttling and failures) into matching requests,
+// so that the retry and recovery behaviour of code which calls ARM through
+// the real Azure SDK clients can be tested deterministically.  It is safe
+// for concurrent use.
+//
+// Point the Azure clients at the Server by sending their requests with
+// Sender(), e.g. with azureclient.WithSender.
+type Server struct {
+	srv *httptest.Server
+
+	mu         sync.Mutex
+	resources  map[string]map[string]interface{}
+	operations map[string]*operation
+	faults     []*Fault
+	requests   []string
+
+	// AsyncPolls is the number of times that the status of each long running
+	// operation is reported as InProgress before it completes.  If zero, PUTs
+	// and DELETEs complete synchronously.
+	AsyncPolls int
+}
+
+// Fault is injected into the requests which match Method and Path
+type Fault struct {
+	// Method is the HTTP method to match; if empty, any method matches
+	Method string
+
+	// Path is a regular expression matched against the request path, case
+	// insensitively; if empty, any path matches
+	Path string
+
+	// Times is the number of matching requests to inject the fault into; if
+	// zero, the fault is injected into every matching request
+	Times int
+
+	// Latency delays the response
+	Latency time.Duration
+
+	// StatusCode, if set, is returned with an ARM error instead of handling
+	// the request
+	StatusCode int
+
+	// Code is the ARM error code to return with StatusCode
+	Code string
+
+	// RetryAfter is the number of seconds returned in the Retry-After header
+	// with StatusCode
+	RetryAfter int
+
+	// AsyncFailure accepts the request but fails its long running operation
+	AsyncFailure bool
+
+	path *regexp.Regexp
+	hits int
+}
+
+type operation struct {
+	polls  int
+	failed bool
+}
+
+// New returns a running Server, which must be closed with Close
+func New() *Server {
+	s := &Server{
+		resources:  map[string]map[string]interface{}{},
+		operations: map[string]*operation{},
+	}
+
+	s.srv = httptest.NewTLSServer(s)
+
+	return s
+}
+
+// Close shuts the Server down
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Sender returns an autorest Sender which sends requests for any host to the
+// Server
+func (s *Server) Sender() autorest.Sender {
+	u, _ := url.Parse(s.srv.URL)
+	cli := s.srv.Client()
+
+	return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		req = req.Clone(req.Context())
+		req.URL.Scheme = u.Scheme
+		req.URL.Host = u.Host
+
+		return cli.Do(req)
+	})
+}
+
+// AddFault injects f into the matching requests from now on
+func (s *Server) AddFault(f *Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f.path = regexp.MustCompile("(?i)" + f.Path)
+	s.faults = append(s.faults, f)
+}
+
+// AddResource stores a resource as though it had been PUT
+func (s *Server) AddResource(id string, resource interface{}) error {
+	b, err := json.Marshal(resource)
+	if err != nil {
+		return err
+	}
+
+	var r map[string]interface{}
+	err = json.Unmarshal(b, &r)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(id, r)
+
+	return nil
+}
+
+// Resource unmarshals the stored resource with the given ID into v and
+// returns true, or returns false if there is no such resource
+func (s *Server) Resource(id string, v interface{}) (bool, error) {
+	s.mu.Lock()
+	r, found := s.resources[strings.ToLower(id)]
+	s.mu.Unlock()
+
+	if !found {
+		return false, nil
+	}
+
+	b, err := json.Marshal(r)
+	if err != nil {
+		return false, err
+	}
+
+	return true, json.Unmarshal(b, v)
+}
+
+// Requests returns the requests received so far, each as "METHOD path",
+// including those into which faults were injected and the polls of long
+// running operations
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.requests...)
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+	f := s.fault(r)
+	s.mu.Unlock()
+
+	var asyncFailure bool
+	if f != nil {
+		if f.Latency > 0 {
+			select {
+			case <-time.After(f.Latency):
+			case <-r.Context().Done():
+				return
+			}
+		}
+
+		if f.StatusCode != 0 {
+			if f.RetryAfter > 0 {
+				w.Header().Set("Retry-After", strconv.Itoa(f.RetryAfter))
+			}
+			writeError(w, f.StatusCode, f.Code, "Injected fault.")
+			return
+		}
+
+		asyncFailure = f.AsyncFailure
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if strings.HasPrefix(r.URL.Path, "/operations/") {
+		s.serveOperation(w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		s.serveGet(w, r)
+	case http.MethodPut:
+		s.servePut(w, r, asyncFailure)
+	case http.MethodPatch:
+		s.servePatch(w, r)
+	case http.MethodDelete:
+		s.serveDelete(w, r, asyncFailure)
+	case http.MethodPost:
+		s.servePost(w, r, asyncFailure)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "", "The method is not supported.")
+	}
+}
+
+// fault returns the first fault to inject into r, if any.  s.mu must be
+// held.
+func (s *Server) fault(r *http.Request) *Fault {
+	for _, f := range s.faults {
+		if f.Method != "" && !strings.EqualFold(f.Method, r.Method) ||
+			!f.path.MatchString(r.URL.Path) ||
+			f.Times > 0 && f.hits >= f.Times {
+			continue
+		}
+
+		f.hits++
+		return f
+	}
+
+	return nil
+}
+
+func (s *Server) serveGet(w http.ResponseWriter, r *http.Request) {
+	if !isCollection(r.URL.Path) {
+		resource, found := s.resources[strings.ToLower(r.URL.Path)]
+		if !found {
+			writeError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("The resource '%s' was not found.", r.URL.Path))
+			return
+		}
+
+		writeJSON(w, http.StatusOK, resource)
+		return
+	}
+
+	prefix := strings.ToLower(r.URL.Path) + "/"
+
+	value := []interface{}{}
+	for id, resource := range s.resources {
+		if strings.HasPrefix(id, prefix) && !strings.Contains(id[len(prefix):], "/") {
+			value = append(value, resource)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"value": value,
+	})
+}
+
+func (s *Server) servePut(w http.ResponseWriter, r *http.Request, asyncFailure bool) {
+	var resource map[string]interface{}
+	err := json.NewDecoder(r.Body).Decode(&resource)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+		return
+	}
+
+	if !asyncFailure {
+		s.put(r.URL.Path, resource)
+	}
+
+	if s.AsyncPolls == 0 && !asyncFailure {
+		writeJSON(w, http.StatusOK, resource)
+		return
+	}
+
+	s.startOperation(w, r, asyncFailure)
+	writeJSON(w, http.StatusCreated, resource)
+}
+
+// servePatch merges the top level fields of the request into the resource
+func (s *Server) servePatch(w http.ResponseWriter, r *http.Request) {
+	resource, found := s.resources[strings.ToLower(r.URL.Path)]
+	if !found {
+		writeError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("The resource '%s' was not found.", r.URL.Path))
+		return
+	}
+
+	var patch map[string]interface{}
+	err := json.NewDecoder(r.Body).Decode(&patch)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+		return
+	}
+
+	for k, v := range patch {
+		resource[k] = v
+	}
+
+	writeJSON(w, http.StatusOK, resource)
+}
+
+// serveDelete deletes the resource and its children
+func (s *Server) serveDelete(w http.ResponseWriter, r *http.Request, asyncFailure bool) {
+	id := strings.ToLower(r.URL.Path)
+
+	if _, found := s.resources[id]; !found {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if !asyncFailure {
+		for k := range s.resources {
+			if k == id || strings.HasPrefix(k, id+"/") {
+				delete(s.resources, k)
+			}
+		}
+	}
+
+	if s.AsyncPolls == 0 && !asyncFailure {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	s.startOperation(w, r, asyncFailure)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// servePost runs an action (e.g. a VM start) on the resource; actions are
+// always long running operations
+func (s *Server) servePost(w http.ResponseWriter, r *http.Request, asyncFailure bool) {
+	id := strings.ToLower(r.URL.Path[:strings.LastIndexByte(r.URL.Path, '/')])
+
+	if _, found := s.resources[id]; !found {
+		writeError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("The resource '%s' was not found.", id))
+		return
+	}
+
+	s.startOperation(w, r, asyncFailure)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (s *Server) serveOperation(w http.ResponseWriter, r *http.Request) {
+	op, found := s.operations[r.URL.Path]
+	if !found {
+		writeError(w, http.StatusNotFound, "NotFound", "The operation was not found.")
+		return
+	}
+
+	w.Header().Set("Retry-After", "0")
+
+	switch {
+	case op.polls > 0:
+		op.polls--
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"status": "InProgress",
+		})
+
+	case op.failed:
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"status": "Failed",
+			"error": map[string]interface{}{
+				"code":    "InternalOperationError",
+				"message": "Injected fault.",
+			},
+		})
+
+	default:
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"status": "Succeeded",
+		})
+	}
+}
+
+// put stores a resource.  s.mu must be held.
+func (s *Server) put(id string, resource map[string]interface{}) {
+	resource["id"] = id
+	resource["name"] = id[strings.LastIndexByte(id, '/')+1:]
+
+	s.resources[strings.ToLower(id)] = resource
+}
+
+// startOperation starts a long running operation and sets the header through
+// which the client polls it.  s.mu must be held.
+func (s *Server) startOperation(w http.ResponseWriter, r *http.Request, failed bool) {
+	path := fmt.Sprintf("/operations/%d", len(s.operations))
+
+	s.operations[path] = &operation{
+		polls:  s.AsyncPolls,
+		failed: failed,
+	}
+
+	w.Header().Set("Azure-AsyncOperation", "https://"+r.Host+path)
+	w.Header().Set("Retry-After", "0")
+}
+
+// isCollection returns true if path is a collection of resources (e.g.
+// .../providers/Microsoft.Compute/virtualMachines) rather than a resource
+func isCollection(path string) bool {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+
+	for i := len(parts) - 1; i >= 0; i-- {
+		if strings.EqualFold(parts[i], "providers") {
+			// providers/{namespace}/{type}/{name}[/{type}/{name}...]
+			return (len(parts)-i-1)%2 == 0
+		}
+	}
+
+	// subscriptions/{id}/resourceGroups/{name}
+	return len(parts)%2 == 1
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, statusCode int, code, message string) {
+	if code == "" {
+		code = strings.ReplaceAll(http.StatusText(statusCode), " ", "")
+	}
+
+	writeJSON(w, statusCode, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
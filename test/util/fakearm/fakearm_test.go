@@ -0,0 +1,118 @@
+package fakearm
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	mgmtfeatures "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-07-01/features"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/features"
+)
+
+func TestServer(t *testing.T) {
+	subscriptionID := "00000000-0000-0000-0000-000000000000"
+
+	s := New()
+	defer s.Close()
+
+	s.AsyncPolls = 1
+
+	ctx := azureclient.WithSender(context.Background(), s.Sender())
+	resourceGroups := features.NewResourceGroupsClient(subscriptionID, &autorest.NullAuthorizer{})
+
+	s.AddFault(&Fault{
+		Method:     http.MethodPut,
+		Times:      1,
+		StatusCode: http.StatusBadRequest,
+		Code:       "LocationNotAvailableForResourceGroup",
+	})
+
+	_, err := resourceGroups.CreateOrUpdate(ctx, "rg", mgmtfeatures.ResourceGroup{Location: to.StringPtr("eastus")})
+	if err == nil || !strings.Contains(err.Error(), `Code="LocationNotAvailableForResourceGroup"`) {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"rg", "RG2"} {
+		_, err = resourceGroups.CreateOrUpdate(ctx, name, mgmtfeatures.ResourceGroup{Location: to.StringPtr("eastus")})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rg, err := resourceGroups.Get(ctx, "rg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *rg.ID != "/subscriptions/"+subscriptionID+"/resourcegroups/rg" || *rg.Name != "rg" || *rg.Location != "eastus" {
+		t.Error(*rg.ID, *rg.Name, *rg.Location)
+	}
+
+	rgs, err := resourceGroups.List(ctx, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rgs) != 2 {
+		t.Error(len(rgs))
+	}
+
+	err = resourceGroups.DeleteAndWait(ctx, "rg")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = resourceGroups.Get(ctx, "rg")
+	if err == nil || !strings.Contains(err.Error(), `Code="ResourceNotFound"`) {
+		t.Error(err)
+	}
+
+	var stored mgmtfeatures.ResourceGroup
+	found, err := s.Resource("/subscriptions/"+subscriptionID+"/resourcegroups/rg2", &stored)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !found || *stored.Name != "RG2" {
+		t.Error(found, stored)
+	}
+
+	s.AddFault(&Fault{
+		Method:       http.MethodDelete,
+		AsyncFailure: true,
+	})
+
+	err = resourceGroups.DeleteAndWait(ctx, "rg2")
+	if err == nil || !strings.Contains(err.Error(), `Code="InternalOperationError"`) {
+		t.Error(err)
+	}
+
+	// a failed operation leaves the resource in place
+	_, err = resourceGroups.Get(ctx, "rg2")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestIsCollection(t *testing.T) {
+	for path, want := range map[string]bool{
+		"/subscriptions":                       true,
+		"/subscriptions/sub":                   false,
+		"/subscriptions/sub/resourcegroups":    true,
+		"/subscriptions/sub/resourcegroups/rg": false,
+		"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines":                          true,
+		"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/vm":                       false,
+		"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet/subnets":             true,
+		"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet/subnets/subnet":      false,
+		"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/privateDnsZones/zone/virtualNetworkLinks": true,
+	} {
+		if got := isCollection(path); got != want {
+			t.Error(path, got)
+		}
+	}
+}